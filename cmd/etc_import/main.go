@@ -0,0 +1,89 @@
+// etc_import ETC利用照会サービスからダウンロードした明細CSVをdb_serviceに取り込む
+//
+//	go run ./cmd/etc_import -dry-run 202509.csv   # 取り込み結果の確認のみ
+//	go run ./cmd/etc_import 202509.csv 202510.csv
+//
+// 登録済みの明細（hashが同じ明細）は作成しないため、同じCSVを再度取り込んでも重複しない。
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/yhonda-ohishi/db_service/src/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func main() {
+	addr := flag.String("addr", "localhost:50051", "db_serviceのアドレス")
+	dryRun := flag.Bool("dry-run", false, "作成せずに取り込み結果のみ表示する")
+	verbose := flag.Bool("v", false, "重複した明細も1件ずつ表示する")
+	timeout := flag.Duration("timeout", time.Minute, "1ファイルあたりのタイムアウト")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] file.csv...\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to connect: %v", err)
+	}
+	defer conn.Close()
+	client := proto.NewDb_ETCMeisaiServiceClient(conn)
+
+	failed := false
+	for _, path := range flag.Args() {
+		if err := importFile(client, path, *dryRun, *verbose, *timeout); err != nil {
+			log.Printf("%s: %v", path, err)
+			failed = true
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// importFile 1ファイルを取り込んで結果を表示
+func importFile(client proto.Db_ETCMeisaiServiceClient, path string, dryRun, verbose bool, timeout time.Duration) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	resp, err := client.Import(ctx, &proto.Db_ImportETCMeisaiRequest{Csv: data, DryRun: dryRun})
+	if err != nil {
+		return err
+	}
+
+	mode := ""
+	if resp.DryRun {
+		mode = " (dry-run: 作成予定)"
+	}
+	fmt.Printf("%s: 作成 %d件 / 重複 %d件 / 不正 %d件%s\n",
+		path, resp.InsertedCount, resp.DuplicateCount, resp.InvalidCount, mode)
+
+	for _, result := range resp.Results {
+		switch result.Status {
+		case proto.Db_BatchItemStatus_BATCH_ITEM_STATUS_INVALID:
+			fmt.Printf("  %d行目: 不正: %s\n", result.Index, result.Reason)
+		case proto.Db_BatchItemStatus_BATCH_ITEM_STATUS_DUPLICATE:
+			if verbose {
+				fmt.Printf("  %d行目: 重複: id=%d %s\n", result.Index, result.Id, result.Reason)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+// mapping_audit etc_meisai_mappingの整合性をチェックする
+//
+//	go run ./cmd/mapping_audit                        # 全ての問題を表示
+//	go run ./cmd/mapping_audit -kind orphan_dtako_row # 運行データがないマッピングのみ表示
+//
+// 問題が見つかった場合は終了コード1で終了する（定期実行での検知用）。
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/yhonda-ohishi/db_service/src/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// kindNames -kindで指定する問題の種類の名前
+var kindNames = map[string]proto.Db_MappingIssueKind{
+	"orphan_hash":      proto.Db_MappingIssueKind_MAPPING_ISSUE_KIND_ORPHAN_HASH,
+	"orphan_dtako_row": proto.Db_MappingIssueKind_MAPPING_ISSUE_KIND_ORPHAN_DTAKO_ROW,
+	"duplicate_hash":   proto.Db_MappingIssueKind_MAPPING_ISSUE_KIND_DUPLICATE_HASH,
+	"stale_hash":       proto.Db_MappingIssueKind_MAPPING_ISSUE_KIND_STALE_HASH,
+}
+
+// errIssuesFound 問題が見つかった（終了コード1で終了する）
+var errIssuesFound = errors.New("mapping issues found")
+
+func main() {
+	if err := run(); err != nil {
+		if errors.Is(err, errIssuesFound) {
+			os.Exit(1)
+		}
+		log.Fatal(err)
+	}
+}
+
+// run 監査を実行して結果を表示する
+func run() error {
+	addr := flag.String("addr", "localhost:50051", "db_serviceのアドレス")
+	kinds := flag.String("kind", "", "表示する問題の種類（カンマ区切り: orphan_hash, orphan_dtako_row, duplicate_hash, stale_hash）")
+	timeout := flag.Duration("timeout", 5*time.Minute, "タイムアウト")
+	flag.Parse()
+
+	req := &proto.Db_AuditETCMeisaiMappingRequest{}
+	if *kinds != "" {
+		for _, name := range strings.Split(*kinds, ",") {
+			kind, ok := kindNames[strings.TrimSpace(name)]
+			if !ok {
+				return fmt.Errorf("unknown kind: %q", name)
+			}
+			req.Kinds = append(req.Kinds, kind)
+		}
+	}
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return fmt.Errorf("failed to connect: %w", err)
+	}
+	defer conn.Close()
+	client := proto.NewDb_ETCMeisaiMappingAuditServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	resp, err := client.Audit(ctx, req)
+	if err != nil {
+		return fmt.Errorf("audit failed: %w", err)
+	}
+
+	fmt.Printf("マッピング %d件: ETC明細なし %d件 / 運行データなし %d件 / hash重複 %d件 / hash不一致 %d件\n",
+		resp.MappingCount, resp.OrphanHashCount, resp.OrphanDtakoRowCount, resp.DuplicateHashCount, resp.StaleHashCount)
+
+	for _, issue := range resp.Issues {
+		switch issue.Kind {
+		case proto.Db_MappingIssueKind_MAPPING_ISSUE_KIND_ORPHAN_HASH:
+			fmt.Printf("  ETC明細なし: hash=%s mapping_id=%v\n", issue.EtcMeisaiHash, issue.MappingIds)
+		case proto.Db_MappingIssueKind_MAPPING_ISSUE_KIND_ORPHAN_DTAKO_ROW:
+			fmt.Printf("  運行データなし: dtako_row_id=%v mapping_id=%v\n", issue.DtakoRowIds, issue.MappingIds)
+		case proto.Db_MappingIssueKind_MAPPING_ISSUE_KIND_DUPLICATE_HASH:
+			fmt.Printf("  hash重複: hash=%s dtako_row_id=%v mapping_id=%v\n", issue.EtcMeisaiHash, issue.DtakoRowIds, issue.MappingIds)
+		case proto.Db_MappingIssueKind_MAPPING_ISSUE_KIND_STALE_HASH:
+			fmt.Printf("  hash不一致: etc_meisai_id=%d hash=%s expected=%s mapping_id=%v\n",
+				issue.EtcMeisaiId, issue.EtcMeisaiHash, issue.ExpectedHash, issue.MappingIds)
+		}
+	}
+
+	if len(resp.Issues) > 0 {
+		return errIssuesFound
+	}
+	return nil
+}
@@ -11,12 +11,12 @@ import (
 	"strings"
 	"time"
 
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/soheilhy/cmux"
 	"github.com/yhonda-ohishi/db_service/src/config"
-	"github.com/yhonda-ohishi/db_service/src/proto"
-	"github.com/yhonda-ohishi/db_service/src/repository"
-	"github.com/yhonda-ohishi/db_service/src/service"
+	"github.com/yhonda-ohishi/db_service/src/registry"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -32,111 +32,35 @@ func main() {
 		log.Fatalf("Invalid configuration: %v", err)
 	}
 
-	// データベース接続
-	db, err := config.InitDatabase(cfg)
+	// gRPCサーバーの作成
+	grpcServer := grpc.NewServer()
+
+	// サービスの登録
+	// 組み込み利用時（registry.Register）と同じサービス構成で起動する
+	serviceRegistry, err := registry.NewServiceRegistryE(
+		registry.WithExcludeServices(cfg.ExcludeServices...),
+		registry.WithOnlyServices(cfg.OnlyServices...),
+	)
 	if err != nil {
-		log.Fatalf("Failed to initialize database: %v", err)
+		log.Fatalf("Failed to initialize db_service services: %v", err)
 	}
 	defer func() {
-		if err := config.CloseDatabase(db); err != nil {
-			log.Printf("Failed to close database: %v", err)
+		if err := serviceRegistry.Close(); err != nil {
+			log.Printf("Failed to close databases: %v", err)
 		}
 	}()
-
-	// ヘルスチェック
-	if err := config.HealthCheck(db); err != nil {
-		log.Fatalf("Database health check failed: %v", err)
-	}
-
-	// 本番データベース接続（オプション）
-	prodDB, err := config.NewProdDatabase()
-	if err != nil {
-		log.Printf("Production database connection failed: %v (continuing without prod DB)", err)
-		prodDB = nil
-	} else {
-		log.Printf("Production database connected successfully")
-		defer func() {
-			if err := prodDB.Close(); err != nil {
-				log.Printf("Failed to close production database: %v", err)
-			}
-		}()
-	}
-
-	// SQL Serverデータベース接続（オプション）
-	sqlServerDB, err := config.NewSQLServerDatabase()
-	if err != nil {
-		log.Printf("SQL Server database connection failed: %v (continuing without SQL Server)", err)
-		sqlServerDB = nil
-	} else {
-		log.Printf("SQL Server database connected successfully")
-		defer func() {
-			sqlDB, _ := sqlServerDB.DB.DB()
-			if sqlDB != nil {
-				sqlDB.Close()
-			}
-		}()
-	}
-
-	// リポジトリの初期化
-	dtakoUriageKeihiRepo := repository.NewDTakoUriageKeihiRepository(db)
-	etcMeisaiRepo := repository.NewETCMeisaiRepository(db)
-	dtakoFerryRowsRepo := repository.NewDTakoFerryRowsRepository(db)
-
-	// 本番DBリポジトリの初期化（現在無効化）
-	// var dtakoRowsRepo repository.DTakoRowsRepository
-	// var etcNumRepo repository.ETCNumRepository
-	// if prodDB != nil {
-	//     dtakoRowsRepo = repository.NewDTakoRowsRepository(prodDB)
-	//     etcNumRepo = repository.NewETCNumRepository(prodDB)
-	// }
-
-	// gRPCサーバーの作成
-	grpcServer := grpc.NewServer()
-
-	// サービスの登録
-	dtakoUriageKeihiService := service.NewDTakoUriageKeihiService(dtakoUriageKeihiRepo)
-	proto.RegisterDb_DTakoUriageKeihiServiceServer(grpcServer, dtakoUriageKeihiService)
-
-	etcMeisaiService := service.NewETCMeisaiService(etcMeisaiRepo)
-	proto.RegisterDb_ETCMeisaiServiceServer(grpcServer, etcMeisaiService)
-
-	dtakoFerryRowsService := service.NewDTakoFerryRowsService(dtakoFerryRowsRepo)
-	proto.RegisterDb_DTakoFerryRowsServiceServer(grpcServer, dtakoFerryRowsService)
-
-	// ETC明細マッピングサービスの登録
-	etcMeisaiMappingRepo := repository.NewETCMeisaiMappingRepository(db)
-	etcMeisaiMappingService := service.NewETCMeisaiMappingService(etcMeisaiMappingRepo)
-	proto.RegisterDb_ETCMeisaiMappingServiceServer(grpcServer, etcMeisaiMappingService)
-
-	// SQL Serverサービスの登録
-	if sqlServerDB != nil {
-		// SQL Serverリポジトリの初期化
-		untenNippoMeisaiRepo := repository.NewUntenNippoMeisaiRepository(sqlServerDB)
-		shainMasterRepo := repository.NewShainMasterRepository(sqlServerDB)
-		chiikiMasterRepo := repository.NewChiikiMasterRepository(sqlServerDB)
-		chikuMasterRepo := repository.NewChikuMasterRepository(sqlServerDB)
-
-		// SQL Serverサービスの登録
-		untenNippoMeisaiService := service.NewUntenNippoMeisaiService(untenNippoMeisaiRepo)
-		proto.RegisterDb_UntenNippoMeisaiServiceServer(grpcServer, untenNippoMeisaiService)
-
-		shainMasterService := service.NewShainMasterService(shainMasterRepo)
-		proto.RegisterDb_ShainMasterServiceServer(grpcServer, shainMasterService)
-
-		chiikiMasterService := service.NewChiikiMasterService(chiikiMasterRepo)
-		proto.RegisterDb_ChiikiMasterServiceServer(grpcServer, chiikiMasterService)
-
-		chikuMasterService := service.NewChikuMasterService(chikuMasterRepo)
-		proto.RegisterDb_ChikuMasterServiceServer(grpcServer, chikuMasterService)
-
-		log.Println("SQL Server services registered: UntenNippoMeisai, ShainMaster, ChiikiMaster, ChikuMaster")
+	for _, backend := range serviceRegistry.Availability().Backends() {
+		if !backend.Available && !backend.NotConfigured {
+			log.Printf("Backend %s unavailable: %s (related services return UNAVAILABLE until it recovers)", backend.Backend, backend.Reason)
+		}
 	}
+	serviceRegistry.RegisterAll(grpcServer)
 
-	// 本番DBサービスの登録（現在無効化）
-	if prodDB != nil {
-		// Note: 本番DBサービスは現在無効化されています（プロトコルバッファー定義が必要）
-		log.Println("本番DBサービスは現在無効化されています")
-	}
+	// ヘルスチェック（grpc.health.v1）の登録とバックグラウンド監視
+	serviceRegistry.RegisterHealth(grpcServer)
+	healthCtx, healthCancel := context.WithCancel(context.Background())
+	defer healthCancel()
+	serviceRegistry.StartHealthProber(healthCtx, time.Duration(cfg.HealthProbeInterval)*time.Second)
 
 	// リフレクションの登録（開発環境用）
 	reflection.Register(grpcServer)
@@ -147,7 +71,7 @@ func main() {
 		log.Fatalf("Failed to listen on %s: %v", cfg.GetGRPCAddress(), err)
 	}
 
-	log.Printf("Server starting on %s (gRPC + HTTP)", cfg.GetGRPCAddress())
+	log.Printf("Server starting on %s (gRPC + HTTP/REST)", cfg.GetGRPCAddress())
 
 	// グレースフルシャットダウンの設定
 	sigChan := make(chan os.Signal, 1)
@@ -190,10 +114,25 @@ func main() {
 		}()
 	})
 
-	httpMux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		fmt.Fprintln(w, "OK")
-	})
+	httpMux.Handle("/health", serviceRegistry.HealthHandler())
+
+	// gRPC-Gateway（REST/JSON API）の設定
+	// 同一ポートのgRPCサーバーへループバック接続し、/api/ 以下のリクエストを転送する
+	gatewayCtx, gatewayCancel := context.WithCancel(context.Background())
+	defer gatewayCancel()
+
+	gatewayConn, err := grpc.NewClient(fmt.Sprintf("localhost:%d", cfg.GRPCPort),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to create gateway client: %v", err)
+	}
+	defer gatewayConn.Close()
+
+	gatewayMux := runtime.NewServeMux()
+	if err := registry.RegisterGateway(gatewayCtx, gatewayMux, gatewayConn, grpcServer); err != nil {
+		log.Fatalf("Failed to register gateway handlers: %v", err)
+	}
+	httpMux.Handle("/api/", gatewayMux)
 
 	httpServer := &http.Server{
 		Handler: httpMux,
@@ -25,17 +25,17 @@ func main() {
 	fmt.Println("=== 既存サービステスト ===")
 
 	// ETCMeisaiサービステスト
-	etcClient := proto.NewETCMeisaiServiceClient(conn)
-	etcListResp, err := etcClient.List(ctx, &proto.ListETCMeisaiRequest{
+	etcClient := proto.NewDb_ETCMeisaiServiceClient(conn)
+	etcListResp, err := etcClient.List(ctx, &proto.Db_ListETCMeisaiRequest{
 		Limit:  5,
 		Offset: 0,
 	})
 	if err != nil {
 		log.Printf("ETCMeisai List エラー: %v", err)
 	} else {
-		fmt.Printf("ETCMeisai総数: %d件\n", etcListResp.TotalCount)
+		fmt.Printf("ETCMeisai総数: %d件\n", etcListResp.GetTotalCount())
 		for i, item := range etcListResp.Items {
-			fmt.Printf("  %d. ID:%d, %s -> %s, 料金:%d円\n", i+1, item.Id, item.IcFr, item.IcTo, item.Price)
+			fmt.Printf("  %d. ID:%d, %s -> %s, 料金:%d円\n", i+1, item.Id, item.GetIcFr(), item.IcTo, item.Price)
 		}
 	}
 
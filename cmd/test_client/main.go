@@ -20,18 +20,19 @@ func main() {
 	defer conn.Close()
 
 	// ETCMeisaiクライアントを作成
-	etcClient := proto.NewETCMeisaiServiceClient(conn)
+	etcClient := proto.NewDb_ETCMeisaiServiceClient(conn)
 
 	// テストデータの作成
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
 	defer cancel()
 
 	// Create テスト
-	createReq := &proto.CreateETCMeisaiRequest{
-		EtcMeisai: &proto.ETCMeisai{
+	icFr := "東京IC"
+	createReq := &proto.Db_CreateETCMeisaiRequest{
+		EtcMeisai: &proto.Db_ETCMeisai{
 			DateTo:     time.Now().Format(time.RFC3339),
 			DateToDate: time.Now().Format("2006-01-02"),
-			IcFr:       "東京IC",
+			IcFr:       &icFr,
 			IcTo:       "名古屋IC",
 			Price:      5000,
 			Shashu:     1,
@@ -47,7 +48,7 @@ func main() {
 	}
 
 	// List テスト
-	listReq := &proto.ListETCMeisaiRequest{
+	listReq := &proto.Db_ListETCMeisaiRequest{
 		Limit:  10,
 		Offset: 0,
 	}
@@ -56,9 +57,9 @@ func main() {
 	if err != nil {
 		log.Printf("List failed: %v", err)
 	} else {
-		fmt.Printf("Found %d ETC records\n", listResp.TotalCount)
+		fmt.Printf("Found %d ETC records\n", listResp.GetTotalCount())
 		for i, item := range listResp.Items {
-			fmt.Printf("%d. IC: %s -> %s, Price: %d\n", i+1, item.IcFr, item.IcTo, item.Price)
+			fmt.Printf("%d. IC: %s -> %s, Price: %d\n", i+1, item.GetIcFr(), item.IcTo, item.Price)
 		}
 	}
 }
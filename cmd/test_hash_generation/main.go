@@ -6,7 +6,7 @@ import (
 	"time"
 
 	"github.com/yhonda-ohishi/db_service/src/config"
-	"github.com/yhonda-ohishi/db_service/src/models"
+	models "github.com/yhonda-ohishi/db_service/src/models/mysql"
 )
 
 func main() {
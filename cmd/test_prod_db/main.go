@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -27,11 +28,13 @@ func main() {
 
 	fmt.Println("本番DB接続成功!")
 
+	ctx := context.Background()
+
 	// DTakoCarsテーブルテスト
 	fmt.Println("\n=== DTakoCars テスト ===")
 	carsRepo := repository.NewDTakoCarsRepository(prodDB)
 
-	cars, totalCount, err := carsRepo.GetAll(5, 0)
+	cars, totalCount, err := carsRepo.GetAll(ctx, 5, 0)
 	if err != nil {
 		log.Printf("DTakoCars取得エラー: %v", err)
 	} else {
@@ -45,7 +48,7 @@ func main() {
 	fmt.Println("\n=== DTakoEvents テスト ===")
 	eventsRepo := repository.NewDTakoEventsRepository(prodDB)
 
-	events, totalCount, err := eventsRepo.GetAll(3, 0)
+	events, totalCount, err := eventsRepo.GetAll(ctx, 3, 0, nil)
 	if err != nil {
 		log.Printf("DTakoEvents取得エラー: %v", err)
 	} else {
@@ -59,7 +62,7 @@ func main() {
 	fmt.Println("\n=== DTakoRows テスト ===")
 	rowsRepo := repository.NewDTakoRowsRepository(prodDB)
 
-	rows, totalCount, err := rowsRepo.GetAll(3, 0)
+	rows, totalCount, err := rowsRepo.GetAll(ctx, 3, 0, nil)
 	if err != nil {
 		log.Printf("DTakoRows取得エラー: %v", err)
 	} else {
@@ -73,7 +76,7 @@ func main() {
 	fmt.Println("\n=== ETCNum テスト ===")
 	etcRepo := repository.NewETCNumRepository(prodDB)
 
-	etcNums, totalCount, err := etcRepo.GetAll(5, 0)
+	etcNums, totalCount, err := etcRepo.GetAll(ctx, 5, 0)
 	if err != nil {
 		log.Printf("ETCNum取得エラー: %v", err)
 	} else {
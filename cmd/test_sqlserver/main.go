@@ -33,7 +33,7 @@ func main() {
 }
 
 func testShainMaster(conn *grpc.ClientConn) {
-	client := pb.Db_NewShainMasterServiceClient(conn)
+	client := pb.NewDb_ShainMasterServiceClient(conn)
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
@@ -49,15 +49,15 @@ func testShainMaster(conn *grpc.ClientConn) {
 		return
 	}
 
-	log.Printf("ShainMaster List success: %d items (total: %d)", len(resp.Items), resp.TotalCount)
+	log.Printf("ShainMaster List success: %d items (total: %d)", len(resp.Items), resp.GetTotalCount())
 	if len(resp.Items) > 0 {
 		item := resp.Items[0]
-		log.Printf("  First item: 社員CD=%s, 社員名=%s", item.ShainC, item.ShainN)
+		log.Printf("  First item: 社員CD=%s, 社員名=%s", item.ShainC, item.GetShainN())
 	}
 }
 
 func testChiikiMaster(conn *grpc.ClientConn) {
-	client := pb.Db_NewChiikiMasterServiceClient(conn)
+	client := pb.NewDb_ChiikiMasterServiceClient(conn)
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
@@ -73,9 +73,9 @@ func testChiikiMaster(conn *grpc.ClientConn) {
 		return
 	}
 
-	log.Printf("ChiikiMaster List success: %d items (total: %d)", len(resp.Items), resp.TotalCount)
+	log.Printf("ChiikiMaster List success: %d items (total: %d)", len(resp.Items), resp.GetTotalCount())
 	if len(resp.Items) > 0 {
 		item := resp.Items[0]
-		log.Printf("  First item: 地域CD=%s, 地域名=%s", item.ChiikiC, item.ChiikiN)
+		log.Printf("  First item: 地域CD=%s, 地域名=%s", item.ChiikiC, item.GetChiikiN())
 	}
 }
@@ -0,0 +1,5 @@
+SET NOCOUNT OFF;
+SELECT COLUMN_NAME, DATA_TYPE, CHARACTER_MAXIMUM_LENGTH, IS_NULLABLE
+FROM INFORMATION_SCHEMA.COLUMNS
+WHERE TABLE_NAME = N'$(TABLE_NAME)'
+ORDER BY ORDINAL_POSITION;
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/joho/godotenv"
 )
@@ -20,11 +21,23 @@ type Config struct {
 	// gRPCサーバー設定
 	GRPCPort int
 
+	// サービス設定
+	// 登録から除外するサービス名のリスト（例: DTakoRowsService）
+	ExcludeServices []string
+	// 登録するサービス名のリスト（指定した場合はこのリストのサービスのみ登録）
+	OnlyServices []string
+
+	// ヘルスチェック設定
+	HealthProbeInterval int // 秒単位
+
 	// 接続プール設定
 	MaxOpenConns    int
 	MaxIdleConns    int
 	ConnMaxLifetime int
 	ConnMaxIdleTime int
+
+	// クエリのデフォルトのタイムアウト（秒単位、0の場合はタイムアウトなし）
+	QueryTimeout int
 }
 
 // LoadConfig 環境変数から設定を読み込み
@@ -56,12 +69,23 @@ func LoadConfig() (*Config, error) {
 	// PORT環境変数を優先、なければGRPC_PORT、デフォルトは50051
 	config.GRPCPort = getEnvAsInt("PORT", getEnvAsInt("GRPC_PORT", 50051))
 
+	// サービス設定
+	// カンマ区切りで指定（例: EXCLUDE_SERVICES=DTakoRowsService,DTakoEventsService）
+	config.ExcludeServices = getEnvAsSlice("EXCLUDE_SERVICES")
+	config.OnlyServices = getEnvAsSlice("ONLY_SERVICES")
+
+	// ヘルスチェック設定
+	config.HealthProbeInterval = getEnvAsInt("HEALTH_PROBE_INTERVAL", 30) // 秒単位
+
 	// 接続プール設定
 	config.MaxOpenConns = getEnvAsInt("DB_MAX_OPEN_CONNS", 25)
 	config.MaxIdleConns = getEnvAsInt("DB_MAX_IDLE_CONNS", 5)
 	config.ConnMaxLifetime = getEnvAsInt("DB_CONN_MAX_LIFETIME", 3600) // 秒単位
 	config.ConnMaxIdleTime = getEnvAsInt("DB_CONN_MAX_IDLE_TIME", 300) // 秒単位
 
+	// クエリのタイムアウト設定（リクエストに期限がない場合に適用）
+	config.QueryTimeout = getEnvAsInt("DB_QUERY_TIMEOUT", 30) // 秒単位
+
 	return config, nil
 }
 
@@ -98,6 +122,17 @@ func getEnvAsInt(key string, defaultValue int) int {
 	return defaultValue
 }
 
+// getEnvAsSlice カンマ区切りの環境変数をスライスとして取得
+func getEnvAsSlice(key string) []string {
+	var values []string
+	for _, value := range strings.Split(getEnv(key, ""), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
 // Validate 設定の妥当性を検証
 func (c *Config) Validate() error {
 	if c.DBHost == "" {
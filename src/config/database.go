@@ -48,6 +48,12 @@ func InitDatabase(config *Config) (*gorm.DB, error) {
 		return nil, fmt.Errorf("failed to ping database: %w", err)
 	}
 
+	// クエリのタイムアウト
+	if err := UseQueryTimeout(db, time.Duration(config.QueryTimeout)*time.Second); err != nil {
+		sqlDB.Close()
+		return nil, fmt.Errorf("failed to set query timeout: %w", err)
+	}
+
 	log.Printf("Database connection established: %s:%d/%s",
 		config.DBHost, config.DBPort, config.DBName)
 
@@ -0,0 +1,25 @@
+package config
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// ErrNotConnected 起動時に接続できず、再接続もまだ完了していない
+var ErrNotConnected = errors.New("database is not connected")
+
+// notConnectedDB 未接続の間にConn()が返す接続
+// ErrNotConnectedを設定済みのため、クエリを実行せずにすべての操作がErrNotConnectedを返す
+var notConnectedDB = newNotConnectedDB()
+
+// newNotConnectedDB 未接続の間に使用する接続を作成
+func newNotConnectedDB() *gorm.DB {
+	db, err := gorm.Open(nil, &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		panic(err)
+	}
+	db.Error = ErrNotConnected
+	return db
+}
@@ -1,9 +1,12 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"os"
+	"sync"
+	"time"
 
 	"github.com/joho/godotenv"
 	"gorm.io/driver/mysql"
@@ -11,9 +14,39 @@ import (
 	"gorm.io/gorm/logger"
 )
 
+// ErrProdDBNotConfigured 本番DBの接続情報が環境変数に設定されていない
+var ErrProdDBNotConfigured = errors.New("production database connection info not provided in environment variables")
+
 // ProdDatabase 本番データベース接続（読み取り専用）
+// 起動時に接続できなかった場合は再接続で接続が設定されるため、作成後はConn()・SetConn()で参照・設定する
 type ProdDatabase struct {
 	DB *gorm.DB
+	mu sync.RWMutex
+}
+
+// Conn 接続を取得
+// 未接続の場合もnilは返さず、すべての操作がErrNotConnectedを返す接続を返す
+func (pdb *ProdDatabase) Conn() *gorm.DB {
+	pdb.mu.RLock()
+	defer pdb.mu.RUnlock()
+	if pdb.DB == nil {
+		return notConnectedDB
+	}
+	return pdb.DB
+}
+
+// Connected 接続済みか（起動時に接続できず再接続が完了していない場合はfalse）
+func (pdb *ProdDatabase) Connected() bool {
+	pdb.mu.RLock()
+	defer pdb.mu.RUnlock()
+	return pdb.DB != nil
+}
+
+// SetConn 接続を設定（再接続で使用）
+func (pdb *ProdDatabase) SetConn(db *gorm.DB) {
+	pdb.mu.Lock()
+	defer pdb.mu.Unlock()
+	pdb.DB = db
 }
 
 // NewProdDatabase 本番データベース接続の初期化
@@ -29,6 +62,10 @@ func NewProdDatabase() (*ProdDatabase, error) {
 	password := os.Getenv("PROD_DB_PASSWORD")
 	dbname := os.Getenv("PROD_DB_NAME")
 
+	if host == "" || user == "" || dbname == "" {
+		return nil, ErrProdDBNotConfigured
+	}
+
 	dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?charset=utf8mb4&parseTime=True&loc=Local",
 		user, password, host, port, dbname)
 
@@ -53,6 +90,13 @@ func NewProdDatabase() (*ProdDatabase, error) {
 	sqlDB.SetMaxOpenConns(10)
 	sqlDB.SetMaxIdleConns(5)
 
+	// クエリのタイムアウト（秒単位、0の場合はタイムアウトなし）
+	queryTimeout := getEnvAsInt("PROD_DB_QUERY_TIMEOUT", 30)
+	if err := UseQueryTimeout(db, time.Duration(queryTimeout)*time.Second); err != nil {
+		sqlDB.Close()
+		return nil, fmt.Errorf("failed to set query timeout: %v", err)
+	}
+
 	log.Printf("Production database connection established: %s:%s/%s", host, port, dbname)
 
 	return &ProdDatabase{DB: db}, nil
@@ -60,9 +104,24 @@ func NewProdDatabase() (*ProdDatabase, error) {
 
 // Close 接続を閉じる
 func (pdb *ProdDatabase) Close() error {
-	sqlDB, err := pdb.DB.DB()
+	if !pdb.Connected() {
+		return nil
+	}
+	sqlDB, err := pdb.Conn().DB()
 	if err != nil {
 		return err
 	}
 	return sqlDB.Close()
 }
+
+// ProdHealthCheck 本番データベース接続確認
+func ProdHealthCheck(db *ProdDatabase) error {
+	if !db.Connected() {
+		return ErrNotConnected
+	}
+	sqlDB, err := db.Conn().DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Ping()
+}
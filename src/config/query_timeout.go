@@ -0,0 +1,66 @@
+package config
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// queryTimeoutKey タイムアウトを設定する前のcontextを保存するcontextのキー
+type queryTimeoutKey struct{}
+
+// queryTimeoutState タイムアウトを設定する前のcontextとキャンセル関数
+type queryTimeoutState struct {
+	parent context.Context
+	cancel context.CancelFunc
+}
+
+// UseQueryTimeout クエリのデフォルトのタイムアウトを設定（0以下の場合は設定しない）
+// リクエストのcontextに期限がない場合のみ適用し、期限がある場合はそちらを優先する。
+// 対象はCreate/Query/Update/Delete/Exec（Rows()で行を逐次読み込むクエリは対象外）
+func UseQueryTimeout(db *gorm.DB, timeout time.Duration) error {
+	if timeout <= 0 {
+		return nil
+	}
+
+	before := func(tx *gorm.DB) {
+		parent := tx.Statement.Context
+		if parent == nil {
+			parent = context.Background()
+		}
+		if _, ok := parent.Deadline(); ok {
+			return
+		}
+		ctx, cancel := context.WithTimeout(parent, timeout)
+		tx.Statement.Context = context.WithValue(ctx, queryTimeoutKey{}, &queryTimeoutState{parent: parent, cancel: cancel})
+	}
+	after := func(tx *gorm.DB) {
+		state, ok := tx.Statement.Context.Value(queryTimeoutKey{}).(*queryTimeoutState)
+		if !ok {
+			return
+		}
+		state.cancel()
+		// 同じ*gorm.DBで続けてクエリを実行できるように元のcontextに戻す
+		tx.Statement.Context = state.parent
+	}
+
+	callbacks := db.Callback()
+	for _, register := range []struct {
+		before, after func(string, func(*gorm.DB)) error
+	}{
+		{callbacks.Create().Before("*").Register, callbacks.Create().After("*").Register},
+		{callbacks.Query().Before("*").Register, callbacks.Query().After("*").Register},
+		{callbacks.Update().Before("*").Register, callbacks.Update().After("*").Register},
+		{callbacks.Delete().Before("*").Register, callbacks.Delete().After("*").Register},
+		{callbacks.Raw().Before("*").Register, callbacks.Raw().After("*").Register},
+	} {
+		if err := register.before("db_service:query_timeout_before", before); err != nil {
+			return err
+		}
+		if err := register.after("db_service:query_timeout_after", after); err != nil {
+			return err
+		}
+	}
+	return nil
+}
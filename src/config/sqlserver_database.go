@@ -1,18 +1,51 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"os"
+	"sync"
+	"time"
 
 	"github.com/joho/godotenv"
 	"gorm.io/driver/sqlserver"
 	"gorm.io/gorm"
 )
 
+// ErrSQLServerNotConfigured SQL Serverの接続情報が環境変数に設定されていない
+var ErrSQLServerNotConfigured = errors.New("SQL Server connection info not provided in environment variables")
+
 // SQLServerDatabase SQL Serverデータベース接続
+// 起動時に接続できなかった場合は再接続で接続が設定されるため、作成後はConn()・SetConn()で参照・設定する
 type SQLServerDatabase struct {
 	DB *gorm.DB
+	mu sync.RWMutex
+}
+
+// Conn 接続を取得
+// 未接続の場合もnilは返さず、すべての操作がErrNotConnectedを返す接続を返す
+func (sdb *SQLServerDatabase) Conn() *gorm.DB {
+	sdb.mu.RLock()
+	defer sdb.mu.RUnlock()
+	if sdb.DB == nil {
+		return notConnectedDB
+	}
+	return sdb.DB
+}
+
+// Connected 接続済みか（起動時に接続できず再接続が完了していない場合はfalse）
+func (sdb *SQLServerDatabase) Connected() bool {
+	sdb.mu.RLock()
+	defer sdb.mu.RUnlock()
+	return sdb.DB != nil
+}
+
+// SetConn 接続を設定（再接続で使用）
+func (sdb *SQLServerDatabase) SetConn(db *gorm.DB) {
+	sdb.mu.Lock()
+	defer sdb.mu.Unlock()
+	sdb.DB = db
 }
 
 // NewSQLServerDatabase SQL Serverデータベース接続を初期化
@@ -29,7 +62,7 @@ func NewSQLServerDatabase() (*SQLServerDatabase, error) {
 	database := os.Getenv("SQLSERVER_DATABASE")
 
 	if host == "" || user == "" || password == "" || database == "" {
-		return nil, fmt.Errorf("SQL Server connection info not provided in environment variables")
+		return nil, ErrSQLServerNotConfigured
 	}
 
 	// SQL Server接続文字列の構築
@@ -57,14 +90,39 @@ func NewSQLServerDatabase() (*SQLServerDatabase, error) {
 		return nil, fmt.Errorf("failed to connect to SQL Server database: %v", err)
 	}
 
+	// クエリのタイムアウト（秒単位、0の場合はタイムアウトなし）
+	// 運転日報明細の期間指定など重いクエリがあるため本番DBより長め
+	queryTimeout := getEnvAsInt("SQLSERVER_QUERY_TIMEOUT", 60)
+	if err := UseQueryTimeout(db, time.Duration(queryTimeout)*time.Second); err != nil {
+		if sqlDB, dbErr := db.DB(); dbErr == nil {
+			sqlDB.Close()
+		}
+		return nil, fmt.Errorf("failed to set query timeout: %v", err)
+	}
+
 	log.Printf("SQL Server database connected: %s", database)
 
 	return &SQLServerDatabase{DB: db}, nil
 }
 
+// Close 接続を閉じる
+func (sdb *SQLServerDatabase) Close() error {
+	if !sdb.Connected() {
+		return nil
+	}
+	sqlDB, err := sdb.Conn().DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Close()
+}
+
 // HealthCheck SQL Serverデータベース接続確認
 func SQLServerHealthCheck(db *SQLServerDatabase) error {
-	sqlDB, err := db.DB.DB()
+	if !db.Connected() {
+		return ErrNotConnected
+	}
+	sqlDB, err := db.Conn().DB()
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,399 @@
+// Package employeeid 社員の識別子の突き合わせ
+//
+// 社員は本番DB・一番星・デジタコ・タイムカードでそれぞれ別のコードで登録されているため、
+// ローカルDBの対応表（employee_identity・employee_card）で1人の社員にまとめる。
+//   - drivers.id は driver_id
+//   - 社員ﾏｽﾀ.社員C・運転日報明細.運転手C は shain_c（一番星では同じコード、前後の空白は除いて比較）
+//   - dtako_rows.乗務員CD1・対象乗務員CD は dtako_driver_code
+//   - timecard_logs.id は time_card_user_id、timecard_logs.card_id は employee_card
+package employeeid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/yhonda-ohishi/db_service/src/models/ichibanboshi"
+	"github.com/yhonda-ohishi/db_service/src/models/mysql"
+	"github.com/yhonda-ohishi/db_service/src/repository"
+	"gorm.io/gorm"
+)
+
+// Source 社員コードの登録元
+type Source int
+
+const (
+	// SourceDrivers 本番DBのdrivers.id
+	SourceDrivers Source = iota + 1
+	// SourceShainMaster 一番星の社員ﾏｽﾀ.社員C
+	SourceShainMaster
+	// SourceDTakoRows デジタコのdtako_rows.乗務員CD1・対象乗務員CD
+	SourceDTakoRows
+	// SourceUntenNippoMeisai 一番星の運転日報明細.運転手C
+	SourceUntenNippoMeisai
+	// SourceTimeCardUser タイムカードのtimecard_logs.id
+	SourceTimeCardUser
+	// SourceTimeCardCard タイムカードのtimecard_logs.card_id
+	SourceTimeCardCard
+)
+
+// String 登録元の名前
+func (s Source) String() string {
+	switch s {
+	case SourceDrivers:
+		return "drivers"
+	case SourceShainMaster:
+		return "shain_master"
+	case SourceDTakoRows:
+		return "dtako_rows"
+	case SourceUntenNippoMeisai:
+		return "unten_nippo_meisai"
+	case SourceTimeCardUser:
+		return "time_card_user"
+	case SourceTimeCardCard:
+		return "time_card_card"
+	}
+	return fmt.Sprintf("Source(%d)", int(s))
+}
+
+// Key 検索する社員コード（Sourceのいずれか）
+type Key struct {
+	Source Source
+	Value  string
+}
+
+// ErrInvalidKey 社員コードの種類・値が不正
+var ErrInvalidKey = errors.New("invalid employee key")
+
+// Person 1人の社員の識別子
+type Person struct {
+	Employee *mysql.EmployeeIdentity
+	Cards    []*mysql.EmployeeCard
+	// Driver 本番DBの乗務員（本番DBが未設定・使用できない場合、driversにない場合はnil）
+	Driver *mysql.Drivers
+	// Shain 一番星の社員ﾏｽﾀ（SQL Serverが未設定・使用できない場合、社員ﾏｽﾀにない場合はnil）
+	Shain *ichibanboshi.ShainMaster
+}
+
+// Resolver 社員の識別子の突き合わせ
+type Resolver struct {
+	employees    repository.EmployeeIdentityRepository
+	timeCardLogs repository.TimeCardLogRepository
+	drivers      repository.DriversRepository
+	dtakoRows    repository.DTakoRowsRepository
+	shain        repository.ShainMasterRepository
+	untenNippo   repository.UntenNippoMeisaiRepository
+	// prodAvailable・sqlServerAvailable 本番DB・SQL Serverが使用可能か（nilの場合は常に使用可能とする）
+	prodAvailable      func() bool
+	sqlServerAvailable func() bool
+}
+
+// Option Resolverのオプション
+type Option func(*Resolver)
+
+// WithAvailability 本番DB・SQL Serverが使用可能かを確認する関数を指定するオプション
+// 使用できない間の呼び出しでは、そのバックエンドの登録元を参照しない
+func WithAvailability(prod, sqlServer func() bool) Option {
+	return func(r *Resolver) {
+		r.prodAvailable = prod
+		r.sqlServerAvailable = sqlServer
+	}
+}
+
+// New Resolverのコンストラクタ
+// 本番DB（drivers, dtakoRows）・SQL Server（shain, untenNippo）のリポジトリがnilの場合はその登録元を参照しない
+func New(employees repository.EmployeeIdentityRepository, timeCardLogs repository.TimeCardLogRepository,
+	drivers repository.DriversRepository, dtakoRows repository.DTakoRowsRepository,
+	shain repository.ShainMasterRepository, untenNippo repository.UntenNippoMeisaiRepository, opts ...Option) *Resolver {
+	r := &Resolver{
+		employees:    employees,
+		timeCardLogs: timeCardLogs,
+		drivers:      drivers,
+		dtakoRows:    dtakoRows,
+		shain:        shain,
+		untenNippo:   untenNippo,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// SkippedSource 本番DB・SQL Serverが使用できないため突き合わせなかった登録元
+type SkippedSource struct {
+	Source Source
+	Reason string
+}
+
+// sources 呼び出し時点で参照する本番DB・SQL Serverのリポジトリ（未設定・使用できない場合はnil）
+type sources struct {
+	drivers    repository.DriversRepository
+	dtakoRows  repository.DTakoRowsRepository
+	shain      repository.ShainMasterRepository
+	untenNippo repository.UntenNippoMeisaiRepository
+	// skipped 設定されているが使用できないため参照しない登録元
+	skipped []*SkippedSource
+}
+
+// current 呼び出し時点で参照する登録元
+func (r *Resolver) current() *sources {
+	s := &sources{drivers: r.drivers, dtakoRows: r.dtakoRows, shain: r.shain, untenNippo: r.untenNippo}
+	prod := r.prodAvailable == nil || r.prodAvailable()
+	sqlServer := r.sqlServerAvailable == nil || r.sqlServerAvailable()
+	skip := func(source Source, configured bool, reason string) {
+		if configured {
+			s.skipped = append(s.skipped, &SkippedSource{Source: source, Reason: reason})
+		}
+	}
+	if !prod {
+		skip(SourceDrivers, s.drivers != nil, "production database is unavailable")
+		s.drivers = nil
+	}
+	if !sqlServer {
+		skip(SourceShainMaster, s.shain != nil, "SQL Server is unavailable")
+		s.shain = nil
+	}
+	if !prod {
+		skip(SourceDTakoRows, s.dtakoRows != nil, "production database is unavailable")
+		s.dtakoRows = nil
+	}
+	if !sqlServer {
+		skip(SourceUntenNippoMeisai, s.untenNippo != nil, "SQL Server is unavailable")
+		s.untenNippo = nil
+	}
+	return s
+}
+
+// list 突き合わせる登録元
+func (s *sources) list() []Source {
+	var list []Source
+	if s.drivers != nil {
+		list = append(list, SourceDrivers)
+	}
+	if s.shain != nil {
+		list = append(list, SourceShainMaster)
+	}
+	if s.dtakoRows != nil {
+		list = append(list, SourceDTakoRows)
+	}
+	if s.untenNippo != nil {
+		list = append(list, SourceUntenNippoMeisai)
+	}
+	return append(list, SourceTimeCardUser, SourceTimeCardCard)
+}
+
+// skip 取得に失敗した登録元を突き合わせなかった登録元とする
+func (s *sources) skip(source Source, err error) {
+	log.Printf("Warning: skipped employee code source %s: %v", source, err)
+	s.skipped = append(s.skipped, &SkippedSource{Source: source, Reason: err.Error()})
+}
+
+// Sources 突き合わせる登録元（本番DB・SQL Serverが未設定・使用できない場合はその登録元を含まない）
+func (r *Resolver) Sources() []Source {
+	return r.current().list()
+}
+
+// Resolve 社員コードのいずれか1つから社員を取得（対応表にない場合はgorm.ErrRecordNotFound）
+// 乗務員・社員ﾏｽﾀは参考情報のため、取得に失敗した場合もエラーにしない
+func (r *Resolver) Resolve(ctx context.Context, key Key) (*Person, error) {
+	value := strings.TrimSpace(key.Value)
+	if value == "" {
+		return nil, fmt.Errorf("%w: empty value", ErrInvalidKey)
+	}
+
+	var employee *mysql.EmployeeIdentity
+	var err error
+	switch key.Source {
+	case SourceShainMaster, SourceUntenNippoMeisai:
+		employee, err = r.employees.GetByShainC(ctx, value)
+	case SourceTimeCardCard:
+		employee, err = r.employees.GetByCardID(ctx, value)
+	case SourceDrivers, SourceDTakoRows, SourceTimeCardUser:
+		code, convErr := strconv.Atoi(value)
+		if convErr != nil {
+			return nil, fmt.Errorf("%w: %s %q", ErrInvalidKey, key.Source, key.Value)
+		}
+		switch key.Source {
+		case SourceDrivers:
+			employee, err = r.employees.GetByDriverID(ctx, code)
+		case SourceDTakoRows:
+			employee, err = r.employees.GetByDTakoDriverCode(ctx, code)
+		default:
+			employee, err = r.employees.GetByTimeCardUserID(ctx, code)
+		}
+	default:
+		return nil, fmt.Errorf("%w: source %d", ErrInvalidKey, key.Source)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return r.person(ctx, employee)
+}
+
+// person 社員のカード・乗務員・社員ﾏｽﾀ
+func (r *Resolver) person(ctx context.Context, employee *mysql.EmployeeIdentity) (*Person, error) {
+	cards, err := r.employees.GetCards(ctx, employee.ID)
+	if err != nil {
+		return nil, err
+	}
+	person := &Person{Employee: employee, Cards: cards}
+
+	s := r.current()
+	if s.drivers != nil && employee.DriverID != nil {
+		driver, err := s.drivers.GetByID(ctx, *employee.DriverID)
+		switch {
+		case err == nil:
+			person.Driver = driver
+		case !errors.Is(err, gorm.ErrRecordNotFound):
+			log.Printf("Warning: failed to get driver %d: %v", *employee.DriverID, err)
+		}
+	}
+	if s.shain != nil && employee.ShainC != nil {
+		shain, err := s.shain.GetByShainC(ctx, *employee.ShainC)
+		switch {
+		case err == nil:
+			person.Shain = shain
+		case !errors.Is(err, gorm.ErrRecordNotFound):
+			log.Printf("Warning: failed to get shain %s: %v", *employee.ShainC, err)
+		}
+	}
+	return person, nil
+}
+
+// UnlinkedCode 対応表に登録されていない社員コード
+type UnlinkedCode struct {
+	Source Source
+	Code   string
+	// Name 氏名（drivers.name・社員ﾏｽﾀ.社員N、ない場合はnil）
+	Name *string
+}
+
+// Report 対応表に登録されていない社員コードの報告
+type Report struct {
+	// Sources 突き合わせた登録元
+	Sources []Source
+	// Codes 登録されていない社員コード（登録元・コード順）
+	Codes []*UnlinkedCode
+	// Skipped 本番DB・SQL Serverが使用できない・取得に失敗したため突き合わせなかった登録元
+	Skipped []*SkippedSource
+}
+
+// Unlinked 全登録元の社員コードを対応表と突き合わせ、登録されていないコードを報告する
+// 本番DB・SQL Serverの登録元は使用できない場合・取得に失敗した場合も報告全体は失敗させず、Skippedに含める
+func (r *Resolver) Unlinked(ctx context.Context) (*Report, error) {
+	employees, err := repository.AllPages(ctx, r.employees.GetPage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list employees: %w", err)
+	}
+	cards, err := r.employees.GetAllCards(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list employee cards: %w", err)
+	}
+
+	// 対応表に登録されている社員コード
+	linked := make(map[Source]map[string]bool)
+	link := func(source Source, code string) {
+		if linked[source] == nil {
+			linked[source] = make(map[string]bool)
+		}
+		linked[source][code] = true
+	}
+	for _, e := range employees {
+		if e.DriverID != nil {
+			link(SourceDrivers, strconv.Itoa(*e.DriverID))
+		}
+		if e.ShainC != nil {
+			link(SourceShainMaster, *e.ShainC)
+			link(SourceUntenNippoMeisai, *e.ShainC)
+		}
+		if e.DTakoDriverCode != nil {
+			link(SourceDTakoRows, strconv.Itoa(*e.DTakoDriverCode))
+		}
+		if e.TimeCardUserID != nil {
+			link(SourceTimeCardUser, strconv.Itoa(*e.TimeCardUserID))
+		}
+	}
+	for _, c := range cards {
+		link(SourceTimeCardCard, c.CardID)
+	}
+
+	s := r.current()
+	var codes []*UnlinkedCode
+	add := func(source Source, code string, name *string) {
+		if !linked[source][code] {
+			codes = append(codes, &UnlinkedCode{Source: source, Code: code, Name: name})
+		}
+	}
+
+	if s.drivers != nil {
+		drivers, err := repository.AllPages(ctx, s.drivers.GetPage)
+		switch {
+		case ctx.Err() != nil:
+			return nil, ctx.Err()
+		case err != nil:
+			s.skip(SourceDrivers, fmt.Errorf("failed to list drivers: %w", err))
+			s.drivers = nil
+		}
+		for _, d := range drivers {
+			add(SourceDrivers, strconv.Itoa(d.ID), d.Name)
+		}
+	}
+	if s.shain != nil {
+		shain, err := repository.AllPages(ctx, s.shain.GetPage)
+		switch {
+		case ctx.Err() != nil:
+			return nil, ctx.Err()
+		case err != nil:
+			s.skip(SourceShainMaster, fmt.Errorf("failed to list 社員ﾏｽﾀ: %w", err))
+			s.shain = nil
+		}
+		for _, m := range shain {
+			add(SourceShainMaster, strings.TrimSpace(m.ShainC), m.ShainN)
+		}
+	}
+	if s.dtakoRows != nil {
+		driverCodes, err := s.dtakoRows.GetDriverCodes(ctx)
+		switch {
+		case ctx.Err() != nil:
+			return nil, ctx.Err()
+		case err != nil:
+			s.skip(SourceDTakoRows, fmt.Errorf("failed to list dtako_rows driver codes: %w", err))
+			s.dtakoRows = nil
+		}
+		for _, code := range driverCodes {
+			add(SourceDTakoRows, strconv.Itoa(code), nil)
+		}
+	}
+	if s.untenNippo != nil {
+		untenshuCodes, err := s.untenNippo.GetUntenshuCodes(ctx)
+		switch {
+		case ctx.Err() != nil:
+			return nil, ctx.Err()
+		case err != nil:
+			s.skip(SourceUntenNippoMeisai, fmt.Errorf("failed to list 運転日報明細 運転手C: %w", err))
+			s.untenNippo = nil
+		}
+		for _, code := range untenshuCodes {
+			add(SourceUntenNippoMeisai, code, nil)
+		}
+	}
+
+	userIDs, err := r.timeCardLogs.GetUserIDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list timecard_logs ids: %w", err)
+	}
+	for _, id := range userIDs {
+		add(SourceTimeCardUser, strconv.Itoa(id), nil)
+	}
+	cardIDs, err := r.timeCardLogs.GetCardIDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list timecard_logs card_ids: %w", err)
+	}
+	for _, cardID := range cardIDs {
+		add(SourceTimeCardCard, cardID, nil)
+	}
+	return &Report{Sources: s.list(), Codes: codes, Skipped: s.skipped}, nil
+}
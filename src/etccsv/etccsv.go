@@ -0,0 +1,353 @@
+// Package etccsv ETC利用照会サービスからダウンロードしたETC明細CSVの読み込み
+//
+// 文字コードはShift_JIS（ダウンロードしたファイルのまま）とUTF-8に対応する。
+// 列はヘッダー行の列名で判定し、ヘッダー行がない場合（1行目の3列目が利用年月日の場合）は標準の列順（Header）とみなす。
+package etccsv
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/yhonda-ohishi/db_service/src/models/mysql"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Header 標準のETC明細CSVの列
+var Header = []string{
+	"利用年月日（自）", "時刻（自）", "利用年月日（至）", "時刻（至）",
+	"利用ＩＣ（自）", "利用ＩＣ（至）",
+	"割引前料金", "ＥＴＣ割引額", "通行料金",
+	"車種", "車両番号", "ＥＴＣカード番号", "備考",
+}
+
+// column 列の種類
+type column int
+
+const (
+	colDateFr column = iota
+	colTimeFr
+	colDateTo
+	colTimeTo
+	colIcFr
+	colIcTo
+	colPriceBf
+	colDescount
+	colPrice
+	colShashu
+	colCarNum
+	colEtcNum
+	colDetail
+	numColumns
+)
+
+// headerAliases 列名（正規化後）と列の対応
+// 標準の列名に加えて、過去の形式・手作業で編集したCSVの列名も受け付ける
+var headerAliases = map[string]column{
+	"利用年月日(自)": colDateFr, "利用日(自)": colDateFr, "入口利用年月日": colDateFr,
+	"時刻(自)": colTimeFr, "入口時刻": colTimeFr,
+	"利用年月日(至)": colDateTo, "利用日(至)": colDateTo, "出口利用年月日": colDateTo,
+	"時刻(至)": colTimeTo, "出口時刻": colTimeTo,
+	"利用IC(自)": colIcFr, "入口IC": colIcFr,
+	"利用IC(至)": colIcTo, "出口IC": colIcTo,
+	"割引前料金":  colPriceBf,
+	"ETC割引額": colDescount, "割引額": colDescount,
+	"通行料金": colPrice, "料金": colPrice,
+	"車種":   colShashu,
+	"車両番号": colCarNum, "車輌番号": colCarNum,
+	"ETCカード番号": colEtcNum, "カード番号": colEtcNum,
+	"備考": colDetail,
+}
+
+// requiredColumns ヘッダー行に必須の列
+var requiredColumns = []column{colDateTo, colTimeTo, colIcTo, colPrice, colShashu, colEtcNum}
+
+// jst ETC明細の日時のタイムゾーン
+var jst = time.FixedZone("JST", 9*60*60)
+
+// ErrNoHeader ヘッダー行に必須の列がない
+var ErrNoHeader = errors.New("etccsv: required column not found in header")
+
+// Record 読み込んだETC明細
+type Record struct {
+	// Line CSVの行番号（1始まり）
+	Line      int
+	ETCMeisai *mysql.ETCMeisai
+}
+
+// LineError 読み込めなかった行
+type LineError struct {
+	// Line CSVの行番号（1始まり）
+	Line int
+	Err  error
+}
+
+// Error エラーメッセージ
+func (e *LineError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+// Unwrap 元のエラー
+func (e *LineError) Unwrap() error {
+	return e.Err
+}
+
+// Result CSVの読み込み結果（RecordsとErrorsはそれぞれ行番号順）
+type Result struct {
+	Records []*Record
+	Errors  []*LineError
+}
+
+// Parse ETC明細CSVを読み込む
+// 行ごとのエラーはResult.Errorsに含め、ファイル全体が読み込めない場合のみエラーを返す
+// 読み込んだ明細にはSetHashでhashを設定する
+func Parse(data []byte) (*Result, error) {
+	text, err := decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	reader := csv.NewReader(strings.NewReader(text))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	reader.TrimLeadingSpace = true
+
+	result := &Result{}
+	var columns []int
+	for {
+		fields, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				result.Errors = append(result.Errors, &LineError{Line: parseErr.StartLine, Err: parseErr.Err})
+				continue
+			}
+			return nil, fmt.Errorf("etccsv: failed to read csv: %w", err)
+		}
+		if isBlank(fields) {
+			continue
+		}
+		line, _ := reader.FieldPos(0)
+
+		// 最初の行でヘッダーの有無を判定
+		if columns == nil {
+			if columns = headerColumns(fields); columns != nil {
+				continue
+			}
+			if len(fields) <= int(colDateTo) {
+				return nil, ErrNoHeader
+			}
+			if _, err := parseDate(fields[colDateTo]); err != nil {
+				return nil, ErrNoHeader
+			}
+			columns = standardColumns()
+		}
+
+		meisai, err := parseRecord(fields, columns)
+		if err != nil {
+			result.Errors = append(result.Errors, &LineError{Line: line, Err: err})
+			continue
+		}
+		result.Records = append(result.Records, &Record{Line: line, ETCMeisai: meisai})
+	}
+	return result, nil
+}
+
+// decode UTF-8として正しい場合はそのまま（BOMは除去）、それ以外はShift_JISとしてデコード
+func decode(data []byte) (string, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	if utf8.Valid(data) {
+		return string(data), nil
+	}
+	decoded, err := japanese.ShiftJIS.NewDecoder().Bytes(data)
+	if err != nil {
+		return "", fmt.Errorf("etccsv: failed to decode Shift_JIS: %w", err)
+	}
+	return string(decoded), nil
+}
+
+// normalize 全角英数字・括弧を半角にして空白を除去（列名・値の比較用）
+func normalize(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, norm.NFKC.String(s))
+}
+
+// headerColumns ヘッダー行の場合は各列の位置を返す（ヘッダー行でない場合はnil）
+func headerColumns(fields []string) []int {
+	columns := make([]int, numColumns)
+	for i := range columns {
+		columns[i] = -1
+	}
+	for i, field := range fields {
+		if c, ok := headerAliases[normalize(field)]; ok && columns[c] < 0 {
+			columns[c] = i
+		}
+	}
+	for _, c := range requiredColumns {
+		if columns[c] < 0 {
+			return nil
+		}
+	}
+	return columns
+}
+
+// standardColumns 標準の列順の各列の位置
+func standardColumns() []int {
+	columns := make([]int, numColumns)
+	for i := range columns {
+		columns[i] = i
+	}
+	return columns
+}
+
+// isBlank 空行か（すべての列が空）
+func isBlank(fields []string) bool {
+	for _, field := range fields {
+		if strings.TrimSpace(field) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// parseRecord 1行をETC明細に変換
+func parseRecord(fields []string, columns []int) (*mysql.ETCMeisai, error) {
+	value := func(c column) string {
+		if i := columns[c]; i >= 0 && i < len(fields) {
+			return strings.TrimSpace(fields[i])
+		}
+		return ""
+	}
+
+	dateTo, err := parseDateTime(value(colDateTo), value(colTimeTo))
+	if err != nil {
+		return nil, fmt.Errorf("invalid 利用年月日（至）: %w", err)
+	}
+	m := &mysql.ETCMeisai{
+		DateTo:     dateTo,
+		DateToDate: time.Date(dateTo.Year(), dateTo.Month(), dateTo.Day(), 0, 0, 0, 0, jst),
+		IcFr:       value(colIcFr),
+		IcTo:       value(colIcTo),
+		EtcNum:     value(colEtcNum),
+	}
+
+	if v := value(colDateFr); v != "" {
+		dateFr, err := parseDateTime(v, value(colTimeFr))
+		if err != nil {
+			return nil, fmt.Errorf("invalid 利用年月日（自）: %w", err)
+		}
+		m.DateFr = &dateFr
+	}
+
+	if m.PriceBf, err = parseOptionalInt(value(colPriceBf)); err != nil {
+		return nil, fmt.Errorf("invalid 割引前料金: %w", err)
+	}
+	if m.Descount, err = parseOptionalInt(value(colDescount)); err != nil {
+		return nil, fmt.Errorf("invalid ETC割引額: %w", err)
+	}
+	if m.Price, err = parseInt(value(colPrice)); err != nil {
+		return nil, fmt.Errorf("invalid 通行料金: %w", err)
+	}
+	if m.Shashu, err = parseInt(value(colShashu)); err != nil {
+		return nil, fmt.Errorf("invalid 車種: %w", err)
+	}
+	m.CarIDNum = parseCarNumber(value(colCarNum))
+	if v := value(colDetail); v != "" {
+		m.Detail = &v
+	}
+
+	m.SetHash()
+	return m, nil
+}
+
+// dateLayouts 利用年月日の形式（ETC利用照会サービスは年2桁）
+var dateLayouts = []string{"06/01/02", "2006/01/02", "2006/1/2", "2006-01-02", "20060102"}
+
+// timeLayouts 時刻の形式
+var timeLayouts = []string{"15:04", "15:04:05", "1504"}
+
+// parseDate 利用年月日を解釈（JST）
+func parseDate(value string) (time.Time, error) {
+	value = normalize(value)
+	for _, layout := range dateLayouts {
+		if t, err := time.ParseInLocation(layout, value, jst); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unknown date format %q", value)
+}
+
+// parseDateTime 利用年月日と時刻を解釈（JST、時刻が空の場合は0時）
+func parseDateTime(date, clock string) (time.Time, error) {
+	d, err := parseDate(date)
+	if err != nil {
+		return time.Time{}, err
+	}
+	clock = normalize(clock)
+	if clock == "" {
+		return d, nil
+	}
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, clock); err == nil {
+			return time.Date(d.Year(), d.Month(), d.Day(), t.Hour(), t.Minute(), t.Second(), 0, jst), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unknown time format %q", clock)
+}
+
+// parseInt 金額・車種を解釈（桁区切りのカンマ・円記号は無視）
+func parseInt(value string) (int32, error) {
+	value = strings.NewReplacer(",", "", "¥", "", "\\", "", "円", "").Replace(normalize(value))
+	n, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("not a number %q", value)
+	}
+	return int32(n), nil
+}
+
+// parseOptionalInt 空の場合はnil
+func parseOptionalInt(value string) (*int32, error) {
+	if value == "" {
+		return nil, nil
+	}
+	n, err := parseInt(value)
+	if err != nil {
+		return nil, err
+	}
+	return &n, nil
+}
+
+// parseCarNumber 車両番号の末尾の一連指定番号（例: "品川 500 あ 12-34" → 1234）
+// 数字がない場合はnil
+func parseCarNumber(value string) *int32 {
+	value = strings.ReplaceAll(normalize(value), "-", "")
+	end := len(value)
+	start := end
+	for start > 0 && value[start-1] >= '0' && value[start-1] <= '9' {
+		start--
+	}
+	if start == end {
+		return nil
+	}
+	n, err := strconv.ParseInt(value[max(start, end-4):end], 10, 32)
+	if err != nil {
+		return nil
+	}
+	num := int32(n)
+	return &num
+}
@@ -0,0 +1,89 @@
+package etccsv
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/text/encoding/japanese"
+)
+
+// shiftJIS UTF-8の文字列をShift_JISに変換
+func shiftJIS(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := japanese.ShiftJIS.NewEncoder().Bytes([]byte(s))
+	if err != nil {
+		t.Fatalf("failed to encode Shift_JIS: %v", err)
+	}
+	return b
+}
+
+func TestParse(t *testing.T) {
+	csv := strings.Join([]string{
+		strings.Join(Header, ","),
+		`25/09/19,09:30,25/09/19,10:05,東京,横浜青葉,"1,500",300,"1,200",1,品川 100 あ 12-34,1234567890123456,`,
+		`,,25/09/20,18:00,,名古屋,,,2000,2,,1234567890123456,入口不明`,
+		`25/09/21,09:00,２５/０９/xx,10:00,東京,横浜,1000,0,1000,1,,1234567890123456,`,
+		``,
+	}, "\r\n")
+
+	result, err := Parse(shiftJIS(t, csv))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if len(result.Records) != 2 || len(result.Errors) != 1 {
+		t.Fatalf("unexpected result: %d records, %d errors", len(result.Records), len(result.Errors))
+	}
+
+	jst := time.FixedZone("JST", 9*60*60)
+	first := result.Records[0]
+	if first.Line != 2 {
+		t.Errorf("unexpected line: %d", first.Line)
+	}
+	m := first.ETCMeisai
+	if !m.DateTo.Equal(time.Date(2025, 9, 19, 10, 5, 0, 0, jst)) || m.DateFr == nil || !m.DateFr.Equal(time.Date(2025, 9, 19, 9, 30, 0, 0, jst)) {
+		t.Errorf("unexpected dates: %v %v", m.DateFr, m.DateTo)
+	}
+	if m.DateToDate.Format("2006-01-02") != "2025-09-19" {
+		t.Errorf("unexpected date_to_date: %v", m.DateToDate)
+	}
+	if m.IcFr != "東京" || m.IcTo != "横浜青葉" || m.Price != 1200 || *m.PriceBf != 1500 || *m.Descount != 300 || m.Shashu != 1 {
+		t.Errorf("unexpected record: %+v", m)
+	}
+	if m.CarIDNum == nil || *m.CarIDNum != 1234 || m.Detail != nil {
+		t.Errorf("unexpected car number / detail: %v %v", m.CarIDNum, m.Detail)
+	}
+	if m.Hash == "" || m.Hash != m.GenerateHash() {
+		t.Errorf("hash is not set: %q", m.Hash)
+	}
+	if err := m.Validate(); err != nil {
+		t.Errorf("parsed record should be valid: %v", err)
+	}
+
+	// 入口不明の明細
+	second := result.Records[1].ETCMeisai
+	if second.DateFr != nil || second.IcFr != "" || second.PriceBf != nil || second.Detail == nil || *second.Detail != "入口不明" {
+		t.Errorf("unexpected record: %+v", second)
+	}
+
+	if result.Errors[0].Line != 4 {
+		t.Errorf("unexpected error line: %v", result.Errors[0])
+	}
+}
+
+func TestParse_Header(t *testing.T) {
+	// ヘッダーなし（標準の列順）・UTF-8
+	result, err := Parse([]byte("25/09/19,09:30,25/09/19,10:05,東京,横浜,1000,0,1000,1,1234,1234567890123456,\n"))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if len(result.Records) != 1 || result.Records[0].Line != 1 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+
+	// 必須の列がないCSVは読み込まない
+	if _, err := Parse([]byte("日付,金額\n2025/09/19,1000\n")); !errors.Is(err, ErrNoHeader) {
+		t.Errorf("expected ErrNoHeader, got %v", err)
+	}
+}
@@ -0,0 +1,301 @@
+// Package etcmatch ETC明細と運行データ（dtako_rows）の自動照合
+//
+// ETC明細のETCカード番号をetc_num（利用日時に有効なカード）で車輌に変換し、
+// その車輌の運行データのうち出庫日時〜帰庫日時に利用日時が含まれるものを候補とする。
+// 候補が1件に決まり信頼度がしきい値以上の場合はcreated_by="auto-matcher"でマッピングを作成する。
+package etcmatch
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/yhonda-ohishi/db_service/src/models/mysql"
+	"github.com/yhonda-ohishi/db_service/src/repository"
+)
+
+// CreatedBy 自動照合で作成したマッピングのcreated_by
+const CreatedBy = "auto-matcher"
+
+// DefaultMinConfidence マッピングを作成する信頼度のしきい値の既定値
+const DefaultMinConfidence = 0.8
+
+// 信頼度
+const (
+	// confidenceBoth 入口・出口の利用日時がどちらも運行中
+	confidenceBoth = 1.0
+	// confidenceExitOnly 入口の利用日時がない明細（入口不明）で、出口の利用日時が運行中
+	confidenceExitOnly = 0.9
+	// confidencePartial 入口・出口の利用日時の一方のみが運行中
+	confidencePartial = 0.5
+)
+
+// streamBatchSize ETC明細を読み込む件数
+const streamBatchSize = 500
+
+// Status 照合結果の種類
+type Status int
+
+const (
+	// Matched 候補が1件に決まった
+	Matched Status = iota + 1
+	// Ambiguous 信頼度が最も高い候補が複数ある、または信頼度がしきい値未満（手作業での確認が必要）
+	Ambiguous
+	// Unmatched 候補がない
+	Unmatched
+	// AlreadyMapped マッピングが登録済み
+	AlreadyMapped
+)
+
+// String 照合結果の種類の名前
+func (s Status) String() string {
+	switch s {
+	case Matched:
+		return "matched"
+	case Ambiguous:
+		return "ambiguous"
+	case Unmatched:
+		return "unmatched"
+	case AlreadyMapped:
+		return "already_mapped"
+	}
+	return fmt.Sprintf("Status(%d)", int(s))
+}
+
+// Candidate 照合候補の運行データ
+type Candidate struct {
+	DTakoRowID string
+	CarID      string
+	Confidence float64
+}
+
+// Result ETC明細1件の照合結果
+type Result struct {
+	ETCMeisai *mysql.ETCMeisai
+	Status    Status
+	// Candidates 照合候補（信頼度の高い順、AlreadyMappedの場合は登録済みの運行データ）
+	Candidates []Candidate
+	// Reason Matched以外の理由
+	Reason string
+	// MappingID 作成したマッピングのID（作成しなかった場合は0）
+	MappingID int64
+}
+
+// Options 照合の条件
+type Options struct {
+	// Start, End 照合するETC明細のdate_toの範囲
+	Start, End time.Time
+	// MinConfidence マッピングを作成する信頼度のしきい値（0の場合はDefaultMinConfidence）
+	MinConfidence float64
+	// DryRun trueの場合はマッピングを作成せず照合結果のみ返す
+	DryRun bool
+}
+
+// Matcher ETC明細と運行データの自動照合
+type Matcher struct {
+	etcMeisai repository.ETCMeisaiRepository
+	mappings  repository.ETCMeisaiMappingRepository
+	etcNums   repository.ETCNumRepository
+	dtakoRows repository.DTakoRowsRepository
+}
+
+// New Matcherのコンストラクタ
+func New(etcMeisai repository.ETCMeisaiRepository, mappings repository.ETCMeisaiMappingRepository,
+	etcNums repository.ETCNumRepository, dtakoRows repository.DTakoRowsRepository) *Matcher {
+	return &Matcher{
+		etcMeisai: etcMeisai,
+		mappings:  mappings,
+		etcNums:   etcNums,
+		dtakoRows: dtakoRows,
+	}
+}
+
+// run 1回の照合中に読み込んだetc_num・運行データ
+type run struct {
+	*Matcher
+	opts Options
+	// cards ETCカード番号ごとのetc_num
+	cards map[string][]*mysql.ETCNum
+	// carRows 車輌IDごとの運行データ
+	carRows map[string]*carRows
+}
+
+// carRows 車輌の運行データのうち、from〜照合期間の終了と重なるもの
+type carRows struct {
+	from time.Time
+	rows []*mysql.DTakoRows
+}
+
+// Match 期間内のETC明細を照合する（結果はdate_toの古い順）
+func (m *Matcher) Match(ctx context.Context, opts Options) ([]*Result, error) {
+	if opts.MinConfidence <= 0 {
+		opts.MinConfidence = DefaultMinConfidence
+	}
+	r := &run{
+		Matcher: m,
+		opts:    opts,
+		cards:   make(map[string][]*mysql.ETCNum),
+		carRows: make(map[string]*carRows),
+	}
+
+	var results []*Result
+	params := &repository.ETCMeisaiListParams{StartDate: &opts.Start, EndDate: &opts.End}
+	err := m.etcMeisai.Stream(ctx, params, streamBatchSize, func(batch []*mysql.ETCMeisai) error {
+		for _, meisai := range batch {
+			result, err := r.match(ctx, meisai)
+			if err != nil {
+				return err
+			}
+			results = append(results, result)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// match ETC明細1件を照合し、候補が1件に決まった場合はマッピングを作成する
+func (r *run) match(ctx context.Context, meisai *mysql.ETCMeisai) (*Result, error) {
+	result := &Result{ETCMeisai: meisai}
+
+	mapped, err := r.mappings.GetDTakoRowIDsByHash(ctx, meisai.Hash)
+	if err != nil {
+		return nil, err
+	}
+	if len(mapped) > 0 {
+		result.Status = AlreadyMapped
+		for _, id := range mapped {
+			result.Candidates = append(result.Candidates, Candidate{DTakoRowID: id})
+		}
+		result.Reason = "mapping already exists"
+		return result, nil
+	}
+
+	carIDs, err := r.carIDs(ctx, meisai)
+	if err != nil {
+		return nil, err
+	}
+	if len(carIDs) == 0 {
+		result.Status = Unmatched
+		result.Reason = fmt.Sprintf("no car is registered for etc_num %q at %s", meisai.EtcNum, meisai.DateTo.Format(time.RFC3339))
+		return result, nil
+	}
+
+	for _, carID := range carIDs {
+		rows, err := r.rows(ctx, carID, meisai)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			if confidence := Score(meisai, row); confidence > 0 {
+				result.Candidates = append(result.Candidates, Candidate{DTakoRowID: row.ID, CarID: carID, Confidence: confidence})
+			}
+		}
+	}
+	sort.SliceStable(result.Candidates, func(i, j int) bool {
+		return result.Candidates[i].Confidence > result.Candidates[j].Confidence
+	})
+
+	switch {
+	case len(result.Candidates) == 0:
+		result.Status = Unmatched
+		result.Reason = fmt.Sprintf("no dtako_rows of car %v contains the usage time", carIDs)
+		return result, nil
+	case len(result.Candidates) > 1 && result.Candidates[1].Confidence == result.Candidates[0].Confidence:
+		result.Status = Ambiguous
+		result.Reason = "multiple dtako_rows have the same confidence"
+		return result, nil
+	case result.Candidates[0].Confidence < r.opts.MinConfidence:
+		result.Status = Ambiguous
+		result.Reason = fmt.Sprintf("confidence %.2f is below %.2f", result.Candidates[0].Confidence, r.opts.MinConfidence)
+		return result, nil
+	}
+
+	result.Status = Matched
+	if r.opts.DryRun {
+		return result, nil
+	}
+	best := result.Candidates[0]
+	notes := fmt.Sprintf("confidence=%.2f", best.Confidence)
+	mapping := &mysql.ETCMeisaiMapping{
+		ETCMeisaiHash: meisai.Hash,
+		DTakoRowID:    best.DTakoRowID,
+		CreatedBy:     CreatedBy,
+		Notes:         &notes,
+	}
+	mapping.BeforeCreate()
+	// 同時に実行された照合・再実行で作成済みの場合は登録済みのマッピングを使用する
+	if _, err := r.mappings.Upsert(ctx, mapping); err != nil {
+		return nil, err
+	}
+	result.MappingID = mapping.ID
+	return result, nil
+}
+
+// carIDs ETC明細の利用日時に有効なETCカードの車輌ID
+func (r *run) carIDs(ctx context.Context, meisai *mysql.ETCMeisai) ([]string, error) {
+	etcNums, ok := r.cards[meisai.EtcNum]
+	if !ok {
+		var err error
+		if etcNums, err = r.etcNums.GetByETCCardNum(ctx, meisai.EtcNum); err != nil {
+			return nil, err
+		}
+		r.cards[meisai.EtcNum] = etcNums
+	}
+
+	var carIDs []string
+	for _, etcNum := range etcNums {
+		if etcNum.ValidAt(meisai.DateTo) {
+			carIDs = append(carIDs, etcNum.CarID)
+		}
+	}
+	return carIDs, nil
+}
+
+// rows ETC明細の利用日時を含みうる車輌の運行データ
+//
+// 照合期間の開始（入口の利用日時がより前の場合は入口の利用日時）〜照合期間の終了と
+// 重なる運行データ（帰庫日時 >= 開始 かつ 出庫日時 <= 終了）を取得する。
+// 出庫が照合期間より何日前でも、期間中に運行していれば対象になる。
+// 取得済みの範囲より前の入口の利用日時の明細があった場合は取得し直す。
+func (r *run) rows(ctx context.Context, carID string, meisai *mysql.ETCMeisai) ([]*mysql.DTakoRows, error) {
+	from := r.opts.Start
+	if meisai.DateFr != nil && meisai.DateFr.Before(from) {
+		from = *meisai.DateFr
+	}
+	if cached, ok := r.carRows[carID]; ok && !cached.from.After(from) {
+		return cached.rows, nil
+	}
+	rows, err := r.dtakoRows.GetByCarCC(ctx, carID, from, r.opts.End)
+	if err != nil {
+		return nil, err
+	}
+	r.carRows[carID] = &carRows{from: from, rows: rows}
+	return rows, nil
+}
+
+// Score ETC明細と運行データの照合の信頼度（0の場合は候補外）
+func Score(meisai *mysql.ETCMeisai, row *mysql.DTakoRows) float64 {
+	during := func(t time.Time) bool {
+		return !t.Before(row.DepartureDateTime) && !t.After(row.ReturnDateTime)
+	}
+	exit := during(meisai.DateTo)
+	switch {
+	case meisai.DateFr == nil && exit:
+		return confidenceExitOnly
+	case meisai.DateFr == nil:
+		return 0
+	}
+
+	entry := during(*meisai.DateFr)
+	switch {
+	case entry && exit:
+		return confidenceBoth
+	case entry || exit:
+		return confidencePartial
+	}
+	return 0
+}
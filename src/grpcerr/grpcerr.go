@@ -0,0 +1,154 @@
+// Package grpcerr リポジトリ・モデルのエラーをgRPCのステータスに変換する
+//
+// 全サービスで同じ基準でステータスコードを決め、詳細にgoogle.rpc.ErrorInfo（reason、domain="db_service"）を、
+// 入力値のエラーの場合はgoogle.rpc.BadRequest（対象のフィールド）も設定する。
+//
+//	エラー                                            コード               reason
+//	mysql.ValidationError・不正なpage_token/sort      INVALID_ARGUMENT     VALIDATION_FAILED
+//	gorm.ErrRecordNotFound・mysql.ErrRecordNotFound   NOT_FOUND            NOT_FOUND
+//	重複キー（MySQL 1062等）                          ALREADY_EXISTS       ALREADY_EXISTS
+//	context.DeadlineExceeded・クエリのタイムアウト    DEADLINE_EXCEEDED    TIMEOUT
+//	context.Canceled                                  CANCELLED            CANCELLED
+//	DBに接続できない・接続が切れた・未接続            UNAVAILABLE          DB_UNAVAILABLE
+//	それ以外                                          INTERNAL             INTERNAL
+package grpcerr
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net"
+
+	mysqldriver "github.com/go-sql-driver/mysql"
+	"github.com/yhonda-ohishi/db_service/src/config"
+	"github.com/yhonda-ohishi/db_service/src/models/mysql"
+	"github.com/yhonda-ohishi/db_service/src/repository"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"gorm.io/gorm"
+)
+
+// Domain ErrorInfoのdomain
+const Domain = "db_service"
+
+// ErrorInfoのreason
+const (
+	ReasonValidationFailed = "VALIDATION_FAILED"
+	ReasonNotFound         = "NOT_FOUND"
+	ReasonAlreadyExists    = "ALREADY_EXISTS"
+	ReasonTimeout          = "TIMEOUT"
+	ReasonCancelled        = "CANCELLED"
+	ReasonDBUnavailable    = "DB_UNAVAILABLE"
+	ReasonInternal         = "INTERNAL"
+)
+
+// MySQLのエラー番号
+const (
+	mysqlDuplicateEntry   = 1062
+	mysqlTooManyConns     = 1040
+	mysqlLockWaitTimeout  = 1205
+	mysqlQueryInterrupted = 3024 // max_execution_timeを超えた
+)
+
+// FromError エラーをgRPCのステータスに変換（nilの場合はnil、gRPCのステータスはそのまま返す）
+// メッセージは "<msg>: <err>"
+func FromError(err error, msg string) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var validation *mysql.ValidationError
+	switch {
+	case errors.As(err, &validation):
+		return invalidArgument(validation.Field, fmt.Sprintf("%s: %v", msg, err), validation.Message)
+	case errors.Is(err, repository.ErrInvalidPageToken):
+		return InvalidArgument("page_token", "invalid page_token")
+	case errors.Is(err, repository.ErrInvalidSortField):
+		return InvalidArgument("sort", "%v", err)
+	}
+
+	code, reason := classify(err)
+	return New(code, reason, fmt.Sprintf("%s: %v", msg, err))
+}
+
+// InvalidArgument fieldの値が不正な場合のINVALID_ARGUMENT（BadRequestのフィールドにfieldを設定）
+func InvalidArgument(field, format string, args ...interface{}) error {
+	msg := fmt.Sprintf(format, args...)
+	return invalidArgument(field, msg, msg)
+}
+
+// Unavailable DBに接続できない場合のUNAVAILABLE
+func Unavailable(format string, args ...interface{}) error {
+	return New(codes.Unavailable, ReasonDBUnavailable, fmt.Sprintf(format, args...))
+}
+
+// New ErrorInfoを設定したステータス
+func New(code codes.Code, reason, msg string) error {
+	return withDetails(status.New(code, msg), &errdetails.ErrorInfo{Reason: reason, Domain: Domain})
+}
+
+// invalidArgument INVALID_ARGUMENT（descriptionはBadRequestのフィールドの説明）
+func invalidArgument(field, msg, description string) error {
+	return withDetails(status.New(codes.InvalidArgument, msg),
+		&errdetails.ErrorInfo{
+			Reason:   ReasonValidationFailed,
+			Domain:   Domain,
+			Metadata: map[string]string{"field": field},
+		},
+		&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: field, Description: description}},
+		},
+	)
+}
+
+// withDetails 詳細を設定（設定できない場合は詳細なしのステータス）
+func withDetails(st *status.Status, details ...protoadapt.MessageV1) error {
+	detailed, err := st.WithDetails(details...)
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// classify エラーのステータスコードとreason
+func classify(err error) (codes.Code, string) {
+	var mysqlErr *mysqldriver.MySQLError
+	var netErr net.Error
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return codes.DeadlineExceeded, ReasonTimeout
+	case errors.Is(err, context.Canceled):
+		return codes.Canceled, ReasonCancelled
+	case errors.Is(err, gorm.ErrRecordNotFound), errors.Is(err, mysql.ErrRecordNotFound):
+		return codes.NotFound, ReasonNotFound
+	case errors.Is(err, gorm.ErrDuplicatedKey), errors.Is(err, mysql.ErrDuplicateKey),
+		errors.Is(err, repository.ErrMappingExists), errors.Is(err, repository.ErrHashExists),
+		errors.Is(err, repository.ErrEmployeeCodeLinked), errors.Is(err, repository.ErrEmployeeCardRegistered):
+		return codes.AlreadyExists, ReasonAlreadyExists
+	case errors.As(err, &mysqlErr):
+		switch mysqlErr.Number {
+		case mysqlDuplicateEntry:
+			return codes.AlreadyExists, ReasonAlreadyExists
+		case mysqlLockWaitTimeout, mysqlQueryInterrupted:
+			return codes.DeadlineExceeded, ReasonTimeout
+		case mysqlTooManyConns:
+			return codes.Unavailable, ReasonDBUnavailable
+		}
+	case errors.Is(err, config.ErrNotConnected),
+		errors.Is(err, driver.ErrBadConn), errors.Is(err, sql.ErrConnDone), errors.Is(err, mysqldriver.ErrInvalidConn):
+		return codes.Unavailable, ReasonDBUnavailable
+	case errors.As(err, &netErr):
+		if netErr.Timeout() {
+			return codes.DeadlineExceeded, ReasonTimeout
+		}
+		return codes.Unavailable, ReasonDBUnavailable
+	}
+	return codes.Internal, ReasonInternal
+}
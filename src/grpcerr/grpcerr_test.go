@@ -0,0 +1,96 @@
+package grpcerr
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	mysqldriver "github.com/go-sql-driver/mysql"
+	"github.com/yhonda-ohishi/db_service/src/config"
+	"github.com/yhonda-ohishi/db_service/src/models/mysql"
+	"github.com/yhonda-ohishi/db_service/src/repository"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// errorInfo ステータスの詳細のErrorInfo（ない場合はnil）
+func errorInfo(st *status.Status) *errdetails.ErrorInfo {
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info
+		}
+	}
+	return nil
+}
+
+func TestFromError(t *testing.T) {
+	testCases := []struct {
+		name   string
+		err    error
+		code   codes.Code
+		reason string
+	}{
+		{"gorm not found", fmt.Errorf("wrapped: %w", gorm.ErrRecordNotFound), codes.NotFound, ReasonNotFound},
+		{"model not found", mysql.ErrRecordNotFound, codes.NotFound, ReasonNotFound},
+		{"duplicated key", gorm.ErrDuplicatedKey, codes.AlreadyExists, ReasonAlreadyExists},
+		{"mapping exists", fmt.Errorf("failed to create mapping: %w", repository.ErrMappingExists), codes.AlreadyExists, ReasonAlreadyExists},
+		{"mysql duplicate entry", &mysqldriver.MySQLError{Number: 1062, Message: "Duplicate entry"}, codes.AlreadyExists, ReasonAlreadyExists},
+		{"mysql query timeout", &mysqldriver.MySQLError{Number: 3024}, codes.DeadlineExceeded, ReasonTimeout},
+		{"context deadline", context.DeadlineExceeded, codes.DeadlineExceeded, ReasonTimeout},
+		{"context canceled", context.Canceled, codes.Canceled, ReasonCancelled},
+		{"bad conn", driver.ErrBadConn, codes.Unavailable, ReasonDBUnavailable},
+		{"not connected", fmt.Errorf("query: %w", config.ErrNotConnected), codes.Unavailable, ReasonDBUnavailable},
+		{"invalid conn", mysqldriver.ErrInvalidConn, codes.Unavailable, ReasonDBUnavailable},
+		{"dial error", &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}, codes.Unavailable, ReasonDBUnavailable},
+		{"other", errors.New("boom"), codes.Internal, ReasonInternal},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			st := status.Convert(FromError(tc.err, "failed"))
+			if st.Code() != tc.code {
+				t.Errorf("code = %v, want %v", st.Code(), tc.code)
+			}
+			if info := errorInfo(st); info == nil || info.Reason != tc.reason || info.Domain != Domain {
+				t.Errorf("unexpected ErrorInfo: %v", info)
+			}
+		})
+	}
+
+	if FromError(nil, "failed") != nil {
+		t.Error("FromError(nil) should be nil")
+	}
+	unavailable := Unavailable("prod database is unavailable")
+	if got := FromError(unavailable, "failed"); got != unavailable {
+		t.Errorf("status error should be returned as is, got %v", got)
+	}
+}
+
+func TestFromError_Validation(t *testing.T) {
+	st := status.Convert(FromError(fmt.Errorf("validation failed: %w", mysql.ErrInvalidETCMeisaiHash), "invalid etc_meisai_mapping"))
+	if st.Code() != codes.InvalidArgument || st.Message() != "invalid etc_meisai_mapping: validation failed: etc_meisai_hash cannot be empty" {
+		t.Fatalf("unexpected status: %v", st)
+	}
+	if info := errorInfo(st); info == nil || info.Reason != ReasonValidationFailed || info.Metadata["field"] != "etc_meisai_hash" {
+		t.Errorf("unexpected ErrorInfo: %v", info)
+	}
+
+	var badRequest *errdetails.BadRequest
+	for _, detail := range st.Details() {
+		if d, ok := detail.(*errdetails.BadRequest); ok {
+			badRequest = d
+		}
+	}
+	if badRequest == nil || len(badRequest.FieldViolations) != 1 || badRequest.FieldViolations[0].Field != "etc_meisai_hash" {
+		t.Errorf("unexpected BadRequest: %v", badRequest)
+	}
+
+	if code := status.Code(FromError(repository.ErrInvalidPageToken, "failed")); code != codes.InvalidArgument {
+		t.Errorf("invalid page token: code = %v", code)
+	}
+}
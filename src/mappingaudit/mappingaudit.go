@@ -0,0 +1,212 @@
+// Package mappingaudit etc_meisai_mappingの整合性チェック
+//
+// etc_meisai_mappingのetc_meisai_hash・dtako_row_idは外部キーがないため、
+// ローカルDBのetc_meisai・本番DBのdtako_rowsと突き合わせて次の問題を検出する。
+//   - 参照先のETC明細がないマッピング（OrphanHash）
+//   - 参照先の運行データがないマッピング（OrphanDTakoRow）
+//   - 同じhashのマッピングが複数ある（DuplicateHash）
+//   - 参照先のETC明細の保存されたhashがGenerateHash()と一致しない（StaleHash）
+package mappingaudit
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/yhonda-ohishi/db_service/src/models/mysql"
+	"github.com/yhonda-ohishi/db_service/src/repository"
+)
+
+// Kind 問題の種類
+type Kind int
+
+const (
+	// OrphanHash etc_meisai_hashのETC明細がない
+	OrphanHash Kind = iota + 1
+	// OrphanDTakoRow dtako_row_idの運行データがない
+	OrphanDTakoRow
+	// DuplicateHash 同じetc_meisai_hashのマッピングが複数ある
+	DuplicateHash
+	// StaleHash ETC明細の保存されたhashがGenerateHash()と一致しない（作成後に明細が変更された）
+	StaleHash
+)
+
+// String 問題の種類の名前
+func (k Kind) String() string {
+	switch k {
+	case OrphanHash:
+		return "orphan_hash"
+	case OrphanDTakoRow:
+		return "orphan_dtako_row"
+	case DuplicateHash:
+		return "duplicate_hash"
+	case StaleHash:
+		return "stale_hash"
+	}
+	return fmt.Sprintf("Kind(%d)", int(k))
+}
+
+// Issue 検出した問題
+type Issue struct {
+	Kind Kind
+	// MappingIDs 問題のあるマッピングのID（昇順）
+	MappingIDs    []int64
+	ETCMeisaiHash string
+	// DTakoRowIDs マッピングの運行データID（OrphanDTakoRowの場合は存在しないID）
+	DTakoRowIDs []string
+	// ETCMeisaiID StaleHashのETC明細のID
+	ETCMeisaiID int64
+	// ExpectedHash StaleHashのETC明細のGenerateHash()
+	ExpectedHash string
+}
+
+// Report 整合性チェックの結果
+type Report struct {
+	// MappingCount チェックしたマッピングの件数
+	MappingCount int
+	// Issues 検出した問題（種類の順）
+	Issues []*Issue
+}
+
+// Count 種類ごとの問題の件数
+func (r *Report) Count(kind Kind) int {
+	n := 0
+	for _, issue := range r.Issues {
+		if issue.Kind == kind {
+			n++
+		}
+	}
+	return n
+}
+
+// Auditor etc_meisai_mappingの整合性チェック
+type Auditor struct {
+	mappings  repository.ETCMeisaiMappingRepository
+	etcMeisai repository.ETCMeisaiRepository
+	dtakoRows repository.DTakoRowsRepository
+}
+
+// New Auditorのコンストラクタ
+func New(mappings repository.ETCMeisaiMappingRepository, etcMeisai repository.ETCMeisaiRepository,
+	dtakoRows repository.DTakoRowsRepository) *Auditor {
+	return &Auditor{
+		mappings:  mappings,
+		etcMeisai: etcMeisai,
+		dtakoRows: dtakoRows,
+	}
+}
+
+// Audit 全マッピングの整合性をチェックする
+func (a *Auditor) Audit(ctx context.Context) (*Report, error) {
+	mappings, err := a.allMappings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// hash・運行データIDごとのマッピング
+	byHash := make(map[string][]*mysql.ETCMeisaiMapping)
+	byRowID := make(map[string][]*mysql.ETCMeisaiMapping)
+	for _, m := range mappings {
+		byHash[m.ETCMeisaiHash] = append(byHash[m.ETCMeisaiHash], m)
+		byRowID[m.DTakoRowID] = append(byRowID[m.DTakoRowID], m)
+	}
+	hashes := sortedKeys(byHash)
+	rowIDs := sortedKeys(byRowID)
+
+	report := &Report{MappingCount: len(mappings)}
+
+	// ETC明細
+	meisai, err := a.etcMeisai.ListByHashes(ctx, hashes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list etc_meisai: %w", err)
+	}
+	found := make(map[string]bool, len(meisai))
+	for _, m := range meisai {
+		found[m.Hash] = true
+	}
+	for _, hash := range hashes {
+		if !found[hash] {
+			report.Issues = append(report.Issues, newIssue(OrphanHash, hash, byHash[hash]))
+		}
+	}
+
+	// 運行データ
+	rows, err := a.dtakoRows.GetByIDs(ctx, rowIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dtako_rows: %w", err)
+	}
+	existing := make(map[string]bool, len(rows))
+	for _, row := range rows {
+		existing[row.ID] = true
+	}
+	for _, rowID := range rowIDs {
+		if existing[rowID] {
+			continue
+		}
+		issue := newIssue(OrphanDTakoRow, "", byRowID[rowID])
+		issue.DTakoRowIDs = []string{rowID}
+		report.Issues = append(report.Issues, issue)
+	}
+
+	// 同じhashのマッピング
+	for _, hash := range hashes {
+		if len(byHash[hash]) > 1 {
+			report.Issues = append(report.Issues, newIssue(DuplicateHash, hash, byHash[hash]))
+		}
+	}
+
+	// 保存されたhashと明細の内容
+	for _, m := range meisai {
+		if expected := m.GenerateHash(); expected != m.Hash {
+			issue := newIssue(StaleHash, m.Hash, byHash[m.Hash])
+			issue.ETCMeisaiID = m.ID
+			issue.ExpectedHash = expected
+			report.Issues = append(report.Issues, issue)
+		}
+	}
+
+	return report, nil
+}
+
+// allMappings 全マッピングを取得
+func (a *Auditor) allMappings(ctx context.Context) ([]*mysql.ETCMeisaiMapping, error) {
+	var mappings []*mysql.ETCMeisaiMapping
+	page := repository.PageRequest{PageSize: repository.MaxPageSize}
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		result, err := a.mappings.ListPage(ctx, &repository.ETCMeisaiMappingListParams{}, page)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list mappings: %w", err)
+		}
+		mappings = append(mappings, result.Items...)
+		if result.NextPageToken == "" {
+			return mappings, nil
+		}
+		page.PageToken = result.NextPageToken
+	}
+}
+
+// newIssue マッピングの問題（マッピングIDは昇順、運行データIDは重複を除いて昇順）
+func newIssue(kind Kind, hash string, mappings []*mysql.ETCMeisaiMapping) *Issue {
+	issue := &Issue{Kind: kind, ETCMeisaiHash: hash}
+	rowIDs := make(map[string]bool)
+	for _, m := range mappings {
+		issue.MappingIDs = append(issue.MappingIDs, m.ID)
+		rowIDs[m.DTakoRowID] = true
+	}
+	sort.Slice(issue.MappingIDs, func(i, j int) bool { return issue.MappingIDs[i] < issue.MappingIDs[j] })
+	issue.DTakoRowIDs = sortedKeys(rowIDs)
+	return issue
+}
+
+// sortedKeys mapのキー（昇順）
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,15 @@
+package ichibanboshi
+
+// BumonMaster 部門マスタテーブルのモデル（SQL Server）
+// 部門Cは社員マスタの部門C、運転日報明細の受注部門/稼動部門、本番DBのcars.bumon_code_id/drivers.bumonに対応
+type BumonMaster struct {
+	BumonC string  `gorm:"column:部門C;primaryKey;size:3" json:"bumon_c"`
+	BumonN *string `gorm:"column:部門N;size:32" json:"bumon_n,omitempty"`
+	BumonR *string `gorm:"column:部門R;size:16" json:"bumon_r,omitempty"`
+	BumonF *string `gorm:"column:部門F;size:12" json:"bumon_f,omitempty"`
+}
+
+// TableName テーブル名を指定
+func (BumonMaster) TableName() string {
+	return "部門ﾏｽﾀ"
+}
@@ -0,0 +1,36 @@
+package ichibanboshi
+
+// HinmeiMaster 品名マスタテーブルのモデル（SQL Server）
+// 品名C・品名Hの複合主キー（運転日報明細の品名C/品名H、地区マスタのDGR品名C/DGR品名Hに対応）
+type HinmeiMaster struct {
+	HinmeiC   string   `gorm:"column:品名C;primaryKey;size:4" json:"hinmei_c"`
+	HinmeiH   string   `gorm:"column:品名H;primaryKey;size:2" json:"hinmei_h"`
+	HinmeiN   *string  `gorm:"column:品名N;size:32" json:"hinmei_n,omitempty"`
+	HinmeiR   *string  `gorm:"column:品名R;size:16" json:"hinmei_r,omitempty"`
+	HinmeiF   *string  `gorm:"column:品名F;size:12" json:"hinmei_f,omitempty"`
+	Tani      *string  `gorm:"column:単位;size:4" json:"tani,omitempty"`
+	TaniJuryo *float64 `gorm:"column:単位重量;type:decimal" json:"tani_juryo,omitempty"`
+}
+
+// TableName テーブル名を指定
+func (HinmeiMaster) TableName() string {
+	return "品名ﾏｽﾀ"
+}
+
+// TokuisakiHinmeiMaster 得意先別品名マスタテーブルのモデル（SQL Server）
+// 得意先ごとに品名マスタの品名・単位・単位重量を置き換える（NULL・空の項目は品名マスタの値を使用）
+type TokuisakiHinmeiMaster struct {
+	TokuisakiC string   `gorm:"column:得意先C;primaryKey;size:6" json:"tokuisaki_c"`
+	TokuisakiH string   `gorm:"column:得意先H;primaryKey;size:3" json:"tokuisaki_h"`
+	HinmeiC    string   `gorm:"column:品名C;primaryKey;size:4" json:"hinmei_c"`
+	HinmeiH    string   `gorm:"column:品名H;primaryKey;size:2" json:"hinmei_h"`
+	HinmeiN    *string  `gorm:"column:品名N;size:32" json:"hinmei_n,omitempty"`
+	HinmeiR    *string  `gorm:"column:品名R;size:16" json:"hinmei_r,omitempty"`
+	Tani       *string  `gorm:"column:単位;size:4" json:"tani,omitempty"`
+	TaniJuryo  *float64 `gorm:"column:単位重量;type:decimal" json:"tani_juryo,omitempty"`
+}
+
+// TableName テーブル名を指定
+func (TokuisakiHinmeiMaster) TableName() string {
+	return "得意先別品名ﾏｽﾀ"
+}
@@ -0,0 +1,18 @@
+package ichibanboshi
+
+// SharyoMaster 車輌マスタテーブルのモデル（SQL Server）
+// 車輌C・車輌Hの複合主キー（運転日報明細の車輌C/車輌Hに対応）
+type SharyoMaster struct {
+	SharyoC   string  `gorm:"column:車輌C;primaryKey;size:4" json:"sharyo_c"`
+	SharyoH   string  `gorm:"column:車輌H;primaryKey;size:2" json:"sharyo_h"`
+	SharyoN   *string `gorm:"column:車輌N;size:32" json:"sharyo_n,omitempty"`
+	SharyoR   *string `gorm:"column:車輌R;size:16" json:"sharyo_r,omitempty"`
+	ShashuC   *string `gorm:"column:車種C;size:2" json:"shashu_c,omitempty"`
+	BumonC    *string `gorm:"column:部門C;size:3" json:"bumon_c,omitempty"`
+	UntenshuC *string `gorm:"column:運転手C;size:4" json:"untenshu_c,omitempty"`
+}
+
+// TableName テーブル名を指定
+func (SharyoMaster) TableName() string {
+	return "車輌ﾏｽﾀ"
+}
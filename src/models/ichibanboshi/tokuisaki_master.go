@@ -0,0 +1,39 @@
+package ichibanboshi
+
+import "time"
+
+// TokuisakiMaster 得意先マスタテーブルのモデル（SQL Server）
+// 得意先C・得意先Hの複合主キー（運転日報明細の得意先C/得意先Hに対応）
+type TokuisakiMaster struct {
+	TokuisakiC string  `gorm:"column:得意先C;primaryKey;size:6" json:"tokuisaki_c"`
+	TokuisakiH string  `gorm:"column:得意先H;primaryKey;size:3" json:"tokuisaki_h"`
+	TokuisakiN *string `gorm:"column:得意先N;size:40" json:"tokuisaki_n,omitempty"`
+	TokuisakiR *string `gorm:"column:得意先R;size:20" json:"tokuisaki_r,omitempty"`
+	TokuisakiF *string `gorm:"column:得意先F;size:12" json:"tokuisaki_f,omitempty"`
+	YubinBango *string `gorm:"column:郵便番号;size:8" json:"yubin_bango,omitempty"`
+	Jusho1     *string `gorm:"column:住所1;size:40" json:"jusho1,omitempty"`
+	Jusho2     *string `gorm:"column:住所2;size:40" json:"jusho2,omitempty"`
+	DenwaBango *string `gorm:"column:電話番号;size:13" json:"denwa_bango,omitempty"`
+	FAXBango   *string `gorm:"column:FAX番号;size:13" json:"fax_bango,omitempty"`
+	Tantosha   *string `gorm:"column:担当者;size:16" json:"tantosha,omitempty"`
+}
+
+// TableName テーブル名を指定
+func (TokuisakiMaster) TableName() string {
+	return "得意先ﾏｽﾀ"
+}
+
+// TokuisakiTekiyobiMaster 得意先適用日マスタテーブルのモデル（SQL Server）
+// 適用日以降の得意先名（適用日が最も新しいものが有効）
+type TokuisakiTekiyobiMaster struct {
+	TokuisakiC string    `gorm:"column:得意先C;primaryKey;size:6" json:"tokuisaki_c"`
+	TokuisakiH string    `gorm:"column:得意先H;primaryKey;size:3" json:"tokuisaki_h"`
+	Tekiyobi   time.Time `gorm:"column:適用日;primaryKey" json:"tekiyobi"`
+	TokuisakiN *string   `gorm:"column:得意先N;size:40" json:"tokuisaki_n,omitempty"`
+	TokuisakiR *string   `gorm:"column:得意先R;size:20" json:"tokuisaki_r,omitempty"`
+}
+
+// TableName テーブル名を指定
+func (TokuisakiTekiyobiMaster) TableName() string {
+	return "得意先適用日ﾏｽﾀ"
+}
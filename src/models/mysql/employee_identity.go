@@ -0,0 +1,131 @@
+package mysql
+
+import (
+	"strings"
+	"time"
+)
+
+// EmployeeIdentity 社員の識別子の対応表（ローカルDB）
+// 本番DB・一番星・デジタコ・タイムカードで別々の社員コードを1人の社員にまとめる（各コードは1人の社員にのみ対応）
+type EmployeeIdentity struct {
+	// 主キー（自動インクリメント）
+	ID int64 `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+
+	// 氏名
+	Name string `gorm:"column:name;size:40;not null" json:"name"`
+
+	// 本番DBのdrivers.id
+	DriverID *int `gorm:"column:driver_id;uniqueIndex:uq_employee_identity_driver_id" json:"driver_id,omitempty"`
+
+	// 一番星の社員ﾏｽﾀ.社員C（運転日報明細の運転手Cと同じコード、前後の空白は除いて保存）
+	ShainC *string `gorm:"column:shain_c;size:4;uniqueIndex:uq_employee_identity_shain_c" json:"shain_c,omitempty"`
+
+	// デジタコの乗務員コード（dtako_rows.乗務員CD1・対象乗務員CD）
+	DTakoDriverCode *int `gorm:"column:dtako_driver_code;uniqueIndex:uq_employee_identity_dtako_driver_code" json:"dtako_driver_code,omitempty"`
+
+	// タイムカードのユーザーID（timecard_logs.id、0はゲストのため登録不可）
+	TimeCardUserID *int `gorm:"column:time_card_user_id;uniqueIndex:uq_employee_identity_time_card_user_id" json:"time_card_user_id,omitempty"`
+
+	// 作成日時
+	CreatedAt time.Time `gorm:"column:created_at;not null" json:"created_at"`
+
+	// 更新日時
+	UpdatedAt time.Time `gorm:"column:updated_at;not null" json:"updated_at"`
+
+	// 備考
+	Notes *string `gorm:"column:notes;size:200" json:"notes,omitempty"`
+}
+
+// TableName テーブル名を指定
+func (EmployeeIdentity) TableName() string {
+	return "employee_identity"
+}
+
+// Normalize 社員Cの前後の空白を除く（空の場合はnil）
+func (m *EmployeeIdentity) Normalize() {
+	if m.ShainC == nil {
+		return
+	}
+	shainC := strings.TrimSpace(*m.ShainC)
+	if shainC == "" {
+		m.ShainC = nil
+		return
+	}
+	m.ShainC = &shainC
+}
+
+// Validate バリデーション
+func (m *EmployeeIdentity) Validate() error {
+	if strings.TrimSpace(m.Name) == "" {
+		return ErrInvalidEmployeeName
+	}
+	if m.TimeCardUserID != nil && *m.TimeCardUserID <= 0 {
+		return ErrInvalidTimeCardUserID
+	}
+	if m.CreatedAt.IsZero() {
+		return ErrInvalidCreatedAt
+	}
+	if m.UpdatedAt.IsZero() {
+		return ErrInvalidUpdatedAt
+	}
+	return nil
+}
+
+// BeforeCreate GORM作成前フック
+func (m *EmployeeIdentity) BeforeCreate() {
+	now := time.Now()
+	m.CreatedAt = now
+	m.UpdatedAt = now
+}
+
+// BeforeUpdate GORM更新前フック
+func (m *EmployeeIdentity) BeforeUpdate() {
+	m.UpdatedAt = time.Now()
+}
+
+// EmployeeCard 社員のFeliCaカード（ローカルDB、1枚のカードは1人の社員にのみ登録）
+type EmployeeCard struct {
+	// カードID（FeliCa UIDなど、timecard_logs.card_idと同じ値）
+	CardID string `gorm:"column:card_id;primaryKey;size:50" json:"card_id"`
+
+	// 社員（employee_identity.id）
+	EmployeeID int64 `gorm:"column:employee_id;not null;index" json:"employee_id"`
+
+	// 登録日時
+	CreatedAt time.Time `gorm:"column:created_at;not null" json:"created_at"`
+
+	// 登録者/システム
+	CreatedBy string `gorm:"column:created_by;size:50;not null" json:"created_by"`
+}
+
+// TableName テーブル名を指定
+func (EmployeeCard) TableName() string {
+	return "employee_card"
+}
+
+// Validate バリデーション
+func (m *EmployeeCard) Validate() error {
+	if strings.TrimSpace(m.CardID) == "" {
+		return ErrInvalidCardID
+	}
+	if m.EmployeeID <= 0 {
+		return ErrInvalidEmployeeID
+	}
+	if m.CreatedBy == "" {
+		return ErrInvalidCreatedBy
+	}
+	if m.CreatedAt.IsZero() {
+		return ErrInvalidCreatedAt
+	}
+	return nil
+}
+
+// SetDefaults デフォルト値を設定
+func (m *EmployeeCard) SetDefaults() {
+	if m.CreatedAt.IsZero() {
+		m.CreatedAt = time.Now()
+	}
+	if m.CreatedBy == "" {
+		m.CreatedBy = "system"
+	}
+}
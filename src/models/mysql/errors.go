@@ -2,43 +2,69 @@ package mysql
 
 import "errors"
 
+// ValidationError バリデーションエラー（Fieldは対象のフィールド名）
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+// Error エラーメッセージ（"<field> <message>"）
+func (e *ValidationError) Error() string {
+	return e.Field + " " + e.Message
+}
+
+// newValidationError バリデーションエラーを作成
+func newValidationError(field, message string) *ValidationError {
+	return &ValidationError{Field: field, Message: message}
+}
+
 // バリデーションエラー定義
 var (
 	// 共通エラー
-	ErrInvalidPrice   = errors.New("price must be non-negative")
+	ErrInvalidPrice   = newValidationError("price", "must be non-negative")
 	ErrRecordNotFound = errors.New("record not found")
 	ErrDuplicateKey   = errors.New("duplicate primary key")
 
 	// DTakoUriageKeihi関連
-	ErrInvalidSrchID      = errors.New("srch_id cannot be empty")
-	ErrInvalidKeihiC      = errors.New("keihi_c must be non-negative")
-	ErrInvalidKm          = errors.New("km must be non-negative")
-	ErrInvalidDtakoRowID  = errors.New("dtako_row_id cannot be empty")
-	ErrInvalidDtakoRowIDR = errors.New("dtako_row_id_r cannot be empty")
+	ErrInvalidSrchID      = newValidationError("srch_id", "cannot be empty")
+	ErrInvalidKeihiC      = newValidationError("keihi_c", "must be non-negative")
+	ErrInvalidKm          = newValidationError("km", "must be non-negative")
+	ErrInvalidDtakoRowID  = newValidationError("dtako_row_id", "cannot be empty")
+	ErrInvalidDtakoRowIDR = newValidationError("dtako_row_id_r", "cannot be empty")
 
 	// ETCMeisai関連
-	ErrInvalidDateTo     = errors.New("date_to cannot be empty")
-	ErrInvalidDateToDate = errors.New("date_to_date cannot be empty")
-	ErrInvalidIcFr       = errors.New("ic_fr cannot be empty")
-	ErrInvalidIcTo       = errors.New("ic_to cannot be empty")
-	ErrInvalidPriceBf    = errors.New("price_bf must be non-negative")
-	ErrInvalidDescount   = errors.New("descount must be non-negative")
-	ErrInvalidShashu     = errors.New("shashu must be positive")
-	ErrInvalidEtcNum     = errors.New("etc_num cannot be empty")
-	ErrInvalidHash       = errors.New("hash cannot be empty")
-	ErrInvalidCreatedBy  = errors.New("created_by cannot be empty")
-	ErrInvalidCreatedAt  = errors.New("created_at cannot be zero")
-	ErrInvalidUpdatedAt  = errors.New("updated_at cannot be zero")
+	ErrInvalidDateTo     = newValidationError("date_to", "cannot be empty")
+	ErrInvalidDateToDate = newValidationError("date_to_date", "cannot be empty")
+	ErrInvalidIcFr       = newValidationError("ic_fr", "cannot be empty")
+	ErrInvalidIcTo       = newValidationError("ic_to", "cannot be empty")
+	ErrInvalidPriceBf    = newValidationError("price_bf", "must be non-negative")
+	ErrInvalidDescount   = newValidationError("descount", "must be non-negative")
+	ErrInvalidShashu     = newValidationError("shashu", "must be positive")
+	ErrInvalidEtcNum     = newValidationError("etc_num", "cannot be empty")
+	ErrInvalidHash       = newValidationError("hash", "cannot be empty")
+	ErrHashMismatch      = newValidationError("hash", "does not match the hash generated from the record")
+	ErrInvalidCreatedBy  = newValidationError("created_by", "cannot be empty")
+	ErrInvalidCreatedAt  = newValidationError("created_at", "cannot be zero")
+	ErrInvalidUpdatedAt  = newValidationError("updated_at", "cannot be zero")
+
+	// ETCMeisaiMapping関連
+	ErrInvalidETCMeisaiHash = newValidationError("etc_meisai_hash", "cannot be empty")
+
+	// EmployeeIdentity関連
+	ErrInvalidEmployeeName   = newValidationError("name", "cannot be empty")
+	ErrInvalidTimeCardUserID = newValidationError("time_card_user_id", "must be positive")
+	ErrInvalidCardID         = newValidationError("card_id", "cannot be empty")
+	ErrInvalidEmployeeID     = newValidationError("employee_id", "must be positive")
 
 	// DTakoFerryRows関連
-	ErrInvalidUnkoNo        = errors.New("unko_no cannot be empty")
-	ErrInvalidUnkoDate      = errors.New("unko_date cannot be empty")
-	ErrInvalidYomitoriDate  = errors.New("yomitori_date cannot be empty")
-	ErrInvalidJigyoshoCD    = errors.New("jigyosho_cd must be positive")
-	ErrInvalidJigyoshoName  = errors.New("jigyosho_name cannot be empty")
-	ErrInvalidSharyoCD      = errors.New("sharyo_cd must be positive")
-	ErrInvalidSharyoName    = errors.New("sharyo_name cannot be empty")
-	ErrInvalidHyojunRyokin  = errors.New("hyojun_ryokin must be non-negative")
-	ErrInvalidKeiyakuRyokin = errors.New("keiyaku_ryokin must be non-negative")
-	ErrInvalidMinashiKyori  = errors.New("minashi_kyori must be non-negative")
+	ErrInvalidUnkoNo        = newValidationError("unko_no", "cannot be empty")
+	ErrInvalidUnkoDate      = newValidationError("unko_date", "cannot be empty")
+	ErrInvalidYomitoriDate  = newValidationError("yomitori_date", "cannot be empty")
+	ErrInvalidJigyoshoCD    = newValidationError("jigyosho_cd", "must be positive")
+	ErrInvalidJigyoshoName  = newValidationError("jigyosho_name", "cannot be empty")
+	ErrInvalidSharyoCD      = newValidationError("sharyo_cd", "must be positive")
+	ErrInvalidSharyoName    = newValidationError("sharyo_name", "cannot be empty")
+	ErrInvalidHyojunRyokin  = newValidationError("hyojun_ryokin", "must be non-negative")
+	ErrInvalidKeiyakuRyokin = newValidationError("keiyaku_ryokin", "must be non-negative")
+	ErrInvalidMinashiKyori  = newValidationError("minashi_kyori", "must be non-negative")
 )
@@ -31,8 +31,8 @@ type ETCMeisai struct {
 	EtcNum   string  `gorm:"column:etc_num;size:20;not null" json:"etc_num"`
 	Detail   *string `gorm:"column:detail;size:40" json:"detail,omitempty"`
 
-	// ハッシュ値（データ整合性確認用）
-	Hash string `gorm:"column:hash;size:64;not null" json:"hash"`
+	// ハッシュ値（データ整合性確認用、重複登録防止のため一意）
+	Hash string `gorm:"column:hash;size:64;not null;uniqueIndex:uq_etc_meisai_hash" json:"hash"`
 }
 
 // TableName テーブル名を指定
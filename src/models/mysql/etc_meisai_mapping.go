@@ -7,11 +7,11 @@ type ETCMeisaiMapping struct {
 	// 主キー（自動インクリメント）
 	ID int64 `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
 
-	// ETC明細のハッシュ値
-	ETCMeisaiHash string `gorm:"column:etc_meisai_hash;size:64;not null;index" json:"etc_meisai_hash"`
+	// ETC明細のハッシュ値（dtako_row_idとの組み合わせで一意）
+	ETCMeisaiHash string `gorm:"column:etc_meisai_hash;size:64;not null;index;uniqueIndex:uq_etc_meisai_mapping_hash_row,priority:1" json:"etc_meisai_hash"`
 
 	// 運行データID
-	DTakoRowID string `gorm:"column:dtako_row_id;size:24;not null;index" json:"dtako_row_id"`
+	DTakoRowID string `gorm:"column:dtako_row_id;size:24;not null;index;uniqueIndex:uq_etc_meisai_mapping_hash_row,priority:2" json:"dtako_row_id"`
 
 	// マッピング作成日時
 	CreatedAt time.Time `gorm:"column:created_at;not null" json:"created_at"`
@@ -34,7 +34,7 @@ func (ETCMeisaiMapping) TableName() string {
 // Validate バリデーション
 func (m *ETCMeisaiMapping) Validate() error {
 	if m.ETCMeisaiHash == "" {
-		return ErrInvalidHash
+		return ErrInvalidETCMeisaiHash
 	}
 	if m.DTakoRowID == "" {
 		return ErrInvalidDtakoRowID
@@ -15,3 +15,20 @@ type ETCNum struct {
 func (ETCNum) TableName() string {
 	return "etc_num"
 }
+
+// ValidAt 指定日時にカードが有効か（開始日時・終了日時がない場合は期限なし）
+//
+// 有効期間は開始日時〜終了日時の半開区間（開始日時は有効、終了日時は無効）で、
+// Overlapsと同じく付け替えの日時には付け替え後の登録のみが有効になる。
+func (e *ETCNum) ValidAt(t time.Time) bool {
+	if e.StartDateTime != nil && t.Before(*e.StartDateTime) {
+		return false
+	}
+	return e.DueDateTime == nil || t.Before(*e.DueDateTime)
+}
+
+// Overlaps 有効期間が重なるか（一方の終了日時と他方の開始日時が同じ場合は付け替えとみなし重ならない）
+func (e *ETCNum) Overlaps(other *ETCNum) bool {
+	return (e.StartDateTime == nil || other.DueDateTime == nil || e.StartDateTime.Before(*other.DueDateTime)) &&
+		(other.StartDateTime == nil || e.DueDateTime == nil || other.StartDateTime.Before(*e.DueDateTime))
+}
@@ -7,6 +7,7 @@
 package proto
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -21,925 +22,1295 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// 経費精算データ
-type Db_DTakoUriageKeihi struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	SrchId         string                 `protobuf:"bytes,1,opt,name=srch_id,json=srchId,proto3" json:"srch_id,omitempty"`
-	Datetime       string                 `protobuf:"bytes,2,opt,name=datetime,proto3" json:"datetime,omitempty"` // RFC3339形式
-	KeihiC         int32                  `protobuf:"varint,3,opt,name=keihi_c,json=keihiC,proto3" json:"keihi_c,omitempty"`
-	Price          float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
-	Km             *float64               `protobuf:"fixed64,5,opt,name=km,proto3,oneof" json:"km,omitempty"`
-	DtakoRowId     string                 `protobuf:"bytes,6,opt,name=dtako_row_id,json=dtakoRowId,proto3" json:"dtako_row_id,omitempty"`
-	DtakoRowIdR    string                 `protobuf:"bytes,7,opt,name=dtako_row_id_r,json=dtakoRowIdR,proto3" json:"dtako_row_id_r,omitempty"`
-	StartSrchId    *string                `protobuf:"bytes,8,opt,name=start_srch_id,json=startSrchId,proto3,oneof" json:"start_srch_id,omitempty"`
-	StartSrchTime  *string                `protobuf:"bytes,9,opt,name=start_srch_time,json=startSrchTime,proto3,oneof" json:"start_srch_time,omitempty"`
-	StartSrchPlace *string                `protobuf:"bytes,10,opt,name=start_srch_place,json=startSrchPlace,proto3,oneof" json:"start_srch_place,omitempty"`
-	StartSrchTokui *string                `protobuf:"bytes,11,opt,name=start_srch_tokui,json=startSrchTokui,proto3,oneof" json:"start_srch_tokui,omitempty"`
-	EndSrchId      *string                `protobuf:"bytes,12,opt,name=end_srch_id,json=endSrchId,proto3,oneof" json:"end_srch_id,omitempty"`
-	EndSrchTime    *string                `protobuf:"bytes,13,opt,name=end_srch_time,json=endSrchTime,proto3,oneof" json:"end_srch_time,omitempty"`
-	EndSrchPlace   *string                `protobuf:"bytes,14,opt,name=end_srch_place,json=endSrchPlace,proto3,oneof" json:"end_srch_place,omitempty"`
-	Manual         *bool                  `protobuf:"varint,15,opt,name=manual,proto3,oneof" json:"manual,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// 一括作成の各明細の結果
+type Db_BatchItemStatus int32
+
+const (
+	Db_BatchItemStatus_BATCH_ITEM_STATUS_UNSPECIFIED Db_BatchItemStatus = 0
+	Db_BatchItemStatus_BATCH_ITEM_STATUS_INSERTED    Db_BatchItemStatus = 1 // 作成した
+	Db_BatchItemStatus_BATCH_ITEM_STATUS_DUPLICATE   Db_BatchItemStatus = 2 // 同じhashの明細が登録済み（またはリクエスト内で重複）のためスキップ
+	Db_BatchItemStatus_BATCH_ITEM_STATUS_INVALID     Db_BatchItemStatus = 3 // 入力が不正なためスキップ
+)
+
+// Enum value maps for Db_BatchItemStatus.
+var (
+	Db_BatchItemStatus_name = map[int32]string{
+		0: "BATCH_ITEM_STATUS_UNSPECIFIED",
+		1: "BATCH_ITEM_STATUS_INSERTED",
+		2: "BATCH_ITEM_STATUS_DUPLICATE",
+		3: "BATCH_ITEM_STATUS_INVALID",
+	}
+	Db_BatchItemStatus_value = map[string]int32{
+		"BATCH_ITEM_STATUS_UNSPECIFIED": 0,
+		"BATCH_ITEM_STATUS_INSERTED":    1,
+		"BATCH_ITEM_STATUS_DUPLICATE":   2,
+		"BATCH_ITEM_STATUS_INVALID":     3,
+	}
+)
+
+func (x Db_BatchItemStatus) Enum() *Db_BatchItemStatus {
+	p := new(Db_BatchItemStatus)
+	*p = x
+	return p
 }
 
-func (x *Db_DTakoUriageKeihi) Reset() {
-	*x = Db_DTakoUriageKeihi{}
-	mi := &file_db_service_proto_msgTypes[0]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x Db_BatchItemStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Db_DTakoUriageKeihi) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (Db_BatchItemStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_db_service_proto_enumTypes[0].Descriptor()
 }
 
-func (*Db_DTakoUriageKeihi) ProtoMessage() {}
+func (Db_BatchItemStatus) Type() protoreflect.EnumType {
+	return &file_db_service_proto_enumTypes[0]
+}
 
-func (x *Db_DTakoUriageKeihi) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[0]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x Db_BatchItemStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use Db_DTakoUriageKeihi.ProtoReflect.Descriptor instead.
-func (*Db_DTakoUriageKeihi) Descriptor() ([]byte, []int) {
+// Deprecated: Use Db_BatchItemStatus.Descriptor instead.
+func (Db_BatchItemStatus) EnumDescriptor() ([]byte, []int) {
 	return file_db_service_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *Db_DTakoUriageKeihi) GetSrchId() string {
-	if x != nil {
-		return x.SrchId
+type Db_MappingIssueKind int32
+
+const (
+	Db_MappingIssueKind_MAPPING_ISSUE_KIND_UNSPECIFIED      Db_MappingIssueKind = 0
+	Db_MappingIssueKind_MAPPING_ISSUE_KIND_ORPHAN_HASH      Db_MappingIssueKind = 1 // etc_meisai_hashのETC明細がない
+	Db_MappingIssueKind_MAPPING_ISSUE_KIND_ORPHAN_DTAKO_ROW Db_MappingIssueKind = 2 // dtako_row_idの運行データがない
+	Db_MappingIssueKind_MAPPING_ISSUE_KIND_DUPLICATE_HASH   Db_MappingIssueKind = 3 // 同じetc_meisai_hashのマッピングが複数ある
+	Db_MappingIssueKind_MAPPING_ISSUE_KIND_STALE_HASH       Db_MappingIssueKind = 4 // ETC明細の保存されたhashが明細の内容から生成したhashと一致しない
+)
+
+// Enum value maps for Db_MappingIssueKind.
+var (
+	Db_MappingIssueKind_name = map[int32]string{
+		0: "MAPPING_ISSUE_KIND_UNSPECIFIED",
+		1: "MAPPING_ISSUE_KIND_ORPHAN_HASH",
+		2: "MAPPING_ISSUE_KIND_ORPHAN_DTAKO_ROW",
+		3: "MAPPING_ISSUE_KIND_DUPLICATE_HASH",
+		4: "MAPPING_ISSUE_KIND_STALE_HASH",
+	}
+	Db_MappingIssueKind_value = map[string]int32{
+		"MAPPING_ISSUE_KIND_UNSPECIFIED":      0,
+		"MAPPING_ISSUE_KIND_ORPHAN_HASH":      1,
+		"MAPPING_ISSUE_KIND_ORPHAN_DTAKO_ROW": 2,
+		"MAPPING_ISSUE_KIND_DUPLICATE_HASH":   3,
+		"MAPPING_ISSUE_KIND_STALE_HASH":       4,
 	}
-	return ""
+)
+
+func (x Db_MappingIssueKind) Enum() *Db_MappingIssueKind {
+	p := new(Db_MappingIssueKind)
+	*p = x
+	return p
 }
 
-func (x *Db_DTakoUriageKeihi) GetDatetime() string {
-	if x != nil {
-		return x.Datetime
-	}
-	return ""
+func (x Db_MappingIssueKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Db_DTakoUriageKeihi) GetKeihiC() int32 {
-	if x != nil {
-		return x.KeihiC
-	}
-	return 0
+func (Db_MappingIssueKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_db_service_proto_enumTypes[1].Descriptor()
 }
 
-func (x *Db_DTakoUriageKeihi) GetPrice() float64 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
+func (Db_MappingIssueKind) Type() protoreflect.EnumType {
+	return &file_db_service_proto_enumTypes[1]
 }
 
-func (x *Db_DTakoUriageKeihi) GetKm() float64 {
-	if x != nil && x.Km != nil {
-		return *x.Km
-	}
-	return 0
+func (x Db_MappingIssueKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Db_DTakoUriageKeihi) GetDtakoRowId() string {
-	if x != nil {
-		return x.DtakoRowId
-	}
-	return ""
+// Deprecated: Use Db_MappingIssueKind.Descriptor instead.
+func (Db_MappingIssueKind) EnumDescriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *Db_DTakoUriageKeihi) GetDtakoRowIdR() string {
-	if x != nil {
-		return x.DtakoRowIdR
+type Db_AutoMatchStatus int32
+
+const (
+	Db_AutoMatchStatus_AUTO_MATCH_STATUS_UNSPECIFIED    Db_AutoMatchStatus = 0
+	Db_AutoMatchStatus_AUTO_MATCH_STATUS_MATCHED        Db_AutoMatchStatus = 1 // 候補が1件に決まった（dry_runでない場合はマッピングを作成）
+	Db_AutoMatchStatus_AUTO_MATCH_STATUS_AMBIGUOUS      Db_AutoMatchStatus = 2 // 同じ信頼度の候補が複数ある、または信頼度がしきい値未満
+	Db_AutoMatchStatus_AUTO_MATCH_STATUS_UNMATCHED      Db_AutoMatchStatus = 3 // 車輌・運行データが見つからない
+	Db_AutoMatchStatus_AUTO_MATCH_STATUS_ALREADY_MAPPED Db_AutoMatchStatus = 4 // マッピングが登録済み
+)
+
+// Enum value maps for Db_AutoMatchStatus.
+var (
+	Db_AutoMatchStatus_name = map[int32]string{
+		0: "AUTO_MATCH_STATUS_UNSPECIFIED",
+		1: "AUTO_MATCH_STATUS_MATCHED",
+		2: "AUTO_MATCH_STATUS_AMBIGUOUS",
+		3: "AUTO_MATCH_STATUS_UNMATCHED",
+		4: "AUTO_MATCH_STATUS_ALREADY_MAPPED",
+	}
+	Db_AutoMatchStatus_value = map[string]int32{
+		"AUTO_MATCH_STATUS_UNSPECIFIED":    0,
+		"AUTO_MATCH_STATUS_MATCHED":        1,
+		"AUTO_MATCH_STATUS_AMBIGUOUS":      2,
+		"AUTO_MATCH_STATUS_UNMATCHED":      3,
+		"AUTO_MATCH_STATUS_ALREADY_MAPPED": 4,
 	}
-	return ""
+)
+
+func (x Db_AutoMatchStatus) Enum() *Db_AutoMatchStatus {
+	p := new(Db_AutoMatchStatus)
+	*p = x
+	return p
 }
 
-func (x *Db_DTakoUriageKeihi) GetStartSrchId() string {
-	if x != nil && x.StartSrchId != nil {
-		return *x.StartSrchId
-	}
-	return ""
+func (x Db_AutoMatchStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Db_DTakoUriageKeihi) GetStartSrchTime() string {
-	if x != nil && x.StartSrchTime != nil {
-		return *x.StartSrchTime
-	}
-	return ""
+func (Db_AutoMatchStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_db_service_proto_enumTypes[2].Descriptor()
 }
 
-func (x *Db_DTakoUriageKeihi) GetStartSrchPlace() string {
-	if x != nil && x.StartSrchPlace != nil {
-		return *x.StartSrchPlace
-	}
-	return ""
+func (Db_AutoMatchStatus) Type() protoreflect.EnumType {
+	return &file_db_service_proto_enumTypes[2]
 }
 
-func (x *Db_DTakoUriageKeihi) GetStartSrchTokui() string {
-	if x != nil && x.StartSrchTokui != nil {
-		return *x.StartSrchTokui
-	}
-	return ""
+func (x Db_AutoMatchStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Db_DTakoUriageKeihi) GetEndSrchId() string {
-	if x != nil && x.EndSrchId != nil {
-		return *x.EndSrchId
-	}
-	return ""
+// Deprecated: Use Db_AutoMatchStatus.Descriptor instead.
+func (Db_AutoMatchStatus) EnumDescriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *Db_DTakoUriageKeihi) GetEndSrchTime() string {
-	if x != nil && x.EndSrchTime != nil {
-		return *x.EndSrchTime
+// DTakoEventsのListでソートできるフィールド（値名の DTAKO_EVENTS_SORT_FIELD_ 以降がprotoのフィールド名）
+type Db_DTakoEventsSortField int32
+
+const (
+	Db_DTakoEventsSortField_DTAKO_EVENTS_SORT_FIELD_UNSPECIFIED        Db_DTakoEventsSortField = 0 // 指定不可
+	Db_DTakoEventsSortField_DTAKO_EVENTS_SORT_FIELD_ID                 Db_DTakoEventsSortField = 1 // id
+	Db_DTakoEventsSortField_DTAKO_EVENTS_SORT_FIELD_OPERATION_NO       Db_DTakoEventsSortField = 2 // 運行NO
+	Db_DTakoEventsSortField_DTAKO_EVENTS_SORT_FIELD_READ_DATE          Db_DTakoEventsSortField = 3 // 読取日
+	Db_DTakoEventsSortField_DTAKO_EVENTS_SORT_FIELD_CAR_CODE           Db_DTakoEventsSortField = 4 // 車輌CD
+	Db_DTakoEventsSortField_DTAKO_EVENTS_SORT_FIELD_DRIVER_CODE1       Db_DTakoEventsSortField = 5 // 乗務員CD1
+	Db_DTakoEventsSortField_DTAKO_EVENTS_SORT_FIELD_TARGET_DRIVER_CODE Db_DTakoEventsSortField = 6 // 対象乗務員CD
+	Db_DTakoEventsSortField_DTAKO_EVENTS_SORT_FIELD_START_DATETIME     Db_DTakoEventsSortField = 7 // 開始日時
+	Db_DTakoEventsSortField_DTAKO_EVENTS_SORT_FIELD_END_DATETIME       Db_DTakoEventsSortField = 8 // 終了日時
+	Db_DTakoEventsSortField_DTAKO_EVENTS_SORT_FIELD_EVENT_CODE         Db_DTakoEventsSortField = 9 // イベントCD
+)
+
+// Enum value maps for Db_DTakoEventsSortField.
+var (
+	Db_DTakoEventsSortField_name = map[int32]string{
+		0: "DTAKO_EVENTS_SORT_FIELD_UNSPECIFIED",
+		1: "DTAKO_EVENTS_SORT_FIELD_ID",
+		2: "DTAKO_EVENTS_SORT_FIELD_OPERATION_NO",
+		3: "DTAKO_EVENTS_SORT_FIELD_READ_DATE",
+		4: "DTAKO_EVENTS_SORT_FIELD_CAR_CODE",
+		5: "DTAKO_EVENTS_SORT_FIELD_DRIVER_CODE1",
+		6: "DTAKO_EVENTS_SORT_FIELD_TARGET_DRIVER_CODE",
+		7: "DTAKO_EVENTS_SORT_FIELD_START_DATETIME",
+		8: "DTAKO_EVENTS_SORT_FIELD_END_DATETIME",
+		9: "DTAKO_EVENTS_SORT_FIELD_EVENT_CODE",
+	}
+	Db_DTakoEventsSortField_value = map[string]int32{
+		"DTAKO_EVENTS_SORT_FIELD_UNSPECIFIED":        0,
+		"DTAKO_EVENTS_SORT_FIELD_ID":                 1,
+		"DTAKO_EVENTS_SORT_FIELD_OPERATION_NO":       2,
+		"DTAKO_EVENTS_SORT_FIELD_READ_DATE":          3,
+		"DTAKO_EVENTS_SORT_FIELD_CAR_CODE":           4,
+		"DTAKO_EVENTS_SORT_FIELD_DRIVER_CODE1":       5,
+		"DTAKO_EVENTS_SORT_FIELD_TARGET_DRIVER_CODE": 6,
+		"DTAKO_EVENTS_SORT_FIELD_START_DATETIME":     7,
+		"DTAKO_EVENTS_SORT_FIELD_END_DATETIME":       8,
+		"DTAKO_EVENTS_SORT_FIELD_EVENT_CODE":         9,
 	}
-	return ""
+)
+
+func (x Db_DTakoEventsSortField) Enum() *Db_DTakoEventsSortField {
+	p := new(Db_DTakoEventsSortField)
+	*p = x
+	return p
 }
 
-func (x *Db_DTakoUriageKeihi) GetEndSrchPlace() string {
-	if x != nil && x.EndSrchPlace != nil {
-		return *x.EndSrchPlace
-	}
-	return ""
+func (x Db_DTakoEventsSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Db_DTakoUriageKeihi) GetManual() bool {
-	if x != nil && x.Manual != nil {
-		return *x.Manual
-	}
-	return false
+func (Db_DTakoEventsSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_db_service_proto_enumTypes[3].Descriptor()
 }
 
-// ETC明細データ
-type Db_ETCMeisai struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	DateFr        *string                `protobuf:"bytes,2,opt,name=date_fr,json=dateFr,proto3,oneof" json:"date_fr,omitempty"`
-	DateTo        string                 `protobuf:"bytes,3,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`               // RFC3339形式推奨 (例: 2025-10-18T00:00:00Z)
-	DateToDate    string                 `protobuf:"bytes,4,opt,name=date_to_date,json=dateToDate,proto3" json:"date_to_date,omitempty"` // YYYY-MM-DD形式 (例: 2025-10-18)
-	IcFr          *string                `protobuf:"bytes,5,opt,name=ic_fr,json=icFr,proto3,oneof" json:"ic_fr,omitempty"`               // 入口IC不明の場合は省略可（実データの22.3%が空）
-	IcTo          string                 `protobuf:"bytes,6,opt,name=ic_to,json=icTo,proto3" json:"ic_to,omitempty"`                     // 出口IC（必須）
-	PriceBf       *int32                 `protobuf:"varint,7,opt,name=price_bf,json=priceBf,proto3,oneof" json:"price_bf,omitempty"`
-	Descount      *int32                 `protobuf:"varint,8,opt,name=descount,proto3,oneof" json:"descount,omitempty"`
-	Price         int32                  `protobuf:"varint,9,opt,name=price,proto3" json:"price,omitempty"`
-	Shashu        int32                  `protobuf:"varint,10,opt,name=shashu,proto3" json:"shashu,omitempty"`
-	CarIdNum      *int32                 `protobuf:"varint,11,opt,name=car_id_num,json=carIdNum,proto3,oneof" json:"car_id_num,omitempty"`
-	EtcNum        string                 `protobuf:"bytes,12,opt,name=etc_num,json=etcNum,proto3" json:"etc_num,omitempty"`
-	Detail        *string                `protobuf:"bytes,13,opt,name=detail,proto3,oneof" json:"detail,omitempty"`
-	Hash          string                 `protobuf:"bytes,14,opt,name=hash,proto3" json:"hash,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (Db_DTakoEventsSortField) Type() protoreflect.EnumType {
+	return &file_db_service_proto_enumTypes[3]
 }
 
-func (x *Db_ETCMeisai) Reset() {
-	*x = Db_ETCMeisai{}
-	mi := &file_db_service_proto_msgTypes[1]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x Db_DTakoEventsSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Db_ETCMeisai) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use Db_DTakoEventsSortField.Descriptor instead.
+func (Db_DTakoEventsSortField) EnumDescriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{3}
 }
 
-func (*Db_ETCMeisai) ProtoMessage() {}
+// DTakoRowsのListでソートできるフィールド（値名の DTAKO_ROWS_SORT_FIELD_ 以降がprotoのフィールド名）
+type Db_DTakoRowsSortField int32
 
-func (x *Db_ETCMeisai) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[1]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	Db_DTakoRowsSortField_DTAKO_ROWS_SORT_FIELD_UNSPECIFIED         Db_DTakoRowsSortField = 0  // 指定不可
+	Db_DTakoRowsSortField_DTAKO_ROWS_SORT_FIELD_ID                  Db_DTakoRowsSortField = 1  // id
+	Db_DTakoRowsSortField_DTAKO_ROWS_SORT_FIELD_OPERATION_NO        Db_DTakoRowsSortField = 2  // 運行NO
+	Db_DTakoRowsSortField_DTAKO_ROWS_SORT_FIELD_READ_DATE           Db_DTakoRowsSortField = 3  // 読取日
+	Db_DTakoRowsSortField_DTAKO_ROWS_SORT_FIELD_OPERATION_DATE      Db_DTakoRowsSortField = 4  // 運行日
+	Db_DTakoRowsSortField_DTAKO_ROWS_SORT_FIELD_CAR_CODE            Db_DTakoRowsSortField = 5  // 車輌CD
+	Db_DTakoRowsSortField_DTAKO_ROWS_SORT_FIELD_DRIVER_CODE1        Db_DTakoRowsSortField = 6  // 乗務員CD1
+	Db_DTakoRowsSortField_DTAKO_ROWS_SORT_FIELD_TARGET_DRIVER_CODE  Db_DTakoRowsSortField = 7  // 対象乗務員CD
+	Db_DTakoRowsSortField_DTAKO_ROWS_SORT_FIELD_START_WORK_DATETIME Db_DTakoRowsSortField = 8  // 出社日時
+	Db_DTakoRowsSortField_DTAKO_ROWS_SORT_FIELD_END_WORK_DATETIME   Db_DTakoRowsSortField = 9  // 退社日時
+	Db_DTakoRowsSortField_DTAKO_ROWS_SORT_FIELD_DEPARTURE_DATETIME  Db_DTakoRowsSortField = 10 // 出庫日時
+	Db_DTakoRowsSortField_DTAKO_ROWS_SORT_FIELD_RETURN_DATETIME     Db_DTakoRowsSortField = 11 // 帰庫日時
+	Db_DTakoRowsSortField_DTAKO_ROWS_SORT_FIELD_TOTAL_DISTANCE      Db_DTakoRowsSortField = 12 // 総走行距離
+)
+
+// Enum value maps for Db_DTakoRowsSortField.
+var (
+	Db_DTakoRowsSortField_name = map[int32]string{
+		0:  "DTAKO_ROWS_SORT_FIELD_UNSPECIFIED",
+		1:  "DTAKO_ROWS_SORT_FIELD_ID",
+		2:  "DTAKO_ROWS_SORT_FIELD_OPERATION_NO",
+		3:  "DTAKO_ROWS_SORT_FIELD_READ_DATE",
+		4:  "DTAKO_ROWS_SORT_FIELD_OPERATION_DATE",
+		5:  "DTAKO_ROWS_SORT_FIELD_CAR_CODE",
+		6:  "DTAKO_ROWS_SORT_FIELD_DRIVER_CODE1",
+		7:  "DTAKO_ROWS_SORT_FIELD_TARGET_DRIVER_CODE",
+		8:  "DTAKO_ROWS_SORT_FIELD_START_WORK_DATETIME",
+		9:  "DTAKO_ROWS_SORT_FIELD_END_WORK_DATETIME",
+		10: "DTAKO_ROWS_SORT_FIELD_DEPARTURE_DATETIME",
+		11: "DTAKO_ROWS_SORT_FIELD_RETURN_DATETIME",
+		12: "DTAKO_ROWS_SORT_FIELD_TOTAL_DISTANCE",
+	}
+	Db_DTakoRowsSortField_value = map[string]int32{
+		"DTAKO_ROWS_SORT_FIELD_UNSPECIFIED":         0,
+		"DTAKO_ROWS_SORT_FIELD_ID":                  1,
+		"DTAKO_ROWS_SORT_FIELD_OPERATION_NO":        2,
+		"DTAKO_ROWS_SORT_FIELD_READ_DATE":           3,
+		"DTAKO_ROWS_SORT_FIELD_OPERATION_DATE":      4,
+		"DTAKO_ROWS_SORT_FIELD_CAR_CODE":            5,
+		"DTAKO_ROWS_SORT_FIELD_DRIVER_CODE1":        6,
+		"DTAKO_ROWS_SORT_FIELD_TARGET_DRIVER_CODE":  7,
+		"DTAKO_ROWS_SORT_FIELD_START_WORK_DATETIME": 8,
+		"DTAKO_ROWS_SORT_FIELD_END_WORK_DATETIME":   9,
+		"DTAKO_ROWS_SORT_FIELD_DEPARTURE_DATETIME":  10,
+		"DTAKO_ROWS_SORT_FIELD_RETURN_DATETIME":     11,
+		"DTAKO_ROWS_SORT_FIELD_TOTAL_DISTANCE":      12,
 	}
-	return mi.MessageOf(x)
-}
+)
 
-// Deprecated: Use Db_ETCMeisai.ProtoReflect.Descriptor instead.
-func (*Db_ETCMeisai) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{1}
+func (x Db_DTakoRowsSortField) Enum() *Db_DTakoRowsSortField {
+	p := new(Db_DTakoRowsSortField)
+	*p = x
+	return p
 }
 
-func (x *Db_ETCMeisai) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+func (x Db_DTakoRowsSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Db_ETCMeisai) GetDateFr() string {
-	if x != nil && x.DateFr != nil {
-		return *x.DateFr
-	}
-	return ""
+func (Db_DTakoRowsSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_db_service_proto_enumTypes[4].Descriptor()
 }
 
-func (x *Db_ETCMeisai) GetDateTo() string {
-	if x != nil {
-		return x.DateTo
-	}
-	return ""
+func (Db_DTakoRowsSortField) Type() protoreflect.EnumType {
+	return &file_db_service_proto_enumTypes[4]
 }
 
-func (x *Db_ETCMeisai) GetDateToDate() string {
-	if x != nil {
-		return x.DateToDate
-	}
-	return ""
+func (x Db_DTakoRowsSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Db_ETCMeisai) GetIcFr() string {
-	if x != nil && x.IcFr != nil {
-		return *x.IcFr
-	}
-	return ""
+// Deprecated: Use Db_DTakoRowsSortField.Descriptor instead.
+func (Db_DTakoRowsSortField) EnumDescriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *Db_ETCMeisai) GetIcTo() string {
-	if x != nil {
-		return x.IcTo
+// CarsのListでソートできるフィールド（値名の CARS_SORT_FIELD_ 以降がprotoのフィールド名）
+type Db_CarsSortField int32
+
+const (
+	Db_CarsSortField_CARS_SORT_FIELD_UNSPECIFIED       Db_CarsSortField = 0 // 指定不可
+	Db_CarsSortField_CARS_SORT_FIELD_ID                Db_CarsSortField = 1 // id
+	Db_CarsSortField_CARS_SORT_FIELD_ID4               Db_CarsSortField = 2 // id4
+	Db_CarsSortField_CARS_SORT_FIELD_NAME              Db_CarsSortField = 3 // name
+	Db_CarsSortField_CARS_SORT_FIELD_REG_DATE          Db_CarsSortField = 4 // reg_date
+	Db_CarsSortField_CARS_SORT_FIELD_NEXT_INSPECT_DATE Db_CarsSortField = 5 // next_inspect_date
+	Db_CarsSortField_CARS_SORT_FIELD_SCRAP_DATE        Db_CarsSortField = 6 // scrap_date
+	Db_CarsSortField_CARS_SORT_FIELD_BUMON_CODE_ID     Db_CarsSortField = 7 // bumon_code_id
+	Db_CarsSortField_CARS_SORT_FIELD_DRIVER_ID         Db_CarsSortField = 8 // driver_id
+)
+
+// Enum value maps for Db_CarsSortField.
+var (
+	Db_CarsSortField_name = map[int32]string{
+		0: "CARS_SORT_FIELD_UNSPECIFIED",
+		1: "CARS_SORT_FIELD_ID",
+		2: "CARS_SORT_FIELD_ID4",
+		3: "CARS_SORT_FIELD_NAME",
+		4: "CARS_SORT_FIELD_REG_DATE",
+		5: "CARS_SORT_FIELD_NEXT_INSPECT_DATE",
+		6: "CARS_SORT_FIELD_SCRAP_DATE",
+		7: "CARS_SORT_FIELD_BUMON_CODE_ID",
+		8: "CARS_SORT_FIELD_DRIVER_ID",
+	}
+	Db_CarsSortField_value = map[string]int32{
+		"CARS_SORT_FIELD_UNSPECIFIED":       0,
+		"CARS_SORT_FIELD_ID":                1,
+		"CARS_SORT_FIELD_ID4":               2,
+		"CARS_SORT_FIELD_NAME":              3,
+		"CARS_SORT_FIELD_REG_DATE":          4,
+		"CARS_SORT_FIELD_NEXT_INSPECT_DATE": 5,
+		"CARS_SORT_FIELD_SCRAP_DATE":        6,
+		"CARS_SORT_FIELD_BUMON_CODE_ID":     7,
+		"CARS_SORT_FIELD_DRIVER_ID":         8,
 	}
-	return ""
+)
+
+func (x Db_CarsSortField) Enum() *Db_CarsSortField {
+	p := new(Db_CarsSortField)
+	*p = x
+	return p
 }
 
-func (x *Db_ETCMeisai) GetPriceBf() int32 {
-	if x != nil && x.PriceBf != nil {
-		return *x.PriceBf
-	}
-	return 0
+func (x Db_CarsSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Db_ETCMeisai) GetDescount() int32 {
-	if x != nil && x.Descount != nil {
-		return *x.Descount
-	}
-	return 0
+func (Db_CarsSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_db_service_proto_enumTypes[5].Descriptor()
 }
 
-func (x *Db_ETCMeisai) GetPrice() int32 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
+func (Db_CarsSortField) Type() protoreflect.EnumType {
+	return &file_db_service_proto_enumTypes[5]
 }
 
-func (x *Db_ETCMeisai) GetShashu() int32 {
-	if x != nil {
-		return x.Shashu
-	}
-	return 0
+func (x Db_CarsSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Db_ETCMeisai) GetCarIdNum() int32 {
-	if x != nil && x.CarIdNum != nil {
-		return *x.CarIdNum
-	}
-	return 0
+// Deprecated: Use Db_CarsSortField.Descriptor instead.
+func (Db_CarsSortField) EnumDescriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Db_ETCMeisai) GetEtcNum() string {
-	if x != nil {
-		return x.EtcNum
+// DriversのListでソートできるフィールド（値名の DRIVERS_SORT_FIELD_ 以降がprotoのフィールド名）
+type Db_DriversSortField int32
+
+const (
+	Db_DriversSortField_DRIVERS_SORT_FIELD_UNSPECIFIED Db_DriversSortField = 0 // 指定不可
+	Db_DriversSortField_DRIVERS_SORT_FIELD_ID          Db_DriversSortField = 1 // id
+	Db_DriversSortField_DRIVERS_SORT_FIELD_NAME        Db_DriversSortField = 2 // name
+	Db_DriversSortField_DRIVERS_SORT_FIELD_SHAIN_R     Db_DriversSortField = 3 // 社員R
+	Db_DriversSortField_DRIVERS_SORT_FIELD_BUMON       Db_DriversSortField = 4 // bumon
+	Db_DriversSortField_DRIVERS_SORT_FIELD_JOIN_DATE   Db_DriversSortField = 5 // join_date
+	Db_DriversSortField_DRIVERS_SORT_FIELD_RETIRE_DATE Db_DriversSortField = 6 // retire_date
+)
+
+// Enum value maps for Db_DriversSortField.
+var (
+	Db_DriversSortField_name = map[int32]string{
+		0: "DRIVERS_SORT_FIELD_UNSPECIFIED",
+		1: "DRIVERS_SORT_FIELD_ID",
+		2: "DRIVERS_SORT_FIELD_NAME",
+		3: "DRIVERS_SORT_FIELD_SHAIN_R",
+		4: "DRIVERS_SORT_FIELD_BUMON",
+		5: "DRIVERS_SORT_FIELD_JOIN_DATE",
+		6: "DRIVERS_SORT_FIELD_RETIRE_DATE",
+	}
+	Db_DriversSortField_value = map[string]int32{
+		"DRIVERS_SORT_FIELD_UNSPECIFIED": 0,
+		"DRIVERS_SORT_FIELD_ID":          1,
+		"DRIVERS_SORT_FIELD_NAME":        2,
+		"DRIVERS_SORT_FIELD_SHAIN_R":     3,
+		"DRIVERS_SORT_FIELD_BUMON":       4,
+		"DRIVERS_SORT_FIELD_JOIN_DATE":   5,
+		"DRIVERS_SORT_FIELD_RETIRE_DATE": 6,
 	}
-	return ""
+)
+
+func (x Db_DriversSortField) Enum() *Db_DriversSortField {
+	p := new(Db_DriversSortField)
+	*p = x
+	return p
 }
 
-func (x *Db_ETCMeisai) GetDetail() string {
-	if x != nil && x.Detail != nil {
-		return *x.Detail
-	}
-	return ""
+func (x Db_DriversSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Db_ETCMeisai) GetHash() string {
-	if x != nil {
-		return x.Hash
-	}
-	return ""
+func (Db_DriversSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_db_service_proto_enumTypes[6].Descriptor()
 }
 
-// フェリー運行データ
-type Db_DTakoFerryRows struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Id                int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UnkoNo            string                 `protobuf:"bytes,2,opt,name=unko_no,json=unkoNo,proto3" json:"unko_no,omitempty"`                                       // 運行NO
-	UnkoDate          string                 `protobuf:"bytes,3,opt,name=unko_date,json=unkoDate,proto3" json:"unko_date,omitempty"`                                 // 運行日
-	YomitoriDate      string                 `protobuf:"bytes,4,opt,name=yomitori_date,json=yomitoriDate,proto3" json:"yomitori_date,omitempty"`                     // 読取日
-	JigyoshoCd        int32                  `protobuf:"varint,5,opt,name=jigyosho_cd,json=jigyoshoCd,proto3" json:"jigyosho_cd,omitempty"`                          // 事業所CD
-	JigyoshoName      string                 `protobuf:"bytes,6,opt,name=jigyosho_name,json=jigyoshoName,proto3" json:"jigyosho_name,omitempty"`                     // 事業所名
-	SharyoCd          int32                  `protobuf:"varint,7,opt,name=sharyo_cd,json=sharyoCd,proto3" json:"sharyo_cd,omitempty"`                                // 車輌CD
-	SharyoName        string                 `protobuf:"bytes,8,opt,name=sharyo_name,json=sharyoName,proto3" json:"sharyo_name,omitempty"`                           // 車輌名
-	JomuinCd1         int32                  `protobuf:"varint,9,opt,name=jomuin_cd1,json=jomuinCd1,proto3" json:"jomuin_cd1,omitempty"`                             // 乗務員CD1
-	JomuinName1       string                 `protobuf:"bytes,10,opt,name=jomuin_name1,json=jomuinName1,proto3" json:"jomuin_name1,omitempty"`                       // 乗務員名１
-	TaishoJomuinKbn   int32                  `protobuf:"varint,11,opt,name=taisho_jomuin_kbn,json=taishoJomuinKbn,proto3" json:"taisho_jomuin_kbn,omitempty"`        // 対象乗務員区分
-	KaishiDatetime    string                 `protobuf:"bytes,12,opt,name=kaishi_datetime,json=kaishiDatetime,proto3" json:"kaishi_datetime,omitempty"`              // 開始日時
-	ShuryoDatetime    string                 `protobuf:"bytes,13,opt,name=shuryo_datetime,json=shuryoDatetime,proto3" json:"shuryo_datetime,omitempty"`              // 終了日時
-	FerryCompanyCd    int32                  `protobuf:"varint,14,opt,name=ferry_company_cd,json=ferryCompanyCd,proto3" json:"ferry_company_cd,omitempty"`           // フェリー会社CD
-	FerryCompanyName  string                 `protobuf:"bytes,15,opt,name=ferry_company_name,json=ferryCompanyName,proto3" json:"ferry_company_name,omitempty"`      // フェリー会社名
-	NoribaCd          int32                  `protobuf:"varint,16,opt,name=noriba_cd,json=noribaCd,proto3" json:"noriba_cd,omitempty"`                               // 乗場CD
-	NoribaName        string                 `protobuf:"bytes,17,opt,name=noriba_name,json=noribaName,proto3" json:"noriba_name,omitempty"`                          // 乗場名
-	Bin               string                 `protobuf:"bytes,18,opt,name=bin,proto3" json:"bin,omitempty"`                                                          // 便
-	OribaCd           int32                  `protobuf:"varint,19,opt,name=oriba_cd,json=oribaCd,proto3" json:"oriba_cd,omitempty"`                                  // 降場CD
-	OribaName         string                 `protobuf:"bytes,20,opt,name=oriba_name,json=oribaName,proto3" json:"oriba_name,omitempty"`                             // 降場名
-	SeisanKbn         int32                  `protobuf:"varint,21,opt,name=seisan_kbn,json=seisanKbn,proto3" json:"seisan_kbn,omitempty"`                            // 精算区分
-	SeisanKbnName     string                 `protobuf:"bytes,22,opt,name=seisan_kbn_name,json=seisanKbnName,proto3" json:"seisan_kbn_name,omitempty"`               // 精算区分名
-	HyojunRyokin      int32                  `protobuf:"varint,23,opt,name=hyojun_ryokin,json=hyojunRyokin,proto3" json:"hyojun_ryokin,omitempty"`                   // 標準料金
-	KeiyakuRyokin     int32                  `protobuf:"varint,24,opt,name=keiyaku_ryokin,json=keiyakuRyokin,proto3" json:"keiyaku_ryokin,omitempty"`                // 契約料金
-	KosoShashuKbn     int32                  `protobuf:"varint,25,opt,name=koso_shashu_kbn,json=kosoShashuKbn,proto3" json:"koso_shashu_kbn,omitempty"`              // 航送車種区分
-	KosoShashuKbnName string                 `protobuf:"bytes,26,opt,name=koso_shashu_kbn_name,json=kosoShashuKbnName,proto3" json:"koso_shashu_kbn_name,omitempty"` // 航送車種区分名
-	MinashiKyori      int32                  `protobuf:"varint,27,opt,name=minashi_kyori,json=minashiKyori,proto3" json:"minashi_kyori,omitempty"`                   // 見なし距離
-	FerrySrch         *string                `protobuf:"bytes,28,opt,name=ferry_srch,json=ferrySrch,proto3,oneof" json:"ferry_srch,omitempty"`                       // ferry_srch
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (Db_DriversSortField) Type() protoreflect.EnumType {
+	return &file_db_service_proto_enumTypes[6]
 }
 
-func (x *Db_DTakoFerryRows) Reset() {
-	*x = Db_DTakoFerryRows{}
-	mi := &file_db_service_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x Db_DriversSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Db_DTakoFerryRows) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use Db_DriversSortField.Descriptor instead.
+func (Db_DriversSortField) EnumDescriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (*Db_DTakoFerryRows) ProtoMessage() {}
+// db_UntenNippoMeisaiBumonField 部門で絞り込む列
+type Db_UntenNippoMeisaiBumonField int32
 
-func (x *Db_DTakoFerryRows) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[2]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	Db_UntenNippoMeisaiBumonField_UNTEN_NIPPO_MEISAI_BUMON_FIELD_UNSPECIFIED Db_UntenNippoMeisaiBumonField = 0 // 受注部門・稼動部門のいずれか
+	Db_UntenNippoMeisaiBumonField_UNTEN_NIPPO_MEISAI_BUMON_FIELD_JUCHU       Db_UntenNippoMeisaiBumonField = 1 // 受注部門
+	Db_UntenNippoMeisaiBumonField_UNTEN_NIPPO_MEISAI_BUMON_FIELD_KADO        Db_UntenNippoMeisaiBumonField = 2 // 稼動部門
+)
+
+// Enum value maps for Db_UntenNippoMeisaiBumonField.
+var (
+	Db_UntenNippoMeisaiBumonField_name = map[int32]string{
+		0: "UNTEN_NIPPO_MEISAI_BUMON_FIELD_UNSPECIFIED",
+		1: "UNTEN_NIPPO_MEISAI_BUMON_FIELD_JUCHU",
+		2: "UNTEN_NIPPO_MEISAI_BUMON_FIELD_KADO",
 	}
-	return mi.MessageOf(x)
-}
+	Db_UntenNippoMeisaiBumonField_value = map[string]int32{
+		"UNTEN_NIPPO_MEISAI_BUMON_FIELD_UNSPECIFIED": 0,
+		"UNTEN_NIPPO_MEISAI_BUMON_FIELD_JUCHU":       1,
+		"UNTEN_NIPPO_MEISAI_BUMON_FIELD_KADO":        2,
+	}
+)
 
-// Deprecated: Use Db_DTakoFerryRows.ProtoReflect.Descriptor instead.
-func (*Db_DTakoFerryRows) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{2}
+func (x Db_UntenNippoMeisaiBumonField) Enum() *Db_UntenNippoMeisaiBumonField {
+	p := new(Db_UntenNippoMeisaiBumonField)
+	*p = x
+	return p
 }
 
-func (x *Db_DTakoFerryRows) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+func (x Db_UntenNippoMeisaiBumonField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Db_DTakoFerryRows) GetUnkoNo() string {
-	if x != nil {
-		return x.UnkoNo
-	}
-	return ""
+func (Db_UntenNippoMeisaiBumonField) Descriptor() protoreflect.EnumDescriptor {
+	return file_db_service_proto_enumTypes[7].Descriptor()
 }
 
-func (x *Db_DTakoFerryRows) GetUnkoDate() string {
-	if x != nil {
-		return x.UnkoDate
-	}
-	return ""
+func (Db_UntenNippoMeisaiBumonField) Type() protoreflect.EnumType {
+	return &file_db_service_proto_enumTypes[7]
 }
 
-func (x *Db_DTakoFerryRows) GetYomitoriDate() string {
-	if x != nil {
-		return x.YomitoriDate
-	}
-	return ""
+func (x Db_UntenNippoMeisaiBumonField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Db_DTakoFerryRows) GetJigyoshoCd() int32 {
-	if x != nil {
-		return x.JigyoshoCd
-	}
-	return 0
+// Deprecated: Use Db_UntenNippoMeisaiBumonField.Descriptor instead.
+func (Db_UntenNippoMeisaiBumonField) EnumDescriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Db_DTakoFerryRows) GetJigyoshoName() string {
-	if x != nil {
-		return x.JigyoshoName
+// UntenNippoMeisaiのListでソートできるフィールド（値名の UNTEN_NIPPO_MEISAI_SORT_FIELD_ 以降がprotoのフィールド名）
+type Db_UntenNippoMeisaiSortField int32
+
+const (
+	Db_UntenNippoMeisaiSortField_UNTEN_NIPPO_MEISAI_SORT_FIELD_UNSPECIFIED     Db_UntenNippoMeisaiSortField = 0  // 指定不可
+	Db_UntenNippoMeisaiSortField_UNTEN_NIPPO_MEISAI_SORT_FIELD_NIPPO_K         Db_UntenNippoMeisaiSortField = 1  // 日報K
+	Db_UntenNippoMeisaiSortField_UNTEN_NIPPO_MEISAI_SORT_FIELD_HAISHA_K        Db_UntenNippoMeisaiSortField = 2  // 配車K
+	Db_UntenNippoMeisaiSortField_UNTEN_NIPPO_MEISAI_SORT_FIELD_SHARYO_C        Db_UntenNippoMeisaiSortField = 3  // 車輌C
+	Db_UntenNippoMeisaiSortField_UNTEN_NIPPO_MEISAI_SORT_FIELD_UNKO_NENGAPPI   Db_UntenNippoMeisaiSortField = 4  // 運行年月日
+	Db_UntenNippoMeisaiSortField_UNTEN_NIPPO_MEISAI_SORT_FIELD_UNTENSHU_C      Db_UntenNippoMeisaiSortField = 5  // 運転手C
+	Db_UntenNippoMeisaiSortField_UNTEN_NIPPO_MEISAI_SORT_FIELD_JUCHU_NENGAPPI  Db_UntenNippoMeisaiSortField = 6  // 受注年月日
+	Db_UntenNippoMeisaiSortField_UNTEN_NIPPO_MEISAI_SORT_FIELD_KANRI_NENGAPPI  Db_UntenNippoMeisaiSortField = 7  // 管理年月日
+	Db_UntenNippoMeisaiSortField_UNTEN_NIPPO_MEISAI_SORT_FIELD_TOKUISAKI_C     Db_UntenNippoMeisaiSortField = 8  // 得意先C
+	Db_UntenNippoMeisaiSortField_UNTEN_NIPPO_MEISAI_SORT_FIELD_DENPYO_NO       Db_UntenNippoMeisaiSortField = 9  // 伝票NO
+	Db_UntenNippoMeisaiSortField_UNTEN_NIPPO_MEISAI_SORT_FIELD_URIAGE_NENGAPPI Db_UntenNippoMeisaiSortField = 10 // 売上年月日
+)
+
+// Enum value maps for Db_UntenNippoMeisaiSortField.
+var (
+	Db_UntenNippoMeisaiSortField_name = map[int32]string{
+		0:  "UNTEN_NIPPO_MEISAI_SORT_FIELD_UNSPECIFIED",
+		1:  "UNTEN_NIPPO_MEISAI_SORT_FIELD_NIPPO_K",
+		2:  "UNTEN_NIPPO_MEISAI_SORT_FIELD_HAISHA_K",
+		3:  "UNTEN_NIPPO_MEISAI_SORT_FIELD_SHARYO_C",
+		4:  "UNTEN_NIPPO_MEISAI_SORT_FIELD_UNKO_NENGAPPI",
+		5:  "UNTEN_NIPPO_MEISAI_SORT_FIELD_UNTENSHU_C",
+		6:  "UNTEN_NIPPO_MEISAI_SORT_FIELD_JUCHU_NENGAPPI",
+		7:  "UNTEN_NIPPO_MEISAI_SORT_FIELD_KANRI_NENGAPPI",
+		8:  "UNTEN_NIPPO_MEISAI_SORT_FIELD_TOKUISAKI_C",
+		9:  "UNTEN_NIPPO_MEISAI_SORT_FIELD_DENPYO_NO",
+		10: "UNTEN_NIPPO_MEISAI_SORT_FIELD_URIAGE_NENGAPPI",
+	}
+	Db_UntenNippoMeisaiSortField_value = map[string]int32{
+		"UNTEN_NIPPO_MEISAI_SORT_FIELD_UNSPECIFIED":     0,
+		"UNTEN_NIPPO_MEISAI_SORT_FIELD_NIPPO_K":         1,
+		"UNTEN_NIPPO_MEISAI_SORT_FIELD_HAISHA_K":        2,
+		"UNTEN_NIPPO_MEISAI_SORT_FIELD_SHARYO_C":        3,
+		"UNTEN_NIPPO_MEISAI_SORT_FIELD_UNKO_NENGAPPI":   4,
+		"UNTEN_NIPPO_MEISAI_SORT_FIELD_UNTENSHU_C":      5,
+		"UNTEN_NIPPO_MEISAI_SORT_FIELD_JUCHU_NENGAPPI":  6,
+		"UNTEN_NIPPO_MEISAI_SORT_FIELD_KANRI_NENGAPPI":  7,
+		"UNTEN_NIPPO_MEISAI_SORT_FIELD_TOKUISAKI_C":     8,
+		"UNTEN_NIPPO_MEISAI_SORT_FIELD_DENPYO_NO":       9,
+		"UNTEN_NIPPO_MEISAI_SORT_FIELD_URIAGE_NENGAPPI": 10,
 	}
-	return ""
+)
+
+func (x Db_UntenNippoMeisaiSortField) Enum() *Db_UntenNippoMeisaiSortField {
+	p := new(Db_UntenNippoMeisaiSortField)
+	*p = x
+	return p
 }
 
-func (x *Db_DTakoFerryRows) GetSharyoCd() int32 {
-	if x != nil {
-		return x.SharyoCd
-	}
-	return 0
+func (x Db_UntenNippoMeisaiSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Db_DTakoFerryRows) GetSharyoName() string {
-	if x != nil {
-		return x.SharyoName
-	}
-	return ""
+func (Db_UntenNippoMeisaiSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_db_service_proto_enumTypes[8].Descriptor()
 }
 
-func (x *Db_DTakoFerryRows) GetJomuinCd1() int32 {
-	if x != nil {
-		return x.JomuinCd1
-	}
-	return 0
+func (Db_UntenNippoMeisaiSortField) Type() protoreflect.EnumType {
+	return &file_db_service_proto_enumTypes[8]
 }
 
-func (x *Db_DTakoFerryRows) GetJomuinName1() string {
-	if x != nil {
-		return x.JomuinName1
-	}
-	return ""
+func (x Db_UntenNippoMeisaiSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Db_DTakoFerryRows) GetTaishoJomuinKbn() int32 {
-	if x != nil {
-		return x.TaishoJomuinKbn
-	}
-	return 0
+// Deprecated: Use Db_UntenNippoMeisaiSortField.Descriptor instead.
+func (Db_UntenNippoMeisaiSortField) EnumDescriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Db_DTakoFerryRows) GetKaishiDatetime() string {
-	if x != nil {
-		return x.KaishiDatetime
+// ShainMasterのListでソートできるフィールド（値名の SHAIN_MASTER_SORT_FIELD_ 以降がprotoのフィールド名）
+type Db_ShainMasterSortField int32
+
+const (
+	Db_ShainMasterSortField_SHAIN_MASTER_SORT_FIELD_UNSPECIFIED       Db_ShainMasterSortField = 0 // 指定不可
+	Db_ShainMasterSortField_SHAIN_MASTER_SORT_FIELD_SHAIN_C           Db_ShainMasterSortField = 1 // 社員C
+	Db_ShainMasterSortField_SHAIN_MASTER_SORT_FIELD_SHAIN_N           Db_ShainMasterSortField = 2 // 社員N
+	Db_ShainMasterSortField_SHAIN_MASTER_SORT_FIELD_SHAIN_F           Db_ShainMasterSortField = 3 // 社員F
+	Db_ShainMasterSortField_SHAIN_MASTER_SORT_FIELD_SHAIN_K           Db_ShainMasterSortField = 4 // 社員K
+	Db_ShainMasterSortField_SHAIN_MASTER_SORT_FIELD_NYUSHA_NENGAPPI   Db_ShainMasterSortField = 5 // 入社年月日
+	Db_ShainMasterSortField_SHAIN_MASTER_SORT_FIELD_TAISHOKU_NENGAPPI Db_ShainMasterSortField = 6 // 退職年月日
+	Db_ShainMasterSortField_SHAIN_MASTER_SORT_FIELD_BUMON_C           Db_ShainMasterSortField = 7 // 部門C
+)
+
+// Enum value maps for Db_ShainMasterSortField.
+var (
+	Db_ShainMasterSortField_name = map[int32]string{
+		0: "SHAIN_MASTER_SORT_FIELD_UNSPECIFIED",
+		1: "SHAIN_MASTER_SORT_FIELD_SHAIN_C",
+		2: "SHAIN_MASTER_SORT_FIELD_SHAIN_N",
+		3: "SHAIN_MASTER_SORT_FIELD_SHAIN_F",
+		4: "SHAIN_MASTER_SORT_FIELD_SHAIN_K",
+		5: "SHAIN_MASTER_SORT_FIELD_NYUSHA_NENGAPPI",
+		6: "SHAIN_MASTER_SORT_FIELD_TAISHOKU_NENGAPPI",
+		7: "SHAIN_MASTER_SORT_FIELD_BUMON_C",
+	}
+	Db_ShainMasterSortField_value = map[string]int32{
+		"SHAIN_MASTER_SORT_FIELD_UNSPECIFIED":       0,
+		"SHAIN_MASTER_SORT_FIELD_SHAIN_C":           1,
+		"SHAIN_MASTER_SORT_FIELD_SHAIN_N":           2,
+		"SHAIN_MASTER_SORT_FIELD_SHAIN_F":           3,
+		"SHAIN_MASTER_SORT_FIELD_SHAIN_K":           4,
+		"SHAIN_MASTER_SORT_FIELD_NYUSHA_NENGAPPI":   5,
+		"SHAIN_MASTER_SORT_FIELD_TAISHOKU_NENGAPPI": 6,
+		"SHAIN_MASTER_SORT_FIELD_BUMON_C":           7,
 	}
-	return ""
+)
+
+func (x Db_ShainMasterSortField) Enum() *Db_ShainMasterSortField {
+	p := new(Db_ShainMasterSortField)
+	*p = x
+	return p
 }
 
-func (x *Db_DTakoFerryRows) GetShuryoDatetime() string {
-	if x != nil {
-		return x.ShuryoDatetime
-	}
-	return ""
+func (x Db_ShainMasterSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Db_DTakoFerryRows) GetFerryCompanyCd() int32 {
-	if x != nil {
-		return x.FerryCompanyCd
-	}
-	return 0
+func (Db_ShainMasterSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_db_service_proto_enumTypes[9].Descriptor()
 }
 
-func (x *Db_DTakoFerryRows) GetFerryCompanyName() string {
-	if x != nil {
-		return x.FerryCompanyName
-	}
-	return ""
+func (Db_ShainMasterSortField) Type() protoreflect.EnumType {
+	return &file_db_service_proto_enumTypes[9]
 }
 
-func (x *Db_DTakoFerryRows) GetNoribaCd() int32 {
-	if x != nil {
-		return x.NoribaCd
-	}
-	return 0
+func (x Db_ShainMasterSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Db_DTakoFerryRows) GetNoribaName() string {
-	if x != nil {
-		return x.NoribaName
-	}
-	return ""
+// Deprecated: Use Db_ShainMasterSortField.Descriptor instead.
+func (Db_ShainMasterSortField) EnumDescriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Db_DTakoFerryRows) GetBin() string {
-	if x != nil {
-		return x.Bin
+// ChiikiMasterのListでソートできるフィールド（値名の CHIIKI_MASTER_SORT_FIELD_ 以降がprotoのフィールド名）
+type Db_ChiikiMasterSortField int32
+
+const (
+	Db_ChiikiMasterSortField_CHIIKI_MASTER_SORT_FIELD_UNSPECIFIED Db_ChiikiMasterSortField = 0 // 指定不可
+	Db_ChiikiMasterSortField_CHIIKI_MASTER_SORT_FIELD_CHIIKI_C    Db_ChiikiMasterSortField = 1 // 地域C
+	Db_ChiikiMasterSortField_CHIIKI_MASTER_SORT_FIELD_CHIIKI_N    Db_ChiikiMasterSortField = 2 // 地域N
+	Db_ChiikiMasterSortField_CHIIKI_MASTER_SORT_FIELD_CHIIKI_F    Db_ChiikiMasterSortField = 3 // 地域F
+)
+
+// Enum value maps for Db_ChiikiMasterSortField.
+var (
+	Db_ChiikiMasterSortField_name = map[int32]string{
+		0: "CHIIKI_MASTER_SORT_FIELD_UNSPECIFIED",
+		1: "CHIIKI_MASTER_SORT_FIELD_CHIIKI_C",
+		2: "CHIIKI_MASTER_SORT_FIELD_CHIIKI_N",
+		3: "CHIIKI_MASTER_SORT_FIELD_CHIIKI_F",
+	}
+	Db_ChiikiMasterSortField_value = map[string]int32{
+		"CHIIKI_MASTER_SORT_FIELD_UNSPECIFIED": 0,
+		"CHIIKI_MASTER_SORT_FIELD_CHIIKI_C":    1,
+		"CHIIKI_MASTER_SORT_FIELD_CHIIKI_N":    2,
+		"CHIIKI_MASTER_SORT_FIELD_CHIIKI_F":    3,
 	}
-	return ""
+)
+
+func (x Db_ChiikiMasterSortField) Enum() *Db_ChiikiMasterSortField {
+	p := new(Db_ChiikiMasterSortField)
+	*p = x
+	return p
 }
 
-func (x *Db_DTakoFerryRows) GetOribaCd() int32 {
-	if x != nil {
-		return x.OribaCd
-	}
-	return 0
+func (x Db_ChiikiMasterSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Db_DTakoFerryRows) GetOribaName() string {
-	if x != nil {
-		return x.OribaName
-	}
-	return ""
+func (Db_ChiikiMasterSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_db_service_proto_enumTypes[10].Descriptor()
 }
 
-func (x *Db_DTakoFerryRows) GetSeisanKbn() int32 {
-	if x != nil {
-		return x.SeisanKbn
-	}
-	return 0
+func (Db_ChiikiMasterSortField) Type() protoreflect.EnumType {
+	return &file_db_service_proto_enumTypes[10]
 }
 
-func (x *Db_DTakoFerryRows) GetSeisanKbnName() string {
-	if x != nil {
-		return x.SeisanKbnName
-	}
-	return ""
+func (x Db_ChiikiMasterSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Db_DTakoFerryRows) GetHyojunRyokin() int32 {
-	if x != nil {
-		return x.HyojunRyokin
-	}
-	return 0
+// Deprecated: Use Db_ChiikiMasterSortField.Descriptor instead.
+func (Db_ChiikiMasterSortField) EnumDescriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Db_DTakoFerryRows) GetKeiyakuRyokin() int32 {
-	if x != nil {
-		return x.KeiyakuRyokin
+// ChikuMasterのListでソートできるフィールド（値名の CHIKU_MASTER_SORT_FIELD_ 以降がprotoのフィールド名）
+type Db_ChikuMasterSortField int32
+
+const (
+	Db_ChikuMasterSortField_CHIKU_MASTER_SORT_FIELD_UNSPECIFIED Db_ChikuMasterSortField = 0 // 指定不可
+	Db_ChikuMasterSortField_CHIKU_MASTER_SORT_FIELD_CHIKU_C     Db_ChikuMasterSortField = 1 // 地区C
+	Db_ChikuMasterSortField_CHIKU_MASTER_SORT_FIELD_CHIKU_N     Db_ChikuMasterSortField = 2 // 地区N
+	Db_ChikuMasterSortField_CHIKU_MASTER_SORT_FIELD_CHIKU_F     Db_ChikuMasterSortField = 3 // 地区F
+	Db_ChikuMasterSortField_CHIKU_MASTER_SORT_FIELD_CHIIKI_C    Db_ChikuMasterSortField = 4 // 地域C
+)
+
+// Enum value maps for Db_ChikuMasterSortField.
+var (
+	Db_ChikuMasterSortField_name = map[int32]string{
+		0: "CHIKU_MASTER_SORT_FIELD_UNSPECIFIED",
+		1: "CHIKU_MASTER_SORT_FIELD_CHIKU_C",
+		2: "CHIKU_MASTER_SORT_FIELD_CHIKU_N",
+		3: "CHIKU_MASTER_SORT_FIELD_CHIKU_F",
+		4: "CHIKU_MASTER_SORT_FIELD_CHIIKI_C",
+	}
+	Db_ChikuMasterSortField_value = map[string]int32{
+		"CHIKU_MASTER_SORT_FIELD_UNSPECIFIED": 0,
+		"CHIKU_MASTER_SORT_FIELD_CHIKU_C":     1,
+		"CHIKU_MASTER_SORT_FIELD_CHIKU_N":     2,
+		"CHIKU_MASTER_SORT_FIELD_CHIKU_F":     3,
+		"CHIKU_MASTER_SORT_FIELD_CHIIKI_C":    4,
 	}
-	return 0
+)
+
+func (x Db_ChikuMasterSortField) Enum() *Db_ChikuMasterSortField {
+	p := new(Db_ChikuMasterSortField)
+	*p = x
+	return p
 }
 
-func (x *Db_DTakoFerryRows) GetKosoShashuKbn() int32 {
-	if x != nil {
-		return x.KosoShashuKbn
-	}
-	return 0
+func (x Db_ChikuMasterSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Db_DTakoFerryRows) GetKosoShashuKbnName() string {
-	if x != nil {
-		return x.KosoShashuKbnName
-	}
-	return ""
+func (Db_ChikuMasterSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_db_service_proto_enumTypes[11].Descriptor()
 }
 
-func (x *Db_DTakoFerryRows) GetMinashiKyori() int32 {
-	if x != nil {
-		return x.MinashiKyori
-	}
-	return 0
+func (Db_ChikuMasterSortField) Type() protoreflect.EnumType {
+	return &file_db_service_proto_enumTypes[11]
 }
 
-func (x *Db_DTakoFerryRows) GetFerrySrch() string {
-	if x != nil && x.FerrySrch != nil {
-		return *x.FerrySrch
-	}
-	return ""
+func (x Db_ChikuMasterSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-// DTakoUriageKeihi用
-type Db_CreateDTakoUriageKeihiRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	DtakoUriageKeihi *Db_DTakoUriageKeihi   `protobuf:"bytes,1,opt,name=dtako_uriage_keihi,json=dtakoUriageKeihi,proto3" json:"dtako_uriage_keihi,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// Deprecated: Use Db_ChikuMasterSortField.Descriptor instead.
+func (Db_ChikuMasterSortField) EnumDescriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *Db_CreateDTakoUriageKeihiRequest) Reset() {
-	*x = Db_CreateDTakoUriageKeihiRequest{}
-	mi := &file_db_service_proto_msgTypes[3]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+// TokuisakiMasterのListでソートできるフィールド（値名の TOKUISAKI_MASTER_SORT_FIELD_ 以降がprotoのフィールド名）
+type Db_TokuisakiMasterSortField int32
+
+const (
+	Db_TokuisakiMasterSortField_TOKUISAKI_MASTER_SORT_FIELD_UNSPECIFIED Db_TokuisakiMasterSortField = 0 // 指定不可
+	Db_TokuisakiMasterSortField_TOKUISAKI_MASTER_SORT_FIELD_TOKUISAKI_C Db_TokuisakiMasterSortField = 1 // 得意先C
+	Db_TokuisakiMasterSortField_TOKUISAKI_MASTER_SORT_FIELD_TOKUISAKI_H Db_TokuisakiMasterSortField = 2 // 得意先H
+	Db_TokuisakiMasterSortField_TOKUISAKI_MASTER_SORT_FIELD_TOKUISAKI_N Db_TokuisakiMasterSortField = 3 // 得意先N
+	Db_TokuisakiMasterSortField_TOKUISAKI_MASTER_SORT_FIELD_TOKUISAKI_F Db_TokuisakiMasterSortField = 4 // 得意先F
+)
+
+// Enum value maps for Db_TokuisakiMasterSortField.
+var (
+	Db_TokuisakiMasterSortField_name = map[int32]string{
+		0: "TOKUISAKI_MASTER_SORT_FIELD_UNSPECIFIED",
+		1: "TOKUISAKI_MASTER_SORT_FIELD_TOKUISAKI_C",
+		2: "TOKUISAKI_MASTER_SORT_FIELD_TOKUISAKI_H",
+		3: "TOKUISAKI_MASTER_SORT_FIELD_TOKUISAKI_N",
+		4: "TOKUISAKI_MASTER_SORT_FIELD_TOKUISAKI_F",
+	}
+	Db_TokuisakiMasterSortField_value = map[string]int32{
+		"TOKUISAKI_MASTER_SORT_FIELD_UNSPECIFIED": 0,
+		"TOKUISAKI_MASTER_SORT_FIELD_TOKUISAKI_C": 1,
+		"TOKUISAKI_MASTER_SORT_FIELD_TOKUISAKI_H": 2,
+		"TOKUISAKI_MASTER_SORT_FIELD_TOKUISAKI_N": 3,
+		"TOKUISAKI_MASTER_SORT_FIELD_TOKUISAKI_F": 4,
+	}
+)
+
+func (x Db_TokuisakiMasterSortField) Enum() *Db_TokuisakiMasterSortField {
+	p := new(Db_TokuisakiMasterSortField)
+	*p = x
+	return p
 }
 
-func (x *Db_CreateDTakoUriageKeihiRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x Db_TokuisakiMasterSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*Db_CreateDTakoUriageKeihiRequest) ProtoMessage() {}
+func (Db_TokuisakiMasterSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_db_service_proto_enumTypes[12].Descriptor()
+}
 
-func (x *Db_CreateDTakoUriageKeihiRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[3]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (Db_TokuisakiMasterSortField) Type() protoreflect.EnumType {
+	return &file_db_service_proto_enumTypes[12]
 }
 
-// Deprecated: Use Db_CreateDTakoUriageKeihiRequest.ProtoReflect.Descriptor instead.
-func (*Db_CreateDTakoUriageKeihiRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{3}
+func (x Db_TokuisakiMasterSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Db_CreateDTakoUriageKeihiRequest) GetDtakoUriageKeihi() *Db_DTakoUriageKeihi {
-	if x != nil {
-		return x.DtakoUriageKeihi
-	}
-	return nil
+// Deprecated: Use Db_TokuisakiMasterSortField.Descriptor instead.
+func (Db_TokuisakiMasterSortField) EnumDescriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{12}
 }
 
-type Db_GetDTakoUriageKeihiRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SrchId        string                 `protobuf:"bytes,1,opt,name=srch_id,json=srchId,proto3" json:"srch_id,omitempty"`
-	Datetime      string                 `protobuf:"bytes,2,opt,name=datetime,proto3" json:"datetime,omitempty"`
-	KeihiC        int32                  `protobuf:"varint,3,opt,name=keihi_c,json=keihiC,proto3" json:"keihi_c,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// HinmeiMasterのListでソートできるフィールド（値名の HINMEI_MASTER_SORT_FIELD_ 以降がprotoのフィールド名）
+type Db_HinmeiMasterSortField int32
+
+const (
+	Db_HinmeiMasterSortField_HINMEI_MASTER_SORT_FIELD_UNSPECIFIED Db_HinmeiMasterSortField = 0 // 指定不可
+	Db_HinmeiMasterSortField_HINMEI_MASTER_SORT_FIELD_HINMEI_C    Db_HinmeiMasterSortField = 1 // 品名C
+	Db_HinmeiMasterSortField_HINMEI_MASTER_SORT_FIELD_HINMEI_H    Db_HinmeiMasterSortField = 2 // 品名H
+	Db_HinmeiMasterSortField_HINMEI_MASTER_SORT_FIELD_HINMEI_N    Db_HinmeiMasterSortField = 3 // 品名N
+	Db_HinmeiMasterSortField_HINMEI_MASTER_SORT_FIELD_HINMEI_F    Db_HinmeiMasterSortField = 4 // 品名F
+)
+
+// Enum value maps for Db_HinmeiMasterSortField.
+var (
+	Db_HinmeiMasterSortField_name = map[int32]string{
+		0: "HINMEI_MASTER_SORT_FIELD_UNSPECIFIED",
+		1: "HINMEI_MASTER_SORT_FIELD_HINMEI_C",
+		2: "HINMEI_MASTER_SORT_FIELD_HINMEI_H",
+		3: "HINMEI_MASTER_SORT_FIELD_HINMEI_N",
+		4: "HINMEI_MASTER_SORT_FIELD_HINMEI_F",
+	}
+	Db_HinmeiMasterSortField_value = map[string]int32{
+		"HINMEI_MASTER_SORT_FIELD_UNSPECIFIED": 0,
+		"HINMEI_MASTER_SORT_FIELD_HINMEI_C":    1,
+		"HINMEI_MASTER_SORT_FIELD_HINMEI_H":    2,
+		"HINMEI_MASTER_SORT_FIELD_HINMEI_N":    3,
+		"HINMEI_MASTER_SORT_FIELD_HINMEI_F":    4,
+	}
+)
+
+func (x Db_HinmeiMasterSortField) Enum() *Db_HinmeiMasterSortField {
+	p := new(Db_HinmeiMasterSortField)
+	*p = x
+	return p
 }
 
-func (x *Db_GetDTakoUriageKeihiRequest) Reset() {
-	*x = Db_GetDTakoUriageKeihiRequest{}
-	mi := &file_db_service_proto_msgTypes[4]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x Db_HinmeiMasterSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Db_GetDTakoUriageKeihiRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (Db_HinmeiMasterSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_db_service_proto_enumTypes[13].Descriptor()
 }
 
-func (*Db_GetDTakoUriageKeihiRequest) ProtoMessage() {}
+func (Db_HinmeiMasterSortField) Type() protoreflect.EnumType {
+	return &file_db_service_proto_enumTypes[13]
+}
 
-func (x *Db_GetDTakoUriageKeihiRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[4]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x Db_HinmeiMasterSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use Db_GetDTakoUriageKeihiRequest.ProtoReflect.Descriptor instead.
-func (*Db_GetDTakoUriageKeihiRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use Db_HinmeiMasterSortField.Descriptor instead.
+func (Db_HinmeiMasterSortField) EnumDescriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *Db_GetDTakoUriageKeihiRequest) GetSrchId() string {
-	if x != nil {
-		return x.SrchId
+// BumonMasterのListでソートできるフィールド（値名の BUMON_MASTER_SORT_FIELD_ 以降がprotoのフィールド名）
+type Db_BumonMasterSortField int32
+
+const (
+	Db_BumonMasterSortField_BUMON_MASTER_SORT_FIELD_UNSPECIFIED Db_BumonMasterSortField = 0 // 指定不可
+	Db_BumonMasterSortField_BUMON_MASTER_SORT_FIELD_BUMON_C     Db_BumonMasterSortField = 1 // 部門C
+	Db_BumonMasterSortField_BUMON_MASTER_SORT_FIELD_BUMON_N     Db_BumonMasterSortField = 2 // 部門N
+	Db_BumonMasterSortField_BUMON_MASTER_SORT_FIELD_BUMON_F     Db_BumonMasterSortField = 3 // 部門F
+)
+
+// Enum value maps for Db_BumonMasterSortField.
+var (
+	Db_BumonMasterSortField_name = map[int32]string{
+		0: "BUMON_MASTER_SORT_FIELD_UNSPECIFIED",
+		1: "BUMON_MASTER_SORT_FIELD_BUMON_C",
+		2: "BUMON_MASTER_SORT_FIELD_BUMON_N",
+		3: "BUMON_MASTER_SORT_FIELD_BUMON_F",
+	}
+	Db_BumonMasterSortField_value = map[string]int32{
+		"BUMON_MASTER_SORT_FIELD_UNSPECIFIED": 0,
+		"BUMON_MASTER_SORT_FIELD_BUMON_C":     1,
+		"BUMON_MASTER_SORT_FIELD_BUMON_N":     2,
+		"BUMON_MASTER_SORT_FIELD_BUMON_F":     3,
 	}
-	return ""
+)
+
+func (x Db_BumonMasterSortField) Enum() *Db_BumonMasterSortField {
+	p := new(Db_BumonMasterSortField)
+	*p = x
+	return p
 }
 
-func (x *Db_GetDTakoUriageKeihiRequest) GetDatetime() string {
-	if x != nil {
-		return x.Datetime
-	}
-	return ""
+func (x Db_BumonMasterSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Db_GetDTakoUriageKeihiRequest) GetKeihiC() int32 {
-	if x != nil {
-		return x.KeihiC
-	}
-	return 0
+func (Db_BumonMasterSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_db_service_proto_enumTypes[14].Descriptor()
 }
 
-type Db_UpdateDTakoUriageKeihiRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	DtakoUriageKeihi *Db_DTakoUriageKeihi   `protobuf:"bytes,1,opt,name=dtako_uriage_keihi,json=dtakoUriageKeihi,proto3" json:"dtako_uriage_keihi,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (Db_BumonMasterSortField) Type() protoreflect.EnumType {
+	return &file_db_service_proto_enumTypes[14]
 }
 
-func (x *Db_UpdateDTakoUriageKeihiRequest) Reset() {
-	*x = Db_UpdateDTakoUriageKeihiRequest{}
-	mi := &file_db_service_proto_msgTypes[5]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x Db_BumonMasterSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Db_UpdateDTakoUriageKeihiRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use Db_BumonMasterSortField.Descriptor instead.
+func (Db_BumonMasterSortField) EnumDescriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{14}
 }
 
-func (*Db_UpdateDTakoUriageKeihiRequest) ProtoMessage() {}
+// SharyoMasterのListでソートできるフィールド（値名の SHARYO_MASTER_SORT_FIELD_ 以降がprotoのフィールド名）
+type Db_SharyoMasterSortField int32
 
-func (x *Db_UpdateDTakoUriageKeihiRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[5]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	Db_SharyoMasterSortField_SHARYO_MASTER_SORT_FIELD_UNSPECIFIED Db_SharyoMasterSortField = 0 // 指定不可
+	Db_SharyoMasterSortField_SHARYO_MASTER_SORT_FIELD_SHARYO_C    Db_SharyoMasterSortField = 1 // 車輌C
+	Db_SharyoMasterSortField_SHARYO_MASTER_SORT_FIELD_SHARYO_H    Db_SharyoMasterSortField = 2 // 車輌H
+	Db_SharyoMasterSortField_SHARYO_MASTER_SORT_FIELD_SHARYO_N    Db_SharyoMasterSortField = 3 // 車輌N
+	Db_SharyoMasterSortField_SHARYO_MASTER_SORT_FIELD_BUMON_C     Db_SharyoMasterSortField = 4 // 部門C
+)
+
+// Enum value maps for Db_SharyoMasterSortField.
+var (
+	Db_SharyoMasterSortField_name = map[int32]string{
+		0: "SHARYO_MASTER_SORT_FIELD_UNSPECIFIED",
+		1: "SHARYO_MASTER_SORT_FIELD_SHARYO_C",
+		2: "SHARYO_MASTER_SORT_FIELD_SHARYO_H",
+		3: "SHARYO_MASTER_SORT_FIELD_SHARYO_N",
+		4: "SHARYO_MASTER_SORT_FIELD_BUMON_C",
+	}
+	Db_SharyoMasterSortField_value = map[string]int32{
+		"SHARYO_MASTER_SORT_FIELD_UNSPECIFIED": 0,
+		"SHARYO_MASTER_SORT_FIELD_SHARYO_C":    1,
+		"SHARYO_MASTER_SORT_FIELD_SHARYO_H":    2,
+		"SHARYO_MASTER_SORT_FIELD_SHARYO_N":    3,
+		"SHARYO_MASTER_SORT_FIELD_BUMON_C":     4,
 	}
-	return mi.MessageOf(x)
+)
+
+func (x Db_SharyoMasterSortField) Enum() *Db_SharyoMasterSortField {
+	p := new(Db_SharyoMasterSortField)
+	*p = x
+	return p
 }
 
-// Deprecated: Use Db_UpdateDTakoUriageKeihiRequest.ProtoReflect.Descriptor instead.
-func (*Db_UpdateDTakoUriageKeihiRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{5}
+func (x Db_SharyoMasterSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Db_UpdateDTakoUriageKeihiRequest) GetDtakoUriageKeihi() *Db_DTakoUriageKeihi {
-	if x != nil {
-		return x.DtakoUriageKeihi
-	}
-	return nil
+func (Db_SharyoMasterSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_db_service_proto_enumTypes[15].Descriptor()
 }
 
-type Db_DeleteDTakoUriageKeihiRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SrchId        string                 `protobuf:"bytes,1,opt,name=srch_id,json=srchId,proto3" json:"srch_id,omitempty"`
-	Datetime      string                 `protobuf:"bytes,2,opt,name=datetime,proto3" json:"datetime,omitempty"`
-	KeihiC        int32                  `protobuf:"varint,3,opt,name=keihi_c,json=keihiC,proto3" json:"keihi_c,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (Db_SharyoMasterSortField) Type() protoreflect.EnumType {
+	return &file_db_service_proto_enumTypes[15]
 }
 
-func (x *Db_DeleteDTakoUriageKeihiRequest) Reset() {
-	*x = Db_DeleteDTakoUriageKeihiRequest{}
-	mi := &file_db_service_proto_msgTypes[6]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x Db_SharyoMasterSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Db_DeleteDTakoUriageKeihiRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use Db_SharyoMasterSortField.Descriptor instead.
+func (Db_SharyoMasterSortField) EnumDescriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{15}
 }
 
-func (*Db_DeleteDTakoUriageKeihiRequest) ProtoMessage() {}
+// VehicleIdentity用リクエスト/レスポンス
+type Db_VehicleSystem int32
 
-func (x *Db_DeleteDTakoUriageKeihiRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[6]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	Db_VehicleSystem_VEHICLE_SYSTEM_UNSPECIFIED   Db_VehicleSystem = 0
+	Db_VehicleSystem_VEHICLE_SYSTEM_CARS          Db_VehicleSystem = 1 // 本番DBのcars（id, id4）
+	Db_VehicleSystem_VEHICLE_SYSTEM_DTAKO_CARS    Db_VehicleSystem = 2 // dtako_cars（車輌CD, 車輌CC）
+	Db_VehicleSystem_VEHICLE_SYSTEM_ETC_NUM       Db_VehicleSystem = 3 // etc_num（car_id）
+	Db_VehicleSystem_VEHICLE_SYSTEM_SHARYO_MASTER Db_VehicleSystem = 4 // 一番星の車輌ﾏｽﾀ（車輌C, 車輌H）
+)
+
+// Enum value maps for Db_VehicleSystem.
+var (
+	Db_VehicleSystem_name = map[int32]string{
+		0: "VEHICLE_SYSTEM_UNSPECIFIED",
+		1: "VEHICLE_SYSTEM_CARS",
+		2: "VEHICLE_SYSTEM_DTAKO_CARS",
+		3: "VEHICLE_SYSTEM_ETC_NUM",
+		4: "VEHICLE_SYSTEM_SHARYO_MASTER",
+	}
+	Db_VehicleSystem_value = map[string]int32{
+		"VEHICLE_SYSTEM_UNSPECIFIED":   0,
+		"VEHICLE_SYSTEM_CARS":          1,
+		"VEHICLE_SYSTEM_DTAKO_CARS":    2,
+		"VEHICLE_SYSTEM_ETC_NUM":       3,
+		"VEHICLE_SYSTEM_SHARYO_MASTER": 4,
 	}
-	return mi.MessageOf(x)
-}
+)
 
-// Deprecated: Use Db_DeleteDTakoUriageKeihiRequest.ProtoReflect.Descriptor instead.
-func (*Db_DeleteDTakoUriageKeihiRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{6}
+func (x Db_VehicleSystem) Enum() *Db_VehicleSystem {
+	p := new(Db_VehicleSystem)
+	*p = x
+	return p
 }
 
-func (x *Db_DeleteDTakoUriageKeihiRequest) GetSrchId() string {
-	if x != nil {
-		return x.SrchId
-	}
-	return ""
+func (x Db_VehicleSystem) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Db_DeleteDTakoUriageKeihiRequest) GetDatetime() string {
-	if x != nil {
-		return x.Datetime
-	}
-	return ""
+func (Db_VehicleSystem) Descriptor() protoreflect.EnumDescriptor {
+	return file_db_service_proto_enumTypes[16].Descriptor()
 }
 
-func (x *Db_DeleteDTakoUriageKeihiRequest) GetKeihiC() int32 {
-	if x != nil {
-		return x.KeihiC
-	}
-	return 0
+func (Db_VehicleSystem) Type() protoreflect.EnumType {
+	return &file_db_service_proto_enumTypes[16]
 }
 
-type Db_ListDTakoUriageKeihiRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	DtakoRowId    *string                `protobuf:"bytes,1,opt,name=dtako_row_id,json=dtakoRowId,proto3,oneof" json:"dtako_row_id,omitempty"`
-	StartDate     *string                `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"`
-	EndDate       *string                `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
-	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x Db_VehicleSystem) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Db_ListDTakoUriageKeihiRequest) Reset() {
-	*x = Db_ListDTakoUriageKeihiRequest{}
-	mi := &file_db_service_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+// Deprecated: Use Db_VehicleSystem.Descriptor instead.
+func (Db_VehicleSystem) EnumDescriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *Db_ListDTakoUriageKeihiRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+// TimeCardのListでソートできるフィールド（値名の TIME_CARD_SORT_FIELD_ 以降がprotoのフィールド名）
+type Db_TimeCardSortField int32
 
-func (*Db_ListDTakoUriageKeihiRequest) ProtoMessage() {}
+const (
+	Db_TimeCardSortField_TIME_CARD_SORT_FIELD_UNSPECIFIED Db_TimeCardSortField = 0 // 指定不可
+	Db_TimeCardSortField_TIME_CARD_SORT_FIELD_DATETIME    Db_TimeCardSortField = 1 // datetime
+	Db_TimeCardSortField_TIME_CARD_SORT_FIELD_ID          Db_TimeCardSortField = 2 // id
+	Db_TimeCardSortField_TIME_CARD_SORT_FIELD_STATE       Db_TimeCardSortField = 3 // state
+	Db_TimeCardSortField_TIME_CARD_SORT_FIELD_CREATED     Db_TimeCardSortField = 4 // created
+	Db_TimeCardSortField_TIME_CARD_SORT_FIELD_MODIFIED    Db_TimeCardSortField = 5 // modified
+)
 
-func (x *Db_ListDTakoUriageKeihiRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[7]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+// Enum value maps for Db_TimeCardSortField.
+var (
+	Db_TimeCardSortField_name = map[int32]string{
+		0: "TIME_CARD_SORT_FIELD_UNSPECIFIED",
+		1: "TIME_CARD_SORT_FIELD_DATETIME",
+		2: "TIME_CARD_SORT_FIELD_ID",
+		3: "TIME_CARD_SORT_FIELD_STATE",
+		4: "TIME_CARD_SORT_FIELD_CREATED",
+		5: "TIME_CARD_SORT_FIELD_MODIFIED",
+	}
+	Db_TimeCardSortField_value = map[string]int32{
+		"TIME_CARD_SORT_FIELD_UNSPECIFIED": 0,
+		"TIME_CARD_SORT_FIELD_DATETIME":    1,
+		"TIME_CARD_SORT_FIELD_ID":          2,
+		"TIME_CARD_SORT_FIELD_STATE":       3,
+		"TIME_CARD_SORT_FIELD_CREATED":     4,
+		"TIME_CARD_SORT_FIELD_MODIFIED":    5,
 	}
-	return mi.MessageOf(x)
+)
+
+func (x Db_TimeCardSortField) Enum() *Db_TimeCardSortField {
+	p := new(Db_TimeCardSortField)
+	*p = x
+	return p
 }
 
-// Deprecated: Use Db_ListDTakoUriageKeihiRequest.ProtoReflect.Descriptor instead.
-func (*Db_ListDTakoUriageKeihiRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{7}
+func (x Db_TimeCardSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Db_ListDTakoUriageKeihiRequest) GetDtakoRowId() string {
-	if x != nil && x.DtakoRowId != nil {
-		return *x.DtakoRowId
-	}
-	return ""
+func (Db_TimeCardSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_db_service_proto_enumTypes[17].Descriptor()
 }
 
-func (x *Db_ListDTakoUriageKeihiRequest) GetStartDate() string {
-	if x != nil && x.StartDate != nil {
-		return *x.StartDate
-	}
-	return ""
+func (Db_TimeCardSortField) Type() protoreflect.EnumType {
+	return &file_db_service_proto_enumTypes[17]
 }
 
-func (x *Db_ListDTakoUriageKeihiRequest) GetEndDate() string {
-	if x != nil && x.EndDate != nil {
-		return *x.EndDate
-	}
-	return ""
+func (x Db_TimeCardSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Db_ListDTakoUriageKeihiRequest) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
+// Deprecated: Use Db_TimeCardSortField.Descriptor instead.
+func (Db_TimeCardSortField) EnumDescriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *Db_ListDTakoUriageKeihiRequest) GetOffset() int32 {
-	if x != nil {
-		return x.Offset
+// TimeCardLogのListでソートできるフィールド（値名の TIME_CARD_LOG_SORT_FIELD_ 以降がprotoのフィールド名）
+type Db_TimeCardLogSortField int32
+
+const (
+	Db_TimeCardLogSortField_TIME_CARD_LOG_SORT_FIELD_UNSPECIFIED Db_TimeCardLogSortField = 0 // 指定不可
+	Db_TimeCardLogSortField_TIME_CARD_LOG_SORT_FIELD_DATETIME    Db_TimeCardLogSortField = 1 // datetime
+	Db_TimeCardLogSortField_TIME_CARD_LOG_SORT_FIELD_ID          Db_TimeCardLogSortField = 2 // id
+	Db_TimeCardLogSortField_TIME_CARD_LOG_SORT_FIELD_CARD_ID     Db_TimeCardLogSortField = 3 // card_id
+	Db_TimeCardLogSortField_TIME_CARD_LOG_SORT_FIELD_STATE       Db_TimeCardLogSortField = 4 // state
+	Db_TimeCardLogSortField_TIME_CARD_LOG_SORT_FIELD_CREATED     Db_TimeCardLogSortField = 5 // created
+	Db_TimeCardLogSortField_TIME_CARD_LOG_SORT_FIELD_MODIFIED    Db_TimeCardLogSortField = 6 // modified
+)
+
+// Enum value maps for Db_TimeCardLogSortField.
+var (
+	Db_TimeCardLogSortField_name = map[int32]string{
+		0: "TIME_CARD_LOG_SORT_FIELD_UNSPECIFIED",
+		1: "TIME_CARD_LOG_SORT_FIELD_DATETIME",
+		2: "TIME_CARD_LOG_SORT_FIELD_ID",
+		3: "TIME_CARD_LOG_SORT_FIELD_CARD_ID",
+		4: "TIME_CARD_LOG_SORT_FIELD_STATE",
+		5: "TIME_CARD_LOG_SORT_FIELD_CREATED",
+		6: "TIME_CARD_LOG_SORT_FIELD_MODIFIED",
+	}
+	Db_TimeCardLogSortField_value = map[string]int32{
+		"TIME_CARD_LOG_SORT_FIELD_UNSPECIFIED": 0,
+		"TIME_CARD_LOG_SORT_FIELD_DATETIME":    1,
+		"TIME_CARD_LOG_SORT_FIELD_ID":          2,
+		"TIME_CARD_LOG_SORT_FIELD_CARD_ID":     3,
+		"TIME_CARD_LOG_SORT_FIELD_STATE":       4,
+		"TIME_CARD_LOG_SORT_FIELD_CREATED":     5,
+		"TIME_CARD_LOG_SORT_FIELD_MODIFIED":    6,
 	}
-	return 0
+)
+
+func (x Db_TimeCardLogSortField) Enum() *Db_TimeCardLogSortField {
+	p := new(Db_TimeCardLogSortField)
+	*p = x
+	return p
 }
 
-type Db_DTakoUriageKeihiResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	DtakoUriageKeihi *Db_DTakoUriageKeihi   `protobuf:"bytes,1,opt,name=dtako_uriage_keihi,json=dtakoUriageKeihi,proto3" json:"dtako_uriage_keihi,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x Db_TimeCardLogSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Db_DTakoUriageKeihiResponse) Reset() {
-	*x = Db_DTakoUriageKeihiResponse{}
-	mi := &file_db_service_proto_msgTypes[8]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (Db_TimeCardLogSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_db_service_proto_enumTypes[18].Descriptor()
 }
 
-func (x *Db_DTakoUriageKeihiResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (Db_TimeCardLogSortField) Type() protoreflect.EnumType {
+	return &file_db_service_proto_enumTypes[18]
 }
 
-func (*Db_DTakoUriageKeihiResponse) ProtoMessage() {}
+func (x Db_TimeCardLogSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
 
-func (x *Db_DTakoUriageKeihiResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[8]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+// Deprecated: Use Db_TimeCardLogSortField.Descriptor instead.
+func (Db_TimeCardLogSortField) EnumDescriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{18}
+}
+
+type Db_EmployeeCodeSource int32
+
+const (
+	Db_EmployeeCodeSource_EMPLOYEE_CODE_SOURCE_UNSPECIFIED        Db_EmployeeCodeSource = 0
+	Db_EmployeeCodeSource_EMPLOYEE_CODE_SOURCE_DRIVERS            Db_EmployeeCodeSource = 1 // 本番DBのdrivers.id
+	Db_EmployeeCodeSource_EMPLOYEE_CODE_SOURCE_SHAIN_MASTER       Db_EmployeeCodeSource = 2 // 一番星の社員ﾏｽﾀ.社員C
+	Db_EmployeeCodeSource_EMPLOYEE_CODE_SOURCE_DTAKO_ROWS         Db_EmployeeCodeSource = 3 // dtako_rows.乗務員CD1・対象乗務員CD
+	Db_EmployeeCodeSource_EMPLOYEE_CODE_SOURCE_UNTEN_NIPPO_MEISAI Db_EmployeeCodeSource = 4 // 一番星の運転日報明細.運転手C
+	Db_EmployeeCodeSource_EMPLOYEE_CODE_SOURCE_TIME_CARD_USER     Db_EmployeeCodeSource = 5 // timecard_logs.id
+	Db_EmployeeCodeSource_EMPLOYEE_CODE_SOURCE_TIME_CARD_CARD     Db_EmployeeCodeSource = 6 // timecard_logs.card_id
+)
+
+// Enum value maps for Db_EmployeeCodeSource.
+var (
+	Db_EmployeeCodeSource_name = map[int32]string{
+		0: "EMPLOYEE_CODE_SOURCE_UNSPECIFIED",
+		1: "EMPLOYEE_CODE_SOURCE_DRIVERS",
+		2: "EMPLOYEE_CODE_SOURCE_SHAIN_MASTER",
+		3: "EMPLOYEE_CODE_SOURCE_DTAKO_ROWS",
+		4: "EMPLOYEE_CODE_SOURCE_UNTEN_NIPPO_MEISAI",
+		5: "EMPLOYEE_CODE_SOURCE_TIME_CARD_USER",
+		6: "EMPLOYEE_CODE_SOURCE_TIME_CARD_CARD",
+	}
+	Db_EmployeeCodeSource_value = map[string]int32{
+		"EMPLOYEE_CODE_SOURCE_UNSPECIFIED":        0,
+		"EMPLOYEE_CODE_SOURCE_DRIVERS":            1,
+		"EMPLOYEE_CODE_SOURCE_SHAIN_MASTER":       2,
+		"EMPLOYEE_CODE_SOURCE_DTAKO_ROWS":         3,
+		"EMPLOYEE_CODE_SOURCE_UNTEN_NIPPO_MEISAI": 4,
+		"EMPLOYEE_CODE_SOURCE_TIME_CARD_USER":     5,
+		"EMPLOYEE_CODE_SOURCE_TIME_CARD_CARD":     6,
 	}
-	return mi.MessageOf(x)
+)
+
+func (x Db_EmployeeCodeSource) Enum() *Db_EmployeeCodeSource {
+	p := new(Db_EmployeeCodeSource)
+	*p = x
+	return p
 }
 
-// Deprecated: Use Db_DTakoUriageKeihiResponse.ProtoReflect.Descriptor instead.
-func (*Db_DTakoUriageKeihiResponse) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{8}
+func (x Db_EmployeeCodeSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Db_DTakoUriageKeihiResponse) GetDtakoUriageKeihi() *Db_DTakoUriageKeihi {
-	if x != nil {
-		return x.DtakoUriageKeihi
+func (Db_EmployeeCodeSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_db_service_proto_enumTypes[19].Descriptor()
+}
+
+func (Db_EmployeeCodeSource) Type() protoreflect.EnumType {
+	return &file_db_service_proto_enumTypes[19]
+}
+
+func (x Db_EmployeeCodeSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Db_EmployeeCodeSource.Descriptor instead.
+func (Db_EmployeeCodeSource) EnumDescriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{19}
+}
+
+// 共通メッセージ
+// 一覧取得のソート方向（ソートできるフィールドは一覧ごとの db_*SortField）
+type Db_SortDirection int32
+
+const (
+	Db_SortDirection_SORT_DIRECTION_UNSPECIFIED Db_SortDirection = 0 // 昇順
+	Db_SortDirection_SORT_DIRECTION_ASC         Db_SortDirection = 1
+	Db_SortDirection_SORT_DIRECTION_DESC        Db_SortDirection = 2
+)
+
+// Enum value maps for Db_SortDirection.
+var (
+	Db_SortDirection_name = map[int32]string{
+		0: "SORT_DIRECTION_UNSPECIFIED",
+		1: "SORT_DIRECTION_ASC",
+		2: "SORT_DIRECTION_DESC",
 	}
-	return nil
+	Db_SortDirection_value = map[string]int32{
+		"SORT_DIRECTION_UNSPECIFIED": 0,
+		"SORT_DIRECTION_ASC":         1,
+		"SORT_DIRECTION_DESC":        2,
+	}
+)
+
+func (x Db_SortDirection) Enum() *Db_SortDirection {
+	p := new(Db_SortDirection)
+	*p = x
+	return p
 }
 
-type Db_ListDTakoUriageKeihiResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*Db_DTakoUriageKeihi `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x Db_SortDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Db_ListDTakoUriageKeihiResponse) Reset() {
-	*x = Db_ListDTakoUriageKeihiResponse{}
-	mi := &file_db_service_proto_msgTypes[9]
+func (Db_SortDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_db_service_proto_enumTypes[20].Descriptor()
+}
+
+func (Db_SortDirection) Type() protoreflect.EnumType {
+	return &file_db_service_proto_enumTypes[20]
+}
+
+func (x Db_SortDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Db_SortDirection.Descriptor instead.
+func (Db_SortDirection) EnumDescriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{20}
+}
+
+// 経費精算データ
+type Db_DTakoUriageKeihi struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SrchId         string                 `protobuf:"bytes,1,opt,name=srch_id,json=srchId,proto3" json:"srch_id,omitempty"`
+	Datetime       string                 `protobuf:"bytes,2,opt,name=datetime,proto3" json:"datetime,omitempty"` // RFC3339形式
+	KeihiC         int32                  `protobuf:"varint,3,opt,name=keihi_c,json=keihiC,proto3" json:"keihi_c,omitempty"`
+	Price          float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	Km             *float64               `protobuf:"fixed64,5,opt,name=km,proto3,oneof" json:"km,omitempty"`
+	DtakoRowId     string                 `protobuf:"bytes,6,opt,name=dtako_row_id,json=dtakoRowId,proto3" json:"dtako_row_id,omitempty"`
+	DtakoRowIdR    string                 `protobuf:"bytes,7,opt,name=dtako_row_id_r,json=dtakoRowIdR,proto3" json:"dtako_row_id_r,omitempty"`
+	StartSrchId    *string                `protobuf:"bytes,8,opt,name=start_srch_id,json=startSrchId,proto3,oneof" json:"start_srch_id,omitempty"`
+	StartSrchTime  *string                `protobuf:"bytes,9,opt,name=start_srch_time,json=startSrchTime,proto3,oneof" json:"start_srch_time,omitempty"`
+	StartSrchPlace *string                `protobuf:"bytes,10,opt,name=start_srch_place,json=startSrchPlace,proto3,oneof" json:"start_srch_place,omitempty"`
+	StartSrchTokui *string                `protobuf:"bytes,11,opt,name=start_srch_tokui,json=startSrchTokui,proto3,oneof" json:"start_srch_tokui,omitempty"`
+	EndSrchId      *string                `protobuf:"bytes,12,opt,name=end_srch_id,json=endSrchId,proto3,oneof" json:"end_srch_id,omitempty"`
+	EndSrchTime    *string                `protobuf:"bytes,13,opt,name=end_srch_time,json=endSrchTime,proto3,oneof" json:"end_srch_time,omitempty"`
+	EndSrchPlace   *string                `protobuf:"bytes,14,opt,name=end_srch_place,json=endSrchPlace,proto3,oneof" json:"end_srch_place,omitempty"`
+	Manual         *bool                  `protobuf:"varint,15,opt,name=manual,proto3,oneof" json:"manual,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Db_DTakoUriageKeihi) Reset() {
+	*x = Db_DTakoUriageKeihi{}
+	mi := &file_db_service_proto_msgTypes[0]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ListDTakoUriageKeihiResponse) String() string {
+func (x *Db_DTakoUriageKeihi) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_ListDTakoUriageKeihiResponse) ProtoMessage() {}
+func (*Db_DTakoUriageKeihi) ProtoMessage() {}
 
-func (x *Db_ListDTakoUriageKeihiResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[9]
+func (x *Db_DTakoUriageKeihi) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[0]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -950,92 +1321,152 @@ func (x *Db_ListDTakoUriageKeihiResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_ListDTakoUriageKeihiResponse.ProtoReflect.Descriptor instead.
-func (*Db_ListDTakoUriageKeihiResponse) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use Db_DTakoUriageKeihi.ProtoReflect.Descriptor instead.
+func (*Db_DTakoUriageKeihi) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *Db_ListDTakoUriageKeihiResponse) GetItems() []*Db_DTakoUriageKeihi {
+func (x *Db_DTakoUriageKeihi) GetSrchId() string {
 	if x != nil {
-		return x.Items
+		return x.SrchId
 	}
-	return nil
+	return ""
 }
 
-func (x *Db_ListDTakoUriageKeihiResponse) GetTotalCount() int32 {
+func (x *Db_DTakoUriageKeihi) GetDatetime() string {
 	if x != nil {
-		return x.TotalCount
+		return x.Datetime
 	}
-	return 0
+	return ""
 }
 
-// ETCMeisai用
-type Db_CreateETCMeisaiRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	EtcMeisai     *Db_ETCMeisai          `protobuf:"bytes,1,opt,name=etc_meisai,json=etcMeisai,proto3" json:"etc_meisai,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Db_DTakoUriageKeihi) GetKeihiC() int32 {
+	if x != nil {
+		return x.KeihiC
+	}
+	return 0
 }
 
-func (x *Db_CreateETCMeisaiRequest) Reset() {
-	*x = Db_CreateETCMeisaiRequest{}
-	mi := &file_db_service_proto_msgTypes[10]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Db_DTakoUriageKeihi) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
 }
 
-func (x *Db_CreateETCMeisaiRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Db_DTakoUriageKeihi) GetKm() float64 {
+	if x != nil && x.Km != nil {
+		return *x.Km
+	}
+	return 0
 }
 
-func (*Db_CreateETCMeisaiRequest) ProtoMessage() {}
+func (x *Db_DTakoUriageKeihi) GetDtakoRowId() string {
+	if x != nil {
+		return x.DtakoRowId
+	}
+	return ""
+}
 
-func (x *Db_CreateETCMeisaiRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[10]
+func (x *Db_DTakoUriageKeihi) GetDtakoRowIdR() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.DtakoRowIdR
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use Db_CreateETCMeisaiRequest.ProtoReflect.Descriptor instead.
-func (*Db_CreateETCMeisaiRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{10}
+func (x *Db_DTakoUriageKeihi) GetStartSrchId() string {
+	if x != nil && x.StartSrchId != nil {
+		return *x.StartSrchId
+	}
+	return ""
 }
 
-func (x *Db_CreateETCMeisaiRequest) GetEtcMeisai() *Db_ETCMeisai {
-	if x != nil {
-		return x.EtcMeisai
+func (x *Db_DTakoUriageKeihi) GetStartSrchTime() string {
+	if x != nil && x.StartSrchTime != nil {
+		return *x.StartSrchTime
 	}
-	return nil
+	return ""
 }
 
-type Db_GetETCMeisaiRequest struct {
+func (x *Db_DTakoUriageKeihi) GetStartSrchPlace() string {
+	if x != nil && x.StartSrchPlace != nil {
+		return *x.StartSrchPlace
+	}
+	return ""
+}
+
+func (x *Db_DTakoUriageKeihi) GetStartSrchTokui() string {
+	if x != nil && x.StartSrchTokui != nil {
+		return *x.StartSrchTokui
+	}
+	return ""
+}
+
+func (x *Db_DTakoUriageKeihi) GetEndSrchId() string {
+	if x != nil && x.EndSrchId != nil {
+		return *x.EndSrchId
+	}
+	return ""
+}
+
+func (x *Db_DTakoUriageKeihi) GetEndSrchTime() string {
+	if x != nil && x.EndSrchTime != nil {
+		return *x.EndSrchTime
+	}
+	return ""
+}
+
+func (x *Db_DTakoUriageKeihi) GetEndSrchPlace() string {
+	if x != nil && x.EndSrchPlace != nil {
+		return *x.EndSrchPlace
+	}
+	return ""
+}
+
+func (x *Db_DTakoUriageKeihi) GetManual() bool {
+	if x != nil && x.Manual != nil {
+		return *x.Manual
+	}
+	return false
+}
+
+// ETC明細データ
+type Db_ETCMeisai struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DateFr        *string                `protobuf:"bytes,2,opt,name=date_fr,json=dateFr,proto3,oneof" json:"date_fr,omitempty"`
+	DateTo        string                 `protobuf:"bytes,3,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`               // RFC3339形式推奨 (例: 2025-10-18T00:00:00Z)
+	DateToDate    string                 `protobuf:"bytes,4,opt,name=date_to_date,json=dateToDate,proto3" json:"date_to_date,omitempty"` // YYYY-MM-DD形式 (例: 2025-10-18)
+	IcFr          *string                `protobuf:"bytes,5,opt,name=ic_fr,json=icFr,proto3,oneof" json:"ic_fr,omitempty"`               // 入口IC不明の場合は省略可（実データの22.3%が空）
+	IcTo          string                 `protobuf:"bytes,6,opt,name=ic_to,json=icTo,proto3" json:"ic_to,omitempty"`                     // 出口IC（必須）
+	PriceBf       *int32                 `protobuf:"varint,7,opt,name=price_bf,json=priceBf,proto3,oneof" json:"price_bf,omitempty"`
+	Descount      *int32                 `protobuf:"varint,8,opt,name=descount,proto3,oneof" json:"descount,omitempty"`
+	Price         int32                  `protobuf:"varint,9,opt,name=price,proto3" json:"price,omitempty"`
+	Shashu        int32                  `protobuf:"varint,10,opt,name=shashu,proto3" json:"shashu,omitempty"`
+	CarIdNum      *int32                 `protobuf:"varint,11,opt,name=car_id_num,json=carIdNum,proto3,oneof" json:"car_id_num,omitempty"`
+	EtcNum        string                 `protobuf:"bytes,12,opt,name=etc_num,json=etcNum,proto3" json:"etc_num,omitempty"`
+	Detail        *string                `protobuf:"bytes,13,opt,name=detail,proto3,oneof" json:"detail,omitempty"`
+	Hash          string                 `protobuf:"bytes,14,opt,name=hash,proto3" json:"hash,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_GetETCMeisaiRequest) Reset() {
-	*x = Db_GetETCMeisaiRequest{}
-	mi := &file_db_service_proto_msgTypes[11]
+func (x *Db_ETCMeisai) Reset() {
+	*x = Db_ETCMeisai{}
+	mi := &file_db_service_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_GetETCMeisaiRequest) String() string {
+func (x *Db_ETCMeisai) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_GetETCMeisaiRequest) ProtoMessage() {}
+func (*Db_ETCMeisai) ProtoMessage() {}
 
-func (x *Db_GetETCMeisaiRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[11]
+func (x *Db_ETCMeisai) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1046,132 +1477,159 @@ func (x *Db_GetETCMeisaiRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_GetETCMeisaiRequest.ProtoReflect.Descriptor instead.
-func (*Db_GetETCMeisaiRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use Db_ETCMeisai.ProtoReflect.Descriptor instead.
+func (*Db_ETCMeisai) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *Db_GetETCMeisaiRequest) GetId() int64 {
+func (x *Db_ETCMeisai) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type Db_UpdateETCMeisaiRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	EtcMeisai     *Db_ETCMeisai          `protobuf:"bytes,1,opt,name=etc_meisai,json=etcMeisai,proto3" json:"etc_meisai,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Db_ETCMeisai) GetDateFr() string {
+	if x != nil && x.DateFr != nil {
+		return *x.DateFr
+	}
+	return ""
 }
 
-func (x *Db_UpdateETCMeisaiRequest) Reset() {
-	*x = Db_UpdateETCMeisaiRequest{}
-	mi := &file_db_service_proto_msgTypes[12]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Db_ETCMeisai) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
 }
 
-func (x *Db_UpdateETCMeisaiRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Db_ETCMeisai) GetDateToDate() string {
+	if x != nil {
+		return x.DateToDate
+	}
+	return ""
 }
 
-func (*Db_UpdateETCMeisaiRequest) ProtoMessage() {}
+func (x *Db_ETCMeisai) GetIcFr() string {
+	if x != nil && x.IcFr != nil {
+		return *x.IcFr
+	}
+	return ""
+}
 
-func (x *Db_UpdateETCMeisaiRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[12]
+func (x *Db_ETCMeisai) GetIcTo() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.IcTo
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use Db_UpdateETCMeisaiRequest.ProtoReflect.Descriptor instead.
-func (*Db_UpdateETCMeisaiRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{12}
+func (x *Db_ETCMeisai) GetPriceBf() int32 {
+	if x != nil && x.PriceBf != nil {
+		return *x.PriceBf
+	}
+	return 0
 }
 
-func (x *Db_UpdateETCMeisaiRequest) GetEtcMeisai() *Db_ETCMeisai {
-	if x != nil {
-		return x.EtcMeisai
+func (x *Db_ETCMeisai) GetDescount() int32 {
+	if x != nil && x.Descount != nil {
+		return *x.Descount
 	}
-	return nil
+	return 0
 }
 
-type Db_DeleteETCMeisaiRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Db_ETCMeisai) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
 }
 
-func (x *Db_DeleteETCMeisaiRequest) Reset() {
-	*x = Db_DeleteETCMeisaiRequest{}
-	mi := &file_db_service_proto_msgTypes[13]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Db_ETCMeisai) GetShashu() int32 {
+	if x != nil {
+		return x.Shashu
+	}
+	return 0
 }
 
-func (x *Db_DeleteETCMeisaiRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Db_ETCMeisai) GetCarIdNum() int32 {
+	if x != nil && x.CarIdNum != nil {
+		return *x.CarIdNum
+	}
+	return 0
 }
 
-func (*Db_DeleteETCMeisaiRequest) ProtoMessage() {}
-
-func (x *Db_DeleteETCMeisaiRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[13]
+func (x *Db_ETCMeisai) GetEtcNum() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.EtcNum
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use Db_DeleteETCMeisaiRequest.ProtoReflect.Descriptor instead.
-func (*Db_DeleteETCMeisaiRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{13}
+func (x *Db_ETCMeisai) GetDetail() string {
+	if x != nil && x.Detail != nil {
+		return *x.Detail
+	}
+	return ""
 }
 
-func (x *Db_DeleteETCMeisaiRequest) GetId() int64 {
+func (x *Db_ETCMeisai) GetHash() string {
 	if x != nil {
-		return x.Id
+		return x.Hash
 	}
-	return 0
+	return ""
 }
 
-type Db_ListETCMeisaiRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Hash          *string                `protobuf:"bytes,1,opt,name=hash,proto3,oneof" json:"hash,omitempty"`
-	StartDate     *string                `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"`
-	EndDate       *string                `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
-	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// フェリー運行データ
+type Db_DTakoFerryRows struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UnkoNo            string                 `protobuf:"bytes,2,opt,name=unko_no,json=unkoNo,proto3" json:"unko_no,omitempty"`                                       // 運行NO
+	UnkoDate          string                 `protobuf:"bytes,3,opt,name=unko_date,json=unkoDate,proto3" json:"unko_date,omitempty"`                                 // 運行日
+	YomitoriDate      string                 `protobuf:"bytes,4,opt,name=yomitori_date,json=yomitoriDate,proto3" json:"yomitori_date,omitempty"`                     // 読取日
+	JigyoshoCd        int32                  `protobuf:"varint,5,opt,name=jigyosho_cd,json=jigyoshoCd,proto3" json:"jigyosho_cd,omitempty"`                          // 事業所CD
+	JigyoshoName      string                 `protobuf:"bytes,6,opt,name=jigyosho_name,json=jigyoshoName,proto3" json:"jigyosho_name,omitempty"`                     // 事業所名
+	SharyoCd          int32                  `protobuf:"varint,7,opt,name=sharyo_cd,json=sharyoCd,proto3" json:"sharyo_cd,omitempty"`                                // 車輌CD
+	SharyoName        string                 `protobuf:"bytes,8,opt,name=sharyo_name,json=sharyoName,proto3" json:"sharyo_name,omitempty"`                           // 車輌名
+	JomuinCd1         int32                  `protobuf:"varint,9,opt,name=jomuin_cd1,json=jomuinCd1,proto3" json:"jomuin_cd1,omitempty"`                             // 乗務員CD1
+	JomuinName1       string                 `protobuf:"bytes,10,opt,name=jomuin_name1,json=jomuinName1,proto3" json:"jomuin_name1,omitempty"`                       // 乗務員名１
+	TaishoJomuinKbn   int32                  `protobuf:"varint,11,opt,name=taisho_jomuin_kbn,json=taishoJomuinKbn,proto3" json:"taisho_jomuin_kbn,omitempty"`        // 対象乗務員区分
+	KaishiDatetime    string                 `protobuf:"bytes,12,opt,name=kaishi_datetime,json=kaishiDatetime,proto3" json:"kaishi_datetime,omitempty"`              // 開始日時
+	ShuryoDatetime    string                 `protobuf:"bytes,13,opt,name=shuryo_datetime,json=shuryoDatetime,proto3" json:"shuryo_datetime,omitempty"`              // 終了日時
+	FerryCompanyCd    int32                  `protobuf:"varint,14,opt,name=ferry_company_cd,json=ferryCompanyCd,proto3" json:"ferry_company_cd,omitempty"`           // フェリー会社CD
+	FerryCompanyName  string                 `protobuf:"bytes,15,opt,name=ferry_company_name,json=ferryCompanyName,proto3" json:"ferry_company_name,omitempty"`      // フェリー会社名
+	NoribaCd          int32                  `protobuf:"varint,16,opt,name=noriba_cd,json=noribaCd,proto3" json:"noriba_cd,omitempty"`                               // 乗場CD
+	NoribaName        string                 `protobuf:"bytes,17,opt,name=noriba_name,json=noribaName,proto3" json:"noriba_name,omitempty"`                          // 乗場名
+	Bin               string                 `protobuf:"bytes,18,opt,name=bin,proto3" json:"bin,omitempty"`                                                          // 便
+	OribaCd           int32                  `protobuf:"varint,19,opt,name=oriba_cd,json=oribaCd,proto3" json:"oriba_cd,omitempty"`                                  // 降場CD
+	OribaName         string                 `protobuf:"bytes,20,opt,name=oriba_name,json=oribaName,proto3" json:"oriba_name,omitempty"`                             // 降場名
+	SeisanKbn         int32                  `protobuf:"varint,21,opt,name=seisan_kbn,json=seisanKbn,proto3" json:"seisan_kbn,omitempty"`                            // 精算区分
+	SeisanKbnName     string                 `protobuf:"bytes,22,opt,name=seisan_kbn_name,json=seisanKbnName,proto3" json:"seisan_kbn_name,omitempty"`               // 精算区分名
+	HyojunRyokin      int32                  `protobuf:"varint,23,opt,name=hyojun_ryokin,json=hyojunRyokin,proto3" json:"hyojun_ryokin,omitempty"`                   // 標準料金
+	KeiyakuRyokin     int32                  `protobuf:"varint,24,opt,name=keiyaku_ryokin,json=keiyakuRyokin,proto3" json:"keiyaku_ryokin,omitempty"`                // 契約料金
+	KosoShashuKbn     int32                  `protobuf:"varint,25,opt,name=koso_shashu_kbn,json=kosoShashuKbn,proto3" json:"koso_shashu_kbn,omitempty"`              // 航送車種区分
+	KosoShashuKbnName string                 `protobuf:"bytes,26,opt,name=koso_shashu_kbn_name,json=kosoShashuKbnName,proto3" json:"koso_shashu_kbn_name,omitempty"` // 航送車種区分名
+	MinashiKyori      int32                  `protobuf:"varint,27,opt,name=minashi_kyori,json=minashiKyori,proto3" json:"minashi_kyori,omitempty"`                   // 見なし距離
+	FerrySrch         *string                `protobuf:"bytes,28,opt,name=ferry_srch,json=ferrySrch,proto3,oneof" json:"ferry_srch,omitempty"`                       // ferry_srch
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *Db_ListETCMeisaiRequest) Reset() {
-	*x = Db_ListETCMeisaiRequest{}
-	mi := &file_db_service_proto_msgTypes[14]
+func (x *Db_DTakoFerryRows) Reset() {
+	*x = Db_DTakoFerryRows{}
+	mi := &file_db_service_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ListETCMeisaiRequest) String() string {
+func (x *Db_DTakoFerryRows) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_ListETCMeisaiRequest) ProtoMessage() {}
+func (*Db_DTakoFerryRows) ProtoMessage() {}
 
-func (x *Db_ListETCMeisaiRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[14]
+func (x *Db_DTakoFerryRows) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1182,165 +1640,230 @@ func (x *Db_ListETCMeisaiRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_ListETCMeisaiRequest.ProtoReflect.Descriptor instead.
-func (*Db_ListETCMeisaiRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use Db_DTakoFerryRows.ProtoReflect.Descriptor instead.
+func (*Db_DTakoFerryRows) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *Db_ListETCMeisaiRequest) GetHash() string {
-	if x != nil && x.Hash != nil {
-		return *x.Hash
+func (x *Db_DTakoFerryRows) GetId() int32 {
+	if x != nil {
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *Db_ListETCMeisaiRequest) GetStartDate() string {
-	if x != nil && x.StartDate != nil {
-		return *x.StartDate
+func (x *Db_DTakoFerryRows) GetUnkoNo() string {
+	if x != nil {
+		return x.UnkoNo
 	}
 	return ""
 }
 
-func (x *Db_ListETCMeisaiRequest) GetEndDate() string {
-	if x != nil && x.EndDate != nil {
-		return *x.EndDate
+func (x *Db_DTakoFerryRows) GetUnkoDate() string {
+	if x != nil {
+		return x.UnkoDate
 	}
 	return ""
 }
 
-func (x *Db_ListETCMeisaiRequest) GetLimit() int32 {
+func (x *Db_DTakoFerryRows) GetYomitoriDate() string {
 	if x != nil {
-		return x.Limit
+		return x.YomitoriDate
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_ListETCMeisaiRequest) GetOffset() int32 {
+func (x *Db_DTakoFerryRows) GetJigyoshoCd() int32 {
 	if x != nil {
-		return x.Offset
+		return x.JigyoshoCd
 	}
 	return 0
 }
 
-type Db_ETCMeisaiResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	EtcMeisai     *Db_ETCMeisai          `protobuf:"bytes,1,opt,name=etc_meisai,json=etcMeisai,proto3" json:"etc_meisai,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Db_DTakoFerryRows) GetJigyoshoName() string {
+	if x != nil {
+		return x.JigyoshoName
+	}
+	return ""
 }
 
-func (x *Db_ETCMeisaiResponse) Reset() {
-	*x = Db_ETCMeisaiResponse{}
-	mi := &file_db_service_proto_msgTypes[15]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Db_DTakoFerryRows) GetSharyoCd() int32 {
+	if x != nil {
+		return x.SharyoCd
+	}
+	return 0
 }
 
-func (x *Db_ETCMeisaiResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Db_DTakoFerryRows) GetSharyoName() string {
+	if x != nil {
+		return x.SharyoName
+	}
+	return ""
 }
 
-func (*Db_ETCMeisaiResponse) ProtoMessage() {}
-
-func (x *Db_ETCMeisaiResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[15]
+func (x *Db_DTakoFerryRows) GetJomuinCd1() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.JomuinCd1
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use Db_ETCMeisaiResponse.ProtoReflect.Descriptor instead.
-func (*Db_ETCMeisaiResponse) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{15}
+func (x *Db_DTakoFerryRows) GetJomuinName1() string {
+	if x != nil {
+		return x.JomuinName1
+	}
+	return ""
 }
 
-func (x *Db_ETCMeisaiResponse) GetEtcMeisai() *Db_ETCMeisai {
+func (x *Db_DTakoFerryRows) GetTaishoJomuinKbn() int32 {
 	if x != nil {
-		return x.EtcMeisai
+		return x.TaishoJomuinKbn
 	}
-	return nil
+	return 0
 }
 
-type Db_ListETCMeisaiResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*Db_ETCMeisai        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Db_DTakoFerryRows) GetKaishiDatetime() string {
+	if x != nil {
+		return x.KaishiDatetime
+	}
+	return ""
 }
 
-func (x *Db_ListETCMeisaiResponse) Reset() {
-	*x = Db_ListETCMeisaiResponse{}
-	mi := &file_db_service_proto_msgTypes[16]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Db_DTakoFerryRows) GetShuryoDatetime() string {
+	if x != nil {
+		return x.ShuryoDatetime
+	}
+	return ""
 }
 
-func (x *Db_ListETCMeisaiResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Db_DTakoFerryRows) GetFerryCompanyCd() int32 {
+	if x != nil {
+		return x.FerryCompanyCd
+	}
+	return 0
 }
 
-func (*Db_ListETCMeisaiResponse) ProtoMessage() {}
+func (x *Db_DTakoFerryRows) GetFerryCompanyName() string {
+	if x != nil {
+		return x.FerryCompanyName
+	}
+	return ""
+}
 
-func (x *Db_ListETCMeisaiResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[16]
+func (x *Db_DTakoFerryRows) GetNoribaCd() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.NoribaCd
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use Db_ListETCMeisaiResponse.ProtoReflect.Descriptor instead.
-func (*Db_ListETCMeisaiResponse) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{16}
+func (x *Db_DTakoFerryRows) GetNoribaName() string {
+	if x != nil {
+		return x.NoribaName
+	}
+	return ""
 }
 
-func (x *Db_ListETCMeisaiResponse) GetItems() []*Db_ETCMeisai {
+func (x *Db_DTakoFerryRows) GetBin() string {
 	if x != nil {
-		return x.Items
+		return x.Bin
 	}
-	return nil
+	return ""
 }
 
-func (x *Db_ListETCMeisaiResponse) GetTotalCount() int32 {
+func (x *Db_DTakoFerryRows) GetOribaCd() int32 {
 	if x != nil {
-		return x.TotalCount
+		return x.OribaCd
 	}
 	return 0
 }
 
-// DTakoFerryRows用
-type Db_CreateDTakoFerryRowsRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	DtakoFerryRows *Db_DTakoFerryRows     `protobuf:"bytes,1,opt,name=dtako_ferry_rows,json=dtakoFerryRows,proto3" json:"dtako_ferry_rows,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *Db_DTakoFerryRows) GetOribaName() string {
+	if x != nil {
+		return x.OribaName
+	}
+	return ""
 }
 
-func (x *Db_CreateDTakoFerryRowsRequest) Reset() {
-	*x = Db_CreateDTakoFerryRowsRequest{}
-	mi := &file_db_service_proto_msgTypes[17]
+func (x *Db_DTakoFerryRows) GetSeisanKbn() int32 {
+	if x != nil {
+		return x.SeisanKbn
+	}
+	return 0
+}
+
+func (x *Db_DTakoFerryRows) GetSeisanKbnName() string {
+	if x != nil {
+		return x.SeisanKbnName
+	}
+	return ""
+}
+
+func (x *Db_DTakoFerryRows) GetHyojunRyokin() int32 {
+	if x != nil {
+		return x.HyojunRyokin
+	}
+	return 0
+}
+
+func (x *Db_DTakoFerryRows) GetKeiyakuRyokin() int32 {
+	if x != nil {
+		return x.KeiyakuRyokin
+	}
+	return 0
+}
+
+func (x *Db_DTakoFerryRows) GetKosoShashuKbn() int32 {
+	if x != nil {
+		return x.KosoShashuKbn
+	}
+	return 0
+}
+
+func (x *Db_DTakoFerryRows) GetKosoShashuKbnName() string {
+	if x != nil {
+		return x.KosoShashuKbnName
+	}
+	return ""
+}
+
+func (x *Db_DTakoFerryRows) GetMinashiKyori() int32 {
+	if x != nil {
+		return x.MinashiKyori
+	}
+	return 0
+}
+
+func (x *Db_DTakoFerryRows) GetFerrySrch() string {
+	if x != nil && x.FerrySrch != nil {
+		return *x.FerrySrch
+	}
+	return ""
+}
+
+// DTakoUriageKeihi用
+type Db_CreateDTakoUriageKeihiRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	DtakoUriageKeihi *Db_DTakoUriageKeihi   `protobuf:"bytes,1,opt,name=dtako_uriage_keihi,json=dtakoUriageKeihi,proto3" json:"dtako_uriage_keihi,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Db_CreateDTakoUriageKeihiRequest) Reset() {
+	*x = Db_CreateDTakoUriageKeihiRequest{}
+	mi := &file_db_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_CreateDTakoFerryRowsRequest) String() string {
+func (x *Db_CreateDTakoUriageKeihiRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_CreateDTakoFerryRowsRequest) ProtoMessage() {}
+func (*Db_CreateDTakoUriageKeihiRequest) ProtoMessage() {}
 
-func (x *Db_CreateDTakoFerryRowsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[17]
+func (x *Db_CreateDTakoUriageKeihiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1351,40 +1874,42 @@ func (x *Db_CreateDTakoFerryRowsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_CreateDTakoFerryRowsRequest.ProtoReflect.Descriptor instead.
-func (*Db_CreateDTakoFerryRowsRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use Db_CreateDTakoUriageKeihiRequest.ProtoReflect.Descriptor instead.
+func (*Db_CreateDTakoUriageKeihiRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *Db_CreateDTakoFerryRowsRequest) GetDtakoFerryRows() *Db_DTakoFerryRows {
+func (x *Db_CreateDTakoUriageKeihiRequest) GetDtakoUriageKeihi() *Db_DTakoUriageKeihi {
 	if x != nil {
-		return x.DtakoFerryRows
+		return x.DtakoUriageKeihi
 	}
 	return nil
 }
 
-type Db_GetDTakoFerryRowsRequest struct {
+type Db_GetDTakoUriageKeihiRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SrchId        string                 `protobuf:"bytes,1,opt,name=srch_id,json=srchId,proto3" json:"srch_id,omitempty"`
+	Datetime      string                 `protobuf:"bytes,2,opt,name=datetime,proto3" json:"datetime,omitempty"`
+	KeihiC        int32                  `protobuf:"varint,3,opt,name=keihi_c,json=keihiC,proto3" json:"keihi_c,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_GetDTakoFerryRowsRequest) Reset() {
-	*x = Db_GetDTakoFerryRowsRequest{}
-	mi := &file_db_service_proto_msgTypes[18]
+func (x *Db_GetDTakoUriageKeihiRequest) Reset() {
+	*x = Db_GetDTakoUriageKeihiRequest{}
+	mi := &file_db_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_GetDTakoFerryRowsRequest) String() string {
+func (x *Db_GetDTakoUriageKeihiRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_GetDTakoFerryRowsRequest) ProtoMessage() {}
+func (*Db_GetDTakoUriageKeihiRequest) ProtoMessage() {}
 
-func (x *Db_GetDTakoFerryRowsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[18]
+func (x *Db_GetDTakoUriageKeihiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1395,40 +1920,54 @@ func (x *Db_GetDTakoFerryRowsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_GetDTakoFerryRowsRequest.ProtoReflect.Descriptor instead.
-func (*Db_GetDTakoFerryRowsRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use Db_GetDTakoUriageKeihiRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetDTakoUriageKeihiRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *Db_GetDTakoFerryRowsRequest) GetId() int32 {
+func (x *Db_GetDTakoUriageKeihiRequest) GetSrchId() string {
 	if x != nil {
-		return x.Id
+		return x.SrchId
+	}
+	return ""
+}
+
+func (x *Db_GetDTakoUriageKeihiRequest) GetDatetime() string {
+	if x != nil {
+		return x.Datetime
+	}
+	return ""
+}
+
+func (x *Db_GetDTakoUriageKeihiRequest) GetKeihiC() int32 {
+	if x != nil {
+		return x.KeihiC
 	}
 	return 0
 }
 
-type Db_UpdateDTakoFerryRowsRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	DtakoFerryRows *Db_DTakoFerryRows     `protobuf:"bytes,1,opt,name=dtako_ferry_rows,json=dtakoFerryRows,proto3" json:"dtako_ferry_rows,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type Db_UpdateDTakoUriageKeihiRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	DtakoUriageKeihi *Db_DTakoUriageKeihi   `protobuf:"bytes,1,opt,name=dtako_uriage_keihi,json=dtakoUriageKeihi,proto3" json:"dtako_uriage_keihi,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *Db_UpdateDTakoFerryRowsRequest) Reset() {
-	*x = Db_UpdateDTakoFerryRowsRequest{}
-	mi := &file_db_service_proto_msgTypes[19]
+func (x *Db_UpdateDTakoUriageKeihiRequest) Reset() {
+	*x = Db_UpdateDTakoUriageKeihiRequest{}
+	mi := &file_db_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_UpdateDTakoFerryRowsRequest) String() string {
+func (x *Db_UpdateDTakoUriageKeihiRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_UpdateDTakoFerryRowsRequest) ProtoMessage() {}
+func (*Db_UpdateDTakoUriageKeihiRequest) ProtoMessage() {}
 
-func (x *Db_UpdateDTakoFerryRowsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[19]
+func (x *Db_UpdateDTakoUriageKeihiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1439,41 +1978,43 @@ func (x *Db_UpdateDTakoFerryRowsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_UpdateDTakoFerryRowsRequest.ProtoReflect.Descriptor instead.
-func (*Db_UpdateDTakoFerryRowsRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use Db_UpdateDTakoUriageKeihiRequest.ProtoReflect.Descriptor instead.
+func (*Db_UpdateDTakoUriageKeihiRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Db_UpdateDTakoFerryRowsRequest) GetDtakoFerryRows() *Db_DTakoFerryRows {
+func (x *Db_UpdateDTakoUriageKeihiRequest) GetDtakoUriageKeihi() *Db_DTakoUriageKeihi {
 	if x != nil {
-		return x.DtakoFerryRows
+		return x.DtakoUriageKeihi
 	}
 	return nil
 }
 
-type Db_DeleteDTakoFerryRowsRequest struct {
+type Db_DeleteDTakoUriageKeihiRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SrchId        string                 `protobuf:"bytes,1,opt,name=srch_id,json=srchId,proto3" json:"srch_id,omitempty"`
+	Datetime      string                 `protobuf:"bytes,2,opt,name=datetime,proto3" json:"datetime,omitempty"`
+	KeihiC        int32                  `protobuf:"varint,3,opt,name=keihi_c,json=keihiC,proto3" json:"keihi_c,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_DeleteDTakoFerryRowsRequest) Reset() {
-	*x = Db_DeleteDTakoFerryRowsRequest{}
-	mi := &file_db_service_proto_msgTypes[20]
+func (x *Db_DeleteDTakoUriageKeihiRequest) Reset() {
+	*x = Db_DeleteDTakoUriageKeihiRequest{}
+	mi := &file_db_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_DeleteDTakoFerryRowsRequest) String() string {
+func (x *Db_DeleteDTakoUriageKeihiRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_DeleteDTakoFerryRowsRequest) ProtoMessage() {}
+func (*Db_DeleteDTakoUriageKeihiRequest) ProtoMessage() {}
 
-func (x *Db_DeleteDTakoFerryRowsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[20]
-	if x != nil {
+func (x *Db_DeleteDTakoUriageKeihiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[6]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1483,44 +2024,60 @@ func (x *Db_DeleteDTakoFerryRowsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_DeleteDTakoFerryRowsRequest.ProtoReflect.Descriptor instead.
-func (*Db_DeleteDTakoFerryRowsRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use Db_DeleteDTakoUriageKeihiRequest.ProtoReflect.Descriptor instead.
+func (*Db_DeleteDTakoUriageKeihiRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Db_DeleteDTakoFerryRowsRequest) GetId() int32 {
+func (x *Db_DeleteDTakoUriageKeihiRequest) GetSrchId() string {
 	if x != nil {
-		return x.Id
+		return x.SrchId
+	}
+	return ""
+}
+
+func (x *Db_DeleteDTakoUriageKeihiRequest) GetDatetime() string {
+	if x != nil {
+		return x.Datetime
+	}
+	return ""
+}
+
+func (x *Db_DeleteDTakoUriageKeihiRequest) GetKeihiC() int32 {
+	if x != nil {
+		return x.KeihiC
 	}
 	return 0
 }
 
-type Db_ListDTakoFerryRowsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UnkoNo        *string                `protobuf:"bytes,1,opt,name=unko_no,json=unkoNo,proto3,oneof" json:"unko_no,omitempty"`
-	StartDate     *string                `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"`
-	EndDate       *string                `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
-	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type Db_ListDTakoUriageKeihiRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	DtakoRowId        *string                `protobuf:"bytes,1,opt,name=dtako_row_id,json=dtakoRowId,proto3,oneof" json:"dtako_row_id,omitempty"`
+	StartDate         *string                `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"`
+	EndDate           *string                `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
+	Limit             int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset            int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	PageToken         *string                `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`                      // キーセットページネーション用トークン（指定時はoffset/order_byは使用不可、初回は空文字）
+	IncludeTotalCount bool                   `protobuf:"varint,7,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"` // page_token使用時もtotal_countを取得する（COUNT(*)を実行）
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *Db_ListDTakoFerryRowsRequest) Reset() {
-	*x = Db_ListDTakoFerryRowsRequest{}
-	mi := &file_db_service_proto_msgTypes[21]
+func (x *Db_ListDTakoUriageKeihiRequest) Reset() {
+	*x = Db_ListDTakoUriageKeihiRequest{}
+	mi := &file_db_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ListDTakoFerryRowsRequest) String() string {
+func (x *Db_ListDTakoUriageKeihiRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_ListDTakoFerryRowsRequest) ProtoMessage() {}
+func (*Db_ListDTakoUriageKeihiRequest) ProtoMessage() {}
 
-func (x *Db_ListDTakoFerryRowsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[21]
+func (x *Db_ListDTakoUriageKeihiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1531,68 +2088,82 @@ func (x *Db_ListDTakoFerryRowsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_ListDTakoFerryRowsRequest.ProtoReflect.Descriptor instead.
-func (*Db_ListDTakoFerryRowsRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use Db_ListDTakoUriageKeihiRequest.ProtoReflect.Descriptor instead.
+func (*Db_ListDTakoUriageKeihiRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Db_ListDTakoFerryRowsRequest) GetUnkoNo() string {
-	if x != nil && x.UnkoNo != nil {
-		return *x.UnkoNo
+func (x *Db_ListDTakoUriageKeihiRequest) GetDtakoRowId() string {
+	if x != nil && x.DtakoRowId != nil {
+		return *x.DtakoRowId
 	}
 	return ""
 }
 
-func (x *Db_ListDTakoFerryRowsRequest) GetStartDate() string {
+func (x *Db_ListDTakoUriageKeihiRequest) GetStartDate() string {
 	if x != nil && x.StartDate != nil {
 		return *x.StartDate
 	}
 	return ""
 }
 
-func (x *Db_ListDTakoFerryRowsRequest) GetEndDate() string {
+func (x *Db_ListDTakoUriageKeihiRequest) GetEndDate() string {
 	if x != nil && x.EndDate != nil {
 		return *x.EndDate
 	}
 	return ""
 }
 
-func (x *Db_ListDTakoFerryRowsRequest) GetLimit() int32 {
+func (x *Db_ListDTakoUriageKeihiRequest) GetLimit() int32 {
 	if x != nil {
 		return x.Limit
 	}
 	return 0
 }
 
-func (x *Db_ListDTakoFerryRowsRequest) GetOffset() int32 {
+func (x *Db_ListDTakoUriageKeihiRequest) GetOffset() int32 {
 	if x != nil {
 		return x.Offset
 	}
 	return 0
 }
 
-type Db_DTakoFerryRowsResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	DtakoFerryRows *Db_DTakoFerryRows     `protobuf:"bytes,1,opt,name=dtako_ferry_rows,json=dtakoFerryRows,proto3" json:"dtako_ferry_rows,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *Db_ListDTakoUriageKeihiRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
 }
 
-func (x *Db_DTakoFerryRowsResponse) Reset() {
-	*x = Db_DTakoFerryRowsResponse{}
-	mi := &file_db_service_proto_msgTypes[22]
+func (x *Db_ListDTakoUriageKeihiRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+type Db_DTakoUriageKeihiResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	DtakoUriageKeihi *Db_DTakoUriageKeihi   `protobuf:"bytes,1,opt,name=dtako_uriage_keihi,json=dtakoUriageKeihi,proto3" json:"dtako_uriage_keihi,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Db_DTakoUriageKeihiResponse) Reset() {
+	*x = Db_DTakoUriageKeihiResponse{}
+	mi := &file_db_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_DTakoFerryRowsResponse) String() string {
+func (x *Db_DTakoUriageKeihiResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_DTakoFerryRowsResponse) ProtoMessage() {}
+func (*Db_DTakoUriageKeihiResponse) ProtoMessage() {}
 
-func (x *Db_DTakoFerryRowsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[22]
+func (x *Db_DTakoUriageKeihiResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1603,41 +2174,42 @@ func (x *Db_DTakoFerryRowsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_DTakoFerryRowsResponse.ProtoReflect.Descriptor instead.
-func (*Db_DTakoFerryRowsResponse) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use Db_DTakoUriageKeihiResponse.ProtoReflect.Descriptor instead.
+func (*Db_DTakoUriageKeihiResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Db_DTakoFerryRowsResponse) GetDtakoFerryRows() *Db_DTakoFerryRows {
+func (x *Db_DTakoUriageKeihiResponse) GetDtakoUriageKeihi() *Db_DTakoUriageKeihi {
 	if x != nil {
-		return x.DtakoFerryRows
+		return x.DtakoUriageKeihi
 	}
 	return nil
 }
 
-type Db_ListDTakoFerryRowsResponse struct {
+type Db_ListDTakoUriageKeihiResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*Db_DTakoFerryRows   `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Items         []*Db_DTakoUriageKeihi `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalCount    *int32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`     // page_token使用時はinclude_total_count指定時のみ設定
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 次ページのトークン（最終ページの場合は空）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_ListDTakoFerryRowsResponse) Reset() {
-	*x = Db_ListDTakoFerryRowsResponse{}
-	mi := &file_db_service_proto_msgTypes[23]
+func (x *Db_ListDTakoUriageKeihiResponse) Reset() {
+	*x = Db_ListDTakoUriageKeihiResponse{}
+	mi := &file_db_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ListDTakoFerryRowsResponse) String() string {
+func (x *Db_ListDTakoUriageKeihiResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_ListDTakoFerryRowsResponse) ProtoMessage() {}
+func (*Db_ListDTakoUriageKeihiResponse) ProtoMessage() {}
 
-func (x *Db_ListDTakoFerryRowsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[23]
+func (x *Db_ListDTakoUriageKeihiResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1648,54 +2220,55 @@ func (x *Db_ListDTakoFerryRowsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_ListDTakoFerryRowsResponse.ProtoReflect.Descriptor instead.
-func (*Db_ListDTakoFerryRowsResponse) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use Db_ListDTakoUriageKeihiResponse.ProtoReflect.Descriptor instead.
+func (*Db_ListDTakoUriageKeihiResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Db_ListDTakoFerryRowsResponse) GetItems() []*Db_DTakoFerryRows {
+func (x *Db_ListDTakoUriageKeihiResponse) GetItems() []*Db_DTakoUriageKeihi {
 	if x != nil {
 		return x.Items
 	}
 	return nil
 }
 
-func (x *Db_ListDTakoFerryRowsResponse) GetTotalCount() int32 {
-	if x != nil {
-		return x.TotalCount
+func (x *Db_ListDTakoUriageKeihiResponse) GetTotalCount() int32 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
 	}
 	return 0
 }
 
-// ETC明細マッピングデータ
-type Db_ETCMeisaiMapping struct {
+func (x *Db_ListDTakoUriageKeihiResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// ETCMeisai用
+type Db_CreateETCMeisaiRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	EtcMeisaiHash string                 `protobuf:"bytes,2,opt,name=etc_meisai_hash,json=etcMeisaiHash,proto3" json:"etc_meisai_hash,omitempty"`
-	DtakoRowId    string                 `protobuf:"bytes,3,opt,name=dtako_row_id,json=dtakoRowId,proto3" json:"dtako_row_id,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	CreatedBy     string                 `protobuf:"bytes,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	Notes         *string                `protobuf:"bytes,7,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	EtcMeisai     *Db_ETCMeisai          `protobuf:"bytes,1,opt,name=etc_meisai,json=etcMeisai,proto3" json:"etc_meisai,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_ETCMeisaiMapping) Reset() {
-	*x = Db_ETCMeisaiMapping{}
-	mi := &file_db_service_proto_msgTypes[24]
+func (x *Db_CreateETCMeisaiRequest) Reset() {
+	*x = Db_CreateETCMeisaiRequest{}
+	mi := &file_db_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ETCMeisaiMapping) String() string {
+func (x *Db_CreateETCMeisaiRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_ETCMeisaiMapping) ProtoMessage() {}
+func (*Db_CreateETCMeisaiRequest) ProtoMessage() {}
 
-func (x *Db_ETCMeisaiMapping) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[24]
+func (x *Db_CreateETCMeisaiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1706,83 +2279,40 @@ func (x *Db_ETCMeisaiMapping) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_ETCMeisaiMapping.ProtoReflect.Descriptor instead.
-func (*Db_ETCMeisaiMapping) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{24}
-}
-
-func (x *Db_ETCMeisaiMapping) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *Db_ETCMeisaiMapping) GetEtcMeisaiHash() string {
-	if x != nil {
-		return x.EtcMeisaiHash
-	}
-	return ""
-}
-
-func (x *Db_ETCMeisaiMapping) GetDtakoRowId() string {
-	if x != nil {
-		return x.DtakoRowId
-	}
-	return ""
-}
-
-func (x *Db_ETCMeisaiMapping) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *Db_ETCMeisaiMapping) GetUpdatedAt() string {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return ""
+// Deprecated: Use Db_CreateETCMeisaiRequest.ProtoReflect.Descriptor instead.
+func (*Db_CreateETCMeisaiRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Db_ETCMeisaiMapping) GetCreatedBy() string {
+func (x *Db_CreateETCMeisaiRequest) GetEtcMeisai() *Db_ETCMeisai {
 	if x != nil {
-		return x.CreatedBy
-	}
-	return ""
-}
-
-func (x *Db_ETCMeisaiMapping) GetNotes() string {
-	if x != nil && x.Notes != nil {
-		return *x.Notes
+		return x.EtcMeisai
 	}
-	return ""
+	return nil
 }
 
-// ETCMeisaiMapping用リクエスト/レスポンス
-type Db_CreateETCMeisaiMappingRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	EtcMeisaiMapping *Db_ETCMeisaiMapping   `protobuf:"bytes,1,opt,name=etc_meisai_mapping,json=etcMeisaiMapping,proto3" json:"etc_meisai_mapping,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type Db_GetETCMeisaiRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_CreateETCMeisaiMappingRequest) Reset() {
-	*x = Db_CreateETCMeisaiMappingRequest{}
-	mi := &file_db_service_proto_msgTypes[25]
+func (x *Db_GetETCMeisaiRequest) Reset() {
+	*x = Db_GetETCMeisaiRequest{}
+	mi := &file_db_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_CreateETCMeisaiMappingRequest) String() string {
+func (x *Db_GetETCMeisaiRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_CreateETCMeisaiMappingRequest) ProtoMessage() {}
+func (*Db_GetETCMeisaiRequest) ProtoMessage() {}
 
-func (x *Db_CreateETCMeisaiMappingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[25]
+func (x *Db_GetETCMeisaiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1793,40 +2323,40 @@ func (x *Db_CreateETCMeisaiMappingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_CreateETCMeisaiMappingRequest.ProtoReflect.Descriptor instead.
-func (*Db_CreateETCMeisaiMappingRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{25}
-}
-
-func (x *Db_CreateETCMeisaiMappingRequest) GetEtcMeisaiMapping() *Db_ETCMeisaiMapping {
+// Deprecated: Use Db_GetETCMeisaiRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetETCMeisaiRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Db_GetETCMeisaiRequest) GetId() int64 {
 	if x != nil {
-		return x.EtcMeisaiMapping
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type Db_GetETCMeisaiMappingRequest struct {
+type Db_UpdateETCMeisaiRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EtcMeisai     *Db_ETCMeisai          `protobuf:"bytes,1,opt,name=etc_meisai,json=etcMeisai,proto3" json:"etc_meisai,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_GetETCMeisaiMappingRequest) Reset() {
-	*x = Db_GetETCMeisaiMappingRequest{}
-	mi := &file_db_service_proto_msgTypes[26]
+func (x *Db_UpdateETCMeisaiRequest) Reset() {
+	*x = Db_UpdateETCMeisaiRequest{}
+	mi := &file_db_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_GetETCMeisaiMappingRequest) String() string {
+func (x *Db_UpdateETCMeisaiRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_GetETCMeisaiMappingRequest) ProtoMessage() {}
+func (*Db_UpdateETCMeisaiRequest) ProtoMessage() {}
 
-func (x *Db_GetETCMeisaiMappingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[26]
+func (x *Db_UpdateETCMeisaiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1837,40 +2367,40 @@ func (x *Db_GetETCMeisaiMappingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_GetETCMeisaiMappingRequest.ProtoReflect.Descriptor instead.
-func (*Db_GetETCMeisaiMappingRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use Db_UpdateETCMeisaiRequest.ProtoReflect.Descriptor instead.
+func (*Db_UpdateETCMeisaiRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *Db_GetETCMeisaiMappingRequest) GetId() int64 {
+func (x *Db_UpdateETCMeisaiRequest) GetEtcMeisai() *Db_ETCMeisai {
 	if x != nil {
-		return x.Id
+		return x.EtcMeisai
 	}
-	return 0
+	return nil
 }
 
-type Db_UpdateETCMeisaiMappingRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	EtcMeisaiMapping *Db_ETCMeisaiMapping   `protobuf:"bytes,1,opt,name=etc_meisai_mapping,json=etcMeisaiMapping,proto3" json:"etc_meisai_mapping,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type Db_DeleteETCMeisaiRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_UpdateETCMeisaiMappingRequest) Reset() {
-	*x = Db_UpdateETCMeisaiMappingRequest{}
-	mi := &file_db_service_proto_msgTypes[27]
+func (x *Db_DeleteETCMeisaiRequest) Reset() {
+	*x = Db_DeleteETCMeisaiRequest{}
+	mi := &file_db_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_UpdateETCMeisaiMappingRequest) String() string {
+func (x *Db_DeleteETCMeisaiRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_UpdateETCMeisaiMappingRequest) ProtoMessage() {}
+func (*Db_DeleteETCMeisaiRequest) ProtoMessage() {}
 
-func (x *Db_UpdateETCMeisaiMappingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[27]
+func (x *Db_DeleteETCMeisaiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1881,40 +2411,46 @@ func (x *Db_UpdateETCMeisaiMappingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_UpdateETCMeisaiMappingRequest.ProtoReflect.Descriptor instead.
-func (*Db_UpdateETCMeisaiMappingRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use Db_DeleteETCMeisaiRequest.ProtoReflect.Descriptor instead.
+func (*Db_DeleteETCMeisaiRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *Db_UpdateETCMeisaiMappingRequest) GetEtcMeisaiMapping() *Db_ETCMeisaiMapping {
+func (x *Db_DeleteETCMeisaiRequest) GetId() int64 {
 	if x != nil {
-		return x.EtcMeisaiMapping
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type Db_DeleteETCMeisaiMappingRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type Db_ListETCMeisaiRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Hash              *string                `protobuf:"bytes,1,opt,name=hash,proto3,oneof" json:"hash,omitempty"`
+	StartDate         *string                `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"`
+	EndDate           *string                `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
+	Limit             int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset            int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	PageToken         *string                `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`                      // キーセットページネーション用トークン（指定時はoffset/order_byは使用不可、初回は空文字）
+	IncludeTotalCount bool                   `protobuf:"varint,7,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"` // page_token使用時もtotal_countを取得する（COUNT(*)を実行）
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *Db_DeleteETCMeisaiMappingRequest) Reset() {
-	*x = Db_DeleteETCMeisaiMappingRequest{}
-	mi := &file_db_service_proto_msgTypes[28]
+func (x *Db_ListETCMeisaiRequest) Reset() {
+	*x = Db_ListETCMeisaiRequest{}
+	mi := &file_db_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_DeleteETCMeisaiMappingRequest) String() string {
+func (x *Db_ListETCMeisaiRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_DeleteETCMeisaiMappingRequest) ProtoMessage() {}
+func (*Db_ListETCMeisaiRequest) ProtoMessage() {}
 
-func (x *Db_DeleteETCMeisaiMappingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[28]
+func (x *Db_ListETCMeisaiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1925,43 +2461,85 @@ func (x *Db_DeleteETCMeisaiMappingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_DeleteETCMeisaiMappingRequest.ProtoReflect.Descriptor instead.
-func (*Db_DeleteETCMeisaiMappingRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use Db_ListETCMeisaiRequest.ProtoReflect.Descriptor instead.
+func (*Db_ListETCMeisaiRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Db_DeleteETCMeisaiMappingRequest) GetId() int64 {
+func (x *Db_ListETCMeisaiRequest) GetHash() string {
+	if x != nil && x.Hash != nil {
+		return *x.Hash
+	}
+	return ""
+}
+
+func (x *Db_ListETCMeisaiRequest) GetStartDate() string {
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
+	}
+	return ""
+}
+
+func (x *Db_ListETCMeisaiRequest) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
+	}
+	return ""
+}
+
+func (x *Db_ListETCMeisaiRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Id
+		return x.Limit
 	}
 	return 0
 }
 
-type Db_ListETCMeisaiMappingRequest struct {
+func (x *Db_ListETCMeisaiRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *Db_ListETCMeisaiRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+func (x *Db_ListETCMeisaiRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+type Db_StreamETCMeisaiRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EtcMeisaiHash *string                `protobuf:"bytes,1,opt,name=etc_meisai_hash,json=etcMeisaiHash,proto3,oneof" json:"etc_meisai_hash,omitempty"`
-	DtakoRowId    *string                `protobuf:"bytes,2,opt,name=dtako_row_id,json=dtakoRowId,proto3,oneof" json:"dtako_row_id,omitempty"`
-	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	Hash          *string                `protobuf:"bytes,1,opt,name=hash,proto3,oneof" json:"hash,omitempty"`
+	StartDate     *string                `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"` // date_toの開始（RFC3339形式）
+	EndDate       *string                `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`       // date_toの終了（RFC3339形式）
+	BatchSize     int32                  `protobuf:"varint,4,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`      // 1回のクエリで取得する件数（0の場合は100、最大1000）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_ListETCMeisaiMappingRequest) Reset() {
-	*x = Db_ListETCMeisaiMappingRequest{}
-	mi := &file_db_service_proto_msgTypes[29]
+func (x *Db_StreamETCMeisaiRequest) Reset() {
+	*x = Db_StreamETCMeisaiRequest{}
+	mi := &file_db_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ListETCMeisaiMappingRequest) String() string {
+func (x *Db_StreamETCMeisaiRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_ListETCMeisaiMappingRequest) ProtoMessage() {}
+func (*Db_StreamETCMeisaiRequest) ProtoMessage() {}
 
-func (x *Db_ListETCMeisaiMappingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[29]
+func (x *Db_StreamETCMeisaiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1972,61 +2550,105 @@ func (x *Db_ListETCMeisaiMappingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_ListETCMeisaiMappingRequest.ProtoReflect.Descriptor instead.
-func (*Db_ListETCMeisaiMappingRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use Db_StreamETCMeisaiRequest.ProtoReflect.Descriptor instead.
+func (*Db_StreamETCMeisaiRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *Db_ListETCMeisaiMappingRequest) GetEtcMeisaiHash() string {
-	if x != nil && x.EtcMeisaiHash != nil {
-		return *x.EtcMeisaiHash
+func (x *Db_StreamETCMeisaiRequest) GetHash() string {
+	if x != nil && x.Hash != nil {
+		return *x.Hash
 	}
 	return ""
 }
 
-func (x *Db_ListETCMeisaiMappingRequest) GetDtakoRowId() string {
-	if x != nil && x.DtakoRowId != nil {
-		return *x.DtakoRowId
+func (x *Db_StreamETCMeisaiRequest) GetStartDate() string {
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
 	}
 	return ""
 }
 
-func (x *Db_ListETCMeisaiMappingRequest) GetLimit() int32 {
+func (x *Db_StreamETCMeisaiRequest) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
+	}
+	return ""
+}
+
+func (x *Db_StreamETCMeisaiRequest) GetBatchSize() int32 {
 	if x != nil {
-		return x.Limit
+		return x.BatchSize
 	}
 	return 0
 }
 
-func (x *Db_ListETCMeisaiMappingRequest) GetOffset() int32 {
+type Db_ETCMeisaiResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EtcMeisai     *Db_ETCMeisai          `protobuf:"bytes,1,opt,name=etc_meisai,json=etcMeisai,proto3" json:"etc_meisai,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_ETCMeisaiResponse) Reset() {
+	*x = Db_ETCMeisaiResponse{}
+	mi := &file_db_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_ETCMeisaiResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_ETCMeisaiResponse) ProtoMessage() {}
+
+func (x *Db_ETCMeisaiResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[16]
 	if x != nil {
-		return x.Offset
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-type Db_ETCMeisaiMappingResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	EtcMeisaiMapping *Db_ETCMeisaiMapping   `protobuf:"bytes,1,opt,name=etc_meisai_mapping,json=etcMeisaiMapping,proto3" json:"etc_meisai_mapping,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// Deprecated: Use Db_ETCMeisaiResponse.ProtoReflect.Descriptor instead.
+func (*Db_ETCMeisaiResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *Db_ETCMeisaiMappingResponse) Reset() {
-	*x = Db_ETCMeisaiMappingResponse{}
-	mi := &file_db_service_proto_msgTypes[30]
+func (x *Db_ETCMeisaiResponse) GetEtcMeisai() *Db_ETCMeisai {
+	if x != nil {
+		return x.EtcMeisai
+	}
+	return nil
+}
+
+type Db_BatchCreateETCMeisaiRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Db_ETCMeisai        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // hashが空の場合はサーバー側で生成、idは無視（自動採番）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_BatchCreateETCMeisaiRequest) Reset() {
+	*x = Db_BatchCreateETCMeisaiRequest{}
+	mi := &file_db_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ETCMeisaiMappingResponse) String() string {
+func (x *Db_BatchCreateETCMeisaiRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_ETCMeisaiMappingResponse) ProtoMessage() {}
+func (*Db_BatchCreateETCMeisaiRequest) ProtoMessage() {}
 
-func (x *Db_ETCMeisaiMappingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[30]
+func (x *Db_BatchCreateETCMeisaiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2037,41 +2659,6760 @@ func (x *Db_ETCMeisaiMappingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_ETCMeisaiMappingResponse.ProtoReflect.Descriptor instead.
-func (*Db_ETCMeisaiMappingResponse) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use Db_BatchCreateETCMeisaiRequest.ProtoReflect.Descriptor instead.
+func (*Db_BatchCreateETCMeisaiRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *Db_ETCMeisaiMappingResponse) GetEtcMeisaiMapping() *Db_ETCMeisaiMapping {
+func (x *Db_BatchCreateETCMeisaiRequest) GetItems() []*Db_ETCMeisai {
 	if x != nil {
-		return x.EtcMeisaiMapping
+		return x.Items
 	}
 	return nil
 }
 
-type Db_ListETCMeisaiMappingResponse struct {
+type Db_BatchItemResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*Db_ETCMeisaiMapping `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"` // リクエスト内の位置（ストリーミングの場合は全メッセージを通した位置）
+	Status        Db_BatchItemStatus     `protobuf:"varint,2,opt,name=status,proto3,enum=db_service.Db_BatchItemStatus" json:"status,omitempty"`
+	Id            int64                  `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"` // 作成したID、DUPLICATEの場合は登録済みの明細のID
+	Hash          string                 `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"` // INVALID・DUPLICATEの理由
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_ListETCMeisaiMappingResponse) Reset() {
-	*x = Db_ListETCMeisaiMappingResponse{}
-	mi := &file_db_service_proto_msgTypes[31]
+func (x *Db_BatchItemResult) Reset() {
+	*x = Db_BatchItemResult{}
+	mi := &file_db_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ListETCMeisaiMappingResponse) String() string {
+func (x *Db_BatchItemResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_BatchItemResult) ProtoMessage() {}
+
+func (x *Db_BatchItemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_BatchItemResult.ProtoReflect.Descriptor instead.
+func (*Db_BatchItemResult) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Db_BatchItemResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *Db_BatchItemResult) GetStatus() Db_BatchItemStatus {
+	if x != nil {
+		return x.Status
+	}
+	return Db_BatchItemStatus_BATCH_ITEM_STATUS_UNSPECIFIED
+}
+
+func (x *Db_BatchItemResult) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Db_BatchItemResult) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *Db_BatchItemResult) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type Db_BatchCreateETCMeisaiResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Results        []*Db_BatchItemResult  `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	InsertedCount  int32                  `protobuf:"varint,2,opt,name=inserted_count,json=insertedCount,proto3" json:"inserted_count,omitempty"`
+	DuplicateCount int32                  `protobuf:"varint,3,opt,name=duplicate_count,json=duplicateCount,proto3" json:"duplicate_count,omitempty"`
+	InvalidCount   int32                  `protobuf:"varint,4,opt,name=invalid_count,json=invalidCount,proto3" json:"invalid_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Db_BatchCreateETCMeisaiResponse) Reset() {
+	*x = Db_BatchCreateETCMeisaiResponse{}
+	mi := &file_db_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_BatchCreateETCMeisaiResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_BatchCreateETCMeisaiResponse) ProtoMessage() {}
+
+func (x *Db_BatchCreateETCMeisaiResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_BatchCreateETCMeisaiResponse.ProtoReflect.Descriptor instead.
+func (*Db_BatchCreateETCMeisaiResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Db_BatchCreateETCMeisaiResponse) GetResults() []*Db_BatchItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *Db_BatchCreateETCMeisaiResponse) GetInsertedCount() int32 {
+	if x != nil {
+		return x.InsertedCount
+	}
+	return 0
+}
+
+func (x *Db_BatchCreateETCMeisaiResponse) GetDuplicateCount() int32 {
+	if x != nil {
+		return x.DuplicateCount
+	}
+	return 0
+}
+
+func (x *Db_BatchCreateETCMeisaiResponse) GetInvalidCount() int32 {
+	if x != nil {
+		return x.InvalidCount
+	}
+	return 0
+}
+
+type Db_ImportETCMeisaiRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Csv           []byte                 `protobuf:"bytes,1,opt,name=csv,proto3" json:"csv,omitempty"`                      // ダウンロードしたCSVファイルの内容
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // trueの場合は作成せずに結果のみ返す
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_ImportETCMeisaiRequest) Reset() {
+	*x = Db_ImportETCMeisaiRequest{}
+	mi := &file_db_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_ImportETCMeisaiRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_ImportETCMeisaiRequest) ProtoMessage() {}
+
+func (x *Db_ImportETCMeisaiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_ImportETCMeisaiRequest.ProtoReflect.Descriptor instead.
+func (*Db_ImportETCMeisaiRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Db_ImportETCMeisaiRequest) GetCsv() []byte {
+	if x != nil {
+		return x.Csv
+	}
+	return nil
+}
+
+func (x *Db_ImportETCMeisaiRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type Db_ImportETCMeisaiResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Results        []*Db_BatchItemResult  `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`                                   // indexはCSVの行番号（1始まり）、読み込めなかった行はINVALID
+	InsertedCount  int32                  `protobuf:"varint,2,opt,name=inserted_count,json=insertedCount,proto3" json:"inserted_count,omitempty"` // dry_runの場合は作成予定の件数（INSERTEDの明細のidは0）
+	DuplicateCount int32                  `protobuf:"varint,3,opt,name=duplicate_count,json=duplicateCount,proto3" json:"duplicate_count,omitempty"`
+	InvalidCount   int32                  `protobuf:"varint,4,opt,name=invalid_count,json=invalidCount,proto3" json:"invalid_count,omitempty"`
+	DryRun         bool                   `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Db_ImportETCMeisaiResponse) Reset() {
+	*x = Db_ImportETCMeisaiResponse{}
+	mi := &file_db_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_ImportETCMeisaiResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_ImportETCMeisaiResponse) ProtoMessage() {}
+
+func (x *Db_ImportETCMeisaiResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_ImportETCMeisaiResponse.ProtoReflect.Descriptor instead.
+func (*Db_ImportETCMeisaiResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Db_ImportETCMeisaiResponse) GetResults() []*Db_BatchItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *Db_ImportETCMeisaiResponse) GetInsertedCount() int32 {
+	if x != nil {
+		return x.InsertedCount
+	}
+	return 0
+}
+
+func (x *Db_ImportETCMeisaiResponse) GetDuplicateCount() int32 {
+	if x != nil {
+		return x.DuplicateCount
+	}
+	return 0
+}
+
+func (x *Db_ImportETCMeisaiResponse) GetInvalidCount() int32 {
+	if x != nil {
+		return x.InvalidCount
+	}
+	return 0
+}
+
+func (x *Db_ImportETCMeisaiResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type Db_ListETCMeisaiResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Db_ETCMeisai        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalCount    *int32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`     // page_token使用時はinclude_total_count指定時のみ設定
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 次ページのトークン（最終ページの場合は空）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_ListETCMeisaiResponse) Reset() {
+	*x = Db_ListETCMeisaiResponse{}
+	mi := &file_db_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_ListETCMeisaiResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_ListETCMeisaiResponse) ProtoMessage() {}
+
+func (x *Db_ListETCMeisaiResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_ListETCMeisaiResponse.ProtoReflect.Descriptor instead.
+func (*Db_ListETCMeisaiResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Db_ListETCMeisaiResponse) GetItems() []*Db_ETCMeisai {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Db_ListETCMeisaiResponse) GetTotalCount() int32 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
+	}
+	return 0
+}
+
+func (x *Db_ListETCMeisaiResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// DTakoFerryRows用
+type Db_CreateDTakoFerryRowsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DtakoFerryRows *Db_DTakoFerryRows     `protobuf:"bytes,1,opt,name=dtako_ferry_rows,json=dtakoFerryRows,proto3" json:"dtako_ferry_rows,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Db_CreateDTakoFerryRowsRequest) Reset() {
+	*x = Db_CreateDTakoFerryRowsRequest{}
+	mi := &file_db_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_CreateDTakoFerryRowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_CreateDTakoFerryRowsRequest) ProtoMessage() {}
+
+func (x *Db_CreateDTakoFerryRowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_CreateDTakoFerryRowsRequest.ProtoReflect.Descriptor instead.
+func (*Db_CreateDTakoFerryRowsRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Db_CreateDTakoFerryRowsRequest) GetDtakoFerryRows() *Db_DTakoFerryRows {
+	if x != nil {
+		return x.DtakoFerryRows
+	}
+	return nil
+}
+
+type Db_GetDTakoFerryRowsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_GetDTakoFerryRowsRequest) Reset() {
+	*x = Db_GetDTakoFerryRowsRequest{}
+	mi := &file_db_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_GetDTakoFerryRowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_GetDTakoFerryRowsRequest) ProtoMessage() {}
+
+func (x *Db_GetDTakoFerryRowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_GetDTakoFerryRowsRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetDTakoFerryRowsRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Db_GetDTakoFerryRowsRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type Db_UpdateDTakoFerryRowsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DtakoFerryRows *Db_DTakoFerryRows     `protobuf:"bytes,1,opt,name=dtako_ferry_rows,json=dtakoFerryRows,proto3" json:"dtako_ferry_rows,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Db_UpdateDTakoFerryRowsRequest) Reset() {
+	*x = Db_UpdateDTakoFerryRowsRequest{}
+	mi := &file_db_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_UpdateDTakoFerryRowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_UpdateDTakoFerryRowsRequest) ProtoMessage() {}
+
+func (x *Db_UpdateDTakoFerryRowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_UpdateDTakoFerryRowsRequest.ProtoReflect.Descriptor instead.
+func (*Db_UpdateDTakoFerryRowsRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Db_UpdateDTakoFerryRowsRequest) GetDtakoFerryRows() *Db_DTakoFerryRows {
+	if x != nil {
+		return x.DtakoFerryRows
+	}
+	return nil
+}
+
+type Db_DeleteDTakoFerryRowsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_DeleteDTakoFerryRowsRequest) Reset() {
+	*x = Db_DeleteDTakoFerryRowsRequest{}
+	mi := &file_db_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_DeleteDTakoFerryRowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_DeleteDTakoFerryRowsRequest) ProtoMessage() {}
+
+func (x *Db_DeleteDTakoFerryRowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_DeleteDTakoFerryRowsRequest.ProtoReflect.Descriptor instead.
+func (*Db_DeleteDTakoFerryRowsRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Db_DeleteDTakoFerryRowsRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type Db_ListDTakoFerryRowsRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UnkoNo            *string                `protobuf:"bytes,1,opt,name=unko_no,json=unkoNo,proto3,oneof" json:"unko_no,omitempty"`
+	StartDate         *string                `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"`
+	EndDate           *string                `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
+	Limit             int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset            int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	PageToken         *string                `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`                      // キーセットページネーション用トークン（指定時はoffset/order_byは使用不可、初回は空文字）
+	IncludeTotalCount bool                   `protobuf:"varint,7,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"` // page_token使用時もtotal_countを取得する（COUNT(*)を実行）
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Db_ListDTakoFerryRowsRequest) Reset() {
+	*x = Db_ListDTakoFerryRowsRequest{}
+	mi := &file_db_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_ListDTakoFerryRowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_ListDTakoFerryRowsRequest) ProtoMessage() {}
+
+func (x *Db_ListDTakoFerryRowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_ListDTakoFerryRowsRequest.ProtoReflect.Descriptor instead.
+func (*Db_ListDTakoFerryRowsRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Db_ListDTakoFerryRowsRequest) GetUnkoNo() string {
+	if x != nil && x.UnkoNo != nil {
+		return *x.UnkoNo
+	}
+	return ""
+}
+
+func (x *Db_ListDTakoFerryRowsRequest) GetStartDate() string {
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
+	}
+	return ""
+}
+
+func (x *Db_ListDTakoFerryRowsRequest) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
+	}
+	return ""
+}
+
+func (x *Db_ListDTakoFerryRowsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *Db_ListDTakoFerryRowsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *Db_ListDTakoFerryRowsRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+func (x *Db_ListDTakoFerryRowsRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+type Db_DTakoFerryRowsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DtakoFerryRows *Db_DTakoFerryRows     `protobuf:"bytes,1,opt,name=dtako_ferry_rows,json=dtakoFerryRows,proto3" json:"dtako_ferry_rows,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Db_DTakoFerryRowsResponse) Reset() {
+	*x = Db_DTakoFerryRowsResponse{}
+	mi := &file_db_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_DTakoFerryRowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_DTakoFerryRowsResponse) ProtoMessage() {}
+
+func (x *Db_DTakoFerryRowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_DTakoFerryRowsResponse.ProtoReflect.Descriptor instead.
+func (*Db_DTakoFerryRowsResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Db_DTakoFerryRowsResponse) GetDtakoFerryRows() *Db_DTakoFerryRows {
+	if x != nil {
+		return x.DtakoFerryRows
+	}
+	return nil
+}
+
+type Db_ListDTakoFerryRowsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Db_DTakoFerryRows   `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalCount    *int32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`     // page_token使用時はinclude_total_count指定時のみ設定
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 次ページのトークン（最終ページの場合は空）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_ListDTakoFerryRowsResponse) Reset() {
+	*x = Db_ListDTakoFerryRowsResponse{}
+	mi := &file_db_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_ListDTakoFerryRowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_ListDTakoFerryRowsResponse) ProtoMessage() {}
+
+func (x *Db_ListDTakoFerryRowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_ListDTakoFerryRowsResponse.ProtoReflect.Descriptor instead.
+func (*Db_ListDTakoFerryRowsResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Db_ListDTakoFerryRowsResponse) GetItems() []*Db_DTakoFerryRows {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Db_ListDTakoFerryRowsResponse) GetTotalCount() int32 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
+	}
+	return 0
+}
+
+func (x *Db_ListDTakoFerryRowsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// ETC明細マッピングデータ
+type Db_ETCMeisaiMapping struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EtcMeisaiHash string                 `protobuf:"bytes,2,opt,name=etc_meisai_hash,json=etcMeisaiHash,proto3" json:"etc_meisai_hash,omitempty"`
+	DtakoRowId    string                 `protobuf:"bytes,3,opt,name=dtako_row_id,json=dtakoRowId,proto3" json:"dtako_row_id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	Notes         *string                `protobuf:"bytes,7,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_ETCMeisaiMapping) Reset() {
+	*x = Db_ETCMeisaiMapping{}
+	mi := &file_db_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_ETCMeisaiMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_ETCMeisaiMapping) ProtoMessage() {}
+
+func (x *Db_ETCMeisaiMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_ETCMeisaiMapping.ProtoReflect.Descriptor instead.
+func (*Db_ETCMeisaiMapping) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Db_ETCMeisaiMapping) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Db_ETCMeisaiMapping) GetEtcMeisaiHash() string {
+	if x != nil {
+		return x.EtcMeisaiHash
+	}
+	return ""
+}
+
+func (x *Db_ETCMeisaiMapping) GetDtakoRowId() string {
+	if x != nil {
+		return x.DtakoRowId
+	}
+	return ""
+}
+
+func (x *Db_ETCMeisaiMapping) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Db_ETCMeisaiMapping) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Db_ETCMeisaiMapping) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Db_ETCMeisaiMapping) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
+	}
+	return ""
+}
+
+// ETCMeisaiMapping用リクエスト/レスポンス
+type Db_CreateETCMeisaiMappingRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	EtcMeisaiMapping *Db_ETCMeisaiMapping   `protobuf:"bytes,1,opt,name=etc_meisai_mapping,json=etcMeisaiMapping,proto3" json:"etc_meisai_mapping,omitempty"`
+	Upsert           bool                   `protobuf:"varint,2,opt,name=upsert,proto3" json:"upsert,omitempty"` // 同じetc_meisai_hash・dtako_row_idのマッピングがある場合はエラーにせずnotes（指定時）を更新して返す（再実行用）
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Db_CreateETCMeisaiMappingRequest) Reset() {
+	*x = Db_CreateETCMeisaiMappingRequest{}
+	mi := &file_db_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_CreateETCMeisaiMappingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_CreateETCMeisaiMappingRequest) ProtoMessage() {}
+
+func (x *Db_CreateETCMeisaiMappingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_CreateETCMeisaiMappingRequest.ProtoReflect.Descriptor instead.
+func (*Db_CreateETCMeisaiMappingRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Db_CreateETCMeisaiMappingRequest) GetEtcMeisaiMapping() *Db_ETCMeisaiMapping {
+	if x != nil {
+		return x.EtcMeisaiMapping
+	}
+	return nil
+}
+
+func (x *Db_CreateETCMeisaiMappingRequest) GetUpsert() bool {
+	if x != nil {
+		return x.Upsert
+	}
+	return false
+}
+
+type Db_GetETCMeisaiMappingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_GetETCMeisaiMappingRequest) Reset() {
+	*x = Db_GetETCMeisaiMappingRequest{}
+	mi := &file_db_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_GetETCMeisaiMappingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_GetETCMeisaiMappingRequest) ProtoMessage() {}
+
+func (x *Db_GetETCMeisaiMappingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_GetETCMeisaiMappingRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetETCMeisaiMappingRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Db_GetETCMeisaiMappingRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type Db_UpdateETCMeisaiMappingRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	EtcMeisaiMapping *Db_ETCMeisaiMapping   `protobuf:"bytes,1,opt,name=etc_meisai_mapping,json=etcMeisaiMapping,proto3" json:"etc_meisai_mapping,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Db_UpdateETCMeisaiMappingRequest) Reset() {
+	*x = Db_UpdateETCMeisaiMappingRequest{}
+	mi := &file_db_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_UpdateETCMeisaiMappingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_UpdateETCMeisaiMappingRequest) ProtoMessage() {}
+
+func (x *Db_UpdateETCMeisaiMappingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_UpdateETCMeisaiMappingRequest.ProtoReflect.Descriptor instead.
+func (*Db_UpdateETCMeisaiMappingRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Db_UpdateETCMeisaiMappingRequest) GetEtcMeisaiMapping() *Db_ETCMeisaiMapping {
+	if x != nil {
+		return x.EtcMeisaiMapping
+	}
+	return nil
+}
+
+type Db_DeleteETCMeisaiMappingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_DeleteETCMeisaiMappingRequest) Reset() {
+	*x = Db_DeleteETCMeisaiMappingRequest{}
+	mi := &file_db_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_DeleteETCMeisaiMappingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_DeleteETCMeisaiMappingRequest) ProtoMessage() {}
+
+func (x *Db_DeleteETCMeisaiMappingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_DeleteETCMeisaiMappingRequest.ProtoReflect.Descriptor instead.
+func (*Db_DeleteETCMeisaiMappingRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Db_DeleteETCMeisaiMappingRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type Db_ListETCMeisaiMappingRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	EtcMeisaiHash     *string                `protobuf:"bytes,1,opt,name=etc_meisai_hash,json=etcMeisaiHash,proto3,oneof" json:"etc_meisai_hash,omitempty"`
+	DtakoRowId        *string                `protobuf:"bytes,2,opt,name=dtako_row_id,json=dtakoRowId,proto3,oneof" json:"dtako_row_id,omitempty"`
+	Limit             int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset            int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	PageToken         *string                `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`                      // キーセットページネーション用トークン（指定時はoffset/order_byは使用不可、初回は空文字）
+	IncludeTotalCount bool                   `protobuf:"varint,6,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"` // page_token使用時もtotal_countを取得する（COUNT(*)を実行）
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Db_ListETCMeisaiMappingRequest) Reset() {
+	*x = Db_ListETCMeisaiMappingRequest{}
+	mi := &file_db_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_ListETCMeisaiMappingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_ListETCMeisaiMappingRequest) ProtoMessage() {}
+
+func (x *Db_ListETCMeisaiMappingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_ListETCMeisaiMappingRequest.ProtoReflect.Descriptor instead.
+func (*Db_ListETCMeisaiMappingRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Db_ListETCMeisaiMappingRequest) GetEtcMeisaiHash() string {
+	if x != nil && x.EtcMeisaiHash != nil {
+		return *x.EtcMeisaiHash
+	}
+	return ""
+}
+
+func (x *Db_ListETCMeisaiMappingRequest) GetDtakoRowId() string {
+	if x != nil && x.DtakoRowId != nil {
+		return *x.DtakoRowId
+	}
+	return ""
+}
+
+func (x *Db_ListETCMeisaiMappingRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *Db_ListETCMeisaiMappingRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *Db_ListETCMeisaiMappingRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+func (x *Db_ListETCMeisaiMappingRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+type Db_ETCMeisaiMappingResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	EtcMeisaiMapping *Db_ETCMeisaiMapping   `protobuf:"bytes,1,opt,name=etc_meisai_mapping,json=etcMeisaiMapping,proto3" json:"etc_meisai_mapping,omitempty"`
+	Created          bool                   `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"` // Createでマッピングを作成した場合はtrue（upsertで登録済みのマッピングを返した場合はfalse）
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Db_ETCMeisaiMappingResponse) Reset() {
+	*x = Db_ETCMeisaiMappingResponse{}
+	mi := &file_db_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_ETCMeisaiMappingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_ETCMeisaiMappingResponse) ProtoMessage() {}
+
+func (x *Db_ETCMeisaiMappingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_ETCMeisaiMappingResponse.ProtoReflect.Descriptor instead.
+func (*Db_ETCMeisaiMappingResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Db_ETCMeisaiMappingResponse) GetEtcMeisaiMapping() *Db_ETCMeisaiMapping {
+	if x != nil {
+		return x.EtcMeisaiMapping
+	}
+	return nil
+}
+
+func (x *Db_ETCMeisaiMappingResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+type Db_ListETCMeisaiMappingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Db_ETCMeisaiMapping `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalCount    *int32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`     // page_token使用時はinclude_total_count指定時のみ設定
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 次ページのトークン（最終ページの場合は空）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_ListETCMeisaiMappingResponse) Reset() {
+	*x = Db_ListETCMeisaiMappingResponse{}
+	mi := &file_db_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_ListETCMeisaiMappingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_ListETCMeisaiMappingResponse) ProtoMessage() {}
+
+func (x *Db_ListETCMeisaiMappingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_ListETCMeisaiMappingResponse.ProtoReflect.Descriptor instead.
+func (*Db_ListETCMeisaiMappingResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Db_ListETCMeisaiMappingResponse) GetItems() []*Db_ETCMeisaiMapping {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Db_ListETCMeisaiMappingResponse) GetTotalCount() int32 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
+	}
+	return 0
+}
+
+func (x *Db_ListETCMeisaiMappingResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type Db_GetDTakoRowIDByHashRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EtcMeisaiHash string                 `protobuf:"bytes,1,opt,name=etc_meisai_hash,json=etcMeisaiHash,proto3" json:"etc_meisai_hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_GetDTakoRowIDByHashRequest) Reset() {
+	*x = Db_GetDTakoRowIDByHashRequest{}
+	mi := &file_db_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_GetDTakoRowIDByHashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_GetDTakoRowIDByHashRequest) ProtoMessage() {}
+
+func (x *Db_GetDTakoRowIDByHashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_GetDTakoRowIDByHashRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetDTakoRowIDByHashRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *Db_GetDTakoRowIDByHashRequest) GetEtcMeisaiHash() string {
+	if x != nil {
+		return x.EtcMeisaiHash
+	}
+	return ""
+}
+
+type Db_GetDTakoRowIDByHashResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DtakoRowIds   []string               `protobuf:"bytes,1,rep,name=dtako_row_ids,json=dtakoRowIds,proto3" json:"dtako_row_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_GetDTakoRowIDByHashResponse) Reset() {
+	*x = Db_GetDTakoRowIDByHashResponse{}
+	mi := &file_db_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_GetDTakoRowIDByHashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_GetDTakoRowIDByHashResponse) ProtoMessage() {}
+
+func (x *Db_GetDTakoRowIDByHashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_GetDTakoRowIDByHashResponse.ProtoReflect.Descriptor instead.
+func (*Db_GetDTakoRowIDByHashResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *Db_GetDTakoRowIDByHashResponse) GetDtakoRowIds() []string {
+	if x != nil {
+		return x.DtakoRowIds
+	}
+	return nil
+}
+
+type Db_BulkReplaceETCMeisaiMappingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DtakoRowId    string                 `protobuf:"bytes,1,opt,name=dtako_row_id,json=dtakoRowId,proto3" json:"dtako_row_id,omitempty"`
+	Items         []*Db_ETCMeisaiMapping `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"` // 置き換え後のマッピング（dtako_row_idは省略可、id・created_at・updated_atは無視）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_BulkReplaceETCMeisaiMappingRequest) Reset() {
+	*x = Db_BulkReplaceETCMeisaiMappingRequest{}
+	mi := &file_db_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_BulkReplaceETCMeisaiMappingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_BulkReplaceETCMeisaiMappingRequest) ProtoMessage() {}
+
+func (x *Db_BulkReplaceETCMeisaiMappingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_BulkReplaceETCMeisaiMappingRequest.ProtoReflect.Descriptor instead.
+func (*Db_BulkReplaceETCMeisaiMappingRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Db_BulkReplaceETCMeisaiMappingRequest) GetDtakoRowId() string {
+	if x != nil {
+		return x.DtakoRowId
+	}
+	return ""
+}
+
+func (x *Db_BulkReplaceETCMeisaiMappingRequest) GetItems() []*Db_ETCMeisaiMapping {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type Db_BulkReplaceETCMeisaiMappingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Db_ETCMeisaiMapping `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`                                    // 作成したマッピング
+	DeletedCount  int32                  `protobuf:"varint,2,opt,name=deleted_count,json=deletedCount,proto3" json:"deleted_count,omitempty"` // 削除したマッピングの件数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_BulkReplaceETCMeisaiMappingResponse) Reset() {
+	*x = Db_BulkReplaceETCMeisaiMappingResponse{}
+	mi := &file_db_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_BulkReplaceETCMeisaiMappingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_BulkReplaceETCMeisaiMappingResponse) ProtoMessage() {}
+
+func (x *Db_BulkReplaceETCMeisaiMappingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_BulkReplaceETCMeisaiMappingResponse.ProtoReflect.Descriptor instead.
+func (*Db_BulkReplaceETCMeisaiMappingResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Db_BulkReplaceETCMeisaiMappingResponse) GetItems() []*Db_ETCMeisaiMapping {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Db_BulkReplaceETCMeisaiMappingResponse) GetDeletedCount() int32 {
+	if x != nil {
+		return x.DeletedCount
+	}
+	return 0
+}
+
+// ETCMeisaiMappingAudit用リクエスト/レスポンス
+type Db_AuditETCMeisaiMappingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kinds         []Db_MappingIssueKind  `protobuf:"varint,1,rep,packed,name=kinds,proto3,enum=db_service.Db_MappingIssueKind" json:"kinds,omitempty"` // 報告する問題の種類（空の場合は全て）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_AuditETCMeisaiMappingRequest) Reset() {
+	*x = Db_AuditETCMeisaiMappingRequest{}
+	mi := &file_db_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_AuditETCMeisaiMappingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_AuditETCMeisaiMappingRequest) ProtoMessage() {}
+
+func (x *Db_AuditETCMeisaiMappingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_AuditETCMeisaiMappingRequest.ProtoReflect.Descriptor instead.
+func (*Db_AuditETCMeisaiMappingRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Db_AuditETCMeisaiMappingRequest) GetKinds() []Db_MappingIssueKind {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+type Db_MappingIssue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          Db_MappingIssueKind    `protobuf:"varint,1,opt,name=kind,proto3,enum=db_service.Db_MappingIssueKind" json:"kind,omitempty"`
+	MappingIds    []int64                `protobuf:"varint,2,rep,packed,name=mapping_ids,json=mappingIds,proto3" json:"mapping_ids,omitempty"`
+	EtcMeisaiHash string                 `protobuf:"bytes,3,opt,name=etc_meisai_hash,json=etcMeisaiHash,proto3" json:"etc_meisai_hash,omitempty"` // ORPHAN_DTAKO_ROWの場合は空
+	DtakoRowIds   []string               `protobuf:"bytes,4,rep,name=dtako_row_ids,json=dtakoRowIds,proto3" json:"dtako_row_ids,omitempty"`       // ORPHAN_DTAKO_ROWの場合は存在しないID
+	EtcMeisaiId   int64                  `protobuf:"varint,5,opt,name=etc_meisai_id,json=etcMeisaiId,proto3" json:"etc_meisai_id,omitempty"`      // STALE_HASHのETC明細のID
+	ExpectedHash  string                 `protobuf:"bytes,6,opt,name=expected_hash,json=expectedHash,proto3" json:"expected_hash,omitempty"`      // STALE_HASHのETC明細の内容から生成したhash
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_MappingIssue) Reset() {
+	*x = Db_MappingIssue{}
+	mi := &file_db_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_MappingIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_MappingIssue) ProtoMessage() {}
+
+func (x *Db_MappingIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_MappingIssue.ProtoReflect.Descriptor instead.
+func (*Db_MappingIssue) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *Db_MappingIssue) GetKind() Db_MappingIssueKind {
+	if x != nil {
+		return x.Kind
+	}
+	return Db_MappingIssueKind_MAPPING_ISSUE_KIND_UNSPECIFIED
+}
+
+func (x *Db_MappingIssue) GetMappingIds() []int64 {
+	if x != nil {
+		return x.MappingIds
+	}
+	return nil
+}
+
+func (x *Db_MappingIssue) GetEtcMeisaiHash() string {
+	if x != nil {
+		return x.EtcMeisaiHash
+	}
+	return ""
+}
+
+func (x *Db_MappingIssue) GetDtakoRowIds() []string {
+	if x != nil {
+		return x.DtakoRowIds
+	}
+	return nil
+}
+
+func (x *Db_MappingIssue) GetEtcMeisaiId() int64 {
+	if x != nil {
+		return x.EtcMeisaiId
+	}
+	return 0
+}
+
+func (x *Db_MappingIssue) GetExpectedHash() string {
+	if x != nil {
+		return x.ExpectedHash
+	}
+	return ""
+}
+
+type Db_AuditETCMeisaiMappingResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	MappingCount        int32                  `protobuf:"varint,1,opt,name=mapping_count,json=mappingCount,proto3" json:"mapping_count,omitempty"` // チェックしたマッピングの件数
+	Issues              []*Db_MappingIssue     `protobuf:"bytes,2,rep,name=issues,proto3" json:"issues,omitempty"`                                  // 種類の順
+	OrphanHashCount     int32                  `protobuf:"varint,3,opt,name=orphan_hash_count,json=orphanHashCount,proto3" json:"orphan_hash_count,omitempty"`
+	OrphanDtakoRowCount int32                  `protobuf:"varint,4,opt,name=orphan_dtako_row_count,json=orphanDtakoRowCount,proto3" json:"orphan_dtako_row_count,omitempty"`
+	DuplicateHashCount  int32                  `protobuf:"varint,5,opt,name=duplicate_hash_count,json=duplicateHashCount,proto3" json:"duplicate_hash_count,omitempty"`
+	StaleHashCount      int32                  `protobuf:"varint,6,opt,name=stale_hash_count,json=staleHashCount,proto3" json:"stale_hash_count,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Db_AuditETCMeisaiMappingResponse) Reset() {
+	*x = Db_AuditETCMeisaiMappingResponse{}
+	mi := &file_db_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_AuditETCMeisaiMappingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_AuditETCMeisaiMappingResponse) ProtoMessage() {}
+
+func (x *Db_AuditETCMeisaiMappingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_AuditETCMeisaiMappingResponse.ProtoReflect.Descriptor instead.
+func (*Db_AuditETCMeisaiMappingResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *Db_AuditETCMeisaiMappingResponse) GetMappingCount() int32 {
+	if x != nil {
+		return x.MappingCount
+	}
+	return 0
+}
+
+func (x *Db_AuditETCMeisaiMappingResponse) GetIssues() []*Db_MappingIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *Db_AuditETCMeisaiMappingResponse) GetOrphanHashCount() int32 {
+	if x != nil {
+		return x.OrphanHashCount
+	}
+	return 0
+}
+
+func (x *Db_AuditETCMeisaiMappingResponse) GetOrphanDtakoRowCount() int32 {
+	if x != nil {
+		return x.OrphanDtakoRowCount
+	}
+	return 0
+}
+
+func (x *Db_AuditETCMeisaiMappingResponse) GetDuplicateHashCount() int32 {
+	if x != nil {
+		return x.DuplicateHashCount
+	}
+	return 0
+}
+
+func (x *Db_AuditETCMeisaiMappingResponse) GetStaleHashCount() int32 {
+	if x != nil {
+		return x.StaleHashCount
+	}
+	return 0
+}
+
+// ETCMeisaiMatcher用リクエスト/レスポンス
+type Db_AutoMatchETCMeisaiRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	StartDate            string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`                                     // 照合するETC明細のdate_toの開始（RFC3339形式）
+	EndDate              string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                                           // 照合するETC明細のdate_toの終了（RFC3339形式）
+	DryRun               bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                                             // trueの場合はマッピングを作成せず照合結果のみ返す
+	MinConfidence        float64                `protobuf:"fixed64,4,opt,name=min_confidence,json=minConfidence,proto3" json:"min_confidence,omitempty"`                       // マッピングを作成する信頼度のしきい値（0の場合は0.8）
+	IncludeAlreadyMapped bool                   `protobuf:"varint,5,opt,name=include_already_mapped,json=includeAlreadyMapped,proto3" json:"include_already_mapped,omitempty"` // マッピング登録済みの明細も結果に含める
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Db_AutoMatchETCMeisaiRequest) Reset() {
+	*x = Db_AutoMatchETCMeisaiRequest{}
+	mi := &file_db_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_AutoMatchETCMeisaiRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_AutoMatchETCMeisaiRequest) ProtoMessage() {}
+
+func (x *Db_AutoMatchETCMeisaiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_AutoMatchETCMeisaiRequest.ProtoReflect.Descriptor instead.
+func (*Db_AutoMatchETCMeisaiRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *Db_AutoMatchETCMeisaiRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *Db_AutoMatchETCMeisaiRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *Db_AutoMatchETCMeisaiRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *Db_AutoMatchETCMeisaiRequest) GetMinConfidence() float64 {
+	if x != nil {
+		return x.MinConfidence
+	}
+	return 0
+}
+
+func (x *Db_AutoMatchETCMeisaiRequest) GetIncludeAlreadyMapped() bool {
+	if x != nil {
+		return x.IncludeAlreadyMapped
+	}
+	return false
+}
+
+type Db_AutoMatchCandidate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DtakoRowId    string                 `protobuf:"bytes,1,opt,name=dtako_row_id,json=dtakoRowId,proto3" json:"dtako_row_id,omitempty"`
+	CarId         string                 `protobuf:"bytes,2,opt,name=car_id,json=carId,proto3" json:"car_id,omitempty"`
+	Confidence    float64                `protobuf:"fixed64,3,opt,name=confidence,proto3" json:"confidence,omitempty"` // 1.0: 入口・出口とも運行中, 0.9: 入口不明で出口が運行中, 0.5: 一方のみ運行中
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_AutoMatchCandidate) Reset() {
+	*x = Db_AutoMatchCandidate{}
+	mi := &file_db_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_AutoMatchCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_AutoMatchCandidate) ProtoMessage() {}
+
+func (x *Db_AutoMatchCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_AutoMatchCandidate.ProtoReflect.Descriptor instead.
+func (*Db_AutoMatchCandidate) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *Db_AutoMatchCandidate) GetDtakoRowId() string {
+	if x != nil {
+		return x.DtakoRowId
+	}
+	return ""
+}
+
+func (x *Db_AutoMatchCandidate) GetCarId() string {
+	if x != nil {
+		return x.CarId
+	}
+	return ""
+}
+
+func (x *Db_AutoMatchCandidate) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+type Db_AutoMatchResult struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	EtcMeisaiId   int64                    `protobuf:"varint,1,opt,name=etc_meisai_id,json=etcMeisaiId,proto3" json:"etc_meisai_id,omitempty"`
+	EtcMeisaiHash string                   `protobuf:"bytes,2,opt,name=etc_meisai_hash,json=etcMeisaiHash,proto3" json:"etc_meisai_hash,omitempty"`
+	EtcNum        string                   `protobuf:"bytes,3,opt,name=etc_num,json=etcNum,proto3" json:"etc_num,omitempty"`
+	DateTo        string                   `protobuf:"bytes,4,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"` // RFC3339形式
+	Status        Db_AutoMatchStatus       `protobuf:"varint,5,opt,name=status,proto3,enum=db_service.Db_AutoMatchStatus" json:"status,omitempty"`
+	Candidates    []*Db_AutoMatchCandidate `protobuf:"bytes,6,rep,name=candidates,proto3" json:"candidates,omitempty"`                 // 信頼度の高い順
+	Reason        string                   `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`                         // MATCHED以外の理由
+	MappingId     int64                    `protobuf:"varint,8,opt,name=mapping_id,json=mappingId,proto3" json:"mapping_id,omitempty"` // 作成したマッピングのID（作成しなかった場合は0）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_AutoMatchResult) Reset() {
+	*x = Db_AutoMatchResult{}
+	mi := &file_db_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_AutoMatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_AutoMatchResult) ProtoMessage() {}
+
+func (x *Db_AutoMatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_AutoMatchResult.ProtoReflect.Descriptor instead.
+func (*Db_AutoMatchResult) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *Db_AutoMatchResult) GetEtcMeisaiId() int64 {
+	if x != nil {
+		return x.EtcMeisaiId
+	}
+	return 0
+}
+
+func (x *Db_AutoMatchResult) GetEtcMeisaiHash() string {
+	if x != nil {
+		return x.EtcMeisaiHash
+	}
+	return ""
+}
+
+func (x *Db_AutoMatchResult) GetEtcNum() string {
+	if x != nil {
+		return x.EtcNum
+	}
+	return ""
+}
+
+func (x *Db_AutoMatchResult) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+func (x *Db_AutoMatchResult) GetStatus() Db_AutoMatchStatus {
+	if x != nil {
+		return x.Status
+	}
+	return Db_AutoMatchStatus_AUTO_MATCH_STATUS_UNSPECIFIED
+}
+
+func (x *Db_AutoMatchResult) GetCandidates() []*Db_AutoMatchCandidate {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+func (x *Db_AutoMatchResult) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Db_AutoMatchResult) GetMappingId() int64 {
+	if x != nil {
+		return x.MappingId
+	}
+	return 0
+}
+
+type Db_AutoMatchETCMeisaiResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Results            []*Db_AutoMatchResult  `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // date_toの古い順
+	DryRun             bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	MatchedCount       int32                  `protobuf:"varint,3,opt,name=matched_count,json=matchedCount,proto3" json:"matched_count,omitempty"`
+	AmbiguousCount     int32                  `protobuf:"varint,4,opt,name=ambiguous_count,json=ambiguousCount,proto3" json:"ambiguous_count,omitempty"`
+	UnmatchedCount     int32                  `protobuf:"varint,5,opt,name=unmatched_count,json=unmatchedCount,proto3" json:"unmatched_count,omitempty"`
+	AlreadyMappedCount int32                  `protobuf:"varint,6,opt,name=already_mapped_count,json=alreadyMappedCount,proto3" json:"already_mapped_count,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Db_AutoMatchETCMeisaiResponse) Reset() {
+	*x = Db_AutoMatchETCMeisaiResponse{}
+	mi := &file_db_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_AutoMatchETCMeisaiResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_AutoMatchETCMeisaiResponse) ProtoMessage() {}
+
+func (x *Db_AutoMatchETCMeisaiResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_AutoMatchETCMeisaiResponse.ProtoReflect.Descriptor instead.
+func (*Db_AutoMatchETCMeisaiResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *Db_AutoMatchETCMeisaiResponse) GetResults() []*Db_AutoMatchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *Db_AutoMatchETCMeisaiResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *Db_AutoMatchETCMeisaiResponse) GetMatchedCount() int32 {
+	if x != nil {
+		return x.MatchedCount
+	}
+	return 0
+}
+
+func (x *Db_AutoMatchETCMeisaiResponse) GetAmbiguousCount() int32 {
+	if x != nil {
+		return x.AmbiguousCount
+	}
+	return 0
+}
+
+func (x *Db_AutoMatchETCMeisaiResponse) GetUnmatchedCount() int32 {
+	if x != nil {
+		return x.UnmatchedCount
+	}
+	return 0
+}
+
+func (x *Db_AutoMatchETCMeisaiResponse) GetAlreadyMappedCount() int32 {
+	if x != nil {
+		return x.AlreadyMappedCount
+	}
+	return 0
+}
+
+// 車輌マスタデータ（本番DB）
+type Db_DTakoCars struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CarCode             string                 `protobuf:"bytes,2,opt,name=car_code,json=carCode,proto3" json:"car_code,omitempty"`
+	CarCc               string                 `protobuf:"bytes,3,opt,name=car_cc,json=carCc,proto3" json:"car_cc,omitempty"`
+	CarName             string                 `protobuf:"bytes,4,opt,name=car_name,json=carName,proto3" json:"car_name,omitempty"`
+	BelongOfficeCode    int32                  `protobuf:"varint,5,opt,name=belong_office_code,json=belongOfficeCode,proto3" json:"belong_office_code,omitempty"`
+	HighwayCarType      int32                  `protobuf:"varint,6,opt,name=highway_car_type,json=highwayCarType,proto3" json:"highway_car_type,omitempty"`
+	FerryCarType        int32                  `protobuf:"varint,7,opt,name=ferry_car_type,json=ferryCarType,proto3" json:"ferry_car_type,omitempty"`
+	EvaluationClassCode int32                  `protobuf:"varint,8,opt,name=evaluation_class_code,json=evaluationClassCode,proto3" json:"evaluation_class_code,omitempty"`
+	IdlingType          int32                  `protobuf:"varint,9,opt,name=idling_type,json=idlingType,proto3" json:"idling_type,omitempty"`
+	MaxLoadWeightKg     int32                  `protobuf:"varint,10,opt,name=max_load_weight_kg,json=maxLoadWeightKg,proto3" json:"max_load_weight_kg,omitempty"`
+	CarClass1           int32                  `protobuf:"varint,11,opt,name=car_class1,json=carClass1,proto3" json:"car_class1,omitempty"`
+	CarClass2           int32                  `protobuf:"varint,12,opt,name=car_class2,json=carClass2,proto3" json:"car_class2,omitempty"`
+	CarClass3           int32                  `protobuf:"varint,13,opt,name=car_class3,json=carClass3,proto3" json:"car_class3,omitempty"`
+	CarClass4           int32                  `protobuf:"varint,14,opt,name=car_class4,json=carClass4,proto3" json:"car_class4,omitempty"`
+	CarClass5           int32                  `protobuf:"varint,15,opt,name=car_class5,json=carClass5,proto3" json:"car_class5,omitempty"`
+	OperationType       int32                  `protobuf:"varint,16,opt,name=operation_type,json=operationType,proto3" json:"operation_type,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Db_DTakoCars) Reset() {
+	*x = Db_DTakoCars{}
+	mi := &file_db_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_DTakoCars) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_DTakoCars) ProtoMessage() {}
+
+func (x *Db_DTakoCars) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_DTakoCars.ProtoReflect.Descriptor instead.
+func (*Db_DTakoCars) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *Db_DTakoCars) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Db_DTakoCars) GetCarCode() string {
+	if x != nil {
+		return x.CarCode
+	}
+	return ""
+}
+
+func (x *Db_DTakoCars) GetCarCc() string {
+	if x != nil {
+		return x.CarCc
+	}
+	return ""
+}
+
+func (x *Db_DTakoCars) GetCarName() string {
+	if x != nil {
+		return x.CarName
+	}
+	return ""
+}
+
+func (x *Db_DTakoCars) GetBelongOfficeCode() int32 {
+	if x != nil {
+		return x.BelongOfficeCode
+	}
+	return 0
+}
+
+func (x *Db_DTakoCars) GetHighwayCarType() int32 {
+	if x != nil {
+		return x.HighwayCarType
+	}
+	return 0
+}
+
+func (x *Db_DTakoCars) GetFerryCarType() int32 {
+	if x != nil {
+		return x.FerryCarType
+	}
+	return 0
+}
+
+func (x *Db_DTakoCars) GetEvaluationClassCode() int32 {
+	if x != nil {
+		return x.EvaluationClassCode
+	}
+	return 0
+}
+
+func (x *Db_DTakoCars) GetIdlingType() int32 {
+	if x != nil {
+		return x.IdlingType
+	}
+	return 0
+}
+
+func (x *Db_DTakoCars) GetMaxLoadWeightKg() int32 {
+	if x != nil {
+		return x.MaxLoadWeightKg
+	}
+	return 0
+}
+
+func (x *Db_DTakoCars) GetCarClass1() int32 {
+	if x != nil {
+		return x.CarClass1
+	}
+	return 0
+}
+
+func (x *Db_DTakoCars) GetCarClass2() int32 {
+	if x != nil {
+		return x.CarClass2
+	}
+	return 0
+}
+
+func (x *Db_DTakoCars) GetCarClass3() int32 {
+	if x != nil {
+		return x.CarClass3
+	}
+	return 0
+}
+
+func (x *Db_DTakoCars) GetCarClass4() int32 {
+	if x != nil {
+		return x.CarClass4
+	}
+	return 0
+}
+
+func (x *Db_DTakoCars) GetCarClass5() int32 {
+	if x != nil {
+		return x.CarClass5
+	}
+	return 0
+}
+
+func (x *Db_DTakoCars) GetOperationType() int32 {
+	if x != nil {
+		return x.OperationType
+	}
+	return 0
+}
+
+// イベント情報データ（本番DB）
+type Db_DTakoEvents struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OperationNo       string                 `protobuf:"bytes,2,opt,name=operation_no,json=operationNo,proto3" json:"operation_no,omitempty"`
+	ReadDate          string                 `protobuf:"bytes,3,opt,name=read_date,json=readDate,proto3" json:"read_date,omitempty"` // RFC3339形式
+	CarCode           int32                  `protobuf:"varint,4,opt,name=car_code,json=carCode,proto3" json:"car_code,omitempty"`
+	CarCc             string                 `protobuf:"bytes,5,opt,name=car_cc,json=carCc,proto3" json:"car_cc,omitempty"`
+	TargetDriverType  int32                  `protobuf:"varint,6,opt,name=target_driver_type,json=targetDriverType,proto3" json:"target_driver_type,omitempty"`
+	DriverCode1       int32                  `protobuf:"varint,7,opt,name=driver_code1,json=driverCode1,proto3" json:"driver_code1,omitempty"`
+	TargetDriverCode  int32                  `protobuf:"varint,8,opt,name=target_driver_code,json=targetDriverCode,proto3" json:"target_driver_code,omitempty"`
+	StartDatetime     string                 `protobuf:"bytes,9,opt,name=start_datetime,json=startDatetime,proto3" json:"start_datetime,omitempty"` // RFC3339形式
+	EndDatetime       string                 `protobuf:"bytes,10,opt,name=end_datetime,json=endDatetime,proto3" json:"end_datetime,omitempty"`      // RFC3339形式
+	EventCode         *int32                 `protobuf:"varint,11,opt,name=event_code,json=eventCode,proto3,oneof" json:"event_code,omitempty"`
+	EventName         string                 `protobuf:"bytes,12,opt,name=event_name,json=eventName,proto3" json:"event_name,omitempty"`
+	StartMileage      float64                `protobuf:"fixed64,13,opt,name=start_mileage,json=startMileage,proto3" json:"start_mileage,omitempty"`
+	EndMileage        float64                `protobuf:"fixed64,14,opt,name=end_mileage,json=endMileage,proto3" json:"end_mileage,omitempty"`
+	SectionTime       int32                  `protobuf:"varint,15,opt,name=section_time,json=sectionTime,proto3" json:"section_time,omitempty"`
+	SectionDistance   float64                `protobuf:"fixed64,16,opt,name=section_distance,json=sectionDistance,proto3" json:"section_distance,omitempty"`
+	StartCityCode     *int32                 `protobuf:"varint,17,opt,name=start_city_code,json=startCityCode,proto3,oneof" json:"start_city_code,omitempty"`
+	StartCityName     string                 `protobuf:"bytes,18,opt,name=start_city_name,json=startCityName,proto3" json:"start_city_name,omitempty"`
+	EndCityCode       *int32                 `protobuf:"varint,19,opt,name=end_city_code,json=endCityCode,proto3,oneof" json:"end_city_code,omitempty"`
+	EndCityName       string                 `protobuf:"bytes,20,opt,name=end_city_name,json=endCityName,proto3" json:"end_city_name,omitempty"`
+	StartPlaceCode    *int32                 `protobuf:"varint,21,opt,name=start_place_code,json=startPlaceCode,proto3,oneof" json:"start_place_code,omitempty"`
+	StartPlaceName    string                 `protobuf:"bytes,22,opt,name=start_place_name,json=startPlaceName,proto3" json:"start_place_name,omitempty"`
+	EndPlaceCode      *int32                 `protobuf:"varint,23,opt,name=end_place_code,json=endPlaceCode,proto3,oneof" json:"end_place_code,omitempty"`
+	EndPlaceName      string                 `protobuf:"bytes,24,opt,name=end_place_name,json=endPlaceName,proto3" json:"end_place_name,omitempty"`
+	StartGpsValid     *int32                 `protobuf:"varint,25,opt,name=start_gps_valid,json=startGpsValid,proto3,oneof" json:"start_gps_valid,omitempty"`
+	StartGpsLatitude  *int64                 `protobuf:"varint,26,opt,name=start_gps_latitude,json=startGpsLatitude,proto3,oneof" json:"start_gps_latitude,omitempty"`
+	StartGpsLongitude *int64                 `protobuf:"varint,27,opt,name=start_gps_longitude,json=startGpsLongitude,proto3,oneof" json:"start_gps_longitude,omitempty"`
+	EndGpsValid       *int32                 `protobuf:"varint,28,opt,name=end_gps_valid,json=endGpsValid,proto3,oneof" json:"end_gps_valid,omitempty"`
+	EndGpsLatitude    *int64                 `protobuf:"varint,29,opt,name=end_gps_latitude,json=endGpsLatitude,proto3,oneof" json:"end_gps_latitude,omitempty"`
+	EndGpsLongitude   *int64                 `protobuf:"varint,30,opt,name=end_gps_longitude,json=endGpsLongitude,proto3,oneof" json:"end_gps_longitude,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Db_DTakoEvents) Reset() {
+	*x = Db_DTakoEvents{}
+	mi := &file_db_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_DTakoEvents) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_DTakoEvents) ProtoMessage() {}
+
+func (x *Db_DTakoEvents) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_DTakoEvents.ProtoReflect.Descriptor instead.
+func (*Db_DTakoEvents) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *Db_DTakoEvents) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Db_DTakoEvents) GetOperationNo() string {
+	if x != nil {
+		return x.OperationNo
+	}
+	return ""
+}
+
+func (x *Db_DTakoEvents) GetReadDate() string {
+	if x != nil {
+		return x.ReadDate
+	}
+	return ""
+}
+
+func (x *Db_DTakoEvents) GetCarCode() int32 {
+	if x != nil {
+		return x.CarCode
+	}
+	return 0
+}
+
+func (x *Db_DTakoEvents) GetCarCc() string {
+	if x != nil {
+		return x.CarCc
+	}
+	return ""
+}
+
+func (x *Db_DTakoEvents) GetTargetDriverType() int32 {
+	if x != nil {
+		return x.TargetDriverType
+	}
+	return 0
+}
+
+func (x *Db_DTakoEvents) GetDriverCode1() int32 {
+	if x != nil {
+		return x.DriverCode1
+	}
+	return 0
+}
+
+func (x *Db_DTakoEvents) GetTargetDriverCode() int32 {
+	if x != nil {
+		return x.TargetDriverCode
+	}
+	return 0
+}
+
+func (x *Db_DTakoEvents) GetStartDatetime() string {
+	if x != nil {
+		return x.StartDatetime
+	}
+	return ""
+}
+
+func (x *Db_DTakoEvents) GetEndDatetime() string {
+	if x != nil {
+		return x.EndDatetime
+	}
+	return ""
+}
+
+func (x *Db_DTakoEvents) GetEventCode() int32 {
+	if x != nil && x.EventCode != nil {
+		return *x.EventCode
+	}
+	return 0
+}
+
+func (x *Db_DTakoEvents) GetEventName() string {
+	if x != nil {
+		return x.EventName
+	}
+	return ""
+}
+
+func (x *Db_DTakoEvents) GetStartMileage() float64 {
+	if x != nil {
+		return x.StartMileage
+	}
+	return 0
+}
+
+func (x *Db_DTakoEvents) GetEndMileage() float64 {
+	if x != nil {
+		return x.EndMileage
+	}
+	return 0
+}
+
+func (x *Db_DTakoEvents) GetSectionTime() int32 {
+	if x != nil {
+		return x.SectionTime
+	}
+	return 0
+}
+
+func (x *Db_DTakoEvents) GetSectionDistance() float64 {
+	if x != nil {
+		return x.SectionDistance
+	}
+	return 0
+}
+
+func (x *Db_DTakoEvents) GetStartCityCode() int32 {
+	if x != nil && x.StartCityCode != nil {
+		return *x.StartCityCode
+	}
+	return 0
+}
+
+func (x *Db_DTakoEvents) GetStartCityName() string {
+	if x != nil {
+		return x.StartCityName
+	}
+	return ""
+}
+
+func (x *Db_DTakoEvents) GetEndCityCode() int32 {
+	if x != nil && x.EndCityCode != nil {
+		return *x.EndCityCode
+	}
+	return 0
+}
+
+func (x *Db_DTakoEvents) GetEndCityName() string {
+	if x != nil {
+		return x.EndCityName
+	}
+	return ""
+}
+
+func (x *Db_DTakoEvents) GetStartPlaceCode() int32 {
+	if x != nil && x.StartPlaceCode != nil {
+		return *x.StartPlaceCode
+	}
+	return 0
+}
+
+func (x *Db_DTakoEvents) GetStartPlaceName() string {
+	if x != nil {
+		return x.StartPlaceName
+	}
+	return ""
+}
+
+func (x *Db_DTakoEvents) GetEndPlaceCode() int32 {
+	if x != nil && x.EndPlaceCode != nil {
+		return *x.EndPlaceCode
+	}
+	return 0
+}
+
+func (x *Db_DTakoEvents) GetEndPlaceName() string {
+	if x != nil {
+		return x.EndPlaceName
+	}
+	return ""
+}
+
+func (x *Db_DTakoEvents) GetStartGpsValid() int32 {
+	if x != nil && x.StartGpsValid != nil {
+		return *x.StartGpsValid
+	}
+	return 0
+}
+
+func (x *Db_DTakoEvents) GetStartGpsLatitude() int64 {
+	if x != nil && x.StartGpsLatitude != nil {
+		return *x.StartGpsLatitude
+	}
+	return 0
+}
+
+func (x *Db_DTakoEvents) GetStartGpsLongitude() int64 {
+	if x != nil && x.StartGpsLongitude != nil {
+		return *x.StartGpsLongitude
+	}
+	return 0
+}
+
+func (x *Db_DTakoEvents) GetEndGpsValid() int32 {
+	if x != nil && x.EndGpsValid != nil {
+		return *x.EndGpsValid
+	}
+	return 0
+}
+
+func (x *Db_DTakoEvents) GetEndGpsLatitude() int64 {
+	if x != nil && x.EndGpsLatitude != nil {
+		return *x.EndGpsLatitude
+	}
+	return 0
+}
+
+func (x *Db_DTakoEvents) GetEndGpsLongitude() int64 {
+	if x != nil && x.EndGpsLongitude != nil {
+		return *x.EndGpsLongitude
+	}
+	return 0
+}
+
+// 運行データ（本番DB）
+type Db_DTakoRows struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OperationNo          string                 `protobuf:"bytes,2,opt,name=operation_no,json=operationNo,proto3" json:"operation_no,omitempty"`
+	ReadDate             string                 `protobuf:"bytes,3,opt,name=read_date,json=readDate,proto3" json:"read_date,omitempty"`                // RFC3339形式
+	OperationDate        string                 `protobuf:"bytes,4,opt,name=operation_date,json=operationDate,proto3" json:"operation_date,omitempty"` // RFC3339形式
+	CarCode              int32                  `protobuf:"varint,5,opt,name=car_code,json=carCode,proto3" json:"car_code,omitempty"`
+	CarCc                string                 `protobuf:"bytes,6,opt,name=car_cc,json=carCc,proto3" json:"car_cc,omitempty"`
+	DriverCode1          *int32                 `protobuf:"varint,7,opt,name=driver_code1,json=driverCode1,proto3,oneof" json:"driver_code1,omitempty"`
+	TargetDriverType     int32                  `protobuf:"varint,8,opt,name=target_driver_type,json=targetDriverType,proto3" json:"target_driver_type,omitempty"`
+	TargetDriverCode     int32                  `protobuf:"varint,9,opt,name=target_driver_code,json=targetDriverCode,proto3" json:"target_driver_code,omitempty"`
+	StartWorkDatetime    string                 `protobuf:"bytes,10,opt,name=start_work_datetime,json=startWorkDatetime,proto3" json:"start_work_datetime,omitempty"` // RFC3339形式
+	EndWorkDatetime      string                 `protobuf:"bytes,11,opt,name=end_work_datetime,json=endWorkDatetime,proto3" json:"end_work_datetime,omitempty"`       // RFC3339形式
+	DepartureDatetime    string                 `protobuf:"bytes,12,opt,name=departure_datetime,json=departureDatetime,proto3" json:"departure_datetime,omitempty"`   // RFC3339形式
+	ReturnDatetime       string                 `protobuf:"bytes,13,opt,name=return_datetime,json=returnDatetime,proto3" json:"return_datetime,omitempty"`            // RFC3339形式
+	DepartureMeter       float64                `protobuf:"fixed64,14,opt,name=departure_meter,json=departureMeter,proto3" json:"departure_meter,omitempty"`
+	ReturnMeter          float64                `protobuf:"fixed64,15,opt,name=return_meter,json=returnMeter,proto3" json:"return_meter,omitempty"`
+	TotalDistance        float64                `protobuf:"fixed64,16,opt,name=total_distance,json=totalDistance,proto3" json:"total_distance,omitempty"`
+	LoadedDistance       *float64               `protobuf:"fixed64,17,opt,name=loaded_distance,json=loadedDistance,proto3,oneof" json:"loaded_distance,omitempty"`
+	DestinationCityName  *string                `protobuf:"bytes,18,opt,name=destination_city_name,json=destinationCityName,proto3,oneof" json:"destination_city_name,omitempty"`
+	DestinationPlaceName *string                `protobuf:"bytes,19,opt,name=destination_place_name,json=destinationPlaceName,proto3,oneof" json:"destination_place_name,omitempty"`
+	GeneralRoadDriveTime int32                  `protobuf:"varint,20,opt,name=general_road_drive_time,json=generalRoadDriveTime,proto3" json:"general_road_drive_time,omitempty"`
+	HighwayDriveTime     int32                  `protobuf:"varint,21,opt,name=highway_drive_time,json=highwayDriveTime,proto3" json:"highway_drive_time,omitempty"`
+	BypassDriveTime      int32                  `protobuf:"varint,22,opt,name=bypass_drive_time,json=bypassDriveTime,proto3" json:"bypass_drive_time,omitempty"`
+	LoadedDriveTime      int32                  `protobuf:"varint,23,opt,name=loaded_drive_time,json=loadedDriveTime,proto3" json:"loaded_drive_time,omitempty"`
+	EmptyDriveTime       int32                  `protobuf:"varint,24,opt,name=empty_drive_time,json=emptyDriveTime,proto3" json:"empty_drive_time,omitempty"`
+	Work1Time            int32                  `protobuf:"varint,25,opt,name=work1_time,json=work1Time,proto3" json:"work1_time,omitempty"`
+	Work2Time            int32                  `protobuf:"varint,26,opt,name=work2_time,json=work2Time,proto3" json:"work2_time,omitempty"`
+	Work3Time            int32                  `protobuf:"varint,27,opt,name=work3_time,json=work3Time,proto3" json:"work3_time,omitempty"`
+	Work4Time            int32                  `protobuf:"varint,28,opt,name=work4_time,json=work4Time,proto3" json:"work4_time,omitempty"`
+	Status1Distance      float64                `protobuf:"fixed64,29,opt,name=status1_distance,json=status1Distance,proto3" json:"status1_distance,omitempty"`
+	Status1Time          int32                  `protobuf:"varint,30,opt,name=status1_time,json=status1Time,proto3" json:"status1_time,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Db_DTakoRows) Reset() {
+	*x = Db_DTakoRows{}
+	mi := &file_db_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_DTakoRows) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_DTakoRows) ProtoMessage() {}
+
+func (x *Db_DTakoRows) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_DTakoRows.ProtoReflect.Descriptor instead.
+func (*Db_DTakoRows) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *Db_DTakoRows) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Db_DTakoRows) GetOperationNo() string {
+	if x != nil {
+		return x.OperationNo
+	}
+	return ""
+}
+
+func (x *Db_DTakoRows) GetReadDate() string {
+	if x != nil {
+		return x.ReadDate
+	}
+	return ""
+}
+
+func (x *Db_DTakoRows) GetOperationDate() string {
+	if x != nil {
+		return x.OperationDate
+	}
+	return ""
+}
+
+func (x *Db_DTakoRows) GetCarCode() int32 {
+	if x != nil {
+		return x.CarCode
+	}
+	return 0
+}
+
+func (x *Db_DTakoRows) GetCarCc() string {
+	if x != nil {
+		return x.CarCc
+	}
+	return ""
+}
+
+func (x *Db_DTakoRows) GetDriverCode1() int32 {
+	if x != nil && x.DriverCode1 != nil {
+		return *x.DriverCode1
+	}
+	return 0
+}
+
+func (x *Db_DTakoRows) GetTargetDriverType() int32 {
+	if x != nil {
+		return x.TargetDriverType
+	}
+	return 0
+}
+
+func (x *Db_DTakoRows) GetTargetDriverCode() int32 {
+	if x != nil {
+		return x.TargetDriverCode
+	}
+	return 0
+}
+
+func (x *Db_DTakoRows) GetStartWorkDatetime() string {
+	if x != nil {
+		return x.StartWorkDatetime
+	}
+	return ""
+}
+
+func (x *Db_DTakoRows) GetEndWorkDatetime() string {
+	if x != nil {
+		return x.EndWorkDatetime
+	}
+	return ""
+}
+
+func (x *Db_DTakoRows) GetDepartureDatetime() string {
+	if x != nil {
+		return x.DepartureDatetime
+	}
+	return ""
+}
+
+func (x *Db_DTakoRows) GetReturnDatetime() string {
+	if x != nil {
+		return x.ReturnDatetime
+	}
+	return ""
+}
+
+func (x *Db_DTakoRows) GetDepartureMeter() float64 {
+	if x != nil {
+		return x.DepartureMeter
+	}
+	return 0
+}
+
+func (x *Db_DTakoRows) GetReturnMeter() float64 {
+	if x != nil {
+		return x.ReturnMeter
+	}
+	return 0
+}
+
+func (x *Db_DTakoRows) GetTotalDistance() float64 {
+	if x != nil {
+		return x.TotalDistance
+	}
+	return 0
+}
+
+func (x *Db_DTakoRows) GetLoadedDistance() float64 {
+	if x != nil && x.LoadedDistance != nil {
+		return *x.LoadedDistance
+	}
+	return 0
+}
+
+func (x *Db_DTakoRows) GetDestinationCityName() string {
+	if x != nil && x.DestinationCityName != nil {
+		return *x.DestinationCityName
+	}
+	return ""
+}
+
+func (x *Db_DTakoRows) GetDestinationPlaceName() string {
+	if x != nil && x.DestinationPlaceName != nil {
+		return *x.DestinationPlaceName
+	}
+	return ""
+}
+
+func (x *Db_DTakoRows) GetGeneralRoadDriveTime() int32 {
+	if x != nil {
+		return x.GeneralRoadDriveTime
+	}
+	return 0
+}
+
+func (x *Db_DTakoRows) GetHighwayDriveTime() int32 {
+	if x != nil {
+		return x.HighwayDriveTime
+	}
+	return 0
+}
+
+func (x *Db_DTakoRows) GetBypassDriveTime() int32 {
+	if x != nil {
+		return x.BypassDriveTime
+	}
+	return 0
+}
+
+func (x *Db_DTakoRows) GetLoadedDriveTime() int32 {
+	if x != nil {
+		return x.LoadedDriveTime
+	}
+	return 0
+}
+
+func (x *Db_DTakoRows) GetEmptyDriveTime() int32 {
+	if x != nil {
+		return x.EmptyDriveTime
+	}
+	return 0
+}
+
+func (x *Db_DTakoRows) GetWork1Time() int32 {
+	if x != nil {
+		return x.Work1Time
+	}
+	return 0
+}
+
+func (x *Db_DTakoRows) GetWork2Time() int32 {
+	if x != nil {
+		return x.Work2Time
+	}
+	return 0
+}
+
+func (x *Db_DTakoRows) GetWork3Time() int32 {
+	if x != nil {
+		return x.Work3Time
+	}
+	return 0
+}
+
+func (x *Db_DTakoRows) GetWork4Time() int32 {
+	if x != nil {
+		return x.Work4Time
+	}
+	return 0
+}
+
+func (x *Db_DTakoRows) GetStatus1Distance() float64 {
+	if x != nil {
+		return x.Status1Distance
+	}
+	return 0
+}
+
+func (x *Db_DTakoRows) GetStatus1Time() int32 {
+	if x != nil {
+		return x.Status1Time
+	}
+	return 0
+}
+
+// ETCカード番号データ（本番DB）
+type Db_ETCNum struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EtcCardNum    string                 `protobuf:"bytes,1,opt,name=etc_card_num,json=etcCardNum,proto3" json:"etc_card_num,omitempty"`
+	CarId         string                 `protobuf:"bytes,2,opt,name=car_id,json=carId,proto3" json:"car_id,omitempty"`
+	StartDateTime *string                `protobuf:"bytes,3,opt,name=start_date_time,json=startDateTime,proto3,oneof" json:"start_date_time,omitempty"` // RFC3339形式
+	DueDateTime   *string                `protobuf:"bytes,4,opt,name=due_date_time,json=dueDateTime,proto3,oneof" json:"due_date_time,omitempty"`       // RFC3339形式
+	ToChange      *bool                  `protobuf:"varint,5,opt,name=to_change,json=toChange,proto3,oneof" json:"to_change,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_ETCNum) Reset() {
+	*x = Db_ETCNum{}
+	mi := &file_db_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_ETCNum) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_ETCNum) ProtoMessage() {}
+
+func (x *Db_ETCNum) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_ETCNum.ProtoReflect.Descriptor instead.
+func (*Db_ETCNum) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *Db_ETCNum) GetEtcCardNum() string {
+	if x != nil {
+		return x.EtcCardNum
+	}
+	return ""
+}
+
+func (x *Db_ETCNum) GetCarId() string {
+	if x != nil {
+		return x.CarId
+	}
+	return ""
+}
+
+func (x *Db_ETCNum) GetStartDateTime() string {
+	if x != nil && x.StartDateTime != nil {
+		return *x.StartDateTime
+	}
+	return ""
+}
+
+func (x *Db_ETCNum) GetDueDateTime() string {
+	if x != nil && x.DueDateTime != nil {
+		return *x.DueDateTime
+	}
+	return ""
+}
+
+func (x *Db_ETCNum) GetToChange() bool {
+	if x != nil && x.ToChange != nil {
+		return *x.ToChange
+	}
+	return false
+}
+
+// DTakoCars用リクエスト/レスポンス
+type Db_GetDTakoCarsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_GetDTakoCarsRequest) Reset() {
+	*x = Db_GetDTakoCarsRequest{}
+	mi := &file_db_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_GetDTakoCarsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_GetDTakoCarsRequest) ProtoMessage() {}
+
+func (x *Db_GetDTakoCarsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_GetDTakoCarsRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetDTakoCarsRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *Db_GetDTakoCarsRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type Db_GetDTakoCarsByCarCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CarCode       string                 `protobuf:"bytes,1,opt,name=car_code,json=carCode,proto3" json:"car_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_GetDTakoCarsByCarCodeRequest) Reset() {
+	*x = Db_GetDTakoCarsByCarCodeRequest{}
+	mi := &file_db_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_GetDTakoCarsByCarCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_GetDTakoCarsByCarCodeRequest) ProtoMessage() {}
+
+func (x *Db_GetDTakoCarsByCarCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_GetDTakoCarsByCarCodeRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetDTakoCarsByCarCodeRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *Db_GetDTakoCarsByCarCodeRequest) GetCarCode() string {
+	if x != nil {
+		return x.CarCode
+	}
+	return ""
+}
+
+type Db_ListDTakoCarsRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Limit             int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset            int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	PageToken         *string                `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`                      // キーセットページネーション用トークン（指定時はoffset/order_byは使用不可、初回は空文字）
+	IncludeTotalCount bool                   `protobuf:"varint,4,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"` // page_token使用時もtotal_countを取得する（COUNT(*)を実行）
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Db_ListDTakoCarsRequest) Reset() {
+	*x = Db_ListDTakoCarsRequest{}
+	mi := &file_db_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_ListDTakoCarsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_ListDTakoCarsRequest) ProtoMessage() {}
+
+func (x *Db_ListDTakoCarsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_ListDTakoCarsRequest.ProtoReflect.Descriptor instead.
+func (*Db_ListDTakoCarsRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *Db_ListDTakoCarsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *Db_ListDTakoCarsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *Db_ListDTakoCarsRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+func (x *Db_ListDTakoCarsRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+type Db_DTakoCarsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DtakoCars     *Db_DTakoCars          `protobuf:"bytes,1,opt,name=dtako_cars,json=dtakoCars,proto3" json:"dtako_cars,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_DTakoCarsResponse) Reset() {
+	*x = Db_DTakoCarsResponse{}
+	mi := &file_db_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_DTakoCarsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_DTakoCarsResponse) ProtoMessage() {}
+
+func (x *Db_DTakoCarsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_DTakoCarsResponse.ProtoReflect.Descriptor instead.
+func (*Db_DTakoCarsResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *Db_DTakoCarsResponse) GetDtakoCars() *Db_DTakoCars {
+	if x != nil {
+		return x.DtakoCars
+	}
+	return nil
+}
+
+type Db_ListDTakoCarsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Db_DTakoCars        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalCount    *int32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`     // page_token使用時はinclude_total_count指定時のみ設定
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 次ページのトークン（最終ページの場合は空）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_ListDTakoCarsResponse) Reset() {
+	*x = Db_ListDTakoCarsResponse{}
+	mi := &file_db_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_ListDTakoCarsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_ListDTakoCarsResponse) ProtoMessage() {}
+
+func (x *Db_ListDTakoCarsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_ListDTakoCarsResponse.ProtoReflect.Descriptor instead.
+func (*Db_ListDTakoCarsResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *Db_ListDTakoCarsResponse) GetItems() []*Db_DTakoCars {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Db_ListDTakoCarsResponse) GetTotalCount() int32 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
+	}
+	return 0
+}
+
+func (x *Db_ListDTakoCarsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// DTakoEvents用リクエスト/レスポンス
+type Db_GetDTakoEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_GetDTakoEventsRequest) Reset() {
+	*x = Db_GetDTakoEventsRequest{}
+	mi := &file_db_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_GetDTakoEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_GetDTakoEventsRequest) ProtoMessage() {}
+
+func (x *Db_GetDTakoEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_GetDTakoEventsRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetDTakoEventsRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *Db_GetDTakoEventsRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type Db_GetDTakoEventsByOperationNoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperationNo   string                 `protobuf:"bytes,1,opt,name=operation_no,json=operationNo,proto3" json:"operation_no,omitempty"`
+	EventTypes    []string               `protobuf:"bytes,2,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"` // イベントタイプでフィルタ（空の場合は全て）
+	StartTime     string                 `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`    // 開始時刻（RFC3339形式、空の場合は制限なし）
+	EndTime       string                 `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`          // 終了時刻（RFC3339形式、空の場合は制限なし）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_GetDTakoEventsByOperationNoRequest) Reset() {
+	*x = Db_GetDTakoEventsByOperationNoRequest{}
+	mi := &file_db_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_GetDTakoEventsByOperationNoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_GetDTakoEventsByOperationNoRequest) ProtoMessage() {}
+
+func (x *Db_GetDTakoEventsByOperationNoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_GetDTakoEventsByOperationNoRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetDTakoEventsByOperationNoRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *Db_GetDTakoEventsByOperationNoRequest) GetOperationNo() string {
+	if x != nil {
+		return x.OperationNo
+	}
+	return ""
+}
+
+func (x *Db_GetDTakoEventsByOperationNoRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *Db_GetDTakoEventsByOperationNoRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *Db_GetDTakoEventsByOperationNoRequest) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+type Db_DTakoEventsSortSpec struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Field         Db_DTakoEventsSortField `protobuf:"varint,1,opt,name=field,proto3,enum=db_service.Db_DTakoEventsSortField" json:"field,omitempty"`
+	Direction     Db_SortDirection        `protobuf:"varint,2,opt,name=direction,proto3,enum=db_service.Db_SortDirection" json:"direction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_DTakoEventsSortSpec) Reset() {
+	*x = Db_DTakoEventsSortSpec{}
+	mi := &file_db_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_DTakoEventsSortSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_DTakoEventsSortSpec) ProtoMessage() {}
+
+func (x *Db_DTakoEventsSortSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_DTakoEventsSortSpec.ProtoReflect.Descriptor instead.
+func (*Db_DTakoEventsSortSpec) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *Db_DTakoEventsSortSpec) GetField() Db_DTakoEventsSortField {
+	if x != nil {
+		return x.Field
+	}
+	return Db_DTakoEventsSortField_DTAKO_EVENTS_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *Db_DTakoEventsSortSpec) GetDirection() Db_SortDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return Db_SortDirection_SORT_DIRECTION_UNSPECIFIED
+}
+
+type Db_ListDTakoEventsRequest struct {
+	state             protoimpl.MessageState    `protogen:"open.v1"`
+	Limit             int32                     `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset            int32                     `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	OrderBy           *string                   `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3,oneof" json:"order_by,omitempty"`                            // 非推奨: sortを使用（ソート可能なprotoのフィールド名のみ指定可）
+	PageToken         *string                   `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`                      // キーセットページネーション用トークン（指定時はoffset/order_byは使用不可、初回は空文字）
+	IncludeTotalCount bool                      `protobuf:"varint,5,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"` // page_token使用時もtotal_countを取得する（COUNT(*)を実行）
+	Sort              []*Db_DTakoEventsSortSpec `protobuf:"bytes,6,rep,name=sort,proto3" json:"sort,omitempty"`                                                       // ソート条件（複数指定可）
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Db_ListDTakoEventsRequest) Reset() {
+	*x = Db_ListDTakoEventsRequest{}
+	mi := &file_db_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_ListDTakoEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_ListDTakoEventsRequest) ProtoMessage() {}
+
+func (x *Db_ListDTakoEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_ListDTakoEventsRequest.ProtoReflect.Descriptor instead.
+func (*Db_ListDTakoEventsRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *Db_ListDTakoEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *Db_ListDTakoEventsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *Db_ListDTakoEventsRequest) GetOrderBy() string {
+	if x != nil && x.OrderBy != nil {
+		return *x.OrderBy
+	}
+	return ""
+}
+
+func (x *Db_ListDTakoEventsRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+func (x *Db_ListDTakoEventsRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+func (x *Db_ListDTakoEventsRequest) GetSort() []*Db_DTakoEventsSortSpec {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+type Db_StreamDTakoEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartTime     string                 `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`  // 開始日時の開始（RFC3339形式、空の場合は制限なし）
+	EndTime       string                 `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`        // 開始日時の終了（RFC3339形式、空の場合は制限なし）
+	BatchSize     int32                  `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"` // 1回のクエリで取得する件数（0の場合は100、最大1000）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_StreamDTakoEventsRequest) Reset() {
+	*x = Db_StreamDTakoEventsRequest{}
+	mi := &file_db_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_StreamDTakoEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_StreamDTakoEventsRequest) ProtoMessage() {}
+
+func (x *Db_StreamDTakoEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_StreamDTakoEventsRequest.ProtoReflect.Descriptor instead.
+func (*Db_StreamDTakoEventsRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *Db_StreamDTakoEventsRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *Db_StreamDTakoEventsRequest) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *Db_StreamDTakoEventsRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+type Db_DTakoEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DtakoEvents   *Db_DTakoEvents        `protobuf:"bytes,1,opt,name=dtako_events,json=dtakoEvents,proto3" json:"dtako_events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_DTakoEventsResponse) Reset() {
+	*x = Db_DTakoEventsResponse{}
+	mi := &file_db_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_DTakoEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_DTakoEventsResponse) ProtoMessage() {}
+
+func (x *Db_DTakoEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_DTakoEventsResponse.ProtoReflect.Descriptor instead.
+func (*Db_DTakoEventsResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *Db_DTakoEventsResponse) GetDtakoEvents() *Db_DTakoEvents {
+	if x != nil {
+		return x.DtakoEvents
+	}
+	return nil
+}
+
+type Db_ListDTakoEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Db_DTakoEvents      `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalCount    *int32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`     // page_token使用時はinclude_total_count指定時のみ設定
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 次ページのトークン（最終ページの場合は空）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_ListDTakoEventsResponse) Reset() {
+	*x = Db_ListDTakoEventsResponse{}
+	mi := &file_db_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_ListDTakoEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_ListDTakoEventsResponse) ProtoMessage() {}
+
+func (x *Db_ListDTakoEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_ListDTakoEventsResponse.ProtoReflect.Descriptor instead.
+func (*Db_ListDTakoEventsResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *Db_ListDTakoEventsResponse) GetItems() []*Db_DTakoEvents {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Db_ListDTakoEventsResponse) GetTotalCount() int32 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
+	}
+	return 0
+}
+
+func (x *Db_ListDTakoEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// DTakoRows用リクエスト/レスポンス
+type Db_GetDTakoRowsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_GetDTakoRowsRequest) Reset() {
+	*x = Db_GetDTakoRowsRequest{}
+	mi := &file_db_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_GetDTakoRowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_GetDTakoRowsRequest) ProtoMessage() {}
+
+func (x *Db_GetDTakoRowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_GetDTakoRowsRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetDTakoRowsRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *Db_GetDTakoRowsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Db_GetDTakoRowsByOperationNoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperationNo   string                 `protobuf:"bytes,1,opt,name=operation_no,json=operationNo,proto3" json:"operation_no,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_GetDTakoRowsByOperationNoRequest) Reset() {
+	*x = Db_GetDTakoRowsByOperationNoRequest{}
+	mi := &file_db_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_GetDTakoRowsByOperationNoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_GetDTakoRowsByOperationNoRequest) ProtoMessage() {}
+
+func (x *Db_GetDTakoRowsByOperationNoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_GetDTakoRowsByOperationNoRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetDTakoRowsByOperationNoRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *Db_GetDTakoRowsByOperationNoRequest) GetOperationNo() string {
+	if x != nil {
+		return x.OperationNo
+	}
+	return ""
+}
+
+type Db_DTakoRowsSortSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         Db_DTakoRowsSortField  `protobuf:"varint,1,opt,name=field,proto3,enum=db_service.Db_DTakoRowsSortField" json:"field,omitempty"`
+	Direction     Db_SortDirection       `protobuf:"varint,2,opt,name=direction,proto3,enum=db_service.Db_SortDirection" json:"direction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_DTakoRowsSortSpec) Reset() {
+	*x = Db_DTakoRowsSortSpec{}
+	mi := &file_db_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_DTakoRowsSortSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_DTakoRowsSortSpec) ProtoMessage() {}
+
+func (x *Db_DTakoRowsSortSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_DTakoRowsSortSpec.ProtoReflect.Descriptor instead.
+func (*Db_DTakoRowsSortSpec) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *Db_DTakoRowsSortSpec) GetField() Db_DTakoRowsSortField {
+	if x != nil {
+		return x.Field
+	}
+	return Db_DTakoRowsSortField_DTAKO_ROWS_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *Db_DTakoRowsSortSpec) GetDirection() Db_SortDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return Db_SortDirection_SORT_DIRECTION_UNSPECIFIED
+}
+
+type Db_ListDTakoRowsRequest struct {
+	state             protoimpl.MessageState  `protogen:"open.v1"`
+	Limit             int32                   `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset            int32                   `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	OrderBy           *string                 `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3,oneof" json:"order_by,omitempty"`                            // 非推奨: sortを使用（ソート可能なprotoのフィールド名のみ指定可）
+	PageToken         *string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`                      // キーセットページネーション用トークン（指定時はoffset/order_byは使用不可、初回は空文字）
+	IncludeTotalCount bool                    `protobuf:"varint,5,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"` // page_token使用時もtotal_countを取得する（COUNT(*)を実行）
+	Sort              []*Db_DTakoRowsSortSpec `protobuf:"bytes,6,rep,name=sort,proto3" json:"sort,omitempty"`                                                       // ソート条件（複数指定可）
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Db_ListDTakoRowsRequest) Reset() {
+	*x = Db_ListDTakoRowsRequest{}
+	mi := &file_db_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_ListDTakoRowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_ListDTakoRowsRequest) ProtoMessage() {}
+
+func (x *Db_ListDTakoRowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_ListDTakoRowsRequest.ProtoReflect.Descriptor instead.
+func (*Db_ListDTakoRowsRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *Db_ListDTakoRowsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *Db_ListDTakoRowsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *Db_ListDTakoRowsRequest) GetOrderBy() string {
+	if x != nil && x.OrderBy != nil {
+		return *x.OrderBy
+	}
+	return ""
+}
+
+func (x *Db_ListDTakoRowsRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+func (x *Db_ListDTakoRowsRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+func (x *Db_ListDTakoRowsRequest) GetSort() []*Db_DTakoRowsSortSpec {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+type Db_StreamDTakoRowsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`  // 読取日の開始（YYYY-MM-DD形式、空の場合は制限なし）
+	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`        // 読取日の終了（YYYY-MM-DD形式、空の場合は制限なし）
+	BatchSize     int32                  `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"` // 1回のクエリで取得する件数（0の場合は100、最大1000）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_StreamDTakoRowsRequest) Reset() {
+	*x = Db_StreamDTakoRowsRequest{}
+	mi := &file_db_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_StreamDTakoRowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_StreamDTakoRowsRequest) ProtoMessage() {}
+
+func (x *Db_StreamDTakoRowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_StreamDTakoRowsRequest.ProtoReflect.Descriptor instead.
+func (*Db_StreamDTakoRowsRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *Db_StreamDTakoRowsRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *Db_StreamDTakoRowsRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *Db_StreamDTakoRowsRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+type Db_DTakoRowsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DtakoRows     *Db_DTakoRows          `protobuf:"bytes,1,opt,name=dtako_rows,json=dtakoRows,proto3" json:"dtako_rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_DTakoRowsResponse) Reset() {
+	*x = Db_DTakoRowsResponse{}
+	mi := &file_db_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_DTakoRowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_DTakoRowsResponse) ProtoMessage() {}
+
+func (x *Db_DTakoRowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_DTakoRowsResponse.ProtoReflect.Descriptor instead.
+func (*Db_DTakoRowsResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *Db_DTakoRowsResponse) GetDtakoRows() *Db_DTakoRows {
+	if x != nil {
+		return x.DtakoRows
+	}
+	return nil
+}
+
+type Db_ListDTakoRowsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Db_DTakoRows        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalCount    *int32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`     // page_token使用時はinclude_total_count指定時のみ設定
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 次ページのトークン（最終ページの場合は空）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_ListDTakoRowsResponse) Reset() {
+	*x = Db_ListDTakoRowsResponse{}
+	mi := &file_db_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_ListDTakoRowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_ListDTakoRowsResponse) ProtoMessage() {}
+
+func (x *Db_ListDTakoRowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_ListDTakoRowsResponse.ProtoReflect.Descriptor instead.
+func (*Db_ListDTakoRowsResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *Db_ListDTakoRowsResponse) GetItems() []*Db_DTakoRows {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Db_ListDTakoRowsResponse) GetTotalCount() int32 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
+	}
+	return 0
+}
+
+func (x *Db_ListDTakoRowsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// ETCNum用リクエスト/レスポンス
+type Db_GetETCNumByETCCardNumRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EtcCardNum    string                 `protobuf:"bytes,1,opt,name=etc_card_num,json=etcCardNum,proto3" json:"etc_card_num,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_GetETCNumByETCCardNumRequest) Reset() {
+	*x = Db_GetETCNumByETCCardNumRequest{}
+	mi := &file_db_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_GetETCNumByETCCardNumRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_GetETCNumByETCCardNumRequest) ProtoMessage() {}
+
+func (x *Db_GetETCNumByETCCardNumRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_GetETCNumByETCCardNumRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetETCNumByETCCardNumRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *Db_GetETCNumByETCCardNumRequest) GetEtcCardNum() string {
+	if x != nil {
+		return x.EtcCardNum
+	}
+	return ""
+}
+
+type Db_GetETCNumByCarIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CarId         string                 `protobuf:"bytes,1,opt,name=car_id,json=carId,proto3" json:"car_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_GetETCNumByCarIDRequest) Reset() {
+	*x = Db_GetETCNumByCarIDRequest{}
+	mi := &file_db_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_GetETCNumByCarIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_GetETCNumByCarIDRequest) ProtoMessage() {}
+
+func (x *Db_GetETCNumByCarIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_GetETCNumByCarIDRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetETCNumByCarIDRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *Db_GetETCNumByCarIDRequest) GetCarId() string {
+	if x != nil {
+		return x.CarId
+	}
+	return ""
+}
+
+type Db_GetETCNumByETCCardNumAtRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EtcCardNum    string                 `protobuf:"bytes,1,opt,name=etc_card_num,json=etcCardNum,proto3" json:"etc_card_num,omitempty"`
+	At            string                 `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"` // RFC3339形式（空の場合は現在日時）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_GetETCNumByETCCardNumAtRequest) Reset() {
+	*x = Db_GetETCNumByETCCardNumAtRequest{}
+	mi := &file_db_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_GetETCNumByETCCardNumAtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_GetETCNumByETCCardNumAtRequest) ProtoMessage() {}
+
+func (x *Db_GetETCNumByETCCardNumAtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_GetETCNumByETCCardNumAtRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetETCNumByETCCardNumAtRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *Db_GetETCNumByETCCardNumAtRequest) GetEtcCardNum() string {
+	if x != nil {
+		return x.EtcCardNum
+	}
+	return ""
+}
+
+func (x *Db_GetETCNumByETCCardNumAtRequest) GetAt() string {
+	if x != nil {
+		return x.At
+	}
+	return ""
+}
+
+type Db_GetETCNumByCarIDAtRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CarId         string                 `protobuf:"bytes,1,opt,name=car_id,json=carId,proto3" json:"car_id,omitempty"`
+	At            string                 `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"` // RFC3339形式（空の場合は現在日時）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_GetETCNumByCarIDAtRequest) Reset() {
+	*x = Db_GetETCNumByCarIDAtRequest{}
+	mi := &file_db_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_GetETCNumByCarIDAtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_GetETCNumByCarIDAtRequest) ProtoMessage() {}
+
+func (x *Db_GetETCNumByCarIDAtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_GetETCNumByCarIDAtRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetETCNumByCarIDAtRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *Db_GetETCNumByCarIDAtRequest) GetCarId() string {
+	if x != nil {
+		return x.CarId
+	}
+	return ""
+}
+
+func (x *Db_GetETCNumByCarIDAtRequest) GetAt() string {
+	if x != nil {
+		return x.At
+	}
+	return ""
+}
+
+type Db_ListETCNumOverlapsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EtcCardNum    *string                `protobuf:"bytes,1,opt,name=etc_card_num,json=etcCardNum,proto3,oneof" json:"etc_card_num,omitempty"` // 指定しない場合は全てのETCカード
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_ListETCNumOverlapsRequest) Reset() {
+	*x = Db_ListETCNumOverlapsRequest{}
+	mi := &file_db_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_ListETCNumOverlapsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_ListETCNumOverlapsRequest) ProtoMessage() {}
+
+func (x *Db_ListETCNumOverlapsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_ListETCNumOverlapsRequest.ProtoReflect.Descriptor instead.
+func (*Db_ListETCNumOverlapsRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *Db_ListETCNumOverlapsRequest) GetEtcCardNum() string {
+	if x != nil && x.EtcCardNum != nil {
+		return *x.EtcCardNum
+	}
+	return ""
+}
+
+// 有効期間が重なる同じETCカードの登録（一方の終了日時と他方の開始日時が同じ場合は重ならない）
+type Db_ETCNumOverlap struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EtcCardNum    string                 `protobuf:"bytes,1,opt,name=etc_card_num,json=etcCardNum,proto3" json:"etc_card_num,omitempty"`
+	First         *Db_ETCNum             `protobuf:"bytes,2,opt,name=first,proto3" json:"first,omitempty"` // car_idの小さい方
+	Second        *Db_ETCNum             `protobuf:"bytes,3,opt,name=second,proto3" json:"second,omitempty"`
+	OverlapStart  *string                `protobuf:"bytes,4,opt,name=overlap_start,json=overlapStart,proto3,oneof" json:"overlap_start,omitempty"` // 重なる期間の開始（RFC3339形式、ない場合は期限なし）
+	OverlapEnd    *string                `protobuf:"bytes,5,opt,name=overlap_end,json=overlapEnd,proto3,oneof" json:"overlap_end,omitempty"`       // 重なる期間の終了（RFC3339形式、ない場合は期限なし）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_ETCNumOverlap) Reset() {
+	*x = Db_ETCNumOverlap{}
+	mi := &file_db_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_ETCNumOverlap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_ETCNumOverlap) ProtoMessage() {}
+
+func (x *Db_ETCNumOverlap) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_ETCNumOverlap.ProtoReflect.Descriptor instead.
+func (*Db_ETCNumOverlap) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *Db_ETCNumOverlap) GetEtcCardNum() string {
+	if x != nil {
+		return x.EtcCardNum
+	}
+	return ""
+}
+
+func (x *Db_ETCNumOverlap) GetFirst() *Db_ETCNum {
+	if x != nil {
+		return x.First
+	}
+	return nil
+}
+
+func (x *Db_ETCNumOverlap) GetSecond() *Db_ETCNum {
+	if x != nil {
+		return x.Second
+	}
+	return nil
+}
+
+func (x *Db_ETCNumOverlap) GetOverlapStart() string {
+	if x != nil && x.OverlapStart != nil {
+		return *x.OverlapStart
+	}
+	return ""
+}
+
+func (x *Db_ETCNumOverlap) GetOverlapEnd() string {
+	if x != nil && x.OverlapEnd != nil {
+		return *x.OverlapEnd
+	}
+	return ""
+}
+
+type Db_ListETCNumOverlapsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Overlaps      []*Db_ETCNumOverlap    `protobuf:"bytes,1,rep,name=overlaps,proto3" json:"overlaps,omitempty"` // etc_card_num・car_idの順
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_ListETCNumOverlapsResponse) Reset() {
+	*x = Db_ListETCNumOverlapsResponse{}
+	mi := &file_db_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_ListETCNumOverlapsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_ListETCNumOverlapsResponse) ProtoMessage() {}
+
+func (x *Db_ListETCNumOverlapsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_ListETCNumOverlapsResponse.ProtoReflect.Descriptor instead.
+func (*Db_ListETCNumOverlapsResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *Db_ListETCNumOverlapsResponse) GetOverlaps() []*Db_ETCNumOverlap {
+	if x != nil {
+		return x.Overlaps
+	}
+	return nil
+}
+
+type Db_ListETCNumRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Limit             int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset            int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	PageToken         *string                `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`                      // キーセットページネーション用トークン（指定時はoffset/order_byは使用不可、初回は空文字）
+	IncludeTotalCount bool                   `protobuf:"varint,4,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"` // page_token使用時もtotal_countを取得する（COUNT(*)を実行）
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Db_ListETCNumRequest) Reset() {
+	*x = Db_ListETCNumRequest{}
+	mi := &file_db_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_ListETCNumRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_ListETCNumRequest) ProtoMessage() {}
+
+func (x *Db_ListETCNumRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_ListETCNumRequest.ProtoReflect.Descriptor instead.
+func (*Db_ListETCNumRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *Db_ListETCNumRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *Db_ListETCNumRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *Db_ListETCNumRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+func (x *Db_ListETCNumRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+type Db_ListETCNumResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Db_ETCNum           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalCount    *int32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`     // page_token使用時はinclude_total_count指定時のみ設定
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 次ページのトークン（最終ページの場合は空）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_ListETCNumResponse) Reset() {
+	*x = Db_ListETCNumResponse{}
+	mi := &file_db_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_ListETCNumResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_ListETCNumResponse) ProtoMessage() {}
+
+func (x *Db_ListETCNumResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_ListETCNumResponse.ProtoReflect.Descriptor instead.
+func (*Db_ListETCNumResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *Db_ListETCNumResponse) GetItems() []*Db_ETCNum {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Db_ListETCNumResponse) GetTotalCount() int32 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
+	}
+	return 0
+}
+
+func (x *Db_ListETCNumResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// db_DTakoFerryRowsProd（本番DB）データ
+type Db_DTakoFerryRowsProd struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UnkoNo            string                 `protobuf:"bytes,2,opt,name=unko_no,json=unkoNo,proto3" json:"unko_no,omitempty"`
+	UnkoDate          string                 `protobuf:"bytes,3,opt,name=unko_date,json=unkoDate,proto3" json:"unko_date,omitempty"`             // YYYY-MM-DD形式
+	YomitoriDate      string                 `protobuf:"bytes,4,opt,name=yomitori_date,json=yomitoriDate,proto3" json:"yomitori_date,omitempty"` // YYYY-MM-DD形式
+	JigyoshoCd        int32                  `protobuf:"varint,5,opt,name=jigyosho_cd,json=jigyoshoCd,proto3" json:"jigyosho_cd,omitempty"`
+	JigyoshoName      string                 `protobuf:"bytes,6,opt,name=jigyosho_name,json=jigyoshoName,proto3" json:"jigyosho_name,omitempty"`
+	SharyoCd          int32                  `protobuf:"varint,7,opt,name=sharyo_cd,json=sharyoCd,proto3" json:"sharyo_cd,omitempty"`
+	SharyoName        string                 `protobuf:"bytes,8,opt,name=sharyo_name,json=sharyoName,proto3" json:"sharyo_name,omitempty"`
+	JomuinCd1         int32                  `protobuf:"varint,9,opt,name=jomuin_cd1,json=jomuinCd1,proto3" json:"jomuin_cd1,omitempty"`
+	JomuinName1       string                 `protobuf:"bytes,10,opt,name=jomuin_name1,json=jomuinName1,proto3" json:"jomuin_name1,omitempty"`
+	TaishoJomuinKbn   int32                  `protobuf:"varint,11,opt,name=taisho_jomuin_kbn,json=taishoJomuinKbn,proto3" json:"taisho_jomuin_kbn,omitempty"`
+	KaishiDatetime    string                 `protobuf:"bytes,12,opt,name=kaishi_datetime,json=kaishiDatetime,proto3" json:"kaishi_datetime,omitempty"` // RFC3339形式
+	ShuryoDatetime    string                 `protobuf:"bytes,13,opt,name=shuryo_datetime,json=shuryoDatetime,proto3" json:"shuryo_datetime,omitempty"` // RFC3339形式
+	FerryCompanyCd    int32                  `protobuf:"varint,14,opt,name=ferry_company_cd,json=ferryCompanyCd,proto3" json:"ferry_company_cd,omitempty"`
+	FerryCompanyName  string                 `protobuf:"bytes,15,opt,name=ferry_company_name,json=ferryCompanyName,proto3" json:"ferry_company_name,omitempty"`
+	NoribaCd          int32                  `protobuf:"varint,16,opt,name=noriba_cd,json=noribaCd,proto3" json:"noriba_cd,omitempty"`
+	NoribaName        string                 `protobuf:"bytes,17,opt,name=noriba_name,json=noribaName,proto3" json:"noriba_name,omitempty"`
+	Bin               string                 `protobuf:"bytes,18,opt,name=bin,proto3" json:"bin,omitempty"`
+	OribaCd           int32                  `protobuf:"varint,19,opt,name=oriba_cd,json=oribaCd,proto3" json:"oriba_cd,omitempty"`
+	OribaName         string                 `protobuf:"bytes,20,opt,name=oriba_name,json=oribaName,proto3" json:"oriba_name,omitempty"`
+	SeisanKbn         int32                  `protobuf:"varint,21,opt,name=seisan_kbn,json=seisanKbn,proto3" json:"seisan_kbn,omitempty"`
+	SeisanKbnName     string                 `protobuf:"bytes,22,opt,name=seisan_kbn_name,json=seisanKbnName,proto3" json:"seisan_kbn_name,omitempty"`
+	HyojunRyokin      int32                  `protobuf:"varint,23,opt,name=hyojun_ryokin,json=hyojunRyokin,proto3" json:"hyojun_ryokin,omitempty"`
+	KeiyakuRyokin     int32                  `protobuf:"varint,24,opt,name=keiyaku_ryokin,json=keiyakuRyokin,proto3" json:"keiyaku_ryokin,omitempty"`
+	KosoShashuKbn     int32                  `protobuf:"varint,25,opt,name=koso_shashu_kbn,json=kosoShashuKbn,proto3" json:"koso_shashu_kbn,omitempty"`
+	KosoShashuKbnName string                 `protobuf:"bytes,26,opt,name=koso_shashu_kbn_name,json=kosoShashuKbnName,proto3" json:"koso_shashu_kbn_name,omitempty"`
+	MinashiKyori      int32                  `protobuf:"varint,27,opt,name=minashi_kyori,json=minashiKyori,proto3" json:"minashi_kyori,omitempty"`
+	FerrySrch         *string                `protobuf:"bytes,28,opt,name=ferry_srch,json=ferrySrch,proto3,oneof" json:"ferry_srch,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Db_DTakoFerryRowsProd) Reset() {
+	*x = Db_DTakoFerryRowsProd{}
+	mi := &file_db_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_DTakoFerryRowsProd) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_DTakoFerryRowsProd) ProtoMessage() {}
+
+func (x *Db_DTakoFerryRowsProd) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_DTakoFerryRowsProd.ProtoReflect.Descriptor instead.
+func (*Db_DTakoFerryRowsProd) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *Db_DTakoFerryRowsProd) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Db_DTakoFerryRowsProd) GetUnkoNo() string {
+	if x != nil {
+		return x.UnkoNo
+	}
+	return ""
+}
+
+func (x *Db_DTakoFerryRowsProd) GetUnkoDate() string {
+	if x != nil {
+		return x.UnkoDate
+	}
+	return ""
+}
+
+func (x *Db_DTakoFerryRowsProd) GetYomitoriDate() string {
+	if x != nil {
+		return x.YomitoriDate
+	}
+	return ""
+}
+
+func (x *Db_DTakoFerryRowsProd) GetJigyoshoCd() int32 {
+	if x != nil {
+		return x.JigyoshoCd
+	}
+	return 0
+}
+
+func (x *Db_DTakoFerryRowsProd) GetJigyoshoName() string {
+	if x != nil {
+		return x.JigyoshoName
+	}
+	return ""
+}
+
+func (x *Db_DTakoFerryRowsProd) GetSharyoCd() int32 {
+	if x != nil {
+		return x.SharyoCd
+	}
+	return 0
+}
+
+func (x *Db_DTakoFerryRowsProd) GetSharyoName() string {
+	if x != nil {
+		return x.SharyoName
+	}
+	return ""
+}
+
+func (x *Db_DTakoFerryRowsProd) GetJomuinCd1() int32 {
+	if x != nil {
+		return x.JomuinCd1
+	}
+	return 0
+}
+
+func (x *Db_DTakoFerryRowsProd) GetJomuinName1() string {
+	if x != nil {
+		return x.JomuinName1
+	}
+	return ""
+}
+
+func (x *Db_DTakoFerryRowsProd) GetTaishoJomuinKbn() int32 {
+	if x != nil {
+		return x.TaishoJomuinKbn
+	}
+	return 0
+}
+
+func (x *Db_DTakoFerryRowsProd) GetKaishiDatetime() string {
+	if x != nil {
+		return x.KaishiDatetime
+	}
+	return ""
+}
+
+func (x *Db_DTakoFerryRowsProd) GetShuryoDatetime() string {
+	if x != nil {
+		return x.ShuryoDatetime
+	}
+	return ""
+}
+
+func (x *Db_DTakoFerryRowsProd) GetFerryCompanyCd() int32 {
+	if x != nil {
+		return x.FerryCompanyCd
+	}
+	return 0
+}
+
+func (x *Db_DTakoFerryRowsProd) GetFerryCompanyName() string {
+	if x != nil {
+		return x.FerryCompanyName
+	}
+	return ""
+}
+
+func (x *Db_DTakoFerryRowsProd) GetNoribaCd() int32 {
+	if x != nil {
+		return x.NoribaCd
+	}
+	return 0
+}
+
+func (x *Db_DTakoFerryRowsProd) GetNoribaName() string {
+	if x != nil {
+		return x.NoribaName
+	}
+	return ""
+}
+
+func (x *Db_DTakoFerryRowsProd) GetBin() string {
+	if x != nil {
+		return x.Bin
+	}
+	return ""
+}
+
+func (x *Db_DTakoFerryRowsProd) GetOribaCd() int32 {
+	if x != nil {
+		return x.OribaCd
+	}
+	return 0
+}
+
+func (x *Db_DTakoFerryRowsProd) GetOribaName() string {
+	if x != nil {
+		return x.OribaName
+	}
+	return ""
+}
+
+func (x *Db_DTakoFerryRowsProd) GetSeisanKbn() int32 {
+	if x != nil {
+		return x.SeisanKbn
+	}
+	return 0
+}
+
+func (x *Db_DTakoFerryRowsProd) GetSeisanKbnName() string {
+	if x != nil {
+		return x.SeisanKbnName
+	}
+	return ""
+}
+
+func (x *Db_DTakoFerryRowsProd) GetHyojunRyokin() int32 {
+	if x != nil {
+		return x.HyojunRyokin
+	}
+	return 0
+}
+
+func (x *Db_DTakoFerryRowsProd) GetKeiyakuRyokin() int32 {
+	if x != nil {
+		return x.KeiyakuRyokin
+	}
+	return 0
+}
+
+func (x *Db_DTakoFerryRowsProd) GetKosoShashuKbn() int32 {
+	if x != nil {
+		return x.KosoShashuKbn
+	}
+	return 0
+}
+
+func (x *Db_DTakoFerryRowsProd) GetKosoShashuKbnName() string {
+	if x != nil {
+		return x.KosoShashuKbnName
+	}
+	return ""
+}
+
+func (x *Db_DTakoFerryRowsProd) GetMinashiKyori() int32 {
+	if x != nil {
+		return x.MinashiKyori
+	}
+	return 0
+}
+
+func (x *Db_DTakoFerryRowsProd) GetFerrySrch() string {
+	if x != nil && x.FerrySrch != nil {
+		return *x.FerrySrch
+	}
+	return ""
+}
+
+// DTakoFerryRowsProd用リクエスト/レスポンス
+type Db_GetDTakoFerryRowsProdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_GetDTakoFerryRowsProdRequest) Reset() {
+	*x = Db_GetDTakoFerryRowsProdRequest{}
+	mi := &file_db_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_GetDTakoFerryRowsProdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_GetDTakoFerryRowsProdRequest) ProtoMessage() {}
+
+func (x *Db_GetDTakoFerryRowsProdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_GetDTakoFerryRowsProdRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetDTakoFerryRowsProdRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *Db_GetDTakoFerryRowsProdRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type Db_GetDTakoFerryRowsProdByUnkoNoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UnkoNo        string                 `protobuf:"bytes,1,opt,name=unko_no,json=unkoNo,proto3" json:"unko_no,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_GetDTakoFerryRowsProdByUnkoNoRequest) Reset() {
+	*x = Db_GetDTakoFerryRowsProdByUnkoNoRequest{}
+	mi := &file_db_service_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_GetDTakoFerryRowsProdByUnkoNoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_GetDTakoFerryRowsProdByUnkoNoRequest) ProtoMessage() {}
+
+func (x *Db_GetDTakoFerryRowsProdByUnkoNoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_GetDTakoFerryRowsProdByUnkoNoRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetDTakoFerryRowsProdByUnkoNoRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *Db_GetDTakoFerryRowsProdByUnkoNoRequest) GetUnkoNo() string {
+	if x != nil {
+		return x.UnkoNo
+	}
+	return ""
+}
+
+type Db_ListDTakoFerryRowsProdRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Limit             int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset            int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	PageToken         *string                `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`                      // キーセットページネーション用トークン（指定時はoffset/order_byは使用不可、初回は空文字）
+	IncludeTotalCount bool                   `protobuf:"varint,4,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"` // page_token使用時もtotal_countを取得する（COUNT(*)を実行）
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Db_ListDTakoFerryRowsProdRequest) Reset() {
+	*x = Db_ListDTakoFerryRowsProdRequest{}
+	mi := &file_db_service_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_ListDTakoFerryRowsProdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_ListDTakoFerryRowsProdRequest) ProtoMessage() {}
+
+func (x *Db_ListDTakoFerryRowsProdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_ListDTakoFerryRowsProdRequest.ProtoReflect.Descriptor instead.
+func (*Db_ListDTakoFerryRowsProdRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *Db_ListDTakoFerryRowsProdRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *Db_ListDTakoFerryRowsProdRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *Db_ListDTakoFerryRowsProdRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+func (x *Db_ListDTakoFerryRowsProdRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+type Db_DTakoFerryRowsProdResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DtakoFerryRows *Db_DTakoFerryRowsProd `protobuf:"bytes,1,opt,name=dtako_ferry_rows,json=dtakoFerryRows,proto3" json:"dtako_ferry_rows,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Db_DTakoFerryRowsProdResponse) Reset() {
+	*x = Db_DTakoFerryRowsProdResponse{}
+	mi := &file_db_service_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_DTakoFerryRowsProdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_DTakoFerryRowsProdResponse) ProtoMessage() {}
+
+func (x *Db_DTakoFerryRowsProdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_DTakoFerryRowsProdResponse.ProtoReflect.Descriptor instead.
+func (*Db_DTakoFerryRowsProdResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *Db_DTakoFerryRowsProdResponse) GetDtakoFerryRows() *Db_DTakoFerryRowsProd {
+	if x != nil {
+		return x.DtakoFerryRows
+	}
+	return nil
+}
+
+type Db_ListDTakoFerryRowsProdResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Items         []*Db_DTakoFerryRowsProd `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalCount    *int32                   `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`     // page_token使用時はinclude_total_count指定時のみ設定
+	NextPageToken string                   `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 次ページのトークン（最終ページの場合は空）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_ListDTakoFerryRowsProdResponse) Reset() {
+	*x = Db_ListDTakoFerryRowsProdResponse{}
+	mi := &file_db_service_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_ListDTakoFerryRowsProdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_ListDTakoFerryRowsProdResponse) ProtoMessage() {}
+
+func (x *Db_ListDTakoFerryRowsProdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_ListDTakoFerryRowsProdResponse.ProtoReflect.Descriptor instead.
+func (*Db_ListDTakoFerryRowsProdResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *Db_ListDTakoFerryRowsProdResponse) GetItems() []*Db_DTakoFerryRowsProd {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Db_ListDTakoFerryRowsProdResponse) GetTotalCount() int32 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
+	}
+	return 0
+}
+
+func (x *Db_ListDTakoFerryRowsProdResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// db_Cars メッセージ
+type Db_Cars struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id4             int32                  `protobuf:"varint,2,opt,name=id4,proto3" json:"id4,omitempty"`
+	Name            *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	NameR           *string                `protobuf:"bytes,4,opt,name=name_r,json=nameR,proto3,oneof" json:"name_r,omitempty"`
+	Shashu          *string                `protobuf:"bytes,5,opt,name=shashu,proto3,oneof" json:"shashu,omitempty"`
+	Sekisai         *float64               `protobuf:"fixed64,6,opt,name=sekisai,proto3,oneof" json:"sekisai,omitempty"`
+	Youseki         *float64               `protobuf:"fixed64,7,opt,name=youseki,proto3,oneof" json:"youseki,omitempty"`
+	RegDate         *string                `protobuf:"bytes,8,opt,name=reg_date,json=regDate,proto3,oneof" json:"reg_date,omitempty"`                           // RFC3339形式
+	NextInspectDate *string                `protobuf:"bytes,9,opt,name=next_inspect_date,json=nextInspectDate,proto3,oneof" json:"next_inspect_date,omitempty"` // RFC3339形式
+	ParchDate       *string                `protobuf:"bytes,10,opt,name=parch_date,json=parchDate,proto3,oneof" json:"parch_date,omitempty"`                    // RFC3339形式
+	ScrapDate       *string                `protobuf:"bytes,11,opt,name=scrap_date,json=scrapDate,proto3,oneof" json:"scrap_date,omitempty"`                    // RFC3339形式
+	BumonCodeId     *string                `protobuf:"bytes,12,opt,name=bumon_code_id,json=bumonCodeId,proto3,oneof" json:"bumon_code_id,omitempty"`
+	DriverId        *int32                 `protobuf:"varint,13,opt,name=driver_id,json=driverId,proto3,oneof" json:"driver_id,omitempty"`
+	Etc             *int32                 `protobuf:"varint,14,opt,name=etc,proto3,oneof" json:"etc,omitempty"`
+	Dai1            int32                  `protobuf:"varint,15,opt,name=dai1,proto3" json:"dai1,omitempty"`
+	Chu1            int32                  `protobuf:"varint,16,opt,name=chu1,proto3" json:"chu1,omitempty"`
+	Sho1            int32                  `protobuf:"varint,17,opt,name=sho1,proto3" json:"sho1,omitempty"`
+	Dai2            int32                  `protobuf:"varint,18,opt,name=dai2,proto3" json:"dai2,omitempty"`
+	Chu2            int32                  `protobuf:"varint,19,opt,name=chu2,proto3" json:"chu2,omitempty"`
+	Sho2            int32                  `protobuf:"varint,20,opt,name=sho2,proto3" json:"sho2,omitempty"`
+	Daichusho1      *string                `protobuf:"bytes,21,opt,name=daichusho1,proto3,oneof" json:"daichusho1,omitempty"`
+	Daichusho2      *string                `protobuf:"bytes,22,opt,name=daichusho2,proto3,oneof" json:"daichusho2,omitempty"`
+	BumonName       *string                `protobuf:"bytes,23,opt,name=bumon_name,json=bumonName,proto3,oneof" json:"bumon_name,omitempty"` // 部門名（部門マスタの部門N、GetByBumonCodeIDのみ設定）
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Db_Cars) Reset() {
+	*x = Db_Cars{}
+	mi := &file_db_service_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_Cars) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_Cars) ProtoMessage() {}
+
+func (x *Db_Cars) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_Cars.ProtoReflect.Descriptor instead.
+func (*Db_Cars) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *Db_Cars) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Db_Cars) GetId4() int32 {
+	if x != nil {
+		return x.Id4
+	}
+	return 0
+}
+
+func (x *Db_Cars) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *Db_Cars) GetNameR() string {
+	if x != nil && x.NameR != nil {
+		return *x.NameR
+	}
+	return ""
+}
+
+func (x *Db_Cars) GetShashu() string {
+	if x != nil && x.Shashu != nil {
+		return *x.Shashu
+	}
+	return ""
+}
+
+func (x *Db_Cars) GetSekisai() float64 {
+	if x != nil && x.Sekisai != nil {
+		return *x.Sekisai
+	}
+	return 0
+}
+
+func (x *Db_Cars) GetYouseki() float64 {
+	if x != nil && x.Youseki != nil {
+		return *x.Youseki
+	}
+	return 0
+}
+
+func (x *Db_Cars) GetRegDate() string {
+	if x != nil && x.RegDate != nil {
+		return *x.RegDate
+	}
+	return ""
+}
+
+func (x *Db_Cars) GetNextInspectDate() string {
+	if x != nil && x.NextInspectDate != nil {
+		return *x.NextInspectDate
+	}
+	return ""
+}
+
+func (x *Db_Cars) GetParchDate() string {
+	if x != nil && x.ParchDate != nil {
+		return *x.ParchDate
+	}
+	return ""
+}
+
+func (x *Db_Cars) GetScrapDate() string {
+	if x != nil && x.ScrapDate != nil {
+		return *x.ScrapDate
+	}
+	return ""
+}
+
+func (x *Db_Cars) GetBumonCodeId() string {
+	if x != nil && x.BumonCodeId != nil {
+		return *x.BumonCodeId
+	}
+	return ""
+}
+
+func (x *Db_Cars) GetDriverId() int32 {
+	if x != nil && x.DriverId != nil {
+		return *x.DriverId
+	}
+	return 0
+}
+
+func (x *Db_Cars) GetEtc() int32 {
+	if x != nil && x.Etc != nil {
+		return *x.Etc
+	}
+	return 0
+}
+
+func (x *Db_Cars) GetDai1() int32 {
+	if x != nil {
+		return x.Dai1
+	}
+	return 0
+}
+
+func (x *Db_Cars) GetChu1() int32 {
+	if x != nil {
+		return x.Chu1
+	}
+	return 0
+}
+
+func (x *Db_Cars) GetSho1() int32 {
+	if x != nil {
+		return x.Sho1
+	}
+	return 0
+}
+
+func (x *Db_Cars) GetDai2() int32 {
+	if x != nil {
+		return x.Dai2
+	}
+	return 0
+}
+
+func (x *Db_Cars) GetChu2() int32 {
+	if x != nil {
+		return x.Chu2
+	}
+	return 0
+}
+
+func (x *Db_Cars) GetSho2() int32 {
+	if x != nil {
+		return x.Sho2
+	}
+	return 0
+}
+
+func (x *Db_Cars) GetDaichusho1() string {
+	if x != nil && x.Daichusho1 != nil {
+		return *x.Daichusho1
+	}
+	return ""
+}
+
+func (x *Db_Cars) GetDaichusho2() string {
+	if x != nil && x.Daichusho2 != nil {
+		return *x.Daichusho2
+	}
+	return ""
+}
+
+func (x *Db_Cars) GetBumonName() string {
+	if x != nil && x.BumonName != nil {
+		return *x.BumonName
+	}
+	return ""
+}
+
+// db_Drivers メッセージ
+type Db_Drivers struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	ShainR        *string                `protobuf:"bytes,3,opt,name=shain_r,json=shainR,proto3,oneof" json:"shain_r,omitempty"`
+	Bumon         string                 `protobuf:"bytes,4,opt,name=bumon,proto3" json:"bumon,omitempty"`
+	JoinDate      *string                `protobuf:"bytes,5,opt,name=join_date,json=joinDate,proto3,oneof" json:"join_date,omitempty"`       // RFC3339形式
+	RetireDate    *string                `protobuf:"bytes,6,opt,name=retire_date,json=retireDate,proto3,oneof" json:"retire_date,omitempty"` // RFC3339形式
+	Bunrui1       *string                `protobuf:"bytes,7,opt,name=bunrui1,proto3,oneof" json:"bunrui1,omitempty"`
+	Bunrui2       *string                `protobuf:"bytes,8,opt,name=bunrui2,proto3,oneof" json:"bunrui2,omitempty"`
+	Kubun         *int32                 `protobuf:"varint,9,opt,name=kubun,proto3,oneof" json:"kubun,omitempty"`
+	KinmuTaikei   int32                  `protobuf:"varint,10,opt,name=kinmu_taikei,json=kinmuTaikei,proto3" json:"kinmu_taikei,omitempty"`
+	BumonName     *string                `protobuf:"bytes,11,opt,name=bumon_name,json=bumonName,proto3,oneof" json:"bumon_name,omitempty"` // 部門名（部門マスタの部門N、GetByBumonのみ設定）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_Drivers) Reset() {
+	*x = Db_Drivers{}
+	mi := &file_db_service_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_Drivers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_Drivers) ProtoMessage() {}
+
+func (x *Db_Drivers) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_Drivers.ProtoReflect.Descriptor instead.
+func (*Db_Drivers) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *Db_Drivers) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Db_Drivers) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *Db_Drivers) GetShainR() string {
+	if x != nil && x.ShainR != nil {
+		return *x.ShainR
+	}
+	return ""
+}
+
+func (x *Db_Drivers) GetBumon() string {
+	if x != nil {
+		return x.Bumon
+	}
+	return ""
+}
+
+func (x *Db_Drivers) GetJoinDate() string {
+	if x != nil && x.JoinDate != nil {
+		return *x.JoinDate
+	}
+	return ""
+}
+
+func (x *Db_Drivers) GetRetireDate() string {
+	if x != nil && x.RetireDate != nil {
+		return *x.RetireDate
+	}
+	return ""
+}
+
+func (x *Db_Drivers) GetBunrui1() string {
+	if x != nil && x.Bunrui1 != nil {
+		return *x.Bunrui1
+	}
+	return ""
+}
+
+func (x *Db_Drivers) GetBunrui2() string {
+	if x != nil && x.Bunrui2 != nil {
+		return *x.Bunrui2
+	}
+	return ""
+}
+
+func (x *Db_Drivers) GetKubun() int32 {
+	if x != nil && x.Kubun != nil {
+		return *x.Kubun
+	}
+	return 0
+}
+
+func (x *Db_Drivers) GetKinmuTaikei() int32 {
+	if x != nil {
+		return x.KinmuTaikei
+	}
+	return 0
+}
+
+func (x *Db_Drivers) GetBumonName() string {
+	if x != nil && x.BumonName != nil {
+		return *x.BumonName
+	}
+	return ""
+}
+
+// Cars用リクエスト/レスポンス
+type Db_GetCarsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_GetCarsRequest) Reset() {
+	*x = Db_GetCarsRequest{}
+	mi := &file_db_service_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_GetCarsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_GetCarsRequest) ProtoMessage() {}
+
+func (x *Db_GetCarsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_GetCarsRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetCarsRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *Db_GetCarsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Db_GetCarsByBumonCodeIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BumonCodeId   string                 `protobuf:"bytes,1,opt,name=bumon_code_id,json=bumonCodeId,proto3" json:"bumon_code_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_GetCarsByBumonCodeIDRequest) Reset() {
+	*x = Db_GetCarsByBumonCodeIDRequest{}
+	mi := &file_db_service_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_GetCarsByBumonCodeIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_GetCarsByBumonCodeIDRequest) ProtoMessage() {}
+
+func (x *Db_GetCarsByBumonCodeIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_GetCarsByBumonCodeIDRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetCarsByBumonCodeIDRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *Db_GetCarsByBumonCodeIDRequest) GetBumonCodeId() string {
+	if x != nil {
+		return x.BumonCodeId
+	}
+	return ""
+}
+
+type Db_CarsSortSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         Db_CarsSortField       `protobuf:"varint,1,opt,name=field,proto3,enum=db_service.Db_CarsSortField" json:"field,omitempty"`
+	Direction     Db_SortDirection       `protobuf:"varint,2,opt,name=direction,proto3,enum=db_service.Db_SortDirection" json:"direction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_CarsSortSpec) Reset() {
+	*x = Db_CarsSortSpec{}
+	mi := &file_db_service_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_CarsSortSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_CarsSortSpec) ProtoMessage() {}
+
+func (x *Db_CarsSortSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_CarsSortSpec.ProtoReflect.Descriptor instead.
+func (*Db_CarsSortSpec) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *Db_CarsSortSpec) GetField() Db_CarsSortField {
+	if x != nil {
+		return x.Field
+	}
+	return Db_CarsSortField_CARS_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *Db_CarsSortSpec) GetDirection() Db_SortDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return Db_SortDirection_SORT_DIRECTION_UNSPECIFIED
+}
+
+type Db_ListCarsRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Limit             int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset            int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	OrderBy           *string                `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3,oneof" json:"order_by,omitempty"`                            // 非推奨: sortを使用（ソート可能なprotoのフィールド名のみ指定可）
+	PageToken         *string                `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`                      // キーセットページネーション用トークン（指定時はoffset/order_byは使用不可、初回は空文字）
+	IncludeTotalCount bool                   `protobuf:"varint,5,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"` // page_token使用時もtotal_countを取得する（COUNT(*)を実行）
+	Sort              []*Db_CarsSortSpec     `protobuf:"bytes,6,rep,name=sort,proto3" json:"sort,omitempty"`                                                       // ソート条件（複数指定可）
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Db_ListCarsRequest) Reset() {
+	*x = Db_ListCarsRequest{}
+	mi := &file_db_service_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_ListCarsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_ListCarsRequest) ProtoMessage() {}
+
+func (x *Db_ListCarsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_ListCarsRequest.ProtoReflect.Descriptor instead.
+func (*Db_ListCarsRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *Db_ListCarsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *Db_ListCarsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *Db_ListCarsRequest) GetOrderBy() string {
+	if x != nil && x.OrderBy != nil {
+		return *x.OrderBy
+	}
+	return ""
+}
+
+func (x *Db_ListCarsRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+func (x *Db_ListCarsRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+func (x *Db_ListCarsRequest) GetSort() []*Db_CarsSortSpec {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+type Db_CarsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cars          *Db_Cars               `protobuf:"bytes,1,opt,name=cars,proto3" json:"cars,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_CarsResponse) Reset() {
+	*x = Db_CarsResponse{}
+	mi := &file_db_service_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_CarsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_CarsResponse) ProtoMessage() {}
+
+func (x *Db_CarsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_CarsResponse.ProtoReflect.Descriptor instead.
+func (*Db_CarsResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *Db_CarsResponse) GetCars() *Db_Cars {
+	if x != nil {
+		return x.Cars
+	}
+	return nil
+}
+
+type Db_ListCarsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Db_Cars             `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalCount    *int32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`     // page_token使用時はinclude_total_count指定時のみ設定
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 次ページのトークン（最終ページの場合は空）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_ListCarsResponse) Reset() {
+	*x = Db_ListCarsResponse{}
+	mi := &file_db_service_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_ListCarsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_ListCarsResponse) ProtoMessage() {}
+
+func (x *Db_ListCarsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_ListCarsResponse.ProtoReflect.Descriptor instead.
+func (*Db_ListCarsResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *Db_ListCarsResponse) GetItems() []*Db_Cars {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Db_ListCarsResponse) GetTotalCount() int32 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
+	}
+	return 0
+}
+
+func (x *Db_ListCarsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Drivers用リクエスト/レスポンス
+type Db_GetDriversRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_GetDriversRequest) Reset() {
+	*x = Db_GetDriversRequest{}
+	mi := &file_db_service_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_GetDriversRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_GetDriversRequest) ProtoMessage() {}
+
+func (x *Db_GetDriversRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_GetDriversRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetDriversRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *Db_GetDriversRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type Db_GetDriversByBumonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bumon         string                 `protobuf:"bytes,1,opt,name=bumon,proto3" json:"bumon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_GetDriversByBumonRequest) Reset() {
+	*x = Db_GetDriversByBumonRequest{}
+	mi := &file_db_service_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_GetDriversByBumonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_GetDriversByBumonRequest) ProtoMessage() {}
+
+func (x *Db_GetDriversByBumonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_GetDriversByBumonRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetDriversByBumonRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *Db_GetDriversByBumonRequest) GetBumon() string {
+	if x != nil {
+		return x.Bumon
+	}
+	return ""
+}
+
+type Db_DriversSortSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         Db_DriversSortField    `protobuf:"varint,1,opt,name=field,proto3,enum=db_service.Db_DriversSortField" json:"field,omitempty"`
+	Direction     Db_SortDirection       `protobuf:"varint,2,opt,name=direction,proto3,enum=db_service.Db_SortDirection" json:"direction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_DriversSortSpec) Reset() {
+	*x = Db_DriversSortSpec{}
+	mi := &file_db_service_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_DriversSortSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_DriversSortSpec) ProtoMessage() {}
+
+func (x *Db_DriversSortSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_DriversSortSpec.ProtoReflect.Descriptor instead.
+func (*Db_DriversSortSpec) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *Db_DriversSortSpec) GetField() Db_DriversSortField {
+	if x != nil {
+		return x.Field
+	}
+	return Db_DriversSortField_DRIVERS_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *Db_DriversSortSpec) GetDirection() Db_SortDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return Db_SortDirection_SORT_DIRECTION_UNSPECIFIED
+}
+
+type Db_ListDriversRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Limit             int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset            int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	OrderBy           *string                `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3,oneof" json:"order_by,omitempty"`                            // 非推奨: sortを使用（ソート可能なprotoのフィールド名のみ指定可）
+	PageToken         *string                `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`                      // キーセットページネーション用トークン（指定時はoffset/order_byは使用不可、初回は空文字）
+	IncludeTotalCount bool                   `protobuf:"varint,5,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"` // page_token使用時もtotal_countを取得する（COUNT(*)を実行）
+	Sort              []*Db_DriversSortSpec  `protobuf:"bytes,6,rep,name=sort,proto3" json:"sort,omitempty"`                                                       // ソート条件（複数指定可）
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Db_ListDriversRequest) Reset() {
+	*x = Db_ListDriversRequest{}
+	mi := &file_db_service_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_ListDriversRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_ListDriversRequest) ProtoMessage() {}
+
+func (x *Db_ListDriversRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_ListDriversRequest.ProtoReflect.Descriptor instead.
+func (*Db_ListDriversRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *Db_ListDriversRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *Db_ListDriversRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *Db_ListDriversRequest) GetOrderBy() string {
+	if x != nil && x.OrderBy != nil {
+		return *x.OrderBy
+	}
+	return ""
+}
+
+func (x *Db_ListDriversRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+func (x *Db_ListDriversRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+func (x *Db_ListDriversRequest) GetSort() []*Db_DriversSortSpec {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+type Db_DriversResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Drivers       *Db_Drivers            `protobuf:"bytes,1,opt,name=drivers,proto3" json:"drivers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_DriversResponse) Reset() {
+	*x = Db_DriversResponse{}
+	mi := &file_db_service_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_DriversResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_DriversResponse) ProtoMessage() {}
+
+func (x *Db_DriversResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_DriversResponse.ProtoReflect.Descriptor instead.
+func (*Db_DriversResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *Db_DriversResponse) GetDrivers() *Db_Drivers {
+	if x != nil {
+		return x.Drivers
+	}
+	return nil
+}
+
+type Db_ListDriversResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Db_Drivers          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalCount    *int32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`     // page_token使用時はinclude_total_count指定時のみ設定
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 次ページのトークン（最終ページの場合は空）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_ListDriversResponse) Reset() {
+	*x = Db_ListDriversResponse{}
+	mi := &file_db_service_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_ListDriversResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_ListDriversResponse) ProtoMessage() {}
+
+func (x *Db_ListDriversResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_ListDriversResponse.ProtoReflect.Descriptor instead.
+func (*Db_ListDriversResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *Db_ListDriversResponse) GetItems() []*Db_Drivers {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Db_ListDriversResponse) GetTotalCount() int32 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
+	}
+	return 0
+}
+
+func (x *Db_ListDriversResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// db_UntenNippoMeisai メッセージ（106カラム全て）
+type Db_UntenNippoMeisai struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	NippoK                string                 `protobuf:"bytes,1,opt,name=nippo_k,json=nippoK,proto3" json:"nippo_k,omitempty"`
+	UnkoNengappi          *string                `protobuf:"bytes,2,opt,name=unko_nengappi,json=unkoNengappi,proto3,oneof" json:"unko_nengappi,omitempty"`
+	HaishaK               string                 `protobuf:"bytes,3,opt,name=haisha_k,json=haishaK,proto3" json:"haisha_k,omitempty"`
+	SharyoC               string                 `protobuf:"bytes,4,opt,name=sharyo_c,json=sharyoC,proto3" json:"sharyo_c,omitempty"`
+	SharyoH               string                 `protobuf:"bytes,5,opt,name=sharyo_h,json=sharyoH,proto3" json:"sharyo_h,omitempty"`
+	UntenshuC             string                 `protobuf:"bytes,6,opt,name=untenshu_c,json=untenshuC,proto3" json:"untenshu_c,omitempty"`
+	YoshasakiC            string                 `protobuf:"bytes,7,opt,name=yoshasaki_c,json=yoshasakiC,proto3" json:"yoshasaki_c,omitempty"`
+	YoshasakiH            string                 `protobuf:"bytes,8,opt,name=yoshasaki_h,json=yoshasakiH,proto3" json:"yoshasaki_h,omitempty"`
+	Joshu1                string                 `protobuf:"bytes,9,opt,name=joshu1,proto3" json:"joshu1,omitempty"`
+	Joshu2                string                 `protobuf:"bytes,10,opt,name=joshu2,proto3" json:"joshu2,omitempty"`
+	NyuryokuNengappi      *string                `protobuf:"bytes,11,opt,name=nyuryoku_nengappi,json=nyuryokuNengappi,proto3,oneof" json:"nyuryoku_nengappi,omitempty"`
+	ShokaiNengappi        *string                `protobuf:"bytes,12,opt,name=shokai_nengappi,json=shokaiNengappi,proto3,oneof" json:"shokai_nengappi,omitempty"`
+	NyuryokuTantoC        string                 `protobuf:"bytes,13,opt,name=nyuryoku_tanto_c,json=nyuryokuTantoC,proto3" json:"nyuryoku_tanto_c,omitempty"`
+	JuchuNengappi         *string                `protobuf:"bytes,14,opt,name=juchu_nengappi,json=juchuNengappi,proto3,oneof" json:"juchu_nengappi,omitempty"`
+	KanriNengappi         string                 `protobuf:"bytes,15,opt,name=kanri_nengappi,json=kanriNengappi,proto3" json:"kanri_nengappi,omitempty"`
+	KanriC                int32                  `protobuf:"varint,16,opt,name=kanri_c,json=kanriC,proto3" json:"kanri_c,omitempty"`
+	TsumikomiNengappi     *string                `protobuf:"bytes,17,opt,name=tsumikomi_nengappi,json=tsumikomiNengappi,proto3,oneof" json:"tsumikomi_nengappi,omitempty"`
+	NoninNengappi         *string                `protobuf:"bytes,18,opt,name=nonin_nengappi,json=noninNengappi,proto3,oneof" json:"nonin_nengappi,omitempty"`
+	TsumoiK               *string                `protobuf:"bytes,19,opt,name=tsumoi_k,json=tsumoiK,proto3,oneof" json:"tsumoi_k,omitempty"`
+	ShashuC               string                 `protobuf:"bytes,20,opt,name=shashu_c,json=shashuC,proto3" json:"shashu_c,omitempty"`
+	JuchuBumon            string                 `protobuf:"bytes,21,opt,name=juchu_bumon,json=juchuBumon,proto3" json:"juchu_bumon,omitempty"`
+	KadoBumon             string                 `protobuf:"bytes,22,opt,name=kado_bumon,json=kadoBumon,proto3" json:"kado_bumon,omitempty"`
+	UriageK               string                 `protobuf:"bytes,23,opt,name=uriage_k,json=uriageK,proto3" json:"uriage_k,omitempty"`
+	UriageShubekiC        string                 `protobuf:"bytes,24,opt,name=uriage_shubeki_c,json=uriageShubekiC,proto3" json:"uriage_shubeki_c,omitempty"`
+	SeikyuK               string                 `protobuf:"bytes,25,opt,name=seikyu_k,json=seikyuK,proto3" json:"seikyu_k,omitempty"`
+	DenpyoNo              *string                `protobuf:"bytes,26,opt,name=denpyo_no,json=denpyoNo,proto3,oneof" json:"denpyo_no,omitempty"`
+	TokuisakiC            string                 `protobuf:"bytes,27,opt,name=tokuisaki_c,json=tokuisakiC,proto3" json:"tokuisaki_c,omitempty"`
+	TokuisakiH            string                 `protobuf:"bytes,28,opt,name=tokuisaki_h,json=tokuisakiH,proto3" json:"tokuisaki_h,omitempty"`
+	HatchiC               string                 `protobuf:"bytes,29,opt,name=hatchi_c,json=hatchiC,proto3" json:"hatchi_c,omitempty"`
+	HatchiN               *string                `protobuf:"bytes,30,opt,name=hatchi_n,json=hatchiN,proto3,oneof" json:"hatchi_n,omitempty"`
+	ChakuchiC             string                 `protobuf:"bytes,31,opt,name=chakuchi_c,json=chakuchiC,proto3" json:"chakuchi_c,omitempty"`
+	ChakuchiN             *string                `protobuf:"bytes,32,opt,name=chakuchi_n,json=chakuchiN,proto3,oneof" json:"chakuchi_n,omitempty"`
+	HinmeiC               string                 `protobuf:"bytes,33,opt,name=hinmei_c,json=hinmeiC,proto3" json:"hinmei_c,omitempty"`
+	HinmeiH               string                 `protobuf:"bytes,34,opt,name=hinmei_h,json=hinmeiH,proto3" json:"hinmei_h,omitempty"`
+	HinmeiN               *string                `protobuf:"bytes,35,opt,name=hinmei_n,json=hinmeiN,proto3,oneof" json:"hinmei_n,omitempty"`
+	SokoKm                float64                `protobuf:"fixed64,36,opt,name=soko_km,json=sokoKm,proto3" json:"soko_km,omitempty"`
+	JisshaKm              float64                `protobuf:"fixed64,37,opt,name=jissha_km,json=jisshaKm,proto3" json:"jissha_km,omitempty"`
+	SeikyuSokoKm          float64                `protobuf:"fixed64,38,opt,name=seikyu_soko_km,json=seikyuSokoKm,proto3" json:"seikyu_soko_km,omitempty"`
+	SeikyuJisshaKm        float64                `protobuf:"fixed64,39,opt,name=seikyu_jissha_km,json=seikyuJisshaKm,proto3" json:"seikyu_jissha_km,omitempty"`
+	Suryo                 float64                `protobuf:"fixed64,40,opt,name=suryo,proto3" json:"suryo,omitempty"`
+	Tani                  *string                `protobuf:"bytes,41,opt,name=tani,proto3,oneof" json:"tani,omitempty"`
+	TonSu                 float64                `protobuf:"fixed64,42,opt,name=ton_su,json=tonSu,proto3" json:"ton_su,omitempty"`
+	Tanka                 float64                `protobuf:"fixed64,43,opt,name=tanka,proto3" json:"tanka,omitempty"`
+	Kingaku               int32                  `protobuf:"varint,44,opt,name=kingaku,proto3" json:"kingaku,omitempty"`
+	Nebiki                int32                  `protobuf:"varint,45,opt,name=nebiki,proto3" json:"nebiki,omitempty"`
+	Warimashi             int32                  `protobuf:"varint,46,opt,name=warimashi,proto3" json:"warimashi,omitempty"`
+	Jippi                 int32                  `protobuf:"varint,47,opt,name=jippi,proto3" json:"jippi,omitempty"`
+	YoshaTanka            float64                `protobuf:"fixed64,48,opt,name=yosha_tanka,json=yoshaTanka,proto3" json:"yosha_tanka,omitempty"`
+	YoshaKingaku          int32                  `protobuf:"varint,49,opt,name=yosha_kingaku,json=yoshaKingaku,proto3" json:"yosha_kingaku,omitempty"`
+	YoshaNebiki           int32                  `protobuf:"varint,50,opt,name=yosha_nebiki,json=yoshaNebiki,proto3" json:"yosha_nebiki,omitempty"`
+	YoshaWarimashi        int32                  `protobuf:"varint,51,opt,name=yosha_warimashi,json=yoshaWarimashi,proto3" json:"yosha_warimashi,omitempty"`
+	YoshaJippi            int32                  `protobuf:"varint,52,opt,name=yosha_jippi,json=yoshaJippi,proto3" json:"yosha_jippi,omitempty"`
+	KakuteiK              string                 `protobuf:"bytes,53,opt,name=kakutei_k,json=kakuteiK,proto3" json:"kakutei_k,omitempty"`
+	Biko                  *string                `protobuf:"bytes,54,opt,name=biko,proto3,oneof" json:"biko,omitempty"`
+	UriageNengappi        *string                `protobuf:"bytes,55,opt,name=uriage_nengappi,json=uriageNengappi,proto3,oneof" json:"uriage_nengappi,omitempty"`
+	ShiharaiNengappi      *string                `protobuf:"bytes,56,opt,name=shiharai_nengappi,json=shiharaiNengappi,proto3,oneof" json:"shiharai_nengappi,omitempty"`
+	NyukinYoteibi         *string                `protobuf:"bytes,57,opt,name=nyukin_yoteibi,json=nyukinYoteibi,proto3,oneof" json:"nyukin_yoteibi,omitempty"`
+	ShiharaiYoteibi       *string                `protobuf:"bytes,58,opt,name=shiharai_yoteibi,json=shiharaiYoteibi,proto3,oneof" json:"shiharai_yoteibi,omitempty"`
+	Shohizei              int32                  `protobuf:"varint,59,opt,name=shohizei,proto3" json:"shohizei,omitempty"`
+	YoshaShohizei         int32                  `protobuf:"varint,60,opt,name=yosha_shohizei,json=yoshaShohizei,proto3" json:"yosha_shohizei,omitempty"`
+	Zeigaku               int32                  `protobuf:"varint,61,opt,name=zeigaku,proto3" json:"zeigaku,omitempty"`
+	WarimashiZeigaku      int32                  `protobuf:"varint,62,opt,name=warimashi_zeigaku,json=warimashiZeigaku,proto3" json:"warimashi_zeigaku,omitempty"`
+	JippiZeigaku          int32                  `protobuf:"varint,63,opt,name=jippi_zeigaku,json=jippiZeigaku,proto3" json:"jippi_zeigaku,omitempty"`
+	YoshaZeigaku          int32                  `protobuf:"varint,64,opt,name=yosha_zeigaku,json=yoshaZeigaku,proto3" json:"yosha_zeigaku,omitempty"`
+	YoshaWarimashiZeigaku int32                  `protobuf:"varint,65,opt,name=yosha_warimashi_zeigaku,json=yoshaWarimashiZeigaku,proto3" json:"yosha_warimashi_zeigaku,omitempty"`
+	YoshaJippiZeigaku     int32                  `protobuf:"varint,66,opt,name=yosha_jippi_zeigaku,json=yoshaJippiZeigaku,proto3" json:"yosha_jippi_zeigaku,omitempty"`
+	ZeinukiKingaku        int32                  `protobuf:"varint,67,opt,name=zeinuki_kingaku,json=zeinukiKingaku,proto3" json:"zeinuki_kingaku,omitempty"`
+	ZeinukiWarimashi      int32                  `protobuf:"varint,68,opt,name=zeinuki_warimashi,json=zeinukiWarimashi,proto3" json:"zeinuki_warimashi,omitempty"`
+	ZeinukiJippi          int32                  `protobuf:"varint,69,opt,name=zeinuki_jippi,json=zeinukiJippi,proto3" json:"zeinuki_jippi,omitempty"`
+	ZeinukiYoshaKingaku   int32                  `protobuf:"varint,70,opt,name=zeinuki_yosha_kingaku,json=zeinukiYoshaKingaku,proto3" json:"zeinuki_yosha_kingaku,omitempty"`
+	ZeinukiYoshaWarimashi int32                  `protobuf:"varint,71,opt,name=zeinuki_yosha_warimashi,json=zeinukiYoshaWarimashi,proto3" json:"zeinuki_yosha_warimashi,omitempty"`
+	ZeinukiYoshaJippi     int32                  `protobuf:"varint,72,opt,name=zeinuki_yosha_jippi,json=zeinukiYoshaJippi,proto3" json:"zeinuki_yosha_jippi,omitempty"`
+	ZeiK                  string                 `protobuf:"bytes,73,opt,name=zei_k,json=zeiK,proto3" json:"zei_k,omitempty"`
+	YoshaZeiK             string                 `protobuf:"bytes,74,opt,name=yosha_zei_k,json=yoshaZeiK,proto3" json:"yosha_zei_k,omitempty"`
+	Zeiritsu              float64                `protobuf:"fixed64,75,opt,name=zeiritsu,proto3" json:"zeiritsu,omitempty"`
+	YoshaZeiritsu         float64                `protobuf:"fixed64,76,opt,name=yosha_zeiritsu,json=yoshaZeiritsu,proto3" json:"yosha_zeiritsu,omitempty"`
+	UntenshuUriage        int32                  `protobuf:"varint,77,opt,name=untenshu_uriage,json=untenshuUriage,proto3" json:"untenshu_uriage,omitempty"`
+	Joshu1Uriage          int32                  `protobuf:"varint,78,opt,name=joshu1_uriage,json=joshu1Uriage,proto3" json:"joshu1_uriage,omitempty"`
+	Joshu2Uriage          int32                  `protobuf:"varint,79,opt,name=joshu2_uriage,json=joshu2Uriage,proto3" json:"joshu2_uriage,omitempty"`
+	YusoShunyuFurikae     int32                  `protobuf:"varint,80,opt,name=yuso_shunyu_furikae,json=yusoShunyuFurikae,proto3" json:"yuso_shunyu_furikae,omitempty"`
+	UriageKingakuFurikae  int32                  `protobuf:"varint,81,opt,name=uriage_kingaku_furikae,json=uriageKingakuFurikae,proto3" json:"uriage_kingaku_furikae,omitempty"`
+	KazeiUriage           int32                  `protobuf:"varint,82,opt,name=kazei_uriage,json=kazeiUriage,proto3" json:"kazei_uriage,omitempty"`
+	HikazeiUriage         int32                  `protobuf:"varint,83,opt,name=hikazei_uriage,json=hikazeiUriage,proto3" json:"hikazei_uriage,omitempty"`
+	KazeiShiharai         int32                  `protobuf:"varint,84,opt,name=kazei_shiharai,json=kazeiShiharai,proto3" json:"kazei_shiharai,omitempty"`
+	HikazeiShiharai       int32                  `protobuf:"varint,85,opt,name=hikazei_shiharai,json=hikazeiShiharai,proto3" json:"hikazei_shiharai,omitempty"`
+	HatchiikiC            string                 `protobuf:"bytes,86,opt,name=hatchiiki_c,json=hatchiikiC,proto3" json:"hatchiiki_c,omitempty"`
+	ChakuchiikiC          string                 `protobuf:"bytes,87,opt,name=chakuchiiki_c,json=chakuchiikiC,proto3" json:"chakuchiiki_c,omitempty"`
+	Rikunkyoku            *string                `protobuf:"bytes,88,opt,name=rikunkyoku,proto3,oneof" json:"rikunkyoku,omitempty"`
+	Yoseki                *float64               `protobuf:"fixed64,89,opt,name=yoseki,proto3,oneof" json:"yoseki,omitempty"`
+	JuryoKakuninTenpushoK string                 `protobuf:"bytes,90,opt,name=juryo_kakunin_tenpusho_k,json=juryoKakuninTenpushoK,proto3" json:"juryo_kakunin_tenpusho_k,omitempty"`
+	SaishuKoshin          *string                `protobuf:"bytes,91,opt,name=saishu_koshin,json=saishuKoshin,proto3,oneof" json:"saishu_koshin,omitempty"`
+	Lc                    int32                  `protobuf:"varint,92,opt,name=lc,proto3" json:"lc,omitempty"`
+	KoguchiK              *string                `protobuf:"bytes,93,opt,name=koguchi_k,json=koguchiK,proto3,oneof" json:"koguchi_k,omitempty"`
+	UnsoGaishaN           *string                `protobuf:"bytes,94,opt,name=unso_gaisha_n,json=unsoGaishaN,proto3,oneof" json:"unso_gaisha_n,omitempty"`
+	JomuinN               *string                `protobuf:"bytes,95,opt,name=jomuin_n,json=jomuinN,proto3,oneof" json:"jomuin_n,omitempty"`
+	Renrakusaki           *string                `protobuf:"bytes,96,opt,name=renrakusaki,proto3,oneof" json:"renrakusaki,omitempty"`
+	TaniJuryo             *float64               `protobuf:"fixed64,97,opt,name=tani_juryo,json=taniJuryo,proto3,oneof" json:"tani_juryo,omitempty"`
+	ShiharaiKakuteiK      string                 `protobuf:"bytes,98,opt,name=shiharai_kakutei_k,json=shiharaiKakuteiK,proto3" json:"shiharai_kakutei_k,omitempty"`
+	Biko2                 *string                `protobuf:"bytes,99,opt,name=biko2,proto3,oneof" json:"biko2,omitempty"`
+	Yobi1                 *float64               `protobuf:"fixed64,100,opt,name=yobi1,proto3,oneof" json:"yobi1,omitempty"`
+	Yobi2                 *float64               `protobuf:"fixed64,101,opt,name=yobi2,proto3,oneof" json:"yobi2,omitempty"`
+	Yobi3                 *string                `protobuf:"bytes,102,opt,name=yobi3,proto3,oneof" json:"yobi3,omitempty"`
+	Yobi4                 *string                `protobuf:"bytes,103,opt,name=yobi4,proto3,oneof" json:"yobi4,omitempty"`
+	Yobi5                 *string                `protobuf:"bytes,104,opt,name=yobi5,proto3,oneof" json:"yobi5,omitempty"`
+	Yobi6                 *string                `protobuf:"bytes,105,opt,name=yobi6,proto3,oneof" json:"yobi6,omitempty"`
+	Yobi7                 *string                `protobuf:"bytes,106,opt,name=yobi7,proto3,oneof" json:"yobi7,omitempty"`
+	JuchuBumonName        *string                `protobuf:"bytes,107,opt,name=juchu_bumon_name,json=juchuBumonName,proto3,oneof" json:"juchu_bumon_name,omitempty"` // 受注部門の部門名（部門マスタの部門N、GetByBumonのみ設定）
+	KadoBumonName         *string                `protobuf:"bytes,108,opt,name=kado_bumon_name,json=kadoBumonName,proto3,oneof" json:"kado_bumon_name,omitempty"`    // 稼動部門の部門名（部門マスタの部門N、GetByBumonのみ設定）
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *Db_UntenNippoMeisai) Reset() {
+	*x = Db_UntenNippoMeisai{}
+	mi := &file_db_service_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_UntenNippoMeisai) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_UntenNippoMeisai) ProtoMessage() {}
+
+func (x *Db_UntenNippoMeisai) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_UntenNippoMeisai.ProtoReflect.Descriptor instead.
+func (*Db_UntenNippoMeisai) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *Db_UntenNippoMeisai) GetNippoK() string {
+	if x != nil {
+		return x.NippoK
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetUnkoNengappi() string {
+	if x != nil && x.UnkoNengappi != nil {
+		return *x.UnkoNengappi
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetHaishaK() string {
+	if x != nil {
+		return x.HaishaK
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetSharyoC() string {
+	if x != nil {
+		return x.SharyoC
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetSharyoH() string {
+	if x != nil {
+		return x.SharyoH
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetUntenshuC() string {
+	if x != nil {
+		return x.UntenshuC
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetYoshasakiC() string {
+	if x != nil {
+		return x.YoshasakiC
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetYoshasakiH() string {
+	if x != nil {
+		return x.YoshasakiH
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetJoshu1() string {
+	if x != nil {
+		return x.Joshu1
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetJoshu2() string {
+	if x != nil {
+		return x.Joshu2
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetNyuryokuNengappi() string {
+	if x != nil && x.NyuryokuNengappi != nil {
+		return *x.NyuryokuNengappi
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetShokaiNengappi() string {
+	if x != nil && x.ShokaiNengappi != nil {
+		return *x.ShokaiNengappi
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetNyuryokuTantoC() string {
+	if x != nil {
+		return x.NyuryokuTantoC
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetJuchuNengappi() string {
+	if x != nil && x.JuchuNengappi != nil {
+		return *x.JuchuNengappi
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetKanriNengappi() string {
+	if x != nil {
+		return x.KanriNengappi
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetKanriC() int32 {
+	if x != nil {
+		return x.KanriC
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetTsumikomiNengappi() string {
+	if x != nil && x.TsumikomiNengappi != nil {
+		return *x.TsumikomiNengappi
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetNoninNengappi() string {
+	if x != nil && x.NoninNengappi != nil {
+		return *x.NoninNengappi
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetTsumoiK() string {
+	if x != nil && x.TsumoiK != nil {
+		return *x.TsumoiK
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetShashuC() string {
+	if x != nil {
+		return x.ShashuC
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetJuchuBumon() string {
+	if x != nil {
+		return x.JuchuBumon
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetKadoBumon() string {
+	if x != nil {
+		return x.KadoBumon
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetUriageK() string {
+	if x != nil {
+		return x.UriageK
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetUriageShubekiC() string {
+	if x != nil {
+		return x.UriageShubekiC
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetSeikyuK() string {
+	if x != nil {
+		return x.SeikyuK
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetDenpyoNo() string {
+	if x != nil && x.DenpyoNo != nil {
+		return *x.DenpyoNo
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetTokuisakiC() string {
+	if x != nil {
+		return x.TokuisakiC
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetTokuisakiH() string {
+	if x != nil {
+		return x.TokuisakiH
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetHatchiC() string {
+	if x != nil {
+		return x.HatchiC
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetHatchiN() string {
+	if x != nil && x.HatchiN != nil {
+		return *x.HatchiN
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetChakuchiC() string {
+	if x != nil {
+		return x.ChakuchiC
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetChakuchiN() string {
+	if x != nil && x.ChakuchiN != nil {
+		return *x.ChakuchiN
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetHinmeiC() string {
+	if x != nil {
+		return x.HinmeiC
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetHinmeiH() string {
+	if x != nil {
+		return x.HinmeiH
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetHinmeiN() string {
+	if x != nil && x.HinmeiN != nil {
+		return *x.HinmeiN
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetSokoKm() float64 {
+	if x != nil {
+		return x.SokoKm
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetJisshaKm() float64 {
+	if x != nil {
+		return x.JisshaKm
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetSeikyuSokoKm() float64 {
+	if x != nil {
+		return x.SeikyuSokoKm
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetSeikyuJisshaKm() float64 {
+	if x != nil {
+		return x.SeikyuJisshaKm
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetSuryo() float64 {
+	if x != nil {
+		return x.Suryo
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetTani() string {
+	if x != nil && x.Tani != nil {
+		return *x.Tani
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetTonSu() float64 {
+	if x != nil {
+		return x.TonSu
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetTanka() float64 {
+	if x != nil {
+		return x.Tanka
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetKingaku() int32 {
+	if x != nil {
+		return x.Kingaku
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetNebiki() int32 {
+	if x != nil {
+		return x.Nebiki
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetWarimashi() int32 {
+	if x != nil {
+		return x.Warimashi
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetJippi() int32 {
+	if x != nil {
+		return x.Jippi
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetYoshaTanka() float64 {
+	if x != nil {
+		return x.YoshaTanka
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetYoshaKingaku() int32 {
+	if x != nil {
+		return x.YoshaKingaku
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetYoshaNebiki() int32 {
+	if x != nil {
+		return x.YoshaNebiki
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetYoshaWarimashi() int32 {
+	if x != nil {
+		return x.YoshaWarimashi
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetYoshaJippi() int32 {
+	if x != nil {
+		return x.YoshaJippi
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetKakuteiK() string {
+	if x != nil {
+		return x.KakuteiK
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetBiko() string {
+	if x != nil && x.Biko != nil {
+		return *x.Biko
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetUriageNengappi() string {
+	if x != nil && x.UriageNengappi != nil {
+		return *x.UriageNengappi
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetShiharaiNengappi() string {
+	if x != nil && x.ShiharaiNengappi != nil {
+		return *x.ShiharaiNengappi
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetNyukinYoteibi() string {
+	if x != nil && x.NyukinYoteibi != nil {
+		return *x.NyukinYoteibi
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetShiharaiYoteibi() string {
+	if x != nil && x.ShiharaiYoteibi != nil {
+		return *x.ShiharaiYoteibi
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetShohizei() int32 {
+	if x != nil {
+		return x.Shohizei
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetYoshaShohizei() int32 {
+	if x != nil {
+		return x.YoshaShohizei
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetZeigaku() int32 {
+	if x != nil {
+		return x.Zeigaku
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetWarimashiZeigaku() int32 {
+	if x != nil {
+		return x.WarimashiZeigaku
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetJippiZeigaku() int32 {
+	if x != nil {
+		return x.JippiZeigaku
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetYoshaZeigaku() int32 {
+	if x != nil {
+		return x.YoshaZeigaku
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetYoshaWarimashiZeigaku() int32 {
+	if x != nil {
+		return x.YoshaWarimashiZeigaku
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetYoshaJippiZeigaku() int32 {
+	if x != nil {
+		return x.YoshaJippiZeigaku
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetZeinukiKingaku() int32 {
+	if x != nil {
+		return x.ZeinukiKingaku
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetZeinukiWarimashi() int32 {
+	if x != nil {
+		return x.ZeinukiWarimashi
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetZeinukiJippi() int32 {
+	if x != nil {
+		return x.ZeinukiJippi
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetZeinukiYoshaKingaku() int32 {
+	if x != nil {
+		return x.ZeinukiYoshaKingaku
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetZeinukiYoshaWarimashi() int32 {
+	if x != nil {
+		return x.ZeinukiYoshaWarimashi
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetZeinukiYoshaJippi() int32 {
+	if x != nil {
+		return x.ZeinukiYoshaJippi
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetZeiK() string {
+	if x != nil {
+		return x.ZeiK
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetYoshaZeiK() string {
+	if x != nil {
+		return x.YoshaZeiK
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetZeiritsu() float64 {
+	if x != nil {
+		return x.Zeiritsu
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetYoshaZeiritsu() float64 {
+	if x != nil {
+		return x.YoshaZeiritsu
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetUntenshuUriage() int32 {
+	if x != nil {
+		return x.UntenshuUriage
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetJoshu1Uriage() int32 {
+	if x != nil {
+		return x.Joshu1Uriage
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetJoshu2Uriage() int32 {
+	if x != nil {
+		return x.Joshu2Uriage
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetYusoShunyuFurikae() int32 {
+	if x != nil {
+		return x.YusoShunyuFurikae
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetUriageKingakuFurikae() int32 {
+	if x != nil {
+		return x.UriageKingakuFurikae
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetKazeiUriage() int32 {
+	if x != nil {
+		return x.KazeiUriage
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetHikazeiUriage() int32 {
+	if x != nil {
+		return x.HikazeiUriage
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetKazeiShiharai() int32 {
+	if x != nil {
+		return x.KazeiShiharai
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetHikazeiShiharai() int32 {
+	if x != nil {
+		return x.HikazeiShiharai
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetHatchiikiC() string {
+	if x != nil {
+		return x.HatchiikiC
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetChakuchiikiC() string {
+	if x != nil {
+		return x.ChakuchiikiC
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetRikunkyoku() string {
+	if x != nil && x.Rikunkyoku != nil {
+		return *x.Rikunkyoku
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetYoseki() float64 {
+	if x != nil && x.Yoseki != nil {
+		return *x.Yoseki
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetJuryoKakuninTenpushoK() string {
+	if x != nil {
+		return x.JuryoKakuninTenpushoK
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetSaishuKoshin() string {
+	if x != nil && x.SaishuKoshin != nil {
+		return *x.SaishuKoshin
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetLc() int32 {
+	if x != nil {
+		return x.Lc
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetKoguchiK() string {
+	if x != nil && x.KoguchiK != nil {
+		return *x.KoguchiK
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetUnsoGaishaN() string {
+	if x != nil && x.UnsoGaishaN != nil {
+		return *x.UnsoGaishaN
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetJomuinN() string {
+	if x != nil && x.JomuinN != nil {
+		return *x.JomuinN
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetRenrakusaki() string {
+	if x != nil && x.Renrakusaki != nil {
+		return *x.Renrakusaki
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetTaniJuryo() float64 {
+	if x != nil && x.TaniJuryo != nil {
+		return *x.TaniJuryo
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetShiharaiKakuteiK() string {
+	if x != nil {
+		return x.ShiharaiKakuteiK
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetBiko2() string {
+	if x != nil && x.Biko2 != nil {
+		return *x.Biko2
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetYobi1() float64 {
+	if x != nil && x.Yobi1 != nil {
+		return *x.Yobi1
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetYobi2() float64 {
+	if x != nil && x.Yobi2 != nil {
+		return *x.Yobi2
+	}
+	return 0
+}
+
+func (x *Db_UntenNippoMeisai) GetYobi3() string {
+	if x != nil && x.Yobi3 != nil {
+		return *x.Yobi3
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetYobi4() string {
+	if x != nil && x.Yobi4 != nil {
+		return *x.Yobi4
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetYobi5() string {
+	if x != nil && x.Yobi5 != nil {
+		return *x.Yobi5
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetYobi6() string {
+	if x != nil && x.Yobi6 != nil {
+		return *x.Yobi6
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetYobi7() string {
+	if x != nil && x.Yobi7 != nil {
+		return *x.Yobi7
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetJuchuBumonName() string {
+	if x != nil && x.JuchuBumonName != nil {
+		return *x.JuchuBumonName
+	}
+	return ""
+}
+
+func (x *Db_UntenNippoMeisai) GetKadoBumonName() string {
+	if x != nil && x.KadoBumonName != nil {
+		return *x.KadoBumonName
+	}
+	return ""
+}
+
+// db_ShainMaster メッセージ（43カラム全て）
+type Db_ShainMaster struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ShainC            string                 `protobuf:"bytes,1,opt,name=shain_c,json=shainC,proto3" json:"shain_c,omitempty"`
+	ShainN            *string                `protobuf:"bytes,2,opt,name=shain_n,json=shainN,proto3,oneof" json:"shain_n,omitempty"`
+	ShainR            *string                `protobuf:"bytes,3,opt,name=shain_r,json=shainR,proto3,oneof" json:"shain_r,omitempty"`
+	ShainF            *string                `protobuf:"bytes,4,opt,name=shain_f,json=shainF,proto3,oneof" json:"shain_f,omitempty"`
+	YubinBango        *string                `protobuf:"bytes,5,opt,name=yubin_bango,json=yubinBango,proto3,oneof" json:"yubin_bango,omitempty"`
+	Jusho1            *string                `protobuf:"bytes,6,opt,name=jusho1,proto3,oneof" json:"jusho1,omitempty"`
+	Jusho2            *string                `protobuf:"bytes,7,opt,name=jusho2,proto3,oneof" json:"jusho2,omitempty"`
+	DenwaBango        *string                `protobuf:"bytes,8,opt,name=denwa_bango,json=denwaBango,proto3,oneof" json:"denwa_bango,omitempty"`
+	KeitaiBango       *string                `protobuf:"bytes,9,opt,name=keitai_bango,json=keitaiBango,proto3,oneof" json:"keitai_bango,omitempty"`
+	ShainK            string                 `protobuf:"bytes,10,opt,name=shain_k,json=shainK,proto3" json:"shain_k,omitempty"`
+	Seibetsu          string                 `protobuf:"bytes,11,opt,name=seibetsu,proto3" json:"seibetsu,omitempty"`
+	Ketsuekigata      string                 `protobuf:"bytes,12,opt,name=ketsuekigata,proto3" json:"ketsuekigata,omitempty"`
+	Seinengappi       *string                `protobuf:"bytes,13,opt,name=seinengappi,proto3,oneof" json:"seinengappi,omitempty"`
+	NyushaNengappi    *string                `protobuf:"bytes,14,opt,name=nyusha_nengappi,json=nyushaNengappi,proto3,oneof" json:"nyusha_nengappi,omitempty"`
+	TaishokuNengappi  *string                `protobuf:"bytes,15,opt,name=taishoku_nengappi,json=taishokuNengappi,proto3,oneof" json:"taishoku_nengappi,omitempty"`
+	DaiBunrui1        string                 `protobuf:"bytes,16,opt,name=dai_bunrui1,json=daiBunrui1,proto3" json:"dai_bunrui1,omitempty"`
+	ChuBunrui1        string                 `protobuf:"bytes,17,opt,name=chu_bunrui1,json=chuBunrui1,proto3" json:"chu_bunrui1,omitempty"`
+	ShoBunrui1        string                 `protobuf:"bytes,18,opt,name=sho_bunrui1,json=shoBunrui1,proto3" json:"sho_bunrui1,omitempty"`
+	DaiBunrui2        string                 `protobuf:"bytes,19,opt,name=dai_bunrui2,json=daiBunrui2,proto3" json:"dai_bunrui2,omitempty"`
+	ChuBunrui2        string                 `protobuf:"bytes,20,opt,name=chu_bunrui2,json=chuBunrui2,proto3" json:"chu_bunrui2,omitempty"`
+	ShoBunrui2        string                 `protobuf:"bytes,21,opt,name=sho_bunrui2,json=shoBunrui2,proto3" json:"sho_bunrui2,omitempty"`
+	KodanPlate        *string                `protobuf:"bytes,22,opt,name=kodan_plate,json=kodanPlate,proto3,oneof" json:"kodan_plate,omitempty"`
+	UriageMokuhyogaku int32                  `protobuf:"varint,23,opt,name=uriage_mokuhyogaku,json=uriageMokuhyogaku,proto3" json:"uriage_mokuhyogaku,omitempty"`
+	UntenMenkyoK      string                 `protobuf:"bytes,24,opt,name=unten_menkyo_k,json=untenMenkyoK,proto3" json:"unten_menkyo_k,omitempty"`
+	MenkyoshoBango    *string                `protobuf:"bytes,25,opt,name=menkyosho_bango,json=menkyoshoBango,proto3,oneof" json:"menkyosho_bango,omitempty"`
+	JikaiKoshinbi     *string                `protobuf:"bytes,26,opt,name=jikai_koshinbi,json=jikaiKoshinbi,proto3,oneof" json:"jikai_koshinbi,omitempty"`
+	JishaYoshaK       string                 `protobuf:"bytes,27,opt,name=jisha_yosha_k,json=jishaYoshaK,proto3" json:"jisha_yosha_k,omitempty"`
+	KeisanK           string                 `protobuf:"bytes,28,opt,name=keisan_k,json=keisanK,proto3" json:"keisan_k,omitempty"`
+	ShiharaiRitsu     float64                `protobuf:"fixed64,29,opt,name=shiharai_ritsu,json=shiharaiRitsu,proto3" json:"shiharai_ritsu,omitempty"`
+	HasuK             string                 `protobuf:"bytes,30,opt,name=hasu_k,json=hasuK,proto3" json:"hasu_k,omitempty"`
+	BumonC            string                 `protobuf:"bytes,31,opt,name=bumon_c,json=bumonC,proto3" json:"bumon_c,omitempty"`
+	UnchinPatternC    string                 `protobuf:"bytes,32,opt,name=unchin_pattern_c,json=unchinPatternC,proto3" json:"unchin_pattern_c,omitempty"`
+	Kiji1             *string                `protobuf:"bytes,33,opt,name=kiji1,proto3,oneof" json:"kiji1,omitempty"`
+	Kiji2             *string                `protobuf:"bytes,34,opt,name=kiji2,proto3,oneof" json:"kiji2,omitempty"`
+	Kiji3             *string                `protobuf:"bytes,35,opt,name=kiji3,proto3,oneof" json:"kiji3,omitempty"`
+	Kiji4             *string                `protobuf:"bytes,36,opt,name=kiji4,proto3,oneof" json:"kiji4,omitempty"`
+	Kiji5             *string                `protobuf:"bytes,37,opt,name=kiji5,proto3,oneof" json:"kiji5,omitempty"`
+	Yobi1             *string                `protobuf:"bytes,38,opt,name=yobi1,proto3,oneof" json:"yobi1,omitempty"`
+	Yobi2             *string                `protobuf:"bytes,39,opt,name=yobi2,proto3,oneof" json:"yobi2,omitempty"`
+	Yobi3             *string                `protobuf:"bytes,40,opt,name=yobi3,proto3,oneof" json:"yobi3,omitempty"`
+	Yobi4             *string                `protobuf:"bytes,41,opt,name=yobi4,proto3,oneof" json:"yobi4,omitempty"`
+	Yobi5             *string                `protobuf:"bytes,42,opt,name=yobi5,proto3,oneof" json:"yobi5,omitempty"`
+	KinmuTaikeiC      string                 `protobuf:"bytes,43,opt,name=kinmu_taikei_c,json=kinmuTaikeiC,proto3" json:"kinmu_taikei_c,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Db_ShainMaster) Reset() {
+	*x = Db_ShainMaster{}
+	mi := &file_db_service_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_ShainMaster) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_ListETCMeisaiMappingResponse) ProtoMessage() {}
+func (*Db_ShainMaster) ProtoMessage() {}
 
-func (x *Db_ListETCMeisaiMappingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[31]
+func (x *Db_ShainMaster) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2082,330 +9423,338 @@ func (x *Db_ListETCMeisaiMappingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_ListETCMeisaiMappingResponse.ProtoReflect.Descriptor instead.
-func (*Db_ListETCMeisaiMappingResponse) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use Db_ShainMaster.ProtoReflect.Descriptor instead.
+func (*Db_ShainMaster) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *Db_ListETCMeisaiMappingResponse) GetItems() []*Db_ETCMeisaiMapping {
+func (x *Db_ShainMaster) GetShainC() string {
 	if x != nil {
-		return x.Items
+		return x.ShainC
 	}
-	return nil
+	return ""
 }
 
-func (x *Db_ListETCMeisaiMappingResponse) GetTotalCount() int32 {
-	if x != nil {
-		return x.TotalCount
+func (x *Db_ShainMaster) GetShainN() string {
+	if x != nil && x.ShainN != nil {
+		return *x.ShainN
 	}
-	return 0
+	return ""
 }
 
-type Db_GetDTakoRowIDByHashRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	EtcMeisaiHash string                 `protobuf:"bytes,1,opt,name=etc_meisai_hash,json=etcMeisaiHash,proto3" json:"etc_meisai_hash,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Db_ShainMaster) GetShainR() string {
+	if x != nil && x.ShainR != nil {
+		return *x.ShainR
+	}
+	return ""
 }
 
-func (x *Db_GetDTakoRowIDByHashRequest) Reset() {
-	*x = Db_GetDTakoRowIDByHashRequest{}
-	mi := &file_db_service_proto_msgTypes[32]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Db_ShainMaster) GetShainF() string {
+	if x != nil && x.ShainF != nil {
+		return *x.ShainF
+	}
+	return ""
 }
 
-func (x *Db_GetDTakoRowIDByHashRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Db_ShainMaster) GetYubinBango() string {
+	if x != nil && x.YubinBango != nil {
+		return *x.YubinBango
+	}
+	return ""
 }
 
-func (*Db_GetDTakoRowIDByHashRequest) ProtoMessage() {}
+func (x *Db_ShainMaster) GetJusho1() string {
+	if x != nil && x.Jusho1 != nil {
+		return *x.Jusho1
+	}
+	return ""
+}
 
-func (x *Db_GetDTakoRowIDByHashRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[32]
+func (x *Db_ShainMaster) GetJusho2() string {
+	if x != nil && x.Jusho2 != nil {
+		return *x.Jusho2
+	}
+	return ""
+}
+
+func (x *Db_ShainMaster) GetDenwaBango() string {
+	if x != nil && x.DenwaBango != nil {
+		return *x.DenwaBango
+	}
+	return ""
+}
+
+func (x *Db_ShainMaster) GetKeitaiBango() string {
+	if x != nil && x.KeitaiBango != nil {
+		return *x.KeitaiBango
+	}
+	return ""
+}
+
+func (x *Db_ShainMaster) GetShainK() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ShainK
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use Db_GetDTakoRowIDByHashRequest.ProtoReflect.Descriptor instead.
-func (*Db_GetDTakoRowIDByHashRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{32}
+func (x *Db_ShainMaster) GetSeibetsu() string {
+	if x != nil {
+		return x.Seibetsu
+	}
+	return ""
 }
 
-func (x *Db_GetDTakoRowIDByHashRequest) GetEtcMeisaiHash() string {
+func (x *Db_ShainMaster) GetKetsuekigata() string {
 	if x != nil {
-		return x.EtcMeisaiHash
+		return x.Ketsuekigata
 	}
 	return ""
 }
 
-type Db_GetDTakoRowIDByHashResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	DtakoRowIds   []string               `protobuf:"bytes,1,rep,name=dtako_row_ids,json=dtakoRowIds,proto3" json:"dtako_row_ids,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Db_ShainMaster) GetSeinengappi() string {
+	if x != nil && x.Seinengappi != nil {
+		return *x.Seinengappi
+	}
+	return ""
 }
 
-func (x *Db_GetDTakoRowIDByHashResponse) Reset() {
-	*x = Db_GetDTakoRowIDByHashResponse{}
-	mi := &file_db_service_proto_msgTypes[33]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Db_ShainMaster) GetNyushaNengappi() string {
+	if x != nil && x.NyushaNengappi != nil {
+		return *x.NyushaNengappi
+	}
+	return ""
 }
 
-func (x *Db_GetDTakoRowIDByHashResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Db_ShainMaster) GetTaishokuNengappi() string {
+	if x != nil && x.TaishokuNengappi != nil {
+		return *x.TaishokuNengappi
+	}
+	return ""
 }
 
-func (*Db_GetDTakoRowIDByHashResponse) ProtoMessage() {}
+func (x *Db_ShainMaster) GetDaiBunrui1() string {
+	if x != nil {
+		return x.DaiBunrui1
+	}
+	return ""
+}
 
-func (x *Db_GetDTakoRowIDByHashResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[33]
+func (x *Db_ShainMaster) GetChuBunrui1() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ChuBunrui1
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use Db_GetDTakoRowIDByHashResponse.ProtoReflect.Descriptor instead.
-func (*Db_GetDTakoRowIDByHashResponse) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{33}
+func (x *Db_ShainMaster) GetShoBunrui1() string {
+	if x != nil {
+		return x.ShoBunrui1
+	}
+	return ""
 }
 
-func (x *Db_GetDTakoRowIDByHashResponse) GetDtakoRowIds() []string {
+func (x *Db_ShainMaster) GetDaiBunrui2() string {
 	if x != nil {
-		return x.DtakoRowIds
+		return x.DaiBunrui2
 	}
-	return nil
+	return ""
 }
 
-// 車輌マスタデータ（本番DB）
-type Db_DTakoCars struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	Id                  int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CarCode             string                 `protobuf:"bytes,2,opt,name=car_code,json=carCode,proto3" json:"car_code,omitempty"`
-	CarCc               string                 `protobuf:"bytes,3,opt,name=car_cc,json=carCc,proto3" json:"car_cc,omitempty"`
-	CarName             string                 `protobuf:"bytes,4,opt,name=car_name,json=carName,proto3" json:"car_name,omitempty"`
-	BelongOfficeCode    int32                  `protobuf:"varint,5,opt,name=belong_office_code,json=belongOfficeCode,proto3" json:"belong_office_code,omitempty"`
-	HighwayCarType      int32                  `protobuf:"varint,6,opt,name=highway_car_type,json=highwayCarType,proto3" json:"highway_car_type,omitempty"`
-	FerryCarType        int32                  `protobuf:"varint,7,opt,name=ferry_car_type,json=ferryCarType,proto3" json:"ferry_car_type,omitempty"`
-	EvaluationClassCode int32                  `protobuf:"varint,8,opt,name=evaluation_class_code,json=evaluationClassCode,proto3" json:"evaluation_class_code,omitempty"`
-	IdlingType          int32                  `protobuf:"varint,9,opt,name=idling_type,json=idlingType,proto3" json:"idling_type,omitempty"`
-	MaxLoadWeightKg     int32                  `protobuf:"varint,10,opt,name=max_load_weight_kg,json=maxLoadWeightKg,proto3" json:"max_load_weight_kg,omitempty"`
-	CarClass1           int32                  `protobuf:"varint,11,opt,name=car_class1,json=carClass1,proto3" json:"car_class1,omitempty"`
-	CarClass2           int32                  `protobuf:"varint,12,opt,name=car_class2,json=carClass2,proto3" json:"car_class2,omitempty"`
-	CarClass3           int32                  `protobuf:"varint,13,opt,name=car_class3,json=carClass3,proto3" json:"car_class3,omitempty"`
-	CarClass4           int32                  `protobuf:"varint,14,opt,name=car_class4,json=carClass4,proto3" json:"car_class4,omitempty"`
-	CarClass5           int32                  `protobuf:"varint,15,opt,name=car_class5,json=carClass5,proto3" json:"car_class5,omitempty"`
-	OperationType       int32                  `protobuf:"varint,16,opt,name=operation_type,json=operationType,proto3" json:"operation_type,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+func (x *Db_ShainMaster) GetChuBunrui2() string {
+	if x != nil {
+		return x.ChuBunrui2
+	}
+	return ""
 }
 
-func (x *Db_DTakoCars) Reset() {
-	*x = Db_DTakoCars{}
-	mi := &file_db_service_proto_msgTypes[34]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Db_ShainMaster) GetShoBunrui2() string {
+	if x != nil {
+		return x.ShoBunrui2
+	}
+	return ""
 }
 
-func (x *Db_DTakoCars) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Db_ShainMaster) GetKodanPlate() string {
+	if x != nil && x.KodanPlate != nil {
+		return *x.KodanPlate
+	}
+	return ""
 }
 
-func (*Db_DTakoCars) ProtoMessage() {}
+func (x *Db_ShainMaster) GetUriageMokuhyogaku() int32 {
+	if x != nil {
+		return x.UriageMokuhyogaku
+	}
+	return 0
+}
 
-func (x *Db_DTakoCars) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[34]
+func (x *Db_ShainMaster) GetUntenMenkyoK() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.UntenMenkyoK
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use Db_DTakoCars.ProtoReflect.Descriptor instead.
-func (*Db_DTakoCars) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{34}
+func (x *Db_ShainMaster) GetMenkyoshoBango() string {
+	if x != nil && x.MenkyoshoBango != nil {
+		return *x.MenkyoshoBango
+	}
+	return ""
 }
 
-func (x *Db_DTakoCars) GetId() int32 {
+func (x *Db_ShainMaster) GetJikaiKoshinbi() string {
+	if x != nil && x.JikaiKoshinbi != nil {
+		return *x.JikaiKoshinbi
+	}
+	return ""
+}
+
+func (x *Db_ShainMaster) GetJishaYoshaK() string {
 	if x != nil {
-		return x.Id
+		return x.JishaYoshaK
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_DTakoCars) GetCarCode() string {
+func (x *Db_ShainMaster) GetKeisanK() string {
 	if x != nil {
-		return x.CarCode
+		return x.KeisanK
 	}
 	return ""
 }
 
-func (x *Db_DTakoCars) GetCarCc() string {
+func (x *Db_ShainMaster) GetShiharaiRitsu() float64 {
+	if x != nil {
+		return x.ShiharaiRitsu
+	}
+	return 0
+}
+
+func (x *Db_ShainMaster) GetHasuK() string {
 	if x != nil {
-		return x.CarCc
+		return x.HasuK
 	}
 	return ""
 }
 
-func (x *Db_DTakoCars) GetCarName() string {
+func (x *Db_ShainMaster) GetBumonC() string {
 	if x != nil {
-		return x.CarName
+		return x.BumonC
 	}
 	return ""
 }
 
-func (x *Db_DTakoCars) GetBelongOfficeCode() int32 {
+func (x *Db_ShainMaster) GetUnchinPatternC() string {
 	if x != nil {
-		return x.BelongOfficeCode
+		return x.UnchinPatternC
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_DTakoCars) GetHighwayCarType() int32 {
-	if x != nil {
-		return x.HighwayCarType
+func (x *Db_ShainMaster) GetKiji1() string {
+	if x != nil && x.Kiji1 != nil {
+		return *x.Kiji1
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_DTakoCars) GetFerryCarType() int32 {
-	if x != nil {
-		return x.FerryCarType
+func (x *Db_ShainMaster) GetKiji2() string {
+	if x != nil && x.Kiji2 != nil {
+		return *x.Kiji2
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_DTakoCars) GetEvaluationClassCode() int32 {
-	if x != nil {
-		return x.EvaluationClassCode
+func (x *Db_ShainMaster) GetKiji3() string {
+	if x != nil && x.Kiji3 != nil {
+		return *x.Kiji3
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_DTakoCars) GetIdlingType() int32 {
-	if x != nil {
-		return x.IdlingType
+func (x *Db_ShainMaster) GetKiji4() string {
+	if x != nil && x.Kiji4 != nil {
+		return *x.Kiji4
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_DTakoCars) GetMaxLoadWeightKg() int32 {
-	if x != nil {
-		return x.MaxLoadWeightKg
+func (x *Db_ShainMaster) GetKiji5() string {
+	if x != nil && x.Kiji5 != nil {
+		return *x.Kiji5
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_DTakoCars) GetCarClass1() int32 {
-	if x != nil {
-		return x.CarClass1
+func (x *Db_ShainMaster) GetYobi1() string {
+	if x != nil && x.Yobi1 != nil {
+		return *x.Yobi1
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_DTakoCars) GetCarClass2() int32 {
-	if x != nil {
-		return x.CarClass2
+func (x *Db_ShainMaster) GetYobi2() string {
+	if x != nil && x.Yobi2 != nil {
+		return *x.Yobi2
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_DTakoCars) GetCarClass3() int32 {
-	if x != nil {
-		return x.CarClass3
+func (x *Db_ShainMaster) GetYobi3() string {
+	if x != nil && x.Yobi3 != nil {
+		return *x.Yobi3
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_DTakoCars) GetCarClass4() int32 {
-	if x != nil {
-		return x.CarClass4
+func (x *Db_ShainMaster) GetYobi4() string {
+	if x != nil && x.Yobi4 != nil {
+		return *x.Yobi4
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_DTakoCars) GetCarClass5() int32 {
-	if x != nil {
-		return x.CarClass5
+func (x *Db_ShainMaster) GetYobi5() string {
+	if x != nil && x.Yobi5 != nil {
+		return *x.Yobi5
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_DTakoCars) GetOperationType() int32 {
+func (x *Db_ShainMaster) GetKinmuTaikeiC() string {
 	if x != nil {
-		return x.OperationType
+		return x.KinmuTaikeiC
 	}
-	return 0
+	return ""
 }
 
-// イベント情報データ（本番DB）
-type Db_DTakoEvents struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	OperationNo       string                 `protobuf:"bytes,2,opt,name=operation_no,json=operationNo,proto3" json:"operation_no,omitempty"`
-	ReadDate          string                 `protobuf:"bytes,3,opt,name=read_date,json=readDate,proto3" json:"read_date,omitempty"` // RFC3339形式
-	CarCode           int32                  `protobuf:"varint,4,opt,name=car_code,json=carCode,proto3" json:"car_code,omitempty"`
-	CarCc             string                 `protobuf:"bytes,5,opt,name=car_cc,json=carCc,proto3" json:"car_cc,omitempty"`
-	TargetDriverType  int32                  `protobuf:"varint,6,opt,name=target_driver_type,json=targetDriverType,proto3" json:"target_driver_type,omitempty"`
-	DriverCode1       int32                  `protobuf:"varint,7,opt,name=driver_code1,json=driverCode1,proto3" json:"driver_code1,omitempty"`
-	TargetDriverCode  int32                  `protobuf:"varint,8,opt,name=target_driver_code,json=targetDriverCode,proto3" json:"target_driver_code,omitempty"`
-	StartDatetime     string                 `protobuf:"bytes,9,opt,name=start_datetime,json=startDatetime,proto3" json:"start_datetime,omitempty"` // RFC3339形式
-	EndDatetime       string                 `protobuf:"bytes,10,opt,name=end_datetime,json=endDatetime,proto3" json:"end_datetime,omitempty"`      // RFC3339形式
-	EventCode         *int32                 `protobuf:"varint,11,opt,name=event_code,json=eventCode,proto3,oneof" json:"event_code,omitempty"`
-	EventName         string                 `protobuf:"bytes,12,opt,name=event_name,json=eventName,proto3" json:"event_name,omitempty"`
-	StartMileage      float64                `protobuf:"fixed64,13,opt,name=start_mileage,json=startMileage,proto3" json:"start_mileage,omitempty"`
-	EndMileage        float64                `protobuf:"fixed64,14,opt,name=end_mileage,json=endMileage,proto3" json:"end_mileage,omitempty"`
-	SectionTime       int32                  `protobuf:"varint,15,opt,name=section_time,json=sectionTime,proto3" json:"section_time,omitempty"`
-	SectionDistance   float64                `protobuf:"fixed64,16,opt,name=section_distance,json=sectionDistance,proto3" json:"section_distance,omitempty"`
-	StartCityCode     *int32                 `protobuf:"varint,17,opt,name=start_city_code,json=startCityCode,proto3,oneof" json:"start_city_code,omitempty"`
-	StartCityName     string                 `protobuf:"bytes,18,opt,name=start_city_name,json=startCityName,proto3" json:"start_city_name,omitempty"`
-	EndCityCode       *int32                 `protobuf:"varint,19,opt,name=end_city_code,json=endCityCode,proto3,oneof" json:"end_city_code,omitempty"`
-	EndCityName       string                 `protobuf:"bytes,20,opt,name=end_city_name,json=endCityName,proto3" json:"end_city_name,omitempty"`
-	StartPlaceCode    *int32                 `protobuf:"varint,21,opt,name=start_place_code,json=startPlaceCode,proto3,oneof" json:"start_place_code,omitempty"`
-	StartPlaceName    string                 `protobuf:"bytes,22,opt,name=start_place_name,json=startPlaceName,proto3" json:"start_place_name,omitempty"`
-	EndPlaceCode      *int32                 `protobuf:"varint,23,opt,name=end_place_code,json=endPlaceCode,proto3,oneof" json:"end_place_code,omitempty"`
-	EndPlaceName      string                 `protobuf:"bytes,24,opt,name=end_place_name,json=endPlaceName,proto3" json:"end_place_name,omitempty"`
-	StartGpsValid     *int32                 `protobuf:"varint,25,opt,name=start_gps_valid,json=startGpsValid,proto3,oneof" json:"start_gps_valid,omitempty"`
-	StartGpsLatitude  *int64                 `protobuf:"varint,26,opt,name=start_gps_latitude,json=startGpsLatitude,proto3,oneof" json:"start_gps_latitude,omitempty"`
-	StartGpsLongitude *int64                 `protobuf:"varint,27,opt,name=start_gps_longitude,json=startGpsLongitude,proto3,oneof" json:"start_gps_longitude,omitempty"`
-	EndGpsValid       *int32                 `protobuf:"varint,28,opt,name=end_gps_valid,json=endGpsValid,proto3,oneof" json:"end_gps_valid,omitempty"`
-	EndGpsLatitude    *int64                 `protobuf:"varint,29,opt,name=end_gps_latitude,json=endGpsLatitude,proto3,oneof" json:"end_gps_latitude,omitempty"`
-	EndGpsLongitude   *int64                 `protobuf:"varint,30,opt,name=end_gps_longitude,json=endGpsLongitude,proto3,oneof" json:"end_gps_longitude,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+// db_ChiikiMaster メッセージ（4カラム全て）
+type Db_ChiikiMaster struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChiikiC       string                 `protobuf:"bytes,1,opt,name=chiiki_c,json=chiikiC,proto3" json:"chiiki_c,omitempty"`
+	ChiikiN       *string                `protobuf:"bytes,2,opt,name=chiiki_n,json=chiikiN,proto3,oneof" json:"chiiki_n,omitempty"`
+	ChiikiR       *string                `protobuf:"bytes,3,opt,name=chiiki_r,json=chiikiR,proto3,oneof" json:"chiiki_r,omitempty"`
+	ChiikiF       *string                `protobuf:"bytes,4,opt,name=chiiki_f,json=chiikiF,proto3,oneof" json:"chiiki_f,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_DTakoEvents) Reset() {
-	*x = Db_DTakoEvents{}
-	mi := &file_db_service_proto_msgTypes[35]
+func (x *Db_ChiikiMaster) Reset() {
+	*x = Db_ChiikiMaster{}
+	mi := &file_db_service_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_DTakoEvents) String() string {
+func (x *Db_ChiikiMaster) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_DTakoEvents) ProtoMessage() {}
+func (*Db_ChiikiMaster) ProtoMessage() {}
 
-func (x *Db_DTakoEvents) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[35]
+func (x *Db_ChiikiMaster) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2416,273 +9765,269 @@ func (x *Db_DTakoEvents) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_DTakoEvents.ProtoReflect.Descriptor instead.
-func (*Db_DTakoEvents) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use Db_ChiikiMaster.ProtoReflect.Descriptor instead.
+func (*Db_ChiikiMaster) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *Db_DTakoEvents) GetId() int64 {
+func (x *Db_ChiikiMaster) GetChiikiC() string {
 	if x != nil {
-		return x.Id
+		return x.ChiikiC
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_DTakoEvents) GetOperationNo() string {
-	if x != nil {
-		return x.OperationNo
+func (x *Db_ChiikiMaster) GetChiikiN() string {
+	if x != nil && x.ChiikiN != nil {
+		return *x.ChiikiN
 	}
 	return ""
 }
 
-func (x *Db_DTakoEvents) GetReadDate() string {
-	if x != nil {
-		return x.ReadDate
+func (x *Db_ChiikiMaster) GetChiikiR() string {
+	if x != nil && x.ChiikiR != nil {
+		return *x.ChiikiR
 	}
 	return ""
 }
 
-func (x *Db_DTakoEvents) GetCarCode() int32 {
-	if x != nil {
-		return x.CarCode
+func (x *Db_ChiikiMaster) GetChiikiF() string {
+	if x != nil && x.ChiikiF != nil {
+		return *x.ChiikiF
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_DTakoEvents) GetCarCc() string {
-	if x != nil {
-		return x.CarCc
-	}
-	return ""
+// db_ChikuMaster メッセージ（20カラム全て）
+type Db_ChikuMaster struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChikuC        string                 `protobuf:"bytes,1,opt,name=chiku_c,json=chikuC,proto3" json:"chiku_c,omitempty"`
+	ChikuN        *string                `protobuf:"bytes,2,opt,name=chiku_n,json=chikuN,proto3,oneof" json:"chiku_n,omitempty"`
+	ChikuR        *string                `protobuf:"bytes,3,opt,name=chiku_r,json=chikuR,proto3,oneof" json:"chiku_r,omitempty"`
+	ChikuF        *string                `protobuf:"bytes,4,opt,name=chiku_f,json=chikuF,proto3,oneof" json:"chiku_f,omitempty"`
+	ChiikiC       string                 `protobuf:"bytes,5,opt,name=chiiki_c,json=chiikiC,proto3" json:"chiiki_c,omitempty"`
+	YubinBango    *string                `protobuf:"bytes,6,opt,name=yubin_bango,json=yubinBango,proto3,oneof" json:"yubin_bango,omitempty"`
+	Jusho1        *string                `protobuf:"bytes,7,opt,name=jusho1,proto3,oneof" json:"jusho1,omitempty"`
+	Jusho2        *string                `protobuf:"bytes,8,opt,name=jusho2,proto3,oneof" json:"jusho2,omitempty"`
+	DenwaBango    *string                `protobuf:"bytes,9,opt,name=denwa_bango,json=denwaBango,proto3,oneof" json:"denwa_bango,omitempty"`
+	FaxBango      *string                `protobuf:"bytes,10,opt,name=fax_bango,json=faxBango,proto3,oneof" json:"fax_bango,omitempty"`
+	Tantosha      *string                `protobuf:"bytes,11,opt,name=tantosha,proto3,oneof" json:"tantosha,omitempty"`
+	Yobi1         *string                `protobuf:"bytes,12,opt,name=yobi1,proto3,oneof" json:"yobi1,omitempty"`
+	Yobi2         *string                `protobuf:"bytes,13,opt,name=yobi2,proto3,oneof" json:"yobi2,omitempty"`
+	Yobi3         *string                `protobuf:"bytes,14,opt,name=yobi3,proto3,oneof" json:"yobi3,omitempty"`
+	Yobi4         *string                `protobuf:"bytes,15,opt,name=yobi4,proto3,oneof" json:"yobi4,omitempty"`
+	Yobi5         *string                `protobuf:"bytes,16,opt,name=yobi5,proto3,oneof" json:"yobi5,omitempty"`
+	DgrTokuisakiC string                 `protobuf:"bytes,17,opt,name=dgr_tokuisaki_c,json=dgrTokuisakiC,proto3" json:"dgr_tokuisaki_c,omitempty"`
+	DgrTokuisakiH string                 `protobuf:"bytes,18,opt,name=dgr_tokuisaki_h,json=dgrTokuisakiH,proto3" json:"dgr_tokuisaki_h,omitempty"`
+	DgrHinmeiC    string                 `protobuf:"bytes,19,opt,name=dgr_hinmei_c,json=dgrHinmeiC,proto3" json:"dgr_hinmei_c,omitempty"`
+	DgrHinmeiH    string                 `protobuf:"bytes,20,opt,name=dgr_hinmei_h,json=dgrHinmeiH,proto3" json:"dgr_hinmei_h,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_DTakoEvents) GetTargetDriverType() int32 {
-	if x != nil {
-		return x.TargetDriverType
-	}
-	return 0
+func (x *Db_ChikuMaster) Reset() {
+	*x = Db_ChikuMaster{}
+	mi := &file_db_service_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_DTakoEvents) GetDriverCode1() int32 {
-	if x != nil {
-		return x.DriverCode1
-	}
-	return 0
+func (x *Db_ChikuMaster) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Db_DTakoEvents) GetTargetDriverCode() int32 {
+func (*Db_ChikuMaster) ProtoMessage() {}
+
+func (x *Db_ChikuMaster) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[104]
 	if x != nil {
-		return x.TargetDriverCode
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Db_DTakoEvents) GetStartDatetime() string {
-	if x != nil {
-		return x.StartDatetime
-	}
-	return ""
+// Deprecated: Use Db_ChikuMaster.ProtoReflect.Descriptor instead.
+func (*Db_ChikuMaster) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *Db_DTakoEvents) GetEndDatetime() string {
+func (x *Db_ChikuMaster) GetChikuC() string {
 	if x != nil {
-		return x.EndDatetime
+		return x.ChikuC
 	}
 	return ""
 }
 
-func (x *Db_DTakoEvents) GetEventCode() int32 {
-	if x != nil && x.EventCode != nil {
-		return *x.EventCode
-	}
-	return 0
-}
-
-func (x *Db_DTakoEvents) GetEventName() string {
-	if x != nil {
-		return x.EventName
+func (x *Db_ChikuMaster) GetChikuN() string {
+	if x != nil && x.ChikuN != nil {
+		return *x.ChikuN
 	}
 	return ""
 }
 
-func (x *Db_DTakoEvents) GetStartMileage() float64 {
-	if x != nil {
-		return x.StartMileage
+func (x *Db_ChikuMaster) GetChikuR() string {
+	if x != nil && x.ChikuR != nil {
+		return *x.ChikuR
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_DTakoEvents) GetEndMileage() float64 {
-	if x != nil {
-		return x.EndMileage
+func (x *Db_ChikuMaster) GetChikuF() string {
+	if x != nil && x.ChikuF != nil {
+		return *x.ChikuF
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_DTakoEvents) GetSectionTime() int32 {
+func (x *Db_ChikuMaster) GetChiikiC() string {
 	if x != nil {
-		return x.SectionTime
+		return x.ChiikiC
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_DTakoEvents) GetSectionDistance() float64 {
-	if x != nil {
-		return x.SectionDistance
+func (x *Db_ChikuMaster) GetYubinBango() string {
+	if x != nil && x.YubinBango != nil {
+		return *x.YubinBango
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_DTakoEvents) GetStartCityCode() int32 {
-	if x != nil && x.StartCityCode != nil {
-		return *x.StartCityCode
+func (x *Db_ChikuMaster) GetJusho1() string {
+	if x != nil && x.Jusho1 != nil {
+		return *x.Jusho1
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_DTakoEvents) GetStartCityName() string {
-	if x != nil {
-		return x.StartCityName
+func (x *Db_ChikuMaster) GetJusho2() string {
+	if x != nil && x.Jusho2 != nil {
+		return *x.Jusho2
 	}
 	return ""
 }
 
-func (x *Db_DTakoEvents) GetEndCityCode() int32 {
-	if x != nil && x.EndCityCode != nil {
-		return *x.EndCityCode
+func (x *Db_ChikuMaster) GetDenwaBango() string {
+	if x != nil && x.DenwaBango != nil {
+		return *x.DenwaBango
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_DTakoEvents) GetEndCityName() string {
-	if x != nil {
-		return x.EndCityName
+func (x *Db_ChikuMaster) GetFaxBango() string {
+	if x != nil && x.FaxBango != nil {
+		return *x.FaxBango
 	}
 	return ""
 }
 
-func (x *Db_DTakoEvents) GetStartPlaceCode() int32 {
-	if x != nil && x.StartPlaceCode != nil {
-		return *x.StartPlaceCode
+func (x *Db_ChikuMaster) GetTantosha() string {
+	if x != nil && x.Tantosha != nil {
+		return *x.Tantosha
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_DTakoEvents) GetStartPlaceName() string {
-	if x != nil {
-		return x.StartPlaceName
+func (x *Db_ChikuMaster) GetYobi1() string {
+	if x != nil && x.Yobi1 != nil {
+		return *x.Yobi1
 	}
 	return ""
 }
 
-func (x *Db_DTakoEvents) GetEndPlaceCode() int32 {
-	if x != nil && x.EndPlaceCode != nil {
-		return *x.EndPlaceCode
+func (x *Db_ChikuMaster) GetYobi2() string {
+	if x != nil && x.Yobi2 != nil {
+		return *x.Yobi2
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_DTakoEvents) GetEndPlaceName() string {
-	if x != nil {
-		return x.EndPlaceName
+func (x *Db_ChikuMaster) GetYobi3() string {
+	if x != nil && x.Yobi3 != nil {
+		return *x.Yobi3
 	}
 	return ""
 }
 
-func (x *Db_DTakoEvents) GetStartGpsValid() int32 {
-	if x != nil && x.StartGpsValid != nil {
-		return *x.StartGpsValid
+func (x *Db_ChikuMaster) GetYobi4() string {
+	if x != nil && x.Yobi4 != nil {
+		return *x.Yobi4
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_DTakoEvents) GetStartGpsLatitude() int64 {
-	if x != nil && x.StartGpsLatitude != nil {
-		return *x.StartGpsLatitude
+func (x *Db_ChikuMaster) GetYobi5() string {
+	if x != nil && x.Yobi5 != nil {
+		return *x.Yobi5
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_DTakoEvents) GetStartGpsLongitude() int64 {
-	if x != nil && x.StartGpsLongitude != nil {
-		return *x.StartGpsLongitude
+func (x *Db_ChikuMaster) GetDgrTokuisakiC() string {
+	if x != nil {
+		return x.DgrTokuisakiC
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_DTakoEvents) GetEndGpsValid() int32 {
-	if x != nil && x.EndGpsValid != nil {
-		return *x.EndGpsValid
+func (x *Db_ChikuMaster) GetDgrTokuisakiH() string {
+	if x != nil {
+		return x.DgrTokuisakiH
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_DTakoEvents) GetEndGpsLatitude() int64 {
-	if x != nil && x.EndGpsLatitude != nil {
-		return *x.EndGpsLatitude
+func (x *Db_ChikuMaster) GetDgrHinmeiC() string {
+	if x != nil {
+		return x.DgrHinmeiC
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_DTakoEvents) GetEndGpsLongitude() int64 {
-	if x != nil && x.EndGpsLongitude != nil {
-		return *x.EndGpsLongitude
+func (x *Db_ChikuMaster) GetDgrHinmeiH() string {
+	if x != nil {
+		return x.DgrHinmeiH
 	}
-	return 0
+	return ""
 }
 
-// 運行データ（本番DB）
-type Db_DTakoRows struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	OperationNo          string                 `protobuf:"bytes,2,opt,name=operation_no,json=operationNo,proto3" json:"operation_no,omitempty"`
-	ReadDate             string                 `protobuf:"bytes,3,opt,name=read_date,json=readDate,proto3" json:"read_date,omitempty"`                // RFC3339形式
-	OperationDate        string                 `protobuf:"bytes,4,opt,name=operation_date,json=operationDate,proto3" json:"operation_date,omitempty"` // RFC3339形式
-	CarCode              int32                  `protobuf:"varint,5,opt,name=car_code,json=carCode,proto3" json:"car_code,omitempty"`
-	CarCc                string                 `protobuf:"bytes,6,opt,name=car_cc,json=carCc,proto3" json:"car_cc,omitempty"`
-	DriverCode1          *int32                 `protobuf:"varint,7,opt,name=driver_code1,json=driverCode1,proto3,oneof" json:"driver_code1,omitempty"`
-	TargetDriverType     int32                  `protobuf:"varint,8,opt,name=target_driver_type,json=targetDriverType,proto3" json:"target_driver_type,omitempty"`
-	TargetDriverCode     int32                  `protobuf:"varint,9,opt,name=target_driver_code,json=targetDriverCode,proto3" json:"target_driver_code,omitempty"`
-	StartWorkDatetime    string                 `protobuf:"bytes,10,opt,name=start_work_datetime,json=startWorkDatetime,proto3" json:"start_work_datetime,omitempty"` // RFC3339形式
-	EndWorkDatetime      string                 `protobuf:"bytes,11,opt,name=end_work_datetime,json=endWorkDatetime,proto3" json:"end_work_datetime,omitempty"`       // RFC3339形式
-	DepartureDatetime    string                 `protobuf:"bytes,12,opt,name=departure_datetime,json=departureDatetime,proto3" json:"departure_datetime,omitempty"`   // RFC3339形式
-	ReturnDatetime       string                 `protobuf:"bytes,13,opt,name=return_datetime,json=returnDatetime,proto3" json:"return_datetime,omitempty"`            // RFC3339形式
-	DepartureMeter       float64                `protobuf:"fixed64,14,opt,name=departure_meter,json=departureMeter,proto3" json:"departure_meter,omitempty"`
-	ReturnMeter          float64                `protobuf:"fixed64,15,opt,name=return_meter,json=returnMeter,proto3" json:"return_meter,omitempty"`
-	TotalDistance        float64                `protobuf:"fixed64,16,opt,name=total_distance,json=totalDistance,proto3" json:"total_distance,omitempty"`
-	LoadedDistance       *float64               `protobuf:"fixed64,17,opt,name=loaded_distance,json=loadedDistance,proto3,oneof" json:"loaded_distance,omitempty"`
-	DestinationCityName  *string                `protobuf:"bytes,18,opt,name=destination_city_name,json=destinationCityName,proto3,oneof" json:"destination_city_name,omitempty"`
-	DestinationPlaceName *string                `protobuf:"bytes,19,opt,name=destination_place_name,json=destinationPlaceName,proto3,oneof" json:"destination_place_name,omitempty"`
-	GeneralRoadDriveTime int32                  `protobuf:"varint,20,opt,name=general_road_drive_time,json=generalRoadDriveTime,proto3" json:"general_road_drive_time,omitempty"`
-	HighwayDriveTime     int32                  `protobuf:"varint,21,opt,name=highway_drive_time,json=highwayDriveTime,proto3" json:"highway_drive_time,omitempty"`
-	BypassDriveTime      int32                  `protobuf:"varint,22,opt,name=bypass_drive_time,json=bypassDriveTime,proto3" json:"bypass_drive_time,omitempty"`
-	LoadedDriveTime      int32                  `protobuf:"varint,23,opt,name=loaded_drive_time,json=loadedDriveTime,proto3" json:"loaded_drive_time,omitempty"`
-	EmptyDriveTime       int32                  `protobuf:"varint,24,opt,name=empty_drive_time,json=emptyDriveTime,proto3" json:"empty_drive_time,omitempty"`
-	Work1Time            int32                  `protobuf:"varint,25,opt,name=work1_time,json=work1Time,proto3" json:"work1_time,omitempty"`
-	Work2Time            int32                  `protobuf:"varint,26,opt,name=work2_time,json=work2Time,proto3" json:"work2_time,omitempty"`
-	Work3Time            int32                  `protobuf:"varint,27,opt,name=work3_time,json=work3Time,proto3" json:"work3_time,omitempty"`
-	Work4Time            int32                  `protobuf:"varint,28,opt,name=work4_time,json=work4Time,proto3" json:"work4_time,omitempty"`
-	Status1Distance      float64                `protobuf:"fixed64,29,opt,name=status1_distance,json=status1Distance,proto3" json:"status1_distance,omitempty"`
-	Status1Time          int32                  `protobuf:"varint,30,opt,name=status1_time,json=status1Time,proto3" json:"status1_time,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+// db_TokuisakiMaster メッセージ
+type Db_TokuisakiMaster struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TokuisakiC    string                 `protobuf:"bytes,1,opt,name=tokuisaki_c,json=tokuisakiC,proto3" json:"tokuisaki_c,omitempty"`
+	TokuisakiH    string                 `protobuf:"bytes,2,opt,name=tokuisaki_h,json=tokuisakiH,proto3" json:"tokuisaki_h,omitempty"`
+	TokuisakiN    *string                `protobuf:"bytes,3,opt,name=tokuisaki_n,json=tokuisakiN,proto3,oneof" json:"tokuisaki_n,omitempty"`
+	TokuisakiR    *string                `protobuf:"bytes,4,opt,name=tokuisaki_r,json=tokuisakiR,proto3,oneof" json:"tokuisaki_r,omitempty"`
+	TokuisakiF    *string                `protobuf:"bytes,5,opt,name=tokuisaki_f,json=tokuisakiF,proto3,oneof" json:"tokuisaki_f,omitempty"`
+	YubinBango    *string                `protobuf:"bytes,6,opt,name=yubin_bango,json=yubinBango,proto3,oneof" json:"yubin_bango,omitempty"`
+	Jusho1        *string                `protobuf:"bytes,7,opt,name=jusho1,proto3,oneof" json:"jusho1,omitempty"`
+	Jusho2        *string                `protobuf:"bytes,8,opt,name=jusho2,proto3,oneof" json:"jusho2,omitempty"`
+	DenwaBango    *string                `protobuf:"bytes,9,opt,name=denwa_bango,json=denwaBango,proto3,oneof" json:"denwa_bango,omitempty"`
+	FaxBango      *string                `protobuf:"bytes,10,opt,name=fax_bango,json=faxBango,proto3,oneof" json:"fax_bango,omitempty"`
+	Tantosha      *string                `protobuf:"bytes,11,opt,name=tantosha,proto3,oneof" json:"tantosha,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_DTakoRows) Reset() {
-	*x = Db_DTakoRows{}
-	mi := &file_db_service_proto_msgTypes[36]
+func (x *Db_TokuisakiMaster) Reset() {
+	*x = Db_TokuisakiMaster{}
+	mi := &file_db_service_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_DTakoRows) String() string {
+func (x *Db_TokuisakiMaster) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_DTakoRows) ProtoMessage() {}
+func (*Db_TokuisakiMaster) ProtoMessage() {}
 
-func (x *Db_DTakoRows) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[36]
+func (x *Db_TokuisakiMaster) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2693,248 +10038,288 @@ func (x *Db_DTakoRows) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_DTakoRows.ProtoReflect.Descriptor instead.
-func (*Db_DTakoRows) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use Db_TokuisakiMaster.ProtoReflect.Descriptor instead.
+func (*Db_TokuisakiMaster) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *Db_DTakoRows) GetId() string {
+func (x *Db_TokuisakiMaster) GetTokuisakiC() string {
 	if x != nil {
-		return x.Id
+		return x.TokuisakiC
 	}
 	return ""
 }
 
-func (x *Db_DTakoRows) GetOperationNo() string {
+func (x *Db_TokuisakiMaster) GetTokuisakiH() string {
 	if x != nil {
-		return x.OperationNo
+		return x.TokuisakiH
 	}
 	return ""
 }
 
-func (x *Db_DTakoRows) GetReadDate() string {
-	if x != nil {
-		return x.ReadDate
+func (x *Db_TokuisakiMaster) GetTokuisakiN() string {
+	if x != nil && x.TokuisakiN != nil {
+		return *x.TokuisakiN
 	}
 	return ""
 }
 
-func (x *Db_DTakoRows) GetOperationDate() string {
-	if x != nil {
-		return x.OperationDate
+func (x *Db_TokuisakiMaster) GetTokuisakiR() string {
+	if x != nil && x.TokuisakiR != nil {
+		return *x.TokuisakiR
 	}
 	return ""
 }
 
-func (x *Db_DTakoRows) GetCarCode() int32 {
-	if x != nil {
-		return x.CarCode
-	}
-	return 0
-}
-
-func (x *Db_DTakoRows) GetCarCc() string {
-	if x != nil {
-		return x.CarCc
+func (x *Db_TokuisakiMaster) GetTokuisakiF() string {
+	if x != nil && x.TokuisakiF != nil {
+		return *x.TokuisakiF
 	}
 	return ""
 }
 
-func (x *Db_DTakoRows) GetDriverCode1() int32 {
-	if x != nil && x.DriverCode1 != nil {
-		return *x.DriverCode1
-	}
-	return 0
-}
-
-func (x *Db_DTakoRows) GetTargetDriverType() int32 {
-	if x != nil {
-		return x.TargetDriverType
+func (x *Db_TokuisakiMaster) GetYubinBango() string {
+	if x != nil && x.YubinBango != nil {
+		return *x.YubinBango
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_DTakoRows) GetTargetDriverCode() int32 {
-	if x != nil {
-		return x.TargetDriverCode
+func (x *Db_TokuisakiMaster) GetJusho1() string {
+	if x != nil && x.Jusho1 != nil {
+		return *x.Jusho1
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_DTakoRows) GetStartWorkDatetime() string {
-	if x != nil {
-		return x.StartWorkDatetime
+func (x *Db_TokuisakiMaster) GetJusho2() string {
+	if x != nil && x.Jusho2 != nil {
+		return *x.Jusho2
 	}
 	return ""
 }
 
-func (x *Db_DTakoRows) GetEndWorkDatetime() string {
-	if x != nil {
-		return x.EndWorkDatetime
+func (x *Db_TokuisakiMaster) GetDenwaBango() string {
+	if x != nil && x.DenwaBango != nil {
+		return *x.DenwaBango
 	}
 	return ""
 }
 
-func (x *Db_DTakoRows) GetDepartureDatetime() string {
-	if x != nil {
-		return x.DepartureDatetime
+func (x *Db_TokuisakiMaster) GetFaxBango() string {
+	if x != nil && x.FaxBango != nil {
+		return *x.FaxBango
 	}
 	return ""
 }
 
-func (x *Db_DTakoRows) GetReturnDatetime() string {
-	if x != nil {
-		return x.ReturnDatetime
+func (x *Db_TokuisakiMaster) GetTantosha() string {
+	if x != nil && x.Tantosha != nil {
+		return *x.Tantosha
 	}
 	return ""
 }
 
-func (x *Db_DTakoRows) GetDepartureMeter() float64 {
+// db_TokuisakiTekiyobiMaster メッセージ
+type Db_TokuisakiTekiyobiMaster struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TokuisakiC    string                 `protobuf:"bytes,1,opt,name=tokuisaki_c,json=tokuisakiC,proto3" json:"tokuisaki_c,omitempty"`
+	TokuisakiH    string                 `protobuf:"bytes,2,opt,name=tokuisaki_h,json=tokuisakiH,proto3" json:"tokuisaki_h,omitempty"`
+	Tekiyobi      string                 `protobuf:"bytes,3,opt,name=tekiyobi,proto3" json:"tekiyobi,omitempty"` // 適用日（YYYY-MM-DD）
+	TokuisakiN    *string                `protobuf:"bytes,4,opt,name=tokuisaki_n,json=tokuisakiN,proto3,oneof" json:"tokuisaki_n,omitempty"`
+	TokuisakiR    *string                `protobuf:"bytes,5,opt,name=tokuisaki_r,json=tokuisakiR,proto3,oneof" json:"tokuisaki_r,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_TokuisakiTekiyobiMaster) Reset() {
+	*x = Db_TokuisakiTekiyobiMaster{}
+	mi := &file_db_service_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_TokuisakiTekiyobiMaster) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_TokuisakiTekiyobiMaster) ProtoMessage() {}
+
+func (x *Db_TokuisakiTekiyobiMaster) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[106]
 	if x != nil {
-		return x.DepartureMeter
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Db_DTakoRows) GetReturnMeter() float64 {
+// Deprecated: Use Db_TokuisakiTekiyobiMaster.ProtoReflect.Descriptor instead.
+func (*Db_TokuisakiTekiyobiMaster) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *Db_TokuisakiTekiyobiMaster) GetTokuisakiC() string {
 	if x != nil {
-		return x.ReturnMeter
+		return x.TokuisakiC
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_DTakoRows) GetTotalDistance() float64 {
+func (x *Db_TokuisakiTekiyobiMaster) GetTokuisakiH() string {
 	if x != nil {
-		return x.TotalDistance
+		return x.TokuisakiH
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_DTakoRows) GetLoadedDistance() float64 {
-	if x != nil && x.LoadedDistance != nil {
-		return *x.LoadedDistance
+func (x *Db_TokuisakiTekiyobiMaster) GetTekiyobi() string {
+	if x != nil {
+		return x.Tekiyobi
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_DTakoRows) GetDestinationCityName() string {
-	if x != nil && x.DestinationCityName != nil {
-		return *x.DestinationCityName
+func (x *Db_TokuisakiTekiyobiMaster) GetTokuisakiN() string {
+	if x != nil && x.TokuisakiN != nil {
+		return *x.TokuisakiN
 	}
 	return ""
 }
 
-func (x *Db_DTakoRows) GetDestinationPlaceName() string {
-	if x != nil && x.DestinationPlaceName != nil {
-		return *x.DestinationPlaceName
+func (x *Db_TokuisakiTekiyobiMaster) GetTokuisakiR() string {
+	if x != nil && x.TokuisakiR != nil {
+		return *x.TokuisakiR
 	}
 	return ""
 }
 
-func (x *Db_DTakoRows) GetGeneralRoadDriveTime() int32 {
-	if x != nil {
-		return x.GeneralRoadDriveTime
-	}
-	return 0
+// db_HinmeiMaster メッセージ
+type Db_HinmeiMaster struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HinmeiC       string                 `protobuf:"bytes,1,opt,name=hinmei_c,json=hinmeiC,proto3" json:"hinmei_c,omitempty"`
+	HinmeiH       string                 `protobuf:"bytes,2,opt,name=hinmei_h,json=hinmeiH,proto3" json:"hinmei_h,omitempty"`
+	HinmeiN       *string                `protobuf:"bytes,3,opt,name=hinmei_n,json=hinmeiN,proto3,oneof" json:"hinmei_n,omitempty"`
+	HinmeiR       *string                `protobuf:"bytes,4,opt,name=hinmei_r,json=hinmeiR,proto3,oneof" json:"hinmei_r,omitempty"`
+	HinmeiF       *string                `protobuf:"bytes,5,opt,name=hinmei_f,json=hinmeiF,proto3,oneof" json:"hinmei_f,omitempty"`
+	Tani          *string                `protobuf:"bytes,6,opt,name=tani,proto3,oneof" json:"tani,omitempty"`
+	TaniJuryo     *float64               `protobuf:"fixed64,7,opt,name=tani_juryo,json=taniJuryo,proto3,oneof" json:"tani_juryo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_DTakoRows) GetHighwayDriveTime() int32 {
-	if x != nil {
-		return x.HighwayDriveTime
-	}
-	return 0
+func (x *Db_HinmeiMaster) Reset() {
+	*x = Db_HinmeiMaster{}
+	mi := &file_db_service_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_DTakoRows) GetBypassDriveTime() int32 {
-	if x != nil {
-		return x.BypassDriveTime
-	}
-	return 0
+func (x *Db_HinmeiMaster) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Db_DTakoRows) GetLoadedDriveTime() int32 {
+func (*Db_HinmeiMaster) ProtoMessage() {}
+
+func (x *Db_HinmeiMaster) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[107]
 	if x != nil {
-		return x.LoadedDriveTime
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Db_DTakoRows) GetEmptyDriveTime() int32 {
+// Deprecated: Use Db_HinmeiMaster.ProtoReflect.Descriptor instead.
+func (*Db_HinmeiMaster) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *Db_HinmeiMaster) GetHinmeiC() string {
 	if x != nil {
-		return x.EmptyDriveTime
+		return x.HinmeiC
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_DTakoRows) GetWork1Time() int32 {
+func (x *Db_HinmeiMaster) GetHinmeiH() string {
 	if x != nil {
-		return x.Work1Time
+		return x.HinmeiH
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_DTakoRows) GetWork2Time() int32 {
-	if x != nil {
-		return x.Work2Time
+func (x *Db_HinmeiMaster) GetHinmeiN() string {
+	if x != nil && x.HinmeiN != nil {
+		return *x.HinmeiN
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_DTakoRows) GetWork3Time() int32 {
-	if x != nil {
-		return x.Work3Time
+func (x *Db_HinmeiMaster) GetHinmeiR() string {
+	if x != nil && x.HinmeiR != nil {
+		return *x.HinmeiR
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_DTakoRows) GetWork4Time() int32 {
-	if x != nil {
-		return x.Work4Time
+func (x *Db_HinmeiMaster) GetHinmeiF() string {
+	if x != nil && x.HinmeiF != nil {
+		return *x.HinmeiF
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_DTakoRows) GetStatus1Distance() float64 {
-	if x != nil {
-		return x.Status1Distance
+func (x *Db_HinmeiMaster) GetTani() string {
+	if x != nil && x.Tani != nil {
+		return *x.Tani
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_DTakoRows) GetStatus1Time() int32 {
-	if x != nil {
-		return x.Status1Time
+func (x *Db_HinmeiMaster) GetTaniJuryo() float64 {
+	if x != nil && x.TaniJuryo != nil {
+		return *x.TaniJuryo
 	}
 	return 0
 }
 
-// ETCカード番号データ（本番DB）
-type Db_ETCNum struct {
+// db_TokuisakiHinmeiMaster メッセージ
+type Db_TokuisakiHinmeiMaster struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EtcCardNum    string                 `protobuf:"bytes,1,opt,name=etc_card_num,json=etcCardNum,proto3" json:"etc_card_num,omitempty"`
-	CarId         string                 `protobuf:"bytes,2,opt,name=car_id,json=carId,proto3" json:"car_id,omitempty"`
-	StartDateTime *string                `protobuf:"bytes,3,opt,name=start_date_time,json=startDateTime,proto3,oneof" json:"start_date_time,omitempty"` // RFC3339形式
-	DueDateTime   *string                `protobuf:"bytes,4,opt,name=due_date_time,json=dueDateTime,proto3,oneof" json:"due_date_time,omitempty"`       // RFC3339形式
-	ToChange      *bool                  `protobuf:"varint,5,opt,name=to_change,json=toChange,proto3,oneof" json:"to_change,omitempty"`
+	TokuisakiC    string                 `protobuf:"bytes,1,opt,name=tokuisaki_c,json=tokuisakiC,proto3" json:"tokuisaki_c,omitempty"`
+	TokuisakiH    string                 `protobuf:"bytes,2,opt,name=tokuisaki_h,json=tokuisakiH,proto3" json:"tokuisaki_h,omitempty"`
+	HinmeiC       string                 `protobuf:"bytes,3,opt,name=hinmei_c,json=hinmeiC,proto3" json:"hinmei_c,omitempty"`
+	HinmeiH       string                 `protobuf:"bytes,4,opt,name=hinmei_h,json=hinmeiH,proto3" json:"hinmei_h,omitempty"`
+	HinmeiN       *string                `protobuf:"bytes,5,opt,name=hinmei_n,json=hinmeiN,proto3,oneof" json:"hinmei_n,omitempty"`
+	HinmeiR       *string                `protobuf:"bytes,6,opt,name=hinmei_r,json=hinmeiR,proto3,oneof" json:"hinmei_r,omitempty"`
+	Tani          *string                `protobuf:"bytes,7,opt,name=tani,proto3,oneof" json:"tani,omitempty"`
+	TaniJuryo     *float64               `protobuf:"fixed64,8,opt,name=tani_juryo,json=taniJuryo,proto3,oneof" json:"tani_juryo,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_ETCNum) Reset() {
-	*x = Db_ETCNum{}
-	mi := &file_db_service_proto_msgTypes[37]
+func (x *Db_TokuisakiHinmeiMaster) Reset() {
+	*x = Db_TokuisakiHinmeiMaster{}
+	mi := &file_db_service_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ETCNum) String() string {
+func (x *Db_TokuisakiHinmeiMaster) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_ETCNum) ProtoMessage() {}
+func (*Db_TokuisakiHinmeiMaster) ProtoMessage() {}
 
-func (x *Db_ETCNum) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[37]
+func (x *Db_TokuisakiHinmeiMaster) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2945,69 +10330,92 @@ func (x *Db_ETCNum) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_ETCNum.ProtoReflect.Descriptor instead.
-func (*Db_ETCNum) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use Db_TokuisakiHinmeiMaster.ProtoReflect.Descriptor instead.
+func (*Db_TokuisakiHinmeiMaster) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *Db_ETCNum) GetEtcCardNum() string {
+func (x *Db_TokuisakiHinmeiMaster) GetTokuisakiC() string {
 	if x != nil {
-		return x.EtcCardNum
+		return x.TokuisakiC
 	}
 	return ""
 }
 
-func (x *Db_ETCNum) GetCarId() string {
+func (x *Db_TokuisakiHinmeiMaster) GetTokuisakiH() string {
 	if x != nil {
-		return x.CarId
+		return x.TokuisakiH
 	}
 	return ""
 }
 
-func (x *Db_ETCNum) GetStartDateTime() string {
-	if x != nil && x.StartDateTime != nil {
-		return *x.StartDateTime
+func (x *Db_TokuisakiHinmeiMaster) GetHinmeiC() string {
+	if x != nil {
+		return x.HinmeiC
 	}
 	return ""
 }
 
-func (x *Db_ETCNum) GetDueDateTime() string {
-	if x != nil && x.DueDateTime != nil {
-		return *x.DueDateTime
+func (x *Db_TokuisakiHinmeiMaster) GetHinmeiH() string {
+	if x != nil {
+		return x.HinmeiH
 	}
 	return ""
 }
 
-func (x *Db_ETCNum) GetToChange() bool {
-	if x != nil && x.ToChange != nil {
-		return *x.ToChange
+func (x *Db_TokuisakiHinmeiMaster) GetHinmeiN() string {
+	if x != nil && x.HinmeiN != nil {
+		return *x.HinmeiN
 	}
-	return false
+	return ""
 }
 
-// DTakoCars用リクエスト/レスポンス
-type Db_GetDTakoCarsRequest struct {
+func (x *Db_TokuisakiHinmeiMaster) GetHinmeiR() string {
+	if x != nil && x.HinmeiR != nil {
+		return *x.HinmeiR
+	}
+	return ""
+}
+
+func (x *Db_TokuisakiHinmeiMaster) GetTani() string {
+	if x != nil && x.Tani != nil {
+		return *x.Tani
+	}
+	return ""
+}
+
+func (x *Db_TokuisakiHinmeiMaster) GetTaniJuryo() float64 {
+	if x != nil && x.TaniJuryo != nil {
+		return *x.TaniJuryo
+	}
+	return 0
+}
+
+// UntenNippoMeisai用リクエスト/レスポンス
+type Db_GetUntenNippoMeisaiRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	NippoK        string                 `protobuf:"bytes,1,opt,name=nippo_k,json=nippoK,proto3" json:"nippo_k,omitempty"`
+	HaishaK       string                 `protobuf:"bytes,2,opt,name=haisha_k,json=haishaK,proto3" json:"haisha_k,omitempty"`
+	SharyoC       string                 `protobuf:"bytes,3,opt,name=sharyo_c,json=sharyoC,proto3" json:"sharyo_c,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_GetDTakoCarsRequest) Reset() {
-	*x = Db_GetDTakoCarsRequest{}
-	mi := &file_db_service_proto_msgTypes[38]
+func (x *Db_GetUntenNippoMeisaiRequest) Reset() {
+	*x = Db_GetUntenNippoMeisaiRequest{}
+	mi := &file_db_service_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_GetDTakoCarsRequest) String() string {
+func (x *Db_GetUntenNippoMeisaiRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_GetDTakoCarsRequest) ProtoMessage() {}
+func (*Db_GetUntenNippoMeisaiRequest) ProtoMessage() {}
 
-func (x *Db_GetDTakoCarsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[38]
+func (x *Db_GetUntenNippoMeisaiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3018,40 +10426,55 @@ func (x *Db_GetDTakoCarsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_GetDTakoCarsRequest.ProtoReflect.Descriptor instead.
-func (*Db_GetDTakoCarsRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use Db_GetUntenNippoMeisaiRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetUntenNippoMeisaiRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *Db_GetDTakoCarsRequest) GetId() int32 {
+func (x *Db_GetUntenNippoMeisaiRequest) GetNippoK() string {
 	if x != nil {
-		return x.Id
+		return x.NippoK
 	}
-	return 0
+	return ""
 }
 
-type Db_GetDTakoCarsByCarCodeRequest struct {
+func (x *Db_GetUntenNippoMeisaiRequest) GetHaishaK() string {
+	if x != nil {
+		return x.HaishaK
+	}
+	return ""
+}
+
+func (x *Db_GetUntenNippoMeisaiRequest) GetSharyoC() string {
+	if x != nil {
+		return x.SharyoC
+	}
+	return ""
+}
+
+type Db_GetUntenNippoMeisaiBySharyoCRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CarCode       string                 `protobuf:"bytes,1,opt,name=car_code,json=carCode,proto3" json:"car_code,omitempty"`
+	SharyoC       string                 `protobuf:"bytes,1,opt,name=sharyo_c,json=sharyoC,proto3" json:"sharyo_c,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_GetDTakoCarsByCarCodeRequest) Reset() {
-	*x = Db_GetDTakoCarsByCarCodeRequest{}
-	mi := &file_db_service_proto_msgTypes[39]
+func (x *Db_GetUntenNippoMeisaiBySharyoCRequest) Reset() {
+	*x = Db_GetUntenNippoMeisaiBySharyoCRequest{}
+	mi := &file_db_service_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_GetDTakoCarsByCarCodeRequest) String() string {
+func (x *Db_GetUntenNippoMeisaiBySharyoCRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_GetDTakoCarsByCarCodeRequest) ProtoMessage() {}
+func (*Db_GetUntenNippoMeisaiBySharyoCRequest) ProtoMessage() {}
 
-func (x *Db_GetDTakoCarsByCarCodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[39]
+func (x *Db_GetUntenNippoMeisaiBySharyoCRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3062,41 +10485,50 @@ func (x *Db_GetDTakoCarsByCarCodeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_GetDTakoCarsByCarCodeRequest.ProtoReflect.Descriptor instead.
-func (*Db_GetDTakoCarsByCarCodeRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use Db_GetUntenNippoMeisaiBySharyoCRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetUntenNippoMeisaiBySharyoCRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *Db_GetDTakoCarsByCarCodeRequest) GetCarCode() string {
+func (x *Db_GetUntenNippoMeisaiBySharyoCRequest) GetSharyoC() string {
 	if x != nil {
-		return x.CarCode
+		return x.SharyoC
 	}
 	return ""
 }
 
-type Db_ListDTakoCarsRequest struct {
+func (x *Db_GetUntenNippoMeisaiBySharyoCRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type Db_GetUntenNippoMeisaiByDateRangeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_ListDTakoCarsRequest) Reset() {
-	*x = Db_ListDTakoCarsRequest{}
-	mi := &file_db_service_proto_msgTypes[40]
+func (x *Db_GetUntenNippoMeisaiByDateRangeRequest) Reset() {
+	*x = Db_GetUntenNippoMeisaiByDateRangeRequest{}
+	mi := &file_db_service_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ListDTakoCarsRequest) String() string {
+func (x *Db_GetUntenNippoMeisaiByDateRangeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_ListDTakoCarsRequest) ProtoMessage() {}
+func (*Db_GetUntenNippoMeisaiByDateRangeRequest) ProtoMessage() {}
 
-func (x *Db_ListDTakoCarsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[40]
+func (x *Db_GetUntenNippoMeisaiByDateRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3107,47 +10539,66 @@ func (x *Db_ListDTakoCarsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_ListDTakoCarsRequest.ProtoReflect.Descriptor instead.
-func (*Db_ListDTakoCarsRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use Db_GetUntenNippoMeisaiByDateRangeRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetUntenNippoMeisaiByDateRangeRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *Db_ListDTakoCarsRequest) GetLimit() int32 {
+func (x *Db_GetUntenNippoMeisaiByDateRangeRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *Db_GetUntenNippoMeisaiByDateRangeRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *Db_GetUntenNippoMeisaiByDateRangeRequest) GetLimit() int32 {
 	if x != nil {
 		return x.Limit
 	}
 	return 0
 }
 
-func (x *Db_ListDTakoCarsRequest) GetOffset() int32 {
+func (x *Db_GetUntenNippoMeisaiByDateRangeRequest) GetOffset() int32 {
 	if x != nil {
 		return x.Offset
 	}
 	return 0
 }
 
-type Db_DTakoCarsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	DtakoCars     *Db_DTakoCars          `protobuf:"bytes,1,opt,name=dtako_cars,json=dtakoCars,proto3" json:"dtako_cars,omitempty"`
+type Db_GetUntenNippoMeisaiByBumonRequest struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Bumon         string                        `protobuf:"bytes,1,opt,name=bumon,proto3" json:"bumon,omitempty"`                                                // 部門C
+	Field         Db_UntenNippoMeisaiBumonField `protobuf:"varint,2,opt,name=field,proto3,enum=db_service.Db_UntenNippoMeisaiBumonField" json:"field,omitempty"` // 絞り込む列
+	StartDate     string                        `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`                       // 管理年月日の開始（空の場合は制限しない）
+	EndDate       string                        `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                             // 管理年月日の終了（空の場合は制限しない）
+	Limit         int32                         `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                         `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_DTakoCarsResponse) Reset() {
-	*x = Db_DTakoCarsResponse{}
-	mi := &file_db_service_proto_msgTypes[41]
+func (x *Db_GetUntenNippoMeisaiByBumonRequest) Reset() {
+	*x = Db_GetUntenNippoMeisaiByBumonRequest{}
+	mi := &file_db_service_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_DTakoCarsResponse) String() string {
+func (x *Db_GetUntenNippoMeisaiByBumonRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_DTakoCarsResponse) ProtoMessage() {}
+func (*Db_GetUntenNippoMeisaiByBumonRequest) ProtoMessage() {}
 
-func (x *Db_DTakoCarsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[41]
+func (x *Db_GetUntenNippoMeisaiByBumonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3158,41 +10609,76 @@ func (x *Db_DTakoCarsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_DTakoCarsResponse.ProtoReflect.Descriptor instead.
-func (*Db_DTakoCarsResponse) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use Db_GetUntenNippoMeisaiByBumonRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetUntenNippoMeisaiByBumonRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *Db_DTakoCarsResponse) GetDtakoCars() *Db_DTakoCars {
+func (x *Db_GetUntenNippoMeisaiByBumonRequest) GetBumon() string {
 	if x != nil {
-		return x.DtakoCars
+		return x.Bumon
 	}
-	return nil
+	return ""
 }
 
-type Db_ListDTakoCarsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*Db_DTakoCars        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+func (x *Db_GetUntenNippoMeisaiByBumonRequest) GetField() Db_UntenNippoMeisaiBumonField {
+	if x != nil {
+		return x.Field
+	}
+	return Db_UntenNippoMeisaiBumonField_UNTEN_NIPPO_MEISAI_BUMON_FIELD_UNSPECIFIED
+}
+
+func (x *Db_GetUntenNippoMeisaiByBumonRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *Db_GetUntenNippoMeisaiByBumonRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *Db_GetUntenNippoMeisaiByBumonRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *Db_GetUntenNippoMeisaiByBumonRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type Db_UntenNippoMeisaiSortSpec struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Field         Db_UntenNippoMeisaiSortField `protobuf:"varint,1,opt,name=field,proto3,enum=db_service.Db_UntenNippoMeisaiSortField" json:"field,omitempty"`
+	Direction     Db_SortDirection             `protobuf:"varint,2,opt,name=direction,proto3,enum=db_service.Db_SortDirection" json:"direction,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_ListDTakoCarsResponse) Reset() {
-	*x = Db_ListDTakoCarsResponse{}
-	mi := &file_db_service_proto_msgTypes[42]
+func (x *Db_UntenNippoMeisaiSortSpec) Reset() {
+	*x = Db_UntenNippoMeisaiSortSpec{}
+	mi := &file_db_service_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ListDTakoCarsResponse) String() string {
+func (x *Db_UntenNippoMeisaiSortSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_ListDTakoCarsResponse) ProtoMessage() {}
+func (*Db_UntenNippoMeisaiSortSpec) ProtoMessage() {}
 
-func (x *Db_ListDTakoCarsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[42]
+func (x *Db_UntenNippoMeisaiSortSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3203,48 +10689,52 @@ func (x *Db_ListDTakoCarsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_ListDTakoCarsResponse.ProtoReflect.Descriptor instead.
-func (*Db_ListDTakoCarsResponse) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use Db_UntenNippoMeisaiSortSpec.ProtoReflect.Descriptor instead.
+func (*Db_UntenNippoMeisaiSortSpec) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *Db_ListDTakoCarsResponse) GetItems() []*Db_DTakoCars {
+func (x *Db_UntenNippoMeisaiSortSpec) GetField() Db_UntenNippoMeisaiSortField {
 	if x != nil {
-		return x.Items
+		return x.Field
 	}
-	return nil
+	return Db_UntenNippoMeisaiSortField_UNTEN_NIPPO_MEISAI_SORT_FIELD_UNSPECIFIED
 }
 
-func (x *Db_ListDTakoCarsResponse) GetTotalCount() int32 {
+func (x *Db_UntenNippoMeisaiSortSpec) GetDirection() Db_SortDirection {
 	if x != nil {
-		return x.TotalCount
+		return x.Direction
 	}
-	return 0
+	return Db_SortDirection_SORT_DIRECTION_UNSPECIFIED
 }
 
-// DTakoEvents用リクエスト/レスポンス
-type Db_GetDTakoEventsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type Db_ListUntenNippoMeisaiRequest struct {
+	state             protoimpl.MessageState         `protogen:"open.v1"`
+	Limit             int32                          `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset            int32                          `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	OrderBy           *string                        `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3,oneof" json:"order_by,omitempty"`                            // 非推奨: sortを使用（ソート可能なprotoのフィールド名のみ指定可）
+	PageToken         *string                        `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`                      // キーセットページネーション用トークン（指定時はoffset/order_byは使用不可、初回は空文字）
+	IncludeTotalCount bool                           `protobuf:"varint,5,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"` // page_token使用時もtotal_countを取得する（COUNT(*)を実行）
+	Sort              []*Db_UntenNippoMeisaiSortSpec `protobuf:"bytes,6,rep,name=sort,proto3" json:"sort,omitempty"`                                                       // ソート条件（複数指定可）
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *Db_GetDTakoEventsRequest) Reset() {
-	*x = Db_GetDTakoEventsRequest{}
-	mi := &file_db_service_proto_msgTypes[43]
+func (x *Db_ListUntenNippoMeisaiRequest) Reset() {
+	*x = Db_ListUntenNippoMeisaiRequest{}
+	mi := &file_db_service_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_GetDTakoEventsRequest) String() string {
+func (x *Db_ListUntenNippoMeisaiRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_GetDTakoEventsRequest) ProtoMessage() {}
+func (*Db_ListUntenNippoMeisaiRequest) ProtoMessage() {}
 
-func (x *Db_GetDTakoEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[43]
+func (x *Db_ListUntenNippoMeisaiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3255,43 +10745,77 @@ func (x *Db_GetDTakoEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_GetDTakoEventsRequest.ProtoReflect.Descriptor instead.
-func (*Db_GetDTakoEventsRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use Db_ListUntenNippoMeisaiRequest.ProtoReflect.Descriptor instead.
+func (*Db_ListUntenNippoMeisaiRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *Db_GetDTakoEventsRequest) GetId() int64 {
+func (x *Db_ListUntenNippoMeisaiRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Id
+		return x.Limit
 	}
 	return 0
 }
 
-type Db_GetDTakoEventsByOperationNoRequest struct {
+func (x *Db_ListUntenNippoMeisaiRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *Db_ListUntenNippoMeisaiRequest) GetOrderBy() string {
+	if x != nil && x.OrderBy != nil {
+		return *x.OrderBy
+	}
+	return ""
+}
+
+func (x *Db_ListUntenNippoMeisaiRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+func (x *Db_ListUntenNippoMeisaiRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+func (x *Db_ListUntenNippoMeisaiRequest) GetSort() []*Db_UntenNippoMeisaiSortSpec {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+type Db_StreamUntenNippoMeisaiRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OperationNo   string                 `protobuf:"bytes,1,opt,name=operation_no,json=operationNo,proto3" json:"operation_no,omitempty"`
-	EventTypes    []string               `protobuf:"bytes,2,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"` // イベントタイプでフィルタ（空の場合は全て）
-	StartTime     string                 `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`    // 開始時刻（RFC3339形式、空の場合は制限なし）
-	EndTime       string                 `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`          // 終了時刻（RFC3339形式、空の場合は制限なし）
+	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`  // 管理年月日の開始
+	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`        // 管理年月日の終了
+	BatchSize     int32                  `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"` // 1回のクエリで取得する件数（0の場合は100、最大1000）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_GetDTakoEventsByOperationNoRequest) Reset() {
-	*x = Db_GetDTakoEventsByOperationNoRequest{}
-	mi := &file_db_service_proto_msgTypes[44]
+func (x *Db_StreamUntenNippoMeisaiRequest) Reset() {
+	*x = Db_StreamUntenNippoMeisaiRequest{}
+	mi := &file_db_service_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_GetDTakoEventsByOperationNoRequest) String() string {
+func (x *Db_StreamUntenNippoMeisaiRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_GetDTakoEventsByOperationNoRequest) ProtoMessage() {}
+func (*Db_StreamUntenNippoMeisaiRequest) ProtoMessage() {}
 
-func (x *Db_GetDTakoEventsByOperationNoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[44]
+func (x *Db_StreamUntenNippoMeisaiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3302,63 +10826,54 @@ func (x *Db_GetDTakoEventsByOperationNoRequest) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_GetDTakoEventsByOperationNoRequest.ProtoReflect.Descriptor instead.
-func (*Db_GetDTakoEventsByOperationNoRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use Db_StreamUntenNippoMeisaiRequest.ProtoReflect.Descriptor instead.
+func (*Db_StreamUntenNippoMeisaiRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *Db_GetDTakoEventsByOperationNoRequest) GetOperationNo() string {
+func (x *Db_StreamUntenNippoMeisaiRequest) GetStartDate() string {
 	if x != nil {
-		return x.OperationNo
+		return x.StartDate
 	}
 	return ""
 }
 
-func (x *Db_GetDTakoEventsByOperationNoRequest) GetEventTypes() []string {
-	if x != nil {
-		return x.EventTypes
-	}
-	return nil
-}
-
-func (x *Db_GetDTakoEventsByOperationNoRequest) GetStartTime() string {
+func (x *Db_StreamUntenNippoMeisaiRequest) GetEndDate() string {
 	if x != nil {
-		return x.StartTime
+		return x.EndDate
 	}
 	return ""
 }
 
-func (x *Db_GetDTakoEventsByOperationNoRequest) GetEndTime() string {
+func (x *Db_StreamUntenNippoMeisaiRequest) GetBatchSize() int32 {
 	if x != nil {
-		return x.EndTime
+		return x.BatchSize
 	}
-	return ""
+	return 0
 }
 
-type Db_ListDTakoEventsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	OrderBy       *string                `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3,oneof" json:"order_by,omitempty"` // 例: "開始日時 DESC", "id ASC"
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type Db_UntenNippoMeisaiResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UntenNippoMeisai *Db_UntenNippoMeisai   `protobuf:"bytes,1,opt,name=unten_nippo_meisai,json=untenNippoMeisai,proto3" json:"unten_nippo_meisai,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *Db_ListDTakoEventsRequest) Reset() {
-	*x = Db_ListDTakoEventsRequest{}
-	mi := &file_db_service_proto_msgTypes[45]
+func (x *Db_UntenNippoMeisaiResponse) Reset() {
+	*x = Db_UntenNippoMeisaiResponse{}
+	mi := &file_db_service_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ListDTakoEventsRequest) String() string {
+func (x *Db_UntenNippoMeisaiResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_ListDTakoEventsRequest) ProtoMessage() {}
+func (*Db_UntenNippoMeisaiResponse) ProtoMessage() {}
 
-func (x *Db_ListDTakoEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[45]
+func (x *Db_UntenNippoMeisaiResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3369,54 +10884,42 @@ func (x *Db_ListDTakoEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_ListDTakoEventsRequest.ProtoReflect.Descriptor instead.
-func (*Db_ListDTakoEventsRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{45}
-}
-
-func (x *Db_ListDTakoEventsRequest) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
+// Deprecated: Use Db_UntenNippoMeisaiResponse.ProtoReflect.Descriptor instead.
+func (*Db_UntenNippoMeisaiResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *Db_ListDTakoEventsRequest) GetOffset() int32 {
+func (x *Db_UntenNippoMeisaiResponse) GetUntenNippoMeisai() *Db_UntenNippoMeisai {
 	if x != nil {
-		return x.Offset
-	}
-	return 0
-}
-
-func (x *Db_ListDTakoEventsRequest) GetOrderBy() string {
-	if x != nil && x.OrderBy != nil {
-		return *x.OrderBy
+		return x.UntenNippoMeisai
 	}
-	return ""
+	return nil
 }
 
-type Db_DTakoEventsResponse struct {
+type Db_ListUntenNippoMeisaiResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DtakoEvents   *Db_DTakoEvents        `protobuf:"bytes,1,opt,name=dtako_events,json=dtakoEvents,proto3" json:"dtako_events,omitempty"`
+	Items         []*Db_UntenNippoMeisai `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalCount    *int32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`     // page_token使用時はinclude_total_count指定時のみ設定
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 次ページのトークン（最終ページの場合は空）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_DTakoEventsResponse) Reset() {
-	*x = Db_DTakoEventsResponse{}
-	mi := &file_db_service_proto_msgTypes[46]
+func (x *Db_ListUntenNippoMeisaiResponse) Reset() {
+	*x = Db_ListUntenNippoMeisaiResponse{}
+	mi := &file_db_service_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_DTakoEventsResponse) String() string {
+func (x *Db_ListUntenNippoMeisaiResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_DTakoEventsResponse) ProtoMessage() {}
+func (*Db_ListUntenNippoMeisaiResponse) ProtoMessage() {}
 
-func (x *Db_DTakoEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[46]
+func (x *Db_ListUntenNippoMeisaiResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3427,41 +10930,55 @@ func (x *Db_DTakoEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_DTakoEventsResponse.ProtoReflect.Descriptor instead.
-func (*Db_DTakoEventsResponse) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use Db_ListUntenNippoMeisaiResponse.ProtoReflect.Descriptor instead.
+func (*Db_ListUntenNippoMeisaiResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *Db_DTakoEventsResponse) GetDtakoEvents() *Db_DTakoEvents {
+func (x *Db_ListUntenNippoMeisaiResponse) GetItems() []*Db_UntenNippoMeisai {
 	if x != nil {
-		return x.DtakoEvents
+		return x.Items
 	}
 	return nil
 }
 
-type Db_ListDTakoEventsResponse struct {
+func (x *Db_ListUntenNippoMeisaiResponse) GetTotalCount() int32 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
+	}
+	return 0
+}
+
+func (x *Db_ListUntenNippoMeisaiResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// ShainMaster用リクエスト/レスポンス
+type Db_GetShainMasterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*Db_DTakoEvents      `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	ShainC        string                 `protobuf:"bytes,1,opt,name=shain_c,json=shainC,proto3" json:"shain_c,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_ListDTakoEventsResponse) Reset() {
-	*x = Db_ListDTakoEventsResponse{}
-	mi := &file_db_service_proto_msgTypes[47]
+func (x *Db_GetShainMasterRequest) Reset() {
+	*x = Db_GetShainMasterRequest{}
+	mi := &file_db_service_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ListDTakoEventsResponse) String() string {
+func (x *Db_GetShainMasterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_ListDTakoEventsResponse) ProtoMessage() {}
+func (*Db_GetShainMasterRequest) ProtoMessage() {}
 
-func (x *Db_ListDTakoEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[47]
+func (x *Db_GetShainMasterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3472,48 +10989,40 @@ func (x *Db_ListDTakoEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_ListDTakoEventsResponse.ProtoReflect.Descriptor instead.
-func (*Db_ListDTakoEventsResponse) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{47}
-}
-
-func (x *Db_ListDTakoEventsResponse) GetItems() []*Db_DTakoEvents {
-	if x != nil {
-		return x.Items
-	}
-	return nil
+// Deprecated: Use Db_GetShainMasterRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetShainMasterRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *Db_ListDTakoEventsResponse) GetTotalCount() int32 {
+func (x *Db_GetShainMasterRequest) GetShainC() string {
 	if x != nil {
-		return x.TotalCount
+		return x.ShainC
 	}
-	return 0
+	return ""
 }
 
-// DTakoRows用リクエスト/レスポンス
-type Db_GetDTakoRowsRequest struct {
+type Db_GetShainMasterByBumonCRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BumonC        string                 `protobuf:"bytes,1,opt,name=bumon_c,json=bumonC,proto3" json:"bumon_c,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_GetDTakoRowsRequest) Reset() {
-	*x = Db_GetDTakoRowsRequest{}
-	mi := &file_db_service_proto_msgTypes[48]
+func (x *Db_GetShainMasterByBumonCRequest) Reset() {
+	*x = Db_GetShainMasterByBumonCRequest{}
+	mi := &file_db_service_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_GetDTakoRowsRequest) String() string {
+func (x *Db_GetShainMasterByBumonCRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_GetDTakoRowsRequest) ProtoMessage() {}
+func (*Db_GetShainMasterByBumonCRequest) ProtoMessage() {}
 
-func (x *Db_GetDTakoRowsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[48]
+func (x *Db_GetShainMasterByBumonCRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3524,40 +11033,41 @@ func (x *Db_GetDTakoRowsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_GetDTakoRowsRequest.ProtoReflect.Descriptor instead.
-func (*Db_GetDTakoRowsRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use Db_GetShainMasterByBumonCRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetShainMasterByBumonCRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *Db_GetDTakoRowsRequest) GetId() string {
+func (x *Db_GetShainMasterByBumonCRequest) GetBumonC() string {
 	if x != nil {
-		return x.Id
+		return x.BumonC
 	}
 	return ""
 }
 
-type Db_GetDTakoRowsByOperationNoRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OperationNo   string                 `protobuf:"bytes,1,opt,name=operation_no,json=operationNo,proto3" json:"operation_no,omitempty"`
+type Db_ShainMasterSortSpec struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Field         Db_ShainMasterSortField `protobuf:"varint,1,opt,name=field,proto3,enum=db_service.Db_ShainMasterSortField" json:"field,omitempty"`
+	Direction     Db_SortDirection        `protobuf:"varint,2,opt,name=direction,proto3,enum=db_service.Db_SortDirection" json:"direction,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_GetDTakoRowsByOperationNoRequest) Reset() {
-	*x = Db_GetDTakoRowsByOperationNoRequest{}
-	mi := &file_db_service_proto_msgTypes[49]
+func (x *Db_ShainMasterSortSpec) Reset() {
+	*x = Db_ShainMasterSortSpec{}
+	mi := &file_db_service_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_GetDTakoRowsByOperationNoRequest) String() string {
+func (x *Db_ShainMasterSortSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_GetDTakoRowsByOperationNoRequest) ProtoMessage() {}
+func (*Db_ShainMasterSortSpec) ProtoMessage() {}
 
-func (x *Db_GetDTakoRowsByOperationNoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[49]
+func (x *Db_ShainMasterSortSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3568,42 +11078,52 @@ func (x *Db_GetDTakoRowsByOperationNoRequest) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_GetDTakoRowsByOperationNoRequest.ProtoReflect.Descriptor instead.
-func (*Db_GetDTakoRowsByOperationNoRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use Db_ShainMasterSortSpec.ProtoReflect.Descriptor instead.
+func (*Db_ShainMasterSortSpec) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *Db_GetDTakoRowsByOperationNoRequest) GetOperationNo() string {
+func (x *Db_ShainMasterSortSpec) GetField() Db_ShainMasterSortField {
 	if x != nil {
-		return x.OperationNo
+		return x.Field
 	}
-	return ""
+	return Db_ShainMasterSortField_SHAIN_MASTER_SORT_FIELD_UNSPECIFIED
 }
 
-type Db_ListDTakoRowsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	OrderBy       *string                `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3,oneof" json:"order_by,omitempty"` // 例: "読取日 DESC", "id ASC"
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Db_ShainMasterSortSpec) GetDirection() Db_SortDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return Db_SortDirection_SORT_DIRECTION_UNSPECIFIED
 }
 
-func (x *Db_ListDTakoRowsRequest) Reset() {
-	*x = Db_ListDTakoRowsRequest{}
-	mi := &file_db_service_proto_msgTypes[50]
+type Db_ListShainMasterRequest struct {
+	state             protoimpl.MessageState    `protogen:"open.v1"`
+	Limit             int32                     `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset            int32                     `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	OrderBy           *string                   `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3,oneof" json:"order_by,omitempty"`                            // 非推奨: sortを使用（ソート可能なprotoのフィールド名のみ指定可）
+	PageToken         *string                   `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`                      // キーセットページネーション用トークン（指定時はoffset/order_byは使用不可、初回は空文字）
+	IncludeTotalCount bool                      `protobuf:"varint,5,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"` // page_token使用時もtotal_countを取得する（COUNT(*)を実行）
+	Sort              []*Db_ShainMasterSortSpec `protobuf:"bytes,6,rep,name=sort,proto3" json:"sort,omitempty"`                                                       // ソート条件（複数指定可）
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Db_ListShainMasterRequest) Reset() {
+	*x = Db_ListShainMasterRequest{}
+	mi := &file_db_service_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ListDTakoRowsRequest) String() string {
+func (x *Db_ListShainMasterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_ListDTakoRowsRequest) ProtoMessage() {}
+func (*Db_ListShainMasterRequest) ProtoMessage() {}
 
-func (x *Db_ListDTakoRowsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[50]
+func (x *Db_ListShainMasterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3614,54 +11134,75 @@ func (x *Db_ListDTakoRowsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_ListDTakoRowsRequest.ProtoReflect.Descriptor instead.
-func (*Db_ListDTakoRowsRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use Db_ListShainMasterRequest.ProtoReflect.Descriptor instead.
+func (*Db_ListShainMasterRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *Db_ListDTakoRowsRequest) GetLimit() int32 {
+func (x *Db_ListShainMasterRequest) GetLimit() int32 {
 	if x != nil {
 		return x.Limit
 	}
 	return 0
 }
 
-func (x *Db_ListDTakoRowsRequest) GetOffset() int32 {
+func (x *Db_ListShainMasterRequest) GetOffset() int32 {
 	if x != nil {
 		return x.Offset
 	}
 	return 0
 }
 
-func (x *Db_ListDTakoRowsRequest) GetOrderBy() string {
+func (x *Db_ListShainMasterRequest) GetOrderBy() string {
 	if x != nil && x.OrderBy != nil {
 		return *x.OrderBy
 	}
 	return ""
 }
 
-type Db_DTakoRowsResponse struct {
+func (x *Db_ListShainMasterRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+func (x *Db_ListShainMasterRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+func (x *Db_ListShainMasterRequest) GetSort() []*Db_ShainMasterSortSpec {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+type Db_ShainMasterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DtakoRows     *Db_DTakoRows          `protobuf:"bytes,1,opt,name=dtako_rows,json=dtakoRows,proto3" json:"dtako_rows,omitempty"`
+	ShainMaster   *Db_ShainMaster        `protobuf:"bytes,1,opt,name=shain_master,json=shainMaster,proto3" json:"shain_master,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_DTakoRowsResponse) Reset() {
-	*x = Db_DTakoRowsResponse{}
-	mi := &file_db_service_proto_msgTypes[51]
+func (x *Db_ShainMasterResponse) Reset() {
+	*x = Db_ShainMasterResponse{}
+	mi := &file_db_service_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_DTakoRowsResponse) String() string {
+func (x *Db_ShainMasterResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_DTakoRowsResponse) ProtoMessage() {}
+func (*Db_ShainMasterResponse) ProtoMessage() {}
 
-func (x *Db_DTakoRowsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[51]
+func (x *Db_ShainMasterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3672,41 +11213,42 @@ func (x *Db_DTakoRowsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_DTakoRowsResponse.ProtoReflect.Descriptor instead.
-func (*Db_DTakoRowsResponse) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use Db_ShainMasterResponse.ProtoReflect.Descriptor instead.
+func (*Db_ShainMasterResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *Db_DTakoRowsResponse) GetDtakoRows() *Db_DTakoRows {
+func (x *Db_ShainMasterResponse) GetShainMaster() *Db_ShainMaster {
 	if x != nil {
-		return x.DtakoRows
+		return x.ShainMaster
 	}
 	return nil
 }
 
-type Db_ListDTakoRowsResponse struct {
+type Db_ListShainMasterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*Db_DTakoRows        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Items         []*Db_ShainMaster      `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalCount    *int32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`     // page_token使用時はinclude_total_count指定時のみ設定
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 次ページのトークン（最終ページの場合は空）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_ListDTakoRowsResponse) Reset() {
-	*x = Db_ListDTakoRowsResponse{}
-	mi := &file_db_service_proto_msgTypes[52]
+func (x *Db_ListShainMasterResponse) Reset() {
+	*x = Db_ListShainMasterResponse{}
+	mi := &file_db_service_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ListDTakoRowsResponse) String() string {
+func (x *Db_ListShainMasterResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_ListDTakoRowsResponse) ProtoMessage() {}
+func (*Db_ListShainMasterResponse) ProtoMessage() {}
 
-func (x *Db_ListDTakoRowsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[52]
+func (x *Db_ListShainMasterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3717,48 +11259,55 @@ func (x *Db_ListDTakoRowsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_ListDTakoRowsResponse.ProtoReflect.Descriptor instead.
-func (*Db_ListDTakoRowsResponse) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use Db_ListShainMasterResponse.ProtoReflect.Descriptor instead.
+func (*Db_ListShainMasterResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *Db_ListDTakoRowsResponse) GetItems() []*Db_DTakoRows {
+func (x *Db_ListShainMasterResponse) GetItems() []*Db_ShainMaster {
 	if x != nil {
 		return x.Items
 	}
 	return nil
 }
 
-func (x *Db_ListDTakoRowsResponse) GetTotalCount() int32 {
-	if x != nil {
-		return x.TotalCount
+func (x *Db_ListShainMasterResponse) GetTotalCount() int32 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
 	}
 	return 0
 }
 
-// ETCNum用リクエスト/レスポンス
-type Db_GetETCNumByETCCardNumRequest struct {
+func (x *Db_ListShainMasterResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// ChiikiMaster用リクエスト/レスポンス
+type Db_GetChiikiMasterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EtcCardNum    string                 `protobuf:"bytes,1,opt,name=etc_card_num,json=etcCardNum,proto3" json:"etc_card_num,omitempty"`
+	ChiikiC       string                 `protobuf:"bytes,1,opt,name=chiiki_c,json=chiikiC,proto3" json:"chiiki_c,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_GetETCNumByETCCardNumRequest) Reset() {
-	*x = Db_GetETCNumByETCCardNumRequest{}
-	mi := &file_db_service_proto_msgTypes[53]
+func (x *Db_GetChiikiMasterRequest) Reset() {
+	*x = Db_GetChiikiMasterRequest{}
+	mi := &file_db_service_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_GetETCNumByETCCardNumRequest) String() string {
+func (x *Db_GetChiikiMasterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_GetETCNumByETCCardNumRequest) ProtoMessage() {}
+func (*Db_GetChiikiMasterRequest) ProtoMessage() {}
 
-func (x *Db_GetETCNumByETCCardNumRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[53]
+func (x *Db_GetChiikiMasterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3769,40 +11318,41 @@ func (x *Db_GetETCNumByETCCardNumRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_GetETCNumByETCCardNumRequest.ProtoReflect.Descriptor instead.
-func (*Db_GetETCNumByETCCardNumRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use Db_GetChiikiMasterRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetChiikiMasterRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{124}
 }
 
-func (x *Db_GetETCNumByETCCardNumRequest) GetEtcCardNum() string {
+func (x *Db_GetChiikiMasterRequest) GetChiikiC() string {
 	if x != nil {
-		return x.EtcCardNum
+		return x.ChiikiC
 	}
 	return ""
 }
 
-type Db_GetETCNumByCarIDRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CarId         string                 `protobuf:"bytes,1,opt,name=car_id,json=carId,proto3" json:"car_id,omitempty"`
+type Db_ChiikiMasterSortSpec struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Field         Db_ChiikiMasterSortField `protobuf:"varint,1,opt,name=field,proto3,enum=db_service.Db_ChiikiMasterSortField" json:"field,omitempty"`
+	Direction     Db_SortDirection         `protobuf:"varint,2,opt,name=direction,proto3,enum=db_service.Db_SortDirection" json:"direction,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_GetETCNumByCarIDRequest) Reset() {
-	*x = Db_GetETCNumByCarIDRequest{}
-	mi := &file_db_service_proto_msgTypes[54]
+func (x *Db_ChiikiMasterSortSpec) Reset() {
+	*x = Db_ChiikiMasterSortSpec{}
+	mi := &file_db_service_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_GetETCNumByCarIDRequest) String() string {
+func (x *Db_ChiikiMasterSortSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_GetETCNumByCarIDRequest) ProtoMessage() {}
+func (*Db_ChiikiMasterSortSpec) ProtoMessage() {}
 
-func (x *Db_GetETCNumByCarIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[54]
+func (x *Db_ChiikiMasterSortSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3813,41 +11363,52 @@ func (x *Db_GetETCNumByCarIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_GetETCNumByCarIDRequest.ProtoReflect.Descriptor instead.
-func (*Db_GetETCNumByCarIDRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use Db_ChiikiMasterSortSpec.ProtoReflect.Descriptor instead.
+func (*Db_ChiikiMasterSortSpec) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *Db_GetETCNumByCarIDRequest) GetCarId() string {
+func (x *Db_ChiikiMasterSortSpec) GetField() Db_ChiikiMasterSortField {
 	if x != nil {
-		return x.CarId
+		return x.Field
 	}
-	return ""
+	return Db_ChiikiMasterSortField_CHIIKI_MASTER_SORT_FIELD_UNSPECIFIED
 }
 
-type Db_ListETCNumRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Db_ChiikiMasterSortSpec) GetDirection() Db_SortDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return Db_SortDirection_SORT_DIRECTION_UNSPECIFIED
 }
 
-func (x *Db_ListETCNumRequest) Reset() {
-	*x = Db_ListETCNumRequest{}
-	mi := &file_db_service_proto_msgTypes[55]
+type Db_ListChiikiMasterRequest struct {
+	state             protoimpl.MessageState     `protogen:"open.v1"`
+	Limit             int32                      `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset            int32                      `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	OrderBy           *string                    `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3,oneof" json:"order_by,omitempty"`                            // 非推奨: sortを使用（ソート可能なprotoのフィールド名のみ指定可）
+	PageToken         *string                    `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`                      // キーセットページネーション用トークン（指定時はoffset/order_byは使用不可、初回は空文字）
+	IncludeTotalCount bool                       `protobuf:"varint,5,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"` // page_token使用時もtotal_countを取得する（COUNT(*)を実行）
+	Sort              []*Db_ChiikiMasterSortSpec `protobuf:"bytes,6,rep,name=sort,proto3" json:"sort,omitempty"`                                                       // ソート条件（複数指定可）
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Db_ListChiikiMasterRequest) Reset() {
+	*x = Db_ListChiikiMasterRequest{}
+	mi := &file_db_service_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ListETCNumRequest) String() string {
+func (x *Db_ListChiikiMasterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_ListETCNumRequest) ProtoMessage() {}
+func (*Db_ListChiikiMasterRequest) ProtoMessage() {}
 
-func (x *Db_ListETCNumRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[55]
+func (x *Db_ListChiikiMasterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3858,48 +11419,75 @@ func (x *Db_ListETCNumRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_ListETCNumRequest.ProtoReflect.Descriptor instead.
-func (*Db_ListETCNumRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use Db_ListChiikiMasterRequest.ProtoReflect.Descriptor instead.
+func (*Db_ListChiikiMasterRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *Db_ListETCNumRequest) GetLimit() int32 {
+func (x *Db_ListChiikiMasterRequest) GetLimit() int32 {
 	if x != nil {
 		return x.Limit
 	}
 	return 0
 }
 
-func (x *Db_ListETCNumRequest) GetOffset() int32 {
+func (x *Db_ListChiikiMasterRequest) GetOffset() int32 {
 	if x != nil {
 		return x.Offset
 	}
 	return 0
 }
 
-type Db_ListETCNumResponse struct {
+func (x *Db_ListChiikiMasterRequest) GetOrderBy() string {
+	if x != nil && x.OrderBy != nil {
+		return *x.OrderBy
+	}
+	return ""
+}
+
+func (x *Db_ListChiikiMasterRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+func (x *Db_ListChiikiMasterRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+func (x *Db_ListChiikiMasterRequest) GetSort() []*Db_ChiikiMasterSortSpec {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+type Db_ChiikiMasterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*Db_ETCNum           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	ChiikiMaster  *Db_ChiikiMaster       `protobuf:"bytes,1,opt,name=chiiki_master,json=chiikiMaster,proto3" json:"chiiki_master,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_ListETCNumResponse) Reset() {
-	*x = Db_ListETCNumResponse{}
-	mi := &file_db_service_proto_msgTypes[56]
+func (x *Db_ChiikiMasterResponse) Reset() {
+	*x = Db_ChiikiMasterResponse{}
+	mi := &file_db_service_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ListETCNumResponse) String() string {
+func (x *Db_ChiikiMasterResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_ListETCNumResponse) ProtoMessage() {}
+func (*Db_ChiikiMasterResponse) ProtoMessage() {}
 
-func (x *Db_ListETCNumResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[56]
+func (x *Db_ChiikiMasterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3910,75 +11498,42 @@ func (x *Db_ListETCNumResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_ListETCNumResponse.ProtoReflect.Descriptor instead.
-func (*Db_ListETCNumResponse) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use Db_ChiikiMasterResponse.ProtoReflect.Descriptor instead.
+func (*Db_ChiikiMasterResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{127}
 }
 
-func (x *Db_ListETCNumResponse) GetItems() []*Db_ETCNum {
+func (x *Db_ChiikiMasterResponse) GetChiikiMaster() *Db_ChiikiMaster {
 	if x != nil {
-		return x.Items
+		return x.ChiikiMaster
 	}
 	return nil
 }
 
-func (x *Db_ListETCNumResponse) GetTotalCount() int32 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
-}
-
-// db_DTakoFerryRowsProd（本番DB）データ
-type Db_DTakoFerryRowsProd struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Id                int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UnkoNo            string                 `protobuf:"bytes,2,opt,name=unko_no,json=unkoNo,proto3" json:"unko_no,omitempty"`
-	UnkoDate          string                 `protobuf:"bytes,3,opt,name=unko_date,json=unkoDate,proto3" json:"unko_date,omitempty"`             // YYYY-MM-DD形式
-	YomitoriDate      string                 `protobuf:"bytes,4,opt,name=yomitori_date,json=yomitoriDate,proto3" json:"yomitori_date,omitempty"` // YYYY-MM-DD形式
-	JigyoshoCd        int32                  `protobuf:"varint,5,opt,name=jigyosho_cd,json=jigyoshoCd,proto3" json:"jigyosho_cd,omitempty"`
-	JigyoshoName      string                 `protobuf:"bytes,6,opt,name=jigyosho_name,json=jigyoshoName,proto3" json:"jigyosho_name,omitempty"`
-	SharyoCd          int32                  `protobuf:"varint,7,opt,name=sharyo_cd,json=sharyoCd,proto3" json:"sharyo_cd,omitempty"`
-	SharyoName        string                 `protobuf:"bytes,8,opt,name=sharyo_name,json=sharyoName,proto3" json:"sharyo_name,omitempty"`
-	JomuinCd1         int32                  `protobuf:"varint,9,opt,name=jomuin_cd1,json=jomuinCd1,proto3" json:"jomuin_cd1,omitempty"`
-	JomuinName1       string                 `protobuf:"bytes,10,opt,name=jomuin_name1,json=jomuinName1,proto3" json:"jomuin_name1,omitempty"`
-	TaishoJomuinKbn   int32                  `protobuf:"varint,11,opt,name=taisho_jomuin_kbn,json=taishoJomuinKbn,proto3" json:"taisho_jomuin_kbn,omitempty"`
-	KaishiDatetime    string                 `protobuf:"bytes,12,opt,name=kaishi_datetime,json=kaishiDatetime,proto3" json:"kaishi_datetime,omitempty"` // RFC3339形式
-	ShuryoDatetime    string                 `protobuf:"bytes,13,opt,name=shuryo_datetime,json=shuryoDatetime,proto3" json:"shuryo_datetime,omitempty"` // RFC3339形式
-	FerryCompanyCd    int32                  `protobuf:"varint,14,opt,name=ferry_company_cd,json=ferryCompanyCd,proto3" json:"ferry_company_cd,omitempty"`
-	FerryCompanyName  string                 `protobuf:"bytes,15,opt,name=ferry_company_name,json=ferryCompanyName,proto3" json:"ferry_company_name,omitempty"`
-	NoribaCd          int32                  `protobuf:"varint,16,opt,name=noriba_cd,json=noribaCd,proto3" json:"noriba_cd,omitempty"`
-	NoribaName        string                 `protobuf:"bytes,17,opt,name=noriba_name,json=noribaName,proto3" json:"noriba_name,omitempty"`
-	Bin               string                 `protobuf:"bytes,18,opt,name=bin,proto3" json:"bin,omitempty"`
-	OribaCd           int32                  `protobuf:"varint,19,opt,name=oriba_cd,json=oribaCd,proto3" json:"oriba_cd,omitempty"`
-	OribaName         string                 `protobuf:"bytes,20,opt,name=oriba_name,json=oribaName,proto3" json:"oriba_name,omitempty"`
-	SeisanKbn         int32                  `protobuf:"varint,21,opt,name=seisan_kbn,json=seisanKbn,proto3" json:"seisan_kbn,omitempty"`
-	SeisanKbnName     string                 `protobuf:"bytes,22,opt,name=seisan_kbn_name,json=seisanKbnName,proto3" json:"seisan_kbn_name,omitempty"`
-	HyojunRyokin      int32                  `protobuf:"varint,23,opt,name=hyojun_ryokin,json=hyojunRyokin,proto3" json:"hyojun_ryokin,omitempty"`
-	KeiyakuRyokin     int32                  `protobuf:"varint,24,opt,name=keiyaku_ryokin,json=keiyakuRyokin,proto3" json:"keiyaku_ryokin,omitempty"`
-	KosoShashuKbn     int32                  `protobuf:"varint,25,opt,name=koso_shashu_kbn,json=kosoShashuKbn,proto3" json:"koso_shashu_kbn,omitempty"`
-	KosoShashuKbnName string                 `protobuf:"bytes,26,opt,name=koso_shashu_kbn_name,json=kosoShashuKbnName,proto3" json:"koso_shashu_kbn_name,omitempty"`
-	MinashiKyori      int32                  `protobuf:"varint,27,opt,name=minashi_kyori,json=minashiKyori,proto3" json:"minashi_kyori,omitempty"`
-	FerrySrch         *string                `protobuf:"bytes,28,opt,name=ferry_srch,json=ferrySrch,proto3,oneof" json:"ferry_srch,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type Db_ListChiikiMasterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Db_ChiikiMaster     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalCount    *int32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`     // page_token使用時はinclude_total_count指定時のみ設定
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 次ページのトークン（最終ページの場合は空）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_DTakoFerryRowsProd) Reset() {
-	*x = Db_DTakoFerryRowsProd{}
-	mi := &file_db_service_proto_msgTypes[57]
+func (x *Db_ListChiikiMasterResponse) Reset() {
+	*x = Db_ListChiikiMasterResponse{}
+	mi := &file_db_service_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_DTakoFerryRowsProd) String() string {
+func (x *Db_ListChiikiMasterResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_DTakoFerryRowsProd) ProtoMessage() {}
+func (*Db_ListChiikiMasterResponse) ProtoMessage() {}
 
-func (x *Db_DTakoFerryRowsProd) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[57]
+func (x *Db_ListChiikiMasterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3989,230 +11544,200 @@ func (x *Db_DTakoFerryRowsProd) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_DTakoFerryRowsProd.ProtoReflect.Descriptor instead.
-func (*Db_DTakoFerryRowsProd) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{57}
-}
-
-func (x *Db_DTakoFerryRowsProd) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *Db_DTakoFerryRowsProd) GetUnkoNo() string {
-	if x != nil {
-		return x.UnkoNo
-	}
-	return ""
-}
-
-func (x *Db_DTakoFerryRowsProd) GetUnkoDate() string {
-	if x != nil {
-		return x.UnkoDate
-	}
-	return ""
+// Deprecated: Use Db_ListChiikiMasterResponse.ProtoReflect.Descriptor instead.
+func (*Db_ListChiikiMasterResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *Db_DTakoFerryRowsProd) GetYomitoriDate() string {
+func (x *Db_ListChiikiMasterResponse) GetItems() []*Db_ChiikiMaster {
 	if x != nil {
-		return x.YomitoriDate
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-func (x *Db_DTakoFerryRowsProd) GetJigyoshoCd() int32 {
-	if x != nil {
-		return x.JigyoshoCd
+func (x *Db_ListChiikiMasterResponse) GetTotalCount() int32 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
 	}
 	return 0
 }
 
-func (x *Db_DTakoFerryRowsProd) GetJigyoshoName() string {
+func (x *Db_ListChiikiMasterResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.JigyoshoName
+		return x.NextPageToken
 	}
 	return ""
 }
 
-func (x *Db_DTakoFerryRowsProd) GetSharyoCd() int32 {
-	if x != nil {
-		return x.SharyoCd
-	}
-	return 0
+// ChikuMaster用リクエスト/レスポンス
+type Db_GetChikuMasterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChikuC        string                 `protobuf:"bytes,1,opt,name=chiku_c,json=chikuC,proto3" json:"chiku_c,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_DTakoFerryRowsProd) GetSharyoName() string {
-	if x != nil {
-		return x.SharyoName
-	}
-	return ""
+func (x *Db_GetChikuMasterRequest) Reset() {
+	*x = Db_GetChikuMasterRequest{}
+	mi := &file_db_service_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_DTakoFerryRowsProd) GetJomuinCd1() int32 {
-	if x != nil {
-		return x.JomuinCd1
-	}
-	return 0
+func (x *Db_GetChikuMasterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Db_DTakoFerryRowsProd) GetJomuinName1() string {
-	if x != nil {
-		return x.JomuinName1
-	}
-	return ""
-}
+func (*Db_GetChikuMasterRequest) ProtoMessage() {}
 
-func (x *Db_DTakoFerryRowsProd) GetTaishoJomuinKbn() int32 {
+func (x *Db_GetChikuMasterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[129]
 	if x != nil {
-		return x.TaishoJomuinKbn
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Db_DTakoFerryRowsProd) GetKaishiDatetime() string {
-	if x != nil {
-		return x.KaishiDatetime
-	}
-	return ""
+// Deprecated: Use Db_GetChikuMasterRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetChikuMasterRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *Db_DTakoFerryRowsProd) GetShuryoDatetime() string {
+func (x *Db_GetChikuMasterRequest) GetChikuC() string {
 	if x != nil {
-		return x.ShuryoDatetime
+		return x.ChikuC
 	}
 	return ""
 }
 
-func (x *Db_DTakoFerryRowsProd) GetFerryCompanyCd() int32 {
-	if x != nil {
-		return x.FerryCompanyCd
-	}
-	return 0
+type Db_GetChikuMasterByChiikiCRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChiikiC       string                 `protobuf:"bytes,1,opt,name=chiiki_c,json=chiikiC,proto3" json:"chiiki_c,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_DTakoFerryRowsProd) GetFerryCompanyName() string {
-	if x != nil {
-		return x.FerryCompanyName
-	}
-	return ""
+func (x *Db_GetChikuMasterByChiikiCRequest) Reset() {
+	*x = Db_GetChikuMasterByChiikiCRequest{}
+	mi := &file_db_service_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_DTakoFerryRowsProd) GetNoribaCd() int32 {
-	if x != nil {
-		return x.NoribaCd
-	}
-	return 0
+func (x *Db_GetChikuMasterByChiikiCRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Db_DTakoFerryRowsProd) GetNoribaName() string {
-	if x != nil {
-		return x.NoribaName
-	}
-	return ""
-}
+func (*Db_GetChikuMasterByChiikiCRequest) ProtoMessage() {}
 
-func (x *Db_DTakoFerryRowsProd) GetBin() string {
+func (x *Db_GetChikuMasterByChiikiCRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[130]
 	if x != nil {
-		return x.Bin
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Db_DTakoFerryRowsProd) GetOribaCd() int32 {
-	if x != nil {
-		return x.OribaCd
-	}
-	return 0
+// Deprecated: Use Db_GetChikuMasterByChiikiCRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetChikuMasterByChiikiCRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *Db_DTakoFerryRowsProd) GetOribaName() string {
+func (x *Db_GetChikuMasterByChiikiCRequest) GetChiikiC() string {
 	if x != nil {
-		return x.OribaName
+		return x.ChiikiC
 	}
 	return ""
 }
 
-func (x *Db_DTakoFerryRowsProd) GetSeisanKbn() int32 {
-	if x != nil {
-		return x.SeisanKbn
-	}
-	return 0
+type Db_ChikuMasterSortSpec struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Field         Db_ChikuMasterSortField `protobuf:"varint,1,opt,name=field,proto3,enum=db_service.Db_ChikuMasterSortField" json:"field,omitempty"`
+	Direction     Db_SortDirection        `protobuf:"varint,2,opt,name=direction,proto3,enum=db_service.Db_SortDirection" json:"direction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_DTakoFerryRowsProd) GetSeisanKbnName() string {
-	if x != nil {
-		return x.SeisanKbnName
-	}
-	return ""
+func (x *Db_ChikuMasterSortSpec) Reset() {
+	*x = Db_ChikuMasterSortSpec{}
+	mi := &file_db_service_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_DTakoFerryRowsProd) GetHyojunRyokin() int32 {
-	if x != nil {
-		return x.HyojunRyokin
-	}
-	return 0
+func (x *Db_ChikuMasterSortSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Db_DTakoFerryRowsProd) GetKeiyakuRyokin() int32 {
-	if x != nil {
-		return x.KeiyakuRyokin
-	}
-	return 0
-}
+func (*Db_ChikuMasterSortSpec) ProtoMessage() {}
 
-func (x *Db_DTakoFerryRowsProd) GetKosoShashuKbn() int32 {
+func (x *Db_ChikuMasterSortSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[131]
 	if x != nil {
-		return x.KosoShashuKbn
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Db_DTakoFerryRowsProd) GetKosoShashuKbnName() string {
-	if x != nil {
-		return x.KosoShashuKbnName
-	}
-	return ""
+// Deprecated: Use Db_ChikuMasterSortSpec.ProtoReflect.Descriptor instead.
+func (*Db_ChikuMasterSortSpec) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{131}
 }
 
-func (x *Db_DTakoFerryRowsProd) GetMinashiKyori() int32 {
+func (x *Db_ChikuMasterSortSpec) GetField() Db_ChikuMasterSortField {
 	if x != nil {
-		return x.MinashiKyori
+		return x.Field
 	}
-	return 0
+	return Db_ChikuMasterSortField_CHIKU_MASTER_SORT_FIELD_UNSPECIFIED
 }
 
-func (x *Db_DTakoFerryRowsProd) GetFerrySrch() string {
-	if x != nil && x.FerrySrch != nil {
-		return *x.FerrySrch
+func (x *Db_ChikuMasterSortSpec) GetDirection() Db_SortDirection {
+	if x != nil {
+		return x.Direction
 	}
-	return ""
+	return Db_SortDirection_SORT_DIRECTION_UNSPECIFIED
 }
 
-// DTakoFerryRowsProd用リクエスト/レスポンス
-type Db_GetDTakoFerryRowsProdRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type Db_ListChikuMasterRequest struct {
+	state             protoimpl.MessageState    `protogen:"open.v1"`
+	Limit             int32                     `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset            int32                     `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	OrderBy           *string                   `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3,oneof" json:"order_by,omitempty"`                            // 非推奨: sortを使用（ソート可能なprotoのフィールド名のみ指定可）
+	PageToken         *string                   `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`                      // キーセットページネーション用トークン（指定時はoffset/order_byは使用不可、初回は空文字）
+	IncludeTotalCount bool                      `protobuf:"varint,5,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"` // page_token使用時もtotal_countを取得する（COUNT(*)を実行）
+	Sort              []*Db_ChikuMasterSortSpec `protobuf:"bytes,6,rep,name=sort,proto3" json:"sort,omitempty"`                                                       // ソート条件（複数指定可）
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *Db_GetDTakoFerryRowsProdRequest) Reset() {
-	*x = Db_GetDTakoFerryRowsProdRequest{}
-	mi := &file_db_service_proto_msgTypes[58]
+func (x *Db_ListChikuMasterRequest) Reset() {
+	*x = Db_ListChikuMasterRequest{}
+	mi := &file_db_service_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_GetDTakoFerryRowsProdRequest) String() string {
+func (x *Db_ListChikuMasterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_GetDTakoFerryRowsProdRequest) ProtoMessage() {}
+func (*Db_ListChikuMasterRequest) ProtoMessage() {}
 
-func (x *Db_GetDTakoFerryRowsProdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[58]
+func (x *Db_ListChikuMasterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4223,40 +11748,75 @@ func (x *Db_GetDTakoFerryRowsProdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_GetDTakoFerryRowsProdRequest.ProtoReflect.Descriptor instead.
-func (*Db_GetDTakoFerryRowsProdRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use Db_ListChikuMasterRequest.ProtoReflect.Descriptor instead.
+func (*Db_ListChikuMasterRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{132}
 }
 
-func (x *Db_GetDTakoFerryRowsProdRequest) GetId() int32 {
+func (x *Db_ListChikuMasterRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Id
+		return x.Limit
 	}
 	return 0
 }
 
-type Db_GetDTakoFerryRowsProdByUnkoNoRequest struct {
+func (x *Db_ListChikuMasterRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *Db_ListChikuMasterRequest) GetOrderBy() string {
+	if x != nil && x.OrderBy != nil {
+		return *x.OrderBy
+	}
+	return ""
+}
+
+func (x *Db_ListChikuMasterRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+func (x *Db_ListChikuMasterRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+func (x *Db_ListChikuMasterRequest) GetSort() []*Db_ChikuMasterSortSpec {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+type Db_ChikuMasterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UnkoNo        string                 `protobuf:"bytes,1,opt,name=unko_no,json=unkoNo,proto3" json:"unko_no,omitempty"`
+	ChikuMaster   *Db_ChikuMaster        `protobuf:"bytes,1,opt,name=chiku_master,json=chikuMaster,proto3" json:"chiku_master,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_GetDTakoFerryRowsProdByUnkoNoRequest) Reset() {
-	*x = Db_GetDTakoFerryRowsProdByUnkoNoRequest{}
-	mi := &file_db_service_proto_msgTypes[59]
+func (x *Db_ChikuMasterResponse) Reset() {
+	*x = Db_ChikuMasterResponse{}
+	mi := &file_db_service_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_GetDTakoFerryRowsProdByUnkoNoRequest) String() string {
+func (x *Db_ChikuMasterResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_GetDTakoFerryRowsProdByUnkoNoRequest) ProtoMessage() {}
+func (*Db_ChikuMasterResponse) ProtoMessage() {}
 
-func (x *Db_GetDTakoFerryRowsProdByUnkoNoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[59]
+func (x *Db_ChikuMasterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4267,41 +11827,42 @@ func (x *Db_GetDTakoFerryRowsProdByUnkoNoRequest) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_GetDTakoFerryRowsProdByUnkoNoRequest.ProtoReflect.Descriptor instead.
-func (*Db_GetDTakoFerryRowsProdByUnkoNoRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use Db_ChikuMasterResponse.ProtoReflect.Descriptor instead.
+func (*Db_ChikuMasterResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{133}
 }
 
-func (x *Db_GetDTakoFerryRowsProdByUnkoNoRequest) GetUnkoNo() string {
+func (x *Db_ChikuMasterResponse) GetChikuMaster() *Db_ChikuMaster {
 	if x != nil {
-		return x.UnkoNo
+		return x.ChikuMaster
 	}
-	return ""
+	return nil
 }
 
-type Db_ListDTakoFerryRowsProdRequest struct {
+type Db_ListChikuMasterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Items         []*Db_ChikuMaster      `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalCount    *int32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`     // page_token使用時はinclude_total_count指定時のみ設定
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 次ページのトークン（最終ページの場合は空）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_ListDTakoFerryRowsProdRequest) Reset() {
-	*x = Db_ListDTakoFerryRowsProdRequest{}
-	mi := &file_db_service_proto_msgTypes[60]
+func (x *Db_ListChikuMasterResponse) Reset() {
+	*x = Db_ListChikuMasterResponse{}
+	mi := &file_db_service_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ListDTakoFerryRowsProdRequest) String() string {
+func (x *Db_ListChikuMasterResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_ListDTakoFerryRowsProdRequest) ProtoMessage() {}
+func (*Db_ListChikuMasterResponse) ProtoMessage() {}
 
-func (x *Db_ListDTakoFerryRowsProdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[60]
+func (x *Db_ListChikuMasterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4312,47 +11873,56 @@ func (x *Db_ListDTakoFerryRowsProdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_ListDTakoFerryRowsProdRequest.ProtoReflect.Descriptor instead.
-func (*Db_ListDTakoFerryRowsProdRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use Db_ListChikuMasterResponse.ProtoReflect.Descriptor instead.
+func (*Db_ListChikuMasterResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{134}
 }
 
-func (x *Db_ListDTakoFerryRowsProdRequest) GetLimit() int32 {
+func (x *Db_ListChikuMasterResponse) GetItems() []*Db_ChikuMaster {
 	if x != nil {
-		return x.Limit
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Db_ListChikuMasterResponse) GetTotalCount() int32 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
 	}
 	return 0
 }
 
-func (x *Db_ListDTakoFerryRowsProdRequest) GetOffset() int32 {
+func (x *Db_ListChikuMasterResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.Offset
+		return x.NextPageToken
 	}
-	return 0
+	return ""
 }
 
-type Db_DTakoFerryRowsProdResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	DtakoFerryRows *Db_DTakoFerryRowsProd `protobuf:"bytes,1,opt,name=dtako_ferry_rows,json=dtakoFerryRows,proto3" json:"dtako_ferry_rows,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// TokuisakiMaster用リクエスト/レスポンス
+type Db_GetTokuisakiMasterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TokuisakiC    string                 `protobuf:"bytes,1,opt,name=tokuisaki_c,json=tokuisakiC,proto3" json:"tokuisaki_c,omitempty"`
+	TokuisakiH    string                 `protobuf:"bytes,2,opt,name=tokuisaki_h,json=tokuisakiH,proto3" json:"tokuisaki_h,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_DTakoFerryRowsProdResponse) Reset() {
-	*x = Db_DTakoFerryRowsProdResponse{}
-	mi := &file_db_service_proto_msgTypes[61]
+func (x *Db_GetTokuisakiMasterRequest) Reset() {
+	*x = Db_GetTokuisakiMasterRequest{}
+	mi := &file_db_service_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_DTakoFerryRowsProdResponse) String() string {
+func (x *Db_GetTokuisakiMasterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_DTakoFerryRowsProdResponse) ProtoMessage() {}
+func (*Db_GetTokuisakiMasterRequest) ProtoMessage() {}
 
-func (x *Db_DTakoFerryRowsProdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[61]
+func (x *Db_GetTokuisakiMasterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4363,41 +11933,48 @@ func (x *Db_DTakoFerryRowsProdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_DTakoFerryRowsProdResponse.ProtoReflect.Descriptor instead.
-func (*Db_DTakoFerryRowsProdResponse) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use Db_GetTokuisakiMasterRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetTokuisakiMasterRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{135}
 }
 
-func (x *Db_DTakoFerryRowsProdResponse) GetDtakoFerryRows() *Db_DTakoFerryRowsProd {
+func (x *Db_GetTokuisakiMasterRequest) GetTokuisakiC() string {
 	if x != nil {
-		return x.DtakoFerryRows
+		return x.TokuisakiC
 	}
-	return nil
+	return ""
 }
 
-type Db_ListDTakoFerryRowsProdResponse struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Items         []*Db_DTakoFerryRowsProd `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	TotalCount    int32                    `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+func (x *Db_GetTokuisakiMasterRequest) GetTokuisakiH() string {
+	if x != nil {
+		return x.TokuisakiH
+	}
+	return ""
+}
+
+type Db_TokuisakiMasterSortSpec struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Field         Db_TokuisakiMasterSortField `protobuf:"varint,1,opt,name=field,proto3,enum=db_service.Db_TokuisakiMasterSortField" json:"field,omitempty"`
+	Direction     Db_SortDirection            `protobuf:"varint,2,opt,name=direction,proto3,enum=db_service.Db_SortDirection" json:"direction,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_ListDTakoFerryRowsProdResponse) Reset() {
-	*x = Db_ListDTakoFerryRowsProdResponse{}
-	mi := &file_db_service_proto_msgTypes[62]
+func (x *Db_TokuisakiMasterSortSpec) Reset() {
+	*x = Db_TokuisakiMasterSortSpec{}
+	mi := &file_db_service_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ListDTakoFerryRowsProdResponse) String() string {
+func (x *Db_TokuisakiMasterSortSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_ListDTakoFerryRowsProdResponse) ProtoMessage() {}
+func (*Db_TokuisakiMasterSortSpec) ProtoMessage() {}
 
-func (x *Db_ListDTakoFerryRowsProdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[62]
+func (x *Db_TokuisakiMasterSortSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4408,69 +11985,52 @@ func (x *Db_ListDTakoFerryRowsProdResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_ListDTakoFerryRowsProdResponse.ProtoReflect.Descriptor instead.
-func (*Db_ListDTakoFerryRowsProdResponse) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use Db_TokuisakiMasterSortSpec.ProtoReflect.Descriptor instead.
+func (*Db_TokuisakiMasterSortSpec) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{136}
 }
 
-func (x *Db_ListDTakoFerryRowsProdResponse) GetItems() []*Db_DTakoFerryRowsProd {
+func (x *Db_TokuisakiMasterSortSpec) GetField() Db_TokuisakiMasterSortField {
 	if x != nil {
-		return x.Items
+		return x.Field
 	}
-	return nil
+	return Db_TokuisakiMasterSortField_TOKUISAKI_MASTER_SORT_FIELD_UNSPECIFIED
 }
 
-func (x *Db_ListDTakoFerryRowsProdResponse) GetTotalCount() int32 {
+func (x *Db_TokuisakiMasterSortSpec) GetDirection() Db_SortDirection {
 	if x != nil {
-		return x.TotalCount
+		return x.Direction
 	}
-	return 0
+	return Db_SortDirection_SORT_DIRECTION_UNSPECIFIED
 }
 
-// db_Cars メッセージ
-type Db_Cars struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Id4             int32                  `protobuf:"varint,2,opt,name=id4,proto3" json:"id4,omitempty"`
-	Name            *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	NameR           *string                `protobuf:"bytes,4,opt,name=name_r,json=nameR,proto3,oneof" json:"name_r,omitempty"`
-	Shashu          *string                `protobuf:"bytes,5,opt,name=shashu,proto3,oneof" json:"shashu,omitempty"`
-	Sekisai         *float64               `protobuf:"fixed64,6,opt,name=sekisai,proto3,oneof" json:"sekisai,omitempty"`
-	Youseki         *float64               `protobuf:"fixed64,7,opt,name=youseki,proto3,oneof" json:"youseki,omitempty"`
-	RegDate         *string                `protobuf:"bytes,8,opt,name=reg_date,json=regDate,proto3,oneof" json:"reg_date,omitempty"`                           // RFC3339形式
-	NextInspectDate *string                `protobuf:"bytes,9,opt,name=next_inspect_date,json=nextInspectDate,proto3,oneof" json:"next_inspect_date,omitempty"` // RFC3339形式
-	ParchDate       *string                `protobuf:"bytes,10,opt,name=parch_date,json=parchDate,proto3,oneof" json:"parch_date,omitempty"`                    // RFC3339形式
-	ScrapDate       *string                `protobuf:"bytes,11,opt,name=scrap_date,json=scrapDate,proto3,oneof" json:"scrap_date,omitempty"`                    // RFC3339形式
-	BumonCodeId     *string                `protobuf:"bytes,12,opt,name=bumon_code_id,json=bumonCodeId,proto3,oneof" json:"bumon_code_id,omitempty"`
-	DriverId        *int32                 `protobuf:"varint,13,opt,name=driver_id,json=driverId,proto3,oneof" json:"driver_id,omitempty"`
-	Etc             *int32                 `protobuf:"varint,14,opt,name=etc,proto3,oneof" json:"etc,omitempty"`
-	Dai1            int32                  `protobuf:"varint,15,opt,name=dai1,proto3" json:"dai1,omitempty"`
-	Chu1            int32                  `protobuf:"varint,16,opt,name=chu1,proto3" json:"chu1,omitempty"`
-	Sho1            int32                  `protobuf:"varint,17,opt,name=sho1,proto3" json:"sho1,omitempty"`
-	Dai2            int32                  `protobuf:"varint,18,opt,name=dai2,proto3" json:"dai2,omitempty"`
-	Chu2            int32                  `protobuf:"varint,19,opt,name=chu2,proto3" json:"chu2,omitempty"`
-	Sho2            int32                  `protobuf:"varint,20,opt,name=sho2,proto3" json:"sho2,omitempty"`
-	Daichusho1      *string                `protobuf:"bytes,21,opt,name=daichusho1,proto3,oneof" json:"daichusho1,omitempty"`
-	Daichusho2      *string                `protobuf:"bytes,22,opt,name=daichusho2,proto3,oneof" json:"daichusho2,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type Db_ListTokuisakiMasterRequest struct {
+	state             protoimpl.MessageState        `protogen:"open.v1"`
+	Limit             int32                         `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset            int32                         `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	OrderBy           *string                       `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3,oneof" json:"order_by,omitempty"`                            // 非推奨: sortを使用（ソート可能なprotoのフィールド名のみ指定可）
+	PageToken         *string                       `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`                      // キーセットページネーション用トークン（指定時はoffset/order_byは使用不可、初回は空文字）
+	IncludeTotalCount bool                          `protobuf:"varint,5,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"` // page_token使用時もtotal_countを取得する（COUNT(*)を実行）
+	Sort              []*Db_TokuisakiMasterSortSpec `protobuf:"bytes,6,rep,name=sort,proto3" json:"sort,omitempty"`                                                       // ソート条件（複数指定可）
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *Db_Cars) Reset() {
-	*x = Db_Cars{}
-	mi := &file_db_service_proto_msgTypes[63]
+func (x *Db_ListTokuisakiMasterRequest) Reset() {
+	*x = Db_ListTokuisakiMasterRequest{}
+	mi := &file_db_service_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_Cars) String() string {
+func (x *Db_ListTokuisakiMasterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_Cars) ProtoMessage() {}
+func (*Db_ListTokuisakiMasterRequest) ProtoMessage() {}
 
-func (x *Db_Cars) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[63]
+func (x *Db_ListTokuisakiMasterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4481,197 +12041,232 @@ func (x *Db_Cars) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_Cars.ProtoReflect.Descriptor instead.
-func (*Db_Cars) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use Db_ListTokuisakiMasterRequest.ProtoReflect.Descriptor instead.
+func (*Db_ListTokuisakiMasterRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{137}
 }
 
-func (x *Db_Cars) GetId() string {
+func (x *Db_ListTokuisakiMasterRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Id
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *Db_Cars) GetId4() int32 {
+func (x *Db_ListTokuisakiMasterRequest) GetOffset() int32 {
 	if x != nil {
-		return x.Id4
+		return x.Offset
 	}
 	return 0
 }
 
-func (x *Db_Cars) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *Db_ListTokuisakiMasterRequest) GetOrderBy() string {
+	if x != nil && x.OrderBy != nil {
+		return *x.OrderBy
 	}
 	return ""
 }
 
-func (x *Db_Cars) GetNameR() string {
-	if x != nil && x.NameR != nil {
-		return *x.NameR
+func (x *Db_ListTokuisakiMasterRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
 	}
 	return ""
 }
 
-func (x *Db_Cars) GetShashu() string {
-	if x != nil && x.Shashu != nil {
-		return *x.Shashu
+func (x *Db_ListTokuisakiMasterRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
 	}
-	return ""
+	return false
 }
 
-func (x *Db_Cars) GetSekisai() float64 {
-	if x != nil && x.Sekisai != nil {
-		return *x.Sekisai
+func (x *Db_ListTokuisakiMasterRequest) GetSort() []*Db_TokuisakiMasterSortSpec {
+	if x != nil {
+		return x.Sort
 	}
-	return 0
+	return nil
 }
 
-func (x *Db_Cars) GetYouseki() float64 {
-	if x != nil && x.Youseki != nil {
-		return *x.Youseki
-	}
-	return 0
+type Db_SearchTokuisakiMasterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`  // 得意先N・得意先R・得意先Fに含まれる文字列
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // 最大件数（0の場合は100、最大1000）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_Cars) GetRegDate() string {
-	if x != nil && x.RegDate != nil {
-		return *x.RegDate
-	}
-	return ""
+func (x *Db_SearchTokuisakiMasterRequest) Reset() {
+	*x = Db_SearchTokuisakiMasterRequest{}
+	mi := &file_db_service_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_Cars) GetNextInspectDate() string {
-	if x != nil && x.NextInspectDate != nil {
-		return *x.NextInspectDate
-	}
-	return ""
+func (x *Db_SearchTokuisakiMasterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Db_Cars) GetParchDate() string {
-	if x != nil && x.ParchDate != nil {
-		return *x.ParchDate
+func (*Db_SearchTokuisakiMasterRequest) ProtoMessage() {}
+
+func (x *Db_SearchTokuisakiMasterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Db_Cars) GetScrapDate() string {
-	if x != nil && x.ScrapDate != nil {
-		return *x.ScrapDate
-	}
-	return ""
+// Deprecated: Use Db_SearchTokuisakiMasterRequest.ProtoReflect.Descriptor instead.
+func (*Db_SearchTokuisakiMasterRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{138}
 }
 
-func (x *Db_Cars) GetBumonCodeId() string {
-	if x != nil && x.BumonCodeId != nil {
-		return *x.BumonCodeId
+func (x *Db_SearchTokuisakiMasterRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
 	}
 	return ""
 }
 
-func (x *Db_Cars) GetDriverId() int32 {
-	if x != nil && x.DriverId != nil {
-		return *x.DriverId
+func (x *Db_SearchTokuisakiMasterRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
 	}
 	return 0
 }
 
-func (x *Db_Cars) GetEtc() int32 {
-	if x != nil && x.Etc != nil {
-		return *x.Etc
-	}
-	return 0
+type Db_TokuisakiMasterResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TokuisakiMaster *Db_TokuisakiMaster    `protobuf:"bytes,1,opt,name=tokuisaki_master,json=tokuisakiMaster,proto3" json:"tokuisaki_master,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *Db_Cars) GetDai1() int32 {
-	if x != nil {
-		return x.Dai1
-	}
-	return 0
+func (x *Db_TokuisakiMasterResponse) Reset() {
+	*x = Db_TokuisakiMasterResponse{}
+	mi := &file_db_service_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_Cars) GetChu1() int32 {
-	if x != nil {
-		return x.Chu1
-	}
-	return 0
+func (x *Db_TokuisakiMasterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Db_Cars) GetSho1() int32 {
+func (*Db_TokuisakiMasterResponse) ProtoMessage() {}
+
+func (x *Db_TokuisakiMasterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[139]
 	if x != nil {
-		return x.Sho1
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Db_Cars) GetDai2() int32 {
+// Deprecated: Use Db_TokuisakiMasterResponse.ProtoReflect.Descriptor instead.
+func (*Db_TokuisakiMasterResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *Db_TokuisakiMasterResponse) GetTokuisakiMaster() *Db_TokuisakiMaster {
 	if x != nil {
-		return x.Dai2
+		return x.TokuisakiMaster
 	}
-	return 0
+	return nil
 }
 
-func (x *Db_Cars) GetChu2() int32 {
+type Db_ListTokuisakiMasterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Db_TokuisakiMaster  `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalCount    *int32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`     // page_token使用時はinclude_total_count指定時のみ設定
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 次ページのトークン（最終ページの場合は空）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_ListTokuisakiMasterResponse) Reset() {
+	*x = Db_ListTokuisakiMasterResponse{}
+	mi := &file_db_service_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_ListTokuisakiMasterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_ListTokuisakiMasterResponse) ProtoMessage() {}
+
+func (x *Db_ListTokuisakiMasterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[140]
 	if x != nil {
-		return x.Chu2
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Db_Cars) GetSho2() int32 {
+// Deprecated: Use Db_ListTokuisakiMasterResponse.ProtoReflect.Descriptor instead.
+func (*Db_ListTokuisakiMasterResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *Db_ListTokuisakiMasterResponse) GetItems() []*Db_TokuisakiMaster {
 	if x != nil {
-		return x.Sho2
+		return x.Items
 	}
-	return 0
+	return nil
 }
 
-func (x *Db_Cars) GetDaichusho1() string {
-	if x != nil && x.Daichusho1 != nil {
-		return *x.Daichusho1
+func (x *Db_ListTokuisakiMasterResponse) GetTotalCount() int32 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
 	}
-	return ""
+	return 0
 }
 
-func (x *Db_Cars) GetDaichusho2() string {
-	if x != nil && x.Daichusho2 != nil {
-		return *x.Daichusho2
+func (x *Db_ListTokuisakiMasterResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
 	}
 	return ""
 }
 
-// db_Drivers メッセージ
-type Db_Drivers struct {
+type Db_ListTokuisakiTekiyobiRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	ShainR        *string                `protobuf:"bytes,3,opt,name=shain_r,json=shainR,proto3,oneof" json:"shain_r,omitempty"`
-	Bumon         string                 `protobuf:"bytes,4,opt,name=bumon,proto3" json:"bumon,omitempty"`
-	JoinDate      *string                `protobuf:"bytes,5,opt,name=join_date,json=joinDate,proto3,oneof" json:"join_date,omitempty"`       // RFC3339形式
-	RetireDate    *string                `protobuf:"bytes,6,opt,name=retire_date,json=retireDate,proto3,oneof" json:"retire_date,omitempty"` // RFC3339形式
-	Bunrui1       *string                `protobuf:"bytes,7,opt,name=bunrui1,proto3,oneof" json:"bunrui1,omitempty"`
-	Bunrui2       *string                `protobuf:"bytes,8,opt,name=bunrui2,proto3,oneof" json:"bunrui2,omitempty"`
-	Kubun         *int32                 `protobuf:"varint,9,opt,name=kubun,proto3,oneof" json:"kubun,omitempty"`
-	KinmuTaikei   int32                  `protobuf:"varint,10,opt,name=kinmu_taikei,json=kinmuTaikei,proto3" json:"kinmu_taikei,omitempty"`
+	TokuisakiC    string                 `protobuf:"bytes,1,opt,name=tokuisaki_c,json=tokuisakiC,proto3" json:"tokuisaki_c,omitempty"`
+	TokuisakiH    string                 `protobuf:"bytes,2,opt,name=tokuisaki_h,json=tokuisakiH,proto3" json:"tokuisaki_h,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_Drivers) Reset() {
-	*x = Db_Drivers{}
-	mi := &file_db_service_proto_msgTypes[64]
+func (x *Db_ListTokuisakiTekiyobiRequest) Reset() {
+	*x = Db_ListTokuisakiTekiyobiRequest{}
+	mi := &file_db_service_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_Drivers) String() string {
+func (x *Db_ListTokuisakiTekiyobiRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_Drivers) ProtoMessage() {}
+func (*Db_ListTokuisakiTekiyobiRequest) ProtoMessage() {}
 
-func (x *Db_Drivers) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[64]
+func (x *Db_ListTokuisakiTekiyobiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4682,104 +12277,107 @@ func (x *Db_Drivers) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_Drivers.ProtoReflect.Descriptor instead.
-func (*Db_Drivers) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use Db_ListTokuisakiTekiyobiRequest.ProtoReflect.Descriptor instead.
+func (*Db_ListTokuisakiTekiyobiRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{141}
 }
 
-func (x *Db_Drivers) GetId() int32 {
+func (x *Db_ListTokuisakiTekiyobiRequest) GetTokuisakiC() string {
 	if x != nil {
-		return x.Id
+		return x.TokuisakiC
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_Drivers) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *Db_ListTokuisakiTekiyobiRequest) GetTokuisakiH() string {
+	if x != nil {
+		return x.TokuisakiH
 	}
 	return ""
 }
 
-func (x *Db_Drivers) GetShainR() string {
-	if x != nil && x.ShainR != nil {
-		return *x.ShainR
-	}
-	return ""
+type Db_GetTokuisakiTekiyobiAtRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TokuisakiC    string                 `protobuf:"bytes,1,opt,name=tokuisaki_c,json=tokuisakiC,proto3" json:"tokuisaki_c,omitempty"`
+	TokuisakiH    string                 `protobuf:"bytes,2,opt,name=tokuisaki_h,json=tokuisakiH,proto3" json:"tokuisaki_h,omitempty"`
+	Date          string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD（空の場合は今日）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_Drivers) GetBumon() string {
-	if x != nil {
-		return x.Bumon
-	}
-	return ""
+func (x *Db_GetTokuisakiTekiyobiAtRequest) Reset() {
+	*x = Db_GetTokuisakiTekiyobiAtRequest{}
+	mi := &file_db_service_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_Drivers) GetJoinDate() string {
-	if x != nil && x.JoinDate != nil {
-		return *x.JoinDate
-	}
-	return ""
+func (x *Db_GetTokuisakiTekiyobiAtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Db_Drivers) GetRetireDate() string {
-	if x != nil && x.RetireDate != nil {
-		return *x.RetireDate
+func (*Db_GetTokuisakiTekiyobiAtRequest) ProtoMessage() {}
+
+func (x *Db_GetTokuisakiTekiyobiAtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Db_Drivers) GetBunrui1() string {
-	if x != nil && x.Bunrui1 != nil {
-		return *x.Bunrui1
-	}
-	return ""
+// Deprecated: Use Db_GetTokuisakiTekiyobiAtRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetTokuisakiTekiyobiAtRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{142}
 }
 
-func (x *Db_Drivers) GetBunrui2() string {
-	if x != nil && x.Bunrui2 != nil {
-		return *x.Bunrui2
+func (x *Db_GetTokuisakiTekiyobiAtRequest) GetTokuisakiC() string {
+	if x != nil {
+		return x.TokuisakiC
 	}
 	return ""
 }
 
-func (x *Db_Drivers) GetKubun() int32 {
-	if x != nil && x.Kubun != nil {
-		return *x.Kubun
+func (x *Db_GetTokuisakiTekiyobiAtRequest) GetTokuisakiH() string {
+	if x != nil {
+		return x.TokuisakiH
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_Drivers) GetKinmuTaikei() int32 {
+func (x *Db_GetTokuisakiTekiyobiAtRequest) GetDate() string {
 	if x != nil {
-		return x.KinmuTaikei
+		return x.Date
 	}
-	return 0
+	return ""
 }
 
-// Cars用リクエスト/レスポンス
-type Db_GetCarsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type Db_TokuisakiTekiyobiResponse struct {
+	state             protoimpl.MessageState      `protogen:"open.v1"`
+	TokuisakiTekiyobi *Db_TokuisakiTekiyobiMaster `protobuf:"bytes,1,opt,name=tokuisaki_tekiyobi,json=tokuisakiTekiyobi,proto3" json:"tokuisaki_tekiyobi,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *Db_GetCarsRequest) Reset() {
-	*x = Db_GetCarsRequest{}
-	mi := &file_db_service_proto_msgTypes[65]
+func (x *Db_TokuisakiTekiyobiResponse) Reset() {
+	*x = Db_TokuisakiTekiyobiResponse{}
+	mi := &file_db_service_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_GetCarsRequest) String() string {
+func (x *Db_TokuisakiTekiyobiResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_GetCarsRequest) ProtoMessage() {}
+func (*Db_TokuisakiTekiyobiResponse) ProtoMessage() {}
 
-func (x *Db_GetCarsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[65]
+func (x *Db_TokuisakiTekiyobiResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4790,40 +12388,40 @@ func (x *Db_GetCarsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_GetCarsRequest.ProtoReflect.Descriptor instead.
-func (*Db_GetCarsRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use Db_TokuisakiTekiyobiResponse.ProtoReflect.Descriptor instead.
+func (*Db_TokuisakiTekiyobiResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{143}
 }
 
-func (x *Db_GetCarsRequest) GetId() string {
+func (x *Db_TokuisakiTekiyobiResponse) GetTokuisakiTekiyobi() *Db_TokuisakiTekiyobiMaster {
 	if x != nil {
-		return x.Id
+		return x.TokuisakiTekiyobi
 	}
-	return ""
+	return nil
 }
 
-type Db_GetCarsByBumonCodeIDRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	BumonCodeId   string                 `protobuf:"bytes,1,opt,name=bumon_code_id,json=bumonCodeId,proto3" json:"bumon_code_id,omitempty"`
+type Db_ListTokuisakiTekiyobiResponse struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Items         []*Db_TokuisakiTekiyobiMaster `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_GetCarsByBumonCodeIDRequest) Reset() {
-	*x = Db_GetCarsByBumonCodeIDRequest{}
-	mi := &file_db_service_proto_msgTypes[66]
+func (x *Db_ListTokuisakiTekiyobiResponse) Reset() {
+	*x = Db_ListTokuisakiTekiyobiResponse{}
+	mi := &file_db_service_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_GetCarsByBumonCodeIDRequest) String() string {
+func (x *Db_ListTokuisakiTekiyobiResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_GetCarsByBumonCodeIDRequest) ProtoMessage() {}
+func (*Db_ListTokuisakiTekiyobiResponse) ProtoMessage() {}
 
-func (x *Db_GetCarsByBumonCodeIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[66]
+func (x *Db_ListTokuisakiTekiyobiResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4834,42 +12432,42 @@ func (x *Db_GetCarsByBumonCodeIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_GetCarsByBumonCodeIDRequest.ProtoReflect.Descriptor instead.
-func (*Db_GetCarsByBumonCodeIDRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{66}
+// Deprecated: Use Db_ListTokuisakiTekiyobiResponse.ProtoReflect.Descriptor instead.
+func (*Db_ListTokuisakiTekiyobiResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{144}
 }
 
-func (x *Db_GetCarsByBumonCodeIDRequest) GetBumonCodeId() string {
+func (x *Db_ListTokuisakiTekiyobiResponse) GetItems() []*Db_TokuisakiTekiyobiMaster {
 	if x != nil {
-		return x.BumonCodeId
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-type Db_ListCarsRequest struct {
+// HinmeiMaster用リクエスト/レスポンス
+type Db_GetHinmeiMasterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	OrderBy       *string                `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3,oneof" json:"order_by,omitempty"` // 例: "id ASC", "name DESC"
+	HinmeiC       string                 `protobuf:"bytes,1,opt,name=hinmei_c,json=hinmeiC,proto3" json:"hinmei_c,omitempty"`
+	HinmeiH       string                 `protobuf:"bytes,2,opt,name=hinmei_h,json=hinmeiH,proto3" json:"hinmei_h,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_ListCarsRequest) Reset() {
-	*x = Db_ListCarsRequest{}
-	mi := &file_db_service_proto_msgTypes[67]
+func (x *Db_GetHinmeiMasterRequest) Reset() {
+	*x = Db_GetHinmeiMasterRequest{}
+	mi := &file_db_service_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ListCarsRequest) String() string {
+func (x *Db_GetHinmeiMasterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_ListCarsRequest) ProtoMessage() {}
+func (*Db_GetHinmeiMasterRequest) ProtoMessage() {}
 
-func (x *Db_ListCarsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[67]
+func (x *Db_GetHinmeiMasterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4880,54 +12478,48 @@ func (x *Db_ListCarsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_ListCarsRequest.ProtoReflect.Descriptor instead.
-func (*Db_ListCarsRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{67}
+// Deprecated: Use Db_GetHinmeiMasterRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetHinmeiMasterRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{145}
 }
 
-func (x *Db_ListCarsRequest) GetLimit() int32 {
+func (x *Db_GetHinmeiMasterRequest) GetHinmeiC() string {
 	if x != nil {
-		return x.Limit
+		return x.HinmeiC
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_ListCarsRequest) GetOffset() int32 {
+func (x *Db_GetHinmeiMasterRequest) GetHinmeiH() string {
 	if x != nil {
-		return x.Offset
-	}
-	return 0
-}
-
-func (x *Db_ListCarsRequest) GetOrderBy() string {
-	if x != nil && x.OrderBy != nil {
-		return *x.OrderBy
+		return x.HinmeiH
 	}
 	return ""
 }
 
-type Db_CarsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cars          *Db_Cars               `protobuf:"bytes,1,opt,name=cars,proto3" json:"cars,omitempty"`
+type Db_HinmeiMasterSortSpec struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Field         Db_HinmeiMasterSortField `protobuf:"varint,1,opt,name=field,proto3,enum=db_service.Db_HinmeiMasterSortField" json:"field,omitempty"`
+	Direction     Db_SortDirection         `protobuf:"varint,2,opt,name=direction,proto3,enum=db_service.Db_SortDirection" json:"direction,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_CarsResponse) Reset() {
-	*x = Db_CarsResponse{}
-	mi := &file_db_service_proto_msgTypes[68]
+func (x *Db_HinmeiMasterSortSpec) Reset() {
+	*x = Db_HinmeiMasterSortSpec{}
+	mi := &file_db_service_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_CarsResponse) String() string {
+func (x *Db_HinmeiMasterSortSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_CarsResponse) ProtoMessage() {}
+func (*Db_HinmeiMasterSortSpec) ProtoMessage() {}
 
-func (x *Db_CarsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[68]
+func (x *Db_HinmeiMasterSortSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4938,41 +12530,52 @@ func (x *Db_CarsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_CarsResponse.ProtoReflect.Descriptor instead.
-func (*Db_CarsResponse) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{68}
+// Deprecated: Use Db_HinmeiMasterSortSpec.ProtoReflect.Descriptor instead.
+func (*Db_HinmeiMasterSortSpec) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{146}
 }
 
-func (x *Db_CarsResponse) GetCars() *Db_Cars {
+func (x *Db_HinmeiMasterSortSpec) GetField() Db_HinmeiMasterSortField {
 	if x != nil {
-		return x.Cars
+		return x.Field
 	}
-	return nil
+	return Db_HinmeiMasterSortField_HINMEI_MASTER_SORT_FIELD_UNSPECIFIED
 }
 
-type Db_ListCarsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*Db_Cars             `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Db_HinmeiMasterSortSpec) GetDirection() Db_SortDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return Db_SortDirection_SORT_DIRECTION_UNSPECIFIED
 }
 
-func (x *Db_ListCarsResponse) Reset() {
-	*x = Db_ListCarsResponse{}
-	mi := &file_db_service_proto_msgTypes[69]
+type Db_ListHinmeiMasterRequest struct {
+	state             protoimpl.MessageState     `protogen:"open.v1"`
+	Limit             int32                      `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset            int32                      `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	OrderBy           *string                    `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3,oneof" json:"order_by,omitempty"`                            // 非推奨: sortを使用（ソート可能なprotoのフィールド名のみ指定可）
+	PageToken         *string                    `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`                      // キーセットページネーション用トークン（指定時はoffset/order_byは使用不可、初回は空文字）
+	IncludeTotalCount bool                       `protobuf:"varint,5,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"` // page_token使用時もtotal_countを取得する（COUNT(*)を実行）
+	Sort              []*Db_HinmeiMasterSortSpec `protobuf:"bytes,6,rep,name=sort,proto3" json:"sort,omitempty"`                                                       // ソート条件（複数指定可）
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Db_ListHinmeiMasterRequest) Reset() {
+	*x = Db_ListHinmeiMasterRequest{}
+	mi := &file_db_service_proto_msgTypes[147]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ListCarsResponse) String() string {
+func (x *Db_ListHinmeiMasterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_ListCarsResponse) ProtoMessage() {}
+func (*Db_ListHinmeiMasterRequest) ProtoMessage() {}
 
-func (x *Db_ListCarsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[69]
+func (x *Db_ListHinmeiMasterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[147]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4983,48 +12586,76 @@ func (x *Db_ListCarsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_ListCarsResponse.ProtoReflect.Descriptor instead.
-func (*Db_ListCarsResponse) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use Db_ListHinmeiMasterRequest.ProtoReflect.Descriptor instead.
+func (*Db_ListHinmeiMasterRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{147}
 }
 
-func (x *Db_ListCarsResponse) GetItems() []*Db_Cars {
+func (x *Db_ListHinmeiMasterRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Items
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-func (x *Db_ListCarsResponse) GetTotalCount() int32 {
+func (x *Db_ListHinmeiMasterRequest) GetOffset() int32 {
 	if x != nil {
-		return x.TotalCount
+		return x.Offset
 	}
 	return 0
 }
 
-// Drivers用リクエスト/レスポンス
-type Db_GetDriversRequest struct {
+func (x *Db_ListHinmeiMasterRequest) GetOrderBy() string {
+	if x != nil && x.OrderBy != nil {
+		return *x.OrderBy
+	}
+	return ""
+}
+
+func (x *Db_ListHinmeiMasterRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+func (x *Db_ListHinmeiMasterRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+func (x *Db_ListHinmeiMasterRequest) GetSort() []*Db_HinmeiMasterSortSpec {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+type Db_SearchHinmeiMasterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`  // 品名N・品名R・品名Fに含まれる文字列
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // 最大件数（0の場合は100、最大1000）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_GetDriversRequest) Reset() {
-	*x = Db_GetDriversRequest{}
-	mi := &file_db_service_proto_msgTypes[70]
+func (x *Db_SearchHinmeiMasterRequest) Reset() {
+	*x = Db_SearchHinmeiMasterRequest{}
+	mi := &file_db_service_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_GetDriversRequest) String() string {
+func (x *Db_SearchHinmeiMasterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_GetDriversRequest) ProtoMessage() {}
+func (*Db_SearchHinmeiMasterRequest) ProtoMessage() {}
 
-func (x *Db_GetDriversRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[70]
+func (x *Db_SearchHinmeiMasterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5035,40 +12666,47 @@ func (x *Db_GetDriversRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_GetDriversRequest.ProtoReflect.Descriptor instead.
-func (*Db_GetDriversRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use Db_SearchHinmeiMasterRequest.ProtoReflect.Descriptor instead.
+func (*Db_SearchHinmeiMasterRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{148}
 }
 
-func (x *Db_GetDriversRequest) GetId() int32 {
+func (x *Db_SearchHinmeiMasterRequest) GetQuery() string {
 	if x != nil {
-		return x.Id
+		return x.Query
+	}
+	return ""
+}
+
+func (x *Db_SearchHinmeiMasterRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
 	}
 	return 0
 }
 
-type Db_GetDriversByBumonRequest struct {
+type Db_HinmeiMasterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Bumon         string                 `protobuf:"bytes,1,opt,name=bumon,proto3" json:"bumon,omitempty"`
+	HinmeiMaster  *Db_HinmeiMaster       `protobuf:"bytes,1,opt,name=hinmei_master,json=hinmeiMaster,proto3" json:"hinmei_master,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_GetDriversByBumonRequest) Reset() {
-	*x = Db_GetDriversByBumonRequest{}
-	mi := &file_db_service_proto_msgTypes[71]
+func (x *Db_HinmeiMasterResponse) Reset() {
+	*x = Db_HinmeiMasterResponse{}
+	mi := &file_db_service_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_GetDriversByBumonRequest) String() string {
+func (x *Db_HinmeiMasterResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_GetDriversByBumonRequest) ProtoMessage() {}
+func (*Db_HinmeiMasterResponse) ProtoMessage() {}
 
-func (x *Db_GetDriversByBumonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[71]
+func (x *Db_HinmeiMasterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5079,42 +12717,42 @@ func (x *Db_GetDriversByBumonRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_GetDriversByBumonRequest.ProtoReflect.Descriptor instead.
-func (*Db_GetDriversByBumonRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{71}
+// Deprecated: Use Db_HinmeiMasterResponse.ProtoReflect.Descriptor instead.
+func (*Db_HinmeiMasterResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{149}
 }
 
-func (x *Db_GetDriversByBumonRequest) GetBumon() string {
+func (x *Db_HinmeiMasterResponse) GetHinmeiMaster() *Db_HinmeiMaster {
 	if x != nil {
-		return x.Bumon
+		return x.HinmeiMaster
 	}
-	return ""
+	return nil
 }
 
-type Db_ListDriversRequest struct {
+type Db_ListHinmeiMasterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	OrderBy       *string                `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3,oneof" json:"order_by,omitempty"` // 例: "id ASC", "name DESC"
+	Items         []*Db_HinmeiMaster     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalCount    *int32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`     // page_token使用時はinclude_total_count指定時のみ設定
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 次ページのトークン（最終ページの場合は空）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_ListDriversRequest) Reset() {
-	*x = Db_ListDriversRequest{}
-	mi := &file_db_service_proto_msgTypes[72]
+func (x *Db_ListHinmeiMasterResponse) Reset() {
+	*x = Db_ListHinmeiMasterResponse{}
+	mi := &file_db_service_proto_msgTypes[150]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ListDriversRequest) String() string {
+func (x *Db_ListHinmeiMasterResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_ListDriversRequest) ProtoMessage() {}
+func (*Db_ListHinmeiMasterResponse) ProtoMessage() {}
 
-func (x *Db_ListDriversRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[72]
+func (x *Db_ListHinmeiMasterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5125,54 +12763,55 @@ func (x *Db_ListDriversRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_ListDriversRequest.ProtoReflect.Descriptor instead.
-func (*Db_ListDriversRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{72}
+// Deprecated: Use Db_ListHinmeiMasterResponse.ProtoReflect.Descriptor instead.
+func (*Db_ListHinmeiMasterResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{150}
 }
 
-func (x *Db_ListDriversRequest) GetLimit() int32 {
+func (x *Db_ListHinmeiMasterResponse) GetItems() []*Db_HinmeiMaster {
 	if x != nil {
-		return x.Limit
+		return x.Items
 	}
-	return 0
+	return nil
 }
 
-func (x *Db_ListDriversRequest) GetOffset() int32 {
-	if x != nil {
-		return x.Offset
+func (x *Db_ListHinmeiMasterResponse) GetTotalCount() int32 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
 	}
 	return 0
 }
 
-func (x *Db_ListDriversRequest) GetOrderBy() string {
-	if x != nil && x.OrderBy != nil {
-		return *x.OrderBy
+func (x *Db_ListHinmeiMasterResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
 	}
 	return ""
 }
 
-type Db_DriversResponse struct {
+type Db_ListTokuisakiHinmeiRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Drivers       *Db_Drivers            `protobuf:"bytes,1,opt,name=drivers,proto3" json:"drivers,omitempty"`
+	TokuisakiC    string                 `protobuf:"bytes,1,opt,name=tokuisaki_c,json=tokuisakiC,proto3" json:"tokuisaki_c,omitempty"`
+	TokuisakiH    string                 `protobuf:"bytes,2,opt,name=tokuisaki_h,json=tokuisakiH,proto3" json:"tokuisaki_h,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_DriversResponse) Reset() {
-	*x = Db_DriversResponse{}
-	mi := &file_db_service_proto_msgTypes[73]
+func (x *Db_ListTokuisakiHinmeiRequest) Reset() {
+	*x = Db_ListTokuisakiHinmeiRequest{}
+	mi := &file_db_service_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_DriversResponse) String() string {
+func (x *Db_ListTokuisakiHinmeiRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_DriversResponse) ProtoMessage() {}
+func (*Db_ListTokuisakiHinmeiRequest) ProtoMessage() {}
 
-func (x *Db_DriversResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[73]
+func (x *Db_ListTokuisakiHinmeiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5183,41 +12822,47 @@ func (x *Db_DriversResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_DriversResponse.ProtoReflect.Descriptor instead.
-func (*Db_DriversResponse) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use Db_ListTokuisakiHinmeiRequest.ProtoReflect.Descriptor instead.
+func (*Db_ListTokuisakiHinmeiRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{151}
 }
 
-func (x *Db_DriversResponse) GetDrivers() *Db_Drivers {
+func (x *Db_ListTokuisakiHinmeiRequest) GetTokuisakiC() string {
 	if x != nil {
-		return x.Drivers
+		return x.TokuisakiC
 	}
-	return nil
+	return ""
 }
 
-type Db_ListDriversResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*Db_Drivers          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+func (x *Db_ListTokuisakiHinmeiRequest) GetTokuisakiH() string {
+	if x != nil {
+		return x.TokuisakiH
+	}
+	return ""
+}
+
+type Db_ListTokuisakiHinmeiResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Items         []*Db_TokuisakiHinmeiMaster `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_ListDriversResponse) Reset() {
-	*x = Db_ListDriversResponse{}
-	mi := &file_db_service_proto_msgTypes[74]
+func (x *Db_ListTokuisakiHinmeiResponse) Reset() {
+	*x = Db_ListTokuisakiHinmeiResponse{}
+	mi := &file_db_service_proto_msgTypes[152]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ListDriversResponse) String() string {
+func (x *Db_ListTokuisakiHinmeiResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_ListDriversResponse) ProtoMessage() {}
+func (*Db_ListTokuisakiHinmeiResponse) ProtoMessage() {}
 
-func (x *Db_ListDriversResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[74]
+func (x *Db_ListTokuisakiHinmeiResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[152]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5228,153 +12873,43 @@ func (x *Db_ListDriversResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_ListDriversResponse.ProtoReflect.Descriptor instead.
-func (*Db_ListDriversResponse) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use Db_ListTokuisakiHinmeiResponse.ProtoReflect.Descriptor instead.
+func (*Db_ListTokuisakiHinmeiResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{152}
 }
 
-func (x *Db_ListDriversResponse) GetItems() []*Db_Drivers {
+func (x *Db_ListTokuisakiHinmeiResponse) GetItems() []*Db_TokuisakiHinmeiMaster {
 	if x != nil {
 		return x.Items
 	}
 	return nil
 }
 
-func (x *Db_ListDriversResponse) GetTotalCount() int32 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
-}
-
-// db_UntenNippoMeisai メッセージ（106カラム全て）
-type Db_UntenNippoMeisai struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	NippoK                string                 `protobuf:"bytes,1,opt,name=nippo_k,json=nippoK,proto3" json:"nippo_k,omitempty"`
-	UnkoNengappi          *string                `protobuf:"bytes,2,opt,name=unko_nengappi,json=unkoNengappi,proto3,oneof" json:"unko_nengappi,omitempty"`
-	HaishaK               string                 `protobuf:"bytes,3,opt,name=haisha_k,json=haishaK,proto3" json:"haisha_k,omitempty"`
-	SharyoC               string                 `protobuf:"bytes,4,opt,name=sharyo_c,json=sharyoC,proto3" json:"sharyo_c,omitempty"`
-	SharyoH               string                 `protobuf:"bytes,5,opt,name=sharyo_h,json=sharyoH,proto3" json:"sharyo_h,omitempty"`
-	UntenshuC             string                 `protobuf:"bytes,6,opt,name=untenshu_c,json=untenshuC,proto3" json:"untenshu_c,omitempty"`
-	YoshasakiC            string                 `protobuf:"bytes,7,opt,name=yoshasaki_c,json=yoshasakiC,proto3" json:"yoshasaki_c,omitempty"`
-	YoshasakiH            string                 `protobuf:"bytes,8,opt,name=yoshasaki_h,json=yoshasakiH,proto3" json:"yoshasaki_h,omitempty"`
-	Joshu1                string                 `protobuf:"bytes,9,opt,name=joshu1,proto3" json:"joshu1,omitempty"`
-	Joshu2                string                 `protobuf:"bytes,10,opt,name=joshu2,proto3" json:"joshu2,omitempty"`
-	NyuryokuNengappi      *string                `protobuf:"bytes,11,opt,name=nyuryoku_nengappi,json=nyuryokuNengappi,proto3,oneof" json:"nyuryoku_nengappi,omitempty"`
-	ShokaiNengappi        *string                `protobuf:"bytes,12,opt,name=shokai_nengappi,json=shokaiNengappi,proto3,oneof" json:"shokai_nengappi,omitempty"`
-	NyuryokuTantoC        string                 `protobuf:"bytes,13,opt,name=nyuryoku_tanto_c,json=nyuryokuTantoC,proto3" json:"nyuryoku_tanto_c,omitempty"`
-	JuchuNengappi         *string                `protobuf:"bytes,14,opt,name=juchu_nengappi,json=juchuNengappi,proto3,oneof" json:"juchu_nengappi,omitempty"`
-	KanriNengappi         string                 `protobuf:"bytes,15,opt,name=kanri_nengappi,json=kanriNengappi,proto3" json:"kanri_nengappi,omitempty"`
-	KanriC                int32                  `protobuf:"varint,16,opt,name=kanri_c,json=kanriC,proto3" json:"kanri_c,omitempty"`
-	TsumikomiNengappi     *string                `protobuf:"bytes,17,opt,name=tsumikomi_nengappi,json=tsumikomiNengappi,proto3,oneof" json:"tsumikomi_nengappi,omitempty"`
-	NoninNengappi         *string                `protobuf:"bytes,18,opt,name=nonin_nengappi,json=noninNengappi,proto3,oneof" json:"nonin_nengappi,omitempty"`
-	TsumoiK               *string                `protobuf:"bytes,19,opt,name=tsumoi_k,json=tsumoiK,proto3,oneof" json:"tsumoi_k,omitempty"`
-	ShashuC               string                 `protobuf:"bytes,20,opt,name=shashu_c,json=shashuC,proto3" json:"shashu_c,omitempty"`
-	JuchuBumon            string                 `protobuf:"bytes,21,opt,name=juchu_bumon,json=juchuBumon,proto3" json:"juchu_bumon,omitempty"`
-	KadoBumon             string                 `protobuf:"bytes,22,opt,name=kado_bumon,json=kadoBumon,proto3" json:"kado_bumon,omitempty"`
-	UriageK               string                 `protobuf:"bytes,23,opt,name=uriage_k,json=uriageK,proto3" json:"uriage_k,omitempty"`
-	UriageShubekiC        string                 `protobuf:"bytes,24,opt,name=uriage_shubeki_c,json=uriageShubekiC,proto3" json:"uriage_shubeki_c,omitempty"`
-	SeikyuK               string                 `protobuf:"bytes,25,opt,name=seikyu_k,json=seikyuK,proto3" json:"seikyu_k,omitempty"`
-	DenpyoNo              *string                `protobuf:"bytes,26,opt,name=denpyo_no,json=denpyoNo,proto3,oneof" json:"denpyo_no,omitempty"`
-	TokuisakiC            string                 `protobuf:"bytes,27,opt,name=tokuisaki_c,json=tokuisakiC,proto3" json:"tokuisaki_c,omitempty"`
-	TokuisakiH            string                 `protobuf:"bytes,28,opt,name=tokuisaki_h,json=tokuisakiH,proto3" json:"tokuisaki_h,omitempty"`
-	HatchiC               string                 `protobuf:"bytes,29,opt,name=hatchi_c,json=hatchiC,proto3" json:"hatchi_c,omitempty"`
-	HatchiN               *string                `protobuf:"bytes,30,opt,name=hatchi_n,json=hatchiN,proto3,oneof" json:"hatchi_n,omitempty"`
-	ChakuchiC             string                 `protobuf:"bytes,31,opt,name=chakuchi_c,json=chakuchiC,proto3" json:"chakuchi_c,omitempty"`
-	ChakuchiN             *string                `protobuf:"bytes,32,opt,name=chakuchi_n,json=chakuchiN,proto3,oneof" json:"chakuchi_n,omitempty"`
-	HinmeiC               string                 `protobuf:"bytes,33,opt,name=hinmei_c,json=hinmeiC,proto3" json:"hinmei_c,omitempty"`
-	HinmeiH               string                 `protobuf:"bytes,34,opt,name=hinmei_h,json=hinmeiH,proto3" json:"hinmei_h,omitempty"`
-	HinmeiN               *string                `protobuf:"bytes,35,opt,name=hinmei_n,json=hinmeiN,proto3,oneof" json:"hinmei_n,omitempty"`
-	SokoKm                float64                `protobuf:"fixed64,36,opt,name=soko_km,json=sokoKm,proto3" json:"soko_km,omitempty"`
-	JisshaKm              float64                `protobuf:"fixed64,37,opt,name=jissha_km,json=jisshaKm,proto3" json:"jissha_km,omitempty"`
-	SeikyuSokoKm          float64                `protobuf:"fixed64,38,opt,name=seikyu_soko_km,json=seikyuSokoKm,proto3" json:"seikyu_soko_km,omitempty"`
-	SeikyuJisshaKm        float64                `protobuf:"fixed64,39,opt,name=seikyu_jissha_km,json=seikyuJisshaKm,proto3" json:"seikyu_jissha_km,omitempty"`
-	Suryo                 float64                `protobuf:"fixed64,40,opt,name=suryo,proto3" json:"suryo,omitempty"`
-	Tani                  *string                `protobuf:"bytes,41,opt,name=tani,proto3,oneof" json:"tani,omitempty"`
-	TonSu                 float64                `protobuf:"fixed64,42,opt,name=ton_su,json=tonSu,proto3" json:"ton_su,omitempty"`
-	Tanka                 float64                `protobuf:"fixed64,43,opt,name=tanka,proto3" json:"tanka,omitempty"`
-	Kingaku               int32                  `protobuf:"varint,44,opt,name=kingaku,proto3" json:"kingaku,omitempty"`
-	Nebiki                int32                  `protobuf:"varint,45,opt,name=nebiki,proto3" json:"nebiki,omitempty"`
-	Warimashi             int32                  `protobuf:"varint,46,opt,name=warimashi,proto3" json:"warimashi,omitempty"`
-	Jippi                 int32                  `protobuf:"varint,47,opt,name=jippi,proto3" json:"jippi,omitempty"`
-	YoshaTanka            float64                `protobuf:"fixed64,48,opt,name=yosha_tanka,json=yoshaTanka,proto3" json:"yosha_tanka,omitempty"`
-	YoshaKingaku          int32                  `protobuf:"varint,49,opt,name=yosha_kingaku,json=yoshaKingaku,proto3" json:"yosha_kingaku,omitempty"`
-	YoshaNebiki           int32                  `protobuf:"varint,50,opt,name=yosha_nebiki,json=yoshaNebiki,proto3" json:"yosha_nebiki,omitempty"`
-	YoshaWarimashi        int32                  `protobuf:"varint,51,opt,name=yosha_warimashi,json=yoshaWarimashi,proto3" json:"yosha_warimashi,omitempty"`
-	YoshaJippi            int32                  `protobuf:"varint,52,opt,name=yosha_jippi,json=yoshaJippi,proto3" json:"yosha_jippi,omitempty"`
-	KakuteiK              string                 `protobuf:"bytes,53,opt,name=kakutei_k,json=kakuteiK,proto3" json:"kakutei_k,omitempty"`
-	Biko                  *string                `protobuf:"bytes,54,opt,name=biko,proto3,oneof" json:"biko,omitempty"`
-	UriageNengappi        *string                `protobuf:"bytes,55,opt,name=uriage_nengappi,json=uriageNengappi,proto3,oneof" json:"uriage_nengappi,omitempty"`
-	ShiharaiNengappi      *string                `protobuf:"bytes,56,opt,name=shiharai_nengappi,json=shiharaiNengappi,proto3,oneof" json:"shiharai_nengappi,omitempty"`
-	NyukinYoteibi         *string                `protobuf:"bytes,57,opt,name=nyukin_yoteibi,json=nyukinYoteibi,proto3,oneof" json:"nyukin_yoteibi,omitempty"`
-	ShiharaiYoteibi       *string                `protobuf:"bytes,58,opt,name=shiharai_yoteibi,json=shiharaiYoteibi,proto3,oneof" json:"shiharai_yoteibi,omitempty"`
-	Shohizei              int32                  `protobuf:"varint,59,opt,name=shohizei,proto3" json:"shohizei,omitempty"`
-	YoshaShohizei         int32                  `protobuf:"varint,60,opt,name=yosha_shohizei,json=yoshaShohizei,proto3" json:"yosha_shohizei,omitempty"`
-	Zeigaku               int32                  `protobuf:"varint,61,opt,name=zeigaku,proto3" json:"zeigaku,omitempty"`
-	WarimashiZeigaku      int32                  `protobuf:"varint,62,opt,name=warimashi_zeigaku,json=warimashiZeigaku,proto3" json:"warimashi_zeigaku,omitempty"`
-	JippiZeigaku          int32                  `protobuf:"varint,63,opt,name=jippi_zeigaku,json=jippiZeigaku,proto3" json:"jippi_zeigaku,omitempty"`
-	YoshaZeigaku          int32                  `protobuf:"varint,64,opt,name=yosha_zeigaku,json=yoshaZeigaku,proto3" json:"yosha_zeigaku,omitempty"`
-	YoshaWarimashiZeigaku int32                  `protobuf:"varint,65,opt,name=yosha_warimashi_zeigaku,json=yoshaWarimashiZeigaku,proto3" json:"yosha_warimashi_zeigaku,omitempty"`
-	YoshaJippiZeigaku     int32                  `protobuf:"varint,66,opt,name=yosha_jippi_zeigaku,json=yoshaJippiZeigaku,proto3" json:"yosha_jippi_zeigaku,omitempty"`
-	ZeinukiKingaku        int32                  `protobuf:"varint,67,opt,name=zeinuki_kingaku,json=zeinukiKingaku,proto3" json:"zeinuki_kingaku,omitempty"`
-	ZeinukiWarimashi      int32                  `protobuf:"varint,68,opt,name=zeinuki_warimashi,json=zeinukiWarimashi,proto3" json:"zeinuki_warimashi,omitempty"`
-	ZeinukiJippi          int32                  `protobuf:"varint,69,opt,name=zeinuki_jippi,json=zeinukiJippi,proto3" json:"zeinuki_jippi,omitempty"`
-	ZeinukiYoshaKingaku   int32                  `protobuf:"varint,70,opt,name=zeinuki_yosha_kingaku,json=zeinukiYoshaKingaku,proto3" json:"zeinuki_yosha_kingaku,omitempty"`
-	ZeinukiYoshaWarimashi int32                  `protobuf:"varint,71,opt,name=zeinuki_yosha_warimashi,json=zeinukiYoshaWarimashi,proto3" json:"zeinuki_yosha_warimashi,omitempty"`
-	ZeinukiYoshaJippi     int32                  `protobuf:"varint,72,opt,name=zeinuki_yosha_jippi,json=zeinukiYoshaJippi,proto3" json:"zeinuki_yosha_jippi,omitempty"`
-	ZeiK                  string                 `protobuf:"bytes,73,opt,name=zei_k,json=zeiK,proto3" json:"zei_k,omitempty"`
-	YoshaZeiK             string                 `protobuf:"bytes,74,opt,name=yosha_zei_k,json=yoshaZeiK,proto3" json:"yosha_zei_k,omitempty"`
-	Zeiritsu              float64                `protobuf:"fixed64,75,opt,name=zeiritsu,proto3" json:"zeiritsu,omitempty"`
-	YoshaZeiritsu         float64                `protobuf:"fixed64,76,opt,name=yosha_zeiritsu,json=yoshaZeiritsu,proto3" json:"yosha_zeiritsu,omitempty"`
-	UntenshuUriage        int32                  `protobuf:"varint,77,opt,name=untenshu_uriage,json=untenshuUriage,proto3" json:"untenshu_uriage,omitempty"`
-	Joshu1Uriage          int32                  `protobuf:"varint,78,opt,name=joshu1_uriage,json=joshu1Uriage,proto3" json:"joshu1_uriage,omitempty"`
-	Joshu2Uriage          int32                  `protobuf:"varint,79,opt,name=joshu2_uriage,json=joshu2Uriage,proto3" json:"joshu2_uriage,omitempty"`
-	YusoShunyuFurikae     int32                  `protobuf:"varint,80,opt,name=yuso_shunyu_furikae,json=yusoShunyuFurikae,proto3" json:"yuso_shunyu_furikae,omitempty"`
-	UriageKingakuFurikae  int32                  `protobuf:"varint,81,opt,name=uriage_kingaku_furikae,json=uriageKingakuFurikae,proto3" json:"uriage_kingaku_furikae,omitempty"`
-	KazeiUriage           int32                  `protobuf:"varint,82,opt,name=kazei_uriage,json=kazeiUriage,proto3" json:"kazei_uriage,omitempty"`
-	HikazeiUriage         int32                  `protobuf:"varint,83,opt,name=hikazei_uriage,json=hikazeiUriage,proto3" json:"hikazei_uriage,omitempty"`
-	KazeiShiharai         int32                  `protobuf:"varint,84,opt,name=kazei_shiharai,json=kazeiShiharai,proto3" json:"kazei_shiharai,omitempty"`
-	HikazeiShiharai       int32                  `protobuf:"varint,85,opt,name=hikazei_shiharai,json=hikazeiShiharai,proto3" json:"hikazei_shiharai,omitempty"`
-	HatchiikiC            string                 `protobuf:"bytes,86,opt,name=hatchiiki_c,json=hatchiikiC,proto3" json:"hatchiiki_c,omitempty"`
-	ChakuchiikiC          string                 `protobuf:"bytes,87,opt,name=chakuchiiki_c,json=chakuchiikiC,proto3" json:"chakuchiiki_c,omitempty"`
-	Rikunkyoku            *string                `protobuf:"bytes,88,opt,name=rikunkyoku,proto3,oneof" json:"rikunkyoku,omitempty"`
-	Yoseki                *float64               `protobuf:"fixed64,89,opt,name=yoseki,proto3,oneof" json:"yoseki,omitempty"`
-	JuryoKakuninTenpushoK string                 `protobuf:"bytes,90,opt,name=juryo_kakunin_tenpusho_k,json=juryoKakuninTenpushoK,proto3" json:"juryo_kakunin_tenpusho_k,omitempty"`
-	SaishuKoshin          *string                `protobuf:"bytes,91,opt,name=saishu_koshin,json=saishuKoshin,proto3,oneof" json:"saishu_koshin,omitempty"`
-	Lc                    int32                  `protobuf:"varint,92,opt,name=lc,proto3" json:"lc,omitempty"`
-	KoguchiK              *string                `protobuf:"bytes,93,opt,name=koguchi_k,json=koguchiK,proto3,oneof" json:"koguchi_k,omitempty"`
-	UnsoGaishaN           *string                `protobuf:"bytes,94,opt,name=unso_gaisha_n,json=unsoGaishaN,proto3,oneof" json:"unso_gaisha_n,omitempty"`
-	JomuinN               *string                `protobuf:"bytes,95,opt,name=jomuin_n,json=jomuinN,proto3,oneof" json:"jomuin_n,omitempty"`
-	Renrakusaki           *string                `protobuf:"bytes,96,opt,name=renrakusaki,proto3,oneof" json:"renrakusaki,omitempty"`
-	TaniJuryo             *float64               `protobuf:"fixed64,97,opt,name=tani_juryo,json=taniJuryo,proto3,oneof" json:"tani_juryo,omitempty"`
-	ShiharaiKakuteiK      string                 `protobuf:"bytes,98,opt,name=shiharai_kakutei_k,json=shiharaiKakuteiK,proto3" json:"shiharai_kakutei_k,omitempty"`
-	Biko2                 *string                `protobuf:"bytes,99,opt,name=biko2,proto3,oneof" json:"biko2,omitempty"`
-	Yobi1                 *float64               `protobuf:"fixed64,100,opt,name=yobi1,proto3,oneof" json:"yobi1,omitempty"`
-	Yobi2                 *float64               `protobuf:"fixed64,101,opt,name=yobi2,proto3,oneof" json:"yobi2,omitempty"`
-	Yobi3                 *string                `protobuf:"bytes,102,opt,name=yobi3,proto3,oneof" json:"yobi3,omitempty"`
-	Yobi4                 *string                `protobuf:"bytes,103,opt,name=yobi4,proto3,oneof" json:"yobi4,omitempty"`
-	Yobi5                 *string                `protobuf:"bytes,104,opt,name=yobi5,proto3,oneof" json:"yobi5,omitempty"`
-	Yobi6                 *string                `protobuf:"bytes,105,opt,name=yobi6,proto3,oneof" json:"yobi6,omitempty"`
-	Yobi7                 *string                `protobuf:"bytes,106,opt,name=yobi7,proto3,oneof" json:"yobi7,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type Db_GetTokuisakiHinmeiRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TokuisakiC    string                 `protobuf:"bytes,1,opt,name=tokuisaki_c,json=tokuisakiC,proto3" json:"tokuisaki_c,omitempty"`
+	TokuisakiH    string                 `protobuf:"bytes,2,opt,name=tokuisaki_h,json=tokuisakiH,proto3" json:"tokuisaki_h,omitempty"`
+	HinmeiC       string                 `protobuf:"bytes,3,opt,name=hinmei_c,json=hinmeiC,proto3" json:"hinmei_c,omitempty"`
+	HinmeiH       string                 `protobuf:"bytes,4,opt,name=hinmei_h,json=hinmeiH,proto3" json:"hinmei_h,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_UntenNippoMeisai) Reset() {
-	*x = Db_UntenNippoMeisai{}
-	mi := &file_db_service_proto_msgTypes[75]
+func (x *Db_GetTokuisakiHinmeiRequest) Reset() {
+	*x = Db_GetTokuisakiHinmeiRequest{}
+	mi := &file_db_service_proto_msgTypes[153]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_UntenNippoMeisai) String() string {
+func (x *Db_GetTokuisakiHinmeiRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_UntenNippoMeisai) ProtoMessage() {}
+func (*Db_GetTokuisakiHinmeiRequest) ProtoMessage() {}
 
-func (x *Db_UntenNippoMeisai) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[75]
+func (x *Db_GetTokuisakiHinmeiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[153]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5385,818 +12920,1225 @@ func (x *Db_UntenNippoMeisai) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_UntenNippoMeisai.ProtoReflect.Descriptor instead.
-func (*Db_UntenNippoMeisai) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use Db_GetTokuisakiHinmeiRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetTokuisakiHinmeiRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{153}
 }
 
-func (x *Db_UntenNippoMeisai) GetNippoK() string {
+func (x *Db_GetTokuisakiHinmeiRequest) GetTokuisakiC() string {
 	if x != nil {
-		return x.NippoK
+		return x.TokuisakiC
 	}
 	return ""
 }
 
-func (x *Db_UntenNippoMeisai) GetUnkoNengappi() string {
-	if x != nil && x.UnkoNengappi != nil {
-		return *x.UnkoNengappi
+func (x *Db_GetTokuisakiHinmeiRequest) GetTokuisakiH() string {
+	if x != nil {
+		return x.TokuisakiH
 	}
 	return ""
 }
 
-func (x *Db_UntenNippoMeisai) GetHaishaK() string {
+func (x *Db_GetTokuisakiHinmeiRequest) GetHinmeiC() string {
 	if x != nil {
-		return x.HaishaK
+		return x.HinmeiC
 	}
 	return ""
 }
 
-func (x *Db_UntenNippoMeisai) GetSharyoC() string {
+func (x *Db_GetTokuisakiHinmeiRequest) GetHinmeiH() string {
 	if x != nil {
-		return x.SharyoC
+		return x.HinmeiH
 	}
 	return ""
 }
 
-func (x *Db_UntenNippoMeisai) GetSharyoH() string {
-	if x != nil {
-		return x.SharyoH
-	}
-	return ""
+type Db_TokuisakiHinmeiResponse struct {
+	state           protoimpl.MessageState    `protogen:"open.v1"`
+	TokuisakiHinmei *Db_TokuisakiHinmeiMaster `protobuf:"bytes,1,opt,name=tokuisaki_hinmei,json=tokuisakiHinmei,proto3" json:"tokuisaki_hinmei,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *Db_UntenNippoMeisai) GetUntenshuC() string {
-	if x != nil {
-		return x.UntenshuC
-	}
-	return ""
+func (x *Db_TokuisakiHinmeiResponse) Reset() {
+	*x = Db_TokuisakiHinmeiResponse{}
+	mi := &file_db_service_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_UntenNippoMeisai) GetYoshasakiC() string {
-	if x != nil {
-		return x.YoshasakiC
-	}
-	return ""
+func (x *Db_TokuisakiHinmeiResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Db_UntenNippoMeisai) GetYoshasakiH() string {
+func (*Db_TokuisakiHinmeiResponse) ProtoMessage() {}
+
+func (x *Db_TokuisakiHinmeiResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[154]
 	if x != nil {
-		return x.YoshasakiH
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Db_UntenNippoMeisai) GetJoshu1() string {
+// Deprecated: Use Db_TokuisakiHinmeiResponse.ProtoReflect.Descriptor instead.
+func (*Db_TokuisakiHinmeiResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *Db_TokuisakiHinmeiResponse) GetTokuisakiHinmei() *Db_TokuisakiHinmeiMaster {
 	if x != nil {
-		return x.Joshu1
+		return x.TokuisakiHinmei
 	}
-	return ""
+	return nil
 }
 
-func (x *Db_UntenNippoMeisai) GetJoshu2() string {
+type Db_ResolveHinmeiRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TokuisakiC    string                 `protobuf:"bytes,1,opt,name=tokuisaki_c,json=tokuisakiC,proto3" json:"tokuisaki_c,omitempty"`
+	TokuisakiH    string                 `protobuf:"bytes,2,opt,name=tokuisaki_h,json=tokuisakiH,proto3" json:"tokuisaki_h,omitempty"`
+	HinmeiC       string                 `protobuf:"bytes,3,opt,name=hinmei_c,json=hinmeiC,proto3" json:"hinmei_c,omitempty"`
+	HinmeiH       string                 `protobuf:"bytes,4,opt,name=hinmei_h,json=hinmeiH,proto3" json:"hinmei_h,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_ResolveHinmeiRequest) Reset() {
+	*x = Db_ResolveHinmeiRequest{}
+	mi := &file_db_service_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_ResolveHinmeiRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_ResolveHinmeiRequest) ProtoMessage() {}
+
+func (x *Db_ResolveHinmeiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[155]
 	if x != nil {
-		return x.Joshu2
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Db_UntenNippoMeisai) GetNyuryokuNengappi() string {
-	if x != nil && x.NyuryokuNengappi != nil {
-		return *x.NyuryokuNengappi
-	}
-	return ""
+// Deprecated: Use Db_ResolveHinmeiRequest.ProtoReflect.Descriptor instead.
+func (*Db_ResolveHinmeiRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{155}
 }
 
-func (x *Db_UntenNippoMeisai) GetShokaiNengappi() string {
-	if x != nil && x.ShokaiNengappi != nil {
-		return *x.ShokaiNengappi
+func (x *Db_ResolveHinmeiRequest) GetTokuisakiC() string {
+	if x != nil {
+		return x.TokuisakiC
 	}
 	return ""
 }
 
-func (x *Db_UntenNippoMeisai) GetNyuryokuTantoC() string {
+func (x *Db_ResolveHinmeiRequest) GetTokuisakiH() string {
 	if x != nil {
-		return x.NyuryokuTantoC
+		return x.TokuisakiH
 	}
 	return ""
 }
 
-func (x *Db_UntenNippoMeisai) GetJuchuNengappi() string {
-	if x != nil && x.JuchuNengappi != nil {
-		return *x.JuchuNengappi
+func (x *Db_ResolveHinmeiRequest) GetHinmeiC() string {
+	if x != nil {
+		return x.HinmeiC
 	}
 	return ""
 }
 
-func (x *Db_UntenNippoMeisai) GetKanriNengappi() string {
+func (x *Db_ResolveHinmeiRequest) GetHinmeiH() string {
 	if x != nil {
-		return x.KanriNengappi
+		return x.HinmeiH
 	}
 	return ""
 }
 
-func (x *Db_UntenNippoMeisai) GetKanriC() int32 {
-	if x != nil {
-		return x.KanriC
-	}
-	return 0
+type Db_ResolveHinmeiResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TokuisakiC        string                 `protobuf:"bytes,1,opt,name=tokuisaki_c,json=tokuisakiC,proto3" json:"tokuisaki_c,omitempty"`
+	TokuisakiH        string                 `protobuf:"bytes,2,opt,name=tokuisaki_h,json=tokuisakiH,proto3" json:"tokuisaki_h,omitempty"`
+	HinmeiC           string                 `protobuf:"bytes,3,opt,name=hinmei_c,json=hinmeiC,proto3" json:"hinmei_c,omitempty"`
+	HinmeiH           string                 `protobuf:"bytes,4,opt,name=hinmei_h,json=hinmeiH,proto3" json:"hinmei_h,omitempty"`
+	HinmeiN           *string                `protobuf:"bytes,5,opt,name=hinmei_n,json=hinmeiN,proto3,oneof" json:"hinmei_n,omitempty"`
+	Tani              *string                `protobuf:"bytes,6,opt,name=tani,proto3,oneof" json:"tani,omitempty"`
+	TaniJuryo         *float64               `protobuf:"fixed64,7,opt,name=tani_juryo,json=taniJuryo,proto3,oneof" json:"tani_juryo,omitempty"`
+	TokuisakiSpecific bool                   `protobuf:"varint,8,opt,name=tokuisaki_specific,json=tokuisakiSpecific,proto3" json:"tokuisaki_specific,omitempty"` // 得意先別品名マスタの登録がある
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *Db_UntenNippoMeisai) GetTsumikomiNengappi() string {
-	if x != nil && x.TsumikomiNengappi != nil {
-		return *x.TsumikomiNengappi
-	}
-	return ""
+func (x *Db_ResolveHinmeiResponse) Reset() {
+	*x = Db_ResolveHinmeiResponse{}
+	mi := &file_db_service_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_UntenNippoMeisai) GetNoninNengappi() string {
-	if x != nil && x.NoninNengappi != nil {
-		return *x.NoninNengappi
+func (x *Db_ResolveHinmeiResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_ResolveHinmeiResponse) ProtoMessage() {}
+
+func (x *Db_ResolveHinmeiResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Db_UntenNippoMeisai) GetTsumoiK() string {
-	if x != nil && x.TsumoiK != nil {
-		return *x.TsumoiK
-	}
-	return ""
+// Deprecated: Use Db_ResolveHinmeiResponse.ProtoReflect.Descriptor instead.
+func (*Db_ResolveHinmeiResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{156}
 }
 
-func (x *Db_UntenNippoMeisai) GetShashuC() string {
+func (x *Db_ResolveHinmeiResponse) GetTokuisakiC() string {
 	if x != nil {
-		return x.ShashuC
+		return x.TokuisakiC
 	}
 	return ""
 }
 
-func (x *Db_UntenNippoMeisai) GetJuchuBumon() string {
+func (x *Db_ResolveHinmeiResponse) GetTokuisakiH() string {
 	if x != nil {
-		return x.JuchuBumon
+		return x.TokuisakiH
 	}
 	return ""
 }
 
-func (x *Db_UntenNippoMeisai) GetKadoBumon() string {
+func (x *Db_ResolveHinmeiResponse) GetHinmeiC() string {
 	if x != nil {
-		return x.KadoBumon
+		return x.HinmeiC
 	}
 	return ""
 }
 
-func (x *Db_UntenNippoMeisai) GetUriageK() string {
+func (x *Db_ResolveHinmeiResponse) GetHinmeiH() string {
 	if x != nil {
-		return x.UriageK
+		return x.HinmeiH
 	}
 	return ""
 }
 
-func (x *Db_UntenNippoMeisai) GetUriageShubekiC() string {
-	if x != nil {
-		return x.UriageShubekiC
+func (x *Db_ResolveHinmeiResponse) GetHinmeiN() string {
+	if x != nil && x.HinmeiN != nil {
+		return *x.HinmeiN
 	}
 	return ""
 }
 
-func (x *Db_UntenNippoMeisai) GetSeikyuK() string {
-	if x != nil {
-		return x.SeikyuK
+func (x *Db_ResolveHinmeiResponse) GetTani() string {
+	if x != nil && x.Tani != nil {
+		return *x.Tani
 	}
 	return ""
 }
 
-func (x *Db_UntenNippoMeisai) GetDenpyoNo() string {
-	if x != nil && x.DenpyoNo != nil {
-		return *x.DenpyoNo
+func (x *Db_ResolveHinmeiResponse) GetTaniJuryo() float64 {
+	if x != nil && x.TaniJuryo != nil {
+		return *x.TaniJuryo
 	}
-	return ""
+	return 0
 }
 
-func (x *Db_UntenNippoMeisai) GetTokuisakiC() string {
+func (x *Db_ResolveHinmeiResponse) GetTokuisakiSpecific() bool {
 	if x != nil {
-		return x.TokuisakiC
+		return x.TokuisakiSpecific
 	}
-	return ""
+	return false
 }
 
-func (x *Db_UntenNippoMeisai) GetTokuisakiH() string {
+// BumonMaster用リクエスト/レスポンス
+type Db_BumonMaster struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BumonC        string                 `protobuf:"bytes,1,opt,name=bumon_c,json=bumonC,proto3" json:"bumon_c,omitempty"`
+	BumonN        *string                `protobuf:"bytes,2,opt,name=bumon_n,json=bumonN,proto3,oneof" json:"bumon_n,omitempty"`
+	BumonR        *string                `protobuf:"bytes,3,opt,name=bumon_r,json=bumonR,proto3,oneof" json:"bumon_r,omitempty"`
+	BumonF        *string                `protobuf:"bytes,4,opt,name=bumon_f,json=bumonF,proto3,oneof" json:"bumon_f,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_BumonMaster) Reset() {
+	*x = Db_BumonMaster{}
+	mi := &file_db_service_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_BumonMaster) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_BumonMaster) ProtoMessage() {}
+
+func (x *Db_BumonMaster) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[157]
 	if x != nil {
-		return x.TokuisakiH
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Db_UntenNippoMeisai) GetHatchiC() string {
+// Deprecated: Use Db_BumonMaster.ProtoReflect.Descriptor instead.
+func (*Db_BumonMaster) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *Db_BumonMaster) GetBumonC() string {
 	if x != nil {
-		return x.HatchiC
+		return x.BumonC
 	}
 	return ""
 }
 
-func (x *Db_UntenNippoMeisai) GetHatchiN() string {
-	if x != nil && x.HatchiN != nil {
-		return *x.HatchiN
+func (x *Db_BumonMaster) GetBumonN() string {
+	if x != nil && x.BumonN != nil {
+		return *x.BumonN
 	}
 	return ""
 }
 
-func (x *Db_UntenNippoMeisai) GetChakuchiC() string {
-	if x != nil {
-		return x.ChakuchiC
+func (x *Db_BumonMaster) GetBumonR() string {
+	if x != nil && x.BumonR != nil {
+		return *x.BumonR
 	}
 	return ""
 }
 
-func (x *Db_UntenNippoMeisai) GetChakuchiN() string {
-	if x != nil && x.ChakuchiN != nil {
-		return *x.ChakuchiN
+func (x *Db_BumonMaster) GetBumonF() string {
+	if x != nil && x.BumonF != nil {
+		return *x.BumonF
 	}
 	return ""
 }
 
-func (x *Db_UntenNippoMeisai) GetHinmeiC() string {
+type Db_GetBumonMasterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BumonC        string                 `protobuf:"bytes,1,opt,name=bumon_c,json=bumonC,proto3" json:"bumon_c,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_GetBumonMasterRequest) Reset() {
+	*x = Db_GetBumonMasterRequest{}
+	mi := &file_db_service_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_GetBumonMasterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_GetBumonMasterRequest) ProtoMessage() {}
+
+func (x *Db_GetBumonMasterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[158]
 	if x != nil {
-		return x.HinmeiC
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Db_UntenNippoMeisai) GetHinmeiH() string {
+// Deprecated: Use Db_GetBumonMasterRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetBumonMasterRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *Db_GetBumonMasterRequest) GetBumonC() string {
 	if x != nil {
-		return x.HinmeiH
+		return x.BumonC
 	}
 	return ""
 }
 
-func (x *Db_UntenNippoMeisai) GetHinmeiN() string {
-	if x != nil && x.HinmeiN != nil {
-		return *x.HinmeiN
+type Db_BumonMasterSortSpec struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Field         Db_BumonMasterSortField `protobuf:"varint,1,opt,name=field,proto3,enum=db_service.Db_BumonMasterSortField" json:"field,omitempty"`
+	Direction     Db_SortDirection        `protobuf:"varint,2,opt,name=direction,proto3,enum=db_service.Db_SortDirection" json:"direction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_BumonMasterSortSpec) Reset() {
+	*x = Db_BumonMasterSortSpec{}
+	mi := &file_db_service_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_BumonMasterSortSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_BumonMasterSortSpec) ProtoMessage() {}
+
+func (x *Db_BumonMasterSortSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[159]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Db_UntenNippoMeisai) GetSokoKm() float64 {
+// Deprecated: Use Db_BumonMasterSortSpec.ProtoReflect.Descriptor instead.
+func (*Db_BumonMasterSortSpec) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *Db_BumonMasterSortSpec) GetField() Db_BumonMasterSortField {
 	if x != nil {
-		return x.SokoKm
+		return x.Field
 	}
-	return 0
+	return Db_BumonMasterSortField_BUMON_MASTER_SORT_FIELD_UNSPECIFIED
 }
 
-func (x *Db_UntenNippoMeisai) GetJisshaKm() float64 {
+func (x *Db_BumonMasterSortSpec) GetDirection() Db_SortDirection {
 	if x != nil {
-		return x.JisshaKm
+		return x.Direction
 	}
-	return 0
+	return Db_SortDirection_SORT_DIRECTION_UNSPECIFIED
 }
 
-func (x *Db_UntenNippoMeisai) GetSeikyuSokoKm() float64 {
+type Db_ListBumonMasterRequest struct {
+	state             protoimpl.MessageState    `protogen:"open.v1"`
+	Limit             int32                     `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset            int32                     `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	OrderBy           *string                   `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3,oneof" json:"order_by,omitempty"`                            // 非推奨: sortを使用（ソート可能なprotoのフィールド名のみ指定可）
+	PageToken         *string                   `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`                      // キーセットページネーション用トークン（指定時はoffset/order_byは使用不可、初回は空文字）
+	IncludeTotalCount bool                      `protobuf:"varint,5,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"` // page_token使用時もtotal_countを取得する（COUNT(*)を実行）
+	Sort              []*Db_BumonMasterSortSpec `protobuf:"bytes,6,rep,name=sort,proto3" json:"sort,omitempty"`                                                       // ソート条件（複数指定可）
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Db_ListBumonMasterRequest) Reset() {
+	*x = Db_ListBumonMasterRequest{}
+	mi := &file_db_service_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_ListBumonMasterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_ListBumonMasterRequest) ProtoMessage() {}
+
+func (x *Db_ListBumonMasterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[160]
 	if x != nil {
-		return x.SeikyuSokoKm
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Db_UntenNippoMeisai) GetSeikyuJisshaKm() float64 {
+// Deprecated: Use Db_ListBumonMasterRequest.ProtoReflect.Descriptor instead.
+func (*Db_ListBumonMasterRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *Db_ListBumonMasterRequest) GetLimit() int32 {
 	if x != nil {
-		return x.SeikyuJisshaKm
+		return x.Limit
 	}
 	return 0
 }
 
-func (x *Db_UntenNippoMeisai) GetSuryo() float64 {
+func (x *Db_ListBumonMasterRequest) GetOffset() int32 {
 	if x != nil {
-		return x.Suryo
+		return x.Offset
 	}
 	return 0
 }
 
-func (x *Db_UntenNippoMeisai) GetTani() string {
-	if x != nil && x.Tani != nil {
-		return *x.Tani
+func (x *Db_ListBumonMasterRequest) GetOrderBy() string {
+	if x != nil && x.OrderBy != nil {
+		return *x.OrderBy
 	}
 	return ""
 }
 
-func (x *Db_UntenNippoMeisai) GetTonSu() float64 {
-	if x != nil {
-		return x.TonSu
+func (x *Db_ListBumonMasterRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_UntenNippoMeisai) GetTanka() float64 {
+func (x *Db_ListBumonMasterRequest) GetIncludeTotalCount() bool {
 	if x != nil {
-		return x.Tanka
+		return x.IncludeTotalCount
 	}
-	return 0
+	return false
 }
 
-func (x *Db_UntenNippoMeisai) GetKingaku() int32 {
+func (x *Db_ListBumonMasterRequest) GetSort() []*Db_BumonMasterSortSpec {
 	if x != nil {
-		return x.Kingaku
+		return x.Sort
 	}
-	return 0
+	return nil
 }
 
-func (x *Db_UntenNippoMeisai) GetNebiki() int32 {
+type Db_BumonMasterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BumonMaster   *Db_BumonMaster        `protobuf:"bytes,1,opt,name=bumon_master,json=bumonMaster,proto3" json:"bumon_master,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_BumonMasterResponse) Reset() {
+	*x = Db_BumonMasterResponse{}
+	mi := &file_db_service_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_BumonMasterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_BumonMasterResponse) ProtoMessage() {}
+
+func (x *Db_BumonMasterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[161]
 	if x != nil {
-		return x.Nebiki
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Db_UntenNippoMeisai) GetWarimashi() int32 {
+// Deprecated: Use Db_BumonMasterResponse.ProtoReflect.Descriptor instead.
+func (*Db_BumonMasterResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *Db_BumonMasterResponse) GetBumonMaster() *Db_BumonMaster {
 	if x != nil {
-		return x.Warimashi
+		return x.BumonMaster
 	}
-	return 0
+	return nil
 }
 
-func (x *Db_UntenNippoMeisai) GetJippi() int32 {
+type Db_ListBumonMasterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Db_BumonMaster      `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalCount    *int32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`     // page_token使用時はinclude_total_count指定時のみ設定
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 次ページのトークン（最終ページの場合は空）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_ListBumonMasterResponse) Reset() {
+	*x = Db_ListBumonMasterResponse{}
+	mi := &file_db_service_proto_msgTypes[162]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_ListBumonMasterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_ListBumonMasterResponse) ProtoMessage() {}
+
+func (x *Db_ListBumonMasterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[162]
 	if x != nil {
-		return x.Jippi
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Db_UntenNippoMeisai) GetYoshaTanka() float64 {
+// Deprecated: Use Db_ListBumonMasterResponse.ProtoReflect.Descriptor instead.
+func (*Db_ListBumonMasterResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *Db_ListBumonMasterResponse) GetItems() []*Db_BumonMaster {
 	if x != nil {
-		return x.YoshaTanka
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Db_ListBumonMasterResponse) GetTotalCount() int32 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
 	}
 	return 0
 }
 
-func (x *Db_UntenNippoMeisai) GetYoshaKingaku() int32 {
+func (x *Db_ListBumonMasterResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.YoshaKingaku
+		return x.NextPageToken
 	}
-	return 0
+	return ""
+}
+
+// SharyoMaster用リクエスト/レスポンス
+type Db_SharyoMaster struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SharyoC       string                 `protobuf:"bytes,1,opt,name=sharyo_c,json=sharyoC,proto3" json:"sharyo_c,omitempty"`
+	SharyoH       string                 `protobuf:"bytes,2,opt,name=sharyo_h,json=sharyoH,proto3" json:"sharyo_h,omitempty"`
+	SharyoN       *string                `protobuf:"bytes,3,opt,name=sharyo_n,json=sharyoN,proto3,oneof" json:"sharyo_n,omitempty"`
+	SharyoR       *string                `protobuf:"bytes,4,opt,name=sharyo_r,json=sharyoR,proto3,oneof" json:"sharyo_r,omitempty"`
+	ShashuC       *string                `protobuf:"bytes,5,opt,name=shashu_c,json=shashuC,proto3,oneof" json:"shashu_c,omitempty"`
+	BumonC        *string                `protobuf:"bytes,6,opt,name=bumon_c,json=bumonC,proto3,oneof" json:"bumon_c,omitempty"`
+	UntenshuC     *string                `protobuf:"bytes,7,opt,name=untenshu_c,json=untenshuC,proto3,oneof" json:"untenshu_c,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_SharyoMaster) Reset() {
+	*x = Db_SharyoMaster{}
+	mi := &file_db_service_proto_msgTypes[163]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_UntenNippoMeisai) GetYoshaNebiki() int32 {
-	if x != nil {
-		return x.YoshaNebiki
-	}
-	return 0
+func (x *Db_SharyoMaster) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Db_UntenNippoMeisai) GetYoshaWarimashi() int32 {
+func (*Db_SharyoMaster) ProtoMessage() {}
+
+func (x *Db_SharyoMaster) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[163]
 	if x != nil {
-		return x.YoshaWarimashi
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Db_UntenNippoMeisai) GetYoshaJippi() int32 {
+// Deprecated: Use Db_SharyoMaster.ProtoReflect.Descriptor instead.
+func (*Db_SharyoMaster) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *Db_SharyoMaster) GetSharyoC() string {
 	if x != nil {
-		return x.YoshaJippi
+		return x.SharyoC
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_UntenNippoMeisai) GetKakuteiK() string {
+func (x *Db_SharyoMaster) GetSharyoH() string {
 	if x != nil {
-		return x.KakuteiK
+		return x.SharyoH
 	}
 	return ""
 }
 
-func (x *Db_UntenNippoMeisai) GetBiko() string {
-	if x != nil && x.Biko != nil {
-		return *x.Biko
+func (x *Db_SharyoMaster) GetSharyoN() string {
+	if x != nil && x.SharyoN != nil {
+		return *x.SharyoN
 	}
 	return ""
 }
 
-func (x *Db_UntenNippoMeisai) GetUriageNengappi() string {
-	if x != nil && x.UriageNengappi != nil {
-		return *x.UriageNengappi
+func (x *Db_SharyoMaster) GetSharyoR() string {
+	if x != nil && x.SharyoR != nil {
+		return *x.SharyoR
 	}
 	return ""
 }
 
-func (x *Db_UntenNippoMeisai) GetShiharaiNengappi() string {
-	if x != nil && x.ShiharaiNengappi != nil {
-		return *x.ShiharaiNengappi
+func (x *Db_SharyoMaster) GetShashuC() string {
+	if x != nil && x.ShashuC != nil {
+		return *x.ShashuC
 	}
 	return ""
 }
 
-func (x *Db_UntenNippoMeisai) GetNyukinYoteibi() string {
-	if x != nil && x.NyukinYoteibi != nil {
-		return *x.NyukinYoteibi
+func (x *Db_SharyoMaster) GetBumonC() string {
+	if x != nil && x.BumonC != nil {
+		return *x.BumonC
 	}
 	return ""
 }
 
-func (x *Db_UntenNippoMeisai) GetShiharaiYoteibi() string {
-	if x != nil && x.ShiharaiYoteibi != nil {
-		return *x.ShiharaiYoteibi
+func (x *Db_SharyoMaster) GetUntenshuC() string {
+	if x != nil && x.UntenshuC != nil {
+		return *x.UntenshuC
 	}
 	return ""
 }
 
-func (x *Db_UntenNippoMeisai) GetShohizei() int32 {
-	if x != nil {
-		return x.Shohizei
-	}
-	return 0
+type Db_GetSharyoMasterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SharyoC       string                 `protobuf:"bytes,1,opt,name=sharyo_c,json=sharyoC,proto3" json:"sharyo_c,omitempty"`
+	SharyoH       string                 `protobuf:"bytes,2,opt,name=sharyo_h,json=sharyoH,proto3" json:"sharyo_h,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_UntenNippoMeisai) GetYoshaShohizei() int32 {
-	if x != nil {
-		return x.YoshaShohizei
-	}
-	return 0
+func (x *Db_GetSharyoMasterRequest) Reset() {
+	*x = Db_GetSharyoMasterRequest{}
+	mi := &file_db_service_proto_msgTypes[164]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_UntenNippoMeisai) GetZeigaku() int32 {
-	if x != nil {
-		return x.Zeigaku
-	}
-	return 0
+func (x *Db_GetSharyoMasterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Db_UntenNippoMeisai) GetWarimashiZeigaku() int32 {
+func (*Db_GetSharyoMasterRequest) ProtoMessage() {}
+
+func (x *Db_GetSharyoMasterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[164]
 	if x != nil {
-		return x.WarimashiZeigaku
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Db_UntenNippoMeisai) GetJippiZeigaku() int32 {
+// Deprecated: Use Db_GetSharyoMasterRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetSharyoMasterRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *Db_GetSharyoMasterRequest) GetSharyoC() string {
 	if x != nil {
-		return x.JippiZeigaku
+		return x.SharyoC
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_UntenNippoMeisai) GetYoshaZeigaku() int32 {
+func (x *Db_GetSharyoMasterRequest) GetSharyoH() string {
 	if x != nil {
-		return x.YoshaZeigaku
+		return x.SharyoH
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_UntenNippoMeisai) GetYoshaWarimashiZeigaku() int32 {
+type Db_ListSharyoMasterBySharyoCRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SharyoC       string                 `protobuf:"bytes,1,opt,name=sharyo_c,json=sharyoC,proto3" json:"sharyo_c,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_ListSharyoMasterBySharyoCRequest) Reset() {
+	*x = Db_ListSharyoMasterBySharyoCRequest{}
+	mi := &file_db_service_proto_msgTypes[165]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_ListSharyoMasterBySharyoCRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_ListSharyoMasterBySharyoCRequest) ProtoMessage() {}
+
+func (x *Db_ListSharyoMasterBySharyoCRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[165]
 	if x != nil {
-		return x.YoshaWarimashiZeigaku
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Db_UntenNippoMeisai) GetYoshaJippiZeigaku() int32 {
+// Deprecated: Use Db_ListSharyoMasterBySharyoCRequest.ProtoReflect.Descriptor instead.
+func (*Db_ListSharyoMasterBySharyoCRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *Db_ListSharyoMasterBySharyoCRequest) GetSharyoC() string {
 	if x != nil {
-		return x.YoshaJippiZeigaku
+		return x.SharyoC
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_UntenNippoMeisai) GetZeinukiKingaku() int32 {
+type Db_SharyoMasterSortSpec struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Field         Db_SharyoMasterSortField `protobuf:"varint,1,opt,name=field,proto3,enum=db_service.Db_SharyoMasterSortField" json:"field,omitempty"`
+	Direction     Db_SortDirection         `protobuf:"varint,2,opt,name=direction,proto3,enum=db_service.Db_SortDirection" json:"direction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_SharyoMasterSortSpec) Reset() {
+	*x = Db_SharyoMasterSortSpec{}
+	mi := &file_db_service_proto_msgTypes[166]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_SharyoMasterSortSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_SharyoMasterSortSpec) ProtoMessage() {}
+
+func (x *Db_SharyoMasterSortSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[166]
 	if x != nil {
-		return x.ZeinukiKingaku
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Db_UntenNippoMeisai) GetZeinukiWarimashi() int32 {
+// Deprecated: Use Db_SharyoMasterSortSpec.ProtoReflect.Descriptor instead.
+func (*Db_SharyoMasterSortSpec) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *Db_SharyoMasterSortSpec) GetField() Db_SharyoMasterSortField {
 	if x != nil {
-		return x.ZeinukiWarimashi
+		return x.Field
 	}
-	return 0
+	return Db_SharyoMasterSortField_SHARYO_MASTER_SORT_FIELD_UNSPECIFIED
 }
 
-func (x *Db_UntenNippoMeisai) GetZeinukiJippi() int32 {
+func (x *Db_SharyoMasterSortSpec) GetDirection() Db_SortDirection {
 	if x != nil {
-		return x.ZeinukiJippi
+		return x.Direction
 	}
-	return 0
+	return Db_SortDirection_SORT_DIRECTION_UNSPECIFIED
 }
 
-func (x *Db_UntenNippoMeisai) GetZeinukiYoshaKingaku() int32 {
+type Db_ListSharyoMasterRequest struct {
+	state             protoimpl.MessageState     `protogen:"open.v1"`
+	Limit             int32                      `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset            int32                      `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	OrderBy           *string                    `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3,oneof" json:"order_by,omitempty"`                            // 非推奨: sortを使用（ソート可能なprotoのフィールド名のみ指定可）
+	PageToken         *string                    `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`                      // キーセットページネーション用トークン（指定時はoffset/order_byは使用不可、初回は空文字）
+	IncludeTotalCount bool                       `protobuf:"varint,5,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"` // page_token使用時もtotal_countを取得する（COUNT(*)を実行）
+	Sort              []*Db_SharyoMasterSortSpec `protobuf:"bytes,6,rep,name=sort,proto3" json:"sort,omitempty"`                                                       // ソート条件（複数指定可）
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Db_ListSharyoMasterRequest) Reset() {
+	*x = Db_ListSharyoMasterRequest{}
+	mi := &file_db_service_proto_msgTypes[167]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_ListSharyoMasterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_ListSharyoMasterRequest) ProtoMessage() {}
+
+func (x *Db_ListSharyoMasterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[167]
 	if x != nil {
-		return x.ZeinukiYoshaKingaku
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Db_UntenNippoMeisai) GetZeinukiYoshaWarimashi() int32 {
+// Deprecated: Use Db_ListSharyoMasterRequest.ProtoReflect.Descriptor instead.
+func (*Db_ListSharyoMasterRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *Db_ListSharyoMasterRequest) GetLimit() int32 {
 	if x != nil {
-		return x.ZeinukiYoshaWarimashi
+		return x.Limit
 	}
 	return 0
 }
 
-func (x *Db_UntenNippoMeisai) GetZeinukiYoshaJippi() int32 {
+func (x *Db_ListSharyoMasterRequest) GetOffset() int32 {
 	if x != nil {
-		return x.ZeinukiYoshaJippi
+		return x.Offset
 	}
 	return 0
 }
 
-func (x *Db_UntenNippoMeisai) GetZeiK() string {
-	if x != nil {
-		return x.ZeiK
+func (x *Db_ListSharyoMasterRequest) GetOrderBy() string {
+	if x != nil && x.OrderBy != nil {
+		return *x.OrderBy
 	}
 	return ""
 }
 
-func (x *Db_UntenNippoMeisai) GetYoshaZeiK() string {
-	if x != nil {
-		return x.YoshaZeiK
+func (x *Db_ListSharyoMasterRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
 	}
 	return ""
 }
 
-func (x *Db_UntenNippoMeisai) GetZeiritsu() float64 {
+func (x *Db_ListSharyoMasterRequest) GetIncludeTotalCount() bool {
 	if x != nil {
-		return x.Zeiritsu
+		return x.IncludeTotalCount
 	}
-	return 0
+	return false
 }
 
-func (x *Db_UntenNippoMeisai) GetYoshaZeiritsu() float64 {
+func (x *Db_ListSharyoMasterRequest) GetSort() []*Db_SharyoMasterSortSpec {
 	if x != nil {
-		return x.YoshaZeiritsu
+		return x.Sort
 	}
-	return 0
+	return nil
 }
 
-func (x *Db_UntenNippoMeisai) GetUntenshuUriage() int32 {
-	if x != nil {
-		return x.UntenshuUriage
-	}
-	return 0
+type Db_SharyoMasterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SharyoMaster  *Db_SharyoMaster       `protobuf:"bytes,1,opt,name=sharyo_master,json=sharyoMaster,proto3" json:"sharyo_master,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_UntenNippoMeisai) GetJoshu1Uriage() int32 {
-	if x != nil {
-		return x.Joshu1Uriage
-	}
-	return 0
+func (x *Db_SharyoMasterResponse) Reset() {
+	*x = Db_SharyoMasterResponse{}
+	mi := &file_db_service_proto_msgTypes[168]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_UntenNippoMeisai) GetJoshu2Uriage() int32 {
-	if x != nil {
-		return x.Joshu2Uriage
-	}
-	return 0
+func (x *Db_SharyoMasterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Db_UntenNippoMeisai) GetYusoShunyuFurikae() int32 {
+func (*Db_SharyoMasterResponse) ProtoMessage() {}
+
+func (x *Db_SharyoMasterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[168]
 	if x != nil {
-		return x.YusoShunyuFurikae
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Db_UntenNippoMeisai) GetUriageKingakuFurikae() int32 {
-	if x != nil {
-		return x.UriageKingakuFurikae
-	}
-	return 0
+// Deprecated: Use Db_SharyoMasterResponse.ProtoReflect.Descriptor instead.
+func (*Db_SharyoMasterResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{168}
 }
 
-func (x *Db_UntenNippoMeisai) GetKazeiUriage() int32 {
+func (x *Db_SharyoMasterResponse) GetSharyoMaster() *Db_SharyoMaster {
 	if x != nil {
-		return x.KazeiUriage
+		return x.SharyoMaster
 	}
-	return 0
+	return nil
 }
 
-func (x *Db_UntenNippoMeisai) GetHikazeiUriage() int32 {
+type Db_ListSharyoMasterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Db_SharyoMaster     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalCount    *int32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`     // page_token使用時はinclude_total_count指定時のみ設定
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 次ページのトークン（最終ページの場合は空）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_ListSharyoMasterResponse) Reset() {
+	*x = Db_ListSharyoMasterResponse{}
+	mi := &file_db_service_proto_msgTypes[169]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_ListSharyoMasterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_ListSharyoMasterResponse) ProtoMessage() {}
+
+func (x *Db_ListSharyoMasterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[169]
 	if x != nil {
-		return x.HikazeiUriage
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Db_UntenNippoMeisai) GetKazeiShiharai() int32 {
+// Deprecated: Use Db_ListSharyoMasterResponse.ProtoReflect.Descriptor instead.
+func (*Db_ListSharyoMasterResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *Db_ListSharyoMasterResponse) GetItems() []*Db_SharyoMaster {
 	if x != nil {
-		return x.KazeiShiharai
+		return x.Items
 	}
-	return 0
+	return nil
 }
 
-func (x *Db_UntenNippoMeisai) GetHikazeiShiharai() int32 {
-	if x != nil {
-		return x.HikazeiShiharai
+func (x *Db_ListSharyoMasterResponse) GetTotalCount() int32 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
 	}
 	return 0
 }
 
-func (x *Db_UntenNippoMeisai) GetHatchiikiC() string {
+func (x *Db_ListSharyoMasterResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.HatchiikiC
+		return x.NextPageToken
 	}
 	return ""
 }
 
-func (x *Db_UntenNippoMeisai) GetChakuchiikiC() string {
-	if x != nil {
-		return x.ChakuchiikiC
-	}
-	return ""
+type Db_GetVehicleIdentityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 検索する識別子（いずれか1つを指定）
+	//
+	// Types that are valid to be assigned to Key:
+	//
+	//	*Db_GetVehicleIdentityRequest_CarId
+	//	*Db_GetVehicleIdentityRequest_Id4
+	//	*Db_GetVehicleIdentityRequest_CarCode
+	//	*Db_GetVehicleIdentityRequest_CarCc
+	//	*Db_GetVehicleIdentityRequest_EtcCarId
+	//	*Db_GetVehicleIdentityRequest_SharyoC
+	Key           isDb_GetVehicleIdentityRequest_Key `protobuf_oneof:"key"`
+	SharyoH       string                             `protobuf:"bytes,7,opt,name=sharyo_h,json=sharyoH,proto3" json:"sharyo_h,omitempty"` // sharyo_c指定時の車輌H（空の場合はすべて）
+	At            string                             `protobuf:"bytes,8,opt,name=at,proto3" json:"at,omitempty"`                          // active_etc_numsの基準日時（RFC3339形式、空の場合は現在日時）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_UntenNippoMeisai) GetRikunkyoku() string {
-	if x != nil && x.Rikunkyoku != nil {
-		return *x.Rikunkyoku
-	}
-	return ""
+func (x *Db_GetVehicleIdentityRequest) Reset() {
+	*x = Db_GetVehicleIdentityRequest{}
+	mi := &file_db_service_proto_msgTypes[170]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_UntenNippoMeisai) GetYoseki() float64 {
-	if x != nil && x.Yoseki != nil {
-		return *x.Yoseki
+func (x *Db_GetVehicleIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_GetVehicleIdentityRequest) ProtoMessage() {}
+
+func (x *Db_GetVehicleIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[170]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Db_UntenNippoMeisai) GetJuryoKakuninTenpushoK() string {
+// Deprecated: Use Db_GetVehicleIdentityRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetVehicleIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *Db_GetVehicleIdentityRequest) GetKey() isDb_GetVehicleIdentityRequest_Key {
 	if x != nil {
-		return x.JuryoKakuninTenpushoK
+		return x.Key
 	}
-	return ""
+	return nil
 }
 
-func (x *Db_UntenNippoMeisai) GetSaishuKoshin() string {
-	if x != nil && x.SaishuKoshin != nil {
-		return *x.SaishuKoshin
+func (x *Db_GetVehicleIdentityRequest) GetCarId() string {
+	if x != nil {
+		if x, ok := x.Key.(*Db_GetVehicleIdentityRequest_CarId); ok {
+			return x.CarId
+		}
 	}
 	return ""
 }
 
-func (x *Db_UntenNippoMeisai) GetLc() int32 {
+func (x *Db_GetVehicleIdentityRequest) GetId4() int32 {
 	if x != nil {
-		return x.Lc
+		if x, ok := x.Key.(*Db_GetVehicleIdentityRequest_Id4); ok {
+			return x.Id4
+		}
 	}
 	return 0
 }
 
-func (x *Db_UntenNippoMeisai) GetKoguchiK() string {
-	if x != nil && x.KoguchiK != nil {
-		return *x.KoguchiK
+func (x *Db_GetVehicleIdentityRequest) GetCarCode() string {
+	if x != nil {
+		if x, ok := x.Key.(*Db_GetVehicleIdentityRequest_CarCode); ok {
+			return x.CarCode
+		}
 	}
 	return ""
 }
 
-func (x *Db_UntenNippoMeisai) GetUnsoGaishaN() string {
-	if x != nil && x.UnsoGaishaN != nil {
-		return *x.UnsoGaishaN
+func (x *Db_GetVehicleIdentityRequest) GetCarCc() string {
+	if x != nil {
+		if x, ok := x.Key.(*Db_GetVehicleIdentityRequest_CarCc); ok {
+			return x.CarCc
+		}
 	}
 	return ""
 }
 
-func (x *Db_UntenNippoMeisai) GetJomuinN() string {
-	if x != nil && x.JomuinN != nil {
-		return *x.JomuinN
+func (x *Db_GetVehicleIdentityRequest) GetEtcCarId() string {
+	if x != nil {
+		if x, ok := x.Key.(*Db_GetVehicleIdentityRequest_EtcCarId); ok {
+			return x.EtcCarId
+		}
 	}
 	return ""
 }
 
-func (x *Db_UntenNippoMeisai) GetRenrakusaki() string {
-	if x != nil && x.Renrakusaki != nil {
-		return *x.Renrakusaki
+func (x *Db_GetVehicleIdentityRequest) GetSharyoC() string {
+	if x != nil {
+		if x, ok := x.Key.(*Db_GetVehicleIdentityRequest_SharyoC); ok {
+			return x.SharyoC
+		}
 	}
 	return ""
 }
 
-func (x *Db_UntenNippoMeisai) GetTaniJuryo() float64 {
-	if x != nil && x.TaniJuryo != nil {
-		return *x.TaniJuryo
-	}
-	return 0
-}
-
-func (x *Db_UntenNippoMeisai) GetShiharaiKakuteiK() string {
+func (x *Db_GetVehicleIdentityRequest) GetSharyoH() string {
 	if x != nil {
-		return x.ShiharaiKakuteiK
+		return x.SharyoH
 	}
 	return ""
 }
 
-func (x *Db_UntenNippoMeisai) GetBiko2() string {
-	if x != nil && x.Biko2 != nil {
-		return *x.Biko2
+func (x *Db_GetVehicleIdentityRequest) GetAt() string {
+	if x != nil {
+		return x.At
 	}
 	return ""
 }
 
-func (x *Db_UntenNippoMeisai) GetYobi1() float64 {
-	if x != nil && x.Yobi1 != nil {
-		return *x.Yobi1
-	}
-	return 0
+type isDb_GetVehicleIdentityRequest_Key interface {
+	isDb_GetVehicleIdentityRequest_Key()
 }
 
-func (x *Db_UntenNippoMeisai) GetYobi2() float64 {
-	if x != nil && x.Yobi2 != nil {
-		return *x.Yobi2
-	}
-	return 0
+type Db_GetVehicleIdentityRequest_CarId struct {
+	CarId string `protobuf:"bytes,1,opt,name=car_id,json=carId,proto3,oneof"` // cars.id
 }
 
-func (x *Db_UntenNippoMeisai) GetYobi3() string {
-	if x != nil && x.Yobi3 != nil {
-		return *x.Yobi3
-	}
-	return ""
+type Db_GetVehicleIdentityRequest_Id4 struct {
+	Id4 int32 `protobuf:"varint,2,opt,name=id4,proto3,oneof"` // cars.id4
 }
 
-func (x *Db_UntenNippoMeisai) GetYobi4() string {
-	if x != nil && x.Yobi4 != nil {
-		return *x.Yobi4
-	}
-	return ""
+type Db_GetVehicleIdentityRequest_CarCode struct {
+	CarCode string `protobuf:"bytes,3,opt,name=car_code,json=carCode,proto3,oneof"` // dtako_cars.車輌CD
 }
 
-func (x *Db_UntenNippoMeisai) GetYobi5() string {
-	if x != nil && x.Yobi5 != nil {
-		return *x.Yobi5
-	}
-	return ""
+type Db_GetVehicleIdentityRequest_CarCc struct {
+	CarCc string `protobuf:"bytes,4,opt,name=car_cc,json=carCc,proto3,oneof"` // dtako_cars.車輌CC
 }
 
-func (x *Db_UntenNippoMeisai) GetYobi6() string {
-	if x != nil && x.Yobi6 != nil {
-		return *x.Yobi6
-	}
-	return ""
+type Db_GetVehicleIdentityRequest_EtcCarId struct {
+	EtcCarId string `protobuf:"bytes,5,opt,name=etc_car_id,json=etcCarId,proto3,oneof"` // etc_num.car_id
 }
 
-func (x *Db_UntenNippoMeisai) GetYobi7() string {
-	if x != nil && x.Yobi7 != nil {
-		return *x.Yobi7
-	}
-	return ""
+type Db_GetVehicleIdentityRequest_SharyoC struct {
+	SharyoC string `protobuf:"bytes,6,opt,name=sharyo_c,json=sharyoC,proto3,oneof"` // 車輌ﾏｽﾀ・運転日報明細の車輌C
 }
 
-// db_ShainMaster メッセージ（43カラム全て）
-type Db_ShainMaster struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	ShainC            string                 `protobuf:"bytes,1,opt,name=shain_c,json=shainC,proto3" json:"shain_c,omitempty"`
-	ShainN            *string                `protobuf:"bytes,2,opt,name=shain_n,json=shainN,proto3,oneof" json:"shain_n,omitempty"`
-	ShainR            *string                `protobuf:"bytes,3,opt,name=shain_r,json=shainR,proto3,oneof" json:"shain_r,omitempty"`
-	ShainF            *string                `protobuf:"bytes,4,opt,name=shain_f,json=shainF,proto3,oneof" json:"shain_f,omitempty"`
-	YubinBango        *string                `protobuf:"bytes,5,opt,name=yubin_bango,json=yubinBango,proto3,oneof" json:"yubin_bango,omitempty"`
-	Jusho1            *string                `protobuf:"bytes,6,opt,name=jusho1,proto3,oneof" json:"jusho1,omitempty"`
-	Jusho2            *string                `protobuf:"bytes,7,opt,name=jusho2,proto3,oneof" json:"jusho2,omitempty"`
-	DenwaBango        *string                `protobuf:"bytes,8,opt,name=denwa_bango,json=denwaBango,proto3,oneof" json:"denwa_bango,omitempty"`
-	KeitaiBango       *string                `protobuf:"bytes,9,opt,name=keitai_bango,json=keitaiBango,proto3,oneof" json:"keitai_bango,omitempty"`
-	ShainK            string                 `protobuf:"bytes,10,opt,name=shain_k,json=shainK,proto3" json:"shain_k,omitempty"`
-	Seibetsu          string                 `protobuf:"bytes,11,opt,name=seibetsu,proto3" json:"seibetsu,omitempty"`
-	Ketsuekigata      string                 `protobuf:"bytes,12,opt,name=ketsuekigata,proto3" json:"ketsuekigata,omitempty"`
-	Seinengappi       *string                `protobuf:"bytes,13,opt,name=seinengappi,proto3,oneof" json:"seinengappi,omitempty"`
-	NyushaNengappi    *string                `protobuf:"bytes,14,opt,name=nyusha_nengappi,json=nyushaNengappi,proto3,oneof" json:"nyusha_nengappi,omitempty"`
-	TaishokuNengappi  *string                `protobuf:"bytes,15,opt,name=taishoku_nengappi,json=taishokuNengappi,proto3,oneof" json:"taishoku_nengappi,omitempty"`
-	DaiBunrui1        string                 `protobuf:"bytes,16,opt,name=dai_bunrui1,json=daiBunrui1,proto3" json:"dai_bunrui1,omitempty"`
-	ChuBunrui1        string                 `protobuf:"bytes,17,opt,name=chu_bunrui1,json=chuBunrui1,proto3" json:"chu_bunrui1,omitempty"`
-	ShoBunrui1        string                 `protobuf:"bytes,18,opt,name=sho_bunrui1,json=shoBunrui1,proto3" json:"sho_bunrui1,omitempty"`
-	DaiBunrui2        string                 `protobuf:"bytes,19,opt,name=dai_bunrui2,json=daiBunrui2,proto3" json:"dai_bunrui2,omitempty"`
-	ChuBunrui2        string                 `protobuf:"bytes,20,opt,name=chu_bunrui2,json=chuBunrui2,proto3" json:"chu_bunrui2,omitempty"`
-	ShoBunrui2        string                 `protobuf:"bytes,21,opt,name=sho_bunrui2,json=shoBunrui2,proto3" json:"sho_bunrui2,omitempty"`
-	KodanPlate        *string                `protobuf:"bytes,22,opt,name=kodan_plate,json=kodanPlate,proto3,oneof" json:"kodan_plate,omitempty"`
-	UriageMokuhyogaku int32                  `protobuf:"varint,23,opt,name=uriage_mokuhyogaku,json=uriageMokuhyogaku,proto3" json:"uriage_mokuhyogaku,omitempty"`
-	UntenMenkyoK      string                 `protobuf:"bytes,24,opt,name=unten_menkyo_k,json=untenMenkyoK,proto3" json:"unten_menkyo_k,omitempty"`
-	MenkyoshoBango    *string                `protobuf:"bytes,25,opt,name=menkyosho_bango,json=menkyoshoBango,proto3,oneof" json:"menkyosho_bango,omitempty"`
-	JikaiKoshinbi     *string                `protobuf:"bytes,26,opt,name=jikai_koshinbi,json=jikaiKoshinbi,proto3,oneof" json:"jikai_koshinbi,omitempty"`
-	JishaYoshaK       string                 `protobuf:"bytes,27,opt,name=jisha_yosha_k,json=jishaYoshaK,proto3" json:"jisha_yosha_k,omitempty"`
-	KeisanK           string                 `protobuf:"bytes,28,opt,name=keisan_k,json=keisanK,proto3" json:"keisan_k,omitempty"`
-	ShiharaiRitsu     float64                `protobuf:"fixed64,29,opt,name=shiharai_ritsu,json=shiharaiRitsu,proto3" json:"shiharai_ritsu,omitempty"`
-	HasuK             string                 `protobuf:"bytes,30,opt,name=hasu_k,json=hasuK,proto3" json:"hasu_k,omitempty"`
-	BumonC            string                 `protobuf:"bytes,31,opt,name=bumon_c,json=bumonC,proto3" json:"bumon_c,omitempty"`
-	UnchinPatternC    string                 `protobuf:"bytes,32,opt,name=unchin_pattern_c,json=unchinPatternC,proto3" json:"unchin_pattern_c,omitempty"`
-	Kiji1             *string                `protobuf:"bytes,33,opt,name=kiji1,proto3,oneof" json:"kiji1,omitempty"`
-	Kiji2             *string                `protobuf:"bytes,34,opt,name=kiji2,proto3,oneof" json:"kiji2,omitempty"`
-	Kiji3             *string                `protobuf:"bytes,35,opt,name=kiji3,proto3,oneof" json:"kiji3,omitempty"`
-	Kiji4             *string                `protobuf:"bytes,36,opt,name=kiji4,proto3,oneof" json:"kiji4,omitempty"`
-	Kiji5             *string                `protobuf:"bytes,37,opt,name=kiji5,proto3,oneof" json:"kiji5,omitempty"`
-	Yobi1             *string                `protobuf:"bytes,38,opt,name=yobi1,proto3,oneof" json:"yobi1,omitempty"`
-	Yobi2             *string                `protobuf:"bytes,39,opt,name=yobi2,proto3,oneof" json:"yobi2,omitempty"`
-	Yobi3             *string                `protobuf:"bytes,40,opt,name=yobi3,proto3,oneof" json:"yobi3,omitempty"`
-	Yobi4             *string                `protobuf:"bytes,41,opt,name=yobi4,proto3,oneof" json:"yobi4,omitempty"`
-	Yobi5             *string                `protobuf:"bytes,42,opt,name=yobi5,proto3,oneof" json:"yobi5,omitempty"`
-	KinmuTaikeiC      string                 `protobuf:"bytes,43,opt,name=kinmu_taikei_c,json=kinmuTaikeiC,proto3" json:"kinmu_taikei_c,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (*Db_GetVehicleIdentityRequest_CarId) isDb_GetVehicleIdentityRequest_Key() {}
+
+func (*Db_GetVehicleIdentityRequest_Id4) isDb_GetVehicleIdentityRequest_Key() {}
+
+func (*Db_GetVehicleIdentityRequest_CarCode) isDb_GetVehicleIdentityRequest_Key() {}
+
+func (*Db_GetVehicleIdentityRequest_CarCc) isDb_GetVehicleIdentityRequest_Key() {}
+
+func (*Db_GetVehicleIdentityRequest_EtcCarId) isDb_GetVehicleIdentityRequest_Key() {}
+
+func (*Db_GetVehicleIdentityRequest_SharyoC) isDb_GetVehicleIdentityRequest_Key() {}
+
+type Db_VehicleIdentity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CarCc         string                 `protobuf:"bytes,1,opt,name=car_cc,json=carCc,proto3" json:"car_cc,omitempty"` // 車輌コード（cars.id・dtako_cars.車輌CC・etc_num.car_id、不明な場合は空）
+	Id4           *int32                 `protobuf:"varint,2,opt,name=id4,proto3,oneof" json:"id4,omitempty"`           // cars.id4（車輌ﾏｽﾀの車輌Cと対応）
+	Car           *Db_Cars               `protobuf:"bytes,3,opt,name=car,proto3,oneof" json:"car,omitempty"`
+	DtakoCars     []*Db_DTakoCars        `protobuf:"bytes,4,rep,name=dtako_cars,json=dtakoCars,proto3" json:"dtako_cars,omitempty"`
+	EtcNums       []*Db_ETCNum           `protobuf:"bytes,5,rep,name=etc_nums,json=etcNums,proto3" json:"etc_nums,omitempty"` // etc_numの登録履歴
+	SharyoMasters []*Db_SharyoMaster     `protobuf:"bytes,6,rep,name=sharyo_masters,json=sharyoMasters,proto3" json:"sharyo_masters,omitempty"`
+	Missing       []Db_VehicleSystem     `protobuf:"varint,7,rep,packed,name=missing,proto3,enum=db_service.Db_VehicleSystem" json:"missing,omitempty"` // 登録されていないシステム
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_ShainMaster) Reset() {
-	*x = Db_ShainMaster{}
-	mi := &file_db_service_proto_msgTypes[76]
+func (x *Db_VehicleIdentity) Reset() {
+	*x = Db_VehicleIdentity{}
+	mi := &file_db_service_proto_msgTypes[171]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ShainMaster) String() string {
+func (x *Db_VehicleIdentity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_ShainMaster) ProtoMessage() {}
+func (*Db_VehicleIdentity) ProtoMessage() {}
 
-func (x *Db_ShainMaster) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[76]
+func (x *Db_VehicleIdentity) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6207,338 +14149,384 @@ func (x *Db_ShainMaster) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_ShainMaster.ProtoReflect.Descriptor instead.
-func (*Db_ShainMaster) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use Db_VehicleIdentity.ProtoReflect.Descriptor instead.
+func (*Db_VehicleIdentity) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{171}
 }
 
-func (x *Db_ShainMaster) GetShainC() string {
+func (x *Db_VehicleIdentity) GetCarCc() string {
 	if x != nil {
-		return x.ShainC
+		return x.CarCc
 	}
 	return ""
 }
 
-func (x *Db_ShainMaster) GetShainN() string {
-	if x != nil && x.ShainN != nil {
-		return *x.ShainN
+func (x *Db_VehicleIdentity) GetId4() int32 {
+	if x != nil && x.Id4 != nil {
+		return *x.Id4
 	}
-	return ""
+	return 0
 }
 
-func (x *Db_ShainMaster) GetShainR() string {
-	if x != nil && x.ShainR != nil {
-		return *x.ShainR
+func (x *Db_VehicleIdentity) GetCar() *Db_Cars {
+	if x != nil {
+		return x.Car
 	}
-	return ""
+	return nil
 }
 
-func (x *Db_ShainMaster) GetShainF() string {
-	if x != nil && x.ShainF != nil {
-		return *x.ShainF
+func (x *Db_VehicleIdentity) GetDtakoCars() []*Db_DTakoCars {
+	if x != nil {
+		return x.DtakoCars
 	}
-	return ""
+	return nil
 }
 
-func (x *Db_ShainMaster) GetYubinBango() string {
-	if x != nil && x.YubinBango != nil {
-		return *x.YubinBango
+func (x *Db_VehicleIdentity) GetEtcNums() []*Db_ETCNum {
+	if x != nil {
+		return x.EtcNums
 	}
-	return ""
+	return nil
 }
 
-func (x *Db_ShainMaster) GetJusho1() string {
-	if x != nil && x.Jusho1 != nil {
-		return *x.Jusho1
+func (x *Db_VehicleIdentity) GetSharyoMasters() []*Db_SharyoMaster {
+	if x != nil {
+		return x.SharyoMasters
 	}
-	return ""
+	return nil
 }
 
-func (x *Db_ShainMaster) GetJusho2() string {
-	if x != nil && x.Jusho2 != nil {
-		return *x.Jusho2
+func (x *Db_VehicleIdentity) GetMissing() []Db_VehicleSystem {
+	if x != nil {
+		return x.Missing
 	}
-	return ""
+	return nil
 }
 
-func (x *Db_ShainMaster) GetDenwaBango() string {
-	if x != nil && x.DenwaBango != nil {
-		return *x.DenwaBango
-	}
-	return ""
+type Db_VehicleIdentityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identity      *Db_VehicleIdentity    `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	ActiveEtcNums []*Db_ETCNum           `protobuf:"bytes,2,rep,name=active_etc_nums,json=activeEtcNums,proto3" json:"active_etc_nums,omitempty"` // atの時点で登録されているETCカード
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_ShainMaster) GetKeitaiBango() string {
-	if x != nil && x.KeitaiBango != nil {
-		return *x.KeitaiBango
-	}
-	return ""
+func (x *Db_VehicleIdentityResponse) Reset() {
+	*x = Db_VehicleIdentityResponse{}
+	mi := &file_db_service_proto_msgTypes[172]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ShainMaster) GetShainK() string {
+func (x *Db_VehicleIdentityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_VehicleIdentityResponse) ProtoMessage() {}
+
+func (x *Db_VehicleIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[172]
 	if x != nil {
-		return x.ShainK
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Db_VehicleIdentityResponse.ProtoReflect.Descriptor instead.
+func (*Db_VehicleIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{172}
 }
 
-func (x *Db_ShainMaster) GetSeibetsu() string {
+func (x *Db_VehicleIdentityResponse) GetIdentity() *Db_VehicleIdentity {
 	if x != nil {
-		return x.Seibetsu
+		return x.Identity
 	}
-	return ""
+	return nil
 }
 
-func (x *Db_ShainMaster) GetKetsuekigata() string {
+func (x *Db_VehicleIdentityResponse) GetActiveEtcNums() []*Db_ETCNum {
 	if x != nil {
-		return x.Ketsuekigata
+		return x.ActiveEtcNums
 	}
-	return ""
+	return nil
 }
 
-func (x *Db_ShainMaster) GetSeinengappi() string {
-	if x != nil && x.Seinengappi != nil {
-		return *x.Seinengappi
-	}
-	return ""
+type Db_ListVehicleIdentityGapsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Missing       []Db_VehicleSystem     `protobuf:"varint,1,rep,packed,name=missing,proto3,enum=db_service.Db_VehicleSystem" json:"missing,omitempty"` // 指定したシステムに登録されていない車輌のみ（空の場合は全て）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_ShainMaster) GetNyushaNengappi() string {
-	if x != nil && x.NyushaNengappi != nil {
-		return *x.NyushaNengappi
-	}
-	return ""
+func (x *Db_ListVehicleIdentityGapsRequest) Reset() {
+	*x = Db_ListVehicleIdentityGapsRequest{}
+	mi := &file_db_service_proto_msgTypes[173]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ShainMaster) GetTaishokuNengappi() string {
-	if x != nil && x.TaishokuNengappi != nil {
-		return *x.TaishokuNengappi
-	}
-	return ""
+func (x *Db_ListVehicleIdentityGapsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Db_ShainMaster) GetDaiBunrui1() string {
+func (*Db_ListVehicleIdentityGapsRequest) ProtoMessage() {}
+
+func (x *Db_ListVehicleIdentityGapsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[173]
 	if x != nil {
-		return x.DaiBunrui1
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Db_ShainMaster) GetChuBunrui1() string {
-	if x != nil {
-		return x.ChuBunrui1
-	}
-	return ""
+// Deprecated: Use Db_ListVehicleIdentityGapsRequest.ProtoReflect.Descriptor instead.
+func (*Db_ListVehicleIdentityGapsRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{173}
 }
 
-func (x *Db_ShainMaster) GetShoBunrui1() string {
+func (x *Db_ListVehicleIdentityGapsRequest) GetMissing() []Db_VehicleSystem {
 	if x != nil {
-		return x.ShoBunrui1
+		return x.Missing
 	}
-	return ""
+	return nil
 }
 
-func (x *Db_ShainMaster) GetDaiBunrui2() string {
-	if x != nil {
-		return x.DaiBunrui2
-	}
-	return ""
+type Db_ListVehicleIdentityGapsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VehicleCount  int32                  `protobuf:"varint,1,opt,name=vehicle_count,json=vehicleCount,proto3" json:"vehicle_count,omitempty"`           // 突き合わせた車輌の台数
+	Systems       []Db_VehicleSystem     `protobuf:"varint,2,rep,packed,name=systems,proto3,enum=db_service.Db_VehicleSystem" json:"systems,omitempty"` // 突き合わせたシステム（SQL Server未設定の場合は車輌ﾏｽﾀを含まない）
+	Items         []*Db_VehicleIdentity  `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`                                              // 車輌コード・車輌C順
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_ShainMaster) GetChuBunrui2() string {
-	if x != nil {
-		return x.ChuBunrui2
-	}
-	return ""
+func (x *Db_ListVehicleIdentityGapsResponse) Reset() {
+	*x = Db_ListVehicleIdentityGapsResponse{}
+	mi := &file_db_service_proto_msgTypes[174]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ShainMaster) GetShoBunrui2() string {
+func (x *Db_ListVehicleIdentityGapsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_ListVehicleIdentityGapsResponse) ProtoMessage() {}
+
+func (x *Db_ListVehicleIdentityGapsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[174]
 	if x != nil {
-		return x.ShoBunrui2
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Db_ShainMaster) GetKodanPlate() string {
-	if x != nil && x.KodanPlate != nil {
-		return *x.KodanPlate
-	}
-	return ""
+// Deprecated: Use Db_ListVehicleIdentityGapsResponse.ProtoReflect.Descriptor instead.
+func (*Db_ListVehicleIdentityGapsResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{174}
 }
 
-func (x *Db_ShainMaster) GetUriageMokuhyogaku() int32 {
+func (x *Db_ListVehicleIdentityGapsResponse) GetVehicleCount() int32 {
 	if x != nil {
-		return x.UriageMokuhyogaku
+		return x.VehicleCount
 	}
 	return 0
 }
 
-func (x *Db_ShainMaster) GetUntenMenkyoK() string {
+func (x *Db_ListVehicleIdentityGapsResponse) GetSystems() []Db_VehicleSystem {
 	if x != nil {
-		return x.UntenMenkyoK
+		return x.Systems
 	}
-	return ""
+	return nil
 }
 
-func (x *Db_ShainMaster) GetMenkyoshoBango() string {
-	if x != nil && x.MenkyoshoBango != nil {
-		return *x.MenkyoshoBango
+func (x *Db_ListVehicleIdentityGapsResponse) GetItems() []*Db_VehicleIdentity {
+	if x != nil {
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-func (x *Db_ShainMaster) GetJikaiKoshinbi() string {
-	if x != nil && x.JikaiKoshinbi != nil {
-		return *x.JikaiKoshinbi
-	}
-	return ""
+// TimeCard用メッセージ
+type Db_TimeCard struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Datetime      string                 `protobuf:"bytes,1,opt,name=datetime,proto3" json:"datetime,omitempty"` // RFC3339形式
+	Id            int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	MachineIp     string                 `protobuf:"bytes,3,opt,name=machine_ip,json=machineIp,proto3" json:"machine_ip,omitempty"`
+	State         string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	StateDetail   *string                `protobuf:"bytes,5,opt,name=state_detail,json=stateDetail,proto3,oneof" json:"state_detail,omitempty"`
+	Created       string                 `protobuf:"bytes,6,opt,name=created,proto3" json:"created,omitempty"`   // RFC3339形式
+	Modified      string                 `protobuf:"bytes,7,opt,name=modified,proto3" json:"modified,omitempty"` // RFC3339形式
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_ShainMaster) GetJishaYoshaK() string {
-	if x != nil {
-		return x.JishaYoshaK
-	}
-	return ""
+func (x *Db_TimeCard) Reset() {
+	*x = Db_TimeCard{}
+	mi := &file_db_service_proto_msgTypes[175]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ShainMaster) GetKeisanK() string {
-	if x != nil {
-		return x.KeisanK
-	}
-	return ""
+func (x *Db_TimeCard) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Db_ShainMaster) GetShiharaiRitsu() float64 {
+func (*Db_TimeCard) ProtoMessage() {}
+
+func (x *Db_TimeCard) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[175]
 	if x != nil {
-		return x.ShiharaiRitsu
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Db_ShainMaster) GetHasuK() string {
+// Deprecated: Use Db_TimeCard.ProtoReflect.Descriptor instead.
+func (*Db_TimeCard) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *Db_TimeCard) GetDatetime() string {
 	if x != nil {
-		return x.HasuK
+		return x.Datetime
 	}
 	return ""
 }
 
-func (x *Db_ShainMaster) GetBumonC() string {
+func (x *Db_TimeCard) GetId() int32 {
 	if x != nil {
-		return x.BumonC
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *Db_ShainMaster) GetUnchinPatternC() string {
+func (x *Db_TimeCard) GetMachineIp() string {
 	if x != nil {
-		return x.UnchinPatternC
+		return x.MachineIp
 	}
 	return ""
 }
 
-func (x *Db_ShainMaster) GetKiji1() string {
-	if x != nil && x.Kiji1 != nil {
-		return *x.Kiji1
+func (x *Db_TimeCard) GetState() string {
+	if x != nil {
+		return x.State
 	}
 	return ""
 }
 
-func (x *Db_ShainMaster) GetKiji2() string {
-	if x != nil && x.Kiji2 != nil {
-		return *x.Kiji2
+func (x *Db_TimeCard) GetStateDetail() string {
+	if x != nil && x.StateDetail != nil {
+		return *x.StateDetail
 	}
 	return ""
 }
 
-func (x *Db_ShainMaster) GetKiji3() string {
-	if x != nil && x.Kiji3 != nil {
-		return *x.Kiji3
+func (x *Db_TimeCard) GetCreated() string {
+	if x != nil {
+		return x.Created
 	}
 	return ""
 }
 
-func (x *Db_ShainMaster) GetKiji4() string {
-	if x != nil && x.Kiji4 != nil {
-		return *x.Kiji4
+func (x *Db_TimeCard) GetModified() string {
+	if x != nil {
+		return x.Modified
 	}
 	return ""
 }
 
-func (x *Db_ShainMaster) GetKiji5() string {
-	if x != nil && x.Kiji5 != nil {
-		return *x.Kiji5
-	}
-	return ""
+type Db_GetTimeCardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Datetime      string                 `protobuf:"bytes,1,opt,name=datetime,proto3" json:"datetime,omitempty"` // RFC3339形式
+	Id            int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_ShainMaster) GetYobi1() string {
-	if x != nil && x.Yobi1 != nil {
-		return *x.Yobi1
-	}
-	return ""
+func (x *Db_GetTimeCardRequest) Reset() {
+	*x = Db_GetTimeCardRequest{}
+	mi := &file_db_service_proto_msgTypes[176]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ShainMaster) GetYobi2() string {
-	if x != nil && x.Yobi2 != nil {
-		return *x.Yobi2
-	}
-	return ""
+func (x *Db_GetTimeCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Db_ShainMaster) GetYobi3() string {
-	if x != nil && x.Yobi3 != nil {
-		return *x.Yobi3
+func (*Db_GetTimeCardRequest) ProtoMessage() {}
+
+func (x *Db_GetTimeCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[176]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Db_ShainMaster) GetYobi4() string {
-	if x != nil && x.Yobi4 != nil {
-		return *x.Yobi4
-	}
-	return ""
+// Deprecated: Use Db_GetTimeCardRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetTimeCardRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{176}
 }
 
-func (x *Db_ShainMaster) GetYobi5() string {
-	if x != nil && x.Yobi5 != nil {
-		return *x.Yobi5
+func (x *Db_GetTimeCardRequest) GetDatetime() string {
+	if x != nil {
+		return x.Datetime
 	}
 	return ""
 }
 
-func (x *Db_ShainMaster) GetKinmuTaikeiC() string {
+func (x *Db_GetTimeCardRequest) GetId() int32 {
 	if x != nil {
-		return x.KinmuTaikeiC
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-// db_ChiikiMaster メッセージ（4カラム全て）
-type Db_ChiikiMaster struct {
+type Db_TimeCardSortSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ChiikiC       string                 `protobuf:"bytes,1,opt,name=chiiki_c,json=chiikiC,proto3" json:"chiiki_c,omitempty"`
-	ChiikiN       *string                `protobuf:"bytes,2,opt,name=chiiki_n,json=chiikiN,proto3,oneof" json:"chiiki_n,omitempty"`
-	ChiikiR       *string                `protobuf:"bytes,3,opt,name=chiiki_r,json=chiikiR,proto3,oneof" json:"chiiki_r,omitempty"`
-	ChiikiF       *string                `protobuf:"bytes,4,opt,name=chiiki_f,json=chiikiF,proto3,oneof" json:"chiiki_f,omitempty"`
+	Field         Db_TimeCardSortField   `protobuf:"varint,1,opt,name=field,proto3,enum=db_service.Db_TimeCardSortField" json:"field,omitempty"`
+	Direction     Db_SortDirection       `protobuf:"varint,2,opt,name=direction,proto3,enum=db_service.Db_SortDirection" json:"direction,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_ChiikiMaster) Reset() {
-	*x = Db_ChiikiMaster{}
-	mi := &file_db_service_proto_msgTypes[77]
+func (x *Db_TimeCardSortSpec) Reset() {
+	*x = Db_TimeCardSortSpec{}
+	mi := &file_db_service_proto_msgTypes[177]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ChiikiMaster) String() string {
+func (x *Db_TimeCardSortSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_ChiikiMaster) ProtoMessage() {}
-
-func (x *Db_ChiikiMaster) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[77]
+func (*Db_TimeCardSortSpec) ProtoMessage() {}
+
+func (x *Db_TimeCardSortSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[177]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6549,81 +14537,52 @@ func (x *Db_ChiikiMaster) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_ChiikiMaster.ProtoReflect.Descriptor instead.
-func (*Db_ChiikiMaster) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{77}
+// Deprecated: Use Db_TimeCardSortSpec.ProtoReflect.Descriptor instead.
+func (*Db_TimeCardSortSpec) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{177}
 }
 
-func (x *Db_ChiikiMaster) GetChiikiC() string {
+func (x *Db_TimeCardSortSpec) GetField() Db_TimeCardSortField {
 	if x != nil {
-		return x.ChiikiC
-	}
-	return ""
-}
-
-func (x *Db_ChiikiMaster) GetChiikiN() string {
-	if x != nil && x.ChiikiN != nil {
-		return *x.ChiikiN
-	}
-	return ""
-}
-
-func (x *Db_ChiikiMaster) GetChiikiR() string {
-	if x != nil && x.ChiikiR != nil {
-		return *x.ChiikiR
+		return x.Field
 	}
-	return ""
+	return Db_TimeCardSortField_TIME_CARD_SORT_FIELD_UNSPECIFIED
 }
 
-func (x *Db_ChiikiMaster) GetChiikiF() string {
-	if x != nil && x.ChiikiF != nil {
-		return *x.ChiikiF
+func (x *Db_TimeCardSortSpec) GetDirection() Db_SortDirection {
+	if x != nil {
+		return x.Direction
 	}
-	return ""
+	return Db_SortDirection_SORT_DIRECTION_UNSPECIFIED
 }
 
-// db_ChikuMaster メッセージ（20カラム全て）
-type Db_ChikuMaster struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ChikuC        string                 `protobuf:"bytes,1,opt,name=chiku_c,json=chikuC,proto3" json:"chiku_c,omitempty"`
-	ChikuN        *string                `protobuf:"bytes,2,opt,name=chiku_n,json=chikuN,proto3,oneof" json:"chiku_n,omitempty"`
-	ChikuR        *string                `protobuf:"bytes,3,opt,name=chiku_r,json=chikuR,proto3,oneof" json:"chiku_r,omitempty"`
-	ChikuF        *string                `protobuf:"bytes,4,opt,name=chiku_f,json=chikuF,proto3,oneof" json:"chiku_f,omitempty"`
-	ChiikiC       string                 `protobuf:"bytes,5,opt,name=chiiki_c,json=chiikiC,proto3" json:"chiiki_c,omitempty"`
-	YubinBango    *string                `protobuf:"bytes,6,opt,name=yubin_bango,json=yubinBango,proto3,oneof" json:"yubin_bango,omitempty"`
-	Jusho1        *string                `protobuf:"bytes,7,opt,name=jusho1,proto3,oneof" json:"jusho1,omitempty"`
-	Jusho2        *string                `protobuf:"bytes,8,opt,name=jusho2,proto3,oneof" json:"jusho2,omitempty"`
-	DenwaBango    *string                `protobuf:"bytes,9,opt,name=denwa_bango,json=denwaBango,proto3,oneof" json:"denwa_bango,omitempty"`
-	FaxBango      *string                `protobuf:"bytes,10,opt,name=fax_bango,json=faxBango,proto3,oneof" json:"fax_bango,omitempty"`
-	Tantosha      *string                `protobuf:"bytes,11,opt,name=tantosha,proto3,oneof" json:"tantosha,omitempty"`
-	Yobi1         *string                `protobuf:"bytes,12,opt,name=yobi1,proto3,oneof" json:"yobi1,omitempty"`
-	Yobi2         *string                `protobuf:"bytes,13,opt,name=yobi2,proto3,oneof" json:"yobi2,omitempty"`
-	Yobi3         *string                `protobuf:"bytes,14,opt,name=yobi3,proto3,oneof" json:"yobi3,omitempty"`
-	Yobi4         *string                `protobuf:"bytes,15,opt,name=yobi4,proto3,oneof" json:"yobi4,omitempty"`
-	Yobi5         *string                `protobuf:"bytes,16,opt,name=yobi5,proto3,oneof" json:"yobi5,omitempty"`
-	DgrTokuisakiC string                 `protobuf:"bytes,17,opt,name=dgr_tokuisaki_c,json=dgrTokuisakiC,proto3" json:"dgr_tokuisaki_c,omitempty"`
-	DgrTokuisakiH string                 `protobuf:"bytes,18,opt,name=dgr_tokuisaki_h,json=dgrTokuisakiH,proto3" json:"dgr_tokuisaki_h,omitempty"`
-	DgrHinmeiC    string                 `protobuf:"bytes,19,opt,name=dgr_hinmei_c,json=dgrHinmeiC,proto3" json:"dgr_hinmei_c,omitempty"`
-	DgrHinmeiH    string                 `protobuf:"bytes,20,opt,name=dgr_hinmei_h,json=dgrHinmeiH,proto3" json:"dgr_hinmei_h,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type Db_ListTimeCardRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Limit             int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset            int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	OrderBy           *string                `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3,oneof" json:"order_by,omitempty"`                            // 非推奨: sortを使用（ソート可能なprotoのフィールド名のみ指定可）
+	PageToken         *string                `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`                      // キーセットページネーション用トークン（指定時はoffset/order_byは使用不可、初回は空文字）
+	IncludeTotalCount bool                   `protobuf:"varint,5,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"` // page_token使用時もtotal_countを取得する（COUNT(*)を実行）
+	Sort              []*Db_TimeCardSortSpec `protobuf:"bytes,6,rep,name=sort,proto3" json:"sort,omitempty"`                                                       // ソート条件（複数指定可）
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *Db_ChikuMaster) Reset() {
-	*x = Db_ChikuMaster{}
-	mi := &file_db_service_proto_msgTypes[78]
+func (x *Db_ListTimeCardRequest) Reset() {
+	*x = Db_ListTimeCardRequest{}
+	mi := &file_db_service_proto_msgTypes[178]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ChikuMaster) String() string {
+func (x *Db_ListTimeCardRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_ChikuMaster) ProtoMessage() {}
+func (*Db_ListTimeCardRequest) ProtoMessage() {}
 
-func (x *Db_ChikuMaster) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[78]
+func (x *Db_ListTimeCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[178]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6634,235 +14593,223 @@ func (x *Db_ChikuMaster) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_ChikuMaster.ProtoReflect.Descriptor instead.
-func (*Db_ChikuMaster) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{78}
+// Deprecated: Use Db_ListTimeCardRequest.ProtoReflect.Descriptor instead.
+func (*Db_ListTimeCardRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{178}
 }
 
-func (x *Db_ChikuMaster) GetChikuC() string {
+func (x *Db_ListTimeCardRequest) GetLimit() int32 {
 	if x != nil {
-		return x.ChikuC
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *Db_ChikuMaster) GetChikuN() string {
-	if x != nil && x.ChikuN != nil {
-		return *x.ChikuN
+func (x *Db_ListTimeCardRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
 	}
-	return ""
+	return 0
 }
 
-func (x *Db_ChikuMaster) GetChikuR() string {
-	if x != nil && x.ChikuR != nil {
-		return *x.ChikuR
+func (x *Db_ListTimeCardRequest) GetOrderBy() string {
+	if x != nil && x.OrderBy != nil {
+		return *x.OrderBy
 	}
 	return ""
 }
 
-func (x *Db_ChikuMaster) GetChikuF() string {
-	if x != nil && x.ChikuF != nil {
-		return *x.ChikuF
+func (x *Db_ListTimeCardRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
 	}
 	return ""
 }
 
-func (x *Db_ChikuMaster) GetChiikiC() string {
+func (x *Db_ListTimeCardRequest) GetIncludeTotalCount() bool {
 	if x != nil {
-		return x.ChiikiC
+		return x.IncludeTotalCount
 	}
-	return ""
+	return false
 }
 
-func (x *Db_ChikuMaster) GetYubinBango() string {
-	if x != nil && x.YubinBango != nil {
-		return *x.YubinBango
+func (x *Db_ListTimeCardRequest) GetSort() []*Db_TimeCardSortSpec {
+	if x != nil {
+		return x.Sort
 	}
-	return ""
+	return nil
 }
 
-func (x *Db_ChikuMaster) GetJusho1() string {
-	if x != nil && x.Jusho1 != nil {
-		return *x.Jusho1
-	}
-	return ""
+type Db_TimeCardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TimeCard      *Db_TimeCard           `protobuf:"bytes,1,opt,name=time_card,json=timeCard,proto3" json:"time_card,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_ChikuMaster) GetJusho2() string {
-	if x != nil && x.Jusho2 != nil {
-		return *x.Jusho2
-	}
-	return ""
+func (x *Db_TimeCardResponse) Reset() {
+	*x = Db_TimeCardResponse{}
+	mi := &file_db_service_proto_msgTypes[179]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ChikuMaster) GetDenwaBango() string {
-	if x != nil && x.DenwaBango != nil {
-		return *x.DenwaBango
-	}
-	return ""
+func (x *Db_TimeCardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Db_ChikuMaster) GetFaxBango() string {
-	if x != nil && x.FaxBango != nil {
-		return *x.FaxBango
-	}
-	return ""
-}
+func (*Db_TimeCardResponse) ProtoMessage() {}
 
-func (x *Db_ChikuMaster) GetTantosha() string {
-	if x != nil && x.Tantosha != nil {
-		return *x.Tantosha
+func (x *Db_TimeCardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[179]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Db_ChikuMaster) GetYobi1() string {
-	if x != nil && x.Yobi1 != nil {
-		return *x.Yobi1
-	}
-	return ""
+// Deprecated: Use Db_TimeCardResponse.ProtoReflect.Descriptor instead.
+func (*Db_TimeCardResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{179}
 }
 
-func (x *Db_ChikuMaster) GetYobi2() string {
-	if x != nil && x.Yobi2 != nil {
-		return *x.Yobi2
+func (x *Db_TimeCardResponse) GetTimeCard() *Db_TimeCard {
+	if x != nil {
+		return x.TimeCard
 	}
-	return ""
+	return nil
 }
 
-func (x *Db_ChikuMaster) GetYobi3() string {
-	if x != nil && x.Yobi3 != nil {
-		return *x.Yobi3
-	}
-	return ""
+type Db_ListTimeCardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Db_TimeCard         `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalCount    *int32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`     // page_token使用時はinclude_total_count指定時のみ設定
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 次ページのトークン（最終ページの場合は空）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_ChikuMaster) GetYobi4() string {
-	if x != nil && x.Yobi4 != nil {
-		return *x.Yobi4
-	}
-	return ""
+func (x *Db_ListTimeCardResponse) Reset() {
+	*x = Db_ListTimeCardResponse{}
+	mi := &file_db_service_proto_msgTypes[180]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ChikuMaster) GetYobi5() string {
-	if x != nil && x.Yobi5 != nil {
-		return *x.Yobi5
-	}
-	return ""
+func (x *Db_ListTimeCardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Db_ChikuMaster) GetDgrTokuisakiC() string {
+func (*Db_ListTimeCardResponse) ProtoMessage() {}
+
+func (x *Db_ListTimeCardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[180]
 	if x != nil {
-		return x.DgrTokuisakiC
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Db_ChikuMaster) GetDgrTokuisakiH() string {
+// Deprecated: Use Db_ListTimeCardResponse.ProtoReflect.Descriptor instead.
+func (*Db_ListTimeCardResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *Db_ListTimeCardResponse) GetItems() []*Db_TimeCard {
 	if x != nil {
-		return x.DgrTokuisakiH
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-func (x *Db_ChikuMaster) GetDgrHinmeiC() string {
-	if x != nil {
-		return x.DgrHinmeiC
+func (x *Db_ListTimeCardResponse) GetTotalCount() int32 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
 	}
-	return ""
+	return 0
 }
 
-func (x *Db_ChikuMaster) GetDgrHinmeiH() string {
+func (x *Db_ListTimeCardResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.DgrHinmeiH
+		return x.NextPageToken
 	}
 	return ""
 }
 
-// UntenNippoMeisai用リクエスト/レスポンス
-type Db_GetUntenNippoMeisaiRequest struct {
+type Db_CreateTimeCardRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	NippoK        string                 `protobuf:"bytes,1,opt,name=nippo_k,json=nippoK,proto3" json:"nippo_k,omitempty"`
-	HaishaK       string                 `protobuf:"bytes,2,opt,name=haisha_k,json=haishaK,proto3" json:"haisha_k,omitempty"`
-	SharyoC       string                 `protobuf:"bytes,3,opt,name=sharyo_c,json=sharyoC,proto3" json:"sharyo_c,omitempty"`
+	TimeCard      *Db_TimeCard           `protobuf:"bytes,1,opt,name=time_card,json=timeCard,proto3" json:"time_card,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_GetUntenNippoMeisaiRequest) Reset() {
-	*x = Db_GetUntenNippoMeisaiRequest{}
-	mi := &file_db_service_proto_msgTypes[79]
+func (x *Db_CreateTimeCardRequest) Reset() {
+	*x = Db_CreateTimeCardRequest{}
+	mi := &file_db_service_proto_msgTypes[181]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_GetUntenNippoMeisaiRequest) String() string {
+func (x *Db_CreateTimeCardRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_GetUntenNippoMeisaiRequest) ProtoMessage() {}
+func (*Db_CreateTimeCardRequest) ProtoMessage() {}
 
-func (x *Db_GetUntenNippoMeisaiRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[79]
+func (x *Db_CreateTimeCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[181]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Db_GetUntenNippoMeisaiRequest.ProtoReflect.Descriptor instead.
-func (*Db_GetUntenNippoMeisaiRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{79}
-}
-
-func (x *Db_GetUntenNippoMeisaiRequest) GetNippoK() string {
-	if x != nil {
-		return x.NippoK
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Db_GetUntenNippoMeisaiRequest) GetHaishaK() string {
-	if x != nil {
-		return x.HaishaK
-	}
-	return ""
+// Deprecated: Use Db_CreateTimeCardRequest.ProtoReflect.Descriptor instead.
+func (*Db_CreateTimeCardRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{181}
 }
 
-func (x *Db_GetUntenNippoMeisaiRequest) GetSharyoC() string {
+func (x *Db_CreateTimeCardRequest) GetTimeCard() *Db_TimeCard {
 	if x != nil {
-		return x.SharyoC
+		return x.TimeCard
 	}
-	return ""
+	return nil
 }
 
-type Db_GetUntenNippoMeisaiBySharyoCRequest struct {
+type Db_UpdateTimeCardRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SharyoC       string                 `protobuf:"bytes,1,opt,name=sharyo_c,json=sharyoC,proto3" json:"sharyo_c,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	TimeCard      *Db_TimeCard           `protobuf:"bytes,1,opt,name=time_card,json=timeCard,proto3" json:"time_card,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_GetUntenNippoMeisaiBySharyoCRequest) Reset() {
-	*x = Db_GetUntenNippoMeisaiBySharyoCRequest{}
-	mi := &file_db_service_proto_msgTypes[80]
+func (x *Db_UpdateTimeCardRequest) Reset() {
+	*x = Db_UpdateTimeCardRequest{}
+	mi := &file_db_service_proto_msgTypes[182]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_GetUntenNippoMeisaiBySharyoCRequest) String() string {
+func (x *Db_UpdateTimeCardRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_GetUntenNippoMeisaiBySharyoCRequest) ProtoMessage() {}
+func (*Db_UpdateTimeCardRequest) ProtoMessage() {}
 
-func (x *Db_GetUntenNippoMeisaiBySharyoCRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[80]
+func (x *Db_UpdateTimeCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[182]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6873,50 +14820,41 @@ func (x *Db_GetUntenNippoMeisaiBySharyoCRequest) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_GetUntenNippoMeisaiBySharyoCRequest.ProtoReflect.Descriptor instead.
-func (*Db_GetUntenNippoMeisaiBySharyoCRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{80}
-}
-
-func (x *Db_GetUntenNippoMeisaiBySharyoCRequest) GetSharyoC() string {
-	if x != nil {
-		return x.SharyoC
-	}
-	return ""
+// Deprecated: Use Db_UpdateTimeCardRequest.ProtoReflect.Descriptor instead.
+func (*Db_UpdateTimeCardRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{182}
 }
 
-func (x *Db_GetUntenNippoMeisaiBySharyoCRequest) GetLimit() int32 {
+func (x *Db_UpdateTimeCardRequest) GetTimeCard() *Db_TimeCard {
 	if x != nil {
-		return x.Limit
+		return x.TimeCard
 	}
-	return 0
+	return nil
 }
 
-type Db_GetUntenNippoMeisaiByDateRangeRequest struct {
+type Db_DeleteTimeCardRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	Datetime      string                 `protobuf:"bytes,1,opt,name=datetime,proto3" json:"datetime,omitempty"` // RFC3339形式
+	Id            int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_GetUntenNippoMeisaiByDateRangeRequest) Reset() {
-	*x = Db_GetUntenNippoMeisaiByDateRangeRequest{}
-	mi := &file_db_service_proto_msgTypes[81]
+func (x *Db_DeleteTimeCardRequest) Reset() {
+	*x = Db_DeleteTimeCardRequest{}
+	mi := &file_db_service_proto_msgTypes[183]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_GetUntenNippoMeisaiByDateRangeRequest) String() string {
+func (x *Db_DeleteTimeCardRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_GetUntenNippoMeisaiByDateRangeRequest) ProtoMessage() {}
+func (*Db_DeleteTimeCardRequest) ProtoMessage() {}
 
-func (x *Db_GetUntenNippoMeisaiByDateRangeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[81]
+func (x *Db_DeleteTimeCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[183]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6927,63 +14865,55 @@ func (x *Db_GetUntenNippoMeisaiByDateRangeRequest) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_GetUntenNippoMeisaiByDateRangeRequest.ProtoReflect.Descriptor instead.
-func (*Db_GetUntenNippoMeisaiByDateRangeRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{81}
-}
-
-func (x *Db_GetUntenNippoMeisaiByDateRangeRequest) GetStartDate() string {
-	if x != nil {
-		return x.StartDate
-	}
-	return ""
+// Deprecated: Use Db_DeleteTimeCardRequest.ProtoReflect.Descriptor instead.
+func (*Db_DeleteTimeCardRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{183}
 }
 
-func (x *Db_GetUntenNippoMeisaiByDateRangeRequest) GetEndDate() string {
+func (x *Db_DeleteTimeCardRequest) GetDatetime() string {
 	if x != nil {
-		return x.EndDate
+		return x.Datetime
 	}
 	return ""
 }
 
-func (x *Db_GetUntenNippoMeisaiByDateRangeRequest) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-func (x *Db_GetUntenNippoMeisaiByDateRangeRequest) GetOffset() int32 {
+func (x *Db_DeleteTimeCardRequest) GetId() int32 {
 	if x != nil {
-		return x.Offset
+		return x.Id
 	}
 	return 0
 }
 
-type Db_ListUntenNippoMeisaiRequest struct {
+// TimeCardLog メッセージ
+type Db_TimeCardLog struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	OrderBy       *string                `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3,oneof" json:"order_by,omitempty"`
+	Datetime      string                 `protobuf:"bytes,1,opt,name=datetime,proto3" json:"datetime,omitempty"`                                // RFC3339形式のタイムスタンプ
+	Id            int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`                                           // ユーザーID（0はゲスト/不明）
+	CardId        string                 `protobuf:"bytes,3,opt,name=card_id,json=cardId,proto3" json:"card_id,omitempty"`                      // カードID（FeliCa UIDなど）
+	MachineIp     string                 `protobuf:"bytes,4,opt,name=machine_ip,json=machineIp,proto3" json:"machine_ip,omitempty"`             // マシンIP/Reader ID
+	State         string                 `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`                                      // 状態: in/out
+	StateDetail   *string                `protobuf:"bytes,6,opt,name=state_detail,json=stateDetail,proto3,oneof" json:"state_detail,omitempty"` // 状態詳細（オプション）
+	Created       string                 `protobuf:"bytes,7,opt,name=created,proto3" json:"created,omitempty"`                                  // RFC3339形式
+	Modified      string                 `protobuf:"bytes,8,opt,name=modified,proto3" json:"modified,omitempty"`                                // RFC3339形式
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_ListUntenNippoMeisaiRequest) Reset() {
-	*x = Db_ListUntenNippoMeisaiRequest{}
-	mi := &file_db_service_proto_msgTypes[82]
+func (x *Db_TimeCardLog) Reset() {
+	*x = Db_TimeCardLog{}
+	mi := &file_db_service_proto_msgTypes[184]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ListUntenNippoMeisaiRequest) String() string {
+func (x *Db_TimeCardLog) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_ListUntenNippoMeisaiRequest) ProtoMessage() {}
+func (*Db_TimeCardLog) ProtoMessage() {}
 
-func (x *Db_ListUntenNippoMeisaiRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[82]
+func (x *Db_TimeCardLog) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[184]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6994,99 +14924,89 @@ func (x *Db_ListUntenNippoMeisaiRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_ListUntenNippoMeisaiRequest.ProtoReflect.Descriptor instead.
-func (*Db_ListUntenNippoMeisaiRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{82}
+// Deprecated: Use Db_TimeCardLog.ProtoReflect.Descriptor instead.
+func (*Db_TimeCardLog) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{184}
 }
 
-func (x *Db_ListUntenNippoMeisaiRequest) GetLimit() int32 {
+func (x *Db_TimeCardLog) GetDatetime() string {
 	if x != nil {
-		return x.Limit
+		return x.Datetime
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_ListUntenNippoMeisaiRequest) GetOffset() int32 {
+func (x *Db_TimeCardLog) GetId() int32 {
 	if x != nil {
-		return x.Offset
+		return x.Id
 	}
 	return 0
 }
 
-func (x *Db_ListUntenNippoMeisaiRequest) GetOrderBy() string {
-	if x != nil && x.OrderBy != nil {
-		return *x.OrderBy
+func (x *Db_TimeCardLog) GetCardId() string {
+	if x != nil {
+		return x.CardId
 	}
 	return ""
 }
 
-type Db_UntenNippoMeisaiResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	UntenNippoMeisai *Db_UntenNippoMeisai   `protobuf:"bytes,1,opt,name=unten_nippo_meisai,json=untenNippoMeisai,proto3" json:"unten_nippo_meisai,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *Db_TimeCardLog) GetMachineIp() string {
+	if x != nil {
+		return x.MachineIp
+	}
+	return ""
 }
 
-func (x *Db_UntenNippoMeisaiResponse) Reset() {
-	*x = Db_UntenNippoMeisaiResponse{}
-	mi := &file_db_service_proto_msgTypes[83]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Db_TimeCardLog) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
 }
 
-func (x *Db_UntenNippoMeisaiResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Db_TimeCardLog) GetStateDetail() string {
+	if x != nil && x.StateDetail != nil {
+		return *x.StateDetail
+	}
+	return ""
 }
 
-func (*Db_UntenNippoMeisaiResponse) ProtoMessage() {}
-
-func (x *Db_UntenNippoMeisaiResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[83]
+func (x *Db_TimeCardLog) GetCreated() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Created
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Db_UntenNippoMeisaiResponse.ProtoReflect.Descriptor instead.
-func (*Db_UntenNippoMeisaiResponse) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{83}
+	return ""
 }
 
-func (x *Db_UntenNippoMeisaiResponse) GetUntenNippoMeisai() *Db_UntenNippoMeisai {
+func (x *Db_TimeCardLog) GetModified() string {
 	if x != nil {
-		return x.UntenNippoMeisai
+		return x.Modified
 	}
-	return nil
+	return ""
 }
 
-type Db_ListUntenNippoMeisaiResponse struct {
+type Db_CreateTimeCardLogRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*Db_UntenNippoMeisai `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Log           *Db_TimeCardLog        `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_ListUntenNippoMeisaiResponse) Reset() {
-	*x = Db_ListUntenNippoMeisaiResponse{}
-	mi := &file_db_service_proto_msgTypes[84]
+func (x *Db_CreateTimeCardLogRequest) Reset() {
+	*x = Db_CreateTimeCardLogRequest{}
+	mi := &file_db_service_proto_msgTypes[185]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ListUntenNippoMeisaiResponse) String() string {
+func (x *Db_CreateTimeCardLogRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_ListUntenNippoMeisaiResponse) ProtoMessage() {}
+func (*Db_CreateTimeCardLogRequest) ProtoMessage() {}
 
-func (x *Db_ListUntenNippoMeisaiResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[84]
+func (x *Db_CreateTimeCardLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[185]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7097,48 +15017,41 @@ func (x *Db_ListUntenNippoMeisaiResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_ListUntenNippoMeisaiResponse.ProtoReflect.Descriptor instead.
-func (*Db_ListUntenNippoMeisaiResponse) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{84}
+// Deprecated: Use Db_CreateTimeCardLogRequest.ProtoReflect.Descriptor instead.
+func (*Db_CreateTimeCardLogRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{185}
 }
 
-func (x *Db_ListUntenNippoMeisaiResponse) GetItems() []*Db_UntenNippoMeisai {
+func (x *Db_CreateTimeCardLogRequest) GetLog() *Db_TimeCardLog {
 	if x != nil {
-		return x.Items
+		return x.Log
 	}
 	return nil
 }
 
-func (x *Db_ListUntenNippoMeisaiResponse) GetTotalCount() int32 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
-}
-
-// ShainMaster用リクエスト/レスポンス
-type Db_GetShainMasterRequest struct {
+type Db_GetTimeCardLogRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShainC        string                 `protobuf:"bytes,1,opt,name=shain_c,json=shainC,proto3" json:"shain_c,omitempty"`
+	Datetime      string                 `protobuf:"bytes,1,opt,name=datetime,proto3" json:"datetime,omitempty"` // RFC3339形式のタイムスタンプ
+	Id            int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`            // ユーザーID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_GetShainMasterRequest) Reset() {
-	*x = Db_GetShainMasterRequest{}
-	mi := &file_db_service_proto_msgTypes[85]
+func (x *Db_GetTimeCardLogRequest) Reset() {
+	*x = Db_GetTimeCardLogRequest{}
+	mi := &file_db_service_proto_msgTypes[186]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_GetShainMasterRequest) String() string {
+func (x *Db_GetTimeCardLogRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_GetShainMasterRequest) ProtoMessage() {}
+func (*Db_GetTimeCardLogRequest) ProtoMessage() {}
 
-func (x *Db_GetShainMasterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[85]
+func (x *Db_GetTimeCardLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[186]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7149,40 +15062,47 @@ func (x *Db_GetShainMasterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_GetShainMasterRequest.ProtoReflect.Descriptor instead.
-func (*Db_GetShainMasterRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{85}
+// Deprecated: Use Db_GetTimeCardLogRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetTimeCardLogRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{186}
+}
+
+func (x *Db_GetTimeCardLogRequest) GetDatetime() string {
+	if x != nil {
+		return x.Datetime
+	}
+	return ""
 }
 
-func (x *Db_GetShainMasterRequest) GetShainC() string {
+func (x *Db_GetTimeCardLogRequest) GetId() int32 {
 	if x != nil {
-		return x.ShainC
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-type Db_GetShainMasterByBumonCRequest struct {
+type Db_UpdateTimeCardLogRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	BumonC        string                 `protobuf:"bytes,1,opt,name=bumon_c,json=bumonC,proto3" json:"bumon_c,omitempty"`
+	Log           *Db_TimeCardLog        `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_GetShainMasterByBumonCRequest) Reset() {
-	*x = Db_GetShainMasterByBumonCRequest{}
-	mi := &file_db_service_proto_msgTypes[86]
+func (x *Db_UpdateTimeCardLogRequest) Reset() {
+	*x = Db_UpdateTimeCardLogRequest{}
+	mi := &file_db_service_proto_msgTypes[187]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_GetShainMasterByBumonCRequest) String() string {
+func (x *Db_UpdateTimeCardLogRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_GetShainMasterByBumonCRequest) ProtoMessage() {}
+func (*Db_UpdateTimeCardLogRequest) ProtoMessage() {}
 
-func (x *Db_GetShainMasterByBumonCRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[86]
+func (x *Db_UpdateTimeCardLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[187]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7193,42 +15113,41 @@ func (x *Db_GetShainMasterByBumonCRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_GetShainMasterByBumonCRequest.ProtoReflect.Descriptor instead.
-func (*Db_GetShainMasterByBumonCRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{86}
+// Deprecated: Use Db_UpdateTimeCardLogRequest.ProtoReflect.Descriptor instead.
+func (*Db_UpdateTimeCardLogRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{187}
 }
 
-func (x *Db_GetShainMasterByBumonCRequest) GetBumonC() string {
+func (x *Db_UpdateTimeCardLogRequest) GetLog() *Db_TimeCardLog {
 	if x != nil {
-		return x.BumonC
+		return x.Log
 	}
-	return ""
+	return nil
 }
 
-type Db_ListShainMasterRequest struct {
+type Db_DeleteTimeCardLogRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	OrderBy       *string                `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3,oneof" json:"order_by,omitempty"`
+	Datetime      string                 `protobuf:"bytes,1,opt,name=datetime,proto3" json:"datetime,omitempty"` // RFC3339形式のタイムスタンプ
+	Id            int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`            // ユーザーID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_ListShainMasterRequest) Reset() {
-	*x = Db_ListShainMasterRequest{}
-	mi := &file_db_service_proto_msgTypes[87]
+func (x *Db_DeleteTimeCardLogRequest) Reset() {
+	*x = Db_DeleteTimeCardLogRequest{}
+	mi := &file_db_service_proto_msgTypes[188]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ListShainMasterRequest) String() string {
+func (x *Db_DeleteTimeCardLogRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_ListShainMasterRequest) ProtoMessage() {}
+func (*Db_DeleteTimeCardLogRequest) ProtoMessage() {}
 
-func (x *Db_ListShainMasterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[87]
+func (x *Db_DeleteTimeCardLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[188]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7239,54 +15158,48 @@ func (x *Db_ListShainMasterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_ListShainMasterRequest.ProtoReflect.Descriptor instead.
-func (*Db_ListShainMasterRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{87}
+// Deprecated: Use Db_DeleteTimeCardLogRequest.ProtoReflect.Descriptor instead.
+func (*Db_DeleteTimeCardLogRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{188}
 }
 
-func (x *Db_ListShainMasterRequest) GetLimit() int32 {
+func (x *Db_DeleteTimeCardLogRequest) GetDatetime() string {
 	if x != nil {
-		return x.Limit
+		return x.Datetime
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_ListShainMasterRequest) GetOffset() int32 {
+func (x *Db_DeleteTimeCardLogRequest) GetId() int32 {
 	if x != nil {
-		return x.Offset
+		return x.Id
 	}
 	return 0
 }
 
-func (x *Db_ListShainMasterRequest) GetOrderBy() string {
-	if x != nil && x.OrderBy != nil {
-		return *x.OrderBy
-	}
-	return ""
-}
-
-type Db_ShainMasterResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShainMaster   *Db_ShainMaster        `protobuf:"bytes,1,opt,name=shain_master,json=shainMaster,proto3" json:"shain_master,omitempty"`
+type Db_TimeCardLogSortSpec struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Field         Db_TimeCardLogSortField `protobuf:"varint,1,opt,name=field,proto3,enum=db_service.Db_TimeCardLogSortField" json:"field,omitempty"`
+	Direction     Db_SortDirection        `protobuf:"varint,2,opt,name=direction,proto3,enum=db_service.Db_SortDirection" json:"direction,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_ShainMasterResponse) Reset() {
-	*x = Db_ShainMasterResponse{}
-	mi := &file_db_service_proto_msgTypes[88]
+func (x *Db_TimeCardLogSortSpec) Reset() {
+	*x = Db_TimeCardLogSortSpec{}
+	mi := &file_db_service_proto_msgTypes[189]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ShainMasterResponse) String() string {
+func (x *Db_TimeCardLogSortSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_ShainMasterResponse) ProtoMessage() {}
+func (*Db_TimeCardLogSortSpec) ProtoMessage() {}
 
-func (x *Db_ShainMasterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[88]
+func (x *Db_TimeCardLogSortSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[189]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7297,41 +15210,52 @@ func (x *Db_ShainMasterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_ShainMasterResponse.ProtoReflect.Descriptor instead.
-func (*Db_ShainMasterResponse) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{88}
+// Deprecated: Use Db_TimeCardLogSortSpec.ProtoReflect.Descriptor instead.
+func (*Db_TimeCardLogSortSpec) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{189}
 }
 
-func (x *Db_ShainMasterResponse) GetShainMaster() *Db_ShainMaster {
+func (x *Db_TimeCardLogSortSpec) GetField() Db_TimeCardLogSortField {
 	if x != nil {
-		return x.ShainMaster
+		return x.Field
 	}
-	return nil
+	return Db_TimeCardLogSortField_TIME_CARD_LOG_SORT_FIELD_UNSPECIFIED
 }
 
-type Db_ListShainMasterResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*Db_ShainMaster      `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Db_TimeCardLogSortSpec) GetDirection() Db_SortDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return Db_SortDirection_SORT_DIRECTION_UNSPECIFIED
 }
 
-func (x *Db_ListShainMasterResponse) Reset() {
-	*x = Db_ListShainMasterResponse{}
-	mi := &file_db_service_proto_msgTypes[89]
+type Db_ListTimeCardLogRequest struct {
+	state             protoimpl.MessageState    `protogen:"open.v1"`
+	Limit             int32                     `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset            int32                     `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	OrderBy           *string                   `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3,oneof" json:"order_by,omitempty"`                            // 非推奨: sortを使用（ソート可能なprotoのフィールド名のみ指定可）
+	PageToken         *string                   `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`                      // キーセットページネーション用トークン（指定時はoffset/order_byは使用不可、初回は空文字）
+	IncludeTotalCount bool                      `protobuf:"varint,5,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"` // page_token使用時もtotal_countを取得する（COUNT(*)を実行）
+	Sort              []*Db_TimeCardLogSortSpec `protobuf:"bytes,6,rep,name=sort,proto3" json:"sort,omitempty"`                                                       // ソート条件（複数指定可）
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Db_ListTimeCardLogRequest) Reset() {
+	*x = Db_ListTimeCardLogRequest{}
+	mi := &file_db_service_proto_msgTypes[190]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ListShainMasterResponse) String() string {
+func (x *Db_ListTimeCardLogRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_ListShainMasterResponse) ProtoMessage() {}
+func (*Db_ListTimeCardLogRequest) ProtoMessage() {}
 
-func (x *Db_ListShainMasterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[89]
+func (x *Db_ListTimeCardLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[190]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7342,94 +15266,77 @@ func (x *Db_ListShainMasterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_ListShainMasterResponse.ProtoReflect.Descriptor instead.
-func (*Db_ListShainMasterResponse) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{89}
+// Deprecated: Use Db_ListTimeCardLogRequest.ProtoReflect.Descriptor instead.
+func (*Db_ListTimeCardLogRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{190}
 }
 
-func (x *Db_ListShainMasterResponse) GetItems() []*Db_ShainMaster {
+func (x *Db_ListTimeCardLogRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Items
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-func (x *Db_ListShainMasterResponse) GetTotalCount() int32 {
+func (x *Db_ListTimeCardLogRequest) GetOffset() int32 {
 	if x != nil {
-		return x.TotalCount
+		return x.Offset
 	}
 	return 0
 }
 
-// ChiikiMaster用リクエスト/レスポンス
-type Db_GetChiikiMasterRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ChiikiC       string                 `protobuf:"bytes,1,opt,name=chiiki_c,json=chiikiC,proto3" json:"chiiki_c,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *Db_GetChiikiMasterRequest) Reset() {
-	*x = Db_GetChiikiMasterRequest{}
-	mi := &file_db_service_proto_msgTypes[90]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Db_ListTimeCardLogRequest) GetOrderBy() string {
+	if x != nil && x.OrderBy != nil {
+		return *x.OrderBy
+	}
+	return ""
 }
 
-func (x *Db_GetChiikiMasterRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Db_ListTimeCardLogRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
 }
 
-func (*Db_GetChiikiMasterRequest) ProtoMessage() {}
-
-func (x *Db_GetChiikiMasterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[90]
+func (x *Db_ListTimeCardLogRequest) GetIncludeTotalCount() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.IncludeTotalCount
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Db_GetChiikiMasterRequest.ProtoReflect.Descriptor instead.
-func (*Db_GetChiikiMasterRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{90}
+	return false
 }
 
-func (x *Db_GetChiikiMasterRequest) GetChiikiC() string {
+func (x *Db_ListTimeCardLogRequest) GetSort() []*Db_TimeCardLogSortSpec {
 	if x != nil {
-		return x.ChiikiC
+		return x.Sort
 	}
-	return ""
+	return nil
 }
 
-type Db_ListChiikiMasterRequest struct {
+type Db_GetByCardIDRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	OrderBy       *string                `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3,oneof" json:"order_by,omitempty"`
+	CardId        string                 `protobuf:"bytes,1,opt,name=card_id,json=cardId,proto3" json:"card_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_ListChiikiMasterRequest) Reset() {
-	*x = Db_ListChiikiMasterRequest{}
-	mi := &file_db_service_proto_msgTypes[91]
+func (x *Db_GetByCardIDRequest) Reset() {
+	*x = Db_GetByCardIDRequest{}
+	mi := &file_db_service_proto_msgTypes[191]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ListChiikiMasterRequest) String() string {
+func (x *Db_GetByCardIDRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_ListChiikiMasterRequest) ProtoMessage() {}
+func (*Db_GetByCardIDRequest) ProtoMessage() {}
 
-func (x *Db_ListChiikiMasterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[91]
+func (x *Db_GetByCardIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[191]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7440,54 +15347,54 @@ func (x *Db_ListChiikiMasterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_ListChiikiMasterRequest.ProtoReflect.Descriptor instead.
-func (*Db_ListChiikiMasterRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{91}
+// Deprecated: Use Db_GetByCardIDRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetByCardIDRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{191}
 }
 
-func (x *Db_ListChiikiMasterRequest) GetLimit() int32 {
+func (x *Db_GetByCardIDRequest) GetCardId() string {
 	if x != nil {
-		return x.Limit
+		return x.CardId
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_ListChiikiMasterRequest) GetOffset() int32 {
+func (x *Db_GetByCardIDRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Offset
+		return x.Limit
 	}
 	return 0
 }
 
-func (x *Db_ListChiikiMasterRequest) GetOrderBy() string {
-	if x != nil && x.OrderBy != nil {
-		return *x.OrderBy
+func (x *Db_GetByCardIDRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
 	}
-	return ""
+	return 0
 }
 
-type Db_ChiikiMasterResponse struct {
+type Db_TimeCardLogResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ChiikiMaster  *Db_ChiikiMaster       `protobuf:"bytes,1,opt,name=chiiki_master,json=chiikiMaster,proto3" json:"chiiki_master,omitempty"`
+	Log           *Db_TimeCardLog        `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_ChiikiMasterResponse) Reset() {
-	*x = Db_ChiikiMasterResponse{}
-	mi := &file_db_service_proto_msgTypes[92]
+func (x *Db_TimeCardLogResponse) Reset() {
+	*x = Db_TimeCardLogResponse{}
+	mi := &file_db_service_proto_msgTypes[192]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ChiikiMasterResponse) String() string {
+func (x *Db_TimeCardLogResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_ChiikiMasterResponse) ProtoMessage() {}
+func (*Db_TimeCardLogResponse) ProtoMessage() {}
 
-func (x *Db_ChiikiMasterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[92]
+func (x *Db_TimeCardLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[192]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7498,41 +15405,42 @@ func (x *Db_ChiikiMasterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_ChiikiMasterResponse.ProtoReflect.Descriptor instead.
-func (*Db_ChiikiMasterResponse) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{92}
+// Deprecated: Use Db_TimeCardLogResponse.ProtoReflect.Descriptor instead.
+func (*Db_TimeCardLogResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{192}
 }
 
-func (x *Db_ChiikiMasterResponse) GetChiikiMaster() *Db_ChiikiMaster {
+func (x *Db_TimeCardLogResponse) GetLog() *Db_TimeCardLog {
 	if x != nil {
-		return x.ChiikiMaster
+		return x.Log
 	}
 	return nil
 }
 
-type Db_ListChiikiMasterResponse struct {
+type Db_ListTimeCardLogResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*Db_ChiikiMaster     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Items         []*Db_TimeCardLog      `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalCount    *int32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`     // page_token使用時はinclude_total_count指定時のみ設定
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 次ページのトークン（最終ページの場合は空）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_ListChiikiMasterResponse) Reset() {
-	*x = Db_ListChiikiMasterResponse{}
-	mi := &file_db_service_proto_msgTypes[93]
+func (x *Db_ListTimeCardLogResponse) Reset() {
+	*x = Db_ListTimeCardLogResponse{}
+	mi := &file_db_service_proto_msgTypes[193]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ListChiikiMasterResponse) String() string {
+func (x *Db_ListTimeCardLogResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_ListChiikiMasterResponse) ProtoMessage() {}
+func (*Db_ListTimeCardLogResponse) ProtoMessage() {}
 
-func (x *Db_ListChiikiMasterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[93]
+func (x *Db_ListTimeCardLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[193]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7543,48 +15451,63 @@ func (x *Db_ListChiikiMasterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_ListChiikiMasterResponse.ProtoReflect.Descriptor instead.
-func (*Db_ListChiikiMasterResponse) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{93}
+// Deprecated: Use Db_ListTimeCardLogResponse.ProtoReflect.Descriptor instead.
+func (*Db_ListTimeCardLogResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{193}
 }
 
-func (x *Db_ListChiikiMasterResponse) GetItems() []*Db_ChiikiMaster {
+func (x *Db_ListTimeCardLogResponse) GetItems() []*Db_TimeCardLog {
 	if x != nil {
 		return x.Items
 	}
 	return nil
 }
 
-func (x *Db_ListChiikiMasterResponse) GetTotalCount() int32 {
-	if x != nil {
-		return x.TotalCount
+func (x *Db_ListTimeCardLogResponse) GetTotalCount() int32 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
 	}
 	return 0
 }
 
-// ChikuMaster用リクエスト/レスポンス
-type Db_GetChikuMasterRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ChikuC        string                 `protobuf:"bytes,1,opt,name=chiku_c,json=chikuC,proto3" json:"chiku_c,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Db_ListTimeCardLogResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
 }
 
-func (x *Db_GetChikuMasterRequest) Reset() {
-	*x = Db_GetChikuMasterRequest{}
-	mi := &file_db_service_proto_msgTypes[94]
+// EmployeeIdentity用メッセージ
+type Db_EmployeeIdentity struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DriverId        *int32                 `protobuf:"varint,3,opt,name=driver_id,json=driverId,proto3,oneof" json:"driver_id,omitempty"`                        // 本番DBのdrivers.id
+	ShainC          *string                `protobuf:"bytes,4,opt,name=shain_c,json=shainC,proto3,oneof" json:"shain_c,omitempty"`                               // 一番星の社員ﾏｽﾀ.社員C（運転日報明細の運転手Cと同じ）
+	DtakoDriverCode *int32                 `protobuf:"varint,5,opt,name=dtako_driver_code,json=dtakoDriverCode,proto3,oneof" json:"dtako_driver_code,omitempty"` // dtako_rowsの乗務員CD1・対象乗務員CD
+	TimeCardUserId  *int32                 `protobuf:"varint,6,opt,name=time_card_user_id,json=timeCardUserId,proto3,oneof" json:"time_card_user_id,omitempty"`  // timecard_logs.id（0は登録不可）
+	CreatedAt       string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                            // RFC3339形式
+	UpdatedAt       string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                            // RFC3339形式
+	Notes           *string                `protobuf:"bytes,9,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Db_EmployeeIdentity) Reset() {
+	*x = Db_EmployeeIdentity{}
+	mi := &file_db_service_proto_msgTypes[194]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_GetChikuMasterRequest) String() string {
+func (x *Db_EmployeeIdentity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_GetChikuMasterRequest) ProtoMessage() {}
+func (*Db_EmployeeIdentity) ProtoMessage() {}
 
-func (x *Db_GetChikuMasterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[94]
+func (x *Db_EmployeeIdentity) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[194]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7595,86 +15518,99 @@ func (x *Db_GetChikuMasterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_GetChikuMasterRequest.ProtoReflect.Descriptor instead.
-func (*Db_GetChikuMasterRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{94}
+// Deprecated: Use Db_EmployeeIdentity.ProtoReflect.Descriptor instead.
+func (*Db_EmployeeIdentity) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{194}
 }
 
-func (x *Db_GetChikuMasterRequest) GetChikuC() string {
+func (x *Db_EmployeeIdentity) GetId() int64 {
 	if x != nil {
-		return x.ChikuC
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Db_EmployeeIdentity) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-type Db_GetChikuMasterByChiikiCRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ChiikiC       string                 `protobuf:"bytes,1,opt,name=chiiki_c,json=chiikiC,proto3" json:"chiiki_c,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Db_EmployeeIdentity) GetDriverId() int32 {
+	if x != nil && x.DriverId != nil {
+		return *x.DriverId
+	}
+	return 0
 }
 
-func (x *Db_GetChikuMasterByChiikiCRequest) Reset() {
-	*x = Db_GetChikuMasterByChiikiCRequest{}
-	mi := &file_db_service_proto_msgTypes[95]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Db_EmployeeIdentity) GetShainC() string {
+	if x != nil && x.ShainC != nil {
+		return *x.ShainC
+	}
+	return ""
 }
 
-func (x *Db_GetChikuMasterByChiikiCRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Db_EmployeeIdentity) GetDtakoDriverCode() int32 {
+	if x != nil && x.DtakoDriverCode != nil {
+		return *x.DtakoDriverCode
+	}
+	return 0
 }
 
-func (*Db_GetChikuMasterByChiikiCRequest) ProtoMessage() {}
+func (x *Db_EmployeeIdentity) GetTimeCardUserId() int32 {
+	if x != nil && x.TimeCardUserId != nil {
+		return *x.TimeCardUserId
+	}
+	return 0
+}
 
-func (x *Db_GetChikuMasterByChiikiCRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[95]
+func (x *Db_EmployeeIdentity) GetCreatedAt() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.CreatedAt
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use Db_GetChikuMasterByChiikiCRequest.ProtoReflect.Descriptor instead.
-func (*Db_GetChikuMasterByChiikiCRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{95}
+func (x *Db_EmployeeIdentity) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
 }
 
-func (x *Db_GetChikuMasterByChiikiCRequest) GetChiikiC() string {
-	if x != nil {
-		return x.ChiikiC
+func (x *Db_EmployeeIdentity) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
 	}
 	return ""
 }
 
-type Db_ListChikuMasterRequest struct {
+type Db_EmployeeCard struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	OrderBy       *string                `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3,oneof" json:"order_by,omitempty"`
+	CardId        string                 `protobuf:"bytes,1,opt,name=card_id,json=cardId,proto3" json:"card_id,omitempty"` // FeliCaのカードID（timecard_logs.card_id）
+	EmployeeId    int64                  `protobuf:"varint,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC3339形式
+	CreatedBy     string                 `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_ListChikuMasterRequest) Reset() {
-	*x = Db_ListChikuMasterRequest{}
-	mi := &file_db_service_proto_msgTypes[96]
+func (x *Db_EmployeeCard) Reset() {
+	*x = Db_EmployeeCard{}
+	mi := &file_db_service_proto_msgTypes[195]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ListChikuMasterRequest) String() string {
+func (x *Db_EmployeeCard) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_ListChikuMasterRequest) ProtoMessage() {}
+func (*Db_EmployeeCard) ProtoMessage() {}
 
-func (x *Db_ListChikuMasterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[96]
+func (x *Db_EmployeeCard) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[195]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7685,54 +15621,61 @@ func (x *Db_ListChikuMasterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_ListChikuMasterRequest.ProtoReflect.Descriptor instead.
-func (*Db_ListChikuMasterRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{96}
+// Deprecated: Use Db_EmployeeCard.ProtoReflect.Descriptor instead.
+func (*Db_EmployeeCard) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{195}
 }
 
-func (x *Db_ListChikuMasterRequest) GetLimit() int32 {
+func (x *Db_EmployeeCard) GetCardId() string {
 	if x != nil {
-		return x.Limit
+		return x.CardId
 	}
-	return 0
+	return ""
 }
 
-func (x *Db_ListChikuMasterRequest) GetOffset() int32 {
+func (x *Db_EmployeeCard) GetEmployeeId() int64 {
 	if x != nil {
-		return x.Offset
+		return x.EmployeeId
 	}
 	return 0
 }
 
-func (x *Db_ListChikuMasterRequest) GetOrderBy() string {
-	if x != nil && x.OrderBy != nil {
-		return *x.OrderBy
+func (x *Db_EmployeeCard) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return ""
 }
 
-type Db_ChikuMasterResponse struct {
+func (x *Db_EmployeeCard) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type Db_CreateEmployeeIdentityRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ChikuMaster   *Db_ChikuMaster        `protobuf:"bytes,1,opt,name=chiku_master,json=chikuMaster,proto3" json:"chiku_master,omitempty"`
+	Employee      *Db_EmployeeIdentity   `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_ChikuMasterResponse) Reset() {
-	*x = Db_ChikuMasterResponse{}
-	mi := &file_db_service_proto_msgTypes[97]
+func (x *Db_CreateEmployeeIdentityRequest) Reset() {
+	*x = Db_CreateEmployeeIdentityRequest{}
+	mi := &file_db_service_proto_msgTypes[196]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ChikuMasterResponse) String() string {
+func (x *Db_CreateEmployeeIdentityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_ChikuMasterResponse) ProtoMessage() {}
+func (*Db_CreateEmployeeIdentityRequest) ProtoMessage() {}
 
-func (x *Db_ChikuMasterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[97]
+func (x *Db_CreateEmployeeIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[196]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7743,41 +15686,40 @@ func (x *Db_ChikuMasterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_ChikuMasterResponse.ProtoReflect.Descriptor instead.
-func (*Db_ChikuMasterResponse) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{97}
+// Deprecated: Use Db_CreateEmployeeIdentityRequest.ProtoReflect.Descriptor instead.
+func (*Db_CreateEmployeeIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{196}
 }
 
-func (x *Db_ChikuMasterResponse) GetChikuMaster() *Db_ChikuMaster {
+func (x *Db_CreateEmployeeIdentityRequest) GetEmployee() *Db_EmployeeIdentity {
 	if x != nil {
-		return x.ChikuMaster
+		return x.Employee
 	}
 	return nil
 }
 
-type Db_ListChikuMasterResponse struct {
+type Db_GetEmployeeIdentityRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*Db_ChikuMaster      `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_ListChikuMasterResponse) Reset() {
-	*x = Db_ListChikuMasterResponse{}
-	mi := &file_db_service_proto_msgTypes[98]
+func (x *Db_GetEmployeeIdentityRequest) Reset() {
+	*x = Db_GetEmployeeIdentityRequest{}
+	mi := &file_db_service_proto_msgTypes[197]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ListChikuMasterResponse) String() string {
+func (x *Db_GetEmployeeIdentityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_ListChikuMasterResponse) ProtoMessage() {}
+func (*Db_GetEmployeeIdentityRequest) ProtoMessage() {}
 
-func (x *Db_ListChikuMasterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[98]
+func (x *Db_GetEmployeeIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[197]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7788,54 +15730,40 @@ func (x *Db_ListChikuMasterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_ListChikuMasterResponse.ProtoReflect.Descriptor instead.
-func (*Db_ListChikuMasterResponse) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{98}
-}
-
-func (x *Db_ListChikuMasterResponse) GetItems() []*Db_ChikuMaster {
-	if x != nil {
-		return x.Items
-	}
-	return nil
+// Deprecated: Use Db_GetEmployeeIdentityRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetEmployeeIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{197}
 }
 
-func (x *Db_ListChikuMasterResponse) GetTotalCount() int32 {
+func (x *Db_GetEmployeeIdentityRequest) GetId() int64 {
 	if x != nil {
-		return x.TotalCount
+		return x.Id
 	}
 	return 0
 }
 
-// TimeCard用メッセージ
-type Db_TimeCard struct {
+type Db_UpdateEmployeeIdentityRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Datetime      string                 `protobuf:"bytes,1,opt,name=datetime,proto3" json:"datetime,omitempty"` // RFC3339形式
-	Id            int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	MachineIp     string                 `protobuf:"bytes,3,opt,name=machine_ip,json=machineIp,proto3" json:"machine_ip,omitempty"`
-	State         string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
-	StateDetail   *string                `protobuf:"bytes,5,opt,name=state_detail,json=stateDetail,proto3,oneof" json:"state_detail,omitempty"`
-	Created       string                 `protobuf:"bytes,6,opt,name=created,proto3" json:"created,omitempty"`   // RFC3339形式
-	Modified      string                 `protobuf:"bytes,7,opt,name=modified,proto3" json:"modified,omitempty"` // RFC3339形式
+	Employee      *Db_EmployeeIdentity   `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_TimeCard) Reset() {
-	*x = Db_TimeCard{}
-	mi := &file_db_service_proto_msgTypes[99]
+func (x *Db_UpdateEmployeeIdentityRequest) Reset() {
+	*x = Db_UpdateEmployeeIdentityRequest{}
+	mi := &file_db_service_proto_msgTypes[198]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_TimeCard) String() string {
+func (x *Db_UpdateEmployeeIdentityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_TimeCard) ProtoMessage() {}
+func (*Db_UpdateEmployeeIdentityRequest) ProtoMessage() {}
 
-func (x *Db_TimeCard) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[99]
+func (x *Db_UpdateEmployeeIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[198]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7846,83 +15774,40 @@ func (x *Db_TimeCard) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_TimeCard.ProtoReflect.Descriptor instead.
-func (*Db_TimeCard) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{99}
-}
-
-func (x *Db_TimeCard) GetDatetime() string {
-	if x != nil {
-		return x.Datetime
-	}
-	return ""
-}
-
-func (x *Db_TimeCard) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *Db_TimeCard) GetMachineIp() string {
-	if x != nil {
-		return x.MachineIp
-	}
-	return ""
-}
-
-func (x *Db_TimeCard) GetState() string {
-	if x != nil {
-		return x.State
-	}
-	return ""
-}
-
-func (x *Db_TimeCard) GetStateDetail() string {
-	if x != nil && x.StateDetail != nil {
-		return *x.StateDetail
-	}
-	return ""
-}
-
-func (x *Db_TimeCard) GetCreated() string {
-	if x != nil {
-		return x.Created
-	}
-	return ""
+// Deprecated: Use Db_UpdateEmployeeIdentityRequest.ProtoReflect.Descriptor instead.
+func (*Db_UpdateEmployeeIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{198}
 }
 
-func (x *Db_TimeCard) GetModified() string {
+func (x *Db_UpdateEmployeeIdentityRequest) GetEmployee() *Db_EmployeeIdentity {
 	if x != nil {
-		return x.Modified
+		return x.Employee
 	}
-	return ""
+	return nil
 }
 
-type Db_GetTimeCardRequest struct {
+type Db_DeleteEmployeeIdentityRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Datetime      string                 `protobuf:"bytes,1,opt,name=datetime,proto3" json:"datetime,omitempty"` // RFC3339形式
-	Id            int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_GetTimeCardRequest) Reset() {
-	*x = Db_GetTimeCardRequest{}
-	mi := &file_db_service_proto_msgTypes[100]
+func (x *Db_DeleteEmployeeIdentityRequest) Reset() {
+	*x = Db_DeleteEmployeeIdentityRequest{}
+	mi := &file_db_service_proto_msgTypes[199]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_GetTimeCardRequest) String() string {
+func (x *Db_DeleteEmployeeIdentityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_GetTimeCardRequest) ProtoMessage() {}
+func (*Db_DeleteEmployeeIdentityRequest) ProtoMessage() {}
 
-func (x *Db_GetTimeCardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[100]
+func (x *Db_DeleteEmployeeIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[199]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7933,49 +15818,43 @@ func (x *Db_GetTimeCardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_GetTimeCardRequest.ProtoReflect.Descriptor instead.
-func (*Db_GetTimeCardRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{100}
-}
-
-func (x *Db_GetTimeCardRequest) GetDatetime() string {
-	if x != nil {
-		return x.Datetime
-	}
-	return ""
+// Deprecated: Use Db_DeleteEmployeeIdentityRequest.ProtoReflect.Descriptor instead.
+func (*Db_DeleteEmployeeIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{199}
 }
 
-func (x *Db_GetTimeCardRequest) GetId() int32 {
+func (x *Db_DeleteEmployeeIdentityRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type Db_ListTimeCardRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	OrderBy       *string                `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3,oneof" json:"order_by,omitempty"` // 例: "datetime DESC", "id ASC"
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type Db_ListEmployeeIdentityRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Limit             int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset            int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	PageToken         *string                `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`                      // キーセットページネーション用トークン（指定時はoffsetは使用不可、初回は空文字）
+	IncludeTotalCount bool                   `protobuf:"varint,4,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"` // page_token使用時もtotal_countを取得する（COUNT(*)を実行）
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *Db_ListTimeCardRequest) Reset() {
-	*x = Db_ListTimeCardRequest{}
-	mi := &file_db_service_proto_msgTypes[101]
+func (x *Db_ListEmployeeIdentityRequest) Reset() {
+	*x = Db_ListEmployeeIdentityRequest{}
+	mi := &file_db_service_proto_msgTypes[200]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ListTimeCardRequest) String() string {
+func (x *Db_ListEmployeeIdentityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_ListTimeCardRequest) ProtoMessage() {}
+func (*Db_ListEmployeeIdentityRequest) ProtoMessage() {}
 
-func (x *Db_ListTimeCardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[101]
+func (x *Db_ListEmployeeIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[200]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7986,54 +15865,62 @@ func (x *Db_ListTimeCardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_ListTimeCardRequest.ProtoReflect.Descriptor instead.
-func (*Db_ListTimeCardRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{101}
+// Deprecated: Use Db_ListEmployeeIdentityRequest.ProtoReflect.Descriptor instead.
+func (*Db_ListEmployeeIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{200}
 }
 
-func (x *Db_ListTimeCardRequest) GetLimit() int32 {
+func (x *Db_ListEmployeeIdentityRequest) GetLimit() int32 {
 	if x != nil {
 		return x.Limit
 	}
 	return 0
 }
 
-func (x *Db_ListTimeCardRequest) GetOffset() int32 {
+func (x *Db_ListEmployeeIdentityRequest) GetOffset() int32 {
 	if x != nil {
 		return x.Offset
 	}
 	return 0
 }
 
-func (x *Db_ListTimeCardRequest) GetOrderBy() string {
-	if x != nil && x.OrderBy != nil {
-		return *x.OrderBy
+func (x *Db_ListEmployeeIdentityRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+func (x *Db_ListEmployeeIdentityRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
 	}
-	return ""
+	return false
 }
 
-type Db_TimeCardResponse struct {
+type Db_EmployeeIdentityResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TimeCard      *Db_TimeCard           `protobuf:"bytes,1,opt,name=time_card,json=timeCard,proto3" json:"time_card,omitempty"`
+	Employee      *Db_EmployeeIdentity   `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+	Cards         []*Db_EmployeeCard     `protobuf:"bytes,2,rep,name=cards,proto3" json:"cards,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_TimeCardResponse) Reset() {
-	*x = Db_TimeCardResponse{}
-	mi := &file_db_service_proto_msgTypes[102]
+func (x *Db_EmployeeIdentityResponse) Reset() {
+	*x = Db_EmployeeIdentityResponse{}
+	mi := &file_db_service_proto_msgTypes[201]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_TimeCardResponse) String() string {
+func (x *Db_EmployeeIdentityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_TimeCardResponse) ProtoMessage() {}
+func (*Db_EmployeeIdentityResponse) ProtoMessage() {}
 
-func (x *Db_TimeCardResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[102]
+func (x *Db_EmployeeIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[201]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8044,41 +15931,49 @@ func (x *Db_TimeCardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_TimeCardResponse.ProtoReflect.Descriptor instead.
-func (*Db_TimeCardResponse) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{102}
+// Deprecated: Use Db_EmployeeIdentityResponse.ProtoReflect.Descriptor instead.
+func (*Db_EmployeeIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{201}
 }
 
-func (x *Db_TimeCardResponse) GetTimeCard() *Db_TimeCard {
+func (x *Db_EmployeeIdentityResponse) GetEmployee() *Db_EmployeeIdentity {
 	if x != nil {
-		return x.TimeCard
+		return x.Employee
 	}
 	return nil
 }
 
-type Db_ListTimeCardResponse struct {
+func (x *Db_EmployeeIdentityResponse) GetCards() []*Db_EmployeeCard {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+type Db_ListEmployeeIdentityResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*Db_TimeCard         `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Items         []*Db_EmployeeIdentity `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalCount    *int32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`     // page_token使用時はinclude_total_count指定時のみ設定
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 次ページのトークン（最終ページの場合は空）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_ListTimeCardResponse) Reset() {
-	*x = Db_ListTimeCardResponse{}
-	mi := &file_db_service_proto_msgTypes[103]
+func (x *Db_ListEmployeeIdentityResponse) Reset() {
+	*x = Db_ListEmployeeIdentityResponse{}
+	mi := &file_db_service_proto_msgTypes[202]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ListTimeCardResponse) String() string {
+func (x *Db_ListEmployeeIdentityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_ListTimeCardResponse) ProtoMessage() {}
+func (*Db_ListEmployeeIdentityResponse) ProtoMessage() {}
 
-func (x *Db_ListTimeCardResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[103]
+func (x *Db_ListEmployeeIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[202]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8089,47 +15984,64 @@ func (x *Db_ListTimeCardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_ListTimeCardResponse.ProtoReflect.Descriptor instead.
-func (*Db_ListTimeCardResponse) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{103}
+// Deprecated: Use Db_ListEmployeeIdentityResponse.ProtoReflect.Descriptor instead.
+func (*Db_ListEmployeeIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{202}
 }
 
-func (x *Db_ListTimeCardResponse) GetItems() []*Db_TimeCard {
+func (x *Db_ListEmployeeIdentityResponse) GetItems() []*Db_EmployeeIdentity {
 	if x != nil {
 		return x.Items
 	}
 	return nil
 }
 
-func (x *Db_ListTimeCardResponse) GetTotalCount() int32 {
-	if x != nil {
-		return x.TotalCount
+func (x *Db_ListEmployeeIdentityResponse) GetTotalCount() int32 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
 	}
 	return 0
 }
 
-type Db_CreateTimeCardRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TimeCard      *Db_TimeCard           `protobuf:"bytes,1,opt,name=time_card,json=timeCard,proto3" json:"time_card,omitempty"`
+func (x *Db_ListEmployeeIdentityResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type Db_ResolveEmployeeIdentityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 検索する社員コード（いずれか1つを指定）
+	//
+	// Types that are valid to be assigned to Key:
+	//
+	//	*Db_ResolveEmployeeIdentityRequest_DriverId
+	//	*Db_ResolveEmployeeIdentityRequest_ShainC
+	//	*Db_ResolveEmployeeIdentityRequest_DtakoDriverCode
+	//	*Db_ResolveEmployeeIdentityRequest_UntenshuC
+	//	*Db_ResolveEmployeeIdentityRequest_TimeCardUserId
+	//	*Db_ResolveEmployeeIdentityRequest_CardId
+	Key           isDb_ResolveEmployeeIdentityRequest_Key `protobuf_oneof:"key"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_CreateTimeCardRequest) Reset() {
-	*x = Db_CreateTimeCardRequest{}
-	mi := &file_db_service_proto_msgTypes[104]
+func (x *Db_ResolveEmployeeIdentityRequest) Reset() {
+	*x = Db_ResolveEmployeeIdentityRequest{}
+	mi := &file_db_service_proto_msgTypes[203]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_CreateTimeCardRequest) String() string {
+func (x *Db_ResolveEmployeeIdentityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_CreateTimeCardRequest) ProtoMessage() {}
+func (*Db_ResolveEmployeeIdentityRequest) ProtoMessage() {}
 
-func (x *Db_CreateTimeCardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[104]
+func (x *Db_ResolveEmployeeIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[203]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8140,85 +16052,137 @@ func (x *Db_CreateTimeCardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_CreateTimeCardRequest.ProtoReflect.Descriptor instead.
-func (*Db_CreateTimeCardRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{104}
+// Deprecated: Use Db_ResolveEmployeeIdentityRequest.ProtoReflect.Descriptor instead.
+func (*Db_ResolveEmployeeIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{203}
 }
 
-func (x *Db_CreateTimeCardRequest) GetTimeCard() *Db_TimeCard {
+func (x *Db_ResolveEmployeeIdentityRequest) GetKey() isDb_ResolveEmployeeIdentityRequest_Key {
 	if x != nil {
-		return x.TimeCard
+		return x.Key
 	}
 	return nil
 }
 
-type Db_UpdateTimeCardRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TimeCard      *Db_TimeCard           `protobuf:"bytes,1,opt,name=time_card,json=timeCard,proto3" json:"time_card,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Db_ResolveEmployeeIdentityRequest) GetDriverId() int32 {
+	if x != nil {
+		if x, ok := x.Key.(*Db_ResolveEmployeeIdentityRequest_DriverId); ok {
+			return x.DriverId
+		}
+	}
+	return 0
 }
 
-func (x *Db_UpdateTimeCardRequest) Reset() {
-	*x = Db_UpdateTimeCardRequest{}
-	mi := &file_db_service_proto_msgTypes[105]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Db_ResolveEmployeeIdentityRequest) GetShainC() string {
+	if x != nil {
+		if x, ok := x.Key.(*Db_ResolveEmployeeIdentityRequest_ShainC); ok {
+			return x.ShainC
+		}
+	}
+	return ""
 }
 
-func (x *Db_UpdateTimeCardRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Db_ResolveEmployeeIdentityRequest) GetDtakoDriverCode() int32 {
+	if x != nil {
+		if x, ok := x.Key.(*Db_ResolveEmployeeIdentityRequest_DtakoDriverCode); ok {
+			return x.DtakoDriverCode
+		}
+	}
+	return 0
 }
 
-func (*Db_UpdateTimeCardRequest) ProtoMessage() {}
-
-func (x *Db_UpdateTimeCardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[105]
+func (x *Db_ResolveEmployeeIdentityRequest) GetUntenshuC() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		if x, ok := x.Key.(*Db_ResolveEmployeeIdentityRequest_UntenshuC); ok {
+			return x.UntenshuC
 		}
-		return ms
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use Db_UpdateTimeCardRequest.ProtoReflect.Descriptor instead.
-func (*Db_UpdateTimeCardRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{105}
+func (x *Db_ResolveEmployeeIdentityRequest) GetTimeCardUserId() int32 {
+	if x != nil {
+		if x, ok := x.Key.(*Db_ResolveEmployeeIdentityRequest_TimeCardUserId); ok {
+			return x.TimeCardUserId
+		}
+	}
+	return 0
 }
 
-func (x *Db_UpdateTimeCardRequest) GetTimeCard() *Db_TimeCard {
+func (x *Db_ResolveEmployeeIdentityRequest) GetCardId() string {
 	if x != nil {
-		return x.TimeCard
+		if x, ok := x.Key.(*Db_ResolveEmployeeIdentityRequest_CardId); ok {
+			return x.CardId
+		}
 	}
-	return nil
+	return ""
 }
 
-type Db_DeleteTimeCardRequest struct {
+type isDb_ResolveEmployeeIdentityRequest_Key interface {
+	isDb_ResolveEmployeeIdentityRequest_Key()
+}
+
+type Db_ResolveEmployeeIdentityRequest_DriverId struct {
+	DriverId int32 `protobuf:"varint,1,opt,name=driver_id,json=driverId,proto3,oneof"` // drivers.id
+}
+
+type Db_ResolveEmployeeIdentityRequest_ShainC struct {
+	ShainC string `protobuf:"bytes,2,opt,name=shain_c,json=shainC,proto3,oneof"` // 社員ﾏｽﾀ.社員C
+}
+
+type Db_ResolveEmployeeIdentityRequest_DtakoDriverCode struct {
+	DtakoDriverCode int32 `protobuf:"varint,3,opt,name=dtako_driver_code,json=dtakoDriverCode,proto3,oneof"` // dtako_rows.乗務員CD1・対象乗務員CD
+}
+
+type Db_ResolveEmployeeIdentityRequest_UntenshuC struct {
+	UntenshuC string `protobuf:"bytes,4,opt,name=untenshu_c,json=untenshuC,proto3,oneof"` // 運転日報明細.運転手C
+}
+
+type Db_ResolveEmployeeIdentityRequest_TimeCardUserId struct {
+	TimeCardUserId int32 `protobuf:"varint,5,opt,name=time_card_user_id,json=timeCardUserId,proto3,oneof"` // timecard_logs.id
+}
+
+type Db_ResolveEmployeeIdentityRequest_CardId struct {
+	CardId string `protobuf:"bytes,6,opt,name=card_id,json=cardId,proto3,oneof"` // timecard_logs.card_id（登録済みのFeliCaカード）
+}
+
+func (*Db_ResolveEmployeeIdentityRequest_DriverId) isDb_ResolveEmployeeIdentityRequest_Key() {}
+
+func (*Db_ResolveEmployeeIdentityRequest_ShainC) isDb_ResolveEmployeeIdentityRequest_Key() {}
+
+func (*Db_ResolveEmployeeIdentityRequest_DtakoDriverCode) isDb_ResolveEmployeeIdentityRequest_Key() {}
+
+func (*Db_ResolveEmployeeIdentityRequest_UntenshuC) isDb_ResolveEmployeeIdentityRequest_Key() {}
+
+func (*Db_ResolveEmployeeIdentityRequest_TimeCardUserId) isDb_ResolveEmployeeIdentityRequest_Key() {}
+
+func (*Db_ResolveEmployeeIdentityRequest_CardId) isDb_ResolveEmployeeIdentityRequest_Key() {}
+
+type Db_ResolveEmployeeIdentityResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Datetime      string                 `protobuf:"bytes,1,opt,name=datetime,proto3" json:"datetime,omitempty"` // RFC3339形式
-	Id            int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Employee      *Db_EmployeeIdentity   `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+	Cards         []*Db_EmployeeCard     `protobuf:"bytes,2,rep,name=cards,proto3" json:"cards,omitempty"`
+	Driver        *Db_Drivers            `protobuf:"bytes,3,opt,name=driver,proto3,oneof" json:"driver,omitempty"` // 本番DB未設定・driversにない場合は空
+	Shain         *Db_ShainMaster        `protobuf:"bytes,4,opt,name=shain,proto3,oneof" json:"shain,omitempty"`   // SQL Server未設定・社員ﾏｽﾀにない場合は空
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_DeleteTimeCardRequest) Reset() {
-	*x = Db_DeleteTimeCardRequest{}
-	mi := &file_db_service_proto_msgTypes[106]
+func (x *Db_ResolveEmployeeIdentityResponse) Reset() {
+	*x = Db_ResolveEmployeeIdentityResponse{}
+	mi := &file_db_service_proto_msgTypes[204]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_DeleteTimeCardRequest) String() string {
+func (x *Db_ResolveEmployeeIdentityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_DeleteTimeCardRequest) ProtoMessage() {}
+func (*Db_ResolveEmployeeIdentityResponse) ProtoMessage() {}
 
-func (x *Db_DeleteTimeCardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[106]
+func (x *Db_ResolveEmployeeIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[204]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8229,55 +16193,63 @@ func (x *Db_DeleteTimeCardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_DeleteTimeCardRequest.ProtoReflect.Descriptor instead.
-func (*Db_DeleteTimeCardRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{106}
+// Deprecated: Use Db_ResolveEmployeeIdentityResponse.ProtoReflect.Descriptor instead.
+func (*Db_ResolveEmployeeIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{204}
 }
 
-func (x *Db_DeleteTimeCardRequest) GetDatetime() string {
+func (x *Db_ResolveEmployeeIdentityResponse) GetEmployee() *Db_EmployeeIdentity {
 	if x != nil {
-		return x.Datetime
+		return x.Employee
 	}
-	return ""
+	return nil
 }
 
-func (x *Db_DeleteTimeCardRequest) GetId() int32 {
+func (x *Db_ResolveEmployeeIdentityResponse) GetCards() []*Db_EmployeeCard {
 	if x != nil {
-		return x.Id
+		return x.Cards
 	}
-	return 0
+	return nil
 }
 
-// TimeCardLog メッセージ
-type Db_TimeCardLog struct {
+func (x *Db_ResolveEmployeeIdentityResponse) GetDriver() *Db_Drivers {
+	if x != nil {
+		return x.Driver
+	}
+	return nil
+}
+
+func (x *Db_ResolveEmployeeIdentityResponse) GetShain() *Db_ShainMaster {
+	if x != nil {
+		return x.Shain
+	}
+	return nil
+}
+
+type Db_RegisterEmployeeCardRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Datetime      string                 `protobuf:"bytes,1,opt,name=datetime,proto3" json:"datetime,omitempty"`                                // RFC3339形式のタイムスタンプ
-	Id            int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`                                           // ユーザーID（0はゲスト/不明）
-	CardId        string                 `protobuf:"bytes,3,opt,name=card_id,json=cardId,proto3" json:"card_id,omitempty"`                      // カードID（FeliCa UIDなど）
-	MachineIp     string                 `protobuf:"bytes,4,opt,name=machine_ip,json=machineIp,proto3" json:"machine_ip,omitempty"`             // マシンIP/Reader ID
-	State         string                 `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`                                      // 状態: in/out
-	StateDetail   *string                `protobuf:"bytes,6,opt,name=state_detail,json=stateDetail,proto3,oneof" json:"state_detail,omitempty"` // 状態詳細（オプション）
-	Created       string                 `protobuf:"bytes,7,opt,name=created,proto3" json:"created,omitempty"`                                  // RFC3339形式
-	Modified      string                 `protobuf:"bytes,8,opt,name=modified,proto3" json:"modified,omitempty"`                                // RFC3339形式
+	EmployeeId    int64                  `protobuf:"varint,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	CardId        string                 `protobuf:"bytes,2,opt,name=card_id,json=cardId,proto3" json:"card_id,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"` // 空の場合は"system"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_TimeCardLog) Reset() {
-	*x = Db_TimeCardLog{}
-	mi := &file_db_service_proto_msgTypes[107]
+func (x *Db_RegisterEmployeeCardRequest) Reset() {
+	*x = Db_RegisterEmployeeCardRequest{}
+	mi := &file_db_service_proto_msgTypes[205]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_TimeCardLog) String() string {
+func (x *Db_RegisterEmployeeCardRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_TimeCardLog) ProtoMessage() {}
+func (*Db_RegisterEmployeeCardRequest) ProtoMessage() {}
 
-func (x *Db_TimeCardLog) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[107]
+func (x *Db_RegisterEmployeeCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[205]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8288,89 +16260,106 @@ func (x *Db_TimeCardLog) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_TimeCardLog.ProtoReflect.Descriptor instead.
-func (*Db_TimeCardLog) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{107}
-}
-
-func (x *Db_TimeCardLog) GetDatetime() string {
-	if x != nil {
-		return x.Datetime
-	}
-	return ""
+// Deprecated: Use Db_RegisterEmployeeCardRequest.ProtoReflect.Descriptor instead.
+func (*Db_RegisterEmployeeCardRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{205}
 }
 
-func (x *Db_TimeCardLog) GetId() int32 {
+func (x *Db_RegisterEmployeeCardRequest) GetEmployeeId() int64 {
 	if x != nil {
-		return x.Id
+		return x.EmployeeId
 	}
 	return 0
 }
 
-func (x *Db_TimeCardLog) GetCardId() string {
+func (x *Db_RegisterEmployeeCardRequest) GetCardId() string {
 	if x != nil {
 		return x.CardId
 	}
 	return ""
 }
 
-func (x *Db_TimeCardLog) GetMachineIp() string {
+func (x *Db_RegisterEmployeeCardRequest) GetCreatedBy() string {
 	if x != nil {
-		return x.MachineIp
+		return x.CreatedBy
 	}
 	return ""
 }
 
-func (x *Db_TimeCardLog) GetState() string {
+type Db_EmployeeCardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Card          *Db_EmployeeCard       `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
+	Created       bool                   `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"` // カードを登録した場合はtrue（同じ社員に登録済みの場合はfalse）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Db_EmployeeCardResponse) Reset() {
+	*x = Db_EmployeeCardResponse{}
+	mi := &file_db_service_proto_msgTypes[206]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Db_EmployeeCardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Db_EmployeeCardResponse) ProtoMessage() {}
+
+func (x *Db_EmployeeCardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[206]
 	if x != nil {
-		return x.State
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Db_TimeCardLog) GetStateDetail() string {
-	if x != nil && x.StateDetail != nil {
-		return *x.StateDetail
-	}
-	return ""
+// Deprecated: Use Db_EmployeeCardResponse.ProtoReflect.Descriptor instead.
+func (*Db_EmployeeCardResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{206}
 }
 
-func (x *Db_TimeCardLog) GetCreated() string {
+func (x *Db_EmployeeCardResponse) GetCard() *Db_EmployeeCard {
 	if x != nil {
-		return x.Created
+		return x.Card
 	}
-	return ""
+	return nil
 }
 
-func (x *Db_TimeCardLog) GetModified() string {
+func (x *Db_EmployeeCardResponse) GetCreated() bool {
 	if x != nil {
-		return x.Modified
+		return x.Created
 	}
-	return ""
+	return false
 }
 
-type Db_CreateTimeCardLogRequest struct {
+type Db_UnregisterEmployeeCardRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Log           *Db_TimeCardLog        `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
+	CardId        string                 `protobuf:"bytes,1,opt,name=card_id,json=cardId,proto3" json:"card_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_CreateTimeCardLogRequest) Reset() {
-	*x = Db_CreateTimeCardLogRequest{}
-	mi := &file_db_service_proto_msgTypes[108]
+func (x *Db_UnregisterEmployeeCardRequest) Reset() {
+	*x = Db_UnregisterEmployeeCardRequest{}
+	mi := &file_db_service_proto_msgTypes[207]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_CreateTimeCardLogRequest) String() string {
+func (x *Db_UnregisterEmployeeCardRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_CreateTimeCardLogRequest) ProtoMessage() {}
+func (*Db_UnregisterEmployeeCardRequest) ProtoMessage() {}
 
-func (x *Db_CreateTimeCardLogRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[108]
+func (x *Db_UnregisterEmployeeCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[207]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8381,41 +16370,42 @@ func (x *Db_CreateTimeCardLogRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_CreateTimeCardLogRequest.ProtoReflect.Descriptor instead.
-func (*Db_CreateTimeCardLogRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{108}
+// Deprecated: Use Db_UnregisterEmployeeCardRequest.ProtoReflect.Descriptor instead.
+func (*Db_UnregisterEmployeeCardRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{207}
 }
 
-func (x *Db_CreateTimeCardLogRequest) GetLog() *Db_TimeCardLog {
+func (x *Db_UnregisterEmployeeCardRequest) GetCardId() string {
 	if x != nil {
-		return x.Log
+		return x.CardId
 	}
-	return nil
+	return ""
 }
 
-type Db_GetTimeCardLogRequest struct {
+type Db_UnlinkedEmployeeCode struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Datetime      string                 `protobuf:"bytes,1,opt,name=datetime,proto3" json:"datetime,omitempty"` // RFC3339形式のタイムスタンプ
-	Id            int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`            // ユーザーID
+	Source        Db_EmployeeCodeSource  `protobuf:"varint,1,opt,name=source,proto3,enum=db_service.Db_EmployeeCodeSource" json:"source,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"` // drivers.name・社員ﾏｽﾀ.社員N（その他の登録元は空）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_GetTimeCardLogRequest) Reset() {
-	*x = Db_GetTimeCardLogRequest{}
-	mi := &file_db_service_proto_msgTypes[109]
+func (x *Db_UnlinkedEmployeeCode) Reset() {
+	*x = Db_UnlinkedEmployeeCode{}
+	mi := &file_db_service_proto_msgTypes[208]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_GetTimeCardLogRequest) String() string {
+func (x *Db_UnlinkedEmployeeCode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_GetTimeCardLogRequest) ProtoMessage() {}
+func (*Db_UnlinkedEmployeeCode) ProtoMessage() {}
 
-func (x *Db_GetTimeCardLogRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[109]
+func (x *Db_UnlinkedEmployeeCode) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[208]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8426,47 +16416,54 @@ func (x *Db_GetTimeCardLogRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_GetTimeCardLogRequest.ProtoReflect.Descriptor instead.
-func (*Db_GetTimeCardLogRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{109}
+// Deprecated: Use Db_UnlinkedEmployeeCode.ProtoReflect.Descriptor instead.
+func (*Db_UnlinkedEmployeeCode) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{208}
+}
+
+func (x *Db_UnlinkedEmployeeCode) GetSource() Db_EmployeeCodeSource {
+	if x != nil {
+		return x.Source
+	}
+	return Db_EmployeeCodeSource_EMPLOYEE_CODE_SOURCE_UNSPECIFIED
 }
 
-func (x *Db_GetTimeCardLogRequest) GetDatetime() string {
+func (x *Db_UnlinkedEmployeeCode) GetCode() string {
 	if x != nil {
-		return x.Datetime
+		return x.Code
 	}
 	return ""
 }
 
-func (x *Db_GetTimeCardLogRequest) GetId() int32 {
-	if x != nil {
-		return x.Id
+func (x *Db_UnlinkedEmployeeCode) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
-	return 0
+	return ""
 }
 
-type Db_UpdateTimeCardLogRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Log           *Db_TimeCardLog        `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
+type Db_ListUnlinkedEmployeeCodesRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Sources       []Db_EmployeeCodeSource `protobuf:"varint,1,rep,packed,name=sources,proto3,enum=db_service.Db_EmployeeCodeSource" json:"sources,omitempty"` // 報告する登録元（空の場合は全て）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_UpdateTimeCardLogRequest) Reset() {
-	*x = Db_UpdateTimeCardLogRequest{}
-	mi := &file_db_service_proto_msgTypes[110]
+func (x *Db_ListUnlinkedEmployeeCodesRequest) Reset() {
+	*x = Db_ListUnlinkedEmployeeCodesRequest{}
+	mi := &file_db_service_proto_msgTypes[209]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_UpdateTimeCardLogRequest) String() string {
+func (x *Db_ListUnlinkedEmployeeCodesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_UpdateTimeCardLogRequest) ProtoMessage() {}
+func (*Db_ListUnlinkedEmployeeCodesRequest) ProtoMessage() {}
 
-func (x *Db_UpdateTimeCardLogRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[110]
+func (x *Db_ListUnlinkedEmployeeCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[209]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8477,41 +16474,41 @@ func (x *Db_UpdateTimeCardLogRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_UpdateTimeCardLogRequest.ProtoReflect.Descriptor instead.
-func (*Db_UpdateTimeCardLogRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{110}
+// Deprecated: Use Db_ListUnlinkedEmployeeCodesRequest.ProtoReflect.Descriptor instead.
+func (*Db_ListUnlinkedEmployeeCodesRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{209}
 }
 
-func (x *Db_UpdateTimeCardLogRequest) GetLog() *Db_TimeCardLog {
+func (x *Db_ListUnlinkedEmployeeCodesRequest) GetSources() []Db_EmployeeCodeSource {
 	if x != nil {
-		return x.Log
+		return x.Sources
 	}
 	return nil
 }
 
-type Db_DeleteTimeCardLogRequest struct {
+type Db_SkippedEmployeeCodeSource struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Datetime      string                 `protobuf:"bytes,1,opt,name=datetime,proto3" json:"datetime,omitempty"` // RFC3339形式のタイムスタンプ
-	Id            int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`            // ユーザーID
+	Source        Db_EmployeeCodeSource  `protobuf:"varint,1,opt,name=source,proto3,enum=db_service.Db_EmployeeCodeSource" json:"source,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // 突き合わせなかった理由
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_DeleteTimeCardLogRequest) Reset() {
-	*x = Db_DeleteTimeCardLogRequest{}
-	mi := &file_db_service_proto_msgTypes[111]
+func (x *Db_SkippedEmployeeCodeSource) Reset() {
+	*x = Db_SkippedEmployeeCodeSource{}
+	mi := &file_db_service_proto_msgTypes[210]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_DeleteTimeCardLogRequest) String() string {
+func (x *Db_SkippedEmployeeCodeSource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_DeleteTimeCardLogRequest) ProtoMessage() {}
+func (*Db_SkippedEmployeeCodeSource) ProtoMessage() {}
 
-func (x *Db_DeleteTimeCardLogRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[111]
+func (x *Db_SkippedEmployeeCodeSource) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[210]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8522,49 +16519,49 @@ func (x *Db_DeleteTimeCardLogRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_DeleteTimeCardLogRequest.ProtoReflect.Descriptor instead.
-func (*Db_DeleteTimeCardLogRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{111}
+// Deprecated: Use Db_SkippedEmployeeCodeSource.ProtoReflect.Descriptor instead.
+func (*Db_SkippedEmployeeCodeSource) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{210}
 }
 
-func (x *Db_DeleteTimeCardLogRequest) GetDatetime() string {
+func (x *Db_SkippedEmployeeCodeSource) GetSource() Db_EmployeeCodeSource {
 	if x != nil {
-		return x.Datetime
+		return x.Source
 	}
-	return ""
+	return Db_EmployeeCodeSource_EMPLOYEE_CODE_SOURCE_UNSPECIFIED
 }
 
-func (x *Db_DeleteTimeCardLogRequest) GetId() int32 {
+func (x *Db_SkippedEmployeeCodeSource) GetReason() string {
 	if x != nil {
-		return x.Id
+		return x.Reason
 	}
-	return 0
+	return ""
 }
 
-type Db_ListTimeCardLogRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	OrderBy       *string                `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3,oneof" json:"order_by,omitempty"` // 例: "datetime DESC"
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type Db_ListUnlinkedEmployeeCodesResponse struct {
+	state          protoimpl.MessageState          `protogen:"open.v1"`
+	Sources        []Db_EmployeeCodeSource         `protobuf:"varint,1,rep,packed,name=sources,proto3,enum=db_service.Db_EmployeeCodeSource" json:"sources,omitempty"` // 突き合わせた登録元（本番DB・SQL Server未設定・使用できない場合はその登録元を含まない）
+	Items          []*Db_UnlinkedEmployeeCode      `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`                                                   // 登録元・コード順
+	SkippedSources []*Db_SkippedEmployeeCodeSource `protobuf:"bytes,3,rep,name=skipped_sources,json=skippedSources,proto3" json:"skipped_sources,omitempty"`           // 本番DB・SQL Serverが使用できない（再接続中・取得に失敗した）ため突き合わせなかった登録元
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *Db_ListTimeCardLogRequest) Reset() {
-	*x = Db_ListTimeCardLogRequest{}
-	mi := &file_db_service_proto_msgTypes[112]
+func (x *Db_ListUnlinkedEmployeeCodesResponse) Reset() {
+	*x = Db_ListUnlinkedEmployeeCodesResponse{}
+	mi := &file_db_service_proto_msgTypes[211]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ListTimeCardLogRequest) String() string {
+func (x *Db_ListUnlinkedEmployeeCodesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_ListTimeCardLogRequest) ProtoMessage() {}
+func (*Db_ListUnlinkedEmployeeCodesResponse) ProtoMessage() {}
 
-func (x *Db_ListTimeCardLogRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[112]
+func (x *Db_ListUnlinkedEmployeeCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[211]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8575,56 +16572,57 @@ func (x *Db_ListTimeCardLogRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_ListTimeCardLogRequest.ProtoReflect.Descriptor instead.
-func (*Db_ListTimeCardLogRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{112}
+// Deprecated: Use Db_ListUnlinkedEmployeeCodesResponse.ProtoReflect.Descriptor instead.
+func (*Db_ListUnlinkedEmployeeCodesResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{211}
 }
 
-func (x *Db_ListTimeCardLogRequest) GetLimit() int32 {
+func (x *Db_ListUnlinkedEmployeeCodesResponse) GetSources() []Db_EmployeeCodeSource {
 	if x != nil {
-		return x.Limit
+		return x.Sources
 	}
-	return 0
+	return nil
 }
 
-func (x *Db_ListTimeCardLogRequest) GetOffset() int32 {
+func (x *Db_ListUnlinkedEmployeeCodesResponse) GetItems() []*Db_UnlinkedEmployeeCode {
 	if x != nil {
-		return x.Offset
+		return x.Items
 	}
-	return 0
+	return nil
 }
 
-func (x *Db_ListTimeCardLogRequest) GetOrderBy() string {
-	if x != nil && x.OrderBy != nil {
-		return *x.OrderBy
+func (x *Db_ListUnlinkedEmployeeCodesResponse) GetSkippedSources() []*Db_SkippedEmployeeCodeSource {
+	if x != nil {
+		return x.SkippedSources
 	}
-	return ""
+	return nil
 }
 
-type Db_GetByCardIDRequest struct {
+// バックエンドDBの接続状態
+type Db_BackendStatus struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CardId        string                 `protobuf:"bytes,1,opt,name=card_id,json=cardId,proto3" json:"card_id,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Backend       string                 `protobuf:"bytes,1,opt,name=backend,proto3" json:"backend,omitempty"`      // バックエンド名: local/prod/sqlserver
+	Available     bool                   `protobuf:"varint,2,opt,name=available,proto3" json:"available,omitempty"` // 接続可能か
+	Reason        *string                `protobuf:"bytes,3,opt,name=reason,proto3,oneof" json:"reason,omitempty"`  // 接続できない場合の理由
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_GetByCardIDRequest) Reset() {
-	*x = Db_GetByCardIDRequest{}
-	mi := &file_db_service_proto_msgTypes[113]
+func (x *Db_BackendStatus) Reset() {
+	*x = Db_BackendStatus{}
+	mi := &file_db_service_proto_msgTypes[212]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_GetByCardIDRequest) String() string {
+func (x *Db_BackendStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_GetByCardIDRequest) ProtoMessage() {}
+func (*Db_BackendStatus) ProtoMessage() {}
 
-func (x *Db_GetByCardIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[113]
+func (x *Db_BackendStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[212]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8635,54 +16633,53 @@ func (x *Db_GetByCardIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_GetByCardIDRequest.ProtoReflect.Descriptor instead.
-func (*Db_GetByCardIDRequest) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{113}
+// Deprecated: Use Db_BackendStatus.ProtoReflect.Descriptor instead.
+func (*Db_BackendStatus) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{212}
 }
 
-func (x *Db_GetByCardIDRequest) GetCardId() string {
+func (x *Db_BackendStatus) GetBackend() string {
 	if x != nil {
-		return x.CardId
+		return x.Backend
 	}
 	return ""
 }
 
-func (x *Db_GetByCardIDRequest) GetLimit() int32 {
+func (x *Db_BackendStatus) GetAvailable() bool {
 	if x != nil {
-		return x.Limit
+		return x.Available
 	}
-	return 0
+	return false
 }
 
-func (x *Db_GetByCardIDRequest) GetOffset() int32 {
-	if x != nil {
-		return x.Offset
+func (x *Db_BackendStatus) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
 	}
-	return 0
+	return ""
 }
 
-type Db_TimeCardLogResponse struct {
+type Db_GetAvailabilityRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Log           *Db_TimeCardLog        `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_TimeCardLogResponse) Reset() {
-	*x = Db_TimeCardLogResponse{}
-	mi := &file_db_service_proto_msgTypes[114]
+func (x *Db_GetAvailabilityRequest) Reset() {
+	*x = Db_GetAvailabilityRequest{}
+	mi := &file_db_service_proto_msgTypes[213]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_TimeCardLogResponse) String() string {
+func (x *Db_GetAvailabilityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_TimeCardLogResponse) ProtoMessage() {}
+func (*Db_GetAvailabilityRequest) ProtoMessage() {}
 
-func (x *Db_TimeCardLogResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[114]
+func (x *Db_GetAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[213]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8693,41 +16690,33 @@ func (x *Db_TimeCardLogResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_TimeCardLogResponse.ProtoReflect.Descriptor instead.
-func (*Db_TimeCardLogResponse) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{114}
-}
-
-func (x *Db_TimeCardLogResponse) GetLog() *Db_TimeCardLog {
-	if x != nil {
-		return x.Log
-	}
-	return nil
+// Deprecated: Use Db_GetAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*Db_GetAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{213}
 }
 
-type Db_ListTimeCardLogResponse struct {
+type Db_GetAvailabilityResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*Db_TimeCardLog      `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Backends      []*Db_BackendStatus    `protobuf:"bytes,1,rep,name=backends,proto3" json:"backends,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Db_ListTimeCardLogResponse) Reset() {
-	*x = Db_ListTimeCardLogResponse{}
-	mi := &file_db_service_proto_msgTypes[115]
+func (x *Db_GetAvailabilityResponse) Reset() {
+	*x = Db_GetAvailabilityResponse{}
+	mi := &file_db_service_proto_msgTypes[214]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Db_ListTimeCardLogResponse) String() string {
+func (x *Db_GetAvailabilityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Db_ListTimeCardLogResponse) ProtoMessage() {}
+func (*Db_GetAvailabilityResponse) ProtoMessage() {}
 
-func (x *Db_ListTimeCardLogResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[115]
+func (x *Db_GetAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_service_proto_msgTypes[214]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8738,26 +16727,18 @@ func (x *Db_ListTimeCardLogResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Db_ListTimeCardLogResponse.ProtoReflect.Descriptor instead.
-func (*Db_ListTimeCardLogResponse) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{115}
+// Deprecated: Use Db_GetAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*Db_GetAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_db_service_proto_rawDescGZIP(), []int{214}
 }
 
-func (x *Db_ListTimeCardLogResponse) GetItems() []*Db_TimeCardLog {
+func (x *Db_GetAvailabilityResponse) GetBackends() []*Db_BackendStatus {
 	if x != nil {
-		return x.Items
+		return x.Backends
 	}
 	return nil
 }
 
-func (x *Db_ListTimeCardLogResponse) GetTotalCount() int32 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
-}
-
-// 共通メッセージ
 type Db_Empty struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -8766,7 +16747,7 @@ type Db_Empty struct {
 
 func (x *Db_Empty) Reset() {
 	*x = Db_Empty{}
-	mi := &file_db_service_proto_msgTypes[116]
+	mi := &file_db_service_proto_msgTypes[215]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8778,7 +16759,7 @@ func (x *Db_Empty) String() string {
 func (*Db_Empty) ProtoMessage() {}
 
 func (x *Db_Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_db_service_proto_msgTypes[116]
+	mi := &file_db_service_proto_msgTypes[215]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8791,7 +16772,7 @@ func (x *Db_Empty) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Db_Empty.ProtoReflect.Descriptor instead.
 func (*Db_Empty) Descriptor() ([]byte, []int) {
-	return file_db_service_proto_rawDescGZIP(), []int{116}
+	return file_db_service_proto_rawDescGZIP(), []int{215}
 }
 
 var File_db_service_proto protoreflect.FileDescriptor
@@ -8799,7 +16780,7 @@ var File_db_service_proto protoreflect.FileDescriptor
 const file_db_service_proto_rawDesc = "" +
 	"\n" +
 	"\x10db_service.proto\x12\n" +
-	"db_service\"\xb6\x05\n" +
+	"db_service\x1a\x1cgoogle/api/annotations.proto\"\xb6\x05\n" +
 	"\x13db_DTakoUriageKeihi\x12\x17\n" +
 	"\asrch_id\x18\x01 \x01(\tR\x06srchId\x12\x1a\n" +
 	"\bdatetime\x18\x02 \x01(\tR\bdatetime\x12\x17\n" +
@@ -8901,7 +16882,7 @@ const file_db_service_proto_rawDesc = "" +
 	" db_DeleteDTakoUriageKeihiRequest\x12\x17\n" +
 	"\asrch_id\x18\x01 \x01(\tR\x06srchId\x12\x1a\n" +
 	"\bdatetime\x18\x02 \x01(\tR\bdatetime\x12\x17\n" +
-	"\akeihi_c\x18\x03 \x01(\x05R\x06keihiC\"\xe6\x01\n" +
+	"\akeihi_c\x18\x03 \x01(\x05R\x06keihiC\"\xc9\x02\n" +
 	"\x1edb_ListDTakoUriageKeihiRequest\x12%\n" +
 	"\fdtako_row_id\x18\x01 \x01(\tH\x00R\n" +
 	"dtakoRowId\x88\x01\x01\x12\"\n" +
@@ -8909,16 +16890,22 @@ const file_db_service_proto_rawDesc = "" +
 	"start_date\x18\x02 \x01(\tH\x01R\tstartDate\x88\x01\x01\x12\x1e\n" +
 	"\bend_date\x18\x03 \x01(\tH\x02R\aendDate\x88\x01\x01\x12\x14\n" +
 	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x05 \x01(\x05R\x06offsetB\x0f\n" +
+	"\x06offset\x18\x05 \x01(\x05R\x06offset\x12\"\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tH\x03R\tpageToken\x88\x01\x01\x12.\n" +
+	"\x13include_total_count\x18\a \x01(\bR\x11includeTotalCountB\x0f\n" +
 	"\r_dtako_row_idB\r\n" +
 	"\v_start_dateB\v\n" +
-	"\t_end_date\"l\n" +
+	"\t_end_dateB\r\n" +
+	"\v_page_token\"l\n" +
 	"\x1bdb_DTakoUriageKeihiResponse\x12M\n" +
-	"\x12dtako_uriage_keihi\x18\x01 \x01(\v2\x1f.db_service.db_DTakoUriageKeihiR\x10dtakoUriageKeihi\"y\n" +
+	"\x12dtako_uriage_keihi\x18\x01 \x01(\v2\x1f.db_service.db_DTakoUriageKeihiR\x10dtakoUriageKeihi\"\xb6\x01\n" +
 	"\x1fdb_ListDTakoUriageKeihiResponse\x125\n" +
-	"\x05items\x18\x01 \x03(\v2\x1f.db_service.db_DTakoUriageKeihiR\x05items\x12\x1f\n" +
-	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"T\n" +
+	"\x05items\x18\x01 \x03(\v2\x1f.db_service.db_DTakoUriageKeihiR\x05items\x12$\n" +
+	"\vtotal_count\x18\x02 \x01(\x05H\x00R\n" +
+	"totalCount\x88\x01\x01\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageTokenB\x0e\n" +
+	"\f_total_count\"T\n" +
 	"\x19db_CreateETCMeisaiRequest\x127\n" +
 	"\n" +
 	"etc_meisai\x18\x01 \x01(\v2\x18.db_service.db_ETCMeisaiR\tetcMeisai\"(\n" +
@@ -8928,24 +16915,62 @@ const file_db_service_proto_rawDesc = "" +
 	"\n" +
 	"etc_meisai\x18\x01 \x01(\v2\x18.db_service.db_ETCMeisaiR\tetcMeisai\"+\n" +
 	"\x19db_DeleteETCMeisaiRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"\xc9\x01\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xac\x02\n" +
 	"\x17db_ListETCMeisaiRequest\x12\x17\n" +
 	"\x04hash\x18\x01 \x01(\tH\x00R\x04hash\x88\x01\x01\x12\"\n" +
 	"\n" +
 	"start_date\x18\x02 \x01(\tH\x01R\tstartDate\x88\x01\x01\x12\x1e\n" +
 	"\bend_date\x18\x03 \x01(\tH\x02R\aendDate\x88\x01\x01\x12\x14\n" +
 	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x05 \x01(\x05R\x06offsetB\a\n" +
+	"\x06offset\x18\x05 \x01(\x05R\x06offset\x12\"\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tH\x03R\tpageToken\x88\x01\x01\x12.\n" +
+	"\x13include_total_count\x18\a \x01(\bR\x11includeTotalCountB\a\n" +
+	"\x05_hashB\r\n" +
+	"\v_start_dateB\v\n" +
+	"\t_end_dateB\r\n" +
+	"\v_page_token\"\xbc\x01\n" +
+	"\x19db_StreamETCMeisaiRequest\x12\x17\n" +
+	"\x04hash\x18\x01 \x01(\tH\x00R\x04hash\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tH\x01R\tstartDate\x88\x01\x01\x12\x1e\n" +
+	"\bend_date\x18\x03 \x01(\tH\x02R\aendDate\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x04 \x01(\x05R\tbatchSizeB\a\n" +
 	"\x05_hashB\r\n" +
 	"\v_start_dateB\v\n" +
 	"\t_end_date\"O\n" +
 	"\x14db_ETCMeisaiResponse\x127\n" +
 	"\n" +
-	"etc_meisai\x18\x01 \x01(\v2\x18.db_service.db_ETCMeisaiR\tetcMeisai\"k\n" +
+	"etc_meisai\x18\x01 \x01(\v2\x18.db_service.db_ETCMeisaiR\tetcMeisai\"P\n" +
+	"\x1edb_BatchCreateETCMeisaiRequest\x12.\n" +
+	"\x05items\x18\x01 \x03(\v2\x18.db_service.db_ETCMeisaiR\x05items\"\x9e\x01\n" +
+	"\x12db_BatchItemResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x126\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1e.db_service.db_BatchItemStatusR\x06status\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04hash\x18\x04 \x01(\tR\x04hash\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"\xd0\x01\n" +
+	"\x1fdb_BatchCreateETCMeisaiResponse\x128\n" +
+	"\aresults\x18\x01 \x03(\v2\x1e.db_service.db_BatchItemResultR\aresults\x12%\n" +
+	"\x0einserted_count\x18\x02 \x01(\x05R\rinsertedCount\x12'\n" +
+	"\x0fduplicate_count\x18\x03 \x01(\x05R\x0eduplicateCount\x12#\n" +
+	"\rinvalid_count\x18\x04 \x01(\x05R\finvalidCount\"F\n" +
+	"\x19db_ImportETCMeisaiRequest\x12\x10\n" +
+	"\x03csv\x18\x01 \x01(\fR\x03csv\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"\xe4\x01\n" +
+	"\x1adb_ImportETCMeisaiResponse\x128\n" +
+	"\aresults\x18\x01 \x03(\v2\x1e.db_service.db_BatchItemResultR\aresults\x12%\n" +
+	"\x0einserted_count\x18\x02 \x01(\x05R\rinsertedCount\x12'\n" +
+	"\x0fduplicate_count\x18\x03 \x01(\x05R\x0eduplicateCount\x12#\n" +
+	"\rinvalid_count\x18\x04 \x01(\x05R\finvalidCount\x12\x17\n" +
+	"\adry_run\x18\x05 \x01(\bR\x06dryRun\"\xa8\x01\n" +
 	"\x18db_ListETCMeisaiResponse\x12.\n" +
-	"\x05items\x18\x01 \x03(\v2\x18.db_service.db_ETCMeisaiR\x05items\x12\x1f\n" +
-	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"i\n" +
+	"\x05items\x18\x01 \x03(\v2\x18.db_service.db_ETCMeisaiR\x05items\x12$\n" +
+	"\vtotal_count\x18\x02 \x01(\x05H\x00R\n" +
+	"totalCount\x88\x01\x01\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageTokenB\x0e\n" +
+	"\f_total_count\"i\n" +
 	"\x1edb_CreateDTakoFerryRowsRequest\x12G\n" +
 	"\x10dtako_ferry_rows\x18\x01 \x01(\v2\x1d.db_service.db_DTakoFerryRowsR\x0edtakoFerryRows\"-\n" +
 	"\x1bdb_GetDTakoFerryRowsRequest\x12\x0e\n" +
@@ -8953,24 +16978,30 @@ const file_db_service_proto_rawDesc = "" +
 	"\x1edb_UpdateDTakoFerryRowsRequest\x12G\n" +
 	"\x10dtako_ferry_rows\x18\x01 \x01(\v2\x1d.db_service.db_DTakoFerryRowsR\x0edtakoFerryRows\"0\n" +
 	"\x1edb_DeleteDTakoFerryRowsRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"\xd6\x01\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\xb9\x02\n" +
 	"\x1cdb_ListDTakoFerryRowsRequest\x12\x1c\n" +
 	"\aunko_no\x18\x01 \x01(\tH\x00R\x06unkoNo\x88\x01\x01\x12\"\n" +
 	"\n" +
 	"start_date\x18\x02 \x01(\tH\x01R\tstartDate\x88\x01\x01\x12\x1e\n" +
 	"\bend_date\x18\x03 \x01(\tH\x02R\aendDate\x88\x01\x01\x12\x14\n" +
 	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x05 \x01(\x05R\x06offsetB\n" +
+	"\x06offset\x18\x05 \x01(\x05R\x06offset\x12\"\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tH\x03R\tpageToken\x88\x01\x01\x12.\n" +
+	"\x13include_total_count\x18\a \x01(\bR\x11includeTotalCountB\n" +
 	"\n" +
 	"\b_unko_noB\r\n" +
 	"\v_start_dateB\v\n" +
-	"\t_end_date\"d\n" +
+	"\t_end_dateB\r\n" +
+	"\v_page_token\"d\n" +
 	"\x19db_DTakoFerryRowsResponse\x12G\n" +
-	"\x10dtako_ferry_rows\x18\x01 \x01(\v2\x1d.db_service.db_DTakoFerryRowsR\x0edtakoFerryRows\"u\n" +
+	"\x10dtako_ferry_rows\x18\x01 \x01(\v2\x1d.db_service.db_DTakoFerryRowsR\x0edtakoFerryRows\"\xb2\x01\n" +
 	"\x1ddb_ListDTakoFerryRowsResponse\x123\n" +
-	"\x05items\x18\x01 \x03(\v2\x1d.db_service.db_DTakoFerryRowsR\x05items\x12\x1f\n" +
-	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"\xf1\x01\n" +
+	"\x05items\x18\x01 \x03(\v2\x1d.db_service.db_DTakoFerryRowsR\x05items\x12$\n" +
+	"\vtotal_count\x18\x02 \x01(\x05H\x00R\n" +
+	"totalCount\x88\x01\x01\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageTokenB\x0e\n" +
+	"\f_total_count\"\xf1\x01\n" +
 	"\x13db_ETCMeisaiMapping\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12&\n" +
 	"\x0fetc_meisai_hash\x18\x02 \x01(\tR\retcMeisaiHash\x12 \n" +
@@ -8983,33 +17014,98 @@ const file_db_service_proto_rawDesc = "" +
 	"\n" +
 	"created_by\x18\x06 \x01(\tR\tcreatedBy\x12\x19\n" +
 	"\x05notes\x18\a \x01(\tH\x00R\x05notes\x88\x01\x01B\b\n" +
-	"\x06_notes\"q\n" +
+	"\x06_notes\"\x89\x01\n" +
 	" db_CreateETCMeisaiMappingRequest\x12M\n" +
-	"\x12etc_meisai_mapping\x18\x01 \x01(\v2\x1f.db_service.db_ETCMeisaiMappingR\x10etcMeisaiMapping\"/\n" +
+	"\x12etc_meisai_mapping\x18\x01 \x01(\v2\x1f.db_service.db_ETCMeisaiMappingR\x10etcMeisaiMapping\x12\x16\n" +
+	"\x06upsert\x18\x02 \x01(\bR\x06upsert\"/\n" +
 	"\x1ddb_GetETCMeisaiMappingRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"q\n" +
 	" db_UpdateETCMeisaiMappingRequest\x12M\n" +
 	"\x12etc_meisai_mapping\x18\x01 \x01(\v2\x1f.db_service.db_ETCMeisaiMappingR\x10etcMeisaiMapping\"2\n" +
 	" db_DeleteETCMeisaiMappingRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"\xc7\x01\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xaa\x02\n" +
 	"\x1edb_ListETCMeisaiMappingRequest\x12+\n" +
 	"\x0fetc_meisai_hash\x18\x01 \x01(\tH\x00R\retcMeisaiHash\x88\x01\x01\x12%\n" +
 	"\fdtako_row_id\x18\x02 \x01(\tH\x01R\n" +
 	"dtakoRowId\x88\x01\x01\x12\x14\n" +
 	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x04 \x01(\x05R\x06offsetB\x12\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\x12\"\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tH\x02R\tpageToken\x88\x01\x01\x12.\n" +
+	"\x13include_total_count\x18\x06 \x01(\bR\x11includeTotalCountB\x12\n" +
 	"\x10_etc_meisai_hashB\x0f\n" +
-	"\r_dtako_row_id\"l\n" +
+	"\r_dtako_row_idB\r\n" +
+	"\v_page_token\"\x86\x01\n" +
 	"\x1bdb_ETCMeisaiMappingResponse\x12M\n" +
-	"\x12etc_meisai_mapping\x18\x01 \x01(\v2\x1f.db_service.db_ETCMeisaiMappingR\x10etcMeisaiMapping\"y\n" +
+	"\x12etc_meisai_mapping\x18\x01 \x01(\v2\x1f.db_service.db_ETCMeisaiMappingR\x10etcMeisaiMapping\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\bR\acreated\"\xb6\x01\n" +
 	"\x1fdb_ListETCMeisaiMappingResponse\x125\n" +
-	"\x05items\x18\x01 \x03(\v2\x1f.db_service.db_ETCMeisaiMappingR\x05items\x12\x1f\n" +
-	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"G\n" +
+	"\x05items\x18\x01 \x03(\v2\x1f.db_service.db_ETCMeisaiMappingR\x05items\x12$\n" +
+	"\vtotal_count\x18\x02 \x01(\x05H\x00R\n" +
+	"totalCount\x88\x01\x01\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageTokenB\x0e\n" +
+	"\f_total_count\"G\n" +
 	"\x1ddb_GetDTakoRowIDByHashRequest\x12&\n" +
 	"\x0fetc_meisai_hash\x18\x01 \x01(\tR\retcMeisaiHash\"D\n" +
 	"\x1edb_GetDTakoRowIDByHashResponse\x12\"\n" +
-	"\rdtako_row_ids\x18\x01 \x03(\tR\vdtakoRowIds\"\xad\x04\n" +
+	"\rdtako_row_ids\x18\x01 \x03(\tR\vdtakoRowIds\"\x80\x01\n" +
+	"%db_BulkReplaceETCMeisaiMappingRequest\x12 \n" +
+	"\fdtako_row_id\x18\x01 \x01(\tR\n" +
+	"dtakoRowId\x125\n" +
+	"\x05items\x18\x02 \x03(\v2\x1f.db_service.db_ETCMeisaiMappingR\x05items\"\x84\x01\n" +
+	"&db_BulkReplaceETCMeisaiMappingResponse\x125\n" +
+	"\x05items\x18\x01 \x03(\v2\x1f.db_service.db_ETCMeisaiMappingR\x05items\x12#\n" +
+	"\rdeleted_count\x18\x02 \x01(\x05R\fdeletedCount\"X\n" +
+	"\x1fdb_AuditETCMeisaiMappingRequest\x125\n" +
+	"\x05kinds\x18\x01 \x03(\x0e2\x1f.db_service.db_MappingIssueKindR\x05kinds\"\xfc\x01\n" +
+	"\x0fdb_MappingIssue\x123\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x1f.db_service.db_MappingIssueKindR\x04kind\x12\x1f\n" +
+	"\vmapping_ids\x18\x02 \x03(\x03R\n" +
+	"mappingIds\x12&\n" +
+	"\x0fetc_meisai_hash\x18\x03 \x01(\tR\retcMeisaiHash\x12\"\n" +
+	"\rdtako_row_ids\x18\x04 \x03(\tR\vdtakoRowIds\x12\"\n" +
+	"\retc_meisai_id\x18\x05 \x01(\x03R\vetcMeisaiId\x12#\n" +
+	"\rexpected_hash\x18\x06 \x01(\tR\fexpectedHash\"\xb9\x02\n" +
+	" db_AuditETCMeisaiMappingResponse\x12#\n" +
+	"\rmapping_count\x18\x01 \x01(\x05R\fmappingCount\x123\n" +
+	"\x06issues\x18\x02 \x03(\v2\x1b.db_service.db_MappingIssueR\x06issues\x12*\n" +
+	"\x11orphan_hash_count\x18\x03 \x01(\x05R\x0forphanHashCount\x123\n" +
+	"\x16orphan_dtako_row_count\x18\x04 \x01(\x05R\x13orphanDtakoRowCount\x120\n" +
+	"\x14duplicate_hash_count\x18\x05 \x01(\x05R\x12duplicateHashCount\x12(\n" +
+	"\x10stale_hash_count\x18\x06 \x01(\x05R\x0estaleHashCount\"\xce\x01\n" +
+	"\x1cdb_AutoMatchETCMeisaiRequest\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x02 \x01(\tR\aendDate\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\x12%\n" +
+	"\x0emin_confidence\x18\x04 \x01(\x01R\rminConfidence\x124\n" +
+	"\x16include_already_mapped\x18\x05 \x01(\bR\x14includeAlreadyMapped\"p\n" +
+	"\x15db_AutoMatchCandidate\x12 \n" +
+	"\fdtako_row_id\x18\x01 \x01(\tR\n" +
+	"dtakoRowId\x12\x15\n" +
+	"\x06car_id\x18\x02 \x01(\tR\x05carId\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x03 \x01(\x01R\n" +
+	"confidence\"\xc4\x02\n" +
+	"\x12db_AutoMatchResult\x12\"\n" +
+	"\retc_meisai_id\x18\x01 \x01(\x03R\vetcMeisaiId\x12&\n" +
+	"\x0fetc_meisai_hash\x18\x02 \x01(\tR\retcMeisaiHash\x12\x17\n" +
+	"\aetc_num\x18\x03 \x01(\tR\x06etcNum\x12\x17\n" +
+	"\adate_to\x18\x04 \x01(\tR\x06dateTo\x126\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x1e.db_service.db_AutoMatchStatusR\x06status\x12A\n" +
+	"\n" +
+	"candidates\x18\x06 \x03(\v2!.db_service.db_AutoMatchCandidateR\n" +
+	"candidates\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"mapping_id\x18\b \x01(\x03R\tmappingId\"\x9b\x02\n" +
+	"\x1ddb_AutoMatchETCMeisaiResponse\x128\n" +
+	"\aresults\x18\x01 \x03(\v2\x1e.db_service.db_AutoMatchResultR\aresults\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x12#\n" +
+	"\rmatched_count\x18\x03 \x01(\x05R\fmatchedCount\x12'\n" +
+	"\x0fambiguous_count\x18\x04 \x01(\x05R\x0eambiguousCount\x12'\n" +
+	"\x0funmatched_count\x18\x05 \x01(\x05R\x0eunmatchedCount\x120\n" +
+	"\x14already_mapped_count\x18\x06 \x01(\x05R\x12alreadyMappedCount\"\xad\x04\n" +
 	"\fdb_DTakoCars\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x19\n" +
 	"\bcar_code\x18\x02 \x01(\tR\acarCode\x12\x15\n" +
@@ -9137,17 +17233,23 @@ const file_db_service_proto_rawDesc = "" +
 	"\x16db_GetDTakoCarsRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\"<\n" +
 	"\x1fdb_GetDTakoCarsByCarCodeRequest\x12\x19\n" +
-	"\bcar_code\x18\x01 \x01(\tR\acarCode\"G\n" +
+	"\bcar_code\x18\x01 \x01(\tR\acarCode\"\xaa\x01\n" +
 	"\x17db_ListDTakoCarsRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x02 \x01(\x05R\x06offset\"O\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\"\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tH\x00R\tpageToken\x88\x01\x01\x12.\n" +
+	"\x13include_total_count\x18\x04 \x01(\bR\x11includeTotalCountB\r\n" +
+	"\v_page_token\"O\n" +
 	"\x14db_DTakoCarsResponse\x127\n" +
 	"\n" +
-	"dtako_cars\x18\x01 \x01(\v2\x18.db_service.db_DTakoCarsR\tdtakoCars\"k\n" +
+	"dtako_cars\x18\x01 \x01(\v2\x18.db_service.db_DTakoCarsR\tdtakoCars\"\xa8\x01\n" +
 	"\x18db_ListDTakoCarsResponse\x12.\n" +
-	"\x05items\x18\x01 \x03(\v2\x18.db_service.db_DTakoCarsR\x05items\x12\x1f\n" +
-	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"*\n" +
+	"\x05items\x18\x01 \x03(\v2\x18.db_service.db_DTakoCarsR\x05items\x12$\n" +
+	"\vtotal_count\x18\x02 \x01(\x05H\x00R\n" +
+	"totalCount\x88\x01\x01\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageTokenB\x0e\n" +
+	"\f_total_count\"*\n" +
 	"\x18db_GetDTakoEventsRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"\xa5\x01\n" +
 	"%db_GetDTakoEventsByOperationNoRequest\x12!\n" +
@@ -9156,46 +17258,107 @@ const file_db_service_proto_rawDesc = "" +
 	"eventTypes\x12\x1d\n" +
 	"\n" +
 	"start_time\x18\x03 \x01(\tR\tstartTime\x12\x19\n" +
-	"\bend_time\x18\x04 \x01(\tR\aendTime\"v\n" +
+	"\bend_time\x18\x04 \x01(\tR\aendTime\"\x8f\x01\n" +
+	"\x16db_DTakoEventsSortSpec\x129\n" +
+	"\x05field\x18\x01 \x01(\x0e2#.db_service.db_DTakoEventsSortFieldR\x05field\x12:\n" +
+	"\tdirection\x18\x02 \x01(\x0e2\x1c.db_service.db_SortDirectionR\tdirection\"\x91\x02\n" +
 	"\x19db_ListDTakoEventsRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x1e\n" +
-	"\border_by\x18\x03 \x01(\tH\x00R\aorderBy\x88\x01\x01B\v\n" +
-	"\t_order_by\"W\n" +
+	"\border_by\x18\x03 \x01(\tH\x00R\aorderBy\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tH\x01R\tpageToken\x88\x01\x01\x12.\n" +
+	"\x13include_total_count\x18\x05 \x01(\bR\x11includeTotalCount\x126\n" +
+	"\x04sort\x18\x06 \x03(\v2\".db_service.db_DTakoEventsSortSpecR\x04sortB\v\n" +
+	"\t_order_byB\r\n" +
+	"\v_page_token\"v\n" +
+	"\x1bdb_StreamDTakoEventsRequest\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x02 \x01(\tR\aendTime\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x03 \x01(\x05R\tbatchSize\"W\n" +
 	"\x16db_DTakoEventsResponse\x12=\n" +
-	"\fdtako_events\x18\x01 \x01(\v2\x1a.db_service.db_DTakoEventsR\vdtakoEvents\"o\n" +
+	"\fdtako_events\x18\x01 \x01(\v2\x1a.db_service.db_DTakoEventsR\vdtakoEvents\"\xac\x01\n" +
 	"\x1adb_ListDTakoEventsResponse\x120\n" +
-	"\x05items\x18\x01 \x03(\v2\x1a.db_service.db_DTakoEventsR\x05items\x12\x1f\n" +
-	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"(\n" +
+	"\x05items\x18\x01 \x03(\v2\x1a.db_service.db_DTakoEventsR\x05items\x12$\n" +
+	"\vtotal_count\x18\x02 \x01(\x05H\x00R\n" +
+	"totalCount\x88\x01\x01\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageTokenB\x0e\n" +
+	"\f_total_count\"(\n" +
 	"\x16db_GetDTakoRowsRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"H\n" +
 	"#db_GetDTakoRowsByOperationNoRequest\x12!\n" +
-	"\foperation_no\x18\x01 \x01(\tR\voperationNo\"t\n" +
+	"\foperation_no\x18\x01 \x01(\tR\voperationNo\"\x8b\x01\n" +
+	"\x14db_DTakoRowsSortSpec\x127\n" +
+	"\x05field\x18\x01 \x01(\x0e2!.db_service.db_DTakoRowsSortFieldR\x05field\x12:\n" +
+	"\tdirection\x18\x02 \x01(\x0e2\x1c.db_service.db_SortDirectionR\tdirection\"\x8d\x02\n" +
 	"\x17db_ListDTakoRowsRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x1e\n" +
-	"\border_by\x18\x03 \x01(\tH\x00R\aorderBy\x88\x01\x01B\v\n" +
-	"\t_order_by\"O\n" +
+	"\border_by\x18\x03 \x01(\tH\x00R\aorderBy\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tH\x01R\tpageToken\x88\x01\x01\x12.\n" +
+	"\x13include_total_count\x18\x05 \x01(\bR\x11includeTotalCount\x124\n" +
+	"\x04sort\x18\x06 \x03(\v2 .db_service.db_DTakoRowsSortSpecR\x04sortB\v\n" +
+	"\t_order_byB\r\n" +
+	"\v_page_token\"t\n" +
+	"\x19db_StreamDTakoRowsRequest\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x02 \x01(\tR\aendDate\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x03 \x01(\x05R\tbatchSize\"O\n" +
 	"\x14db_DTakoRowsResponse\x127\n" +
 	"\n" +
-	"dtako_rows\x18\x01 \x01(\v2\x18.db_service.db_DTakoRowsR\tdtakoRows\"k\n" +
+	"dtako_rows\x18\x01 \x01(\v2\x18.db_service.db_DTakoRowsR\tdtakoRows\"\xa8\x01\n" +
 	"\x18db_ListDTakoRowsResponse\x12.\n" +
-	"\x05items\x18\x01 \x03(\v2\x18.db_service.db_DTakoRowsR\x05items\x12\x1f\n" +
-	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"C\n" +
+	"\x05items\x18\x01 \x03(\v2\x18.db_service.db_DTakoRowsR\x05items\x12$\n" +
+	"\vtotal_count\x18\x02 \x01(\x05H\x00R\n" +
+	"totalCount\x88\x01\x01\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageTokenB\x0e\n" +
+	"\f_total_count\"C\n" +
 	"\x1fdb_GetETCNumByETCCardNumRequest\x12 \n" +
 	"\fetc_card_num\x18\x01 \x01(\tR\n" +
 	"etcCardNum\"3\n" +
 	"\x1adb_GetETCNumByCarIDRequest\x12\x15\n" +
-	"\x06car_id\x18\x01 \x01(\tR\x05carId\"D\n" +
+	"\x06car_id\x18\x01 \x01(\tR\x05carId\"U\n" +
+	"!db_GetETCNumByETCCardNumAtRequest\x12 \n" +
+	"\fetc_card_num\x18\x01 \x01(\tR\n" +
+	"etcCardNum\x12\x0e\n" +
+	"\x02at\x18\x02 \x01(\tR\x02at\"E\n" +
+	"\x1cdb_GetETCNumByCarIDAtRequest\x12\x15\n" +
+	"\x06car_id\x18\x01 \x01(\tR\x05carId\x12\x0e\n" +
+	"\x02at\x18\x02 \x01(\tR\x02at\"V\n" +
+	"\x1cdb_ListETCNumOverlapsRequest\x12%\n" +
+	"\fetc_card_num\x18\x01 \x01(\tH\x00R\n" +
+	"etcCardNum\x88\x01\x01B\x0f\n" +
+	"\r_etc_card_num\"\x82\x02\n" +
+	"\x10db_ETCNumOverlap\x12 \n" +
+	"\fetc_card_num\x18\x01 \x01(\tR\n" +
+	"etcCardNum\x12+\n" +
+	"\x05first\x18\x02 \x01(\v2\x15.db_service.db_ETCNumR\x05first\x12-\n" +
+	"\x06second\x18\x03 \x01(\v2\x15.db_service.db_ETCNumR\x06second\x12(\n" +
+	"\roverlap_start\x18\x04 \x01(\tH\x00R\foverlapStart\x88\x01\x01\x12$\n" +
+	"\voverlap_end\x18\x05 \x01(\tH\x01R\n" +
+	"overlapEnd\x88\x01\x01B\x10\n" +
+	"\x0e_overlap_startB\x0e\n" +
+	"\f_overlap_end\"Y\n" +
+	"\x1ddb_ListETCNumOverlapsResponse\x128\n" +
+	"\boverlaps\x18\x01 \x03(\v2\x1c.db_service.db_ETCNumOverlapR\boverlaps\"\xa7\x01\n" +
 	"\x14db_ListETCNumRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x02 \x01(\x05R\x06offset\"e\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\"\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tH\x00R\tpageToken\x88\x01\x01\x12.\n" +
+	"\x13include_total_count\x18\x04 \x01(\bR\x11includeTotalCountB\r\n" +
+	"\v_page_token\"\xa2\x01\n" +
 	"\x15db_ListETCNumResponse\x12+\n" +
-	"\x05items\x18\x01 \x03(\v2\x15.db_service.db_ETCNumR\x05items\x12\x1f\n" +
-	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"\xec\a\n" +
+	"\x05items\x18\x01 \x03(\v2\x15.db_service.db_ETCNumR\x05items\x12$\n" +
+	"\vtotal_count\x18\x02 \x01(\x05H\x00R\n" +
+	"totalCount\x88\x01\x01\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageTokenB\x0e\n" +
+	"\f_total_count\"\xec\a\n" +
 	"\x15db_DTakoFerryRowsProd\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
 	"\aunko_no\x18\x02 \x01(\tR\x06unkoNo\x12\x1b\n" +
@@ -9237,16 +17400,22 @@ const file_db_service_proto_rawDesc = "" +
 	"\x1fdb_GetDTakoFerryRowsProdRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\"B\n" +
 	"'db_GetDTakoFerryRowsProdByUnkoNoRequest\x12\x17\n" +
-	"\aunko_no\x18\x01 \x01(\tR\x06unkoNo\"P\n" +
+	"\aunko_no\x18\x01 \x01(\tR\x06unkoNo\"\xb3\x01\n" +
 	" db_ListDTakoFerryRowsProdRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x02 \x01(\x05R\x06offset\"l\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\"\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tH\x00R\tpageToken\x88\x01\x01\x12.\n" +
+	"\x13include_total_count\x18\x04 \x01(\bR\x11includeTotalCountB\r\n" +
+	"\v_page_token\"l\n" +
 	"\x1ddb_DTakoFerryRowsProdResponse\x12K\n" +
-	"\x10dtako_ferry_rows\x18\x01 \x01(\v2!.db_service.db_DTakoFerryRowsProdR\x0edtakoFerryRows\"}\n" +
+	"\x10dtako_ferry_rows\x18\x01 \x01(\v2!.db_service.db_DTakoFerryRowsProdR\x0edtakoFerryRows\"\xba\x01\n" +
 	"!db_ListDTakoFerryRowsProdResponse\x127\n" +
-	"\x05items\x18\x01 \x03(\v2!.db_service.db_DTakoFerryRowsProdR\x05items\x12\x1f\n" +
-	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"\xb6\x06\n" +
+	"\x05items\x18\x01 \x03(\v2!.db_service.db_DTakoFerryRowsProdR\x05items\x12$\n" +
+	"\vtotal_count\x18\x02 \x01(\x05H\x00R\n" +
+	"totalCount\x88\x01\x01\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageTokenB\x0e\n" +
+	"\f_total_count\"\xe9\x06\n" +
 	"\adb_Cars\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
 	"\x03id4\x18\x02 \x01(\x05R\x03id4\x12\x17\n" +
@@ -9277,7 +17446,9 @@ const file_db_service_proto_rawDesc = "" +
 	"daichusho1\x88\x01\x01\x12#\n" +
 	"\n" +
 	"daichusho2\x18\x16 \x01(\tH\rR\n" +
-	"daichusho2\x88\x01\x01B\a\n" +
+	"daichusho2\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"bumon_name\x18\x17 \x01(\tH\x0eR\tbumonName\x88\x01\x01B\a\n" +
 	"\x05_nameB\t\n" +
 	"\a_name_rB\t\n" +
 	"\a_shashuB\n" +
@@ -9294,7 +17465,8 @@ const file_db_service_proto_rawDesc = "" +
 	"_driver_idB\x06\n" +
 	"\x04_etcB\r\n" +
 	"\v_daichusho1B\r\n" +
-	"\v_daichusho2\"\x82\x03\n" +
+	"\v_daichusho2B\r\n" +
+	"\v_bumon_name\"\xb5\x03\n" +
 	"\n" +
 	"db_Drivers\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
@@ -9308,7 +17480,9 @@ const file_db_service_proto_rawDesc = "" +
 	"\abunrui2\x18\b \x01(\tH\x05R\abunrui2\x88\x01\x01\x12\x19\n" +
 	"\x05kubun\x18\t \x01(\x05H\x06R\x05kubun\x88\x01\x01\x12!\n" +
 	"\fkinmu_taikei\x18\n" +
-	" \x01(\x05R\vkinmuTaikeiB\a\n" +
+	" \x01(\x05R\vkinmuTaikei\x12\"\n" +
+	"\n" +
+	"bumon_name\x18\v \x01(\tH\aR\tbumonName\x88\x01\x01B\a\n" +
 	"\x05_nameB\n" +
 	"\n" +
 	"\b_shain_rB\f\n" +
@@ -9319,37 +17493,58 @@ const file_db_service_proto_rawDesc = "" +
 	"\b_bunrui1B\n" +
 	"\n" +
 	"\b_bunrui2B\b\n" +
-	"\x06_kubun\"#\n" +
+	"\x06_kubunB\r\n" +
+	"\v_bumon_name\"#\n" +
 	"\x11db_GetCarsRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"D\n" +
 	"\x1edb_GetCarsByBumonCodeIDRequest\x12\"\n" +
-	"\rbumon_code_id\x18\x01 \x01(\tR\vbumonCodeId\"o\n" +
+	"\rbumon_code_id\x18\x01 \x01(\tR\vbumonCodeId\"\x81\x01\n" +
+	"\x0fdb_CarsSortSpec\x122\n" +
+	"\x05field\x18\x01 \x01(\x0e2\x1c.db_service.db_CarsSortFieldR\x05field\x12:\n" +
+	"\tdirection\x18\x02 \x01(\x0e2\x1c.db_service.db_SortDirectionR\tdirection\"\x83\x02\n" +
 	"\x12db_ListCarsRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x1e\n" +
-	"\border_by\x18\x03 \x01(\tH\x00R\aorderBy\x88\x01\x01B\v\n" +
-	"\t_order_by\":\n" +
+	"\border_by\x18\x03 \x01(\tH\x00R\aorderBy\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tH\x01R\tpageToken\x88\x01\x01\x12.\n" +
+	"\x13include_total_count\x18\x05 \x01(\bR\x11includeTotalCount\x12/\n" +
+	"\x04sort\x18\x06 \x03(\v2\x1b.db_service.db_CarsSortSpecR\x04sortB\v\n" +
+	"\t_order_byB\r\n" +
+	"\v_page_token\":\n" +
 	"\x0fdb_CarsResponse\x12'\n" +
-	"\x04cars\x18\x01 \x01(\v2\x13.db_service.db_CarsR\x04cars\"a\n" +
+	"\x04cars\x18\x01 \x01(\v2\x13.db_service.db_CarsR\x04cars\"\x9e\x01\n" +
 	"\x13db_ListCarsResponse\x12)\n" +
-	"\x05items\x18\x01 \x03(\v2\x13.db_service.db_CarsR\x05items\x12\x1f\n" +
-	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"&\n" +
+	"\x05items\x18\x01 \x03(\v2\x13.db_service.db_CarsR\x05items\x12$\n" +
+	"\vtotal_count\x18\x02 \x01(\x05H\x00R\n" +
+	"totalCount\x88\x01\x01\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageTokenB\x0e\n" +
+	"\f_total_count\"&\n" +
 	"\x14db_GetDriversRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\"3\n" +
 	"\x1bdb_GetDriversByBumonRequest\x12\x14\n" +
-	"\x05bumon\x18\x01 \x01(\tR\x05bumon\"r\n" +
+	"\x05bumon\x18\x01 \x01(\tR\x05bumon\"\x87\x01\n" +
+	"\x12db_DriversSortSpec\x125\n" +
+	"\x05field\x18\x01 \x01(\x0e2\x1f.db_service.db_DriversSortFieldR\x05field\x12:\n" +
+	"\tdirection\x18\x02 \x01(\x0e2\x1c.db_service.db_SortDirectionR\tdirection\"\x89\x02\n" +
 	"\x15db_ListDriversRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x1e\n" +
-	"\border_by\x18\x03 \x01(\tH\x00R\aorderBy\x88\x01\x01B\v\n" +
-	"\t_order_by\"F\n" +
+	"\border_by\x18\x03 \x01(\tH\x00R\aorderBy\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tH\x01R\tpageToken\x88\x01\x01\x12.\n" +
+	"\x13include_total_count\x18\x05 \x01(\bR\x11includeTotalCount\x122\n" +
+	"\x04sort\x18\x06 \x03(\v2\x1e.db_service.db_DriversSortSpecR\x04sortB\v\n" +
+	"\t_order_byB\r\n" +
+	"\v_page_token\"F\n" +
 	"\x12db_DriversResponse\x120\n" +
-	"\adrivers\x18\x01 \x01(\v2\x16.db_service.db_DriversR\adrivers\"g\n" +
+	"\adrivers\x18\x01 \x01(\v2\x16.db_service.db_DriversR\adrivers\"\xa4\x01\n" +
 	"\x16db_ListDriversResponse\x12,\n" +
-	"\x05items\x18\x01 \x03(\v2\x16.db_service.db_DriversR\x05items\x12\x1f\n" +
-	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"\xf0 \n" +
+	"\x05items\x18\x01 \x03(\v2\x16.db_service.db_DriversR\x05items\x12$\n" +
+	"\vtotal_count\x18\x02 \x01(\x05H\x00R\n" +
+	"totalCount\x88\x01\x01\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageTokenB\x0e\n" +
+	"\f_total_count\"\xf5!\n" +
 	"\x13db_UntenNippoMeisai\x12\x17\n" +
 	"\anippo_k\x18\x01 \x01(\tR\x06nippoK\x12(\n" +
 	"\runko_nengappi\x18\x02 \x01(\tH\x00R\funkoNengappi\x88\x01\x01\x12\x19\n" +
@@ -9473,7 +17668,9 @@ const file_db_service_proto_rawDesc = "" +
 	"\x05yobi4\x18g \x01(\tH\x1dR\x05yobi4\x88\x01\x01\x12\x19\n" +
 	"\x05yobi5\x18h \x01(\tH\x1eR\x05yobi5\x88\x01\x01\x12\x19\n" +
 	"\x05yobi6\x18i \x01(\tH\x1fR\x05yobi6\x88\x01\x01\x12\x19\n" +
-	"\x05yobi7\x18j \x01(\tH R\x05yobi7\x88\x01\x01B\x10\n" +
+	"\x05yobi7\x18j \x01(\tH R\x05yobi7\x88\x01\x01\x12-\n" +
+	"\x10juchu_bumon_name\x18k \x01(\tH!R\x0ejuchuBumonName\x88\x01\x01\x12+\n" +
+	"\x0fkado_bumon_name\x18l \x01(\tH\"R\rkadoBumonName\x88\x01\x01B\x10\n" +
 	"\x0e_unko_nengappiB\x14\n" +
 	"\x12_nyuryoku_nengappiB\x12\n" +
 	"\x10_shokai_nengappiB\x11\n" +
@@ -9508,7 +17705,9 @@ const file_db_service_proto_rawDesc = "" +
 	"\x06_yobi4B\b\n" +
 	"\x06_yobi5B\b\n" +
 	"\x06_yobi6B\b\n" +
-	"\x06_yobi7\"\xe0\r\n" +
+	"\x06_yobi7B\x13\n" +
+	"\x11_juchu_bumon_nameB\x12\n" +
+	"\x10_kado_bumon_name\"\xe0\r\n" +
 	"\x0edb_ShainMaster\x12\x17\n" +
 	"\ashain_c\x18\x01 \x01(\tR\x06shainC\x12\x1c\n" +
 	"\ashain_n\x18\x02 \x01(\tH\x00R\x06shainN\x88\x01\x01\x12\x1c\n" +
@@ -9643,7 +17842,79 @@ const file_db_service_proto_rawDesc = "" +
 	"\x06_yobi2B\b\n" +
 	"\x06_yobi3B\b\n" +
 	"\x06_yobi4B\b\n" +
-	"\x06_yobi5\"n\n" +
+	"\x06_yobi5\"\x92\x04\n" +
+	"\x12db_TokuisakiMaster\x12\x1f\n" +
+	"\vtokuisaki_c\x18\x01 \x01(\tR\n" +
+	"tokuisakiC\x12\x1f\n" +
+	"\vtokuisaki_h\x18\x02 \x01(\tR\n" +
+	"tokuisakiH\x12$\n" +
+	"\vtokuisaki_n\x18\x03 \x01(\tH\x00R\n" +
+	"tokuisakiN\x88\x01\x01\x12$\n" +
+	"\vtokuisaki_r\x18\x04 \x01(\tH\x01R\n" +
+	"tokuisakiR\x88\x01\x01\x12$\n" +
+	"\vtokuisaki_f\x18\x05 \x01(\tH\x02R\n" +
+	"tokuisakiF\x88\x01\x01\x12$\n" +
+	"\vyubin_bango\x18\x06 \x01(\tH\x03R\n" +
+	"yubinBango\x88\x01\x01\x12\x1b\n" +
+	"\x06jusho1\x18\a \x01(\tH\x04R\x06jusho1\x88\x01\x01\x12\x1b\n" +
+	"\x06jusho2\x18\b \x01(\tH\x05R\x06jusho2\x88\x01\x01\x12$\n" +
+	"\vdenwa_bango\x18\t \x01(\tH\x06R\n" +
+	"denwaBango\x88\x01\x01\x12 \n" +
+	"\tfax_bango\x18\n" +
+	" \x01(\tH\aR\bfaxBango\x88\x01\x01\x12\x1f\n" +
+	"\btantosha\x18\v \x01(\tH\bR\btantosha\x88\x01\x01B\x0e\n" +
+	"\f_tokuisaki_nB\x0e\n" +
+	"\f_tokuisaki_rB\x0e\n" +
+	"\f_tokuisaki_fB\x0e\n" +
+	"\f_yubin_bangoB\t\n" +
+	"\a_jusho1B\t\n" +
+	"\a_jusho2B\x0e\n" +
+	"\f_denwa_bangoB\f\n" +
+	"\n" +
+	"_fax_bangoB\v\n" +
+	"\t_tantosha\"\xe6\x01\n" +
+	"\x1adb_TokuisakiTekiyobiMaster\x12\x1f\n" +
+	"\vtokuisaki_c\x18\x01 \x01(\tR\n" +
+	"tokuisakiC\x12\x1f\n" +
+	"\vtokuisaki_h\x18\x02 \x01(\tR\n" +
+	"tokuisakiH\x12\x1a\n" +
+	"\btekiyobi\x18\x03 \x01(\tR\btekiyobi\x12$\n" +
+	"\vtokuisaki_n\x18\x04 \x01(\tH\x00R\n" +
+	"tokuisakiN\x88\x01\x01\x12$\n" +
+	"\vtokuisaki_r\x18\x05 \x01(\tH\x01R\n" +
+	"tokuisakiR\x88\x01\x01B\x0e\n" +
+	"\f_tokuisaki_nB\x0e\n" +
+	"\f_tokuisaki_r\"\xa3\x02\n" +
+	"\x0fdb_HinmeiMaster\x12\x19\n" +
+	"\bhinmei_c\x18\x01 \x01(\tR\ahinmeiC\x12\x19\n" +
+	"\bhinmei_h\x18\x02 \x01(\tR\ahinmeiH\x12\x1e\n" +
+	"\bhinmei_n\x18\x03 \x01(\tH\x00R\ahinmeiN\x88\x01\x01\x12\x1e\n" +
+	"\bhinmei_r\x18\x04 \x01(\tH\x01R\ahinmeiR\x88\x01\x01\x12\x1e\n" +
+	"\bhinmei_f\x18\x05 \x01(\tH\x02R\ahinmeiF\x88\x01\x01\x12\x17\n" +
+	"\x04tani\x18\x06 \x01(\tH\x03R\x04tani\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"tani_juryo\x18\a \x01(\x01H\x04R\ttaniJuryo\x88\x01\x01B\v\n" +
+	"\t_hinmei_nB\v\n" +
+	"\t_hinmei_rB\v\n" +
+	"\t_hinmei_fB\a\n" +
+	"\x05_taniB\r\n" +
+	"\v_tani_juryo\"\xc1\x02\n" +
+	"\x18db_TokuisakiHinmeiMaster\x12\x1f\n" +
+	"\vtokuisaki_c\x18\x01 \x01(\tR\n" +
+	"tokuisakiC\x12\x1f\n" +
+	"\vtokuisaki_h\x18\x02 \x01(\tR\n" +
+	"tokuisakiH\x12\x19\n" +
+	"\bhinmei_c\x18\x03 \x01(\tR\ahinmeiC\x12\x19\n" +
+	"\bhinmei_h\x18\x04 \x01(\tR\ahinmeiH\x12\x1e\n" +
+	"\bhinmei_n\x18\x05 \x01(\tH\x00R\ahinmeiN\x88\x01\x01\x12\x1e\n" +
+	"\bhinmei_r\x18\x06 \x01(\tH\x01R\ahinmeiR\x88\x01\x01\x12\x17\n" +
+	"\x04tani\x18\a \x01(\tH\x02R\x04tani\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"tani_juryo\x18\b \x01(\x01H\x03R\ttaniJuryo\x88\x01\x01B\v\n" +
+	"\t_hinmei_nB\v\n" +
+	"\t_hinmei_rB\a\n" +
+	"\x05_taniB\r\n" +
+	"\v_tani_juryo\"n\n" +
 	"\x1ddb_GetUntenNippoMeisaiRequest\x12\x17\n" +
 	"\anippo_k\x18\x01 \x01(\tR\x06nippoK\x12\x19\n" +
 	"\bhaisha_k\x18\x02 \x01(\tR\ahaishaK\x12\x19\n" +
@@ -9656,61 +17927,330 @@ const file_db_service_proto_rawDesc = "" +
 	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
 	"\bend_date\x18\x02 \x01(\tR\aendDate\x12\x14\n" +
 	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x04 \x01(\x05R\x06offset\"{\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"\xe5\x01\n" +
+	"$db_GetUntenNippoMeisaiByBumonRequest\x12\x14\n" +
+	"\x05bumon\x18\x01 \x01(\tR\x05bumon\x12?\n" +
+	"\x05field\x18\x02 \x01(\x0e2).db_service.db_UntenNippoMeisaiBumonFieldR\x05field\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x04 \x01(\tR\aendDate\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x06 \x01(\x05R\x06offset\"\x99\x01\n" +
+	"\x1bdb_UntenNippoMeisaiSortSpec\x12>\n" +
+	"\x05field\x18\x01 \x01(\x0e2(.db_service.db_UntenNippoMeisaiSortFieldR\x05field\x12:\n" +
+	"\tdirection\x18\x02 \x01(\x0e2\x1c.db_service.db_SortDirectionR\tdirection\"\x9b\x02\n" +
 	"\x1edb_ListUntenNippoMeisaiRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x1e\n" +
-	"\border_by\x18\x03 \x01(\tH\x00R\aorderBy\x88\x01\x01B\v\n" +
-	"\t_order_by\"l\n" +
+	"\border_by\x18\x03 \x01(\tH\x00R\aorderBy\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tH\x01R\tpageToken\x88\x01\x01\x12.\n" +
+	"\x13include_total_count\x18\x05 \x01(\bR\x11includeTotalCount\x12;\n" +
+	"\x04sort\x18\x06 \x03(\v2'.db_service.db_UntenNippoMeisaiSortSpecR\x04sortB\v\n" +
+	"\t_order_byB\r\n" +
+	"\v_page_token\"{\n" +
+	" db_StreamUntenNippoMeisaiRequest\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x02 \x01(\tR\aendDate\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x03 \x01(\x05R\tbatchSize\"l\n" +
 	"\x1bdb_UntenNippoMeisaiResponse\x12M\n" +
-	"\x12unten_nippo_meisai\x18\x01 \x01(\v2\x1f.db_service.db_UntenNippoMeisaiR\x10untenNippoMeisai\"y\n" +
+	"\x12unten_nippo_meisai\x18\x01 \x01(\v2\x1f.db_service.db_UntenNippoMeisaiR\x10untenNippoMeisai\"\xb6\x01\n" +
 	"\x1fdb_ListUntenNippoMeisaiResponse\x125\n" +
-	"\x05items\x18\x01 \x03(\v2\x1f.db_service.db_UntenNippoMeisaiR\x05items\x12\x1f\n" +
-	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"3\n" +
+	"\x05items\x18\x01 \x03(\v2\x1f.db_service.db_UntenNippoMeisaiR\x05items\x12$\n" +
+	"\vtotal_count\x18\x02 \x01(\x05H\x00R\n" +
+	"totalCount\x88\x01\x01\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageTokenB\x0e\n" +
+	"\f_total_count\"3\n" +
 	"\x18db_GetShainMasterRequest\x12\x17\n" +
 	"\ashain_c\x18\x01 \x01(\tR\x06shainC\";\n" +
 	" db_GetShainMasterByBumonCRequest\x12\x17\n" +
-	"\abumon_c\x18\x01 \x01(\tR\x06bumonC\"v\n" +
+	"\abumon_c\x18\x01 \x01(\tR\x06bumonC\"\x8f\x01\n" +
+	"\x16db_ShainMasterSortSpec\x129\n" +
+	"\x05field\x18\x01 \x01(\x0e2#.db_service.db_ShainMasterSortFieldR\x05field\x12:\n" +
+	"\tdirection\x18\x02 \x01(\x0e2\x1c.db_service.db_SortDirectionR\tdirection\"\x91\x02\n" +
 	"\x19db_ListShainMasterRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x1e\n" +
-	"\border_by\x18\x03 \x01(\tH\x00R\aorderBy\x88\x01\x01B\v\n" +
-	"\t_order_by\"W\n" +
+	"\border_by\x18\x03 \x01(\tH\x00R\aorderBy\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tH\x01R\tpageToken\x88\x01\x01\x12.\n" +
+	"\x13include_total_count\x18\x05 \x01(\bR\x11includeTotalCount\x126\n" +
+	"\x04sort\x18\x06 \x03(\v2\".db_service.db_ShainMasterSortSpecR\x04sortB\v\n" +
+	"\t_order_byB\r\n" +
+	"\v_page_token\"W\n" +
 	"\x16db_ShainMasterResponse\x12=\n" +
-	"\fshain_master\x18\x01 \x01(\v2\x1a.db_service.db_ShainMasterR\vshainMaster\"o\n" +
+	"\fshain_master\x18\x01 \x01(\v2\x1a.db_service.db_ShainMasterR\vshainMaster\"\xac\x01\n" +
 	"\x1adb_ListShainMasterResponse\x120\n" +
-	"\x05items\x18\x01 \x03(\v2\x1a.db_service.db_ShainMasterR\x05items\x12\x1f\n" +
-	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"6\n" +
+	"\x05items\x18\x01 \x03(\v2\x1a.db_service.db_ShainMasterR\x05items\x12$\n" +
+	"\vtotal_count\x18\x02 \x01(\x05H\x00R\n" +
+	"totalCount\x88\x01\x01\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageTokenB\x0e\n" +
+	"\f_total_count\"6\n" +
 	"\x19db_GetChiikiMasterRequest\x12\x19\n" +
-	"\bchiiki_c\x18\x01 \x01(\tR\achiikiC\"w\n" +
+	"\bchiiki_c\x18\x01 \x01(\tR\achiikiC\"\x91\x01\n" +
+	"\x17db_ChiikiMasterSortSpec\x12:\n" +
+	"\x05field\x18\x01 \x01(\x0e2$.db_service.db_ChiikiMasterSortFieldR\x05field\x12:\n" +
+	"\tdirection\x18\x02 \x01(\x0e2\x1c.db_service.db_SortDirectionR\tdirection\"\x93\x02\n" +
 	"\x1adb_ListChiikiMasterRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x1e\n" +
-	"\border_by\x18\x03 \x01(\tH\x00R\aorderBy\x88\x01\x01B\v\n" +
-	"\t_order_by\"[\n" +
+	"\border_by\x18\x03 \x01(\tH\x00R\aorderBy\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tH\x01R\tpageToken\x88\x01\x01\x12.\n" +
+	"\x13include_total_count\x18\x05 \x01(\bR\x11includeTotalCount\x127\n" +
+	"\x04sort\x18\x06 \x03(\v2#.db_service.db_ChiikiMasterSortSpecR\x04sortB\v\n" +
+	"\t_order_byB\r\n" +
+	"\v_page_token\"[\n" +
 	"\x17db_ChiikiMasterResponse\x12@\n" +
-	"\rchiiki_master\x18\x01 \x01(\v2\x1b.db_service.db_ChiikiMasterR\fchiikiMaster\"q\n" +
+	"\rchiiki_master\x18\x01 \x01(\v2\x1b.db_service.db_ChiikiMasterR\fchiikiMaster\"\xae\x01\n" +
 	"\x1bdb_ListChiikiMasterResponse\x121\n" +
-	"\x05items\x18\x01 \x03(\v2\x1b.db_service.db_ChiikiMasterR\x05items\x12\x1f\n" +
-	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"3\n" +
+	"\x05items\x18\x01 \x03(\v2\x1b.db_service.db_ChiikiMasterR\x05items\x12$\n" +
+	"\vtotal_count\x18\x02 \x01(\x05H\x00R\n" +
+	"totalCount\x88\x01\x01\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageTokenB\x0e\n" +
+	"\f_total_count\"3\n" +
 	"\x18db_GetChikuMasterRequest\x12\x17\n" +
 	"\achiku_c\x18\x01 \x01(\tR\x06chikuC\">\n" +
 	"!db_GetChikuMasterByChiikiCRequest\x12\x19\n" +
-	"\bchiiki_c\x18\x01 \x01(\tR\achiikiC\"v\n" +
+	"\bchiiki_c\x18\x01 \x01(\tR\achiikiC\"\x8f\x01\n" +
+	"\x16db_ChikuMasterSortSpec\x129\n" +
+	"\x05field\x18\x01 \x01(\x0e2#.db_service.db_ChikuMasterSortFieldR\x05field\x12:\n" +
+	"\tdirection\x18\x02 \x01(\x0e2\x1c.db_service.db_SortDirectionR\tdirection\"\x91\x02\n" +
 	"\x19db_ListChikuMasterRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x1e\n" +
-	"\border_by\x18\x03 \x01(\tH\x00R\aorderBy\x88\x01\x01B\v\n" +
-	"\t_order_by\"W\n" +
+	"\border_by\x18\x03 \x01(\tH\x00R\aorderBy\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tH\x01R\tpageToken\x88\x01\x01\x12.\n" +
+	"\x13include_total_count\x18\x05 \x01(\bR\x11includeTotalCount\x126\n" +
+	"\x04sort\x18\x06 \x03(\v2\".db_service.db_ChikuMasterSortSpecR\x04sortB\v\n" +
+	"\t_order_byB\r\n" +
+	"\v_page_token\"W\n" +
 	"\x16db_ChikuMasterResponse\x12=\n" +
-	"\fchiku_master\x18\x01 \x01(\v2\x1a.db_service.db_ChikuMasterR\vchikuMaster\"o\n" +
+	"\fchiku_master\x18\x01 \x01(\v2\x1a.db_service.db_ChikuMasterR\vchikuMaster\"\xac\x01\n" +
 	"\x1adb_ListChikuMasterResponse\x120\n" +
-	"\x05items\x18\x01 \x03(\v2\x1a.db_service.db_ChikuMasterR\x05items\x12\x1f\n" +
-	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"\xdd\x01\n" +
+	"\x05items\x18\x01 \x03(\v2\x1a.db_service.db_ChikuMasterR\x05items\x12$\n" +
+	"\vtotal_count\x18\x02 \x01(\x05H\x00R\n" +
+	"totalCount\x88\x01\x01\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageTokenB\x0e\n" +
+	"\f_total_count\"`\n" +
+	"\x1cdb_GetTokuisakiMasterRequest\x12\x1f\n" +
+	"\vtokuisaki_c\x18\x01 \x01(\tR\n" +
+	"tokuisakiC\x12\x1f\n" +
+	"\vtokuisaki_h\x18\x02 \x01(\tR\n" +
+	"tokuisakiH\"\x97\x01\n" +
+	"\x1adb_TokuisakiMasterSortSpec\x12=\n" +
+	"\x05field\x18\x01 \x01(\x0e2'.db_service.db_TokuisakiMasterSortFieldR\x05field\x12:\n" +
+	"\tdirection\x18\x02 \x01(\x0e2\x1c.db_service.db_SortDirectionR\tdirection\"\x99\x02\n" +
+	"\x1ddb_ListTokuisakiMasterRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x1e\n" +
+	"\border_by\x18\x03 \x01(\tH\x00R\aorderBy\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tH\x01R\tpageToken\x88\x01\x01\x12.\n" +
+	"\x13include_total_count\x18\x05 \x01(\bR\x11includeTotalCount\x12:\n" +
+	"\x04sort\x18\x06 \x03(\v2&.db_service.db_TokuisakiMasterSortSpecR\x04sortB\v\n" +
+	"\t_order_byB\r\n" +
+	"\v_page_token\"M\n" +
+	"\x1fdb_SearchTokuisakiMasterRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"g\n" +
+	"\x1adb_TokuisakiMasterResponse\x12I\n" +
+	"\x10tokuisaki_master\x18\x01 \x01(\v2\x1e.db_service.db_TokuisakiMasterR\x0ftokuisakiMaster\"\xb4\x01\n" +
+	"\x1edb_ListTokuisakiMasterResponse\x124\n" +
+	"\x05items\x18\x01 \x03(\v2\x1e.db_service.db_TokuisakiMasterR\x05items\x12$\n" +
+	"\vtotal_count\x18\x02 \x01(\x05H\x00R\n" +
+	"totalCount\x88\x01\x01\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageTokenB\x0e\n" +
+	"\f_total_count\"c\n" +
+	"\x1fdb_ListTokuisakiTekiyobiRequest\x12\x1f\n" +
+	"\vtokuisaki_c\x18\x01 \x01(\tR\n" +
+	"tokuisakiC\x12\x1f\n" +
+	"\vtokuisaki_h\x18\x02 \x01(\tR\n" +
+	"tokuisakiH\"x\n" +
+	" db_GetTokuisakiTekiyobiAtRequest\x12\x1f\n" +
+	"\vtokuisaki_c\x18\x01 \x01(\tR\n" +
+	"tokuisakiC\x12\x1f\n" +
+	"\vtokuisaki_h\x18\x02 \x01(\tR\n" +
+	"tokuisakiH\x12\x12\n" +
+	"\x04date\x18\x03 \x01(\tR\x04date\"u\n" +
+	"\x1cdb_TokuisakiTekiyobiResponse\x12U\n" +
+	"\x12tokuisaki_tekiyobi\x18\x01 \x01(\v2&.db_service.db_TokuisakiTekiyobiMasterR\x11tokuisakiTekiyobi\"`\n" +
+	" db_ListTokuisakiTekiyobiResponse\x12<\n" +
+	"\x05items\x18\x01 \x03(\v2&.db_service.db_TokuisakiTekiyobiMasterR\x05items\"Q\n" +
+	"\x19db_GetHinmeiMasterRequest\x12\x19\n" +
+	"\bhinmei_c\x18\x01 \x01(\tR\ahinmeiC\x12\x19\n" +
+	"\bhinmei_h\x18\x02 \x01(\tR\ahinmeiH\"\x91\x01\n" +
+	"\x17db_HinmeiMasterSortSpec\x12:\n" +
+	"\x05field\x18\x01 \x01(\x0e2$.db_service.db_HinmeiMasterSortFieldR\x05field\x12:\n" +
+	"\tdirection\x18\x02 \x01(\x0e2\x1c.db_service.db_SortDirectionR\tdirection\"\x93\x02\n" +
+	"\x1adb_ListHinmeiMasterRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x1e\n" +
+	"\border_by\x18\x03 \x01(\tH\x00R\aorderBy\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tH\x01R\tpageToken\x88\x01\x01\x12.\n" +
+	"\x13include_total_count\x18\x05 \x01(\bR\x11includeTotalCount\x127\n" +
+	"\x04sort\x18\x06 \x03(\v2#.db_service.db_HinmeiMasterSortSpecR\x04sortB\v\n" +
+	"\t_order_byB\r\n" +
+	"\v_page_token\"J\n" +
+	"\x1cdb_SearchHinmeiMasterRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"[\n" +
+	"\x17db_HinmeiMasterResponse\x12@\n" +
+	"\rhinmei_master\x18\x01 \x01(\v2\x1b.db_service.db_HinmeiMasterR\fhinmeiMaster\"\xae\x01\n" +
+	"\x1bdb_ListHinmeiMasterResponse\x121\n" +
+	"\x05items\x18\x01 \x03(\v2\x1b.db_service.db_HinmeiMasterR\x05items\x12$\n" +
+	"\vtotal_count\x18\x02 \x01(\x05H\x00R\n" +
+	"totalCount\x88\x01\x01\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageTokenB\x0e\n" +
+	"\f_total_count\"a\n" +
+	"\x1ddb_ListTokuisakiHinmeiRequest\x12\x1f\n" +
+	"\vtokuisaki_c\x18\x01 \x01(\tR\n" +
+	"tokuisakiC\x12\x1f\n" +
+	"\vtokuisaki_h\x18\x02 \x01(\tR\n" +
+	"tokuisakiH\"\\\n" +
+	"\x1edb_ListTokuisakiHinmeiResponse\x12:\n" +
+	"\x05items\x18\x01 \x03(\v2$.db_service.db_TokuisakiHinmeiMasterR\x05items\"\x96\x01\n" +
+	"\x1cdb_GetTokuisakiHinmeiRequest\x12\x1f\n" +
+	"\vtokuisaki_c\x18\x01 \x01(\tR\n" +
+	"tokuisakiC\x12\x1f\n" +
+	"\vtokuisaki_h\x18\x02 \x01(\tR\n" +
+	"tokuisakiH\x12\x19\n" +
+	"\bhinmei_c\x18\x03 \x01(\tR\ahinmeiC\x12\x19\n" +
+	"\bhinmei_h\x18\x04 \x01(\tR\ahinmeiH\"m\n" +
+	"\x1adb_TokuisakiHinmeiResponse\x12O\n" +
+	"\x10tokuisaki_hinmei\x18\x01 \x01(\v2$.db_service.db_TokuisakiHinmeiMasterR\x0ftokuisakiHinmei\"\x91\x01\n" +
+	"\x17db_ResolveHinmeiRequest\x12\x1f\n" +
+	"\vtokuisaki_c\x18\x01 \x01(\tR\n" +
+	"tokuisakiC\x12\x1f\n" +
+	"\vtokuisaki_h\x18\x02 \x01(\tR\n" +
+	"tokuisakiH\x12\x19\n" +
+	"\bhinmei_c\x18\x03 \x01(\tR\ahinmeiC\x12\x19\n" +
+	"\bhinmei_h\x18\x04 \x01(\tR\ahinmeiH\"\xc3\x02\n" +
+	"\x18db_ResolveHinmeiResponse\x12\x1f\n" +
+	"\vtokuisaki_c\x18\x01 \x01(\tR\n" +
+	"tokuisakiC\x12\x1f\n" +
+	"\vtokuisaki_h\x18\x02 \x01(\tR\n" +
+	"tokuisakiH\x12\x19\n" +
+	"\bhinmei_c\x18\x03 \x01(\tR\ahinmeiC\x12\x19\n" +
+	"\bhinmei_h\x18\x04 \x01(\tR\ahinmeiH\x12\x1e\n" +
+	"\bhinmei_n\x18\x05 \x01(\tH\x00R\ahinmeiN\x88\x01\x01\x12\x17\n" +
+	"\x04tani\x18\x06 \x01(\tH\x01R\x04tani\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"tani_juryo\x18\a \x01(\x01H\x02R\ttaniJuryo\x88\x01\x01\x12-\n" +
+	"\x12tokuisaki_specific\x18\b \x01(\bR\x11tokuisakiSpecificB\v\n" +
+	"\t_hinmei_nB\a\n" +
+	"\x05_taniB\r\n" +
+	"\v_tani_juryo\"\xa7\x01\n" +
+	"\x0edb_BumonMaster\x12\x17\n" +
+	"\abumon_c\x18\x01 \x01(\tR\x06bumonC\x12\x1c\n" +
+	"\abumon_n\x18\x02 \x01(\tH\x00R\x06bumonN\x88\x01\x01\x12\x1c\n" +
+	"\abumon_r\x18\x03 \x01(\tH\x01R\x06bumonR\x88\x01\x01\x12\x1c\n" +
+	"\abumon_f\x18\x04 \x01(\tH\x02R\x06bumonF\x88\x01\x01B\n" +
+	"\n" +
+	"\b_bumon_nB\n" +
+	"\n" +
+	"\b_bumon_rB\n" +
+	"\n" +
+	"\b_bumon_f\"3\n" +
+	"\x18db_GetBumonMasterRequest\x12\x17\n" +
+	"\abumon_c\x18\x01 \x01(\tR\x06bumonC\"\x8f\x01\n" +
+	"\x16db_BumonMasterSortSpec\x129\n" +
+	"\x05field\x18\x01 \x01(\x0e2#.db_service.db_BumonMasterSortFieldR\x05field\x12:\n" +
+	"\tdirection\x18\x02 \x01(\x0e2\x1c.db_service.db_SortDirectionR\tdirection\"\x91\x02\n" +
+	"\x19db_ListBumonMasterRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x1e\n" +
+	"\border_by\x18\x03 \x01(\tH\x00R\aorderBy\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tH\x01R\tpageToken\x88\x01\x01\x12.\n" +
+	"\x13include_total_count\x18\x05 \x01(\bR\x11includeTotalCount\x126\n" +
+	"\x04sort\x18\x06 \x03(\v2\".db_service.db_BumonMasterSortSpecR\x04sortB\v\n" +
+	"\t_order_byB\r\n" +
+	"\v_page_token\"W\n" +
+	"\x16db_BumonMasterResponse\x12=\n" +
+	"\fbumon_master\x18\x01 \x01(\v2\x1a.db_service.db_BumonMasterR\vbumonMaster\"\xac\x01\n" +
+	"\x1adb_ListBumonMasterResponse\x120\n" +
+	"\x05items\x18\x01 \x03(\v2\x1a.db_service.db_BumonMasterR\x05items\x12$\n" +
+	"\vtotal_count\x18\x02 \x01(\x05H\x00R\n" +
+	"totalCount\x88\x01\x01\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageTokenB\x0e\n" +
+	"\f_total_count\"\xab\x02\n" +
+	"\x0fdb_SharyoMaster\x12\x19\n" +
+	"\bsharyo_c\x18\x01 \x01(\tR\asharyoC\x12\x19\n" +
+	"\bsharyo_h\x18\x02 \x01(\tR\asharyoH\x12\x1e\n" +
+	"\bsharyo_n\x18\x03 \x01(\tH\x00R\asharyoN\x88\x01\x01\x12\x1e\n" +
+	"\bsharyo_r\x18\x04 \x01(\tH\x01R\asharyoR\x88\x01\x01\x12\x1e\n" +
+	"\bshashu_c\x18\x05 \x01(\tH\x02R\ashashuC\x88\x01\x01\x12\x1c\n" +
+	"\abumon_c\x18\x06 \x01(\tH\x03R\x06bumonC\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"untenshu_c\x18\a \x01(\tH\x04R\tuntenshuC\x88\x01\x01B\v\n" +
+	"\t_sharyo_nB\v\n" +
+	"\t_sharyo_rB\v\n" +
+	"\t_shashu_cB\n" +
+	"\n" +
+	"\b_bumon_cB\r\n" +
+	"\v_untenshu_c\"Q\n" +
+	"\x19db_GetSharyoMasterRequest\x12\x19\n" +
+	"\bsharyo_c\x18\x01 \x01(\tR\asharyoC\x12\x19\n" +
+	"\bsharyo_h\x18\x02 \x01(\tR\asharyoH\"@\n" +
+	"#db_ListSharyoMasterBySharyoCRequest\x12\x19\n" +
+	"\bsharyo_c\x18\x01 \x01(\tR\asharyoC\"\x91\x01\n" +
+	"\x17db_SharyoMasterSortSpec\x12:\n" +
+	"\x05field\x18\x01 \x01(\x0e2$.db_service.db_SharyoMasterSortFieldR\x05field\x12:\n" +
+	"\tdirection\x18\x02 \x01(\x0e2\x1c.db_service.db_SortDirectionR\tdirection\"\x93\x02\n" +
+	"\x1adb_ListSharyoMasterRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x1e\n" +
+	"\border_by\x18\x03 \x01(\tH\x00R\aorderBy\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tH\x01R\tpageToken\x88\x01\x01\x12.\n" +
+	"\x13include_total_count\x18\x05 \x01(\bR\x11includeTotalCount\x127\n" +
+	"\x04sort\x18\x06 \x03(\v2#.db_service.db_SharyoMasterSortSpecR\x04sortB\v\n" +
+	"\t_order_byB\r\n" +
+	"\v_page_token\"[\n" +
+	"\x17db_SharyoMasterResponse\x12@\n" +
+	"\rsharyo_master\x18\x01 \x01(\v2\x1b.db_service.db_SharyoMasterR\fsharyoMaster\"\xae\x01\n" +
+	"\x1bdb_ListSharyoMasterResponse\x121\n" +
+	"\x05items\x18\x01 \x03(\v2\x1b.db_service.db_SharyoMasterR\x05items\x12$\n" +
+	"\vtotal_count\x18\x02 \x01(\x05H\x00R\n" +
+	"totalCount\x88\x01\x01\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageTokenB\x0e\n" +
+	"\f_total_count\"\xf0\x01\n" +
+	"\x1cdb_GetVehicleIdentityRequest\x12\x17\n" +
+	"\x06car_id\x18\x01 \x01(\tH\x00R\x05carId\x12\x12\n" +
+	"\x03id4\x18\x02 \x01(\x05H\x00R\x03id4\x12\x1b\n" +
+	"\bcar_code\x18\x03 \x01(\tH\x00R\acarCode\x12\x17\n" +
+	"\x06car_cc\x18\x04 \x01(\tH\x00R\x05carCc\x12\x1e\n" +
+	"\n" +
+	"etc_car_id\x18\x05 \x01(\tH\x00R\betcCarId\x12\x1b\n" +
+	"\bsharyo_c\x18\x06 \x01(\tH\x00R\asharyoC\x12\x19\n" +
+	"\bsharyo_h\x18\a \x01(\tR\asharyoH\x12\x0e\n" +
+	"\x02at\x18\b \x01(\tR\x02atB\x05\n" +
+	"\x03key\"\xe5\x02\n" +
+	"\x12db_VehicleIdentity\x12\x15\n" +
+	"\x06car_cc\x18\x01 \x01(\tR\x05carCc\x12\x15\n" +
+	"\x03id4\x18\x02 \x01(\x05H\x00R\x03id4\x88\x01\x01\x12*\n" +
+	"\x03car\x18\x03 \x01(\v2\x13.db_service.db_CarsH\x01R\x03car\x88\x01\x01\x127\n" +
+	"\n" +
+	"dtako_cars\x18\x04 \x03(\v2\x18.db_service.db_DTakoCarsR\tdtakoCars\x120\n" +
+	"\betc_nums\x18\x05 \x03(\v2\x15.db_service.db_ETCNumR\aetcNums\x12B\n" +
+	"\x0esharyo_masters\x18\x06 \x03(\v2\x1b.db_service.db_SharyoMasterR\rsharyoMasters\x126\n" +
+	"\amissing\x18\a \x03(\x0e2\x1c.db_service.db_VehicleSystemR\amissingB\x06\n" +
+	"\x04_id4B\x06\n" +
+	"\x04_car\"\x97\x01\n" +
+	"\x1adb_VehicleIdentityResponse\x12:\n" +
+	"\bidentity\x18\x01 \x01(\v2\x1e.db_service.db_VehicleIdentityR\bidentity\x12=\n" +
+	"\x0factive_etc_nums\x18\x02 \x03(\v2\x15.db_service.db_ETCNumR\ractiveEtcNums\"[\n" +
+	"!db_ListVehicleIdentityGapsRequest\x126\n" +
+	"\amissing\x18\x01 \x03(\x0e2\x1c.db_service.db_VehicleSystemR\amissing\"\xb7\x01\n" +
+	"\"db_ListVehicleIdentityGapsResponse\x12#\n" +
+	"\rvehicle_count\x18\x01 \x01(\x05R\fvehicleCount\x126\n" +
+	"\asystems\x18\x02 \x03(\x0e2\x1c.db_service.db_VehicleSystemR\asystems\x124\n" +
+	"\x05items\x18\x03 \x03(\v2\x1e.db_service.db_VehicleIdentityR\x05items\"\xdd\x01\n" +
 	"\vdb_TimeCard\x12\x1a\n" +
 	"\bdatetime\x18\x01 \x01(\tR\bdatetime\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\x05R\x02id\x12\x1d\n" +
@@ -9723,18 +18263,28 @@ const file_db_service_proto_rawDesc = "" +
 	"\r_state_detail\"C\n" +
 	"\x15db_GetTimeCardRequest\x12\x1a\n" +
 	"\bdatetime\x18\x01 \x01(\tR\bdatetime\x12\x0e\n" +
-	"\x02id\x18\x02 \x01(\x05R\x02id\"s\n" +
+	"\x02id\x18\x02 \x01(\x05R\x02id\"\x89\x01\n" +
+	"\x13db_TimeCardSortSpec\x126\n" +
+	"\x05field\x18\x01 \x01(\x0e2 .db_service.db_TimeCardSortFieldR\x05field\x12:\n" +
+	"\tdirection\x18\x02 \x01(\x0e2\x1c.db_service.db_SortDirectionR\tdirection\"\x8b\x02\n" +
 	"\x16db_ListTimeCardRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x1e\n" +
-	"\border_by\x18\x03 \x01(\tH\x00R\aorderBy\x88\x01\x01B\v\n" +
-	"\t_order_by\"K\n" +
+	"\border_by\x18\x03 \x01(\tH\x00R\aorderBy\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tH\x01R\tpageToken\x88\x01\x01\x12.\n" +
+	"\x13include_total_count\x18\x05 \x01(\bR\x11includeTotalCount\x123\n" +
+	"\x04sort\x18\x06 \x03(\v2\x1f.db_service.db_TimeCardSortSpecR\x04sortB\v\n" +
+	"\t_order_byB\r\n" +
+	"\v_page_token\"K\n" +
 	"\x13db_TimeCardResponse\x124\n" +
-	"\ttime_card\x18\x01 \x01(\v2\x17.db_service.db_TimeCardR\btimeCard\"i\n" +
+	"\ttime_card\x18\x01 \x01(\v2\x17.db_service.db_TimeCardR\btimeCard\"\xa6\x01\n" +
 	"\x17db_ListTimeCardResponse\x12-\n" +
-	"\x05items\x18\x01 \x03(\v2\x17.db_service.db_TimeCardR\x05items\x12\x1f\n" +
-	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"P\n" +
+	"\x05items\x18\x01 \x03(\v2\x17.db_service.db_TimeCardR\x05items\x12$\n" +
+	"\vtotal_count\x18\x02 \x01(\x05H\x00R\n" +
+	"totalCount\x88\x01\x01\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageTokenB\x0e\n" +
+	"\f_total_count\"P\n" +
 	"\x18db_CreateTimeCardRequest\x124\n" +
 	"\ttime_card\x18\x01 \x01(\v2\x17.db_service.db_TimeCardR\btimeCard\"P\n" +
 	"\x18db_UpdateTimeCardRequest\x124\n" +
@@ -9762,111 +18312,430 @@ const file_db_service_proto_rawDesc = "" +
 	"\x03log\x18\x01 \x01(\v2\x1a.db_service.db_TimeCardLogR\x03log\"I\n" +
 	"\x1bdb_DeleteTimeCardLogRequest\x12\x1a\n" +
 	"\bdatetime\x18\x01 \x01(\tR\bdatetime\x12\x0e\n" +
-	"\x02id\x18\x02 \x01(\x05R\x02id\"v\n" +
+	"\x02id\x18\x02 \x01(\x05R\x02id\"\x8f\x01\n" +
+	"\x16db_TimeCardLogSortSpec\x129\n" +
+	"\x05field\x18\x01 \x01(\x0e2#.db_service.db_TimeCardLogSortFieldR\x05field\x12:\n" +
+	"\tdirection\x18\x02 \x01(\x0e2\x1c.db_service.db_SortDirectionR\tdirection\"\x91\x02\n" +
 	"\x19db_ListTimeCardLogRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x1e\n" +
-	"\border_by\x18\x03 \x01(\tH\x00R\aorderBy\x88\x01\x01B\v\n" +
-	"\t_order_by\"^\n" +
+	"\border_by\x18\x03 \x01(\tH\x00R\aorderBy\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tH\x01R\tpageToken\x88\x01\x01\x12.\n" +
+	"\x13include_total_count\x18\x05 \x01(\bR\x11includeTotalCount\x126\n" +
+	"\x04sort\x18\x06 \x03(\v2\".db_service.db_TimeCardLogSortSpecR\x04sortB\v\n" +
+	"\t_order_byB\r\n" +
+	"\v_page_token\"^\n" +
 	"\x15db_GetByCardIDRequest\x12\x17\n" +
 	"\acard_id\x18\x01 \x01(\tR\x06cardId\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x03 \x01(\x05R\x06offset\"F\n" +
 	"\x16db_TimeCardLogResponse\x12,\n" +
-	"\x03log\x18\x01 \x01(\v2\x1a.db_service.db_TimeCardLogR\x03log\"o\n" +
+	"\x03log\x18\x01 \x01(\v2\x1a.db_service.db_TimeCardLogR\x03log\"\xac\x01\n" +
 	"\x1adb_ListTimeCardLogResponse\x120\n" +
-	"\x05items\x18\x01 \x03(\v2\x1a.db_service.db_TimeCardLogR\x05items\x12\x1f\n" +
-	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"\n" +
-	"\n" +
-	"\bdb_Empty2\xf2\x03\n" +
-	"\x1adb_DTakoUriageKeihiService\x12a\n" +
-	"\x06Create\x12,.db_service.db_CreateDTakoUriageKeihiRequest\x1a'.db_service.db_DTakoUriageKeihiResponse\"\x00\x12[\n" +
-	"\x03Get\x12).db_service.db_GetDTakoUriageKeihiRequest\x1a'.db_service.db_DTakoUriageKeihiResponse\"\x00\x12a\n" +
-	"\x06Update\x12,.db_service.db_UpdateDTakoUriageKeihiRequest\x1a'.db_service.db_DTakoUriageKeihiResponse\"\x00\x12N\n" +
-	"\x06Delete\x12,.db_service.db_DeleteDTakoUriageKeihiRequest\x1a\x14.db_service.db_Empty\"\x00\x12a\n" +
-	"\x04List\x12*.db_service.db_ListDTakoUriageKeihiRequest\x1a+.db_service.db_ListDTakoUriageKeihiResponse\"\x002\xac\x03\n" +
-	"\x13db_ETCMeisaiService\x12S\n" +
-	"\x06Create\x12%.db_service.db_CreateETCMeisaiRequest\x1a .db_service.db_ETCMeisaiResponse\"\x00\x12M\n" +
-	"\x03Get\x12\".db_service.db_GetETCMeisaiRequest\x1a .db_service.db_ETCMeisaiResponse\"\x00\x12S\n" +
-	"\x06Update\x12%.db_service.db_UpdateETCMeisaiRequest\x1a .db_service.db_ETCMeisaiResponse\"\x00\x12G\n" +
-	"\x06Delete\x12%.db_service.db_DeleteETCMeisaiRequest\x1a\x14.db_service.db_Empty\"\x00\x12S\n" +
-	"\x04List\x12#.db_service.db_ListETCMeisaiRequest\x1a$.db_service.db_ListETCMeisaiResponse\"\x002\xde\x03\n" +
-	"\x18db_DTakoFerryRowsService\x12]\n" +
-	"\x06Create\x12*.db_service.db_CreateDTakoFerryRowsRequest\x1a%.db_service.db_DTakoFerryRowsResponse\"\x00\x12W\n" +
-	"\x03Get\x12'.db_service.db_GetDTakoFerryRowsRequest\x1a%.db_service.db_DTakoFerryRowsResponse\"\x00\x12]\n" +
-	"\x06Update\x12*.db_service.db_UpdateDTakoFerryRowsRequest\x1a%.db_service.db_DTakoFerryRowsResponse\"\x00\x12L\n" +
-	"\x06Delete\x12*.db_service.db_DeleteDTakoFerryRowsRequest\x1a\x14.db_service.db_Empty\"\x00\x12]\n" +
-	"\x04List\x12(.db_service.db_ListDTakoFerryRowsRequest\x1a).db_service.db_ListDTakoFerryRowsResponse\"\x002\xe2\x04\n" +
-	"\x1adb_ETCMeisaiMappingService\x12a\n" +
-	"\x06Create\x12,.db_service.db_CreateETCMeisaiMappingRequest\x1a'.db_service.db_ETCMeisaiMappingResponse\"\x00\x12[\n" +
-	"\x03Get\x12).db_service.db_GetETCMeisaiMappingRequest\x1a'.db_service.db_ETCMeisaiMappingResponse\"\x00\x12a\n" +
-	"\x06Update\x12,.db_service.db_UpdateETCMeisaiMappingRequest\x1a'.db_service.db_ETCMeisaiMappingResponse\"\x00\x12N\n" +
-	"\x06Delete\x12,.db_service.db_DeleteETCMeisaiMappingRequest\x1a\x14.db_service.db_Empty\"\x00\x12a\n" +
-	"\x04List\x12*.db_service.db_ListETCMeisaiMappingRequest\x1a+.db_service.db_ListETCMeisaiMappingResponse\"\x00\x12n\n" +
-	"\x13GetDTakoRowIDByHash\x12).db_service.db_GetDTakoRowIDByHashRequest\x1a*.db_service.db_GetDTakoRowIDByHashResponse\"\x002\x9a\x02\n" +
-	"\x13db_DTakoCarsService\x12M\n" +
-	"\x03Get\x12\".db_service.db_GetDTakoCarsRequest\x1a .db_service.db_DTakoCarsResponse\"\x00\x12S\n" +
-	"\x04List\x12#.db_service.db_ListDTakoCarsRequest\x1a$.db_service.db_ListDTakoCarsResponse\"\x00\x12_\n" +
-	"\fGetByCarCode\x12+.db_service.db_GetDTakoCarsByCarCodeRequest\x1a .db_service.db_DTakoCarsResponse\"\x002\xb4\x02\n" +
-	"\x15db_DTakoEventsService\x12Q\n" +
-	"\x03Get\x12$.db_service.db_GetDTakoEventsRequest\x1a\".db_service.db_DTakoEventsResponse\"\x00\x12W\n" +
-	"\x04List\x12%.db_service.db_ListDTakoEventsRequest\x1a&.db_service.db_ListDTakoEventsResponse\"\x00\x12o\n" +
-	"\x10GetByOperationNo\x121.db_service.db_GetDTakoEventsByOperationNoRequest\x1a&.db_service.db_ListDTakoEventsResponse\"\x002\xa6\x02\n" +
-	"\x13db_DTakoRowsService\x12M\n" +
-	"\x03Get\x12\".db_service.db_GetDTakoRowsRequest\x1a .db_service.db_DTakoRowsResponse\"\x00\x12S\n" +
-	"\x04List\x12#.db_service.db_ListDTakoRowsRequest\x1a$.db_service.db_ListDTakoRowsResponse\"\x00\x12k\n" +
-	"\x10GetByOperationNo\x12/.db_service.db_GetDTakoRowsByOperationNoRequest\x1a$.db_service.db_ListDTakoRowsResponse\"\x002\xa1\x02\n" +
-	"\x10db_ETCNumService\x12M\n" +
-	"\x04List\x12 .db_service.db_ListETCNumRequest\x1a!.db_service.db_ListETCNumResponse\"\x00\x12c\n" +
-	"\x0fGetByETCCardNum\x12+.db_service.db_GetETCNumByETCCardNumRequest\x1a!.db_service.db_ListETCNumResponse\"\x00\x12Y\n" +
-	"\n" +
-	"GetByCarID\x12&.db_service.db_GetETCNumByCarIDRequest\x1a!.db_service.db_ListETCNumResponse\"\x002\xdb\x02\n" +
-	"\x1cdb_DTakoFerryRowsProdService\x12_\n" +
-	"\x03Get\x12+.db_service.db_GetDTakoFerryRowsProdRequest\x1a).db_service.db_DTakoFerryRowsProdResponse\"\x00\x12e\n" +
-	"\x04List\x12,.db_service.db_ListDTakoFerryRowsProdRequest\x1a-.db_service.db_ListDTakoFerryRowsProdResponse\"\x00\x12s\n" +
-	"\vGetByUnkoNo\x123.db_service.db_GetDTakoFerryRowsProdByUnkoNoRequest\x1a-.db_service.db_ListDTakoFerryRowsProdResponse\"\x002\x83\x02\n" +
-	"\x0edb_CarsService\x12C\n" +
-	"\x03Get\x12\x1d.db_service.db_GetCarsRequest\x1a\x1b.db_service.db_CarsResponse\"\x00\x12I\n" +
-	"\x04List\x12\x1e.db_service.db_ListCarsRequest\x1a\x1f.db_service.db_ListCarsResponse\"\x00\x12a\n" +
-	"\x10GetByBumonCodeID\x12*.db_service.db_GetCarsByBumonCodeIDRequest\x1a\x1f.db_service.db_ListCarsResponse\"\x002\x8c\x02\n" +
-	"\x11db_DriversService\x12I\n" +
-	"\x03Get\x12 .db_service.db_GetDriversRequest\x1a\x1e.db_service.db_DriversResponse\"\x00\x12O\n" +
-	"\x04List\x12!.db_service.db_ListDriversRequest\x1a\".db_service.db_ListDriversResponse\"\x00\x12[\n" +
-	"\n" +
-	"GetByBumon\x12'.db_service.db_GetDriversByBumonRequest\x1a\".db_service.db_ListDriversResponse\"\x002\xc6\x03\n" +
-	"\x1adb_UntenNippoMeisaiService\x12[\n" +
-	"\x03Get\x12).db_service.db_GetUntenNippoMeisaiRequest\x1a'.db_service.db_UntenNippoMeisaiResponse\"\x00\x12a\n" +
-	"\x04List\x12*.db_service.db_ListUntenNippoMeisaiRequest\x1a+.db_service.db_ListUntenNippoMeisaiResponse\"\x00\x12q\n" +
-	"\fGetBySharyoC\x122.db_service.db_GetUntenNippoMeisaiBySharyoCRequest\x1a+.db_service.db_ListUntenNippoMeisaiResponse\"\x00\x12u\n" +
-	"\x0eGetByDateRange\x124.db_service.db_GetUntenNippoMeisaiByDateRangeRequest\x1a+.db_service.db_ListUntenNippoMeisaiResponse\"\x002\xaa\x02\n" +
-	"\x15db_ShainMasterService\x12Q\n" +
-	"\x03Get\x12$.db_service.db_GetShainMasterRequest\x1a\".db_service.db_ShainMasterResponse\"\x00\x12W\n" +
-	"\x04List\x12%.db_service.db_ListShainMasterRequest\x1a&.db_service.db_ListShainMasterResponse\"\x00\x12e\n" +
-	"\vGetByBumonC\x12,.db_service.db_GetShainMasterByBumonCRequest\x1a&.db_service.db_ListShainMasterResponse\"\x002\xc8\x01\n" +
-	"\x16db_ChiikiMasterService\x12S\n" +
-	"\x03Get\x12%.db_service.db_GetChiikiMasterRequest\x1a#.db_service.db_ChiikiMasterResponse\"\x00\x12Y\n" +
-	"\x04List\x12&.db_service.db_ListChiikiMasterRequest\x1a'.db_service.db_ListChiikiMasterResponse\"\x002\xac\x02\n" +
-	"\x15db_ChikuMasterService\x12Q\n" +
-	"\x03Get\x12$.db_service.db_GetChikuMasterRequest\x1a\".db_service.db_ChikuMasterResponse\"\x00\x12W\n" +
-	"\x04List\x12%.db_service.db_ListChikuMasterRequest\x1a&.db_service.db_ListChikuMasterResponse\"\x00\x12g\n" +
-	"\fGetByChiikiC\x12-.db_service.db_GetChikuMasterByChiikiCRequest\x1a&.db_service.db_ListChikuMasterResponse\"\x002\xb4\x01\n" +
-	"\x12db_TimeCardService\x12K\n" +
-	"\x03Get\x12!.db_service.db_GetTimeCardRequest\x1a\x1f.db_service.db_TimeCardResponse\"\x00\x12Q\n" +
-	"\x04List\x12\".db_service.db_ListTimeCardRequest\x1a#.db_service.db_ListTimeCardResponse\"\x002\xa5\x03\n" +
-	"\x15db_TimeCardDevService\x12Q\n" +
-	"\x06Create\x12$.db_service.db_CreateTimeCardRequest\x1a\x1f.db_service.db_TimeCardResponse\"\x00\x12K\n" +
-	"\x03Get\x12!.db_service.db_GetTimeCardRequest\x1a\x1f.db_service.db_TimeCardResponse\"\x00\x12Q\n" +
-	"\x06Update\x12$.db_service.db_UpdateTimeCardRequest\x1a\x1f.db_service.db_TimeCardResponse\"\x00\x12F\n" +
-	"\x06Delete\x12$.db_service.db_DeleteTimeCardRequest\x1a\x14.db_service.db_Empty\"\x00\x12Q\n" +
-	"\x04List\x12\".db_service.db_ListTimeCardRequest\x1a#.db_service.db_ListTimeCardResponse\"\x002\x9c\x04\n" +
-	"\x15db_TimeCardLogService\x12W\n" +
-	"\x06Create\x12'.db_service.db_CreateTimeCardLogRequest\x1a\".db_service.db_TimeCardLogResponse\"\x00\x12Q\n" +
-	"\x03Get\x12$.db_service.db_GetTimeCardLogRequest\x1a\".db_service.db_TimeCardLogResponse\"\x00\x12W\n" +
-	"\x06Update\x12'.db_service.db_UpdateTimeCardLogRequest\x1a\".db_service.db_TimeCardLogResponse\"\x00\x12I\n" +
-	"\x06Delete\x12'.db_service.db_DeleteTimeCardLogRequest\x1a\x14.db_service.db_Empty\"\x00\x12W\n" +
-	"\x04List\x12%.db_service.db_ListTimeCardLogRequest\x1a&.db_service.db_ListTimeCardLogResponse\"\x00\x12Z\n" +
-	"\vGetByCardID\x12!.db_service.db_GetByCardIDRequest\x1a&.db_service.db_ListTimeCardLogResponse\"\x00B\x93\x01\n" +
+	"\x05items\x18\x01 \x03(\v2\x1a.db_service.db_TimeCardLogR\x05items\x12$\n" +
+	"\vtotal_count\x18\x02 \x01(\x05H\x00R\n" +
+	"totalCount\x88\x01\x01\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageTokenB\x0e\n" +
+	"\f_total_count\"\x83\x03\n" +
+	"\x13db_EmployeeIdentity\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\tdriver_id\x18\x03 \x01(\x05H\x00R\bdriverId\x88\x01\x01\x12\x1c\n" +
+	"\ashain_c\x18\x04 \x01(\tH\x01R\x06shainC\x88\x01\x01\x12/\n" +
+	"\x11dtako_driver_code\x18\x05 \x01(\x05H\x02R\x0fdtakoDriverCode\x88\x01\x01\x12.\n" +
+	"\x11time_card_user_id\x18\x06 \x01(\x05H\x03R\x0etimeCardUserId\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAt\x12\x19\n" +
+	"\x05notes\x18\t \x01(\tH\x04R\x05notes\x88\x01\x01B\f\n" +
+	"\n" +
+	"_driver_idB\n" +
+	"\n" +
+	"\b_shain_cB\x14\n" +
+	"\x12_dtako_driver_codeB\x14\n" +
+	"\x12_time_card_user_idB\b\n" +
+	"\x06_notes\"\x89\x01\n" +
+	"\x0fdb_EmployeeCard\x12\x17\n" +
+	"\acard_id\x18\x01 \x01(\tR\x06cardId\x12\x1f\n" +
+	"\vemployee_id\x18\x02 \x01(\x03R\n" +
+	"employeeId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x04 \x01(\tR\tcreatedBy\"_\n" +
+	" db_CreateEmployeeIdentityRequest\x12;\n" +
+	"\bemployee\x18\x01 \x01(\v2\x1f.db_service.db_EmployeeIdentityR\bemployee\"/\n" +
+	"\x1ddb_GetEmployeeIdentityRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"_\n" +
+	" db_UpdateEmployeeIdentityRequest\x12;\n" +
+	"\bemployee\x18\x01 \x01(\v2\x1f.db_service.db_EmployeeIdentityR\bemployee\"2\n" +
+	" db_DeleteEmployeeIdentityRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xb1\x01\n" +
+	"\x1edb_ListEmployeeIdentityRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\"\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tH\x00R\tpageToken\x88\x01\x01\x12.\n" +
+	"\x13include_total_count\x18\x04 \x01(\bR\x11includeTotalCountB\r\n" +
+	"\v_page_token\"\x8d\x01\n" +
+	"\x1bdb_EmployeeIdentityResponse\x12;\n" +
+	"\bemployee\x18\x01 \x01(\v2\x1f.db_service.db_EmployeeIdentityR\bemployee\x121\n" +
+	"\x05cards\x18\x02 \x03(\v2\x1b.db_service.db_EmployeeCardR\x05cards\"\xb6\x01\n" +
+	"\x1fdb_ListEmployeeIdentityResponse\x125\n" +
+	"\x05items\x18\x01 \x03(\v2\x1f.db_service.db_EmployeeIdentityR\x05items\x12$\n" +
+	"\vtotal_count\x18\x02 \x01(\x05H\x00R\n" +
+	"totalCount\x88\x01\x01\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageTokenB\x0e\n" +
+	"\f_total_count\"\xfb\x01\n" +
+	"!db_ResolveEmployeeIdentityRequest\x12\x1d\n" +
+	"\tdriver_id\x18\x01 \x01(\x05H\x00R\bdriverId\x12\x19\n" +
+	"\ashain_c\x18\x02 \x01(\tH\x00R\x06shainC\x12,\n" +
+	"\x11dtako_driver_code\x18\x03 \x01(\x05H\x00R\x0fdtakoDriverCode\x12\x1f\n" +
+	"\n" +
+	"untenshu_c\x18\x04 \x01(\tH\x00R\tuntenshuC\x12+\n" +
+	"\x11time_card_user_id\x18\x05 \x01(\x05H\x00R\x0etimeCardUserId\x12\x19\n" +
+	"\acard_id\x18\x06 \x01(\tH\x00R\x06cardIdB\x05\n" +
+	"\x03key\"\x95\x02\n" +
+	"\"db_ResolveEmployeeIdentityResponse\x12;\n" +
+	"\bemployee\x18\x01 \x01(\v2\x1f.db_service.db_EmployeeIdentityR\bemployee\x121\n" +
+	"\x05cards\x18\x02 \x03(\v2\x1b.db_service.db_EmployeeCardR\x05cards\x123\n" +
+	"\x06driver\x18\x03 \x01(\v2\x16.db_service.db_DriversH\x00R\x06driver\x88\x01\x01\x125\n" +
+	"\x05shain\x18\x04 \x01(\v2\x1a.db_service.db_ShainMasterH\x01R\x05shain\x88\x01\x01B\t\n" +
+	"\a_driverB\b\n" +
+	"\x06_shain\"y\n" +
+	"\x1edb_RegisterEmployeeCardRequest\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\x03R\n" +
+	"employeeId\x12\x17\n" +
+	"\acard_id\x18\x02 \x01(\tR\x06cardId\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x03 \x01(\tR\tcreatedBy\"d\n" +
+	"\x17db_EmployeeCardResponse\x12/\n" +
+	"\x04card\x18\x01 \x01(\v2\x1b.db_service.db_EmployeeCardR\x04card\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\bR\acreated\";\n" +
+	" db_UnregisterEmployeeCardRequest\x12\x17\n" +
+	"\acard_id\x18\x01 \x01(\tR\x06cardId\"\x8a\x01\n" +
+	"\x17db_UnlinkedEmployeeCode\x129\n" +
+	"\x06source\x18\x01 \x01(\x0e2!.db_service.db_EmployeeCodeSourceR\x06source\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01B\a\n" +
+	"\x05_name\"b\n" +
+	"#db_ListUnlinkedEmployeeCodesRequest\x12;\n" +
+	"\asources\x18\x01 \x03(\x0e2!.db_service.db_EmployeeCodeSourceR\asources\"q\n" +
+	"\x1cdb_SkippedEmployeeCodeSource\x129\n" +
+	"\x06source\x18\x01 \x01(\x0e2!.db_service.db_EmployeeCodeSourceR\x06source\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\xf1\x01\n" +
+	"$db_ListUnlinkedEmployeeCodesResponse\x12;\n" +
+	"\asources\x18\x01 \x03(\x0e2!.db_service.db_EmployeeCodeSourceR\asources\x129\n" +
+	"\x05items\x18\x02 \x03(\v2#.db_service.db_UnlinkedEmployeeCodeR\x05items\x12Q\n" +
+	"\x0fskipped_sources\x18\x03 \x03(\v2(.db_service.db_SkippedEmployeeCodeSourceR\x0eskippedSources\"r\n" +
+	"\x10db_BackendStatus\x12\x18\n" +
+	"\abackend\x18\x01 \x01(\tR\abackend\x12\x1c\n" +
+	"\tavailable\x18\x02 \x01(\bR\tavailable\x12\x1b\n" +
+	"\x06reason\x18\x03 \x01(\tH\x00R\x06reason\x88\x01\x01B\t\n" +
+	"\a_reason\"\x1b\n" +
+	"\x19db_GetAvailabilityRequest\"V\n" +
+	"\x1adb_GetAvailabilityResponse\x128\n" +
+	"\bbackends\x18\x01 \x03(\v2\x1c.db_service.db_BackendStatusR\bbackends\"\n" +
+	"\n" +
+	"\bdb_Empty*\x97\x01\n" +
+	"\x12db_BatchItemStatus\x12!\n" +
+	"\x1dBATCH_ITEM_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aBATCH_ITEM_STATUS_INSERTED\x10\x01\x12\x1f\n" +
+	"\x1bBATCH_ITEM_STATUS_DUPLICATE\x10\x02\x12\x1d\n" +
+	"\x19BATCH_ITEM_STATUS_INVALID\x10\x03*\xd0\x01\n" +
+	"\x13db_MappingIssueKind\x12\"\n" +
+	"\x1eMAPPING_ISSUE_KIND_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eMAPPING_ISSUE_KIND_ORPHAN_HASH\x10\x01\x12'\n" +
+	"#MAPPING_ISSUE_KIND_ORPHAN_DTAKO_ROW\x10\x02\x12%\n" +
+	"!MAPPING_ISSUE_KIND_DUPLICATE_HASH\x10\x03\x12!\n" +
+	"\x1dMAPPING_ISSUE_KIND_STALE_HASH\x10\x04*\xbe\x01\n" +
+	"\x12db_AutoMatchStatus\x12!\n" +
+	"\x1dAUTO_MATCH_STATUS_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19AUTO_MATCH_STATUS_MATCHED\x10\x01\x12\x1f\n" +
+	"\x1bAUTO_MATCH_STATUS_AMBIGUOUS\x10\x02\x12\x1f\n" +
+	"\x1bAUTO_MATCH_STATUS_UNMATCHED\x10\x03\x12$\n" +
+	" AUTO_MATCH_STATUS_ALREADY_MAPPED\x10\x04*\xb1\x03\n" +
+	"\x17db_DTakoEventsSortField\x12'\n" +
+	"#DTAKO_EVENTS_SORT_FIELD_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aDTAKO_EVENTS_SORT_FIELD_ID\x10\x01\x12(\n" +
+	"$DTAKO_EVENTS_SORT_FIELD_OPERATION_NO\x10\x02\x12%\n" +
+	"!DTAKO_EVENTS_SORT_FIELD_READ_DATE\x10\x03\x12$\n" +
+	" DTAKO_EVENTS_SORT_FIELD_CAR_CODE\x10\x04\x12(\n" +
+	"$DTAKO_EVENTS_SORT_FIELD_DRIVER_CODE1\x10\x05\x12.\n" +
+	"*DTAKO_EVENTS_SORT_FIELD_TARGET_DRIVER_CODE\x10\x06\x12*\n" +
+	"&DTAKO_EVENTS_SORT_FIELD_START_DATETIME\x10\a\x12(\n" +
+	"$DTAKO_EVENTS_SORT_FIELD_END_DATETIME\x10\b\x12&\n" +
+	"\"DTAKO_EVENTS_SORT_FIELD_EVENT_CODE\x10\t*\xac\x04\n" +
+	"\x15db_DTakoRowsSortField\x12%\n" +
+	"!DTAKO_ROWS_SORT_FIELD_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18DTAKO_ROWS_SORT_FIELD_ID\x10\x01\x12&\n" +
+	"\"DTAKO_ROWS_SORT_FIELD_OPERATION_NO\x10\x02\x12#\n" +
+	"\x1fDTAKO_ROWS_SORT_FIELD_READ_DATE\x10\x03\x12(\n" +
+	"$DTAKO_ROWS_SORT_FIELD_OPERATION_DATE\x10\x04\x12\"\n" +
+	"\x1eDTAKO_ROWS_SORT_FIELD_CAR_CODE\x10\x05\x12&\n" +
+	"\"DTAKO_ROWS_SORT_FIELD_DRIVER_CODE1\x10\x06\x12,\n" +
+	"(DTAKO_ROWS_SORT_FIELD_TARGET_DRIVER_CODE\x10\a\x12-\n" +
+	")DTAKO_ROWS_SORT_FIELD_START_WORK_DATETIME\x10\b\x12+\n" +
+	"'DTAKO_ROWS_SORT_FIELD_END_WORK_DATETIME\x10\t\x12,\n" +
+	"(DTAKO_ROWS_SORT_FIELD_DEPARTURE_DATETIME\x10\n" +
+	"\x12)\n" +
+	"%DTAKO_ROWS_SORT_FIELD_RETURN_DATETIME\x10\v\x12(\n" +
+	"$DTAKO_ROWS_SORT_FIELD_TOTAL_DISTANCE\x10\f*\xa5\x02\n" +
+	"\x10db_CarsSortField\x12\x1f\n" +
+	"\x1bCARS_SORT_FIELD_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12CARS_SORT_FIELD_ID\x10\x01\x12\x17\n" +
+	"\x13CARS_SORT_FIELD_ID4\x10\x02\x12\x18\n" +
+	"\x14CARS_SORT_FIELD_NAME\x10\x03\x12\x1c\n" +
+	"\x18CARS_SORT_FIELD_REG_DATE\x10\x04\x12%\n" +
+	"!CARS_SORT_FIELD_NEXT_INSPECT_DATE\x10\x05\x12\x1e\n" +
+	"\x1aCARS_SORT_FIELD_SCRAP_DATE\x10\x06\x12!\n" +
+	"\x1dCARS_SORT_FIELD_BUMON_CODE_ID\x10\a\x12\x1d\n" +
+	"\x19CARS_SORT_FIELD_DRIVER_ID\x10\b*\xf5\x01\n" +
+	"\x13db_DriversSortField\x12\"\n" +
+	"\x1eDRIVERS_SORT_FIELD_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15DRIVERS_SORT_FIELD_ID\x10\x01\x12\x1b\n" +
+	"\x17DRIVERS_SORT_FIELD_NAME\x10\x02\x12\x1e\n" +
+	"\x1aDRIVERS_SORT_FIELD_SHAIN_R\x10\x03\x12\x1c\n" +
+	"\x18DRIVERS_SORT_FIELD_BUMON\x10\x04\x12 \n" +
+	"\x1cDRIVERS_SORT_FIELD_JOIN_DATE\x10\x05\x12\"\n" +
+	"\x1eDRIVERS_SORT_FIELD_RETIRE_DATE\x10\x06*\xa2\x01\n" +
+	"\x1ddb_UntenNippoMeisaiBumonField\x12.\n" +
+	"*UNTEN_NIPPO_MEISAI_BUMON_FIELD_UNSPECIFIED\x10\x00\x12(\n" +
+	"$UNTEN_NIPPO_MEISAI_BUMON_FIELD_JUCHU\x10\x01\x12'\n" +
+	"#UNTEN_NIPPO_MEISAI_BUMON_FIELD_KADO\x10\x02*\xa2\x04\n" +
+	"\x1cdb_UntenNippoMeisaiSortField\x12-\n" +
+	")UNTEN_NIPPO_MEISAI_SORT_FIELD_UNSPECIFIED\x10\x00\x12)\n" +
+	"%UNTEN_NIPPO_MEISAI_SORT_FIELD_NIPPO_K\x10\x01\x12*\n" +
+	"&UNTEN_NIPPO_MEISAI_SORT_FIELD_HAISHA_K\x10\x02\x12*\n" +
+	"&UNTEN_NIPPO_MEISAI_SORT_FIELD_SHARYO_C\x10\x03\x12/\n" +
+	"+UNTEN_NIPPO_MEISAI_SORT_FIELD_UNKO_NENGAPPI\x10\x04\x12,\n" +
+	"(UNTEN_NIPPO_MEISAI_SORT_FIELD_UNTENSHU_C\x10\x05\x120\n" +
+	",UNTEN_NIPPO_MEISAI_SORT_FIELD_JUCHU_NENGAPPI\x10\x06\x120\n" +
+	",UNTEN_NIPPO_MEISAI_SORT_FIELD_KANRI_NENGAPPI\x10\a\x12-\n" +
+	")UNTEN_NIPPO_MEISAI_SORT_FIELD_TOKUISAKI_C\x10\b\x12+\n" +
+	"'UNTEN_NIPPO_MEISAI_SORT_FIELD_DENPYO_NO\x10\t\x121\n" +
+	"-UNTEN_NIPPO_MEISAI_SORT_FIELD_URIAGE_NENGAPPI\x10\n" +
+	"*\xd7\x02\n" +
+	"\x17db_ShainMasterSortField\x12'\n" +
+	"#SHAIN_MASTER_SORT_FIELD_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fSHAIN_MASTER_SORT_FIELD_SHAIN_C\x10\x01\x12#\n" +
+	"\x1fSHAIN_MASTER_SORT_FIELD_SHAIN_N\x10\x02\x12#\n" +
+	"\x1fSHAIN_MASTER_SORT_FIELD_SHAIN_F\x10\x03\x12#\n" +
+	"\x1fSHAIN_MASTER_SORT_FIELD_SHAIN_K\x10\x04\x12+\n" +
+	"'SHAIN_MASTER_SORT_FIELD_NYUSHA_NENGAPPI\x10\x05\x12-\n" +
+	")SHAIN_MASTER_SORT_FIELD_TAISHOKU_NENGAPPI\x10\x06\x12#\n" +
+	"\x1fSHAIN_MASTER_SORT_FIELD_BUMON_C\x10\a*\xb9\x01\n" +
+	"\x18db_ChiikiMasterSortField\x12(\n" +
+	"$CHIIKI_MASTER_SORT_FIELD_UNSPECIFIED\x10\x00\x12%\n" +
+	"!CHIIKI_MASTER_SORT_FIELD_CHIIKI_C\x10\x01\x12%\n" +
+	"!CHIIKI_MASTER_SORT_FIELD_CHIIKI_N\x10\x02\x12%\n" +
+	"!CHIIKI_MASTER_SORT_FIELD_CHIIKI_F\x10\x03*\xd7\x01\n" +
+	"\x17db_ChikuMasterSortField\x12'\n" +
+	"#CHIKU_MASTER_SORT_FIELD_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fCHIKU_MASTER_SORT_FIELD_CHIKU_C\x10\x01\x12#\n" +
+	"\x1fCHIKU_MASTER_SORT_FIELD_CHIKU_N\x10\x02\x12#\n" +
+	"\x1fCHIKU_MASTER_SORT_FIELD_CHIKU_F\x10\x03\x12$\n" +
+	" CHIKU_MASTER_SORT_FIELD_CHIIKI_C\x10\x04*\xfe\x01\n" +
+	"\x1bdb_TokuisakiMasterSortField\x12+\n" +
+	"'TOKUISAKI_MASTER_SORT_FIELD_UNSPECIFIED\x10\x00\x12+\n" +
+	"'TOKUISAKI_MASTER_SORT_FIELD_TOKUISAKI_C\x10\x01\x12+\n" +
+	"'TOKUISAKI_MASTER_SORT_FIELD_TOKUISAKI_H\x10\x02\x12+\n" +
+	"'TOKUISAKI_MASTER_SORT_FIELD_TOKUISAKI_N\x10\x03\x12+\n" +
+	"'TOKUISAKI_MASTER_SORT_FIELD_TOKUISAKI_F\x10\x04*\xe0\x01\n" +
+	"\x18db_HinmeiMasterSortField\x12(\n" +
+	"$HINMEI_MASTER_SORT_FIELD_UNSPECIFIED\x10\x00\x12%\n" +
+	"!HINMEI_MASTER_SORT_FIELD_HINMEI_C\x10\x01\x12%\n" +
+	"!HINMEI_MASTER_SORT_FIELD_HINMEI_H\x10\x02\x12%\n" +
+	"!HINMEI_MASTER_SORT_FIELD_HINMEI_N\x10\x03\x12%\n" +
+	"!HINMEI_MASTER_SORT_FIELD_HINMEI_F\x10\x04*\xb1\x01\n" +
+	"\x17db_BumonMasterSortField\x12'\n" +
+	"#BUMON_MASTER_SORT_FIELD_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fBUMON_MASTER_SORT_FIELD_BUMON_C\x10\x01\x12#\n" +
+	"\x1fBUMON_MASTER_SORT_FIELD_BUMON_N\x10\x02\x12#\n" +
+	"\x1fBUMON_MASTER_SORT_FIELD_BUMON_F\x10\x03*\xdf\x01\n" +
+	"\x18db_SharyoMasterSortField\x12(\n" +
+	"$SHARYO_MASTER_SORT_FIELD_UNSPECIFIED\x10\x00\x12%\n" +
+	"!SHARYO_MASTER_SORT_FIELD_SHARYO_C\x10\x01\x12%\n" +
+	"!SHARYO_MASTER_SORT_FIELD_SHARYO_H\x10\x02\x12%\n" +
+	"!SHARYO_MASTER_SORT_FIELD_SHARYO_N\x10\x03\x12$\n" +
+	" SHARYO_MASTER_SORT_FIELD_BUMON_C\x10\x04*\xa8\x01\n" +
+	"\x10db_VehicleSystem\x12\x1e\n" +
+	"\x1aVEHICLE_SYSTEM_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13VEHICLE_SYSTEM_CARS\x10\x01\x12\x1d\n" +
+	"\x19VEHICLE_SYSTEM_DTAKO_CARS\x10\x02\x12\x1a\n" +
+	"\x16VEHICLE_SYSTEM_ETC_NUM\x10\x03\x12 \n" +
+	"\x1cVEHICLE_SYSTEM_SHARYO_MASTER\x10\x04*\xe1\x01\n" +
+	"\x14db_TimeCardSortField\x12$\n" +
+	" TIME_CARD_SORT_FIELD_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dTIME_CARD_SORT_FIELD_DATETIME\x10\x01\x12\x1b\n" +
+	"\x17TIME_CARD_SORT_FIELD_ID\x10\x02\x12\x1e\n" +
+	"\x1aTIME_CARD_SORT_FIELD_STATE\x10\x03\x12 \n" +
+	"\x1cTIME_CARD_SORT_FIELD_CREATED\x10\x04\x12!\n" +
+	"\x1dTIME_CARD_SORT_FIELD_MODIFIED\x10\x05*\xa2\x02\n" +
+	"\x17db_TimeCardLogSortField\x12(\n" +
+	"$TIME_CARD_LOG_SORT_FIELD_UNSPECIFIED\x10\x00\x12%\n" +
+	"!TIME_CARD_LOG_SORT_FIELD_DATETIME\x10\x01\x12\x1f\n" +
+	"\x1bTIME_CARD_LOG_SORT_FIELD_ID\x10\x02\x12$\n" +
+	" TIME_CARD_LOG_SORT_FIELD_CARD_ID\x10\x03\x12\"\n" +
+	"\x1eTIME_CARD_LOG_SORT_FIELD_STATE\x10\x04\x12$\n" +
+	" TIME_CARD_LOG_SORT_FIELD_CREATED\x10\x05\x12%\n" +
+	"!TIME_CARD_LOG_SORT_FIELD_MODIFIED\x10\x06*\xaa\x02\n" +
+	"\x15db_EmployeeCodeSource\x12$\n" +
+	" EMPLOYEE_CODE_SOURCE_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cEMPLOYEE_CODE_SOURCE_DRIVERS\x10\x01\x12%\n" +
+	"!EMPLOYEE_CODE_SOURCE_SHAIN_MASTER\x10\x02\x12#\n" +
+	"\x1fEMPLOYEE_CODE_SOURCE_DTAKO_ROWS\x10\x03\x12+\n" +
+	"'EMPLOYEE_CODE_SOURCE_UNTEN_NIPPO_MEISAI\x10\x04\x12'\n" +
+	"#EMPLOYEE_CODE_SOURCE_TIME_CARD_USER\x10\x05\x12'\n" +
+	"#EMPLOYEE_CODE_SOURCE_TIME_CARD_CARD\x10\x06*c\n" +
+	"\x10db_SortDirection\x12\x1e\n" +
+	"\x1aSORT_DIRECTION_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12SORT_DIRECTION_ASC\x10\x01\x12\x17\n" +
+	"\x13SORT_DIRECTION_DESC\x10\x022\x88\x06\n" +
+	"\x1adb_DTakoUriageKeihiService\x12\x9a\x01\n" +
+	"\x06Create\x12,.db_service.db_CreateDTakoUriageKeihiRequest\x1a'.db_service.db_DTakoUriageKeihiResponse\"9\x82\xd3\xe4\x93\x023:\x12dtako_uriage_keihi\"\x1d/api/v1/db/dtako-uriage-keihi\x12\x8a\x01\n" +
+	"\x03Get\x12).db_service.db_GetDTakoUriageKeihiRequest\x1a'.db_service.db_DTakoUriageKeihiResponse\"/\x82\xd3\xe4\x93\x02)\x12'/api/v1/db/dtako-uriage-keihi/{srch_id}\x12\xb7\x01\n" +
+	"\x06Update\x12,.db_service.db_UpdateDTakoUriageKeihiRequest\x1a'.db_service.db_DTakoUriageKeihiResponse\"V\x82\xd3\xe4\x93\x02P:\x12dtako_uriage_keihi\x1a:/api/v1/db/dtako-uriage-keihi/{dtako_uriage_keihi.srch_id}\x12}\n" +
+	"\x06Delete\x12,.db_service.db_DeleteDTakoUriageKeihiRequest\x1a\x14.db_service.db_Empty\"/\x82\xd3\xe4\x93\x02)*'/api/v1/db/dtako-uriage-keihi/{srch_id}\x12\x86\x01\n" +
+	"\x04List\x12*.db_service.db_ListDTakoUriageKeihiRequest\x1a+.db_service.db_ListDTakoUriageKeihiResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/db/dtako-uriage-keihi2\xe7\b\n" +
+	"\x13db_ETCMeisaiService\x12|\n" +
+	"\x06Create\x12%.db_service.db_CreateETCMeisaiRequest\x1a .db_service.db_ETCMeisaiResponse\")\x82\xd3\xe4\x93\x02#:\n" +
+	"etc_meisai\"\x15/api/v1/db/etc-meisai\x12o\n" +
+	"\x03Get\x12\".db_service.db_GetETCMeisaiRequest\x1a .db_service.db_ETCMeisaiResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/db/etc-meisai/{id}\x12\x8c\x01\n" +
+	"\x06Update\x12%.db_service.db_UpdateETCMeisaiRequest\x1a .db_service.db_ETCMeisaiResponse\"9\x82\xd3\xe4\x93\x023:\n" +
+	"etc_meisai\x1a%/api/v1/db/etc-meisai/{etc_meisai.id}\x12i\n" +
+	"\x06Delete\x12%.db_service.db_DeleteETCMeisaiRequest\x1a\x14.db_service.db_Empty\"\"\x82\xd3\xe4\x93\x02\x1c*\x1a/api/v1/db/etc-meisai/{id}\x12p\n" +
+	"\x04List\x12#.db_service.db_ListETCMeisaiRequest\x1a$.db_service.db_ListETCMeisaiResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/db/etc-meisai\x12q\n" +
+	"\x06Stream\x12%.db_service.db_StreamETCMeisaiRequest\x1a\x18.db_service.db_ETCMeisai\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/db/etc-meisai/stream0\x01\x12\x8e\x01\n" +
+	"\vBatchCreate\x12*.db_service.db_BatchCreateETCMeisaiRequest\x1a+.db_service.db_BatchCreateETCMeisaiResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/db/etc-meisai/batch\x12n\n" +
+	"\x11BatchCreateStream\x12*.db_service.db_BatchCreateETCMeisaiRequest\x1a+.db_service.db_BatchCreateETCMeisaiResponse(\x01\x12\x80\x01\n" +
+	"\x06Import\x12%.db_service.db_ImportETCMeisaiRequest\x1a&.db_service.db_ImportETCMeisaiResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/db/etc-meisai/import2\xd4\x05\n" +
+	"\x18db_DTakoFerryRowsService\x12\x92\x01\n" +
+	"\x06Create\x12*.db_service.db_CreateDTakoFerryRowsRequest\x1a%.db_service.db_DTakoFerryRowsResponse\"5\x82\xd3\xe4\x93\x02/:\x10dtako_ferry_rows\"\x1b/api/v1/db/dtako-ferry-rows\x12\x7f\n" +
+	"\x03Get\x12'.db_service.db_GetDTakoFerryRowsRequest\x1a%.db_service.db_DTakoFerryRowsResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /api/v1/db/dtako-ferry-rows/{id}\x12\xa8\x01\n" +
+	"\x06Update\x12*.db_service.db_UpdateDTakoFerryRowsRequest\x1a%.db_service.db_DTakoFerryRowsResponse\"K\x82\xd3\xe4\x93\x02E:\x10dtako_ferry_rows\x1a1/api/v1/db/dtako-ferry-rows/{dtako_ferry_rows.id}\x12t\n" +
+	"\x06Delete\x12*.db_service.db_DeleteDTakoFerryRowsRequest\x1a\x14.db_service.db_Empty\"(\x82\xd3\xe4\x93\x02\"* /api/v1/db/dtako-ferry-rows/{id}\x12\x80\x01\n" +
+	"\x04List\x12(.db_service.db_ListDTakoFerryRowsRequest\x1a).db_service.db_ListDTakoFerryRowsResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/db/dtako-ferry-rows2\xe9\b\n" +
+	"\x1adb_ETCMeisaiMappingService\x12\x9a\x01\n" +
+	"\x06Create\x12,.db_service.db_CreateETCMeisaiMappingRequest\x1a'.db_service.db_ETCMeisaiMappingResponse\"9\x82\xd3\xe4\x93\x023:\x12etc_meisai_mapping\"\x1d/api/v1/db/etc-meisai-mapping\x12\x85\x01\n" +
+	"\x03Get\x12).db_service.db_GetETCMeisaiMappingRequest\x1a'.db_service.db_ETCMeisaiMappingResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/api/v1/db/etc-meisai-mapping/{id}\x12\xb2\x01\n" +
+	"\x06Update\x12,.db_service.db_UpdateETCMeisaiMappingRequest\x1a'.db_service.db_ETCMeisaiMappingResponse\"Q\x82\xd3\xe4\x93\x02K:\x12etc_meisai_mapping\x1a5/api/v1/db/etc-meisai-mapping/{etc_meisai_mapping.id}\x12x\n" +
+	"\x06Delete\x12,.db_service.db_DeleteETCMeisaiMappingRequest\x1a\x14.db_service.db_Empty\"*\x82\xd3\xe4\x93\x02$*\"/api/v1/db/etc-meisai-mapping/{id}\x12\x86\x01\n" +
+	"\x04List\x12*.db_service.db_ListETCMeisaiMappingRequest\x1a+.db_service.db_ListETCMeisaiMappingResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/db/etc-meisai-mapping\x12\xad\x01\n" +
+	"\x13GetDTakoRowIDByHash\x12).db_service.db_GetDTakoRowIDByHashRequest\x1a*.db_service.db_GetDTakoRowIDByHashResponse\"?\x82\xd3\xe4\x93\x029\x127/api/v1/db/etc-meisai-mapping/by-hash/{etc_meisai_hash}\x12\xbd\x01\n" +
+	"\vBulkReplace\x121.db_service.db_BulkReplaceETCMeisaiMappingRequest\x1a2.db_service.db_BulkReplaceETCMeisaiMappingResponse\"G\x82\xd3\xe4\x93\x02A:\x01*\x1a</api/v1/db/etc-meisai-mapping/by-dtako-row-id/{dtako_row_id}2\xb4\x01\n" +
+	"\x1adb_ETCMeisaiMatcherService\x12\x95\x01\n" +
+	"\tAutoMatch\x12(.db_service.db_AutoMatchETCMeisaiRequest\x1a).db_service.db_AutoMatchETCMeisaiResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/api/v1/db/etc-meisai-mapping/auto-match2\xb3\x01\n" +
+	"\x1fdb_ETCMeisaiMappingAuditService\x12\x8f\x01\n" +
+	"\x05Audit\x12+.db_service.db_AuditETCMeisaiMappingRequest\x1a,.db_service.db_AuditETCMeisaiMappingResponse\"+\x82\xd3\xe4\x93\x02%\x12#/api/v1/db/etc-meisai-mapping/audit2\x8e\x03\n" +
+	"\x13db_DTakoCarsService\x12o\n" +
+	"\x03Get\x12\".db_service.db_GetDTakoCarsRequest\x1a .db_service.db_DTakoCarsResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/db/dtako-cars/{id}\x12p\n" +
+	"\x04List\x12#.db_service.db_ListDTakoCarsRequest\x1a$.db_service.db_ListDTakoCarsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/db/dtako-cars\x12\x93\x01\n" +
+	"\fGetByCarCode\x12+.db_service.db_GetDTakoCarsByCarCodeRequest\x1a .db_service.db_DTakoCarsResponse\"4\x82\xd3\xe4\x93\x02.\x12,/api/v1/db/dtako-cars/by-car-code/{car_code}2\xaf\x04\n" +
+	"\x15db_DTakoEventsService\x12u\n" +
+	"\x03Get\x12$.db_service.db_GetDTakoEventsRequest\x1a\".db_service.db_DTakoEventsResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/db/dtako-events/{id}\x12v\n" +
+	"\x04List\x12%.db_service.db_ListDTakoEventsRequest\x1a&.db_service.db_ListDTakoEventsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/db/dtako-events\x12w\n" +
+	"\x06Stream\x12'.db_service.db_StreamDTakoEventsRequest\x1a\x1a.db_service.db_DTakoEvents\"&\x82\xd3\xe4\x93\x02 \x12\x1e/api/v1/db/dtako-events/stream0\x01\x12\xad\x01\n" +
+	"\x10GetByOperationNo\x121.db_service.db_GetDTakoEventsByOperationNoRequest\x1a&.db_service.db_ListDTakoEventsResponse\">\x82\xd3\xe4\x93\x028\x126/api/v1/db/dtako-events/by-operation-no/{operation_no}2\x95\x04\n" +
+	"\x13db_DTakoRowsService\x12o\n" +
+	"\x03Get\x12\".db_service.db_GetDTakoRowsRequest\x1a .db_service.db_DTakoRowsResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/db/dtako-rows/{id}\x12p\n" +
+	"\x04List\x12#.db_service.db_ListDTakoRowsRequest\x1a$.db_service.db_ListDTakoRowsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/db/dtako-rows\x12q\n" +
+	"\x06Stream\x12%.db_service.db_StreamDTakoRowsRequest\x1a\x18.db_service.db_DTakoRows\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/db/dtako-rows/stream0\x01\x12\xa7\x01\n" +
+	"\x10GetByOperationNo\x12/.db_service.db_GetDTakoRowsByOperationNoRequest\x1a$.db_service.db_ListDTakoRowsResponse\"<\x82\xd3\xe4\x93\x026\x124/api/v1/db/dtako-rows/by-operation-no/{operation_no}2\xea\x06\n" +
+	"\x10db_ETCNumService\x12g\n" +
+	"\x04List\x12 .db_service.db_ListETCNumRequest\x1a!.db_service.db_ListETCNumResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/db/etc-num\x12\x9c\x01\n" +
+	"\x0fGetByETCCardNum\x12+.db_service.db_GetETCNumByETCCardNumRequest\x1a!.db_service.db_ListETCNumResponse\"9\x82\xd3\xe4\x93\x023\x121/api/v1/db/etc-num/by-etc-card-num/{etc_card_num}\x12\x86\x01\n" +
+	"\n" +
+	"GetByCarID\x12&.db_service.db_GetETCNumByCarIDRequest\x1a!.db_service.db_ListETCNumResponse\"-\x82\xd3\xe4\x93\x02'\x12%/api/v1/db/etc-num/by-car-id/{car_id}\x12\xa6\x01\n" +
+	"\x11GetByETCCardNumAt\x12-.db_service.db_GetETCNumByETCCardNumAtRequest\x1a!.db_service.db_ListETCNumResponse\"?\x82\xd3\xe4\x93\x029\x127/api/v1/db/etc-num/by-etc-card-num/{etc_card_num}/as-of\x12\x90\x01\n" +
+	"\fGetByCarIDAt\x12(.db_service.db_GetETCNumByCarIDAtRequest\x1a!.db_service.db_ListETCNumResponse\"3\x82\xd3\xe4\x93\x02-\x12+/api/v1/db/etc-num/by-car-id/{car_id}/as-of\x12\x88\x01\n" +
+	"\fListOverlaps\x12(.db_service.db_ListETCNumOverlapsRequest\x1a).db_service.db_ListETCNumOverlapsResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/db/etc-num/overlaps2\xf0\x03\n" +
+	"\x1cdb_DTakoFerryRowsProdService\x12\x8c\x01\n" +
+	"\x03Get\x12+.db_service.db_GetDTakoFerryRowsProdRequest\x1a).db_service.db_DTakoFerryRowsProdResponse\"-\x82\xd3\xe4\x93\x02'\x12%/api/v1/db/dtako-ferry-rows-prod/{id}\x12\x8d\x01\n" +
+	"\x04List\x12,.db_service.db_ListDTakoFerryRowsProdRequest\x1a-.db_service.db_ListDTakoFerryRowsProdResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /api/v1/db/dtako-ferry-rows-prod\x12\xb0\x01\n" +
+	"\vGetByUnkoNo\x123.db_service.db_GetDTakoFerryRowsProdByUnkoNoRequest\x1a-.db_service.db_ListDTakoFerryRowsProdResponse\"=\x82\xd3\xe4\x93\x027\x125/api/v1/db/dtako-ferry-rows-prod/by-unko-no/{unko_no}2\xe4\x02\n" +
+	"\x0edb_CarsService\x12_\n" +
+	"\x03Get\x12\x1d.db_service.db_GetCarsRequest\x1a\x1b.db_service.db_CarsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/db/cars/{id}\x12`\n" +
+	"\x04List\x12\x1e.db_service.db_ListCarsRequest\x1a\x1f.db_service.db_ListCarsResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/api/v1/db/cars\x12\x8e\x01\n" +
+	"\x10GetByBumonCodeID\x12*.db_service.db_GetCarsByBumonCodeIDRequest\x1a\x1f.db_service.db_ListCarsResponse\"-\x82\xd3\xe4\x93\x02'\x12%/api/v1/db/cars/bumon/{bumon_code_id}2\xee\x02\n" +
+	"\x11db_DriversService\x12h\n" +
+	"\x03Get\x12 .db_service.db_GetDriversRequest\x1a\x1e.db_service.db_DriversResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/db/drivers/{id}\x12i\n" +
+	"\x04List\x12!.db_service.db_ListDriversRequest\x1a\".db_service.db_ListDriversResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/db/drivers\x12\x83\x01\n" +
+	"\n" +
+	"GetByBumon\x12'.db_service.db_GetDriversByBumonRequest\x1a\".db_service.db_ListDriversResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /api/v1/db/drivers/bumon/{bumon}2\xc8\a\n" +
+	"\x1adb_UntenNippoMeisaiService\x12\xa0\x01\n" +
+	"\x03Get\x12).db_service.db_GetUntenNippoMeisaiRequest\x1a'.db_service.db_UntenNippoMeisaiResponse\"E\x82\xd3\xe4\x93\x02?\x12=/api/v1/db/unten-nippo-meisai/{nippo_k}/{haisha_k}/{sharyo_c}\x12\x86\x01\n" +
+	"\x04List\x12*.db_service.db_ListUntenNippoMeisaiRequest\x1a+.db_service.db_ListUntenNippoMeisaiResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/db/unten-nippo-meisai\x12\x87\x01\n" +
+	"\x06Stream\x12,.db_service.db_StreamUntenNippoMeisaiRequest\x1a\x1f.db_service.db_UntenNippoMeisai\",\x82\xd3\xe4\x93\x02&\x12$/api/v1/db/unten-nippo-meisai/stream0\x01\x12\xa8\x01\n" +
+	"\fGetBySharyoC\x122.db_service.db_GetUntenNippoMeisaiBySharyoCRequest\x1a+.db_service.db_ListUntenNippoMeisaiResponse\"7\x82\xd3\xe4\x93\x021\x12//api/v1/db/unten-nippo-meisai/sharyo/{sharyo_c}\x12\xa5\x01\n" +
+	"\x0eGetByDateRange\x124.db_service.db_GetUntenNippoMeisaiByDateRangeRequest\x1a+.db_service.db_ListUntenNippoMeisaiResponse\"0\x82\xd3\xe4\x93\x02*\x12(/api/v1/db/unten-nippo-meisai/date-range\x12\xa0\x01\n" +
+	"\n" +
+	"GetByBumon\x120.db_service.db_GetUntenNippoMeisaiByBumonRequest\x1a+.db_service.db_ListUntenNippoMeisaiResponse\"3\x82\xd3\xe4\x93\x02-\x12+/api/v1/db/unten-nippo-meisai/bumon/{bumon}2\xa2\x03\n" +
+	"\x15db_ShainMasterService\x12z\n" +
+	"\x03Get\x12$.db_service.db_GetShainMasterRequest\x1a\".db_service.db_ShainMasterResponse\")\x82\xd3\xe4\x93\x02#\x12!/api/v1/db/shain-master/{shain_c}\x12v\n" +
+	"\x04List\x12%.db_service.db_ListShainMasterRequest\x1a&.db_service.db_ListShainMasterResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/db/shain-master\x12\x94\x01\n" +
+	"\vGetByBumonC\x12,.db_service.db_GetShainMasterByBumonCRequest\x1a&.db_service.db_ListShainMasterResponse\"/\x82\xd3\xe4\x93\x02)\x12'/api/v1/db/shain-master/bumon/{bumon_c}2\x93\x02\n" +
+	"\x16db_ChiikiMasterService\x12~\n" +
+	"\x03Get\x12%.db_service.db_GetChiikiMasterRequest\x1a#.db_service.db_ChiikiMasterResponse\"+\x82\xd3\xe4\x93\x02%\x12#/api/v1/db/chiiki-master/{chiiki_c}\x12y\n" +
+	"\x04List\x12&.db_service.db_ListChiikiMasterRequest\x1a'.db_service.db_ListChiikiMasterResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/db/chiiki-master2\xa6\x03\n" +
+	"\x15db_ChikuMasterService\x12z\n" +
+	"\x03Get\x12$.db_service.db_GetChikuMasterRequest\x1a\".db_service.db_ChikuMasterResponse\")\x82\xd3\xe4\x93\x02#\x12!/api/v1/db/chiku-master/{chiku_c}\x12v\n" +
+	"\x04List\x12%.db_service.db_ListChikuMasterRequest\x1a&.db_service.db_ListChikuMasterResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/db/chiku-master\x12\x98\x01\n" +
+	"\fGetByChiikiC\x12-.db_service.db_GetChikuMasterByChiikiCRequest\x1a&.db_service.db_ListChikuMasterResponse\"1\x82\xd3\xe4\x93\x02+\x12)/api/v1/db/chiku-master/chiiki/{chiiki_c}2\xb8\x06\n" +
+	"\x19db_TokuisakiMasterService\x12\x98\x01\n" +
+	"\x03Get\x12(.db_service.db_GetTokuisakiMasterRequest\x1a&.db_service.db_TokuisakiMasterResponse\"?\x82\xd3\xe4\x93\x029\x127/api/v1/db/tokuisaki-master/{tokuisaki_c}/{tokuisaki_h}\x12\x82\x01\n" +
+	"\x04List\x12).db_service.db_ListTokuisakiMasterRequest\x1a*.db_service.db_ListTokuisakiMasterResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/db/tokuisaki-master\x12\x8d\x01\n" +
+	"\x06Search\x12+.db_service.db_SearchTokuisakiMasterRequest\x1a*.db_service.db_ListTokuisakiMasterResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/api/v1/db/tokuisaki-master/search\x12\xb3\x01\n" +
+	"\fListTekiyobi\x12+.db_service.db_ListTokuisakiTekiyobiRequest\x1a,.db_service.db_ListTokuisakiTekiyobiResponse\"H\x82\xd3\xe4\x93\x02B\x12@/api/v1/db/tokuisaki-master/{tokuisaki_c}/{tokuisaki_h}/tekiyobi\x12\xb4\x01\n" +
+	"\rGetTekiyobiAt\x12,.db_service.db_GetTokuisakiTekiyobiAtRequest\x1a(.db_service.db_TokuisakiTekiyobiResponse\"K\x82\xd3\xe4\x93\x02E\x12C/api/v1/db/tokuisaki-master/{tokuisaki_c}/{tokuisaki_h}/tekiyobi/at2\xbb\a\n" +
+	"\x16db_HinmeiMasterService\x12\x89\x01\n" +
+	"\x03Get\x12%.db_service.db_GetHinmeiMasterRequest\x1a#.db_service.db_HinmeiMasterResponse\"6\x82\xd3\xe4\x93\x020\x12./api/v1/db/hinmei-master/{hinmei_c}/{hinmei_h}\x12y\n" +
+	"\x04List\x12&.db_service.db_ListHinmeiMasterRequest\x1a'.db_service.db_ListHinmeiMasterResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/db/hinmei-master\x12\x84\x01\n" +
+	"\x06Search\x12(.db_service.db_SearchHinmeiMasterRequest\x1a'.db_service.db_ListHinmeiMasterResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/db/hinmei-master/search\x12\xb4\x01\n" +
+	"\x13ListTokuisakiHinmei\x12).db_service.db_ListTokuisakiHinmeiRequest\x1a*.db_service.db_ListTokuisakiHinmeiResponse\"F\x82\xd3\xe4\x93\x02@\x12>/api/v1/db/tokuisaki-master/{tokuisaki_c}/{tokuisaki_h}/hinmei\x12\xc4\x01\n" +
+	"\x12GetTokuisakiHinmei\x12(.db_service.db_GetTokuisakiHinmeiRequest\x1a&.db_service.db_TokuisakiHinmeiResponse\"\\\x82\xd3\xe4\x93\x02V\x12T/api/v1/db/tokuisaki-master/{tokuisaki_c}/{tokuisaki_h}/hinmei/{hinmei_c}/{hinmei_h}\x12\x94\x01\n" +
+	"\aResolve\x12#.db_service.db_ResolveHinmeiRequest\x1a$.db_service.db_ResolveHinmeiResponse\">\x82\xd3\xe4\x93\x028\x126/api/v1/db/hinmei-master/{hinmei_c}/{hinmei_h}/resolve2\x8b\x02\n" +
+	"\x15db_BumonMasterService\x12z\n" +
+	"\x03Get\x12$.db_service.db_GetBumonMasterRequest\x1a\".db_service.db_BumonMasterResponse\")\x82\xd3\xe4\x93\x02#\x12!/api/v1/db/bumon-master/{bumon_c}\x12v\n" +
+	"\x04List\x12%.db_service.db_ListBumonMasterRequest\x1a&.db_service.db_ListBumonMasterResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/db/bumon-master2\xb8\x03\n" +
+	"\x16db_SharyoMasterService\x12\x89\x01\n" +
+	"\x03Get\x12%.db_service.db_GetSharyoMasterRequest\x1a#.db_service.db_SharyoMasterResponse\"6\x82\xd3\xe4\x93\x020\x12./api/v1/db/sharyo-master/{sharyo_c}/{sharyo_h}\x12\x96\x01\n" +
+	"\rListBySharyoC\x12/.db_service.db_ListSharyoMasterBySharyoCRequest\x1a'.db_service.db_ListSharyoMasterResponse\"+\x82\xd3\xe4\x93\x02%\x12#/api/v1/db/sharyo-master/{sharyo_c}\x12y\n" +
+	"\x04List\x12&.db_service.db_ListSharyoMasterRequest\x1a'.db_service.db_ListSharyoMasterResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/db/sharyo-master2\xaf\x02\n" +
+	"\x19db_VehicleIdentityService\x12|\n" +
+	"\x03Get\x12(.db_service.db_GetVehicleIdentityRequest\x1a&.db_service.db_VehicleIdentityResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/db/vehicle-identity\x12\x93\x01\n" +
+	"\bListGaps\x12-.db_service.db_ListVehicleIdentityGapsRequest\x1a..db_service.db_ListVehicleIdentityGapsResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /api/v1/db/vehicle-identity/gaps2\xf1\x01\n" +
+	"\x12db_TimeCardService\x12l\n" +
+	"\x03Get\x12!.db_service.db_GetTimeCardRequest\x1a\x1f.db_service.db_TimeCardResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/db/time-card/{id}\x12m\n" +
+	"\x04List\x12\".db_service.db_ListTimeCardRequest\x1a#.db_service.db_ListTimeCardResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/db/time-card2\xf5\x04\n" +
+	"\x15db_TimeCardDevService\x12|\n" +
+	"\x06Create\x12$.db_service.db_CreateTimeCardRequest\x1a\x1f.db_service.db_TimeCardResponse\"+\x82\xd3\xe4\x93\x02%:\ttime_card\"\x18/api/v1/db/time-card-dev\x12p\n" +
+	"\x03Get\x12!.db_service.db_GetTimeCardRequest\x1a\x1f.db_service.db_TimeCardResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/db/time-card-dev/{id}\x12\x8b\x01\n" +
+	"\x06Update\x12$.db_service.db_UpdateTimeCardRequest\x1a\x1f.db_service.db_TimeCardResponse\":\x82\xd3\xe4\x93\x024:\ttime_card\x1a'/api/v1/db/time-card-dev/{time_card.id}\x12k\n" +
+	"\x06Delete\x12$.db_service.db_DeleteTimeCardRequest\x1a\x14.db_service.db_Empty\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/api/v1/db/time-card-dev/{id}\x12q\n" +
+	"\x04List\x12\".db_service.db_ListTimeCardRequest\x1a#.db_service.db_ListTimeCardResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/db/time-card-dev2\x90\x06\n" +
+	"\x15db_TimeCardLogService\x12|\n" +
+	"\x06Create\x12'.db_service.db_CreateTimeCardLogRequest\x1a\".db_service.db_TimeCardLogResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x03log\"\x18/api/v1/db/time-card-log\x12v\n" +
+	"\x03Get\x12$.db_service.db_GetTimeCardLogRequest\x1a\".db_service.db_TimeCardLogResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/db/time-card-log/{id}\x12\x85\x01\n" +
+	"\x06Update\x12'.db_service.db_UpdateTimeCardLogRequest\x1a\".db_service.db_TimeCardLogResponse\".\x82\xd3\xe4\x93\x02(:\x03log\x1a!/api/v1/db/time-card-log/{log.id}\x12n\n" +
+	"\x06Delete\x12'.db_service.db_DeleteTimeCardLogRequest\x1a\x14.db_service.db_Empty\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/api/v1/db/time-card-log/{id}\x12w\n" +
+	"\x04List\x12%.db_service.db_ListTimeCardLogRequest\x1a&.db_service.db_ListTimeCardLogResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/db/time-card-log\x12\x8f\x01\n" +
+	"\vGetByCardID\x12!.db_service.db_GetByCardIDRequest\x1a&.db_service.db_ListTimeCardLogResponse\"5\x82\xd3\xe4\x93\x02/\x12-/api/v1/db/time-card-log/by-card-id/{card_id}2\xbe\n" +
+	"\n" +
+	"\x1adb_EmployeeIdentityService\x12\x8f\x01\n" +
+	"\x06Create\x12,.db_service.db_CreateEmployeeIdentityRequest\x1a'.db_service.db_EmployeeIdentityResponse\".\x82\xd3\xe4\x93\x02(:\bemployee\"\x1c/api/v1/db/employee-identity\x12\x84\x01\n" +
+	"\x03Get\x12).db_service.db_GetEmployeeIdentityRequest\x1a'.db_service.db_EmployeeIdentityResponse\")\x82\xd3\xe4\x93\x02#\x12!/api/v1/db/employee-identity/{id}\x12\x9d\x01\n" +
+	"\x06Update\x12,.db_service.db_UpdateEmployeeIdentityRequest\x1a'.db_service.db_EmployeeIdentityResponse\"<\x82\xd3\xe4\x93\x026:\bemployee\x1a*/api/v1/db/employee-identity/{employee.id}\x12w\n" +
+	"\x06Delete\x12,.db_service.db_DeleteEmployeeIdentityRequest\x1a\x14.db_service.db_Empty\")\x82\xd3\xe4\x93\x02#*!/api/v1/db/employee-identity/{id}\x12\x85\x01\n" +
+	"\x04List\x12*.db_service.db_ListEmployeeIdentityRequest\x1a+.db_service.db_ListEmployeeIdentityResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/db/employee-identity\x12\x96\x01\n" +
+	"\aResolve\x12-.db_service.db_ResolveEmployeeIdentityRequest\x1a..db_service.db_ResolveEmployeeIdentityResponse\",\x82\xd3\xe4\x93\x02&\x12$/api/v1/db/employee-identity/resolve\x12\x9c\x01\n" +
+	"\fRegisterCard\x12*.db_service.db_RegisterEmployeeCardRequest\x1a#.db_service.db_EmployeeCardResponse\";\x82\xd3\xe4\x93\x025:\x01*\"0/api/v1/db/employee-identity/{employee_id}/cards\x12\x8a\x01\n" +
+	"\x0eUnregisterCard\x12,.db_service.db_UnregisterEmployeeCardRequest\x1a\x14.db_service.db_Empty\"4\x82\xd3\xe4\x93\x02.*,/api/v1/db/employee-identity/cards/{card_id}\x12\xa0\x01\n" +
+	"\fListUnlinked\x12/.db_service.db_ListUnlinkedEmployeeCodesRequest\x1a0.db_service.db_ListUnlinkedEmployeeCodesResponse\"-\x82\xd3\xe4\x93\x02'\x12%/api/v1/db/employee-identity/unlinked2\xa1\x01\n" +
+	"\x12db_RegistryService\x12\x8a\x01\n" +
+	"\x0fGetAvailability\x12%.db_service.db_GetAvailabilityRequest\x1a&.db_service.db_GetAvailabilityResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /api/v1/db/registry/availabilityB\x93\x01\n" +
 	"\x0ecom.db_serviceB\x0eDbServiceProtoP\x01Z-github.com/yhonda-ohishi/db_service/src/proto\xa2\x02\x03DXX\xaa\x02\tDbService\xca\x02\tDbService\xe2\x02\x15DbService\\GPBMetadata\xea\x02\tDbServiceb\x06proto3"
 
 var (
@@ -9881,311 +18750,613 @@ func file_db_service_proto_rawDescGZIP() []byte {
 	return file_db_service_proto_rawDescData
 }
 
-var file_db_service_proto_msgTypes = make([]protoimpl.MessageInfo, 117)
+var file_db_service_proto_enumTypes = make([]protoimpl.EnumInfo, 21)
+var file_db_service_proto_msgTypes = make([]protoimpl.MessageInfo, 216)
 var file_db_service_proto_goTypes = []any{
-	(*Db_DTakoUriageKeihi)(nil),                      // 0: db_service.db_DTakoUriageKeihi
-	(*Db_ETCMeisai)(nil),                             // 1: db_service.db_ETCMeisai
-	(*Db_DTakoFerryRows)(nil),                        // 2: db_service.db_DTakoFerryRows
-	(*Db_CreateDTakoUriageKeihiRequest)(nil),         // 3: db_service.db_CreateDTakoUriageKeihiRequest
-	(*Db_GetDTakoUriageKeihiRequest)(nil),            // 4: db_service.db_GetDTakoUriageKeihiRequest
-	(*Db_UpdateDTakoUriageKeihiRequest)(nil),         // 5: db_service.db_UpdateDTakoUriageKeihiRequest
-	(*Db_DeleteDTakoUriageKeihiRequest)(nil),         // 6: db_service.db_DeleteDTakoUriageKeihiRequest
-	(*Db_ListDTakoUriageKeihiRequest)(nil),           // 7: db_service.db_ListDTakoUriageKeihiRequest
-	(*Db_DTakoUriageKeihiResponse)(nil),              // 8: db_service.db_DTakoUriageKeihiResponse
-	(*Db_ListDTakoUriageKeihiResponse)(nil),          // 9: db_service.db_ListDTakoUriageKeihiResponse
-	(*Db_CreateETCMeisaiRequest)(nil),                // 10: db_service.db_CreateETCMeisaiRequest
-	(*Db_GetETCMeisaiRequest)(nil),                   // 11: db_service.db_GetETCMeisaiRequest
-	(*Db_UpdateETCMeisaiRequest)(nil),                // 12: db_service.db_UpdateETCMeisaiRequest
-	(*Db_DeleteETCMeisaiRequest)(nil),                // 13: db_service.db_DeleteETCMeisaiRequest
-	(*Db_ListETCMeisaiRequest)(nil),                  // 14: db_service.db_ListETCMeisaiRequest
-	(*Db_ETCMeisaiResponse)(nil),                     // 15: db_service.db_ETCMeisaiResponse
-	(*Db_ListETCMeisaiResponse)(nil),                 // 16: db_service.db_ListETCMeisaiResponse
-	(*Db_CreateDTakoFerryRowsRequest)(nil),           // 17: db_service.db_CreateDTakoFerryRowsRequest
-	(*Db_GetDTakoFerryRowsRequest)(nil),              // 18: db_service.db_GetDTakoFerryRowsRequest
-	(*Db_UpdateDTakoFerryRowsRequest)(nil),           // 19: db_service.db_UpdateDTakoFerryRowsRequest
-	(*Db_DeleteDTakoFerryRowsRequest)(nil),           // 20: db_service.db_DeleteDTakoFerryRowsRequest
-	(*Db_ListDTakoFerryRowsRequest)(nil),             // 21: db_service.db_ListDTakoFerryRowsRequest
-	(*Db_DTakoFerryRowsResponse)(nil),                // 22: db_service.db_DTakoFerryRowsResponse
-	(*Db_ListDTakoFerryRowsResponse)(nil),            // 23: db_service.db_ListDTakoFerryRowsResponse
-	(*Db_ETCMeisaiMapping)(nil),                      // 24: db_service.db_ETCMeisaiMapping
-	(*Db_CreateETCMeisaiMappingRequest)(nil),         // 25: db_service.db_CreateETCMeisaiMappingRequest
-	(*Db_GetETCMeisaiMappingRequest)(nil),            // 26: db_service.db_GetETCMeisaiMappingRequest
-	(*Db_UpdateETCMeisaiMappingRequest)(nil),         // 27: db_service.db_UpdateETCMeisaiMappingRequest
-	(*Db_DeleteETCMeisaiMappingRequest)(nil),         // 28: db_service.db_DeleteETCMeisaiMappingRequest
-	(*Db_ListETCMeisaiMappingRequest)(nil),           // 29: db_service.db_ListETCMeisaiMappingRequest
-	(*Db_ETCMeisaiMappingResponse)(nil),              // 30: db_service.db_ETCMeisaiMappingResponse
-	(*Db_ListETCMeisaiMappingResponse)(nil),          // 31: db_service.db_ListETCMeisaiMappingResponse
-	(*Db_GetDTakoRowIDByHashRequest)(nil),            // 32: db_service.db_GetDTakoRowIDByHashRequest
-	(*Db_GetDTakoRowIDByHashResponse)(nil),           // 33: db_service.db_GetDTakoRowIDByHashResponse
-	(*Db_DTakoCars)(nil),                             // 34: db_service.db_DTakoCars
-	(*Db_DTakoEvents)(nil),                           // 35: db_service.db_DTakoEvents
-	(*Db_DTakoRows)(nil),                             // 36: db_service.db_DTakoRows
-	(*Db_ETCNum)(nil),                                // 37: db_service.db_ETCNum
-	(*Db_GetDTakoCarsRequest)(nil),                   // 38: db_service.db_GetDTakoCarsRequest
-	(*Db_GetDTakoCarsByCarCodeRequest)(nil),          // 39: db_service.db_GetDTakoCarsByCarCodeRequest
-	(*Db_ListDTakoCarsRequest)(nil),                  // 40: db_service.db_ListDTakoCarsRequest
-	(*Db_DTakoCarsResponse)(nil),                     // 41: db_service.db_DTakoCarsResponse
-	(*Db_ListDTakoCarsResponse)(nil),                 // 42: db_service.db_ListDTakoCarsResponse
-	(*Db_GetDTakoEventsRequest)(nil),                 // 43: db_service.db_GetDTakoEventsRequest
-	(*Db_GetDTakoEventsByOperationNoRequest)(nil),    // 44: db_service.db_GetDTakoEventsByOperationNoRequest
-	(*Db_ListDTakoEventsRequest)(nil),                // 45: db_service.db_ListDTakoEventsRequest
-	(*Db_DTakoEventsResponse)(nil),                   // 46: db_service.db_DTakoEventsResponse
-	(*Db_ListDTakoEventsResponse)(nil),               // 47: db_service.db_ListDTakoEventsResponse
-	(*Db_GetDTakoRowsRequest)(nil),                   // 48: db_service.db_GetDTakoRowsRequest
-	(*Db_GetDTakoRowsByOperationNoRequest)(nil),      // 49: db_service.db_GetDTakoRowsByOperationNoRequest
-	(*Db_ListDTakoRowsRequest)(nil),                  // 50: db_service.db_ListDTakoRowsRequest
-	(*Db_DTakoRowsResponse)(nil),                     // 51: db_service.db_DTakoRowsResponse
-	(*Db_ListDTakoRowsResponse)(nil),                 // 52: db_service.db_ListDTakoRowsResponse
-	(*Db_GetETCNumByETCCardNumRequest)(nil),          // 53: db_service.db_GetETCNumByETCCardNumRequest
-	(*Db_GetETCNumByCarIDRequest)(nil),               // 54: db_service.db_GetETCNumByCarIDRequest
-	(*Db_ListETCNumRequest)(nil),                     // 55: db_service.db_ListETCNumRequest
-	(*Db_ListETCNumResponse)(nil),                    // 56: db_service.db_ListETCNumResponse
-	(*Db_DTakoFerryRowsProd)(nil),                    // 57: db_service.db_DTakoFerryRowsProd
-	(*Db_GetDTakoFerryRowsProdRequest)(nil),          // 58: db_service.db_GetDTakoFerryRowsProdRequest
-	(*Db_GetDTakoFerryRowsProdByUnkoNoRequest)(nil),  // 59: db_service.db_GetDTakoFerryRowsProdByUnkoNoRequest
-	(*Db_ListDTakoFerryRowsProdRequest)(nil),         // 60: db_service.db_ListDTakoFerryRowsProdRequest
-	(*Db_DTakoFerryRowsProdResponse)(nil),            // 61: db_service.db_DTakoFerryRowsProdResponse
-	(*Db_ListDTakoFerryRowsProdResponse)(nil),        // 62: db_service.db_ListDTakoFerryRowsProdResponse
-	(*Db_Cars)(nil),                                  // 63: db_service.db_Cars
-	(*Db_Drivers)(nil),                               // 64: db_service.db_Drivers
-	(*Db_GetCarsRequest)(nil),                        // 65: db_service.db_GetCarsRequest
-	(*Db_GetCarsByBumonCodeIDRequest)(nil),           // 66: db_service.db_GetCarsByBumonCodeIDRequest
-	(*Db_ListCarsRequest)(nil),                       // 67: db_service.db_ListCarsRequest
-	(*Db_CarsResponse)(nil),                          // 68: db_service.db_CarsResponse
-	(*Db_ListCarsResponse)(nil),                      // 69: db_service.db_ListCarsResponse
-	(*Db_GetDriversRequest)(nil),                     // 70: db_service.db_GetDriversRequest
-	(*Db_GetDriversByBumonRequest)(nil),              // 71: db_service.db_GetDriversByBumonRequest
-	(*Db_ListDriversRequest)(nil),                    // 72: db_service.db_ListDriversRequest
-	(*Db_DriversResponse)(nil),                       // 73: db_service.db_DriversResponse
-	(*Db_ListDriversResponse)(nil),                   // 74: db_service.db_ListDriversResponse
-	(*Db_UntenNippoMeisai)(nil),                      // 75: db_service.db_UntenNippoMeisai
-	(*Db_ShainMaster)(nil),                           // 76: db_service.db_ShainMaster
-	(*Db_ChiikiMaster)(nil),                          // 77: db_service.db_ChiikiMaster
-	(*Db_ChikuMaster)(nil),                           // 78: db_service.db_ChikuMaster
-	(*Db_GetUntenNippoMeisaiRequest)(nil),            // 79: db_service.db_GetUntenNippoMeisaiRequest
-	(*Db_GetUntenNippoMeisaiBySharyoCRequest)(nil),   // 80: db_service.db_GetUntenNippoMeisaiBySharyoCRequest
-	(*Db_GetUntenNippoMeisaiByDateRangeRequest)(nil), // 81: db_service.db_GetUntenNippoMeisaiByDateRangeRequest
-	(*Db_ListUntenNippoMeisaiRequest)(nil),           // 82: db_service.db_ListUntenNippoMeisaiRequest
-	(*Db_UntenNippoMeisaiResponse)(nil),              // 83: db_service.db_UntenNippoMeisaiResponse
-	(*Db_ListUntenNippoMeisaiResponse)(nil),          // 84: db_service.db_ListUntenNippoMeisaiResponse
-	(*Db_GetShainMasterRequest)(nil),                 // 85: db_service.db_GetShainMasterRequest
-	(*Db_GetShainMasterByBumonCRequest)(nil),         // 86: db_service.db_GetShainMasterByBumonCRequest
-	(*Db_ListShainMasterRequest)(nil),                // 87: db_service.db_ListShainMasterRequest
-	(*Db_ShainMasterResponse)(nil),                   // 88: db_service.db_ShainMasterResponse
-	(*Db_ListShainMasterResponse)(nil),               // 89: db_service.db_ListShainMasterResponse
-	(*Db_GetChiikiMasterRequest)(nil),                // 90: db_service.db_GetChiikiMasterRequest
-	(*Db_ListChiikiMasterRequest)(nil),               // 91: db_service.db_ListChiikiMasterRequest
-	(*Db_ChiikiMasterResponse)(nil),                  // 92: db_service.db_ChiikiMasterResponse
-	(*Db_ListChiikiMasterResponse)(nil),              // 93: db_service.db_ListChiikiMasterResponse
-	(*Db_GetChikuMasterRequest)(nil),                 // 94: db_service.db_GetChikuMasterRequest
-	(*Db_GetChikuMasterByChiikiCRequest)(nil),        // 95: db_service.db_GetChikuMasterByChiikiCRequest
-	(*Db_ListChikuMasterRequest)(nil),                // 96: db_service.db_ListChikuMasterRequest
-	(*Db_ChikuMasterResponse)(nil),                   // 97: db_service.db_ChikuMasterResponse
-	(*Db_ListChikuMasterResponse)(nil),               // 98: db_service.db_ListChikuMasterResponse
-	(*Db_TimeCard)(nil),                              // 99: db_service.db_TimeCard
-	(*Db_GetTimeCardRequest)(nil),                    // 100: db_service.db_GetTimeCardRequest
-	(*Db_ListTimeCardRequest)(nil),                   // 101: db_service.db_ListTimeCardRequest
-	(*Db_TimeCardResponse)(nil),                      // 102: db_service.db_TimeCardResponse
-	(*Db_ListTimeCardResponse)(nil),                  // 103: db_service.db_ListTimeCardResponse
-	(*Db_CreateTimeCardRequest)(nil),                 // 104: db_service.db_CreateTimeCardRequest
-	(*Db_UpdateTimeCardRequest)(nil),                 // 105: db_service.db_UpdateTimeCardRequest
-	(*Db_DeleteTimeCardRequest)(nil),                 // 106: db_service.db_DeleteTimeCardRequest
-	(*Db_TimeCardLog)(nil),                           // 107: db_service.db_TimeCardLog
-	(*Db_CreateTimeCardLogRequest)(nil),              // 108: db_service.db_CreateTimeCardLogRequest
-	(*Db_GetTimeCardLogRequest)(nil),                 // 109: db_service.db_GetTimeCardLogRequest
-	(*Db_UpdateTimeCardLogRequest)(nil),              // 110: db_service.db_UpdateTimeCardLogRequest
-	(*Db_DeleteTimeCardLogRequest)(nil),              // 111: db_service.db_DeleteTimeCardLogRequest
-	(*Db_ListTimeCardLogRequest)(nil),                // 112: db_service.db_ListTimeCardLogRequest
-	(*Db_GetByCardIDRequest)(nil),                    // 113: db_service.db_GetByCardIDRequest
-	(*Db_TimeCardLogResponse)(nil),                   // 114: db_service.db_TimeCardLogResponse
-	(*Db_ListTimeCardLogResponse)(nil),               // 115: db_service.db_ListTimeCardLogResponse
-	(*Db_Empty)(nil),                                 // 116: db_service.db_Empty
+	(Db_BatchItemStatus)(0),                          // 0: db_service.db_BatchItemStatus
+	(Db_MappingIssueKind)(0),                         // 1: db_service.db_MappingIssueKind
+	(Db_AutoMatchStatus)(0),                          // 2: db_service.db_AutoMatchStatus
+	(Db_DTakoEventsSortField)(0),                     // 3: db_service.db_DTakoEventsSortField
+	(Db_DTakoRowsSortField)(0),                       // 4: db_service.db_DTakoRowsSortField
+	(Db_CarsSortField)(0),                            // 5: db_service.db_CarsSortField
+	(Db_DriversSortField)(0),                         // 6: db_service.db_DriversSortField
+	(Db_UntenNippoMeisaiBumonField)(0),               // 7: db_service.db_UntenNippoMeisaiBumonField
+	(Db_UntenNippoMeisaiSortField)(0),                // 8: db_service.db_UntenNippoMeisaiSortField
+	(Db_ShainMasterSortField)(0),                     // 9: db_service.db_ShainMasterSortField
+	(Db_ChiikiMasterSortField)(0),                    // 10: db_service.db_ChiikiMasterSortField
+	(Db_ChikuMasterSortField)(0),                     // 11: db_service.db_ChikuMasterSortField
+	(Db_TokuisakiMasterSortField)(0),                 // 12: db_service.db_TokuisakiMasterSortField
+	(Db_HinmeiMasterSortField)(0),                    // 13: db_service.db_HinmeiMasterSortField
+	(Db_BumonMasterSortField)(0),                     // 14: db_service.db_BumonMasterSortField
+	(Db_SharyoMasterSortField)(0),                    // 15: db_service.db_SharyoMasterSortField
+	(Db_VehicleSystem)(0),                            // 16: db_service.db_VehicleSystem
+	(Db_TimeCardSortField)(0),                        // 17: db_service.db_TimeCardSortField
+	(Db_TimeCardLogSortField)(0),                     // 18: db_service.db_TimeCardLogSortField
+	(Db_EmployeeCodeSource)(0),                       // 19: db_service.db_EmployeeCodeSource
+	(Db_SortDirection)(0),                            // 20: db_service.db_SortDirection
+	(*Db_DTakoUriageKeihi)(nil),                      // 21: db_service.db_DTakoUriageKeihi
+	(*Db_ETCMeisai)(nil),                             // 22: db_service.db_ETCMeisai
+	(*Db_DTakoFerryRows)(nil),                        // 23: db_service.db_DTakoFerryRows
+	(*Db_CreateDTakoUriageKeihiRequest)(nil),         // 24: db_service.db_CreateDTakoUriageKeihiRequest
+	(*Db_GetDTakoUriageKeihiRequest)(nil),            // 25: db_service.db_GetDTakoUriageKeihiRequest
+	(*Db_UpdateDTakoUriageKeihiRequest)(nil),         // 26: db_service.db_UpdateDTakoUriageKeihiRequest
+	(*Db_DeleteDTakoUriageKeihiRequest)(nil),         // 27: db_service.db_DeleteDTakoUriageKeihiRequest
+	(*Db_ListDTakoUriageKeihiRequest)(nil),           // 28: db_service.db_ListDTakoUriageKeihiRequest
+	(*Db_DTakoUriageKeihiResponse)(nil),              // 29: db_service.db_DTakoUriageKeihiResponse
+	(*Db_ListDTakoUriageKeihiResponse)(nil),          // 30: db_service.db_ListDTakoUriageKeihiResponse
+	(*Db_CreateETCMeisaiRequest)(nil),                // 31: db_service.db_CreateETCMeisaiRequest
+	(*Db_GetETCMeisaiRequest)(nil),                   // 32: db_service.db_GetETCMeisaiRequest
+	(*Db_UpdateETCMeisaiRequest)(nil),                // 33: db_service.db_UpdateETCMeisaiRequest
+	(*Db_DeleteETCMeisaiRequest)(nil),                // 34: db_service.db_DeleteETCMeisaiRequest
+	(*Db_ListETCMeisaiRequest)(nil),                  // 35: db_service.db_ListETCMeisaiRequest
+	(*Db_StreamETCMeisaiRequest)(nil),                // 36: db_service.db_StreamETCMeisaiRequest
+	(*Db_ETCMeisaiResponse)(nil),                     // 37: db_service.db_ETCMeisaiResponse
+	(*Db_BatchCreateETCMeisaiRequest)(nil),           // 38: db_service.db_BatchCreateETCMeisaiRequest
+	(*Db_BatchItemResult)(nil),                       // 39: db_service.db_BatchItemResult
+	(*Db_BatchCreateETCMeisaiResponse)(nil),          // 40: db_service.db_BatchCreateETCMeisaiResponse
+	(*Db_ImportETCMeisaiRequest)(nil),                // 41: db_service.db_ImportETCMeisaiRequest
+	(*Db_ImportETCMeisaiResponse)(nil),               // 42: db_service.db_ImportETCMeisaiResponse
+	(*Db_ListETCMeisaiResponse)(nil),                 // 43: db_service.db_ListETCMeisaiResponse
+	(*Db_CreateDTakoFerryRowsRequest)(nil),           // 44: db_service.db_CreateDTakoFerryRowsRequest
+	(*Db_GetDTakoFerryRowsRequest)(nil),              // 45: db_service.db_GetDTakoFerryRowsRequest
+	(*Db_UpdateDTakoFerryRowsRequest)(nil),           // 46: db_service.db_UpdateDTakoFerryRowsRequest
+	(*Db_DeleteDTakoFerryRowsRequest)(nil),           // 47: db_service.db_DeleteDTakoFerryRowsRequest
+	(*Db_ListDTakoFerryRowsRequest)(nil),             // 48: db_service.db_ListDTakoFerryRowsRequest
+	(*Db_DTakoFerryRowsResponse)(nil),                // 49: db_service.db_DTakoFerryRowsResponse
+	(*Db_ListDTakoFerryRowsResponse)(nil),            // 50: db_service.db_ListDTakoFerryRowsResponse
+	(*Db_ETCMeisaiMapping)(nil),                      // 51: db_service.db_ETCMeisaiMapping
+	(*Db_CreateETCMeisaiMappingRequest)(nil),         // 52: db_service.db_CreateETCMeisaiMappingRequest
+	(*Db_GetETCMeisaiMappingRequest)(nil),            // 53: db_service.db_GetETCMeisaiMappingRequest
+	(*Db_UpdateETCMeisaiMappingRequest)(nil),         // 54: db_service.db_UpdateETCMeisaiMappingRequest
+	(*Db_DeleteETCMeisaiMappingRequest)(nil),         // 55: db_service.db_DeleteETCMeisaiMappingRequest
+	(*Db_ListETCMeisaiMappingRequest)(nil),           // 56: db_service.db_ListETCMeisaiMappingRequest
+	(*Db_ETCMeisaiMappingResponse)(nil),              // 57: db_service.db_ETCMeisaiMappingResponse
+	(*Db_ListETCMeisaiMappingResponse)(nil),          // 58: db_service.db_ListETCMeisaiMappingResponse
+	(*Db_GetDTakoRowIDByHashRequest)(nil),            // 59: db_service.db_GetDTakoRowIDByHashRequest
+	(*Db_GetDTakoRowIDByHashResponse)(nil),           // 60: db_service.db_GetDTakoRowIDByHashResponse
+	(*Db_BulkReplaceETCMeisaiMappingRequest)(nil),    // 61: db_service.db_BulkReplaceETCMeisaiMappingRequest
+	(*Db_BulkReplaceETCMeisaiMappingResponse)(nil),   // 62: db_service.db_BulkReplaceETCMeisaiMappingResponse
+	(*Db_AuditETCMeisaiMappingRequest)(nil),          // 63: db_service.db_AuditETCMeisaiMappingRequest
+	(*Db_MappingIssue)(nil),                          // 64: db_service.db_MappingIssue
+	(*Db_AuditETCMeisaiMappingResponse)(nil),         // 65: db_service.db_AuditETCMeisaiMappingResponse
+	(*Db_AutoMatchETCMeisaiRequest)(nil),             // 66: db_service.db_AutoMatchETCMeisaiRequest
+	(*Db_AutoMatchCandidate)(nil),                    // 67: db_service.db_AutoMatchCandidate
+	(*Db_AutoMatchResult)(nil),                       // 68: db_service.db_AutoMatchResult
+	(*Db_AutoMatchETCMeisaiResponse)(nil),            // 69: db_service.db_AutoMatchETCMeisaiResponse
+	(*Db_DTakoCars)(nil),                             // 70: db_service.db_DTakoCars
+	(*Db_DTakoEvents)(nil),                           // 71: db_service.db_DTakoEvents
+	(*Db_DTakoRows)(nil),                             // 72: db_service.db_DTakoRows
+	(*Db_ETCNum)(nil),                                // 73: db_service.db_ETCNum
+	(*Db_GetDTakoCarsRequest)(nil),                   // 74: db_service.db_GetDTakoCarsRequest
+	(*Db_GetDTakoCarsByCarCodeRequest)(nil),          // 75: db_service.db_GetDTakoCarsByCarCodeRequest
+	(*Db_ListDTakoCarsRequest)(nil),                  // 76: db_service.db_ListDTakoCarsRequest
+	(*Db_DTakoCarsResponse)(nil),                     // 77: db_service.db_DTakoCarsResponse
+	(*Db_ListDTakoCarsResponse)(nil),                 // 78: db_service.db_ListDTakoCarsResponse
+	(*Db_GetDTakoEventsRequest)(nil),                 // 79: db_service.db_GetDTakoEventsRequest
+	(*Db_GetDTakoEventsByOperationNoRequest)(nil),    // 80: db_service.db_GetDTakoEventsByOperationNoRequest
+	(*Db_DTakoEventsSortSpec)(nil),                   // 81: db_service.db_DTakoEventsSortSpec
+	(*Db_ListDTakoEventsRequest)(nil),                // 82: db_service.db_ListDTakoEventsRequest
+	(*Db_StreamDTakoEventsRequest)(nil),              // 83: db_service.db_StreamDTakoEventsRequest
+	(*Db_DTakoEventsResponse)(nil),                   // 84: db_service.db_DTakoEventsResponse
+	(*Db_ListDTakoEventsResponse)(nil),               // 85: db_service.db_ListDTakoEventsResponse
+	(*Db_GetDTakoRowsRequest)(nil),                   // 86: db_service.db_GetDTakoRowsRequest
+	(*Db_GetDTakoRowsByOperationNoRequest)(nil),      // 87: db_service.db_GetDTakoRowsByOperationNoRequest
+	(*Db_DTakoRowsSortSpec)(nil),                     // 88: db_service.db_DTakoRowsSortSpec
+	(*Db_ListDTakoRowsRequest)(nil),                  // 89: db_service.db_ListDTakoRowsRequest
+	(*Db_StreamDTakoRowsRequest)(nil),                // 90: db_service.db_StreamDTakoRowsRequest
+	(*Db_DTakoRowsResponse)(nil),                     // 91: db_service.db_DTakoRowsResponse
+	(*Db_ListDTakoRowsResponse)(nil),                 // 92: db_service.db_ListDTakoRowsResponse
+	(*Db_GetETCNumByETCCardNumRequest)(nil),          // 93: db_service.db_GetETCNumByETCCardNumRequest
+	(*Db_GetETCNumByCarIDRequest)(nil),               // 94: db_service.db_GetETCNumByCarIDRequest
+	(*Db_GetETCNumByETCCardNumAtRequest)(nil),        // 95: db_service.db_GetETCNumByETCCardNumAtRequest
+	(*Db_GetETCNumByCarIDAtRequest)(nil),             // 96: db_service.db_GetETCNumByCarIDAtRequest
+	(*Db_ListETCNumOverlapsRequest)(nil),             // 97: db_service.db_ListETCNumOverlapsRequest
+	(*Db_ETCNumOverlap)(nil),                         // 98: db_service.db_ETCNumOverlap
+	(*Db_ListETCNumOverlapsResponse)(nil),            // 99: db_service.db_ListETCNumOverlapsResponse
+	(*Db_ListETCNumRequest)(nil),                     // 100: db_service.db_ListETCNumRequest
+	(*Db_ListETCNumResponse)(nil),                    // 101: db_service.db_ListETCNumResponse
+	(*Db_DTakoFerryRowsProd)(nil),                    // 102: db_service.db_DTakoFerryRowsProd
+	(*Db_GetDTakoFerryRowsProdRequest)(nil),          // 103: db_service.db_GetDTakoFerryRowsProdRequest
+	(*Db_GetDTakoFerryRowsProdByUnkoNoRequest)(nil),  // 104: db_service.db_GetDTakoFerryRowsProdByUnkoNoRequest
+	(*Db_ListDTakoFerryRowsProdRequest)(nil),         // 105: db_service.db_ListDTakoFerryRowsProdRequest
+	(*Db_DTakoFerryRowsProdResponse)(nil),            // 106: db_service.db_DTakoFerryRowsProdResponse
+	(*Db_ListDTakoFerryRowsProdResponse)(nil),        // 107: db_service.db_ListDTakoFerryRowsProdResponse
+	(*Db_Cars)(nil),                                  // 108: db_service.db_Cars
+	(*Db_Drivers)(nil),                               // 109: db_service.db_Drivers
+	(*Db_GetCarsRequest)(nil),                        // 110: db_service.db_GetCarsRequest
+	(*Db_GetCarsByBumonCodeIDRequest)(nil),           // 111: db_service.db_GetCarsByBumonCodeIDRequest
+	(*Db_CarsSortSpec)(nil),                          // 112: db_service.db_CarsSortSpec
+	(*Db_ListCarsRequest)(nil),                       // 113: db_service.db_ListCarsRequest
+	(*Db_CarsResponse)(nil),                          // 114: db_service.db_CarsResponse
+	(*Db_ListCarsResponse)(nil),                      // 115: db_service.db_ListCarsResponse
+	(*Db_GetDriversRequest)(nil),                     // 116: db_service.db_GetDriversRequest
+	(*Db_GetDriversByBumonRequest)(nil),              // 117: db_service.db_GetDriversByBumonRequest
+	(*Db_DriversSortSpec)(nil),                       // 118: db_service.db_DriversSortSpec
+	(*Db_ListDriversRequest)(nil),                    // 119: db_service.db_ListDriversRequest
+	(*Db_DriversResponse)(nil),                       // 120: db_service.db_DriversResponse
+	(*Db_ListDriversResponse)(nil),                   // 121: db_service.db_ListDriversResponse
+	(*Db_UntenNippoMeisai)(nil),                      // 122: db_service.db_UntenNippoMeisai
+	(*Db_ShainMaster)(nil),                           // 123: db_service.db_ShainMaster
+	(*Db_ChiikiMaster)(nil),                          // 124: db_service.db_ChiikiMaster
+	(*Db_ChikuMaster)(nil),                           // 125: db_service.db_ChikuMaster
+	(*Db_TokuisakiMaster)(nil),                       // 126: db_service.db_TokuisakiMaster
+	(*Db_TokuisakiTekiyobiMaster)(nil),               // 127: db_service.db_TokuisakiTekiyobiMaster
+	(*Db_HinmeiMaster)(nil),                          // 128: db_service.db_HinmeiMaster
+	(*Db_TokuisakiHinmeiMaster)(nil),                 // 129: db_service.db_TokuisakiHinmeiMaster
+	(*Db_GetUntenNippoMeisaiRequest)(nil),            // 130: db_service.db_GetUntenNippoMeisaiRequest
+	(*Db_GetUntenNippoMeisaiBySharyoCRequest)(nil),   // 131: db_service.db_GetUntenNippoMeisaiBySharyoCRequest
+	(*Db_GetUntenNippoMeisaiByDateRangeRequest)(nil), // 132: db_service.db_GetUntenNippoMeisaiByDateRangeRequest
+	(*Db_GetUntenNippoMeisaiByBumonRequest)(nil),     // 133: db_service.db_GetUntenNippoMeisaiByBumonRequest
+	(*Db_UntenNippoMeisaiSortSpec)(nil),              // 134: db_service.db_UntenNippoMeisaiSortSpec
+	(*Db_ListUntenNippoMeisaiRequest)(nil),           // 135: db_service.db_ListUntenNippoMeisaiRequest
+	(*Db_StreamUntenNippoMeisaiRequest)(nil),         // 136: db_service.db_StreamUntenNippoMeisaiRequest
+	(*Db_UntenNippoMeisaiResponse)(nil),              // 137: db_service.db_UntenNippoMeisaiResponse
+	(*Db_ListUntenNippoMeisaiResponse)(nil),          // 138: db_service.db_ListUntenNippoMeisaiResponse
+	(*Db_GetShainMasterRequest)(nil),                 // 139: db_service.db_GetShainMasterRequest
+	(*Db_GetShainMasterByBumonCRequest)(nil),         // 140: db_service.db_GetShainMasterByBumonCRequest
+	(*Db_ShainMasterSortSpec)(nil),                   // 141: db_service.db_ShainMasterSortSpec
+	(*Db_ListShainMasterRequest)(nil),                // 142: db_service.db_ListShainMasterRequest
+	(*Db_ShainMasterResponse)(nil),                   // 143: db_service.db_ShainMasterResponse
+	(*Db_ListShainMasterResponse)(nil),               // 144: db_service.db_ListShainMasterResponse
+	(*Db_GetChiikiMasterRequest)(nil),                // 145: db_service.db_GetChiikiMasterRequest
+	(*Db_ChiikiMasterSortSpec)(nil),                  // 146: db_service.db_ChiikiMasterSortSpec
+	(*Db_ListChiikiMasterRequest)(nil),               // 147: db_service.db_ListChiikiMasterRequest
+	(*Db_ChiikiMasterResponse)(nil),                  // 148: db_service.db_ChiikiMasterResponse
+	(*Db_ListChiikiMasterResponse)(nil),              // 149: db_service.db_ListChiikiMasterResponse
+	(*Db_GetChikuMasterRequest)(nil),                 // 150: db_service.db_GetChikuMasterRequest
+	(*Db_GetChikuMasterByChiikiCRequest)(nil),        // 151: db_service.db_GetChikuMasterByChiikiCRequest
+	(*Db_ChikuMasterSortSpec)(nil),                   // 152: db_service.db_ChikuMasterSortSpec
+	(*Db_ListChikuMasterRequest)(nil),                // 153: db_service.db_ListChikuMasterRequest
+	(*Db_ChikuMasterResponse)(nil),                   // 154: db_service.db_ChikuMasterResponse
+	(*Db_ListChikuMasterResponse)(nil),               // 155: db_service.db_ListChikuMasterResponse
+	(*Db_GetTokuisakiMasterRequest)(nil),             // 156: db_service.db_GetTokuisakiMasterRequest
+	(*Db_TokuisakiMasterSortSpec)(nil),               // 157: db_service.db_TokuisakiMasterSortSpec
+	(*Db_ListTokuisakiMasterRequest)(nil),            // 158: db_service.db_ListTokuisakiMasterRequest
+	(*Db_SearchTokuisakiMasterRequest)(nil),          // 159: db_service.db_SearchTokuisakiMasterRequest
+	(*Db_TokuisakiMasterResponse)(nil),               // 160: db_service.db_TokuisakiMasterResponse
+	(*Db_ListTokuisakiMasterResponse)(nil),           // 161: db_service.db_ListTokuisakiMasterResponse
+	(*Db_ListTokuisakiTekiyobiRequest)(nil),          // 162: db_service.db_ListTokuisakiTekiyobiRequest
+	(*Db_GetTokuisakiTekiyobiAtRequest)(nil),         // 163: db_service.db_GetTokuisakiTekiyobiAtRequest
+	(*Db_TokuisakiTekiyobiResponse)(nil),             // 164: db_service.db_TokuisakiTekiyobiResponse
+	(*Db_ListTokuisakiTekiyobiResponse)(nil),         // 165: db_service.db_ListTokuisakiTekiyobiResponse
+	(*Db_GetHinmeiMasterRequest)(nil),                // 166: db_service.db_GetHinmeiMasterRequest
+	(*Db_HinmeiMasterSortSpec)(nil),                  // 167: db_service.db_HinmeiMasterSortSpec
+	(*Db_ListHinmeiMasterRequest)(nil),               // 168: db_service.db_ListHinmeiMasterRequest
+	(*Db_SearchHinmeiMasterRequest)(nil),             // 169: db_service.db_SearchHinmeiMasterRequest
+	(*Db_HinmeiMasterResponse)(nil),                  // 170: db_service.db_HinmeiMasterResponse
+	(*Db_ListHinmeiMasterResponse)(nil),              // 171: db_service.db_ListHinmeiMasterResponse
+	(*Db_ListTokuisakiHinmeiRequest)(nil),            // 172: db_service.db_ListTokuisakiHinmeiRequest
+	(*Db_ListTokuisakiHinmeiResponse)(nil),           // 173: db_service.db_ListTokuisakiHinmeiResponse
+	(*Db_GetTokuisakiHinmeiRequest)(nil),             // 174: db_service.db_GetTokuisakiHinmeiRequest
+	(*Db_TokuisakiHinmeiResponse)(nil),               // 175: db_service.db_TokuisakiHinmeiResponse
+	(*Db_ResolveHinmeiRequest)(nil),                  // 176: db_service.db_ResolveHinmeiRequest
+	(*Db_ResolveHinmeiResponse)(nil),                 // 177: db_service.db_ResolveHinmeiResponse
+	(*Db_BumonMaster)(nil),                           // 178: db_service.db_BumonMaster
+	(*Db_GetBumonMasterRequest)(nil),                 // 179: db_service.db_GetBumonMasterRequest
+	(*Db_BumonMasterSortSpec)(nil),                   // 180: db_service.db_BumonMasterSortSpec
+	(*Db_ListBumonMasterRequest)(nil),                // 181: db_service.db_ListBumonMasterRequest
+	(*Db_BumonMasterResponse)(nil),                   // 182: db_service.db_BumonMasterResponse
+	(*Db_ListBumonMasterResponse)(nil),               // 183: db_service.db_ListBumonMasterResponse
+	(*Db_SharyoMaster)(nil),                          // 184: db_service.db_SharyoMaster
+	(*Db_GetSharyoMasterRequest)(nil),                // 185: db_service.db_GetSharyoMasterRequest
+	(*Db_ListSharyoMasterBySharyoCRequest)(nil),      // 186: db_service.db_ListSharyoMasterBySharyoCRequest
+	(*Db_SharyoMasterSortSpec)(nil),                  // 187: db_service.db_SharyoMasterSortSpec
+	(*Db_ListSharyoMasterRequest)(nil),               // 188: db_service.db_ListSharyoMasterRequest
+	(*Db_SharyoMasterResponse)(nil),                  // 189: db_service.db_SharyoMasterResponse
+	(*Db_ListSharyoMasterResponse)(nil),              // 190: db_service.db_ListSharyoMasterResponse
+	(*Db_GetVehicleIdentityRequest)(nil),             // 191: db_service.db_GetVehicleIdentityRequest
+	(*Db_VehicleIdentity)(nil),                       // 192: db_service.db_VehicleIdentity
+	(*Db_VehicleIdentityResponse)(nil),               // 193: db_service.db_VehicleIdentityResponse
+	(*Db_ListVehicleIdentityGapsRequest)(nil),        // 194: db_service.db_ListVehicleIdentityGapsRequest
+	(*Db_ListVehicleIdentityGapsResponse)(nil),       // 195: db_service.db_ListVehicleIdentityGapsResponse
+	(*Db_TimeCard)(nil),                              // 196: db_service.db_TimeCard
+	(*Db_GetTimeCardRequest)(nil),                    // 197: db_service.db_GetTimeCardRequest
+	(*Db_TimeCardSortSpec)(nil),                      // 198: db_service.db_TimeCardSortSpec
+	(*Db_ListTimeCardRequest)(nil),                   // 199: db_service.db_ListTimeCardRequest
+	(*Db_TimeCardResponse)(nil),                      // 200: db_service.db_TimeCardResponse
+	(*Db_ListTimeCardResponse)(nil),                  // 201: db_service.db_ListTimeCardResponse
+	(*Db_CreateTimeCardRequest)(nil),                 // 202: db_service.db_CreateTimeCardRequest
+	(*Db_UpdateTimeCardRequest)(nil),                 // 203: db_service.db_UpdateTimeCardRequest
+	(*Db_DeleteTimeCardRequest)(nil),                 // 204: db_service.db_DeleteTimeCardRequest
+	(*Db_TimeCardLog)(nil),                           // 205: db_service.db_TimeCardLog
+	(*Db_CreateTimeCardLogRequest)(nil),              // 206: db_service.db_CreateTimeCardLogRequest
+	(*Db_GetTimeCardLogRequest)(nil),                 // 207: db_service.db_GetTimeCardLogRequest
+	(*Db_UpdateTimeCardLogRequest)(nil),              // 208: db_service.db_UpdateTimeCardLogRequest
+	(*Db_DeleteTimeCardLogRequest)(nil),              // 209: db_service.db_DeleteTimeCardLogRequest
+	(*Db_TimeCardLogSortSpec)(nil),                   // 210: db_service.db_TimeCardLogSortSpec
+	(*Db_ListTimeCardLogRequest)(nil),                // 211: db_service.db_ListTimeCardLogRequest
+	(*Db_GetByCardIDRequest)(nil),                    // 212: db_service.db_GetByCardIDRequest
+	(*Db_TimeCardLogResponse)(nil),                   // 213: db_service.db_TimeCardLogResponse
+	(*Db_ListTimeCardLogResponse)(nil),               // 214: db_service.db_ListTimeCardLogResponse
+	(*Db_EmployeeIdentity)(nil),                      // 215: db_service.db_EmployeeIdentity
+	(*Db_EmployeeCard)(nil),                          // 216: db_service.db_EmployeeCard
+	(*Db_CreateEmployeeIdentityRequest)(nil),         // 217: db_service.db_CreateEmployeeIdentityRequest
+	(*Db_GetEmployeeIdentityRequest)(nil),            // 218: db_service.db_GetEmployeeIdentityRequest
+	(*Db_UpdateEmployeeIdentityRequest)(nil),         // 219: db_service.db_UpdateEmployeeIdentityRequest
+	(*Db_DeleteEmployeeIdentityRequest)(nil),         // 220: db_service.db_DeleteEmployeeIdentityRequest
+	(*Db_ListEmployeeIdentityRequest)(nil),           // 221: db_service.db_ListEmployeeIdentityRequest
+	(*Db_EmployeeIdentityResponse)(nil),              // 222: db_service.db_EmployeeIdentityResponse
+	(*Db_ListEmployeeIdentityResponse)(nil),          // 223: db_service.db_ListEmployeeIdentityResponse
+	(*Db_ResolveEmployeeIdentityRequest)(nil),        // 224: db_service.db_ResolveEmployeeIdentityRequest
+	(*Db_ResolveEmployeeIdentityResponse)(nil),       // 225: db_service.db_ResolveEmployeeIdentityResponse
+	(*Db_RegisterEmployeeCardRequest)(nil),           // 226: db_service.db_RegisterEmployeeCardRequest
+	(*Db_EmployeeCardResponse)(nil),                  // 227: db_service.db_EmployeeCardResponse
+	(*Db_UnregisterEmployeeCardRequest)(nil),         // 228: db_service.db_UnregisterEmployeeCardRequest
+	(*Db_UnlinkedEmployeeCode)(nil),                  // 229: db_service.db_UnlinkedEmployeeCode
+	(*Db_ListUnlinkedEmployeeCodesRequest)(nil),      // 230: db_service.db_ListUnlinkedEmployeeCodesRequest
+	(*Db_SkippedEmployeeCodeSource)(nil),             // 231: db_service.db_SkippedEmployeeCodeSource
+	(*Db_ListUnlinkedEmployeeCodesResponse)(nil),     // 232: db_service.db_ListUnlinkedEmployeeCodesResponse
+	(*Db_BackendStatus)(nil),                         // 233: db_service.db_BackendStatus
+	(*Db_GetAvailabilityRequest)(nil),                // 234: db_service.db_GetAvailabilityRequest
+	(*Db_GetAvailabilityResponse)(nil),               // 235: db_service.db_GetAvailabilityResponse
+	(*Db_Empty)(nil),                                 // 236: db_service.db_Empty
 }
 var file_db_service_proto_depIdxs = []int32{
-	0,   // 0: db_service.db_CreateDTakoUriageKeihiRequest.dtako_uriage_keihi:type_name -> db_service.db_DTakoUriageKeihi
-	0,   // 1: db_service.db_UpdateDTakoUriageKeihiRequest.dtako_uriage_keihi:type_name -> db_service.db_DTakoUriageKeihi
-	0,   // 2: db_service.db_DTakoUriageKeihiResponse.dtako_uriage_keihi:type_name -> db_service.db_DTakoUriageKeihi
-	0,   // 3: db_service.db_ListDTakoUriageKeihiResponse.items:type_name -> db_service.db_DTakoUriageKeihi
-	1,   // 4: db_service.db_CreateETCMeisaiRequest.etc_meisai:type_name -> db_service.db_ETCMeisai
-	1,   // 5: db_service.db_UpdateETCMeisaiRequest.etc_meisai:type_name -> db_service.db_ETCMeisai
-	1,   // 6: db_service.db_ETCMeisaiResponse.etc_meisai:type_name -> db_service.db_ETCMeisai
-	1,   // 7: db_service.db_ListETCMeisaiResponse.items:type_name -> db_service.db_ETCMeisai
-	2,   // 8: db_service.db_CreateDTakoFerryRowsRequest.dtako_ferry_rows:type_name -> db_service.db_DTakoFerryRows
-	2,   // 9: db_service.db_UpdateDTakoFerryRowsRequest.dtako_ferry_rows:type_name -> db_service.db_DTakoFerryRows
-	2,   // 10: db_service.db_DTakoFerryRowsResponse.dtako_ferry_rows:type_name -> db_service.db_DTakoFerryRows
-	2,   // 11: db_service.db_ListDTakoFerryRowsResponse.items:type_name -> db_service.db_DTakoFerryRows
-	24,  // 12: db_service.db_CreateETCMeisaiMappingRequest.etc_meisai_mapping:type_name -> db_service.db_ETCMeisaiMapping
-	24,  // 13: db_service.db_UpdateETCMeisaiMappingRequest.etc_meisai_mapping:type_name -> db_service.db_ETCMeisaiMapping
-	24,  // 14: db_service.db_ETCMeisaiMappingResponse.etc_meisai_mapping:type_name -> db_service.db_ETCMeisaiMapping
-	24,  // 15: db_service.db_ListETCMeisaiMappingResponse.items:type_name -> db_service.db_ETCMeisaiMapping
-	34,  // 16: db_service.db_DTakoCarsResponse.dtako_cars:type_name -> db_service.db_DTakoCars
-	34,  // 17: db_service.db_ListDTakoCarsResponse.items:type_name -> db_service.db_DTakoCars
-	35,  // 18: db_service.db_DTakoEventsResponse.dtako_events:type_name -> db_service.db_DTakoEvents
-	35,  // 19: db_service.db_ListDTakoEventsResponse.items:type_name -> db_service.db_DTakoEvents
-	36,  // 20: db_service.db_DTakoRowsResponse.dtako_rows:type_name -> db_service.db_DTakoRows
-	36,  // 21: db_service.db_ListDTakoRowsResponse.items:type_name -> db_service.db_DTakoRows
-	37,  // 22: db_service.db_ListETCNumResponse.items:type_name -> db_service.db_ETCNum
-	57,  // 23: db_service.db_DTakoFerryRowsProdResponse.dtako_ferry_rows:type_name -> db_service.db_DTakoFerryRowsProd
-	57,  // 24: db_service.db_ListDTakoFerryRowsProdResponse.items:type_name -> db_service.db_DTakoFerryRowsProd
-	63,  // 25: db_service.db_CarsResponse.cars:type_name -> db_service.db_Cars
-	63,  // 26: db_service.db_ListCarsResponse.items:type_name -> db_service.db_Cars
-	64,  // 27: db_service.db_DriversResponse.drivers:type_name -> db_service.db_Drivers
-	64,  // 28: db_service.db_ListDriversResponse.items:type_name -> db_service.db_Drivers
-	75,  // 29: db_service.db_UntenNippoMeisaiResponse.unten_nippo_meisai:type_name -> db_service.db_UntenNippoMeisai
-	75,  // 30: db_service.db_ListUntenNippoMeisaiResponse.items:type_name -> db_service.db_UntenNippoMeisai
-	76,  // 31: db_service.db_ShainMasterResponse.shain_master:type_name -> db_service.db_ShainMaster
-	76,  // 32: db_service.db_ListShainMasterResponse.items:type_name -> db_service.db_ShainMaster
-	77,  // 33: db_service.db_ChiikiMasterResponse.chiiki_master:type_name -> db_service.db_ChiikiMaster
-	77,  // 34: db_service.db_ListChiikiMasterResponse.items:type_name -> db_service.db_ChiikiMaster
-	78,  // 35: db_service.db_ChikuMasterResponse.chiku_master:type_name -> db_service.db_ChikuMaster
-	78,  // 36: db_service.db_ListChikuMasterResponse.items:type_name -> db_service.db_ChikuMaster
-	99,  // 37: db_service.db_TimeCardResponse.time_card:type_name -> db_service.db_TimeCard
-	99,  // 38: db_service.db_ListTimeCardResponse.items:type_name -> db_service.db_TimeCard
-	99,  // 39: db_service.db_CreateTimeCardRequest.time_card:type_name -> db_service.db_TimeCard
-	99,  // 40: db_service.db_UpdateTimeCardRequest.time_card:type_name -> db_service.db_TimeCard
-	107, // 41: db_service.db_CreateTimeCardLogRequest.log:type_name -> db_service.db_TimeCardLog
-	107, // 42: db_service.db_UpdateTimeCardLogRequest.log:type_name -> db_service.db_TimeCardLog
-	107, // 43: db_service.db_TimeCardLogResponse.log:type_name -> db_service.db_TimeCardLog
-	107, // 44: db_service.db_ListTimeCardLogResponse.items:type_name -> db_service.db_TimeCardLog
-	3,   // 45: db_service.db_DTakoUriageKeihiService.Create:input_type -> db_service.db_CreateDTakoUriageKeihiRequest
-	4,   // 46: db_service.db_DTakoUriageKeihiService.Get:input_type -> db_service.db_GetDTakoUriageKeihiRequest
-	5,   // 47: db_service.db_DTakoUriageKeihiService.Update:input_type -> db_service.db_UpdateDTakoUriageKeihiRequest
-	6,   // 48: db_service.db_DTakoUriageKeihiService.Delete:input_type -> db_service.db_DeleteDTakoUriageKeihiRequest
-	7,   // 49: db_service.db_DTakoUriageKeihiService.List:input_type -> db_service.db_ListDTakoUriageKeihiRequest
-	10,  // 50: db_service.db_ETCMeisaiService.Create:input_type -> db_service.db_CreateETCMeisaiRequest
-	11,  // 51: db_service.db_ETCMeisaiService.Get:input_type -> db_service.db_GetETCMeisaiRequest
-	12,  // 52: db_service.db_ETCMeisaiService.Update:input_type -> db_service.db_UpdateETCMeisaiRequest
-	13,  // 53: db_service.db_ETCMeisaiService.Delete:input_type -> db_service.db_DeleteETCMeisaiRequest
-	14,  // 54: db_service.db_ETCMeisaiService.List:input_type -> db_service.db_ListETCMeisaiRequest
-	17,  // 55: db_service.db_DTakoFerryRowsService.Create:input_type -> db_service.db_CreateDTakoFerryRowsRequest
-	18,  // 56: db_service.db_DTakoFerryRowsService.Get:input_type -> db_service.db_GetDTakoFerryRowsRequest
-	19,  // 57: db_service.db_DTakoFerryRowsService.Update:input_type -> db_service.db_UpdateDTakoFerryRowsRequest
-	20,  // 58: db_service.db_DTakoFerryRowsService.Delete:input_type -> db_service.db_DeleteDTakoFerryRowsRequest
-	21,  // 59: db_service.db_DTakoFerryRowsService.List:input_type -> db_service.db_ListDTakoFerryRowsRequest
-	25,  // 60: db_service.db_ETCMeisaiMappingService.Create:input_type -> db_service.db_CreateETCMeisaiMappingRequest
-	26,  // 61: db_service.db_ETCMeisaiMappingService.Get:input_type -> db_service.db_GetETCMeisaiMappingRequest
-	27,  // 62: db_service.db_ETCMeisaiMappingService.Update:input_type -> db_service.db_UpdateETCMeisaiMappingRequest
-	28,  // 63: db_service.db_ETCMeisaiMappingService.Delete:input_type -> db_service.db_DeleteETCMeisaiMappingRequest
-	29,  // 64: db_service.db_ETCMeisaiMappingService.List:input_type -> db_service.db_ListETCMeisaiMappingRequest
-	32,  // 65: db_service.db_ETCMeisaiMappingService.GetDTakoRowIDByHash:input_type -> db_service.db_GetDTakoRowIDByHashRequest
-	38,  // 66: db_service.db_DTakoCarsService.Get:input_type -> db_service.db_GetDTakoCarsRequest
-	40,  // 67: db_service.db_DTakoCarsService.List:input_type -> db_service.db_ListDTakoCarsRequest
-	39,  // 68: db_service.db_DTakoCarsService.GetByCarCode:input_type -> db_service.db_GetDTakoCarsByCarCodeRequest
-	43,  // 69: db_service.db_DTakoEventsService.Get:input_type -> db_service.db_GetDTakoEventsRequest
-	45,  // 70: db_service.db_DTakoEventsService.List:input_type -> db_service.db_ListDTakoEventsRequest
-	44,  // 71: db_service.db_DTakoEventsService.GetByOperationNo:input_type -> db_service.db_GetDTakoEventsByOperationNoRequest
-	48,  // 72: db_service.db_DTakoRowsService.Get:input_type -> db_service.db_GetDTakoRowsRequest
-	50,  // 73: db_service.db_DTakoRowsService.List:input_type -> db_service.db_ListDTakoRowsRequest
-	49,  // 74: db_service.db_DTakoRowsService.GetByOperationNo:input_type -> db_service.db_GetDTakoRowsByOperationNoRequest
-	55,  // 75: db_service.db_ETCNumService.List:input_type -> db_service.db_ListETCNumRequest
-	53,  // 76: db_service.db_ETCNumService.GetByETCCardNum:input_type -> db_service.db_GetETCNumByETCCardNumRequest
-	54,  // 77: db_service.db_ETCNumService.GetByCarID:input_type -> db_service.db_GetETCNumByCarIDRequest
-	58,  // 78: db_service.db_DTakoFerryRowsProdService.Get:input_type -> db_service.db_GetDTakoFerryRowsProdRequest
-	60,  // 79: db_service.db_DTakoFerryRowsProdService.List:input_type -> db_service.db_ListDTakoFerryRowsProdRequest
-	59,  // 80: db_service.db_DTakoFerryRowsProdService.GetByUnkoNo:input_type -> db_service.db_GetDTakoFerryRowsProdByUnkoNoRequest
-	65,  // 81: db_service.db_CarsService.Get:input_type -> db_service.db_GetCarsRequest
-	67,  // 82: db_service.db_CarsService.List:input_type -> db_service.db_ListCarsRequest
-	66,  // 83: db_service.db_CarsService.GetByBumonCodeID:input_type -> db_service.db_GetCarsByBumonCodeIDRequest
-	70,  // 84: db_service.db_DriversService.Get:input_type -> db_service.db_GetDriversRequest
-	72,  // 85: db_service.db_DriversService.List:input_type -> db_service.db_ListDriversRequest
-	71,  // 86: db_service.db_DriversService.GetByBumon:input_type -> db_service.db_GetDriversByBumonRequest
-	79,  // 87: db_service.db_UntenNippoMeisaiService.Get:input_type -> db_service.db_GetUntenNippoMeisaiRequest
-	82,  // 88: db_service.db_UntenNippoMeisaiService.List:input_type -> db_service.db_ListUntenNippoMeisaiRequest
-	80,  // 89: db_service.db_UntenNippoMeisaiService.GetBySharyoC:input_type -> db_service.db_GetUntenNippoMeisaiBySharyoCRequest
-	81,  // 90: db_service.db_UntenNippoMeisaiService.GetByDateRange:input_type -> db_service.db_GetUntenNippoMeisaiByDateRangeRequest
-	85,  // 91: db_service.db_ShainMasterService.Get:input_type -> db_service.db_GetShainMasterRequest
-	87,  // 92: db_service.db_ShainMasterService.List:input_type -> db_service.db_ListShainMasterRequest
-	86,  // 93: db_service.db_ShainMasterService.GetByBumonC:input_type -> db_service.db_GetShainMasterByBumonCRequest
-	90,  // 94: db_service.db_ChiikiMasterService.Get:input_type -> db_service.db_GetChiikiMasterRequest
-	91,  // 95: db_service.db_ChiikiMasterService.List:input_type -> db_service.db_ListChiikiMasterRequest
-	94,  // 96: db_service.db_ChikuMasterService.Get:input_type -> db_service.db_GetChikuMasterRequest
-	96,  // 97: db_service.db_ChikuMasterService.List:input_type -> db_service.db_ListChikuMasterRequest
-	95,  // 98: db_service.db_ChikuMasterService.GetByChiikiC:input_type -> db_service.db_GetChikuMasterByChiikiCRequest
-	100, // 99: db_service.db_TimeCardService.Get:input_type -> db_service.db_GetTimeCardRequest
-	101, // 100: db_service.db_TimeCardService.List:input_type -> db_service.db_ListTimeCardRequest
-	104, // 101: db_service.db_TimeCardDevService.Create:input_type -> db_service.db_CreateTimeCardRequest
-	100, // 102: db_service.db_TimeCardDevService.Get:input_type -> db_service.db_GetTimeCardRequest
-	105, // 103: db_service.db_TimeCardDevService.Update:input_type -> db_service.db_UpdateTimeCardRequest
-	106, // 104: db_service.db_TimeCardDevService.Delete:input_type -> db_service.db_DeleteTimeCardRequest
-	101, // 105: db_service.db_TimeCardDevService.List:input_type -> db_service.db_ListTimeCardRequest
-	108, // 106: db_service.db_TimeCardLogService.Create:input_type -> db_service.db_CreateTimeCardLogRequest
-	109, // 107: db_service.db_TimeCardLogService.Get:input_type -> db_service.db_GetTimeCardLogRequest
-	110, // 108: db_service.db_TimeCardLogService.Update:input_type -> db_service.db_UpdateTimeCardLogRequest
-	111, // 109: db_service.db_TimeCardLogService.Delete:input_type -> db_service.db_DeleteTimeCardLogRequest
-	112, // 110: db_service.db_TimeCardLogService.List:input_type -> db_service.db_ListTimeCardLogRequest
-	113, // 111: db_service.db_TimeCardLogService.GetByCardID:input_type -> db_service.db_GetByCardIDRequest
-	8,   // 112: db_service.db_DTakoUriageKeihiService.Create:output_type -> db_service.db_DTakoUriageKeihiResponse
-	8,   // 113: db_service.db_DTakoUriageKeihiService.Get:output_type -> db_service.db_DTakoUriageKeihiResponse
-	8,   // 114: db_service.db_DTakoUriageKeihiService.Update:output_type -> db_service.db_DTakoUriageKeihiResponse
-	116, // 115: db_service.db_DTakoUriageKeihiService.Delete:output_type -> db_service.db_Empty
-	9,   // 116: db_service.db_DTakoUriageKeihiService.List:output_type -> db_service.db_ListDTakoUriageKeihiResponse
-	15,  // 117: db_service.db_ETCMeisaiService.Create:output_type -> db_service.db_ETCMeisaiResponse
-	15,  // 118: db_service.db_ETCMeisaiService.Get:output_type -> db_service.db_ETCMeisaiResponse
-	15,  // 119: db_service.db_ETCMeisaiService.Update:output_type -> db_service.db_ETCMeisaiResponse
-	116, // 120: db_service.db_ETCMeisaiService.Delete:output_type -> db_service.db_Empty
-	16,  // 121: db_service.db_ETCMeisaiService.List:output_type -> db_service.db_ListETCMeisaiResponse
-	22,  // 122: db_service.db_DTakoFerryRowsService.Create:output_type -> db_service.db_DTakoFerryRowsResponse
-	22,  // 123: db_service.db_DTakoFerryRowsService.Get:output_type -> db_service.db_DTakoFerryRowsResponse
-	22,  // 124: db_service.db_DTakoFerryRowsService.Update:output_type -> db_service.db_DTakoFerryRowsResponse
-	116, // 125: db_service.db_DTakoFerryRowsService.Delete:output_type -> db_service.db_Empty
-	23,  // 126: db_service.db_DTakoFerryRowsService.List:output_type -> db_service.db_ListDTakoFerryRowsResponse
-	30,  // 127: db_service.db_ETCMeisaiMappingService.Create:output_type -> db_service.db_ETCMeisaiMappingResponse
-	30,  // 128: db_service.db_ETCMeisaiMappingService.Get:output_type -> db_service.db_ETCMeisaiMappingResponse
-	30,  // 129: db_service.db_ETCMeisaiMappingService.Update:output_type -> db_service.db_ETCMeisaiMappingResponse
-	116, // 130: db_service.db_ETCMeisaiMappingService.Delete:output_type -> db_service.db_Empty
-	31,  // 131: db_service.db_ETCMeisaiMappingService.List:output_type -> db_service.db_ListETCMeisaiMappingResponse
-	33,  // 132: db_service.db_ETCMeisaiMappingService.GetDTakoRowIDByHash:output_type -> db_service.db_GetDTakoRowIDByHashResponse
-	41,  // 133: db_service.db_DTakoCarsService.Get:output_type -> db_service.db_DTakoCarsResponse
-	42,  // 134: db_service.db_DTakoCarsService.List:output_type -> db_service.db_ListDTakoCarsResponse
-	41,  // 135: db_service.db_DTakoCarsService.GetByCarCode:output_type -> db_service.db_DTakoCarsResponse
-	46,  // 136: db_service.db_DTakoEventsService.Get:output_type -> db_service.db_DTakoEventsResponse
-	47,  // 137: db_service.db_DTakoEventsService.List:output_type -> db_service.db_ListDTakoEventsResponse
-	47,  // 138: db_service.db_DTakoEventsService.GetByOperationNo:output_type -> db_service.db_ListDTakoEventsResponse
-	51,  // 139: db_service.db_DTakoRowsService.Get:output_type -> db_service.db_DTakoRowsResponse
-	52,  // 140: db_service.db_DTakoRowsService.List:output_type -> db_service.db_ListDTakoRowsResponse
-	52,  // 141: db_service.db_DTakoRowsService.GetByOperationNo:output_type -> db_service.db_ListDTakoRowsResponse
-	56,  // 142: db_service.db_ETCNumService.List:output_type -> db_service.db_ListETCNumResponse
-	56,  // 143: db_service.db_ETCNumService.GetByETCCardNum:output_type -> db_service.db_ListETCNumResponse
-	56,  // 144: db_service.db_ETCNumService.GetByCarID:output_type -> db_service.db_ListETCNumResponse
-	61,  // 145: db_service.db_DTakoFerryRowsProdService.Get:output_type -> db_service.db_DTakoFerryRowsProdResponse
-	62,  // 146: db_service.db_DTakoFerryRowsProdService.List:output_type -> db_service.db_ListDTakoFerryRowsProdResponse
-	62,  // 147: db_service.db_DTakoFerryRowsProdService.GetByUnkoNo:output_type -> db_service.db_ListDTakoFerryRowsProdResponse
-	68,  // 148: db_service.db_CarsService.Get:output_type -> db_service.db_CarsResponse
-	69,  // 149: db_service.db_CarsService.List:output_type -> db_service.db_ListCarsResponse
-	69,  // 150: db_service.db_CarsService.GetByBumonCodeID:output_type -> db_service.db_ListCarsResponse
-	73,  // 151: db_service.db_DriversService.Get:output_type -> db_service.db_DriversResponse
-	74,  // 152: db_service.db_DriversService.List:output_type -> db_service.db_ListDriversResponse
-	74,  // 153: db_service.db_DriversService.GetByBumon:output_type -> db_service.db_ListDriversResponse
-	83,  // 154: db_service.db_UntenNippoMeisaiService.Get:output_type -> db_service.db_UntenNippoMeisaiResponse
-	84,  // 155: db_service.db_UntenNippoMeisaiService.List:output_type -> db_service.db_ListUntenNippoMeisaiResponse
-	84,  // 156: db_service.db_UntenNippoMeisaiService.GetBySharyoC:output_type -> db_service.db_ListUntenNippoMeisaiResponse
-	84,  // 157: db_service.db_UntenNippoMeisaiService.GetByDateRange:output_type -> db_service.db_ListUntenNippoMeisaiResponse
-	88,  // 158: db_service.db_ShainMasterService.Get:output_type -> db_service.db_ShainMasterResponse
-	89,  // 159: db_service.db_ShainMasterService.List:output_type -> db_service.db_ListShainMasterResponse
-	89,  // 160: db_service.db_ShainMasterService.GetByBumonC:output_type -> db_service.db_ListShainMasterResponse
-	92,  // 161: db_service.db_ChiikiMasterService.Get:output_type -> db_service.db_ChiikiMasterResponse
-	93,  // 162: db_service.db_ChiikiMasterService.List:output_type -> db_service.db_ListChiikiMasterResponse
-	97,  // 163: db_service.db_ChikuMasterService.Get:output_type -> db_service.db_ChikuMasterResponse
-	98,  // 164: db_service.db_ChikuMasterService.List:output_type -> db_service.db_ListChikuMasterResponse
-	98,  // 165: db_service.db_ChikuMasterService.GetByChiikiC:output_type -> db_service.db_ListChikuMasterResponse
-	102, // 166: db_service.db_TimeCardService.Get:output_type -> db_service.db_TimeCardResponse
-	103, // 167: db_service.db_TimeCardService.List:output_type -> db_service.db_ListTimeCardResponse
-	102, // 168: db_service.db_TimeCardDevService.Create:output_type -> db_service.db_TimeCardResponse
-	102, // 169: db_service.db_TimeCardDevService.Get:output_type -> db_service.db_TimeCardResponse
-	102, // 170: db_service.db_TimeCardDevService.Update:output_type -> db_service.db_TimeCardResponse
-	116, // 171: db_service.db_TimeCardDevService.Delete:output_type -> db_service.db_Empty
-	103, // 172: db_service.db_TimeCardDevService.List:output_type -> db_service.db_ListTimeCardResponse
-	114, // 173: db_service.db_TimeCardLogService.Create:output_type -> db_service.db_TimeCardLogResponse
-	114, // 174: db_service.db_TimeCardLogService.Get:output_type -> db_service.db_TimeCardLogResponse
-	114, // 175: db_service.db_TimeCardLogService.Update:output_type -> db_service.db_TimeCardLogResponse
-	116, // 176: db_service.db_TimeCardLogService.Delete:output_type -> db_service.db_Empty
-	115, // 177: db_service.db_TimeCardLogService.List:output_type -> db_service.db_ListTimeCardLogResponse
-	115, // 178: db_service.db_TimeCardLogService.GetByCardID:output_type -> db_service.db_ListTimeCardLogResponse
-	112, // [112:179] is the sub-list for method output_type
-	45,  // [45:112] is the sub-list for method input_type
-	45,  // [45:45] is the sub-list for extension type_name
-	45,  // [45:45] is the sub-list for extension extendee
-	0,   // [0:45] is the sub-list for field type_name
+	21,  // 0: db_service.db_CreateDTakoUriageKeihiRequest.dtako_uriage_keihi:type_name -> db_service.db_DTakoUriageKeihi
+	21,  // 1: db_service.db_UpdateDTakoUriageKeihiRequest.dtako_uriage_keihi:type_name -> db_service.db_DTakoUriageKeihi
+	21,  // 2: db_service.db_DTakoUriageKeihiResponse.dtako_uriage_keihi:type_name -> db_service.db_DTakoUriageKeihi
+	21,  // 3: db_service.db_ListDTakoUriageKeihiResponse.items:type_name -> db_service.db_DTakoUriageKeihi
+	22,  // 4: db_service.db_CreateETCMeisaiRequest.etc_meisai:type_name -> db_service.db_ETCMeisai
+	22,  // 5: db_service.db_UpdateETCMeisaiRequest.etc_meisai:type_name -> db_service.db_ETCMeisai
+	22,  // 6: db_service.db_ETCMeisaiResponse.etc_meisai:type_name -> db_service.db_ETCMeisai
+	22,  // 7: db_service.db_BatchCreateETCMeisaiRequest.items:type_name -> db_service.db_ETCMeisai
+	0,   // 8: db_service.db_BatchItemResult.status:type_name -> db_service.db_BatchItemStatus
+	39,  // 9: db_service.db_BatchCreateETCMeisaiResponse.results:type_name -> db_service.db_BatchItemResult
+	39,  // 10: db_service.db_ImportETCMeisaiResponse.results:type_name -> db_service.db_BatchItemResult
+	22,  // 11: db_service.db_ListETCMeisaiResponse.items:type_name -> db_service.db_ETCMeisai
+	23,  // 12: db_service.db_CreateDTakoFerryRowsRequest.dtako_ferry_rows:type_name -> db_service.db_DTakoFerryRows
+	23,  // 13: db_service.db_UpdateDTakoFerryRowsRequest.dtako_ferry_rows:type_name -> db_service.db_DTakoFerryRows
+	23,  // 14: db_service.db_DTakoFerryRowsResponse.dtako_ferry_rows:type_name -> db_service.db_DTakoFerryRows
+	23,  // 15: db_service.db_ListDTakoFerryRowsResponse.items:type_name -> db_service.db_DTakoFerryRows
+	51,  // 16: db_service.db_CreateETCMeisaiMappingRequest.etc_meisai_mapping:type_name -> db_service.db_ETCMeisaiMapping
+	51,  // 17: db_service.db_UpdateETCMeisaiMappingRequest.etc_meisai_mapping:type_name -> db_service.db_ETCMeisaiMapping
+	51,  // 18: db_service.db_ETCMeisaiMappingResponse.etc_meisai_mapping:type_name -> db_service.db_ETCMeisaiMapping
+	51,  // 19: db_service.db_ListETCMeisaiMappingResponse.items:type_name -> db_service.db_ETCMeisaiMapping
+	51,  // 20: db_service.db_BulkReplaceETCMeisaiMappingRequest.items:type_name -> db_service.db_ETCMeisaiMapping
+	51,  // 21: db_service.db_BulkReplaceETCMeisaiMappingResponse.items:type_name -> db_service.db_ETCMeisaiMapping
+	1,   // 22: db_service.db_AuditETCMeisaiMappingRequest.kinds:type_name -> db_service.db_MappingIssueKind
+	1,   // 23: db_service.db_MappingIssue.kind:type_name -> db_service.db_MappingIssueKind
+	64,  // 24: db_service.db_AuditETCMeisaiMappingResponse.issues:type_name -> db_service.db_MappingIssue
+	2,   // 25: db_service.db_AutoMatchResult.status:type_name -> db_service.db_AutoMatchStatus
+	67,  // 26: db_service.db_AutoMatchResult.candidates:type_name -> db_service.db_AutoMatchCandidate
+	68,  // 27: db_service.db_AutoMatchETCMeisaiResponse.results:type_name -> db_service.db_AutoMatchResult
+	70,  // 28: db_service.db_DTakoCarsResponse.dtako_cars:type_name -> db_service.db_DTakoCars
+	70,  // 29: db_service.db_ListDTakoCarsResponse.items:type_name -> db_service.db_DTakoCars
+	3,   // 30: db_service.db_DTakoEventsSortSpec.field:type_name -> db_service.db_DTakoEventsSortField
+	20,  // 31: db_service.db_DTakoEventsSortSpec.direction:type_name -> db_service.db_SortDirection
+	81,  // 32: db_service.db_ListDTakoEventsRequest.sort:type_name -> db_service.db_DTakoEventsSortSpec
+	71,  // 33: db_service.db_DTakoEventsResponse.dtako_events:type_name -> db_service.db_DTakoEvents
+	71,  // 34: db_service.db_ListDTakoEventsResponse.items:type_name -> db_service.db_DTakoEvents
+	4,   // 35: db_service.db_DTakoRowsSortSpec.field:type_name -> db_service.db_DTakoRowsSortField
+	20,  // 36: db_service.db_DTakoRowsSortSpec.direction:type_name -> db_service.db_SortDirection
+	88,  // 37: db_service.db_ListDTakoRowsRequest.sort:type_name -> db_service.db_DTakoRowsSortSpec
+	72,  // 38: db_service.db_DTakoRowsResponse.dtako_rows:type_name -> db_service.db_DTakoRows
+	72,  // 39: db_service.db_ListDTakoRowsResponse.items:type_name -> db_service.db_DTakoRows
+	73,  // 40: db_service.db_ETCNumOverlap.first:type_name -> db_service.db_ETCNum
+	73,  // 41: db_service.db_ETCNumOverlap.second:type_name -> db_service.db_ETCNum
+	98,  // 42: db_service.db_ListETCNumOverlapsResponse.overlaps:type_name -> db_service.db_ETCNumOverlap
+	73,  // 43: db_service.db_ListETCNumResponse.items:type_name -> db_service.db_ETCNum
+	102, // 44: db_service.db_DTakoFerryRowsProdResponse.dtako_ferry_rows:type_name -> db_service.db_DTakoFerryRowsProd
+	102, // 45: db_service.db_ListDTakoFerryRowsProdResponse.items:type_name -> db_service.db_DTakoFerryRowsProd
+	5,   // 46: db_service.db_CarsSortSpec.field:type_name -> db_service.db_CarsSortField
+	20,  // 47: db_service.db_CarsSortSpec.direction:type_name -> db_service.db_SortDirection
+	112, // 48: db_service.db_ListCarsRequest.sort:type_name -> db_service.db_CarsSortSpec
+	108, // 49: db_service.db_CarsResponse.cars:type_name -> db_service.db_Cars
+	108, // 50: db_service.db_ListCarsResponse.items:type_name -> db_service.db_Cars
+	6,   // 51: db_service.db_DriversSortSpec.field:type_name -> db_service.db_DriversSortField
+	20,  // 52: db_service.db_DriversSortSpec.direction:type_name -> db_service.db_SortDirection
+	118, // 53: db_service.db_ListDriversRequest.sort:type_name -> db_service.db_DriversSortSpec
+	109, // 54: db_service.db_DriversResponse.drivers:type_name -> db_service.db_Drivers
+	109, // 55: db_service.db_ListDriversResponse.items:type_name -> db_service.db_Drivers
+	7,   // 56: db_service.db_GetUntenNippoMeisaiByBumonRequest.field:type_name -> db_service.db_UntenNippoMeisaiBumonField
+	8,   // 57: db_service.db_UntenNippoMeisaiSortSpec.field:type_name -> db_service.db_UntenNippoMeisaiSortField
+	20,  // 58: db_service.db_UntenNippoMeisaiSortSpec.direction:type_name -> db_service.db_SortDirection
+	134, // 59: db_service.db_ListUntenNippoMeisaiRequest.sort:type_name -> db_service.db_UntenNippoMeisaiSortSpec
+	122, // 60: db_service.db_UntenNippoMeisaiResponse.unten_nippo_meisai:type_name -> db_service.db_UntenNippoMeisai
+	122, // 61: db_service.db_ListUntenNippoMeisaiResponse.items:type_name -> db_service.db_UntenNippoMeisai
+	9,   // 62: db_service.db_ShainMasterSortSpec.field:type_name -> db_service.db_ShainMasterSortField
+	20,  // 63: db_service.db_ShainMasterSortSpec.direction:type_name -> db_service.db_SortDirection
+	141, // 64: db_service.db_ListShainMasterRequest.sort:type_name -> db_service.db_ShainMasterSortSpec
+	123, // 65: db_service.db_ShainMasterResponse.shain_master:type_name -> db_service.db_ShainMaster
+	123, // 66: db_service.db_ListShainMasterResponse.items:type_name -> db_service.db_ShainMaster
+	10,  // 67: db_service.db_ChiikiMasterSortSpec.field:type_name -> db_service.db_ChiikiMasterSortField
+	20,  // 68: db_service.db_ChiikiMasterSortSpec.direction:type_name -> db_service.db_SortDirection
+	146, // 69: db_service.db_ListChiikiMasterRequest.sort:type_name -> db_service.db_ChiikiMasterSortSpec
+	124, // 70: db_service.db_ChiikiMasterResponse.chiiki_master:type_name -> db_service.db_ChiikiMaster
+	124, // 71: db_service.db_ListChiikiMasterResponse.items:type_name -> db_service.db_ChiikiMaster
+	11,  // 72: db_service.db_ChikuMasterSortSpec.field:type_name -> db_service.db_ChikuMasterSortField
+	20,  // 73: db_service.db_ChikuMasterSortSpec.direction:type_name -> db_service.db_SortDirection
+	152, // 74: db_service.db_ListChikuMasterRequest.sort:type_name -> db_service.db_ChikuMasterSortSpec
+	125, // 75: db_service.db_ChikuMasterResponse.chiku_master:type_name -> db_service.db_ChikuMaster
+	125, // 76: db_service.db_ListChikuMasterResponse.items:type_name -> db_service.db_ChikuMaster
+	12,  // 77: db_service.db_TokuisakiMasterSortSpec.field:type_name -> db_service.db_TokuisakiMasterSortField
+	20,  // 78: db_service.db_TokuisakiMasterSortSpec.direction:type_name -> db_service.db_SortDirection
+	157, // 79: db_service.db_ListTokuisakiMasterRequest.sort:type_name -> db_service.db_TokuisakiMasterSortSpec
+	126, // 80: db_service.db_TokuisakiMasterResponse.tokuisaki_master:type_name -> db_service.db_TokuisakiMaster
+	126, // 81: db_service.db_ListTokuisakiMasterResponse.items:type_name -> db_service.db_TokuisakiMaster
+	127, // 82: db_service.db_TokuisakiTekiyobiResponse.tokuisaki_tekiyobi:type_name -> db_service.db_TokuisakiTekiyobiMaster
+	127, // 83: db_service.db_ListTokuisakiTekiyobiResponse.items:type_name -> db_service.db_TokuisakiTekiyobiMaster
+	13,  // 84: db_service.db_HinmeiMasterSortSpec.field:type_name -> db_service.db_HinmeiMasterSortField
+	20,  // 85: db_service.db_HinmeiMasterSortSpec.direction:type_name -> db_service.db_SortDirection
+	167, // 86: db_service.db_ListHinmeiMasterRequest.sort:type_name -> db_service.db_HinmeiMasterSortSpec
+	128, // 87: db_service.db_HinmeiMasterResponse.hinmei_master:type_name -> db_service.db_HinmeiMaster
+	128, // 88: db_service.db_ListHinmeiMasterResponse.items:type_name -> db_service.db_HinmeiMaster
+	129, // 89: db_service.db_ListTokuisakiHinmeiResponse.items:type_name -> db_service.db_TokuisakiHinmeiMaster
+	129, // 90: db_service.db_TokuisakiHinmeiResponse.tokuisaki_hinmei:type_name -> db_service.db_TokuisakiHinmeiMaster
+	14,  // 91: db_service.db_BumonMasterSortSpec.field:type_name -> db_service.db_BumonMasterSortField
+	20,  // 92: db_service.db_BumonMasterSortSpec.direction:type_name -> db_service.db_SortDirection
+	180, // 93: db_service.db_ListBumonMasterRequest.sort:type_name -> db_service.db_BumonMasterSortSpec
+	178, // 94: db_service.db_BumonMasterResponse.bumon_master:type_name -> db_service.db_BumonMaster
+	178, // 95: db_service.db_ListBumonMasterResponse.items:type_name -> db_service.db_BumonMaster
+	15,  // 96: db_service.db_SharyoMasterSortSpec.field:type_name -> db_service.db_SharyoMasterSortField
+	20,  // 97: db_service.db_SharyoMasterSortSpec.direction:type_name -> db_service.db_SortDirection
+	187, // 98: db_service.db_ListSharyoMasterRequest.sort:type_name -> db_service.db_SharyoMasterSortSpec
+	184, // 99: db_service.db_SharyoMasterResponse.sharyo_master:type_name -> db_service.db_SharyoMaster
+	184, // 100: db_service.db_ListSharyoMasterResponse.items:type_name -> db_service.db_SharyoMaster
+	108, // 101: db_service.db_VehicleIdentity.car:type_name -> db_service.db_Cars
+	70,  // 102: db_service.db_VehicleIdentity.dtako_cars:type_name -> db_service.db_DTakoCars
+	73,  // 103: db_service.db_VehicleIdentity.etc_nums:type_name -> db_service.db_ETCNum
+	184, // 104: db_service.db_VehicleIdentity.sharyo_masters:type_name -> db_service.db_SharyoMaster
+	16,  // 105: db_service.db_VehicleIdentity.missing:type_name -> db_service.db_VehicleSystem
+	192, // 106: db_service.db_VehicleIdentityResponse.identity:type_name -> db_service.db_VehicleIdentity
+	73,  // 107: db_service.db_VehicleIdentityResponse.active_etc_nums:type_name -> db_service.db_ETCNum
+	16,  // 108: db_service.db_ListVehicleIdentityGapsRequest.missing:type_name -> db_service.db_VehicleSystem
+	16,  // 109: db_service.db_ListVehicleIdentityGapsResponse.systems:type_name -> db_service.db_VehicleSystem
+	192, // 110: db_service.db_ListVehicleIdentityGapsResponse.items:type_name -> db_service.db_VehicleIdentity
+	17,  // 111: db_service.db_TimeCardSortSpec.field:type_name -> db_service.db_TimeCardSortField
+	20,  // 112: db_service.db_TimeCardSortSpec.direction:type_name -> db_service.db_SortDirection
+	198, // 113: db_service.db_ListTimeCardRequest.sort:type_name -> db_service.db_TimeCardSortSpec
+	196, // 114: db_service.db_TimeCardResponse.time_card:type_name -> db_service.db_TimeCard
+	196, // 115: db_service.db_ListTimeCardResponse.items:type_name -> db_service.db_TimeCard
+	196, // 116: db_service.db_CreateTimeCardRequest.time_card:type_name -> db_service.db_TimeCard
+	196, // 117: db_service.db_UpdateTimeCardRequest.time_card:type_name -> db_service.db_TimeCard
+	205, // 118: db_service.db_CreateTimeCardLogRequest.log:type_name -> db_service.db_TimeCardLog
+	205, // 119: db_service.db_UpdateTimeCardLogRequest.log:type_name -> db_service.db_TimeCardLog
+	18,  // 120: db_service.db_TimeCardLogSortSpec.field:type_name -> db_service.db_TimeCardLogSortField
+	20,  // 121: db_service.db_TimeCardLogSortSpec.direction:type_name -> db_service.db_SortDirection
+	210, // 122: db_service.db_ListTimeCardLogRequest.sort:type_name -> db_service.db_TimeCardLogSortSpec
+	205, // 123: db_service.db_TimeCardLogResponse.log:type_name -> db_service.db_TimeCardLog
+	205, // 124: db_service.db_ListTimeCardLogResponse.items:type_name -> db_service.db_TimeCardLog
+	215, // 125: db_service.db_CreateEmployeeIdentityRequest.employee:type_name -> db_service.db_EmployeeIdentity
+	215, // 126: db_service.db_UpdateEmployeeIdentityRequest.employee:type_name -> db_service.db_EmployeeIdentity
+	215, // 127: db_service.db_EmployeeIdentityResponse.employee:type_name -> db_service.db_EmployeeIdentity
+	216, // 128: db_service.db_EmployeeIdentityResponse.cards:type_name -> db_service.db_EmployeeCard
+	215, // 129: db_service.db_ListEmployeeIdentityResponse.items:type_name -> db_service.db_EmployeeIdentity
+	215, // 130: db_service.db_ResolveEmployeeIdentityResponse.employee:type_name -> db_service.db_EmployeeIdentity
+	216, // 131: db_service.db_ResolveEmployeeIdentityResponse.cards:type_name -> db_service.db_EmployeeCard
+	109, // 132: db_service.db_ResolveEmployeeIdentityResponse.driver:type_name -> db_service.db_Drivers
+	123, // 133: db_service.db_ResolveEmployeeIdentityResponse.shain:type_name -> db_service.db_ShainMaster
+	216, // 134: db_service.db_EmployeeCardResponse.card:type_name -> db_service.db_EmployeeCard
+	19,  // 135: db_service.db_UnlinkedEmployeeCode.source:type_name -> db_service.db_EmployeeCodeSource
+	19,  // 136: db_service.db_ListUnlinkedEmployeeCodesRequest.sources:type_name -> db_service.db_EmployeeCodeSource
+	19,  // 137: db_service.db_SkippedEmployeeCodeSource.source:type_name -> db_service.db_EmployeeCodeSource
+	19,  // 138: db_service.db_ListUnlinkedEmployeeCodesResponse.sources:type_name -> db_service.db_EmployeeCodeSource
+	229, // 139: db_service.db_ListUnlinkedEmployeeCodesResponse.items:type_name -> db_service.db_UnlinkedEmployeeCode
+	231, // 140: db_service.db_ListUnlinkedEmployeeCodesResponse.skipped_sources:type_name -> db_service.db_SkippedEmployeeCodeSource
+	233, // 141: db_service.db_GetAvailabilityResponse.backends:type_name -> db_service.db_BackendStatus
+	24,  // 142: db_service.db_DTakoUriageKeihiService.Create:input_type -> db_service.db_CreateDTakoUriageKeihiRequest
+	25,  // 143: db_service.db_DTakoUriageKeihiService.Get:input_type -> db_service.db_GetDTakoUriageKeihiRequest
+	26,  // 144: db_service.db_DTakoUriageKeihiService.Update:input_type -> db_service.db_UpdateDTakoUriageKeihiRequest
+	27,  // 145: db_service.db_DTakoUriageKeihiService.Delete:input_type -> db_service.db_DeleteDTakoUriageKeihiRequest
+	28,  // 146: db_service.db_DTakoUriageKeihiService.List:input_type -> db_service.db_ListDTakoUriageKeihiRequest
+	31,  // 147: db_service.db_ETCMeisaiService.Create:input_type -> db_service.db_CreateETCMeisaiRequest
+	32,  // 148: db_service.db_ETCMeisaiService.Get:input_type -> db_service.db_GetETCMeisaiRequest
+	33,  // 149: db_service.db_ETCMeisaiService.Update:input_type -> db_service.db_UpdateETCMeisaiRequest
+	34,  // 150: db_service.db_ETCMeisaiService.Delete:input_type -> db_service.db_DeleteETCMeisaiRequest
+	35,  // 151: db_service.db_ETCMeisaiService.List:input_type -> db_service.db_ListETCMeisaiRequest
+	36,  // 152: db_service.db_ETCMeisaiService.Stream:input_type -> db_service.db_StreamETCMeisaiRequest
+	38,  // 153: db_service.db_ETCMeisaiService.BatchCreate:input_type -> db_service.db_BatchCreateETCMeisaiRequest
+	38,  // 154: db_service.db_ETCMeisaiService.BatchCreateStream:input_type -> db_service.db_BatchCreateETCMeisaiRequest
+	41,  // 155: db_service.db_ETCMeisaiService.Import:input_type -> db_service.db_ImportETCMeisaiRequest
+	44,  // 156: db_service.db_DTakoFerryRowsService.Create:input_type -> db_service.db_CreateDTakoFerryRowsRequest
+	45,  // 157: db_service.db_DTakoFerryRowsService.Get:input_type -> db_service.db_GetDTakoFerryRowsRequest
+	46,  // 158: db_service.db_DTakoFerryRowsService.Update:input_type -> db_service.db_UpdateDTakoFerryRowsRequest
+	47,  // 159: db_service.db_DTakoFerryRowsService.Delete:input_type -> db_service.db_DeleteDTakoFerryRowsRequest
+	48,  // 160: db_service.db_DTakoFerryRowsService.List:input_type -> db_service.db_ListDTakoFerryRowsRequest
+	52,  // 161: db_service.db_ETCMeisaiMappingService.Create:input_type -> db_service.db_CreateETCMeisaiMappingRequest
+	53,  // 162: db_service.db_ETCMeisaiMappingService.Get:input_type -> db_service.db_GetETCMeisaiMappingRequest
+	54,  // 163: db_service.db_ETCMeisaiMappingService.Update:input_type -> db_service.db_UpdateETCMeisaiMappingRequest
+	55,  // 164: db_service.db_ETCMeisaiMappingService.Delete:input_type -> db_service.db_DeleteETCMeisaiMappingRequest
+	56,  // 165: db_service.db_ETCMeisaiMappingService.List:input_type -> db_service.db_ListETCMeisaiMappingRequest
+	59,  // 166: db_service.db_ETCMeisaiMappingService.GetDTakoRowIDByHash:input_type -> db_service.db_GetDTakoRowIDByHashRequest
+	61,  // 167: db_service.db_ETCMeisaiMappingService.BulkReplace:input_type -> db_service.db_BulkReplaceETCMeisaiMappingRequest
+	66,  // 168: db_service.db_ETCMeisaiMatcherService.AutoMatch:input_type -> db_service.db_AutoMatchETCMeisaiRequest
+	63,  // 169: db_service.db_ETCMeisaiMappingAuditService.Audit:input_type -> db_service.db_AuditETCMeisaiMappingRequest
+	74,  // 170: db_service.db_DTakoCarsService.Get:input_type -> db_service.db_GetDTakoCarsRequest
+	76,  // 171: db_service.db_DTakoCarsService.List:input_type -> db_service.db_ListDTakoCarsRequest
+	75,  // 172: db_service.db_DTakoCarsService.GetByCarCode:input_type -> db_service.db_GetDTakoCarsByCarCodeRequest
+	79,  // 173: db_service.db_DTakoEventsService.Get:input_type -> db_service.db_GetDTakoEventsRequest
+	82,  // 174: db_service.db_DTakoEventsService.List:input_type -> db_service.db_ListDTakoEventsRequest
+	83,  // 175: db_service.db_DTakoEventsService.Stream:input_type -> db_service.db_StreamDTakoEventsRequest
+	80,  // 176: db_service.db_DTakoEventsService.GetByOperationNo:input_type -> db_service.db_GetDTakoEventsByOperationNoRequest
+	86,  // 177: db_service.db_DTakoRowsService.Get:input_type -> db_service.db_GetDTakoRowsRequest
+	89,  // 178: db_service.db_DTakoRowsService.List:input_type -> db_service.db_ListDTakoRowsRequest
+	90,  // 179: db_service.db_DTakoRowsService.Stream:input_type -> db_service.db_StreamDTakoRowsRequest
+	87,  // 180: db_service.db_DTakoRowsService.GetByOperationNo:input_type -> db_service.db_GetDTakoRowsByOperationNoRequest
+	100, // 181: db_service.db_ETCNumService.List:input_type -> db_service.db_ListETCNumRequest
+	93,  // 182: db_service.db_ETCNumService.GetByETCCardNum:input_type -> db_service.db_GetETCNumByETCCardNumRequest
+	94,  // 183: db_service.db_ETCNumService.GetByCarID:input_type -> db_service.db_GetETCNumByCarIDRequest
+	95,  // 184: db_service.db_ETCNumService.GetByETCCardNumAt:input_type -> db_service.db_GetETCNumByETCCardNumAtRequest
+	96,  // 185: db_service.db_ETCNumService.GetByCarIDAt:input_type -> db_service.db_GetETCNumByCarIDAtRequest
+	97,  // 186: db_service.db_ETCNumService.ListOverlaps:input_type -> db_service.db_ListETCNumOverlapsRequest
+	103, // 187: db_service.db_DTakoFerryRowsProdService.Get:input_type -> db_service.db_GetDTakoFerryRowsProdRequest
+	105, // 188: db_service.db_DTakoFerryRowsProdService.List:input_type -> db_service.db_ListDTakoFerryRowsProdRequest
+	104, // 189: db_service.db_DTakoFerryRowsProdService.GetByUnkoNo:input_type -> db_service.db_GetDTakoFerryRowsProdByUnkoNoRequest
+	110, // 190: db_service.db_CarsService.Get:input_type -> db_service.db_GetCarsRequest
+	113, // 191: db_service.db_CarsService.List:input_type -> db_service.db_ListCarsRequest
+	111, // 192: db_service.db_CarsService.GetByBumonCodeID:input_type -> db_service.db_GetCarsByBumonCodeIDRequest
+	116, // 193: db_service.db_DriversService.Get:input_type -> db_service.db_GetDriversRequest
+	119, // 194: db_service.db_DriversService.List:input_type -> db_service.db_ListDriversRequest
+	117, // 195: db_service.db_DriversService.GetByBumon:input_type -> db_service.db_GetDriversByBumonRequest
+	130, // 196: db_service.db_UntenNippoMeisaiService.Get:input_type -> db_service.db_GetUntenNippoMeisaiRequest
+	135, // 197: db_service.db_UntenNippoMeisaiService.List:input_type -> db_service.db_ListUntenNippoMeisaiRequest
+	136, // 198: db_service.db_UntenNippoMeisaiService.Stream:input_type -> db_service.db_StreamUntenNippoMeisaiRequest
+	131, // 199: db_service.db_UntenNippoMeisaiService.GetBySharyoC:input_type -> db_service.db_GetUntenNippoMeisaiBySharyoCRequest
+	132, // 200: db_service.db_UntenNippoMeisaiService.GetByDateRange:input_type -> db_service.db_GetUntenNippoMeisaiByDateRangeRequest
+	133, // 201: db_service.db_UntenNippoMeisaiService.GetByBumon:input_type -> db_service.db_GetUntenNippoMeisaiByBumonRequest
+	139, // 202: db_service.db_ShainMasterService.Get:input_type -> db_service.db_GetShainMasterRequest
+	142, // 203: db_service.db_ShainMasterService.List:input_type -> db_service.db_ListShainMasterRequest
+	140, // 204: db_service.db_ShainMasterService.GetByBumonC:input_type -> db_service.db_GetShainMasterByBumonCRequest
+	145, // 205: db_service.db_ChiikiMasterService.Get:input_type -> db_service.db_GetChiikiMasterRequest
+	147, // 206: db_service.db_ChiikiMasterService.List:input_type -> db_service.db_ListChiikiMasterRequest
+	150, // 207: db_service.db_ChikuMasterService.Get:input_type -> db_service.db_GetChikuMasterRequest
+	153, // 208: db_service.db_ChikuMasterService.List:input_type -> db_service.db_ListChikuMasterRequest
+	151, // 209: db_service.db_ChikuMasterService.GetByChiikiC:input_type -> db_service.db_GetChikuMasterByChiikiCRequest
+	156, // 210: db_service.db_TokuisakiMasterService.Get:input_type -> db_service.db_GetTokuisakiMasterRequest
+	158, // 211: db_service.db_TokuisakiMasterService.List:input_type -> db_service.db_ListTokuisakiMasterRequest
+	159, // 212: db_service.db_TokuisakiMasterService.Search:input_type -> db_service.db_SearchTokuisakiMasterRequest
+	162, // 213: db_service.db_TokuisakiMasterService.ListTekiyobi:input_type -> db_service.db_ListTokuisakiTekiyobiRequest
+	163, // 214: db_service.db_TokuisakiMasterService.GetTekiyobiAt:input_type -> db_service.db_GetTokuisakiTekiyobiAtRequest
+	166, // 215: db_service.db_HinmeiMasterService.Get:input_type -> db_service.db_GetHinmeiMasterRequest
+	168, // 216: db_service.db_HinmeiMasterService.List:input_type -> db_service.db_ListHinmeiMasterRequest
+	169, // 217: db_service.db_HinmeiMasterService.Search:input_type -> db_service.db_SearchHinmeiMasterRequest
+	172, // 218: db_service.db_HinmeiMasterService.ListTokuisakiHinmei:input_type -> db_service.db_ListTokuisakiHinmeiRequest
+	174, // 219: db_service.db_HinmeiMasterService.GetTokuisakiHinmei:input_type -> db_service.db_GetTokuisakiHinmeiRequest
+	176, // 220: db_service.db_HinmeiMasterService.Resolve:input_type -> db_service.db_ResolveHinmeiRequest
+	179, // 221: db_service.db_BumonMasterService.Get:input_type -> db_service.db_GetBumonMasterRequest
+	181, // 222: db_service.db_BumonMasterService.List:input_type -> db_service.db_ListBumonMasterRequest
+	185, // 223: db_service.db_SharyoMasterService.Get:input_type -> db_service.db_GetSharyoMasterRequest
+	186, // 224: db_service.db_SharyoMasterService.ListBySharyoC:input_type -> db_service.db_ListSharyoMasterBySharyoCRequest
+	188, // 225: db_service.db_SharyoMasterService.List:input_type -> db_service.db_ListSharyoMasterRequest
+	191, // 226: db_service.db_VehicleIdentityService.Get:input_type -> db_service.db_GetVehicleIdentityRequest
+	194, // 227: db_service.db_VehicleIdentityService.ListGaps:input_type -> db_service.db_ListVehicleIdentityGapsRequest
+	197, // 228: db_service.db_TimeCardService.Get:input_type -> db_service.db_GetTimeCardRequest
+	199, // 229: db_service.db_TimeCardService.List:input_type -> db_service.db_ListTimeCardRequest
+	202, // 230: db_service.db_TimeCardDevService.Create:input_type -> db_service.db_CreateTimeCardRequest
+	197, // 231: db_service.db_TimeCardDevService.Get:input_type -> db_service.db_GetTimeCardRequest
+	203, // 232: db_service.db_TimeCardDevService.Update:input_type -> db_service.db_UpdateTimeCardRequest
+	204, // 233: db_service.db_TimeCardDevService.Delete:input_type -> db_service.db_DeleteTimeCardRequest
+	199, // 234: db_service.db_TimeCardDevService.List:input_type -> db_service.db_ListTimeCardRequest
+	206, // 235: db_service.db_TimeCardLogService.Create:input_type -> db_service.db_CreateTimeCardLogRequest
+	207, // 236: db_service.db_TimeCardLogService.Get:input_type -> db_service.db_GetTimeCardLogRequest
+	208, // 237: db_service.db_TimeCardLogService.Update:input_type -> db_service.db_UpdateTimeCardLogRequest
+	209, // 238: db_service.db_TimeCardLogService.Delete:input_type -> db_service.db_DeleteTimeCardLogRequest
+	211, // 239: db_service.db_TimeCardLogService.List:input_type -> db_service.db_ListTimeCardLogRequest
+	212, // 240: db_service.db_TimeCardLogService.GetByCardID:input_type -> db_service.db_GetByCardIDRequest
+	217, // 241: db_service.db_EmployeeIdentityService.Create:input_type -> db_service.db_CreateEmployeeIdentityRequest
+	218, // 242: db_service.db_EmployeeIdentityService.Get:input_type -> db_service.db_GetEmployeeIdentityRequest
+	219, // 243: db_service.db_EmployeeIdentityService.Update:input_type -> db_service.db_UpdateEmployeeIdentityRequest
+	220, // 244: db_service.db_EmployeeIdentityService.Delete:input_type -> db_service.db_DeleteEmployeeIdentityRequest
+	221, // 245: db_service.db_EmployeeIdentityService.List:input_type -> db_service.db_ListEmployeeIdentityRequest
+	224, // 246: db_service.db_EmployeeIdentityService.Resolve:input_type -> db_service.db_ResolveEmployeeIdentityRequest
+	226, // 247: db_service.db_EmployeeIdentityService.RegisterCard:input_type -> db_service.db_RegisterEmployeeCardRequest
+	228, // 248: db_service.db_EmployeeIdentityService.UnregisterCard:input_type -> db_service.db_UnregisterEmployeeCardRequest
+	230, // 249: db_service.db_EmployeeIdentityService.ListUnlinked:input_type -> db_service.db_ListUnlinkedEmployeeCodesRequest
+	234, // 250: db_service.db_RegistryService.GetAvailability:input_type -> db_service.db_GetAvailabilityRequest
+	29,  // 251: db_service.db_DTakoUriageKeihiService.Create:output_type -> db_service.db_DTakoUriageKeihiResponse
+	29,  // 252: db_service.db_DTakoUriageKeihiService.Get:output_type -> db_service.db_DTakoUriageKeihiResponse
+	29,  // 253: db_service.db_DTakoUriageKeihiService.Update:output_type -> db_service.db_DTakoUriageKeihiResponse
+	236, // 254: db_service.db_DTakoUriageKeihiService.Delete:output_type -> db_service.db_Empty
+	30,  // 255: db_service.db_DTakoUriageKeihiService.List:output_type -> db_service.db_ListDTakoUriageKeihiResponse
+	37,  // 256: db_service.db_ETCMeisaiService.Create:output_type -> db_service.db_ETCMeisaiResponse
+	37,  // 257: db_service.db_ETCMeisaiService.Get:output_type -> db_service.db_ETCMeisaiResponse
+	37,  // 258: db_service.db_ETCMeisaiService.Update:output_type -> db_service.db_ETCMeisaiResponse
+	236, // 259: db_service.db_ETCMeisaiService.Delete:output_type -> db_service.db_Empty
+	43,  // 260: db_service.db_ETCMeisaiService.List:output_type -> db_service.db_ListETCMeisaiResponse
+	22,  // 261: db_service.db_ETCMeisaiService.Stream:output_type -> db_service.db_ETCMeisai
+	40,  // 262: db_service.db_ETCMeisaiService.BatchCreate:output_type -> db_service.db_BatchCreateETCMeisaiResponse
+	40,  // 263: db_service.db_ETCMeisaiService.BatchCreateStream:output_type -> db_service.db_BatchCreateETCMeisaiResponse
+	42,  // 264: db_service.db_ETCMeisaiService.Import:output_type -> db_service.db_ImportETCMeisaiResponse
+	49,  // 265: db_service.db_DTakoFerryRowsService.Create:output_type -> db_service.db_DTakoFerryRowsResponse
+	49,  // 266: db_service.db_DTakoFerryRowsService.Get:output_type -> db_service.db_DTakoFerryRowsResponse
+	49,  // 267: db_service.db_DTakoFerryRowsService.Update:output_type -> db_service.db_DTakoFerryRowsResponse
+	236, // 268: db_service.db_DTakoFerryRowsService.Delete:output_type -> db_service.db_Empty
+	50,  // 269: db_service.db_DTakoFerryRowsService.List:output_type -> db_service.db_ListDTakoFerryRowsResponse
+	57,  // 270: db_service.db_ETCMeisaiMappingService.Create:output_type -> db_service.db_ETCMeisaiMappingResponse
+	57,  // 271: db_service.db_ETCMeisaiMappingService.Get:output_type -> db_service.db_ETCMeisaiMappingResponse
+	57,  // 272: db_service.db_ETCMeisaiMappingService.Update:output_type -> db_service.db_ETCMeisaiMappingResponse
+	236, // 273: db_service.db_ETCMeisaiMappingService.Delete:output_type -> db_service.db_Empty
+	58,  // 274: db_service.db_ETCMeisaiMappingService.List:output_type -> db_service.db_ListETCMeisaiMappingResponse
+	60,  // 275: db_service.db_ETCMeisaiMappingService.GetDTakoRowIDByHash:output_type -> db_service.db_GetDTakoRowIDByHashResponse
+	62,  // 276: db_service.db_ETCMeisaiMappingService.BulkReplace:output_type -> db_service.db_BulkReplaceETCMeisaiMappingResponse
+	69,  // 277: db_service.db_ETCMeisaiMatcherService.AutoMatch:output_type -> db_service.db_AutoMatchETCMeisaiResponse
+	65,  // 278: db_service.db_ETCMeisaiMappingAuditService.Audit:output_type -> db_service.db_AuditETCMeisaiMappingResponse
+	77,  // 279: db_service.db_DTakoCarsService.Get:output_type -> db_service.db_DTakoCarsResponse
+	78,  // 280: db_service.db_DTakoCarsService.List:output_type -> db_service.db_ListDTakoCarsResponse
+	77,  // 281: db_service.db_DTakoCarsService.GetByCarCode:output_type -> db_service.db_DTakoCarsResponse
+	84,  // 282: db_service.db_DTakoEventsService.Get:output_type -> db_service.db_DTakoEventsResponse
+	85,  // 283: db_service.db_DTakoEventsService.List:output_type -> db_service.db_ListDTakoEventsResponse
+	71,  // 284: db_service.db_DTakoEventsService.Stream:output_type -> db_service.db_DTakoEvents
+	85,  // 285: db_service.db_DTakoEventsService.GetByOperationNo:output_type -> db_service.db_ListDTakoEventsResponse
+	91,  // 286: db_service.db_DTakoRowsService.Get:output_type -> db_service.db_DTakoRowsResponse
+	92,  // 287: db_service.db_DTakoRowsService.List:output_type -> db_service.db_ListDTakoRowsResponse
+	72,  // 288: db_service.db_DTakoRowsService.Stream:output_type -> db_service.db_DTakoRows
+	92,  // 289: db_service.db_DTakoRowsService.GetByOperationNo:output_type -> db_service.db_ListDTakoRowsResponse
+	101, // 290: db_service.db_ETCNumService.List:output_type -> db_service.db_ListETCNumResponse
+	101, // 291: db_service.db_ETCNumService.GetByETCCardNum:output_type -> db_service.db_ListETCNumResponse
+	101, // 292: db_service.db_ETCNumService.GetByCarID:output_type -> db_service.db_ListETCNumResponse
+	101, // 293: db_service.db_ETCNumService.GetByETCCardNumAt:output_type -> db_service.db_ListETCNumResponse
+	101, // 294: db_service.db_ETCNumService.GetByCarIDAt:output_type -> db_service.db_ListETCNumResponse
+	99,  // 295: db_service.db_ETCNumService.ListOverlaps:output_type -> db_service.db_ListETCNumOverlapsResponse
+	106, // 296: db_service.db_DTakoFerryRowsProdService.Get:output_type -> db_service.db_DTakoFerryRowsProdResponse
+	107, // 297: db_service.db_DTakoFerryRowsProdService.List:output_type -> db_service.db_ListDTakoFerryRowsProdResponse
+	107, // 298: db_service.db_DTakoFerryRowsProdService.GetByUnkoNo:output_type -> db_service.db_ListDTakoFerryRowsProdResponse
+	114, // 299: db_service.db_CarsService.Get:output_type -> db_service.db_CarsResponse
+	115, // 300: db_service.db_CarsService.List:output_type -> db_service.db_ListCarsResponse
+	115, // 301: db_service.db_CarsService.GetByBumonCodeID:output_type -> db_service.db_ListCarsResponse
+	120, // 302: db_service.db_DriversService.Get:output_type -> db_service.db_DriversResponse
+	121, // 303: db_service.db_DriversService.List:output_type -> db_service.db_ListDriversResponse
+	121, // 304: db_service.db_DriversService.GetByBumon:output_type -> db_service.db_ListDriversResponse
+	137, // 305: db_service.db_UntenNippoMeisaiService.Get:output_type -> db_service.db_UntenNippoMeisaiResponse
+	138, // 306: db_service.db_UntenNippoMeisaiService.List:output_type -> db_service.db_ListUntenNippoMeisaiResponse
+	122, // 307: db_service.db_UntenNippoMeisaiService.Stream:output_type -> db_service.db_UntenNippoMeisai
+	138, // 308: db_service.db_UntenNippoMeisaiService.GetBySharyoC:output_type -> db_service.db_ListUntenNippoMeisaiResponse
+	138, // 309: db_service.db_UntenNippoMeisaiService.GetByDateRange:output_type -> db_service.db_ListUntenNippoMeisaiResponse
+	138, // 310: db_service.db_UntenNippoMeisaiService.GetByBumon:output_type -> db_service.db_ListUntenNippoMeisaiResponse
+	143, // 311: db_service.db_ShainMasterService.Get:output_type -> db_service.db_ShainMasterResponse
+	144, // 312: db_service.db_ShainMasterService.List:output_type -> db_service.db_ListShainMasterResponse
+	144, // 313: db_service.db_ShainMasterService.GetByBumonC:output_type -> db_service.db_ListShainMasterResponse
+	148, // 314: db_service.db_ChiikiMasterService.Get:output_type -> db_service.db_ChiikiMasterResponse
+	149, // 315: db_service.db_ChiikiMasterService.List:output_type -> db_service.db_ListChiikiMasterResponse
+	154, // 316: db_service.db_ChikuMasterService.Get:output_type -> db_service.db_ChikuMasterResponse
+	155, // 317: db_service.db_ChikuMasterService.List:output_type -> db_service.db_ListChikuMasterResponse
+	155, // 318: db_service.db_ChikuMasterService.GetByChiikiC:output_type -> db_service.db_ListChikuMasterResponse
+	160, // 319: db_service.db_TokuisakiMasterService.Get:output_type -> db_service.db_TokuisakiMasterResponse
+	161, // 320: db_service.db_TokuisakiMasterService.List:output_type -> db_service.db_ListTokuisakiMasterResponse
+	161, // 321: db_service.db_TokuisakiMasterService.Search:output_type -> db_service.db_ListTokuisakiMasterResponse
+	165, // 322: db_service.db_TokuisakiMasterService.ListTekiyobi:output_type -> db_service.db_ListTokuisakiTekiyobiResponse
+	164, // 323: db_service.db_TokuisakiMasterService.GetTekiyobiAt:output_type -> db_service.db_TokuisakiTekiyobiResponse
+	170, // 324: db_service.db_HinmeiMasterService.Get:output_type -> db_service.db_HinmeiMasterResponse
+	171, // 325: db_service.db_HinmeiMasterService.List:output_type -> db_service.db_ListHinmeiMasterResponse
+	171, // 326: db_service.db_HinmeiMasterService.Search:output_type -> db_service.db_ListHinmeiMasterResponse
+	173, // 327: db_service.db_HinmeiMasterService.ListTokuisakiHinmei:output_type -> db_service.db_ListTokuisakiHinmeiResponse
+	175, // 328: db_service.db_HinmeiMasterService.GetTokuisakiHinmei:output_type -> db_service.db_TokuisakiHinmeiResponse
+	177, // 329: db_service.db_HinmeiMasterService.Resolve:output_type -> db_service.db_ResolveHinmeiResponse
+	182, // 330: db_service.db_BumonMasterService.Get:output_type -> db_service.db_BumonMasterResponse
+	183, // 331: db_service.db_BumonMasterService.List:output_type -> db_service.db_ListBumonMasterResponse
+	189, // 332: db_service.db_SharyoMasterService.Get:output_type -> db_service.db_SharyoMasterResponse
+	190, // 333: db_service.db_SharyoMasterService.ListBySharyoC:output_type -> db_service.db_ListSharyoMasterResponse
+	190, // 334: db_service.db_SharyoMasterService.List:output_type -> db_service.db_ListSharyoMasterResponse
+	193, // 335: db_service.db_VehicleIdentityService.Get:output_type -> db_service.db_VehicleIdentityResponse
+	195, // 336: db_service.db_VehicleIdentityService.ListGaps:output_type -> db_service.db_ListVehicleIdentityGapsResponse
+	200, // 337: db_service.db_TimeCardService.Get:output_type -> db_service.db_TimeCardResponse
+	201, // 338: db_service.db_TimeCardService.List:output_type -> db_service.db_ListTimeCardResponse
+	200, // 339: db_service.db_TimeCardDevService.Create:output_type -> db_service.db_TimeCardResponse
+	200, // 340: db_service.db_TimeCardDevService.Get:output_type -> db_service.db_TimeCardResponse
+	200, // 341: db_service.db_TimeCardDevService.Update:output_type -> db_service.db_TimeCardResponse
+	236, // 342: db_service.db_TimeCardDevService.Delete:output_type -> db_service.db_Empty
+	201, // 343: db_service.db_TimeCardDevService.List:output_type -> db_service.db_ListTimeCardResponse
+	213, // 344: db_service.db_TimeCardLogService.Create:output_type -> db_service.db_TimeCardLogResponse
+	213, // 345: db_service.db_TimeCardLogService.Get:output_type -> db_service.db_TimeCardLogResponse
+	213, // 346: db_service.db_TimeCardLogService.Update:output_type -> db_service.db_TimeCardLogResponse
+	236, // 347: db_service.db_TimeCardLogService.Delete:output_type -> db_service.db_Empty
+	214, // 348: db_service.db_TimeCardLogService.List:output_type -> db_service.db_ListTimeCardLogResponse
+	214, // 349: db_service.db_TimeCardLogService.GetByCardID:output_type -> db_service.db_ListTimeCardLogResponse
+	222, // 350: db_service.db_EmployeeIdentityService.Create:output_type -> db_service.db_EmployeeIdentityResponse
+	222, // 351: db_service.db_EmployeeIdentityService.Get:output_type -> db_service.db_EmployeeIdentityResponse
+	222, // 352: db_service.db_EmployeeIdentityService.Update:output_type -> db_service.db_EmployeeIdentityResponse
+	236, // 353: db_service.db_EmployeeIdentityService.Delete:output_type -> db_service.db_Empty
+	223, // 354: db_service.db_EmployeeIdentityService.List:output_type -> db_service.db_ListEmployeeIdentityResponse
+	225, // 355: db_service.db_EmployeeIdentityService.Resolve:output_type -> db_service.db_ResolveEmployeeIdentityResponse
+	227, // 356: db_service.db_EmployeeIdentityService.RegisterCard:output_type -> db_service.db_EmployeeCardResponse
+	236, // 357: db_service.db_EmployeeIdentityService.UnregisterCard:output_type -> db_service.db_Empty
+	232, // 358: db_service.db_EmployeeIdentityService.ListUnlinked:output_type -> db_service.db_ListUnlinkedEmployeeCodesResponse
+	235, // 359: db_service.db_RegistryService.GetAvailability:output_type -> db_service.db_GetAvailabilityResponse
+	251, // [251:360] is the sub-list for method output_type
+	142, // [142:251] is the sub-list for method input_type
+	142, // [142:142] is the sub-list for extension type_name
+	142, // [142:142] is the sub-list for extension extendee
+	0,   // [0:142] is the sub-list for field type_name
 }
 
 func init() { file_db_service_proto_init() }
@@ -10197,44 +19368,106 @@ func file_db_service_proto_init() {
 	file_db_service_proto_msgTypes[1].OneofWrappers = []any{}
 	file_db_service_proto_msgTypes[2].OneofWrappers = []any{}
 	file_db_service_proto_msgTypes[7].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[9].OneofWrappers = []any{}
 	file_db_service_proto_msgTypes[14].OneofWrappers = []any{}
-	file_db_service_proto_msgTypes[21].OneofWrappers = []any{}
-	file_db_service_proto_msgTypes[24].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[15].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[22].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[27].OneofWrappers = []any{}
 	file_db_service_proto_msgTypes[29].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[30].OneofWrappers = []any{}
 	file_db_service_proto_msgTypes[35].OneofWrappers = []any{}
-	file_db_service_proto_msgTypes[36].OneofWrappers = []any{}
 	file_db_service_proto_msgTypes[37].OneofWrappers = []any{}
-	file_db_service_proto_msgTypes[45].OneofWrappers = []any{}
 	file_db_service_proto_msgTypes[50].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[51].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[52].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[55].OneofWrappers = []any{}
 	file_db_service_proto_msgTypes[57].OneofWrappers = []any{}
-	file_db_service_proto_msgTypes[63].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[61].OneofWrappers = []any{}
 	file_db_service_proto_msgTypes[64].OneofWrappers = []any{}
-	file_db_service_proto_msgTypes[67].OneofWrappers = []any{}
-	file_db_service_proto_msgTypes[72].OneofWrappers = []any{}
-	file_db_service_proto_msgTypes[75].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[68].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[71].OneofWrappers = []any{}
 	file_db_service_proto_msgTypes[76].OneofWrappers = []any{}
 	file_db_service_proto_msgTypes[77].OneofWrappers = []any{}
-	file_db_service_proto_msgTypes[78].OneofWrappers = []any{}
-	file_db_service_proto_msgTypes[82].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[79].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[80].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[81].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[84].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[86].OneofWrappers = []any{}
 	file_db_service_proto_msgTypes[87].OneofWrappers = []any{}
-	file_db_service_proto_msgTypes[91].OneofWrappers = []any{}
-	file_db_service_proto_msgTypes[96].OneofWrappers = []any{}
-	file_db_service_proto_msgTypes[99].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[88].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[92].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[94].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[98].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[100].OneofWrappers = []any{}
 	file_db_service_proto_msgTypes[101].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[102].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[103].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[104].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[105].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[106].OneofWrappers = []any{}
 	file_db_service_proto_msgTypes[107].OneofWrappers = []any{}
-	file_db_service_proto_msgTypes[112].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[108].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[114].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[117].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[121].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[123].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[126].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[128].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[132].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[134].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[137].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[140].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[147].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[150].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[156].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[157].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[160].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[162].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[163].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[167].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[169].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[170].OneofWrappers = []any{
+		(*Db_GetVehicleIdentityRequest_CarId)(nil),
+		(*Db_GetVehicleIdentityRequest_Id4)(nil),
+		(*Db_GetVehicleIdentityRequest_CarCode)(nil),
+		(*Db_GetVehicleIdentityRequest_CarCc)(nil),
+		(*Db_GetVehicleIdentityRequest_EtcCarId)(nil),
+		(*Db_GetVehicleIdentityRequest_SharyoC)(nil),
+	}
+	file_db_service_proto_msgTypes[171].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[175].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[178].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[180].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[184].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[190].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[193].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[194].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[200].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[202].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[203].OneofWrappers = []any{
+		(*Db_ResolveEmployeeIdentityRequest_DriverId)(nil),
+		(*Db_ResolveEmployeeIdentityRequest_ShainC)(nil),
+		(*Db_ResolveEmployeeIdentityRequest_DtakoDriverCode)(nil),
+		(*Db_ResolveEmployeeIdentityRequest_UntenshuC)(nil),
+		(*Db_ResolveEmployeeIdentityRequest_TimeCardUserId)(nil),
+		(*Db_ResolveEmployeeIdentityRequest_CardId)(nil),
+	}
+	file_db_service_proto_msgTypes[204].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[208].OneofWrappers = []any{}
+	file_db_service_proto_msgTypes[212].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_db_service_proto_rawDesc), len(file_db_service_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   117,
+			NumEnums:      21,
+			NumMessages:   216,
 			NumExtensions: 0,
-			NumServices:   18,
+			NumServices:   27,
 		},
 		GoTypes:           file_db_service_proto_goTypes,
 		DependencyIndexes: file_db_service_proto_depIdxs,
+		EnumInfos:         file_db_service_proto_enumTypes,
 		MessageInfos:      file_db_service_proto_msgTypes,
 	}.Build()
 	File_db_service_proto = out.File
@@ -433,6 +433,88 @@ func local_request_Db_ETCMeisaiService_List_0(ctx context.Context, marshaler run
 	return msg, metadata, err
 }
 
+var filter_Db_ETCMeisaiService_Stream_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+
+func request_Db_ETCMeisaiService_Stream_0(ctx context.Context, marshaler runtime.Marshaler, client Db_ETCMeisaiServiceClient, req *http.Request, pathParams map[string]string) (Db_ETCMeisaiService_StreamClient, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_StreamETCMeisaiRequest
+		metadata runtime.ServerMetadata
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_ETCMeisaiService_Stream_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	stream, err := client.Stream(ctx, &protoReq)
+	if err != nil {
+		return nil, metadata, err
+	}
+	header, err := stream.Header()
+	if err != nil {
+		return nil, metadata, err
+	}
+	metadata.HeaderMD = header
+	return stream, metadata, nil
+}
+
+func request_Db_ETCMeisaiService_BatchCreate_0(ctx context.Context, marshaler runtime.Marshaler, client Db_ETCMeisaiServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_BatchCreateETCMeisaiRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	msg, err := client.BatchCreate(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_ETCMeisaiService_BatchCreate_0(ctx context.Context, marshaler runtime.Marshaler, server Db_ETCMeisaiServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_BatchCreateETCMeisaiRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.BatchCreate(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+func request_Db_ETCMeisaiService_Import_0(ctx context.Context, marshaler runtime.Marshaler, client Db_ETCMeisaiServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_ImportETCMeisaiRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	msg, err := client.Import(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_ETCMeisaiService_Import_0(ctx context.Context, marshaler runtime.Marshaler, server Db_ETCMeisaiServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_ImportETCMeisaiRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.Import(ctx, &protoReq)
+	return msg, metadata, err
+}
+
 func request_Db_DTakoFerryRowsService_Create_0(ctx context.Context, marshaler runtime.Marshaler, client Db_DTakoFerryRowsServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
 		protoReq Db_CreateDTakoFerryRowsRequest
@@ -618,6 +700,8 @@ func local_request_Db_DTakoFerryRowsService_List_0(ctx context.Context, marshale
 	return msg, metadata, err
 }
 
+var filter_Db_ETCMeisaiMappingService_Create_0 = &utilities.DoubleArray{Encoding: map[string]int{"etc_meisai_mapping": 0}, Base: []int{1, 1, 0}, Check: []int{0, 1, 2}}
+
 func request_Db_ETCMeisaiMappingService_Create_0(ctx context.Context, marshaler runtime.Marshaler, client Db_ETCMeisaiMappingServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
 		protoReq Db_CreateETCMeisaiMappingRequest
@@ -629,6 +713,12 @@ func request_Db_ETCMeisaiMappingService_Create_0(ctx context.Context, marshaler
 	if req.Body != nil {
 		_, _ = io.Copy(io.Discard, req.Body)
 	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_ETCMeisaiMappingService_Create_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
 	msg, err := client.Create(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
 	return msg, metadata, err
 }
@@ -641,6 +731,12 @@ func local_request_Db_ETCMeisaiMappingService_Create_0(ctx context.Context, mars
 	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq.EtcMeisaiMapping); err != nil && !errors.Is(err, io.EOF) {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_ETCMeisaiMappingService_Create_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
 	msg, err := server.Create(ctx, &protoReq)
 	return msg, metadata, err
 }
@@ -842,6 +938,113 @@ func local_request_Db_ETCMeisaiMappingService_GetDTakoRowIDByHash_0(ctx context.
 	return msg, metadata, err
 }
 
+func request_Db_ETCMeisaiMappingService_BulkReplace_0(ctx context.Context, marshaler runtime.Marshaler, client Db_ETCMeisaiMappingServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_BulkReplaceETCMeisaiMappingRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	val, ok := pathParams["dtako_row_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "dtako_row_id")
+	}
+	protoReq.DtakoRowId, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "dtako_row_id", err)
+	}
+	msg, err := client.BulkReplace(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_ETCMeisaiMappingService_BulkReplace_0(ctx context.Context, marshaler runtime.Marshaler, server Db_ETCMeisaiMappingServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_BulkReplaceETCMeisaiMappingRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	val, ok := pathParams["dtako_row_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "dtako_row_id")
+	}
+	protoReq.DtakoRowId, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "dtako_row_id", err)
+	}
+	msg, err := server.BulkReplace(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+func request_Db_ETCMeisaiMatcherService_AutoMatch_0(ctx context.Context, marshaler runtime.Marshaler, client Db_ETCMeisaiMatcherServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_AutoMatchETCMeisaiRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	msg, err := client.AutoMatch(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_ETCMeisaiMatcherService_AutoMatch_0(ctx context.Context, marshaler runtime.Marshaler, server Db_ETCMeisaiMatcherServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_AutoMatchETCMeisaiRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.AutoMatch(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+var filter_Db_ETCMeisaiMappingAuditService_Audit_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+
+func request_Db_ETCMeisaiMappingAuditService_Audit_0(ctx context.Context, marshaler runtime.Marshaler, client Db_ETCMeisaiMappingAuditServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_AuditETCMeisaiMappingRequest
+		metadata runtime.ServerMetadata
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_ETCMeisaiMappingAuditService_Audit_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := client.Audit(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_ETCMeisaiMappingAuditService_Audit_0(ctx context.Context, marshaler runtime.Marshaler, server Db_ETCMeisaiMappingAuditServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_AuditETCMeisaiMappingRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_ETCMeisaiMappingAuditService_Audit_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.Audit(ctx, &protoReq)
+	return msg, metadata, err
+}
+
 func request_Db_DTakoCarsService_Get_0(ctx context.Context, marshaler runtime.Marshaler, client Db_DTakoCarsServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
 		protoReq Db_GetDTakoCarsRequest
@@ -1029,6 +1232,36 @@ func local_request_Db_DTakoEventsService_List_0(ctx context.Context, marshaler r
 	return msg, metadata, err
 }
 
+var filter_Db_DTakoEventsService_Stream_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+
+func request_Db_DTakoEventsService_Stream_0(ctx context.Context, marshaler runtime.Marshaler, client Db_DTakoEventsServiceClient, req *http.Request, pathParams map[string]string) (Db_DTakoEventsService_StreamClient, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_StreamDTakoEventsRequest
+		metadata runtime.ServerMetadata
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_DTakoEventsService_Stream_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	stream, err := client.Stream(ctx, &protoReq)
+	if err != nil {
+		return nil, metadata, err
+	}
+	header, err := stream.Header()
+	if err != nil {
+		return nil, metadata, err
+	}
+	metadata.HeaderMD = header
+	return stream, metadata, nil
+}
+
+var filter_Db_DTakoEventsService_GetByOperationNo_0 = &utilities.DoubleArray{Encoding: map[string]int{"operation_no": 0}, Base: []int{1, 1, 0}, Check: []int{0, 1, 2}}
+
 func request_Db_DTakoEventsService_GetByOperationNo_0(ctx context.Context, marshaler runtime.Marshaler, client Db_DTakoEventsServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
 		protoReq Db_GetDTakoEventsByOperationNoRequest
@@ -1046,6 +1279,12 @@ func request_Db_DTakoEventsService_GetByOperationNo_0(ctx context.Context, marsh
 	if err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "operation_no", err)
 	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_DTakoEventsService_GetByOperationNo_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
 	msg, err := client.GetByOperationNo(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
 	return msg, metadata, err
 }
@@ -1064,6 +1303,12 @@ func local_request_Db_DTakoEventsService_GetByOperationNo_0(ctx context.Context,
 	if err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "operation_no", err)
 	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_DTakoEventsService_GetByOperationNo_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
 	msg, err := server.GetByOperationNo(ctx, &protoReq)
 	return msg, metadata, err
 }
@@ -1142,6 +1387,34 @@ func local_request_Db_DTakoRowsService_List_0(ctx context.Context, marshaler run
 	return msg, metadata, err
 }
 
+var filter_Db_DTakoRowsService_Stream_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+
+func request_Db_DTakoRowsService_Stream_0(ctx context.Context, marshaler runtime.Marshaler, client Db_DTakoRowsServiceClient, req *http.Request, pathParams map[string]string) (Db_DTakoRowsService_StreamClient, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_StreamDTakoRowsRequest
+		metadata runtime.ServerMetadata
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_DTakoRowsService_Stream_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	stream, err := client.Stream(ctx, &protoReq)
+	if err != nil {
+		return nil, metadata, err
+	}
+	header, err := stream.Header()
+	if err != nil {
+		return nil, metadata, err
+	}
+	metadata.HeaderMD = header
+	return stream, metadata, nil
+}
+
 func request_Db_DTakoRowsService_GetByOperationNo_0(ctx context.Context, marshaler runtime.Marshaler, client Db_DTakoRowsServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
 		protoReq Db_GetDTakoRowsByOperationNoRequest
@@ -1294,93 +1567,234 @@ func local_request_Db_ETCNumService_GetByCarID_0(ctx context.Context, marshaler
 	return msg, metadata, err
 }
 
-func request_Db_DTakoFerryRowsProdService_Get_0(ctx context.Context, marshaler runtime.Marshaler, client Db_DTakoFerryRowsProdServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+var filter_Db_ETCNumService_GetByETCCardNumAt_0 = &utilities.DoubleArray{Encoding: map[string]int{"etc_card_num": 0}, Base: []int{1, 1, 0}, Check: []int{0, 1, 2}}
+
+func request_Db_ETCNumService_GetByETCCardNumAt_0(ctx context.Context, marshaler runtime.Marshaler, client Db_ETCNumServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq Db_GetDTakoFerryRowsProdRequest
+		protoReq Db_GetETCNumByETCCardNumAtRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
 	if req.Body != nil {
 		_, _ = io.Copy(io.Discard, req.Body)
 	}
-	val, ok := pathParams["id"]
+	val, ok := pathParams["etc_card_num"]
 	if !ok {
-		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "id")
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "etc_card_num")
 	}
-	protoReq.Id, err = runtime.Int32(val)
+	protoReq.EtcCardNum, err = runtime.String(val)
 	if err != nil {
-		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "id", err)
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "etc_card_num", err)
 	}
-	msg, err := client.Get(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_ETCNumService_GetByETCCardNumAt_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := client.GetByETCCardNumAt(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
 	return msg, metadata, err
 }
 
-func local_request_Db_DTakoFerryRowsProdService_Get_0(ctx context.Context, marshaler runtime.Marshaler, server Db_DTakoFerryRowsProdServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+func local_request_Db_ETCNumService_GetByETCCardNumAt_0(ctx context.Context, marshaler runtime.Marshaler, server Db_ETCNumServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq Db_GetDTakoFerryRowsProdRequest
+		protoReq Db_GetETCNumByETCCardNumAtRequest
 		metadata runtime.ServerMetadata
 		err      error
 	)
-	val, ok := pathParams["id"]
+	val, ok := pathParams["etc_card_num"]
 	if !ok {
-		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "id")
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "etc_card_num")
 	}
-	protoReq.Id, err = runtime.Int32(val)
+	protoReq.EtcCardNum, err = runtime.String(val)
 	if err != nil {
-		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "id", err)
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "etc_card_num", err)
 	}
-	msg, err := server.Get(ctx, &protoReq)
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_ETCNumService_GetByETCCardNumAt_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.GetByETCCardNumAt(ctx, &protoReq)
 	return msg, metadata, err
 }
 
-var filter_Db_DTakoFerryRowsProdService_List_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+var filter_Db_ETCNumService_GetByCarIDAt_0 = &utilities.DoubleArray{Encoding: map[string]int{"car_id": 0}, Base: []int{1, 1, 0}, Check: []int{0, 1, 2}}
 
-func request_Db_DTakoFerryRowsProdService_List_0(ctx context.Context, marshaler runtime.Marshaler, client Db_DTakoFerryRowsProdServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+func request_Db_ETCNumService_GetByCarIDAt_0(ctx context.Context, marshaler runtime.Marshaler, client Db_ETCNumServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq Db_ListDTakoFerryRowsProdRequest
+		protoReq Db_GetETCNumByCarIDAtRequest
 		metadata runtime.ServerMetadata
+		err      error
 	)
 	if req.Body != nil {
 		_, _ = io.Copy(io.Discard, req.Body)
 	}
+	val, ok := pathParams["car_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "car_id")
+	}
+	protoReq.CarId, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "car_id", err)
+	}
 	if err := req.ParseForm(); err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
-	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_DTakoFerryRowsProdService_List_0); err != nil {
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_ETCNumService_GetByCarIDAt_0); err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
-	msg, err := client.List(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	msg, err := client.GetByCarIDAt(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
 	return msg, metadata, err
 }
 
-func local_request_Db_DTakoFerryRowsProdService_List_0(ctx context.Context, marshaler runtime.Marshaler, server Db_DTakoFerryRowsProdServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+func local_request_Db_ETCNumService_GetByCarIDAt_0(ctx context.Context, marshaler runtime.Marshaler, server Db_ETCNumServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq Db_ListDTakoFerryRowsProdRequest
+		protoReq Db_GetETCNumByCarIDAtRequest
 		metadata runtime.ServerMetadata
+		err      error
 	)
+	val, ok := pathParams["car_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "car_id")
+	}
+	protoReq.CarId, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "car_id", err)
+	}
 	if err := req.ParseForm(); err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
-	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_DTakoFerryRowsProdService_List_0); err != nil {
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_ETCNumService_GetByCarIDAt_0); err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
-	msg, err := server.List(ctx, &protoReq)
+	msg, err := server.GetByCarIDAt(ctx, &protoReq)
 	return msg, metadata, err
 }
 
-func request_Db_DTakoFerryRowsProdService_GetByUnkoNo_0(ctx context.Context, marshaler runtime.Marshaler, client Db_DTakoFerryRowsProdServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+var filter_Db_ETCNumService_ListOverlaps_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+
+func request_Db_ETCNumService_ListOverlaps_0(ctx context.Context, marshaler runtime.Marshaler, client Db_ETCNumServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
-		protoReq Db_GetDTakoFerryRowsProdByUnkoNoRequest
+		protoReq Db_ListETCNumOverlapsRequest
 		metadata runtime.ServerMetadata
-		err      error
 	)
 	if req.Body != nil {
 		_, _ = io.Copy(io.Discard, req.Body)
 	}
-	val, ok := pathParams["unko_no"]
-	if !ok {
-		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "unko_no")
-	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_ETCNumService_ListOverlaps_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := client.ListOverlaps(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_ETCNumService_ListOverlaps_0(ctx context.Context, marshaler runtime.Marshaler, server Db_ETCNumServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_ListETCNumOverlapsRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_ETCNumService_ListOverlaps_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.ListOverlaps(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+func request_Db_DTakoFerryRowsProdService_Get_0(ctx context.Context, marshaler runtime.Marshaler, client Db_DTakoFerryRowsProdServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_GetDTakoFerryRowsProdRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	val, ok := pathParams["id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "id")
+	}
+	protoReq.Id, err = runtime.Int32(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "id", err)
+	}
+	msg, err := client.Get(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_DTakoFerryRowsProdService_Get_0(ctx context.Context, marshaler runtime.Marshaler, server Db_DTakoFerryRowsProdServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_GetDTakoFerryRowsProdRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	val, ok := pathParams["id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "id")
+	}
+	protoReq.Id, err = runtime.Int32(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "id", err)
+	}
+	msg, err := server.Get(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+var filter_Db_DTakoFerryRowsProdService_List_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+
+func request_Db_DTakoFerryRowsProdService_List_0(ctx context.Context, marshaler runtime.Marshaler, client Db_DTakoFerryRowsProdServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_ListDTakoFerryRowsProdRequest
+		metadata runtime.ServerMetadata
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_DTakoFerryRowsProdService_List_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := client.List(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_DTakoFerryRowsProdService_List_0(ctx context.Context, marshaler runtime.Marshaler, server Db_DTakoFerryRowsProdServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_ListDTakoFerryRowsProdRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_DTakoFerryRowsProdService_List_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.List(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+func request_Db_DTakoFerryRowsProdService_GetByUnkoNo_0(ctx context.Context, marshaler runtime.Marshaler, client Db_DTakoFerryRowsProdServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_GetDTakoFerryRowsProdByUnkoNoRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	val, ok := pathParams["unko_no"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "unko_no")
+	}
 	protoReq.UnkoNo, err = runtime.String(val)
 	if err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "unko_no", err)
@@ -1739,6 +2153,34 @@ func local_request_Db_UntenNippoMeisaiService_List_0(ctx context.Context, marsha
 	return msg, metadata, err
 }
 
+var filter_Db_UntenNippoMeisaiService_Stream_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+
+func request_Db_UntenNippoMeisaiService_Stream_0(ctx context.Context, marshaler runtime.Marshaler, client Db_UntenNippoMeisaiServiceClient, req *http.Request, pathParams map[string]string) (Db_UntenNippoMeisaiService_StreamClient, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_StreamUntenNippoMeisaiRequest
+		metadata runtime.ServerMetadata
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_UntenNippoMeisaiService_Stream_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	stream, err := client.Stream(ctx, &protoReq)
+	if err != nil {
+		return nil, metadata, err
+	}
+	header, err := stream.Header()
+	if err != nil {
+		return nil, metadata, err
+	}
+	metadata.HeaderMD = header
+	return stream, metadata, nil
+}
+
 var filter_Db_UntenNippoMeisaiService_GetBySharyoC_0 = &utilities.DoubleArray{Encoding: map[string]int{"sharyo_c": 0}, Base: []int{1, 1, 0}, Check: []int{0, 1, 2}}
 
 func request_Db_UntenNippoMeisaiService_GetBySharyoC_0(ctx context.Context, marshaler runtime.Marshaler, client Db_UntenNippoMeisaiServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
@@ -1827,6 +2269,59 @@ func local_request_Db_UntenNippoMeisaiService_GetByDateRange_0(ctx context.Conte
 	return msg, metadata, err
 }
 
+var filter_Db_UntenNippoMeisaiService_GetByBumon_0 = &utilities.DoubleArray{Encoding: map[string]int{"bumon": 0}, Base: []int{1, 1, 0}, Check: []int{0, 1, 2}}
+
+func request_Db_UntenNippoMeisaiService_GetByBumon_0(ctx context.Context, marshaler runtime.Marshaler, client Db_UntenNippoMeisaiServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_GetUntenNippoMeisaiByBumonRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	val, ok := pathParams["bumon"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "bumon")
+	}
+	protoReq.Bumon, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "bumon", err)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_UntenNippoMeisaiService_GetByBumon_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := client.GetByBumon(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_UntenNippoMeisaiService_GetByBumon_0(ctx context.Context, marshaler runtime.Marshaler, server Db_UntenNippoMeisaiServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_GetUntenNippoMeisaiByBumonRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	val, ok := pathParams["bumon"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "bumon")
+	}
+	protoReq.Bumon, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "bumon", err)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_UntenNippoMeisaiService_GetByBumon_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.GetByBumon(ctx, &protoReq)
+	return msg, metadata, err
+}
+
 func request_Db_ShainMasterService_Get_0(ctx context.Context, marshaler runtime.Marshaler, client Db_ShainMasterServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
 		protoReq Db_GetShainMasterRequest
@@ -2127,155 +2622,4372 @@ func local_request_Db_ChikuMasterService_GetByChiikiC_0(ctx context.Context, mar
 	return msg, metadata, err
 }
 
-// RegisterDb_DTakoUriageKeihiServiceHandlerServer registers the http handlers for service Db_DTakoUriageKeihiService to "mux".
-// UnaryRPC     :call Db_DTakoUriageKeihiServiceServer directly.
-// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
-// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_DTakoUriageKeihiServiceHandlerFromEndpoint instead.
-// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
-func RegisterDb_DTakoUriageKeihiServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_DTakoUriageKeihiServiceServer) error {
-	mux.Handle(http.MethodPost, pattern_Db_DTakoUriageKeihiService_Create_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
-		ctx, cancel := context.WithCancel(req.Context())
-		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
-		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoUriageKeihiService/Create", runtime.WithHTTPPathPattern("/api/v1/db/dtako-uriage-keihi"))
-		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
-			return
-		}
-		resp, md, err := local_request_Db_DTakoUriageKeihiService_Create_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
-		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
-		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
-			return
-		}
-		forward_Db_DTakoUriageKeihiService_Create_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
-	mux.Handle(http.MethodGet, pattern_Db_DTakoUriageKeihiService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
-		ctx, cancel := context.WithCancel(req.Context())
-		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
-		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoUriageKeihiService/Get", runtime.WithHTTPPathPattern("/api/v1/db/dtako-uriage-keihi/{srch_id}"))
-		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
-			return
-		}
-		resp, md, err := local_request_Db_DTakoUriageKeihiService_Get_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
-		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
-		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
-			return
-		}
-		forward_Db_DTakoUriageKeihiService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
-	mux.Handle(http.MethodPut, pattern_Db_DTakoUriageKeihiService_Update_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
-		ctx, cancel := context.WithCancel(req.Context())
-		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
-		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoUriageKeihiService/Update", runtime.WithHTTPPathPattern("/api/v1/db/dtako-uriage-keihi/{dtako_uriage_keihi.srch_id}"))
-		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
-			return
-		}
-		resp, md, err := local_request_Db_DTakoUriageKeihiService_Update_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
-		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
-		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
-			return
-		}
-		forward_Db_DTakoUriageKeihiService_Update_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
-	mux.Handle(http.MethodDelete, pattern_Db_DTakoUriageKeihiService_Delete_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
-		ctx, cancel := context.WithCancel(req.Context())
-		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
-		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoUriageKeihiService/Delete", runtime.WithHTTPPathPattern("/api/v1/db/dtako-uriage-keihi/{srch_id}"))
-		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
-			return
-		}
-		resp, md, err := local_request_Db_DTakoUriageKeihiService_Delete_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
-		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
-		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
-			return
-		}
-		forward_Db_DTakoUriageKeihiService_Delete_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+func request_Db_TokuisakiMasterService_Get_0(ctx context.Context, marshaler runtime.Marshaler, client Db_TokuisakiMasterServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_GetTokuisakiMasterRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	val, ok := pathParams["tokuisaki_c"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "tokuisaki_c")
+	}
+	protoReq.TokuisakiC, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "tokuisaki_c", err)
+	}
+	val, ok = pathParams["tokuisaki_h"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "tokuisaki_h")
+	}
+	protoReq.TokuisakiH, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "tokuisaki_h", err)
+	}
+	msg, err := client.Get(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_TokuisakiMasterService_Get_0(ctx context.Context, marshaler runtime.Marshaler, server Db_TokuisakiMasterServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_GetTokuisakiMasterRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	val, ok := pathParams["tokuisaki_c"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "tokuisaki_c")
+	}
+	protoReq.TokuisakiC, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "tokuisaki_c", err)
+	}
+	val, ok = pathParams["tokuisaki_h"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "tokuisaki_h")
+	}
+	protoReq.TokuisakiH, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "tokuisaki_h", err)
+	}
+	msg, err := server.Get(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+var filter_Db_TokuisakiMasterService_List_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+
+func request_Db_TokuisakiMasterService_List_0(ctx context.Context, marshaler runtime.Marshaler, client Db_TokuisakiMasterServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_ListTokuisakiMasterRequest
+		metadata runtime.ServerMetadata
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_TokuisakiMasterService_List_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := client.List(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_TokuisakiMasterService_List_0(ctx context.Context, marshaler runtime.Marshaler, server Db_TokuisakiMasterServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_ListTokuisakiMasterRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_TokuisakiMasterService_List_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.List(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+var filter_Db_TokuisakiMasterService_Search_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+
+func request_Db_TokuisakiMasterService_Search_0(ctx context.Context, marshaler runtime.Marshaler, client Db_TokuisakiMasterServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_SearchTokuisakiMasterRequest
+		metadata runtime.ServerMetadata
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_TokuisakiMasterService_Search_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := client.Search(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_TokuisakiMasterService_Search_0(ctx context.Context, marshaler runtime.Marshaler, server Db_TokuisakiMasterServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_SearchTokuisakiMasterRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_TokuisakiMasterService_Search_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.Search(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+func request_Db_TokuisakiMasterService_ListTekiyobi_0(ctx context.Context, marshaler runtime.Marshaler, client Db_TokuisakiMasterServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_ListTokuisakiTekiyobiRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	val, ok := pathParams["tokuisaki_c"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "tokuisaki_c")
+	}
+	protoReq.TokuisakiC, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "tokuisaki_c", err)
+	}
+	val, ok = pathParams["tokuisaki_h"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "tokuisaki_h")
+	}
+	protoReq.TokuisakiH, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "tokuisaki_h", err)
+	}
+	msg, err := client.ListTekiyobi(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_TokuisakiMasterService_ListTekiyobi_0(ctx context.Context, marshaler runtime.Marshaler, server Db_TokuisakiMasterServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_ListTokuisakiTekiyobiRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	val, ok := pathParams["tokuisaki_c"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "tokuisaki_c")
+	}
+	protoReq.TokuisakiC, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "tokuisaki_c", err)
+	}
+	val, ok = pathParams["tokuisaki_h"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "tokuisaki_h")
+	}
+	protoReq.TokuisakiH, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "tokuisaki_h", err)
+	}
+	msg, err := server.ListTekiyobi(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+var filter_Db_TokuisakiMasterService_GetTekiyobiAt_0 = &utilities.DoubleArray{Encoding: map[string]int{"tokuisaki_c": 0, "tokuisaki_h": 1}, Base: []int{1, 1, 2, 0, 0}, Check: []int{0, 1, 1, 2, 3}}
+
+func request_Db_TokuisakiMasterService_GetTekiyobiAt_0(ctx context.Context, marshaler runtime.Marshaler, client Db_TokuisakiMasterServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_GetTokuisakiTekiyobiAtRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	val, ok := pathParams["tokuisaki_c"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "tokuisaki_c")
+	}
+	protoReq.TokuisakiC, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "tokuisaki_c", err)
+	}
+	val, ok = pathParams["tokuisaki_h"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "tokuisaki_h")
+	}
+	protoReq.TokuisakiH, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "tokuisaki_h", err)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_TokuisakiMasterService_GetTekiyobiAt_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := client.GetTekiyobiAt(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_TokuisakiMasterService_GetTekiyobiAt_0(ctx context.Context, marshaler runtime.Marshaler, server Db_TokuisakiMasterServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_GetTokuisakiTekiyobiAtRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	val, ok := pathParams["tokuisaki_c"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "tokuisaki_c")
+	}
+	protoReq.TokuisakiC, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "tokuisaki_c", err)
+	}
+	val, ok = pathParams["tokuisaki_h"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "tokuisaki_h")
+	}
+	protoReq.TokuisakiH, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "tokuisaki_h", err)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_TokuisakiMasterService_GetTekiyobiAt_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.GetTekiyobiAt(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+func request_Db_HinmeiMasterService_Get_0(ctx context.Context, marshaler runtime.Marshaler, client Db_HinmeiMasterServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_GetHinmeiMasterRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	val, ok := pathParams["hinmei_c"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "hinmei_c")
+	}
+	protoReq.HinmeiC, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "hinmei_c", err)
+	}
+	val, ok = pathParams["hinmei_h"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "hinmei_h")
+	}
+	protoReq.HinmeiH, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "hinmei_h", err)
+	}
+	msg, err := client.Get(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_HinmeiMasterService_Get_0(ctx context.Context, marshaler runtime.Marshaler, server Db_HinmeiMasterServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_GetHinmeiMasterRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	val, ok := pathParams["hinmei_c"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "hinmei_c")
+	}
+	protoReq.HinmeiC, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "hinmei_c", err)
+	}
+	val, ok = pathParams["hinmei_h"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "hinmei_h")
+	}
+	protoReq.HinmeiH, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "hinmei_h", err)
+	}
+	msg, err := server.Get(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+var filter_Db_HinmeiMasterService_List_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+
+func request_Db_HinmeiMasterService_List_0(ctx context.Context, marshaler runtime.Marshaler, client Db_HinmeiMasterServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_ListHinmeiMasterRequest
+		metadata runtime.ServerMetadata
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_HinmeiMasterService_List_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := client.List(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_HinmeiMasterService_List_0(ctx context.Context, marshaler runtime.Marshaler, server Db_HinmeiMasterServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_ListHinmeiMasterRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_HinmeiMasterService_List_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.List(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+var filter_Db_HinmeiMasterService_Search_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+
+func request_Db_HinmeiMasterService_Search_0(ctx context.Context, marshaler runtime.Marshaler, client Db_HinmeiMasterServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_SearchHinmeiMasterRequest
+		metadata runtime.ServerMetadata
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_HinmeiMasterService_Search_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := client.Search(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_HinmeiMasterService_Search_0(ctx context.Context, marshaler runtime.Marshaler, server Db_HinmeiMasterServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_SearchHinmeiMasterRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_HinmeiMasterService_Search_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.Search(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+func request_Db_HinmeiMasterService_ListTokuisakiHinmei_0(ctx context.Context, marshaler runtime.Marshaler, client Db_HinmeiMasterServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_ListTokuisakiHinmeiRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	val, ok := pathParams["tokuisaki_c"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "tokuisaki_c")
+	}
+	protoReq.TokuisakiC, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "tokuisaki_c", err)
+	}
+	val, ok = pathParams["tokuisaki_h"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "tokuisaki_h")
+	}
+	protoReq.TokuisakiH, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "tokuisaki_h", err)
+	}
+	msg, err := client.ListTokuisakiHinmei(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_HinmeiMasterService_ListTokuisakiHinmei_0(ctx context.Context, marshaler runtime.Marshaler, server Db_HinmeiMasterServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_ListTokuisakiHinmeiRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	val, ok := pathParams["tokuisaki_c"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "tokuisaki_c")
+	}
+	protoReq.TokuisakiC, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "tokuisaki_c", err)
+	}
+	val, ok = pathParams["tokuisaki_h"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "tokuisaki_h")
+	}
+	protoReq.TokuisakiH, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "tokuisaki_h", err)
+	}
+	msg, err := server.ListTokuisakiHinmei(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+func request_Db_HinmeiMasterService_GetTokuisakiHinmei_0(ctx context.Context, marshaler runtime.Marshaler, client Db_HinmeiMasterServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_GetTokuisakiHinmeiRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	val, ok := pathParams["tokuisaki_c"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "tokuisaki_c")
+	}
+	protoReq.TokuisakiC, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "tokuisaki_c", err)
+	}
+	val, ok = pathParams["tokuisaki_h"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "tokuisaki_h")
+	}
+	protoReq.TokuisakiH, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "tokuisaki_h", err)
+	}
+	val, ok = pathParams["hinmei_c"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "hinmei_c")
+	}
+	protoReq.HinmeiC, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "hinmei_c", err)
+	}
+	val, ok = pathParams["hinmei_h"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "hinmei_h")
+	}
+	protoReq.HinmeiH, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "hinmei_h", err)
+	}
+	msg, err := client.GetTokuisakiHinmei(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_HinmeiMasterService_GetTokuisakiHinmei_0(ctx context.Context, marshaler runtime.Marshaler, server Db_HinmeiMasterServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_GetTokuisakiHinmeiRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	val, ok := pathParams["tokuisaki_c"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "tokuisaki_c")
+	}
+	protoReq.TokuisakiC, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "tokuisaki_c", err)
+	}
+	val, ok = pathParams["tokuisaki_h"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "tokuisaki_h")
+	}
+	protoReq.TokuisakiH, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "tokuisaki_h", err)
+	}
+	val, ok = pathParams["hinmei_c"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "hinmei_c")
+	}
+	protoReq.HinmeiC, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "hinmei_c", err)
+	}
+	val, ok = pathParams["hinmei_h"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "hinmei_h")
+	}
+	protoReq.HinmeiH, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "hinmei_h", err)
+	}
+	msg, err := server.GetTokuisakiHinmei(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+var filter_Db_HinmeiMasterService_Resolve_0 = &utilities.DoubleArray{Encoding: map[string]int{"hinmei_c": 0, "hinmei_h": 1}, Base: []int{1, 1, 2, 0, 0}, Check: []int{0, 1, 1, 2, 3}}
+
+func request_Db_HinmeiMasterService_Resolve_0(ctx context.Context, marshaler runtime.Marshaler, client Db_HinmeiMasterServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_ResolveHinmeiRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	val, ok := pathParams["hinmei_c"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "hinmei_c")
+	}
+	protoReq.HinmeiC, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "hinmei_c", err)
+	}
+	val, ok = pathParams["hinmei_h"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "hinmei_h")
+	}
+	protoReq.HinmeiH, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "hinmei_h", err)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_HinmeiMasterService_Resolve_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := client.Resolve(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_HinmeiMasterService_Resolve_0(ctx context.Context, marshaler runtime.Marshaler, server Db_HinmeiMasterServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_ResolveHinmeiRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	val, ok := pathParams["hinmei_c"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "hinmei_c")
+	}
+	protoReq.HinmeiC, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "hinmei_c", err)
+	}
+	val, ok = pathParams["hinmei_h"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "hinmei_h")
+	}
+	protoReq.HinmeiH, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "hinmei_h", err)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_HinmeiMasterService_Resolve_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.Resolve(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+func request_Db_BumonMasterService_Get_0(ctx context.Context, marshaler runtime.Marshaler, client Db_BumonMasterServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_GetBumonMasterRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	val, ok := pathParams["bumon_c"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "bumon_c")
+	}
+	protoReq.BumonC, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "bumon_c", err)
+	}
+	msg, err := client.Get(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_BumonMasterService_Get_0(ctx context.Context, marshaler runtime.Marshaler, server Db_BumonMasterServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_GetBumonMasterRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	val, ok := pathParams["bumon_c"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "bumon_c")
+	}
+	protoReq.BumonC, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "bumon_c", err)
+	}
+	msg, err := server.Get(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+var filter_Db_BumonMasterService_List_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+
+func request_Db_BumonMasterService_List_0(ctx context.Context, marshaler runtime.Marshaler, client Db_BumonMasterServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_ListBumonMasterRequest
+		metadata runtime.ServerMetadata
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_BumonMasterService_List_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := client.List(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_BumonMasterService_List_0(ctx context.Context, marshaler runtime.Marshaler, server Db_BumonMasterServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_ListBumonMasterRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_BumonMasterService_List_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.List(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+func request_Db_SharyoMasterService_Get_0(ctx context.Context, marshaler runtime.Marshaler, client Db_SharyoMasterServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_GetSharyoMasterRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	val, ok := pathParams["sharyo_c"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "sharyo_c")
+	}
+	protoReq.SharyoC, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "sharyo_c", err)
+	}
+	val, ok = pathParams["sharyo_h"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "sharyo_h")
+	}
+	protoReq.SharyoH, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "sharyo_h", err)
+	}
+	msg, err := client.Get(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_SharyoMasterService_Get_0(ctx context.Context, marshaler runtime.Marshaler, server Db_SharyoMasterServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_GetSharyoMasterRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	val, ok := pathParams["sharyo_c"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "sharyo_c")
+	}
+	protoReq.SharyoC, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "sharyo_c", err)
+	}
+	val, ok = pathParams["sharyo_h"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "sharyo_h")
+	}
+	protoReq.SharyoH, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "sharyo_h", err)
+	}
+	msg, err := server.Get(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+func request_Db_SharyoMasterService_ListBySharyoC_0(ctx context.Context, marshaler runtime.Marshaler, client Db_SharyoMasterServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_ListSharyoMasterBySharyoCRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	val, ok := pathParams["sharyo_c"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "sharyo_c")
+	}
+	protoReq.SharyoC, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "sharyo_c", err)
+	}
+	msg, err := client.ListBySharyoC(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_SharyoMasterService_ListBySharyoC_0(ctx context.Context, marshaler runtime.Marshaler, server Db_SharyoMasterServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_ListSharyoMasterBySharyoCRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	val, ok := pathParams["sharyo_c"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "sharyo_c")
+	}
+	protoReq.SharyoC, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "sharyo_c", err)
+	}
+	msg, err := server.ListBySharyoC(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+var filter_Db_SharyoMasterService_List_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+
+func request_Db_SharyoMasterService_List_0(ctx context.Context, marshaler runtime.Marshaler, client Db_SharyoMasterServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_ListSharyoMasterRequest
+		metadata runtime.ServerMetadata
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_SharyoMasterService_List_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := client.List(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_SharyoMasterService_List_0(ctx context.Context, marshaler runtime.Marshaler, server Db_SharyoMasterServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_ListSharyoMasterRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_SharyoMasterService_List_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.List(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+var filter_Db_VehicleIdentityService_Get_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+
+func request_Db_VehicleIdentityService_Get_0(ctx context.Context, marshaler runtime.Marshaler, client Db_VehicleIdentityServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_GetVehicleIdentityRequest
+		metadata runtime.ServerMetadata
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_VehicleIdentityService_Get_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := client.Get(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_VehicleIdentityService_Get_0(ctx context.Context, marshaler runtime.Marshaler, server Db_VehicleIdentityServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_GetVehicleIdentityRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_VehicleIdentityService_Get_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.Get(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+var filter_Db_VehicleIdentityService_ListGaps_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+
+func request_Db_VehicleIdentityService_ListGaps_0(ctx context.Context, marshaler runtime.Marshaler, client Db_VehicleIdentityServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_ListVehicleIdentityGapsRequest
+		metadata runtime.ServerMetadata
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_VehicleIdentityService_ListGaps_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := client.ListGaps(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_VehicleIdentityService_ListGaps_0(ctx context.Context, marshaler runtime.Marshaler, server Db_VehicleIdentityServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_ListVehicleIdentityGapsRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_VehicleIdentityService_ListGaps_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.ListGaps(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+var filter_Db_TimeCardService_Get_0 = &utilities.DoubleArray{Encoding: map[string]int{"id": 0}, Base: []int{1, 1, 0}, Check: []int{0, 1, 2}}
+
+func request_Db_TimeCardService_Get_0(ctx context.Context, marshaler runtime.Marshaler, client Db_TimeCardServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_GetTimeCardRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	val, ok := pathParams["id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "id")
+	}
+	protoReq.Id, err = runtime.Int32(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "id", err)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_TimeCardService_Get_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := client.Get(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_TimeCardService_Get_0(ctx context.Context, marshaler runtime.Marshaler, server Db_TimeCardServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_GetTimeCardRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	val, ok := pathParams["id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "id")
+	}
+	protoReq.Id, err = runtime.Int32(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "id", err)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_TimeCardService_Get_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.Get(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+var filter_Db_TimeCardService_List_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+
+func request_Db_TimeCardService_List_0(ctx context.Context, marshaler runtime.Marshaler, client Db_TimeCardServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_ListTimeCardRequest
+		metadata runtime.ServerMetadata
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_TimeCardService_List_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := client.List(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_TimeCardService_List_0(ctx context.Context, marshaler runtime.Marshaler, server Db_TimeCardServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_ListTimeCardRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_TimeCardService_List_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.List(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+func request_Db_TimeCardDevService_Create_0(ctx context.Context, marshaler runtime.Marshaler, client Db_TimeCardDevServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_CreateTimeCardRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq.TimeCard); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	msg, err := client.Create(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_TimeCardDevService_Create_0(ctx context.Context, marshaler runtime.Marshaler, server Db_TimeCardDevServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_CreateTimeCardRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq.TimeCard); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.Create(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+var filter_Db_TimeCardDevService_Get_0 = &utilities.DoubleArray{Encoding: map[string]int{"id": 0}, Base: []int{1, 1, 0}, Check: []int{0, 1, 2}}
+
+func request_Db_TimeCardDevService_Get_0(ctx context.Context, marshaler runtime.Marshaler, client Db_TimeCardDevServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_GetTimeCardRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	val, ok := pathParams["id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "id")
+	}
+	protoReq.Id, err = runtime.Int32(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "id", err)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_TimeCardDevService_Get_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := client.Get(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_TimeCardDevService_Get_0(ctx context.Context, marshaler runtime.Marshaler, server Db_TimeCardDevServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_GetTimeCardRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	val, ok := pathParams["id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "id")
+	}
+	protoReq.Id, err = runtime.Int32(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "id", err)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_TimeCardDevService_Get_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.Get(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+func request_Db_TimeCardDevService_Update_0(ctx context.Context, marshaler runtime.Marshaler, client Db_TimeCardDevServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_UpdateTimeCardRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq.TimeCard); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	val, ok := pathParams["time_card.id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "time_card.id")
+	}
+	err = runtime.PopulateFieldFromPath(&protoReq, "time_card.id", val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "time_card.id", err)
+	}
+	msg, err := client.Update(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_TimeCardDevService_Update_0(ctx context.Context, marshaler runtime.Marshaler, server Db_TimeCardDevServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_UpdateTimeCardRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq.TimeCard); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	val, ok := pathParams["time_card.id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "time_card.id")
+	}
+	err = runtime.PopulateFieldFromPath(&protoReq, "time_card.id", val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "time_card.id", err)
+	}
+	msg, err := server.Update(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+var filter_Db_TimeCardDevService_Delete_0 = &utilities.DoubleArray{Encoding: map[string]int{"id": 0}, Base: []int{1, 1, 0}, Check: []int{0, 1, 2}}
+
+func request_Db_TimeCardDevService_Delete_0(ctx context.Context, marshaler runtime.Marshaler, client Db_TimeCardDevServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_DeleteTimeCardRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	val, ok := pathParams["id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "id")
+	}
+	protoReq.Id, err = runtime.Int32(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "id", err)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_TimeCardDevService_Delete_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := client.Delete(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_TimeCardDevService_Delete_0(ctx context.Context, marshaler runtime.Marshaler, server Db_TimeCardDevServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_DeleteTimeCardRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	val, ok := pathParams["id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "id")
+	}
+	protoReq.Id, err = runtime.Int32(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "id", err)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_TimeCardDevService_Delete_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.Delete(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+var filter_Db_TimeCardDevService_List_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+
+func request_Db_TimeCardDevService_List_0(ctx context.Context, marshaler runtime.Marshaler, client Db_TimeCardDevServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_ListTimeCardRequest
+		metadata runtime.ServerMetadata
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_TimeCardDevService_List_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := client.List(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_TimeCardDevService_List_0(ctx context.Context, marshaler runtime.Marshaler, server Db_TimeCardDevServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_ListTimeCardRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_TimeCardDevService_List_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.List(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+func request_Db_TimeCardLogService_Create_0(ctx context.Context, marshaler runtime.Marshaler, client Db_TimeCardLogServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_CreateTimeCardLogRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq.Log); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	msg, err := client.Create(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_TimeCardLogService_Create_0(ctx context.Context, marshaler runtime.Marshaler, server Db_TimeCardLogServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_CreateTimeCardLogRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq.Log); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.Create(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+var filter_Db_TimeCardLogService_Get_0 = &utilities.DoubleArray{Encoding: map[string]int{"id": 0}, Base: []int{1, 1, 0}, Check: []int{0, 1, 2}}
+
+func request_Db_TimeCardLogService_Get_0(ctx context.Context, marshaler runtime.Marshaler, client Db_TimeCardLogServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_GetTimeCardLogRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	val, ok := pathParams["id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "id")
+	}
+	protoReq.Id, err = runtime.Int32(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "id", err)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_TimeCardLogService_Get_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := client.Get(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_TimeCardLogService_Get_0(ctx context.Context, marshaler runtime.Marshaler, server Db_TimeCardLogServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_GetTimeCardLogRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	val, ok := pathParams["id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "id")
+	}
+	protoReq.Id, err = runtime.Int32(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "id", err)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_TimeCardLogService_Get_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.Get(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+func request_Db_TimeCardLogService_Update_0(ctx context.Context, marshaler runtime.Marshaler, client Db_TimeCardLogServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_UpdateTimeCardLogRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq.Log); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	val, ok := pathParams["log.id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "log.id")
+	}
+	err = runtime.PopulateFieldFromPath(&protoReq, "log.id", val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "log.id", err)
+	}
+	msg, err := client.Update(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_TimeCardLogService_Update_0(ctx context.Context, marshaler runtime.Marshaler, server Db_TimeCardLogServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_UpdateTimeCardLogRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq.Log); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	val, ok := pathParams["log.id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "log.id")
+	}
+	err = runtime.PopulateFieldFromPath(&protoReq, "log.id", val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "log.id", err)
+	}
+	msg, err := server.Update(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+var filter_Db_TimeCardLogService_Delete_0 = &utilities.DoubleArray{Encoding: map[string]int{"id": 0}, Base: []int{1, 1, 0}, Check: []int{0, 1, 2}}
+
+func request_Db_TimeCardLogService_Delete_0(ctx context.Context, marshaler runtime.Marshaler, client Db_TimeCardLogServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_DeleteTimeCardLogRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	val, ok := pathParams["id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "id")
+	}
+	protoReq.Id, err = runtime.Int32(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "id", err)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_TimeCardLogService_Delete_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := client.Delete(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_TimeCardLogService_Delete_0(ctx context.Context, marshaler runtime.Marshaler, server Db_TimeCardLogServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_DeleteTimeCardLogRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	val, ok := pathParams["id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "id")
+	}
+	protoReq.Id, err = runtime.Int32(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "id", err)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_TimeCardLogService_Delete_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.Delete(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+var filter_Db_TimeCardLogService_List_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+
+func request_Db_TimeCardLogService_List_0(ctx context.Context, marshaler runtime.Marshaler, client Db_TimeCardLogServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_ListTimeCardLogRequest
+		metadata runtime.ServerMetadata
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_TimeCardLogService_List_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := client.List(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_TimeCardLogService_List_0(ctx context.Context, marshaler runtime.Marshaler, server Db_TimeCardLogServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_ListTimeCardLogRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_TimeCardLogService_List_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.List(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+var filter_Db_TimeCardLogService_GetByCardID_0 = &utilities.DoubleArray{Encoding: map[string]int{"card_id": 0}, Base: []int{1, 1, 0}, Check: []int{0, 1, 2}}
+
+func request_Db_TimeCardLogService_GetByCardID_0(ctx context.Context, marshaler runtime.Marshaler, client Db_TimeCardLogServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_GetByCardIDRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	val, ok := pathParams["card_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "card_id")
+	}
+	protoReq.CardId, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "card_id", err)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_TimeCardLogService_GetByCardID_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := client.GetByCardID(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_TimeCardLogService_GetByCardID_0(ctx context.Context, marshaler runtime.Marshaler, server Db_TimeCardLogServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_GetByCardIDRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	val, ok := pathParams["card_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "card_id")
+	}
+	protoReq.CardId, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "card_id", err)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_TimeCardLogService_GetByCardID_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.GetByCardID(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+func request_Db_EmployeeIdentityService_Create_0(ctx context.Context, marshaler runtime.Marshaler, client Db_EmployeeIdentityServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_CreateEmployeeIdentityRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq.Employee); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	msg, err := client.Create(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_EmployeeIdentityService_Create_0(ctx context.Context, marshaler runtime.Marshaler, server Db_EmployeeIdentityServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_CreateEmployeeIdentityRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq.Employee); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.Create(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+func request_Db_EmployeeIdentityService_Get_0(ctx context.Context, marshaler runtime.Marshaler, client Db_EmployeeIdentityServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_GetEmployeeIdentityRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	val, ok := pathParams["id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "id")
+	}
+	protoReq.Id, err = runtime.Int64(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "id", err)
+	}
+	msg, err := client.Get(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_EmployeeIdentityService_Get_0(ctx context.Context, marshaler runtime.Marshaler, server Db_EmployeeIdentityServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_GetEmployeeIdentityRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	val, ok := pathParams["id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "id")
+	}
+	protoReq.Id, err = runtime.Int64(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "id", err)
+	}
+	msg, err := server.Get(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+func request_Db_EmployeeIdentityService_Update_0(ctx context.Context, marshaler runtime.Marshaler, client Db_EmployeeIdentityServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_UpdateEmployeeIdentityRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq.Employee); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	val, ok := pathParams["employee.id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "employee.id")
+	}
+	err = runtime.PopulateFieldFromPath(&protoReq, "employee.id", val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "employee.id", err)
+	}
+	msg, err := client.Update(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_EmployeeIdentityService_Update_0(ctx context.Context, marshaler runtime.Marshaler, server Db_EmployeeIdentityServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_UpdateEmployeeIdentityRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq.Employee); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	val, ok := pathParams["employee.id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "employee.id")
+	}
+	err = runtime.PopulateFieldFromPath(&protoReq, "employee.id", val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "employee.id", err)
+	}
+	msg, err := server.Update(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+func request_Db_EmployeeIdentityService_Delete_0(ctx context.Context, marshaler runtime.Marshaler, client Db_EmployeeIdentityServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_DeleteEmployeeIdentityRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	val, ok := pathParams["id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "id")
+	}
+	protoReq.Id, err = runtime.Int64(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "id", err)
+	}
+	msg, err := client.Delete(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_EmployeeIdentityService_Delete_0(ctx context.Context, marshaler runtime.Marshaler, server Db_EmployeeIdentityServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_DeleteEmployeeIdentityRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	val, ok := pathParams["id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "id")
+	}
+	protoReq.Id, err = runtime.Int64(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "id", err)
+	}
+	msg, err := server.Delete(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+var filter_Db_EmployeeIdentityService_List_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+
+func request_Db_EmployeeIdentityService_List_0(ctx context.Context, marshaler runtime.Marshaler, client Db_EmployeeIdentityServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_ListEmployeeIdentityRequest
+		metadata runtime.ServerMetadata
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_EmployeeIdentityService_List_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := client.List(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_EmployeeIdentityService_List_0(ctx context.Context, marshaler runtime.Marshaler, server Db_EmployeeIdentityServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_ListEmployeeIdentityRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_EmployeeIdentityService_List_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.List(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+var filter_Db_EmployeeIdentityService_Resolve_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+
+func request_Db_EmployeeIdentityService_Resolve_0(ctx context.Context, marshaler runtime.Marshaler, client Db_EmployeeIdentityServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_ResolveEmployeeIdentityRequest
+		metadata runtime.ServerMetadata
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_EmployeeIdentityService_Resolve_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := client.Resolve(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_EmployeeIdentityService_Resolve_0(ctx context.Context, marshaler runtime.Marshaler, server Db_EmployeeIdentityServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_ResolveEmployeeIdentityRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_EmployeeIdentityService_Resolve_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.Resolve(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+func request_Db_EmployeeIdentityService_RegisterCard_0(ctx context.Context, marshaler runtime.Marshaler, client Db_EmployeeIdentityServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_RegisterEmployeeCardRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	val, ok := pathParams["employee_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "employee_id")
+	}
+	protoReq.EmployeeId, err = runtime.Int64(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "employee_id", err)
+	}
+	msg, err := client.RegisterCard(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_EmployeeIdentityService_RegisterCard_0(ctx context.Context, marshaler runtime.Marshaler, server Db_EmployeeIdentityServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_RegisterEmployeeCardRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	val, ok := pathParams["employee_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "employee_id")
+	}
+	protoReq.EmployeeId, err = runtime.Int64(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "employee_id", err)
+	}
+	msg, err := server.RegisterCard(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+func request_Db_EmployeeIdentityService_UnregisterCard_0(ctx context.Context, marshaler runtime.Marshaler, client Db_EmployeeIdentityServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_UnregisterEmployeeCardRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	val, ok := pathParams["card_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "card_id")
+	}
+	protoReq.CardId, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "card_id", err)
+	}
+	msg, err := client.UnregisterCard(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_EmployeeIdentityService_UnregisterCard_0(ctx context.Context, marshaler runtime.Marshaler, server Db_EmployeeIdentityServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_UnregisterEmployeeCardRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	val, ok := pathParams["card_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "card_id")
+	}
+	protoReq.CardId, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "card_id", err)
+	}
+	msg, err := server.UnregisterCard(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+var filter_Db_EmployeeIdentityService_ListUnlinked_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+
+func request_Db_EmployeeIdentityService_ListUnlinked_0(ctx context.Context, marshaler runtime.Marshaler, client Db_EmployeeIdentityServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_ListUnlinkedEmployeeCodesRequest
+		metadata runtime.ServerMetadata
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_EmployeeIdentityService_ListUnlinked_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := client.ListUnlinked(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_EmployeeIdentityService_ListUnlinked_0(ctx context.Context, marshaler runtime.Marshaler, server Db_EmployeeIdentityServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_ListUnlinkedEmployeeCodesRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_Db_EmployeeIdentityService_ListUnlinked_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.ListUnlinked(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+func request_Db_RegistryService_GetAvailability_0(ctx context.Context, marshaler runtime.Marshaler, client Db_RegistryServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_GetAvailabilityRequest
+		metadata runtime.ServerMetadata
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	msg, err := client.GetAvailability(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Db_RegistryService_GetAvailability_0(ctx context.Context, marshaler runtime.Marshaler, server Db_RegistryServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq Db_GetAvailabilityRequest
+		metadata runtime.ServerMetadata
+	)
+	msg, err := server.GetAvailability(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+// RegisterDb_DTakoUriageKeihiServiceHandlerServer registers the http handlers for service Db_DTakoUriageKeihiService to "mux".
+// UnaryRPC     :call Db_DTakoUriageKeihiServiceServer directly.
+// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_DTakoUriageKeihiServiceHandlerFromEndpoint instead.
+// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
+func RegisterDb_DTakoUriageKeihiServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_DTakoUriageKeihiServiceServer) error {
+	mux.Handle(http.MethodPost, pattern_Db_DTakoUriageKeihiService_Create_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoUriageKeihiService/Create", runtime.WithHTTPPathPattern("/api/v1/db/dtako-uriage-keihi"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_DTakoUriageKeihiService_Create_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_DTakoUriageKeihiService_Create_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_DTakoUriageKeihiService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoUriageKeihiService/Get", runtime.WithHTTPPathPattern("/api/v1/db/dtako-uriage-keihi/{srch_id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_DTakoUriageKeihiService_Get_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_DTakoUriageKeihiService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodPut, pattern_Db_DTakoUriageKeihiService_Update_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoUriageKeihiService/Update", runtime.WithHTTPPathPattern("/api/v1/db/dtako-uriage-keihi/{dtako_uriage_keihi.srch_id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_DTakoUriageKeihiService_Update_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_DTakoUriageKeihiService_Update_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodDelete, pattern_Db_DTakoUriageKeihiService_Delete_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoUriageKeihiService/Delete", runtime.WithHTTPPathPattern("/api/v1/db/dtako-uriage-keihi/{srch_id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_DTakoUriageKeihiService_Delete_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_DTakoUriageKeihiService_Delete_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 	mux.Handle(http.MethodGet, pattern_Db_DTakoUriageKeihiService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoUriageKeihiService/List", runtime.WithHTTPPathPattern("/api/v1/db/dtako-uriage-keihi"))
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoUriageKeihiService/List", runtime.WithHTTPPathPattern("/api/v1/db/dtako-uriage-keihi"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_DTakoUriageKeihiService_List_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_DTakoUriageKeihiService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+
+	return nil
+}
+
+// RegisterDb_ETCMeisaiServiceHandlerServer registers the http handlers for service Db_ETCMeisaiService to "mux".
+// UnaryRPC     :call Db_ETCMeisaiServiceServer directly.
+// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_ETCMeisaiServiceHandlerFromEndpoint instead.
+// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
+func RegisterDb_ETCMeisaiServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_ETCMeisaiServiceServer) error {
+	mux.Handle(http.MethodPost, pattern_Db_ETCMeisaiService_Create_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ETCMeisaiService/Create", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_ETCMeisaiService_Create_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_ETCMeisaiService_Create_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_ETCMeisaiService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ETCMeisaiService/Get", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai/{id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_ETCMeisaiService_Get_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_ETCMeisaiService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodPut, pattern_Db_ETCMeisaiService_Update_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ETCMeisaiService/Update", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai/{etc_meisai.id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_ETCMeisaiService_Update_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_ETCMeisaiService_Update_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodDelete, pattern_Db_ETCMeisaiService_Delete_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ETCMeisaiService/Delete", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai/{id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_ETCMeisaiService_Delete_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_ETCMeisaiService_Delete_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_ETCMeisaiService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ETCMeisaiService/List", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_ETCMeisaiService_List_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_ETCMeisaiService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+
+	mux.Handle(http.MethodGet, pattern_Db_ETCMeisaiService_Stream_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		err := status.Error(codes.Unimplemented, "streaming calls are not yet supported in the in-process transport")
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+		return
+	})
+	mux.Handle(http.MethodPost, pattern_Db_ETCMeisaiService_BatchCreate_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ETCMeisaiService/BatchCreate", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai/batch"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_ETCMeisaiService_BatchCreate_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_ETCMeisaiService_BatchCreate_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodPost, pattern_Db_ETCMeisaiService_Import_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ETCMeisaiService/Import", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai/import"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_ETCMeisaiService_Import_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_ETCMeisaiService_Import_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+
+	return nil
+}
+
+// RegisterDb_DTakoFerryRowsServiceHandlerServer registers the http handlers for service Db_DTakoFerryRowsService to "mux".
+// UnaryRPC     :call Db_DTakoFerryRowsServiceServer directly.
+// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_DTakoFerryRowsServiceHandlerFromEndpoint instead.
+// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
+func RegisterDb_DTakoFerryRowsServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_DTakoFerryRowsServiceServer) error {
+	mux.Handle(http.MethodPost, pattern_Db_DTakoFerryRowsService_Create_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoFerryRowsService/Create", runtime.WithHTTPPathPattern("/api/v1/db/dtako-ferry-rows"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_DTakoFerryRowsService_Create_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_DTakoFerryRowsService_Create_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_DTakoFerryRowsService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoFerryRowsService/Get", runtime.WithHTTPPathPattern("/api/v1/db/dtako-ferry-rows/{id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_DTakoFerryRowsService_Get_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_DTakoFerryRowsService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodPut, pattern_Db_DTakoFerryRowsService_Update_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoFerryRowsService/Update", runtime.WithHTTPPathPattern("/api/v1/db/dtako-ferry-rows/{dtako_ferry_rows.id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_DTakoFerryRowsService_Update_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_DTakoFerryRowsService_Update_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodDelete, pattern_Db_DTakoFerryRowsService_Delete_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoFerryRowsService/Delete", runtime.WithHTTPPathPattern("/api/v1/db/dtako-ferry-rows/{id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_DTakoFerryRowsService_Delete_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_DTakoFerryRowsService_Delete_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_DTakoFerryRowsService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoFerryRowsService/List", runtime.WithHTTPPathPattern("/api/v1/db/dtako-ferry-rows"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_DTakoFerryRowsService_List_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_DTakoFerryRowsService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+
+	return nil
+}
+
+// RegisterDb_ETCMeisaiMappingServiceHandlerServer registers the http handlers for service Db_ETCMeisaiMappingService to "mux".
+// UnaryRPC     :call Db_ETCMeisaiMappingServiceServer directly.
+// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_ETCMeisaiMappingServiceHandlerFromEndpoint instead.
+// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
+func RegisterDb_ETCMeisaiMappingServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_ETCMeisaiMappingServiceServer) error {
+	mux.Handle(http.MethodPost, pattern_Db_ETCMeisaiMappingService_Create_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ETCMeisaiMappingService/Create", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai-mapping"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_ETCMeisaiMappingService_Create_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_ETCMeisaiMappingService_Create_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_ETCMeisaiMappingService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ETCMeisaiMappingService/Get", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai-mapping/{id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_ETCMeisaiMappingService_Get_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_ETCMeisaiMappingService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodPut, pattern_Db_ETCMeisaiMappingService_Update_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ETCMeisaiMappingService/Update", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai-mapping/{etc_meisai_mapping.id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_ETCMeisaiMappingService_Update_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_ETCMeisaiMappingService_Update_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodDelete, pattern_Db_ETCMeisaiMappingService_Delete_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ETCMeisaiMappingService/Delete", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai-mapping/{id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_ETCMeisaiMappingService_Delete_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_ETCMeisaiMappingService_Delete_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_ETCMeisaiMappingService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ETCMeisaiMappingService/List", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai-mapping"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_ETCMeisaiMappingService_List_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_ETCMeisaiMappingService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_ETCMeisaiMappingService_GetDTakoRowIDByHash_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ETCMeisaiMappingService/GetDTakoRowIDByHash", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai-mapping/by-hash/{etc_meisai_hash}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_ETCMeisaiMappingService_GetDTakoRowIDByHash_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_ETCMeisaiMappingService_GetDTakoRowIDByHash_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodPut, pattern_Db_ETCMeisaiMappingService_BulkReplace_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ETCMeisaiMappingService/BulkReplace", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai-mapping/by-dtako-row-id/{dtako_row_id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_ETCMeisaiMappingService_BulkReplace_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_ETCMeisaiMappingService_BulkReplace_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+
+	return nil
+}
+
+// RegisterDb_ETCMeisaiMatcherServiceHandlerServer registers the http handlers for service Db_ETCMeisaiMatcherService to "mux".
+// UnaryRPC     :call Db_ETCMeisaiMatcherServiceServer directly.
+// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_ETCMeisaiMatcherServiceHandlerFromEndpoint instead.
+// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
+func RegisterDb_ETCMeisaiMatcherServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_ETCMeisaiMatcherServiceServer) error {
+	mux.Handle(http.MethodPost, pattern_Db_ETCMeisaiMatcherService_AutoMatch_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ETCMeisaiMatcherService/AutoMatch", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai-mapping/auto-match"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_ETCMeisaiMatcherService_AutoMatch_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_ETCMeisaiMatcherService_AutoMatch_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+
+	return nil
+}
+
+// RegisterDb_ETCMeisaiMappingAuditServiceHandlerServer registers the http handlers for service Db_ETCMeisaiMappingAuditService to "mux".
+// UnaryRPC     :call Db_ETCMeisaiMappingAuditServiceServer directly.
+// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_ETCMeisaiMappingAuditServiceHandlerFromEndpoint instead.
+// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
+func RegisterDb_ETCMeisaiMappingAuditServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_ETCMeisaiMappingAuditServiceServer) error {
+	mux.Handle(http.MethodGet, pattern_Db_ETCMeisaiMappingAuditService_Audit_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ETCMeisaiMappingAuditService/Audit", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai-mapping/audit"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_ETCMeisaiMappingAuditService_Audit_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_ETCMeisaiMappingAuditService_Audit_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+
+	return nil
+}
+
+// RegisterDb_DTakoCarsServiceHandlerServer registers the http handlers for service Db_DTakoCarsService to "mux".
+// UnaryRPC     :call Db_DTakoCarsServiceServer directly.
+// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_DTakoCarsServiceHandlerFromEndpoint instead.
+// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
+func RegisterDb_DTakoCarsServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_DTakoCarsServiceServer) error {
+	mux.Handle(http.MethodGet, pattern_Db_DTakoCarsService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoCarsService/Get", runtime.WithHTTPPathPattern("/api/v1/db/dtako-cars/{id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_DTakoCarsService_Get_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_DTakoCarsService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_DTakoCarsService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoCarsService/List", runtime.WithHTTPPathPattern("/api/v1/db/dtako-cars"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_DTakoCarsService_List_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_DTakoCarsService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_DTakoCarsService_GetByCarCode_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoCarsService/GetByCarCode", runtime.WithHTTPPathPattern("/api/v1/db/dtako-cars/by-car-code/{car_code}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_DTakoCarsService_GetByCarCode_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_DTakoCarsService_GetByCarCode_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+
+	return nil
+}
+
+// RegisterDb_DTakoEventsServiceHandlerServer registers the http handlers for service Db_DTakoEventsService to "mux".
+// UnaryRPC     :call Db_DTakoEventsServiceServer directly.
+// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_DTakoEventsServiceHandlerFromEndpoint instead.
+// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
+func RegisterDb_DTakoEventsServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_DTakoEventsServiceServer) error {
+	mux.Handle(http.MethodGet, pattern_Db_DTakoEventsService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoEventsService/Get", runtime.WithHTTPPathPattern("/api/v1/db/dtako-events/{id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_DTakoEventsService_Get_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_DTakoEventsService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_DTakoEventsService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoEventsService/List", runtime.WithHTTPPathPattern("/api/v1/db/dtako-events"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_DTakoEventsService_List_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_DTakoEventsService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+
+	mux.Handle(http.MethodGet, pattern_Db_DTakoEventsService_Stream_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		err := status.Error(codes.Unimplemented, "streaming calls are not yet supported in the in-process transport")
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+		return
+	})
+	mux.Handle(http.MethodGet, pattern_Db_DTakoEventsService_GetByOperationNo_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoEventsService/GetByOperationNo", runtime.WithHTTPPathPattern("/api/v1/db/dtako-events/by-operation-no/{operation_no}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_DTakoEventsService_GetByOperationNo_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_DTakoEventsService_GetByOperationNo_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+
+	return nil
+}
+
+// RegisterDb_DTakoRowsServiceHandlerServer registers the http handlers for service Db_DTakoRowsService to "mux".
+// UnaryRPC     :call Db_DTakoRowsServiceServer directly.
+// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_DTakoRowsServiceHandlerFromEndpoint instead.
+// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
+func RegisterDb_DTakoRowsServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_DTakoRowsServiceServer) error {
+	mux.Handle(http.MethodGet, pattern_Db_DTakoRowsService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoRowsService/Get", runtime.WithHTTPPathPattern("/api/v1/db/dtako-rows/{id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_DTakoRowsService_Get_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_DTakoRowsService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_DTakoRowsService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoRowsService/List", runtime.WithHTTPPathPattern("/api/v1/db/dtako-rows"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_DTakoRowsService_List_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_DTakoRowsService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+
+	mux.Handle(http.MethodGet, pattern_Db_DTakoRowsService_Stream_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		err := status.Error(codes.Unimplemented, "streaming calls are not yet supported in the in-process transport")
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+		return
+	})
+	mux.Handle(http.MethodGet, pattern_Db_DTakoRowsService_GetByOperationNo_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoRowsService/GetByOperationNo", runtime.WithHTTPPathPattern("/api/v1/db/dtako-rows/by-operation-no/{operation_no}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_DTakoRowsService_GetByOperationNo_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_DTakoRowsService_GetByOperationNo_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+
+	return nil
+}
+
+// RegisterDb_ETCNumServiceHandlerServer registers the http handlers for service Db_ETCNumService to "mux".
+// UnaryRPC     :call Db_ETCNumServiceServer directly.
+// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_ETCNumServiceHandlerFromEndpoint instead.
+// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
+func RegisterDb_ETCNumServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_ETCNumServiceServer) error {
+	mux.Handle(http.MethodGet, pattern_Db_ETCNumService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ETCNumService/List", runtime.WithHTTPPathPattern("/api/v1/db/etc-num"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_ETCNumService_List_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_ETCNumService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_ETCNumService_GetByETCCardNum_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ETCNumService/GetByETCCardNum", runtime.WithHTTPPathPattern("/api/v1/db/etc-num/by-etc-card-num/{etc_card_num}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_ETCNumService_GetByETCCardNum_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_ETCNumService_GetByETCCardNum_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_ETCNumService_GetByCarID_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ETCNumService/GetByCarID", runtime.WithHTTPPathPattern("/api/v1/db/etc-num/by-car-id/{car_id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_ETCNumService_GetByCarID_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_ETCNumService_GetByCarID_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_ETCNumService_GetByETCCardNumAt_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ETCNumService/GetByETCCardNumAt", runtime.WithHTTPPathPattern("/api/v1/db/etc-num/by-etc-card-num/{etc_card_num}/as-of"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_ETCNumService_GetByETCCardNumAt_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_ETCNumService_GetByETCCardNumAt_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_ETCNumService_GetByCarIDAt_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ETCNumService/GetByCarIDAt", runtime.WithHTTPPathPattern("/api/v1/db/etc-num/by-car-id/{car_id}/as-of"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_ETCNumService_GetByCarIDAt_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_ETCNumService_GetByCarIDAt_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_ETCNumService_ListOverlaps_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ETCNumService/ListOverlaps", runtime.WithHTTPPathPattern("/api/v1/db/etc-num/overlaps"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_ETCNumService_ListOverlaps_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_ETCNumService_ListOverlaps_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+
+	return nil
+}
+
+// RegisterDb_DTakoFerryRowsProdServiceHandlerServer registers the http handlers for service Db_DTakoFerryRowsProdService to "mux".
+// UnaryRPC     :call Db_DTakoFerryRowsProdServiceServer directly.
+// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_DTakoFerryRowsProdServiceHandlerFromEndpoint instead.
+// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
+func RegisterDb_DTakoFerryRowsProdServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_DTakoFerryRowsProdServiceServer) error {
+	mux.Handle(http.MethodGet, pattern_Db_DTakoFerryRowsProdService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoFerryRowsProdService/Get", runtime.WithHTTPPathPattern("/api/v1/db/dtako-ferry-rows-prod/{id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_DTakoFerryRowsProdService_Get_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_DTakoFerryRowsProdService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_DTakoFerryRowsProdService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoFerryRowsProdService/List", runtime.WithHTTPPathPattern("/api/v1/db/dtako-ferry-rows-prod"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_DTakoFerryRowsProdService_List_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_DTakoFerryRowsProdService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_DTakoFerryRowsProdService_GetByUnkoNo_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoFerryRowsProdService/GetByUnkoNo", runtime.WithHTTPPathPattern("/api/v1/db/dtako-ferry-rows-prod/by-unko-no/{unko_no}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_DTakoFerryRowsProdService_GetByUnkoNo_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_DTakoFerryRowsProdService_GetByUnkoNo_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+
+	return nil
+}
+
+// RegisterDb_CarsServiceHandlerServer registers the http handlers for service Db_CarsService to "mux".
+// UnaryRPC     :call Db_CarsServiceServer directly.
+// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_CarsServiceHandlerFromEndpoint instead.
+// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
+func RegisterDb_CarsServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_CarsServiceServer) error {
+	mux.Handle(http.MethodGet, pattern_Db_CarsService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_CarsService/Get", runtime.WithHTTPPathPattern("/api/v1/db/cars/{id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_CarsService_Get_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_CarsService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_CarsService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_CarsService/List", runtime.WithHTTPPathPattern("/api/v1/db/cars"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_CarsService_List_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_CarsService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_CarsService_GetByBumonCodeID_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_CarsService/GetByBumonCodeID", runtime.WithHTTPPathPattern("/api/v1/db/cars/bumon/{bumon_code_id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_CarsService_GetByBumonCodeID_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_CarsService_GetByBumonCodeID_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+
+	return nil
+}
+
+// RegisterDb_DriversServiceHandlerServer registers the http handlers for service Db_DriversService to "mux".
+// UnaryRPC     :call Db_DriversServiceServer directly.
+// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_DriversServiceHandlerFromEndpoint instead.
+// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
+func RegisterDb_DriversServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_DriversServiceServer) error {
+	mux.Handle(http.MethodGet, pattern_Db_DriversService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DriversService/Get", runtime.WithHTTPPathPattern("/api/v1/db/drivers/{id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_DriversService_Get_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_DriversService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_DriversService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DriversService/List", runtime.WithHTTPPathPattern("/api/v1/db/drivers"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_DriversService_List_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_DriversService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_DriversService_GetByBumon_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DriversService/GetByBumon", runtime.WithHTTPPathPattern("/api/v1/db/drivers/bumon/{bumon}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_DriversService_GetByBumon_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_DriversService_GetByBumon_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+
+	return nil
+}
+
+// RegisterDb_UntenNippoMeisaiServiceHandlerServer registers the http handlers for service Db_UntenNippoMeisaiService to "mux".
+// UnaryRPC     :call Db_UntenNippoMeisaiServiceServer directly.
+// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_UntenNippoMeisaiServiceHandlerFromEndpoint instead.
+// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
+func RegisterDb_UntenNippoMeisaiServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_UntenNippoMeisaiServiceServer) error {
+	mux.Handle(http.MethodGet, pattern_Db_UntenNippoMeisaiService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_UntenNippoMeisaiService/Get", runtime.WithHTTPPathPattern("/api/v1/db/unten-nippo-meisai/{nippo_k}/{haisha_k}/{sharyo_c}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_UntenNippoMeisaiService_Get_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_UntenNippoMeisaiService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_UntenNippoMeisaiService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_UntenNippoMeisaiService/List", runtime.WithHTTPPathPattern("/api/v1/db/unten-nippo-meisai"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_UntenNippoMeisaiService_List_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_UntenNippoMeisaiService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+
+	mux.Handle(http.MethodGet, pattern_Db_UntenNippoMeisaiService_Stream_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		err := status.Error(codes.Unimplemented, "streaming calls are not yet supported in the in-process transport")
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+		return
+	})
+	mux.Handle(http.MethodGet, pattern_Db_UntenNippoMeisaiService_GetBySharyoC_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_UntenNippoMeisaiService/GetBySharyoC", runtime.WithHTTPPathPattern("/api/v1/db/unten-nippo-meisai/sharyo/{sharyo_c}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_UntenNippoMeisaiService_GetBySharyoC_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_UntenNippoMeisaiService_GetBySharyoC_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_UntenNippoMeisaiService_GetByDateRange_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_UntenNippoMeisaiService/GetByDateRange", runtime.WithHTTPPathPattern("/api/v1/db/unten-nippo-meisai/date-range"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_UntenNippoMeisaiService_GetByDateRange_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_UntenNippoMeisaiService_GetByDateRange_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_UntenNippoMeisaiService_GetByBumon_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_UntenNippoMeisaiService/GetByBumon", runtime.WithHTTPPathPattern("/api/v1/db/unten-nippo-meisai/bumon/{bumon}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_UntenNippoMeisaiService_GetByBumon_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_UntenNippoMeisaiService_GetByBumon_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+
+	return nil
+}
+
+// RegisterDb_ShainMasterServiceHandlerServer registers the http handlers for service Db_ShainMasterService to "mux".
+// UnaryRPC     :call Db_ShainMasterServiceServer directly.
+// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_ShainMasterServiceHandlerFromEndpoint instead.
+// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
+func RegisterDb_ShainMasterServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_ShainMasterServiceServer) error {
+	mux.Handle(http.MethodGet, pattern_Db_ShainMasterService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ShainMasterService/Get", runtime.WithHTTPPathPattern("/api/v1/db/shain-master/{shain_c}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_ShainMasterService_Get_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_ShainMasterService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_ShainMasterService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ShainMasterService/List", runtime.WithHTTPPathPattern("/api/v1/db/shain-master"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_ShainMasterService_List_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_ShainMasterService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_ShainMasterService_GetByBumonC_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ShainMasterService/GetByBumonC", runtime.WithHTTPPathPattern("/api/v1/db/shain-master/bumon/{bumon_c}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_ShainMasterService_GetByBumonC_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_ShainMasterService_GetByBumonC_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+
+	return nil
+}
+
+// RegisterDb_ChiikiMasterServiceHandlerServer registers the http handlers for service Db_ChiikiMasterService to "mux".
+// UnaryRPC     :call Db_ChiikiMasterServiceServer directly.
+// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_ChiikiMasterServiceHandlerFromEndpoint instead.
+// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
+func RegisterDb_ChiikiMasterServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_ChiikiMasterServiceServer) error {
+	mux.Handle(http.MethodGet, pattern_Db_ChiikiMasterService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ChiikiMasterService/Get", runtime.WithHTTPPathPattern("/api/v1/db/chiiki-master/{chiiki_c}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_ChiikiMasterService_Get_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_ChiikiMasterService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_ChiikiMasterService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ChiikiMasterService/List", runtime.WithHTTPPathPattern("/api/v1/db/chiiki-master"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_ChiikiMasterService_List_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_ChiikiMasterService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+
+	return nil
+}
+
+// RegisterDb_ChikuMasterServiceHandlerServer registers the http handlers for service Db_ChikuMasterService to "mux".
+// UnaryRPC     :call Db_ChikuMasterServiceServer directly.
+// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_ChikuMasterServiceHandlerFromEndpoint instead.
+// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
+func RegisterDb_ChikuMasterServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_ChikuMasterServiceServer) error {
+	mux.Handle(http.MethodGet, pattern_Db_ChikuMasterService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ChikuMasterService/Get", runtime.WithHTTPPathPattern("/api/v1/db/chiku-master/{chiku_c}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_ChikuMasterService_Get_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_ChikuMasterService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_ChikuMasterService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ChikuMasterService/List", runtime.WithHTTPPathPattern("/api/v1/db/chiku-master"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_ChikuMasterService_List_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_ChikuMasterService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_ChikuMasterService_GetByChiikiC_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ChikuMasterService/GetByChiikiC", runtime.WithHTTPPathPattern("/api/v1/db/chiku-master/chiiki/{chiiki_c}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_ChikuMasterService_GetByChiikiC_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_ChikuMasterService_GetByChiikiC_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+
+	return nil
+}
+
+// RegisterDb_TokuisakiMasterServiceHandlerServer registers the http handlers for service Db_TokuisakiMasterService to "mux".
+// UnaryRPC     :call Db_TokuisakiMasterServiceServer directly.
+// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_TokuisakiMasterServiceHandlerFromEndpoint instead.
+// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
+func RegisterDb_TokuisakiMasterServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_TokuisakiMasterServiceServer) error {
+	mux.Handle(http.MethodGet, pattern_Db_TokuisakiMasterService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_TokuisakiMasterService/Get", runtime.WithHTTPPathPattern("/api/v1/db/tokuisaki-master/{tokuisaki_c}/{tokuisaki_h}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_TokuisakiMasterService_Get_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_TokuisakiMasterService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_TokuisakiMasterService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_TokuisakiMasterService/List", runtime.WithHTTPPathPattern("/api/v1/db/tokuisaki-master"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_TokuisakiMasterService_List_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_TokuisakiMasterService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_TokuisakiMasterService_Search_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_TokuisakiMasterService/Search", runtime.WithHTTPPathPattern("/api/v1/db/tokuisaki-master/search"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_TokuisakiMasterService_Search_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_TokuisakiMasterService_Search_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_TokuisakiMasterService_ListTekiyobi_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_TokuisakiMasterService/ListTekiyobi", runtime.WithHTTPPathPattern("/api/v1/db/tokuisaki-master/{tokuisaki_c}/{tokuisaki_h}/tekiyobi"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_TokuisakiMasterService_ListTekiyobi_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_TokuisakiMasterService_ListTekiyobi_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_TokuisakiMasterService_GetTekiyobiAt_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_TokuisakiMasterService/GetTekiyobiAt", runtime.WithHTTPPathPattern("/api/v1/db/tokuisaki-master/{tokuisaki_c}/{tokuisaki_h}/tekiyobi/at"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_TokuisakiMasterService_GetTekiyobiAt_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_TokuisakiMasterService_GetTekiyobiAt_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+
+	return nil
+}
+
+// RegisterDb_HinmeiMasterServiceHandlerServer registers the http handlers for service Db_HinmeiMasterService to "mux".
+// UnaryRPC     :call Db_HinmeiMasterServiceServer directly.
+// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_HinmeiMasterServiceHandlerFromEndpoint instead.
+// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
+func RegisterDb_HinmeiMasterServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_HinmeiMasterServiceServer) error {
+	mux.Handle(http.MethodGet, pattern_Db_HinmeiMasterService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_HinmeiMasterService/Get", runtime.WithHTTPPathPattern("/api/v1/db/hinmei-master/{hinmei_c}/{hinmei_h}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_HinmeiMasterService_Get_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_HinmeiMasterService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_HinmeiMasterService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_HinmeiMasterService/List", runtime.WithHTTPPathPattern("/api/v1/db/hinmei-master"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_HinmeiMasterService_List_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_HinmeiMasterService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_HinmeiMasterService_Search_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_HinmeiMasterService/Search", runtime.WithHTTPPathPattern("/api/v1/db/hinmei-master/search"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_HinmeiMasterService_Search_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_HinmeiMasterService_Search_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_HinmeiMasterService_ListTokuisakiHinmei_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_HinmeiMasterService/ListTokuisakiHinmei", runtime.WithHTTPPathPattern("/api/v1/db/tokuisaki-master/{tokuisaki_c}/{tokuisaki_h}/hinmei"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_HinmeiMasterService_ListTokuisakiHinmei_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_HinmeiMasterService_ListTokuisakiHinmei_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_HinmeiMasterService_GetTokuisakiHinmei_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_HinmeiMasterService/GetTokuisakiHinmei", runtime.WithHTTPPathPattern("/api/v1/db/tokuisaki-master/{tokuisaki_c}/{tokuisaki_h}/hinmei/{hinmei_c}/{hinmei_h}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_HinmeiMasterService_GetTokuisakiHinmei_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_HinmeiMasterService_GetTokuisakiHinmei_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_HinmeiMasterService_Resolve_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_HinmeiMasterService/Resolve", runtime.WithHTTPPathPattern("/api/v1/db/hinmei-master/{hinmei_c}/{hinmei_h}/resolve"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_HinmeiMasterService_Resolve_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_HinmeiMasterService_Resolve_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+
+	return nil
+}
+
+// RegisterDb_BumonMasterServiceHandlerServer registers the http handlers for service Db_BumonMasterService to "mux".
+// UnaryRPC     :call Db_BumonMasterServiceServer directly.
+// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_BumonMasterServiceHandlerFromEndpoint instead.
+// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
+func RegisterDb_BumonMasterServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_BumonMasterServiceServer) error {
+	mux.Handle(http.MethodGet, pattern_Db_BumonMasterService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_BumonMasterService/Get", runtime.WithHTTPPathPattern("/api/v1/db/bumon-master/{bumon_c}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_BumonMasterService_Get_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_BumonMasterService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_BumonMasterService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_BumonMasterService/List", runtime.WithHTTPPathPattern("/api/v1/db/bumon-master"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_BumonMasterService_List_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_BumonMasterService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+
+	return nil
+}
+
+// RegisterDb_SharyoMasterServiceHandlerServer registers the http handlers for service Db_SharyoMasterService to "mux".
+// UnaryRPC     :call Db_SharyoMasterServiceServer directly.
+// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_SharyoMasterServiceHandlerFromEndpoint instead.
+// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
+func RegisterDb_SharyoMasterServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_SharyoMasterServiceServer) error {
+	mux.Handle(http.MethodGet, pattern_Db_SharyoMasterService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_SharyoMasterService/Get", runtime.WithHTTPPathPattern("/api/v1/db/sharyo-master/{sharyo_c}/{sharyo_h}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_SharyoMasterService_Get_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_SharyoMasterService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_SharyoMasterService_ListBySharyoC_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_SharyoMasterService/ListBySharyoC", runtime.WithHTTPPathPattern("/api/v1/db/sharyo-master/{sharyo_c}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_SharyoMasterService_ListBySharyoC_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_SharyoMasterService_ListBySharyoC_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_SharyoMasterService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_SharyoMasterService/List", runtime.WithHTTPPathPattern("/api/v1/db/sharyo-master"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_SharyoMasterService_List_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_SharyoMasterService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+
+	return nil
+}
+
+// RegisterDb_VehicleIdentityServiceHandlerServer registers the http handlers for service Db_VehicleIdentityService to "mux".
+// UnaryRPC     :call Db_VehicleIdentityServiceServer directly.
+// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_VehicleIdentityServiceHandlerFromEndpoint instead.
+// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
+func RegisterDb_VehicleIdentityServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_VehicleIdentityServiceServer) error {
+	mux.Handle(http.MethodGet, pattern_Db_VehicleIdentityService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_VehicleIdentityService/Get", runtime.WithHTTPPathPattern("/api/v1/db/vehicle-identity"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_VehicleIdentityService_Get_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_VehicleIdentityService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_VehicleIdentityService_ListGaps_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_VehicleIdentityService/ListGaps", runtime.WithHTTPPathPattern("/api/v1/db/vehicle-identity/gaps"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_VehicleIdentityService_ListGaps_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_VehicleIdentityService_ListGaps_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+
+	return nil
+}
+
+// RegisterDb_TimeCardServiceHandlerServer registers the http handlers for service Db_TimeCardService to "mux".
+// UnaryRPC     :call Db_TimeCardServiceServer directly.
+// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_TimeCardServiceHandlerFromEndpoint instead.
+// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
+func RegisterDb_TimeCardServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_TimeCardServiceServer) error {
+	mux.Handle(http.MethodGet, pattern_Db_TimeCardService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_TimeCardService/Get", runtime.WithHTTPPathPattern("/api/v1/db/time-card/{id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_TimeCardService_Get_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_TimeCardService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_TimeCardService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_TimeCardService/List", runtime.WithHTTPPathPattern("/api/v1/db/time-card"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_TimeCardService_List_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_TimeCardService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+
+	return nil
+}
+
+// RegisterDb_TimeCardDevServiceHandlerServer registers the http handlers for service Db_TimeCardDevService to "mux".
+// UnaryRPC     :call Db_TimeCardDevServiceServer directly.
+// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_TimeCardDevServiceHandlerFromEndpoint instead.
+// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
+func RegisterDb_TimeCardDevServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_TimeCardDevServiceServer) error {
+	mux.Handle(http.MethodPost, pattern_Db_TimeCardDevService_Create_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_TimeCardDevService/Create", runtime.WithHTTPPathPattern("/api/v1/db/time-card-dev"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_TimeCardDevService_Create_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_TimeCardDevService_Create_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_TimeCardDevService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_TimeCardDevService/Get", runtime.WithHTTPPathPattern("/api/v1/db/time-card-dev/{id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_TimeCardDevService_Get_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_TimeCardDevService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodPut, pattern_Db_TimeCardDevService_Update_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_TimeCardDevService/Update", runtime.WithHTTPPathPattern("/api/v1/db/time-card-dev/{time_card.id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_TimeCardDevService_Update_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_TimeCardDevService_Update_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodDelete, pattern_Db_TimeCardDevService_Delete_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_TimeCardDevService/Delete", runtime.WithHTTPPathPattern("/api/v1/db/time-card-dev/{id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_TimeCardDevService_Delete_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_TimeCardDevService_Delete_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_TimeCardDevService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_TimeCardDevService/List", runtime.WithHTTPPathPattern("/api/v1/db/time-card-dev"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_TimeCardDevService_List_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_TimeCardDevService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+
+	return nil
+}
+
+// RegisterDb_TimeCardLogServiceHandlerServer registers the http handlers for service Db_TimeCardLogService to "mux".
+// UnaryRPC     :call Db_TimeCardLogServiceServer directly.
+// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_TimeCardLogServiceHandlerFromEndpoint instead.
+// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
+func RegisterDb_TimeCardLogServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_TimeCardLogServiceServer) error {
+	mux.Handle(http.MethodPost, pattern_Db_TimeCardLogService_Create_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_TimeCardLogService/Create", runtime.WithHTTPPathPattern("/api/v1/db/time-card-log"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_TimeCardLogService_Create_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_TimeCardLogService_Create_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_TimeCardLogService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_TimeCardLogService/Get", runtime.WithHTTPPathPattern("/api/v1/db/time-card-log/{id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_TimeCardLogService_Get_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_TimeCardLogService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodPut, pattern_Db_TimeCardLogService_Update_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_TimeCardLogService/Update", runtime.WithHTTPPathPattern("/api/v1/db/time-card-log/{log.id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_TimeCardLogService_Update_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_TimeCardLogService_Update_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodDelete, pattern_Db_TimeCardLogService_Delete_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_TimeCardLogService/Delete", runtime.WithHTTPPathPattern("/api/v1/db/time-card-log/{id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_TimeCardLogService_Delete_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_TimeCardLogService_Delete_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_TimeCardLogService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_TimeCardLogService/List", runtime.WithHTTPPathPattern("/api/v1/db/time-card-log"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_TimeCardLogService_List_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_TimeCardLogService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_TimeCardLogService_GetByCardID_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_TimeCardLogService/GetByCardID", runtime.WithHTTPPathPattern("/api/v1/db/time-card-log/by-card-id/{card_id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_TimeCardLogService_GetByCardID_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_TimeCardLogService_GetByCardID_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+
+	return nil
+}
+
+// RegisterDb_EmployeeIdentityServiceHandlerServer registers the http handlers for service Db_EmployeeIdentityService to "mux".
+// UnaryRPC     :call Db_EmployeeIdentityServiceServer directly.
+// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_EmployeeIdentityServiceHandlerFromEndpoint instead.
+// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
+func RegisterDb_EmployeeIdentityServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_EmployeeIdentityServiceServer) error {
+	mux.Handle(http.MethodPost, pattern_Db_EmployeeIdentityService_Create_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_EmployeeIdentityService/Create", runtime.WithHTTPPathPattern("/api/v1/db/employee-identity"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_EmployeeIdentityService_Create_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_EmployeeIdentityService_Create_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_EmployeeIdentityService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_EmployeeIdentityService/Get", runtime.WithHTTPPathPattern("/api/v1/db/employee-identity/{id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_EmployeeIdentityService_Get_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_EmployeeIdentityService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodPut, pattern_Db_EmployeeIdentityService_Update_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_EmployeeIdentityService/Update", runtime.WithHTTPPathPattern("/api/v1/db/employee-identity/{employee.id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_EmployeeIdentityService_Update_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_EmployeeIdentityService_Update_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodDelete, pattern_Db_EmployeeIdentityService_Delete_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_EmployeeIdentityService/Delete", runtime.WithHTTPPathPattern("/api/v1/db/employee-identity/{id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_EmployeeIdentityService_Delete_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_EmployeeIdentityService_Delete_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_EmployeeIdentityService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_EmployeeIdentityService/List", runtime.WithHTTPPathPattern("/api/v1/db/employee-identity"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_EmployeeIdentityService_List_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_EmployeeIdentityService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_EmployeeIdentityService_Resolve_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_EmployeeIdentityService/Resolve", runtime.WithHTTPPathPattern("/api/v1/db/employee-identity/resolve"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_EmployeeIdentityService_Resolve_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_EmployeeIdentityService_Resolve_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodPost, pattern_Db_EmployeeIdentityService_RegisterCard_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_EmployeeIdentityService/RegisterCard", runtime.WithHTTPPathPattern("/api/v1/db/employee-identity/{employee_id}/cards"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_EmployeeIdentityService_RegisterCard_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_EmployeeIdentityService_RegisterCard_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodDelete, pattern_Db_EmployeeIdentityService_UnregisterCard_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_EmployeeIdentityService/UnregisterCard", runtime.WithHTTPPathPattern("/api/v1/db/employee-identity/cards/{card_id}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_DTakoUriageKeihiService_List_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		resp, md, err := local_request_Db_EmployeeIdentityService_UnregisterCard_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_DTakoUriageKeihiService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_EmployeeIdentityService_UnregisterCard_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_EmployeeIdentityService_ListUnlinked_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_EmployeeIdentityService/ListUnlinked", runtime.WithHTTPPathPattern("/api/v1/db/employee-identity/unlinked"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Db_EmployeeIdentityService_ListUnlinked_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_EmployeeIdentityService_ListUnlinked_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
 
 	return nil
 }
 
-// RegisterDb_ETCMeisaiServiceHandlerServer registers the http handlers for service Db_ETCMeisaiService to "mux".
-// UnaryRPC     :call Db_ETCMeisaiServiceServer directly.
+// RegisterDb_RegistryServiceHandlerServer registers the http handlers for service Db_RegistryService to "mux".
+// UnaryRPC     :call Db_RegistryServiceServer directly.
 // StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
-// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_ETCMeisaiServiceHandlerFromEndpoint instead.
+// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_RegistryServiceHandlerFromEndpoint instead.
 // GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
-func RegisterDb_ETCMeisaiServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_ETCMeisaiServiceServer) error {
-	mux.Handle(http.MethodPost, pattern_Db_ETCMeisaiService_Create_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+func RegisterDb_RegistryServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_RegistryServiceServer) error {
+	mux.Handle(http.MethodGet, pattern_Db_RegistryService_GetAvailability_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ETCMeisaiService/Create", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai"))
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_RegistryService/GetAvailability", runtime.WithHTTPPathPattern("/api/v1/db/registry/availability"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_ETCMeisaiService_Create_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		resp, md, err := local_request_Db_RegistryService_GetAvailability_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		forward_Db_RegistryService_GetAvailability_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+
+	return nil
+}
+
+// RegisterDb_DTakoUriageKeihiServiceHandlerFromEndpoint is same as RegisterDb_DTakoUriageKeihiServiceHandler but
+// automatically dials to "endpoint" and closes the connection when "ctx" gets done.
+func RegisterDb_DTakoUriageKeihiServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+	conn, err := grpc.NewClient(endpoint, opts...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Errorf("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+			return
+		}
+		go func() {
+			<-ctx.Done()
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Errorf("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+		}()
+	}()
+	return RegisterDb_DTakoUriageKeihiServiceHandler(ctx, mux, conn)
+}
+
+// RegisterDb_DTakoUriageKeihiServiceHandler registers the http handlers for service Db_DTakoUriageKeihiService to "mux".
+// The handlers forward requests to the grpc endpoint over "conn".
+func RegisterDb_DTakoUriageKeihiServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterDb_DTakoUriageKeihiServiceHandlerClient(ctx, mux, NewDb_DTakoUriageKeihiServiceClient(conn))
+}
+
+// RegisterDb_DTakoUriageKeihiServiceHandlerClient registers the http handlers for service Db_DTakoUriageKeihiService
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_DTakoUriageKeihiServiceClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_DTakoUriageKeihiServiceClient"
+// doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
+// "Db_DTakoUriageKeihiServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
+func RegisterDb_DTakoUriageKeihiServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_DTakoUriageKeihiServiceClient) error {
+	mux.Handle(http.MethodPost, pattern_Db_DTakoUriageKeihiService_Create_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoUriageKeihiService/Create", runtime.WithHTTPPathPattern("/api/v1/db/dtako-uriage-keihi"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_Db_DTakoUriageKeihiService_Create_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_DTakoUriageKeihiService_Create_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_DTakoUriageKeihiService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoUriageKeihiService/Get", runtime.WithHTTPPathPattern("/api/v1/db/dtako-uriage-keihi/{srch_id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_Db_DTakoUriageKeihiService_Get_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_DTakoUriageKeihiService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodPut, pattern_Db_DTakoUriageKeihiService_Update_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoUriageKeihiService/Update", runtime.WithHTTPPathPattern("/api/v1/db/dtako-uriage-keihi/{dtako_uriage_keihi.srch_id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_Db_DTakoUriageKeihiService_Update_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_DTakoUriageKeihiService_Update_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodDelete, pattern_Db_DTakoUriageKeihiService_Delete_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoUriageKeihiService/Delete", runtime.WithHTTPPathPattern("/api/v1/db/dtako-uriage-keihi/{srch_id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_Db_DTakoUriageKeihiService_Delete_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_DTakoUriageKeihiService_Delete_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_DTakoUriageKeihiService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoUriageKeihiService/List", runtime.WithHTTPPathPattern("/api/v1/db/dtako-uriage-keihi"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_Db_DTakoUriageKeihiService_List_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_DTakoUriageKeihiService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	return nil
+}
+
+var (
+	pattern_Db_DTakoUriageKeihiService_Create_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "dtako-uriage-keihi"}, ""))
+	pattern_Db_DTakoUriageKeihiService_Get_0    = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "dtako-uriage-keihi", "srch_id"}, ""))
+	pattern_Db_DTakoUriageKeihiService_Update_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "dtako-uriage-keihi", "dtako_uriage_keihi.srch_id"}, ""))
+	pattern_Db_DTakoUriageKeihiService_Delete_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "dtako-uriage-keihi", "srch_id"}, ""))
+	pattern_Db_DTakoUriageKeihiService_List_0   = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "dtako-uriage-keihi"}, ""))
+)
+
+var (
+	forward_Db_DTakoUriageKeihiService_Create_0 = runtime.ForwardResponseMessage
+	forward_Db_DTakoUriageKeihiService_Get_0    = runtime.ForwardResponseMessage
+	forward_Db_DTakoUriageKeihiService_Update_0 = runtime.ForwardResponseMessage
+	forward_Db_DTakoUriageKeihiService_Delete_0 = runtime.ForwardResponseMessage
+	forward_Db_DTakoUriageKeihiService_List_0   = runtime.ForwardResponseMessage
+)
+
+// RegisterDb_ETCMeisaiServiceHandlerFromEndpoint is same as RegisterDb_ETCMeisaiServiceHandler but
+// automatically dials to "endpoint" and closes the connection when "ctx" gets done.
+func RegisterDb_ETCMeisaiServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+	conn, err := grpc.NewClient(endpoint, opts...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Errorf("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+			return
+		}
+		go func() {
+			<-ctx.Done()
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Errorf("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+		}()
+	}()
+	return RegisterDb_ETCMeisaiServiceHandler(ctx, mux, conn)
+}
+
+// RegisterDb_ETCMeisaiServiceHandler registers the http handlers for service Db_ETCMeisaiService to "mux".
+// The handlers forward requests to the grpc endpoint over "conn".
+func RegisterDb_ETCMeisaiServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterDb_ETCMeisaiServiceHandlerClient(ctx, mux, NewDb_ETCMeisaiServiceClient(conn))
+}
+
+// RegisterDb_ETCMeisaiServiceHandlerClient registers the http handlers for service Db_ETCMeisaiService
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_ETCMeisaiServiceClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_ETCMeisaiServiceClient"
+// doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
+// "Db_ETCMeisaiServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
+func RegisterDb_ETCMeisaiServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_ETCMeisaiServiceClient) error {
+	mux.Handle(http.MethodPost, pattern_Db_ETCMeisaiService_Create_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ETCMeisaiService/Create", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_Db_ETCMeisaiService_Create_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
 		forward_Db_ETCMeisaiService_Create_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
 	mux.Handle(http.MethodGet, pattern_Db_ETCMeisaiService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ETCMeisaiService/Get", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai/{id}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ETCMeisaiService/Get", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai/{id}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_ETCMeisaiService_Get_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_ETCMeisaiService_Get_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
@@ -2286,16 +6998,13 @@ func RegisterDb_ETCMeisaiServiceHandlerServer(ctx context.Context, mux *runtime.
 	mux.Handle(http.MethodPut, pattern_Db_ETCMeisaiService_Update_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ETCMeisaiService/Update", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai/{etc_meisai.id}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ETCMeisaiService/Update", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai/{etc_meisai.id}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_ETCMeisaiService_Update_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_ETCMeisaiService_Update_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
@@ -2306,16 +7015,13 @@ func RegisterDb_ETCMeisaiServiceHandlerServer(ctx context.Context, mux *runtime.
 	mux.Handle(http.MethodDelete, pattern_Db_ETCMeisaiService_Delete_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ETCMeisaiService/Delete", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai/{id}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ETCMeisaiService/Delete", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai/{id}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
-		}
-		resp, md, err := local_request_Db_ETCMeisaiService_Delete_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		}
+		resp, md, err := request_Db_ETCMeisaiService_Delete_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
@@ -2326,16 +7032,13 @@ func RegisterDb_ETCMeisaiServiceHandlerServer(ctx context.Context, mux *runtime.
 	mux.Handle(http.MethodGet, pattern_Db_ETCMeisaiService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ETCMeisaiService/List", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ETCMeisaiService/List", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_ETCMeisaiService_List_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_ETCMeisaiService_List_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
@@ -2343,1023 +7046,1340 @@ func RegisterDb_ETCMeisaiServiceHandlerServer(ctx context.Context, mux *runtime.
 		}
 		forward_Db_ETCMeisaiService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-
-	return nil
-}
-
-// RegisterDb_DTakoFerryRowsServiceHandlerServer registers the http handlers for service Db_DTakoFerryRowsService to "mux".
-// UnaryRPC     :call Db_DTakoFerryRowsServiceServer directly.
-// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
-// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_DTakoFerryRowsServiceHandlerFromEndpoint instead.
-// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
-func RegisterDb_DTakoFerryRowsServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_DTakoFerryRowsServiceServer) error {
-	mux.Handle(http.MethodPost, pattern_Db_DTakoFerryRowsService_Create_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_ETCMeisaiService_Stream_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoFerryRowsService/Create", runtime.WithHTTPPathPattern("/api/v1/db/dtako-ferry-rows"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ETCMeisaiService/Stream", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai/stream"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_DTakoFerryRowsService_Create_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_ETCMeisaiService_Stream_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_DTakoFerryRowsService_Create_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_ETCMeisaiService_Stream_0(annotatedContext, mux, outboundMarshaler, w, req, func() (proto.Message, error) { return resp.Recv() }, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_DTakoFerryRowsService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodPost, pattern_Db_ETCMeisaiService_BatchCreate_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoFerryRowsService/Get", runtime.WithHTTPPathPattern("/api/v1/db/dtako-ferry-rows/{id}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ETCMeisaiService/BatchCreate", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai/batch"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_DTakoFerryRowsService_Get_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_ETCMeisaiService_BatchCreate_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_DTakoFerryRowsService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_ETCMeisaiService_BatchCreate_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodPut, pattern_Db_DTakoFerryRowsService_Update_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodPost, pattern_Db_ETCMeisaiService_Import_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoFerryRowsService/Update", runtime.WithHTTPPathPattern("/api/v1/db/dtako-ferry-rows/{dtako_ferry_rows.id}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ETCMeisaiService/Import", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai/import"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_DTakoFerryRowsService_Update_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_ETCMeisaiService_Import_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_DTakoFerryRowsService_Update_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_ETCMeisaiService_Import_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodDelete, pattern_Db_DTakoFerryRowsService_Delete_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	return nil
+}
+
+var (
+	pattern_Db_ETCMeisaiService_Create_0      = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "etc-meisai"}, ""))
+	pattern_Db_ETCMeisaiService_Get_0         = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "etc-meisai", "id"}, ""))
+	pattern_Db_ETCMeisaiService_Update_0      = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "etc-meisai", "etc_meisai.id"}, ""))
+	pattern_Db_ETCMeisaiService_Delete_0      = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "etc-meisai", "id"}, ""))
+	pattern_Db_ETCMeisaiService_List_0        = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "etc-meisai"}, ""))
+	pattern_Db_ETCMeisaiService_Stream_0      = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4}, []string{"api", "v1", "db", "etc-meisai", "stream"}, ""))
+	pattern_Db_ETCMeisaiService_BatchCreate_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4}, []string{"api", "v1", "db", "etc-meisai", "batch"}, ""))
+	pattern_Db_ETCMeisaiService_Import_0      = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4}, []string{"api", "v1", "db", "etc-meisai", "import"}, ""))
+)
+
+var (
+	forward_Db_ETCMeisaiService_Create_0      = runtime.ForwardResponseMessage
+	forward_Db_ETCMeisaiService_Get_0         = runtime.ForwardResponseMessage
+	forward_Db_ETCMeisaiService_Update_0      = runtime.ForwardResponseMessage
+	forward_Db_ETCMeisaiService_Delete_0      = runtime.ForwardResponseMessage
+	forward_Db_ETCMeisaiService_List_0        = runtime.ForwardResponseMessage
+	forward_Db_ETCMeisaiService_Stream_0      = runtime.ForwardResponseStream
+	forward_Db_ETCMeisaiService_BatchCreate_0 = runtime.ForwardResponseMessage
+	forward_Db_ETCMeisaiService_Import_0      = runtime.ForwardResponseMessage
+)
+
+// RegisterDb_DTakoFerryRowsServiceHandlerFromEndpoint is same as RegisterDb_DTakoFerryRowsServiceHandler but
+// automatically dials to "endpoint" and closes the connection when "ctx" gets done.
+func RegisterDb_DTakoFerryRowsServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+	conn, err := grpc.NewClient(endpoint, opts...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Errorf("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+			return
+		}
+		go func() {
+			<-ctx.Done()
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Errorf("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+		}()
+	}()
+	return RegisterDb_DTakoFerryRowsServiceHandler(ctx, mux, conn)
+}
+
+// RegisterDb_DTakoFerryRowsServiceHandler registers the http handlers for service Db_DTakoFerryRowsService to "mux".
+// The handlers forward requests to the grpc endpoint over "conn".
+func RegisterDb_DTakoFerryRowsServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterDb_DTakoFerryRowsServiceHandlerClient(ctx, mux, NewDb_DTakoFerryRowsServiceClient(conn))
+}
+
+// RegisterDb_DTakoFerryRowsServiceHandlerClient registers the http handlers for service Db_DTakoFerryRowsService
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_DTakoFerryRowsServiceClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_DTakoFerryRowsServiceClient"
+// doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
+// "Db_DTakoFerryRowsServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
+func RegisterDb_DTakoFerryRowsServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_DTakoFerryRowsServiceClient) error {
+	mux.Handle(http.MethodPost, pattern_Db_DTakoFerryRowsService_Create_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoFerryRowsService/Delete", runtime.WithHTTPPathPattern("/api/v1/db/dtako-ferry-rows/{id}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoFerryRowsService/Create", runtime.WithHTTPPathPattern("/api/v1/db/dtako-ferry-rows"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_DTakoFerryRowsService_Delete_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_DTakoFerryRowsService_Create_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_DTakoFerryRowsService_Delete_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_DTakoFerryRowsService_Create_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_DTakoFerryRowsService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_DTakoFerryRowsService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoFerryRowsService/List", runtime.WithHTTPPathPattern("/api/v1/db/dtako-ferry-rows"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoFerryRowsService/Get", runtime.WithHTTPPathPattern("/api/v1/db/dtako-ferry-rows/{id}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_DTakoFerryRowsService_List_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_DTakoFerryRowsService_Get_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_DTakoFerryRowsService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_DTakoFerryRowsService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-
-	return nil
-}
-
-// RegisterDb_ETCMeisaiMappingServiceHandlerServer registers the http handlers for service Db_ETCMeisaiMappingService to "mux".
-// UnaryRPC     :call Db_ETCMeisaiMappingServiceServer directly.
-// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
-// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_ETCMeisaiMappingServiceHandlerFromEndpoint instead.
-// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
-func RegisterDb_ETCMeisaiMappingServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_ETCMeisaiMappingServiceServer) error {
-	mux.Handle(http.MethodPost, pattern_Db_ETCMeisaiMappingService_Create_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodPut, pattern_Db_DTakoFerryRowsService_Update_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ETCMeisaiMappingService/Create", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai-mapping"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoFerryRowsService/Update", runtime.WithHTTPPathPattern("/api/v1/db/dtako-ferry-rows/{dtako_ferry_rows.id}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_ETCMeisaiMappingService_Create_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_DTakoFerryRowsService_Update_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_ETCMeisaiMappingService_Create_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_DTakoFerryRowsService_Update_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_ETCMeisaiMappingService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodDelete, pattern_Db_DTakoFerryRowsService_Delete_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ETCMeisaiMappingService/Get", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai-mapping/{id}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoFerryRowsService/Delete", runtime.WithHTTPPathPattern("/api/v1/db/dtako-ferry-rows/{id}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_ETCMeisaiMappingService_Get_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_DTakoFerryRowsService_Delete_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_ETCMeisaiMappingService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_DTakoFerryRowsService_Delete_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodPut, pattern_Db_ETCMeisaiMappingService_Update_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_DTakoFerryRowsService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ETCMeisaiMappingService/Update", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai-mapping/{etc_meisai_mapping.id}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoFerryRowsService/List", runtime.WithHTTPPathPattern("/api/v1/db/dtako-ferry-rows"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_ETCMeisaiMappingService_Update_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_DTakoFerryRowsService_List_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_ETCMeisaiMappingService_Update_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_DTakoFerryRowsService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodDelete, pattern_Db_ETCMeisaiMappingService_Delete_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	return nil
+}
+
+var (
+	pattern_Db_DTakoFerryRowsService_Create_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "dtako-ferry-rows"}, ""))
+	pattern_Db_DTakoFerryRowsService_Get_0    = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "dtako-ferry-rows", "id"}, ""))
+	pattern_Db_DTakoFerryRowsService_Update_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "dtako-ferry-rows", "dtako_ferry_rows.id"}, ""))
+	pattern_Db_DTakoFerryRowsService_Delete_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "dtako-ferry-rows", "id"}, ""))
+	pattern_Db_DTakoFerryRowsService_List_0   = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "dtako-ferry-rows"}, ""))
+)
+
+var (
+	forward_Db_DTakoFerryRowsService_Create_0 = runtime.ForwardResponseMessage
+	forward_Db_DTakoFerryRowsService_Get_0    = runtime.ForwardResponseMessage
+	forward_Db_DTakoFerryRowsService_Update_0 = runtime.ForwardResponseMessage
+	forward_Db_DTakoFerryRowsService_Delete_0 = runtime.ForwardResponseMessage
+	forward_Db_DTakoFerryRowsService_List_0   = runtime.ForwardResponseMessage
+)
+
+// RegisterDb_ETCMeisaiMappingServiceHandlerFromEndpoint is same as RegisterDb_ETCMeisaiMappingServiceHandler but
+// automatically dials to "endpoint" and closes the connection when "ctx" gets done.
+func RegisterDb_ETCMeisaiMappingServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+	conn, err := grpc.NewClient(endpoint, opts...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Errorf("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+			return
+		}
+		go func() {
+			<-ctx.Done()
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Errorf("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+		}()
+	}()
+	return RegisterDb_ETCMeisaiMappingServiceHandler(ctx, mux, conn)
+}
+
+// RegisterDb_ETCMeisaiMappingServiceHandler registers the http handlers for service Db_ETCMeisaiMappingService to "mux".
+// The handlers forward requests to the grpc endpoint over "conn".
+func RegisterDb_ETCMeisaiMappingServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterDb_ETCMeisaiMappingServiceHandlerClient(ctx, mux, NewDb_ETCMeisaiMappingServiceClient(conn))
+}
+
+// RegisterDb_ETCMeisaiMappingServiceHandlerClient registers the http handlers for service Db_ETCMeisaiMappingService
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_ETCMeisaiMappingServiceClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_ETCMeisaiMappingServiceClient"
+// doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
+// "Db_ETCMeisaiMappingServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
+func RegisterDb_ETCMeisaiMappingServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_ETCMeisaiMappingServiceClient) error {
+	mux.Handle(http.MethodPost, pattern_Db_ETCMeisaiMappingService_Create_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ETCMeisaiMappingService/Delete", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai-mapping/{id}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ETCMeisaiMappingService/Create", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai-mapping"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_ETCMeisaiMappingService_Delete_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_ETCMeisaiMappingService_Create_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_ETCMeisaiMappingService_Delete_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_ETCMeisaiMappingService_Create_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_ETCMeisaiMappingService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_ETCMeisaiMappingService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ETCMeisaiMappingService/List", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai-mapping"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ETCMeisaiMappingService/Get", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai-mapping/{id}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_ETCMeisaiMappingService_List_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_ETCMeisaiMappingService_Get_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_ETCMeisaiMappingService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_ETCMeisaiMappingService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_ETCMeisaiMappingService_GetDTakoRowIDByHash_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodPut, pattern_Db_ETCMeisaiMappingService_Update_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ETCMeisaiMappingService/GetDTakoRowIDByHash", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai-mapping/by-hash/{etc_meisai_hash}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ETCMeisaiMappingService/Update", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai-mapping/{etc_meisai_mapping.id}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_ETCMeisaiMappingService_GetDTakoRowIDByHash_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_ETCMeisaiMappingService_Update_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_ETCMeisaiMappingService_GetDTakoRowIDByHash_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_ETCMeisaiMappingService_Update_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-
-	return nil
-}
-
-// RegisterDb_DTakoCarsServiceHandlerServer registers the http handlers for service Db_DTakoCarsService to "mux".
-// UnaryRPC     :call Db_DTakoCarsServiceServer directly.
-// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
-// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_DTakoCarsServiceHandlerFromEndpoint instead.
-// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
-func RegisterDb_DTakoCarsServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_DTakoCarsServiceServer) error {
-	mux.Handle(http.MethodGet, pattern_Db_DTakoCarsService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodDelete, pattern_Db_ETCMeisaiMappingService_Delete_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoCarsService/Get", runtime.WithHTTPPathPattern("/api/v1/db/dtako-cars/{id}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ETCMeisaiMappingService/Delete", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai-mapping/{id}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_DTakoCarsService_Get_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_ETCMeisaiMappingService_Delete_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_DTakoCarsService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_ETCMeisaiMappingService_Delete_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_DTakoCarsService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_ETCMeisaiMappingService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoCarsService/List", runtime.WithHTTPPathPattern("/api/v1/db/dtako-cars"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ETCMeisaiMappingService/List", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai-mapping"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_DTakoCarsService_List_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_ETCMeisaiMappingService_List_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_DTakoCarsService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_ETCMeisaiMappingService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_DTakoCarsService_GetByCarCode_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_ETCMeisaiMappingService_GetDTakoRowIDByHash_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoCarsService/GetByCarCode", runtime.WithHTTPPathPattern("/api/v1/db/dtako-cars/by-car-code/{car_code}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ETCMeisaiMappingService/GetDTakoRowIDByHash", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai-mapping/by-hash/{etc_meisai_hash}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_DTakoCarsService_GetByCarCode_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_ETCMeisaiMappingService_GetDTakoRowIDByHash_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_DTakoCarsService_GetByCarCode_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_ETCMeisaiMappingService_GetDTakoRowIDByHash_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-
-	return nil
-}
-
-// RegisterDb_DTakoEventsServiceHandlerServer registers the http handlers for service Db_DTakoEventsService to "mux".
-// UnaryRPC     :call Db_DTakoEventsServiceServer directly.
-// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
-// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_DTakoEventsServiceHandlerFromEndpoint instead.
-// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
-func RegisterDb_DTakoEventsServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_DTakoEventsServiceServer) error {
-	mux.Handle(http.MethodGet, pattern_Db_DTakoEventsService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodPut, pattern_Db_ETCMeisaiMappingService_BulkReplace_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoEventsService/Get", runtime.WithHTTPPathPattern("/api/v1/db/dtako-events/{id}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ETCMeisaiMappingService/BulkReplace", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai-mapping/by-dtako-row-id/{dtako_row_id}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_DTakoEventsService_Get_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_ETCMeisaiMappingService_BulkReplace_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_DTakoEventsService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_ETCMeisaiMappingService_BulkReplace_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_DTakoEventsService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	return nil
+}
+
+var (
+	pattern_Db_ETCMeisaiMappingService_Create_0              = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "etc-meisai-mapping"}, ""))
+	pattern_Db_ETCMeisaiMappingService_Get_0                 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "etc-meisai-mapping", "id"}, ""))
+	pattern_Db_ETCMeisaiMappingService_Update_0              = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "etc-meisai-mapping", "etc_meisai_mapping.id"}, ""))
+	pattern_Db_ETCMeisaiMappingService_Delete_0              = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "etc-meisai-mapping", "id"}, ""))
+	pattern_Db_ETCMeisaiMappingService_List_0                = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "etc-meisai-mapping"}, ""))
+	pattern_Db_ETCMeisaiMappingService_GetDTakoRowIDByHash_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 1, 0, 4, 1, 5, 5}, []string{"api", "v1", "db", "etc-meisai-mapping", "by-hash", "etc_meisai_hash"}, ""))
+	pattern_Db_ETCMeisaiMappingService_BulkReplace_0         = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 1, 0, 4, 1, 5, 5}, []string{"api", "v1", "db", "etc-meisai-mapping", "by-dtako-row-id", "dtako_row_id"}, ""))
+)
+
+var (
+	forward_Db_ETCMeisaiMappingService_Create_0              = runtime.ForwardResponseMessage
+	forward_Db_ETCMeisaiMappingService_Get_0                 = runtime.ForwardResponseMessage
+	forward_Db_ETCMeisaiMappingService_Update_0              = runtime.ForwardResponseMessage
+	forward_Db_ETCMeisaiMappingService_Delete_0              = runtime.ForwardResponseMessage
+	forward_Db_ETCMeisaiMappingService_List_0                = runtime.ForwardResponseMessage
+	forward_Db_ETCMeisaiMappingService_GetDTakoRowIDByHash_0 = runtime.ForwardResponseMessage
+	forward_Db_ETCMeisaiMappingService_BulkReplace_0         = runtime.ForwardResponseMessage
+)
+
+// RegisterDb_ETCMeisaiMatcherServiceHandlerFromEndpoint is same as RegisterDb_ETCMeisaiMatcherServiceHandler but
+// automatically dials to "endpoint" and closes the connection when "ctx" gets done.
+func RegisterDb_ETCMeisaiMatcherServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+	conn, err := grpc.NewClient(endpoint, opts...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Errorf("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+			return
+		}
+		go func() {
+			<-ctx.Done()
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Errorf("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+		}()
+	}()
+	return RegisterDb_ETCMeisaiMatcherServiceHandler(ctx, mux, conn)
+}
+
+// RegisterDb_ETCMeisaiMatcherServiceHandler registers the http handlers for service Db_ETCMeisaiMatcherService to "mux".
+// The handlers forward requests to the grpc endpoint over "conn".
+func RegisterDb_ETCMeisaiMatcherServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterDb_ETCMeisaiMatcherServiceHandlerClient(ctx, mux, NewDb_ETCMeisaiMatcherServiceClient(conn))
+}
+
+// RegisterDb_ETCMeisaiMatcherServiceHandlerClient registers the http handlers for service Db_ETCMeisaiMatcherService
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_ETCMeisaiMatcherServiceClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_ETCMeisaiMatcherServiceClient"
+// doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
+// "Db_ETCMeisaiMatcherServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
+func RegisterDb_ETCMeisaiMatcherServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_ETCMeisaiMatcherServiceClient) error {
+	mux.Handle(http.MethodPost, pattern_Db_ETCMeisaiMatcherService_AutoMatch_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoEventsService/List", runtime.WithHTTPPathPattern("/api/v1/db/dtako-events"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ETCMeisaiMatcherService/AutoMatch", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai-mapping/auto-match"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_DTakoEventsService_List_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_ETCMeisaiMatcherService_AutoMatch_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_DTakoEventsService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_ETCMeisaiMatcherService_AutoMatch_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_DTakoEventsService_GetByOperationNo_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	return nil
+}
+
+var (
+	pattern_Db_ETCMeisaiMatcherService_AutoMatch_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4}, []string{"api", "v1", "db", "etc-meisai-mapping", "auto-match"}, ""))
+)
+
+var (
+	forward_Db_ETCMeisaiMatcherService_AutoMatch_0 = runtime.ForwardResponseMessage
+)
+
+// RegisterDb_ETCMeisaiMappingAuditServiceHandlerFromEndpoint is same as RegisterDb_ETCMeisaiMappingAuditServiceHandler but
+// automatically dials to "endpoint" and closes the connection when "ctx" gets done.
+func RegisterDb_ETCMeisaiMappingAuditServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+	conn, err := grpc.NewClient(endpoint, opts...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Errorf("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+			return
+		}
+		go func() {
+			<-ctx.Done()
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Errorf("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+		}()
+	}()
+	return RegisterDb_ETCMeisaiMappingAuditServiceHandler(ctx, mux, conn)
+}
+
+// RegisterDb_ETCMeisaiMappingAuditServiceHandler registers the http handlers for service Db_ETCMeisaiMappingAuditService to "mux".
+// The handlers forward requests to the grpc endpoint over "conn".
+func RegisterDb_ETCMeisaiMappingAuditServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterDb_ETCMeisaiMappingAuditServiceHandlerClient(ctx, mux, NewDb_ETCMeisaiMappingAuditServiceClient(conn))
+}
+
+// RegisterDb_ETCMeisaiMappingAuditServiceHandlerClient registers the http handlers for service Db_ETCMeisaiMappingAuditService
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_ETCMeisaiMappingAuditServiceClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_ETCMeisaiMappingAuditServiceClient"
+// doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
+// "Db_ETCMeisaiMappingAuditServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
+func RegisterDb_ETCMeisaiMappingAuditServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_ETCMeisaiMappingAuditServiceClient) error {
+	mux.Handle(http.MethodGet, pattern_Db_ETCMeisaiMappingAuditService_Audit_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoEventsService/GetByOperationNo", runtime.WithHTTPPathPattern("/api/v1/db/dtako-events/by-operation-no/{operation_no}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ETCMeisaiMappingAuditService/Audit", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai-mapping/audit"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_DTakoEventsService_GetByOperationNo_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_ETCMeisaiMappingAuditService_Audit_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_DTakoEventsService_GetByOperationNo_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_ETCMeisaiMappingAuditService_Audit_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-
 	return nil
 }
 
-// RegisterDb_DTakoRowsServiceHandlerServer registers the http handlers for service Db_DTakoRowsService to "mux".
-// UnaryRPC     :call Db_DTakoRowsServiceServer directly.
-// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
-// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_DTakoRowsServiceHandlerFromEndpoint instead.
-// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
-func RegisterDb_DTakoRowsServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_DTakoRowsServiceServer) error {
-	mux.Handle(http.MethodGet, pattern_Db_DTakoRowsService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+var (
+	pattern_Db_ETCMeisaiMappingAuditService_Audit_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4}, []string{"api", "v1", "db", "etc-meisai-mapping", "audit"}, ""))
+)
+
+var (
+	forward_Db_ETCMeisaiMappingAuditService_Audit_0 = runtime.ForwardResponseMessage
+)
+
+// RegisterDb_DTakoCarsServiceHandlerFromEndpoint is same as RegisterDb_DTakoCarsServiceHandler but
+// automatically dials to "endpoint" and closes the connection when "ctx" gets done.
+func RegisterDb_DTakoCarsServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+	conn, err := grpc.NewClient(endpoint, opts...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Errorf("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+			return
+		}
+		go func() {
+			<-ctx.Done()
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Errorf("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+		}()
+	}()
+	return RegisterDb_DTakoCarsServiceHandler(ctx, mux, conn)
+}
+
+// RegisterDb_DTakoCarsServiceHandler registers the http handlers for service Db_DTakoCarsService to "mux".
+// The handlers forward requests to the grpc endpoint over "conn".
+func RegisterDb_DTakoCarsServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterDb_DTakoCarsServiceHandlerClient(ctx, mux, NewDb_DTakoCarsServiceClient(conn))
+}
+
+// RegisterDb_DTakoCarsServiceHandlerClient registers the http handlers for service Db_DTakoCarsService
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_DTakoCarsServiceClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_DTakoCarsServiceClient"
+// doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
+// "Db_DTakoCarsServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
+func RegisterDb_DTakoCarsServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_DTakoCarsServiceClient) error {
+	mux.Handle(http.MethodGet, pattern_Db_DTakoCarsService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoRowsService/Get", runtime.WithHTTPPathPattern("/api/v1/db/dtako-rows/{id}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoCarsService/Get", runtime.WithHTTPPathPattern("/api/v1/db/dtako-cars/{id}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_DTakoRowsService_Get_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_DTakoCarsService_Get_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_DTakoRowsService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_DTakoCarsService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_DTakoRowsService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_DTakoCarsService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoRowsService/List", runtime.WithHTTPPathPattern("/api/v1/db/dtako-rows"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoCarsService/List", runtime.WithHTTPPathPattern("/api/v1/db/dtako-cars"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_DTakoRowsService_List_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_DTakoCarsService_List_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_DTakoRowsService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_DTakoCarsService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_DTakoRowsService_GetByOperationNo_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_DTakoCarsService_GetByCarCode_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoRowsService/GetByOperationNo", runtime.WithHTTPPathPattern("/api/v1/db/dtako-rows/by-operation-no/{operation_no}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoCarsService/GetByCarCode", runtime.WithHTTPPathPattern("/api/v1/db/dtako-cars/by-car-code/{car_code}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_DTakoRowsService_GetByOperationNo_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_DTakoCarsService_GetByCarCode_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_DTakoRowsService_GetByOperationNo_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_DTakoCarsService_GetByCarCode_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	return nil
+}
+
+var (
+	pattern_Db_DTakoCarsService_Get_0          = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "dtako-cars", "id"}, ""))
+	pattern_Db_DTakoCarsService_List_0         = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "dtako-cars"}, ""))
+	pattern_Db_DTakoCarsService_GetByCarCode_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 1, 0, 4, 1, 5, 5}, []string{"api", "v1", "db", "dtako-cars", "by-car-code", "car_code"}, ""))
+)
+
+var (
+	forward_Db_DTakoCarsService_Get_0          = runtime.ForwardResponseMessage
+	forward_Db_DTakoCarsService_List_0         = runtime.ForwardResponseMessage
+	forward_Db_DTakoCarsService_GetByCarCode_0 = runtime.ForwardResponseMessage
+)
+
+// RegisterDb_DTakoEventsServiceHandlerFromEndpoint is same as RegisterDb_DTakoEventsServiceHandler but
+// automatically dials to "endpoint" and closes the connection when "ctx" gets done.
+func RegisterDb_DTakoEventsServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+	conn, err := grpc.NewClient(endpoint, opts...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Errorf("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+			return
+		}
+		go func() {
+			<-ctx.Done()
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Errorf("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+		}()
+	}()
+	return RegisterDb_DTakoEventsServiceHandler(ctx, mux, conn)
+}
 
-	return nil
+// RegisterDb_DTakoEventsServiceHandler registers the http handlers for service Db_DTakoEventsService to "mux".
+// The handlers forward requests to the grpc endpoint over "conn".
+func RegisterDb_DTakoEventsServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterDb_DTakoEventsServiceHandlerClient(ctx, mux, NewDb_DTakoEventsServiceClient(conn))
 }
 
-// RegisterDb_ETCNumServiceHandlerServer registers the http handlers for service Db_ETCNumService to "mux".
-// UnaryRPC     :call Db_ETCNumServiceServer directly.
-// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
-// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_ETCNumServiceHandlerFromEndpoint instead.
-// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
-func RegisterDb_ETCNumServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_ETCNumServiceServer) error {
-	mux.Handle(http.MethodGet, pattern_Db_ETCNumService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+// RegisterDb_DTakoEventsServiceHandlerClient registers the http handlers for service Db_DTakoEventsService
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_DTakoEventsServiceClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_DTakoEventsServiceClient"
+// doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
+// "Db_DTakoEventsServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
+func RegisterDb_DTakoEventsServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_DTakoEventsServiceClient) error {
+	mux.Handle(http.MethodGet, pattern_Db_DTakoEventsService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ETCNumService/List", runtime.WithHTTPPathPattern("/api/v1/db/etc-num"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoEventsService/Get", runtime.WithHTTPPathPattern("/api/v1/db/dtako-events/{id}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_ETCNumService_List_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_DTakoEventsService_Get_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_ETCNumService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_DTakoEventsService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_ETCNumService_GetByETCCardNum_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_DTakoEventsService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ETCNumService/GetByETCCardNum", runtime.WithHTTPPathPattern("/api/v1/db/etc-num/by-etc-card-num/{etc_card_num}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoEventsService/List", runtime.WithHTTPPathPattern("/api/v1/db/dtako-events"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_ETCNumService_GetByETCCardNum_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_DTakoEventsService_List_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_ETCNumService_GetByETCCardNum_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_DTakoEventsService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_ETCNumService_GetByCarID_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_DTakoEventsService_Stream_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ETCNumService/GetByCarID", runtime.WithHTTPPathPattern("/api/v1/db/etc-num/by-car-id/{car_id}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoEventsService/Stream", runtime.WithHTTPPathPattern("/api/v1/db/dtako-events/stream"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_ETCNumService_GetByCarID_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_DTakoEventsService_Stream_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_ETCNumService_GetByCarID_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_DTakoEventsService_Stream_0(annotatedContext, mux, outboundMarshaler, w, req, func() (proto.Message, error) { return resp.Recv() }, mux.GetForwardResponseOptions()...)
 	})
-
-	return nil
-}
-
-// RegisterDb_DTakoFerryRowsProdServiceHandlerServer registers the http handlers for service Db_DTakoFerryRowsProdService to "mux".
-// UnaryRPC     :call Db_DTakoFerryRowsProdServiceServer directly.
-// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
-// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_DTakoFerryRowsProdServiceHandlerFromEndpoint instead.
-// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
-func RegisterDb_DTakoFerryRowsProdServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_DTakoFerryRowsProdServiceServer) error {
-	mux.Handle(http.MethodGet, pattern_Db_DTakoFerryRowsProdService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_DTakoEventsService_GetByOperationNo_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoFerryRowsProdService/Get", runtime.WithHTTPPathPattern("/api/v1/db/dtako-ferry-rows-prod/{id}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoEventsService/GetByOperationNo", runtime.WithHTTPPathPattern("/api/v1/db/dtako-events/by-operation-no/{operation_no}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_DTakoFerryRowsProdService_Get_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_DTakoEventsService_GetByOperationNo_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_DTakoFerryRowsProdService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_DTakoEventsService_GetByOperationNo_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_DTakoFerryRowsProdService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
-		ctx, cancel := context.WithCancel(req.Context())
-		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
-		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoFerryRowsProdService/List", runtime.WithHTTPPathPattern("/api/v1/db/dtako-ferry-rows-prod"))
-		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
-			return
-		}
-		resp, md, err := local_request_Db_DTakoFerryRowsProdService_List_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
-		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+	return nil
+}
+
+var (
+	pattern_Db_DTakoEventsService_Get_0              = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "dtako-events", "id"}, ""))
+	pattern_Db_DTakoEventsService_List_0             = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "dtako-events"}, ""))
+	pattern_Db_DTakoEventsService_Stream_0           = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4}, []string{"api", "v1", "db", "dtako-events", "stream"}, ""))
+	pattern_Db_DTakoEventsService_GetByOperationNo_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 1, 0, 4, 1, 5, 5}, []string{"api", "v1", "db", "dtako-events", "by-operation-no", "operation_no"}, ""))
+)
+
+var (
+	forward_Db_DTakoEventsService_Get_0              = runtime.ForwardResponseMessage
+	forward_Db_DTakoEventsService_List_0             = runtime.ForwardResponseMessage
+	forward_Db_DTakoEventsService_Stream_0           = runtime.ForwardResponseStream
+	forward_Db_DTakoEventsService_GetByOperationNo_0 = runtime.ForwardResponseMessage
+)
+
+// RegisterDb_DTakoRowsServiceHandlerFromEndpoint is same as RegisterDb_DTakoRowsServiceHandler but
+// automatically dials to "endpoint" and closes the connection when "ctx" gets done.
+func RegisterDb_DTakoRowsServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+	conn, err := grpc.NewClient(endpoint, opts...)
+	if err != nil {
+		return err
+	}
+	defer func() {
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Errorf("Failed to close conn to %s: %v", endpoint, cerr)
+			}
 			return
 		}
-		forward_Db_DTakoFerryRowsProdService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
-	mux.Handle(http.MethodGet, pattern_Db_DTakoFerryRowsProdService_GetByUnkoNo_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		go func() {
+			<-ctx.Done()
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Errorf("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+		}()
+	}()
+	return RegisterDb_DTakoRowsServiceHandler(ctx, mux, conn)
+}
+
+// RegisterDb_DTakoRowsServiceHandler registers the http handlers for service Db_DTakoRowsService to "mux".
+// The handlers forward requests to the grpc endpoint over "conn".
+func RegisterDb_DTakoRowsServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterDb_DTakoRowsServiceHandlerClient(ctx, mux, NewDb_DTakoRowsServiceClient(conn))
+}
+
+// RegisterDb_DTakoRowsServiceHandlerClient registers the http handlers for service Db_DTakoRowsService
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_DTakoRowsServiceClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_DTakoRowsServiceClient"
+// doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
+// "Db_DTakoRowsServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
+func RegisterDb_DTakoRowsServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_DTakoRowsServiceClient) error {
+	mux.Handle(http.MethodGet, pattern_Db_DTakoRowsService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DTakoFerryRowsProdService/GetByUnkoNo", runtime.WithHTTPPathPattern("/api/v1/db/dtako-ferry-rows-prod/by-unko-no/{unko_no}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoRowsService/Get", runtime.WithHTTPPathPattern("/api/v1/db/dtako-rows/{id}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_DTakoFerryRowsProdService_GetByUnkoNo_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_DTakoRowsService_Get_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_DTakoFerryRowsProdService_GetByUnkoNo_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_DTakoRowsService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-
-	return nil
-}
-
-// RegisterDb_CarsServiceHandlerServer registers the http handlers for service Db_CarsService to "mux".
-// UnaryRPC     :call Db_CarsServiceServer directly.
-// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
-// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_CarsServiceHandlerFromEndpoint instead.
-// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
-func RegisterDb_CarsServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_CarsServiceServer) error {
-	mux.Handle(http.MethodGet, pattern_Db_CarsService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_DTakoRowsService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_CarsService/Get", runtime.WithHTTPPathPattern("/api/v1/db/cars/{id}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoRowsService/List", runtime.WithHTTPPathPattern("/api/v1/db/dtako-rows"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_CarsService_Get_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_DTakoRowsService_List_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_CarsService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_DTakoRowsService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_CarsService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_DTakoRowsService_Stream_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_CarsService/List", runtime.WithHTTPPathPattern("/api/v1/db/cars"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoRowsService/Stream", runtime.WithHTTPPathPattern("/api/v1/db/dtako-rows/stream"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_CarsService_List_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_DTakoRowsService_Stream_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_CarsService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_DTakoRowsService_Stream_0(annotatedContext, mux, outboundMarshaler, w, req, func() (proto.Message, error) { return resp.Recv() }, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_CarsService_GetByBumonCodeID_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_DTakoRowsService_GetByOperationNo_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_CarsService/GetByBumonCodeID", runtime.WithHTTPPathPattern("/api/v1/db/cars/bumon/{bumon_code_id}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoRowsService/GetByOperationNo", runtime.WithHTTPPathPattern("/api/v1/db/dtako-rows/by-operation-no/{operation_no}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_CarsService_GetByBumonCodeID_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_DTakoRowsService_GetByOperationNo_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_CarsService_GetByBumonCodeID_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_DTakoRowsService_GetByOperationNo_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-
 	return nil
 }
 
-// RegisterDb_DriversServiceHandlerServer registers the http handlers for service Db_DriversService to "mux".
-// UnaryRPC     :call Db_DriversServiceServer directly.
-// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
-// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_DriversServiceHandlerFromEndpoint instead.
-// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
-func RegisterDb_DriversServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_DriversServiceServer) error {
-	mux.Handle(http.MethodGet, pattern_Db_DriversService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
-		ctx, cancel := context.WithCancel(req.Context())
-		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
-		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DriversService/Get", runtime.WithHTTPPathPattern("/api/v1/db/drivers/{id}"))
-		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
-			return
-		}
-		resp, md, err := local_request_Db_DriversService_Get_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
-		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+var (
+	pattern_Db_DTakoRowsService_Get_0              = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "dtako-rows", "id"}, ""))
+	pattern_Db_DTakoRowsService_List_0             = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "dtako-rows"}, ""))
+	pattern_Db_DTakoRowsService_Stream_0           = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4}, []string{"api", "v1", "db", "dtako-rows", "stream"}, ""))
+	pattern_Db_DTakoRowsService_GetByOperationNo_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 1, 0, 4, 1, 5, 5}, []string{"api", "v1", "db", "dtako-rows", "by-operation-no", "operation_no"}, ""))
+)
+
+var (
+	forward_Db_DTakoRowsService_Get_0              = runtime.ForwardResponseMessage
+	forward_Db_DTakoRowsService_List_0             = runtime.ForwardResponseMessage
+	forward_Db_DTakoRowsService_Stream_0           = runtime.ForwardResponseStream
+	forward_Db_DTakoRowsService_GetByOperationNo_0 = runtime.ForwardResponseMessage
+)
+
+// RegisterDb_ETCNumServiceHandlerFromEndpoint is same as RegisterDb_ETCNumServiceHandler but
+// automatically dials to "endpoint" and closes the connection when "ctx" gets done.
+func RegisterDb_ETCNumServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+	conn, err := grpc.NewClient(endpoint, opts...)
+	if err != nil {
+		return err
+	}
+	defer func() {
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Errorf("Failed to close conn to %s: %v", endpoint, cerr)
+			}
 			return
 		}
-		forward_Db_DriversService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
-	mux.Handle(http.MethodGet, pattern_Db_DriversService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		go func() {
+			<-ctx.Done()
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Errorf("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+		}()
+	}()
+	return RegisterDb_ETCNumServiceHandler(ctx, mux, conn)
+}
+
+// RegisterDb_ETCNumServiceHandler registers the http handlers for service Db_ETCNumService to "mux".
+// The handlers forward requests to the grpc endpoint over "conn".
+func RegisterDb_ETCNumServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterDb_ETCNumServiceHandlerClient(ctx, mux, NewDb_ETCNumServiceClient(conn))
+}
+
+// RegisterDb_ETCNumServiceHandlerClient registers the http handlers for service Db_ETCNumService
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_ETCNumServiceClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_ETCNumServiceClient"
+// doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
+// "Db_ETCNumServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
+func RegisterDb_ETCNumServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_ETCNumServiceClient) error {
+	mux.Handle(http.MethodGet, pattern_Db_ETCNumService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DriversService/List", runtime.WithHTTPPathPattern("/api/v1/db/drivers"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ETCNumService/List", runtime.WithHTTPPathPattern("/api/v1/db/etc-num"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_DriversService_List_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_ETCNumService_List_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_DriversService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_ETCNumService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_DriversService_GetByBumon_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_ETCNumService_GetByETCCardNum_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_DriversService/GetByBumon", runtime.WithHTTPPathPattern("/api/v1/db/drivers/bumon/{bumon}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ETCNumService/GetByETCCardNum", runtime.WithHTTPPathPattern("/api/v1/db/etc-num/by-etc-card-num/{etc_card_num}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_DriversService_GetByBumon_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_ETCNumService_GetByETCCardNum_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_DriversService_GetByBumon_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_ETCNumService_GetByETCCardNum_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-
-	return nil
-}
-
-// RegisterDb_UntenNippoMeisaiServiceHandlerServer registers the http handlers for service Db_UntenNippoMeisaiService to "mux".
-// UnaryRPC     :call Db_UntenNippoMeisaiServiceServer directly.
-// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
-// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_UntenNippoMeisaiServiceHandlerFromEndpoint instead.
-// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
-func RegisterDb_UntenNippoMeisaiServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_UntenNippoMeisaiServiceServer) error {
-	mux.Handle(http.MethodGet, pattern_Db_UntenNippoMeisaiService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_ETCNumService_GetByCarID_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_UntenNippoMeisaiService/Get", runtime.WithHTTPPathPattern("/api/v1/db/unten-nippo-meisai/{nippo_k}/{haisha_k}/{sharyo_c}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ETCNumService/GetByCarID", runtime.WithHTTPPathPattern("/api/v1/db/etc-num/by-car-id/{car_id}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_UntenNippoMeisaiService_Get_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_ETCNumService_GetByCarID_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_UntenNippoMeisaiService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_ETCNumService_GetByCarID_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_UntenNippoMeisaiService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_ETCNumService_GetByETCCardNumAt_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_UntenNippoMeisaiService/List", runtime.WithHTTPPathPattern("/api/v1/db/unten-nippo-meisai"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ETCNumService/GetByETCCardNumAt", runtime.WithHTTPPathPattern("/api/v1/db/etc-num/by-etc-card-num/{etc_card_num}/as-of"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_UntenNippoMeisaiService_List_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_ETCNumService_GetByETCCardNumAt_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_UntenNippoMeisaiService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_ETCNumService_GetByETCCardNumAt_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_UntenNippoMeisaiService_GetBySharyoC_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_ETCNumService_GetByCarIDAt_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_UntenNippoMeisaiService/GetBySharyoC", runtime.WithHTTPPathPattern("/api/v1/db/unten-nippo-meisai/sharyo/{sharyo_c}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ETCNumService/GetByCarIDAt", runtime.WithHTTPPathPattern("/api/v1/db/etc-num/by-car-id/{car_id}/as-of"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_UntenNippoMeisaiService_GetBySharyoC_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_ETCNumService_GetByCarIDAt_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_UntenNippoMeisaiService_GetBySharyoC_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_ETCNumService_GetByCarIDAt_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_UntenNippoMeisaiService_GetByDateRange_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_ETCNumService_ListOverlaps_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_UntenNippoMeisaiService/GetByDateRange", runtime.WithHTTPPathPattern("/api/v1/db/unten-nippo-meisai/date-range"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ETCNumService/ListOverlaps", runtime.WithHTTPPathPattern("/api/v1/db/etc-num/overlaps"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_UntenNippoMeisaiService_GetByDateRange_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_ETCNumService_ListOverlaps_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_UntenNippoMeisaiService_GetByDateRange_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_ETCNumService_ListOverlaps_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-
 	return nil
 }
 
-// RegisterDb_ShainMasterServiceHandlerServer registers the http handlers for service Db_ShainMasterService to "mux".
-// UnaryRPC     :call Db_ShainMasterServiceServer directly.
-// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
-// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_ShainMasterServiceHandlerFromEndpoint instead.
-// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
-func RegisterDb_ShainMasterServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_ShainMasterServiceServer) error {
-	mux.Handle(http.MethodGet, pattern_Db_ShainMasterService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+var (
+	pattern_Db_ETCNumService_List_0              = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "etc-num"}, ""))
+	pattern_Db_ETCNumService_GetByETCCardNum_0   = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 1, 0, 4, 1, 5, 5}, []string{"api", "v1", "db", "etc-num", "by-etc-card-num", "etc_card_num"}, ""))
+	pattern_Db_ETCNumService_GetByCarID_0        = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 1, 0, 4, 1, 5, 5}, []string{"api", "v1", "db", "etc-num", "by-car-id", "car_id"}, ""))
+	pattern_Db_ETCNumService_GetByETCCardNumAt_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 1, 0, 4, 1, 5, 5, 2, 6}, []string{"api", "v1", "db", "etc-num", "by-etc-card-num", "etc_card_num", "as-of"}, ""))
+	pattern_Db_ETCNumService_GetByCarIDAt_0      = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 1, 0, 4, 1, 5, 5, 2, 6}, []string{"api", "v1", "db", "etc-num", "by-car-id", "car_id", "as-of"}, ""))
+	pattern_Db_ETCNumService_ListOverlaps_0      = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4}, []string{"api", "v1", "db", "etc-num", "overlaps"}, ""))
+)
+
+var (
+	forward_Db_ETCNumService_List_0              = runtime.ForwardResponseMessage
+	forward_Db_ETCNumService_GetByETCCardNum_0   = runtime.ForwardResponseMessage
+	forward_Db_ETCNumService_GetByCarID_0        = runtime.ForwardResponseMessage
+	forward_Db_ETCNumService_GetByETCCardNumAt_0 = runtime.ForwardResponseMessage
+	forward_Db_ETCNumService_GetByCarIDAt_0      = runtime.ForwardResponseMessage
+	forward_Db_ETCNumService_ListOverlaps_0      = runtime.ForwardResponseMessage
+)
+
+// RegisterDb_DTakoFerryRowsProdServiceHandlerFromEndpoint is same as RegisterDb_DTakoFerryRowsProdServiceHandler but
+// automatically dials to "endpoint" and closes the connection when "ctx" gets done.
+func RegisterDb_DTakoFerryRowsProdServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+	conn, err := grpc.NewClient(endpoint, opts...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Errorf("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+			return
+		}
+		go func() {
+			<-ctx.Done()
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Errorf("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+		}()
+	}()
+	return RegisterDb_DTakoFerryRowsProdServiceHandler(ctx, mux, conn)
+}
+
+// RegisterDb_DTakoFerryRowsProdServiceHandler registers the http handlers for service Db_DTakoFerryRowsProdService to "mux".
+// The handlers forward requests to the grpc endpoint over "conn".
+func RegisterDb_DTakoFerryRowsProdServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterDb_DTakoFerryRowsProdServiceHandlerClient(ctx, mux, NewDb_DTakoFerryRowsProdServiceClient(conn))
+}
+
+// RegisterDb_DTakoFerryRowsProdServiceHandlerClient registers the http handlers for service Db_DTakoFerryRowsProdService
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_DTakoFerryRowsProdServiceClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_DTakoFerryRowsProdServiceClient"
+// doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
+// "Db_DTakoFerryRowsProdServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
+func RegisterDb_DTakoFerryRowsProdServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_DTakoFerryRowsProdServiceClient) error {
+	mux.Handle(http.MethodGet, pattern_Db_DTakoFerryRowsProdService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ShainMasterService/Get", runtime.WithHTTPPathPattern("/api/v1/db/shain-master/{shain_c}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoFerryRowsProdService/Get", runtime.WithHTTPPathPattern("/api/v1/db/dtako-ferry-rows-prod/{id}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_ShainMasterService_Get_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_DTakoFerryRowsProdService_Get_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_ShainMasterService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_DTakoFerryRowsProdService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_ShainMasterService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_DTakoFerryRowsProdService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ShainMasterService/List", runtime.WithHTTPPathPattern("/api/v1/db/shain-master"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoFerryRowsProdService/List", runtime.WithHTTPPathPattern("/api/v1/db/dtako-ferry-rows-prod"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_ShainMasterService_List_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_DTakoFerryRowsProdService_List_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_ShainMasterService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_DTakoFerryRowsProdService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_ShainMasterService_GetByBumonC_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_DTakoFerryRowsProdService_GetByUnkoNo_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ShainMasterService/GetByBumonC", runtime.WithHTTPPathPattern("/api/v1/db/shain-master/bumon/{bumon_c}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoFerryRowsProdService/GetByUnkoNo", runtime.WithHTTPPathPattern("/api/v1/db/dtako-ferry-rows-prod/by-unko-no/{unko_no}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_ShainMasterService_GetByBumonC_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_DTakoFerryRowsProdService_GetByUnkoNo_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_ShainMasterService_GetByBumonC_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_DTakoFerryRowsProdService_GetByUnkoNo_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-
 	return nil
 }
 
-// RegisterDb_ChiikiMasterServiceHandlerServer registers the http handlers for service Db_ChiikiMasterService to "mux".
-// UnaryRPC     :call Db_ChiikiMasterServiceServer directly.
-// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
-// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_ChiikiMasterServiceHandlerFromEndpoint instead.
-// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
-func RegisterDb_ChiikiMasterServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_ChiikiMasterServiceServer) error {
-	mux.Handle(http.MethodGet, pattern_Db_ChiikiMasterService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+var (
+	pattern_Db_DTakoFerryRowsProdService_Get_0         = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "dtako-ferry-rows-prod", "id"}, ""))
+	pattern_Db_DTakoFerryRowsProdService_List_0        = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "dtako-ferry-rows-prod"}, ""))
+	pattern_Db_DTakoFerryRowsProdService_GetByUnkoNo_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 1, 0, 4, 1, 5, 5}, []string{"api", "v1", "db", "dtako-ferry-rows-prod", "by-unko-no", "unko_no"}, ""))
+)
+
+var (
+	forward_Db_DTakoFerryRowsProdService_Get_0         = runtime.ForwardResponseMessage
+	forward_Db_DTakoFerryRowsProdService_List_0        = runtime.ForwardResponseMessage
+	forward_Db_DTakoFerryRowsProdService_GetByUnkoNo_0 = runtime.ForwardResponseMessage
+)
+
+// RegisterDb_CarsServiceHandlerFromEndpoint is same as RegisterDb_CarsServiceHandler but
+// automatically dials to "endpoint" and closes the connection when "ctx" gets done.
+func RegisterDb_CarsServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+	conn, err := grpc.NewClient(endpoint, opts...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Errorf("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+			return
+		}
+		go func() {
+			<-ctx.Done()
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Errorf("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+		}()
+	}()
+	return RegisterDb_CarsServiceHandler(ctx, mux, conn)
+}
+
+// RegisterDb_CarsServiceHandler registers the http handlers for service Db_CarsService to "mux".
+// The handlers forward requests to the grpc endpoint over "conn".
+func RegisterDb_CarsServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterDb_CarsServiceHandlerClient(ctx, mux, NewDb_CarsServiceClient(conn))
+}
+
+// RegisterDb_CarsServiceHandlerClient registers the http handlers for service Db_CarsService
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_CarsServiceClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_CarsServiceClient"
+// doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
+// "Db_CarsServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
+func RegisterDb_CarsServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_CarsServiceClient) error {
+	mux.Handle(http.MethodGet, pattern_Db_CarsService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ChiikiMasterService/Get", runtime.WithHTTPPathPattern("/api/v1/db/chiiki-master/{chiiki_c}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_CarsService/Get", runtime.WithHTTPPathPattern("/api/v1/db/cars/{id}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_ChiikiMasterService_Get_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_CarsService_Get_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_ChiikiMasterService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_CarsService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_ChiikiMasterService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_CarsService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ChiikiMasterService/List", runtime.WithHTTPPathPattern("/api/v1/db/chiiki-master"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_CarsService/List", runtime.WithHTTPPathPattern("/api/v1/db/cars"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_ChiikiMasterService_List_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_CarsService_List_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_CarsService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_CarsService_GetByBumonCodeID_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_CarsService/GetByBumonCodeID", runtime.WithHTTPPathPattern("/api/v1/db/cars/bumon/{bumon_code_id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_Db_CarsService_GetByBumonCodeID_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_CarsService_GetByBumonCodeID_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	return nil
+}
+
+var (
+	pattern_Db_CarsService_Get_0              = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "cars", "id"}, ""))
+	pattern_Db_CarsService_List_0             = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "cars"}, ""))
+	pattern_Db_CarsService_GetByBumonCodeID_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 1, 0, 4, 1, 5, 5}, []string{"api", "v1", "db", "cars", "bumon", "bumon_code_id"}, ""))
+)
+
+var (
+	forward_Db_CarsService_Get_0              = runtime.ForwardResponseMessage
+	forward_Db_CarsService_List_0             = runtime.ForwardResponseMessage
+	forward_Db_CarsService_GetByBumonCodeID_0 = runtime.ForwardResponseMessage
+)
+
+// RegisterDb_DriversServiceHandlerFromEndpoint is same as RegisterDb_DriversServiceHandler but
+// automatically dials to "endpoint" and closes the connection when "ctx" gets done.
+func RegisterDb_DriversServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+	conn, err := grpc.NewClient(endpoint, opts...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Errorf("Failed to close conn to %s: %v", endpoint, cerr)
+			}
 			return
 		}
-		forward_Db_ChiikiMasterService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		go func() {
+			<-ctx.Done()
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Errorf("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+		}()
+	}()
+	return RegisterDb_DriversServiceHandler(ctx, mux, conn)
+}
 
-	return nil
+// RegisterDb_DriversServiceHandler registers the http handlers for service Db_DriversService to "mux".
+// The handlers forward requests to the grpc endpoint over "conn".
+func RegisterDb_DriversServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterDb_DriversServiceHandlerClient(ctx, mux, NewDb_DriversServiceClient(conn))
 }
 
-// RegisterDb_ChikuMasterServiceHandlerServer registers the http handlers for service Db_ChikuMasterService to "mux".
-// UnaryRPC     :call Db_ChikuMasterServiceServer directly.
-// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
-// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterDb_ChikuMasterServiceHandlerFromEndpoint instead.
-// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
-func RegisterDb_ChikuMasterServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server Db_ChikuMasterServiceServer) error {
-	mux.Handle(http.MethodGet, pattern_Db_ChikuMasterService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+// RegisterDb_DriversServiceHandlerClient registers the http handlers for service Db_DriversService
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_DriversServiceClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_DriversServiceClient"
+// doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
+// "Db_DriversServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
+func RegisterDb_DriversServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_DriversServiceClient) error {
+	mux.Handle(http.MethodGet, pattern_Db_DriversService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ChikuMasterService/Get", runtime.WithHTTPPathPattern("/api/v1/db/chiku-master/{chiku_c}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DriversService/Get", runtime.WithHTTPPathPattern("/api/v1/db/drivers/{id}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_ChikuMasterService_Get_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_DriversService_Get_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_ChikuMasterService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_DriversService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_ChikuMasterService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_DriversService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ChikuMasterService/List", runtime.WithHTTPPathPattern("/api/v1/db/chiku-master"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DriversService/List", runtime.WithHTTPPathPattern("/api/v1/db/drivers"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_ChikuMasterService_List_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_DriversService_List_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_ChikuMasterService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_DriversService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_ChikuMasterService_GetByChiikiC_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_DriversService_GetByBumon_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/db_service.Db_ChikuMasterService/GetByChiikiC", runtime.WithHTTPPathPattern("/api/v1/db/chiku-master/chiiki/{chiiki_c}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DriversService/GetByBumon", runtime.WithHTTPPathPattern("/api/v1/db/drivers/bumon/{bumon}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_Db_ChikuMasterService_GetByChiikiC_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_Db_DriversService_GetByBumon_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_ChikuMasterService_GetByChiikiC_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_DriversService_GetByBumon_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-
 	return nil
 }
 
-// RegisterDb_DTakoUriageKeihiServiceHandlerFromEndpoint is same as RegisterDb_DTakoUriageKeihiServiceHandler but
+var (
+	pattern_Db_DriversService_Get_0        = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "drivers", "id"}, ""))
+	pattern_Db_DriversService_List_0       = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "drivers"}, ""))
+	pattern_Db_DriversService_GetByBumon_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "drivers", "bumon"}, ""))
+)
+
+var (
+	forward_Db_DriversService_Get_0        = runtime.ForwardResponseMessage
+	forward_Db_DriversService_List_0       = runtime.ForwardResponseMessage
+	forward_Db_DriversService_GetByBumon_0 = runtime.ForwardResponseMessage
+)
+
+// RegisterDb_UntenNippoMeisaiServiceHandlerFromEndpoint is same as RegisterDb_UntenNippoMeisaiServiceHandler but
 // automatically dials to "endpoint" and closes the connection when "ctx" gets done.
-func RegisterDb_DTakoUriageKeihiServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+func RegisterDb_UntenNippoMeisaiServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
 	conn, err := grpc.NewClient(endpoint, opts...)
 	if err != nil {
 		return err
@@ -3378,128 +8398,147 @@ func RegisterDb_DTakoUriageKeihiServiceHandlerFromEndpoint(ctx context.Context,
 			}
 		}()
 	}()
-	return RegisterDb_DTakoUriageKeihiServiceHandler(ctx, mux, conn)
+	return RegisterDb_UntenNippoMeisaiServiceHandler(ctx, mux, conn)
 }
 
-// RegisterDb_DTakoUriageKeihiServiceHandler registers the http handlers for service Db_DTakoUriageKeihiService to "mux".
+// RegisterDb_UntenNippoMeisaiServiceHandler registers the http handlers for service Db_UntenNippoMeisaiService to "mux".
 // The handlers forward requests to the grpc endpoint over "conn".
-func RegisterDb_DTakoUriageKeihiServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
-	return RegisterDb_DTakoUriageKeihiServiceHandlerClient(ctx, mux, NewDb_DTakoUriageKeihiServiceClient(conn))
+func RegisterDb_UntenNippoMeisaiServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterDb_UntenNippoMeisaiServiceHandlerClient(ctx, mux, NewDb_UntenNippoMeisaiServiceClient(conn))
 }
 
-// RegisterDb_DTakoUriageKeihiServiceHandlerClient registers the http handlers for service Db_DTakoUriageKeihiService
-// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_DTakoUriageKeihiServiceClient".
-// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_DTakoUriageKeihiServiceClient"
+// RegisterDb_UntenNippoMeisaiServiceHandlerClient registers the http handlers for service Db_UntenNippoMeisaiService
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_UntenNippoMeisaiServiceClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_UntenNippoMeisaiServiceClient"
 // doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
-// "Db_DTakoUriageKeihiServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
-func RegisterDb_DTakoUriageKeihiServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_DTakoUriageKeihiServiceClient) error {
-	mux.Handle(http.MethodPost, pattern_Db_DTakoUriageKeihiService_Create_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+// "Db_UntenNippoMeisaiServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
+func RegisterDb_UntenNippoMeisaiServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_UntenNippoMeisaiServiceClient) error {
+	mux.Handle(http.MethodGet, pattern_Db_UntenNippoMeisaiService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoUriageKeihiService/Create", runtime.WithHTTPPathPattern("/api/v1/db/dtako-uriage-keihi"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_UntenNippoMeisaiService/Get", runtime.WithHTTPPathPattern("/api/v1/db/unten-nippo-meisai/{nippo_k}/{haisha_k}/{sharyo_c}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_DTakoUriageKeihiService_Create_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_UntenNippoMeisaiService_Get_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_DTakoUriageKeihiService_Create_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_UntenNippoMeisaiService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_DTakoUriageKeihiService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_UntenNippoMeisaiService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoUriageKeihiService/Get", runtime.WithHTTPPathPattern("/api/v1/db/dtako-uriage-keihi/{srch_id}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_UntenNippoMeisaiService/List", runtime.WithHTTPPathPattern("/api/v1/db/unten-nippo-meisai"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_DTakoUriageKeihiService_Get_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_UntenNippoMeisaiService_List_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_DTakoUriageKeihiService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_UntenNippoMeisaiService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodPut, pattern_Db_DTakoUriageKeihiService_Update_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_UntenNippoMeisaiService_Stream_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoUriageKeihiService/Update", runtime.WithHTTPPathPattern("/api/v1/db/dtako-uriage-keihi/{dtako_uriage_keihi.srch_id}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_UntenNippoMeisaiService/Stream", runtime.WithHTTPPathPattern("/api/v1/db/unten-nippo-meisai/stream"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_DTakoUriageKeihiService_Update_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_UntenNippoMeisaiService_Stream_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_DTakoUriageKeihiService_Update_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_UntenNippoMeisaiService_Stream_0(annotatedContext, mux, outboundMarshaler, w, req, func() (proto.Message, error) { return resp.Recv() }, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodDelete, pattern_Db_DTakoUriageKeihiService_Delete_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_UntenNippoMeisaiService_GetBySharyoC_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoUriageKeihiService/Delete", runtime.WithHTTPPathPattern("/api/v1/db/dtako-uriage-keihi/{srch_id}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_UntenNippoMeisaiService/GetBySharyoC", runtime.WithHTTPPathPattern("/api/v1/db/unten-nippo-meisai/sharyo/{sharyo_c}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_DTakoUriageKeihiService_Delete_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_UntenNippoMeisaiService_GetBySharyoC_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_DTakoUriageKeihiService_Delete_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_UntenNippoMeisaiService_GetBySharyoC_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_DTakoUriageKeihiService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_UntenNippoMeisaiService_GetByDateRange_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoUriageKeihiService/List", runtime.WithHTTPPathPattern("/api/v1/db/dtako-uriage-keihi"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_UntenNippoMeisaiService/GetByDateRange", runtime.WithHTTPPathPattern("/api/v1/db/unten-nippo-meisai/date-range"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_DTakoUriageKeihiService_List_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_UntenNippoMeisaiService_GetByDateRange_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_DTakoUriageKeihiService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_UntenNippoMeisaiService_GetByDateRange_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_UntenNippoMeisaiService_GetByBumon_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_UntenNippoMeisaiService/GetByBumon", runtime.WithHTTPPathPattern("/api/v1/db/unten-nippo-meisai/bumon/{bumon}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_Db_UntenNippoMeisaiService_GetByBumon_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_UntenNippoMeisaiService_GetByBumon_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
 	return nil
 }
 
 var (
-	pattern_Db_DTakoUriageKeihiService_Create_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "dtako-uriage-keihi"}, ""))
-	pattern_Db_DTakoUriageKeihiService_Get_0    = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "dtako-uriage-keihi", "srch_id"}, ""))
-	pattern_Db_DTakoUriageKeihiService_Update_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "dtako-uriage-keihi", "dtako_uriage_keihi.srch_id"}, ""))
-	pattern_Db_DTakoUriageKeihiService_Delete_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "dtako-uriage-keihi", "srch_id"}, ""))
-	pattern_Db_DTakoUriageKeihiService_List_0   = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "dtako-uriage-keihi"}, ""))
+	pattern_Db_UntenNippoMeisaiService_Get_0            = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 1, 0, 4, 1, 5, 5, 1, 0, 4, 1, 5, 6}, []string{"api", "v1", "db", "unten-nippo-meisai", "nippo_k", "haisha_k", "sharyo_c"}, ""))
+	pattern_Db_UntenNippoMeisaiService_List_0           = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "unten-nippo-meisai"}, ""))
+	pattern_Db_UntenNippoMeisaiService_Stream_0         = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4}, []string{"api", "v1", "db", "unten-nippo-meisai", "stream"}, ""))
+	pattern_Db_UntenNippoMeisaiService_GetBySharyoC_0   = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 1, 0, 4, 1, 5, 5}, []string{"api", "v1", "db", "unten-nippo-meisai", "sharyo", "sharyo_c"}, ""))
+	pattern_Db_UntenNippoMeisaiService_GetByDateRange_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4}, []string{"api", "v1", "db", "unten-nippo-meisai", "date-range"}, ""))
+	pattern_Db_UntenNippoMeisaiService_GetByBumon_0     = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "unten-nippo-meisai", "bumon"}, ""))
 )
 
 var (
-	forward_Db_DTakoUriageKeihiService_Create_0 = runtime.ForwardResponseMessage
-	forward_Db_DTakoUriageKeihiService_Get_0    = runtime.ForwardResponseMessage
-	forward_Db_DTakoUriageKeihiService_Update_0 = runtime.ForwardResponseMessage
-	forward_Db_DTakoUriageKeihiService_Delete_0 = runtime.ForwardResponseMessage
-	forward_Db_DTakoUriageKeihiService_List_0   = runtime.ForwardResponseMessage
+	forward_Db_UntenNippoMeisaiService_Get_0            = runtime.ForwardResponseMessage
+	forward_Db_UntenNippoMeisaiService_List_0           = runtime.ForwardResponseMessage
+	forward_Db_UntenNippoMeisaiService_Stream_0         = runtime.ForwardResponseStream
+	forward_Db_UntenNippoMeisaiService_GetBySharyoC_0   = runtime.ForwardResponseMessage
+	forward_Db_UntenNippoMeisaiService_GetByDateRange_0 = runtime.ForwardResponseMessage
+	forward_Db_UntenNippoMeisaiService_GetByBumon_0     = runtime.ForwardResponseMessage
 )
 
-// RegisterDb_ETCMeisaiServiceHandlerFromEndpoint is same as RegisterDb_ETCMeisaiServiceHandler but
+// RegisterDb_ShainMasterServiceHandlerFromEndpoint is same as RegisterDb_ShainMasterServiceHandler but
 // automatically dials to "endpoint" and closes the connection when "ctx" gets done.
-func RegisterDb_ETCMeisaiServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+func RegisterDb_ShainMasterServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
 	conn, err := grpc.NewClient(endpoint, opts...)
 	if err != nil {
 		return err
@@ -3518,128 +8557,173 @@ func RegisterDb_ETCMeisaiServiceHandlerFromEndpoint(ctx context.Context, mux *ru
 			}
 		}()
 	}()
-	return RegisterDb_ETCMeisaiServiceHandler(ctx, mux, conn)
+	return RegisterDb_ShainMasterServiceHandler(ctx, mux, conn)
 }
 
-// RegisterDb_ETCMeisaiServiceHandler registers the http handlers for service Db_ETCMeisaiService to "mux".
+// RegisterDb_ShainMasterServiceHandler registers the http handlers for service Db_ShainMasterService to "mux".
 // The handlers forward requests to the grpc endpoint over "conn".
-func RegisterDb_ETCMeisaiServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
-	return RegisterDb_ETCMeisaiServiceHandlerClient(ctx, mux, NewDb_ETCMeisaiServiceClient(conn))
+func RegisterDb_ShainMasterServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterDb_ShainMasterServiceHandlerClient(ctx, mux, NewDb_ShainMasterServiceClient(conn))
 }
 
-// RegisterDb_ETCMeisaiServiceHandlerClient registers the http handlers for service Db_ETCMeisaiService
-// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_ETCMeisaiServiceClient".
-// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_ETCMeisaiServiceClient"
+// RegisterDb_ShainMasterServiceHandlerClient registers the http handlers for service Db_ShainMasterService
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_ShainMasterServiceClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_ShainMasterServiceClient"
 // doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
-// "Db_ETCMeisaiServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
-func RegisterDb_ETCMeisaiServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_ETCMeisaiServiceClient) error {
-	mux.Handle(http.MethodPost, pattern_Db_ETCMeisaiService_Create_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+// "Db_ShainMasterServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
+func RegisterDb_ShainMasterServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_ShainMasterServiceClient) error {
+	mux.Handle(http.MethodGet, pattern_Db_ShainMasterService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ETCMeisaiService/Create", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ShainMasterService/Get", runtime.WithHTTPPathPattern("/api/v1/db/shain-master/{shain_c}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_ETCMeisaiService_Create_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_ShainMasterService_Get_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_ETCMeisaiService_Create_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_ShainMasterService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_ETCMeisaiService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_ShainMasterService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ETCMeisaiService/Get", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai/{id}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ShainMasterService/List", runtime.WithHTTPPathPattern("/api/v1/db/shain-master"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_ETCMeisaiService_Get_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_ShainMasterService_List_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_ETCMeisaiService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_ShainMasterService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodPut, pattern_Db_ETCMeisaiService_Update_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_ShainMasterService_GetByBumonC_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ETCMeisaiService/Update", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai/{etc_meisai.id}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ShainMasterService/GetByBumonC", runtime.WithHTTPPathPattern("/api/v1/db/shain-master/bumon/{bumon_c}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_ETCMeisaiService_Update_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_ShainMasterService_GetByBumonC_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_ETCMeisaiService_Update_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_ShainMasterService_GetByBumonC_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodDelete, pattern_Db_ETCMeisaiService_Delete_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	return nil
+}
+
+var (
+	pattern_Db_ShainMasterService_Get_0         = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "shain-master", "shain_c"}, ""))
+	pattern_Db_ShainMasterService_List_0        = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "shain-master"}, ""))
+	pattern_Db_ShainMasterService_GetByBumonC_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 1, 0, 4, 1, 5, 5}, []string{"api", "v1", "db", "shain-master", "bumon", "bumon_c"}, ""))
+)
+
+var (
+	forward_Db_ShainMasterService_Get_0         = runtime.ForwardResponseMessage
+	forward_Db_ShainMasterService_List_0        = runtime.ForwardResponseMessage
+	forward_Db_ShainMasterService_GetByBumonC_0 = runtime.ForwardResponseMessage
+)
+
+// RegisterDb_ChiikiMasterServiceHandlerFromEndpoint is same as RegisterDb_ChiikiMasterServiceHandler but
+// automatically dials to "endpoint" and closes the connection when "ctx" gets done.
+func RegisterDb_ChiikiMasterServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+	conn, err := grpc.NewClient(endpoint, opts...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Errorf("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+			return
+		}
+		go func() {
+			<-ctx.Done()
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Errorf("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+		}()
+	}()
+	return RegisterDb_ChiikiMasterServiceHandler(ctx, mux, conn)
+}
+
+// RegisterDb_ChiikiMasterServiceHandler registers the http handlers for service Db_ChiikiMasterService to "mux".
+// The handlers forward requests to the grpc endpoint over "conn".
+func RegisterDb_ChiikiMasterServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterDb_ChiikiMasterServiceHandlerClient(ctx, mux, NewDb_ChiikiMasterServiceClient(conn))
+}
+
+// RegisterDb_ChiikiMasterServiceHandlerClient registers the http handlers for service Db_ChiikiMasterService
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_ChiikiMasterServiceClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_ChiikiMasterServiceClient"
+// doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
+// "Db_ChiikiMasterServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
+func RegisterDb_ChiikiMasterServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_ChiikiMasterServiceClient) error {
+	mux.Handle(http.MethodGet, pattern_Db_ChiikiMasterService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ETCMeisaiService/Delete", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai/{id}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ChiikiMasterService/Get", runtime.WithHTTPPathPattern("/api/v1/db/chiiki-master/{chiiki_c}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_ETCMeisaiService_Delete_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_ChiikiMasterService_Get_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_ETCMeisaiService_Delete_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_ChiikiMasterService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_ETCMeisaiService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_ChiikiMasterService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ETCMeisaiService/List", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ChiikiMasterService/List", runtime.WithHTTPPathPattern("/api/v1/db/chiiki-master"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_ETCMeisaiService_List_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_ChiikiMasterService_List_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_ETCMeisaiService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_ChiikiMasterService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
 	return nil
 }
 
 var (
-	pattern_Db_ETCMeisaiService_Create_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "etc-meisai"}, ""))
-	pattern_Db_ETCMeisaiService_Get_0    = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "etc-meisai", "id"}, ""))
-	pattern_Db_ETCMeisaiService_Update_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "etc-meisai", "etc_meisai.id"}, ""))
-	pattern_Db_ETCMeisaiService_Delete_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "etc-meisai", "id"}, ""))
-	pattern_Db_ETCMeisaiService_List_0   = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "etc-meisai"}, ""))
+	pattern_Db_ChiikiMasterService_Get_0  = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "chiiki-master", "chiiki_c"}, ""))
+	pattern_Db_ChiikiMasterService_List_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "chiiki-master"}, ""))
 )
 
 var (
-	forward_Db_ETCMeisaiService_Create_0 = runtime.ForwardResponseMessage
-	forward_Db_ETCMeisaiService_Get_0    = runtime.ForwardResponseMessage
-	forward_Db_ETCMeisaiService_Update_0 = runtime.ForwardResponseMessage
-	forward_Db_ETCMeisaiService_Delete_0 = runtime.ForwardResponseMessage
-	forward_Db_ETCMeisaiService_List_0   = runtime.ForwardResponseMessage
+	forward_Db_ChiikiMasterService_Get_0  = runtime.ForwardResponseMessage
+	forward_Db_ChiikiMasterService_List_0 = runtime.ForwardResponseMessage
 )
 
-// RegisterDb_DTakoFerryRowsServiceHandlerFromEndpoint is same as RegisterDb_DTakoFerryRowsServiceHandler but
+// RegisterDb_ChikuMasterServiceHandlerFromEndpoint is same as RegisterDb_ChikuMasterServiceHandler but
 // automatically dials to "endpoint" and closes the connection when "ctx" gets done.
-func RegisterDb_DTakoFerryRowsServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+func RegisterDb_ChikuMasterServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
 	conn, err := grpc.NewClient(endpoint, opts...)
 	if err != nil {
 		return err
@@ -3658,128 +8742,90 @@ func RegisterDb_DTakoFerryRowsServiceHandlerFromEndpoint(ctx context.Context, mu
 			}
 		}()
 	}()
-	return RegisterDb_DTakoFerryRowsServiceHandler(ctx, mux, conn)
+	return RegisterDb_ChikuMasterServiceHandler(ctx, mux, conn)
 }
 
-// RegisterDb_DTakoFerryRowsServiceHandler registers the http handlers for service Db_DTakoFerryRowsService to "mux".
+// RegisterDb_ChikuMasterServiceHandler registers the http handlers for service Db_ChikuMasterService to "mux".
 // The handlers forward requests to the grpc endpoint over "conn".
-func RegisterDb_DTakoFerryRowsServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
-	return RegisterDb_DTakoFerryRowsServiceHandlerClient(ctx, mux, NewDb_DTakoFerryRowsServiceClient(conn))
+func RegisterDb_ChikuMasterServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterDb_ChikuMasterServiceHandlerClient(ctx, mux, NewDb_ChikuMasterServiceClient(conn))
 }
 
-// RegisterDb_DTakoFerryRowsServiceHandlerClient registers the http handlers for service Db_DTakoFerryRowsService
-// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_DTakoFerryRowsServiceClient".
-// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_DTakoFerryRowsServiceClient"
+// RegisterDb_ChikuMasterServiceHandlerClient registers the http handlers for service Db_ChikuMasterService
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_ChikuMasterServiceClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_ChikuMasterServiceClient"
 // doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
-// "Db_DTakoFerryRowsServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
-func RegisterDb_DTakoFerryRowsServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_DTakoFerryRowsServiceClient) error {
-	mux.Handle(http.MethodPost, pattern_Db_DTakoFerryRowsService_Create_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
-		ctx, cancel := context.WithCancel(req.Context())
-		defer cancel()
-		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoFerryRowsService/Create", runtime.WithHTTPPathPattern("/api/v1/db/dtako-ferry-rows"))
-		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
-			return
-		}
-		resp, md, err := request_Db_DTakoFerryRowsService_Create_0(annotatedContext, inboundMarshaler, client, req, pathParams)
-		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
-		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
-			return
-		}
-		forward_Db_DTakoFerryRowsService_Create_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
-	mux.Handle(http.MethodGet, pattern_Db_DTakoFerryRowsService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
-		ctx, cancel := context.WithCancel(req.Context())
-		defer cancel()
-		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoFerryRowsService/Get", runtime.WithHTTPPathPattern("/api/v1/db/dtako-ferry-rows/{id}"))
-		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
-			return
-		}
-		resp, md, err := request_Db_DTakoFerryRowsService_Get_0(annotatedContext, inboundMarshaler, client, req, pathParams)
-		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
-		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
-			return
-		}
-		forward_Db_DTakoFerryRowsService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
-	mux.Handle(http.MethodPut, pattern_Db_DTakoFerryRowsService_Update_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+// "Db_ChikuMasterServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
+func RegisterDb_ChikuMasterServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_ChikuMasterServiceClient) error {
+	mux.Handle(http.MethodGet, pattern_Db_ChikuMasterService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoFerryRowsService/Update", runtime.WithHTTPPathPattern("/api/v1/db/dtako-ferry-rows/{dtako_ferry_rows.id}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ChikuMasterService/Get", runtime.WithHTTPPathPattern("/api/v1/db/chiku-master/{chiku_c}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_DTakoFerryRowsService_Update_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_ChikuMasterService_Get_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_DTakoFerryRowsService_Update_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_ChikuMasterService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodDelete, pattern_Db_DTakoFerryRowsService_Delete_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_ChikuMasterService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoFerryRowsService/Delete", runtime.WithHTTPPathPattern("/api/v1/db/dtako-ferry-rows/{id}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ChikuMasterService/List", runtime.WithHTTPPathPattern("/api/v1/db/chiku-master"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_DTakoFerryRowsService_Delete_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_ChikuMasterService_List_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_DTakoFerryRowsService_Delete_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_ChikuMasterService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_DTakoFerryRowsService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_ChikuMasterService_GetByChiikiC_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoFerryRowsService/List", runtime.WithHTTPPathPattern("/api/v1/db/dtako-ferry-rows"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ChikuMasterService/GetByChiikiC", runtime.WithHTTPPathPattern("/api/v1/db/chiku-master/chiiki/{chiiki_c}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_DTakoFerryRowsService_List_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_ChikuMasterService_GetByChiikiC_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_DTakoFerryRowsService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_ChikuMasterService_GetByChiikiC_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
 	return nil
 }
 
 var (
-	pattern_Db_DTakoFerryRowsService_Create_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "dtako-ferry-rows"}, ""))
-	pattern_Db_DTakoFerryRowsService_Get_0    = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "dtako-ferry-rows", "id"}, ""))
-	pattern_Db_DTakoFerryRowsService_Update_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "dtako-ferry-rows", "dtako_ferry_rows.id"}, ""))
-	pattern_Db_DTakoFerryRowsService_Delete_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "dtako-ferry-rows", "id"}, ""))
-	pattern_Db_DTakoFerryRowsService_List_0   = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "dtako-ferry-rows"}, ""))
+	pattern_Db_ChikuMasterService_Get_0          = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "chiku-master", "chiku_c"}, ""))
+	pattern_Db_ChikuMasterService_List_0         = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "chiku-master"}, ""))
+	pattern_Db_ChikuMasterService_GetByChiikiC_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 1, 0, 4, 1, 5, 5}, []string{"api", "v1", "db", "chiku-master", "chiiki", "chiiki_c"}, ""))
 )
 
 var (
-	forward_Db_DTakoFerryRowsService_Create_0 = runtime.ForwardResponseMessage
-	forward_Db_DTakoFerryRowsService_Get_0    = runtime.ForwardResponseMessage
-	forward_Db_DTakoFerryRowsService_Update_0 = runtime.ForwardResponseMessage
-	forward_Db_DTakoFerryRowsService_Delete_0 = runtime.ForwardResponseMessage
-	forward_Db_DTakoFerryRowsService_List_0   = runtime.ForwardResponseMessage
+	forward_Db_ChikuMasterService_Get_0          = runtime.ForwardResponseMessage
+	forward_Db_ChikuMasterService_List_0         = runtime.ForwardResponseMessage
+	forward_Db_ChikuMasterService_GetByChiikiC_0 = runtime.ForwardResponseMessage
 )
 
-// RegisterDb_ETCMeisaiMappingServiceHandlerFromEndpoint is same as RegisterDb_ETCMeisaiMappingServiceHandler but
+// RegisterDb_TokuisakiMasterServiceHandlerFromEndpoint is same as RegisterDb_TokuisakiMasterServiceHandler but
 // automatically dials to "endpoint" and closes the connection when "ctx" gets done.
-func RegisterDb_ETCMeisaiMappingServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+func RegisterDb_TokuisakiMasterServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
 	conn, err := grpc.NewClient(endpoint, opts...)
 	if err != nil {
 		return err
@@ -3798,147 +8844,128 @@ func RegisterDb_ETCMeisaiMappingServiceHandlerFromEndpoint(ctx context.Context,
 			}
 		}()
 	}()
-	return RegisterDb_ETCMeisaiMappingServiceHandler(ctx, mux, conn)
+	return RegisterDb_TokuisakiMasterServiceHandler(ctx, mux, conn)
 }
 
-// RegisterDb_ETCMeisaiMappingServiceHandler registers the http handlers for service Db_ETCMeisaiMappingService to "mux".
+// RegisterDb_TokuisakiMasterServiceHandler registers the http handlers for service Db_TokuisakiMasterService to "mux".
 // The handlers forward requests to the grpc endpoint over "conn".
-func RegisterDb_ETCMeisaiMappingServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
-	return RegisterDb_ETCMeisaiMappingServiceHandlerClient(ctx, mux, NewDb_ETCMeisaiMappingServiceClient(conn))
+func RegisterDb_TokuisakiMasterServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterDb_TokuisakiMasterServiceHandlerClient(ctx, mux, NewDb_TokuisakiMasterServiceClient(conn))
 }
 
-// RegisterDb_ETCMeisaiMappingServiceHandlerClient registers the http handlers for service Db_ETCMeisaiMappingService
-// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_ETCMeisaiMappingServiceClient".
-// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_ETCMeisaiMappingServiceClient"
+// RegisterDb_TokuisakiMasterServiceHandlerClient registers the http handlers for service Db_TokuisakiMasterService
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_TokuisakiMasterServiceClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_TokuisakiMasterServiceClient"
 // doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
-// "Db_ETCMeisaiMappingServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
-func RegisterDb_ETCMeisaiMappingServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_ETCMeisaiMappingServiceClient) error {
-	mux.Handle(http.MethodPost, pattern_Db_ETCMeisaiMappingService_Create_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
-		ctx, cancel := context.WithCancel(req.Context())
-		defer cancel()
-		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ETCMeisaiMappingService/Create", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai-mapping"))
-		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
-			return
-		}
-		resp, md, err := request_Db_ETCMeisaiMappingService_Create_0(annotatedContext, inboundMarshaler, client, req, pathParams)
-		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
-		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
-			return
-		}
-		forward_Db_ETCMeisaiMappingService_Create_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
-	mux.Handle(http.MethodGet, pattern_Db_ETCMeisaiMappingService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+// "Db_TokuisakiMasterServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
+func RegisterDb_TokuisakiMasterServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_TokuisakiMasterServiceClient) error {
+	mux.Handle(http.MethodGet, pattern_Db_TokuisakiMasterService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ETCMeisaiMappingService/Get", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai-mapping/{id}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_TokuisakiMasterService/Get", runtime.WithHTTPPathPattern("/api/v1/db/tokuisaki-master/{tokuisaki_c}/{tokuisaki_h}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_ETCMeisaiMappingService_Get_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_TokuisakiMasterService_Get_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_ETCMeisaiMappingService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_TokuisakiMasterService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodPut, pattern_Db_ETCMeisaiMappingService_Update_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_TokuisakiMasterService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ETCMeisaiMappingService/Update", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai-mapping/{etc_meisai_mapping.id}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_TokuisakiMasterService/List", runtime.WithHTTPPathPattern("/api/v1/db/tokuisaki-master"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_ETCMeisaiMappingService_Update_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_TokuisakiMasterService_List_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_ETCMeisaiMappingService_Update_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_TokuisakiMasterService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodDelete, pattern_Db_ETCMeisaiMappingService_Delete_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_TokuisakiMasterService_Search_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ETCMeisaiMappingService/Delete", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai-mapping/{id}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_TokuisakiMasterService/Search", runtime.WithHTTPPathPattern("/api/v1/db/tokuisaki-master/search"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_ETCMeisaiMappingService_Delete_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_TokuisakiMasterService_Search_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_ETCMeisaiMappingService_Delete_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_TokuisakiMasterService_Search_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_ETCMeisaiMappingService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_TokuisakiMasterService_ListTekiyobi_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ETCMeisaiMappingService/List", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai-mapping"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_TokuisakiMasterService/ListTekiyobi", runtime.WithHTTPPathPattern("/api/v1/db/tokuisaki-master/{tokuisaki_c}/{tokuisaki_h}/tekiyobi"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_ETCMeisaiMappingService_List_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_TokuisakiMasterService_ListTekiyobi_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_ETCMeisaiMappingService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_TokuisakiMasterService_ListTekiyobi_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_ETCMeisaiMappingService_GetDTakoRowIDByHash_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_TokuisakiMasterService_GetTekiyobiAt_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ETCMeisaiMappingService/GetDTakoRowIDByHash", runtime.WithHTTPPathPattern("/api/v1/db/etc-meisai-mapping/by-hash/{etc_meisai_hash}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_TokuisakiMasterService/GetTekiyobiAt", runtime.WithHTTPPathPattern("/api/v1/db/tokuisaki-master/{tokuisaki_c}/{tokuisaki_h}/tekiyobi/at"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_ETCMeisaiMappingService_GetDTakoRowIDByHash_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_TokuisakiMasterService_GetTekiyobiAt_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_ETCMeisaiMappingService_GetDTakoRowIDByHash_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_TokuisakiMasterService_GetTekiyobiAt_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
 	return nil
 }
 
 var (
-	pattern_Db_ETCMeisaiMappingService_Create_0              = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "etc-meisai-mapping"}, ""))
-	pattern_Db_ETCMeisaiMappingService_Get_0                 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "etc-meisai-mapping", "id"}, ""))
-	pattern_Db_ETCMeisaiMappingService_Update_0              = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "etc-meisai-mapping", "etc_meisai_mapping.id"}, ""))
-	pattern_Db_ETCMeisaiMappingService_Delete_0              = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "etc-meisai-mapping", "id"}, ""))
-	pattern_Db_ETCMeisaiMappingService_List_0                = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "etc-meisai-mapping"}, ""))
-	pattern_Db_ETCMeisaiMappingService_GetDTakoRowIDByHash_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 1, 0, 4, 1, 5, 5}, []string{"api", "v1", "db", "etc-meisai-mapping", "by-hash", "etc_meisai_hash"}, ""))
+	pattern_Db_TokuisakiMasterService_Get_0           = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 1, 0, 4, 1, 5, 5}, []string{"api", "v1", "db", "tokuisaki-master", "tokuisaki_c", "tokuisaki_h"}, ""))
+	pattern_Db_TokuisakiMasterService_List_0          = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "tokuisaki-master"}, ""))
+	pattern_Db_TokuisakiMasterService_Search_0        = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4}, []string{"api", "v1", "db", "tokuisaki-master", "search"}, ""))
+	pattern_Db_TokuisakiMasterService_ListTekiyobi_0  = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 1, 0, 4, 1, 5, 5, 2, 6}, []string{"api", "v1", "db", "tokuisaki-master", "tokuisaki_c", "tokuisaki_h", "tekiyobi"}, ""))
+	pattern_Db_TokuisakiMasterService_GetTekiyobiAt_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 1, 0, 4, 1, 5, 5, 2, 6, 2, 7}, []string{"api", "v1", "db", "tokuisaki-master", "tokuisaki_c", "tokuisaki_h", "tekiyobi", "at"}, ""))
 )
 
 var (
-	forward_Db_ETCMeisaiMappingService_Create_0              = runtime.ForwardResponseMessage
-	forward_Db_ETCMeisaiMappingService_Get_0                 = runtime.ForwardResponseMessage
-	forward_Db_ETCMeisaiMappingService_Update_0              = runtime.ForwardResponseMessage
-	forward_Db_ETCMeisaiMappingService_Delete_0              = runtime.ForwardResponseMessage
-	forward_Db_ETCMeisaiMappingService_List_0                = runtime.ForwardResponseMessage
-	forward_Db_ETCMeisaiMappingService_GetDTakoRowIDByHash_0 = runtime.ForwardResponseMessage
+	forward_Db_TokuisakiMasterService_Get_0           = runtime.ForwardResponseMessage
+	forward_Db_TokuisakiMasterService_List_0          = runtime.ForwardResponseMessage
+	forward_Db_TokuisakiMasterService_Search_0        = runtime.ForwardResponseMessage
+	forward_Db_TokuisakiMasterService_ListTekiyobi_0  = runtime.ForwardResponseMessage
+	forward_Db_TokuisakiMasterService_GetTekiyobiAt_0 = runtime.ForwardResponseMessage
 )
 
-// RegisterDb_DTakoCarsServiceHandlerFromEndpoint is same as RegisterDb_DTakoCarsServiceHandler but
+// RegisterDb_HinmeiMasterServiceHandlerFromEndpoint is same as RegisterDb_HinmeiMasterServiceHandler but
 // automatically dials to "endpoint" and closes the connection when "ctx" gets done.
-func RegisterDb_DTakoCarsServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+func RegisterDb_HinmeiMasterServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
 	conn, err := grpc.NewClient(endpoint, opts...)
 	if err != nil {
 		return err
@@ -3957,192 +8984,147 @@ func RegisterDb_DTakoCarsServiceHandlerFromEndpoint(ctx context.Context, mux *ru
 			}
 		}()
 	}()
-	return RegisterDb_DTakoCarsServiceHandler(ctx, mux, conn)
+	return RegisterDb_HinmeiMasterServiceHandler(ctx, mux, conn)
 }
 
-// RegisterDb_DTakoCarsServiceHandler registers the http handlers for service Db_DTakoCarsService to "mux".
+// RegisterDb_HinmeiMasterServiceHandler registers the http handlers for service Db_HinmeiMasterService to "mux".
 // The handlers forward requests to the grpc endpoint over "conn".
-func RegisterDb_DTakoCarsServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
-	return RegisterDb_DTakoCarsServiceHandlerClient(ctx, mux, NewDb_DTakoCarsServiceClient(conn))
+func RegisterDb_HinmeiMasterServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterDb_HinmeiMasterServiceHandlerClient(ctx, mux, NewDb_HinmeiMasterServiceClient(conn))
 }
 
-// RegisterDb_DTakoCarsServiceHandlerClient registers the http handlers for service Db_DTakoCarsService
-// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_DTakoCarsServiceClient".
-// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_DTakoCarsServiceClient"
+// RegisterDb_HinmeiMasterServiceHandlerClient registers the http handlers for service Db_HinmeiMasterService
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_HinmeiMasterServiceClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_HinmeiMasterServiceClient"
 // doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
-// "Db_DTakoCarsServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
-func RegisterDb_DTakoCarsServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_DTakoCarsServiceClient) error {
-	mux.Handle(http.MethodGet, pattern_Db_DTakoCarsService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
-		ctx, cancel := context.WithCancel(req.Context())
-		defer cancel()
-		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoCarsService/Get", runtime.WithHTTPPathPattern("/api/v1/db/dtako-cars/{id}"))
-		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
-			return
-		}
-		resp, md, err := request_Db_DTakoCarsService_Get_0(annotatedContext, inboundMarshaler, client, req, pathParams)
-		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
-		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
-			return
-		}
-		forward_Db_DTakoCarsService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
-	mux.Handle(http.MethodGet, pattern_Db_DTakoCarsService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+// "Db_HinmeiMasterServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
+func RegisterDb_HinmeiMasterServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_HinmeiMasterServiceClient) error {
+	mux.Handle(http.MethodGet, pattern_Db_HinmeiMasterService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoCarsService/List", runtime.WithHTTPPathPattern("/api/v1/db/dtako-cars"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_HinmeiMasterService/Get", runtime.WithHTTPPathPattern("/api/v1/db/hinmei-master/{hinmei_c}/{hinmei_h}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_DTakoCarsService_List_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_HinmeiMasterService_Get_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_DTakoCarsService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_HinmeiMasterService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_DTakoCarsService_GetByCarCode_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_HinmeiMasterService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoCarsService/GetByCarCode", runtime.WithHTTPPathPattern("/api/v1/db/dtako-cars/by-car-code/{car_code}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_HinmeiMasterService/List", runtime.WithHTTPPathPattern("/api/v1/db/hinmei-master"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
-		}
-		resp, md, err := request_Db_DTakoCarsService_GetByCarCode_0(annotatedContext, inboundMarshaler, client, req, pathParams)
-		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
-		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
-			return
-		}
-		forward_Db_DTakoCarsService_GetByCarCode_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
-	return nil
-}
-
-var (
-	pattern_Db_DTakoCarsService_Get_0          = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "dtako-cars", "id"}, ""))
-	pattern_Db_DTakoCarsService_List_0         = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "dtako-cars"}, ""))
-	pattern_Db_DTakoCarsService_GetByCarCode_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 1, 0, 4, 1, 5, 5}, []string{"api", "v1", "db", "dtako-cars", "by-car-code", "car_code"}, ""))
-)
-
-var (
-	forward_Db_DTakoCarsService_Get_0          = runtime.ForwardResponseMessage
-	forward_Db_DTakoCarsService_List_0         = runtime.ForwardResponseMessage
-	forward_Db_DTakoCarsService_GetByCarCode_0 = runtime.ForwardResponseMessage
-)
-
-// RegisterDb_DTakoEventsServiceHandlerFromEndpoint is same as RegisterDb_DTakoEventsServiceHandler but
-// automatically dials to "endpoint" and closes the connection when "ctx" gets done.
-func RegisterDb_DTakoEventsServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
-	conn, err := grpc.NewClient(endpoint, opts...)
-	if err != nil {
-		return err
-	}
-	defer func() {
+		}
+		resp, md, err := request_Db_HinmeiMasterService_List_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			if cerr := conn.Close(); cerr != nil {
-				grpclog.Errorf("Failed to close conn to %s: %v", endpoint, cerr)
-			}
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		go func() {
-			<-ctx.Done()
-			if cerr := conn.Close(); cerr != nil {
-				grpclog.Errorf("Failed to close conn to %s: %v", endpoint, cerr)
-			}
-		}()
-	}()
-	return RegisterDb_DTakoEventsServiceHandler(ctx, mux, conn)
-}
-
-// RegisterDb_DTakoEventsServiceHandler registers the http handlers for service Db_DTakoEventsService to "mux".
-// The handlers forward requests to the grpc endpoint over "conn".
-func RegisterDb_DTakoEventsServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
-	return RegisterDb_DTakoEventsServiceHandlerClient(ctx, mux, NewDb_DTakoEventsServiceClient(conn))
-}
-
-// RegisterDb_DTakoEventsServiceHandlerClient registers the http handlers for service Db_DTakoEventsService
-// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_DTakoEventsServiceClient".
-// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_DTakoEventsServiceClient"
-// doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
-// "Db_DTakoEventsServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
-func RegisterDb_DTakoEventsServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_DTakoEventsServiceClient) error {
-	mux.Handle(http.MethodGet, pattern_Db_DTakoEventsService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		forward_Db_HinmeiMasterService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_HinmeiMasterService_Search_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoEventsService/Get", runtime.WithHTTPPathPattern("/api/v1/db/dtako-events/{id}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_HinmeiMasterService/Search", runtime.WithHTTPPathPattern("/api/v1/db/hinmei-master/search"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_DTakoEventsService_Get_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_HinmeiMasterService_Search_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_DTakoEventsService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_HinmeiMasterService_Search_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_DTakoEventsService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_HinmeiMasterService_ListTokuisakiHinmei_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoEventsService/List", runtime.WithHTTPPathPattern("/api/v1/db/dtako-events"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_HinmeiMasterService/ListTokuisakiHinmei", runtime.WithHTTPPathPattern("/api/v1/db/tokuisaki-master/{tokuisaki_c}/{tokuisaki_h}/hinmei"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_DTakoEventsService_List_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_HinmeiMasterService_ListTokuisakiHinmei_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_DTakoEventsService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_HinmeiMasterService_ListTokuisakiHinmei_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_DTakoEventsService_GetByOperationNo_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_HinmeiMasterService_GetTokuisakiHinmei_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoEventsService/GetByOperationNo", runtime.WithHTTPPathPattern("/api/v1/db/dtako-events/by-operation-no/{operation_no}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_HinmeiMasterService/GetTokuisakiHinmei", runtime.WithHTTPPathPattern("/api/v1/db/tokuisaki-master/{tokuisaki_c}/{tokuisaki_h}/hinmei/{hinmei_c}/{hinmei_h}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_DTakoEventsService_GetByOperationNo_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_HinmeiMasterService_GetTokuisakiHinmei_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_DTakoEventsService_GetByOperationNo_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_HinmeiMasterService_GetTokuisakiHinmei_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_HinmeiMasterService_Resolve_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_HinmeiMasterService/Resolve", runtime.WithHTTPPathPattern("/api/v1/db/hinmei-master/{hinmei_c}/{hinmei_h}/resolve"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_Db_HinmeiMasterService_Resolve_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_HinmeiMasterService_Resolve_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
 	return nil
 }
 
 var (
-	pattern_Db_DTakoEventsService_Get_0              = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "dtako-events", "id"}, ""))
-	pattern_Db_DTakoEventsService_List_0             = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "dtako-events"}, ""))
-	pattern_Db_DTakoEventsService_GetByOperationNo_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 1, 0, 4, 1, 5, 5}, []string{"api", "v1", "db", "dtako-events", "by-operation-no", "operation_no"}, ""))
+	pattern_Db_HinmeiMasterService_Get_0                 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 1, 0, 4, 1, 5, 5}, []string{"api", "v1", "db", "hinmei-master", "hinmei_c", "hinmei_h"}, ""))
+	pattern_Db_HinmeiMasterService_List_0                = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "hinmei-master"}, ""))
+	pattern_Db_HinmeiMasterService_Search_0              = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4}, []string{"api", "v1", "db", "hinmei-master", "search"}, ""))
+	pattern_Db_HinmeiMasterService_ListTokuisakiHinmei_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 1, 0, 4, 1, 5, 5, 2, 6}, []string{"api", "v1", "db", "tokuisaki-master", "tokuisaki_c", "tokuisaki_h", "hinmei"}, ""))
+	pattern_Db_HinmeiMasterService_GetTokuisakiHinmei_0  = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 1, 0, 4, 1, 5, 5, 2, 6, 1, 0, 4, 1, 5, 7, 1, 0, 4, 1, 5, 8}, []string{"api", "v1", "db", "tokuisaki-master", "tokuisaki_c", "tokuisaki_h", "hinmei", "hinmei_c", "hinmei_h"}, ""))
+	pattern_Db_HinmeiMasterService_Resolve_0             = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 1, 0, 4, 1, 5, 5, 2, 6}, []string{"api", "v1", "db", "hinmei-master", "hinmei_c", "hinmei_h", "resolve"}, ""))
 )
 
 var (
-	forward_Db_DTakoEventsService_Get_0              = runtime.ForwardResponseMessage
-	forward_Db_DTakoEventsService_List_0             = runtime.ForwardResponseMessage
-	forward_Db_DTakoEventsService_GetByOperationNo_0 = runtime.ForwardResponseMessage
+	forward_Db_HinmeiMasterService_Get_0                 = runtime.ForwardResponseMessage
+	forward_Db_HinmeiMasterService_List_0                = runtime.ForwardResponseMessage
+	forward_Db_HinmeiMasterService_Search_0              = runtime.ForwardResponseMessage
+	forward_Db_HinmeiMasterService_ListTokuisakiHinmei_0 = runtime.ForwardResponseMessage
+	forward_Db_HinmeiMasterService_GetTokuisakiHinmei_0  = runtime.ForwardResponseMessage
+	forward_Db_HinmeiMasterService_Resolve_0             = runtime.ForwardResponseMessage
 )
 
-// RegisterDb_DTakoRowsServiceHandlerFromEndpoint is same as RegisterDb_DTakoRowsServiceHandler but
+// RegisterDb_BumonMasterServiceHandlerFromEndpoint is same as RegisterDb_BumonMasterServiceHandler but
 // automatically dials to "endpoint" and closes the connection when "ctx" gets done.
-func RegisterDb_DTakoRowsServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+func RegisterDb_BumonMasterServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
 	conn, err := grpc.NewClient(endpoint, opts...)
 	if err != nil {
 		return err
@@ -4161,90 +9143,71 @@ func RegisterDb_DTakoRowsServiceHandlerFromEndpoint(ctx context.Context, mux *ru
 			}
 		}()
 	}()
-	return RegisterDb_DTakoRowsServiceHandler(ctx, mux, conn)
+	return RegisterDb_BumonMasterServiceHandler(ctx, mux, conn)
 }
 
-// RegisterDb_DTakoRowsServiceHandler registers the http handlers for service Db_DTakoRowsService to "mux".
+// RegisterDb_BumonMasterServiceHandler registers the http handlers for service Db_BumonMasterService to "mux".
 // The handlers forward requests to the grpc endpoint over "conn".
-func RegisterDb_DTakoRowsServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
-	return RegisterDb_DTakoRowsServiceHandlerClient(ctx, mux, NewDb_DTakoRowsServiceClient(conn))
+func RegisterDb_BumonMasterServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterDb_BumonMasterServiceHandlerClient(ctx, mux, NewDb_BumonMasterServiceClient(conn))
 }
 
-// RegisterDb_DTakoRowsServiceHandlerClient registers the http handlers for service Db_DTakoRowsService
-// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_DTakoRowsServiceClient".
-// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_DTakoRowsServiceClient"
+// RegisterDb_BumonMasterServiceHandlerClient registers the http handlers for service Db_BumonMasterService
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_BumonMasterServiceClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_BumonMasterServiceClient"
 // doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
-// "Db_DTakoRowsServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
-func RegisterDb_DTakoRowsServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_DTakoRowsServiceClient) error {
-	mux.Handle(http.MethodGet, pattern_Db_DTakoRowsService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
-		ctx, cancel := context.WithCancel(req.Context())
-		defer cancel()
-		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoRowsService/Get", runtime.WithHTTPPathPattern("/api/v1/db/dtako-rows/{id}"))
-		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
-			return
-		}
-		resp, md, err := request_Db_DTakoRowsService_Get_0(annotatedContext, inboundMarshaler, client, req, pathParams)
-		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
-		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
-			return
-		}
-		forward_Db_DTakoRowsService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
-	mux.Handle(http.MethodGet, pattern_Db_DTakoRowsService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+// "Db_BumonMasterServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
+func RegisterDb_BumonMasterServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_BumonMasterServiceClient) error {
+	mux.Handle(http.MethodGet, pattern_Db_BumonMasterService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoRowsService/List", runtime.WithHTTPPathPattern("/api/v1/db/dtako-rows"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_BumonMasterService/Get", runtime.WithHTTPPathPattern("/api/v1/db/bumon-master/{bumon_c}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_DTakoRowsService_List_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_BumonMasterService_Get_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_DTakoRowsService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_BumonMasterService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_DTakoRowsService_GetByOperationNo_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_BumonMasterService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoRowsService/GetByOperationNo", runtime.WithHTTPPathPattern("/api/v1/db/dtako-rows/by-operation-no/{operation_no}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_BumonMasterService/List", runtime.WithHTTPPathPattern("/api/v1/db/bumon-master"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_DTakoRowsService_GetByOperationNo_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_BumonMasterService_List_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_DTakoRowsService_GetByOperationNo_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_BumonMasterService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
 	return nil
 }
 
 var (
-	pattern_Db_DTakoRowsService_Get_0              = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "dtako-rows", "id"}, ""))
-	pattern_Db_DTakoRowsService_List_0             = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "dtako-rows"}, ""))
-	pattern_Db_DTakoRowsService_GetByOperationNo_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 1, 0, 4, 1, 5, 5}, []string{"api", "v1", "db", "dtako-rows", "by-operation-no", "operation_no"}, ""))
+	pattern_Db_BumonMasterService_Get_0  = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "bumon-master", "bumon_c"}, ""))
+	pattern_Db_BumonMasterService_List_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "bumon-master"}, ""))
 )
 
 var (
-	forward_Db_DTakoRowsService_Get_0              = runtime.ForwardResponseMessage
-	forward_Db_DTakoRowsService_List_0             = runtime.ForwardResponseMessage
-	forward_Db_DTakoRowsService_GetByOperationNo_0 = runtime.ForwardResponseMessage
+	forward_Db_BumonMasterService_Get_0  = runtime.ForwardResponseMessage
+	forward_Db_BumonMasterService_List_0 = runtime.ForwardResponseMessage
 )
 
-// RegisterDb_ETCNumServiceHandlerFromEndpoint is same as RegisterDb_ETCNumServiceHandler but
+// RegisterDb_SharyoMasterServiceHandlerFromEndpoint is same as RegisterDb_SharyoMasterServiceHandler but
 // automatically dials to "endpoint" and closes the connection when "ctx" gets done.
-func RegisterDb_ETCNumServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+func RegisterDb_SharyoMasterServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
 	conn, err := grpc.NewClient(endpoint, opts...)
 	if err != nil {
 		return err
@@ -4263,90 +9226,90 @@ func RegisterDb_ETCNumServiceHandlerFromEndpoint(ctx context.Context, mux *runti
 			}
 		}()
 	}()
-	return RegisterDb_ETCNumServiceHandler(ctx, mux, conn)
+	return RegisterDb_SharyoMasterServiceHandler(ctx, mux, conn)
 }
 
-// RegisterDb_ETCNumServiceHandler registers the http handlers for service Db_ETCNumService to "mux".
+// RegisterDb_SharyoMasterServiceHandler registers the http handlers for service Db_SharyoMasterService to "mux".
 // The handlers forward requests to the grpc endpoint over "conn".
-func RegisterDb_ETCNumServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
-	return RegisterDb_ETCNumServiceHandlerClient(ctx, mux, NewDb_ETCNumServiceClient(conn))
+func RegisterDb_SharyoMasterServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterDb_SharyoMasterServiceHandlerClient(ctx, mux, NewDb_SharyoMasterServiceClient(conn))
 }
 
-// RegisterDb_ETCNumServiceHandlerClient registers the http handlers for service Db_ETCNumService
-// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_ETCNumServiceClient".
-// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_ETCNumServiceClient"
+// RegisterDb_SharyoMasterServiceHandlerClient registers the http handlers for service Db_SharyoMasterService
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_SharyoMasterServiceClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_SharyoMasterServiceClient"
 // doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
-// "Db_ETCNumServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
-func RegisterDb_ETCNumServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_ETCNumServiceClient) error {
-	mux.Handle(http.MethodGet, pattern_Db_ETCNumService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+// "Db_SharyoMasterServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
+func RegisterDb_SharyoMasterServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_SharyoMasterServiceClient) error {
+	mux.Handle(http.MethodGet, pattern_Db_SharyoMasterService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ETCNumService/List", runtime.WithHTTPPathPattern("/api/v1/db/etc-num"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_SharyoMasterService/Get", runtime.WithHTTPPathPattern("/api/v1/db/sharyo-master/{sharyo_c}/{sharyo_h}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_ETCNumService_List_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_SharyoMasterService_Get_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_ETCNumService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_SharyoMasterService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_ETCNumService_GetByETCCardNum_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_SharyoMasterService_ListBySharyoC_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ETCNumService/GetByETCCardNum", runtime.WithHTTPPathPattern("/api/v1/db/etc-num/by-etc-card-num/{etc_card_num}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_SharyoMasterService/ListBySharyoC", runtime.WithHTTPPathPattern("/api/v1/db/sharyo-master/{sharyo_c}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_ETCNumService_GetByETCCardNum_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_SharyoMasterService_ListBySharyoC_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_ETCNumService_GetByETCCardNum_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_SharyoMasterService_ListBySharyoC_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_ETCNumService_GetByCarID_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_SharyoMasterService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ETCNumService/GetByCarID", runtime.WithHTTPPathPattern("/api/v1/db/etc-num/by-car-id/{car_id}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_SharyoMasterService/List", runtime.WithHTTPPathPattern("/api/v1/db/sharyo-master"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_ETCNumService_GetByCarID_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_SharyoMasterService_List_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_ETCNumService_GetByCarID_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_SharyoMasterService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
 	return nil
 }
 
 var (
-	pattern_Db_ETCNumService_List_0            = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "etc-num"}, ""))
-	pattern_Db_ETCNumService_GetByETCCardNum_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 1, 0, 4, 1, 5, 5}, []string{"api", "v1", "db", "etc-num", "by-etc-card-num", "etc_card_num"}, ""))
-	pattern_Db_ETCNumService_GetByCarID_0      = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 1, 0, 4, 1, 5, 5}, []string{"api", "v1", "db", "etc-num", "by-car-id", "car_id"}, ""))
+	pattern_Db_SharyoMasterService_Get_0           = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 1, 0, 4, 1, 5, 5}, []string{"api", "v1", "db", "sharyo-master", "sharyo_c", "sharyo_h"}, ""))
+	pattern_Db_SharyoMasterService_ListBySharyoC_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "sharyo-master", "sharyo_c"}, ""))
+	pattern_Db_SharyoMasterService_List_0          = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "sharyo-master"}, ""))
 )
 
 var (
-	forward_Db_ETCNumService_List_0            = runtime.ForwardResponseMessage
-	forward_Db_ETCNumService_GetByETCCardNum_0 = runtime.ForwardResponseMessage
-	forward_Db_ETCNumService_GetByCarID_0      = runtime.ForwardResponseMessage
+	forward_Db_SharyoMasterService_Get_0           = runtime.ForwardResponseMessage
+	forward_Db_SharyoMasterService_ListBySharyoC_0 = runtime.ForwardResponseMessage
+	forward_Db_SharyoMasterService_List_0          = runtime.ForwardResponseMessage
 )
 
-// RegisterDb_DTakoFerryRowsProdServiceHandlerFromEndpoint is same as RegisterDb_DTakoFerryRowsProdServiceHandler but
+// RegisterDb_VehicleIdentityServiceHandlerFromEndpoint is same as RegisterDb_VehicleIdentityServiceHandler but
 // automatically dials to "endpoint" and closes the connection when "ctx" gets done.
-func RegisterDb_DTakoFerryRowsProdServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+func RegisterDb_VehicleIdentityServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
 	conn, err := grpc.NewClient(endpoint, opts...)
 	if err != nil {
 		return err
@@ -4365,90 +9328,71 @@ func RegisterDb_DTakoFerryRowsProdServiceHandlerFromEndpoint(ctx context.Context
 			}
 		}()
 	}()
-	return RegisterDb_DTakoFerryRowsProdServiceHandler(ctx, mux, conn)
+	return RegisterDb_VehicleIdentityServiceHandler(ctx, mux, conn)
 }
 
-// RegisterDb_DTakoFerryRowsProdServiceHandler registers the http handlers for service Db_DTakoFerryRowsProdService to "mux".
+// RegisterDb_VehicleIdentityServiceHandler registers the http handlers for service Db_VehicleIdentityService to "mux".
 // The handlers forward requests to the grpc endpoint over "conn".
-func RegisterDb_DTakoFerryRowsProdServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
-	return RegisterDb_DTakoFerryRowsProdServiceHandlerClient(ctx, mux, NewDb_DTakoFerryRowsProdServiceClient(conn))
+func RegisterDb_VehicleIdentityServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterDb_VehicleIdentityServiceHandlerClient(ctx, mux, NewDb_VehicleIdentityServiceClient(conn))
 }
 
-// RegisterDb_DTakoFerryRowsProdServiceHandlerClient registers the http handlers for service Db_DTakoFerryRowsProdService
-// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_DTakoFerryRowsProdServiceClient".
-// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_DTakoFerryRowsProdServiceClient"
+// RegisterDb_VehicleIdentityServiceHandlerClient registers the http handlers for service Db_VehicleIdentityService
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_VehicleIdentityServiceClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_VehicleIdentityServiceClient"
 // doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
-// "Db_DTakoFerryRowsProdServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
-func RegisterDb_DTakoFerryRowsProdServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_DTakoFerryRowsProdServiceClient) error {
-	mux.Handle(http.MethodGet, pattern_Db_DTakoFerryRowsProdService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
-		ctx, cancel := context.WithCancel(req.Context())
-		defer cancel()
-		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoFerryRowsProdService/Get", runtime.WithHTTPPathPattern("/api/v1/db/dtako-ferry-rows-prod/{id}"))
-		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
-			return
-		}
-		resp, md, err := request_Db_DTakoFerryRowsProdService_Get_0(annotatedContext, inboundMarshaler, client, req, pathParams)
-		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
-		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
-			return
-		}
-		forward_Db_DTakoFerryRowsProdService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
-	mux.Handle(http.MethodGet, pattern_Db_DTakoFerryRowsProdService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+// "Db_VehicleIdentityServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
+func RegisterDb_VehicleIdentityServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_VehicleIdentityServiceClient) error {
+	mux.Handle(http.MethodGet, pattern_Db_VehicleIdentityService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoFerryRowsProdService/List", runtime.WithHTTPPathPattern("/api/v1/db/dtako-ferry-rows-prod"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_VehicleIdentityService/Get", runtime.WithHTTPPathPattern("/api/v1/db/vehicle-identity"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_DTakoFerryRowsProdService_List_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_VehicleIdentityService_Get_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_DTakoFerryRowsProdService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_VehicleIdentityService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_DTakoFerryRowsProdService_GetByUnkoNo_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_VehicleIdentityService_ListGaps_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DTakoFerryRowsProdService/GetByUnkoNo", runtime.WithHTTPPathPattern("/api/v1/db/dtako-ferry-rows-prod/by-unko-no/{unko_no}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_VehicleIdentityService/ListGaps", runtime.WithHTTPPathPattern("/api/v1/db/vehicle-identity/gaps"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_DTakoFerryRowsProdService_GetByUnkoNo_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_VehicleIdentityService_ListGaps_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_DTakoFerryRowsProdService_GetByUnkoNo_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_VehicleIdentityService_ListGaps_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
 	return nil
 }
 
 var (
-	pattern_Db_DTakoFerryRowsProdService_Get_0         = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "dtako-ferry-rows-prod", "id"}, ""))
-	pattern_Db_DTakoFerryRowsProdService_List_0        = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "dtako-ferry-rows-prod"}, ""))
-	pattern_Db_DTakoFerryRowsProdService_GetByUnkoNo_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 1, 0, 4, 1, 5, 5}, []string{"api", "v1", "db", "dtako-ferry-rows-prod", "by-unko-no", "unko_no"}, ""))
+	pattern_Db_VehicleIdentityService_Get_0      = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "vehicle-identity"}, ""))
+	pattern_Db_VehicleIdentityService_ListGaps_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4}, []string{"api", "v1", "db", "vehicle-identity", "gaps"}, ""))
 )
 
 var (
-	forward_Db_DTakoFerryRowsProdService_Get_0         = runtime.ForwardResponseMessage
-	forward_Db_DTakoFerryRowsProdService_List_0        = runtime.ForwardResponseMessage
-	forward_Db_DTakoFerryRowsProdService_GetByUnkoNo_0 = runtime.ForwardResponseMessage
+	forward_Db_VehicleIdentityService_Get_0      = runtime.ForwardResponseMessage
+	forward_Db_VehicleIdentityService_ListGaps_0 = runtime.ForwardResponseMessage
 )
 
-// RegisterDb_CarsServiceHandlerFromEndpoint is same as RegisterDb_CarsServiceHandler but
+// RegisterDb_TimeCardServiceHandlerFromEndpoint is same as RegisterDb_TimeCardServiceHandler but
 // automatically dials to "endpoint" and closes the connection when "ctx" gets done.
-func RegisterDb_CarsServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+func RegisterDb_TimeCardServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
 	conn, err := grpc.NewClient(endpoint, opts...)
 	if err != nil {
 		return err
@@ -4467,90 +9411,71 @@ func RegisterDb_CarsServiceHandlerFromEndpoint(ctx context.Context, mux *runtime
 			}
 		}()
 	}()
-	return RegisterDb_CarsServiceHandler(ctx, mux, conn)
+	return RegisterDb_TimeCardServiceHandler(ctx, mux, conn)
 }
 
-// RegisterDb_CarsServiceHandler registers the http handlers for service Db_CarsService to "mux".
+// RegisterDb_TimeCardServiceHandler registers the http handlers for service Db_TimeCardService to "mux".
 // The handlers forward requests to the grpc endpoint over "conn".
-func RegisterDb_CarsServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
-	return RegisterDb_CarsServiceHandlerClient(ctx, mux, NewDb_CarsServiceClient(conn))
+func RegisterDb_TimeCardServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterDb_TimeCardServiceHandlerClient(ctx, mux, NewDb_TimeCardServiceClient(conn))
 }
 
-// RegisterDb_CarsServiceHandlerClient registers the http handlers for service Db_CarsService
-// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_CarsServiceClient".
-// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_CarsServiceClient"
+// RegisterDb_TimeCardServiceHandlerClient registers the http handlers for service Db_TimeCardService
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_TimeCardServiceClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_TimeCardServiceClient"
 // doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
-// "Db_CarsServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
-func RegisterDb_CarsServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_CarsServiceClient) error {
-	mux.Handle(http.MethodGet, pattern_Db_CarsService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
-		ctx, cancel := context.WithCancel(req.Context())
-		defer cancel()
-		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_CarsService/Get", runtime.WithHTTPPathPattern("/api/v1/db/cars/{id}"))
-		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
-			return
-		}
-		resp, md, err := request_Db_CarsService_Get_0(annotatedContext, inboundMarshaler, client, req, pathParams)
-		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
-		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
-			return
-		}
-		forward_Db_CarsService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
-	mux.Handle(http.MethodGet, pattern_Db_CarsService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+// "Db_TimeCardServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
+func RegisterDb_TimeCardServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_TimeCardServiceClient) error {
+	mux.Handle(http.MethodGet, pattern_Db_TimeCardService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_CarsService/List", runtime.WithHTTPPathPattern("/api/v1/db/cars"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_TimeCardService/Get", runtime.WithHTTPPathPattern("/api/v1/db/time-card/{id}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_CarsService_List_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_TimeCardService_Get_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_CarsService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_TimeCardService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_CarsService_GetByBumonCodeID_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_TimeCardService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_CarsService/GetByBumonCodeID", runtime.WithHTTPPathPattern("/api/v1/db/cars/bumon/{bumon_code_id}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_TimeCardService/List", runtime.WithHTTPPathPattern("/api/v1/db/time-card"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_CarsService_GetByBumonCodeID_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_TimeCardService_List_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_CarsService_GetByBumonCodeID_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_TimeCardService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
 	return nil
 }
 
 var (
-	pattern_Db_CarsService_Get_0              = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "cars", "id"}, ""))
-	pattern_Db_CarsService_List_0             = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "cars"}, ""))
-	pattern_Db_CarsService_GetByBumonCodeID_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 1, 0, 4, 1, 5, 5}, []string{"api", "v1", "db", "cars", "bumon", "bumon_code_id"}, ""))
+	pattern_Db_TimeCardService_Get_0  = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "time-card", "id"}, ""))
+	pattern_Db_TimeCardService_List_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "time-card"}, ""))
 )
 
 var (
-	forward_Db_CarsService_Get_0              = runtime.ForwardResponseMessage
-	forward_Db_CarsService_List_0             = runtime.ForwardResponseMessage
-	forward_Db_CarsService_GetByBumonCodeID_0 = runtime.ForwardResponseMessage
+	forward_Db_TimeCardService_Get_0  = runtime.ForwardResponseMessage
+	forward_Db_TimeCardService_List_0 = runtime.ForwardResponseMessage
 )
 
-// RegisterDb_DriversServiceHandlerFromEndpoint is same as RegisterDb_DriversServiceHandler but
+// RegisterDb_TimeCardDevServiceHandlerFromEndpoint is same as RegisterDb_TimeCardDevServiceHandler but
 // automatically dials to "endpoint" and closes the connection when "ctx" gets done.
-func RegisterDb_DriversServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+func RegisterDb_TimeCardDevServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
 	conn, err := grpc.NewClient(endpoint, opts...)
 	if err != nil {
 		return err
@@ -4569,90 +9494,128 @@ func RegisterDb_DriversServiceHandlerFromEndpoint(ctx context.Context, mux *runt
 			}
 		}()
 	}()
-	return RegisterDb_DriversServiceHandler(ctx, mux, conn)
+	return RegisterDb_TimeCardDevServiceHandler(ctx, mux, conn)
 }
 
-// RegisterDb_DriversServiceHandler registers the http handlers for service Db_DriversService to "mux".
+// RegisterDb_TimeCardDevServiceHandler registers the http handlers for service Db_TimeCardDevService to "mux".
 // The handlers forward requests to the grpc endpoint over "conn".
-func RegisterDb_DriversServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
-	return RegisterDb_DriversServiceHandlerClient(ctx, mux, NewDb_DriversServiceClient(conn))
+func RegisterDb_TimeCardDevServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterDb_TimeCardDevServiceHandlerClient(ctx, mux, NewDb_TimeCardDevServiceClient(conn))
 }
 
-// RegisterDb_DriversServiceHandlerClient registers the http handlers for service Db_DriversService
-// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_DriversServiceClient".
-// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_DriversServiceClient"
+// RegisterDb_TimeCardDevServiceHandlerClient registers the http handlers for service Db_TimeCardDevService
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_TimeCardDevServiceClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_TimeCardDevServiceClient"
 // doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
-// "Db_DriversServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
-func RegisterDb_DriversServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_DriversServiceClient) error {
-	mux.Handle(http.MethodGet, pattern_Db_DriversService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+// "Db_TimeCardDevServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
+func RegisterDb_TimeCardDevServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_TimeCardDevServiceClient) error {
+	mux.Handle(http.MethodPost, pattern_Db_TimeCardDevService_Create_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DriversService/Get", runtime.WithHTTPPathPattern("/api/v1/db/drivers/{id}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_TimeCardDevService/Create", runtime.WithHTTPPathPattern("/api/v1/db/time-card-dev"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_DriversService_Get_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_TimeCardDevService_Create_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_DriversService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_TimeCardDevService_Create_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_DriversService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_TimeCardDevService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DriversService/List", runtime.WithHTTPPathPattern("/api/v1/db/drivers"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_TimeCardDevService/Get", runtime.WithHTTPPathPattern("/api/v1/db/time-card-dev/{id}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_DriversService_List_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_TimeCardDevService_Get_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_DriversService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_TimeCardDevService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_DriversService_GetByBumon_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodPut, pattern_Db_TimeCardDevService_Update_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_DriversService/GetByBumon", runtime.WithHTTPPathPattern("/api/v1/db/drivers/bumon/{bumon}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_TimeCardDevService/Update", runtime.WithHTTPPathPattern("/api/v1/db/time-card-dev/{time_card.id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_Db_TimeCardDevService_Update_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_TimeCardDevService_Update_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodDelete, pattern_Db_TimeCardDevService_Delete_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_TimeCardDevService/Delete", runtime.WithHTTPPathPattern("/api/v1/db/time-card-dev/{id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_Db_TimeCardDevService_Delete_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_TimeCardDevService_Delete_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_TimeCardDevService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_TimeCardDevService/List", runtime.WithHTTPPathPattern("/api/v1/db/time-card-dev"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_DriversService_GetByBumon_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_TimeCardDevService_List_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_DriversService_GetByBumon_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_TimeCardDevService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
 	return nil
 }
 
 var (
-	pattern_Db_DriversService_Get_0        = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "drivers", "id"}, ""))
-	pattern_Db_DriversService_List_0       = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "drivers"}, ""))
-	pattern_Db_DriversService_GetByBumon_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "drivers", "bumon"}, ""))
+	pattern_Db_TimeCardDevService_Create_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "time-card-dev"}, ""))
+	pattern_Db_TimeCardDevService_Get_0    = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "time-card-dev", "id"}, ""))
+	pattern_Db_TimeCardDevService_Update_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "time-card-dev", "time_card.id"}, ""))
+	pattern_Db_TimeCardDevService_Delete_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "time-card-dev", "id"}, ""))
+	pattern_Db_TimeCardDevService_List_0   = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "time-card-dev"}, ""))
 )
 
 var (
-	forward_Db_DriversService_Get_0        = runtime.ForwardResponseMessage
-	forward_Db_DriversService_List_0       = runtime.ForwardResponseMessage
-	forward_Db_DriversService_GetByBumon_0 = runtime.ForwardResponseMessage
+	forward_Db_TimeCardDevService_Create_0 = runtime.ForwardResponseMessage
+	forward_Db_TimeCardDevService_Get_0    = runtime.ForwardResponseMessage
+	forward_Db_TimeCardDevService_Update_0 = runtime.ForwardResponseMessage
+	forward_Db_TimeCardDevService_Delete_0 = runtime.ForwardResponseMessage
+	forward_Db_TimeCardDevService_List_0   = runtime.ForwardResponseMessage
 )
 
-// RegisterDb_UntenNippoMeisaiServiceHandlerFromEndpoint is same as RegisterDb_UntenNippoMeisaiServiceHandler but
+// RegisterDb_TimeCardLogServiceHandlerFromEndpoint is same as RegisterDb_TimeCardLogServiceHandler but
 // automatically dials to "endpoint" and closes the connection when "ctx" gets done.
-func RegisterDb_UntenNippoMeisaiServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+func RegisterDb_TimeCardLogServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
 	conn, err := grpc.NewClient(endpoint, opts...)
 	if err != nil {
 		return err
@@ -4671,109 +9634,147 @@ func RegisterDb_UntenNippoMeisaiServiceHandlerFromEndpoint(ctx context.Context,
 			}
 		}()
 	}()
-	return RegisterDb_UntenNippoMeisaiServiceHandler(ctx, mux, conn)
+	return RegisterDb_TimeCardLogServiceHandler(ctx, mux, conn)
 }
 
-// RegisterDb_UntenNippoMeisaiServiceHandler registers the http handlers for service Db_UntenNippoMeisaiService to "mux".
+// RegisterDb_TimeCardLogServiceHandler registers the http handlers for service Db_TimeCardLogService to "mux".
 // The handlers forward requests to the grpc endpoint over "conn".
-func RegisterDb_UntenNippoMeisaiServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
-	return RegisterDb_UntenNippoMeisaiServiceHandlerClient(ctx, mux, NewDb_UntenNippoMeisaiServiceClient(conn))
+func RegisterDb_TimeCardLogServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterDb_TimeCardLogServiceHandlerClient(ctx, mux, NewDb_TimeCardLogServiceClient(conn))
 }
 
-// RegisterDb_UntenNippoMeisaiServiceHandlerClient registers the http handlers for service Db_UntenNippoMeisaiService
-// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_UntenNippoMeisaiServiceClient".
-// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_UntenNippoMeisaiServiceClient"
+// RegisterDb_TimeCardLogServiceHandlerClient registers the http handlers for service Db_TimeCardLogService
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_TimeCardLogServiceClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_TimeCardLogServiceClient"
 // doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
-// "Db_UntenNippoMeisaiServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
-func RegisterDb_UntenNippoMeisaiServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_UntenNippoMeisaiServiceClient) error {
-	mux.Handle(http.MethodGet, pattern_Db_UntenNippoMeisaiService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+// "Db_TimeCardLogServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
+func RegisterDb_TimeCardLogServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_TimeCardLogServiceClient) error {
+	mux.Handle(http.MethodPost, pattern_Db_TimeCardLogService_Create_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_UntenNippoMeisaiService/Get", runtime.WithHTTPPathPattern("/api/v1/db/unten-nippo-meisai/{nippo_k}/{haisha_k}/{sharyo_c}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_TimeCardLogService/Create", runtime.WithHTTPPathPattern("/api/v1/db/time-card-log"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_UntenNippoMeisaiService_Get_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_TimeCardLogService_Create_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_UntenNippoMeisaiService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_TimeCardLogService_Create_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_UntenNippoMeisaiService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_TimeCardLogService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_UntenNippoMeisaiService/List", runtime.WithHTTPPathPattern("/api/v1/db/unten-nippo-meisai"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_TimeCardLogService/Get", runtime.WithHTTPPathPattern("/api/v1/db/time-card-log/{id}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_UntenNippoMeisaiService_List_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_TimeCardLogService_Get_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_UntenNippoMeisaiService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_TimeCardLogService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_UntenNippoMeisaiService_GetBySharyoC_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodPut, pattern_Db_TimeCardLogService_Update_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_UntenNippoMeisaiService/GetBySharyoC", runtime.WithHTTPPathPattern("/api/v1/db/unten-nippo-meisai/sharyo/{sharyo_c}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_TimeCardLogService/Update", runtime.WithHTTPPathPattern("/api/v1/db/time-card-log/{log.id}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_UntenNippoMeisaiService_GetBySharyoC_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_TimeCardLogService_Update_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_UntenNippoMeisaiService_GetBySharyoC_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_TimeCardLogService_Update_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_UntenNippoMeisaiService_GetByDateRange_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodDelete, pattern_Db_TimeCardLogService_Delete_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_UntenNippoMeisaiService/GetByDateRange", runtime.WithHTTPPathPattern("/api/v1/db/unten-nippo-meisai/date-range"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_TimeCardLogService/Delete", runtime.WithHTTPPathPattern("/api/v1/db/time-card-log/{id}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_UntenNippoMeisaiService_GetByDateRange_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_TimeCardLogService_Delete_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_UntenNippoMeisaiService_GetByDateRange_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_TimeCardLogService_Delete_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_TimeCardLogService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_TimeCardLogService/List", runtime.WithHTTPPathPattern("/api/v1/db/time-card-log"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_Db_TimeCardLogService_List_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_TimeCardLogService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_TimeCardLogService_GetByCardID_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_TimeCardLogService/GetByCardID", runtime.WithHTTPPathPattern("/api/v1/db/time-card-log/by-card-id/{card_id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_Db_TimeCardLogService_GetByCardID_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_TimeCardLogService_GetByCardID_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
 	return nil
 }
 
 var (
-	pattern_Db_UntenNippoMeisaiService_Get_0            = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 1, 0, 4, 1, 5, 5, 1, 0, 4, 1, 5, 6}, []string{"api", "v1", "db", "unten-nippo-meisai", "nippo_k", "haisha_k", "sharyo_c"}, ""))
-	pattern_Db_UntenNippoMeisaiService_List_0           = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "unten-nippo-meisai"}, ""))
-	pattern_Db_UntenNippoMeisaiService_GetBySharyoC_0   = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 1, 0, 4, 1, 5, 5}, []string{"api", "v1", "db", "unten-nippo-meisai", "sharyo", "sharyo_c"}, ""))
-	pattern_Db_UntenNippoMeisaiService_GetByDateRange_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4}, []string{"api", "v1", "db", "unten-nippo-meisai", "date-range"}, ""))
+	pattern_Db_TimeCardLogService_Create_0      = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "time-card-log"}, ""))
+	pattern_Db_TimeCardLogService_Get_0         = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "time-card-log", "id"}, ""))
+	pattern_Db_TimeCardLogService_Update_0      = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "time-card-log", "log.id"}, ""))
+	pattern_Db_TimeCardLogService_Delete_0      = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "time-card-log", "id"}, ""))
+	pattern_Db_TimeCardLogService_List_0        = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "time-card-log"}, ""))
+	pattern_Db_TimeCardLogService_GetByCardID_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 1, 0, 4, 1, 5, 5}, []string{"api", "v1", "db", "time-card-log", "by-card-id", "card_id"}, ""))
 )
 
 var (
-	forward_Db_UntenNippoMeisaiService_Get_0            = runtime.ForwardResponseMessage
-	forward_Db_UntenNippoMeisaiService_List_0           = runtime.ForwardResponseMessage
-	forward_Db_UntenNippoMeisaiService_GetBySharyoC_0   = runtime.ForwardResponseMessage
-	forward_Db_UntenNippoMeisaiService_GetByDateRange_0 = runtime.ForwardResponseMessage
+	forward_Db_TimeCardLogService_Create_0      = runtime.ForwardResponseMessage
+	forward_Db_TimeCardLogService_Get_0         = runtime.ForwardResponseMessage
+	forward_Db_TimeCardLogService_Update_0      = runtime.ForwardResponseMessage
+	forward_Db_TimeCardLogService_Delete_0      = runtime.ForwardResponseMessage
+	forward_Db_TimeCardLogService_List_0        = runtime.ForwardResponseMessage
+	forward_Db_TimeCardLogService_GetByCardID_0 = runtime.ForwardResponseMessage
 )
 
-// RegisterDb_ShainMasterServiceHandlerFromEndpoint is same as RegisterDb_ShainMasterServiceHandler but
+// RegisterDb_EmployeeIdentityServiceHandlerFromEndpoint is same as RegisterDb_EmployeeIdentityServiceHandler but
 // automatically dials to "endpoint" and closes the connection when "ctx" gets done.
-func RegisterDb_ShainMasterServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+func RegisterDb_EmployeeIdentityServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
 	conn, err := grpc.NewClient(endpoint, opts...)
 	if err != nil {
 		return err
@@ -4792,173 +9793,204 @@ func RegisterDb_ShainMasterServiceHandlerFromEndpoint(ctx context.Context, mux *
 			}
 		}()
 	}()
-	return RegisterDb_ShainMasterServiceHandler(ctx, mux, conn)
+	return RegisterDb_EmployeeIdentityServiceHandler(ctx, mux, conn)
 }
 
-// RegisterDb_ShainMasterServiceHandler registers the http handlers for service Db_ShainMasterService to "mux".
+// RegisterDb_EmployeeIdentityServiceHandler registers the http handlers for service Db_EmployeeIdentityService to "mux".
 // The handlers forward requests to the grpc endpoint over "conn".
-func RegisterDb_ShainMasterServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
-	return RegisterDb_ShainMasterServiceHandlerClient(ctx, mux, NewDb_ShainMasterServiceClient(conn))
+func RegisterDb_EmployeeIdentityServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterDb_EmployeeIdentityServiceHandlerClient(ctx, mux, NewDb_EmployeeIdentityServiceClient(conn))
 }
 
-// RegisterDb_ShainMasterServiceHandlerClient registers the http handlers for service Db_ShainMasterService
-// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_ShainMasterServiceClient".
-// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_ShainMasterServiceClient"
+// RegisterDb_EmployeeIdentityServiceHandlerClient registers the http handlers for service Db_EmployeeIdentityService
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_EmployeeIdentityServiceClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_EmployeeIdentityServiceClient"
 // doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
-// "Db_ShainMasterServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
-func RegisterDb_ShainMasterServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_ShainMasterServiceClient) error {
-	mux.Handle(http.MethodGet, pattern_Db_ShainMasterService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+// "Db_EmployeeIdentityServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
+func RegisterDb_EmployeeIdentityServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_EmployeeIdentityServiceClient) error {
+	mux.Handle(http.MethodPost, pattern_Db_EmployeeIdentityService_Create_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ShainMasterService/Get", runtime.WithHTTPPathPattern("/api/v1/db/shain-master/{shain_c}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_EmployeeIdentityService/Create", runtime.WithHTTPPathPattern("/api/v1/db/employee-identity"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_ShainMasterService_Get_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_EmployeeIdentityService_Create_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_ShainMasterService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_EmployeeIdentityService_Create_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_ShainMasterService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_EmployeeIdentityService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ShainMasterService/List", runtime.WithHTTPPathPattern("/api/v1/db/shain-master"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_EmployeeIdentityService/Get", runtime.WithHTTPPathPattern("/api/v1/db/employee-identity/{id}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_ShainMasterService_List_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_EmployeeIdentityService_Get_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_ShainMasterService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_EmployeeIdentityService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_ShainMasterService_GetByBumonC_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodPut, pattern_Db_EmployeeIdentityService_Update_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ShainMasterService/GetByBumonC", runtime.WithHTTPPathPattern("/api/v1/db/shain-master/bumon/{bumon_c}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_EmployeeIdentityService/Update", runtime.WithHTTPPathPattern("/api/v1/db/employee-identity/{employee.id}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_ShainMasterService_GetByBumonC_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_EmployeeIdentityService_Update_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_ShainMasterService_GetByBumonC_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_EmployeeIdentityService_Update_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	return nil
-}
-
-var (
-	pattern_Db_ShainMasterService_Get_0         = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "shain-master", "shain_c"}, ""))
-	pattern_Db_ShainMasterService_List_0        = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "shain-master"}, ""))
-	pattern_Db_ShainMasterService_GetByBumonC_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 1, 0, 4, 1, 5, 5}, []string{"api", "v1", "db", "shain-master", "bumon", "bumon_c"}, ""))
-)
-
-var (
-	forward_Db_ShainMasterService_Get_0         = runtime.ForwardResponseMessage
-	forward_Db_ShainMasterService_List_0        = runtime.ForwardResponseMessage
-	forward_Db_ShainMasterService_GetByBumonC_0 = runtime.ForwardResponseMessage
-)
-
-// RegisterDb_ChiikiMasterServiceHandlerFromEndpoint is same as RegisterDb_ChiikiMasterServiceHandler but
-// automatically dials to "endpoint" and closes the connection when "ctx" gets done.
-func RegisterDb_ChiikiMasterServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
-	conn, err := grpc.NewClient(endpoint, opts...)
-	if err != nil {
-		return err
-	}
-	defer func() {
+	mux.Handle(http.MethodDelete, pattern_Db_EmployeeIdentityService_Delete_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_EmployeeIdentityService/Delete", runtime.WithHTTPPathPattern("/api/v1/db/employee-identity/{id}"))
 		if err != nil {
-			if cerr := conn.Close(); cerr != nil {
-				grpclog.Errorf("Failed to close conn to %s: %v", endpoint, cerr)
-			}
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		go func() {
-			<-ctx.Done()
-			if cerr := conn.Close(); cerr != nil {
-				grpclog.Errorf("Failed to close conn to %s: %v", endpoint, cerr)
-			}
-		}()
-	}()
-	return RegisterDb_ChiikiMasterServiceHandler(ctx, mux, conn)
-}
-
-// RegisterDb_ChiikiMasterServiceHandler registers the http handlers for service Db_ChiikiMasterService to "mux".
-// The handlers forward requests to the grpc endpoint over "conn".
-func RegisterDb_ChiikiMasterServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
-	return RegisterDb_ChiikiMasterServiceHandlerClient(ctx, mux, NewDb_ChiikiMasterServiceClient(conn))
-}
-
-// RegisterDb_ChiikiMasterServiceHandlerClient registers the http handlers for service Db_ChiikiMasterService
-// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_ChiikiMasterServiceClient".
-// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_ChiikiMasterServiceClient"
-// doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
-// "Db_ChiikiMasterServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
-func RegisterDb_ChiikiMasterServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_ChiikiMasterServiceClient) error {
-	mux.Handle(http.MethodGet, pattern_Db_ChiikiMasterService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		resp, md, err := request_Db_EmployeeIdentityService_Delete_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_EmployeeIdentityService_Delete_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_EmployeeIdentityService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ChiikiMasterService/Get", runtime.WithHTTPPathPattern("/api/v1/db/chiiki-master/{chiiki_c}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_EmployeeIdentityService/List", runtime.WithHTTPPathPattern("/api/v1/db/employee-identity"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_ChiikiMasterService_Get_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_EmployeeIdentityService_List_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_ChiikiMasterService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_EmployeeIdentityService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
-	mux.Handle(http.MethodGet, pattern_Db_ChiikiMasterService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle(http.MethodGet, pattern_Db_EmployeeIdentityService_Resolve_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ChiikiMasterService/List", runtime.WithHTTPPathPattern("/api/v1/db/chiiki-master"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_EmployeeIdentityService/Resolve", runtime.WithHTTPPathPattern("/api/v1/db/employee-identity/resolve"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_ChiikiMasterService_List_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_EmployeeIdentityService_Resolve_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_ChiikiMasterService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_EmployeeIdentityService_Resolve_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodPost, pattern_Db_EmployeeIdentityService_RegisterCard_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_EmployeeIdentityService/RegisterCard", runtime.WithHTTPPathPattern("/api/v1/db/employee-identity/{employee_id}/cards"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_Db_EmployeeIdentityService_RegisterCard_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_EmployeeIdentityService_RegisterCard_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodDelete, pattern_Db_EmployeeIdentityService_UnregisterCard_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_EmployeeIdentityService/UnregisterCard", runtime.WithHTTPPathPattern("/api/v1/db/employee-identity/cards/{card_id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_Db_EmployeeIdentityService_UnregisterCard_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_EmployeeIdentityService_UnregisterCard_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_Db_EmployeeIdentityService_ListUnlinked_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_EmployeeIdentityService/ListUnlinked", runtime.WithHTTPPathPattern("/api/v1/db/employee-identity/unlinked"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_Db_EmployeeIdentityService_ListUnlinked_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Db_EmployeeIdentityService_ListUnlinked_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
 	return nil
 }
 
 var (
-	pattern_Db_ChiikiMasterService_Get_0  = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "chiiki-master", "chiiki_c"}, ""))
-	pattern_Db_ChiikiMasterService_List_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "chiiki-master"}, ""))
+	pattern_Db_EmployeeIdentityService_Create_0         = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "employee-identity"}, ""))
+	pattern_Db_EmployeeIdentityService_Get_0            = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "employee-identity", "id"}, ""))
+	pattern_Db_EmployeeIdentityService_Update_0         = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "employee-identity", "employee.id"}, ""))
+	pattern_Db_EmployeeIdentityService_Delete_0         = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "employee-identity", "id"}, ""))
+	pattern_Db_EmployeeIdentityService_List_0           = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "employee-identity"}, ""))
+	pattern_Db_EmployeeIdentityService_Resolve_0        = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4}, []string{"api", "v1", "db", "employee-identity", "resolve"}, ""))
+	pattern_Db_EmployeeIdentityService_RegisterCard_0   = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"api", "v1", "db", "employee-identity", "employee_id", "cards"}, ""))
+	pattern_Db_EmployeeIdentityService_UnregisterCard_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 1, 0, 4, 1, 5, 5}, []string{"api", "v1", "db", "employee-identity", "cards", "card_id"}, ""))
+	pattern_Db_EmployeeIdentityService_ListUnlinked_0   = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4}, []string{"api", "v1", "db", "employee-identity", "unlinked"}, ""))
 )
 
 var (
-	forward_Db_ChiikiMasterService_Get_0  = runtime.ForwardResponseMessage
-	forward_Db_ChiikiMasterService_List_0 = runtime.ForwardResponseMessage
+	forward_Db_EmployeeIdentityService_Create_0         = runtime.ForwardResponseMessage
+	forward_Db_EmployeeIdentityService_Get_0            = runtime.ForwardResponseMessage
+	forward_Db_EmployeeIdentityService_Update_0         = runtime.ForwardResponseMessage
+	forward_Db_EmployeeIdentityService_Delete_0         = runtime.ForwardResponseMessage
+	forward_Db_EmployeeIdentityService_List_0           = runtime.ForwardResponseMessage
+	forward_Db_EmployeeIdentityService_Resolve_0        = runtime.ForwardResponseMessage
+	forward_Db_EmployeeIdentityService_RegisterCard_0   = runtime.ForwardResponseMessage
+	forward_Db_EmployeeIdentityService_UnregisterCard_0 = runtime.ForwardResponseMessage
+	forward_Db_EmployeeIdentityService_ListUnlinked_0   = runtime.ForwardResponseMessage
 )
 
-// RegisterDb_ChikuMasterServiceHandlerFromEndpoint is same as RegisterDb_ChikuMasterServiceHandler but
+// RegisterDb_RegistryServiceHandlerFromEndpoint is same as RegisterDb_RegistryServiceHandler but
 // automatically dials to "endpoint" and closes the connection when "ctx" gets done.
-func RegisterDb_ChikuMasterServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+func RegisterDb_RegistryServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
 	conn, err := grpc.NewClient(endpoint, opts...)
 	if err != nil {
 		return err
@@ -4977,83 +10009,45 @@ func RegisterDb_ChikuMasterServiceHandlerFromEndpoint(ctx context.Context, mux *
 			}
 		}()
 	}()
-	return RegisterDb_ChikuMasterServiceHandler(ctx, mux, conn)
+	return RegisterDb_RegistryServiceHandler(ctx, mux, conn)
 }
 
-// RegisterDb_ChikuMasterServiceHandler registers the http handlers for service Db_ChikuMasterService to "mux".
+// RegisterDb_RegistryServiceHandler registers the http handlers for service Db_RegistryService to "mux".
 // The handlers forward requests to the grpc endpoint over "conn".
-func RegisterDb_ChikuMasterServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
-	return RegisterDb_ChikuMasterServiceHandlerClient(ctx, mux, NewDb_ChikuMasterServiceClient(conn))
+func RegisterDb_RegistryServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterDb_RegistryServiceHandlerClient(ctx, mux, NewDb_RegistryServiceClient(conn))
 }
 
-// RegisterDb_ChikuMasterServiceHandlerClient registers the http handlers for service Db_ChikuMasterService
-// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_ChikuMasterServiceClient".
-// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_ChikuMasterServiceClient"
+// RegisterDb_RegistryServiceHandlerClient registers the http handlers for service Db_RegistryService
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "Db_RegistryServiceClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "Db_RegistryServiceClient"
 // doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
-// "Db_ChikuMasterServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
-func RegisterDb_ChikuMasterServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_ChikuMasterServiceClient) error {
-	mux.Handle(http.MethodGet, pattern_Db_ChikuMasterService_Get_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
-		ctx, cancel := context.WithCancel(req.Context())
-		defer cancel()
-		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ChikuMasterService/Get", runtime.WithHTTPPathPattern("/api/v1/db/chiku-master/{chiku_c}"))
-		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
-			return
-		}
-		resp, md, err := request_Db_ChikuMasterService_Get_0(annotatedContext, inboundMarshaler, client, req, pathParams)
-		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
-		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
-			return
-		}
-		forward_Db_ChikuMasterService_Get_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
-	mux.Handle(http.MethodGet, pattern_Db_ChikuMasterService_List_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
-		ctx, cancel := context.WithCancel(req.Context())
-		defer cancel()
-		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ChikuMasterService/List", runtime.WithHTTPPathPattern("/api/v1/db/chiku-master"))
-		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
-			return
-		}
-		resp, md, err := request_Db_ChikuMasterService_List_0(annotatedContext, inboundMarshaler, client, req, pathParams)
-		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
-		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
-			return
-		}
-		forward_Db_ChikuMasterService_List_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
-	mux.Handle(http.MethodGet, pattern_Db_ChikuMasterService_GetByChiikiC_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+// "Db_RegistryServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
+func RegisterDb_RegistryServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Db_RegistryServiceClient) error {
+	mux.Handle(http.MethodGet, pattern_Db_RegistryService_GetAvailability_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_ChikuMasterService/GetByChiikiC", runtime.WithHTTPPathPattern("/api/v1/db/chiku-master/chiiki/{chiiki_c}"))
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/db_service.Db_RegistryService/GetAvailability", runtime.WithHTTPPathPattern("/api/v1/db/registry/availability"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_Db_ChikuMasterService_GetByChiikiC_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_Db_RegistryService_GetAvailability_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Db_ChikuMasterService_GetByChiikiC_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_Db_RegistryService_GetAvailability_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
 	return nil
 }
 
 var (
-	pattern_Db_ChikuMasterService_Get_0          = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "db", "chiku-master", "chiku_c"}, ""))
-	pattern_Db_ChikuMasterService_List_0         = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "db", "chiku-master"}, ""))
-	pattern_Db_ChikuMasterService_GetByChiikiC_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 1, 0, 4, 1, 5, 5}, []string{"api", "v1", "db", "chiku-master", "chiiki", "chiiki_c"}, ""))
+	pattern_Db_RegistryService_GetAvailability_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4}, []string{"api", "v1", "db", "registry", "availability"}, ""))
 )
 
 var (
-	forward_Db_ChikuMasterService_Get_0          = runtime.ForwardResponseMessage
-	forward_Db_ChikuMasterService_List_0         = runtime.ForwardResponseMessage
-	forward_Db_ChikuMasterService_GetByChiikiC_0 = runtime.ForwardResponseMessage
+	forward_Db_RegistryService_GetAvailability_0 = runtime.ForwardResponseMessage
 )
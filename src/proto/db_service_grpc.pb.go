@@ -285,11 +285,15 @@ var Db_DTakoUriageKeihiService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	Db_ETCMeisaiService_Create_FullMethodName = "/db_service.db_ETCMeisaiService/Create"
-	Db_ETCMeisaiService_Get_FullMethodName    = "/db_service.db_ETCMeisaiService/Get"
-	Db_ETCMeisaiService_Update_FullMethodName = "/db_service.db_ETCMeisaiService/Update"
-	Db_ETCMeisaiService_Delete_FullMethodName = "/db_service.db_ETCMeisaiService/Delete"
-	Db_ETCMeisaiService_List_FullMethodName   = "/db_service.db_ETCMeisaiService/List"
+	Db_ETCMeisaiService_Create_FullMethodName            = "/db_service.db_ETCMeisaiService/Create"
+	Db_ETCMeisaiService_Get_FullMethodName               = "/db_service.db_ETCMeisaiService/Get"
+	Db_ETCMeisaiService_Update_FullMethodName            = "/db_service.db_ETCMeisaiService/Update"
+	Db_ETCMeisaiService_Delete_FullMethodName            = "/db_service.db_ETCMeisaiService/Delete"
+	Db_ETCMeisaiService_List_FullMethodName              = "/db_service.db_ETCMeisaiService/List"
+	Db_ETCMeisaiService_Stream_FullMethodName            = "/db_service.db_ETCMeisaiService/Stream"
+	Db_ETCMeisaiService_BatchCreate_FullMethodName       = "/db_service.db_ETCMeisaiService/BatchCreate"
+	Db_ETCMeisaiService_BatchCreateStream_FullMethodName = "/db_service.db_ETCMeisaiService/BatchCreateStream"
+	Db_ETCMeisaiService_Import_FullMethodName            = "/db_service.db_ETCMeisaiService/Import"
 )
 
 // Db_ETCMeisaiServiceClient is the client API for Db_ETCMeisaiService service.
@@ -308,6 +312,14 @@ type Db_ETCMeisaiServiceClient interface {
 	Delete(ctx context.Context, in *Db_DeleteETCMeisaiRequest, opts ...grpc.CallOption) (*Db_Empty, error)
 	// ETC明細データ一覧取得
 	List(ctx context.Context, in *Db_ListETCMeisaiRequest, opts ...grpc.CallOption) (*Db_ListETCMeisaiResponse, error)
+	// ETC明細データをストリーミングで取得（期間指定の一括取得用）
+	Stream(ctx context.Context, in *Db_StreamETCMeisaiRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Db_ETCMeisai], error)
+	// ETC明細データ一括作成（hashが登録済みの明細はスキップ、1トランザクションで実行）
+	BatchCreate(ctx context.Context, in *Db_BatchCreateETCMeisaiRequest, opts ...grpc.CallOption) (*Db_BatchCreateETCMeisaiResponse, error)
+	// ETC明細データ一括作成（クライアントストリーミング、全件受信後に1トランザクションで実行）
+	BatchCreateStream(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[Db_BatchCreateETCMeisaiRequest, Db_BatchCreateETCMeisaiResponse], error)
+	// ETC利用照会サービスの明細CSV（Shift_JIS/UTF-8）を取り込み（BatchCreateと同じ重複判定）
+	Import(ctx context.Context, in *Db_ImportETCMeisaiRequest, opts ...grpc.CallOption) (*Db_ImportETCMeisaiResponse, error)
 }
 
 type db_ETCMeisaiServiceClient struct {
@@ -368,6 +380,58 @@ func (c *db_ETCMeisaiServiceClient) List(ctx context.Context, in *Db_ListETCMeis
 	return out, nil
 }
 
+func (c *db_ETCMeisaiServiceClient) Stream(ctx context.Context, in *Db_StreamETCMeisaiRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Db_ETCMeisai], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Db_ETCMeisaiService_ServiceDesc.Streams[0], Db_ETCMeisaiService_Stream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[Db_StreamETCMeisaiRequest, Db_ETCMeisai]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Db_ETCMeisaiService_StreamClient = grpc.ServerStreamingClient[Db_ETCMeisai]
+
+func (c *db_ETCMeisaiServiceClient) BatchCreate(ctx context.Context, in *Db_BatchCreateETCMeisaiRequest, opts ...grpc.CallOption) (*Db_BatchCreateETCMeisaiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Db_BatchCreateETCMeisaiResponse)
+	err := c.cc.Invoke(ctx, Db_ETCMeisaiService_BatchCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *db_ETCMeisaiServiceClient) BatchCreateStream(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[Db_BatchCreateETCMeisaiRequest, Db_BatchCreateETCMeisaiResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Db_ETCMeisaiService_ServiceDesc.Streams[1], Db_ETCMeisaiService_BatchCreateStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[Db_BatchCreateETCMeisaiRequest, Db_BatchCreateETCMeisaiResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Db_ETCMeisaiService_BatchCreateStreamClient = grpc.ClientStreamingClient[Db_BatchCreateETCMeisaiRequest, Db_BatchCreateETCMeisaiResponse]
+
+func (c *db_ETCMeisaiServiceClient) Import(ctx context.Context, in *Db_ImportETCMeisaiRequest, opts ...grpc.CallOption) (*Db_ImportETCMeisaiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Db_ImportETCMeisaiResponse)
+	err := c.cc.Invoke(ctx, Db_ETCMeisaiService_Import_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Db_ETCMeisaiServiceServer is the server API for Db_ETCMeisaiService service.
 // All implementations should embed UnimplementedDb_ETCMeisaiServiceServer
 // for forward compatibility.
@@ -384,6 +448,14 @@ type Db_ETCMeisaiServiceServer interface {
 	Delete(context.Context, *Db_DeleteETCMeisaiRequest) (*Db_Empty, error)
 	// ETC明細データ一覧取得
 	List(context.Context, *Db_ListETCMeisaiRequest) (*Db_ListETCMeisaiResponse, error)
+	// ETC明細データをストリーミングで取得（期間指定の一括取得用）
+	Stream(*Db_StreamETCMeisaiRequest, grpc.ServerStreamingServer[Db_ETCMeisai]) error
+	// ETC明細データ一括作成（hashが登録済みの明細はスキップ、1トランザクションで実行）
+	BatchCreate(context.Context, *Db_BatchCreateETCMeisaiRequest) (*Db_BatchCreateETCMeisaiResponse, error)
+	// ETC明細データ一括作成（クライアントストリーミング、全件受信後に1トランザクションで実行）
+	BatchCreateStream(grpc.ClientStreamingServer[Db_BatchCreateETCMeisaiRequest, Db_BatchCreateETCMeisaiResponse]) error
+	// ETC利用照会サービスの明細CSV（Shift_JIS/UTF-8）を取り込み（BatchCreateと同じ重複判定）
+	Import(context.Context, *Db_ImportETCMeisaiRequest) (*Db_ImportETCMeisaiResponse, error)
 }
 
 // UnimplementedDb_ETCMeisaiServiceServer should be embedded to have
@@ -408,6 +480,18 @@ func (UnimplementedDb_ETCMeisaiServiceServer) Delete(context.Context, *Db_Delete
 func (UnimplementedDb_ETCMeisaiServiceServer) List(context.Context, *Db_ListETCMeisaiRequest) (*Db_ListETCMeisaiResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
+func (UnimplementedDb_ETCMeisaiServiceServer) Stream(*Db_StreamETCMeisaiRequest, grpc.ServerStreamingServer[Db_ETCMeisai]) error {
+	return status.Errorf(codes.Unimplemented, "method Stream not implemented")
+}
+func (UnimplementedDb_ETCMeisaiServiceServer) BatchCreate(context.Context, *Db_BatchCreateETCMeisaiRequest) (*Db_BatchCreateETCMeisaiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (UnimplementedDb_ETCMeisaiServiceServer) BatchCreateStream(grpc.ClientStreamingServer[Db_BatchCreateETCMeisaiRequest, Db_BatchCreateETCMeisaiResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method BatchCreateStream not implemented")
+}
+func (UnimplementedDb_ETCMeisaiServiceServer) Import(context.Context, *Db_ImportETCMeisaiRequest) (*Db_ImportETCMeisaiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Import not implemented")
+}
 func (UnimplementedDb_ETCMeisaiServiceServer) testEmbeddedByValue() {}
 
 // UnsafeDb_ETCMeisaiServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -518,6 +602,60 @@ func _Db_ETCMeisaiService_List_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Db_ETCMeisaiService_Stream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Db_StreamETCMeisaiRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(Db_ETCMeisaiServiceServer).Stream(m, &grpc.GenericServerStream[Db_StreamETCMeisaiRequest, Db_ETCMeisai]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Db_ETCMeisaiService_StreamServer = grpc.ServerStreamingServer[Db_ETCMeisai]
+
+func _Db_ETCMeisaiService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_BatchCreateETCMeisaiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Db_ETCMeisaiServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Db_ETCMeisaiService_BatchCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Db_ETCMeisaiServiceServer).BatchCreate(ctx, req.(*Db_BatchCreateETCMeisaiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Db_ETCMeisaiService_BatchCreateStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(Db_ETCMeisaiServiceServer).BatchCreateStream(&grpc.GenericServerStream[Db_BatchCreateETCMeisaiRequest, Db_BatchCreateETCMeisaiResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Db_ETCMeisaiService_BatchCreateStreamServer = grpc.ClientStreamingServer[Db_BatchCreateETCMeisaiRequest, Db_BatchCreateETCMeisaiResponse]
+
+func _Db_ETCMeisaiService_Import_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_ImportETCMeisaiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Db_ETCMeisaiServiceServer).Import(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Db_ETCMeisaiService_Import_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Db_ETCMeisaiServiceServer).Import(ctx, req.(*Db_ImportETCMeisaiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Db_ETCMeisaiService_ServiceDesc is the grpc.ServiceDesc for Db_ETCMeisaiService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -545,8 +683,27 @@ var Db_ETCMeisaiService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _Db_ETCMeisaiService_List_Handler,
 		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _Db_ETCMeisaiService_BatchCreate_Handler,
+		},
+		{
+			MethodName: "Import",
+			Handler:    _Db_ETCMeisaiService_Import_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Stream",
+			Handler:       _Db_ETCMeisaiService_Stream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "BatchCreateStream",
+			Handler:       _Db_ETCMeisaiService_BatchCreateStream_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "db_service.proto",
 }
 
@@ -823,6 +980,7 @@ const (
 	Db_ETCMeisaiMappingService_Delete_FullMethodName              = "/db_service.db_ETCMeisaiMappingService/Delete"
 	Db_ETCMeisaiMappingService_List_FullMethodName                = "/db_service.db_ETCMeisaiMappingService/List"
 	Db_ETCMeisaiMappingService_GetDTakoRowIDByHash_FullMethodName = "/db_service.db_ETCMeisaiMappingService/GetDTakoRowIDByHash"
+	Db_ETCMeisaiMappingService_BulkReplace_FullMethodName         = "/db_service.db_ETCMeisaiMappingService/BulkReplace"
 )
 
 // Db_ETCMeisaiMappingServiceClient is the client API for Db_ETCMeisaiMappingService service.
@@ -831,7 +989,7 @@ const (
 //
 // ETCMeisaiMappingサービス - ETC明細とDTakoRowsのマッピング管理
 type Db_ETCMeisaiMappingServiceClient interface {
-	// マッピング作成
+	// マッピング作成（同じetc_meisai_hash・dtako_row_idのマッピングがある場合はALREADY_EXISTS、upsert指定時は登録済みのマッピングを返す）
 	Create(ctx context.Context, in *Db_CreateETCMeisaiMappingRequest, opts ...grpc.CallOption) (*Db_ETCMeisaiMappingResponse, error)
 	// マッピング取得
 	Get(ctx context.Context, in *Db_GetETCMeisaiMappingRequest, opts ...grpc.CallOption) (*Db_ETCMeisaiMappingResponse, error)
@@ -843,6 +1001,8 @@ type Db_ETCMeisaiMappingServiceClient interface {
 	List(ctx context.Context, in *Db_ListETCMeisaiMappingRequest, opts ...grpc.CallOption) (*Db_ListETCMeisaiMappingResponse, error)
 	// ハッシュからDTakoRowIDを取得
 	GetDTakoRowIDByHash(ctx context.Context, in *Db_GetDTakoRowIDByHashRequest, opts ...grpc.CallOption) (*Db_GetDTakoRowIDByHashResponse, error)
+	// dtako_row_idのマッピングを1トランザクションで置き換え
+	BulkReplace(ctx context.Context, in *Db_BulkReplaceETCMeisaiMappingRequest, opts ...grpc.CallOption) (*Db_BulkReplaceETCMeisaiMappingResponse, error)
 }
 
 type db_ETCMeisaiMappingServiceClient struct {
@@ -913,13 +1073,23 @@ func (c *db_ETCMeisaiMappingServiceClient) GetDTakoRowIDByHash(ctx context.Conte
 	return out, nil
 }
 
+func (c *db_ETCMeisaiMappingServiceClient) BulkReplace(ctx context.Context, in *Db_BulkReplaceETCMeisaiMappingRequest, opts ...grpc.CallOption) (*Db_BulkReplaceETCMeisaiMappingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Db_BulkReplaceETCMeisaiMappingResponse)
+	err := c.cc.Invoke(ctx, Db_ETCMeisaiMappingService_BulkReplace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Db_ETCMeisaiMappingServiceServer is the server API for Db_ETCMeisaiMappingService service.
 // All implementations should embed UnimplementedDb_ETCMeisaiMappingServiceServer
 // for forward compatibility.
 //
 // ETCMeisaiMappingサービス - ETC明細とDTakoRowsのマッピング管理
 type Db_ETCMeisaiMappingServiceServer interface {
-	// マッピング作成
+	// マッピング作成（同じetc_meisai_hash・dtako_row_idのマッピングがある場合はALREADY_EXISTS、upsert指定時は登録済みのマッピングを返す）
 	Create(context.Context, *Db_CreateETCMeisaiMappingRequest) (*Db_ETCMeisaiMappingResponse, error)
 	// マッピング取得
 	Get(context.Context, *Db_GetETCMeisaiMappingRequest) (*Db_ETCMeisaiMappingResponse, error)
@@ -931,6 +1101,8 @@ type Db_ETCMeisaiMappingServiceServer interface {
 	List(context.Context, *Db_ListETCMeisaiMappingRequest) (*Db_ListETCMeisaiMappingResponse, error)
 	// ハッシュからDTakoRowIDを取得
 	GetDTakoRowIDByHash(context.Context, *Db_GetDTakoRowIDByHashRequest) (*Db_GetDTakoRowIDByHashResponse, error)
+	// dtako_row_idのマッピングを1トランザクションで置き換え
+	BulkReplace(context.Context, *Db_BulkReplaceETCMeisaiMappingRequest) (*Db_BulkReplaceETCMeisaiMappingResponse, error)
 }
 
 // UnimplementedDb_ETCMeisaiMappingServiceServer should be embedded to have
@@ -958,6 +1130,9 @@ func (UnimplementedDb_ETCMeisaiMappingServiceServer) List(context.Context, *Db_L
 func (UnimplementedDb_ETCMeisaiMappingServiceServer) GetDTakoRowIDByHash(context.Context, *Db_GetDTakoRowIDByHashRequest) (*Db_GetDTakoRowIDByHashResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDTakoRowIDByHash not implemented")
 }
+func (UnimplementedDb_ETCMeisaiMappingServiceServer) BulkReplace(context.Context, *Db_BulkReplaceETCMeisaiMappingRequest) (*Db_BulkReplaceETCMeisaiMappingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkReplace not implemented")
+}
 func (UnimplementedDb_ETCMeisaiMappingServiceServer) testEmbeddedByValue() {}
 
 // UnsafeDb_ETCMeisaiMappingServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1086,6 +1261,24 @@ func _Db_ETCMeisaiMappingService_GetDTakoRowIDByHash_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Db_ETCMeisaiMappingService_BulkReplace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_BulkReplaceETCMeisaiMappingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Db_ETCMeisaiMappingServiceServer).BulkReplace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Db_ETCMeisaiMappingService_BulkReplace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Db_ETCMeisaiMappingServiceServer).BulkReplace(ctx, req.(*Db_BulkReplaceETCMeisaiMappingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Db_ETCMeisaiMappingService_ServiceDesc is the grpc.ServiceDesc for Db_ETCMeisaiMappingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1117,6 +1310,222 @@ var Db_ETCMeisaiMappingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDTakoRowIDByHash",
 			Handler:    _Db_ETCMeisaiMappingService_GetDTakoRowIDByHash_Handler,
 		},
+		{
+			MethodName: "BulkReplace",
+			Handler:    _Db_ETCMeisaiMappingService_BulkReplace_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "db_service.proto",
+}
+
+const (
+	Db_ETCMeisaiMatcherService_AutoMatch_FullMethodName = "/db_service.db_ETCMeisaiMatcherService/AutoMatch"
+)
+
+// Db_ETCMeisaiMatcherServiceClient is the client API for Db_ETCMeisaiMatcherService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ETCMeisaiMatcherサービス - ETC明細とDTakoRowsの自動照合（ローカルDB・本番DB）
+type Db_ETCMeisaiMatcherServiceClient interface {
+	// 期間内のETC明細を運行データと照合し、候補が1件に決まった明細のマッピングを作成（created_by="auto-matcher"）
+	AutoMatch(ctx context.Context, in *Db_AutoMatchETCMeisaiRequest, opts ...grpc.CallOption) (*Db_AutoMatchETCMeisaiResponse, error)
+}
+
+type db_ETCMeisaiMatcherServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDb_ETCMeisaiMatcherServiceClient(cc grpc.ClientConnInterface) Db_ETCMeisaiMatcherServiceClient {
+	return &db_ETCMeisaiMatcherServiceClient{cc}
+}
+
+func (c *db_ETCMeisaiMatcherServiceClient) AutoMatch(ctx context.Context, in *Db_AutoMatchETCMeisaiRequest, opts ...grpc.CallOption) (*Db_AutoMatchETCMeisaiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Db_AutoMatchETCMeisaiResponse)
+	err := c.cc.Invoke(ctx, Db_ETCMeisaiMatcherService_AutoMatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Db_ETCMeisaiMatcherServiceServer is the server API for Db_ETCMeisaiMatcherService service.
+// All implementations should embed UnimplementedDb_ETCMeisaiMatcherServiceServer
+// for forward compatibility.
+//
+// ETCMeisaiMatcherサービス - ETC明細とDTakoRowsの自動照合（ローカルDB・本番DB）
+type Db_ETCMeisaiMatcherServiceServer interface {
+	// 期間内のETC明細を運行データと照合し、候補が1件に決まった明細のマッピングを作成（created_by="auto-matcher"）
+	AutoMatch(context.Context, *Db_AutoMatchETCMeisaiRequest) (*Db_AutoMatchETCMeisaiResponse, error)
+}
+
+// UnimplementedDb_ETCMeisaiMatcherServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDb_ETCMeisaiMatcherServiceServer struct{}
+
+func (UnimplementedDb_ETCMeisaiMatcherServiceServer) AutoMatch(context.Context, *Db_AutoMatchETCMeisaiRequest) (*Db_AutoMatchETCMeisaiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoMatch not implemented")
+}
+func (UnimplementedDb_ETCMeisaiMatcherServiceServer) testEmbeddedByValue() {}
+
+// UnsafeDb_ETCMeisaiMatcherServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to Db_ETCMeisaiMatcherServiceServer will
+// result in compilation errors.
+type UnsafeDb_ETCMeisaiMatcherServiceServer interface {
+	mustEmbedUnimplementedDb_ETCMeisaiMatcherServiceServer()
+}
+
+func RegisterDb_ETCMeisaiMatcherServiceServer(s grpc.ServiceRegistrar, srv Db_ETCMeisaiMatcherServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDb_ETCMeisaiMatcherServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Db_ETCMeisaiMatcherService_ServiceDesc, srv)
+}
+
+func _Db_ETCMeisaiMatcherService_AutoMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_AutoMatchETCMeisaiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Db_ETCMeisaiMatcherServiceServer).AutoMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Db_ETCMeisaiMatcherService_AutoMatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Db_ETCMeisaiMatcherServiceServer).AutoMatch(ctx, req.(*Db_AutoMatchETCMeisaiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Db_ETCMeisaiMatcherService_ServiceDesc is the grpc.ServiceDesc for Db_ETCMeisaiMatcherService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Db_ETCMeisaiMatcherService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "db_service.db_ETCMeisaiMatcherService",
+	HandlerType: (*Db_ETCMeisaiMatcherServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AutoMatch",
+			Handler:    _Db_ETCMeisaiMatcherService_AutoMatch_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "db_service.proto",
+}
+
+const (
+	Db_ETCMeisaiMappingAuditService_Audit_FullMethodName = "/db_service.db_ETCMeisaiMappingAuditService/Audit"
+)
+
+// Db_ETCMeisaiMappingAuditServiceClient is the client API for Db_ETCMeisaiMappingAuditService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ETCMeisaiMappingAuditサービス - ETC明細マッピングの整合性チェック（ローカルDB・本番DB）
+type Db_ETCMeisaiMappingAuditServiceClient interface {
+	// マッピングをetc_meisai・本番DBのdtako_rowsと突き合わせて問題を報告
+	Audit(ctx context.Context, in *Db_AuditETCMeisaiMappingRequest, opts ...grpc.CallOption) (*Db_AuditETCMeisaiMappingResponse, error)
+}
+
+type db_ETCMeisaiMappingAuditServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDb_ETCMeisaiMappingAuditServiceClient(cc grpc.ClientConnInterface) Db_ETCMeisaiMappingAuditServiceClient {
+	return &db_ETCMeisaiMappingAuditServiceClient{cc}
+}
+
+func (c *db_ETCMeisaiMappingAuditServiceClient) Audit(ctx context.Context, in *Db_AuditETCMeisaiMappingRequest, opts ...grpc.CallOption) (*Db_AuditETCMeisaiMappingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Db_AuditETCMeisaiMappingResponse)
+	err := c.cc.Invoke(ctx, Db_ETCMeisaiMappingAuditService_Audit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Db_ETCMeisaiMappingAuditServiceServer is the server API for Db_ETCMeisaiMappingAuditService service.
+// All implementations should embed UnimplementedDb_ETCMeisaiMappingAuditServiceServer
+// for forward compatibility.
+//
+// ETCMeisaiMappingAuditサービス - ETC明細マッピングの整合性チェック（ローカルDB・本番DB）
+type Db_ETCMeisaiMappingAuditServiceServer interface {
+	// マッピングをetc_meisai・本番DBのdtako_rowsと突き合わせて問題を報告
+	Audit(context.Context, *Db_AuditETCMeisaiMappingRequest) (*Db_AuditETCMeisaiMappingResponse, error)
+}
+
+// UnimplementedDb_ETCMeisaiMappingAuditServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDb_ETCMeisaiMappingAuditServiceServer struct{}
+
+func (UnimplementedDb_ETCMeisaiMappingAuditServiceServer) Audit(context.Context, *Db_AuditETCMeisaiMappingRequest) (*Db_AuditETCMeisaiMappingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Audit not implemented")
+}
+func (UnimplementedDb_ETCMeisaiMappingAuditServiceServer) testEmbeddedByValue() {}
+
+// UnsafeDb_ETCMeisaiMappingAuditServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to Db_ETCMeisaiMappingAuditServiceServer will
+// result in compilation errors.
+type UnsafeDb_ETCMeisaiMappingAuditServiceServer interface {
+	mustEmbedUnimplementedDb_ETCMeisaiMappingAuditServiceServer()
+}
+
+func RegisterDb_ETCMeisaiMappingAuditServiceServer(s grpc.ServiceRegistrar, srv Db_ETCMeisaiMappingAuditServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDb_ETCMeisaiMappingAuditServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Db_ETCMeisaiMappingAuditService_ServiceDesc, srv)
+}
+
+func _Db_ETCMeisaiMappingAuditService_Audit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_AuditETCMeisaiMappingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Db_ETCMeisaiMappingAuditServiceServer).Audit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Db_ETCMeisaiMappingAuditService_Audit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Db_ETCMeisaiMappingAuditServiceServer).Audit(ctx, req.(*Db_AuditETCMeisaiMappingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Db_ETCMeisaiMappingAuditService_ServiceDesc is the grpc.ServiceDesc for Db_ETCMeisaiMappingAuditService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Db_ETCMeisaiMappingAuditService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "db_service.db_ETCMeisaiMappingAuditService",
+	HandlerType: (*Db_ETCMeisaiMappingAuditServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Audit",
+			Handler:    _Db_ETCMeisaiMappingAuditService_Audit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "db_service.proto",
@@ -1311,6 +1720,7 @@ var Db_DTakoCarsService_ServiceDesc = grpc.ServiceDesc{
 const (
 	Db_DTakoEventsService_Get_FullMethodName              = "/db_service.db_DTakoEventsService/Get"
 	Db_DTakoEventsService_List_FullMethodName             = "/db_service.db_DTakoEventsService/List"
+	Db_DTakoEventsService_Stream_FullMethodName           = "/db_service.db_DTakoEventsService/Stream"
 	Db_DTakoEventsService_GetByOperationNo_FullMethodName = "/db_service.db_DTakoEventsService/GetByOperationNo"
 )
 
@@ -1324,6 +1734,8 @@ type Db_DTakoEventsServiceClient interface {
 	Get(ctx context.Context, in *Db_GetDTakoEventsRequest, opts ...grpc.CallOption) (*Db_DTakoEventsResponse, error)
 	// イベント情報一覧取得
 	List(ctx context.Context, in *Db_ListDTakoEventsRequest, opts ...grpc.CallOption) (*Db_ListDTakoEventsResponse, error)
+	// イベント情報をストリーミングで取得（期間指定の一括取得用）
+	Stream(ctx context.Context, in *Db_StreamDTakoEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Db_DTakoEvents], error)
 	// 運行NOでイベント情報取得
 	GetByOperationNo(ctx context.Context, in *Db_GetDTakoEventsByOperationNoRequest, opts ...grpc.CallOption) (*Db_ListDTakoEventsResponse, error)
 }
@@ -1356,6 +1768,25 @@ func (c *db_DTakoEventsServiceClient) List(ctx context.Context, in *Db_ListDTako
 	return out, nil
 }
 
+func (c *db_DTakoEventsServiceClient) Stream(ctx context.Context, in *Db_StreamDTakoEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Db_DTakoEvents], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Db_DTakoEventsService_ServiceDesc.Streams[0], Db_DTakoEventsService_Stream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[Db_StreamDTakoEventsRequest, Db_DTakoEvents]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Db_DTakoEventsService_StreamClient = grpc.ServerStreamingClient[Db_DTakoEvents]
+
 func (c *db_DTakoEventsServiceClient) GetByOperationNo(ctx context.Context, in *Db_GetDTakoEventsByOperationNoRequest, opts ...grpc.CallOption) (*Db_ListDTakoEventsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Db_ListDTakoEventsResponse)
@@ -1376,6 +1807,8 @@ type Db_DTakoEventsServiceServer interface {
 	Get(context.Context, *Db_GetDTakoEventsRequest) (*Db_DTakoEventsResponse, error)
 	// イベント情報一覧取得
 	List(context.Context, *Db_ListDTakoEventsRequest) (*Db_ListDTakoEventsResponse, error)
+	// イベント情報をストリーミングで取得（期間指定の一括取得用）
+	Stream(*Db_StreamDTakoEventsRequest, grpc.ServerStreamingServer[Db_DTakoEvents]) error
 	// 運行NOでイベント情報取得
 	GetByOperationNo(context.Context, *Db_GetDTakoEventsByOperationNoRequest) (*Db_ListDTakoEventsResponse, error)
 }
@@ -1393,6 +1826,9 @@ func (UnimplementedDb_DTakoEventsServiceServer) Get(context.Context, *Db_GetDTak
 func (UnimplementedDb_DTakoEventsServiceServer) List(context.Context, *Db_ListDTakoEventsRequest) (*Db_ListDTakoEventsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
+func (UnimplementedDb_DTakoEventsServiceServer) Stream(*Db_StreamDTakoEventsRequest, grpc.ServerStreamingServer[Db_DTakoEvents]) error {
+	return status.Errorf(codes.Unimplemented, "method Stream not implemented")
+}
 func (UnimplementedDb_DTakoEventsServiceServer) GetByOperationNo(context.Context, *Db_GetDTakoEventsByOperationNoRequest) (*Db_ListDTakoEventsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetByOperationNo not implemented")
 }
@@ -1452,6 +1888,17 @@ func _Db_DTakoEventsService_List_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Db_DTakoEventsService_Stream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Db_StreamDTakoEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(Db_DTakoEventsServiceServer).Stream(m, &grpc.GenericServerStream[Db_StreamDTakoEventsRequest, Db_DTakoEvents]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Db_DTakoEventsService_StreamServer = grpc.ServerStreamingServer[Db_DTakoEvents]
+
 func _Db_DTakoEventsService_GetByOperationNo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Db_GetDTakoEventsByOperationNoRequest)
 	if err := dec(in); err != nil {
@@ -1490,13 +1937,20 @@ var Db_DTakoEventsService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Db_DTakoEventsService_GetByOperationNo_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Stream",
+			Handler:       _Db_DTakoEventsService_Stream_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "db_service.proto",
 }
 
 const (
 	Db_DTakoRowsService_Get_FullMethodName              = "/db_service.db_DTakoRowsService/Get"
 	Db_DTakoRowsService_List_FullMethodName             = "/db_service.db_DTakoRowsService/List"
+	Db_DTakoRowsService_Stream_FullMethodName           = "/db_service.db_DTakoRowsService/Stream"
 	Db_DTakoRowsService_GetByOperationNo_FullMethodName = "/db_service.db_DTakoRowsService/GetByOperationNo"
 )
 
@@ -1510,6 +1964,8 @@ type Db_DTakoRowsServiceClient interface {
 	Get(ctx context.Context, in *Db_GetDTakoRowsRequest, opts ...grpc.CallOption) (*Db_DTakoRowsResponse, error)
 	// 運行データ一覧取得
 	List(ctx context.Context, in *Db_ListDTakoRowsRequest, opts ...grpc.CallOption) (*Db_ListDTakoRowsResponse, error)
+	// 運行データをストリーミングで取得（期間指定の一括取得用）
+	Stream(ctx context.Context, in *Db_StreamDTakoRowsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Db_DTakoRows], error)
 	// 運行NOで運行データ取得
 	GetByOperationNo(ctx context.Context, in *Db_GetDTakoRowsByOperationNoRequest, opts ...grpc.CallOption) (*Db_ListDTakoRowsResponse, error)
 }
@@ -1542,14 +1998,33 @@ func (c *db_DTakoRowsServiceClient) List(ctx context.Context, in *Db_ListDTakoRo
 	return out, nil
 }
 
-func (c *db_DTakoRowsServiceClient) GetByOperationNo(ctx context.Context, in *Db_GetDTakoRowsByOperationNoRequest, opts ...grpc.CallOption) (*Db_ListDTakoRowsResponse, error) {
+func (c *db_DTakoRowsServiceClient) Stream(ctx context.Context, in *Db_StreamDTakoRowsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Db_DTakoRows], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Db_ListDTakoRowsResponse)
-	err := c.cc.Invoke(ctx, Db_DTakoRowsService_GetByOperationNo_FullMethodName, in, out, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &Db_DTakoRowsService_ServiceDesc.Streams[0], Db_DTakoRowsService_Stream_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &grpc.GenericClientStream[Db_StreamDTakoRowsRequest, Db_DTakoRows]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Db_DTakoRowsService_StreamClient = grpc.ServerStreamingClient[Db_DTakoRows]
+
+func (c *db_DTakoRowsServiceClient) GetByOperationNo(ctx context.Context, in *Db_GetDTakoRowsByOperationNoRequest, opts ...grpc.CallOption) (*Db_ListDTakoRowsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Db_ListDTakoRowsResponse)
+	err := c.cc.Invoke(ctx, Db_DTakoRowsService_GetByOperationNo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
 // Db_DTakoRowsServiceServer is the server API for Db_DTakoRowsService service.
@@ -1562,6 +2037,8 @@ type Db_DTakoRowsServiceServer interface {
 	Get(context.Context, *Db_GetDTakoRowsRequest) (*Db_DTakoRowsResponse, error)
 	// 運行データ一覧取得
 	List(context.Context, *Db_ListDTakoRowsRequest) (*Db_ListDTakoRowsResponse, error)
+	// 運行データをストリーミングで取得（期間指定の一括取得用）
+	Stream(*Db_StreamDTakoRowsRequest, grpc.ServerStreamingServer[Db_DTakoRows]) error
 	// 運行NOで運行データ取得
 	GetByOperationNo(context.Context, *Db_GetDTakoRowsByOperationNoRequest) (*Db_ListDTakoRowsResponse, error)
 }
@@ -1579,6 +2056,9 @@ func (UnimplementedDb_DTakoRowsServiceServer) Get(context.Context, *Db_GetDTakoR
 func (UnimplementedDb_DTakoRowsServiceServer) List(context.Context, *Db_ListDTakoRowsRequest) (*Db_ListDTakoRowsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
+func (UnimplementedDb_DTakoRowsServiceServer) Stream(*Db_StreamDTakoRowsRequest, grpc.ServerStreamingServer[Db_DTakoRows]) error {
+	return status.Errorf(codes.Unimplemented, "method Stream not implemented")
+}
 func (UnimplementedDb_DTakoRowsServiceServer) GetByOperationNo(context.Context, *Db_GetDTakoRowsByOperationNoRequest) (*Db_ListDTakoRowsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetByOperationNo not implemented")
 }
@@ -1638,6 +2118,17 @@ func _Db_DTakoRowsService_List_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Db_DTakoRowsService_Stream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Db_StreamDTakoRowsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(Db_DTakoRowsServiceServer).Stream(m, &grpc.GenericServerStream[Db_StreamDTakoRowsRequest, Db_DTakoRows]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Db_DTakoRowsService_StreamServer = grpc.ServerStreamingServer[Db_DTakoRows]
+
 func _Db_DTakoRowsService_GetByOperationNo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Db_GetDTakoRowsByOperationNoRequest)
 	if err := dec(in); err != nil {
@@ -1676,14 +2167,23 @@ var Db_DTakoRowsService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Db_DTakoRowsService_GetByOperationNo_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Stream",
+			Handler:       _Db_DTakoRowsService_Stream_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "db_service.proto",
 }
 
 const (
-	Db_ETCNumService_List_FullMethodName            = "/db_service.db_ETCNumService/List"
-	Db_ETCNumService_GetByETCCardNum_FullMethodName = "/db_service.db_ETCNumService/GetByETCCardNum"
-	Db_ETCNumService_GetByCarID_FullMethodName      = "/db_service.db_ETCNumService/GetByCarID"
+	Db_ETCNumService_List_FullMethodName              = "/db_service.db_ETCNumService/List"
+	Db_ETCNumService_GetByETCCardNum_FullMethodName   = "/db_service.db_ETCNumService/GetByETCCardNum"
+	Db_ETCNumService_GetByCarID_FullMethodName        = "/db_service.db_ETCNumService/GetByCarID"
+	Db_ETCNumService_GetByETCCardNumAt_FullMethodName = "/db_service.db_ETCNumService/GetByETCCardNumAt"
+	Db_ETCNumService_GetByCarIDAt_FullMethodName      = "/db_service.db_ETCNumService/GetByCarIDAt"
+	Db_ETCNumService_ListOverlaps_FullMethodName      = "/db_service.db_ETCNumService/ListOverlaps"
 )
 
 // Db_ETCNumServiceClient is the client API for Db_ETCNumService service.
@@ -1698,6 +2198,12 @@ type Db_ETCNumServiceClient interface {
 	GetByETCCardNum(ctx context.Context, in *Db_GetETCNumByETCCardNumRequest, opts ...grpc.CallOption) (*Db_ListETCNumResponse, error)
 	// 車輌IDで取得
 	GetByCarID(ctx context.Context, in *Db_GetETCNumByCarIDRequest, opts ...grpc.CallOption) (*Db_ListETCNumResponse, error)
+	// 指定日時にETCカードが登録されていた車輌を取得
+	GetByETCCardNumAt(ctx context.Context, in *Db_GetETCNumByETCCardNumAtRequest, opts ...grpc.CallOption) (*Db_ListETCNumResponse, error)
+	// 指定日時に車輌に登録されていたETCカードを取得
+	GetByCarIDAt(ctx context.Context, in *Db_GetETCNumByCarIDAtRequest, opts ...grpc.CallOption) (*Db_ListETCNumResponse, error)
+	// 同じETCカードが有効期間の重なる複数の車輌に登録されているデータを検出
+	ListOverlaps(ctx context.Context, in *Db_ListETCNumOverlapsRequest, opts ...grpc.CallOption) (*Db_ListETCNumOverlapsResponse, error)
 }
 
 type db_ETCNumServiceClient struct {
@@ -1738,6 +2244,36 @@ func (c *db_ETCNumServiceClient) GetByCarID(ctx context.Context, in *Db_GetETCNu
 	return out, nil
 }
 
+func (c *db_ETCNumServiceClient) GetByETCCardNumAt(ctx context.Context, in *Db_GetETCNumByETCCardNumAtRequest, opts ...grpc.CallOption) (*Db_ListETCNumResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Db_ListETCNumResponse)
+	err := c.cc.Invoke(ctx, Db_ETCNumService_GetByETCCardNumAt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *db_ETCNumServiceClient) GetByCarIDAt(ctx context.Context, in *Db_GetETCNumByCarIDAtRequest, opts ...grpc.CallOption) (*Db_ListETCNumResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Db_ListETCNumResponse)
+	err := c.cc.Invoke(ctx, Db_ETCNumService_GetByCarIDAt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *db_ETCNumServiceClient) ListOverlaps(ctx context.Context, in *Db_ListETCNumOverlapsRequest, opts ...grpc.CallOption) (*Db_ListETCNumOverlapsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Db_ListETCNumOverlapsResponse)
+	err := c.cc.Invoke(ctx, Db_ETCNumService_ListOverlaps_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Db_ETCNumServiceServer is the server API for Db_ETCNumService service.
 // All implementations should embed UnimplementedDb_ETCNumServiceServer
 // for forward compatibility.
@@ -1750,6 +2286,12 @@ type Db_ETCNumServiceServer interface {
 	GetByETCCardNum(context.Context, *Db_GetETCNumByETCCardNumRequest) (*Db_ListETCNumResponse, error)
 	// 車輌IDで取得
 	GetByCarID(context.Context, *Db_GetETCNumByCarIDRequest) (*Db_ListETCNumResponse, error)
+	// 指定日時にETCカードが登録されていた車輌を取得
+	GetByETCCardNumAt(context.Context, *Db_GetETCNumByETCCardNumAtRequest) (*Db_ListETCNumResponse, error)
+	// 指定日時に車輌に登録されていたETCカードを取得
+	GetByCarIDAt(context.Context, *Db_GetETCNumByCarIDAtRequest) (*Db_ListETCNumResponse, error)
+	// 同じETCカードが有効期間の重なる複数の車輌に登録されているデータを検出
+	ListOverlaps(context.Context, *Db_ListETCNumOverlapsRequest) (*Db_ListETCNumOverlapsResponse, error)
 }
 
 // UnimplementedDb_ETCNumServiceServer should be embedded to have
@@ -1768,6 +2310,15 @@ func (UnimplementedDb_ETCNumServiceServer) GetByETCCardNum(context.Context, *Db_
 func (UnimplementedDb_ETCNumServiceServer) GetByCarID(context.Context, *Db_GetETCNumByCarIDRequest) (*Db_ListETCNumResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetByCarID not implemented")
 }
+func (UnimplementedDb_ETCNumServiceServer) GetByETCCardNumAt(context.Context, *Db_GetETCNumByETCCardNumAtRequest) (*Db_ListETCNumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByETCCardNumAt not implemented")
+}
+func (UnimplementedDb_ETCNumServiceServer) GetByCarIDAt(context.Context, *Db_GetETCNumByCarIDAtRequest) (*Db_ListETCNumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByCarIDAt not implemented")
+}
+func (UnimplementedDb_ETCNumServiceServer) ListOverlaps(context.Context, *Db_ListETCNumOverlapsRequest) (*Db_ListETCNumOverlapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOverlaps not implemented")
+}
 func (UnimplementedDb_ETCNumServiceServer) testEmbeddedByValue() {}
 
 // UnsafeDb_ETCNumServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1842,6 +2393,60 @@ func _Db_ETCNumService_GetByCarID_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Db_ETCNumService_GetByETCCardNumAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_GetETCNumByETCCardNumAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Db_ETCNumServiceServer).GetByETCCardNumAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Db_ETCNumService_GetByETCCardNumAt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Db_ETCNumServiceServer).GetByETCCardNumAt(ctx, req.(*Db_GetETCNumByETCCardNumAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Db_ETCNumService_GetByCarIDAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_GetETCNumByCarIDAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Db_ETCNumServiceServer).GetByCarIDAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Db_ETCNumService_GetByCarIDAt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Db_ETCNumServiceServer).GetByCarIDAt(ctx, req.(*Db_GetETCNumByCarIDAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Db_ETCNumService_ListOverlaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_ListETCNumOverlapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Db_ETCNumServiceServer).ListOverlaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Db_ETCNumService_ListOverlaps_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Db_ETCNumServiceServer).ListOverlaps(ctx, req.(*Db_ListETCNumOverlapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Db_ETCNumService_ServiceDesc is the grpc.ServiceDesc for Db_ETCNumService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1861,6 +2466,18 @@ var Db_ETCNumService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetByCarID",
 			Handler:    _Db_ETCNumService_GetByCarID_Handler,
 		},
+		{
+			MethodName: "GetByETCCardNumAt",
+			Handler:    _Db_ETCNumService_GetByETCCardNumAt_Handler,
+		},
+		{
+			MethodName: "GetByCarIDAt",
+			Handler:    _Db_ETCNumService_GetByCarIDAt_Handler,
+		},
+		{
+			MethodName: "ListOverlaps",
+			Handler:    _Db_ETCNumService_ListOverlaps_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "db_service.proto",
@@ -2427,8 +3044,10 @@ var Db_DriversService_ServiceDesc = grpc.ServiceDesc{
 const (
 	Db_UntenNippoMeisaiService_Get_FullMethodName            = "/db_service.db_UntenNippoMeisaiService/Get"
 	Db_UntenNippoMeisaiService_List_FullMethodName           = "/db_service.db_UntenNippoMeisaiService/List"
+	Db_UntenNippoMeisaiService_Stream_FullMethodName         = "/db_service.db_UntenNippoMeisaiService/Stream"
 	Db_UntenNippoMeisaiService_GetBySharyoC_FullMethodName   = "/db_service.db_UntenNippoMeisaiService/GetBySharyoC"
 	Db_UntenNippoMeisaiService_GetByDateRange_FullMethodName = "/db_service.db_UntenNippoMeisaiService/GetByDateRange"
+	Db_UntenNippoMeisaiService_GetByBumon_FullMethodName     = "/db_service.db_UntenNippoMeisaiService/GetByBumon"
 )
 
 // Db_UntenNippoMeisaiServiceClient is the client API for Db_UntenNippoMeisaiService service.
@@ -2439,8 +3058,11 @@ const (
 type Db_UntenNippoMeisaiServiceClient interface {
 	Get(ctx context.Context, in *Db_GetUntenNippoMeisaiRequest, opts ...grpc.CallOption) (*Db_UntenNippoMeisaiResponse, error)
 	List(ctx context.Context, in *Db_ListUntenNippoMeisaiRequest, opts ...grpc.CallOption) (*Db_ListUntenNippoMeisaiResponse, error)
+	Stream(ctx context.Context, in *Db_StreamUntenNippoMeisaiRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Db_UntenNippoMeisai], error)
 	GetBySharyoC(ctx context.Context, in *Db_GetUntenNippoMeisaiBySharyoCRequest, opts ...grpc.CallOption) (*Db_ListUntenNippoMeisaiResponse, error)
 	GetByDateRange(ctx context.Context, in *Db_GetUntenNippoMeisaiByDateRangeRequest, opts ...grpc.CallOption) (*Db_ListUntenNippoMeisaiResponse, error)
+	// 受注部門・稼動部門で取得（部門名を設定）
+	GetByBumon(ctx context.Context, in *Db_GetUntenNippoMeisaiByBumonRequest, opts ...grpc.CallOption) (*Db_ListUntenNippoMeisaiResponse, error)
 }
 
 type db_UntenNippoMeisaiServiceClient struct {
@@ -2471,6 +3093,25 @@ func (c *db_UntenNippoMeisaiServiceClient) List(ctx context.Context, in *Db_List
 	return out, nil
 }
 
+func (c *db_UntenNippoMeisaiServiceClient) Stream(ctx context.Context, in *Db_StreamUntenNippoMeisaiRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Db_UntenNippoMeisai], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Db_UntenNippoMeisaiService_ServiceDesc.Streams[0], Db_UntenNippoMeisaiService_Stream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[Db_StreamUntenNippoMeisaiRequest, Db_UntenNippoMeisai]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Db_UntenNippoMeisaiService_StreamClient = grpc.ServerStreamingClient[Db_UntenNippoMeisai]
+
 func (c *db_UntenNippoMeisaiServiceClient) GetBySharyoC(ctx context.Context, in *Db_GetUntenNippoMeisaiBySharyoCRequest, opts ...grpc.CallOption) (*Db_ListUntenNippoMeisaiResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Db_ListUntenNippoMeisaiResponse)
@@ -2491,6 +3132,16 @@ func (c *db_UntenNippoMeisaiServiceClient) GetByDateRange(ctx context.Context, i
 	return out, nil
 }
 
+func (c *db_UntenNippoMeisaiServiceClient) GetByBumon(ctx context.Context, in *Db_GetUntenNippoMeisaiByBumonRequest, opts ...grpc.CallOption) (*Db_ListUntenNippoMeisaiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Db_ListUntenNippoMeisaiResponse)
+	err := c.cc.Invoke(ctx, Db_UntenNippoMeisaiService_GetByBumon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Db_UntenNippoMeisaiServiceServer is the server API for Db_UntenNippoMeisaiService service.
 // All implementations should embed UnimplementedDb_UntenNippoMeisaiServiceServer
 // for forward compatibility.
@@ -2499,8 +3150,11 @@ func (c *db_UntenNippoMeisaiServiceClient) GetByDateRange(ctx context.Context, i
 type Db_UntenNippoMeisaiServiceServer interface {
 	Get(context.Context, *Db_GetUntenNippoMeisaiRequest) (*Db_UntenNippoMeisaiResponse, error)
 	List(context.Context, *Db_ListUntenNippoMeisaiRequest) (*Db_ListUntenNippoMeisaiResponse, error)
+	Stream(*Db_StreamUntenNippoMeisaiRequest, grpc.ServerStreamingServer[Db_UntenNippoMeisai]) error
 	GetBySharyoC(context.Context, *Db_GetUntenNippoMeisaiBySharyoCRequest) (*Db_ListUntenNippoMeisaiResponse, error)
 	GetByDateRange(context.Context, *Db_GetUntenNippoMeisaiByDateRangeRequest) (*Db_ListUntenNippoMeisaiResponse, error)
+	// 受注部門・稼動部門で取得（部門名を設定）
+	GetByBumon(context.Context, *Db_GetUntenNippoMeisaiByBumonRequest) (*Db_ListUntenNippoMeisaiResponse, error)
 }
 
 // UnimplementedDb_UntenNippoMeisaiServiceServer should be embedded to have
@@ -2516,12 +3170,18 @@ func (UnimplementedDb_UntenNippoMeisaiServiceServer) Get(context.Context, *Db_Ge
 func (UnimplementedDb_UntenNippoMeisaiServiceServer) List(context.Context, *Db_ListUntenNippoMeisaiRequest) (*Db_ListUntenNippoMeisaiResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
+func (UnimplementedDb_UntenNippoMeisaiServiceServer) Stream(*Db_StreamUntenNippoMeisaiRequest, grpc.ServerStreamingServer[Db_UntenNippoMeisai]) error {
+	return status.Errorf(codes.Unimplemented, "method Stream not implemented")
+}
 func (UnimplementedDb_UntenNippoMeisaiServiceServer) GetBySharyoC(context.Context, *Db_GetUntenNippoMeisaiBySharyoCRequest) (*Db_ListUntenNippoMeisaiResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBySharyoC not implemented")
 }
 func (UnimplementedDb_UntenNippoMeisaiServiceServer) GetByDateRange(context.Context, *Db_GetUntenNippoMeisaiByDateRangeRequest) (*Db_ListUntenNippoMeisaiResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetByDateRange not implemented")
 }
+func (UnimplementedDb_UntenNippoMeisaiServiceServer) GetByBumon(context.Context, *Db_GetUntenNippoMeisaiByBumonRequest) (*Db_ListUntenNippoMeisaiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByBumon not implemented")
+}
 func (UnimplementedDb_UntenNippoMeisaiServiceServer) testEmbeddedByValue() {}
 
 // UnsafeDb_UntenNippoMeisaiServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -2578,6 +3238,17 @@ func _Db_UntenNippoMeisaiService_List_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Db_UntenNippoMeisaiService_Stream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Db_StreamUntenNippoMeisaiRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(Db_UntenNippoMeisaiServiceServer).Stream(m, &grpc.GenericServerStream[Db_StreamUntenNippoMeisaiRequest, Db_UntenNippoMeisai]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Db_UntenNippoMeisaiService_StreamServer = grpc.ServerStreamingServer[Db_UntenNippoMeisai]
+
 func _Db_UntenNippoMeisaiService_GetBySharyoC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Db_GetUntenNippoMeisaiBySharyoCRequest)
 	if err := dec(in); err != nil {
@@ -2614,6 +3285,24 @@ func _Db_UntenNippoMeisaiService_GetByDateRange_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Db_UntenNippoMeisaiService_GetByBumon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_GetUntenNippoMeisaiByBumonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Db_UntenNippoMeisaiServiceServer).GetByBumon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Db_UntenNippoMeisaiService_GetByBumon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Db_UntenNippoMeisaiServiceServer).GetByBumon(ctx, req.(*Db_GetUntenNippoMeisaiByBumonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Db_UntenNippoMeisaiService_ServiceDesc is the grpc.ServiceDesc for Db_UntenNippoMeisaiService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2637,8 +3326,18 @@ var Db_UntenNippoMeisaiService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetByDateRange",
 			Handler:    _Db_UntenNippoMeisaiService_GetByDateRange_Handler,
 		},
+		{
+			MethodName: "GetByBumon",
+			Handler:    _Db_UntenNippoMeisaiService_GetByBumon_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Stream",
+			Handler:       _Db_UntenNippoMeisaiService_Stream_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "db_service.proto",
 }
 
@@ -3145,163 +3844,1205 @@ var Db_ChikuMasterService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	Db_TimeCardService_Get_FullMethodName  = "/db_service.db_TimeCardService/Get"
-	Db_TimeCardService_List_FullMethodName = "/db_service.db_TimeCardService/List"
+	Db_TokuisakiMasterService_Get_FullMethodName           = "/db_service.db_TokuisakiMasterService/Get"
+	Db_TokuisakiMasterService_List_FullMethodName          = "/db_service.db_TokuisakiMasterService/List"
+	Db_TokuisakiMasterService_Search_FullMethodName        = "/db_service.db_TokuisakiMasterService/Search"
+	Db_TokuisakiMasterService_ListTekiyobi_FullMethodName  = "/db_service.db_TokuisakiMasterService/ListTekiyobi"
+	Db_TokuisakiMasterService_GetTekiyobiAt_FullMethodName = "/db_service.db_TokuisakiMasterService/GetTekiyobiAt"
 )
 
-// Db_TimeCardServiceClient is the client API for Db_TimeCardService service.
+// Db_TokuisakiMasterServiceClient is the client API for Db_TokuisakiMasterService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 //
-// TimeCardサービス - タイムカードデータ管理（本番DB、読み取り専用）
-type Db_TimeCardServiceClient interface {
-	// タイムカードデータ取得（複合主キー: datetime + id）
-	Get(ctx context.Context, in *Db_GetTimeCardRequest, opts ...grpc.CallOption) (*Db_TimeCardResponse, error)
-	// タイムカードデータ一覧取得
-	List(ctx context.Context, in *Db_ListTimeCardRequest, opts ...grpc.CallOption) (*Db_ListTimeCardResponse, error)
+// TokuisakiMasterService - 得意先マスタ管理（SQL Server、読み取り専用）
+type Db_TokuisakiMasterServiceClient interface {
+	// 得意先C・得意先Hで取得
+	Get(ctx context.Context, in *Db_GetTokuisakiMasterRequest, opts ...grpc.CallOption) (*Db_TokuisakiMasterResponse, error)
+	List(ctx context.Context, in *Db_ListTokuisakiMasterRequest, opts ...grpc.CallOption) (*Db_ListTokuisakiMasterResponse, error)
+	// 得意先N・得意先R・得意先F（カナ）の部分一致で検索
+	Search(ctx context.Context, in *Db_SearchTokuisakiMasterRequest, opts ...grpc.CallOption) (*Db_ListTokuisakiMasterResponse, error)
+	// 得意先適用日マスタ（適用日の古い順）
+	ListTekiyobi(ctx context.Context, in *Db_ListTokuisakiTekiyobiRequest, opts ...grpc.CallOption) (*Db_ListTokuisakiTekiyobiResponse, error)
+	// 指定日に有効な得意先適用日マスタ（適用日が指定日以前で最も新しいもの）
+	GetTekiyobiAt(ctx context.Context, in *Db_GetTokuisakiTekiyobiAtRequest, opts ...grpc.CallOption) (*Db_TokuisakiTekiyobiResponse, error)
+}
+
+type db_TokuisakiMasterServiceClient struct {
+	cc grpc.ClientConnInterface
 }
 
-type db_TimeCardServiceClient struct {
-	cc grpc.ClientConnInterface
+func NewDb_TokuisakiMasterServiceClient(cc grpc.ClientConnInterface) Db_TokuisakiMasterServiceClient {
+	return &db_TokuisakiMasterServiceClient{cc}
 }
 
-func NewDb_TimeCardServiceClient(cc grpc.ClientConnInterface) Db_TimeCardServiceClient {
-	return &db_TimeCardServiceClient{cc}
+func (c *db_TokuisakiMasterServiceClient) Get(ctx context.Context, in *Db_GetTokuisakiMasterRequest, opts ...grpc.CallOption) (*Db_TokuisakiMasterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Db_TokuisakiMasterResponse)
+	err := c.cc.Invoke(ctx, Db_TokuisakiMasterService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (c *db_TimeCardServiceClient) Get(ctx context.Context, in *Db_GetTimeCardRequest, opts ...grpc.CallOption) (*Db_TimeCardResponse, error) {
+func (c *db_TokuisakiMasterServiceClient) List(ctx context.Context, in *Db_ListTokuisakiMasterRequest, opts ...grpc.CallOption) (*Db_ListTokuisakiMasterResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Db_TimeCardResponse)
-	err := c.cc.Invoke(ctx, Db_TimeCardService_Get_FullMethodName, in, out, cOpts...)
+	out := new(Db_ListTokuisakiMasterResponse)
+	err := c.cc.Invoke(ctx, Db_TokuisakiMasterService_List_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *db_TimeCardServiceClient) List(ctx context.Context, in *Db_ListTimeCardRequest, opts ...grpc.CallOption) (*Db_ListTimeCardResponse, error) {
+func (c *db_TokuisakiMasterServiceClient) Search(ctx context.Context, in *Db_SearchTokuisakiMasterRequest, opts ...grpc.CallOption) (*Db_ListTokuisakiMasterResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Db_ListTimeCardResponse)
-	err := c.cc.Invoke(ctx, Db_TimeCardService_List_FullMethodName, in, out, cOpts...)
+	out := new(Db_ListTokuisakiMasterResponse)
+	err := c.cc.Invoke(ctx, Db_TokuisakiMasterService_Search_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// Db_TimeCardServiceServer is the server API for Db_TimeCardService service.
-// All implementations should embed UnimplementedDb_TimeCardServiceServer
-// for forward compatibility.
-//
-// TimeCardサービス - タイムカードデータ管理（本番DB、読み取り専用）
-type Db_TimeCardServiceServer interface {
-	// タイムカードデータ取得（複合主キー: datetime + id）
-	Get(context.Context, *Db_GetTimeCardRequest) (*Db_TimeCardResponse, error)
-	// タイムカードデータ一覧取得
-	List(context.Context, *Db_ListTimeCardRequest) (*Db_ListTimeCardResponse, error)
+func (c *db_TokuisakiMasterServiceClient) ListTekiyobi(ctx context.Context, in *Db_ListTokuisakiTekiyobiRequest, opts ...grpc.CallOption) (*Db_ListTokuisakiTekiyobiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Db_ListTokuisakiTekiyobiResponse)
+	err := c.cc.Invoke(ctx, Db_TokuisakiMasterService_ListTekiyobi_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedDb_TimeCardServiceServer should be embedded to have
+func (c *db_TokuisakiMasterServiceClient) GetTekiyobiAt(ctx context.Context, in *Db_GetTokuisakiTekiyobiAtRequest, opts ...grpc.CallOption) (*Db_TokuisakiTekiyobiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Db_TokuisakiTekiyobiResponse)
+	err := c.cc.Invoke(ctx, Db_TokuisakiMasterService_GetTekiyobiAt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Db_TokuisakiMasterServiceServer is the server API for Db_TokuisakiMasterService service.
+// All implementations should embed UnimplementedDb_TokuisakiMasterServiceServer
+// for forward compatibility.
+//
+// TokuisakiMasterService - 得意先マスタ管理（SQL Server、読み取り専用）
+type Db_TokuisakiMasterServiceServer interface {
+	// 得意先C・得意先Hで取得
+	Get(context.Context, *Db_GetTokuisakiMasterRequest) (*Db_TokuisakiMasterResponse, error)
+	List(context.Context, *Db_ListTokuisakiMasterRequest) (*Db_ListTokuisakiMasterResponse, error)
+	// 得意先N・得意先R・得意先F（カナ）の部分一致で検索
+	Search(context.Context, *Db_SearchTokuisakiMasterRequest) (*Db_ListTokuisakiMasterResponse, error)
+	// 得意先適用日マスタ（適用日の古い順）
+	ListTekiyobi(context.Context, *Db_ListTokuisakiTekiyobiRequest) (*Db_ListTokuisakiTekiyobiResponse, error)
+	// 指定日に有効な得意先適用日マスタ（適用日が指定日以前で最も新しいもの）
+	GetTekiyobiAt(context.Context, *Db_GetTokuisakiTekiyobiAtRequest) (*Db_TokuisakiTekiyobiResponse, error)
+}
+
+// UnimplementedDb_TokuisakiMasterServiceServer should be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedDb_TimeCardServiceServer struct{}
+type UnimplementedDb_TokuisakiMasterServiceServer struct{}
 
-func (UnimplementedDb_TimeCardServiceServer) Get(context.Context, *Db_GetTimeCardRequest) (*Db_TimeCardResponse, error) {
+func (UnimplementedDb_TokuisakiMasterServiceServer) Get(context.Context, *Db_GetTokuisakiMasterRequest) (*Db_TokuisakiMasterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
-func (UnimplementedDb_TimeCardServiceServer) List(context.Context, *Db_ListTimeCardRequest) (*Db_ListTimeCardResponse, error) {
+func (UnimplementedDb_TokuisakiMasterServiceServer) List(context.Context, *Db_ListTokuisakiMasterRequest) (*Db_ListTokuisakiMasterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
-func (UnimplementedDb_TimeCardServiceServer) testEmbeddedByValue() {}
+func (UnimplementedDb_TokuisakiMasterServiceServer) Search(context.Context, *Db_SearchTokuisakiMasterRequest) (*Db_ListTokuisakiMasterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedDb_TokuisakiMasterServiceServer) ListTekiyobi(context.Context, *Db_ListTokuisakiTekiyobiRequest) (*Db_ListTokuisakiTekiyobiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTekiyobi not implemented")
+}
+func (UnimplementedDb_TokuisakiMasterServiceServer) GetTekiyobiAt(context.Context, *Db_GetTokuisakiTekiyobiAtRequest) (*Db_TokuisakiTekiyobiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTekiyobiAt not implemented")
+}
+func (UnimplementedDb_TokuisakiMasterServiceServer) testEmbeddedByValue() {}
 
-// UnsafeDb_TimeCardServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to Db_TimeCardServiceServer will
+// UnsafeDb_TokuisakiMasterServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to Db_TokuisakiMasterServiceServer will
 // result in compilation errors.
-type UnsafeDb_TimeCardServiceServer interface {
-	mustEmbedUnimplementedDb_TimeCardServiceServer()
+type UnsafeDb_TokuisakiMasterServiceServer interface {
+	mustEmbedUnimplementedDb_TokuisakiMasterServiceServer()
 }
 
-func RegisterDb_TimeCardServiceServer(s grpc.ServiceRegistrar, srv Db_TimeCardServiceServer) {
-	// If the following call pancis, it indicates UnimplementedDb_TimeCardServiceServer was
+func RegisterDb_TokuisakiMasterServiceServer(s grpc.ServiceRegistrar, srv Db_TokuisakiMasterServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDb_TokuisakiMasterServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&Db_TimeCardService_ServiceDesc, srv)
+	s.RegisterService(&Db_TokuisakiMasterService_ServiceDesc, srv)
 }
 
-func _Db_TimeCardService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Db_GetTimeCardRequest)
+func _Db_TokuisakiMasterService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_GetTokuisakiMasterRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(Db_TimeCardServiceServer).Get(ctx, in)
+		return srv.(Db_TokuisakiMasterServiceServer).Get(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Db_TimeCardService_Get_FullMethodName,
+		FullMethod: Db_TokuisakiMasterService_Get_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(Db_TimeCardServiceServer).Get(ctx, req.(*Db_GetTimeCardRequest))
+		return srv.(Db_TokuisakiMasterServiceServer).Get(ctx, req.(*Db_GetTokuisakiMasterRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Db_TimeCardService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Db_ListTimeCardRequest)
+func _Db_TokuisakiMasterService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_ListTokuisakiMasterRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(Db_TimeCardServiceServer).List(ctx, in)
+		return srv.(Db_TokuisakiMasterServiceServer).List(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Db_TimeCardService_List_FullMethodName,
+		FullMethod: Db_TokuisakiMasterService_List_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(Db_TimeCardServiceServer).List(ctx, req.(*Db_ListTimeCardRequest))
+		return srv.(Db_TokuisakiMasterServiceServer).List(ctx, req.(*Db_ListTokuisakiMasterRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// Db_TimeCardService_ServiceDesc is the grpc.ServiceDesc for Db_TimeCardService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var Db_TimeCardService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "db_service.db_TimeCardService",
-	HandlerType: (*Db_TimeCardServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Get",
-			Handler:    _Db_TimeCardService_Get_Handler,
-		},
-		{
-			MethodName: "List",
-			Handler:    _Db_TimeCardService_List_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "db_service.proto",
+func _Db_TokuisakiMasterService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_SearchTokuisakiMasterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Db_TokuisakiMasterServiceServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Db_TokuisakiMasterService_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Db_TokuisakiMasterServiceServer).Search(ctx, req.(*Db_SearchTokuisakiMasterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-const (
-	Db_TimeCardDevService_Create_FullMethodName = "/db_service.db_TimeCardDevService/Create"
-	Db_TimeCardDevService_Get_FullMethodName    = "/db_service.db_TimeCardDevService/Get"
-	Db_TimeCardDevService_Update_FullMethodName = "/db_service.db_TimeCardDevService/Update"
-	Db_TimeCardDevService_Delete_FullMethodName = "/db_service.db_TimeCardDevService/Delete"
-	Db_TimeCardDevService_List_FullMethodName   = "/db_service.db_TimeCardDevService/List"
-)
-
-// Db_TimeCardDevServiceClient is the client API for Db_TimeCardDevService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-//
+func _Db_TokuisakiMasterService_ListTekiyobi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_ListTokuisakiTekiyobiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Db_TokuisakiMasterServiceServer).ListTekiyobi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Db_TokuisakiMasterService_ListTekiyobi_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Db_TokuisakiMasterServiceServer).ListTekiyobi(ctx, req.(*Db_ListTokuisakiTekiyobiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Db_TokuisakiMasterService_GetTekiyobiAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_GetTokuisakiTekiyobiAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Db_TokuisakiMasterServiceServer).GetTekiyobiAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Db_TokuisakiMasterService_GetTekiyobiAt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Db_TokuisakiMasterServiceServer).GetTekiyobiAt(ctx, req.(*Db_GetTokuisakiTekiyobiAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Db_TokuisakiMasterService_ServiceDesc is the grpc.ServiceDesc for Db_TokuisakiMasterService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Db_TokuisakiMasterService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "db_service.db_TokuisakiMasterService",
+	HandlerType: (*Db_TokuisakiMasterServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _Db_TokuisakiMasterService_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _Db_TokuisakiMasterService_List_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _Db_TokuisakiMasterService_Search_Handler,
+		},
+		{
+			MethodName: "ListTekiyobi",
+			Handler:    _Db_TokuisakiMasterService_ListTekiyobi_Handler,
+		},
+		{
+			MethodName: "GetTekiyobiAt",
+			Handler:    _Db_TokuisakiMasterService_GetTekiyobiAt_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "db_service.proto",
+}
+
+const (
+	Db_HinmeiMasterService_Get_FullMethodName                 = "/db_service.db_HinmeiMasterService/Get"
+	Db_HinmeiMasterService_List_FullMethodName                = "/db_service.db_HinmeiMasterService/List"
+	Db_HinmeiMasterService_Search_FullMethodName              = "/db_service.db_HinmeiMasterService/Search"
+	Db_HinmeiMasterService_ListTokuisakiHinmei_FullMethodName = "/db_service.db_HinmeiMasterService/ListTokuisakiHinmei"
+	Db_HinmeiMasterService_GetTokuisakiHinmei_FullMethodName  = "/db_service.db_HinmeiMasterService/GetTokuisakiHinmei"
+	Db_HinmeiMasterService_Resolve_FullMethodName             = "/db_service.db_HinmeiMasterService/Resolve"
+)
+
+// Db_HinmeiMasterServiceClient is the client API for Db_HinmeiMasterService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// HinmeiMasterService - 品名マスタ・得意先別品名マスタ管理（SQL Server、読み取り専用）
+type Db_HinmeiMasterServiceClient interface {
+	// 品名C・品名Hで取得
+	Get(ctx context.Context, in *Db_GetHinmeiMasterRequest, opts ...grpc.CallOption) (*Db_HinmeiMasterResponse, error)
+	List(ctx context.Context, in *Db_ListHinmeiMasterRequest, opts ...grpc.CallOption) (*Db_ListHinmeiMasterResponse, error)
+	// 品名N・品名R・品名F（カナ）の部分一致で検索
+	Search(ctx context.Context, in *Db_SearchHinmeiMasterRequest, opts ...grpc.CallOption) (*Db_ListHinmeiMasterResponse, error)
+	// 得意先の得意先別品名マスタ（品名C・品名H順）
+	ListTokuisakiHinmei(ctx context.Context, in *Db_ListTokuisakiHinmeiRequest, opts ...grpc.CallOption) (*Db_ListTokuisakiHinmeiResponse, error)
+	// 得意先C・得意先H・品名C・品名Hで得意先別品名マスタを取得
+	GetTokuisakiHinmei(ctx context.Context, in *Db_GetTokuisakiHinmeiRequest, opts ...grpc.CallOption) (*Db_TokuisakiHinmeiResponse, error)
+	// 得意先別品名マスタを反映した品名・単位・単位重量（請求で使用する値）
+	Resolve(ctx context.Context, in *Db_ResolveHinmeiRequest, opts ...grpc.CallOption) (*Db_ResolveHinmeiResponse, error)
+}
+
+type db_HinmeiMasterServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDb_HinmeiMasterServiceClient(cc grpc.ClientConnInterface) Db_HinmeiMasterServiceClient {
+	return &db_HinmeiMasterServiceClient{cc}
+}
+
+func (c *db_HinmeiMasterServiceClient) Get(ctx context.Context, in *Db_GetHinmeiMasterRequest, opts ...grpc.CallOption) (*Db_HinmeiMasterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Db_HinmeiMasterResponse)
+	err := c.cc.Invoke(ctx, Db_HinmeiMasterService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *db_HinmeiMasterServiceClient) List(ctx context.Context, in *Db_ListHinmeiMasterRequest, opts ...grpc.CallOption) (*Db_ListHinmeiMasterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Db_ListHinmeiMasterResponse)
+	err := c.cc.Invoke(ctx, Db_HinmeiMasterService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *db_HinmeiMasterServiceClient) Search(ctx context.Context, in *Db_SearchHinmeiMasterRequest, opts ...grpc.CallOption) (*Db_ListHinmeiMasterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Db_ListHinmeiMasterResponse)
+	err := c.cc.Invoke(ctx, Db_HinmeiMasterService_Search_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *db_HinmeiMasterServiceClient) ListTokuisakiHinmei(ctx context.Context, in *Db_ListTokuisakiHinmeiRequest, opts ...grpc.CallOption) (*Db_ListTokuisakiHinmeiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Db_ListTokuisakiHinmeiResponse)
+	err := c.cc.Invoke(ctx, Db_HinmeiMasterService_ListTokuisakiHinmei_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *db_HinmeiMasterServiceClient) GetTokuisakiHinmei(ctx context.Context, in *Db_GetTokuisakiHinmeiRequest, opts ...grpc.CallOption) (*Db_TokuisakiHinmeiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Db_TokuisakiHinmeiResponse)
+	err := c.cc.Invoke(ctx, Db_HinmeiMasterService_GetTokuisakiHinmei_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *db_HinmeiMasterServiceClient) Resolve(ctx context.Context, in *Db_ResolveHinmeiRequest, opts ...grpc.CallOption) (*Db_ResolveHinmeiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Db_ResolveHinmeiResponse)
+	err := c.cc.Invoke(ctx, Db_HinmeiMasterService_Resolve_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Db_HinmeiMasterServiceServer is the server API for Db_HinmeiMasterService service.
+// All implementations should embed UnimplementedDb_HinmeiMasterServiceServer
+// for forward compatibility.
+//
+// HinmeiMasterService - 品名マスタ・得意先別品名マスタ管理（SQL Server、読み取り専用）
+type Db_HinmeiMasterServiceServer interface {
+	// 品名C・品名Hで取得
+	Get(context.Context, *Db_GetHinmeiMasterRequest) (*Db_HinmeiMasterResponse, error)
+	List(context.Context, *Db_ListHinmeiMasterRequest) (*Db_ListHinmeiMasterResponse, error)
+	// 品名N・品名R・品名F（カナ）の部分一致で検索
+	Search(context.Context, *Db_SearchHinmeiMasterRequest) (*Db_ListHinmeiMasterResponse, error)
+	// 得意先の得意先別品名マスタ（品名C・品名H順）
+	ListTokuisakiHinmei(context.Context, *Db_ListTokuisakiHinmeiRequest) (*Db_ListTokuisakiHinmeiResponse, error)
+	// 得意先C・得意先H・品名C・品名Hで得意先別品名マスタを取得
+	GetTokuisakiHinmei(context.Context, *Db_GetTokuisakiHinmeiRequest) (*Db_TokuisakiHinmeiResponse, error)
+	// 得意先別品名マスタを反映した品名・単位・単位重量（請求で使用する値）
+	Resolve(context.Context, *Db_ResolveHinmeiRequest) (*Db_ResolveHinmeiResponse, error)
+}
+
+// UnimplementedDb_HinmeiMasterServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDb_HinmeiMasterServiceServer struct{}
+
+func (UnimplementedDb_HinmeiMasterServiceServer) Get(context.Context, *Db_GetHinmeiMasterRequest) (*Db_HinmeiMasterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedDb_HinmeiMasterServiceServer) List(context.Context, *Db_ListHinmeiMasterRequest) (*Db_ListHinmeiMasterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedDb_HinmeiMasterServiceServer) Search(context.Context, *Db_SearchHinmeiMasterRequest) (*Db_ListHinmeiMasterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedDb_HinmeiMasterServiceServer) ListTokuisakiHinmei(context.Context, *Db_ListTokuisakiHinmeiRequest) (*Db_ListTokuisakiHinmeiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTokuisakiHinmei not implemented")
+}
+func (UnimplementedDb_HinmeiMasterServiceServer) GetTokuisakiHinmei(context.Context, *Db_GetTokuisakiHinmeiRequest) (*Db_TokuisakiHinmeiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTokuisakiHinmei not implemented")
+}
+func (UnimplementedDb_HinmeiMasterServiceServer) Resolve(context.Context, *Db_ResolveHinmeiRequest) (*Db_ResolveHinmeiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Resolve not implemented")
+}
+func (UnimplementedDb_HinmeiMasterServiceServer) testEmbeddedByValue() {}
+
+// UnsafeDb_HinmeiMasterServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to Db_HinmeiMasterServiceServer will
+// result in compilation errors.
+type UnsafeDb_HinmeiMasterServiceServer interface {
+	mustEmbedUnimplementedDb_HinmeiMasterServiceServer()
+}
+
+func RegisterDb_HinmeiMasterServiceServer(s grpc.ServiceRegistrar, srv Db_HinmeiMasterServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDb_HinmeiMasterServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Db_HinmeiMasterService_ServiceDesc, srv)
+}
+
+func _Db_HinmeiMasterService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_GetHinmeiMasterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Db_HinmeiMasterServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Db_HinmeiMasterService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Db_HinmeiMasterServiceServer).Get(ctx, req.(*Db_GetHinmeiMasterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Db_HinmeiMasterService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_ListHinmeiMasterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Db_HinmeiMasterServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Db_HinmeiMasterService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Db_HinmeiMasterServiceServer).List(ctx, req.(*Db_ListHinmeiMasterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Db_HinmeiMasterService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_SearchHinmeiMasterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Db_HinmeiMasterServiceServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Db_HinmeiMasterService_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Db_HinmeiMasterServiceServer).Search(ctx, req.(*Db_SearchHinmeiMasterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Db_HinmeiMasterService_ListTokuisakiHinmei_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_ListTokuisakiHinmeiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Db_HinmeiMasterServiceServer).ListTokuisakiHinmei(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Db_HinmeiMasterService_ListTokuisakiHinmei_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Db_HinmeiMasterServiceServer).ListTokuisakiHinmei(ctx, req.(*Db_ListTokuisakiHinmeiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Db_HinmeiMasterService_GetTokuisakiHinmei_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_GetTokuisakiHinmeiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Db_HinmeiMasterServiceServer).GetTokuisakiHinmei(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Db_HinmeiMasterService_GetTokuisakiHinmei_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Db_HinmeiMasterServiceServer).GetTokuisakiHinmei(ctx, req.(*Db_GetTokuisakiHinmeiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Db_HinmeiMasterService_Resolve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_ResolveHinmeiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Db_HinmeiMasterServiceServer).Resolve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Db_HinmeiMasterService_Resolve_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Db_HinmeiMasterServiceServer).Resolve(ctx, req.(*Db_ResolveHinmeiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Db_HinmeiMasterService_ServiceDesc is the grpc.ServiceDesc for Db_HinmeiMasterService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Db_HinmeiMasterService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "db_service.db_HinmeiMasterService",
+	HandlerType: (*Db_HinmeiMasterServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _Db_HinmeiMasterService_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _Db_HinmeiMasterService_List_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _Db_HinmeiMasterService_Search_Handler,
+		},
+		{
+			MethodName: "ListTokuisakiHinmei",
+			Handler:    _Db_HinmeiMasterService_ListTokuisakiHinmei_Handler,
+		},
+		{
+			MethodName: "GetTokuisakiHinmei",
+			Handler:    _Db_HinmeiMasterService_GetTokuisakiHinmei_Handler,
+		},
+		{
+			MethodName: "Resolve",
+			Handler:    _Db_HinmeiMasterService_Resolve_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "db_service.proto",
+}
+
+const (
+	Db_BumonMasterService_Get_FullMethodName  = "/db_service.db_BumonMasterService/Get"
+	Db_BumonMasterService_List_FullMethodName = "/db_service.db_BumonMasterService/List"
+)
+
+// Db_BumonMasterServiceClient is the client API for Db_BumonMasterService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// BumonMasterService - 部門マスタ管理（SQL Server、読み取り専用）
+type Db_BumonMasterServiceClient interface {
+	Get(ctx context.Context, in *Db_GetBumonMasterRequest, opts ...grpc.CallOption) (*Db_BumonMasterResponse, error)
+	List(ctx context.Context, in *Db_ListBumonMasterRequest, opts ...grpc.CallOption) (*Db_ListBumonMasterResponse, error)
+}
+
+type db_BumonMasterServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDb_BumonMasterServiceClient(cc grpc.ClientConnInterface) Db_BumonMasterServiceClient {
+	return &db_BumonMasterServiceClient{cc}
+}
+
+func (c *db_BumonMasterServiceClient) Get(ctx context.Context, in *Db_GetBumonMasterRequest, opts ...grpc.CallOption) (*Db_BumonMasterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Db_BumonMasterResponse)
+	err := c.cc.Invoke(ctx, Db_BumonMasterService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *db_BumonMasterServiceClient) List(ctx context.Context, in *Db_ListBumonMasterRequest, opts ...grpc.CallOption) (*Db_ListBumonMasterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Db_ListBumonMasterResponse)
+	err := c.cc.Invoke(ctx, Db_BumonMasterService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Db_BumonMasterServiceServer is the server API for Db_BumonMasterService service.
+// All implementations should embed UnimplementedDb_BumonMasterServiceServer
+// for forward compatibility.
+//
+// BumonMasterService - 部門マスタ管理（SQL Server、読み取り専用）
+type Db_BumonMasterServiceServer interface {
+	Get(context.Context, *Db_GetBumonMasterRequest) (*Db_BumonMasterResponse, error)
+	List(context.Context, *Db_ListBumonMasterRequest) (*Db_ListBumonMasterResponse, error)
+}
+
+// UnimplementedDb_BumonMasterServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDb_BumonMasterServiceServer struct{}
+
+func (UnimplementedDb_BumonMasterServiceServer) Get(context.Context, *Db_GetBumonMasterRequest) (*Db_BumonMasterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedDb_BumonMasterServiceServer) List(context.Context, *Db_ListBumonMasterRequest) (*Db_ListBumonMasterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedDb_BumonMasterServiceServer) testEmbeddedByValue() {}
+
+// UnsafeDb_BumonMasterServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to Db_BumonMasterServiceServer will
+// result in compilation errors.
+type UnsafeDb_BumonMasterServiceServer interface {
+	mustEmbedUnimplementedDb_BumonMasterServiceServer()
+}
+
+func RegisterDb_BumonMasterServiceServer(s grpc.ServiceRegistrar, srv Db_BumonMasterServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDb_BumonMasterServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Db_BumonMasterService_ServiceDesc, srv)
+}
+
+func _Db_BumonMasterService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_GetBumonMasterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Db_BumonMasterServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Db_BumonMasterService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Db_BumonMasterServiceServer).Get(ctx, req.(*Db_GetBumonMasterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Db_BumonMasterService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_ListBumonMasterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Db_BumonMasterServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Db_BumonMasterService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Db_BumonMasterServiceServer).List(ctx, req.(*Db_ListBumonMasterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Db_BumonMasterService_ServiceDesc is the grpc.ServiceDesc for Db_BumonMasterService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Db_BumonMasterService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "db_service.db_BumonMasterService",
+	HandlerType: (*Db_BumonMasterServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _Db_BumonMasterService_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _Db_BumonMasterService_List_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "db_service.proto",
+}
+
+const (
+	Db_SharyoMasterService_Get_FullMethodName           = "/db_service.db_SharyoMasterService/Get"
+	Db_SharyoMasterService_ListBySharyoC_FullMethodName = "/db_service.db_SharyoMasterService/ListBySharyoC"
+	Db_SharyoMasterService_List_FullMethodName          = "/db_service.db_SharyoMasterService/List"
+)
+
+// Db_SharyoMasterServiceClient is the client API for Db_SharyoMasterService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SharyoMasterサービス - 車輌マスタ管理（SQL Server、読み取り専用）
+type Db_SharyoMasterServiceClient interface {
+	// 車輌マスタ取得（複合主キー: 車輌C + 車輌H）
+	Get(ctx context.Context, in *Db_GetSharyoMasterRequest, opts ...grpc.CallOption) (*Db_SharyoMasterResponse, error)
+	// 車輌Cの車輌マスタ一覧取得（全車輌H）
+	ListBySharyoC(ctx context.Context, in *Db_ListSharyoMasterBySharyoCRequest, opts ...grpc.CallOption) (*Db_ListSharyoMasterResponse, error)
+	// 車輌マスタ一覧取得
+	List(ctx context.Context, in *Db_ListSharyoMasterRequest, opts ...grpc.CallOption) (*Db_ListSharyoMasterResponse, error)
+}
+
+type db_SharyoMasterServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDb_SharyoMasterServiceClient(cc grpc.ClientConnInterface) Db_SharyoMasterServiceClient {
+	return &db_SharyoMasterServiceClient{cc}
+}
+
+func (c *db_SharyoMasterServiceClient) Get(ctx context.Context, in *Db_GetSharyoMasterRequest, opts ...grpc.CallOption) (*Db_SharyoMasterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Db_SharyoMasterResponse)
+	err := c.cc.Invoke(ctx, Db_SharyoMasterService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *db_SharyoMasterServiceClient) ListBySharyoC(ctx context.Context, in *Db_ListSharyoMasterBySharyoCRequest, opts ...grpc.CallOption) (*Db_ListSharyoMasterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Db_ListSharyoMasterResponse)
+	err := c.cc.Invoke(ctx, Db_SharyoMasterService_ListBySharyoC_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *db_SharyoMasterServiceClient) List(ctx context.Context, in *Db_ListSharyoMasterRequest, opts ...grpc.CallOption) (*Db_ListSharyoMasterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Db_ListSharyoMasterResponse)
+	err := c.cc.Invoke(ctx, Db_SharyoMasterService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Db_SharyoMasterServiceServer is the server API for Db_SharyoMasterService service.
+// All implementations should embed UnimplementedDb_SharyoMasterServiceServer
+// for forward compatibility.
+//
+// SharyoMasterサービス - 車輌マスタ管理（SQL Server、読み取り専用）
+type Db_SharyoMasterServiceServer interface {
+	// 車輌マスタ取得（複合主キー: 車輌C + 車輌H）
+	Get(context.Context, *Db_GetSharyoMasterRequest) (*Db_SharyoMasterResponse, error)
+	// 車輌Cの車輌マスタ一覧取得（全車輌H）
+	ListBySharyoC(context.Context, *Db_ListSharyoMasterBySharyoCRequest) (*Db_ListSharyoMasterResponse, error)
+	// 車輌マスタ一覧取得
+	List(context.Context, *Db_ListSharyoMasterRequest) (*Db_ListSharyoMasterResponse, error)
+}
+
+// UnimplementedDb_SharyoMasterServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDb_SharyoMasterServiceServer struct{}
+
+func (UnimplementedDb_SharyoMasterServiceServer) Get(context.Context, *Db_GetSharyoMasterRequest) (*Db_SharyoMasterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedDb_SharyoMasterServiceServer) ListBySharyoC(context.Context, *Db_ListSharyoMasterBySharyoCRequest) (*Db_ListSharyoMasterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBySharyoC not implemented")
+}
+func (UnimplementedDb_SharyoMasterServiceServer) List(context.Context, *Db_ListSharyoMasterRequest) (*Db_ListSharyoMasterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedDb_SharyoMasterServiceServer) testEmbeddedByValue() {}
+
+// UnsafeDb_SharyoMasterServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to Db_SharyoMasterServiceServer will
+// result in compilation errors.
+type UnsafeDb_SharyoMasterServiceServer interface {
+	mustEmbedUnimplementedDb_SharyoMasterServiceServer()
+}
+
+func RegisterDb_SharyoMasterServiceServer(s grpc.ServiceRegistrar, srv Db_SharyoMasterServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDb_SharyoMasterServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Db_SharyoMasterService_ServiceDesc, srv)
+}
+
+func _Db_SharyoMasterService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_GetSharyoMasterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Db_SharyoMasterServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Db_SharyoMasterService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Db_SharyoMasterServiceServer).Get(ctx, req.(*Db_GetSharyoMasterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Db_SharyoMasterService_ListBySharyoC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_ListSharyoMasterBySharyoCRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Db_SharyoMasterServiceServer).ListBySharyoC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Db_SharyoMasterService_ListBySharyoC_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Db_SharyoMasterServiceServer).ListBySharyoC(ctx, req.(*Db_ListSharyoMasterBySharyoCRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Db_SharyoMasterService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_ListSharyoMasterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Db_SharyoMasterServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Db_SharyoMasterService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Db_SharyoMasterServiceServer).List(ctx, req.(*Db_ListSharyoMasterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Db_SharyoMasterService_ServiceDesc is the grpc.ServiceDesc for Db_SharyoMasterService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Db_SharyoMasterService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "db_service.db_SharyoMasterService",
+	HandlerType: (*Db_SharyoMasterServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _Db_SharyoMasterService_Get_Handler,
+		},
+		{
+			MethodName: "ListBySharyoC",
+			Handler:    _Db_SharyoMasterService_ListBySharyoC_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _Db_SharyoMasterService_List_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "db_service.proto",
+}
+
+const (
+	Db_VehicleIdentityService_Get_FullMethodName      = "/db_service.db_VehicleIdentityService/Get"
+	Db_VehicleIdentityService_ListGaps_FullMethodName = "/db_service.db_VehicleIdentityService/ListGaps"
+)
+
+// Db_VehicleIdentityServiceClient is the client API for Db_VehicleIdentityService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// VehicleIdentityサービス - 車輌の識別子の突き合わせ（本番DB・SQL Server、読み取り専用）
+type Db_VehicleIdentityServiceClient interface {
+	// 識別子のいずれか1つから車輌の全識別子と登録されているETCカードを取得
+	Get(ctx context.Context, in *Db_GetVehicleIdentityRequest, opts ...grpc.CallOption) (*Db_VehicleIdentityResponse, error)
+	// 一部のシステムに登録されていない車輌を報告
+	ListGaps(ctx context.Context, in *Db_ListVehicleIdentityGapsRequest, opts ...grpc.CallOption) (*Db_ListVehicleIdentityGapsResponse, error)
+}
+
+type db_VehicleIdentityServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDb_VehicleIdentityServiceClient(cc grpc.ClientConnInterface) Db_VehicleIdentityServiceClient {
+	return &db_VehicleIdentityServiceClient{cc}
+}
+
+func (c *db_VehicleIdentityServiceClient) Get(ctx context.Context, in *Db_GetVehicleIdentityRequest, opts ...grpc.CallOption) (*Db_VehicleIdentityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Db_VehicleIdentityResponse)
+	err := c.cc.Invoke(ctx, Db_VehicleIdentityService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *db_VehicleIdentityServiceClient) ListGaps(ctx context.Context, in *Db_ListVehicleIdentityGapsRequest, opts ...grpc.CallOption) (*Db_ListVehicleIdentityGapsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Db_ListVehicleIdentityGapsResponse)
+	err := c.cc.Invoke(ctx, Db_VehicleIdentityService_ListGaps_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Db_VehicleIdentityServiceServer is the server API for Db_VehicleIdentityService service.
+// All implementations should embed UnimplementedDb_VehicleIdentityServiceServer
+// for forward compatibility.
+//
+// VehicleIdentityサービス - 車輌の識別子の突き合わせ（本番DB・SQL Server、読み取り専用）
+type Db_VehicleIdentityServiceServer interface {
+	// 識別子のいずれか1つから車輌の全識別子と登録されているETCカードを取得
+	Get(context.Context, *Db_GetVehicleIdentityRequest) (*Db_VehicleIdentityResponse, error)
+	// 一部のシステムに登録されていない車輌を報告
+	ListGaps(context.Context, *Db_ListVehicleIdentityGapsRequest) (*Db_ListVehicleIdentityGapsResponse, error)
+}
+
+// UnimplementedDb_VehicleIdentityServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDb_VehicleIdentityServiceServer struct{}
+
+func (UnimplementedDb_VehicleIdentityServiceServer) Get(context.Context, *Db_GetVehicleIdentityRequest) (*Db_VehicleIdentityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedDb_VehicleIdentityServiceServer) ListGaps(context.Context, *Db_ListVehicleIdentityGapsRequest) (*Db_ListVehicleIdentityGapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGaps not implemented")
+}
+func (UnimplementedDb_VehicleIdentityServiceServer) testEmbeddedByValue() {}
+
+// UnsafeDb_VehicleIdentityServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to Db_VehicleIdentityServiceServer will
+// result in compilation errors.
+type UnsafeDb_VehicleIdentityServiceServer interface {
+	mustEmbedUnimplementedDb_VehicleIdentityServiceServer()
+}
+
+func RegisterDb_VehicleIdentityServiceServer(s grpc.ServiceRegistrar, srv Db_VehicleIdentityServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDb_VehicleIdentityServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Db_VehicleIdentityService_ServiceDesc, srv)
+}
+
+func _Db_VehicleIdentityService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_GetVehicleIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Db_VehicleIdentityServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Db_VehicleIdentityService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Db_VehicleIdentityServiceServer).Get(ctx, req.(*Db_GetVehicleIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Db_VehicleIdentityService_ListGaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_ListVehicleIdentityGapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Db_VehicleIdentityServiceServer).ListGaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Db_VehicleIdentityService_ListGaps_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Db_VehicleIdentityServiceServer).ListGaps(ctx, req.(*Db_ListVehicleIdentityGapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Db_VehicleIdentityService_ServiceDesc is the grpc.ServiceDesc for Db_VehicleIdentityService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Db_VehicleIdentityService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "db_service.db_VehicleIdentityService",
+	HandlerType: (*Db_VehicleIdentityServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _Db_VehicleIdentityService_Get_Handler,
+		},
+		{
+			MethodName: "ListGaps",
+			Handler:    _Db_VehicleIdentityService_ListGaps_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "db_service.proto",
+}
+
+const (
+	Db_TimeCardService_Get_FullMethodName  = "/db_service.db_TimeCardService/Get"
+	Db_TimeCardService_List_FullMethodName = "/db_service.db_TimeCardService/List"
+)
+
+// Db_TimeCardServiceClient is the client API for Db_TimeCardService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// TimeCardサービス - タイムカードデータ管理（本番DB、読み取り専用）
+type Db_TimeCardServiceClient interface {
+	// タイムカードデータ取得（複合主キー: datetime + id）
+	Get(ctx context.Context, in *Db_GetTimeCardRequest, opts ...grpc.CallOption) (*Db_TimeCardResponse, error)
+	// タイムカードデータ一覧取得
+	List(ctx context.Context, in *Db_ListTimeCardRequest, opts ...grpc.CallOption) (*Db_ListTimeCardResponse, error)
+}
+
+type db_TimeCardServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDb_TimeCardServiceClient(cc grpc.ClientConnInterface) Db_TimeCardServiceClient {
+	return &db_TimeCardServiceClient{cc}
+}
+
+func (c *db_TimeCardServiceClient) Get(ctx context.Context, in *Db_GetTimeCardRequest, opts ...grpc.CallOption) (*Db_TimeCardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Db_TimeCardResponse)
+	err := c.cc.Invoke(ctx, Db_TimeCardService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *db_TimeCardServiceClient) List(ctx context.Context, in *Db_ListTimeCardRequest, opts ...grpc.CallOption) (*Db_ListTimeCardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Db_ListTimeCardResponse)
+	err := c.cc.Invoke(ctx, Db_TimeCardService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Db_TimeCardServiceServer is the server API for Db_TimeCardService service.
+// All implementations should embed UnimplementedDb_TimeCardServiceServer
+// for forward compatibility.
+//
+// TimeCardサービス - タイムカードデータ管理（本番DB、読み取り専用）
+type Db_TimeCardServiceServer interface {
+	// タイムカードデータ取得（複合主キー: datetime + id）
+	Get(context.Context, *Db_GetTimeCardRequest) (*Db_TimeCardResponse, error)
+	// タイムカードデータ一覧取得
+	List(context.Context, *Db_ListTimeCardRequest) (*Db_ListTimeCardResponse, error)
+}
+
+// UnimplementedDb_TimeCardServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDb_TimeCardServiceServer struct{}
+
+func (UnimplementedDb_TimeCardServiceServer) Get(context.Context, *Db_GetTimeCardRequest) (*Db_TimeCardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedDb_TimeCardServiceServer) List(context.Context, *Db_ListTimeCardRequest) (*Db_ListTimeCardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedDb_TimeCardServiceServer) testEmbeddedByValue() {}
+
+// UnsafeDb_TimeCardServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to Db_TimeCardServiceServer will
+// result in compilation errors.
+type UnsafeDb_TimeCardServiceServer interface {
+	mustEmbedUnimplementedDb_TimeCardServiceServer()
+}
+
+func RegisterDb_TimeCardServiceServer(s grpc.ServiceRegistrar, srv Db_TimeCardServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDb_TimeCardServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Db_TimeCardService_ServiceDesc, srv)
+}
+
+func _Db_TimeCardService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_GetTimeCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Db_TimeCardServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Db_TimeCardService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Db_TimeCardServiceServer).Get(ctx, req.(*Db_GetTimeCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Db_TimeCardService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_ListTimeCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Db_TimeCardServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Db_TimeCardService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Db_TimeCardServiceServer).List(ctx, req.(*Db_ListTimeCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Db_TimeCardService_ServiceDesc is the grpc.ServiceDesc for Db_TimeCardService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Db_TimeCardService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "db_service.db_TimeCardService",
+	HandlerType: (*Db_TimeCardServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _Db_TimeCardService_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _Db_TimeCardService_List_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "db_service.proto",
+}
+
+const (
+	Db_TimeCardDevService_Create_FullMethodName = "/db_service.db_TimeCardDevService/Create"
+	Db_TimeCardDevService_Get_FullMethodName    = "/db_service.db_TimeCardDevService/Get"
+	Db_TimeCardDevService_Update_FullMethodName = "/db_service.db_TimeCardDevService/Update"
+	Db_TimeCardDevService_Delete_FullMethodName = "/db_service.db_TimeCardDevService/Delete"
+	Db_TimeCardDevService_List_FullMethodName   = "/db_service.db_TimeCardDevService/List"
+)
+
+// Db_TimeCardDevServiceClient is the client API for Db_TimeCardDevService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
 // TimeCardDevサービス - タイムカードデータ管理（ローカルDB、読み書き可能）
 type Db_TimeCardDevServiceClient interface {
 	// タイムカードデータ作成
@@ -3316,240 +5057,546 @@ type Db_TimeCardDevServiceClient interface {
 	List(ctx context.Context, in *Db_ListTimeCardRequest, opts ...grpc.CallOption) (*Db_ListTimeCardResponse, error)
 }
 
-type db_TimeCardDevServiceClient struct {
+type db_TimeCardDevServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDb_TimeCardDevServiceClient(cc grpc.ClientConnInterface) Db_TimeCardDevServiceClient {
+	return &db_TimeCardDevServiceClient{cc}
+}
+
+func (c *db_TimeCardDevServiceClient) Create(ctx context.Context, in *Db_CreateTimeCardRequest, opts ...grpc.CallOption) (*Db_TimeCardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Db_TimeCardResponse)
+	err := c.cc.Invoke(ctx, Db_TimeCardDevService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *db_TimeCardDevServiceClient) Get(ctx context.Context, in *Db_GetTimeCardRequest, opts ...grpc.CallOption) (*Db_TimeCardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Db_TimeCardResponse)
+	err := c.cc.Invoke(ctx, Db_TimeCardDevService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *db_TimeCardDevServiceClient) Update(ctx context.Context, in *Db_UpdateTimeCardRequest, opts ...grpc.CallOption) (*Db_TimeCardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Db_TimeCardResponse)
+	err := c.cc.Invoke(ctx, Db_TimeCardDevService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *db_TimeCardDevServiceClient) Delete(ctx context.Context, in *Db_DeleteTimeCardRequest, opts ...grpc.CallOption) (*Db_Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Db_Empty)
+	err := c.cc.Invoke(ctx, Db_TimeCardDevService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *db_TimeCardDevServiceClient) List(ctx context.Context, in *Db_ListTimeCardRequest, opts ...grpc.CallOption) (*Db_ListTimeCardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Db_ListTimeCardResponse)
+	err := c.cc.Invoke(ctx, Db_TimeCardDevService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Db_TimeCardDevServiceServer is the server API for Db_TimeCardDevService service.
+// All implementations should embed UnimplementedDb_TimeCardDevServiceServer
+// for forward compatibility.
+//
+// TimeCardDevサービス - タイムカードデータ管理（ローカルDB、読み書き可能）
+type Db_TimeCardDevServiceServer interface {
+	// タイムカードデータ作成
+	Create(context.Context, *Db_CreateTimeCardRequest) (*Db_TimeCardResponse, error)
+	// タイムカードデータ取得（複合主キー: datetime + id）
+	Get(context.Context, *Db_GetTimeCardRequest) (*Db_TimeCardResponse, error)
+	// タイムカードデータ更新
+	Update(context.Context, *Db_UpdateTimeCardRequest) (*Db_TimeCardResponse, error)
+	// タイムカードデータ削除
+	Delete(context.Context, *Db_DeleteTimeCardRequest) (*Db_Empty, error)
+	// タイムカードデータ一覧取得
+	List(context.Context, *Db_ListTimeCardRequest) (*Db_ListTimeCardResponse, error)
+}
+
+// UnimplementedDb_TimeCardDevServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDb_TimeCardDevServiceServer struct{}
+
+func (UnimplementedDb_TimeCardDevServiceServer) Create(context.Context, *Db_CreateTimeCardRequest) (*Db_TimeCardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedDb_TimeCardDevServiceServer) Get(context.Context, *Db_GetTimeCardRequest) (*Db_TimeCardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedDb_TimeCardDevServiceServer) Update(context.Context, *Db_UpdateTimeCardRequest) (*Db_TimeCardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedDb_TimeCardDevServiceServer) Delete(context.Context, *Db_DeleteTimeCardRequest) (*Db_Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedDb_TimeCardDevServiceServer) List(context.Context, *Db_ListTimeCardRequest) (*Db_ListTimeCardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedDb_TimeCardDevServiceServer) testEmbeddedByValue() {}
+
+// UnsafeDb_TimeCardDevServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to Db_TimeCardDevServiceServer will
+// result in compilation errors.
+type UnsafeDb_TimeCardDevServiceServer interface {
+	mustEmbedUnimplementedDb_TimeCardDevServiceServer()
+}
+
+func RegisterDb_TimeCardDevServiceServer(s grpc.ServiceRegistrar, srv Db_TimeCardDevServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDb_TimeCardDevServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Db_TimeCardDevService_ServiceDesc, srv)
+}
+
+func _Db_TimeCardDevService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_CreateTimeCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Db_TimeCardDevServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Db_TimeCardDevService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Db_TimeCardDevServiceServer).Create(ctx, req.(*Db_CreateTimeCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Db_TimeCardDevService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_GetTimeCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Db_TimeCardDevServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Db_TimeCardDevService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Db_TimeCardDevServiceServer).Get(ctx, req.(*Db_GetTimeCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Db_TimeCardDevService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_UpdateTimeCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Db_TimeCardDevServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Db_TimeCardDevService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Db_TimeCardDevServiceServer).Update(ctx, req.(*Db_UpdateTimeCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Db_TimeCardDevService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_DeleteTimeCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Db_TimeCardDevServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Db_TimeCardDevService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Db_TimeCardDevServiceServer).Delete(ctx, req.(*Db_DeleteTimeCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Db_TimeCardDevService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_ListTimeCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Db_TimeCardDevServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Db_TimeCardDevService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Db_TimeCardDevServiceServer).List(ctx, req.(*Db_ListTimeCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Db_TimeCardDevService_ServiceDesc is the grpc.ServiceDesc for Db_TimeCardDevService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Db_TimeCardDevService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "db_service.db_TimeCardDevService",
+	HandlerType: (*Db_TimeCardDevServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _Db_TimeCardDevService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _Db_TimeCardDevService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _Db_TimeCardDevService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _Db_TimeCardDevService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _Db_TimeCardDevService_List_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "db_service.proto",
+}
+
+const (
+	Db_TimeCardLogService_Create_FullMethodName      = "/db_service.db_TimeCardLogService/Create"
+	Db_TimeCardLogService_Get_FullMethodName         = "/db_service.db_TimeCardLogService/Get"
+	Db_TimeCardLogService_Update_FullMethodName      = "/db_service.db_TimeCardLogService/Update"
+	Db_TimeCardLogService_Delete_FullMethodName      = "/db_service.db_TimeCardLogService/Delete"
+	Db_TimeCardLogService_List_FullMethodName        = "/db_service.db_TimeCardLogService/List"
+	Db_TimeCardLogService_GetByCardID_FullMethodName = "/db_service.db_TimeCardLogService/GetByCardID"
+)
+
+// Db_TimeCardLogServiceClient is the client API for Db_TimeCardLogService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// db_TimeCardLogServiceサービス - タイムカードログデータ管理（ローカルDB）
+type Db_TimeCardLogServiceClient interface {
+	// タイムカードログ作成
+	Create(ctx context.Context, in *Db_CreateTimeCardLogRequest, opts ...grpc.CallOption) (*Db_TimeCardLogResponse, error)
+	// タイムカードログ取得（複合主キー: datetime + id）
+	Get(ctx context.Context, in *Db_GetTimeCardLogRequest, opts ...grpc.CallOption) (*Db_TimeCardLogResponse, error)
+	// タイムカードログ更新
+	Update(ctx context.Context, in *Db_UpdateTimeCardLogRequest, opts ...grpc.CallOption) (*Db_TimeCardLogResponse, error)
+	// タイムカードログ削除
+	Delete(ctx context.Context, in *Db_DeleteTimeCardLogRequest, opts ...grpc.CallOption) (*Db_Empty, error)
+	// タイムカードログ一覧取得
+	List(ctx context.Context, in *Db_ListTimeCardLogRequest, opts ...grpc.CallOption) (*Db_ListTimeCardLogResponse, error)
+	// カードIDでタイムカードログ取得
+	GetByCardID(ctx context.Context, in *Db_GetByCardIDRequest, opts ...grpc.CallOption) (*Db_ListTimeCardLogResponse, error)
+}
+
+type db_TimeCardLogServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewDb_TimeCardDevServiceClient(cc grpc.ClientConnInterface) Db_TimeCardDevServiceClient {
-	return &db_TimeCardDevServiceClient{cc}
+func NewDb_TimeCardLogServiceClient(cc grpc.ClientConnInterface) Db_TimeCardLogServiceClient {
+	return &db_TimeCardLogServiceClient{cc}
 }
 
-func (c *db_TimeCardDevServiceClient) Create(ctx context.Context, in *Db_CreateTimeCardRequest, opts ...grpc.CallOption) (*Db_TimeCardResponse, error) {
+func (c *db_TimeCardLogServiceClient) Create(ctx context.Context, in *Db_CreateTimeCardLogRequest, opts ...grpc.CallOption) (*Db_TimeCardLogResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Db_TimeCardResponse)
-	err := c.cc.Invoke(ctx, Db_TimeCardDevService_Create_FullMethodName, in, out, cOpts...)
+	out := new(Db_TimeCardLogResponse)
+	err := c.cc.Invoke(ctx, Db_TimeCardLogService_Create_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *db_TimeCardDevServiceClient) Get(ctx context.Context, in *Db_GetTimeCardRequest, opts ...grpc.CallOption) (*Db_TimeCardResponse, error) {
+func (c *db_TimeCardLogServiceClient) Get(ctx context.Context, in *Db_GetTimeCardLogRequest, opts ...grpc.CallOption) (*Db_TimeCardLogResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Db_TimeCardResponse)
-	err := c.cc.Invoke(ctx, Db_TimeCardDevService_Get_FullMethodName, in, out, cOpts...)
+	out := new(Db_TimeCardLogResponse)
+	err := c.cc.Invoke(ctx, Db_TimeCardLogService_Get_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *db_TimeCardDevServiceClient) Update(ctx context.Context, in *Db_UpdateTimeCardRequest, opts ...grpc.CallOption) (*Db_TimeCardResponse, error) {
+func (c *db_TimeCardLogServiceClient) Update(ctx context.Context, in *Db_UpdateTimeCardLogRequest, opts ...grpc.CallOption) (*Db_TimeCardLogResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Db_TimeCardResponse)
-	err := c.cc.Invoke(ctx, Db_TimeCardDevService_Update_FullMethodName, in, out, cOpts...)
+	out := new(Db_TimeCardLogResponse)
+	err := c.cc.Invoke(ctx, Db_TimeCardLogService_Update_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *db_TimeCardDevServiceClient) Delete(ctx context.Context, in *Db_DeleteTimeCardRequest, opts ...grpc.CallOption) (*Db_Empty, error) {
+func (c *db_TimeCardLogServiceClient) Delete(ctx context.Context, in *Db_DeleteTimeCardLogRequest, opts ...grpc.CallOption) (*Db_Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Db_Empty)
-	err := c.cc.Invoke(ctx, Db_TimeCardDevService_Delete_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, Db_TimeCardLogService_Delete_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *db_TimeCardDevServiceClient) List(ctx context.Context, in *Db_ListTimeCardRequest, opts ...grpc.CallOption) (*Db_ListTimeCardResponse, error) {
+func (c *db_TimeCardLogServiceClient) List(ctx context.Context, in *Db_ListTimeCardLogRequest, opts ...grpc.CallOption) (*Db_ListTimeCardLogResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Db_ListTimeCardResponse)
-	err := c.cc.Invoke(ctx, Db_TimeCardDevService_List_FullMethodName, in, out, cOpts...)
+	out := new(Db_ListTimeCardLogResponse)
+	err := c.cc.Invoke(ctx, Db_TimeCardLogService_List_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// Db_TimeCardDevServiceServer is the server API for Db_TimeCardDevService service.
-// All implementations should embed UnimplementedDb_TimeCardDevServiceServer
+func (c *db_TimeCardLogServiceClient) GetByCardID(ctx context.Context, in *Db_GetByCardIDRequest, opts ...grpc.CallOption) (*Db_ListTimeCardLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Db_ListTimeCardLogResponse)
+	err := c.cc.Invoke(ctx, Db_TimeCardLogService_GetByCardID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Db_TimeCardLogServiceServer is the server API for Db_TimeCardLogService service.
+// All implementations should embed UnimplementedDb_TimeCardLogServiceServer
 // for forward compatibility.
 //
-// TimeCardDevサービス - タイムカードデータ管理（ローカルDB、読み書き可能）
-type Db_TimeCardDevServiceServer interface {
-	// タイムカードデータ作成
-	Create(context.Context, *Db_CreateTimeCardRequest) (*Db_TimeCardResponse, error)
-	// タイムカードデータ取得（複合主キー: datetime + id）
-	Get(context.Context, *Db_GetTimeCardRequest) (*Db_TimeCardResponse, error)
-	// タイムカードデータ更新
-	Update(context.Context, *Db_UpdateTimeCardRequest) (*Db_TimeCardResponse, error)
-	// タイムカードデータ削除
-	Delete(context.Context, *Db_DeleteTimeCardRequest) (*Db_Empty, error)
-	// タイムカードデータ一覧取得
-	List(context.Context, *Db_ListTimeCardRequest) (*Db_ListTimeCardResponse, error)
+// db_TimeCardLogServiceサービス - タイムカードログデータ管理（ローカルDB）
+type Db_TimeCardLogServiceServer interface {
+	// タイムカードログ作成
+	Create(context.Context, *Db_CreateTimeCardLogRequest) (*Db_TimeCardLogResponse, error)
+	// タイムカードログ取得（複合主キー: datetime + id）
+	Get(context.Context, *Db_GetTimeCardLogRequest) (*Db_TimeCardLogResponse, error)
+	// タイムカードログ更新
+	Update(context.Context, *Db_UpdateTimeCardLogRequest) (*Db_TimeCardLogResponse, error)
+	// タイムカードログ削除
+	Delete(context.Context, *Db_DeleteTimeCardLogRequest) (*Db_Empty, error)
+	// タイムカードログ一覧取得
+	List(context.Context, *Db_ListTimeCardLogRequest) (*Db_ListTimeCardLogResponse, error)
+	// カードIDでタイムカードログ取得
+	GetByCardID(context.Context, *Db_GetByCardIDRequest) (*Db_ListTimeCardLogResponse, error)
 }
 
-// UnimplementedDb_TimeCardDevServiceServer should be embedded to have
+// UnimplementedDb_TimeCardLogServiceServer should be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedDb_TimeCardDevServiceServer struct{}
+type UnimplementedDb_TimeCardLogServiceServer struct{}
 
-func (UnimplementedDb_TimeCardDevServiceServer) Create(context.Context, *Db_CreateTimeCardRequest) (*Db_TimeCardResponse, error) {
+func (UnimplementedDb_TimeCardLogServiceServer) Create(context.Context, *Db_CreateTimeCardLogRequest) (*Db_TimeCardLogResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 }
-func (UnimplementedDb_TimeCardDevServiceServer) Get(context.Context, *Db_GetTimeCardRequest) (*Db_TimeCardResponse, error) {
+func (UnimplementedDb_TimeCardLogServiceServer) Get(context.Context, *Db_GetTimeCardLogRequest) (*Db_TimeCardLogResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
-func (UnimplementedDb_TimeCardDevServiceServer) Update(context.Context, *Db_UpdateTimeCardRequest) (*Db_TimeCardResponse, error) {
+func (UnimplementedDb_TimeCardLogServiceServer) Update(context.Context, *Db_UpdateTimeCardLogRequest) (*Db_TimeCardLogResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
-func (UnimplementedDb_TimeCardDevServiceServer) Delete(context.Context, *Db_DeleteTimeCardRequest) (*Db_Empty, error) {
+func (UnimplementedDb_TimeCardLogServiceServer) Delete(context.Context, *Db_DeleteTimeCardLogRequest) (*Db_Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
-func (UnimplementedDb_TimeCardDevServiceServer) List(context.Context, *Db_ListTimeCardRequest) (*Db_ListTimeCardResponse, error) {
+func (UnimplementedDb_TimeCardLogServiceServer) List(context.Context, *Db_ListTimeCardLogRequest) (*Db_ListTimeCardLogResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
-func (UnimplementedDb_TimeCardDevServiceServer) testEmbeddedByValue() {}
+func (UnimplementedDb_TimeCardLogServiceServer) GetByCardID(context.Context, *Db_GetByCardIDRequest) (*Db_ListTimeCardLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByCardID not implemented")
+}
+func (UnimplementedDb_TimeCardLogServiceServer) testEmbeddedByValue() {}
 
-// UnsafeDb_TimeCardDevServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to Db_TimeCardDevServiceServer will
+// UnsafeDb_TimeCardLogServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to Db_TimeCardLogServiceServer will
 // result in compilation errors.
-type UnsafeDb_TimeCardDevServiceServer interface {
-	mustEmbedUnimplementedDb_TimeCardDevServiceServer()
+type UnsafeDb_TimeCardLogServiceServer interface {
+	mustEmbedUnimplementedDb_TimeCardLogServiceServer()
 }
 
-func RegisterDb_TimeCardDevServiceServer(s grpc.ServiceRegistrar, srv Db_TimeCardDevServiceServer) {
-	// If the following call pancis, it indicates UnimplementedDb_TimeCardDevServiceServer was
+func RegisterDb_TimeCardLogServiceServer(s grpc.ServiceRegistrar, srv Db_TimeCardLogServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDb_TimeCardLogServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&Db_TimeCardDevService_ServiceDesc, srv)
+	s.RegisterService(&Db_TimeCardLogService_ServiceDesc, srv)
 }
 
-func _Db_TimeCardDevService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Db_CreateTimeCardRequest)
+func _Db_TimeCardLogService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_CreateTimeCardLogRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(Db_TimeCardDevServiceServer).Create(ctx, in)
+		return srv.(Db_TimeCardLogServiceServer).Create(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Db_TimeCardDevService_Create_FullMethodName,
+		FullMethod: Db_TimeCardLogService_Create_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(Db_TimeCardDevServiceServer).Create(ctx, req.(*Db_CreateTimeCardRequest))
+		return srv.(Db_TimeCardLogServiceServer).Create(ctx, req.(*Db_CreateTimeCardLogRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Db_TimeCardDevService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Db_GetTimeCardRequest)
+func _Db_TimeCardLogService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_GetTimeCardLogRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(Db_TimeCardDevServiceServer).Get(ctx, in)
+		return srv.(Db_TimeCardLogServiceServer).Get(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Db_TimeCardDevService_Get_FullMethodName,
+		FullMethod: Db_TimeCardLogService_Get_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(Db_TimeCardDevServiceServer).Get(ctx, req.(*Db_GetTimeCardRequest))
+		return srv.(Db_TimeCardLogServiceServer).Get(ctx, req.(*Db_GetTimeCardLogRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Db_TimeCardDevService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Db_UpdateTimeCardRequest)
+func _Db_TimeCardLogService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_UpdateTimeCardLogRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(Db_TimeCardDevServiceServer).Update(ctx, in)
+		return srv.(Db_TimeCardLogServiceServer).Update(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Db_TimeCardDevService_Update_FullMethodName,
+		FullMethod: Db_TimeCardLogService_Update_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(Db_TimeCardDevServiceServer).Update(ctx, req.(*Db_UpdateTimeCardRequest))
+		return srv.(Db_TimeCardLogServiceServer).Update(ctx, req.(*Db_UpdateTimeCardLogRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Db_TimeCardDevService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Db_DeleteTimeCardRequest)
+func _Db_TimeCardLogService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_DeleteTimeCardLogRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(Db_TimeCardDevServiceServer).Delete(ctx, in)
+		return srv.(Db_TimeCardLogServiceServer).Delete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Db_TimeCardDevService_Delete_FullMethodName,
+		FullMethod: Db_TimeCardLogService_Delete_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(Db_TimeCardDevServiceServer).Delete(ctx, req.(*Db_DeleteTimeCardRequest))
+		return srv.(Db_TimeCardLogServiceServer).Delete(ctx, req.(*Db_DeleteTimeCardLogRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Db_TimeCardDevService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Db_ListTimeCardRequest)
+func _Db_TimeCardLogService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_ListTimeCardLogRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(Db_TimeCardDevServiceServer).List(ctx, in)
+		return srv.(Db_TimeCardLogServiceServer).List(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Db_TimeCardDevService_List_FullMethodName,
+		FullMethod: Db_TimeCardLogService_List_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(Db_TimeCardDevServiceServer).List(ctx, req.(*Db_ListTimeCardRequest))
+		return srv.(Db_TimeCardLogServiceServer).List(ctx, req.(*Db_ListTimeCardLogRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// Db_TimeCardDevService_ServiceDesc is the grpc.ServiceDesc for Db_TimeCardDevService service.
+func _Db_TimeCardLogService_GetByCardID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_GetByCardIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Db_TimeCardLogServiceServer).GetByCardID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Db_TimeCardLogService_GetByCardID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Db_TimeCardLogServiceServer).GetByCardID(ctx, req.(*Db_GetByCardIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Db_TimeCardLogService_ServiceDesc is the grpc.ServiceDesc for Db_TimeCardLogService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var Db_TimeCardDevService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "db_service.db_TimeCardDevService",
-	HandlerType: (*Db_TimeCardDevServiceServer)(nil),
+var Db_TimeCardLogService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "db_service.db_TimeCardLogService",
+	HandlerType: (*Db_TimeCardLogServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "Create",
-			Handler:    _Db_TimeCardDevService_Create_Handler,
+			Handler:    _Db_TimeCardLogService_Create_Handler,
 		},
 		{
 			MethodName: "Get",
-			Handler:    _Db_TimeCardDevService_Get_Handler,
+			Handler:    _Db_TimeCardLogService_Get_Handler,
 		},
 		{
 			MethodName: "Update",
-			Handler:    _Db_TimeCardDevService_Update_Handler,
+			Handler:    _Db_TimeCardLogService_Update_Handler,
 		},
 		{
 			MethodName: "Delete",
-			Handler:    _Db_TimeCardDevService_Delete_Handler,
+			Handler:    _Db_TimeCardLogService_Delete_Handler,
 		},
 		{
 			MethodName: "List",
-			Handler:    _Db_TimeCardDevService_List_Handler,
+			Handler:    _Db_TimeCardLogService_List_Handler,
+		},
+		{
+			MethodName: "GetByCardID",
+			Handler:    _Db_TimeCardLogService_GetByCardID_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -3557,305 +5604,531 @@ var Db_TimeCardDevService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	Db_TimeCardLogService_Create_FullMethodName      = "/db_service.db_TimeCardLogService/Create"
-	Db_TimeCardLogService_Get_FullMethodName         = "/db_service.db_TimeCardLogService/Get"
-	Db_TimeCardLogService_Update_FullMethodName      = "/db_service.db_TimeCardLogService/Update"
-	Db_TimeCardLogService_Delete_FullMethodName      = "/db_service.db_TimeCardLogService/Delete"
-	Db_TimeCardLogService_List_FullMethodName        = "/db_service.db_TimeCardLogService/List"
-	Db_TimeCardLogService_GetByCardID_FullMethodName = "/db_service.db_TimeCardLogService/GetByCardID"
+	Db_EmployeeIdentityService_Create_FullMethodName         = "/db_service.db_EmployeeIdentityService/Create"
+	Db_EmployeeIdentityService_Get_FullMethodName            = "/db_service.db_EmployeeIdentityService/Get"
+	Db_EmployeeIdentityService_Update_FullMethodName         = "/db_service.db_EmployeeIdentityService/Update"
+	Db_EmployeeIdentityService_Delete_FullMethodName         = "/db_service.db_EmployeeIdentityService/Delete"
+	Db_EmployeeIdentityService_List_FullMethodName           = "/db_service.db_EmployeeIdentityService/List"
+	Db_EmployeeIdentityService_Resolve_FullMethodName        = "/db_service.db_EmployeeIdentityService/Resolve"
+	Db_EmployeeIdentityService_RegisterCard_FullMethodName   = "/db_service.db_EmployeeIdentityService/RegisterCard"
+	Db_EmployeeIdentityService_UnregisterCard_FullMethodName = "/db_service.db_EmployeeIdentityService/UnregisterCard"
+	Db_EmployeeIdentityService_ListUnlinked_FullMethodName   = "/db_service.db_EmployeeIdentityService/ListUnlinked"
 )
 
-// Db_TimeCardLogServiceClient is the client API for Db_TimeCardLogService service.
+// Db_EmployeeIdentityServiceClient is the client API for Db_EmployeeIdentityService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 //
-// db_TimeCardLogServiceサービス - タイムカードログデータ管理（ローカルDB）
-type Db_TimeCardLogServiceClient interface {
-	// タイムカードログ作成
-	Create(ctx context.Context, in *Db_CreateTimeCardLogRequest, opts ...grpc.CallOption) (*Db_TimeCardLogResponse, error)
-	// タイムカードログ取得（複合主キー: datetime + id）
-	Get(ctx context.Context, in *Db_GetTimeCardLogRequest, opts ...grpc.CallOption) (*Db_TimeCardLogResponse, error)
-	// タイムカードログ更新
-	Update(ctx context.Context, in *Db_UpdateTimeCardLogRequest, opts ...grpc.CallOption) (*Db_TimeCardLogResponse, error)
-	// タイムカードログ削除
-	Delete(ctx context.Context, in *Db_DeleteTimeCardLogRequest, opts ...grpc.CallOption) (*Db_Empty, error)
-	// タイムカードログ一覧取得
-	List(ctx context.Context, in *Db_ListTimeCardLogRequest, opts ...grpc.CallOption) (*Db_ListTimeCardLogResponse, error)
-	// カードIDでタイムカードログ取得
-	GetByCardID(ctx context.Context, in *Db_GetByCardIDRequest, opts ...grpc.CallOption) (*Db_ListTimeCardLogResponse, error)
-}
-
-type db_TimeCardLogServiceClient struct {
+// EmployeeIdentityサービス - 社員の識別子の対応表（ローカルDB、本番DB・SQL Serverの社員コードと突き合わせ）
+type Db_EmployeeIdentityServiceClient interface {
+	// 社員作成（社員コードが別の社員に登録済みの場合はALREADY_EXISTS）
+	Create(ctx context.Context, in *Db_CreateEmployeeIdentityRequest, opts ...grpc.CallOption) (*Db_EmployeeIdentityResponse, error)
+	// 社員取得
+	Get(ctx context.Context, in *Db_GetEmployeeIdentityRequest, opts ...grpc.CallOption) (*Db_EmployeeIdentityResponse, error)
+	// 社員更新（社員コードが別の社員に登録済みの場合はALREADY_EXISTS）
+	Update(ctx context.Context, in *Db_UpdateEmployeeIdentityRequest, opts ...grpc.CallOption) (*Db_EmployeeIdentityResponse, error)
+	// 社員と登録されているカードを削除
+	Delete(ctx context.Context, in *Db_DeleteEmployeeIdentityRequest, opts ...grpc.CallOption) (*Db_Empty, error)
+	// 社員一覧取得
+	List(ctx context.Context, in *Db_ListEmployeeIdentityRequest, opts ...grpc.CallOption) (*Db_ListEmployeeIdentityResponse, error)
+	// 社員コードのいずれか1つから社員を取得
+	Resolve(ctx context.Context, in *Db_ResolveEmployeeIdentityRequest, opts ...grpc.CallOption) (*Db_ResolveEmployeeIdentityResponse, error)
+	// 社員にFeliCaカードを登録（別の社員に登録済みの場合はALREADY_EXISTS、同じ社員に登録済みの場合は登録済みのカードを返す）
+	RegisterCard(ctx context.Context, in *Db_RegisterEmployeeCardRequest, opts ...grpc.CallOption) (*Db_EmployeeCardResponse, error)
+	// カードの登録を削除
+	UnregisterCard(ctx context.Context, in *Db_UnregisterEmployeeCardRequest, opts ...grpc.CallOption) (*Db_Empty, error)
+	// 対応表に登録されていない社員コードを報告
+	ListUnlinked(ctx context.Context, in *Db_ListUnlinkedEmployeeCodesRequest, opts ...grpc.CallOption) (*Db_ListUnlinkedEmployeeCodesResponse, error)
+}
+
+type db_EmployeeIdentityServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewDb_TimeCardLogServiceClient(cc grpc.ClientConnInterface) Db_TimeCardLogServiceClient {
-	return &db_TimeCardLogServiceClient{cc}
+func NewDb_EmployeeIdentityServiceClient(cc grpc.ClientConnInterface) Db_EmployeeIdentityServiceClient {
+	return &db_EmployeeIdentityServiceClient{cc}
 }
 
-func (c *db_TimeCardLogServiceClient) Create(ctx context.Context, in *Db_CreateTimeCardLogRequest, opts ...grpc.CallOption) (*Db_TimeCardLogResponse, error) {
+func (c *db_EmployeeIdentityServiceClient) Create(ctx context.Context, in *Db_CreateEmployeeIdentityRequest, opts ...grpc.CallOption) (*Db_EmployeeIdentityResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Db_TimeCardLogResponse)
-	err := c.cc.Invoke(ctx, Db_TimeCardLogService_Create_FullMethodName, in, out, cOpts...)
+	out := new(Db_EmployeeIdentityResponse)
+	err := c.cc.Invoke(ctx, Db_EmployeeIdentityService_Create_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *db_TimeCardLogServiceClient) Get(ctx context.Context, in *Db_GetTimeCardLogRequest, opts ...grpc.CallOption) (*Db_TimeCardLogResponse, error) {
+func (c *db_EmployeeIdentityServiceClient) Get(ctx context.Context, in *Db_GetEmployeeIdentityRequest, opts ...grpc.CallOption) (*Db_EmployeeIdentityResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Db_TimeCardLogResponse)
-	err := c.cc.Invoke(ctx, Db_TimeCardLogService_Get_FullMethodName, in, out, cOpts...)
+	out := new(Db_EmployeeIdentityResponse)
+	err := c.cc.Invoke(ctx, Db_EmployeeIdentityService_Get_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *db_TimeCardLogServiceClient) Update(ctx context.Context, in *Db_UpdateTimeCardLogRequest, opts ...grpc.CallOption) (*Db_TimeCardLogResponse, error) {
+func (c *db_EmployeeIdentityServiceClient) Update(ctx context.Context, in *Db_UpdateEmployeeIdentityRequest, opts ...grpc.CallOption) (*Db_EmployeeIdentityResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Db_TimeCardLogResponse)
-	err := c.cc.Invoke(ctx, Db_TimeCardLogService_Update_FullMethodName, in, out, cOpts...)
+	out := new(Db_EmployeeIdentityResponse)
+	err := c.cc.Invoke(ctx, Db_EmployeeIdentityService_Update_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *db_TimeCardLogServiceClient) Delete(ctx context.Context, in *Db_DeleteTimeCardLogRequest, opts ...grpc.CallOption) (*Db_Empty, error) {
+func (c *db_EmployeeIdentityServiceClient) Delete(ctx context.Context, in *Db_DeleteEmployeeIdentityRequest, opts ...grpc.CallOption) (*Db_Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Db_Empty)
-	err := c.cc.Invoke(ctx, Db_TimeCardLogService_Delete_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, Db_EmployeeIdentityService_Delete_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *db_TimeCardLogServiceClient) List(ctx context.Context, in *Db_ListTimeCardLogRequest, opts ...grpc.CallOption) (*Db_ListTimeCardLogResponse, error) {
+func (c *db_EmployeeIdentityServiceClient) List(ctx context.Context, in *Db_ListEmployeeIdentityRequest, opts ...grpc.CallOption) (*Db_ListEmployeeIdentityResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Db_ListTimeCardLogResponse)
-	err := c.cc.Invoke(ctx, Db_TimeCardLogService_List_FullMethodName, in, out, cOpts...)
+	out := new(Db_ListEmployeeIdentityResponse)
+	err := c.cc.Invoke(ctx, Db_EmployeeIdentityService_List_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *db_TimeCardLogServiceClient) GetByCardID(ctx context.Context, in *Db_GetByCardIDRequest, opts ...grpc.CallOption) (*Db_ListTimeCardLogResponse, error) {
+func (c *db_EmployeeIdentityServiceClient) Resolve(ctx context.Context, in *Db_ResolveEmployeeIdentityRequest, opts ...grpc.CallOption) (*Db_ResolveEmployeeIdentityResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Db_ListTimeCardLogResponse)
-	err := c.cc.Invoke(ctx, Db_TimeCardLogService_GetByCardID_FullMethodName, in, out, cOpts...)
+	out := new(Db_ResolveEmployeeIdentityResponse)
+	err := c.cc.Invoke(ctx, Db_EmployeeIdentityService_Resolve_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// Db_TimeCardLogServiceServer is the server API for Db_TimeCardLogService service.
-// All implementations should embed UnimplementedDb_TimeCardLogServiceServer
-// for forward compatibility.
-//
-// db_TimeCardLogServiceサービス - タイムカードログデータ管理（ローカルDB）
-type Db_TimeCardLogServiceServer interface {
-	// タイムカードログ作成
-	Create(context.Context, *Db_CreateTimeCardLogRequest) (*Db_TimeCardLogResponse, error)
-	// タイムカードログ取得（複合主キー: datetime + id）
-	Get(context.Context, *Db_GetTimeCardLogRequest) (*Db_TimeCardLogResponse, error)
-	// タイムカードログ更新
-	Update(context.Context, *Db_UpdateTimeCardLogRequest) (*Db_TimeCardLogResponse, error)
-	// タイムカードログ削除
-	Delete(context.Context, *Db_DeleteTimeCardLogRequest) (*Db_Empty, error)
-	// タイムカードログ一覧取得
-	List(context.Context, *Db_ListTimeCardLogRequest) (*Db_ListTimeCardLogResponse, error)
-	// カードIDでタイムカードログ取得
-	GetByCardID(context.Context, *Db_GetByCardIDRequest) (*Db_ListTimeCardLogResponse, error)
+func (c *db_EmployeeIdentityServiceClient) RegisterCard(ctx context.Context, in *Db_RegisterEmployeeCardRequest, opts ...grpc.CallOption) (*Db_EmployeeCardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Db_EmployeeCardResponse)
+	err := c.cc.Invoke(ctx, Db_EmployeeIdentityService_RegisterCard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedDb_TimeCardLogServiceServer should be embedded to have
+func (c *db_EmployeeIdentityServiceClient) UnregisterCard(ctx context.Context, in *Db_UnregisterEmployeeCardRequest, opts ...grpc.CallOption) (*Db_Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Db_Empty)
+	err := c.cc.Invoke(ctx, Db_EmployeeIdentityService_UnregisterCard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *db_EmployeeIdentityServiceClient) ListUnlinked(ctx context.Context, in *Db_ListUnlinkedEmployeeCodesRequest, opts ...grpc.CallOption) (*Db_ListUnlinkedEmployeeCodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Db_ListUnlinkedEmployeeCodesResponse)
+	err := c.cc.Invoke(ctx, Db_EmployeeIdentityService_ListUnlinked_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Db_EmployeeIdentityServiceServer is the server API for Db_EmployeeIdentityService service.
+// All implementations should embed UnimplementedDb_EmployeeIdentityServiceServer
+// for forward compatibility.
+//
+// EmployeeIdentityサービス - 社員の識別子の対応表（ローカルDB、本番DB・SQL Serverの社員コードと突き合わせ）
+type Db_EmployeeIdentityServiceServer interface {
+	// 社員作成（社員コードが別の社員に登録済みの場合はALREADY_EXISTS）
+	Create(context.Context, *Db_CreateEmployeeIdentityRequest) (*Db_EmployeeIdentityResponse, error)
+	// 社員取得
+	Get(context.Context, *Db_GetEmployeeIdentityRequest) (*Db_EmployeeIdentityResponse, error)
+	// 社員更新（社員コードが別の社員に登録済みの場合はALREADY_EXISTS）
+	Update(context.Context, *Db_UpdateEmployeeIdentityRequest) (*Db_EmployeeIdentityResponse, error)
+	// 社員と登録されているカードを削除
+	Delete(context.Context, *Db_DeleteEmployeeIdentityRequest) (*Db_Empty, error)
+	// 社員一覧取得
+	List(context.Context, *Db_ListEmployeeIdentityRequest) (*Db_ListEmployeeIdentityResponse, error)
+	// 社員コードのいずれか1つから社員を取得
+	Resolve(context.Context, *Db_ResolveEmployeeIdentityRequest) (*Db_ResolveEmployeeIdentityResponse, error)
+	// 社員にFeliCaカードを登録（別の社員に登録済みの場合はALREADY_EXISTS、同じ社員に登録済みの場合は登録済みのカードを返す）
+	RegisterCard(context.Context, *Db_RegisterEmployeeCardRequest) (*Db_EmployeeCardResponse, error)
+	// カードの登録を削除
+	UnregisterCard(context.Context, *Db_UnregisterEmployeeCardRequest) (*Db_Empty, error)
+	// 対応表に登録されていない社員コードを報告
+	ListUnlinked(context.Context, *Db_ListUnlinkedEmployeeCodesRequest) (*Db_ListUnlinkedEmployeeCodesResponse, error)
+}
+
+// UnimplementedDb_EmployeeIdentityServiceServer should be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedDb_TimeCardLogServiceServer struct{}
+type UnimplementedDb_EmployeeIdentityServiceServer struct{}
 
-func (UnimplementedDb_TimeCardLogServiceServer) Create(context.Context, *Db_CreateTimeCardLogRequest) (*Db_TimeCardLogResponse, error) {
+func (UnimplementedDb_EmployeeIdentityServiceServer) Create(context.Context, *Db_CreateEmployeeIdentityRequest) (*Db_EmployeeIdentityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 }
-func (UnimplementedDb_TimeCardLogServiceServer) Get(context.Context, *Db_GetTimeCardLogRequest) (*Db_TimeCardLogResponse, error) {
+func (UnimplementedDb_EmployeeIdentityServiceServer) Get(context.Context, *Db_GetEmployeeIdentityRequest) (*Db_EmployeeIdentityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
-func (UnimplementedDb_TimeCardLogServiceServer) Update(context.Context, *Db_UpdateTimeCardLogRequest) (*Db_TimeCardLogResponse, error) {
+func (UnimplementedDb_EmployeeIdentityServiceServer) Update(context.Context, *Db_UpdateEmployeeIdentityRequest) (*Db_EmployeeIdentityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
-func (UnimplementedDb_TimeCardLogServiceServer) Delete(context.Context, *Db_DeleteTimeCardLogRequest) (*Db_Empty, error) {
+func (UnimplementedDb_EmployeeIdentityServiceServer) Delete(context.Context, *Db_DeleteEmployeeIdentityRequest) (*Db_Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
-func (UnimplementedDb_TimeCardLogServiceServer) List(context.Context, *Db_ListTimeCardLogRequest) (*Db_ListTimeCardLogResponse, error) {
+func (UnimplementedDb_EmployeeIdentityServiceServer) List(context.Context, *Db_ListEmployeeIdentityRequest) (*Db_ListEmployeeIdentityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
-func (UnimplementedDb_TimeCardLogServiceServer) GetByCardID(context.Context, *Db_GetByCardIDRequest) (*Db_ListTimeCardLogResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetByCardID not implemented")
+func (UnimplementedDb_EmployeeIdentityServiceServer) Resolve(context.Context, *Db_ResolveEmployeeIdentityRequest) (*Db_ResolveEmployeeIdentityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Resolve not implemented")
 }
-func (UnimplementedDb_TimeCardLogServiceServer) testEmbeddedByValue() {}
+func (UnimplementedDb_EmployeeIdentityServiceServer) RegisterCard(context.Context, *Db_RegisterEmployeeCardRequest) (*Db_EmployeeCardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterCard not implemented")
+}
+func (UnimplementedDb_EmployeeIdentityServiceServer) UnregisterCard(context.Context, *Db_UnregisterEmployeeCardRequest) (*Db_Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterCard not implemented")
+}
+func (UnimplementedDb_EmployeeIdentityServiceServer) ListUnlinked(context.Context, *Db_ListUnlinkedEmployeeCodesRequest) (*Db_ListUnlinkedEmployeeCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUnlinked not implemented")
+}
+func (UnimplementedDb_EmployeeIdentityServiceServer) testEmbeddedByValue() {}
 
-// UnsafeDb_TimeCardLogServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to Db_TimeCardLogServiceServer will
+// UnsafeDb_EmployeeIdentityServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to Db_EmployeeIdentityServiceServer will
 // result in compilation errors.
-type UnsafeDb_TimeCardLogServiceServer interface {
-	mustEmbedUnimplementedDb_TimeCardLogServiceServer()
+type UnsafeDb_EmployeeIdentityServiceServer interface {
+	mustEmbedUnimplementedDb_EmployeeIdentityServiceServer()
 }
 
-func RegisterDb_TimeCardLogServiceServer(s grpc.ServiceRegistrar, srv Db_TimeCardLogServiceServer) {
-	// If the following call pancis, it indicates UnimplementedDb_TimeCardLogServiceServer was
+func RegisterDb_EmployeeIdentityServiceServer(s grpc.ServiceRegistrar, srv Db_EmployeeIdentityServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDb_EmployeeIdentityServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&Db_TimeCardLogService_ServiceDesc, srv)
+	s.RegisterService(&Db_EmployeeIdentityService_ServiceDesc, srv)
 }
 
-func _Db_TimeCardLogService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Db_CreateTimeCardLogRequest)
+func _Db_EmployeeIdentityService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_CreateEmployeeIdentityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(Db_TimeCardLogServiceServer).Create(ctx, in)
+		return srv.(Db_EmployeeIdentityServiceServer).Create(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Db_TimeCardLogService_Create_FullMethodName,
+		FullMethod: Db_EmployeeIdentityService_Create_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(Db_TimeCardLogServiceServer).Create(ctx, req.(*Db_CreateTimeCardLogRequest))
+		return srv.(Db_EmployeeIdentityServiceServer).Create(ctx, req.(*Db_CreateEmployeeIdentityRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Db_TimeCardLogService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Db_GetTimeCardLogRequest)
+func _Db_EmployeeIdentityService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_GetEmployeeIdentityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(Db_TimeCardLogServiceServer).Get(ctx, in)
+		return srv.(Db_EmployeeIdentityServiceServer).Get(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Db_TimeCardLogService_Get_FullMethodName,
+		FullMethod: Db_EmployeeIdentityService_Get_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(Db_TimeCardLogServiceServer).Get(ctx, req.(*Db_GetTimeCardLogRequest))
+		return srv.(Db_EmployeeIdentityServiceServer).Get(ctx, req.(*Db_GetEmployeeIdentityRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Db_TimeCardLogService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Db_UpdateTimeCardLogRequest)
+func _Db_EmployeeIdentityService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_UpdateEmployeeIdentityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(Db_TimeCardLogServiceServer).Update(ctx, in)
+		return srv.(Db_EmployeeIdentityServiceServer).Update(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Db_TimeCardLogService_Update_FullMethodName,
+		FullMethod: Db_EmployeeIdentityService_Update_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(Db_TimeCardLogServiceServer).Update(ctx, req.(*Db_UpdateTimeCardLogRequest))
+		return srv.(Db_EmployeeIdentityServiceServer).Update(ctx, req.(*Db_UpdateEmployeeIdentityRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Db_TimeCardLogService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Db_DeleteTimeCardLogRequest)
+func _Db_EmployeeIdentityService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_DeleteEmployeeIdentityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(Db_TimeCardLogServiceServer).Delete(ctx, in)
+		return srv.(Db_EmployeeIdentityServiceServer).Delete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Db_TimeCardLogService_Delete_FullMethodName,
+		FullMethod: Db_EmployeeIdentityService_Delete_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(Db_TimeCardLogServiceServer).Delete(ctx, req.(*Db_DeleteTimeCardLogRequest))
+		return srv.(Db_EmployeeIdentityServiceServer).Delete(ctx, req.(*Db_DeleteEmployeeIdentityRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Db_TimeCardLogService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Db_ListTimeCardLogRequest)
+func _Db_EmployeeIdentityService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_ListEmployeeIdentityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(Db_TimeCardLogServiceServer).List(ctx, in)
+		return srv.(Db_EmployeeIdentityServiceServer).List(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Db_TimeCardLogService_List_FullMethodName,
+		FullMethod: Db_EmployeeIdentityService_List_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(Db_TimeCardLogServiceServer).List(ctx, req.(*Db_ListTimeCardLogRequest))
+		return srv.(Db_EmployeeIdentityServiceServer).List(ctx, req.(*Db_ListEmployeeIdentityRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Db_TimeCardLogService_GetByCardID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Db_GetByCardIDRequest)
+func _Db_EmployeeIdentityService_Resolve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_ResolveEmployeeIdentityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(Db_TimeCardLogServiceServer).GetByCardID(ctx, in)
+		return srv.(Db_EmployeeIdentityServiceServer).Resolve(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Db_TimeCardLogService_GetByCardID_FullMethodName,
+		FullMethod: Db_EmployeeIdentityService_Resolve_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(Db_TimeCardLogServiceServer).GetByCardID(ctx, req.(*Db_GetByCardIDRequest))
+		return srv.(Db_EmployeeIdentityServiceServer).Resolve(ctx, req.(*Db_ResolveEmployeeIdentityRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// Db_TimeCardLogService_ServiceDesc is the grpc.ServiceDesc for Db_TimeCardLogService service.
+func _Db_EmployeeIdentityService_RegisterCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_RegisterEmployeeCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Db_EmployeeIdentityServiceServer).RegisterCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Db_EmployeeIdentityService_RegisterCard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Db_EmployeeIdentityServiceServer).RegisterCard(ctx, req.(*Db_RegisterEmployeeCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Db_EmployeeIdentityService_UnregisterCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_UnregisterEmployeeCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Db_EmployeeIdentityServiceServer).UnregisterCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Db_EmployeeIdentityService_UnregisterCard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Db_EmployeeIdentityServiceServer).UnregisterCard(ctx, req.(*Db_UnregisterEmployeeCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Db_EmployeeIdentityService_ListUnlinked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_ListUnlinkedEmployeeCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Db_EmployeeIdentityServiceServer).ListUnlinked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Db_EmployeeIdentityService_ListUnlinked_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Db_EmployeeIdentityServiceServer).ListUnlinked(ctx, req.(*Db_ListUnlinkedEmployeeCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Db_EmployeeIdentityService_ServiceDesc is the grpc.ServiceDesc for Db_EmployeeIdentityService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var Db_TimeCardLogService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "db_service.db_TimeCardLogService",
-	HandlerType: (*Db_TimeCardLogServiceServer)(nil),
+var Db_EmployeeIdentityService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "db_service.db_EmployeeIdentityService",
+	HandlerType: (*Db_EmployeeIdentityServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "Create",
-			Handler:    _Db_TimeCardLogService_Create_Handler,
+			Handler:    _Db_EmployeeIdentityService_Create_Handler,
 		},
 		{
 			MethodName: "Get",
-			Handler:    _Db_TimeCardLogService_Get_Handler,
+			Handler:    _Db_EmployeeIdentityService_Get_Handler,
 		},
 		{
 			MethodName: "Update",
-			Handler:    _Db_TimeCardLogService_Update_Handler,
+			Handler:    _Db_EmployeeIdentityService_Update_Handler,
 		},
 		{
 			MethodName: "Delete",
-			Handler:    _Db_TimeCardLogService_Delete_Handler,
+			Handler:    _Db_EmployeeIdentityService_Delete_Handler,
 		},
 		{
 			MethodName: "List",
-			Handler:    _Db_TimeCardLogService_List_Handler,
+			Handler:    _Db_EmployeeIdentityService_List_Handler,
 		},
 		{
-			MethodName: "GetByCardID",
-			Handler:    _Db_TimeCardLogService_GetByCardID_Handler,
+			MethodName: "Resolve",
+			Handler:    _Db_EmployeeIdentityService_Resolve_Handler,
+		},
+		{
+			MethodName: "RegisterCard",
+			Handler:    _Db_EmployeeIdentityService_RegisterCard_Handler,
+		},
+		{
+			MethodName: "UnregisterCard",
+			Handler:    _Db_EmployeeIdentityService_UnregisterCard_Handler,
+		},
+		{
+			MethodName: "ListUnlinked",
+			Handler:    _Db_EmployeeIdentityService_ListUnlinked_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "db_service.proto",
+}
+
+const (
+	Db_RegistryService_GetAvailability_FullMethodName = "/db_service.db_RegistryService/GetAvailability"
+)
+
+// Db_RegistryServiceClient is the client API for Db_RegistryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// db_RegistryServiceサービス - サービスレジストリの状態確認
+type Db_RegistryServiceClient interface {
+	// バックエンドDBごとの接続状態を取得
+	GetAvailability(ctx context.Context, in *Db_GetAvailabilityRequest, opts ...grpc.CallOption) (*Db_GetAvailabilityResponse, error)
+}
+
+type db_RegistryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDb_RegistryServiceClient(cc grpc.ClientConnInterface) Db_RegistryServiceClient {
+	return &db_RegistryServiceClient{cc}
+}
+
+func (c *db_RegistryServiceClient) GetAvailability(ctx context.Context, in *Db_GetAvailabilityRequest, opts ...grpc.CallOption) (*Db_GetAvailabilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Db_GetAvailabilityResponse)
+	err := c.cc.Invoke(ctx, Db_RegistryService_GetAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Db_RegistryServiceServer is the server API for Db_RegistryService service.
+// All implementations should embed UnimplementedDb_RegistryServiceServer
+// for forward compatibility.
+//
+// db_RegistryServiceサービス - サービスレジストリの状態確認
+type Db_RegistryServiceServer interface {
+	// バックエンドDBごとの接続状態を取得
+	GetAvailability(context.Context, *Db_GetAvailabilityRequest) (*Db_GetAvailabilityResponse, error)
+}
+
+// UnimplementedDb_RegistryServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDb_RegistryServiceServer struct{}
+
+func (UnimplementedDb_RegistryServiceServer) GetAvailability(context.Context, *Db_GetAvailabilityRequest) (*Db_GetAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailability not implemented")
+}
+func (UnimplementedDb_RegistryServiceServer) testEmbeddedByValue() {}
+
+// UnsafeDb_RegistryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to Db_RegistryServiceServer will
+// result in compilation errors.
+type UnsafeDb_RegistryServiceServer interface {
+	mustEmbedUnimplementedDb_RegistryServiceServer()
+}
+
+func RegisterDb_RegistryServiceServer(s grpc.ServiceRegistrar, srv Db_RegistryServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDb_RegistryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Db_RegistryService_ServiceDesc, srv)
+}
+
+func _Db_RegistryService_GetAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Db_GetAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Db_RegistryServiceServer).GetAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Db_RegistryService_GetAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Db_RegistryServiceServer).GetAvailability(ctx, req.(*Db_GetAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Db_RegistryService_ServiceDesc is the grpc.ServiceDesc for Db_RegistryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Db_RegistryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "db_service.db_RegistryService",
+	HandlerType: (*Db_RegistryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAvailability",
+			Handler:    _Db_RegistryService_GetAvailability_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -0,0 +1,108 @@
+package registry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/yhonda-ohishi/db_service/src/config"
+	dbproto "github.com/yhonda-ohishi/db_service/src/proto"
+	"gorm.io/gorm"
+)
+
+// バックエンド名
+const (
+	BackendLocal     = "local"
+	BackendProd      = "prod"
+	BackendSQLServer = "sqlserver"
+)
+
+// BackendStatus バックエンドDBの接続状態
+type BackendStatus struct {
+	Backend   string `json:"backend"`
+	Available bool   `json:"available"`
+	// Reason 接続できない場合の理由
+	Reason string `json:"reason,omitempty"`
+	// NotConfigured 接続情報が環境変数に設定されていない（使用しないバックエンド）
+	NotConfigured bool `json:"not_configured,omitempty"`
+}
+
+// AvailabilityReport バックエンドDBごとの接続状態
+type AvailabilityReport struct {
+	Local     BackendStatus
+	Prod      BackendStatus
+	SQLServer BackendStatus
+}
+
+// Backends 接続状態をlocal, prod, sqlserverの順で返す
+func (r AvailabilityReport) Backends() []BackendStatus {
+	return []BackendStatus{r.Local, r.Prod, r.SQLServer}
+}
+
+// newBackendStatus 接続結果からBackendStatusを作成
+func newBackendStatus(backend string, err error) BackendStatus {
+	if err != nil {
+		notConfigured := errors.Is(err, config.ErrProdDBNotConfigured) || errors.Is(err, config.ErrSQLServerNotConfigured)
+		return BackendStatus{Backend: backend, Reason: err.Error(), NotConfigured: notConfigured}
+	}
+	return BackendStatus{Backend: backend, Available: true}
+}
+
+// injectedPingTimeout 注入されたDB接続を初期化時に確認する際のタイムアウト
+const injectedPingTimeout = 3 * time.Second
+
+// pingInjectedDB 注入されたDB接続に接続できるか確認する
+// 接続できない場合もエラーにはせず、BackendStatusのReasonとして返す（ヘルスチェックの確認で回復する）
+func pingInjectedDB(db *gorm.DB) error {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), injectedPingTimeout)
+	defer cancel()
+	return sqlDB.PingContext(ctx)
+}
+
+// InitError レジストリ初期化エラー
+type InitError struct {
+	// Backend 失敗したバックエンド名
+	Backend string
+	// Op 失敗した処理（load config, connect, health check）
+	Op  string
+	Err error
+}
+
+func (e *InitError) Error() string {
+	return fmt.Sprintf("db_service: %s database %s failed: %v", e.Backend, e.Op, e.Err)
+}
+
+func (e *InitError) Unwrap() error {
+	return e.Err
+}
+
+// registryService サービスレジストリの状態を返すgRPCサービス
+type registryService struct {
+	dbproto.UnimplementedDb_RegistryServiceServer
+	registry *ServiceRegistry
+}
+
+// GetAvailability バックエンドDBごとの接続状態を取得
+func (s *registryService) GetAvailability(ctx context.Context, req *dbproto.Db_GetAvailabilityRequest) (*dbproto.Db_GetAvailabilityResponse, error) {
+	backends := s.registry.Availability().Backends()
+	resp := &dbproto.Db_GetAvailabilityResponse{
+		Backends: make([]*dbproto.Db_BackendStatus, 0, len(backends)),
+	}
+	for _, b := range backends {
+		status := &dbproto.Db_BackendStatus{
+			Backend:   b.Backend,
+			Available: b.Available,
+		}
+		if b.Reason != "" {
+			reason := b.Reason
+			status.Reason = &reason
+		}
+		resp.Backends = append(resp.Backends, status)
+	}
+	return resp, nil
+}
@@ -0,0 +1,79 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	dbproto "github.com/yhonda-ohishi/db_service/src/proto"
+	"google.golang.org/grpc"
+)
+
+// gatewayHandlerFunc gRPC-Gatewayハンドラー登録関数の型
+type gatewayHandlerFunc func(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error
+
+// gatewayHandlers gRPCサービス名とgRPC-Gatewayハンドラー登録関数の対応表
+// 新しいサービスを追加した場合はここにも追加する
+var gatewayHandlers = map[string]gatewayHandlerFunc{
+	// ローカルDB用サービス
+	dbproto.Db_ETCMeisaiService_ServiceDesc.ServiceName:        dbproto.RegisterDb_ETCMeisaiServiceHandler,
+	dbproto.Db_DTakoUriageKeihiService_ServiceDesc.ServiceName: dbproto.RegisterDb_DTakoUriageKeihiServiceHandler,
+	dbproto.Db_DTakoFerryRowsService_ServiceDesc.ServiceName:   dbproto.RegisterDb_DTakoFerryRowsServiceHandler,
+	dbproto.Db_ETCMeisaiMappingService_ServiceDesc.ServiceName: dbproto.RegisterDb_ETCMeisaiMappingServiceHandler,
+	dbproto.Db_TimeCardDevService_ServiceDesc.ServiceName:      dbproto.RegisterDb_TimeCardDevServiceHandler,
+	dbproto.Db_TimeCardLogService_ServiceDesc.ServiceName:      dbproto.RegisterDb_TimeCardLogServiceHandler,
+	dbproto.Db_EmployeeIdentityService_ServiceDesc.ServiceName: dbproto.RegisterDb_EmployeeIdentityServiceHandler,
+
+	// 本番DB用サービス
+	dbproto.Db_DTakoCarsService_ServiceDesc.ServiceName:          dbproto.RegisterDb_DTakoCarsServiceHandler,
+	dbproto.Db_DTakoEventsService_ServiceDesc.ServiceName:        dbproto.RegisterDb_DTakoEventsServiceHandler,
+	dbproto.Db_DTakoRowsService_ServiceDesc.ServiceName:          dbproto.RegisterDb_DTakoRowsServiceHandler,
+	dbproto.Db_ETCNumService_ServiceDesc.ServiceName:             dbproto.RegisterDb_ETCNumServiceHandler,
+	dbproto.Db_DTakoFerryRowsProdService_ServiceDesc.ServiceName: dbproto.RegisterDb_DTakoFerryRowsProdServiceHandler,
+	dbproto.Db_CarsService_ServiceDesc.ServiceName:               dbproto.RegisterDb_CarsServiceHandler,
+	dbproto.Db_DriversService_ServiceDesc.ServiceName:            dbproto.RegisterDb_DriversServiceHandler,
+	dbproto.Db_TimeCardService_ServiceDesc.ServiceName:           dbproto.RegisterDb_TimeCardServiceHandler,
+
+	// ローカルDB・本番DBを使用するサービス
+	dbproto.Db_ETCMeisaiMatcherService_ServiceDesc.ServiceName:      dbproto.RegisterDb_ETCMeisaiMatcherServiceHandler,
+	dbproto.Db_ETCMeisaiMappingAuditService_ServiceDesc.ServiceName: dbproto.RegisterDb_ETCMeisaiMappingAuditServiceHandler,
+	dbproto.Db_VehicleIdentityService_ServiceDesc.ServiceName:       dbproto.RegisterDb_VehicleIdentityServiceHandler,
+
+	// SQL Server (ichibanboshi) 用サービス
+	dbproto.Db_UntenNippoMeisaiService_ServiceDesc.ServiceName: dbproto.RegisterDb_UntenNippoMeisaiServiceHandler,
+	dbproto.Db_ShainMasterService_ServiceDesc.ServiceName:      dbproto.RegisterDb_ShainMasterServiceHandler,
+	dbproto.Db_ChiikiMasterService_ServiceDesc.ServiceName:     dbproto.RegisterDb_ChiikiMasterServiceHandler,
+	dbproto.Db_ChikuMasterService_ServiceDesc.ServiceName:      dbproto.RegisterDb_ChikuMasterServiceHandler,
+	dbproto.Db_TokuisakiMasterService_ServiceDesc.ServiceName:  dbproto.RegisterDb_TokuisakiMasterServiceHandler,
+	dbproto.Db_HinmeiMasterService_ServiceDesc.ServiceName:     dbproto.RegisterDb_HinmeiMasterServiceHandler,
+	dbproto.Db_BumonMasterService_ServiceDesc.ServiceName:      dbproto.RegisterDb_BumonMasterServiceHandler,
+	dbproto.Db_SharyoMasterService_ServiceDesc.ServiceName:     dbproto.RegisterDb_SharyoMasterServiceHandler,
+
+	// レジストリ状態確認サービス
+	dbproto.Db_RegistryService_ServiceDesc.ServiceName: dbproto.RegisterDb_RegistryServiceHandler,
+}
+
+// RegisterGateway gRPCサーバーに登録済みのdb_serviceサービスをgRPC-Gatewayに登録する
+// connは同じgRPCサーバーへの接続。REST呼び出しはconn経由でgRPCサーバーに転送されるため、
+// インターセプター等もgRPC呼び出しと同様に適用される
+//
+// Usage:
+//
+//	conn, _ := grpc.NewClient("localhost:50051", grpc.WithTransportCredentials(insecure.NewCredentials()))
+//	gwMux := runtime.NewServeMux()
+//	registry.RegisterGateway(ctx, gwMux, conn, grpcServer)
+func RegisterGateway(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn, server *grpc.Server) error {
+	for serviceName := range server.GetServiceInfo() {
+		register, ok := gatewayHandlers[serviceName]
+		if !ok {
+			// db_service以外のサービス（reflection等）は対象外
+			continue
+		}
+		if err := register(ctx, mux, conn); err != nil {
+			return fmt.Errorf("failed to register gateway handler for %s: %w", serviceName, err)
+		}
+		log.Printf("Gateway registered: %s", serviceName)
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package registry_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	dbproto "github.com/yhonda-ohishi/db_service/src/proto"
+	"github.com/yhonda-ohishi/db_service/src/registry"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+)
+
+// stubChiikiMasterService テスト用の地域マスタサービス
+type stubChiikiMasterService struct {
+	dbproto.UnimplementedDb_ChiikiMasterServiceServer
+}
+
+func (s *stubChiikiMasterService) List(ctx context.Context, req *dbproto.Db_ListChiikiMasterRequest) (*dbproto.Db_ListChiikiMasterResponse, error) {
+	name := "九州"
+	return &dbproto.Db_ListChiikiMasterResponse{
+		Items:      []*dbproto.Db_ChiikiMaster{{ChiikiC: "000001", ChiikiN: &name}},
+		TotalCount: proto.Int32(1),
+	}, nil
+}
+
+func TestRegisterGateway(t *testing.T) {
+	listener := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer()
+	dbproto.RegisterDb_ChiikiMasterServiceServer(grpcServer, &stubChiikiMasterService{})
+	go grpcServer.Serve(listener)
+	defer grpcServer.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer conn.Close()
+
+	mux := runtime.NewServeMux()
+	if err := registry.RegisterGateway(context.Background(), mux, conn, grpcServer); err != nil {
+		t.Fatalf("RegisterGateway failed: %v", err)
+	}
+
+	// 登録済みサービスはRESTで呼び出せる
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/db/chiiki-master", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if !strings.Contains(rec.Body.String(), "九州") {
+		t.Errorf("unexpected body: %s", rec.Body.String())
+	}
+
+	// 未登録サービスのルートは公開されない
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/db/cars", nil))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("expected 404 for unregistered service, got %d", rec.Code)
+	}
+}
+
+func TestRegisterAll_BackendUnavailable(t *testing.T) {
+	// 接続できないDBを注入し、Probeでバックエンドをダウン状態にする
+	reg, err := newInjectedRegistry(t)
+	if err != nil {
+		t.Fatalf("NewServiceRegistryE failed: %v", err)
+	}
+	reg.Probe()
+
+	listener := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer()
+	reg.RegisterAll(grpcServer)
+	go grpcServer.Serve(listener)
+	defer grpcServer.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer conn.Close()
+
+	// ダウン中のバックエンドを使用するサービスはUNAVAILABLEを返す
+	_, err = dbproto.NewDb_DTakoRowsServiceClient(conn).List(context.Background(), &dbproto.Db_ListDTakoRowsRequest{})
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("expected Unavailable, got %v", err)
+	}
+	_, err = dbproto.NewDb_ShainMasterServiceClient(conn).List(context.Background(), &dbproto.Db_ListShainMasterRequest{})
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("expected Unavailable, got %v", err)
+	}
+
+	// ストリーミングRPCも同様
+	stream, err := dbproto.NewDb_DTakoEventsServiceClient(conn).Stream(context.Background(), &dbproto.Db_StreamDTakoEventsRequest{})
+	if err != nil {
+		t.Fatalf("failed to open stream: %v", err)
+	}
+	if _, err := stream.Recv(); status.Code(err) != codes.Unavailable {
+		t.Errorf("expected Unavailable, got %v", err)
+	}
+
+	// DBを使用しないサービスは影響を受けない
+	if _, err := dbproto.NewDb_RegistryServiceClient(conn).GetAvailability(context.Background(), &dbproto.Db_GetAvailabilityRequest{}); err != nil {
+		t.Errorf("GetAvailability failed: %v", err)
+	}
+}
@@ -0,0 +1,197 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/yhonda-ohishi/db_service/src/config"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// DefaultHealthProbeInterval バックグラウンドヘルスチェックのデフォルト間隔
+const DefaultHealthProbeInterval = 30 * time.Second
+
+// ヘルスチェック全体の状態
+const (
+	HealthStatusOK       = "ok"
+	HealthStatusDegraded = "degraded"
+)
+
+// RegisterHealth grpc.health.v1 ヘルスチェックサービスを登録する
+// 各サービスの状態は使用するバックエンドDBの接続状態に連動する
+// （例: 本番DBに接続できない場合、db_DTakoRowsServiceはNOT_SERVING）
+//
+// Usage:
+//
+//	reg.RegisterAll(grpcServer)
+//	reg.RegisterHealth(grpcServer)
+//	reg.StartHealthProber(ctx, registry.DefaultHealthProbeInterval)
+func (r *ServiceRegistry) RegisterHealth(server *grpc.Server) *health.Server {
+	hs := health.NewServer()
+	healthpb.RegisterHealthServer(server, hs)
+
+	r.mu.Lock()
+	r.healthServer = hs
+	r.mu.Unlock()
+
+	r.Probe()
+	return hs
+}
+
+// StartHealthProber バックグラウンドでバックエンドDBの接続状態を定期的に確認する
+// ctxがキャンセルされると停止する
+func (r *ServiceRegistry) StartHealthProber(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultHealthProbeInterval
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				r.Probe()
+			}
+		}
+	}()
+}
+
+// Probe バックエンドDBの接続状態を確認し、ヘルスチェックの状態を更新する
+// 未接続のバックエンド（再接続待ち・未設定）はここでは確認しない
+func (r *ServiceRegistry) Probe() AvailabilityReport {
+	r.mu.RLock()
+	localConnected := r.localDB != nil
+	prodConnected := r.prodDB != nil && r.prodDB.Connected()
+	sqlServerConnected := r.sqlServerDB != nil && r.sqlServerDB.Connected()
+	r.mu.RUnlock()
+
+	if localConnected {
+		r.setBackendStatus(newBackendStatus(BackendLocal, config.HealthCheck(r.localDB)))
+	}
+	if prodConnected {
+		r.setBackendStatus(newBackendStatus(BackendProd, config.ProdHealthCheck(r.prodDB)))
+	}
+	if sqlServerConnected {
+		r.setBackendStatus(newBackendStatus(BackendSQLServer, config.SQLServerHealthCheck(r.sqlServerDB)))
+	}
+	r.updateHealthServer()
+	return r.Availability()
+}
+
+// setBackendStatus バックエンドDBの接続状態を更新する
+func (r *ServiceRegistry) setBackendStatus(current BackendStatus) {
+	r.mu.Lock()
+	var previous BackendStatus
+	switch current.Backend {
+	case BackendLocal:
+		previous, r.availability.Local = r.availability.Local, current
+	case BackendProd:
+		previous, r.availability.Prod = r.availability.Prod, current
+	case BackendSQLServer:
+		previous, r.availability.SQLServer = r.availability.SQLServer, current
+	}
+	r.mu.Unlock()
+
+	if previous.Available != current.Available {
+		if current.Available {
+			log.Printf("Backend %s is now available", current.Backend)
+		} else {
+			log.Printf("Backend %s is now unavailable: %s", current.Backend, current.Reason)
+		}
+		r.updateHealthServer()
+	}
+}
+
+// updateHealthServer 接続状態をヘルスチェックサービスに反映する
+func (r *ServiceRegistry) updateHealthServer() {
+	r.mu.RLock()
+	hs := r.healthServer
+	report := r.availability
+	r.mu.RUnlock()
+	if hs == nil {
+		return
+	}
+
+	for name, status := range r.serviceStatuses(report) {
+		hs.SetServingStatus(name, status)
+	}
+	// サーバー全体の状態はローカルDBに連動する
+	if report.Local.Available {
+		hs.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	} else {
+		hs.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+}
+
+// serviceStatuses RegisterAllで登録したサービスごとのヘルスチェック状態（キーはgRPCサービス名）
+// 登録していないサービス（除外した・バックエンドが未設定のサービス）は含まない
+func (r *ServiceRegistry) serviceStatuses(report AvailabilityReport) map[string]healthpb.HealthCheckResponse_ServingStatus {
+	available := map[string]bool{"": true}
+	for _, b := range report.Backends() {
+		available[b.Backend] = b.Available
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	statuses := make(map[string]healthpb.HealthCheckResponse_ServingStatus, len(r.registered))
+	for name, backend := range r.registered {
+		if available[backend] {
+			statuses[name] = healthpb.HealthCheckResponse_SERVING
+		} else {
+			statuses[name] = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+	}
+	return statuses
+}
+
+// healthResponse /health のレスポンス
+type healthResponse struct {
+	Status   string            `json:"status"`
+	Backends []BackendStatus   `json:"backends"`
+	Services map[string]string `json:"services"`
+}
+
+// HealthHandler バックエンドDBとサービスごとの状態をJSONで返すHTTPハンドラー
+// 登録したサービスが使用するバックエンドに接続できない場合は503を返す
+// 接続情報が未設定のバックエンドは使用しないものとして含めない
+// 状態はStartHealthProberによる最新の確認結果を使用する
+func (r *ServiceRegistry) HealthHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		report := r.Availability()
+		statuses := r.serviceStatuses(report)
+
+		resp := healthResponse{
+			Status:   HealthStatusOK,
+			Backends: []BackendStatus{},
+			Services: make(map[string]string, len(statuses)),
+		}
+		for _, b := range report.Backends() {
+			if !b.NotConfigured {
+				resp.Backends = append(resp.Backends, b)
+			}
+		}
+		for name, status := range statuses {
+			resp.Services[name] = status.String()
+			if status != healthpb.HealthCheckResponse_SERVING {
+				resp.Status = HealthStatusDegraded
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if resp.Status != HealthStatusOK {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		} else {
+			w.WriteHeader(http.StatusOK)
+		}
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			log.Printf("Failed to write health response: %v", err)
+		}
+	})
+}
@@ -0,0 +1,68 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/yhonda-ohishi/db_service/src/config"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+func TestHealth_LocalOnly(t *testing.T) {
+	// 本番DB・SQL Serverの接続情報を設定していないローカルDBのみの構成
+	origProd, origSQLServer := newProdDatabase, newSQLServerDatabase
+	t.Cleanup(func() { newProdDatabase, newSQLServerDatabase = origProd, origSQLServer })
+	newProdDatabase = func() (*config.ProdDatabase, error) {
+		return nil, config.ErrProdDBNotConfigured
+	}
+	newSQLServerDatabase = func() (*config.SQLServerDatabase, error) {
+		return nil, config.ErrSQLServerNotConfigured
+	}
+
+	reg, err := NewServiceRegistryE(WithLocalDB(openSQLite(t)))
+	if err != nil {
+		t.Fatalf("NewServiceRegistryE failed: %v", err)
+	}
+	defer reg.Close()
+	grpcServer := grpc.NewServer()
+	reg.RegisterAll(grpcServer)
+	hs := reg.RegisterHealth(grpcServer)
+
+	rec := httptest.NewRecorder()
+	reg.HealthHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var body healthResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if body.Status != HealthStatusOK {
+		t.Errorf("expected status %q, got %q", HealthStatusOK, body.Status)
+	}
+	// 未設定のバックエンドは含めない
+	if len(body.Backends) != 1 || body.Backends[0].Backend != BackendLocal {
+		t.Errorf("expected only the local backend, got %v", body.Backends)
+	}
+	if _, ok := body.Services["db_service.db_DTakoRowsService"]; ok {
+		t.Errorf("unregistered DTakoRowsService should not be reported: %v", body.Services)
+	}
+	if body.Services["db_service.db_ETCMeisaiService"] != "SERVING" {
+		t.Errorf("unexpected ETCMeisaiService status: %q", body.Services["db_service.db_ETCMeisaiService"])
+	}
+
+	// 登録していないサービスはgRPCヘルスチェックでもNOT_FOUND
+	if _, err := hs.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "db_service.db_ShainMasterService"}); status.Code(err) != codes.NotFound {
+		t.Errorf("Check(ShainMasterService) code = %v, want NotFound", status.Code(err))
+	}
+	resp, err := hs.Check(context.Background(), &healthpb.HealthCheckRequest{Service: ""})
+	if err != nil || resp.Status != healthpb.HealthCheckResponse_SERVING {
+		t.Errorf("Check(\"\") = %v, %v, want SERVING", resp, err)
+	}
+}
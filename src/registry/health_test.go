@@ -0,0 +1,91 @@
+package registry_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/yhonda-ohishi/db_service/src/registry"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestRegisterHealth_BackendDown(t *testing.T) {
+	// 接続できないDBを注入しているため、Probeで全バックエンドがダウンと判定される
+	reg, err := newInjectedRegistry(t)
+	if err != nil {
+		t.Fatalf("NewServiceRegistryE failed: %v", err)
+	}
+	grpcServer := grpc.NewServer()
+	reg.RegisterAll(grpcServer)
+	hs := reg.RegisterHealth(grpcServer)
+
+	tests := []struct {
+		service  string
+		expected healthpb.HealthCheckResponse_ServingStatus
+	}{
+		{"db_service.db_ETCMeisaiService", healthpb.HealthCheckResponse_NOT_SERVING},
+		{"db_service.db_DTakoRowsService", healthpb.HealthCheckResponse_NOT_SERVING},
+		{"db_service.db_ShainMasterService", healthpb.HealthCheckResponse_NOT_SERVING},
+		{"db_service.db_RegistryService", healthpb.HealthCheckResponse_SERVING},
+		{"", healthpb.HealthCheckResponse_NOT_SERVING},
+	}
+	for _, tt := range tests {
+		resp, err := hs.Check(context.Background(), &healthpb.HealthCheckRequest{Service: tt.service})
+		if err != nil {
+			t.Fatalf("Check(%q) failed: %v", tt.service, err)
+		}
+		if resp.Status != tt.expected {
+			t.Errorf("Check(%q): expected %v, got %v", tt.service, tt.expected, resp.Status)
+		}
+	}
+
+	if reg.Availability().Prod.Available {
+		t.Error("prod backend should be unavailable")
+	}
+	if reg.Availability().Prod.Reason == "" {
+		t.Error("unavailable backend should have reason")
+	}
+
+	// HTTP /health はダウン時に503を返す
+	rec := httptest.NewRecorder()
+	reg.HealthHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected 503, got %d", rec.Code)
+	}
+
+	var body struct {
+		Status   string                   `json:"status"`
+		Backends []registry.BackendStatus `json:"backends"`
+		Services map[string]string        `json:"services"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if body.Status != registry.HealthStatusDegraded {
+		t.Errorf("expected status %q, got %q", registry.HealthStatusDegraded, body.Status)
+	}
+	if len(body.Backends) != 3 {
+		t.Errorf("expected 3 backends, got %d", len(body.Backends))
+	}
+	if body.Services["db_service.db_DTakoRowsService"] != "NOT_SERVING" {
+		t.Errorf("unexpected DTakoRowsService status: %q", body.Services["db_service.db_DTakoRowsService"])
+	}
+}
+
+func TestHealthHandler_OnlyUnaffectedServices(t *testing.T) {
+	// DBを使用しないサービスのみ登録した場合はバックエンドの状態に影響されない
+	reg, err := newInjectedRegistry(t, registry.WithOnlyServices("RegistryService"))
+	if err != nil {
+		t.Fatalf("NewServiceRegistryE failed: %v", err)
+	}
+	reg.Probe()
+
+	rec := httptest.NewRecorder()
+	reg.HealthHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health", nil))
+	if rec.Code != http.StatusOK {
+		t.Errorf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+}
@@ -0,0 +1,157 @@
+package registry
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/yhonda-ohishi/db_service/src/config"
+	"github.com/yhonda-ohishi/db_service/src/grpcerr"
+	"google.golang.org/grpc"
+)
+
+// 再接続間隔のデフォルト値（指数バックオフ）
+const (
+	DefaultReconnectInitialInterval = time.Second
+	DefaultReconnectMaxInterval     = time.Minute
+)
+
+// 本番DB・SQL Serverへの接続（テストで差し替える）
+var (
+	newProdDatabase      = config.NewProdDatabase
+	newSQLServerDatabase = config.NewSQLServerDatabase
+)
+
+// reconnectTask 起動時に接続できなかったDBの再接続処理
+type reconnectTask struct {
+	backend string
+	connect func() error
+}
+
+// WithReconnectInterval 起動時に接続できなかった本番DB・SQL Serverへの再接続間隔を指定するオプション
+// initialから開始し、失敗するたびに倍にしてmaxまで延ばす
+func WithReconnectInterval(initial, max time.Duration) RegistryOption {
+	return func(o *RegistryOptions) {
+		o.ReconnectInitialInterval = initial
+		o.ReconnectMaxInterval = max
+	}
+}
+
+// addReconnect 再接続処理を追加（startReconnectsで開始する）
+func (r *ServiceRegistry) addReconnect(backend string, connect func() error) {
+	r.reconnects = append(r.reconnects, reconnectTask{backend: backend, connect: connect})
+}
+
+// startReconnects 再接続処理をバックグラウンドで開始する
+// 接続に成功するか、Closeが呼ばれるまで指数バックオフで再試行する
+func (r *ServiceRegistry) startReconnects() {
+	initial := r.options.ReconnectInitialInterval
+	if initial <= 0 {
+		initial = DefaultReconnectInitialInterval
+	}
+	max := r.options.ReconnectMaxInterval
+	if max < initial {
+		max = DefaultReconnectMaxInterval
+	}
+
+	for _, task := range r.reconnects {
+		r.wg.Add(1)
+		go func(task reconnectTask) {
+			defer r.wg.Done()
+			interval := initial
+			for {
+				select {
+				case <-r.done:
+					return
+				case <-time.After(interval):
+				}
+
+				if err := task.connect(); err != nil {
+					r.setBackendStatus(newBackendStatus(task.backend, err))
+					interval = nextReconnectInterval(interval, max)
+					log.Printf("Reconnect to %s database failed (next retry in %v): %v", task.backend, interval, err)
+					continue
+				}
+
+				log.Printf("Reconnected to %s database", task.backend)
+				r.setBackendStatus(newBackendStatus(task.backend, nil))
+				return
+			}
+		}(task)
+	}
+	r.reconnects = nil
+}
+
+// nextReconnectInterval 再接続に失敗した後の次の再接続間隔（倍にしてmaxまで延ばす）
+func nextReconnectInterval(interval, max time.Duration) time.Duration {
+	interval *= 2
+	if interval > max {
+		return max
+	}
+	return interval
+}
+
+// checkBackend バックエンドDBが使用可能かチェックし、使用できない場合はUNAVAILABLEを返す
+func (r *ServiceRegistry) checkBackend(backend string) error {
+	if backend == "" {
+		return nil
+	}
+	for _, b := range r.Availability().Backends() {
+		if b.Backend == backend && !b.Available {
+			return grpcerr.Unavailable("%s database is unavailable: %s", backend, b.Reason)
+		}
+	}
+	return nil
+}
+
+// backendAvailable バックエンドDBが使用可能かを呼び出しごとに確認する関数
+// サービスから別のバックエンドのリポジトリを参照する場合に、使用できない間の参照を省くために使用する
+func (r *ServiceRegistry) backendAvailable(backend string) func() bool {
+	return func() bool {
+		return r.checkBackend(backend) == nil
+	}
+}
+
+// guardServiceDesc バックエンドDBが使用できない間はUNAVAILABLEを返すようにServiceDescをラップする
+// チェックはインターセプターの内側で行うため、インターセプターからもエラーを参照できる
+func (r *ServiceRegistry) guardServiceDesc(desc *grpc.ServiceDesc, backend string) *grpc.ServiceDesc {
+	if backend == "" {
+		return desc
+	}
+
+	guarded := *desc
+	guarded.Methods = make([]grpc.MethodDesc, len(desc.Methods))
+	for i, method := range desc.Methods {
+		handler := method.Handler
+		guarded.Methods[i] = grpc.MethodDesc{
+			MethodName: method.MethodName,
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				return handler(srv, ctx, dec, func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, next grpc.UnaryHandler) (interface{}, error) {
+					guardedNext := func(ctx context.Context, req interface{}) (interface{}, error) {
+						if err := r.checkBackend(backend); err != nil {
+							return nil, err
+						}
+						return next(ctx, req)
+					}
+					if interceptor == nil {
+						return guardedNext(ctx, req)
+					}
+					return interceptor(ctx, req, info, guardedNext)
+				})
+			},
+		}
+	}
+
+	guarded.Streams = make([]grpc.StreamDesc, len(desc.Streams))
+	for i, stream := range desc.Streams {
+		handler := stream.Handler
+		guarded.Streams[i] = stream
+		guarded.Streams[i].Handler = func(srv interface{}, ss grpc.ServerStream) error {
+			if err := r.checkBackend(backend); err != nil {
+				return err
+			}
+			return handler(srv, ss)
+		}
+	}
+	return &guarded
+}
@@ -0,0 +1,418 @@
+package registry
+
+import (
+	"context"
+	"errors"
+	"net"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"github.com/yhonda-ohishi/db_service/src/config"
+	"github.com/yhonda-ohishi/db_service/src/models/ichibanboshi"
+	"github.com/yhonda-ohishi/db_service/src/models/mysql"
+	"github.com/yhonda-ohishi/db_service/src/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"gorm.io/gorm"
+)
+
+func TestNextReconnectInterval(t *testing.T) {
+	tests := []struct {
+		interval time.Duration
+		max      time.Duration
+		want     time.Duration
+	}{
+		{time.Second, time.Minute, 2 * time.Second},
+		{16 * time.Second, time.Minute, 32 * time.Second},
+		{32 * time.Second, time.Minute, time.Minute},
+		{time.Minute, time.Minute, time.Minute},
+	}
+	for _, tt := range tests {
+		if got := nextReconnectInterval(tt.interval, tt.max); got != tt.want {
+			t.Errorf("nextReconnectInterval(%v, %v) = %v, want %v", tt.interval, tt.max, got, tt.want)
+		}
+	}
+}
+
+// openSQLite インメモリSQLiteを開き、modelsのテーブルを作成する
+func openSQLite(t *testing.T, models ...interface{}) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open sqlite: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("failed to get sql.DB: %v", err)
+	}
+	// インメモリDBを接続間で共有するため接続を1つに制限する
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+	if err := db.AutoMigrate(models...); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	return db
+}
+
+func TestReconnect_Heals(t *testing.T) {
+	prodConn := openSQLite(t)
+	var healed atomic.Bool
+	var attempts atomic.Int32
+	errDown := errors.New("connection refused")
+
+	origProd, origSQLServer := newProdDatabase, newSQLServerDatabase
+	t.Cleanup(func() { newProdDatabase, newSQLServerDatabase = origProd, origSQLServer })
+	newProdDatabase = func() (*config.ProdDatabase, error) {
+		attempts.Add(1)
+		if !healed.Load() {
+			return nil, errDown
+		}
+		return &config.ProdDatabase{DB: prodConn}, nil
+	}
+	newSQLServerDatabase = func() (*config.SQLServerDatabase, error) {
+		return nil, config.ErrSQLServerNotConfigured
+	}
+
+	reg, err := NewServiceRegistryE(
+		WithLocalDB(openSQLite(t)),
+		WithReconnectInterval(5*time.Millisecond, 20*time.Millisecond),
+	)
+	if err != nil {
+		t.Fatalf("NewServiceRegistryE failed: %v", err)
+	}
+	defer reg.Close()
+
+	// ガードしたサービスの呼び出し（バックエンドが使用できる場合はハンドラーが呼ばれる）
+	desc := reg.guardServiceDesc(&grpc.ServiceDesc{
+		ServiceName: "test.Service",
+		Methods: []grpc.MethodDesc{{
+			MethodName: "Call",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				return interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/test.Service/Call"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+					return "ok", nil
+				})
+			},
+		}},
+	}, BackendProd)
+	call := func() error {
+		_, err := desc.Methods[0].Handler(nil, context.Background(), nil, nil)
+		return err
+	}
+
+	if reg.Availability().Prod.Available {
+		t.Fatal("prod should not be available before healing")
+	}
+	if code := status.Code(call()); code != codes.Unavailable {
+		t.Fatalf("call before healing: code = %v, want Unavailable", code)
+	}
+
+	// 再接続中もリポジトリからの参照と競合しないこと（go test -race）
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				_ = reg.prodDB.Conn()
+			}
+		}
+	}()
+
+	// 何度か失敗してから回復させる
+	deadline := time.Now().Add(5 * time.Second)
+	for attempts.Load() < 3 {
+		if time.Now().After(deadline) {
+			t.Fatal("reconnect was not retried")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	healed.Store(true)
+
+	for !reg.Availability().Prod.Available {
+		if time.Now().After(deadline) {
+			t.Fatalf("prod did not become available: %s", reg.Availability().Prod.Reason)
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if err := call(); err != nil {
+		t.Errorf("call after healing: %v", err)
+	}
+	if reg.prodDB.Conn() != prodConn {
+		t.Error("prod connection should be replaced by the reconnected handle")
+	}
+}
+
+// dialRegistry レジストリの全サービスをbufconn上のgRPCサーバーで起動して接続する
+func dialRegistry(t *testing.T, reg *ServiceRegistry) *grpc.ClientConn {
+	t.Helper()
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	reg.RegisterAll(server)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func TestCrossBackendCall_SecondaryDown(t *testing.T) {
+	prodConn := openSQLite(t, &mysql.Cars{})
+	bumon := "001"
+	if err := prodConn.Create(&mysql.Cars{ID: "000001", ID4: 1, BumonCodeID: &bumon}).Error; err != nil {
+		t.Fatalf("failed to seed cars: %v", err)
+	}
+
+	// 本番DBは接続でき、SQL Serverは未設定以外の理由で起動時に接続できない
+	origProd, origSQLServer := newProdDatabase, newSQLServerDatabase
+	t.Cleanup(func() { newProdDatabase, newSQLServerDatabase = origProd, origSQLServer })
+	newProdDatabase = func() (*config.ProdDatabase, error) {
+		return &config.ProdDatabase{DB: prodConn}, nil
+	}
+	newSQLServerDatabase = func() (*config.SQLServerDatabase, error) {
+		return nil, errors.New("connection refused")
+	}
+
+	reg, err := NewServiceRegistryE(
+		WithLocalDB(openSQLite(t)),
+		WithReconnectInterval(time.Hour, time.Hour),
+	)
+	if err != nil {
+		t.Fatalf("NewServiceRegistryE failed: %v", err)
+	}
+	defer reg.Close()
+	if reg.Availability().SQLServer.Available {
+		t.Fatal("SQL Server should not be available")
+	}
+
+	conn := dialRegistry(t, reg)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// 本番DBのサービスから未接続のSQL Serverを参照してもプロセスが落ちない
+	resp, err := proto.NewDb_CarsServiceClient(conn).GetByBumonCodeID(ctx, &proto.Db_GetCarsByBumonCodeIDRequest{BumonCodeId: bumon})
+	if err != nil {
+		t.Fatalf("GetByBumonCodeID failed: %v", err)
+	}
+	if len(resp.Items) != 1 || resp.Items[0].BumonName != nil {
+		t.Errorf("unexpected cars: %v", resp.Items)
+	}
+
+	// SQL Serverのサービスは再接続までUNAVAILABLE
+	_, err = proto.NewDb_BumonMasterServiceClient(conn).List(ctx, &proto.Db_ListBumonMasterRequest{})
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("BumonMasterService.List: code = %v, want Unavailable", status.Code(err))
+	}
+
+	// 未接続の接続での操作はErrNotConnected
+	var cars []*mysql.Cars
+	if err := reg.sqlServerDB.Conn().WithContext(ctx).Find(&cars).Error; !errors.Is(err, config.ErrNotConnected) {
+		t.Errorf("query on unconnected handle: err = %v, want ErrNotConnected", err)
+	}
+}
+
+func TestBumonNames_SQLServerUnavailable(t *testing.T) {
+	prodConn := openSQLite(t, &mysql.Cars{})
+	sqlServerConn := openSQLite(t, &ichibanboshi.BumonMaster{})
+	bumon, name := "001", "本社"
+	if err := prodConn.Create(&mysql.Cars{ID: "000001", ID4: 1, BumonCodeID: &bumon}).Error; err != nil {
+		t.Fatalf("failed to seed cars: %v", err)
+	}
+	if err := sqlServerConn.Create(&ichibanboshi.BumonMaster{BumonC: bumon, BumonN: &name}).Error; err != nil {
+		t.Fatalf("failed to seed 部門ﾏｽﾀ: %v", err)
+	}
+
+	reg, err := NewServiceRegistryE(
+		WithLocalDB(openSQLite(t)),
+		WithProdDB(&config.ProdDatabase{DB: prodConn}),
+		WithSQLServerDB(&config.SQLServerDatabase{DB: sqlServerConn}),
+	)
+	if err != nil {
+		t.Fatalf("NewServiceRegistryE failed: %v", err)
+	}
+	defer reg.Close()
+
+	client := proto.NewDb_CarsServiceClient(dialRegistry(t, reg))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	bumonName := func() *string {
+		t.Helper()
+		resp, err := client.GetByBumonCodeID(ctx, &proto.Db_GetCarsByBumonCodeIDRequest{BumonCodeId: bumon})
+		if err != nil {
+			t.Fatalf("GetByBumonCodeID failed: %v", err)
+		}
+		if len(resp.Items) != 1 {
+			t.Fatalf("unexpected cars: %v", resp.Items)
+		}
+		return resp.Items[0].BumonName
+	}
+
+	if got := bumonName(); got == nil || *got != name {
+		t.Errorf("bumon name = %v, want %q", got, name)
+	}
+
+	// SQL Serverが使用できない間は部門マスタを参照せず部門名なし
+	reg.setBackendStatus(newBackendStatus(BackendSQLServer, errors.New("connection refused")))
+	if got := bumonName(); got != nil {
+		t.Errorf("bumon name while SQL Server is unavailable = %q, want none", *got)
+	}
+
+	reg.setBackendStatus(newBackendStatus(BackendSQLServer, nil))
+	if got := bumonName(); got == nil || *got != name {
+		t.Errorf("bumon name after recovery = %v, want %q", got, name)
+	}
+}
+
+func TestVehicleIdentity_SQLServerUnavailable(t *testing.T) {
+	prodConn := openSQLite(t, &mysql.Cars{}, &mysql.DTakoCars{}, &mysql.ETCNum{})
+	sqlServerConn := openSQLite(t, &ichibanboshi.SharyoMaster{})
+	for _, m := range []interface{}{
+		&mysql.Cars{ID: "000001", ID4: 1},
+		&mysql.DTakoCars{CarCode: "1", CarCC: "000001"},
+		&mysql.ETCNum{ETCCardNum: "1111", CarID: "000001"},
+	} {
+		if err := prodConn.Create(m).Error; err != nil {
+			t.Fatalf("failed to seed %T: %v", m, err)
+		}
+	}
+	if err := sqlServerConn.Create(&ichibanboshi.SharyoMaster{SharyoC: "0001", SharyoH: "01"}).Error; err != nil {
+		t.Fatalf("failed to seed 車輌ﾏｽﾀ: %v", err)
+	}
+
+	reg, err := NewServiceRegistryE(
+		WithLocalDB(openSQLite(t)),
+		WithProdDB(&config.ProdDatabase{DB: prodConn}),
+		WithSQLServerDB(&config.SQLServerDatabase{DB: sqlServerConn}),
+	)
+	if err != nil {
+		t.Fatalf("NewServiceRegistryE failed: %v", err)
+	}
+	defer reg.Close()
+
+	client := proto.NewDb_VehicleIdentityServiceClient(dialRegistry(t, reg))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// SQL Serverが使用できない間は車輌ﾏｽﾀを突き合わせない
+	reg.setBackendStatus(newBackendStatus(BackendSQLServer, errors.New("connection refused")))
+	resp, err := client.Get(ctx, &proto.Db_GetVehicleIdentityRequest{Key: &proto.Db_GetVehicleIdentityRequest_CarId{CarId: "000001"}})
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if len(resp.Identity.SharyoMasters) != 0 || len(resp.Identity.Missing) != 0 {
+		t.Errorf("unexpected identity while SQL Server is unavailable: %v", resp.Identity)
+	}
+	gaps, err := client.ListGaps(ctx, &proto.Db_ListVehicleIdentityGapsRequest{})
+	if err != nil {
+		t.Fatalf("ListGaps failed: %v", err)
+	}
+	if len(gaps.Systems) != 3 || len(gaps.Items) != 0 {
+		t.Errorf("unexpected gaps while SQL Server is unavailable: systems=%v items=%v", gaps.Systems, gaps.Items)
+	}
+
+	// 回復後は車輌ﾏｽﾀも突き合わせる
+	reg.setBackendStatus(newBackendStatus(BackendSQLServer, nil))
+	resp, err = client.Get(ctx, &proto.Db_GetVehicleIdentityRequest{Key: &proto.Db_GetVehicleIdentityRequest_CarId{CarId: "000001"}})
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if len(resp.Identity.SharyoMasters) != 1 {
+		t.Errorf("unexpected identity after recovery: %v", resp.Identity)
+	}
+	gaps, err = client.ListGaps(ctx, &proto.Db_ListVehicleIdentityGapsRequest{})
+	if err != nil {
+		t.Fatalf("ListGaps failed: %v", err)
+	}
+	if len(gaps.Systems) != 4 {
+		t.Errorf("unexpected systems after recovery: %v", gaps.Systems)
+	}
+}
+
+func TestEmployeeIdentity_BackendUnavailable(t *testing.T) {
+	localConn := openSQLite(t, &mysql.EmployeeIdentity{}, &mysql.EmployeeCard{}, &mysql.TimeCardLog{})
+	prodConn := openSQLite(t, &mysql.Drivers{}, &mysql.DTakoRows{})
+	sqlServerConn := openSQLite(t, &ichibanboshi.ShainMaster{}, &ichibanboshi.UntenNippoMeisai{})
+	driverID := 11
+	if err := prodConn.Create(&mysql.Drivers{ID: driverID}).Error; err != nil {
+		t.Fatalf("failed to seed drivers: %v", err)
+	}
+	if err := localConn.Create(&mysql.EmployeeIdentity{Name: "山田太郎", DriverID: &driverID}).Error; err != nil {
+		t.Fatalf("failed to seed employee_identities: %v", err)
+	}
+
+	reg, err := NewServiceRegistryE(
+		WithLocalDB(localConn),
+		WithProdDB(&config.ProdDatabase{DB: prodConn}),
+		WithSQLServerDB(&config.SQLServerDatabase{DB: sqlServerConn}),
+	)
+	if err != nil {
+		t.Fatalf("NewServiceRegistryE failed: %v", err)
+	}
+	defer reg.Close()
+
+	client := proto.NewDb_EmployeeIdentityServiceClient(dialRegistry(t, reg))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// 本番DBが使用できない間は本番DBの登録元を突き合わせず、報告全体は失敗させない
+	reg.setBackendStatus(newBackendStatus(BackendProd, errors.New("connection refused")))
+	resolved, err := client.Resolve(ctx, &proto.Db_ResolveEmployeeIdentityRequest{
+		Key: &proto.Db_ResolveEmployeeIdentityRequest_DriverId{DriverId: int32(driverID)},
+	})
+	if err != nil {
+		t.Fatalf("Resolve failed: %v", err)
+	}
+	if resolved.Driver != nil {
+		t.Errorf("Driver = %v while production database is unavailable, want nil", resolved.Driver)
+	}
+	unlinked, err := client.ListUnlinked(ctx, &proto.Db_ListUnlinkedEmployeeCodesRequest{})
+	if err != nil {
+		t.Fatalf("ListUnlinked failed: %v", err)
+	}
+	skipped := func(resp *proto.Db_ListUnlinkedEmployeeCodesResponse) []proto.Db_EmployeeCodeSource {
+		var sources []proto.Db_EmployeeCodeSource
+		for _, s := range resp.SkippedSources {
+			sources = append(sources, s.Source)
+		}
+		return sources
+	}
+	want := []proto.Db_EmployeeCodeSource{proto.Db_EmployeeCodeSource_EMPLOYEE_CODE_SOURCE_DRIVERS, proto.Db_EmployeeCodeSource_EMPLOYEE_CODE_SOURCE_DTAKO_ROWS}
+	if got := skipped(unlinked); !reflect.DeepEqual(got, want) {
+		t.Errorf("SkippedSources = %v, want %v", got, want)
+	}
+	if len(unlinked.Sources) != 4 {
+		t.Errorf("Sources = %v, want SQL Server and timecard sources", unlinked.Sources)
+	}
+
+	// 取得に失敗したSQL Serverの登録元も報告全体は失敗させない
+	reg.setBackendStatus(newBackendStatus(BackendProd, nil))
+	if err := sqlServerConn.Migrator().DropTable(&ichibanboshi.UntenNippoMeisai{}); err != nil {
+		t.Fatalf("failed to drop 運転日報明細: %v", err)
+	}
+	unlinked, err = client.ListUnlinked(ctx, &proto.Db_ListUnlinkedEmployeeCodesRequest{})
+	if err != nil {
+		t.Fatalf("ListUnlinked failed: %v", err)
+	}
+	want = []proto.Db_EmployeeCodeSource{proto.Db_EmployeeCodeSource_EMPLOYEE_CODE_SOURCE_UNTEN_NIPPO_MEISAI}
+	if got := skipped(unlinked); !reflect.DeepEqual(got, want) || unlinked.SkippedSources[0].Reason == "" {
+		t.Errorf("SkippedSources = %v, want %v with a reason", unlinked.SkippedSources, want)
+	}
+	if len(unlinked.Sources) != 5 {
+		t.Errorf("Sources = %v, want all but 運転日報明細", unlinked.Sources)
+	}
+}
@@ -1,20 +1,42 @@
 package registry
 
 import (
+	"errors"
 	"fmt"
 	"log"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/yhonda-ohishi/db_service/src/config"
+	"github.com/yhonda-ohishi/db_service/src/employeeid"
+	"github.com/yhonda-ohishi/db_service/src/etcmatch"
+	"github.com/yhonda-ohishi/db_service/src/mappingaudit"
 	dbproto "github.com/yhonda-ohishi/db_service/src/proto"
 	"github.com/yhonda-ohishi/db_service/src/repository"
 	"github.com/yhonda-ohishi/db_service/src/service"
+	"github.com/yhonda-ohishi/db_service/src/vehicleid"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"gorm.io/gorm"
 )
 
 // RegistryOptions はサービス登録のオプション
 type RegistryOptions struct {
 	// 除外するサービス名のリスト
 	ExcludeServices []string
+
+	// 登録するサービス名のリスト（指定した場合はこのリストのサービスのみ登録）
+	OnlyServices []string
+
+	// 外部から注入するDB接続（nilの場合はレジストリが環境変数から接続する）
+	LocalDB     *gorm.DB
+	ProdDB      *config.ProdDatabase
+	SQLServerDB *config.SQLServerDatabase
+
+	// 起動時に接続できなかったDBへの再接続間隔（0の場合はデフォルト値）
+	ReconnectInitialInterval time.Duration
+	ReconnectMaxInterval     time.Duration
 }
 
 // RegistryOption は関数オプションの型
@@ -27,16 +49,135 @@ func WithExcludeServices(services ...string) RegistryOption {
 	}
 }
 
-// isExcluded サービスが除外リストに含まれているかチェック
-func (o *RegistryOptions) isExcluded(serviceName string) bool {
-	for _, excluded := range o.ExcludeServices {
-		if excluded == serviceName {
+// WithOnlyServices 指定したサービスのみを登録するオプション
+// WithExcludeServicesと併用した場合は、指定したサービスから除外リストを除いたものが登録される
+func WithOnlyServices(services ...string) RegistryOption {
+	return func(o *RegistryOptions) {
+		o.OnlyServices = append(o.OnlyServices, services...)
+	}
+}
+
+// WithLocalDB 既存のローカルDB接続を使用するオプション
+// 注入された接続はServiceRegistry.Closeでは閉じない
+func WithLocalDB(db *gorm.DB) RegistryOption {
+	return func(o *RegistryOptions) {
+		o.LocalDB = db
+	}
+}
+
+// WithProdDB 既存の本番DB接続を使用するオプション
+// 注入された接続はServiceRegistry.Closeでは閉じない
+func WithProdDB(db *config.ProdDatabase) RegistryOption {
+	return func(o *RegistryOptions) {
+		o.ProdDB = db
+	}
+}
+
+// WithSQLServerDB 既存のSQL Server (ichibanboshi) 接続を使用するオプション
+// 注入された接続はServiceRegistry.Closeでは閉じない
+func WithSQLServerDB(db *config.SQLServerDatabase) RegistryOption {
+	return func(o *RegistryOptions) {
+		o.SQLServerDB = db
+	}
+}
+
+// ErrUnknownService 存在しないサービス名が指定された
+var ErrUnknownService = errors.New("unknown service name")
+
+// serviceEntry サービス名とgRPCサービス名・使用するバックエンドの対応
+type serviceEntry struct {
+	name     string
+	grpcName string
+	// backend 使用するバックエンド名（DBを使用しない場合は空）
+	backend string
+}
+
+// serviceCatalog 登録可能なサービスの一覧
+var serviceCatalog = []serviceEntry{
+	// ローカルDB用サービス
+	{"ETCMeisaiService", dbproto.Db_ETCMeisaiService_ServiceDesc.ServiceName, BackendLocal},
+	{"DTakoUriageKeihiService", dbproto.Db_DTakoUriageKeihiService_ServiceDesc.ServiceName, BackendLocal},
+	{"DTakoFerryRowsService", dbproto.Db_DTakoFerryRowsService_ServiceDesc.ServiceName, BackendLocal},
+	{"ETCMeisaiMappingService", dbproto.Db_ETCMeisaiMappingService_ServiceDesc.ServiceName, BackendLocal},
+	{"TimeCardDevService", dbproto.Db_TimeCardDevService_ServiceDesc.ServiceName, BackendLocal},
+	{"TimeCardLogService", dbproto.Db_TimeCardLogService_ServiceDesc.ServiceName, BackendLocal},
+	// 本番DB・SQL Serverの社員コードも使用するが、未設定・接続できない場合も対応表の管理はできる
+	{"EmployeeIdentityService", dbproto.Db_EmployeeIdentityService_ServiceDesc.ServiceName, BackendLocal},
+
+	// 本番DB用サービス
+	{"DTakoCarsService", dbproto.Db_DTakoCarsService_ServiceDesc.ServiceName, BackendProd},
+	{"DTakoEventsService", dbproto.Db_DTakoEventsService_ServiceDesc.ServiceName, BackendProd},
+	{"DTakoRowsService", dbproto.Db_DTakoRowsService_ServiceDesc.ServiceName, BackendProd},
+	{"ETCNumService", dbproto.Db_ETCNumService_ServiceDesc.ServiceName, BackendProd},
+	{"DTakoFerryRowsProdService", dbproto.Db_DTakoFerryRowsProdService_ServiceDesc.ServiceName, BackendProd},
+	{"CarsService", dbproto.Db_CarsService_ServiceDesc.ServiceName, BackendProd},
+	{"DriversService", dbproto.Db_DriversService_ServiceDesc.ServiceName, BackendProd},
+	{"TimeCardService", dbproto.Db_TimeCardService_ServiceDesc.ServiceName, BackendProd},
+	// ローカルDBのETC明細・マッピングも使用するが、起動時に必須のローカルDBではなく本番DBの状態に連動させる
+	{"ETCMeisaiMatcherService", dbproto.Db_ETCMeisaiMatcherService_ServiceDesc.ServiceName, BackendProd},
+	{"ETCMeisaiMappingAuditService", dbproto.Db_ETCMeisaiMappingAuditService_ServiceDesc.ServiceName, BackendProd},
+	// SQL Serverの車輌マスタも使用するが、SQL Server未設定の場合も本番DBの車輌のみで動作する
+	{"VehicleIdentityService", dbproto.Db_VehicleIdentityService_ServiceDesc.ServiceName, BackendProd},
+
+	// SQL Server (ichibanboshi) 用サービス
+	{"UntenNippoMeisaiService", dbproto.Db_UntenNippoMeisaiService_ServiceDesc.ServiceName, BackendSQLServer},
+	{"ShainMasterService", dbproto.Db_ShainMasterService_ServiceDesc.ServiceName, BackendSQLServer},
+	{"ChiikiMasterService", dbproto.Db_ChiikiMasterService_ServiceDesc.ServiceName, BackendSQLServer},
+	{"ChikuMasterService", dbproto.Db_ChikuMasterService_ServiceDesc.ServiceName, BackendSQLServer},
+	{"TokuisakiMasterService", dbproto.Db_TokuisakiMasterService_ServiceDesc.ServiceName, BackendSQLServer},
+	{"HinmeiMasterService", dbproto.Db_HinmeiMasterService_ServiceDesc.ServiceName, BackendSQLServer},
+	{"BumonMasterService", dbproto.Db_BumonMasterService_ServiceDesc.ServiceName, BackendSQLServer},
+	{"SharyoMasterService", dbproto.Db_SharyoMasterService_ServiceDesc.ServiceName, BackendSQLServer},
+
+	// レジストリ状態確認サービス
+	{"RegistryService", dbproto.Db_RegistryService_ServiceDesc.ServiceName, ""},
+}
+
+// ServiceNames WithExcludeServices / WithOnlyServices で指定可能なサービス名の一覧
+func ServiceNames() []string {
+	names := make([]string, 0, len(serviceCatalog))
+	for _, entry := range serviceCatalog {
+		names = append(names, entry.name)
+	}
+	return names
+}
+
+// containsService サービス名がリストに含まれているかチェック
+func containsService(services []string, serviceName string) bool {
+	for _, s := range services {
+		if s == serviceName {
 			return true
 		}
 	}
 	return false
 }
 
+// validate 除外リスト・登録リストに存在しないサービス名が含まれていないかチェック
+func (o *RegistryOptions) validate() error {
+	known := ServiceNames()
+	var unknown []string
+	for _, name := range append(append([]string{}, o.ExcludeServices...), o.OnlyServices...) {
+		if !containsService(known, name) && !containsService(unknown, name) {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		return fmt.Errorf("%w: %s", ErrUnknownService, strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+// isEnabled サービスを登録するかチェック
+func (o *RegistryOptions) isEnabled(serviceName string) bool {
+	if o == nil {
+		return true
+	}
+	if len(o.OnlyServices) > 0 && !containsService(o.OnlyServices, serviceName) {
+		return false
+	}
+	return !containsService(o.ExcludeServices, serviceName)
+}
+
 // ServiceRegistry holds all db_service gRPC service implementations
 type ServiceRegistry struct {
 	// ローカルDB用サービス
@@ -46,53 +187,133 @@ type ServiceRegistry struct {
 	ETCMeisaiMappingService dbproto.Db_ETCMeisaiMappingServiceServer
 	TimeCardDevService      dbproto.Db_TimeCardDevServiceServer
 	TimeCardLogService      dbproto.Db_TimeCardLogServiceServer
+	EmployeeIdentityService dbproto.Db_EmployeeIdentityServiceServer
 
 	// 本番DB用サービス（読み取り専用）
-	DTakoCarsService         dbproto.Db_DTakoCarsServiceServer
-	DTakoEventsService       dbproto.Db_DTakoEventsServiceServer
-	DTakoRowsService         dbproto.Db_DTakoRowsServiceServer
-	ETCNumService            dbproto.Db_ETCNumServiceServer
+	DTakoCarsService          dbproto.Db_DTakoCarsServiceServer
+	DTakoEventsService        dbproto.Db_DTakoEventsServiceServer
+	DTakoRowsService          dbproto.Db_DTakoRowsServiceServer
+	ETCNumService             dbproto.Db_ETCNumServiceServer
 	DTakoFerryRowsProdService dbproto.Db_DTakoFerryRowsProdServiceServer
-	CarsService              dbproto.Db_CarsServiceServer
-	DriversService           dbproto.Db_DriversServiceServer
-	TimeCardService          dbproto.Db_TimeCardServiceServer
+	CarsService               dbproto.Db_CarsServiceServer
+	DriversService            dbproto.Db_DriversServiceServer
+	TimeCardService           dbproto.Db_TimeCardServiceServer
+
+	// ローカルDB・本番DBを使用するサービス
+	ETCMeisaiMatcherService      dbproto.Db_ETCMeisaiMatcherServiceServer
+	ETCMeisaiMappingAuditService dbproto.Db_ETCMeisaiMappingAuditServiceServer
+	VehicleIdentityService       dbproto.Db_VehicleIdentityServiceServer
 
 	// SQL Server (ichibanboshi) 用サービス（読み取り専用）
 	UntenNippoMeisaiService dbproto.Db_UntenNippoMeisaiServiceServer
 	ShainMasterService      dbproto.Db_ShainMasterServiceServer
 	ChiikiMasterService     dbproto.Db_ChiikiMasterServiceServer
 	ChikuMasterService      dbproto.Db_ChikuMasterServiceServer
+	TokuisakiMasterService  dbproto.Db_TokuisakiMasterServiceServer
+	HinmeiMasterService     dbproto.Db_HinmeiMasterServiceServer
+	BumonMasterService      dbproto.Db_BumonMasterServiceServer
+	SharyoMasterService     dbproto.Db_SharyoMasterServiceServer
+
+	// レジストリ状態確認サービス
+	RegistryService dbproto.Db_RegistryServiceServer
 
 	// オプション
 	options *RegistryOptions
+
+	// レジストリ自身が開いたDB接続（Closeで閉じる）
+	ownedLocalDB     *gorm.DB
+	ownedProdDB      *config.ProdDatabase
+	ownedSQLServerDB *config.SQLServerDatabase
+
+	// 使用中のDB接続（ヘルスチェック用）
+	localDB     *gorm.DB
+	prodDB      *config.ProdDatabase
+	sqlServerDB *config.SQLServerDatabase
+
+	// バックエンドDBごとの接続状態
+	mu           sync.RWMutex
+	availability AvailabilityReport
+	healthServer *health.Server
+	// registered RegisterAllで登録したサービス（gRPCサービス名→使用するバックエンド名）
+	registered map[string]string
+
+	// バックグラウンド再接続
+	reconnects []reconnectTask
+	done       chan struct{}
+	closeOnce  sync.Once
+	wg         sync.WaitGroup
 }
 
 // NewServiceRegistry creates a new service registry with all db_service services initialized
 // Returns nil if db_service initialization fails
+//
+// 既存のDB接続を再利用する場合:
+//
+//	reg := registry.NewServiceRegistry(
+//		registry.WithLocalDB(localDB),
+//		registry.WithProdDB(prodDB),
+//		registry.WithSQLServerDB(sqlServerDB),
+//	)
+//	defer reg.Close()
 func NewServiceRegistry(opts ...RegistryOption) *ServiceRegistry {
+	registry, err := NewServiceRegistryE(opts...)
+	if err != nil {
+		log.Printf("Warning: %v", err)
+		return nil
+	}
+	return registry
+}
+
+// NewServiceRegistryE NewServiceRegistryのエラーを返すバージョン
+// ローカルDBが使用できない場合は*InitErrorを返す
+// 本番DB・SQL Serverは任意のため、接続できなくてもエラーにはせずAvailability()で状態を返す
+//
+// Usage:
+//
+//	reg, err := registry.NewServiceRegistryE()
+//	if err != nil {
+//		log.Fatalf("db_service unavailable: %v", err)
+//	}
+//	if report := reg.Availability(); !report.Prod.Available {
+//		log.Printf("running without production DB: %s", report.Prod.Reason)
+//	}
+func NewServiceRegistryE(opts ...RegistryOption) (*ServiceRegistry, error) {
 	// デフォルトオプション
 	options := &RegistryOptions{}
 	for _, opt := range opts {
 		opt(options)
 	}
-	// Load db_service configuration
-	cfg, err := config.LoadConfig()
-	if err != nil {
-		log.Printf("Warning: Failed to load db_service config: %v", err)
-		return nil
+	if err := options.validate(); err != nil {
+		return nil, err
 	}
+	registry := &ServiceRegistry{options: options, done: make(chan struct{})}
 
 	// Initialize db_service database connection
-	db, err := config.InitDatabase(cfg)
-	if err != nil {
-		log.Printf("Warning: Failed to initialize db_service database: %v", err)
-		return nil
-	}
+	db := options.LocalDB
+	if db == nil {
+		// Load db_service configuration
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return nil, &InitError{Backend: BackendLocal, Op: "load config", Err: err}
+		}
 
-	// Health check
-	if err := config.HealthCheck(db); err != nil {
-		log.Printf("Warning: db_service database health check failed: %v", err)
-		return nil
+		db, err = config.InitDatabase(cfg)
+		if err != nil {
+			return nil, &InitError{Backend: BackendLocal, Op: "connect", Err: err}
+		}
+
+		// Health check
+		if err := config.HealthCheck(db); err != nil {
+			_ = config.CloseDatabase(db)
+			return nil, &InitError{Backend: BackendLocal, Op: "health check", Err: err}
+		}
+		registry.ownedLocalDB = db
+	}
+	registry.localDB = db
+	if registry.ownedLocalDB == nil {
+		registry.availability.Local = newBackendStatus(BackendLocal, pingInjectedDB(db))
+	} else {
+		registry.availability.Local = newBackendStatus(BackendLocal, nil)
 	}
 
 	// Initialize local DB repositories
@@ -102,26 +323,123 @@ func NewServiceRegistry(opts ...RegistryOption) *ServiceRegistry {
 	etcMeisaiMappingRepo := repository.NewETCMeisaiMappingRepository(db)
 	timeCardDevRepo := repository.NewTimeCardDevRepository(db)
 	timeCardLogRepo := repository.NewTimeCardLogRepository(db)
-
-	// Initialize production DB connection (optional)
-	prodDB, err := config.NewProdDatabase()
-	var dtakoCarsService dbproto.Db_DTakoCarsServiceServer
-	var dtakoEventsService dbproto.Db_DTakoEventsServiceServer
-	var dtakoRowsService dbproto.Db_DTakoRowsServiceServer
-	var etcNumService dbproto.Db_ETCNumServiceServer
-	var dtakoFerryRowsProdService dbproto.Db_DTakoFerryRowsProdServiceServer
-	var carsService dbproto.Db_CarsServiceServer
-	var driversService dbproto.Db_DriversServiceServer
-	var timeCardService dbproto.Db_TimeCardServiceServer
+	employeeIdentityRepo := repository.NewEmployeeIdentityRepository(db)
+
+	// 部門名の解決（一番星の部門マスタ、SQL Server未設定の場合はnil、再接続中は部門名なし）
+	// 本番DBのサービスでも使用するため、本番DBより先にSQL Serverを初期化する
+	var bumonResolver *service.BumonResolver
+	// 車輌の識別子の突き合わせに使用する車輌マスタ（SQL Server未設定の場合はnil）
+	var sharyoMasterRepo repository.SharyoMasterRepository
+	// 社員の識別子の突き合わせに使用する本番DB・SQL Serverのリポジトリ（未設定の場合はnil）
+	var (
+		employeeDriversRepo    repository.DriversRepository
+		employeeDTakoRowsRepo  repository.DTakoRowsRepository
+		employeeShainRepo      repository.ShainMasterRepository
+		employeeUntenNippoRepo repository.UntenNippoMeisaiRepository
+	)
 
 	// Initialize SQL Server (ichibanboshi) connection (optional)
-	sqlServerDB, sqlErr := config.NewSQLServerDatabase()
-	var untenNippoMeisaiService dbproto.Db_UntenNippoMeisaiServiceServer
-	var shainMasterService dbproto.Db_ShainMasterServiceServer
-	var chiikiMasterService dbproto.Db_ChiikiMasterServiceServer
-	var chikuMasterService dbproto.Db_ChikuMasterServiceServer
+	// 起動時に接続できない場合もサービスは作成し、バックグラウンドで再接続する
+	sqlServerDB := options.SQLServerDB
+	if sqlServerDB == nil {
+		var err error
+		sqlServerDB, err = newSQLServerDatabase()
+		switch {
+		case err == nil:
+			registry.ownedSQLServerDB = sqlServerDB
+		case errors.Is(err, config.ErrSQLServerNotConfigured):
+			sqlServerDB = nil
+			registry.availability.SQLServer = newBackendStatus(BackendSQLServer, err)
+			log.Printf("Warning: SQL Server not available: %v", err)
+		default:
+			sqlServerDB = &config.SQLServerDatabase{}
+			registry.ownedSQLServerDB = sqlServerDB
+			registry.availability.SQLServer = newBackendStatus(BackendSQLServer, err)
+			log.Printf("Warning: SQL Server not available, retrying in background: %v", err)
+			registry.addReconnect(BackendSQLServer, func() error {
+				db, err := newSQLServerDatabase()
+				if err != nil {
+					return err
+				}
+				// リポジトリはConn()で参照するため、作成済みのサービスも再接続後の接続を使用する
+				sqlServerDB.SetConn(db.Conn())
+				return nil
+			})
+		}
+	}
+	if sqlServerDB != nil {
+		switch {
+		case options.SQLServerDB != nil:
+			registry.availability.SQLServer = newBackendStatus(BackendSQLServer, pingInjectedDB(sqlServerDB.Conn()))
+		case sqlServerDB.Connected():
+			registry.availability.SQLServer = newBackendStatus(BackendSQLServer, nil)
+		}
+		registry.sqlServerDB = sqlServerDB
+
+		// Initialize SQL Server repositories
+		untenNippoMeisaiRepo := repository.NewUntenNippoMeisaiRepository(sqlServerDB)
+		shainMasterRepo := repository.NewShainMasterRepository(sqlServerDB)
+		chiikiMasterRepo := repository.NewChiikiMasterRepository(sqlServerDB)
+		chikuMasterRepo := repository.NewChikuMasterRepository(sqlServerDB)
+		tokuisakiMasterRepo := repository.NewTokuisakiMasterRepository(sqlServerDB)
+		hinmeiMasterRepo := repository.NewHinmeiMasterRepository(sqlServerDB)
+		bumonMasterRepo := repository.NewBumonMasterRepository(sqlServerDB)
+		bumonResolver = service.NewBumonResolver(bumonMasterRepo,
+			service.WithBumonAvailability(registry.backendAvailable(BackendSQLServer)))
+		sharyoMasterRepo = repository.NewSharyoMasterRepository(sqlServerDB)
+		employeeShainRepo = shainMasterRepo
+		employeeUntenNippoRepo = untenNippoMeisaiRepo
+
+		// Initialize SQL Server services
+		registry.UntenNippoMeisaiService = service.NewUntenNippoMeisaiService(untenNippoMeisaiRepo, bumonResolver)
+		registry.ShainMasterService = service.NewShainMasterService(shainMasterRepo)
+		registry.ChiikiMasterService = service.NewChiikiMasterService(chiikiMasterRepo)
+		registry.ChikuMasterService = service.NewChikuMasterService(chikuMasterRepo)
+		registry.TokuisakiMasterService = service.NewTokuisakiMasterService(tokuisakiMasterRepo)
+		registry.HinmeiMasterService = service.NewHinmeiMasterService(hinmeiMasterRepo)
+		registry.BumonMasterService = service.NewBumonMasterService(bumonMasterRepo)
+		registry.SharyoMasterService = service.NewSharyoMasterService(sharyoMasterRepo)
+
+		log.Println("SQL Server (ichibanboshi) services initialized successfully")
+	}
+
+	// Initialize production DB connection (optional)
+	// 起動時に接続できない場合もサービスは作成し、バックグラウンドで再接続する
+	prodDB := options.ProdDB
+	if prodDB == nil {
+		var err error
+		prodDB, err = newProdDatabase()
+		switch {
+		case err == nil:
+			registry.ownedProdDB = prodDB
+		case errors.Is(err, config.ErrProdDBNotConfigured):
+			prodDB = nil
+			registry.availability.Prod = newBackendStatus(BackendProd, err)
+			log.Printf("Warning: Production DB not available: %v", err)
+		default:
+			prodDB = &config.ProdDatabase{}
+			registry.ownedProdDB = prodDB
+			registry.availability.Prod = newBackendStatus(BackendProd, err)
+			log.Printf("Warning: Production DB not available, retrying in background: %v", err)
+			registry.addReconnect(BackendProd, func() error {
+				db, err := newProdDatabase()
+				if err != nil {
+					return err
+				}
+				prodDB.SetConn(db.Conn())
+				return nil
+			})
+		}
+	}
+	if prodDB != nil {
+		switch {
+		case options.ProdDB != nil:
+			registry.availability.Prod = newBackendStatus(BackendProd, pingInjectedDB(prodDB.Conn()))
+		case prodDB.Connected():
+			registry.availability.Prod = newBackendStatus(BackendProd, nil)
+		}
+		registry.prodDB = prodDB
 
-	if err == nil && prodDB != nil {
 		// Initialize production DB repositories
 		dtakoCarsRepo := repository.NewDTakoCarsRepository(prodDB)
 		dtakoEventsRepo := repository.NewDTakoEventsRepository(prodDB)
@@ -131,152 +449,234 @@ func NewServiceRegistry(opts ...RegistryOption) *ServiceRegistry {
 		carsRepo := repository.NewCarsRepository(prodDB)
 		driversRepo := repository.NewDriversRepository(prodDB)
 		timeCardRepo := repository.NewTimeCardRepository(prodDB)
+		employeeDriversRepo = driversRepo
+		employeeDTakoRowsRepo = dtakoRowsRepo
 
 		// Initialize production DB services
-		dtakoCarsService = service.NewDTakoCarsService(dtakoCarsRepo)
-		dtakoEventsService = service.NewDTakoEventsService(dtakoEventsRepo)
-		dtakoRowsService = service.NewDTakoRowsService(dtakoRowsRepo)
-		etcNumService = service.NewETCNumService(etcNumRepo)
-		dtakoFerryRowsProdService = service.NewDTakoFerryRowsProdService(dtakoFerryRowsProdRepo)
-		carsService = service.NewCarsService(carsRepo)
-		driversService = service.NewDriversService(driversRepo)
-		timeCardService = service.NewTimeCardService(timeCardRepo)
+		registry.DTakoCarsService = service.NewDTakoCarsService(dtakoCarsRepo)
+		registry.DTakoEventsService = service.NewDTakoEventsService(dtakoEventsRepo)
+		registry.DTakoRowsService = service.NewDTakoRowsService(dtakoRowsRepo)
+		registry.ETCNumService = service.NewETCNumService(etcNumRepo)
+		registry.DTakoFerryRowsProdService = service.NewDTakoFerryRowsProdService(dtakoFerryRowsProdRepo)
+		registry.CarsService = service.NewCarsService(carsRepo, bumonResolver)
+		registry.DriversService = service.NewDriversService(driversRepo, bumonResolver)
+		registry.TimeCardService = service.NewTimeCardService(timeCardRepo)
+		registry.ETCMeisaiMatcherService = service.NewETCMeisaiMatcherService(
+			etcmatch.New(etcMeisaiRepo, etcMeisaiMappingRepo, etcNumRepo, dtakoRowsRepo))
+		registry.ETCMeisaiMappingAuditService = service.NewETCMeisaiMappingAuditService(
+			mappingaudit.New(etcMeisaiMappingRepo, etcMeisaiRepo, dtakoRowsRepo))
+		registry.VehicleIdentityService = service.NewVehicleIdentityService(
+			vehicleid.New(carsRepo, dtakoCarsRepo, etcNumRepo, sharyoMasterRepo,
+				vehicleid.WithSharyoAvailability(registry.backendAvailable(BackendSQLServer))))
 
 		log.Println("Production DB services initialized successfully")
-	} else {
-		log.Printf("Warning: Production DB not available: %v", err)
 	}
 
-	if sqlErr == nil && sqlServerDB != nil {
-		// Initialize SQL Server repositories
-		untenNippoMeisaiRepo := repository.NewUntenNippoMeisaiRepository(sqlServerDB)
-		shainMasterRepo := repository.NewShainMasterRepository(sqlServerDB)
-		chiikiMasterRepo := repository.NewChiikiMasterRepository(sqlServerDB)
-		chikuMasterRepo := repository.NewChikuMasterRepository(sqlServerDB)
+	// Local DB services
+	registry.ETCMeisaiService = service.NewETCMeisaiService(etcMeisaiRepo)
+	registry.DTakoUriageKeihiService = service.NewDTakoUriageKeihiService(dtakoUriageKeihiRepo)
+	registry.DTakoFerryRowsService = service.NewDTakoFerryRowsService(dtakoFerryRowsRepo)
+	registry.ETCMeisaiMappingService = service.NewETCMeisaiMappingService(etcMeisaiMappingRepo)
+	registry.TimeCardDevService = service.NewTimeCardDevService(timeCardDevRepo)
+	registry.TimeCardLogService = service.NewTimeCardLogService(timeCardLogRepo)
+	registry.EmployeeIdentityService = service.NewEmployeeIdentityService(employeeIdentityRepo,
+		employeeid.New(employeeIdentityRepo, timeCardLogRepo,
+			employeeDriversRepo, employeeDTakoRowsRepo, employeeShainRepo, employeeUntenNippoRepo,
+			employeeid.WithAvailability(registry.backendAvailable(BackendProd), registry.backendAvailable(BackendSQLServer))))
+
+	// Registry service
+	registry.RegistryService = &registryService{registry: registry}
+
+	// 起動時に接続できなかったDBの再接続を開始
+	registry.startReconnects()
+
+	return registry, nil
+}
 
-		// Initialize SQL Server services
-		untenNippoMeisaiService = service.NewUntenNippoMeisaiService(untenNippoMeisaiRepo)
-		shainMasterService = service.NewShainMasterService(shainMasterRepo)
-		chiikiMasterService = service.NewChiikiMasterService(chiikiMasterRepo)
-		chikuMasterService = service.NewChikuMasterService(chikuMasterRepo)
+// Availability バックエンドDBごとの接続状態を取得
+func (r *ServiceRegistry) Availability() AvailabilityReport {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.availability
+}
 
-		log.Println("SQL Server (ichibanboshi) services initialized successfully")
-	} else {
-		log.Printf("Warning: SQL Server not available: %v", sqlErr)
-	}
-
-	return &ServiceRegistry{
-		// Local DB services
-		ETCMeisaiService:        service.NewETCMeisaiService(etcMeisaiRepo),
-		DTakoUriageKeihiService: service.NewDTakoUriageKeihiService(dtakoUriageKeihiRepo),
-		DTakoFerryRowsService:   service.NewDTakoFerryRowsService(dtakoFerryRowsRepo),
-		ETCMeisaiMappingService: service.NewETCMeisaiMappingService(etcMeisaiMappingRepo),
-		TimeCardDevService:      service.NewTimeCardDevService(timeCardDevRepo),
-		TimeCardLogService:      service.NewTimeCardLogService(timeCardLogRepo),
-
-		// Production DB services (may be nil if prod DB not available)
-		DTakoCarsService:         dtakoCarsService,
-		DTakoEventsService:       dtakoEventsService,
-		DTakoRowsService:         dtakoRowsService,
-		ETCNumService:            etcNumService,
-		DTakoFerryRowsProdService: dtakoFerryRowsProdService,
-		CarsService:              carsService,
-		DriversService:           driversService,
-		TimeCardService:          timeCardService,
-
-		// SQL Server services (may be nil if SQL Server not available)
-		UntenNippoMeisaiService: untenNippoMeisaiService,
-		ShainMasterService:      shainMasterService,
-		ChiikiMasterService:     chiikiMasterService,
-		ChikuMasterService:      chikuMasterService,
-
-		// オプション保存
-		options: options,
+// Close レジストリ自身が開いたDB接続を閉じ、バックグラウンド再接続を停止する
+// WithLocalDB / WithProdDB / WithSQLServerDB で注入された接続は呼び出し側が管理するため閉じない
+func (r *ServiceRegistry) Close() error {
+	// バックグラウンド再接続を停止
+	r.closeOnce.Do(func() {
+		if r.done != nil {
+			close(r.done)
+		}
+	})
+	r.wg.Wait()
+
+	var errs []error
+	if r.ownedLocalDB != nil {
+		if err := config.CloseDatabase(r.ownedLocalDB); err != nil {
+			errs = append(errs, err)
+		}
+		r.ownedLocalDB = nil
+	}
+	if r.ownedProdDB != nil && r.ownedProdDB.Connected() {
+		if err := r.ownedProdDB.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to close production database: %w", err))
+		}
+		r.ownedProdDB = nil
 	}
+	if r.ownedSQLServerDB != nil && r.ownedSQLServerDB.Connected() {
+		if err := r.ownedSQLServerDB.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to close SQL Server database: %w", err))
+		}
+		r.ownedSQLServerDB = nil
+	}
+	return errors.Join(errs...)
 }
 
 // RegisterAll registers all db_service services to the gRPC server
+// WithExcludeServices / WithOnlyServices で除外されたサービスは登録しない
+// バックエンドDBが使用できない間、そのDBを使用するサービスはUNAVAILABLEを返す
 // This method automatically detects and registers all available services from db_service
 // When new services are added to db_service, they will be automatically registered here
 func (r *ServiceRegistry) RegisterAll(server *grpc.Server) {
-	if r.ETCMeisaiService != nil {
-		dbproto.RegisterDb_ETCMeisaiServiceServer(server, r.ETCMeisaiService)
+	if r.ETCMeisaiService != nil && r.options.isEnabled("ETCMeisaiService") {
+		r.registerService(server, &dbproto.Db_ETCMeisaiService_ServiceDesc, r.ETCMeisaiService)
 		log.Println("Registered: ETCMeisaiService")
 	}
-	if r.DTakoUriageKeihiService != nil {
-		dbproto.RegisterDb_DTakoUriageKeihiServiceServer(server, r.DTakoUriageKeihiService)
+	if r.DTakoUriageKeihiService != nil && r.options.isEnabled("DTakoUriageKeihiService") {
+		r.registerService(server, &dbproto.Db_DTakoUriageKeihiService_ServiceDesc, r.DTakoUriageKeihiService)
 		log.Println("Registered: DTakoUriageKeihiService")
 	}
-	if r.DTakoFerryRowsService != nil {
-		dbproto.RegisterDb_DTakoFerryRowsServiceServer(server, r.DTakoFerryRowsService)
+	if r.DTakoFerryRowsService != nil && r.options.isEnabled("DTakoFerryRowsService") {
+		r.registerService(server, &dbproto.Db_DTakoFerryRowsService_ServiceDesc, r.DTakoFerryRowsService)
 		log.Println("Registered: DTakoFerryRowsService")
 	}
-	if r.ETCMeisaiMappingService != nil {
-		dbproto.RegisterDb_ETCMeisaiMappingServiceServer(server, r.ETCMeisaiMappingService)
+	if r.ETCMeisaiMappingService != nil && r.options.isEnabled("ETCMeisaiMappingService") {
+		r.registerService(server, &dbproto.Db_ETCMeisaiMappingService_ServiceDesc, r.ETCMeisaiMappingService)
 		log.Println("Registered: ETCMeisaiMappingService")
 	}
-	if r.TimeCardDevService != nil {
-		dbproto.RegisterDb_TimeCardDevServiceServer(server, r.TimeCardDevService)
+	if r.TimeCardDevService != nil && r.options.isEnabled("TimeCardDevService") {
+		r.registerService(server, &dbproto.Db_TimeCardDevService_ServiceDesc, r.TimeCardDevService)
 		log.Println("Registered: TimeCardDevService (Local DB)")
 	}
-	if r.TimeCardLogService != nil {
-		dbproto.RegisterDb_TimeCardLogServiceServer(server, r.TimeCardLogService)
+	if r.TimeCardLogService != nil && r.options.isEnabled("TimeCardLogService") {
+		r.registerService(server, &dbproto.Db_TimeCardLogService_ServiceDesc, r.TimeCardLogService)
 		log.Println("Registered: TimeCardLogService (Local DB)")
 	}
+	if r.EmployeeIdentityService != nil && r.options.isEnabled("EmployeeIdentityService") {
+		r.registerService(server, &dbproto.Db_EmployeeIdentityService_ServiceDesc, r.EmployeeIdentityService)
+		log.Println("Registered: EmployeeIdentityService (Local DB + Production DB + SQL Server)")
+	}
 
 	// Production DB services
-	if r.DTakoCarsService != nil {
-		dbproto.RegisterDb_DTakoCarsServiceServer(server, r.DTakoCarsService)
+	if r.DTakoCarsService != nil && r.options.isEnabled("DTakoCarsService") {
+		r.registerService(server, &dbproto.Db_DTakoCarsService_ServiceDesc, r.DTakoCarsService)
 		log.Println("Registered: DTakoCarsService (Production DB)")
 	}
-	if r.DTakoEventsService != nil && !r.options.isExcluded("DTakoEventsService") {
-		dbproto.RegisterDb_DTakoEventsServiceServer(server, r.DTakoEventsService)
+	if r.DTakoEventsService != nil && r.options.isEnabled("DTakoEventsService") {
+		r.registerService(server, &dbproto.Db_DTakoEventsService_ServiceDesc, r.DTakoEventsService)
 		log.Println("Registered: DTakoEventsService (Production DB)")
 	}
-	if r.DTakoRowsService != nil && !r.options.isExcluded("DTakoRowsService") {
-		dbproto.RegisterDb_DTakoRowsServiceServer(server, r.DTakoRowsService)
+	if r.DTakoRowsService != nil && r.options.isEnabled("DTakoRowsService") {
+		r.registerService(server, &dbproto.Db_DTakoRowsService_ServiceDesc, r.DTakoRowsService)
 		log.Println("Registered: DTakoRowsService (Production DB)")
 	}
-	if r.ETCNumService != nil {
-		dbproto.RegisterDb_ETCNumServiceServer(server, r.ETCNumService)
+	if r.ETCNumService != nil && r.options.isEnabled("ETCNumService") {
+		r.registerService(server, &dbproto.Db_ETCNumService_ServiceDesc, r.ETCNumService)
 		log.Println("Registered: ETCNumService (Production DB)")
 	}
-	if r.DTakoFerryRowsProdService != nil {
-		dbproto.RegisterDb_DTakoFerryRowsProdServiceServer(server, r.DTakoFerryRowsProdService)
+	if r.DTakoFerryRowsProdService != nil && r.options.isEnabled("DTakoFerryRowsProdService") {
+		r.registerService(server, &dbproto.Db_DTakoFerryRowsProdService_ServiceDesc, r.DTakoFerryRowsProdService)
 		log.Println("Registered: DTakoFerryRowsProdService (Production DB)")
 	}
-	if r.CarsService != nil {
-		dbproto.RegisterDb_CarsServiceServer(server, r.CarsService)
+	if r.CarsService != nil && r.options.isEnabled("CarsService") {
+		r.registerService(server, &dbproto.Db_CarsService_ServiceDesc, r.CarsService)
 		log.Println("Registered: CarsService (Production DB)")
 	}
-	if r.DriversService != nil {
-		dbproto.RegisterDb_DriversServiceServer(server, r.DriversService)
+	if r.DriversService != nil && r.options.isEnabled("DriversService") {
+		r.registerService(server, &dbproto.Db_DriversService_ServiceDesc, r.DriversService)
 		log.Println("Registered: DriversService (Production DB)")
 	}
-	if r.TimeCardService != nil {
-		dbproto.RegisterDb_TimeCardServiceServer(server, r.TimeCardService)
+	if r.TimeCardService != nil && r.options.isEnabled("TimeCardService") {
+		r.registerService(server, &dbproto.Db_TimeCardService_ServiceDesc, r.TimeCardService)
 		log.Println("Registered: TimeCardService (Production DB)")
 	}
+	if r.ETCMeisaiMatcherService != nil && r.options.isEnabled("ETCMeisaiMatcherService") {
+		r.registerService(server, &dbproto.Db_ETCMeisaiMatcherService_ServiceDesc, r.ETCMeisaiMatcherService)
+		log.Println("Registered: ETCMeisaiMatcherService (Local DB + Production DB)")
+	}
+	if r.ETCMeisaiMappingAuditService != nil && r.options.isEnabled("ETCMeisaiMappingAuditService") {
+		r.registerService(server, &dbproto.Db_ETCMeisaiMappingAuditService_ServiceDesc, r.ETCMeisaiMappingAuditService)
+		log.Println("Registered: ETCMeisaiMappingAuditService (Local DB + Production DB)")
+	}
+	if r.VehicleIdentityService != nil && r.options.isEnabled("VehicleIdentityService") {
+		r.registerService(server, &dbproto.Db_VehicleIdentityService_ServiceDesc, r.VehicleIdentityService)
+		log.Println("Registered: VehicleIdentityService (Production DB + SQL Server)")
+	}
 
 	// SQL Server services
-	if r.UntenNippoMeisaiService != nil {
-		dbproto.RegisterDb_UntenNippoMeisaiServiceServer(server, r.UntenNippoMeisaiService)
+	if r.UntenNippoMeisaiService != nil && r.options.isEnabled("UntenNippoMeisaiService") {
+		r.registerService(server, &dbproto.Db_UntenNippoMeisaiService_ServiceDesc, r.UntenNippoMeisaiService)
 		log.Println("Registered: UntenNippoMeisaiService (SQL Server)")
 	}
-	if r.ShainMasterService != nil {
-		dbproto.RegisterDb_ShainMasterServiceServer(server, r.ShainMasterService)
+	if r.ShainMasterService != nil && r.options.isEnabled("ShainMasterService") {
+		r.registerService(server, &dbproto.Db_ShainMasterService_ServiceDesc, r.ShainMasterService)
 		log.Println("Registered: ShainMasterService (SQL Server)")
 	}
-	if r.ChiikiMasterService != nil {
-		dbproto.RegisterDb_ChiikiMasterServiceServer(server, r.ChiikiMasterService)
+	if r.ChiikiMasterService != nil && r.options.isEnabled("ChiikiMasterService") {
+		r.registerService(server, &dbproto.Db_ChiikiMasterService_ServiceDesc, r.ChiikiMasterService)
 		log.Println("Registered: ChiikiMasterService (SQL Server)")
 	}
-	if r.ChikuMasterService != nil {
-		dbproto.RegisterDb_ChikuMasterServiceServer(server, r.ChikuMasterService)
+	if r.ChikuMasterService != nil && r.options.isEnabled("ChikuMasterService") {
+		r.registerService(server, &dbproto.Db_ChikuMasterService_ServiceDesc, r.ChikuMasterService)
 		log.Println("Registered: ChikuMasterService (SQL Server)")
 	}
+	if r.TokuisakiMasterService != nil && r.options.isEnabled("TokuisakiMasterService") {
+		r.registerService(server, &dbproto.Db_TokuisakiMasterService_ServiceDesc, r.TokuisakiMasterService)
+		log.Println("Registered: TokuisakiMasterService (SQL Server)")
+	}
+	if r.HinmeiMasterService != nil && r.options.isEnabled("HinmeiMasterService") {
+		r.registerService(server, &dbproto.Db_HinmeiMasterService_ServiceDesc, r.HinmeiMasterService)
+		log.Println("Registered: HinmeiMasterService (SQL Server)")
+	}
+	if r.BumonMasterService != nil && r.options.isEnabled("BumonMasterService") {
+		r.registerService(server, &dbproto.Db_BumonMasterService_ServiceDesc, r.BumonMasterService)
+		log.Println("Registered: BumonMasterService (SQL Server)")
+	}
+	if r.SharyoMasterService != nil && r.options.isEnabled("SharyoMasterService") {
+		r.registerService(server, &dbproto.Db_SharyoMasterService_ServiceDesc, r.SharyoMasterService)
+		log.Println("Registered: SharyoMasterService (SQL Server)")
+	}
+
+	// Registry service
+	if r.RegistryService != nil && r.options.isEnabled("RegistryService") {
+		r.registerService(server, &dbproto.Db_RegistryService_ServiceDesc, r.RegistryService)
+		log.Println("Registered: RegistryService")
+	}
 
 	fmt.Println("db_service: All services registered successfully")
+	// RegisterHealthを先に呼んだ場合も登録したサービスの状態を反映する
+	r.updateHealthServer()
+}
+
+// registerService serviceCatalogのバックエンドが使用できない間はUNAVAILABLEを返すようにしてサービスを登録し、
+// ヘルスチェックの対象として記録する
+func (r *ServiceRegistry) registerService(server *grpc.Server, desc *grpc.ServiceDesc, impl interface{}) {
+	var backend string
+	for _, entry := range serviceCatalog {
+		if entry.grpcName == desc.ServiceName {
+			backend = entry.backend
+			break
+		}
+	}
+	server.RegisterService(r.guardServiceDesc(desc, backend), impl)
+
+	r.mu.Lock()
+	if r.registered == nil {
+		r.registered = make(map[string]string)
+	}
+	r.registered[desc.ServiceName] = backend
+	r.mu.Unlock()
 }
 
 // Register is a convenience function for creating and registering all db_service services
@@ -291,6 +691,8 @@ func (r *ServiceRegistry) RegisterAll(server *grpc.Server) {
 //	registry.Register(grpcServer)
 //	// または特定のサービスを除外
 //	registry.Register(grpcServer, registry.WithExcludeServices("DTakoRowsService", "DTakoEventsService"))
+//	// または特定のサービスのみ登録
+//	registry.Register(grpcServer, registry.WithOnlyServices("ETCMeisaiService", "ETCMeisaiMappingService"))
 func Register(server *grpc.Server, opts ...RegistryOption) *ServiceRegistry {
 	registry := NewServiceRegistry(opts...)
 	if registry == nil {
@@ -1,10 +1,18 @@
 package registry_test
 
 import (
+	"context"
+	"errors"
+	"strings"
 	"testing"
 
+	"github.com/glebarez/sqlite"
+	"github.com/yhonda-ohishi/db_service/src/config"
+	dbproto "github.com/yhonda-ohishi/db_service/src/proto"
 	"github.com/yhonda-ohishi/db_service/src/registry"
 	"google.golang.org/grpc"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
 )
 
 func TestRegister(t *testing.T) {
@@ -22,6 +30,26 @@ func TestRegister(t *testing.T) {
 	}
 }
 
+func TestNewServiceRegistryE(t *testing.T) {
+	reg, err := registry.NewServiceRegistryE()
+	if err != nil {
+		// DB未設定の環境ではローカルDBの初期化エラーになる
+		var initErr *registry.InitError
+		if !errors.As(err, &initErr) {
+			t.Fatalf("expected *registry.InitError, got %T: %v", err, err)
+		}
+		if initErr.Backend != registry.BackendLocal {
+			t.Errorf("expected backend %q, got %q", registry.BackendLocal, initErr.Backend)
+		}
+		return
+	}
+	defer reg.Close()
+
+	if !reg.Availability().Local.Available {
+		t.Error("local backend should be available")
+	}
+}
+
 func TestNewServiceRegistry(t *testing.T) {
 	reg := registry.NewServiceRegistry()
 
@@ -44,3 +72,184 @@ func TestNewServiceRegistry(t *testing.T) {
 		t.Error("ETCMeisaiMappingService should not be nil")
 	}
 }
+
+// newUnconnectedDB 実際には接続しないgorm.DBを作成
+func newUnconnectedDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(mysql.New(mysql.Config{
+		DSN:                       "user:password@tcp(127.0.0.1:1)/db1?parseTime=True",
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{DisableAutomaticPing: true})
+	if err != nil {
+		t.Fatalf("failed to open gorm.DB: %v", err)
+	}
+	return db
+}
+
+// newConnectedDB 接続できるgorm.DB（インメモリのSQLite）を作成
+func newConnectedDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open sqlite: %v", err)
+	}
+	return db
+}
+
+func TestNewServiceRegistry_WithInjectedDB(t *testing.T) {
+	localDB := newUnconnectedDB(t)
+	prodDB := &config.ProdDatabase{DB: newUnconnectedDB(t)}
+	sqlServerDB := &config.SQLServerDatabase{DB: newUnconnectedDB(t)}
+
+	reg := registry.NewServiceRegistry(
+		registry.WithLocalDB(localDB),
+		registry.WithProdDB(prodDB),
+		registry.WithSQLServerDB(sqlServerDB),
+	)
+	if reg == nil {
+		t.Fatal("registry should not be nil when DB handles are injected")
+	}
+
+	if reg.ETCMeisaiService == nil || reg.TimeCardLogService == nil {
+		t.Error("local DB services should be initialized")
+	}
+	if reg.DTakoRowsService == nil || reg.TimeCardService == nil {
+		t.Error("production DB services should be initialized")
+	}
+	if reg.UntenNippoMeisaiService == nil || reg.ChikuMasterService == nil {
+		t.Error("SQL Server services should be initialized")
+	}
+
+	// 注入した接続も初期化時に確認し、接続できない場合は理由を返す
+	for _, backend := range reg.Availability().Backends() {
+		if backend.Available {
+			t.Errorf("backend %s should not be available", backend.Backend)
+		}
+		if !strings.Contains(backend.Reason, "connection refused") {
+			t.Errorf("backend %s reason should be the ping error, got %q", backend.Backend, backend.Reason)
+		}
+	}
+
+	if err := reg.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+
+	// 注入した接続は閉じられていないこと
+	for name, db := range map[string]*gorm.DB{"local": localDB, "prod": prodDB.Conn(), "sqlserver": sqlServerDB.Conn()} {
+		sqlDB, err := db.DB()
+		if err != nil {
+			t.Fatalf("failed to get sql.DB: %v", err)
+		}
+		if err := sqlDB.Ping(); err != nil && strings.Contains(err.Error(), "database is closed") {
+			t.Errorf("%s DB should not be closed by registry", name)
+		}
+		sqlDB.Close()
+	}
+}
+
+func TestRegistryService_GetAvailability(t *testing.T) {
+	reg := registry.NewServiceRegistry(
+		registry.WithLocalDB(newConnectedDB(t)),
+		registry.WithProdDB(&config.ProdDatabase{DB: newConnectedDB(t)}),
+		registry.WithSQLServerDB(&config.SQLServerDatabase{DB: newConnectedDB(t)}),
+	)
+	if reg == nil {
+		t.Fatal("registry should not be nil")
+	}
+
+	resp, err := reg.RegistryService.GetAvailability(context.Background(), &dbproto.Db_GetAvailabilityRequest{})
+	if err != nil {
+		t.Fatalf("GetAvailability failed: %v", err)
+	}
+	if len(resp.Backends) != 3 {
+		t.Fatalf("expected 3 backends, got %d", len(resp.Backends))
+	}
+	for _, b := range resp.Backends {
+		if !b.Available {
+			t.Errorf("backend %s should be available", b.Backend)
+		}
+		if b.Reason != nil {
+			t.Errorf("backend %s should not have reason, got %q", b.Backend, b.GetReason())
+		}
+	}
+}
+
+// newInjectedRegistry 接続しないDBを注入したレジストリを作成
+func newInjectedRegistry(t *testing.T, opts ...registry.RegistryOption) (*registry.ServiceRegistry, error) {
+	t.Helper()
+	opts = append([]registry.RegistryOption{
+		registry.WithLocalDB(newUnconnectedDB(t)),
+		registry.WithProdDB(&config.ProdDatabase{DB: newUnconnectedDB(t)}),
+		registry.WithSQLServerDB(&config.SQLServerDatabase{DB: newUnconnectedDB(t)}),
+	}, opts...)
+	return registry.NewServiceRegistryE(opts...)
+}
+
+func TestRegisterAll_ServiceFilters(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     []registry.RegistryOption
+		expected []string
+		excluded []string
+	}{
+		{
+			name:     "exclude",
+			opts:     []registry.RegistryOption{registry.WithExcludeServices("ETCMeisaiService", "ShainMasterService")},
+			expected: []string{"DTakoRowsService", "ChikuMasterService"},
+			excluded: []string{"ETCMeisaiService", "ShainMasterService"},
+		},
+		{
+			name:     "only",
+			opts:     []registry.RegistryOption{registry.WithOnlyServices("ETCMeisaiService", "CarsService")},
+			expected: []string{"ETCMeisaiService", "CarsService"},
+			excluded: []string{"DTakoRowsService", "ShainMasterService", "RegistryService"},
+		},
+		{
+			name: "only and exclude",
+			opts: []registry.RegistryOption{
+				registry.WithOnlyServices("ETCMeisaiService", "CarsService"),
+				registry.WithExcludeServices("CarsService"),
+			},
+			expected: []string{"ETCMeisaiService"},
+			excluded: []string{"CarsService"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg, err := newInjectedRegistry(t, tt.opts...)
+			if err != nil {
+				t.Fatalf("NewServiceRegistryE failed: %v", err)
+			}
+			grpcServer := grpc.NewServer()
+			reg.RegisterAll(grpcServer)
+
+			info := grpcServer.GetServiceInfo()
+			for _, name := range tt.expected {
+				if _, ok := info["db_service.db_"+name]; !ok {
+					t.Errorf("%s should be registered", name)
+				}
+			}
+			for _, name := range tt.excluded {
+				if _, ok := info["db_service.db_"+name]; ok {
+					t.Errorf("%s should not be registered", name)
+				}
+			}
+		})
+	}
+}
+
+func TestNewServiceRegistryE_UnknownService(t *testing.T) {
+	_, err := newInjectedRegistry(t, registry.WithExcludeServices("ETCMeisaiServce"))
+	if !errors.Is(err, registry.ErrUnknownService) {
+		t.Fatalf("expected ErrUnknownService, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "ETCMeisaiServce") {
+		t.Errorf("error should contain the unknown name: %v", err)
+	}
+
+	_, err = newInjectedRegistry(t, registry.WithOnlyServices("Unknown"))
+	if !errors.Is(err, registry.ErrUnknownService) {
+		t.Fatalf("expected ErrUnknownService, got %v", err)
+	}
+}
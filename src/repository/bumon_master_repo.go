@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"strings"
+
+	"github.com/yhonda-ohishi/db_service/src/config"
+	"github.com/yhonda-ohishi/db_service/src/models/ichibanboshi"
+)
+
+// BumonMasterRepository 部門マスタリポジトリインターフェース
+type BumonMasterRepository interface {
+	GetAll(ctx context.Context, limit, offset int, sort []SortField) ([]*ichibanboshi.BumonMaster, int64, error)
+	GetPage(ctx context.Context, page PageRequest) (*Page[ichibanboshi.BumonMaster], error)
+	GetByBumonC(ctx context.Context, bumonC string) (*ichibanboshi.BumonMaster, error)
+	GetByBumonCs(ctx context.Context, bumonCs []string) ([]*ichibanboshi.BumonMaster, error)
+}
+
+// NormalizeBumonC 部門コードを正規化（char(3)の末尾の空白などを除去）
+// 本番DB（cars.bumon_code_id, drivers.bumon）と一番星（部門C, 受注部門, 稼動部門）のコードを同じ値として比較するために使用する
+func NormalizeBumonC(bumonC string) string {
+	return strings.TrimSpace(bumonC)
+}
+
+// BumonMasterRepositoryImpl 部門マスタリポジトリ実装
+type BumonMasterRepositoryImpl struct {
+	*IchibanboshiRepository
+}
+
+// NewBumonMasterRepository 部門マスタリポジトリのコンストラクタ
+func NewBumonMasterRepository(sqlServerDB *config.SQLServerDatabase) BumonMasterRepository {
+	return &BumonMasterRepositoryImpl{
+		IchibanboshiRepository: NewIchibanboshiRepository(sqlServerDB),
+	}
+}
+
+// GetAll 全部門マスタを取得
+func (r *BumonMasterRepositoryImpl) GetAll(ctx context.Context, limit, offset int, sort []SortField) ([]*ichibanboshi.BumonMaster, int64, error) {
+	// ソート条件（ホワイトリストの列のみ）
+	orderBy, err := bumonMasterSortColumns.orderBy(sort, "部門C ASC")
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var bumon []*ichibanboshi.BumonMaster
+	var totalCount int64
+
+	// 総数取得
+	if err := r.sqlServerDB.Conn().WithContext(ctx).Model(&ichibanboshi.BumonMaster{}).Count(&totalCount).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// データ取得
+	if err := r.sqlServerDB.Conn().WithContext(ctx).Limit(limit).Offset(offset).Order(orderBy).Find(&bumon).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return bumon, totalCount, nil
+}
+
+// bumonMasterKeyset キーセットページネーションのキー（部門C ASC）
+var bumonMasterKeyset = keyset[ichibanboshi.BumonMaster]{
+	desc: false,
+	columns: []keysetColumn[ichibanboshi.BumonMaster]{
+		{column: "部門C", value: func(m *ichibanboshi.BumonMaster) interface{} { return m.BumonC }},
+	},
+}
+
+// GetPage 全部門マスタを取得（キーセットページネーション）
+func (r *BumonMasterRepositoryImpl) GetPage(ctx context.Context, page PageRequest) (*Page[ichibanboshi.BumonMaster], error) {
+	return listByKeyset(r.sqlServerDB.Conn().WithContext(ctx), bumonMasterKeyset, page)
+}
+
+// GetByBumonC 部門Cで部門マスタを取得
+func (r *BumonMasterRepositoryImpl) GetByBumonC(ctx context.Context, bumonC string) (*ichibanboshi.BumonMaster, error) {
+	var bumon ichibanboshi.BumonMaster
+	if err := r.sqlServerDB.Conn().WithContext(ctx).Where("部門C = ?", NormalizeBumonC(bumonC)).First(&bumon).Error; err != nil {
+		return nil, err
+	}
+	return &bumon, nil
+}
+
+// GetByBumonCs 複数の部門Cで部門マスタを取得（部門C順、存在しないコードは含まない）
+func (r *BumonMasterRepositoryImpl) GetByBumonCs(ctx context.Context, bumonCs []string) ([]*ichibanboshi.BumonMaster, error) {
+	if len(bumonCs) == 0 {
+		return nil, nil
+	}
+	normalized := make([]string, len(bumonCs))
+	for i, c := range bumonCs {
+		normalized[i] = NormalizeBumonC(c)
+	}
+
+	var bumon []*ichibanboshi.BumonMaster
+	if err := r.sqlServerDB.Conn().WithContext(ctx).Where("部門C IN ?", normalized).Order("部門C ASC").Find(&bumon).Error; err != nil {
+		return nil, err
+	}
+	return bumon, nil
+}
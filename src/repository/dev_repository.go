@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"time"
 
 	"github.com/yhonda-ohishi/db_service/src/models/mysql"
@@ -21,11 +22,12 @@ func NewDevRepository(db *gorm.DB) *DevRepository {
 
 // TimeCardDevRepository インターフェース
 type TimeCardDevRepository interface {
-	Create(timeCard *mysql.TimeCard) error
-	Update(timeCard *mysql.TimeCard) error
-	GetByCompositeKey(datetime time.Time, id int) (*mysql.TimeCard, error)
-	GetAll(limit, offset int, orderBy string) ([]*mysql.TimeCard, int64, error)
-	Delete(datetime time.Time, id int) error
+	Create(ctx context.Context, timeCard *mysql.TimeCard) error
+	Update(ctx context.Context, timeCard *mysql.TimeCard) error
+	GetByCompositeKey(ctx context.Context, datetime time.Time, id int) (*mysql.TimeCard, error)
+	GetAll(ctx context.Context, limit, offset int, sort []SortField) ([]*mysql.TimeCard, int64, error)
+	GetPage(ctx context.Context, page PageRequest) (*Page[mysql.TimeCard], error)
+	Delete(ctx context.Context, datetime time.Time, id int) error
 }
 
 // TimeCardDevRepositoryImpl 実装
@@ -41,41 +43,42 @@ func NewTimeCardDevRepository(db *gorm.DB) TimeCardDevRepository {
 }
 
 // Create タイムカードデータ作成
-func (r *TimeCardDevRepositoryImpl) Create(timeCard *mysql.TimeCard) error {
-	return r.db.Create(timeCard).Error
+func (r *TimeCardDevRepositoryImpl) Create(ctx context.Context, timeCard *mysql.TimeCard) error {
+	return r.db.WithContext(ctx).Create(timeCard).Error
 }
 
 // Update タイムカードデータ更新
-func (r *TimeCardDevRepositoryImpl) Update(timeCard *mysql.TimeCard) error {
-	return r.db.Save(timeCard).Error
+func (r *TimeCardDevRepositoryImpl) Update(ctx context.Context, timeCard *mysql.TimeCard) error {
+	return r.db.WithContext(ctx).Save(timeCard).Error
 }
 
 // GetByCompositeKey 複合主キー（datetime + id）でタイムカードデータを取得
-func (r *TimeCardDevRepositoryImpl) GetByCompositeKey(datetime time.Time, id int) (*mysql.TimeCard, error) {
+func (r *TimeCardDevRepositoryImpl) GetByCompositeKey(ctx context.Context, datetime time.Time, id int) (*mysql.TimeCard, error) {
 	var timeCard mysql.TimeCard
-	if err := r.db.Where("datetime = ? AND id = ?", datetime, id).First(&timeCard).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("datetime = ? AND id = ?", datetime, id).First(&timeCard).Error; err != nil {
 		return nil, err
 	}
 	return &timeCard, nil
 }
 
 // GetAll 全タイムカードデータを取得
-func (r *TimeCardDevRepositoryImpl) GetAll(limit, offset int, orderBy string) ([]*mysql.TimeCard, int64, error) {
+func (r *TimeCardDevRepositoryImpl) GetAll(ctx context.Context, limit, offset int, sort []SortField) ([]*mysql.TimeCard, int64, error) {
+	// ソート条件（ホワイトリストの列のみ）
+	orderBy, err := timeCardSortColumns.orderBy(sort, "datetime DESC")
+	if err != nil {
+		return nil, 0, err
+	}
+
 	var timeCards []*mysql.TimeCard
 	var totalCount int64
 
 	// 総件数を取得
-	if err := r.db.Model(&mysql.TimeCard{}).Count(&totalCount).Error; err != nil {
+	if err := r.db.WithContext(ctx).Model(&mysql.TimeCard{}).Count(&totalCount).Error; err != nil {
 		return nil, 0, err
 	}
 
 	// データを取得
-	query := r.db.Limit(limit).Offset(offset)
-	if orderBy != "" {
-		query = query.Order(orderBy)
-	} else {
-		query = query.Order("datetime DESC")
-	}
+	query := r.db.WithContext(ctx).Limit(limit).Offset(offset).Order(orderBy)
 
 	if err := query.Find(&timeCards).Error; err != nil {
 		return nil, 0, err
@@ -84,19 +87,27 @@ func (r *TimeCardDevRepositoryImpl) GetAll(limit, offset int, orderBy string) ([
 	return timeCards, totalCount, nil
 }
 
+// GetPage 全タイムカードデータを取得（キーセットページネーション）
+func (r *TimeCardDevRepositoryImpl) GetPage(ctx context.Context, page PageRequest) (*Page[mysql.TimeCard], error) {
+	return listByKeyset(r.db.WithContext(ctx), timeCardKeyset, page)
+}
+
 // Delete タイムカードデータ削除
-func (r *TimeCardDevRepositoryImpl) Delete(datetime time.Time, id int) error {
-	return r.db.Where("datetime = ? AND id = ?", datetime, id).Delete(&mysql.TimeCard{}).Error
+func (r *TimeCardDevRepositoryImpl) Delete(ctx context.Context, datetime time.Time, id int) error {
+	return r.db.WithContext(ctx).Where("datetime = ? AND id = ?", datetime, id).Delete(&mysql.TimeCard{}).Error
 }
 
 // TimeCardLogRepository インターフェース
 type TimeCardLogRepository interface {
-	Create(log *mysql.TimeCardLog) error
-	Update(log *mysql.TimeCardLog) error
-	GetByCompositeKey(datetime string, id int) (*mysql.TimeCardLog, error)
-	GetAll(limit, offset int, orderBy string) ([]*mysql.TimeCardLog, int64, error)
-	GetByCardID(cardID string, limit, offset int) ([]*mysql.TimeCardLog, int64, error)
-	Delete(datetime string, id int) error
+	Create(ctx context.Context, log *mysql.TimeCardLog) error
+	Update(ctx context.Context, log *mysql.TimeCardLog) error
+	GetByCompositeKey(ctx context.Context, datetime string, id int) (*mysql.TimeCardLog, error)
+	GetAll(ctx context.Context, limit, offset int, sort []SortField) ([]*mysql.TimeCardLog, int64, error)
+	GetPage(ctx context.Context, page PageRequest) (*Page[mysql.TimeCardLog], error)
+	GetByCardID(ctx context.Context, cardID string, limit, offset int) ([]*mysql.TimeCardLog, int64, error)
+	GetUserIDs(ctx context.Context) ([]int, error)
+	GetCardIDs(ctx context.Context) ([]string, error)
+	Delete(ctx context.Context, datetime string, id int) error
 }
 
 // TimeCardLogRepositoryImpl 実装
@@ -112,41 +123,42 @@ func NewTimeCardLogRepository(db *gorm.DB) TimeCardLogRepository {
 }
 
 // Create タイムカードログ作成
-func (r *TimeCardLogRepositoryImpl) Create(log *mysql.TimeCardLog) error {
-	return r.db.Create(log).Error
+func (r *TimeCardLogRepositoryImpl) Create(ctx context.Context, log *mysql.TimeCardLog) error {
+	return r.db.WithContext(ctx).Create(log).Error
 }
 
 // Update タイムカードログ更新
-func (r *TimeCardLogRepositoryImpl) Update(log *mysql.TimeCardLog) error {
-	return r.db.Save(log).Error
+func (r *TimeCardLogRepositoryImpl) Update(ctx context.Context, log *mysql.TimeCardLog) error {
+	return r.db.WithContext(ctx).Save(log).Error
 }
 
 // GetByCompositeKey 複合主キー（datetime + id）でタイムカードログを取得
-func (r *TimeCardLogRepositoryImpl) GetByCompositeKey(datetime string, id int) (*mysql.TimeCardLog, error) {
+func (r *TimeCardLogRepositoryImpl) GetByCompositeKey(ctx context.Context, datetime string, id int) (*mysql.TimeCardLog, error) {
 	var log mysql.TimeCardLog
-	if err := r.db.Where("datetime = ? AND id = ?", datetime, id).First(&log).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("datetime = ? AND id = ?", datetime, id).First(&log).Error; err != nil {
 		return nil, err
 	}
 	return &log, nil
 }
 
 // GetAll 全タイムカードログを取得
-func (r *TimeCardLogRepositoryImpl) GetAll(limit, offset int, orderBy string) ([]*mysql.TimeCardLog, int64, error) {
+func (r *TimeCardLogRepositoryImpl) GetAll(ctx context.Context, limit, offset int, sort []SortField) ([]*mysql.TimeCardLog, int64, error) {
+	// ソート条件（ホワイトリストの列のみ）
+	orderBy, err := timeCardLogSortColumns.orderBy(sort, "datetime DESC")
+	if err != nil {
+		return nil, 0, err
+	}
+
 	var logs []*mysql.TimeCardLog
 	var totalCount int64
 
 	// 総件数を取得
-	if err := r.db.Model(&mysql.TimeCardLog{}).Count(&totalCount).Error; err != nil {
+	if err := r.db.WithContext(ctx).Model(&mysql.TimeCardLog{}).Count(&totalCount).Error; err != nil {
 		return nil, 0, err
 	}
 
 	// データを取得
-	query := r.db.Limit(limit).Offset(offset)
-	if orderBy != "" {
-		query = query.Order(orderBy)
-	} else {
-		query = query.Order("datetime DESC")
-	}
+	query := r.db.WithContext(ctx).Limit(limit).Offset(offset).Order(orderBy)
 
 	if err := query.Find(&logs).Error; err != nil {
 		return nil, 0, err
@@ -155,18 +167,32 @@ func (r *TimeCardLogRepositoryImpl) GetAll(limit, offset int, orderBy string) ([
 	return logs, totalCount, nil
 }
 
+// timeCardLogKeyset キーセットページネーションのキー（datetime DESC, id DESC）
+var timeCardLogKeyset = keyset[mysql.TimeCardLog]{
+	desc: true,
+	columns: []keysetColumn[mysql.TimeCardLog]{
+		{column: "datetime", value: func(m *mysql.TimeCardLog) interface{} { return m.Datetime }},
+		{column: "id", value: func(m *mysql.TimeCardLog) interface{} { return m.ID }},
+	},
+}
+
+// GetPage 全タイムカードログを取得（キーセットページネーション）
+func (r *TimeCardLogRepositoryImpl) GetPage(ctx context.Context, page PageRequest) (*Page[mysql.TimeCardLog], error) {
+	return listByKeyset(r.db.WithContext(ctx), timeCardLogKeyset, page)
+}
+
 // GetByCardID カードIDでタイムカードログを取得
-func (r *TimeCardLogRepositoryImpl) GetByCardID(cardID string, limit, offset int) ([]*mysql.TimeCardLog, int64, error) {
+func (r *TimeCardLogRepositoryImpl) GetByCardID(ctx context.Context, cardID string, limit, offset int) ([]*mysql.TimeCardLog, int64, error) {
 	var logs []*mysql.TimeCardLog
 	var totalCount int64
 
 	// 総件数を取得
-	if err := r.db.Model(&mysql.TimeCardLog{}).Where("card_id = ?", cardID).Count(&totalCount).Error; err != nil {
+	if err := r.db.WithContext(ctx).Model(&mysql.TimeCardLog{}).Where("card_id = ?", cardID).Count(&totalCount).Error; err != nil {
 		return nil, 0, err
 	}
 
 	// データを取得
-	if err := r.db.Where("card_id = ?", cardID).
+	if err := r.db.WithContext(ctx).Where("card_id = ?", cardID).
 		Order("datetime DESC").
 		Limit(limit).
 		Offset(offset).
@@ -177,7 +203,27 @@ func (r *TimeCardLogRepositoryImpl) GetByCardID(cardID string, limit, offset int
 	return logs, totalCount, nil
 }
 
+// GetUserIDs タイムカードログのユーザーID（重複なし、ゲストの0を除く昇順）
+func (r *TimeCardLogRepositoryImpl) GetUserIDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := r.db.WithContext(ctx).Model(&mysql.TimeCardLog{}).
+		Where("id <> 0").Distinct().Order("id").Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// GetCardIDs タイムカードログのカードID（重複なし、空を除く昇順）
+func (r *TimeCardLogRepositoryImpl) GetCardIDs(ctx context.Context) ([]string, error) {
+	var cardIDs []string
+	if err := r.db.WithContext(ctx).Model(&mysql.TimeCardLog{}).
+		Where("card_id <> ''").Distinct().Order("card_id").Pluck("card_id", &cardIDs).Error; err != nil {
+		return nil, err
+	}
+	return cardIDs, nil
+}
+
 // Delete タイムカードログ削除
-func (r *TimeCardLogRepositoryImpl) Delete(datetime string, id int) error {
-	return r.db.Where("datetime = ? AND id = ?", datetime, id).Delete(&mysql.TimeCardLog{}).Error
+func (r *TimeCardLogRepositoryImpl) Delete(ctx context.Context, datetime string, id int) error {
+	return r.db.WithContext(ctx).Where("datetime = ? AND id = ?", datetime, id).Delete(&mysql.TimeCardLog{}).Error
 }
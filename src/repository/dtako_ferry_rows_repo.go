@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -10,13 +11,14 @@ import (
 
 // DTakoFerryRowsRepository リポジトリインターフェース
 type DTakoFerryRowsRepository interface {
-	Create(data *mysql.DTakoFerryRows) error
-	GetByID(id int32) (*mysql.DTakoFerryRows, error)
-	Update(data *mysql.DTakoFerryRows) error
-	DeleteByID(id int32) error
-	List(params *DTakoFerryRowsListParams) ([]*mysql.DTakoFerryRows, int64, error)
-	ListByUnkoNo(unkoNo string) ([]*mysql.DTakoFerryRows, error)
-	ListByDateRange(start, end time.Time) ([]*mysql.DTakoFerryRows, error)
+	Create(ctx context.Context, data *mysql.DTakoFerryRows) error
+	GetByID(ctx context.Context, id int32) (*mysql.DTakoFerryRows, error)
+	Update(ctx context.Context, data *mysql.DTakoFerryRows) error
+	DeleteByID(ctx context.Context, id int32) error
+	List(ctx context.Context, params *DTakoFerryRowsListParams) ([]*mysql.DTakoFerryRows, int64, error)
+	ListPage(ctx context.Context, params *DTakoFerryRowsListParams, page PageRequest) (*Page[mysql.DTakoFerryRows], error)
+	ListByUnkoNo(ctx context.Context, unkoNo string) ([]*mysql.DTakoFerryRows, error)
+	ListByDateRange(ctx context.Context, start, end time.Time) ([]*mysql.DTakoFerryRows, error)
 }
 
 // DTakoFerryRowsListParams リスト取得用パラメータ
@@ -39,12 +41,12 @@ func NewDTakoFerryRowsRepository(db *gorm.DB) DTakoFerryRowsRepository {
 }
 
 // Create データ作成
-func (r *dtakoFerryRowsRepo) Create(data *mysql.DTakoFerryRows) error {
+func (r *dtakoFerryRowsRepo) Create(ctx context.Context, data *mysql.DTakoFerryRows) error {
 	if err := data.Validate(); err != nil {
 		return fmt.Errorf("validation error: %w", err)
 	}
 
-	result := r.db.Create(data)
+	result := r.db.WithContext(ctx).Create(data)
 	if result.Error != nil {
 		return fmt.Errorf("failed to create record: %w", result.Error)
 	}
@@ -53,10 +55,10 @@ func (r *dtakoFerryRowsRepo) Create(data *mysql.DTakoFerryRows) error {
 }
 
 // GetByID IDでデータ取得
-func (r *dtakoFerryRowsRepo) GetByID(id int32) (*mysql.DTakoFerryRows, error) {
+func (r *dtakoFerryRowsRepo) GetByID(ctx context.Context, id int32) (*mysql.DTakoFerryRows, error) {
 	var data mysql.DTakoFerryRows
 
-	result := r.db.First(&data, id)
+	result := r.db.WithContext(ctx).First(&data, id)
 	if result.Error != nil {
 		if result.Error == gorm.ErrRecordNotFound {
 			return nil, mysql.ErrRecordNotFound
@@ -68,13 +70,13 @@ func (r *dtakoFerryRowsRepo) GetByID(id int32) (*mysql.DTakoFerryRows, error) {
 }
 
 // Update データ更新
-func (r *dtakoFerryRowsRepo) Update(data *mysql.DTakoFerryRows) error {
+func (r *dtakoFerryRowsRepo) Update(ctx context.Context, data *mysql.DTakoFerryRows) error {
 	if err := data.Validate(); err != nil {
 		return fmt.Errorf("validation error: %w", err)
 	}
 
 	// 既存レコードを確認
-	existing, err := r.GetByID(data.ID)
+	existing, err := r.GetByID(ctx, data.ID)
 	if err != nil {
 		if err == mysql.ErrRecordNotFound {
 			return mysql.ErrRecordNotFound
@@ -83,7 +85,7 @@ func (r *dtakoFerryRowsRepo) Update(data *mysql.DTakoFerryRows) error {
 	}
 
 	// 更新実行
-	result := r.db.Model(existing).Updates(data)
+	result := r.db.WithContext(ctx).Model(existing).Updates(data)
 	if result.Error != nil {
 		return fmt.Errorf("failed to update record: %w", result.Error)
 	}
@@ -96,8 +98,8 @@ func (r *dtakoFerryRowsRepo) Update(data *mysql.DTakoFerryRows) error {
 }
 
 // DeleteByID IDでデータ削除
-func (r *dtakoFerryRowsRepo) DeleteByID(id int32) error {
-	result := r.db.Delete(&mysql.DTakoFerryRows{}, id)
+func (r *dtakoFerryRowsRepo) DeleteByID(ctx context.Context, id int32) error {
+	result := r.db.WithContext(ctx).Delete(&mysql.DTakoFerryRows{}, id)
 	if result.Error != nil {
 		return fmt.Errorf("failed to delete record: %w", result.Error)
 	}
@@ -109,14 +111,19 @@ func (r *dtakoFerryRowsRepo) DeleteByID(id int32) error {
 	return nil
 }
 
-// List 条件付きリスト取得
-func (r *dtakoFerryRowsRepo) List(params *DTakoFerryRowsListParams) ([]*mysql.DTakoFerryRows, int64, error) {
-	var data []*mysql.DTakoFerryRows
-	var totalCount int64
-
-	query := r.db.Model(&mysql.DTakoFerryRows{})
+// dtakoFerryRowsKeyset キーセットページネーションのキー（運行日 DESC, id DESC）
+// 本番DB用リポジトリと共通
+var dtakoFerryRowsKeyset = keyset[mysql.DTakoFerryRows]{
+	desc: true,
+	columns: []keysetColumn[mysql.DTakoFerryRows]{
+		{column: "運行日", value: func(m *mysql.DTakoFerryRows) interface{} { return m.UnkoDate }},
+		{column: "id", value: func(m *mysql.DTakoFerryRows) interface{} { return m.ID }},
+	},
+}
 
-	// 条件の適用
+// filter 条件の適用
+func (r *dtakoFerryRowsRepo) filter(ctx context.Context, params *DTakoFerryRowsListParams) *gorm.DB {
+	query := r.db.WithContext(ctx).Model(&mysql.DTakoFerryRows{})
 	if params.UnkoNo != nil && *params.UnkoNo != "" {
 		query = query.Where("運行NO = ?", *params.UnkoNo)
 	}
@@ -126,6 +133,15 @@ func (r *dtakoFerryRowsRepo) List(params *DTakoFerryRowsListParams) ([]*mysql.DT
 	if params.EndDate != nil {
 		query = query.Where("運行日 <= ?", *params.EndDate)
 	}
+	return query
+}
+
+// List 条件付きリスト取得
+func (r *dtakoFerryRowsRepo) List(ctx context.Context, params *DTakoFerryRowsListParams) ([]*mysql.DTakoFerryRows, int64, error) {
+	var data []*mysql.DTakoFerryRows
+	var totalCount int64
+
+	query := r.filter(ctx, params)
 
 	// 総件数取得
 	if err := query.Count(&totalCount).Error; err != nil {
@@ -148,11 +164,16 @@ func (r *dtakoFerryRowsRepo) List(params *DTakoFerryRowsListParams) ([]*mysql.DT
 	return data, totalCount, nil
 }
 
+// ListPage 条件付きリスト取得（キーセットページネーション）
+func (r *dtakoFerryRowsRepo) ListPage(ctx context.Context, params *DTakoFerryRowsListParams, page PageRequest) (*Page[mysql.DTakoFerryRows], error) {
+	return listByKeyset(r.filter(ctx, params), dtakoFerryRowsKeyset, page)
+}
+
 // ListByUnkoNo 運行NOでリスト取得
-func (r *dtakoFerryRowsRepo) ListByUnkoNo(unkoNo string) ([]*mysql.DTakoFerryRows, error) {
+func (r *dtakoFerryRowsRepo) ListByUnkoNo(ctx context.Context, unkoNo string) ([]*mysql.DTakoFerryRows, error) {
 	var data []*mysql.DTakoFerryRows
 
-	if err := r.db.Where("運行NO = ?", unkoNo).
+	if err := r.db.WithContext(ctx).Where("運行NO = ?", unkoNo).
 		Order("運行日 DESC").
 		Find(&data).Error; err != nil {
 		return nil, fmt.Errorf("failed to list by unko_no: %w", err)
@@ -162,10 +183,10 @@ func (r *dtakoFerryRowsRepo) ListByUnkoNo(unkoNo string) ([]*mysql.DTakoFerryRow
 }
 
 // ListByDateRange 日付範囲でリスト取得
-func (r *dtakoFerryRowsRepo) ListByDateRange(start, end time.Time) ([]*mysql.DTakoFerryRows, error) {
+func (r *dtakoFerryRowsRepo) ListByDateRange(ctx context.Context, start, end time.Time) ([]*mysql.DTakoFerryRows, error) {
 	var data []*mysql.DTakoFerryRows
 
-	if err := r.db.Where("運行日 BETWEEN ? AND ?", start, end).
+	if err := r.db.WithContext(ctx).Where("運行日 BETWEEN ? AND ?", start, end).
 		Order("運行日 DESC").
 		Find(&data).Error; err != nil {
 		return nil, fmt.Errorf("failed to list by date range: %w", err)
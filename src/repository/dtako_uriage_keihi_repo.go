@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -10,14 +12,15 @@ import (
 
 // DTakoUriageKeihiRepository リポジトリインターフェース
 type DTakoUriageKeihiRepository interface {
-	Create(data *mysql.DTakoUriageKeihi) error
-	GetByCompositeKey(srchID string, datetime time.Time, keihiC int32) (*mysql.DTakoUriageKeihi, error)
-	Update(data *mysql.DTakoUriageKeihi) error
-	DeleteByCompositeKey(srchID string, datetime time.Time, keihiC int32) error
-	List(params *ListParams) ([]*mysql.DTakoUriageKeihi, int64, error)
-	ListBySrchID(srchID string) ([]*mysql.DTakoUriageKeihi, error)
-	ListByDtakoRowID(dtakoRowID string) ([]*mysql.DTakoUriageKeihi, error)
-	ListByDateRange(start, end time.Time) ([]*mysql.DTakoUriageKeihi, error)
+	Create(ctx context.Context, data *mysql.DTakoUriageKeihi) error
+	GetByCompositeKey(ctx context.Context, srchID string, datetime time.Time, keihiC int32) (*mysql.DTakoUriageKeihi, error)
+	Update(ctx context.Context, data *mysql.DTakoUriageKeihi) error
+	DeleteByCompositeKey(ctx context.Context, srchID string, datetime time.Time, keihiC int32) error
+	List(ctx context.Context, params *ListParams) ([]*mysql.DTakoUriageKeihi, int64, error)
+	ListPage(ctx context.Context, params *ListParams, page PageRequest) (*Page[mysql.DTakoUriageKeihi], error)
+	ListBySrchID(ctx context.Context, srchID string) ([]*mysql.DTakoUriageKeihi, error)
+	ListByDtakoRowID(ctx context.Context, dtakoRowID string) ([]*mysql.DTakoUriageKeihi, error)
+	ListByDateRange(ctx context.Context, start, end time.Time) ([]*mysql.DTakoUriageKeihi, error)
 }
 
 // ListParams リスト取得用パラメータ
@@ -40,12 +43,12 @@ func NewDTakoUriageKeihiRepository(db *gorm.DB) DTakoUriageKeihiRepository {
 }
 
 // Create データ作成
-func (r *dtakoUriageKeihiRepo) Create(data *mysql.DTakoUriageKeihi) error {
+func (r *dtakoUriageKeihiRepo) Create(ctx context.Context, data *mysql.DTakoUriageKeihi) error {
 	if err := data.Validate(); err != nil {
 		return fmt.Errorf("validation error: %w", err)
 	}
 
-	result := r.db.Create(data)
+	result := r.db.WithContext(ctx).Create(data)
 	if result.Error != nil {
 		if isDuplicateKeyError(result.Error) {
 			return mysql.ErrDuplicateKey
@@ -57,10 +60,10 @@ func (r *dtakoUriageKeihiRepo) Create(data *mysql.DTakoUriageKeihi) error {
 }
 
 // GetByCompositeKey 複合キーでデータ取得
-func (r *dtakoUriageKeihiRepo) GetByCompositeKey(srchID string, datetime time.Time, keihiC int32) (*mysql.DTakoUriageKeihi, error) {
+func (r *dtakoUriageKeihiRepo) GetByCompositeKey(ctx context.Context, srchID string, datetime time.Time, keihiC int32) (*mysql.DTakoUriageKeihi, error) {
 	var data mysql.DTakoUriageKeihi
 
-	result := r.db.Where("srch_id = ? AND datetime = ? AND keihi_c = ?",
+	result := r.db.WithContext(ctx).Where("srch_id = ? AND datetime = ? AND keihi_c = ?",
 		srchID, datetime, keihiC).First(&data)
 
 	if result.Error != nil {
@@ -74,13 +77,13 @@ func (r *dtakoUriageKeihiRepo) GetByCompositeKey(srchID string, datetime time.Ti
 }
 
 // Update データ更新
-func (r *dtakoUriageKeihiRepo) Update(data *mysql.DTakoUriageKeihi) error {
+func (r *dtakoUriageKeihiRepo) Update(ctx context.Context, data *mysql.DTakoUriageKeihi) error {
 	if err := data.Validate(); err != nil {
 		return fmt.Errorf("validation error: %w", err)
 	}
 
 	// 複合キーで既存レコードを確認
-	existing, err := r.GetByCompositeKey(data.SrchID, data.Datetime, data.KeihiC)
+	existing, err := r.GetByCompositeKey(ctx, data.SrchID, data.Datetime, data.KeihiC)
 	if err != nil {
 		if err == mysql.ErrRecordNotFound {
 			return mysql.ErrRecordNotFound
@@ -89,7 +92,7 @@ func (r *dtakoUriageKeihiRepo) Update(data *mysql.DTakoUriageKeihi) error {
 	}
 
 	// 更新実行
-	result := r.db.Model(existing).Updates(data)
+	result := r.db.WithContext(ctx).Model(existing).Updates(data)
 	if result.Error != nil {
 		return fmt.Errorf("failed to update record: %w", result.Error)
 	}
@@ -102,8 +105,8 @@ func (r *dtakoUriageKeihiRepo) Update(data *mysql.DTakoUriageKeihi) error {
 }
 
 // DeleteByCompositeKey 複合キーでデータ削除
-func (r *dtakoUriageKeihiRepo) DeleteByCompositeKey(srchID string, datetime time.Time, keihiC int32) error {
-	result := r.db.Where("srch_id = ? AND datetime = ? AND keihi_c = ?",
+func (r *dtakoUriageKeihiRepo) DeleteByCompositeKey(ctx context.Context, srchID string, datetime time.Time, keihiC int32) error {
+	result := r.db.WithContext(ctx).Where("srch_id = ? AND datetime = ? AND keihi_c = ?",
 		srchID, datetime, keihiC).Delete(&mysql.DTakoUriageKeihi{})
 
 	if result.Error != nil {
@@ -117,14 +120,19 @@ func (r *dtakoUriageKeihiRepo) DeleteByCompositeKey(srchID string, datetime time
 	return nil
 }
 
-// List 条件付きリスト取得
-func (r *dtakoUriageKeihiRepo) List(params *ListParams) ([]*mysql.DTakoUriageKeihi, int64, error) {
-	var data []*mysql.DTakoUriageKeihi
-	var totalCount int64
-
-	query := r.db.Model(&mysql.DTakoUriageKeihi{})
+// dtakoUriageKeihiKeyset キーセットページネーションのキー（複合主キー、datetime DESC）
+var dtakoUriageKeihiKeyset = keyset[mysql.DTakoUriageKeihi]{
+	desc: true,
+	columns: []keysetColumn[mysql.DTakoUriageKeihi]{
+		{column: "datetime", value: func(m *mysql.DTakoUriageKeihi) interface{} { return m.Datetime }},
+		{column: "srch_id", value: func(m *mysql.DTakoUriageKeihi) interface{} { return m.SrchID }},
+		{column: "keihi_c", value: func(m *mysql.DTakoUriageKeihi) interface{} { return m.KeihiC }},
+	},
+}
 
-	// 条件の適用
+// filter 条件の適用
+func (r *dtakoUriageKeihiRepo) filter(ctx context.Context, params *ListParams) *gorm.DB {
+	query := r.db.WithContext(ctx).Model(&mysql.DTakoUriageKeihi{})
 	if params.DtakoRowID != nil && *params.DtakoRowID != "" {
 		query = query.Where("dtako_row_id = ?", *params.DtakoRowID)
 	}
@@ -134,6 +142,15 @@ func (r *dtakoUriageKeihiRepo) List(params *ListParams) ([]*mysql.DTakoUriageKei
 	if params.EndDate != nil {
 		query = query.Where("datetime <= ?", *params.EndDate)
 	}
+	return query
+}
+
+// List 条件付きリスト取得
+func (r *dtakoUriageKeihiRepo) List(ctx context.Context, params *ListParams) ([]*mysql.DTakoUriageKeihi, int64, error) {
+	var data []*mysql.DTakoUriageKeihi
+	var totalCount int64
+
+	query := r.filter(ctx, params)
 
 	// 総件数取得
 	if err := query.Count(&totalCount).Error; err != nil {
@@ -156,11 +173,16 @@ func (r *dtakoUriageKeihiRepo) List(params *ListParams) ([]*mysql.DTakoUriageKei
 	return data, totalCount, nil
 }
 
+// ListPage 条件付きリスト取得（キーセットページネーション）
+func (r *dtakoUriageKeihiRepo) ListPage(ctx context.Context, params *ListParams, page PageRequest) (*Page[mysql.DTakoUriageKeihi], error) {
+	return listByKeyset(r.filter(ctx, params), dtakoUriageKeihiKeyset, page)
+}
+
 // ListBySrchID srch_idでリスト取得
-func (r *dtakoUriageKeihiRepo) ListBySrchID(srchID string) ([]*mysql.DTakoUriageKeihi, error) {
+func (r *dtakoUriageKeihiRepo) ListBySrchID(ctx context.Context, srchID string) ([]*mysql.DTakoUriageKeihi, error) {
 	var data []*mysql.DTakoUriageKeihi
 
-	if err := r.db.Where("srch_id = ?", srchID).
+	if err := r.db.WithContext(ctx).Where("srch_id = ?", srchID).
 		Order("datetime DESC").
 		Find(&data).Error; err != nil {
 		return nil, fmt.Errorf("failed to list by srch_id: %w", err)
@@ -170,10 +192,10 @@ func (r *dtakoUriageKeihiRepo) ListBySrchID(srchID string) ([]*mysql.DTakoUriage
 }
 
 // ListByDtakoRowID dtako_row_idでリスト取得
-func (r *dtakoUriageKeihiRepo) ListByDtakoRowID(dtakoRowID string) ([]*mysql.DTakoUriageKeihi, error) {
+func (r *dtakoUriageKeihiRepo) ListByDtakoRowID(ctx context.Context, dtakoRowID string) ([]*mysql.DTakoUriageKeihi, error) {
 	var data []*mysql.DTakoUriageKeihi
 
-	if err := r.db.Where("dtako_row_id = ?", dtakoRowID).
+	if err := r.db.WithContext(ctx).Where("dtako_row_id = ?", dtakoRowID).
 		Order("datetime DESC").
 		Find(&data).Error; err != nil {
 		return nil, fmt.Errorf("failed to list by dtako_row_id: %w", err)
@@ -183,10 +205,10 @@ func (r *dtakoUriageKeihiRepo) ListByDtakoRowID(dtakoRowID string) ([]*mysql.DTa
 }
 
 // ListByDateRange 日付範囲でリスト取得
-func (r *dtakoUriageKeihiRepo) ListByDateRange(start, end time.Time) ([]*mysql.DTakoUriageKeihi, error) {
+func (r *dtakoUriageKeihiRepo) ListByDateRange(ctx context.Context, start, end time.Time) ([]*mysql.DTakoUriageKeihi, error) {
 	var data []*mysql.DTakoUriageKeihi
 
-	if err := r.db.Where("datetime BETWEEN ? AND ?", start, end).
+	if err := r.db.WithContext(ctx).Where("datetime BETWEEN ? AND ?", start, end).
 		Order("datetime DESC").
 		Find(&data).Error; err != nil {
 		return nil, fmt.Errorf("failed to list by date range: %w", err)
@@ -200,6 +222,10 @@ func isDuplicateKeyError(err error) bool {
 	if err == nil {
 		return false
 	}
+	// TranslateErrorを有効にしたDB（テスト用のSQLite等）ではgorm.ErrDuplicatedKeyに変換される
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return true
+	}
 	// MySQLの重複キーエラーコード: 1062
 	return contains(err.Error(), "1062") || contains(err.Error(), "Duplicate entry")
 }
@@ -0,0 +1,300 @@
+package repository
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/yhonda-ohishi/db_service/src/models/mysql"
+	"gorm.io/gorm"
+)
+
+// EmployeeIdentityRepository 社員の識別子の対応表リポジトリインターフェース
+type EmployeeIdentityRepository interface {
+	Create(ctx context.Context, data *mysql.EmployeeIdentity) error
+	GetByID(ctx context.Context, id int64) (*mysql.EmployeeIdentity, error)
+	Update(ctx context.Context, data *mysql.EmployeeIdentity) error
+	DeleteByID(ctx context.Context, id int64) error
+	GetAll(ctx context.Context, limit, offset int) ([]*mysql.EmployeeIdentity, int64, error)
+	GetPage(ctx context.Context, page PageRequest) (*Page[mysql.EmployeeIdentity], error)
+	GetByDriverID(ctx context.Context, driverID int) (*mysql.EmployeeIdentity, error)
+	GetByShainC(ctx context.Context, shainC string) (*mysql.EmployeeIdentity, error)
+	GetByDTakoDriverCode(ctx context.Context, code int) (*mysql.EmployeeIdentity, error)
+	GetByTimeCardUserID(ctx context.Context, userID int) (*mysql.EmployeeIdentity, error)
+	GetByCardID(ctx context.Context, cardID string) (*mysql.EmployeeIdentity, error)
+	GetCards(ctx context.Context, employeeID int64) ([]*mysql.EmployeeCard, error)
+	GetAllCards(ctx context.Context) ([]*mysql.EmployeeCard, error)
+	RegisterCard(ctx context.Context, card *mysql.EmployeeCard) (bool, error)
+	UnregisterCard(ctx context.Context, cardID string) error
+}
+
+var (
+	// ErrEmployeeCodeLinked 社員コードが別の社員に登録済み
+	ErrEmployeeCodeLinked = errors.New("employee code is already linked to another employee")
+	// ErrEmployeeCardRegistered カードが別の社員に登録済み
+	ErrEmployeeCardRegistered = errors.New("card is already registered to another employee")
+)
+
+// employeeIdentityRepo リポジトリ実装
+type employeeIdentityRepo struct {
+	db *gorm.DB
+}
+
+// NewEmployeeIdentityRepository リポジトリのコンストラクタ
+func NewEmployeeIdentityRepository(db *gorm.DB) EmployeeIdentityRepository {
+	return &employeeIdentityRepo{db: db}
+}
+
+// Create 社員作成（社員コードが別の社員に登録済みの場合はErrEmployeeCodeLinked）
+func (r *employeeIdentityRepo) Create(ctx context.Context, data *mysql.EmployeeIdentity) error {
+	data.Normalize()
+	if err := data.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := checkEmployeeCodes(tx, data); err != nil {
+			return fmt.Errorf("failed to create employee: %w", err)
+		}
+		if err := tx.Create(data).Error; err != nil {
+			if isDuplicateKeyError(err) {
+				return fmt.Errorf("failed to create employee: %w", ErrEmployeeCodeLinked)
+			}
+			return fmt.Errorf("failed to create employee: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetByID ID指定で社員取得
+func (r *employeeIdentityRepo) GetByID(ctx context.Context, id int64) (*mysql.EmployeeIdentity, error) {
+	var data mysql.EmployeeIdentity
+	if err := r.db.WithContext(ctx).First(&data, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("employee not found: %w", err)
+		}
+		return nil, fmt.Errorf("failed to get employee: %w", err)
+	}
+	return &data, nil
+}
+
+// Update 社員更新（存在しない場合はgorm.ErrRecordNotFound）
+func (r *employeeIdentityRepo) Update(ctx context.Context, data *mysql.EmployeeIdentity) error {
+	data.Normalize()
+	if err := data.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing mysql.EmployeeIdentity
+		if err := tx.First(&existing, data.ID).Error; err != nil {
+			return fmt.Errorf("failed to update employee: %w", err)
+		}
+		if err := checkEmployeeCodes(tx, data); err != nil {
+			return fmt.Errorf("failed to update employee: %w", err)
+		}
+		data.CreatedAt = existing.CreatedAt
+		if err := tx.Save(data).Error; err != nil {
+			if isDuplicateKeyError(err) {
+				return fmt.Errorf("failed to update employee: %w", ErrEmployeeCodeLinked)
+			}
+			return fmt.Errorf("failed to update employee: %w", err)
+		}
+		return nil
+	})
+}
+
+// DeleteByID ID指定で社員と登録されているカードを削除
+func (r *employeeIdentityRepo) DeleteByID(ctx context.Context, id int64) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Delete(&mysql.EmployeeIdentity{}, id)
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete employee: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("employee not found: %w", gorm.ErrRecordNotFound)
+		}
+		if err := tx.Where("employee_id = ?", id).Delete(&mysql.EmployeeCard{}).Error; err != nil {
+			return fmt.Errorf("failed to delete employee cards: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetAll 社員一覧取得（id順）
+func (r *employeeIdentityRepo) GetAll(ctx context.Context, limit, offset int) ([]*mysql.EmployeeIdentity, int64, error) {
+	var data []*mysql.EmployeeIdentity
+	var totalCount int64
+
+	if err := r.db.WithContext(ctx).Model(&mysql.EmployeeIdentity{}).Count(&totalCount).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count employees: %w", err)
+	}
+	if err := r.db.WithContext(ctx).Order("id").Limit(limit).Offset(offset).Find(&data).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list employees: %w", err)
+	}
+	return data, totalCount, nil
+}
+
+// employeeIdentityKeyset キーセットページネーションのキー（id）
+var employeeIdentityKeyset = keyset[mysql.EmployeeIdentity]{
+	columns: []keysetColumn[mysql.EmployeeIdentity]{
+		{column: "id", value: func(m *mysql.EmployeeIdentity) interface{} { return m.ID }},
+	},
+}
+
+// GetPage 社員一覧取得（キーセットページネーション）
+func (r *employeeIdentityRepo) GetPage(ctx context.Context, page PageRequest) (*Page[mysql.EmployeeIdentity], error) {
+	return listByKeyset(r.db.WithContext(ctx), employeeIdentityKeyset, page)
+}
+
+// GetByDriverID drivers.idの社員を取得
+func (r *employeeIdentityRepo) GetByDriverID(ctx context.Context, driverID int) (*mysql.EmployeeIdentity, error) {
+	return r.getBy(ctx, "driver_id", driverID)
+}
+
+// GetByShainC 社員C（運転手C）の社員を取得（前後の空白は除いて比較）
+func (r *employeeIdentityRepo) GetByShainC(ctx context.Context, shainC string) (*mysql.EmployeeIdentity, error) {
+	return r.getBy(ctx, "shain_c", strings.TrimSpace(shainC))
+}
+
+// GetByDTakoDriverCode デジタコの乗務員コードの社員を取得
+func (r *employeeIdentityRepo) GetByDTakoDriverCode(ctx context.Context, code int) (*mysql.EmployeeIdentity, error) {
+	return r.getBy(ctx, "dtako_driver_code", code)
+}
+
+// GetByTimeCardUserID タイムカードのユーザーIDの社員を取得
+func (r *employeeIdentityRepo) GetByTimeCardUserID(ctx context.Context, userID int) (*mysql.EmployeeIdentity, error) {
+	return r.getBy(ctx, "time_card_user_id", userID)
+}
+
+// GetByCardID カードIDが登録されている社員を取得
+func (r *employeeIdentityRepo) GetByCardID(ctx context.Context, cardID string) (*mysql.EmployeeIdentity, error) {
+	var card mysql.EmployeeCard
+	if err := r.db.WithContext(ctx).Where("card_id = ?", cardID).First(&card).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("card not found: %w", err)
+		}
+		return nil, fmt.Errorf("failed to get card: %w", err)
+	}
+	return r.GetByID(ctx, card.EmployeeID)
+}
+
+// getBy 社員コードの列で社員を取得
+func (r *employeeIdentityRepo) getBy(ctx context.Context, column string, value interface{}) (*mysql.EmployeeIdentity, error) {
+	var data mysql.EmployeeIdentity
+	if err := r.db.WithContext(ctx).Where(column+" = ?", value).First(&data).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("employee not found: %w", err)
+		}
+		return nil, fmt.Errorf("failed to get employee: %w", err)
+	}
+	return &data, nil
+}
+
+// GetCards 社員に登録されているカードを取得（登録日時順）
+func (r *employeeIdentityRepo) GetCards(ctx context.Context, employeeID int64) ([]*mysql.EmployeeCard, error) {
+	var cards []*mysql.EmployeeCard
+	if err := r.db.WithContext(ctx).Where("employee_id = ?", employeeID).
+		Order("created_at").Order("card_id").
+		Find(&cards).Error; err != nil {
+		return nil, fmt.Errorf("failed to get cards: %w", err)
+	}
+	return cards, nil
+}
+
+// GetAllCards 登録されている全カードを取得（カードID順）
+func (r *employeeIdentityRepo) GetAllCards(ctx context.Context) ([]*mysql.EmployeeCard, error) {
+	var cards []*mysql.EmployeeCard
+	if err := r.db.WithContext(ctx).Order("card_id").Find(&cards).Error; err != nil {
+		return nil, fmt.Errorf("failed to list cards: %w", err)
+	}
+	return cards, nil
+}
+
+// RegisterCard 社員にカードを登録（登録した場合はtrue）
+// 同じ社員に登録済みの場合は登録済みのカードをcardに設定してfalse、別の社員に登録済みの場合はErrEmployeeCardRegistered
+func (r *employeeIdentityRepo) RegisterCard(ctx context.Context, card *mysql.EmployeeCard) (bool, error) {
+	if err := card.Validate(); err != nil {
+		return false, fmt.Errorf("validation failed: %w", err)
+	}
+	created := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.First(&mysql.EmployeeIdentity{}, card.EmployeeID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return fmt.Errorf("employee not found: %w", err)
+			}
+			return fmt.Errorf("failed to get employee: %w", err)
+		}
+
+		var existing []*mysql.EmployeeCard
+		if err := tx.Where("card_id = ?", card.CardID).Limit(1).Find(&existing).Error; err != nil {
+			return fmt.Errorf("failed to get card: %w", err)
+		}
+		if len(existing) > 0 {
+			if existing[0].EmployeeID != card.EmployeeID {
+				return fmt.Errorf("failed to register card: %w", ErrEmployeeCardRegistered)
+			}
+			*card = *existing[0]
+			return nil
+		}
+
+		if err := tx.Create(card).Error; err != nil {
+			if isDuplicateKeyError(err) {
+				return fmt.Errorf("failed to register card: %w", ErrEmployeeCardRegistered)
+			}
+			return fmt.Errorf("failed to register card: %w", err)
+		}
+		created = true
+		return nil
+	})
+	return created, err
+}
+
+// UnregisterCard カードの登録を削除
+func (r *employeeIdentityRepo) UnregisterCard(ctx context.Context, cardID string) error {
+	result := r.db.WithContext(ctx).Where("card_id = ?", cardID).Delete(&mysql.EmployeeCard{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to unregister card: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("card not found: %w", gorm.ErrRecordNotFound)
+	}
+	return nil
+}
+
+// checkEmployeeCodes 社員コードが別の社員に登録されていないか確認（一意制約を追加する前のテーブルでも重複させない）
+func checkEmployeeCodes(db *gorm.DB, data *mysql.EmployeeIdentity) error {
+	codes := []struct {
+		column string
+		value  interface{}
+		set    bool
+	}{
+		{"driver_id", data.DriverID, data.DriverID != nil},
+		{"shain_c", data.ShainC, data.ShainC != nil},
+		{"dtako_driver_code", data.DTakoDriverCode, data.DTakoDriverCode != nil},
+		{"time_card_user_id", data.TimeCardUserID, data.TimeCardUserID != nil},
+	}
+	for _, code := range codes {
+		if !code.set {
+			continue
+		}
+		var count int64
+		if err := db.Model(&mysql.EmployeeIdentity{}).
+			Where(code.column+" = ? AND id <> ?", code.value, data.ID).
+			Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return fmt.Errorf("%w: %s", ErrEmployeeCodeLinked, code.column)
+		}
+	}
+	return nil
+}
+
+// distinctCodes 社員コードの重複と未設定の値（skip）を除いて昇順に並べる
+func distinctCodes[T cmp.Ordered](codes []T, skip T) []T {
+	slices.Sort(codes)
+	codes = slices.Compact(codes)
+	return slices.DeleteFunc(codes, func(code T) bool { return code == skip })
+}
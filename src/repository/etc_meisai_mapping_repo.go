@@ -1,22 +1,35 @@
 package repository
 
 import (
+	"context"
+	"errors"
 	"fmt"
 
+	"github.com/yhonda-ohishi/db_service/src/config"
 	"github.com/yhonda-ohishi/db_service/src/models/mysql"
 	"gorm.io/gorm"
 )
 
 // ETCMeisaiMappingRepository リポジトリインターフェース
 type ETCMeisaiMappingRepository interface {
-	Create(data *mysql.ETCMeisaiMapping) error
-	GetByID(id int64) (*mysql.ETCMeisaiMapping, error)
-	Update(data *mysql.ETCMeisaiMapping) error
-	DeleteByID(id int64) error
-	List(params *ETCMeisaiMappingListParams) ([]*mysql.ETCMeisaiMapping, int64, error)
-	GetDTakoRowIDsByHash(hash string) ([]string, error)
+	Create(ctx context.Context, data *mysql.ETCMeisaiMapping) error
+	GetByID(ctx context.Context, id int64) (*mysql.ETCMeisaiMapping, error)
+	Update(ctx context.Context, data *mysql.ETCMeisaiMapping) error
+	DeleteByID(ctx context.Context, id int64) error
+	List(ctx context.Context, params *ETCMeisaiMappingListParams) ([]*mysql.ETCMeisaiMapping, int64, error)
+	ListPage(ctx context.Context, params *ETCMeisaiMappingListParams, page PageRequest) (*Page[mysql.ETCMeisaiMapping], error)
+	GetDTakoRowIDsByHash(ctx context.Context, hash string) ([]string, error)
+	Upsert(ctx context.Context, data *mysql.ETCMeisaiMapping) (bool, error)
+	BulkReplace(ctx context.Context, dtakoRowID string, data []*mysql.ETCMeisaiMapping) (int64, error)
 }
 
+var (
+	// ErrMappingExists 同じetc_meisai_hash・dtako_row_idのマッピングが登録済み
+	ErrMappingExists = errors.New("mapping with the same etc_meisai_hash and dtako_row_id already exists")
+	// ErrMappingDTakoRowIDMismatch BulkReplaceのマッピングのdtako_row_idが置き換え対象と異なる
+	ErrMappingDTakoRowIDMismatch = &mysql.ValidationError{Field: "dtako_row_id", Message: "does not match the dtako_row_id to replace"}
+)
+
 // ETCMeisaiMappingListParams リスト取得用パラメータ
 type ETCMeisaiMappingListParams struct {
 	ETCMeisaiHash *string
@@ -35,23 +48,28 @@ func NewETCMeisaiMappingRepository(db *gorm.DB) ETCMeisaiMappingRepository {
 	return &etcMeisaiMappingRepo{db: db}
 }
 
-// Create マッピング作成
-func (r *etcMeisaiMappingRepo) Create(data *mysql.ETCMeisaiMapping) error {
+// Create マッピング作成（同じetc_meisai_hash・dtako_row_idのマッピングがある場合はErrMappingExists）
+func (r *etcMeisaiMappingRepo) Create(ctx context.Context, data *mysql.ETCMeisaiMapping) error {
 	if err := data.Validate(); err != nil {
 		return fmt.Errorf("validation failed: %w", err)
 	}
 
-	if err := r.db.Create(data).Error; err != nil {
-		return fmt.Errorf("failed to create mapping: %w", err)
+	// 一意制約を追加する前のテーブルでも重複して作成しない
+	existing, err := findMappingByPair(r.db.WithContext(ctx), data.ETCMeisaiHash, data.DTakoRowID)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return fmt.Errorf("failed to create mapping: %w", ErrMappingExists)
 	}
 
-	return nil
+	return createMapping(r.db.WithContext(ctx), data)
 }
 
 // GetByID ID指定でマッピング取得
-func (r *etcMeisaiMappingRepo) GetByID(id int64) (*mysql.ETCMeisaiMapping, error) {
+func (r *etcMeisaiMappingRepo) GetByID(ctx context.Context, id int64) (*mysql.ETCMeisaiMapping, error) {
 	var data mysql.ETCMeisaiMapping
-	if err := r.db.First(&data, id).Error; err != nil {
+	if err := r.db.WithContext(ctx).First(&data, id).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, fmt.Errorf("mapping not found: %w", err)
 		}
@@ -62,12 +80,15 @@ func (r *etcMeisaiMappingRepo) GetByID(id int64) (*mysql.ETCMeisaiMapping, error
 }
 
 // Update マッピング更新
-func (r *etcMeisaiMappingRepo) Update(data *mysql.ETCMeisaiMapping) error {
+func (r *etcMeisaiMappingRepo) Update(ctx context.Context, data *mysql.ETCMeisaiMapping) error {
 	if err := data.Validate(); err != nil {
 		return fmt.Errorf("validation failed: %w", err)
 	}
 
-	if err := r.db.Save(data).Error; err != nil {
+	if err := r.db.WithContext(ctx).Save(data).Error; err != nil {
+		if isDuplicateKeyError(err) {
+			return fmt.Errorf("failed to update mapping: %w", ErrMappingExists)
+		}
 		return fmt.Errorf("failed to update mapping: %w", err)
 	}
 
@@ -75,33 +96,46 @@ func (r *etcMeisaiMappingRepo) Update(data *mysql.ETCMeisaiMapping) error {
 }
 
 // DeleteByID ID指定でマッピング削除
-func (r *etcMeisaiMappingRepo) DeleteByID(id int64) error {
-	result := r.db.Delete(&mysql.ETCMeisaiMapping{}, id)
+func (r *etcMeisaiMappingRepo) DeleteByID(ctx context.Context, id int64) error {
+	result := r.db.WithContext(ctx).Delete(&mysql.ETCMeisaiMapping{}, id)
 	if result.Error != nil {
 		return fmt.Errorf("failed to delete mapping: %w", result.Error)
 	}
 
 	if result.RowsAffected == 0 {
-		return fmt.Errorf("mapping not found")
+		return fmt.Errorf("mapping not found: %w", gorm.ErrRecordNotFound)
 	}
 
 	return nil
 }
 
-// List マッピング一覧取得
-func (r *etcMeisaiMappingRepo) List(params *ETCMeisaiMappingListParams) ([]*mysql.ETCMeisaiMapping, int64, error) {
-	var data []*mysql.ETCMeisaiMapping
-	var totalCount int64
-
-	query := r.db.Model(&mysql.ETCMeisaiMapping{})
+// etcMeisaiMappingKeyset キーセットページネーションのキー（created_at DESC, id DESC）
+var etcMeisaiMappingKeyset = keyset[mysql.ETCMeisaiMapping]{
+	desc: true,
+	columns: []keysetColumn[mysql.ETCMeisaiMapping]{
+		{column: "created_at", value: func(m *mysql.ETCMeisaiMapping) interface{} { return m.CreatedAt }},
+		{column: "id", value: func(m *mysql.ETCMeisaiMapping) interface{} { return m.ID }},
+	},
+}
 
-	// 条件の適用
+// filter 条件の適用
+func (r *etcMeisaiMappingRepo) filter(ctx context.Context, params *ETCMeisaiMappingListParams) *gorm.DB {
+	query := r.db.WithContext(ctx).Model(&mysql.ETCMeisaiMapping{})
 	if params.ETCMeisaiHash != nil && *params.ETCMeisaiHash != "" {
 		query = query.Where("etc_meisai_hash = ?", *params.ETCMeisaiHash)
 	}
 	if params.DTakoRowID != nil && *params.DTakoRowID != "" {
 		query = query.Where("dtako_row_id = ?", *params.DTakoRowID)
 	}
+	return query
+}
+
+// List マッピング一覧取得
+func (r *etcMeisaiMappingRepo) List(ctx context.Context, params *ETCMeisaiMappingListParams) ([]*mysql.ETCMeisaiMapping, int64, error) {
+	var data []*mysql.ETCMeisaiMapping
+	var totalCount int64
+
+	query := r.filter(ctx, params)
 
 	// 総数取得
 	if err := query.Count(&totalCount).Error; err != nil {
@@ -119,11 +153,16 @@ func (r *etcMeisaiMappingRepo) List(params *ETCMeisaiMappingListParams) ([]*mysq
 	return data, totalCount, nil
 }
 
+// ListPage マッピング一覧取得（キーセットページネーション）
+func (r *etcMeisaiMappingRepo) ListPage(ctx context.Context, params *ETCMeisaiMappingListParams, page PageRequest) (*Page[mysql.ETCMeisaiMapping], error) {
+	return listByKeyset(r.filter(ctx, params), etcMeisaiMappingKeyset, page)
+}
+
 // GetDTakoRowIDsByHash ハッシュからDTakoRowIDのリストを取得
-func (r *etcMeisaiMappingRepo) GetDTakoRowIDsByHash(hash string) ([]string, error) {
+func (r *etcMeisaiMappingRepo) GetDTakoRowIDsByHash(ctx context.Context, hash string) ([]string, error) {
 	var mappings []*mysql.ETCMeisaiMapping
 
-	if err := r.db.Where("etc_meisai_hash = ?", hash).
+	if err := r.db.WithContext(ctx).Where("etc_meisai_hash = ?", hash).
 		Find(&mappings).Error; err != nil {
 		return nil, fmt.Errorf("failed to get mappings by hash: %w", err)
 	}
@@ -134,4 +173,124 @@ func (r *etcMeisaiMappingRepo) GetDTakoRowIDsByHash(hash string) ([]string, erro
 	}
 
 	return dtakoRowIDs, nil
-}
\ No newline at end of file
+}
+
+// Upsert 同じetc_meisai_hash・dtako_row_idのマッピングがない場合は作成し、ある場合はnotes（指定時）を更新する
+// dataには作成・更新後のマッピングを設定し、作成した場合はtrueを返す（再実行しても重複して作成しない）
+func (r *etcMeisaiMappingRepo) Upsert(ctx context.Context, data *mysql.ETCMeisaiMapping) (bool, error) {
+	if err := data.Validate(); err != nil {
+		return false, fmt.Errorf("validation failed: %w", err)
+	}
+
+	existing, err := findMappingByPair(r.db.WithContext(ctx), data.ETCMeisaiHash, data.DTakoRowID)
+	if err != nil {
+		return false, err
+	}
+	if existing == nil {
+		err := createMapping(r.db.WithContext(ctx), data)
+		if !errors.Is(err, ErrMappingExists) {
+			return err == nil, err
+		}
+		// 同時に作成された
+		if existing, err = findMappingByPair(r.db.WithContext(ctx), data.ETCMeisaiHash, data.DTakoRowID); err != nil {
+			return false, err
+		}
+		if existing == nil {
+			return false, fmt.Errorf("failed to create mapping: %w", ErrMappingExists)
+		}
+	}
+
+	if data.Notes != nil {
+		existing.Notes = data.Notes
+	}
+	existing.BeforeUpdate()
+	if err := r.db.WithContext(ctx).Save(existing).Error; err != nil {
+		return false, fmt.Errorf("failed to update mapping: %w", err)
+	}
+	*data = *existing
+	return false, nil
+}
+
+// BulkReplace dtako_row_idのマッピングを1トランザクションでdataに置き換える
+// dataのdtako_row_idは空またはdtakoRowIDであること（空の場合はdtakoRowIDを設定する）。
+// 作成したマッピングのIDはdataに設定し、削除した件数を返す
+func (r *etcMeisaiMappingRepo) BulkReplace(ctx context.Context, dtakoRowID string, data []*mysql.ETCMeisaiMapping) (int64, error) {
+	if err := PrepareETCMeisaiMappingBulkReplace(dtakoRowID, data); err != nil {
+		return 0, err
+	}
+
+	var deleted int64
+	err := config.WithTransaction(r.db.WithContext(ctx), func(tx *gorm.DB) error {
+		result := tx.Where("dtako_row_id = ?", dtakoRowID).Delete(&mysql.ETCMeisaiMapping{})
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete mappings: %w", result.Error)
+		}
+		deleted = result.RowsAffected
+
+		if len(data) == 0 {
+			return nil
+		}
+		if err := tx.CreateInBatches(data, batchChunkSize).Error; err != nil {
+			if isDuplicateKeyError(err) {
+				return fmt.Errorf("failed to create mappings: %w", ErrMappingExists)
+			}
+			return fmt.Errorf("failed to create mappings: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to replace mappings: %w", err)
+	}
+
+	return deleted, nil
+}
+
+// PrepareETCMeisaiMappingBulkReplace BulkReplaceの前処理（dtako_row_idの設定とバリデーション、data内の重複チェック）
+func PrepareETCMeisaiMappingBulkReplace(dtakoRowID string, data []*mysql.ETCMeisaiMapping) error {
+	if dtakoRowID == "" {
+		return fmt.Errorf("validation failed: %w", mysql.ErrInvalidDtakoRowID)
+	}
+	seen := make(map[string]bool, len(data))
+	for i, item := range data {
+		if item.DTakoRowID == "" {
+			item.DTakoRowID = dtakoRowID
+		}
+		if item.DTakoRowID != dtakoRowID {
+			return fmt.Errorf("mapping %d: %w: %q", i, ErrMappingDTakoRowIDMismatch, item.DTakoRowID)
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("mapping %d: validation failed: %w", i, err)
+		}
+		if seen[item.ETCMeisaiHash] {
+			return fmt.Errorf("mapping %d: %w", i, ErrMappingExists)
+		}
+		seen[item.ETCMeisaiHash] = true
+	}
+	return nil
+}
+
+// findMappingByPair etc_meisai_hash・dtako_row_idでマッピング取得（ない場合はnil）
+func findMappingByPair(db *gorm.DB, hash, dtakoRowID string) (*mysql.ETCMeisaiMapping, error) {
+	var data []*mysql.ETCMeisaiMapping
+	if err := db.Where("etc_meisai_hash = ? AND dtako_row_id = ?", hash, dtakoRowID).
+		Order("id").
+		Limit(1).
+		Find(&data).Error; err != nil {
+		return nil, fmt.Errorf("failed to get mapping: %w", err)
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+	return data[0], nil
+}
+
+// createMapping マッピング作成（一意制約違反の場合はErrMappingExists）
+func createMapping(db *gorm.DB, data *mysql.ETCMeisaiMapping) error {
+	if err := db.Create(data).Error; err != nil {
+		if isDuplicateKeyError(err) {
+			return fmt.Errorf("failed to create mapping: %w", ErrMappingExists)
+		}
+		return fmt.Errorf("failed to create mapping: %w", err)
+	}
+	return nil
+}
@@ -1,22 +1,31 @@
 package repository
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"sort"
 	"time"
 
+	"github.com/yhonda-ohishi/db_service/src/config"
 	"github.com/yhonda-ohishi/db_service/src/models/mysql"
 	"gorm.io/gorm"
 )
 
 // ETCMeisaiRepository リポジトリインターフェース
 type ETCMeisaiRepository interface {
-	Create(data *mysql.ETCMeisai) error
-	GetByID(id int64) (*mysql.ETCMeisai, error)
-	Update(data *mysql.ETCMeisai) error
-	DeleteByID(id int64) error
-	List(params *ETCMeisaiListParams) ([]*mysql.ETCMeisai, int64, error)
-	ListByHash(hash string) ([]*mysql.ETCMeisai, error)
-	ListByDateRange(start, end time.Time) ([]*mysql.ETCMeisai, error)
+	Create(ctx context.Context, data *mysql.ETCMeisai) error
+	GetByID(ctx context.Context, id int64) (*mysql.ETCMeisai, error)
+	Update(ctx context.Context, data *mysql.ETCMeisai) error
+	DeleteByID(ctx context.Context, id int64) error
+	List(ctx context.Context, params *ETCMeisaiListParams) ([]*mysql.ETCMeisai, int64, error)
+	ListPage(ctx context.Context, params *ETCMeisaiListParams, page PageRequest) (*Page[mysql.ETCMeisai], error)
+	Stream(ctx context.Context, params *ETCMeisaiListParams, batchSize int, fn func([]*mysql.ETCMeisai) error) error
+	ListByHash(ctx context.Context, hash string) ([]*mysql.ETCMeisai, error)
+	ListByDateRange(ctx context.Context, start, end time.Time) ([]*mysql.ETCMeisai, error)
+	BatchCreate(ctx context.Context, data []*mysql.ETCMeisai) ([]BatchResult, error)
+	FindIDsByHash(ctx context.Context, hashes []string) (map[string]int64, error)
+	ListByHashes(ctx context.Context, hashes []string) ([]*mysql.ETCMeisai, error)
 }
 
 // ETCMeisaiListParams リスト取得用パラメータ
@@ -28,6 +37,69 @@ type ETCMeisaiListParams struct {
 	Offset    int
 }
 
+// BatchStatus 一括作成の各レコードの結果
+type BatchStatus int
+
+const (
+	// BatchInserted 作成した
+	BatchInserted BatchStatus = iota + 1
+	// BatchDuplicate 同じキーのレコードが登録済み（または一括作成の中で重複）のためスキップした
+	BatchDuplicate
+	// BatchInvalid バリデーションエラーのためスキップした
+	BatchInvalid
+)
+
+// BatchResult 一括作成の各レコードの結果（入力と同じ順序）
+type BatchResult struct {
+	Status BatchStatus
+	// ID 作成したレコードのID（BatchDuplicateの場合は登録済みのレコードのID）
+	ID   int64
+	Hash string
+	// Err BatchInvalid・BatchDuplicateの理由
+	Err error
+}
+
+var (
+	// ErrHashExists 同じhashのETC明細が登録済み
+	ErrHashExists = errors.New("etc_meisai with the same hash already exists")
+	// ErrHashDuplicatedInBatch 一括作成の中で同じhashのETC明細が重複
+	ErrHashDuplicatedInBatch = errors.New("duplicate hash in batch")
+)
+
+// batchChunkSize 一括作成時のIN句・INSERT 1回あたりの件数
+const batchChunkSize = 500
+
+// PrepareETCMeisaiBatch 一括作成の前処理
+// hashが空の明細はhashを生成し、バリデーションエラー・hashが明細の内容から生成したhashと異なる明細はBatchInvalid、
+// data内で先に同じhashの明細がある場合はBatchDuplicateとする（それ以外のStatusは0）
+func PrepareETCMeisaiBatch(data []*mysql.ETCMeisai) []BatchResult {
+	results := make([]BatchResult, len(data))
+	seen := make(map[string]int, len(data))
+	for i, item := range data {
+		if item.Hash == "" {
+			item.SetHash()
+		}
+		results[i].Hash = item.Hash
+		if item.Hash != item.GenerateHash() {
+			results[i].Status = BatchInvalid
+			results[i].Err = mysql.ErrHashMismatch
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			results[i].Status = BatchInvalid
+			results[i].Err = err
+			continue
+		}
+		if first, ok := seen[item.Hash]; ok {
+			results[i].Status = BatchDuplicate
+			results[i].Err = fmt.Errorf("%w: same as index %d", ErrHashDuplicatedInBatch, first)
+			continue
+		}
+		seen[item.Hash] = i
+	}
+	return results
+}
+
 // etcMeisaiRepo リポジトリ実装
 type etcMeisaiRepo struct {
 	db *gorm.DB
@@ -39,13 +111,16 @@ func NewETCMeisaiRepository(db *gorm.DB) ETCMeisaiRepository {
 }
 
 // Create データ作成
-func (r *etcMeisaiRepo) Create(data *mysql.ETCMeisai) error {
+func (r *etcMeisaiRepo) Create(ctx context.Context, data *mysql.ETCMeisai) error {
 	if err := data.Validate(); err != nil {
 		return fmt.Errorf("validation error: %w", err)
 	}
 
-	result := r.db.Create(data)
+	result := r.db.WithContext(ctx).Create(data)
 	if result.Error != nil {
+		if isDuplicateKeyError(result.Error) {
+			return ErrHashExists
+		}
 		return fmt.Errorf("failed to create record: %w", result.Error)
 	}
 
@@ -53,10 +128,10 @@ func (r *etcMeisaiRepo) Create(data *mysql.ETCMeisai) error {
 }
 
 // GetByID IDでデータ取得
-func (r *etcMeisaiRepo) GetByID(id int64) (*mysql.ETCMeisai, error) {
+func (r *etcMeisaiRepo) GetByID(ctx context.Context, id int64) (*mysql.ETCMeisai, error) {
 	var data mysql.ETCMeisai
 
-	result := r.db.First(&data, id)
+	result := r.db.WithContext(ctx).First(&data, id)
 	if result.Error != nil {
 		if result.Error == gorm.ErrRecordNotFound {
 			return nil, mysql.ErrRecordNotFound
@@ -68,13 +143,13 @@ func (r *etcMeisaiRepo) GetByID(id int64) (*mysql.ETCMeisai, error) {
 }
 
 // Update データ更新
-func (r *etcMeisaiRepo) Update(data *mysql.ETCMeisai) error {
+func (r *etcMeisaiRepo) Update(ctx context.Context, data *mysql.ETCMeisai) error {
 	if err := data.Validate(); err != nil {
 		return fmt.Errorf("validation error: %w", err)
 	}
 
 	// 既存レコードを確認
-	existing, err := r.GetByID(data.ID)
+	existing, err := r.GetByID(ctx, data.ID)
 	if err != nil {
 		if err == mysql.ErrRecordNotFound {
 			return mysql.ErrRecordNotFound
@@ -83,8 +158,11 @@ func (r *etcMeisaiRepo) Update(data *mysql.ETCMeisai) error {
 	}
 
 	// 更新実行
-	result := r.db.Model(existing).Updates(data)
+	result := r.db.WithContext(ctx).Model(existing).Updates(data)
 	if result.Error != nil {
+		if isDuplicateKeyError(result.Error) {
+			return ErrHashExists
+		}
 		return fmt.Errorf("failed to update record: %w", result.Error)
 	}
 
@@ -96,8 +174,8 @@ func (r *etcMeisaiRepo) Update(data *mysql.ETCMeisai) error {
 }
 
 // DeleteByID IDでデータ削除
-func (r *etcMeisaiRepo) DeleteByID(id int64) error {
-	result := r.db.Delete(&mysql.ETCMeisai{}, id)
+func (r *etcMeisaiRepo) DeleteByID(ctx context.Context, id int64) error {
+	result := r.db.WithContext(ctx).Delete(&mysql.ETCMeisai{}, id)
 	if result.Error != nil {
 		return fmt.Errorf("failed to delete record: %w", result.Error)
 	}
@@ -109,14 +187,18 @@ func (r *etcMeisaiRepo) DeleteByID(id int64) error {
 	return nil
 }
 
-// List 条件付きリスト取得
-func (r *etcMeisaiRepo) List(params *ETCMeisaiListParams) ([]*mysql.ETCMeisai, int64, error) {
-	var data []*mysql.ETCMeisai
-	var totalCount int64
-
-	query := r.db.Model(&mysql.ETCMeisai{})
+// etcMeisaiKeyset キーセットページネーションのキー（date_to DESC, id DESC）
+var etcMeisaiKeyset = keyset[mysql.ETCMeisai]{
+	desc: true,
+	columns: []keysetColumn[mysql.ETCMeisai]{
+		{column: "date_to", value: func(m *mysql.ETCMeisai) interface{} { return m.DateTo }},
+		{column: "id", value: func(m *mysql.ETCMeisai) interface{} { return m.ID }},
+	},
+}
 
-	// 条件の適用
+// filter 条件の適用
+func (r *etcMeisaiRepo) filter(ctx context.Context, params *ETCMeisaiListParams) *gorm.DB {
+	query := r.db.WithContext(ctx).Model(&mysql.ETCMeisai{})
 	if params.Hash != nil && *params.Hash != "" {
 		query = query.Where("hash = ?", *params.Hash)
 	}
@@ -126,6 +208,15 @@ func (r *etcMeisaiRepo) List(params *ETCMeisaiListParams) ([]*mysql.ETCMeisai, i
 	if params.EndDate != nil {
 		query = query.Where("date_to <= ?", *params.EndDate)
 	}
+	return query
+}
+
+// List 条件付きリスト取得
+func (r *etcMeisaiRepo) List(ctx context.Context, params *ETCMeisaiListParams) ([]*mysql.ETCMeisai, int64, error) {
+	var data []*mysql.ETCMeisai
+	var totalCount int64
+
+	query := r.filter(ctx, params)
 
 	// 総件数取得
 	if err := query.Count(&totalCount).Error; err != nil {
@@ -148,11 +239,21 @@ func (r *etcMeisaiRepo) List(params *ETCMeisaiListParams) ([]*mysql.ETCMeisai, i
 	return data, totalCount, nil
 }
 
+// ListPage 条件付きリスト取得（キーセットページネーション）
+func (r *etcMeisaiRepo) ListPage(ctx context.Context, params *ETCMeisaiListParams, page PageRequest) (*Page[mysql.ETCMeisai], error) {
+	return listByKeyset(r.filter(ctx, params), etcMeisaiKeyset, page)
+}
+
+// Stream 条件に一致するETC明細を古い順に一定件数ずつ取得（Limit/Offsetは使用しない）
+func (r *etcMeisaiRepo) Stream(ctx context.Context, params *ETCMeisaiListParams, batchSize int, fn func([]*mysql.ETCMeisai) error) error {
+	return streamByKeyset(ctx, r.filter(ctx, params), etcMeisaiKeyset.ascending(), batchSize, fn)
+}
+
 // ListByHash hashでリスト取得
-func (r *etcMeisaiRepo) ListByHash(hash string) ([]*mysql.ETCMeisai, error) {
+func (r *etcMeisaiRepo) ListByHash(ctx context.Context, hash string) ([]*mysql.ETCMeisai, error) {
 	var data []*mysql.ETCMeisai
 
-	if err := r.db.Where("hash = ?", hash).
+	if err := r.db.WithContext(ctx).Where("hash = ?", hash).
 		Order("date_to DESC").
 		Find(&data).Error; err != nil {
 		return nil, fmt.Errorf("failed to list by hash: %w", err)
@@ -162,10 +263,10 @@ func (r *etcMeisaiRepo) ListByHash(hash string) ([]*mysql.ETCMeisai, error) {
 }
 
 // ListByDateRange 日付範囲でリスト取得
-func (r *etcMeisaiRepo) ListByDateRange(start, end time.Time) ([]*mysql.ETCMeisai, error) {
+func (r *etcMeisaiRepo) ListByDateRange(ctx context.Context, start, end time.Time) ([]*mysql.ETCMeisai, error) {
 	var data []*mysql.ETCMeisai
 
-	if err := r.db.Where("date_to BETWEEN ? AND ?", start, end).
+	if err := r.db.WithContext(ctx).Where("date_to BETWEEN ? AND ?", start, end).
 		Order("date_to DESC").
 		Find(&data).Error; err != nil {
 		return nil, fmt.Errorf("failed to list by date range: %w", err)
@@ -173,3 +274,101 @@ func (r *etcMeisaiRepo) ListByDateRange(start, end time.Time) ([]*mysql.ETCMeisa
 
 	return data, nil
 }
+
+// BatchCreate ETC明細を1トランザクションで一括作成
+// hashが登録済みの明細・data内で重複する明細・バリデーションエラーの明細は作成せず、結果で報告する
+// 同じ明細を同時に取り込んだ場合は一意制約によりどちらかがErrHashExistsで失敗する（再実行すると重複として報告される）
+func (r *etcMeisaiRepo) BatchCreate(ctx context.Context, data []*mysql.ETCMeisai) ([]BatchResult, error) {
+	results := PrepareETCMeisaiBatch(data)
+
+	err := config.WithTransaction(r.db.WithContext(ctx), func(tx *gorm.DB) error {
+		var hashes []string
+		for i := range data {
+			if results[i].Status == 0 {
+				hashes = append(hashes, results[i].Hash)
+			}
+		}
+
+		// 登録済みのhash
+		idByHash, err := findIDsByHash(tx, hashes)
+		if err != nil {
+			return err
+		}
+
+		var inserts []*mysql.ETCMeisai
+		for i, item := range data {
+			if results[i].Status != 0 {
+				continue
+			}
+			if _, ok := idByHash[item.Hash]; ok {
+				results[i].Status = BatchDuplicate
+				results[i].Err = ErrHashExists
+				continue
+			}
+			inserts = append(inserts, item)
+		}
+		if len(inserts) > 0 {
+			if err := tx.CreateInBatches(inserts, batchChunkSize).Error; err != nil {
+				if isDuplicateKeyError(err) {
+					return ErrHashExists
+				}
+				return fmt.Errorf("failed to insert records: %w", err)
+			}
+		}
+
+		for i, item := range data {
+			switch {
+			case results[i].Status == 0:
+				results[i].Status = BatchInserted
+				results[i].ID = item.ID
+				idByHash[item.Hash] = item.ID
+			case results[i].Status == BatchDuplicate:
+				results[i].ID = idByHash[item.Hash]
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to batch create records: %w", err)
+	}
+
+	return results, nil
+}
+
+// FindIDsByHash hashが登録済みのETC明細のID（同じhashが複数ある場合は最小のID）
+func (r *etcMeisaiRepo) FindIDsByHash(ctx context.Context, hashes []string) (map[string]int64, error) {
+	return findIDsByHash(r.db.WithContext(ctx), hashes)
+}
+
+// ListByHashes hashのいずれかに一致するETC明細を取得（idの昇順、IN句はbatchChunkSize件ずつ）
+func (r *etcMeisaiRepo) ListByHashes(ctx context.Context, hashes []string) ([]*mysql.ETCMeisai, error) {
+	var data []*mysql.ETCMeisai
+	for start := 0; start < len(hashes); start += batchChunkSize {
+		var chunkData []*mysql.ETCMeisai
+		chunk := hashes[start:min(start+batchChunkSize, len(hashes))]
+		if err := r.db.WithContext(ctx).Where("hash IN ?", chunk).Find(&chunkData).Error; err != nil {
+			return nil, fmt.Errorf("failed to list by hashes: %w", err)
+		}
+		data = append(data, chunkData...)
+	}
+	sort.Slice(data, func(i, j int) bool { return data[i].ID < data[j].ID })
+	return data, nil
+}
+
+// findIDsByHash hashが登録済みのETC明細のID（IN句はbatchChunkSize件ずつ）
+func findIDsByHash(db *gorm.DB, hashes []string) (map[string]int64, error) {
+	idByHash := make(map[string]int64, len(hashes))
+	for start := 0; start < len(hashes); start += batchChunkSize {
+		var existing []*mysql.ETCMeisai
+		chunk := hashes[start:min(start+batchChunkSize, len(hashes))]
+		if err := db.Select("id", "hash").Where("hash IN ?", chunk).Order("id").Find(&existing).Error; err != nil {
+			return nil, fmt.Errorf("failed to find existing hashes: %w", err)
+		}
+		for _, e := range existing {
+			if _, ok := idByHash[e.Hash]; !ok {
+				idByHash[e.Hash] = e.ID
+			}
+		}
+	}
+	return idByHash, nil
+}
@@ -0,0 +1,185 @@
+package repository
+
+import (
+	"context"
+	"strings"
+
+	"github.com/yhonda-ohishi/db_service/src/config"
+	"github.com/yhonda-ohishi/db_service/src/models/ichibanboshi"
+	"gorm.io/gorm"
+)
+
+// HinmeiMasterRepository 品名マスタ・得意先別品名マスタリポジトリインターフェース
+type HinmeiMasterRepository interface {
+	GetAll(ctx context.Context, limit, offset int, sort []SortField) ([]*ichibanboshi.HinmeiMaster, int64, error)
+	GetPage(ctx context.Context, page PageRequest) (*Page[ichibanboshi.HinmeiMaster], error)
+	GetByHinmeiC(ctx context.Context, hinmeiC, hinmeiH string) (*ichibanboshi.HinmeiMaster, error)
+	Search(ctx context.Context, query string, limit int) ([]*ichibanboshi.HinmeiMaster, error)
+	GetTokuisakiHinmeiList(ctx context.Context, tokuisakiC, tokuisakiH string) ([]*ichibanboshi.TokuisakiHinmeiMaster, error)
+	GetTokuisakiHinmei(ctx context.Context, tokuisakiC, tokuisakiH, hinmeiC, hinmeiH string) (*ichibanboshi.TokuisakiHinmeiMaster, error)
+	Resolve(ctx context.Context, tokuisakiC, tokuisakiH, hinmeiC, hinmeiH string) (*ResolvedHinmei, error)
+}
+
+// ResolvedHinmei 得意先別品名マスタを反映した品名・単位・単位重量
+type ResolvedHinmei struct {
+	HinmeiN           *string
+	Tani              *string
+	TaniJuryo         *float64
+	TokuisakiSpecific bool // 得意先別品名マスタの登録がある
+}
+
+// ResolveHinmei 得意先別品名マスタを反映した品名・単位・単位重量を決定
+//
+// 項目ごとに次の順で決定する（どちらもnilの場合はgorm.ErrRecordNotFoundを返す）。
+//   - 品名N・単位: 得意先別品名マスタの値がNULL・空白のみでなければその値、それ以外は品名マスタの値
+//   - 単位重量: 得意先別品名マスタの値がNULLでなければその値（0も含む）、それ以外は品名マスタの値
+//
+// 品名マスタに登録がなく得意先別品名マスタのみある場合は、得意先別品名マスタの値のみを使用する。
+// この規則は一番星の請求処理で確認したものではなく、得意先別品名マスタを品名マスタの上書きとみなした想定のため、
+// 請求書の品名・単位と異なる場合はここを修正すること。
+func ResolveHinmei(hinmei *ichibanboshi.HinmeiMaster, tokuisaki *ichibanboshi.TokuisakiHinmeiMaster) (*ResolvedHinmei, error) {
+	if hinmei == nil && tokuisaki == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	resolved := &ResolvedHinmei{}
+	if hinmei != nil {
+		resolved.HinmeiN = hinmei.HinmeiN
+		resolved.Tani = hinmei.Tani
+		resolved.TaniJuryo = hinmei.TaniJuryo
+	}
+	if tokuisaki != nil {
+		resolved.TokuisakiSpecific = true
+		if isSet(tokuisaki.HinmeiN) {
+			resolved.HinmeiN = tokuisaki.HinmeiN
+		}
+		if isSet(tokuisaki.Tani) {
+			resolved.Tani = tokuisaki.Tani
+		}
+		if tokuisaki.TaniJuryo != nil {
+			resolved.TaniJuryo = tokuisaki.TaniJuryo
+		}
+	}
+	return resolved, nil
+}
+
+// isSet NULL・空白のみでない文字列
+func isSet(s *string) bool {
+	return s != nil && strings.TrimSpace(*s) != ""
+}
+
+// HinmeiMasterRepositoryImpl 品名マスタリポジトリ実装
+type HinmeiMasterRepositoryImpl struct {
+	*IchibanboshiRepository
+}
+
+// NewHinmeiMasterRepository 品名マスタリポジトリのコンストラクタ
+func NewHinmeiMasterRepository(sqlServerDB *config.SQLServerDatabase) HinmeiMasterRepository {
+	return &HinmeiMasterRepositoryImpl{
+		IchibanboshiRepository: NewIchibanboshiRepository(sqlServerDB),
+	}
+}
+
+// GetAll 全品名マスタを取得
+func (r *HinmeiMasterRepositoryImpl) GetAll(ctx context.Context, limit, offset int, sort []SortField) ([]*ichibanboshi.HinmeiMaster, int64, error) {
+	// ソート条件（ホワイトリストの列のみ）
+	orderBy, err := hinmeiMasterSortColumns.orderBy(sort, "品名C ASC, 品名H ASC")
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var hinmei []*ichibanboshi.HinmeiMaster
+	var totalCount int64
+
+	// 総数取得
+	if err := r.sqlServerDB.Conn().WithContext(ctx).Model(&ichibanboshi.HinmeiMaster{}).Count(&totalCount).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// データ取得
+	if err := r.sqlServerDB.Conn().WithContext(ctx).Limit(limit).Offset(offset).Order(orderBy).Find(&hinmei).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return hinmei, totalCount, nil
+}
+
+// hinmeiMasterKeyset キーセットページネーションのキー（品名C, 品名H ASC）
+var hinmeiMasterKeyset = keyset[ichibanboshi.HinmeiMaster]{
+	desc: false,
+	columns: []keysetColumn[ichibanboshi.HinmeiMaster]{
+		{column: "品名C", value: func(m *ichibanboshi.HinmeiMaster) interface{} { return m.HinmeiC }},
+		{column: "品名H", value: func(m *ichibanboshi.HinmeiMaster) interface{} { return m.HinmeiH }},
+	},
+}
+
+// GetPage 全品名マスタを取得（キーセットページネーション）
+func (r *HinmeiMasterRepositoryImpl) GetPage(ctx context.Context, page PageRequest) (*Page[ichibanboshi.HinmeiMaster], error) {
+	return listByKeyset(r.sqlServerDB.Conn().WithContext(ctx), hinmeiMasterKeyset, page)
+}
+
+// GetByHinmeiC 品名C、品名Hで品名マスタを取得（複合主キー）
+func (r *HinmeiMasterRepositoryImpl) GetByHinmeiC(ctx context.Context, hinmeiC, hinmeiH string) (*ichibanboshi.HinmeiMaster, error) {
+	var hinmei ichibanboshi.HinmeiMaster
+	if err := r.sqlServerDB.Conn().WithContext(ctx).Where("品名C = ? AND 品名H = ?", hinmeiC, hinmeiH).First(&hinmei).Error; err != nil {
+		return nil, err
+	}
+	return &hinmei, nil
+}
+
+// Search 品名N・品名R・品名F（カナ）の部分一致で品名マスタを検索（品名C, 品名H順）
+func (r *HinmeiMasterRepositoryImpl) Search(ctx context.Context, query string, limit int) ([]*ichibanboshi.HinmeiMaster, error) {
+	pattern := containsPattern(query)
+	var hinmei []*ichibanboshi.HinmeiMaster
+	if err := r.sqlServerDB.Conn().WithContext(ctx).
+		Where("品名N LIKE ? ESCAPE '\\' OR 品名R LIKE ? ESCAPE '\\' OR 品名F LIKE ? ESCAPE '\\'", pattern, pattern, pattern).
+		Order(hinmeiMasterKeyset.orderBy()).Limit(limit).Find(&hinmei).Error; err != nil {
+		return nil, err
+	}
+	return hinmei, nil
+}
+
+// GetTokuisakiHinmeiList 得意先の得意先別品名マスタを品名C, 品名H順に取得
+func (r *HinmeiMasterRepositoryImpl) GetTokuisakiHinmeiList(ctx context.Context, tokuisakiC, tokuisakiH string) ([]*ichibanboshi.TokuisakiHinmeiMaster, error) {
+	var hinmei []*ichibanboshi.TokuisakiHinmeiMaster
+	if err := r.sqlServerDB.Conn().WithContext(ctx).Where("得意先C = ? AND 得意先H = ?", tokuisakiC, tokuisakiH).
+		Order("品名C ASC, 品名H ASC").Find(&hinmei).Error; err != nil {
+		return nil, err
+	}
+	return hinmei, nil
+}
+
+// GetTokuisakiHinmei 得意先C、得意先H、品名C、品名Hで得意先別品名マスタを取得（複合主キー）
+func (r *HinmeiMasterRepositoryImpl) GetTokuisakiHinmei(ctx context.Context, tokuisakiC, tokuisakiH, hinmeiC, hinmeiH string) (*ichibanboshi.TokuisakiHinmeiMaster, error) {
+	var hinmei ichibanboshi.TokuisakiHinmeiMaster
+	if err := r.sqlServerDB.Conn().WithContext(ctx).
+		Where("得意先C = ? AND 得意先H = ? AND 品名C = ? AND 品名H = ?", tokuisakiC, tokuisakiH, hinmeiC, hinmeiH).
+		First(&hinmei).Error; err != nil {
+		return nil, err
+	}
+	return &hinmei, nil
+}
+
+// Resolve 得意先別品名マスタを反映した品名・単位・単位重量を取得（ResolveHinmei参照）
+func (r *HinmeiMasterRepositoryImpl) Resolve(ctx context.Context, tokuisakiC, tokuisakiH, hinmeiC, hinmeiH string) (*ResolvedHinmei, error) {
+	var hinmei []*ichibanboshi.HinmeiMaster
+	if err := r.sqlServerDB.Conn().WithContext(ctx).Where("品名C = ? AND 品名H = ?", hinmeiC, hinmeiH).
+		Limit(1).Find(&hinmei).Error; err != nil {
+		return nil, err
+	}
+	var tokuisaki []*ichibanboshi.TokuisakiHinmeiMaster
+	if err := r.sqlServerDB.Conn().WithContext(ctx).
+		Where("得意先C = ? AND 得意先H = ? AND 品名C = ? AND 品名H = ?", tokuisakiC, tokuisakiH, hinmeiC, hinmeiH).
+		Limit(1).Find(&tokuisaki).Error; err != nil {
+		return nil, err
+	}
+	return ResolveHinmei(first(hinmei), first(tokuisaki))
+}
+
+// first 先頭の要素（空の場合はnil）
+func first[T any](items []*T) *T {
+	if len(items) == 0 {
+		return nil
+	}
+	return items[0]
+}
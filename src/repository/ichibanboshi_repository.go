@@ -1,6 +1,9 @@
 package repository
 
 import (
+	"context"
+	"strings"
+
 	"github.com/yhonda-ohishi/db_service/src/config"
 	"github.com/yhonda-ohishi/db_service/src/models/ichibanboshi"
 )
@@ -19,30 +22,49 @@ func NewIchibanboshiRepository(sqlServerDB *config.SQLServerDatabase) *Ichibanbo
 
 // UntenNippoMeisaiRepository 運転日報明細リポジトリインターフェース
 type UntenNippoMeisaiRepository interface {
-	GetAll(limit, offset int, orderBy string) ([]*ichibanboshi.UntenNippoMeisai, int64, error)
-	GetByNippoK(nippoK, haishaK, sharyoC string) (*ichibanboshi.UntenNippoMeisai, error)
-	GetBySharyoC(sharyoC string, limit int) ([]*ichibanboshi.UntenNippoMeisai, error)
-	GetByDateRange(startDate, endDate string, limit, offset int) ([]*ichibanboshi.UntenNippoMeisai, int64, error)
+	GetAll(ctx context.Context, limit, offset int, sort []SortField) ([]*ichibanboshi.UntenNippoMeisai, int64, error)
+	GetPage(ctx context.Context, page PageRequest) (*Page[ichibanboshi.UntenNippoMeisai], error)
+	GetByNippoK(ctx context.Context, nippoK, haishaK, sharyoC string) (*ichibanboshi.UntenNippoMeisai, error)
+	GetBySharyoC(ctx context.Context, sharyoC string, limit int) ([]*ichibanboshi.UntenNippoMeisai, error)
+	GetByDateRange(ctx context.Context, startDate, endDate string, limit, offset int) ([]*ichibanboshi.UntenNippoMeisai, int64, error)
+	GetByBumon(ctx context.Context, bumon string, field UntenNippoMeisaiBumonField, startDate, endDate string, limit, offset int) ([]*ichibanboshi.UntenNippoMeisai, int64, error)
+	GetUntenshuCodes(ctx context.Context) ([]string, error)
+	StreamByDateRange(ctx context.Context, startDate, endDate string, batchSize int, fn func([]*ichibanboshi.UntenNippoMeisai) error) error
 }
 
+// UntenNippoMeisaiBumonField 運転日報明細を部門で絞り込む列
+type UntenNippoMeisaiBumonField int
+
+const (
+	// BumonFieldAny 受注部門・稼動部門のいずれか
+	BumonFieldAny UntenNippoMeisaiBumonField = iota
+	// BumonFieldJuchu 受注部門
+	BumonFieldJuchu
+	// BumonFieldKado 稼動部門
+	BumonFieldKado
+)
+
 // ShainMasterRepository 社員マスタリポジトリインターフェース
 type ShainMasterRepository interface {
-	GetAll(limit, offset int, orderBy string) ([]*ichibanboshi.ShainMaster, int64, error)
-	GetByShainC(shainC string) (*ichibanboshi.ShainMaster, error)
-	GetByBumonC(bumonC string) ([]*ichibanboshi.ShainMaster, error)
+	GetAll(ctx context.Context, limit, offset int, sort []SortField) ([]*ichibanboshi.ShainMaster, int64, error)
+	GetPage(ctx context.Context, page PageRequest) (*Page[ichibanboshi.ShainMaster], error)
+	GetByShainC(ctx context.Context, shainC string) (*ichibanboshi.ShainMaster, error)
+	GetByBumonC(ctx context.Context, bumonC string) ([]*ichibanboshi.ShainMaster, error)
 }
 
 // ChiikiMasterRepository 地域マスタリポジトリインターフェース
 type ChiikiMasterRepository interface {
-	GetAll(limit, offset int, orderBy string) ([]*ichibanboshi.ChiikiMaster, int64, error)
-	GetByChiikiC(chiikiC string) (*ichibanboshi.ChiikiMaster, error)
+	GetAll(ctx context.Context, limit, offset int, sort []SortField) ([]*ichibanboshi.ChiikiMaster, int64, error)
+	GetPage(ctx context.Context, page PageRequest) (*Page[ichibanboshi.ChiikiMaster], error)
+	GetByChiikiC(ctx context.Context, chiikiC string) (*ichibanboshi.ChiikiMaster, error)
 }
 
 // ChikuMasterRepository 地区マスタリポジトリインターフェース
 type ChikuMasterRepository interface {
-	GetAll(limit, offset int, orderBy string) ([]*ichibanboshi.ChikuMaster, int64, error)
-	GetByChikuC(chikuC string) (*ichibanboshi.ChikuMaster, error)
-	GetByChiikiC(chiikiC string) ([]*ichibanboshi.ChikuMaster, error)
+	GetAll(ctx context.Context, limit, offset int, sort []SortField) ([]*ichibanboshi.ChikuMaster, int64, error)
+	GetPage(ctx context.Context, page PageRequest) (*Page[ichibanboshi.ChikuMaster], error)
+	GetByChikuC(ctx context.Context, chikuC string) (*ichibanboshi.ChikuMaster, error)
+	GetByChiikiC(ctx context.Context, chiikiC string) ([]*ichibanboshi.ChikuMaster, error)
 }
 
 // UntenNippoMeisaiRepositoryImpl 運転日報明細リポジトリ実装
@@ -58,52 +80,82 @@ func NewUntenNippoMeisaiRepository(sqlServerDB *config.SQLServerDatabase) UntenN
 }
 
 // GetAll 全運転日報明細を取得
-func (r *UntenNippoMeisaiRepositoryImpl) GetAll(limit, offset int, orderBy string) ([]*ichibanboshi.UntenNippoMeisai, int64, error) {
+func (r *UntenNippoMeisaiRepositoryImpl) GetAll(ctx context.Context, limit, offset int, sort []SortField) ([]*ichibanboshi.UntenNippoMeisai, int64, error) {
+	// ソート条件（ホワイトリストの列のみ）
+	orderBy, err := untenNippoMeisaiSortColumns.orderBy(sort, "管理年月日 DESC")
+	if err != nil {
+		return nil, 0, err
+	}
+
 	var meisai []*ichibanboshi.UntenNippoMeisai
 	var totalCount int64
 
 	// 総数取得
-	if err := r.sqlServerDB.DB.Model(&ichibanboshi.UntenNippoMeisai{}).Count(&totalCount).Error; err != nil {
+	if err := r.sqlServerDB.Conn().WithContext(ctx).Model(&ichibanboshi.UntenNippoMeisai{}).Count(&totalCount).Error; err != nil {
 		return nil, 0, err
 	}
 
-	// デフォルトのorder byを設定
-	if orderBy == "" {
-		orderBy = "管理年月日 DESC"
-	}
-
 	// データ取得
-	if err := r.sqlServerDB.DB.Limit(limit).Offset(offset).Order(orderBy).Find(&meisai).Error; err != nil {
+	if err := r.sqlServerDB.Conn().WithContext(ctx).Limit(limit).Offset(offset).Order(orderBy).Find(&meisai).Error; err != nil {
 		return nil, 0, err
 	}
 
 	return meisai, totalCount, nil
 }
 
+// untenNippoMeisaiKeyset キーセットページネーションのキー（管理年月日 DESC, 日報K DESC, 配車K DESC, 車輌C DESC）
+var untenNippoMeisaiKeyset = keyset[ichibanboshi.UntenNippoMeisai]{
+	desc: true,
+	columns: []keysetColumn[ichibanboshi.UntenNippoMeisai]{
+		{column: "管理年月日", value: func(m *ichibanboshi.UntenNippoMeisai) interface{} { return m.KanriNengappi }},
+		{column: "日報K", value: func(m *ichibanboshi.UntenNippoMeisai) interface{} { return m.NippoK }},
+		{column: "配車K", value: func(m *ichibanboshi.UntenNippoMeisai) interface{} { return m.HaishaK }},
+		{column: "車輌C", value: func(m *ichibanboshi.UntenNippoMeisai) interface{} { return m.SharyoC }},
+	},
+}
+
+// GetPage 全運転日報明細を取得（キーセットページネーション）
+func (r *UntenNippoMeisaiRepositoryImpl) GetPage(ctx context.Context, page PageRequest) (*Page[ichibanboshi.UntenNippoMeisai], error) {
+	return listByKeyset(r.sqlServerDB.Conn().WithContext(ctx), untenNippoMeisaiKeyset, page)
+}
+
 // GetByNippoK 日報K、配車K、車輌Cで運転日報明細を取得（複合主キー）
-func (r *UntenNippoMeisaiRepositoryImpl) GetByNippoK(nippoK, haishaK, sharyoC string) (*ichibanboshi.UntenNippoMeisai, error) {
+func (r *UntenNippoMeisaiRepositoryImpl) GetByNippoK(ctx context.Context, nippoK, haishaK, sharyoC string) (*ichibanboshi.UntenNippoMeisai, error) {
 	var meisai ichibanboshi.UntenNippoMeisai
-	if err := r.sqlServerDB.DB.Where("日報K = ? AND 配車K = ? AND 車輌C = ?", nippoK, haishaK, sharyoC).First(&meisai).Error; err != nil {
+	if err := r.sqlServerDB.Conn().WithContext(ctx).Where("日報K = ? AND 配車K = ? AND 車輌C = ?", nippoK, haishaK, sharyoC).First(&meisai).Error; err != nil {
 		return nil, err
 	}
 	return &meisai, nil
 }
 
 // GetBySharyoC 車輌Cで運転日報明細を取得
-func (r *UntenNippoMeisaiRepositoryImpl) GetBySharyoC(sharyoC string, limit int) ([]*ichibanboshi.UntenNippoMeisai, error) {
+func (r *UntenNippoMeisaiRepositoryImpl) GetBySharyoC(ctx context.Context, sharyoC string, limit int) ([]*ichibanboshi.UntenNippoMeisai, error) {
 	var meisai []*ichibanboshi.UntenNippoMeisai
-	if err := r.sqlServerDB.DB.Where("車輌C = ?", sharyoC).Limit(limit).Order("管理年月日 DESC").Find(&meisai).Error; err != nil {
+	if err := r.sqlServerDB.Conn().WithContext(ctx).Where("車輌C = ?", sharyoC).Limit(limit).Order("管理年月日 DESC").Find(&meisai).Error; err != nil {
 		return nil, err
 	}
 	return meisai, nil
 }
 
+// GetUntenshuCodes 運転日報明細の運転手C（前後の空白を除いて重複なし、空を除く昇順）
+func (r *UntenNippoMeisaiRepositoryImpl) GetUntenshuCodes(ctx context.Context) ([]string, error) {
+	var codes []string
+	if err := r.sqlServerDB.Conn().WithContext(ctx).Model(&ichibanboshi.UntenNippoMeisai{}).
+		Distinct().Pluck("運転手C", &codes).Error; err != nil {
+		return nil, err
+	}
+	for i, code := range codes {
+		codes[i] = strings.TrimSpace(code)
+	}
+	return distinctCodes(codes, ""), nil
+}
+
 // GetByDateRange 日付範囲で運転日報明細を取得
-func (r *UntenNippoMeisaiRepositoryImpl) GetByDateRange(startDate, endDate string, limit, offset int) ([]*ichibanboshi.UntenNippoMeisai, int64, error) {
+func (r *UntenNippoMeisaiRepositoryImpl) GetByDateRange(ctx context.Context, startDate, endDate string, limit, offset int) ([]*ichibanboshi.UntenNippoMeisai, int64, error) {
 	var meisai []*ichibanboshi.UntenNippoMeisai
 	var totalCount int64
 
-	query := r.sqlServerDB.DB.Where("管理年月日 BETWEEN ? AND ?", startDate, endDate)
+	query := r.sqlServerDB.Conn().WithContext(ctx).Where("管理年月日 BETWEEN ? AND ?", startDate, endDate)
 
 	// 総数取得
 	if err := query.Model(&ichibanboshi.UntenNippoMeisai{}).Count(&totalCount).Error; err != nil {
@@ -118,6 +170,54 @@ func (r *UntenNippoMeisaiRepositoryImpl) GetByDateRange(startDate, endDate strin
 	return meisai, totalCount, nil
 }
 
+// GetByBumon 受注部門・稼動部門で運転日報明細を取得（管理年月日の降順）
+// startDate・endDateが空の場合は制限しない
+func (r *UntenNippoMeisaiRepositoryImpl) GetByBumon(ctx context.Context, bumon string, field UntenNippoMeisaiBumonField, startDate, endDate string, limit, offset int) ([]*ichibanboshi.UntenNippoMeisai, int64, error) {
+	bumon = NormalizeBumonC(bumon)
+	query := r.sqlServerDB.Conn().WithContext(ctx).Model(&ichibanboshi.UntenNippoMeisai{})
+	switch field {
+	case BumonFieldJuchu:
+		query = query.Where("受注部門 = ?", bumon)
+	case BumonFieldKado:
+		query = query.Where("稼動部門 = ?", bumon)
+	default:
+		query = query.Where("(受注部門 = ? OR 稼動部門 = ?)", bumon, bumon)
+	}
+	if startDate != "" {
+		query = query.Where("管理年月日 >= ?", startDate)
+	}
+	if endDate != "" {
+		query = query.Where("管理年月日 <= ?", endDate)
+	}
+
+	var meisai []*ichibanboshi.UntenNippoMeisai
+	var totalCount int64
+
+	// 総数取得
+	if err := query.Count(&totalCount).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// データ取得
+	if err := query.Limit(limit).Offset(offset).Order(untenNippoMeisaiKeyset.orderBy()).Find(&meisai).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return meisai, totalCount, nil
+}
+
+// StreamByDateRange 日付範囲で運転日報明細を古い順に一定件数ずつ取得
+func (r *UntenNippoMeisaiRepositoryImpl) StreamByDateRange(ctx context.Context, startDate, endDate string, batchSize int, fn func([]*ichibanboshi.UntenNippoMeisai) error) error {
+	query := r.sqlServerDB.Conn().WithContext(ctx).Model(&ichibanboshi.UntenNippoMeisai{})
+	if startDate != "" {
+		query = query.Where("管理年月日 >= ?", startDate)
+	}
+	if endDate != "" {
+		query = query.Where("管理年月日 <= ?", endDate)
+	}
+	return streamByKeyset(ctx, query, untenNippoMeisaiKeyset.ascending(), batchSize, fn)
+}
+
 // ShainMasterRepositoryImpl 社員マスタリポジトリ実装
 type ShainMasterRepositoryImpl struct {
 	*IchibanboshiRepository
@@ -131,41 +231,55 @@ func NewShainMasterRepository(sqlServerDB *config.SQLServerDatabase) ShainMaster
 }
 
 // GetAll 全社員マスタを取得
-func (r *ShainMasterRepositoryImpl) GetAll(limit, offset int, orderBy string) ([]*ichibanboshi.ShainMaster, int64, error) {
+func (r *ShainMasterRepositoryImpl) GetAll(ctx context.Context, limit, offset int, sort []SortField) ([]*ichibanboshi.ShainMaster, int64, error) {
+	// ソート条件（ホワイトリストの列のみ）
+	orderBy, err := shainMasterSortColumns.orderBy(sort, "社員C ASC")
+	if err != nil {
+		return nil, 0, err
+	}
+
 	var shain []*ichibanboshi.ShainMaster
 	var totalCount int64
 
 	// 総数取得
-	if err := r.sqlServerDB.DB.Model(&ichibanboshi.ShainMaster{}).Count(&totalCount).Error; err != nil {
+	if err := r.sqlServerDB.Conn().WithContext(ctx).Model(&ichibanboshi.ShainMaster{}).Count(&totalCount).Error; err != nil {
 		return nil, 0, err
 	}
 
-	// デフォルトのorder byを設定
-	if orderBy == "" {
-		orderBy = "社員C ASC"
-	}
-
 	// データ取得
-	if err := r.sqlServerDB.DB.Limit(limit).Offset(offset).Order(orderBy).Find(&shain).Error; err != nil {
+	if err := r.sqlServerDB.Conn().WithContext(ctx).Limit(limit).Offset(offset).Order(orderBy).Find(&shain).Error; err != nil {
 		return nil, 0, err
 	}
 
 	return shain, totalCount, nil
 }
 
+// shainMasterKeyset キーセットページネーションのキー（社員C ASC）
+var shainMasterKeyset = keyset[ichibanboshi.ShainMaster]{
+	desc: false,
+	columns: []keysetColumn[ichibanboshi.ShainMaster]{
+		{column: "社員C", value: func(m *ichibanboshi.ShainMaster) interface{} { return m.ShainC }},
+	},
+}
+
+// GetPage 全社員マスタを取得（キーセットページネーション）
+func (r *ShainMasterRepositoryImpl) GetPage(ctx context.Context, page PageRequest) (*Page[ichibanboshi.ShainMaster], error) {
+	return listByKeyset(r.sqlServerDB.Conn().WithContext(ctx), shainMasterKeyset, page)
+}
+
 // GetByShainC 社員Cで社員マスタを取得
-func (r *ShainMasterRepositoryImpl) GetByShainC(shainC string) (*ichibanboshi.ShainMaster, error) {
+func (r *ShainMasterRepositoryImpl) GetByShainC(ctx context.Context, shainC string) (*ichibanboshi.ShainMaster, error) {
 	var shain ichibanboshi.ShainMaster
-	if err := r.sqlServerDB.DB.Where("社員C = ?", shainC).First(&shain).Error; err != nil {
+	if err := r.sqlServerDB.Conn().WithContext(ctx).Where("社員C = ?", shainC).First(&shain).Error; err != nil {
 		return nil, err
 	}
 	return &shain, nil
 }
 
 // GetByBumonC 部門Cで社員マスタを取得
-func (r *ShainMasterRepositoryImpl) GetByBumonC(bumonC string) ([]*ichibanboshi.ShainMaster, error) {
+func (r *ShainMasterRepositoryImpl) GetByBumonC(ctx context.Context, bumonC string) ([]*ichibanboshi.ShainMaster, error) {
 	var shain []*ichibanboshi.ShainMaster
-	if err := r.sqlServerDB.DB.Where("部門C = ?", bumonC).Order("社員C ASC").Find(&shain).Error; err != nil {
+	if err := r.sqlServerDB.Conn().WithContext(ctx).Where("部門C = ?", bumonC).Order("社員C ASC").Find(&shain).Error; err != nil {
 		return nil, err
 	}
 	return shain, nil
@@ -184,32 +298,46 @@ func NewChiikiMasterRepository(sqlServerDB *config.SQLServerDatabase) ChiikiMast
 }
 
 // GetAll 全地域マスタを取得
-func (r *ChiikiMasterRepositoryImpl) GetAll(limit, offset int, orderBy string) ([]*ichibanboshi.ChiikiMaster, int64, error) {
+func (r *ChiikiMasterRepositoryImpl) GetAll(ctx context.Context, limit, offset int, sort []SortField) ([]*ichibanboshi.ChiikiMaster, int64, error) {
+	// ソート条件（ホワイトリストの列のみ）
+	orderBy, err := chiikiMasterSortColumns.orderBy(sort, "地域C ASC")
+	if err != nil {
+		return nil, 0, err
+	}
+
 	var chiiki []*ichibanboshi.ChiikiMaster
 	var totalCount int64
 
 	// 総数取得
-	if err := r.sqlServerDB.DB.Model(&ichibanboshi.ChiikiMaster{}).Count(&totalCount).Error; err != nil {
+	if err := r.sqlServerDB.Conn().WithContext(ctx).Model(&ichibanboshi.ChiikiMaster{}).Count(&totalCount).Error; err != nil {
 		return nil, 0, err
 	}
 
-	// デフォルトのorder byを設定
-	if orderBy == "" {
-		orderBy = "地域C ASC"
-	}
-
 	// データ取得
-	if err := r.sqlServerDB.DB.Limit(limit).Offset(offset).Order(orderBy).Find(&chiiki).Error; err != nil {
+	if err := r.sqlServerDB.Conn().WithContext(ctx).Limit(limit).Offset(offset).Order(orderBy).Find(&chiiki).Error; err != nil {
 		return nil, 0, err
 	}
 
 	return chiiki, totalCount, nil
 }
 
+// chiikiMasterKeyset キーセットページネーションのキー（地域C ASC）
+var chiikiMasterKeyset = keyset[ichibanboshi.ChiikiMaster]{
+	desc: false,
+	columns: []keysetColumn[ichibanboshi.ChiikiMaster]{
+		{column: "地域C", value: func(m *ichibanboshi.ChiikiMaster) interface{} { return m.ChiikiC }},
+	},
+}
+
+// GetPage 全地域マスタを取得（キーセットページネーション）
+func (r *ChiikiMasterRepositoryImpl) GetPage(ctx context.Context, page PageRequest) (*Page[ichibanboshi.ChiikiMaster], error) {
+	return listByKeyset(r.sqlServerDB.Conn().WithContext(ctx), chiikiMasterKeyset, page)
+}
+
 // GetByChiikiC 地域Cで地域マスタを取得
-func (r *ChiikiMasterRepositoryImpl) GetByChiikiC(chiikiC string) (*ichibanboshi.ChiikiMaster, error) {
+func (r *ChiikiMasterRepositoryImpl) GetByChiikiC(ctx context.Context, chiikiC string) (*ichibanboshi.ChiikiMaster, error) {
 	var chiiki ichibanboshi.ChiikiMaster
-	if err := r.sqlServerDB.DB.Where("地域C = ?", chiikiC).First(&chiiki).Error; err != nil {
+	if err := r.sqlServerDB.Conn().WithContext(ctx).Where("地域C = ?", chiikiC).First(&chiiki).Error; err != nil {
 		return nil, err
 	}
 	return &chiiki, nil
@@ -228,41 +356,55 @@ func NewChikuMasterRepository(sqlServerDB *config.SQLServerDatabase) ChikuMaster
 }
 
 // GetAll 全地区マスタを取得
-func (r *ChikuMasterRepositoryImpl) GetAll(limit, offset int, orderBy string) ([]*ichibanboshi.ChikuMaster, int64, error) {
+func (r *ChikuMasterRepositoryImpl) GetAll(ctx context.Context, limit, offset int, sort []SortField) ([]*ichibanboshi.ChikuMaster, int64, error) {
+	// ソート条件（ホワイトリストの列のみ）
+	orderBy, err := chikuMasterSortColumns.orderBy(sort, "地区C ASC")
+	if err != nil {
+		return nil, 0, err
+	}
+
 	var chiku []*ichibanboshi.ChikuMaster
 	var totalCount int64
 
 	// 総数取得
-	if err := r.sqlServerDB.DB.Model(&ichibanboshi.ChikuMaster{}).Count(&totalCount).Error; err != nil {
+	if err := r.sqlServerDB.Conn().WithContext(ctx).Model(&ichibanboshi.ChikuMaster{}).Count(&totalCount).Error; err != nil {
 		return nil, 0, err
 	}
 
-	// デフォルトのorder byを設定
-	if orderBy == "" {
-		orderBy = "地区C ASC"
-	}
-
 	// データ取得
-	if err := r.sqlServerDB.DB.Limit(limit).Offset(offset).Order(orderBy).Find(&chiku).Error; err != nil {
+	if err := r.sqlServerDB.Conn().WithContext(ctx).Limit(limit).Offset(offset).Order(orderBy).Find(&chiku).Error; err != nil {
 		return nil, 0, err
 	}
 
 	return chiku, totalCount, nil
 }
 
+// chikuMasterKeyset キーセットページネーションのキー（地区C ASC）
+var chikuMasterKeyset = keyset[ichibanboshi.ChikuMaster]{
+	desc: false,
+	columns: []keysetColumn[ichibanboshi.ChikuMaster]{
+		{column: "地区C", value: func(m *ichibanboshi.ChikuMaster) interface{} { return m.ChikuC }},
+	},
+}
+
+// GetPage 全地区マスタを取得（キーセットページネーション）
+func (r *ChikuMasterRepositoryImpl) GetPage(ctx context.Context, page PageRequest) (*Page[ichibanboshi.ChikuMaster], error) {
+	return listByKeyset(r.sqlServerDB.Conn().WithContext(ctx), chikuMasterKeyset, page)
+}
+
 // GetByChikuC 地区Cで地区マスタを取得
-func (r *ChikuMasterRepositoryImpl) GetByChikuC(chikuC string) (*ichibanboshi.ChikuMaster, error) {
+func (r *ChikuMasterRepositoryImpl) GetByChikuC(ctx context.Context, chikuC string) (*ichibanboshi.ChikuMaster, error) {
 	var chiku ichibanboshi.ChikuMaster
-	if err := r.sqlServerDB.DB.Where("地区C = ?", chikuC).First(&chiku).Error; err != nil {
+	if err := r.sqlServerDB.Conn().WithContext(ctx).Where("地区C = ?", chikuC).First(&chiku).Error; err != nil {
 		return nil, err
 	}
 	return &chiku, nil
 }
 
 // GetByChiikiC 地域Cで地区マスタを取得
-func (r *ChikuMasterRepositoryImpl) GetByChiikiC(chiikiC string) ([]*ichibanboshi.ChikuMaster, error) {
+func (r *ChikuMasterRepositoryImpl) GetByChiikiC(ctx context.Context, chiikiC string) ([]*ichibanboshi.ChikuMaster, error) {
 	var chiku []*ichibanboshi.ChikuMaster
-	if err := r.sqlServerDB.DB.Where("地域C = ?", chiikiC).Order("地区C ASC").Find(&chiku).Error; err != nil {
+	if err := r.sqlServerDB.Conn().WithContext(ctx).Where("地域C = ?", chiikiC).Order("地区C ASC").Find(&chiku).Error; err != nil {
 		return nil, err
 	}
 	return chiku, nil
@@ -0,0 +1,569 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yhonda-ohishi/db_service/src/models/ichibanboshi"
+	"github.com/yhonda-ohishi/db_service/src/repository"
+	"gorm.io/gorm"
+)
+
+// SQL Server（一番星）用リポジトリのインターフェース実装の確認
+var (
+	_ repository.UntenNippoMeisaiRepository = (*UntenNippoMeisaiRepository)(nil)
+	_ repository.ShainMasterRepository      = (*ShainMasterRepository)(nil)
+	_ repository.ChiikiMasterRepository     = (*ChiikiMasterRepository)(nil)
+	_ repository.ChikuMasterRepository      = (*ChikuMasterRepository)(nil)
+	_ repository.TokuisakiMasterRepository  = (*TokuisakiMasterRepository)(nil)
+	_ repository.HinmeiMasterRepository     = (*HinmeiMasterRepository)(nil)
+	_ repository.BumonMasterRepository      = (*BumonMasterRepository)(nil)
+	_ repository.SharyoMasterRepository     = (*SharyoMasterRepository)(nil)
+)
+
+// ---- UntenNippoMeisai ----
+
+// UntenNippoMeisaiRepository 運転日報明細のインメモリ実装
+type UntenNippoMeisaiRepository struct {
+	table *table[ichibanboshi.UntenNippoMeisai]
+}
+
+// NewUntenNippoMeisaiRepository UntenNippoMeisaiRepositoryのコンストラクタ
+func NewUntenNippoMeisaiRepository() *UntenNippoMeisaiRepository {
+	return &UntenNippoMeisaiRepository{table: newTable(untenNippoMeisaiKey)}
+}
+
+// untenNippoMeisaiKey 複合主キー（日報K, 配車K, 車輌C）
+func untenNippoMeisaiKey(m *ichibanboshi.UntenNippoMeisai) string {
+	return key(m.NippoK, m.HaishaK, m.SharyoC)
+}
+
+// untenNippoMeisaiKeyset キーセットページネーションのキー（管理年月日, 日報K, 配車K, 車輌C DESC）
+var untenNippoMeisaiKeyset = keyset{
+	{Field: "管理年月日", Desc: true},
+	{Field: "日報K", Desc: true},
+	{Field: "配車K", Desc: true},
+	{Field: "車輌C", Desc: true},
+}
+
+// Add データを登録
+func (r *UntenNippoMeisaiRepository) Add(items ...*ichibanboshi.UntenNippoMeisai) {
+	r.table.put(items...)
+}
+
+// GetAll 全運転日報明細を取得
+func (r *UntenNippoMeisaiRepository) GetAll(_ context.Context, limit, offset int, sort []repository.SortField) ([]*ichibanboshi.UntenNippoMeisai, int64, error) {
+	return getAll(r.table, limit, offset, sort, []repository.SortField{{Field: "管理年月日", Desc: true}})
+}
+
+// GetPage 全運転日報明細を取得（キーセットページネーション）
+func (r *UntenNippoMeisaiRepository) GetPage(_ context.Context, page repository.PageRequest) (*repository.Page[ichibanboshi.UntenNippoMeisai], error) {
+	return listByKeyset(r.table.find(nil), untenNippoMeisaiKey, untenNippoMeisaiKeyset, page)
+}
+
+// GetByNippoK 複合主キーで運転日報明細を取得
+func (r *UntenNippoMeisaiRepository) GetByNippoK(_ context.Context, nippoK, haishaK, sharyoC string) (*ichibanboshi.UntenNippoMeisai, error) {
+	return getOrNotFound(r.table, key(nippoK, haishaK, sharyoC))
+}
+
+// GetBySharyoC 車輌Cで運転日報明細を取得（管理年月日の降順）
+func (r *UntenNippoMeisaiRepository) GetBySharyoC(_ context.Context, sharyoC string, limit int) ([]*ichibanboshi.UntenNippoMeisai, error) {
+	meisai := r.table.find(func(m *ichibanboshi.UntenNippoMeisai) bool { return m.SharyoC == sharyoC })
+	sortItems(meisai, untenNippoMeisaiKey, []repository.SortField{{Field: "管理年月日", Desc: true}})
+	return limitOffset(meisai, limit, 0), nil
+}
+
+// GetUntenshuCodes 運転日報明細の運転手C（前後の空白を除いて重複なし、空を除く昇順）
+func (r *UntenNippoMeisaiRepository) GetUntenshuCodes(_ context.Context) ([]string, error) {
+	var codes []string
+	for _, m := range r.table.find(nil) {
+		codes = append(codes, strings.TrimSpace(m.UntenshuC))
+	}
+	return distinctCodes(codes, ""), nil
+}
+
+// GetByDateRange 管理年月日の範囲で運転日報明細を取得（管理年月日の降順）
+func (r *UntenNippoMeisaiRepository) GetByDateRange(_ context.Context, startDate, endDate string, limit, offset int) ([]*ichibanboshi.UntenNippoMeisai, int64, error) {
+	start, err := parseDate(startDate)
+	if err != nil {
+		return nil, 0, err
+	}
+	end, err := parseDate(endDate)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	meisai := r.table.find(func(m *ichibanboshi.UntenNippoMeisai) bool { return inRange(m.KanriNengappi, &start, &end) })
+	sortItems(meisai, untenNippoMeisaiKey, []repository.SortField{{Field: "管理年月日", Desc: true}})
+	return limitOffset(meisai, limit, offset), int64(len(meisai)), nil
+}
+
+// GetByBumon 受注部門・稼動部門で運転日報明細を取得（管理年月日の降順）
+// startDate・endDateが空の場合は制限しない
+func (r *UntenNippoMeisaiRepository) GetByBumon(_ context.Context, bumon string, field repository.UntenNippoMeisaiBumonField, startDate, endDate string, limit, offset int) ([]*ichibanboshi.UntenNippoMeisai, int64, error) {
+	start, end, err := parseDateRange(startDate, endDate)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	bumon = repository.NormalizeBumonC(bumon)
+	juchu := field != repository.BumonFieldKado
+	kado := field != repository.BumonFieldJuchu
+	meisai := r.table.find(func(m *ichibanboshi.UntenNippoMeisai) bool {
+		matched := (juchu && repository.NormalizeBumonC(m.JuchuBumon) == bumon) ||
+			(kado && repository.NormalizeBumonC(m.KadoBumon) == bumon)
+		return matched && inRange(m.KanriNengappi, start, end)
+	})
+	sortItems(meisai, untenNippoMeisaiKey, untenNippoMeisaiKeyset)
+	return limitOffset(meisai, limit, offset), int64(len(meisai)), nil
+}
+
+// StreamByDateRange 管理年月日の範囲に含まれる運転日報明細を古い順に一定件数ずつ取得
+// startDate・endDateが空の場合は制限しない
+func (r *UntenNippoMeisaiRepository) StreamByDateRange(ctx context.Context, startDate, endDate string, batchSize int, fn func([]*ichibanboshi.UntenNippoMeisai) error) error {
+	start, end, err := parseDateRange(startDate, endDate)
+	if err != nil {
+		return err
+	}
+
+	meisai := r.table.find(func(m *ichibanboshi.UntenNippoMeisai) bool { return inRange(m.KanriNengappi, start, end) })
+	return streamByKeyset(ctx, meisai, untenNippoMeisaiKey, untenNippoMeisaiKeyset.ascending(), batchSize, fn)
+}
+
+// parseDateRange 日付の範囲を解釈（空の場合はnil）
+func parseDateRange(startDate, endDate string) (start, end *time.Time, err error) {
+	if startDate != "" {
+		t, err := parseDate(startDate)
+		if err != nil {
+			return nil, nil, err
+		}
+		start = &t
+	}
+	if endDate != "" {
+		t, err := parseDate(endDate)
+		if err != nil {
+			return nil, nil, err
+		}
+		end = &t
+	}
+	return start, end, nil
+}
+
+// dateLayouts SQL Serverが日付として解釈する文字列の形式
+var dateLayouts = []string{time.RFC3339Nano, "2006-01-02 15:04:05", "2006-01-02T15:04:05", "2006-01-02"}
+
+// parseDate 日付の文字列を解釈（タイムゾーンの指定がない場合はUTC）
+func parseDate(value string) (time.Time, error) {
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date: %q", value)
+}
+
+// ---- ShainMaster ----
+
+// ShainMasterRepository 社員マスタのインメモリ実装
+type ShainMasterRepository struct {
+	table *table[ichibanboshi.ShainMaster]
+}
+
+// NewShainMasterRepository ShainMasterRepositoryのコンストラクタ
+func NewShainMasterRepository() *ShainMasterRepository {
+	return &ShainMasterRepository{table: newTable(shainMasterKey)}
+}
+
+// shainMasterKey 主キー（社員C）
+func shainMasterKey(m *ichibanboshi.ShainMaster) string {
+	return key(m.ShainC)
+}
+
+// shainMasterKeyset キーセットページネーションのキー（社員C ASC）
+var shainMasterKeyset = keyset{{Field: "社員C"}}
+
+// Add データを登録
+func (r *ShainMasterRepository) Add(items ...*ichibanboshi.ShainMaster) {
+	r.table.put(items...)
+}
+
+// GetAll 全社員を取得
+func (r *ShainMasterRepository) GetAll(_ context.Context, limit, offset int, sort []repository.SortField) ([]*ichibanboshi.ShainMaster, int64, error) {
+	return getAll(r.table, limit, offset, sort, shainMasterKeyset)
+}
+
+// GetPage 全社員を取得（キーセットページネーション）
+func (r *ShainMasterRepository) GetPage(_ context.Context, page repository.PageRequest) (*repository.Page[ichibanboshi.ShainMaster], error) {
+	return listByKeyset(r.table.find(nil), shainMasterKey, shainMasterKeyset, page)
+}
+
+// GetByShainC 社員Cで社員を取得
+func (r *ShainMasterRepository) GetByShainC(_ context.Context, shainC string) (*ichibanboshi.ShainMaster, error) {
+	return getOrNotFound(r.table, key(shainC))
+}
+
+// GetByBumonC 部門Cで社員を取得（社員C順）
+func (r *ShainMasterRepository) GetByBumonC(_ context.Context, bumonC string) ([]*ichibanboshi.ShainMaster, error) {
+	shain := r.table.find(func(m *ichibanboshi.ShainMaster) bool { return m.BumonC == bumonC })
+	sortItems(shain, shainMasterKey, shainMasterKeyset)
+	return shain, nil
+}
+
+// ---- ChiikiMaster ----
+
+// ChiikiMasterRepository 地域マスタのインメモリ実装
+type ChiikiMasterRepository struct {
+	table *table[ichibanboshi.ChiikiMaster]
+}
+
+// NewChiikiMasterRepository ChiikiMasterRepositoryのコンストラクタ
+func NewChiikiMasterRepository() *ChiikiMasterRepository {
+	return &ChiikiMasterRepository{table: newTable(chiikiMasterKey)}
+}
+
+// chiikiMasterKey 主キー（地域C）
+func chiikiMasterKey(m *ichibanboshi.ChiikiMaster) string {
+	return key(m.ChiikiC)
+}
+
+// chiikiMasterKeyset キーセットページネーションのキー（地域C ASC）
+var chiikiMasterKeyset = keyset{{Field: "地域C"}}
+
+// Add データを登録
+func (r *ChiikiMasterRepository) Add(items ...*ichibanboshi.ChiikiMaster) {
+	r.table.put(items...)
+}
+
+// GetAll 全地域を取得
+func (r *ChiikiMasterRepository) GetAll(_ context.Context, limit, offset int, sort []repository.SortField) ([]*ichibanboshi.ChiikiMaster, int64, error) {
+	return getAll(r.table, limit, offset, sort, chiikiMasterKeyset)
+}
+
+// GetPage 全地域を取得（キーセットページネーション）
+func (r *ChiikiMasterRepository) GetPage(_ context.Context, page repository.PageRequest) (*repository.Page[ichibanboshi.ChiikiMaster], error) {
+	return listByKeyset(r.table.find(nil), chiikiMasterKey, chiikiMasterKeyset, page)
+}
+
+// GetByChiikiC 地域Cで地域を取得
+func (r *ChiikiMasterRepository) GetByChiikiC(_ context.Context, chiikiC string) (*ichibanboshi.ChiikiMaster, error) {
+	return getOrNotFound(r.table, key(chiikiC))
+}
+
+// ---- ChikuMaster ----
+
+// ChikuMasterRepository 地区マスタのインメモリ実装
+type ChikuMasterRepository struct {
+	table *table[ichibanboshi.ChikuMaster]
+}
+
+// NewChikuMasterRepository ChikuMasterRepositoryのコンストラクタ
+func NewChikuMasterRepository() *ChikuMasterRepository {
+	return &ChikuMasterRepository{table: newTable(chikuMasterKey)}
+}
+
+// chikuMasterKey 主キー（地区C）
+func chikuMasterKey(m *ichibanboshi.ChikuMaster) string {
+	return key(m.ChikuC)
+}
+
+// chikuMasterKeyset キーセットページネーションのキー（地区C ASC）
+var chikuMasterKeyset = keyset{{Field: "地区C"}}
+
+// Add データを登録
+func (r *ChikuMasterRepository) Add(items ...*ichibanboshi.ChikuMaster) {
+	r.table.put(items...)
+}
+
+// GetAll 全地区を取得
+func (r *ChikuMasterRepository) GetAll(_ context.Context, limit, offset int, sort []repository.SortField) ([]*ichibanboshi.ChikuMaster, int64, error) {
+	return getAll(r.table, limit, offset, sort, chikuMasterKeyset)
+}
+
+// GetPage 全地区を取得（キーセットページネーション）
+func (r *ChikuMasterRepository) GetPage(_ context.Context, page repository.PageRequest) (*repository.Page[ichibanboshi.ChikuMaster], error) {
+	return listByKeyset(r.table.find(nil), chikuMasterKey, chikuMasterKeyset, page)
+}
+
+// GetByChikuC 地区Cで地区を取得
+func (r *ChikuMasterRepository) GetByChikuC(_ context.Context, chikuC string) (*ichibanboshi.ChikuMaster, error) {
+	return getOrNotFound(r.table, key(chikuC))
+}
+
+// GetByChiikiC 地域Cで地区を取得（地区C順）
+func (r *ChikuMasterRepository) GetByChiikiC(_ context.Context, chiikiC string) ([]*ichibanboshi.ChikuMaster, error) {
+	chiku := r.table.find(func(m *ichibanboshi.ChikuMaster) bool { return m.ChiikiC == chiikiC })
+	sortItems(chiku, chikuMasterKey, chikuMasterKeyset)
+	return chiku, nil
+}
+
+// ---- TokuisakiMaster ----
+
+// TokuisakiMasterRepository 得意先マスタ・得意先適用日マスタのインメモリ実装
+type TokuisakiMasterRepository struct {
+	table     *table[ichibanboshi.TokuisakiMaster]
+	tekiyobis *table[ichibanboshi.TokuisakiTekiyobiMaster]
+}
+
+// NewTokuisakiMasterRepository TokuisakiMasterRepositoryのコンストラクタ
+func NewTokuisakiMasterRepository() *TokuisakiMasterRepository {
+	return &TokuisakiMasterRepository{
+		table:     newTable(tokuisakiMasterKey),
+		tekiyobis: newTable(tokuisakiTekiyobiMasterKey),
+	}
+}
+
+// tokuisakiMasterKey 複合主キー（得意先C, 得意先H）
+func tokuisakiMasterKey(m *ichibanboshi.TokuisakiMaster) string {
+	return key(m.TokuisakiC, m.TokuisakiH)
+}
+
+// tokuisakiTekiyobiMasterKey 複合主キー（得意先C, 得意先H, 適用日）
+func tokuisakiTekiyobiMasterKey(m *ichibanboshi.TokuisakiTekiyobiMaster) string {
+	return key(m.TokuisakiC, m.TokuisakiH, m.Tekiyobi)
+}
+
+// tokuisakiMasterKeyset キーセットページネーションのキー（得意先C, 得意先H ASC）
+var tokuisakiMasterKeyset = keyset{{Field: "得意先C"}, {Field: "得意先H"}}
+
+// tokuisakiTekiyobiMasterOrder 適用日マスタの並び順（適用日 ASC）
+var tokuisakiTekiyobiMasterOrder = []repository.SortField{{Field: "適用日"}}
+
+// Add データを登録
+func (r *TokuisakiMasterRepository) Add(items ...*ichibanboshi.TokuisakiMaster) {
+	r.table.put(items...)
+}
+
+// AddTekiyobi 得意先適用日マスタのデータを登録
+func (r *TokuisakiMasterRepository) AddTekiyobi(items ...*ichibanboshi.TokuisakiTekiyobiMaster) {
+	r.tekiyobis.put(items...)
+}
+
+// GetAll 全得意先を取得
+func (r *TokuisakiMasterRepository) GetAll(_ context.Context, limit, offset int, sort []repository.SortField) ([]*ichibanboshi.TokuisakiMaster, int64, error) {
+	return getAll(r.table, limit, offset, sort, tokuisakiMasterKeyset)
+}
+
+// GetPage 全得意先を取得（キーセットページネーション）
+func (r *TokuisakiMasterRepository) GetPage(_ context.Context, page repository.PageRequest) (*repository.Page[ichibanboshi.TokuisakiMaster], error) {
+	return listByKeyset(r.table.find(nil), tokuisakiMasterKey, tokuisakiMasterKeyset, page)
+}
+
+// GetByTokuisakiC 得意先C、得意先Hで得意先を取得
+func (r *TokuisakiMasterRepository) GetByTokuisakiC(_ context.Context, tokuisakiC, tokuisakiH string) (*ichibanboshi.TokuisakiMaster, error) {
+	return getOrNotFound(r.table, key(tokuisakiC, tokuisakiH))
+}
+
+// Search 得意先N・得意先R・得意先Fの部分一致で得意先を検索（得意先C, 得意先H順）
+func (r *TokuisakiMasterRepository) Search(_ context.Context, query string, limit int) ([]*ichibanboshi.TokuisakiMaster, error) {
+	contains := func(s *string) bool { return s != nil && strings.Contains(*s, query) }
+	tokuisaki := r.table.find(func(m *ichibanboshi.TokuisakiMaster) bool {
+		return contains(m.TokuisakiN) || contains(m.TokuisakiR) || contains(m.TokuisakiF)
+	})
+	sortItems(tokuisaki, tokuisakiMasterKey, tokuisakiMasterKeyset)
+	return limitOffset(tokuisaki, limit, 0), nil
+}
+
+// GetTekiyobiList 得意先の適用日マスタを適用日の古い順に取得
+func (r *TokuisakiMasterRepository) GetTekiyobiList(_ context.Context, tokuisakiC, tokuisakiH string) ([]*ichibanboshi.TokuisakiTekiyobiMaster, error) {
+	tekiyobi := r.tekiyobis.find(func(m *ichibanboshi.TokuisakiTekiyobiMaster) bool {
+		return m.TokuisakiC == tokuisakiC && m.TokuisakiH == tokuisakiH
+	})
+	sortItems(tekiyobi, tokuisakiTekiyobiMasterKey, tokuisakiTekiyobiMasterOrder)
+	return tekiyobi, nil
+}
+
+// GetTekiyobiAt 指定日時に有効な得意先の適用日マスタを取得（適用日がat以前で最も新しいもの）
+func (r *TokuisakiMasterRepository) GetTekiyobiAt(ctx context.Context, tokuisakiC, tokuisakiH string, at time.Time) (*ichibanboshi.TokuisakiTekiyobiMaster, error) {
+	tekiyobi, _ := r.GetTekiyobiList(ctx, tokuisakiC, tokuisakiH)
+	for i := len(tekiyobi) - 1; i >= 0; i-- {
+		if !tekiyobi[i].Tekiyobi.After(at) {
+			return tekiyobi[i], nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+// ---- HinmeiMaster ----
+
+// HinmeiMasterRepository 品名マスタ・得意先別品名マスタのインメモリ実装
+type HinmeiMasterRepository struct {
+	table      *table[ichibanboshi.HinmeiMaster]
+	tokuisakis *table[ichibanboshi.TokuisakiHinmeiMaster]
+}
+
+// NewHinmeiMasterRepository HinmeiMasterRepositoryのコンストラクタ
+func NewHinmeiMasterRepository() *HinmeiMasterRepository {
+	return &HinmeiMasterRepository{
+		table:      newTable(hinmeiMasterKey),
+		tokuisakis: newTable(tokuisakiHinmeiMasterKey),
+	}
+}
+
+// hinmeiMasterKey 複合主キー（品名C, 品名H）
+func hinmeiMasterKey(m *ichibanboshi.HinmeiMaster) string {
+	return key(m.HinmeiC, m.HinmeiH)
+}
+
+// tokuisakiHinmeiMasterKey 複合主キー（得意先C, 得意先H, 品名C, 品名H）
+func tokuisakiHinmeiMasterKey(m *ichibanboshi.TokuisakiHinmeiMaster) string {
+	return key(m.TokuisakiC, m.TokuisakiH, m.HinmeiC, m.HinmeiH)
+}
+
+// hinmeiMasterKeyset キーセットページネーションのキー（品名C, 品名H ASC）
+var hinmeiMasterKeyset = keyset{{Field: "品名C"}, {Field: "品名H"}}
+
+// Add データを登録
+func (r *HinmeiMasterRepository) Add(items ...*ichibanboshi.HinmeiMaster) {
+	r.table.put(items...)
+}
+
+// AddTokuisakiHinmei 得意先別品名マスタのデータを登録
+func (r *HinmeiMasterRepository) AddTokuisakiHinmei(items ...*ichibanboshi.TokuisakiHinmeiMaster) {
+	r.tokuisakis.put(items...)
+}
+
+// GetAll 全品名を取得
+func (r *HinmeiMasterRepository) GetAll(_ context.Context, limit, offset int, sort []repository.SortField) ([]*ichibanboshi.HinmeiMaster, int64, error) {
+	return getAll(r.table, limit, offset, sort, hinmeiMasterKeyset)
+}
+
+// GetPage 全品名を取得（キーセットページネーション）
+func (r *HinmeiMasterRepository) GetPage(_ context.Context, page repository.PageRequest) (*repository.Page[ichibanboshi.HinmeiMaster], error) {
+	return listByKeyset(r.table.find(nil), hinmeiMasterKey, hinmeiMasterKeyset, page)
+}
+
+// GetByHinmeiC 品名C、品名Hで品名を取得
+func (r *HinmeiMasterRepository) GetByHinmeiC(_ context.Context, hinmeiC, hinmeiH string) (*ichibanboshi.HinmeiMaster, error) {
+	return getOrNotFound(r.table, key(hinmeiC, hinmeiH))
+}
+
+// Search 品名N・品名R・品名Fの部分一致で品名を検索（品名C, 品名H順）
+func (r *HinmeiMasterRepository) Search(_ context.Context, query string, limit int) ([]*ichibanboshi.HinmeiMaster, error) {
+	contains := func(s *string) bool { return s != nil && strings.Contains(*s, query) }
+	hinmei := r.table.find(func(m *ichibanboshi.HinmeiMaster) bool {
+		return contains(m.HinmeiN) || contains(m.HinmeiR) || contains(m.HinmeiF)
+	})
+	sortItems(hinmei, hinmeiMasterKey, hinmeiMasterKeyset)
+	return limitOffset(hinmei, limit, 0), nil
+}
+
+// GetTokuisakiHinmeiList 得意先の得意先別品名マスタを品名C, 品名H順に取得
+func (r *HinmeiMasterRepository) GetTokuisakiHinmeiList(_ context.Context, tokuisakiC, tokuisakiH string) ([]*ichibanboshi.TokuisakiHinmeiMaster, error) {
+	hinmei := r.tokuisakis.find(func(m *ichibanboshi.TokuisakiHinmeiMaster) bool {
+		return m.TokuisakiC == tokuisakiC && m.TokuisakiH == tokuisakiH
+	})
+	sortItems(hinmei, tokuisakiHinmeiMasterKey, hinmeiMasterKeyset)
+	return hinmei, nil
+}
+
+// GetTokuisakiHinmei 得意先C、得意先H、品名C、品名Hで得意先別品名マスタを取得
+func (r *HinmeiMasterRepository) GetTokuisakiHinmei(_ context.Context, tokuisakiC, tokuisakiH, hinmeiC, hinmeiH string) (*ichibanboshi.TokuisakiHinmeiMaster, error) {
+	return getOrNotFound(r.tokuisakis, key(tokuisakiC, tokuisakiH, hinmeiC, hinmeiH))
+}
+
+// Resolve 得意先別品名マスタを反映した品名・単位・単位重量を取得
+func (r *HinmeiMasterRepository) Resolve(_ context.Context, tokuisakiC, tokuisakiH, hinmeiC, hinmeiH string) (*repository.ResolvedHinmei, error) {
+	hinmei, _ := r.table.get(key(hinmeiC, hinmeiH))
+	tokuisaki, _ := r.tokuisakis.get(key(tokuisakiC, tokuisakiH, hinmeiC, hinmeiH))
+	return repository.ResolveHinmei(hinmei, tokuisaki)
+}
+
+// ---- BumonMaster ----
+
+// BumonMasterRepository 部門マスタのインメモリ実装
+type BumonMasterRepository struct {
+	table *table[ichibanboshi.BumonMaster]
+}
+
+// NewBumonMasterRepository BumonMasterRepositoryのコンストラクタ
+func NewBumonMasterRepository() *BumonMasterRepository {
+	return &BumonMasterRepository{table: newTable(bumonMasterKey)}
+}
+
+// bumonMasterKey 主キー（部門C）
+func bumonMasterKey(m *ichibanboshi.BumonMaster) string {
+	return key(repository.NormalizeBumonC(m.BumonC))
+}
+
+// bumonMasterKeyset キーセットページネーションのキー（部門C ASC）
+var bumonMasterKeyset = keyset{{Field: "部門C"}}
+
+// Add データを登録
+func (r *BumonMasterRepository) Add(items ...*ichibanboshi.BumonMaster) {
+	r.table.put(items...)
+}
+
+// GetAll 全部門を取得
+func (r *BumonMasterRepository) GetAll(_ context.Context, limit, offset int, sort []repository.SortField) ([]*ichibanboshi.BumonMaster, int64, error) {
+	return getAll(r.table, limit, offset, sort, bumonMasterKeyset)
+}
+
+// GetPage 全部門を取得（キーセットページネーション）
+func (r *BumonMasterRepository) GetPage(_ context.Context, page repository.PageRequest) (*repository.Page[ichibanboshi.BumonMaster], error) {
+	return listByKeyset(r.table.find(nil), bumonMasterKey, bumonMasterKeyset, page)
+}
+
+// GetByBumonC 部門Cで部門を取得
+func (r *BumonMasterRepository) GetByBumonC(_ context.Context, bumonC string) (*ichibanboshi.BumonMaster, error) {
+	return getOrNotFound(r.table, key(repository.NormalizeBumonC(bumonC)))
+}
+
+// GetByBumonCs 複数の部門Cで部門を取得（部門C順、存在しないコードは含まない）
+func (r *BumonMasterRepository) GetByBumonCs(_ context.Context, bumonCs []string) ([]*ichibanboshi.BumonMaster, error) {
+	codes := make(map[string]bool, len(bumonCs))
+	for _, c := range bumonCs {
+		codes[repository.NormalizeBumonC(c)] = true
+	}
+	bumon := r.table.find(func(m *ichibanboshi.BumonMaster) bool { return codes[repository.NormalizeBumonC(m.BumonC)] })
+	sortItems(bumon, bumonMasterKey, bumonMasterKeyset)
+	return bumon, nil
+}
+
+// ---- SharyoMaster ----
+
+// SharyoMasterRepository 車輌マスタのインメモリ実装
+type SharyoMasterRepository struct {
+	table *table[ichibanboshi.SharyoMaster]
+}
+
+// NewSharyoMasterRepository SharyoMasterRepositoryのコンストラクタ
+func NewSharyoMasterRepository() *SharyoMasterRepository {
+	return &SharyoMasterRepository{table: newTable(sharyoMasterKey)}
+}
+
+// sharyoMasterKey 複合主キー（車輌C, 車輌H）
+func sharyoMasterKey(m *ichibanboshi.SharyoMaster) string {
+	return key(m.SharyoC, m.SharyoH)
+}
+
+// sharyoMasterKeyset キーセットページネーションのキー（車輌C, 車輌H ASC）
+var sharyoMasterKeyset = keyset{{Field: "車輌C"}, {Field: "車輌H"}}
+
+// Add データを登録
+func (r *SharyoMasterRepository) Add(items ...*ichibanboshi.SharyoMaster) {
+	r.table.put(items...)
+}
+
+// GetAll 全車輌を取得
+func (r *SharyoMasterRepository) GetAll(_ context.Context, limit, offset int, sort []repository.SortField) ([]*ichibanboshi.SharyoMaster, int64, error) {
+	return getAll(r.table, limit, offset, sort, sharyoMasterKeyset)
+}
+
+// GetPage 全車輌を取得（キーセットページネーション）
+func (r *SharyoMasterRepository) GetPage(_ context.Context, page repository.PageRequest) (*repository.Page[ichibanboshi.SharyoMaster], error) {
+	return listByKeyset(r.table.find(nil), sharyoMasterKey, sharyoMasterKeyset, page)
+}
+
+// GetBySharyoC 車輌C、車輌Hで車輌を取得
+func (r *SharyoMasterRepository) GetBySharyoC(_ context.Context, sharyoC, sharyoH string) (*ichibanboshi.SharyoMaster, error) {
+	return getOrNotFound(r.table, key(sharyoC, sharyoH))
+}
+
+// GetAllBySharyoC 車輌Cの車輌を車輌H順に取得
+func (r *SharyoMasterRepository) GetAllBySharyoC(_ context.Context, sharyoC string) ([]*ichibanboshi.SharyoMaster, error) {
+	sharyo := r.table.find(func(m *ichibanboshi.SharyoMaster) bool { return m.SharyoC == sharyoC })
+	sortItems(sharyo, sharyoMasterKey, sharyoMasterKeyset)
+	return sharyo, nil
+}
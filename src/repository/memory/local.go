@@ -0,0 +1,977 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/yhonda-ohishi/db_service/src/models/mysql"
+	"github.com/yhonda-ohishi/db_service/src/repository"
+	"gorm.io/gorm"
+)
+
+// ローカルDB用リポジトリのインターフェース実装の確認
+var (
+	_ repository.DTakoUriageKeihiRepository = (*DTakoUriageKeihiRepository)(nil)
+	_ repository.ETCMeisaiRepository        = (*ETCMeisaiRepository)(nil)
+	_ repository.DTakoFerryRowsRepository   = (*DTakoFerryRowsRepository)(nil)
+	_ repository.ETCMeisaiMappingRepository = (*ETCMeisaiMappingRepository)(nil)
+	_ repository.TimeCardDevRepository      = (*TimeCardDevRepository)(nil)
+	_ repository.TimeCardLogRepository      = (*TimeCardLogRepository)(nil)
+	_ repository.EmployeeIdentityRepository = (*EmployeeIdentityRepository)(nil)
+)
+
+// ---- DTakoUriageKeihi ----
+
+// DTakoUriageKeihiRepository 経費精算データのインメモリ実装
+type DTakoUriageKeihiRepository struct {
+	table *table[mysql.DTakoUriageKeihi]
+}
+
+// NewDTakoUriageKeihiRepository DTakoUriageKeihiRepositoryのコンストラクタ
+func NewDTakoUriageKeihiRepository() *DTakoUriageKeihiRepository {
+	return &DTakoUriageKeihiRepository{table: newTable(dtakoUriageKeihiKey)}
+}
+
+// dtakoUriageKeihiKey 複合主キー（srch_id, datetime, keihi_c）
+func dtakoUriageKeihiKey(m *mysql.DTakoUriageKeihi) string {
+	return key(m.SrchID, m.Datetime, m.KeihiC)
+}
+
+// dtakoUriageKeihiKeyset キーセットページネーションのキー（datetime, srch_id, keihi_c DESC）
+var dtakoUriageKeihiKeyset = keyset{{Field: "datetime", Desc: true}, {Field: "srch_id", Desc: true}, {Field: "keihi_c", Desc: true}}
+
+// Add データを登録（バリデーションなし、同じ主キーのデータは置き換える）
+func (r *DTakoUriageKeihiRepository) Add(items ...*mysql.DTakoUriageKeihi) {
+	r.table.put(items...)
+}
+
+// Create データ作成
+func (r *DTakoUriageKeihiRepository) Create(_ context.Context, data *mysql.DTakoUriageKeihi) error {
+	if err := data.Validate(); err != nil {
+		return fmt.Errorf("validation error: %w", err)
+	}
+	if !r.table.insert(data) {
+		return mysql.ErrDuplicateKey
+	}
+	return nil
+}
+
+// GetByCompositeKey 複合キーでデータ取得
+func (r *DTakoUriageKeihiRepository) GetByCompositeKey(_ context.Context, srchID string, datetime time.Time, keihiC int32) (*mysql.DTakoUriageKeihi, error) {
+	data, ok := r.table.get(key(srchID, datetime, keihiC))
+	if !ok {
+		return nil, mysql.ErrRecordNotFound
+	}
+	return data, nil
+}
+
+// Update データ更新（ゼロ値のフィールドは更新しない）
+func (r *DTakoUriageKeihiRepository) Update(_ context.Context, data *mysql.DTakoUriageKeihi) error {
+	if err := data.Validate(); err != nil {
+		return fmt.Errorf("validation error: %w", err)
+	}
+	if !r.table.update(data) {
+		return mysql.ErrRecordNotFound
+	}
+	return nil
+}
+
+// DeleteByCompositeKey 複合キーでデータ削除
+func (r *DTakoUriageKeihiRepository) DeleteByCompositeKey(_ context.Context, srchID string, datetime time.Time, keihiC int32) error {
+	if !r.table.delete(key(srchID, datetime, keihiC)) {
+		return mysql.ErrRecordNotFound
+	}
+	return nil
+}
+
+// filter 条件の適用
+func (r *DTakoUriageKeihiRepository) filter(params *repository.ListParams) []*mysql.DTakoUriageKeihi {
+	return r.table.find(func(m *mysql.DTakoUriageKeihi) bool {
+		if params.DtakoRowID != nil && *params.DtakoRowID != "" && m.DtakoRowID != *params.DtakoRowID {
+			return false
+		}
+		return inRange(m.Datetime, params.StartDate, params.EndDate)
+	})
+}
+
+// list 並び替え（datetime DESC）
+func (r *DTakoUriageKeihiRepository) list(items []*mysql.DTakoUriageKeihi) []*mysql.DTakoUriageKeihi {
+	sortItems(items, dtakoUriageKeihiKey, []repository.SortField{{Field: "datetime", Desc: true}})
+	return items
+}
+
+// List 条件付きリスト取得
+func (r *DTakoUriageKeihiRepository) List(_ context.Context, params *repository.ListParams) ([]*mysql.DTakoUriageKeihi, int64, error) {
+	items := r.list(r.filter(params))
+	return limitOffset(items, positiveLimit(params.Limit), params.Offset), int64(len(items)), nil
+}
+
+// ListPage 条件付きリスト取得（キーセットページネーション）
+func (r *DTakoUriageKeihiRepository) ListPage(_ context.Context, params *repository.ListParams, page repository.PageRequest) (*repository.Page[mysql.DTakoUriageKeihi], error) {
+	return listByKeyset(r.filter(params), dtakoUriageKeihiKey, dtakoUriageKeihiKeyset, page)
+}
+
+// ListBySrchID srch_idでリスト取得
+func (r *DTakoUriageKeihiRepository) ListBySrchID(_ context.Context, srchID string) ([]*mysql.DTakoUriageKeihi, error) {
+	return r.list(r.table.find(func(m *mysql.DTakoUriageKeihi) bool { return m.SrchID == srchID })), nil
+}
+
+// ListByDtakoRowID dtako_row_idでリスト取得
+func (r *DTakoUriageKeihiRepository) ListByDtakoRowID(_ context.Context, dtakoRowID string) ([]*mysql.DTakoUriageKeihi, error) {
+	return r.list(r.table.find(func(m *mysql.DTakoUriageKeihi) bool { return m.DtakoRowID == dtakoRowID })), nil
+}
+
+// ListByDateRange 日付範囲でリスト取得
+func (r *DTakoUriageKeihiRepository) ListByDateRange(_ context.Context, start, end time.Time) ([]*mysql.DTakoUriageKeihi, error) {
+	return r.list(r.table.find(func(m *mysql.DTakoUriageKeihi) bool { return inRange(m.Datetime, &start, &end) })), nil
+}
+
+// ---- ETCMeisai ----
+
+// ETCMeisaiRepository ETC明細データのインメモリ実装
+type ETCMeisaiRepository struct {
+	table *table[mysql.ETCMeisai]
+}
+
+// NewETCMeisaiRepository ETCMeisaiRepositoryのコンストラクタ
+func NewETCMeisaiRepository() *ETCMeisaiRepository {
+	return &ETCMeisaiRepository{table: newTable(etcMeisaiKey)}
+}
+
+// etcMeisaiKey 主キー（id）
+func etcMeisaiKey(m *mysql.ETCMeisai) string {
+	return key(m.ID)
+}
+
+// etcMeisaiKeyset キーセットページネーションのキー（date_to DESC, id DESC）
+var etcMeisaiKeyset = keyset{{Field: "date_to", Desc: true}, {Field: "id", Desc: true}}
+
+// Add データを登録（バリデーションなし、IDが0の場合は採番する）
+func (r *ETCMeisaiRepository) Add(items ...*mysql.ETCMeisai) {
+	for _, item := range items {
+		item.ID = r.table.nextID(item.ID)
+		r.table.put(item)
+	}
+}
+
+// Create データ作成（IDが0の場合は採番してdataに設定する）
+func (r *ETCMeisaiRepository) Create(_ context.Context, data *mysql.ETCMeisai) error {
+	if err := data.Validate(); err != nil {
+		return fmt.Errorf("validation error: %w", err)
+	}
+	if r.hashExists(data) {
+		return repository.ErrHashExists
+	}
+	data.ID = r.table.nextID(data.ID)
+	if !r.table.insert(data) {
+		return fmt.Errorf("failed to create record: %w", gorm.ErrDuplicatedKey)
+	}
+	return nil
+}
+
+// GetByID IDでデータ取得
+func (r *ETCMeisaiRepository) GetByID(_ context.Context, id int64) (*mysql.ETCMeisai, error) {
+	data, ok := r.table.get(key(id))
+	if !ok {
+		return nil, mysql.ErrRecordNotFound
+	}
+	return data, nil
+}
+
+// Update データ更新（ゼロ値のフィールドは更新しない）
+func (r *ETCMeisaiRepository) Update(_ context.Context, data *mysql.ETCMeisai) error {
+	if err := data.Validate(); err != nil {
+		return fmt.Errorf("validation error: %w", err)
+	}
+	if r.hashExists(data) {
+		return repository.ErrHashExists
+	}
+	if !r.table.update(data) {
+		return mysql.ErrRecordNotFound
+	}
+	return nil
+}
+
+// DeleteByID IDでデータ削除
+func (r *ETCMeisaiRepository) DeleteByID(_ context.Context, id int64) error {
+	if !r.table.delete(key(id)) {
+		return mysql.ErrRecordNotFound
+	}
+	return nil
+}
+
+// hashExists 他のETC明細に同じhashが登録済みか（etc_meisai.hashの一意制約）
+func (r *ETCMeisaiRepository) hashExists(data *mysql.ETCMeisai) bool {
+	return len(r.table.find(func(m *mysql.ETCMeisai) bool { return m.Hash == data.Hash && m.ID != data.ID })) > 0
+}
+
+// filter 条件の適用
+func (r *ETCMeisaiRepository) filter(params *repository.ETCMeisaiListParams) []*mysql.ETCMeisai {
+	return r.table.find(func(m *mysql.ETCMeisai) bool {
+		if params.Hash != nil && *params.Hash != "" && m.Hash != *params.Hash {
+			return false
+		}
+		return inRange(m.DateTo, params.StartDate, params.EndDate)
+	})
+}
+
+// list 並び替え（date_to DESC）
+func (r *ETCMeisaiRepository) list(items []*mysql.ETCMeisai) []*mysql.ETCMeisai {
+	sortItems(items, etcMeisaiKey, []repository.SortField{{Field: "date_to", Desc: true}})
+	return items
+}
+
+// List 条件付きリスト取得
+func (r *ETCMeisaiRepository) List(_ context.Context, params *repository.ETCMeisaiListParams) ([]*mysql.ETCMeisai, int64, error) {
+	items := r.list(r.filter(params))
+	return limitOffset(items, positiveLimit(params.Limit), params.Offset), int64(len(items)), nil
+}
+
+// ListPage 条件付きリスト取得（キーセットページネーション）
+func (r *ETCMeisaiRepository) ListPage(_ context.Context, params *repository.ETCMeisaiListParams, page repository.PageRequest) (*repository.Page[mysql.ETCMeisai], error) {
+	return listByKeyset(r.filter(params), etcMeisaiKey, etcMeisaiKeyset, page)
+}
+
+// Stream 条件に一致するETC明細を古い順に一定件数ずつ取得（Limit/Offsetは使用しない）
+func (r *ETCMeisaiRepository) Stream(ctx context.Context, params *repository.ETCMeisaiListParams, batchSize int, fn func([]*mysql.ETCMeisai) error) error {
+	return streamByKeyset(ctx, r.filter(params), etcMeisaiKey, etcMeisaiKeyset.ascending(), batchSize, fn)
+}
+
+// ListByHash hashでリスト取得
+func (r *ETCMeisaiRepository) ListByHash(_ context.Context, hash string) ([]*mysql.ETCMeisai, error) {
+	return r.list(r.table.find(func(m *mysql.ETCMeisai) bool { return m.Hash == hash })), nil
+}
+
+// ListByDateRange 日付範囲でリスト取得
+func (r *ETCMeisaiRepository) ListByDateRange(_ context.Context, start, end time.Time) ([]*mysql.ETCMeisai, error) {
+	return r.list(r.table.find(func(m *mysql.ETCMeisai) bool { return inRange(m.DateTo, &start, &end) })), nil
+}
+
+// BatchCreate ETC明細を一括作成
+// hashが登録済みの明細・data内で重複する明細・バリデーションエラーの明細は作成せず、結果で報告する
+func (r *ETCMeisaiRepository) BatchCreate(_ context.Context, data []*mysql.ETCMeisai) ([]repository.BatchResult, error) {
+	results := repository.PrepareETCMeisaiBatch(data)
+
+	idByHash := r.idsByHash(nil)
+
+	for i, item := range data {
+		switch {
+		case results[i].Status == repository.BatchDuplicate:
+			results[i].ID = idByHash[item.Hash]
+		case results[i].Status == repository.BatchInvalid:
+			continue
+		case idByHash[item.Hash] != 0:
+			results[i].Status = repository.BatchDuplicate
+			results[i].ID = idByHash[item.Hash]
+			results[i].Err = repository.ErrHashExists
+		default:
+			item.ID = r.table.nextID(item.ID)
+			r.table.put(item)
+			results[i].Status = repository.BatchInserted
+			results[i].ID = item.ID
+			idByHash[item.Hash] = item.ID
+		}
+	}
+	return results, nil
+}
+
+// FindIDsByHash hashが登録済みのETC明細のID（同じhashが複数ある場合は最小のID）
+func (r *ETCMeisaiRepository) FindIDsByHash(_ context.Context, hashes []string) (map[string]int64, error) {
+	wanted := make(map[string]bool, len(hashes))
+	for _, hash := range hashes {
+		wanted[hash] = true
+	}
+	return r.idsByHash(func(m *mysql.ETCMeisai) bool { return wanted[m.Hash] }), nil
+}
+
+// ListByHashes hashのいずれかに一致するETC明細を取得（idの昇順）
+func (r *ETCMeisaiRepository) ListByHashes(_ context.Context, hashes []string) ([]*mysql.ETCMeisai, error) {
+	wanted := make(map[string]bool, len(hashes))
+	for _, hash := range hashes {
+		wanted[hash] = true
+	}
+	data := r.table.find(func(m *mysql.ETCMeisai) bool { return wanted[m.Hash] })
+	sortItems(data, etcMeisaiKey, []repository.SortField{{Field: "id"}})
+	return data, nil
+}
+
+// idsByHash 条件に一致するETC明細のhashとIDの対応（同じhashが複数ある場合は最小のID）
+func (r *ETCMeisaiRepository) idsByHash(match func(*mysql.ETCMeisai) bool) map[string]int64 {
+	idByHash := make(map[string]int64)
+	for _, e := range r.table.find(match) {
+		if id, ok := idByHash[e.Hash]; !ok || e.ID < id {
+			idByHash[e.Hash] = e.ID
+		}
+	}
+	return idByHash
+}
+
+// ---- DTakoFerryRows ----
+
+// DTakoFerryRowsRepository フェリー運行データのインメモリ実装
+type DTakoFerryRowsRepository struct {
+	table *table[mysql.DTakoFerryRows]
+}
+
+// NewDTakoFerryRowsRepository DTakoFerryRowsRepositoryのコンストラクタ
+func NewDTakoFerryRowsRepository() *DTakoFerryRowsRepository {
+	return &DTakoFerryRowsRepository{table: newTable(dtakoFerryRowsKey)}
+}
+
+// dtakoFerryRowsKey 主キー（id）
+func dtakoFerryRowsKey(m *mysql.DTakoFerryRows) string {
+	return key(m.ID)
+}
+
+// dtakoFerryRowsKeyset キーセットページネーションのキー（運行日 DESC, id DESC）
+// 本番DB用リポジトリと共通
+var dtakoFerryRowsKeyset = keyset{{Field: "運行日", Desc: true}, {Field: "id", Desc: true}}
+
+// Add データを登録（バリデーションなし、IDが0の場合は採番する）
+func (r *DTakoFerryRowsRepository) Add(items ...*mysql.DTakoFerryRows) {
+	for _, item := range items {
+		item.ID = int32(r.table.nextID(int64(item.ID)))
+		r.table.put(item)
+	}
+}
+
+// Create データ作成（IDが0の場合は採番してdataに設定する）
+func (r *DTakoFerryRowsRepository) Create(_ context.Context, data *mysql.DTakoFerryRows) error {
+	if err := data.Validate(); err != nil {
+		return fmt.Errorf("validation error: %w", err)
+	}
+	data.ID = int32(r.table.nextID(int64(data.ID)))
+	if !r.table.insert(data) {
+		return fmt.Errorf("failed to create record: %w", gorm.ErrDuplicatedKey)
+	}
+	return nil
+}
+
+// GetByID IDでデータ取得
+func (r *DTakoFerryRowsRepository) GetByID(_ context.Context, id int32) (*mysql.DTakoFerryRows, error) {
+	data, ok := r.table.get(key(id))
+	if !ok {
+		return nil, mysql.ErrRecordNotFound
+	}
+	return data, nil
+}
+
+// Update データ更新（ゼロ値のフィールドは更新しない）
+func (r *DTakoFerryRowsRepository) Update(_ context.Context, data *mysql.DTakoFerryRows) error {
+	if err := data.Validate(); err != nil {
+		return fmt.Errorf("validation error: %w", err)
+	}
+	if !r.table.update(data) {
+		return mysql.ErrRecordNotFound
+	}
+	return nil
+}
+
+// DeleteByID IDでデータ削除
+func (r *DTakoFerryRowsRepository) DeleteByID(_ context.Context, id int32) error {
+	if !r.table.delete(key(id)) {
+		return mysql.ErrRecordNotFound
+	}
+	return nil
+}
+
+// filter 条件の適用
+func (r *DTakoFerryRowsRepository) filter(params *repository.DTakoFerryRowsListParams) []*mysql.DTakoFerryRows {
+	return r.table.find(func(m *mysql.DTakoFerryRows) bool {
+		if params.UnkoNo != nil && *params.UnkoNo != "" && m.UnkoNo != *params.UnkoNo {
+			return false
+		}
+		return inRange(m.UnkoDate, params.StartDate, params.EndDate)
+	})
+}
+
+// list 並び替え（運行日 DESC）
+func (r *DTakoFerryRowsRepository) list(items []*mysql.DTakoFerryRows) []*mysql.DTakoFerryRows {
+	sortItems(items, dtakoFerryRowsKey, []repository.SortField{{Field: "運行日", Desc: true}})
+	return items
+}
+
+// List 条件付きリスト取得
+func (r *DTakoFerryRowsRepository) List(_ context.Context, params *repository.DTakoFerryRowsListParams) ([]*mysql.DTakoFerryRows, int64, error) {
+	items := r.list(r.filter(params))
+	return limitOffset(items, positiveLimit(params.Limit), params.Offset), int64(len(items)), nil
+}
+
+// ListPage 条件付きリスト取得（キーセットページネーション）
+func (r *DTakoFerryRowsRepository) ListPage(_ context.Context, params *repository.DTakoFerryRowsListParams, page repository.PageRequest) (*repository.Page[mysql.DTakoFerryRows], error) {
+	return listByKeyset(r.filter(params), dtakoFerryRowsKey, dtakoFerryRowsKeyset, page)
+}
+
+// ListByUnkoNo 運行NOでリスト取得
+func (r *DTakoFerryRowsRepository) ListByUnkoNo(_ context.Context, unkoNo string) ([]*mysql.DTakoFerryRows, error) {
+	return r.list(r.table.find(func(m *mysql.DTakoFerryRows) bool { return m.UnkoNo == unkoNo })), nil
+}
+
+// ListByDateRange 日付範囲でリスト取得
+func (r *DTakoFerryRowsRepository) ListByDateRange(_ context.Context, start, end time.Time) ([]*mysql.DTakoFerryRows, error) {
+	return r.list(r.table.find(func(m *mysql.DTakoFerryRows) bool { return inRange(m.UnkoDate, &start, &end) })), nil
+}
+
+// ---- ETCMeisaiMapping ----
+
+// ETCMeisaiMappingRepository ETC明細とDTakoRowsの関連付けのインメモリ実装
+type ETCMeisaiMappingRepository struct {
+	table *table[mysql.ETCMeisaiMapping]
+	// mu etc_meisai_hash・dtako_row_idの一意チェックと書き込みを直列化する
+	mu sync.Mutex
+}
+
+// NewETCMeisaiMappingRepository ETCMeisaiMappingRepositoryのコンストラクタ
+func NewETCMeisaiMappingRepository() *ETCMeisaiMappingRepository {
+	return &ETCMeisaiMappingRepository{table: newTable(etcMeisaiMappingKey)}
+}
+
+// etcMeisaiMappingKey 主キー（id）
+func etcMeisaiMappingKey(m *mysql.ETCMeisaiMapping) string {
+	return key(m.ID)
+}
+
+// etcMeisaiMappingKeyset キーセットページネーションのキー（created_at DESC, id DESC）
+var etcMeisaiMappingKeyset = keyset{{Field: "created_at", Desc: true}, {Field: "id", Desc: true}}
+
+// Add データを登録（バリデーションなし、IDが0の場合は採番する）
+func (r *ETCMeisaiMappingRepository) Add(items ...*mysql.ETCMeisaiMapping) {
+	for _, item := range items {
+		item.ID = r.table.nextID(item.ID)
+		r.table.put(item)
+	}
+}
+
+// Create マッピング作成（IDが0の場合は採番してdataに設定する）
+// 同じetc_meisai_hash・dtako_row_idのマッピングがある場合はrepository.ErrMappingExists
+func (r *ETCMeisaiMappingRepository) Create(_ context.Context, data *mysql.ETCMeisaiMapping) error {
+	if err := data.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.create(data)
+}
+
+// create マッピング作成（r.muを保持して呼び出す）
+func (r *ETCMeisaiMappingRepository) create(data *mysql.ETCMeisaiMapping) error {
+	if r.findByPair(data.ETCMeisaiHash, data.DTakoRowID, data.ID) != nil {
+		return fmt.Errorf("failed to create mapping: %w", repository.ErrMappingExists)
+	}
+	data.ID = r.table.nextID(data.ID)
+	if !r.table.insert(data) {
+		return fmt.Errorf("failed to create mapping: %w", gorm.ErrDuplicatedKey)
+	}
+	return nil
+}
+
+// findByPair etc_meisai_hash・dtako_row_idのマッピング（IDがexcludeIDのものを除く、ない場合はnil）
+func (r *ETCMeisaiMappingRepository) findByPair(hash, dtakoRowID string, excludeID int64) *mysql.ETCMeisaiMapping {
+	items := r.table.find(func(m *mysql.ETCMeisaiMapping) bool {
+		return m.ETCMeisaiHash == hash && m.DTakoRowID == dtakoRowID && (excludeID == 0 || m.ID != excludeID)
+	})
+	if len(items) == 0 {
+		return nil
+	}
+	sortItems(items, etcMeisaiMappingKey, []repository.SortField{{Field: "id"}})
+	return items[0]
+}
+
+// GetByID ID指定でマッピング取得
+func (r *ETCMeisaiMappingRepository) GetByID(_ context.Context, id int64) (*mysql.ETCMeisaiMapping, error) {
+	data, ok := r.table.get(key(id))
+	if !ok {
+		return nil, fmt.Errorf("mapping not found: %w", gorm.ErrRecordNotFound)
+	}
+	return data, nil
+}
+
+// Update マッピング更新（GORMのSaveと同様、存在しない場合は作成する）
+func (r *ETCMeisaiMappingRepository) Update(_ context.Context, data *mysql.ETCMeisaiMapping) error {
+	if err := data.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.findByPair(data.ETCMeisaiHash, data.DTakoRowID, data.ID) != nil {
+		return fmt.Errorf("failed to update mapping: %w", repository.ErrMappingExists)
+	}
+	data.ID = r.table.nextID(data.ID)
+	r.table.put(data)
+	return nil
+}
+
+// DeleteByID ID指定でマッピング削除
+func (r *ETCMeisaiMappingRepository) DeleteByID(_ context.Context, id int64) error {
+	if !r.table.delete(key(id)) {
+		return fmt.Errorf("mapping not found: %w", gorm.ErrRecordNotFound)
+	}
+	return nil
+}
+
+// filter 条件の適用
+func (r *ETCMeisaiMappingRepository) filter(params *repository.ETCMeisaiMappingListParams) []*mysql.ETCMeisaiMapping {
+	return r.table.find(func(m *mysql.ETCMeisaiMapping) bool {
+		if params.ETCMeisaiHash != nil && *params.ETCMeisaiHash != "" && m.ETCMeisaiHash != *params.ETCMeisaiHash {
+			return false
+		}
+		return params.DTakoRowID == nil || *params.DTakoRowID == "" || m.DTakoRowID == *params.DTakoRowID
+	})
+}
+
+// List マッピング一覧取得
+func (r *ETCMeisaiMappingRepository) List(_ context.Context, params *repository.ETCMeisaiMappingListParams) ([]*mysql.ETCMeisaiMapping, int64, error) {
+	items := r.filter(params)
+	sortItems(items, etcMeisaiMappingKey, []repository.SortField{{Field: "created_at", Desc: true}})
+	return limitOffset(items, params.Limit, params.Offset), int64(len(items)), nil
+}
+
+// ListPage マッピング一覧取得（キーセットページネーション）
+func (r *ETCMeisaiMappingRepository) ListPage(_ context.Context, params *repository.ETCMeisaiMappingListParams, page repository.PageRequest) (*repository.Page[mysql.ETCMeisaiMapping], error) {
+	return listByKeyset(r.filter(params), etcMeisaiMappingKey, etcMeisaiMappingKeyset, page)
+}
+
+// GetDTakoRowIDsByHash ハッシュからDTakoRowIDのリストを取得（id順）
+func (r *ETCMeisaiMappingRepository) GetDTakoRowIDsByHash(_ context.Context, hash string) ([]string, error) {
+	mappings := r.table.find(func(m *mysql.ETCMeisaiMapping) bool { return m.ETCMeisaiHash == hash })
+	sortItems(mappings, etcMeisaiMappingKey, []repository.SortField{{Field: "id"}})
+
+	dtakoRowIDs := make([]string, len(mappings))
+	for i, mapping := range mappings {
+		dtakoRowIDs[i] = mapping.DTakoRowID
+	}
+	return dtakoRowIDs, nil
+}
+
+// Upsert 同じetc_meisai_hash・dtako_row_idのマッピングがない場合は作成し、ある場合はnotes（指定時）を更新する
+// dataには作成・更新後のマッピングを設定し、作成した場合はtrueを返す
+func (r *ETCMeisaiMappingRepository) Upsert(_ context.Context, data *mysql.ETCMeisaiMapping) (bool, error) {
+	if err := data.Validate(); err != nil {
+		return false, fmt.Errorf("validation failed: %w", err)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing := r.findByPair(data.ETCMeisaiHash, data.DTakoRowID, 0)
+	if existing == nil {
+		if err := r.create(data); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+	if data.Notes != nil {
+		existing.Notes = data.Notes
+	}
+	existing.BeforeUpdate()
+	r.table.put(existing)
+	*data = *existing
+	return false, nil
+}
+
+// BulkReplace dtako_row_idのマッピングをdataに置き換える
+// dataのdtako_row_idは空またはdtakoRowIDであること（空の場合はdtakoRowIDを設定する）。
+// 作成したマッピングのIDはdataに設定し、削除した件数を返す
+func (r *ETCMeisaiMappingRepository) BulkReplace(_ context.Context, dtakoRowID string, data []*mysql.ETCMeisaiMapping) (int64, error) {
+	if err := repository.PrepareETCMeisaiMappingBulkReplace(dtakoRowID, data); err != nil {
+		return 0, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var deleted int64
+	for _, m := range r.table.find(func(m *mysql.ETCMeisaiMapping) bool { return m.DTakoRowID == dtakoRowID }) {
+		if r.table.delete(etcMeisaiMappingKey(m)) {
+			deleted++
+		}
+	}
+	for _, item := range data {
+		item.ID = r.table.nextID(item.ID)
+		r.table.put(item)
+	}
+	return deleted, nil
+}
+
+// ---- TimeCard (ローカルDB) ----
+
+// TimeCardDevRepository タイムカード（ローカルDB）のインメモリ実装
+type TimeCardDevRepository struct {
+	table *table[mysql.TimeCard]
+}
+
+// NewTimeCardDevRepository TimeCardDevRepositoryのコンストラクタ
+func NewTimeCardDevRepository() *TimeCardDevRepository {
+	return &TimeCardDevRepository{table: newTable(timeCardKey)}
+}
+
+// Add データを登録（同じ主キーのデータは置き換える）
+func (r *TimeCardDevRepository) Add(items ...*mysql.TimeCard) {
+	r.table.put(items...)
+}
+
+// Create タイムカードデータ作成
+func (r *TimeCardDevRepository) Create(_ context.Context, timeCard *mysql.TimeCard) error {
+	if !r.table.insert(timeCard) {
+		return gorm.ErrDuplicatedKey
+	}
+	return nil
+}
+
+// Update タイムカードデータ更新（GORMのSaveと同様、存在しない場合は作成する）
+func (r *TimeCardDevRepository) Update(_ context.Context, timeCard *mysql.TimeCard) error {
+	r.table.put(timeCard)
+	return nil
+}
+
+// GetByCompositeKey 複合主キー（datetime + id）でタイムカードデータを取得
+func (r *TimeCardDevRepository) GetByCompositeKey(_ context.Context, datetime time.Time, id int) (*mysql.TimeCard, error) {
+	return getOrNotFound(r.table, key(datetime, id))
+}
+
+// GetAll 全タイムカードデータを取得
+func (r *TimeCardDevRepository) GetAll(_ context.Context, limit, offset int, sort []repository.SortField) ([]*mysql.TimeCard, int64, error) {
+	return getAll(r.table, limit, offset, sort, []repository.SortField{{Field: "datetime", Desc: true}})
+}
+
+// GetPage 全タイムカードデータを取得（キーセットページネーション）
+func (r *TimeCardDevRepository) GetPage(_ context.Context, page repository.PageRequest) (*repository.Page[mysql.TimeCard], error) {
+	return listByKeyset(r.table.find(nil), timeCardKey, timeCardKeyset, page)
+}
+
+// Delete タイムカードデータ削除（存在しない場合もエラーにしない）
+func (r *TimeCardDevRepository) Delete(_ context.Context, datetime time.Time, id int) error {
+	r.table.delete(key(datetime, id))
+	return nil
+}
+
+// ---- TimeCardLog ----
+
+// TimeCardLogRepository タイムカードログのインメモリ実装
+type TimeCardLogRepository struct {
+	table *table[mysql.TimeCardLog]
+}
+
+// NewTimeCardLogRepository TimeCardLogRepositoryのコンストラクタ
+func NewTimeCardLogRepository() *TimeCardLogRepository {
+	return &TimeCardLogRepository{table: newTable(timeCardLogKey)}
+}
+
+// timeCardLogKey 複合主キー（datetime, id）
+func timeCardLogKey(m *mysql.TimeCardLog) string {
+	return key(m.Datetime, m.ID)
+}
+
+// timeCardLogKeyset キーセットページネーションのキー（datetime DESC, id DESC）
+var timeCardLogKeyset = keyset{{Field: "datetime", Desc: true}, {Field: "id", Desc: true}}
+
+// Add データを登録（同じ主キーのデータは置き換える）
+func (r *TimeCardLogRepository) Add(items ...*mysql.TimeCardLog) {
+	r.table.put(items...)
+}
+
+// Create タイムカードログ作成
+func (r *TimeCardLogRepository) Create(_ context.Context, log *mysql.TimeCardLog) error {
+	if !r.table.insert(log) {
+		return gorm.ErrDuplicatedKey
+	}
+	return nil
+}
+
+// Update タイムカードログ更新（GORMのSaveと同様、存在しない場合は作成する）
+func (r *TimeCardLogRepository) Update(_ context.Context, log *mysql.TimeCardLog) error {
+	r.table.put(log)
+	return nil
+}
+
+// GetByCompositeKey 複合主キー（datetime + id）でタイムカードログを取得
+func (r *TimeCardLogRepository) GetByCompositeKey(_ context.Context, datetime string, id int) (*mysql.TimeCardLog, error) {
+	return getOrNotFound(r.table, key(datetime, id))
+}
+
+// GetAll 全タイムカードログを取得
+func (r *TimeCardLogRepository) GetAll(_ context.Context, limit, offset int, sort []repository.SortField) ([]*mysql.TimeCardLog, int64, error) {
+	return getAll(r.table, limit, offset, sort, []repository.SortField{{Field: "datetime", Desc: true}})
+}
+
+// GetPage 全タイムカードログを取得（キーセットページネーション）
+func (r *TimeCardLogRepository) GetPage(_ context.Context, page repository.PageRequest) (*repository.Page[mysql.TimeCardLog], error) {
+	return listByKeyset(r.table.find(nil), timeCardLogKey, timeCardLogKeyset, page)
+}
+
+// GetByCardID カードIDでタイムカードログを取得
+func (r *TimeCardLogRepository) GetByCardID(_ context.Context, cardID string, limit, offset int) ([]*mysql.TimeCardLog, int64, error) {
+	logs := r.table.find(func(m *mysql.TimeCardLog) bool { return m.CardID == cardID })
+	sortItems(logs, timeCardLogKey, []repository.SortField{{Field: "datetime", Desc: true}})
+	return limitOffset(logs, limit, offset), int64(len(logs)), nil
+}
+
+// GetUserIDs タイムカードログのユーザーID（重複なし、ゲストの0を除く昇順）
+func (r *TimeCardLogRepository) GetUserIDs(_ context.Context) ([]int, error) {
+	var ids []int
+	for _, m := range r.table.find(nil) {
+		ids = append(ids, m.ID)
+	}
+	return distinctCodes(ids, 0), nil
+}
+
+// GetCardIDs タイムカードログのカードID（重複なし、空を除く昇順）
+func (r *TimeCardLogRepository) GetCardIDs(_ context.Context) ([]string, error) {
+	var cardIDs []string
+	for _, m := range r.table.find(nil) {
+		cardIDs = append(cardIDs, m.CardID)
+	}
+	return distinctCodes(cardIDs, ""), nil
+}
+
+// Delete タイムカードログ削除（存在しない場合もエラーにしない）
+func (r *TimeCardLogRepository) Delete(_ context.Context, datetime string, id int) error {
+	r.table.delete(key(datetime, id))
+	return nil
+}
+
+// ---- EmployeeIdentity ----
+
+// EmployeeIdentityRepository 社員の識別子の対応表のインメモリ実装
+type EmployeeIdentityRepository struct {
+	table *table[mysql.EmployeeIdentity]
+	cards *table[mysql.EmployeeCard]
+	// mu 社員コード・カードの一意チェックと書き込みを直列化する
+	mu sync.Mutex
+}
+
+// NewEmployeeIdentityRepository EmployeeIdentityRepositoryのコンストラクタ
+func NewEmployeeIdentityRepository() *EmployeeIdentityRepository {
+	return &EmployeeIdentityRepository{
+		table: newTable(employeeIdentityKey),
+		cards: newTable(employeeCardKey),
+	}
+}
+
+// employeeIdentityKey 主キー（id）
+func employeeIdentityKey(m *mysql.EmployeeIdentity) string {
+	return key(m.ID)
+}
+
+// employeeCardKey 主キー（card_id）
+func employeeCardKey(m *mysql.EmployeeCard) string {
+	return key(m.CardID)
+}
+
+// employeeIdentityKeyset キーセットページネーションのキー（id）
+var employeeIdentityKeyset = keyset{{Field: "id"}}
+
+// Add データを登録（バリデーションなし、IDが0の場合は採番する）
+func (r *EmployeeIdentityRepository) Add(items ...*mysql.EmployeeIdentity) {
+	for _, item := range items {
+		item.ID = r.table.nextID(item.ID)
+		r.table.put(item)
+	}
+}
+
+// AddCards カードを登録（バリデーションなし）
+func (r *EmployeeIdentityRepository) AddCards(cards ...*mysql.EmployeeCard) {
+	r.cards.put(cards...)
+}
+
+// Create 社員作成（IDが0の場合は採番してdataに設定する、社員コードが別の社員に登録済みの場合はrepository.ErrEmployeeCodeLinked）
+func (r *EmployeeIdentityRepository) Create(_ context.Context, data *mysql.EmployeeIdentity) error {
+	data.Normalize()
+	if err := data.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.checkCodes(data); err != nil {
+		return fmt.Errorf("failed to create employee: %w", err)
+	}
+	data.ID = r.table.nextID(data.ID)
+	if !r.table.insert(data) {
+		return fmt.Errorf("failed to create employee: %w", gorm.ErrDuplicatedKey)
+	}
+	return nil
+}
+
+// checkCodes 社員コードが別の社員に登録されていないか確認（r.muを保持して呼び出す）
+func (r *EmployeeIdentityRepository) checkCodes(data *mysql.EmployeeIdentity) error {
+	for _, m := range r.table.find(func(m *mysql.EmployeeIdentity) bool { return m.ID != data.ID }) {
+		switch {
+		case data.DriverID != nil && m.DriverID != nil && *data.DriverID == *m.DriverID:
+			return fmt.Errorf("%w: driver_id", repository.ErrEmployeeCodeLinked)
+		case data.ShainC != nil && m.ShainC != nil && *data.ShainC == *m.ShainC:
+			return fmt.Errorf("%w: shain_c", repository.ErrEmployeeCodeLinked)
+		case data.DTakoDriverCode != nil && m.DTakoDriverCode != nil && *data.DTakoDriverCode == *m.DTakoDriverCode:
+			return fmt.Errorf("%w: dtako_driver_code", repository.ErrEmployeeCodeLinked)
+		case data.TimeCardUserID != nil && m.TimeCardUserID != nil && *data.TimeCardUserID == *m.TimeCardUserID:
+			return fmt.Errorf("%w: time_card_user_id", repository.ErrEmployeeCodeLinked)
+		}
+	}
+	return nil
+}
+
+// GetByID ID指定で社員取得
+func (r *EmployeeIdentityRepository) GetByID(_ context.Context, id int64) (*mysql.EmployeeIdentity, error) {
+	data, ok := r.table.get(key(id))
+	if !ok {
+		return nil, fmt.Errorf("employee not found: %w", gorm.ErrRecordNotFound)
+	}
+	return data, nil
+}
+
+// Update 社員更新（存在しない場合はgorm.ErrRecordNotFound）
+func (r *EmployeeIdentityRepository) Update(_ context.Context, data *mysql.EmployeeIdentity) error {
+	data.Normalize()
+	if err := data.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	existing, ok := r.table.get(key(data.ID))
+	if !ok {
+		return fmt.Errorf("failed to update employee: %w", gorm.ErrRecordNotFound)
+	}
+	if err := r.checkCodes(data); err != nil {
+		return fmt.Errorf("failed to update employee: %w", err)
+	}
+	data.CreatedAt = existing.CreatedAt
+	r.table.put(data)
+	return nil
+}
+
+// DeleteByID ID指定で社員と登録されているカードを削除
+func (r *EmployeeIdentityRepository) DeleteByID(_ context.Context, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.table.delete(key(id)) {
+		return fmt.Errorf("employee not found: %w", gorm.ErrRecordNotFound)
+	}
+	for _, card := range r.cards.find(func(m *mysql.EmployeeCard) bool { return m.EmployeeID == id }) {
+		r.cards.delete(employeeCardKey(card))
+	}
+	return nil
+}
+
+// GetAll 社員一覧取得（id順）
+func (r *EmployeeIdentityRepository) GetAll(_ context.Context, limit, offset int) ([]*mysql.EmployeeIdentity, int64, error) {
+	items := r.table.find(nil)
+	sortItems(items, employeeIdentityKey, []repository.SortField{{Field: "id"}})
+	return limitOffset(items, limit, offset), int64(len(items)), nil
+}
+
+// GetPage 社員一覧取得（キーセットページネーション）
+func (r *EmployeeIdentityRepository) GetPage(_ context.Context, page repository.PageRequest) (*repository.Page[mysql.EmployeeIdentity], error) {
+	return listByKeyset(r.table.find(nil), employeeIdentityKey, employeeIdentityKeyset, page)
+}
+
+// GetByDriverID drivers.idの社員を取得
+func (r *EmployeeIdentityRepository) GetByDriverID(_ context.Context, driverID int) (*mysql.EmployeeIdentity, error) {
+	return r.getBy(func(m *mysql.EmployeeIdentity) bool { return m.DriverID != nil && *m.DriverID == driverID })
+}
+
+// GetByShainC 社員C（運転手C）の社員を取得（前後の空白は除いて比較）
+func (r *EmployeeIdentityRepository) GetByShainC(_ context.Context, shainC string) (*mysql.EmployeeIdentity, error) {
+	shainC = strings.TrimSpace(shainC)
+	return r.getBy(func(m *mysql.EmployeeIdentity) bool { return m.ShainC != nil && *m.ShainC == shainC })
+}
+
+// GetByDTakoDriverCode デジタコの乗務員コードの社員を取得
+func (r *EmployeeIdentityRepository) GetByDTakoDriverCode(_ context.Context, code int) (*mysql.EmployeeIdentity, error) {
+	return r.getBy(func(m *mysql.EmployeeIdentity) bool { return m.DTakoDriverCode != nil && *m.DTakoDriverCode == code })
+}
+
+// GetByTimeCardUserID タイムカードのユーザーIDの社員を取得
+func (r *EmployeeIdentityRepository) GetByTimeCardUserID(_ context.Context, userID int) (*mysql.EmployeeIdentity, error) {
+	return r.getBy(func(m *mysql.EmployeeIdentity) bool { return m.TimeCardUserID != nil && *m.TimeCardUserID == userID })
+}
+
+// GetByCardID カードIDが登録されている社員を取得
+func (r *EmployeeIdentityRepository) GetByCardID(ctx context.Context, cardID string) (*mysql.EmployeeIdentity, error) {
+	card, ok := r.cards.get(key(cardID))
+	if !ok {
+		return nil, fmt.Errorf("card not found: %w", gorm.ErrRecordNotFound)
+	}
+	return r.GetByID(ctx, card.EmployeeID)
+}
+
+// getBy 条件に一致する社員を取得（id順で最初のもの）
+func (r *EmployeeIdentityRepository) getBy(match func(*mysql.EmployeeIdentity) bool) (*mysql.EmployeeIdentity, error) {
+	items := r.table.find(match)
+	if len(items) == 0 {
+		return nil, fmt.Errorf("employee not found: %w", gorm.ErrRecordNotFound)
+	}
+	sortItems(items, employeeIdentityKey, []repository.SortField{{Field: "id"}})
+	return items[0], nil
+}
+
+// GetCards 社員に登録されているカードを取得（登録日時順）
+func (r *EmployeeIdentityRepository) GetCards(_ context.Context, employeeID int64) ([]*mysql.EmployeeCard, error) {
+	cards := r.cards.find(func(m *mysql.EmployeeCard) bool { return m.EmployeeID == employeeID })
+	sortItems(cards, employeeCardKey, []repository.SortField{{Field: "created_at"}, {Field: "card_id"}})
+	return cards, nil
+}
+
+// GetAllCards 登録されている全カードを取得（カードID順）
+func (r *EmployeeIdentityRepository) GetAllCards(_ context.Context) ([]*mysql.EmployeeCard, error) {
+	cards := r.cards.find(nil)
+	sortItems(cards, employeeCardKey, []repository.SortField{{Field: "card_id"}})
+	return cards, nil
+}
+
+// RegisterCard 社員にカードを登録（登録した場合はtrue）
+// 同じ社員に登録済みの場合は登録済みのカードをcardに設定してfalse、別の社員に登録済みの場合はrepository.ErrEmployeeCardRegistered
+func (r *EmployeeIdentityRepository) RegisterCard(_ context.Context, card *mysql.EmployeeCard) (bool, error) {
+	if err := card.Validate(); err != nil {
+		return false, fmt.Errorf("validation failed: %w", err)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.table.get(key(card.EmployeeID)); !ok {
+		return false, fmt.Errorf("employee not found: %w", gorm.ErrRecordNotFound)
+	}
+	if existing, ok := r.cards.get(employeeCardKey(card)); ok {
+		if existing.EmployeeID != card.EmployeeID {
+			return false, fmt.Errorf("failed to register card: %w", repository.ErrEmployeeCardRegistered)
+		}
+		*card = *existing
+		return false, nil
+	}
+	r.cards.put(card)
+	return true, nil
+}
+
+// UnregisterCard カードの登録を削除
+func (r *EmployeeIdentityRepository) UnregisterCard(_ context.Context, cardID string) error {
+	if !r.cards.delete(key(cardID)) {
+		return fmt.Errorf("card not found: %w", gorm.ErrRecordNotFound)
+	}
+	return nil
+}
+
+// ---- 共通 ----
+
+// positiveLimit Limitが正の場合のみ件数を制限する（0以下は制限なし）
+func positiveLimit(limit int) int {
+	if limit > 0 {
+		return limit
+	}
+	return -1
+}
+
+// getOrNotFound 主キーでレコードを取得（存在しない場合はgorm.ErrRecordNotFound）
+func getOrNotFound[T any](t *table[T], key string) (*T, error) {
+	item, ok := t.get(key)
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return item, nil
+}
+
+// getAll 全件をソートしてLIMIT/OFFSETを適用（GetAllの共通実装）
+func getAll[T any](t *table[T], limit, offset int, sort, defaultOrder []repository.SortField) ([]*T, int64, error) {
+	items := t.find(nil)
+	if err := sortBy(items, t.key, sort, defaultOrder); err != nil {
+		return nil, 0, err
+	}
+	return limitOffset(items, limit, offset), int64(len(items)), nil
+}
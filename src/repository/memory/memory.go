@@ -0,0 +1,186 @@
+// Package memory repositoryパッケージの各リポジトリインターフェースのインメモリ実装
+//
+// DBに接続せずにサービスを動かすためのもので、ユニットテストやデモで使用する。
+// 並び順（デフォルト・ソート指定・キーセットページネーション）、レコードが存在しない場合のエラー、
+// 主キー重複時のエラーは対応するDB実装と同じになるようにしている。
+//
+//	repo := memory.NewDTakoEventsRepository()
+//	repo.Add(&mysql.DTakoEvents{ID: 1, OperationNo: "OP001", StartDatetime: time.Now()})
+//	svc := service.NewDTakoEventsService(repo)
+//
+// 読み取り専用のリポジトリ（本番DB・SQL Server）はAddでデータを登録する。
+// 取得したレコードは保持しているレコードのコピー（浅いコピー）のため、変更しても保持しているデータには影響しない。
+package memory
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// table 主キーごとにレコードを保持するインメモリのテーブル
+type table[T any] struct {
+	mu   sync.RWMutex
+	rows map[string]*T
+	// key レコードの主キー
+	key func(*T) string
+	// lastID AUTO_INCREMENTの最終値
+	lastID int64
+}
+
+// newTable テーブルを作成
+func newTable[T any](key func(*T) string) *table[T] {
+	return &table[T]{rows: make(map[string]*T), key: key}
+}
+
+// get 主キーでレコードを取得
+func (t *table[T]) get(key string) (*T, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	item, ok := t.rows[key]
+	if !ok {
+		return nil, false
+	}
+	return clone(item), true
+}
+
+// insert レコードを追加（主キーが重複する場合はfalse）
+func (t *table[T]) insert(item *T) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	key := t.key(item)
+	if _, ok := t.rows[key]; ok {
+		return false
+	}
+	t.rows[key] = clone(item)
+	return true
+}
+
+// put レコードを追加または置き換え
+func (t *table[T]) put(items ...*T) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, item := range items {
+		t.rows[t.key(item)] = clone(item)
+	}
+}
+
+// update 既存レコードにゼロ値以外のフィールドを反映（GORMのUpdatesと同じ）
+// レコードが存在しない場合はfalse
+func (t *table[T]) update(item *T) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	existing, ok := t.rows[t.key(item)]
+	if !ok {
+		return false
+	}
+	updated := clone(existing)
+	mergeNonZero(updated, item)
+	t.rows[t.key(item)] = updated
+	return true
+}
+
+// delete 主キーでレコードを削除（レコードが存在しない場合はfalse）
+func (t *table[T]) delete(key string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.rows[key]; !ok {
+		return false
+	}
+	delete(t.rows, key)
+	return true
+}
+
+// find 条件に一致するレコードを取得（matchがnilの場合は全件、順序は不定）
+func (t *table[T]) find(match func(*T) bool) []*T {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	items := make([]*T, 0, len(t.rows))
+	for _, item := range t.rows {
+		if match == nil || match(item) {
+			items = append(items, clone(item))
+		}
+	}
+	return items
+}
+
+// nextID AUTO_INCREMENTの値を採番
+// idが指定されている場合はその値を使用し、以降の採番はそれより大きい値にする
+func (t *table[T]) nextID(id int64) int64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if id == 0 {
+		t.lastID++
+		return t.lastID
+	}
+	if id > t.lastID {
+		t.lastID = id
+	}
+	return id
+}
+
+// distinctCodes コードの重複と未設定の値（skip）を除いて昇順に並べる
+func distinctCodes[T cmp.Ordered](codes []T, skip T) []T {
+	slices.Sort(codes)
+	codes = slices.Compact(codes)
+	return slices.DeleteFunc(codes, func(code T) bool { return code == skip })
+}
+
+// clone レコードの浅いコピー
+func clone[T any](item *T) *T {
+	c := *item
+	return &c
+}
+
+// mergeNonZero srcのゼロ値以外のフィールドをdstに反映
+func mergeNonZero[T any](dst, src *T) {
+	d := reflect.ValueOf(dst).Elem()
+	s := reflect.ValueOf(src).Elem()
+	for i := 0; i < s.NumField(); i++ {
+		if d.Field(i).CanSet() && !s.Field(i).IsZero() {
+			d.Field(i).Set(s.Field(i))
+		}
+	}
+}
+
+// key 主キーの値を連結したマップのキー
+// time.Timeはタイムゾーンによらず同じ時刻が同じキーになるようにUTCに変換する
+func key(values ...interface{}) string {
+	parts := make([]string, len(values))
+	for i, value := range values {
+		if t, ok := value.(time.Time); ok {
+			value = t.UTC().Format(time.RFC3339Nano)
+		}
+		parts[i] = fmt.Sprint(value)
+	}
+	return strings.Join(parts, "\x00")
+}
+
+// limitOffset LIMIT/OFFSETの適用（limitが負の場合は制限なし、GORMのLimit/Offsetと同じ）
+func limitOffset[T any](items []*T, limit, offset int) []*T {
+	if offset > 0 {
+		if offset >= len(items) {
+			return []*T{}
+		}
+		items = items[offset:]
+	}
+	if limit >= 0 && limit < len(items) {
+		items = items[:limit]
+	}
+	return items
+}
+
+// inRange 時刻がstart〜endの範囲内か（nilの場合は制限なし、両端を含む）
+func inRange(t time.Time, start, end *time.Time) bool {
+	if start != nil && t.Before(*start) {
+		return false
+	}
+	if end != nil && t.After(*end) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,180 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/yhonda-ohishi/db_service/src/models/mysql"
+	"github.com/yhonda-ohishi/db_service/src/repository"
+	"github.com/yhonda-ohishi/db_service/src/testutil"
+	"gorm.io/gorm"
+)
+
+// eventIDs イベントのIDの一覧
+func eventIDs(events []*mysql.DTakoEvents) []int64 {
+	ids := make([]int64, len(events))
+	for i, event := range events {
+		ids[i] = event.ID
+	}
+	return ids
+}
+
+// pageIDs GetPageで全ページを取得したIDの一覧
+func pageIDs(t *testing.T, repo repository.DTakoEventsRepository, size int) []int64 {
+	t.Helper()
+	var ids []int64
+	page := repository.PageRequest{PageSize: size}
+	for {
+		result, err := repo.GetPage(context.Background(), page)
+		if err != nil {
+			t.Fatalf("GetPage failed: %v", err)
+		}
+		ids = append(ids, eventIDs(result.Items)...)
+		if result.NextPageToken == "" {
+			return ids
+		}
+		page.PageToken = result.NextPageToken
+	}
+}
+
+// DB実装（SQLite）と同じ並び順になることを確認
+func TestDTakoEventsRepository_MatchesDB(t *testing.T) {
+	ctx := context.Background()
+	db, repos := testutil.NewRepositories(t)
+	mem := NewDTakoEventsRepository()
+
+	base := time.Date(2025, 1, 10, 8, 0, 0, 0, time.UTC)
+	names := []string{"休憩", "運転", "休憩", "荷積", "休憩", "運転"}
+	for i, name := range names {
+		event := &mysql.DTakoEvents{
+			OperationNo: "OP001",
+			// 開始日時が同じイベントを含める（idで順序が決まる）
+			StartDatetime: base.Add(time.Duration(i/2) * time.Hour),
+			EventName:     name,
+		}
+		if err := db.Prod.DB.Create(event).Error; err != nil {
+			t.Fatalf("failed to seed dtako_events: %v", err)
+		}
+		mem.Add(event)
+	}
+
+	// デフォルト順（開始日時 DESC）は同じ開始日時の順序が不定のため件数のみ比較
+	for _, sort := range [][]repository.SortField{
+		nil,
+		{{Field: "start_datetime"}, {Field: "id", Desc: true}},
+		{{Field: "id", Desc: true}},
+	} {
+		want, wantTotal, err := repos.DTakoEvents.GetAll(ctx, 4, 1, sort)
+		if err != nil {
+			t.Fatalf("GetAll failed: %v", err)
+		}
+		got, gotTotal, err := mem.GetAll(ctx, 4, 1, sort)
+		if err != nil {
+			t.Fatalf("GetAll failed: %v", err)
+		}
+		if gotTotal != wantTotal {
+			t.Errorf("sort %v: total %d, want %d", sort, gotTotal, wantTotal)
+		}
+		if len(sort) > 0 && !reflect.DeepEqual(eventIDs(got), eventIDs(want)) {
+			t.Errorf("sort %v: got %v, want %v", sort, eventIDs(got), eventIDs(want))
+		}
+	}
+
+	// ホワイトリストにないフィールドは拒否
+	if _, _, err := mem.GetAll(ctx, 10, 0, []repository.SortField{{Field: "event_name"}}); !errors.Is(err, repository.ErrInvalidSortField) {
+		t.Errorf("expected ErrInvalidSortField, got %v", err)
+	}
+
+	if got, want := pageIDs(t, mem, 4), pageIDs(t, repos.DTakoEvents, 4); !reflect.DeepEqual(got, want) {
+		t.Errorf("GetPage: got %v, want %v", got, want)
+	}
+
+	start, end := base.Add(time.Hour), base.Add(2*time.Hour)
+	want, err := repos.DTakoEvents.GetByOperationNo(ctx, "OP001", []string{"休憩"}, &start, &end)
+	if err != nil {
+		t.Fatalf("GetByOperationNo failed: %v", err)
+	}
+	got, err := mem.GetByOperationNo(ctx, "OP001", []string{"休憩"}, &start, &end)
+	if err != nil {
+		t.Fatalf("GetByOperationNo failed: %v", err)
+	}
+	if !reflect.DeepEqual(eventIDs(got), eventIDs(want)) {
+		t.Errorf("GetByOperationNo: got %v, want %v", eventIDs(got), eventIDs(want))
+	}
+
+	var streamed []int64
+	err = mem.StreamByDateRange(context.Background(), &start, nil, 3, func(batch []*mysql.DTakoEvents) error {
+		streamed = append(streamed, eventIDs(batch)...)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("StreamByDateRange failed: %v", err)
+	}
+	if want := []int64{3, 4, 5, 6}; !reflect.DeepEqual(streamed, want) {
+		t.Errorf("StreamByDateRange: got %v, want %v", streamed, want)
+	}
+
+	if _, err := mem.GetByID(ctx, 99); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("expected ErrRecordNotFound, got %v", err)
+	}
+}
+
+func TestDTakoUriageKeihiRepository_Errors(t *testing.T) {
+	ctx := context.Background()
+	repo := NewDTakoUriageKeihiRepository()
+	datetime := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	km := 12.5
+	data := &mysql.DTakoUriageKeihi{
+		SrchID:      "SRCH001",
+		Datetime:    datetime,
+		KeihiC:      1,
+		Price:       1000,
+		DtakoRowID:  "ROW001",
+		DtakoRowIDR: "ROW001R",
+		Km:          &km,
+	}
+
+	if err := repo.Create(ctx, data); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if err := repo.Create(ctx, data); !errors.Is(err, mysql.ErrDuplicateKey) {
+		t.Errorf("expected ErrDuplicateKey, got %v", err)
+	}
+	if err := repo.Create(ctx, &mysql.DTakoUriageKeihi{Datetime: datetime}); err == nil {
+		t.Error("expected validation error")
+	}
+
+	// ゼロ値のフィールドは更新しない
+	update := *data
+	update.Price = 2000
+	update.Km = nil
+	if err := repo.Update(ctx, &update); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	// タイムゾーンが異なっても同じ時刻なら同じレコード
+	got, err := repo.GetByCompositeKey(ctx, "SRCH001", datetime.In(time.FixedZone("JST", 9*60*60)), 1)
+	if err != nil {
+		t.Fatalf("GetByCompositeKey failed: %v", err)
+	}
+	if got.Price != 2000 || got.Km == nil || *got.Km != km {
+		t.Errorf("unexpected record after update: %+v", got)
+	}
+
+	missing := *data
+	missing.KeihiC = 2
+	if err := repo.Update(ctx, &missing); !errors.Is(err, mysql.ErrRecordNotFound) {
+		t.Errorf("expected ErrRecordNotFound on update, got %v", err)
+	}
+	if err := repo.DeleteByCompositeKey(ctx, "SRCH001", datetime, 1); err != nil {
+		t.Fatalf("DeleteByCompositeKey failed: %v", err)
+	}
+	if _, err := repo.GetByCompositeKey(ctx, "SRCH001", datetime, 1); !errors.Is(err, mysql.ErrRecordNotFound) {
+		t.Errorf("expected ErrRecordNotFound, got %v", err)
+	}
+	if err := repo.DeleteByCompositeKey(ctx, "SRCH001", datetime, 1); !errors.Is(err, mysql.ErrRecordNotFound) {
+		t.Errorf("expected ErrRecordNotFound on delete, got %v", err)
+	}
+}
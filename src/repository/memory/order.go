@@ -0,0 +1,281 @@
+package memory
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/yhonda-ohishi/db_service/src/repository"
+)
+
+// fieldIndexCache 型・列名ごとのフィールドのインデックス
+var fieldIndexCache sync.Map
+
+// fieldIndexKey fieldIndexCacheのキー
+type fieldIndexKey struct {
+	typ    reflect.Type
+	column string
+}
+
+// fieldIndex gormタグの列名（column:XXX）に対応するフィールドのインデックス
+// 列名が存在しない場合はpanic（並び順の定義の誤り）
+func fieldIndex(typ reflect.Type, column string) int {
+	cacheKey := fieldIndexKey{typ: typ, column: column}
+	if index, ok := fieldIndexCache.Load(cacheKey); ok {
+		return index.(int)
+	}
+	for i := 0; i < typ.NumField(); i++ {
+		for _, setting := range strings.Split(typ.Field(i).Tag.Get("gorm"), ";") {
+			if setting == "column:"+column {
+				fieldIndexCache.Store(cacheKey, i)
+				return i
+			}
+		}
+	}
+	panic("memory: " + typ.String() + " has no column " + column)
+}
+
+// columnValue レコードの列の値
+func columnValue[T any](item *T, column string) reflect.Value {
+	v := reflect.ValueOf(item).Elem()
+	return v.Field(fieldIndex(v.Type(), column))
+}
+
+// timeType time.Timeの型
+var timeType = reflect.TypeOf(time.Time{})
+
+// compareValues 列の値を比較（NULLは最小値として扱う、MySQL・SQL Serverと同じ）
+func compareValues(a, b reflect.Value) int {
+	if a.Kind() == reflect.Pointer {
+		switch {
+		case a.IsNil() && b.IsNil():
+			return 0
+		case a.IsNil():
+			return -1
+		case b.IsNil():
+			return 1
+		}
+		return compareValues(a.Elem(), b.Elem())
+	}
+
+	if a.Type() == timeType {
+		return a.Interface().(time.Time).Compare(b.Interface().(time.Time))
+	}
+	switch a.Kind() {
+	case reflect.String:
+		return strings.Compare(a.String(), b.String())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return compareOrdered(a.Int(), b.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return compareOrdered(a.Uint(), b.Uint())
+	case reflect.Float32, reflect.Float64:
+		return compareOrdered(a.Float(), b.Float())
+	case reflect.Bool:
+		return compareOrdered(boolToInt(a.Bool()), boolToInt(b.Bool()))
+	}
+	panic("memory: unsupported column type " + a.Type().String())
+}
+
+// compareOrdered 順序付きの値の比較
+func compareOrdered[V int64 | uint64 | float64](a, b V) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// boolToInt boolを0/1に変換
+func boolToInt(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// compareItems 並び順に従ってレコードを比較
+func compareItems[T any](a, b *T, order []repository.SortField) int {
+	for _, o := range order {
+		c := compareValues(columnValue(a, o.Field), columnValue(b, o.Field))
+		if o.Desc {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// sortItems レコードを並び替え（orderのFieldは列名）
+// 並び順が同じレコードは主キーの昇順にして結果を安定させる
+func sortItems[T any](items []*T, tableKey func(*T) string, order []repository.SortField) {
+	sort.SliceStable(items, func(i, j int) bool {
+		if c := compareItems(items[i], items[j], order); c != 0 {
+			return c < 0
+		}
+		return tableKey(items[i]) < tableKey(items[j])
+	})
+}
+
+// sortBy ソート条件（未指定の場合はdefaultOrder）でレコードを並び替え
+// ソート可能なフィールドはDB実装と同じホワイトリストで判定する
+func sortBy[T any](items []*T, tableKey func(*T) string, sort []repository.SortField, defaultOrder []repository.SortField) error {
+	order := defaultOrder
+	if len(sort) > 0 {
+		var err error
+		order, err = repository.ResolveSortFields(new(T), sort)
+		if err != nil {
+			return err
+		}
+	}
+	sortItems(items, tableKey, order)
+	return nil
+}
+
+// keyset キーセットページネーションのキー列（Fieldは列名、組み合わせが一意になるように指定する）
+type keyset []repository.SortField
+
+// ascending 同じキー列で昇順にしたキーセット
+func (k keyset) ascending() keyset {
+	asc := make(keyset, len(k))
+	for i, c := range k {
+		asc[i] = repository.SortField{Field: c.Field}
+	}
+	return asc
+}
+
+// pageTokenPayload ページトークンの中身
+type pageTokenPayload struct {
+	Key    string            `json:"k"`
+	Values []json.RawMessage `json:"v"`
+}
+
+// signature キー列の定義を文字列化（別の一覧のトークンを検出するため）
+func (k keyset) signature() string {
+	parts := make([]string, len(k))
+	for i, c := range k {
+		parts[i] = c.Field
+		if c.Desc {
+			parts[i] += " DESC"
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+// encodeToken レコードのキー値からページトークンを作成
+func encodeToken[T any](k keyset, item *T) (string, error) {
+	payload := pageTokenPayload{Key: k.signature()}
+	for _, c := range k {
+		raw, err := json.Marshal(columnValue(item, c.Field).Interface())
+		if err != nil {
+			return "", err
+		}
+		payload.Values = append(payload.Values, raw)
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodeToken ページトークンからキー値を復元（キー値を設定したレコードとして返す）
+func decodeToken[T any](k keyset, token string) (*T, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, repository.ErrInvalidPageToken
+	}
+	var payload pageTokenPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, repository.ErrInvalidPageToken
+	}
+	if payload.Key != k.signature() || len(payload.Values) != len(k) {
+		return nil, repository.ErrInvalidPageToken
+	}
+
+	cursor := new(T)
+	for i, c := range k {
+		field := columnValue(cursor, c.Field)
+		if err := json.Unmarshal(payload.Values[i], field.Addr().Interface()); err != nil {
+			return nil, repository.ErrInvalidPageToken
+		}
+	}
+	return cursor, nil
+}
+
+// pageSize 実際に使用するページサイズ（repository.PageRequestと同じ）
+func pageSize(size int) int {
+	if size <= 0 {
+		return repository.DefaultPageSize
+	}
+	if size > repository.MaxPageSize {
+		return repository.MaxPageSize
+	}
+	return size
+}
+
+// listByKeyset キーセットページネーションで一覧を取得する（itemsは絞り込み済みのレコード）
+func listByKeyset[T any](items []*T, tableKey func(*T) string, k keyset, req repository.PageRequest) (*repository.Page[T], error) {
+	page := &repository.Page[T]{}
+	if req.IncludeTotalCount {
+		totalCount := int64(len(items))
+		page.TotalCount = &totalCount
+	}
+
+	if req.PageToken != "" {
+		cursor, err := decodeToken[T](k, req.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		after := items[:0:0]
+		for _, item := range items {
+			if compareItems(item, cursor, k) > 0 {
+				after = append(after, item)
+			}
+		}
+		items = after
+	}
+	sortItems(items, tableKey, k)
+
+	size := pageSize(req.PageSize)
+	if len(items) > size {
+		items = items[:size]
+		token, err := encodeToken(k, items[size-1])
+		if err != nil {
+			return nil, err
+		}
+		page.NextPageToken = token
+	}
+	page.Items = items
+	return page, nil
+}
+
+// streamByKeyset キーセット順に一定件数ずつfnに渡す
+// ctxがキャンセルされた場合やfnがエラーを返した場合は中断する
+func streamByKeyset[T any](ctx context.Context, items []*T, tableKey func(*T) string, k keyset, batchSize int, fn func([]*T) error) error {
+	sortItems(items, tableKey, k)
+	size := pageSize(batchSize)
+	for start := 0; ; start += size {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if start >= len(items) {
+			return nil
+		}
+		batch := items[start:min(start+size, len(items))]
+		if err := fn(batch); err != nil {
+			return err
+		}
+		if len(batch) < size {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,496 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/yhonda-ohishi/db_service/src/models/mysql"
+	"github.com/yhonda-ohishi/db_service/src/repository"
+	"gorm.io/gorm"
+)
+
+// 本番DB用リポジトリのインターフェース実装の確認
+var (
+	_ repository.DTakoCarsRepository          = (*DTakoCarsRepository)(nil)
+	_ repository.DTakoEventsRepository        = (*DTakoEventsRepository)(nil)
+	_ repository.TimeCardRepository           = (*TimeCardRepository)(nil)
+	_ repository.DTakoFerryRowsProdRepository = (*DTakoFerryRowsProdRepository)(nil)
+	_ repository.DTakoRowsRepository          = (*DTakoRowsRepository)(nil)
+	_ repository.ETCNumRepository             = (*ETCNumRepository)(nil)
+	_ repository.CarsRepository               = (*CarsRepository)(nil)
+	_ repository.DriversRepository            = (*DriversRepository)(nil)
+)
+
+// ---- DTakoCars ----
+
+// DTakoCarsRepository 車輌データのインメモリ実装
+type DTakoCarsRepository struct {
+	table *table[mysql.DTakoCars]
+}
+
+// NewDTakoCarsRepository DTakoCarsRepositoryのコンストラクタ
+func NewDTakoCarsRepository() *DTakoCarsRepository {
+	return &DTakoCarsRepository{table: newTable(dtakoCarsKey)}
+}
+
+// dtakoCarsKey 主キー（id）
+func dtakoCarsKey(m *mysql.DTakoCars) string {
+	return key(m.ID)
+}
+
+// dtakoCarsKeyset キーセットページネーションのキー（id ASC）
+var dtakoCarsKeyset = keyset{{Field: "id"}}
+
+// Add データを登録（IDが0の場合は採番する）
+func (r *DTakoCarsRepository) Add(items ...*mysql.DTakoCars) {
+	for _, item := range items {
+		item.ID = int(r.table.nextID(int64(item.ID)))
+		r.table.put(item)
+	}
+}
+
+// GetAll 全車輌データを取得（主キー順）
+func (r *DTakoCarsRepository) GetAll(_ context.Context, limit, offset int) ([]*mysql.DTakoCars, int64, error) {
+	return getAll(r.table, limit, offset, nil, dtakoCarsKeyset)
+}
+
+// GetPage 全車輌データを取得（キーセットページネーション）
+func (r *DTakoCarsRepository) GetPage(_ context.Context, page repository.PageRequest) (*repository.Page[mysql.DTakoCars], error) {
+	return listByKeyset(r.table.find(nil), dtakoCarsKey, dtakoCarsKeyset, page)
+}
+
+// GetByID IDで車輌データを取得
+func (r *DTakoCarsRepository) GetByID(_ context.Context, id int) (*mysql.DTakoCars, error) {
+	return getOrNotFound(r.table, key(id))
+}
+
+// GetByCarCode 車輌CDで車輌データを取得（複数ある場合は主キー順で最初のもの）
+func (r *DTakoCarsRepository) GetByCarCode(_ context.Context, carCode string) (*mysql.DTakoCars, error) {
+	return first(r.table, dtakoCarsKeyset, func(m *mysql.DTakoCars) bool { return m.CarCode == carCode })
+}
+
+// GetByCarCC 車輌CCで車輌データを取得（id順）
+func (r *DTakoCarsRepository) GetByCarCC(_ context.Context, carCC string) ([]*mysql.DTakoCars, error) {
+	cars := r.table.find(func(m *mysql.DTakoCars) bool { return m.CarCC == carCC })
+	sortItems(cars, dtakoCarsKey, dtakoCarsKeyset)
+	return cars, nil
+}
+
+// ---- DTakoEvents ----
+
+// DTakoEventsRepository 運行イベントデータのインメモリ実装
+type DTakoEventsRepository struct {
+	table *table[mysql.DTakoEvents]
+}
+
+// NewDTakoEventsRepository DTakoEventsRepositoryのコンストラクタ
+func NewDTakoEventsRepository() *DTakoEventsRepository {
+	return &DTakoEventsRepository{table: newTable(dtakoEventsKey)}
+}
+
+// dtakoEventsKey 主キー（id）
+func dtakoEventsKey(m *mysql.DTakoEvents) string {
+	return key(m.ID)
+}
+
+// dtakoEventsKeyset キーセットページネーションのキー（開始日時 DESC, id DESC）
+var dtakoEventsKeyset = keyset{{Field: "開始日時", Desc: true}, {Field: "id", Desc: true}}
+
+// Add データを登録（IDが0の場合は採番する）
+func (r *DTakoEventsRepository) Add(items ...*mysql.DTakoEvents) {
+	for _, item := range items {
+		item.ID = r.table.nextID(item.ID)
+		r.table.put(item)
+	}
+}
+
+// GetAll 全イベントデータを取得
+func (r *DTakoEventsRepository) GetAll(_ context.Context, limit, offset int, sort []repository.SortField) ([]*mysql.DTakoEvents, int64, error) {
+	return getAll(r.table, limit, offset, sort, []repository.SortField{{Field: "開始日時", Desc: true}})
+}
+
+// GetPage 全イベントデータを取得（キーセットページネーション）
+func (r *DTakoEventsRepository) GetPage(_ context.Context, page repository.PageRequest) (*repository.Page[mysql.DTakoEvents], error) {
+	return listByKeyset(r.table.find(nil), dtakoEventsKey, dtakoEventsKeyset, page)
+}
+
+// GetByID IDでイベントデータを取得
+func (r *DTakoEventsRepository) GetByID(_ context.Context, id int64) (*mysql.DTakoEvents, error) {
+	return getOrNotFound(r.table, key(id))
+}
+
+// GetByOperationNo 運行NOでイベントデータを取得（開始日時の昇順）
+func (r *DTakoEventsRepository) GetByOperationNo(_ context.Context, operationNo string, eventTypes []string, startTime, endTime *time.Time) ([]*mysql.DTakoEvents, error) {
+	events := r.table.find(func(m *mysql.DTakoEvents) bool {
+		if m.OperationNo != operationNo {
+			return false
+		}
+		if len(eventTypes) > 0 && !slices.Contains(eventTypes, m.EventName) {
+			return false
+		}
+		return inRange(m.StartDatetime, startTime, endTime)
+	})
+	sortItems(events, dtakoEventsKey, []repository.SortField{{Field: "開始日時"}})
+	return events, nil
+}
+
+// StreamByDateRange 開始日時の範囲に含まれるイベントデータを古い順に一定件数ずつ取得
+func (r *DTakoEventsRepository) StreamByDateRange(ctx context.Context, startTime, endTime *time.Time, batchSize int, fn func([]*mysql.DTakoEvents) error) error {
+	events := r.table.find(func(m *mysql.DTakoEvents) bool { return inRange(m.StartDatetime, startTime, endTime) })
+	return streamByKeyset(ctx, events, dtakoEventsKey, dtakoEventsKeyset.ascending(), batchSize, fn)
+}
+
+// ---- TimeCard ----
+
+// TimeCardRepository タイムカード（本番DB）のインメモリ実装
+type TimeCardRepository struct {
+	table *table[mysql.TimeCard]
+}
+
+// NewTimeCardRepository TimeCardRepositoryのコンストラクタ
+func NewTimeCardRepository() *TimeCardRepository {
+	return &TimeCardRepository{table: newTable(timeCardKey)}
+}
+
+// timeCardKey 複合主キー（datetime, id）
+func timeCardKey(m *mysql.TimeCard) string {
+	return key(m.Datetime, m.ID)
+}
+
+// timeCardKeyset キーセットページネーションのキー（datetime DESC, id DESC）
+var timeCardKeyset = keyset{{Field: "datetime", Desc: true}, {Field: "id", Desc: true}}
+
+// Add データを登録
+func (r *TimeCardRepository) Add(items ...*mysql.TimeCard) {
+	r.table.put(items...)
+}
+
+// GetAll 全タイムカードデータを取得
+func (r *TimeCardRepository) GetAll(_ context.Context, limit, offset int, sort []repository.SortField) ([]*mysql.TimeCard, int64, error) {
+	return getAll(r.table, limit, offset, sort, []repository.SortField{{Field: "datetime", Desc: true}})
+}
+
+// GetPage 全タイムカードデータを取得（キーセットページネーション）
+func (r *TimeCardRepository) GetPage(_ context.Context, page repository.PageRequest) (*repository.Page[mysql.TimeCard], error) {
+	return listByKeyset(r.table.find(nil), timeCardKey, timeCardKeyset, page)
+}
+
+// GetByCompositeKey 複合主キー（datetime + id）でタイムカードデータを取得
+func (r *TimeCardRepository) GetByCompositeKey(_ context.Context, datetime time.Time, id int) (*mysql.TimeCard, error) {
+	return getOrNotFound(r.table, key(datetime, id))
+}
+
+// ---- DTakoFerryRows (本番DB) ----
+
+// DTakoFerryRowsProdRepository フェリー運行データ（本番DB）のインメモリ実装
+type DTakoFerryRowsProdRepository struct {
+	table *table[mysql.DTakoFerryRows]
+}
+
+// NewDTakoFerryRowsProdRepository DTakoFerryRowsProdRepositoryのコンストラクタ
+func NewDTakoFerryRowsProdRepository() *DTakoFerryRowsProdRepository {
+	return &DTakoFerryRowsProdRepository{table: newTable(dtakoFerryRowsKey)}
+}
+
+// Add データを登録（IDが0の場合は採番する）
+func (r *DTakoFerryRowsProdRepository) Add(items ...*mysql.DTakoFerryRows) {
+	for _, item := range items {
+		item.ID = int32(r.table.nextID(int64(item.ID)))
+		r.table.put(item)
+	}
+}
+
+// GetAll 全フェリー運行データを取得（運行日の降順）
+func (r *DTakoFerryRowsProdRepository) GetAll(_ context.Context, limit, offset int) ([]*mysql.DTakoFerryRows, int64, error) {
+	return getAll(r.table, limit, offset, nil, []repository.SortField{{Field: "運行日", Desc: true}})
+}
+
+// GetPage 全フェリー運行データを取得（キーセットページネーション）
+func (r *DTakoFerryRowsProdRepository) GetPage(_ context.Context, page repository.PageRequest) (*repository.Page[mysql.DTakoFerryRows], error) {
+	return listByKeyset(r.table.find(nil), dtakoFerryRowsKey, dtakoFerryRowsKeyset, page)
+}
+
+// GetByID IDでフェリー運行データを取得
+func (r *DTakoFerryRowsProdRepository) GetByID(_ context.Context, id int32) (*mysql.DTakoFerryRows, error) {
+	return getOrNotFound(r.table, key(id))
+}
+
+// GetByUnkoNo 運行NOでフェリー運行データを取得（運行日の昇順）
+func (r *DTakoFerryRowsProdRepository) GetByUnkoNo(_ context.Context, unkoNo string) ([]*mysql.DTakoFerryRows, error) {
+	rows := r.table.find(func(m *mysql.DTakoFerryRows) bool { return m.UnkoNo == unkoNo })
+	sortItems(rows, dtakoFerryRowsKey, []repository.SortField{{Field: "運行日"}})
+	return rows, nil
+}
+
+// ---- DTakoRows ----
+
+// DTakoRowsRepository 運行データのインメモリ実装
+type DTakoRowsRepository struct {
+	table *table[mysql.DTakoRows]
+}
+
+// NewDTakoRowsRepository DTakoRowsRepositoryのコンストラクタ
+func NewDTakoRowsRepository() *DTakoRowsRepository {
+	return &DTakoRowsRepository{table: newTable(dtakoRowsKey)}
+}
+
+// dtakoRowsKey 主キー（id）
+func dtakoRowsKey(m *mysql.DTakoRows) string {
+	return key(m.ID)
+}
+
+// dtakoRowsKeyset キーセットページネーションのキー（読取日 DESC, id DESC）
+var dtakoRowsKeyset = keyset{{Field: "読取日", Desc: true}, {Field: "id", Desc: true}}
+
+// Add データを登録
+func (r *DTakoRowsRepository) Add(items ...*mysql.DTakoRows) {
+	r.table.put(items...)
+}
+
+// GetAll 全運行データを取得
+func (r *DTakoRowsRepository) GetAll(_ context.Context, limit, offset int, sort []repository.SortField) ([]*mysql.DTakoRows, int64, error) {
+	return getAll(r.table, limit, offset, sort, []repository.SortField{{Field: "読取日", Desc: true}})
+}
+
+// GetPage 全運行データを取得（キーセットページネーション）
+func (r *DTakoRowsRepository) GetPage(_ context.Context, page repository.PageRequest) (*repository.Page[mysql.DTakoRows], error) {
+	return listByKeyset(r.table.find(nil), dtakoRowsKey, dtakoRowsKeyset, page)
+}
+
+// GetByID IDで運行データを取得
+func (r *DTakoRowsRepository) GetByID(_ context.Context, id string) (*mysql.DTakoRows, error) {
+	return getOrNotFound(r.table, key(id))
+}
+
+// GetByIDs IDのいずれかに一致する運行データを取得（idの昇順、存在しないIDは無視）
+func (r *DTakoRowsRepository) GetByIDs(_ context.Context, ids []string) ([]*mysql.DTakoRows, error) {
+	wanted := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		wanted[id] = true
+	}
+	rows := r.table.find(func(m *mysql.DTakoRows) bool { return wanted[m.ID] })
+	sortItems(rows, dtakoRowsKey, []repository.SortField{{Field: "id"}})
+	return rows, nil
+}
+
+// GetByOperationNo 運行NOで運行データを取得（読取日の昇順）
+func (r *DTakoRowsRepository) GetByOperationNo(_ context.Context, operationNo string) ([]*mysql.DTakoRows, error) {
+	rows := r.table.find(func(m *mysql.DTakoRows) bool { return m.OperationNo == operationNo })
+	sortItems(rows, dtakoRowsKey, []repository.SortField{{Field: "読取日"}})
+	return rows, nil
+}
+
+// GetByCarCC 車輌CCで出庫日時〜帰庫日時が期間と重なる運行データを取得（出庫日時の昇順）
+func (r *DTakoRowsRepository) GetByCarCC(_ context.Context, carCC string, start, end time.Time) ([]*mysql.DTakoRows, error) {
+	rows := r.table.find(func(m *mysql.DTakoRows) bool {
+		return m.CarCC == carCC && !m.DepartureDateTime.After(end) && !m.ReturnDateTime.Before(start)
+	})
+	sortItems(rows, dtakoRowsKey, []repository.SortField{{Field: "出庫日時"}})
+	return rows, nil
+}
+
+// GetDriverCodes 運行データの乗務員コード（乗務員CD1・対象乗務員CDの重複なし、0を除く昇順）
+func (r *DTakoRowsRepository) GetDriverCodes(_ context.Context) ([]int, error) {
+	var codes []int
+	for _, m := range r.table.find(nil) {
+		if m.DriverCode1 != nil {
+			codes = append(codes, *m.DriverCode1)
+		}
+		codes = append(codes, m.TargetDriverCode)
+	}
+	return distinctCodes(codes, 0), nil
+}
+
+// StreamByDateRange 読取日の範囲に含まれる運行データを古い順に一定件数ずつ取得
+func (r *DTakoRowsRepository) StreamByDateRange(ctx context.Context, startDate, endDate *time.Time, batchSize int, fn func([]*mysql.DTakoRows) error) error {
+	rows := r.table.find(func(m *mysql.DTakoRows) bool { return inRange(m.ReadDate, startDate, endDate) })
+	return streamByKeyset(ctx, rows, dtakoRowsKey, dtakoRowsKeyset.ascending(), batchSize, fn)
+}
+
+// ---- ETCNum ----
+
+// ETCNumRepository ETCカード番号のインメモリ実装
+type ETCNumRepository struct {
+	table *table[mysql.ETCNum]
+}
+
+// NewETCNumRepository ETCNumRepositoryのコンストラクタ
+func NewETCNumRepository() *ETCNumRepository {
+	return &ETCNumRepository{table: newTable(etcNumKey)}
+}
+
+// etcNumKey 複合主キー（etc_card_num, car_id）
+func etcNumKey(m *mysql.ETCNum) string {
+	return key(m.ETCCardNum, m.CarID)
+}
+
+// etcNumKeyset キーセットページネーションのキー（etc_card_num, car_id ASC）
+var etcNumKeyset = keyset{{Field: "etc_card_num"}, {Field: "car_id"}}
+
+// Add データを登録
+func (r *ETCNumRepository) Add(items ...*mysql.ETCNum) {
+	r.table.put(items...)
+}
+
+// GetAll 全ETCカード番号を取得（主キー順）
+func (r *ETCNumRepository) GetAll(_ context.Context, limit, offset int) ([]*mysql.ETCNum, int64, error) {
+	return getAll(r.table, limit, offset, nil, etcNumKeyset)
+}
+
+// GetPage 全ETCカード番号を取得（キーセットページネーション）
+func (r *ETCNumRepository) GetPage(_ context.Context, page repository.PageRequest) (*repository.Page[mysql.ETCNum], error) {
+	return listByKeyset(r.table.find(nil), etcNumKey, etcNumKeyset, page)
+}
+
+// GetByETCCardNum ETCカード番号で取得（主キー順）
+func (r *ETCNumRepository) GetByETCCardNum(_ context.Context, etcCardNum string) ([]*mysql.ETCNum, error) {
+	etcNums := r.table.find(func(m *mysql.ETCNum) bool { return m.ETCCardNum == etcCardNum })
+	sortItems(etcNums, etcNumKey, etcNumKeyset)
+	return etcNums, nil
+}
+
+// GetByCarID 車輌IDで取得（主キー順）
+func (r *ETCNumRepository) GetByCarID(_ context.Context, carID string) ([]*mysql.ETCNum, error) {
+	etcNums := r.table.find(func(m *mysql.ETCNum) bool { return m.CarID == carID })
+	sortItems(etcNums, etcNumKey, etcNumKeyset)
+	return etcNums, nil
+}
+
+// GetByETCCardNumAt 指定日時にETCカードが登録されていた車輌を取得（主キー順）
+func (r *ETCNumRepository) GetByETCCardNumAt(_ context.Context, etcCardNum string, at time.Time) ([]*mysql.ETCNum, error) {
+	etcNums := r.table.find(func(m *mysql.ETCNum) bool { return m.ETCCardNum == etcCardNum && m.ValidAt(at) })
+	sortItems(etcNums, etcNumKey, etcNumKeyset)
+	return etcNums, nil
+}
+
+// GetByCarIDAt 指定日時に車輌に登録されていたETCカードを取得（主キー順）
+func (r *ETCNumRepository) GetByCarIDAt(_ context.Context, carID string, at time.Time) ([]*mysql.ETCNum, error) {
+	etcNums := r.table.find(func(m *mysql.ETCNum) bool { return m.CarID == carID && m.ValidAt(at) })
+	sortItems(etcNums, etcNumKey, etcNumKeyset)
+	return etcNums, nil
+}
+
+// GetSharedCards 複数の車輌に登録されたETCカードのデータを取得（主キー順）
+func (r *ETCNumRepository) GetSharedCards(_ context.Context) ([]*mysql.ETCNum, error) {
+	cars := make(map[string]int)
+	for _, m := range r.table.find(nil) {
+		cars[m.ETCCardNum]++
+	}
+	etcNums := r.table.find(func(m *mysql.ETCNum) bool { return cars[m.ETCCardNum] > 1 })
+	sortItems(etcNums, etcNumKey, etcNumKeyset)
+	return etcNums, nil
+}
+
+// ---- Cars ----
+
+// CarsRepository 車両マスタのインメモリ実装
+type CarsRepository struct {
+	table *table[mysql.Cars]
+}
+
+// NewCarsRepository CarsRepositoryのコンストラクタ
+func NewCarsRepository() *CarsRepository {
+	return &CarsRepository{table: newTable(carsKey)}
+}
+
+// carsKey 主キー（id）
+func carsKey(m *mysql.Cars) string {
+	return key(m.ID)
+}
+
+// carsKeyset キーセットページネーションのキー（id ASC）
+var carsKeyset = keyset{{Field: "id"}}
+
+// Add データを登録
+func (r *CarsRepository) Add(items ...*mysql.Cars) {
+	r.table.put(items...)
+}
+
+// GetAll 全車両を取得
+func (r *CarsRepository) GetAll(_ context.Context, limit, offset int, sort []repository.SortField) ([]*mysql.Cars, int64, error) {
+	return getAll(r.table, limit, offset, sort, carsKeyset)
+}
+
+// GetPage 全車両を取得（キーセットページネーション）
+func (r *CarsRepository) GetPage(_ context.Context, page repository.PageRequest) (*repository.Page[mysql.Cars], error) {
+	return listByKeyset(r.table.find(nil), carsKey, carsKeyset, page)
+}
+
+// GetByID IDで車両を取得
+func (r *CarsRepository) GetByID(_ context.Context, id string) (*mysql.Cars, error) {
+	return getOrNotFound(r.table, key(id))
+}
+
+// GetByID4 id4で車両を取得（id順）
+func (r *CarsRepository) GetByID4(_ context.Context, id4 int) ([]*mysql.Cars, error) {
+	cars := r.table.find(func(m *mysql.Cars) bool { return m.ID4 == id4 })
+	sortItems(cars, carsKey, carsKeyset)
+	return cars, nil
+}
+
+// GetByBumonCodeID 部門コードIDで車両を取得（id順）
+func (r *CarsRepository) GetByBumonCodeID(_ context.Context, bumonCodeID string) ([]*mysql.Cars, error) {
+	cars := r.table.find(func(m *mysql.Cars) bool { return m.BumonCodeID != nil && *m.BumonCodeID == bumonCodeID })
+	sortItems(cars, carsKey, carsKeyset)
+	return cars, nil
+}
+
+// ---- Drivers ----
+
+// DriversRepository 乗務員マスタのインメモリ実装
+type DriversRepository struct {
+	table *table[mysql.Drivers]
+}
+
+// NewDriversRepository DriversRepositoryのコンストラクタ
+func NewDriversRepository() *DriversRepository {
+	return &DriversRepository{table: newTable(driversKey)}
+}
+
+// driversKey 主キー（id）
+func driversKey(m *mysql.Drivers) string {
+	return key(m.ID)
+}
+
+// driversKeyset キーセットページネーションのキー（id ASC）
+var driversKeyset = keyset{{Field: "id"}}
+
+// Add データを登録
+func (r *DriversRepository) Add(items ...*mysql.Drivers) {
+	r.table.put(items...)
+}
+
+// GetAll 全乗務員を取得
+func (r *DriversRepository) GetAll(_ context.Context, limit, offset int, sort []repository.SortField) ([]*mysql.Drivers, int64, error) {
+	return getAll(r.table, limit, offset, sort, driversKeyset)
+}
+
+// GetPage 全乗務員を取得（キーセットページネーション）
+func (r *DriversRepository) GetPage(_ context.Context, page repository.PageRequest) (*repository.Page[mysql.Drivers], error) {
+	return listByKeyset(r.table.find(nil), driversKey, driversKeyset, page)
+}
+
+// GetByID IDで乗務員を取得
+func (r *DriversRepository) GetByID(_ context.Context, id int) (*mysql.Drivers, error) {
+	return getOrNotFound(r.table, key(id))
+}
+
+// GetByBumon 部門で乗務員を取得（id順）
+func (r *DriversRepository) GetByBumon(_ context.Context, bumon string) ([]*mysql.Drivers, error) {
+	drivers := r.table.find(func(m *mysql.Drivers) bool { return m.Bumon == bumon })
+	sortItems(drivers, driversKey, driversKeyset)
+	return drivers, nil
+}
+
+// ---- 共通 ----
+
+// first 条件に一致するレコードのうち並び順で最初のものを取得（存在しない場合はgorm.ErrRecordNotFound）
+func first[T any](t *table[T], order []repository.SortField, match func(*T) bool) (*T, error) {
+	items := t.find(match)
+	if len(items) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	sortItems(items, t.key, order)
+	return items[0], nil
+}
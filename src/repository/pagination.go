@@ -0,0 +1,276 @@
+package repository
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// ページサイズ
+const (
+	DefaultPageSize = 100
+	MaxPageSize     = 1000
+)
+
+// ErrInvalidPageToken ページトークンが不正（改ざん・別の一覧のトークン等）
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// PageRequest キーセットページネーションのリクエスト
+type PageRequest struct {
+	// PageSize 1ページの件数（0の場合はDefaultPageSize、MaxPageSizeで上限）
+	PageSize int
+	// PageToken 前ページのNextPageToken（初回は空）
+	PageToken string
+	// IncludeTotalCount 総件数も取得する（COUNT(*)を実行するため遅い）
+	IncludeTotalCount bool
+}
+
+// size 実際に使用するページサイズ
+func (p PageRequest) size() int {
+	if p.PageSize <= 0 {
+		return DefaultPageSize
+	}
+	if p.PageSize > MaxPageSize {
+		return MaxPageSize
+	}
+	return p.PageSize
+}
+
+// Page キーセットページネーションの取得結果
+type Page[T any] struct {
+	Items []*T
+	// NextPageToken 次ページのトークン（最終ページの場合は空）
+	NextPageToken string
+	// TotalCount 総件数（IncludeTotalCount指定時のみ）
+	TotalCount *int64
+}
+
+// keysetColumn キーセットページネーションのキー列
+type keysetColumn[T any] struct {
+	column string
+	value  func(*T) interface{}
+}
+
+// keyset テーブルの自然順序（キー列はすべて同じ方向でソートする）
+// キー列の組み合わせは一意になるように指定する
+type keyset[T any] struct {
+	desc    bool
+	columns []keysetColumn[T]
+}
+
+// pageTokenPayload ページトークンの中身
+type pageTokenPayload struct {
+	// Key キー列の定義（別の一覧のトークンを検出するため）
+	Key    string            `json:"k"`
+	Values []json.RawMessage `json:"v"`
+}
+
+// signature キー列の定義を文字列化
+func (k keyset[T]) signature() string {
+	names := make([]string, len(k.columns))
+	for i, c := range k.columns {
+		names[i] = c.column
+	}
+	direction := "asc"
+	if k.desc {
+		direction = "desc"
+	}
+	return strings.Join(names, ",") + ":" + direction
+}
+
+// orderBy ORDER BY句
+func (k keyset[T]) orderBy() string {
+	direction := " ASC"
+	if k.desc {
+		direction = " DESC"
+	}
+	orders := make([]string, len(k.columns))
+	for i, c := range k.columns {
+		orders[i] = c.column + direction
+	}
+	return strings.Join(orders, ", ")
+}
+
+// ascending 同じキー列で昇順にしたキーセット
+func (k keyset[T]) ascending() keyset[T] {
+	return keyset[T]{columns: k.columns}
+}
+
+// values レコードのキー値
+func (k keyset[T]) values(item *T) []interface{} {
+	values := make([]interface{}, len(k.columns))
+	for i, c := range k.columns {
+		values[i] = c.value(item)
+	}
+	return values
+}
+
+// encodeToken レコードのキー値からページトークンを作成
+func (k keyset[T]) encodeToken(item *T) (string, error) {
+	payload := pageTokenPayload{Key: k.signature()}
+	for _, value := range k.values(item) {
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return "", fmt.Errorf("failed to encode page token: %w", err)
+		}
+		payload.Values = append(payload.Values, raw)
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode page token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodeToken ページトークンからキー値を復元
+// 値はキー列と同じ型に復元する（time.Timeも文字列ではなくtime.Timeとして比較する）
+func (k keyset[T]) decodeToken(token string) ([]interface{}, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	var payload pageTokenPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	if payload.Key != k.signature() || len(payload.Values) != len(k.columns) {
+		return nil, ErrInvalidPageToken
+	}
+
+	var zero T
+	values := make([]interface{}, len(k.columns))
+	for i, c := range k.columns {
+		ptr := reflect.New(reflect.TypeOf(c.value(&zero)))
+		if err := json.Unmarshal(payload.Values[i], ptr.Interface()); err != nil {
+			return nil, ErrInvalidPageToken
+		}
+		values[i] = ptr.Elem().Interface()
+	}
+	return values, nil
+}
+
+// after キー値より後のレコードを取得する条件
+// (k1 > ?) OR (k1 = ? AND k2 > ?) OR ... の形式（SQL Serverは行値比較に対応していないため）
+func (k keyset[T]) after(values []interface{}) (string, []interface{}) {
+	op := " > ?"
+	if k.desc {
+		op = " < ?"
+	}
+
+	var conditions []string
+	var args []interface{}
+	for i, c := range k.columns {
+		var parts []string
+		for j := 0; j < i; j++ {
+			parts = append(parts, k.columns[j].column+" = ?")
+			args = append(args, values[j])
+		}
+		parts = append(parts, c.column+op)
+		args = append(args, values[i])
+		conditions = append(conditions, "("+strings.Join(parts, " AND ")+")")
+	}
+	return strings.Join(conditions, " OR "), args
+}
+
+// listByKeyset キーセットページネーションで一覧を取得する
+// queryには絞り込み条件のみを指定する（ORDER BY/LIMITはここで設定する）
+func listByKeyset[T any](query *gorm.DB, k keyset[T], req PageRequest) (*Page[T], error) {
+	page := &Page[T]{}
+
+	if req.IncludeTotalCount {
+		var totalCount int64
+		if err := query.Session(&gorm.Session{}).Model(new(T)).Count(&totalCount).Error; err != nil {
+			return nil, fmt.Errorf("failed to count records: %w", err)
+		}
+		page.TotalCount = &totalCount
+	}
+
+	query = query.Session(&gorm.Session{})
+	if req.PageToken != "" {
+		values, err := k.decodeToken(req.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		condition, args := k.after(values)
+		query = query.Where(condition, args...)
+	}
+
+	// 次ページの有無を判定するため1件多く取得
+	size := req.size()
+	var items []*T
+	if err := query.Order(k.orderBy()).Limit(size + 1).Find(&items).Error; err != nil {
+		return nil, fmt.Errorf("failed to list records: %w", err)
+	}
+
+	if len(items) > size {
+		items = items[:size]
+		token, err := k.encodeToken(items[size-1])
+		if err != nil {
+			return nil, err
+		}
+		page.NextPageToken = token
+	}
+	page.Items = items
+	return page, nil
+}
+
+// streamByKeyset キーセット順に一定件数ずつ取得し、fnに渡す
+// 1回のクエリはbatchSize件（0の場合はDefaultPageSize、MaxPageSizeで上限）で、
+// ctxがキャンセルされた場合やfnがエラーを返した場合は中断する
+func streamByKeyset[T any](ctx context.Context, query *gorm.DB, k keyset[T], batchSize int, fn func([]*T) error) error {
+	size := PageRequest{PageSize: batchSize}.size()
+
+	var last []interface{}
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		batch := query.Session(&gorm.Session{}).WithContext(ctx)
+		if last != nil {
+			condition, args := k.after(last)
+			batch = batch.Where(condition, args...)
+		}
+
+		var items []*T
+		if err := batch.Order(k.orderBy()).Limit(size).Find(&items).Error; err != nil {
+			return fmt.Errorf("failed to stream records: %w", err)
+		}
+		if len(items) == 0 {
+			return nil
+		}
+		if err := fn(items); err != nil {
+			return err
+		}
+		if len(items) < size {
+			return nil
+		}
+		last = k.values(items[len(items)-1])
+	}
+}
+
+// AllPages キーセットページネーションで全ページを取得（全件を突き合わせる集計用）
+func AllPages[T any](ctx context.Context, getPage func(context.Context, PageRequest) (*Page[T], error)) ([]*T, error) {
+	var items []*T
+	page := PageRequest{PageSize: MaxPageSize}
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		result, err := getPage(ctx, page)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, result.Items...)
+		if result.NextPageToken == "" {
+			return items, nil
+		}
+		page.PageToken = result.NextPageToken
+	}
+}
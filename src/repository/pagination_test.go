@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/yhonda-ohishi/db_service/src/models/mysql"
+)
+
+func TestKeysetToken(t *testing.T) {
+	event := &mysql.DTakoEvents{
+		ID:            42,
+		StartDatetime: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+
+	token, err := dtakoEventsKeyset.encodeToken(event)
+	if err != nil {
+		t.Fatalf("encodeToken failed: %v", err)
+	}
+
+	// キー値は元の型で復元される
+	values, err := dtakoEventsKeyset.decodeToken(token)
+	if err != nil {
+		t.Fatalf("decodeToken failed: %v", err)
+	}
+	if !values[0].(time.Time).Equal(event.StartDatetime) || values[1] != int64(42) {
+		t.Errorf("unexpected values: %#v", values)
+	}
+
+	condition, args := dtakoEventsKeyset.after(values)
+	if condition != "(開始日時 < ?) OR (開始日時 = ? AND id < ?)" {
+		t.Errorf("unexpected condition: %s", condition)
+	}
+	if !reflect.DeepEqual(args, []interface{}{values[0], values[0], values[1]}) {
+		t.Errorf("unexpected args: %#v", args)
+	}
+
+	// 別の一覧のトークンや改ざんされたトークンは拒否する
+	for _, invalid := range []string{"not-a-token", token + "x"} {
+		if _, err := dtakoEventsKeyset.decodeToken(invalid); !errors.Is(err, ErrInvalidPageToken) {
+			t.Errorf("expected ErrInvalidPageToken for %q, got %v", invalid, err)
+		}
+	}
+	if _, err := dtakoRowsKeyset.decodeToken(token); !errors.Is(err, ErrInvalidPageToken) {
+		t.Errorf("expected ErrInvalidPageToken for token of another list, got %v", err)
+	}
+}
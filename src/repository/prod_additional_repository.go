@@ -1,29 +1,44 @@
 package repository
 
 import (
+	"context"
+	"sort"
+	"time"
+
 	"github.com/yhonda-ohishi/db_service/src/config"
 	"github.com/yhonda-ohishi/db_service/src/models/mysql"
+	"gorm.io/gorm"
 )
 
 // DTakoFerryRowsRepository インターフェース（本番DB用）
 type DTakoFerryRowsProdRepository interface {
-	GetAll(limit, offset int) ([]*mysql.DTakoFerryRows, int64, error)
-	GetByID(id int32) (*mysql.DTakoFerryRows, error)
-	GetByUnkoNo(unkoNo string) ([]*mysql.DTakoFerryRows, error)
+	GetAll(ctx context.Context, limit, offset int) ([]*mysql.DTakoFerryRows, int64, error)
+	GetPage(ctx context.Context, page PageRequest) (*Page[mysql.DTakoFerryRows], error)
+	GetByID(ctx context.Context, id int32) (*mysql.DTakoFerryRows, error)
+	GetByUnkoNo(ctx context.Context, unkoNo string) ([]*mysql.DTakoFerryRows, error)
 }
 
 // DTakoRowsRepository インターフェース
 type DTakoRowsRepository interface {
-	GetAll(limit, offset int, orderBy string) ([]*mysql.DTakoRows, int64, error)
-	GetByID(id string) (*mysql.DTakoRows, error)
-	GetByOperationNo(operationNo string) ([]*mysql.DTakoRows, error)
+	GetAll(ctx context.Context, limit, offset int, sort []SortField) ([]*mysql.DTakoRows, int64, error)
+	GetPage(ctx context.Context, page PageRequest) (*Page[mysql.DTakoRows], error)
+	GetByID(ctx context.Context, id string) (*mysql.DTakoRows, error)
+	GetByIDs(ctx context.Context, ids []string) ([]*mysql.DTakoRows, error)
+	GetByOperationNo(ctx context.Context, operationNo string) ([]*mysql.DTakoRows, error)
+	GetByCarCC(ctx context.Context, carCC string, start, end time.Time) ([]*mysql.DTakoRows, error)
+	GetDriverCodes(ctx context.Context) ([]int, error)
+	StreamByDateRange(ctx context.Context, startDate, endDate *time.Time, batchSize int, fn func([]*mysql.DTakoRows) error) error
 }
 
 // ETCNumRepository インターフェース
 type ETCNumRepository interface {
-	GetAll(limit, offset int) ([]*mysql.ETCNum, int64, error)
-	GetByETCCardNum(etcCardNum string) ([]*mysql.ETCNum, error)
-	GetByCarID(carID string) ([]*mysql.ETCNum, error)
+	GetAll(ctx context.Context, limit, offset int) ([]*mysql.ETCNum, int64, error)
+	GetPage(ctx context.Context, page PageRequest) (*Page[mysql.ETCNum], error)
+	GetByETCCardNum(ctx context.Context, etcCardNum string) ([]*mysql.ETCNum, error)
+	GetByCarID(ctx context.Context, carID string) ([]*mysql.ETCNum, error)
+	GetByETCCardNumAt(ctx context.Context, etcCardNum string, at time.Time) ([]*mysql.ETCNum, error)
+	GetByCarIDAt(ctx context.Context, carID string, at time.Time) ([]*mysql.ETCNum, error)
+	GetSharedCards(ctx context.Context) ([]*mysql.ETCNum, error)
 }
 
 // DTakoFerryRowsProdRepositoryImpl 本番DB用実装
@@ -39,36 +54,41 @@ func NewDTakoFerryRowsProdRepository(prodDB *config.ProdDatabase) DTakoFerryRows
 }
 
 // GetAll 全フェリー運行データを取得
-func (r *DTakoFerryRowsProdRepositoryImpl) GetAll(limit, offset int) ([]*mysql.DTakoFerryRows, int64, error) {
+func (r *DTakoFerryRowsProdRepositoryImpl) GetAll(ctx context.Context, limit, offset int) ([]*mysql.DTakoFerryRows, int64, error) {
 	var rows []*mysql.DTakoFerryRows
 	var totalCount int64
 
 	// 総数取得
-	if err := r.prodDB.DB.Model(&mysql.DTakoFerryRows{}).Count(&totalCount).Error; err != nil {
+	if err := r.prodDB.Conn().WithContext(ctx).Model(&mysql.DTakoFerryRows{}).Count(&totalCount).Error; err != nil {
 		return nil, 0, err
 	}
 
 	// データ取得
-	if err := r.prodDB.DB.Limit(limit).Offset(offset).Order("運行日 DESC").Find(&rows).Error; err != nil {
+	if err := r.prodDB.Conn().WithContext(ctx).Limit(limit).Offset(offset).Order("運行日 DESC").Find(&rows).Error; err != nil {
 		return nil, 0, err
 	}
 
 	return rows, totalCount, nil
 }
 
+// GetPage 全フェリー運行データを取得（キーセットページネーション）
+func (r *DTakoFerryRowsProdRepositoryImpl) GetPage(ctx context.Context, page PageRequest) (*Page[mysql.DTakoFerryRows], error) {
+	return listByKeyset(r.prodDB.Conn().WithContext(ctx), dtakoFerryRowsKeyset, page)
+}
+
 // GetByID IDでフェリー運行データを取得
-func (r *DTakoFerryRowsProdRepositoryImpl) GetByID(id int32) (*mysql.DTakoFerryRows, error) {
+func (r *DTakoFerryRowsProdRepositoryImpl) GetByID(ctx context.Context, id int32) (*mysql.DTakoFerryRows, error) {
 	var row mysql.DTakoFerryRows
-	if err := r.prodDB.DB.Where("id = ?", id).First(&row).Error; err != nil {
+	if err := r.prodDB.Conn().WithContext(ctx).Where("id = ?", id).First(&row).Error; err != nil {
 		return nil, err
 	}
 	return &row, nil
 }
 
 // GetByUnkoNo 運行NOでフェリー運行データを取得
-func (r *DTakoFerryRowsProdRepositoryImpl) GetByUnkoNo(unkoNo string) ([]*mysql.DTakoFerryRows, error) {
+func (r *DTakoFerryRowsProdRepositoryImpl) GetByUnkoNo(ctx context.Context, unkoNo string) ([]*mysql.DTakoFerryRows, error) {
 	var rows []*mysql.DTakoFerryRows
-	if err := r.prodDB.DB.Where("運行NO = ?", unkoNo).Order("運行日 ASC").Find(&rows).Error; err != nil {
+	if err := r.prodDB.Conn().WithContext(ctx).Where("運行NO = ?", unkoNo).Order("運行日 ASC").Find(&rows).Error; err != nil {
 		return nil, err
 	}
 	return rows, nil
@@ -87,46 +107,115 @@ func NewDTakoRowsRepository(prodDB *config.ProdDatabase) DTakoRowsRepository {
 }
 
 // GetAll 全運行データを取得
-func (r *DTakoRowsRepositoryImpl) GetAll(limit, offset int, orderBy string) ([]*mysql.DTakoRows, int64, error) {
+func (r *DTakoRowsRepositoryImpl) GetAll(ctx context.Context, limit, offset int, sort []SortField) ([]*mysql.DTakoRows, int64, error) {
+	// ソート条件（ホワイトリストの列のみ）
+	orderBy, err := dtakoRowsSortColumns.orderBy(sort, "読取日 DESC")
+	if err != nil {
+		return nil, 0, err
+	}
+
 	var rows []*mysql.DTakoRows
 	var totalCount int64
 
 	// 総数取得
-	if err := r.prodDB.DB.Model(&mysql.DTakoRows{}).Count(&totalCount).Error; err != nil {
+	if err := r.prodDB.Conn().WithContext(ctx).Model(&mysql.DTakoRows{}).Count(&totalCount).Error; err != nil {
 		return nil, 0, err
 	}
 
-	// デフォルトのorder byを設定
-	if orderBy == "" {
-		orderBy = "読取日 DESC"
-	}
-
 	// データ取得
-	if err := r.prodDB.DB.Limit(limit).Offset(offset).Order(orderBy).Find(&rows).Error; err != nil {
+	if err := r.prodDB.Conn().WithContext(ctx).Limit(limit).Offset(offset).Order(orderBy).Find(&rows).Error; err != nil {
 		return nil, 0, err
 	}
 
 	return rows, totalCount, nil
 }
 
+// dtakoRowsKeyset キーセットページネーションのキー（読取日 DESC, id DESC）
+var dtakoRowsKeyset = keyset[mysql.DTakoRows]{
+	desc: true,
+	columns: []keysetColumn[mysql.DTakoRows]{
+		{column: "読取日", value: func(m *mysql.DTakoRows) interface{} { return m.ReadDate }},
+		{column: "id", value: func(m *mysql.DTakoRows) interface{} { return m.ID }},
+	},
+}
+
+// GetPage 全運行データを取得（キーセットページネーション）
+func (r *DTakoRowsRepositoryImpl) GetPage(ctx context.Context, page PageRequest) (*Page[mysql.DTakoRows], error) {
+	return listByKeyset(r.prodDB.Conn().WithContext(ctx), dtakoRowsKeyset, page)
+}
+
 // GetByID IDで運行データを取得
-func (r *DTakoRowsRepositoryImpl) GetByID(id string) (*mysql.DTakoRows, error) {
+func (r *DTakoRowsRepositoryImpl) GetByID(ctx context.Context, id string) (*mysql.DTakoRows, error) {
 	var row mysql.DTakoRows
-	if err := r.prodDB.DB.Where("id = ?", id).First(&row).Error; err != nil {
+	if err := r.prodDB.Conn().WithContext(ctx).Where("id = ?", id).First(&row).Error; err != nil {
 		return nil, err
 	}
 	return &row, nil
 }
 
+// dtakoRowsChunkSize GetByIDsのIN句1回あたりの件数
+const dtakoRowsChunkSize = 500
+
+// GetByIDs IDのいずれかに一致する運行データを取得（idの昇順、存在しないIDは無視）
+func (r *DTakoRowsRepositoryImpl) GetByIDs(ctx context.Context, ids []string) ([]*mysql.DTakoRows, error) {
+	var rows []*mysql.DTakoRows
+	for start := 0; start < len(ids); start += dtakoRowsChunkSize {
+		var chunkRows []*mysql.DTakoRows
+		chunk := ids[start:min(start+dtakoRowsChunkSize, len(ids))]
+		if err := r.prodDB.Conn().WithContext(ctx).Where("id IN ?", chunk).Find(&chunkRows).Error; err != nil {
+			return nil, err
+		}
+		rows = append(rows, chunkRows...)
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].ID < rows[j].ID })
+	return rows, nil
+}
+
 // GetByOperationNo 運行NOで運行データを取得
-func (r *DTakoRowsRepositoryImpl) GetByOperationNo(operationNo string) ([]*mysql.DTakoRows, error) {
+func (r *DTakoRowsRepositoryImpl) GetByOperationNo(ctx context.Context, operationNo string) ([]*mysql.DTakoRows, error) {
+	var rows []*mysql.DTakoRows
+	if err := r.prodDB.Conn().WithContext(ctx).Where("運行NO = ?", operationNo).Order("読取日 ASC").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// GetDriverCodes 運行データの乗務員コード（乗務員CD1・対象乗務員CDの重複なし、0を除く昇順）
+func (r *DTakoRowsRepositoryImpl) GetDriverCodes(ctx context.Context) ([]int, error) {
+	var codes, targetCodes []int
+	if err := r.prodDB.Conn().WithContext(ctx).Model(&mysql.DTakoRows{}).
+		Where("乗務員CD1 IS NOT NULL").Distinct().Pluck("乗務員CD1", &codes).Error; err != nil {
+		return nil, err
+	}
+	if err := r.prodDB.Conn().WithContext(ctx).Model(&mysql.DTakoRows{}).
+		Distinct().Pluck("対象乗務員CD", &targetCodes).Error; err != nil {
+		return nil, err
+	}
+	return distinctCodes(append(codes, targetCodes...), 0), nil
+}
+
+// GetByCarCC 車輌CCで出庫日時〜帰庫日時が期間と重なる運行データを取得（出庫日時の昇順）
+func (r *DTakoRowsRepositoryImpl) GetByCarCC(ctx context.Context, carCC string, start, end time.Time) ([]*mysql.DTakoRows, error) {
 	var rows []*mysql.DTakoRows
-	if err := r.prodDB.DB.Where("運行NO = ?", operationNo).Order("読取日 ASC").Find(&rows).Error; err != nil {
+	if err := r.prodDB.Conn().WithContext(ctx).Where("車輌CC = ? AND 出庫日時 <= ? AND 帰庫日時 >= ?", carCC, end, start).
+		Order("出庫日時 ASC").Order("id ASC").Find(&rows).Error; err != nil {
 		return nil, err
 	}
 	return rows, nil
 }
 
+// StreamByDateRange 読取日の範囲で運行データを古い順に一定件数ずつ取得
+func (r *DTakoRowsRepositoryImpl) StreamByDateRange(ctx context.Context, startDate, endDate *time.Time, batchSize int, fn func([]*mysql.DTakoRows) error) error {
+	query := r.prodDB.Conn().WithContext(ctx).Model(&mysql.DTakoRows{})
+	if startDate != nil {
+		query = query.Where("読取日 >= ?", startDate)
+	}
+	if endDate != nil {
+		query = query.Where("読取日 <= ?", endDate)
+	}
+	return streamByKeyset(ctx, query, dtakoRowsKeyset.ascending(), batchSize, fn)
+}
+
 // ETCNumRepositoryImpl 実装
 type ETCNumRepositoryImpl struct {
 	*ProdRepository
@@ -140,36 +229,90 @@ func NewETCNumRepository(prodDB *config.ProdDatabase) ETCNumRepository {
 }
 
 // GetAll 全ETCカード番号を取得
-func (r *ETCNumRepositoryImpl) GetAll(limit, offset int) ([]*mysql.ETCNum, int64, error) {
+func (r *ETCNumRepositoryImpl) GetAll(ctx context.Context, limit, offset int) ([]*mysql.ETCNum, int64, error) {
 	var etcNums []*mysql.ETCNum
 	var totalCount int64
 
 	// 総数取得
-	if err := r.prodDB.DB.Model(&mysql.ETCNum{}).Count(&totalCount).Error; err != nil {
+	if err := r.prodDB.Conn().WithContext(ctx).Model(&mysql.ETCNum{}).Count(&totalCount).Error; err != nil {
 		return nil, 0, err
 	}
 
 	// データ取得
-	if err := r.prodDB.DB.Limit(limit).Offset(offset).Find(&etcNums).Error; err != nil {
+	if err := r.prodDB.Conn().WithContext(ctx).Limit(limit).Offset(offset).Find(&etcNums).Error; err != nil {
 		return nil, 0, err
 	}
 
 	return etcNums, totalCount, nil
 }
 
+// etcNumKeyset キーセットページネーションのキー（etc_card_num ASC, car_id ASC）
+var etcNumKeyset = keyset[mysql.ETCNum]{
+	desc: false,
+	columns: []keysetColumn[mysql.ETCNum]{
+		{column: "etc_card_num", value: func(m *mysql.ETCNum) interface{} { return m.ETCCardNum }},
+		{column: "car_id", value: func(m *mysql.ETCNum) interface{} { return m.CarID }},
+	},
+}
+
+// GetPage 全ETCカード番号を取得（キーセットページネーション）
+func (r *ETCNumRepositoryImpl) GetPage(ctx context.Context, page PageRequest) (*Page[mysql.ETCNum], error) {
+	return listByKeyset(r.prodDB.Conn().WithContext(ctx), etcNumKeyset, page)
+}
+
 // GetByETCCardNum ETCカード番号でデータを取得
-func (r *ETCNumRepositoryImpl) GetByETCCardNum(etcCardNum string) ([]*mysql.ETCNum, error) {
+func (r *ETCNumRepositoryImpl) GetByETCCardNum(ctx context.Context, etcCardNum string) ([]*mysql.ETCNum, error) {
 	var etcNums []*mysql.ETCNum
-	if err := r.prodDB.DB.Where("etc_card_num = ?", etcCardNum).Find(&etcNums).Error; err != nil {
+	if err := r.prodDB.Conn().WithContext(ctx).Where("etc_card_num = ?", etcCardNum).Find(&etcNums).Error; err != nil {
 		return nil, err
 	}
 	return etcNums, nil
 }
 
 // GetByCarID 車輌IDでETCカード番号を取得
-func (r *ETCNumRepositoryImpl) GetByCarID(carID string) ([]*mysql.ETCNum, error) {
+func (r *ETCNumRepositoryImpl) GetByCarID(ctx context.Context, carID string) ([]*mysql.ETCNum, error) {
 	var etcNums []*mysql.ETCNum
-	if err := r.prodDB.DB.Where("car_id = ?", carID).Find(&etcNums).Error; err != nil {
+	if err := r.prodDB.Conn().WithContext(ctx).Where("car_id = ?", carID).Find(&etcNums).Error; err != nil {
+		return nil, err
+	}
+	return etcNums, nil
+}
+
+// validAt 指定日時に有効なデータの条件（開始日時・終了日時がNULLの場合は期限なし、終了日時は無効）
+func (r *ETCNumRepositoryImpl) validAt(ctx context.Context, at time.Time) *gorm.DB {
+	return r.prodDB.Conn().WithContext(ctx).
+		Where("start_date_time IS NULL OR start_date_time <= ?", at).
+		Where("due_date_time IS NULL OR due_date_time > ?", at)
+}
+
+// GetByETCCardNumAt 指定日時にETCカードが登録されていた車輌を取得（主キー順）
+func (r *ETCNumRepositoryImpl) GetByETCCardNumAt(ctx context.Context, etcCardNum string, at time.Time) ([]*mysql.ETCNum, error) {
+	var etcNums []*mysql.ETCNum
+	if err := r.validAt(ctx, at).Where("etc_card_num = ?", etcCardNum).
+		Order("etc_card_num ASC").Order("car_id ASC").Find(&etcNums).Error; err != nil {
+		return nil, err
+	}
+	return etcNums, nil
+}
+
+// GetByCarIDAt 指定日時に車輌に登録されていたETCカードを取得（主キー順）
+func (r *ETCNumRepositoryImpl) GetByCarIDAt(ctx context.Context, carID string, at time.Time) ([]*mysql.ETCNum, error) {
+	var etcNums []*mysql.ETCNum
+	if err := r.validAt(ctx, at).Where("car_id = ?", carID).
+		Order("etc_card_num ASC").Order("car_id ASC").Find(&etcNums).Error; err != nil {
+		return nil, err
+	}
+	return etcNums, nil
+}
+
+// GetSharedCards 複数の車輌に登録されたETCカードのデータを取得（主キー順）
+func (r *ETCNumRepositoryImpl) GetSharedCards(ctx context.Context) ([]*mysql.ETCNum, error) {
+	shared := r.prodDB.Conn().WithContext(ctx).Model(&mysql.ETCNum{}).Select("etc_card_num").
+		Group("etc_card_num").Having("COUNT(*) > 1")
+
+	var etcNums []*mysql.ETCNum
+	if err := r.prodDB.Conn().WithContext(ctx).Where("etc_card_num IN (?)", shared).
+		Order("etc_card_num ASC").Order("car_id ASC").Find(&etcNums).Error; err != nil {
 		return nil, err
 	}
 	return etcNums, nil
@@ -177,16 +320,19 @@ func (r *ETCNumRepositoryImpl) GetByCarID(carID string) ([]*mysql.ETCNum, error)
 
 // CarsRepository インターフェース
 type CarsRepository interface {
-	GetAll(limit, offset int, orderBy string) ([]*mysql.Cars, int64, error)
-	GetByID(id string) (*mysql.Cars, error)
-	GetByBumonCodeID(bumonCodeID string) ([]*mysql.Cars, error)
+	GetAll(ctx context.Context, limit, offset int, sort []SortField) ([]*mysql.Cars, int64, error)
+	GetPage(ctx context.Context, page PageRequest) (*Page[mysql.Cars], error)
+	GetByID(ctx context.Context, id string) (*mysql.Cars, error)
+	GetByID4(ctx context.Context, id4 int) ([]*mysql.Cars, error)
+	GetByBumonCodeID(ctx context.Context, bumonCodeID string) ([]*mysql.Cars, error)
 }
 
 // DriversRepository インターフェース
 type DriversRepository interface {
-	GetAll(limit, offset int, orderBy string) ([]*mysql.Drivers, int64, error)
-	GetByID(id int) (*mysql.Drivers, error)
-	GetByBumon(bumon string) ([]*mysql.Drivers, error)
+	GetAll(ctx context.Context, limit, offset int, sort []SortField) ([]*mysql.Drivers, int64, error)
+	GetPage(ctx context.Context, page PageRequest) (*Page[mysql.Drivers], error)
+	GetByID(ctx context.Context, id int) (*mysql.Drivers, error)
+	GetByBumon(ctx context.Context, bumon string) ([]*mysql.Drivers, error)
 }
 
 // CarsRepositoryImpl 実装
@@ -202,41 +348,64 @@ func NewCarsRepository(prodDB *config.ProdDatabase) CarsRepository {
 }
 
 // GetAll 全車両情報を取得
-func (r *CarsRepositoryImpl) GetAll(limit, offset int, orderBy string) ([]*mysql.Cars, int64, error) {
+func (r *CarsRepositoryImpl) GetAll(ctx context.Context, limit, offset int, sort []SortField) ([]*mysql.Cars, int64, error) {
+	// ソート条件（ホワイトリストの列のみ）
+	orderBy, err := carsSortColumns.orderBy(sort, "id ASC")
+	if err != nil {
+		return nil, 0, err
+	}
+
 	var cars []*mysql.Cars
 	var totalCount int64
 
 	// 総数取得
-	if err := r.prodDB.DB.Model(&mysql.Cars{}).Count(&totalCount).Error; err != nil {
+	if err := r.prodDB.Conn().WithContext(ctx).Model(&mysql.Cars{}).Count(&totalCount).Error; err != nil {
 		return nil, 0, err
 	}
 
-	// デフォルトのorder byを設定
-	if orderBy == "" {
-		orderBy = "id ASC"
-	}
-
 	// データ取得
-	if err := r.prodDB.DB.Limit(limit).Offset(offset).Order(orderBy).Find(&cars).Error; err != nil {
+	if err := r.prodDB.Conn().WithContext(ctx).Limit(limit).Offset(offset).Order(orderBy).Find(&cars).Error; err != nil {
 		return nil, 0, err
 	}
 
 	return cars, totalCount, nil
 }
 
+// carsKeyset キーセットページネーションのキー（id ASC）
+var carsKeyset = keyset[mysql.Cars]{
+	desc: false,
+	columns: []keysetColumn[mysql.Cars]{
+		{column: "id", value: func(m *mysql.Cars) interface{} { return m.ID }},
+	},
+}
+
+// GetPage 全車両情報を取得（キーセットページネーション）
+func (r *CarsRepositoryImpl) GetPage(ctx context.Context, page PageRequest) (*Page[mysql.Cars], error) {
+	return listByKeyset(r.prodDB.Conn().WithContext(ctx), carsKeyset, page)
+}
+
 // GetByID IDで車両情報を取得
-func (r *CarsRepositoryImpl) GetByID(id string) (*mysql.Cars, error) {
+func (r *CarsRepositoryImpl) GetByID(ctx context.Context, id string) (*mysql.Cars, error) {
 	var car mysql.Cars
-	if err := r.prodDB.DB.Where("id = ?", id).First(&car).Error; err != nil {
+	if err := r.prodDB.Conn().WithContext(ctx).Where("id = ?", id).First(&car).Error; err != nil {
 		return nil, err
 	}
 	return &car, nil
 }
 
+// GetByID4 id4で車両情報を取得（id順）
+func (r *CarsRepositoryImpl) GetByID4(ctx context.Context, id4 int) ([]*mysql.Cars, error) {
+	var cars []*mysql.Cars
+	if err := r.prodDB.Conn().WithContext(ctx).Where("id4 = ?", id4).Order("id ASC").Find(&cars).Error; err != nil {
+		return nil, err
+	}
+	return cars, nil
+}
+
 // GetByBumonCodeID 部門コードで車両情報を取得
-func (r *CarsRepositoryImpl) GetByBumonCodeID(bumonCodeID string) ([]*mysql.Cars, error) {
+func (r *CarsRepositoryImpl) GetByBumonCodeID(ctx context.Context, bumonCodeID string) ([]*mysql.Cars, error) {
 	var cars []*mysql.Cars
-	if err := r.prodDB.DB.Where("bumon_code_id = ?", bumonCodeID).Order("id ASC").Find(&cars).Error; err != nil {
+	if err := r.prodDB.Conn().WithContext(ctx).Where("bumon_code_id = ?", bumonCodeID).Order("id ASC").Find(&cars).Error; err != nil {
 		return nil, err
 	}
 	return cars, nil
@@ -255,41 +424,55 @@ func NewDriversRepository(prodDB *config.ProdDatabase) DriversRepository {
 }
 
 // GetAll 全ドライバー情報を取得
-func (r *DriversRepositoryImpl) GetAll(limit, offset int, orderBy string) ([]*mysql.Drivers, int64, error) {
+func (r *DriversRepositoryImpl) GetAll(ctx context.Context, limit, offset int, sort []SortField) ([]*mysql.Drivers, int64, error) {
+	// ソート条件（ホワイトリストの列のみ）
+	orderBy, err := driversSortColumns.orderBy(sort, "id ASC")
+	if err != nil {
+		return nil, 0, err
+	}
+
 	var drivers []*mysql.Drivers
 	var totalCount int64
 
 	// 総数取得
-	if err := r.prodDB.DB.Model(&mysql.Drivers{}).Count(&totalCount).Error; err != nil {
+	if err := r.prodDB.Conn().WithContext(ctx).Model(&mysql.Drivers{}).Count(&totalCount).Error; err != nil {
 		return nil, 0, err
 	}
 
-	// デフォルトのorder byを設定
-	if orderBy == "" {
-		orderBy = "id ASC"
-	}
-
 	// データ取得
-	if err := r.prodDB.DB.Limit(limit).Offset(offset).Order(orderBy).Find(&drivers).Error; err != nil {
+	if err := r.prodDB.Conn().WithContext(ctx).Limit(limit).Offset(offset).Order(orderBy).Find(&drivers).Error; err != nil {
 		return nil, 0, err
 	}
 
 	return drivers, totalCount, nil
 }
 
+// driversKeyset キーセットページネーションのキー（id ASC）
+var driversKeyset = keyset[mysql.Drivers]{
+	desc: false,
+	columns: []keysetColumn[mysql.Drivers]{
+		{column: "id", value: func(m *mysql.Drivers) interface{} { return m.ID }},
+	},
+}
+
+// GetPage 全ドライバー情報を取得（キーセットページネーション）
+func (r *DriversRepositoryImpl) GetPage(ctx context.Context, page PageRequest) (*Page[mysql.Drivers], error) {
+	return listByKeyset(r.prodDB.Conn().WithContext(ctx), driversKeyset, page)
+}
+
 // GetByID IDでドライバー情報を取得
-func (r *DriversRepositoryImpl) GetByID(id int) (*mysql.Drivers, error) {
+func (r *DriversRepositoryImpl) GetByID(ctx context.Context, id int) (*mysql.Drivers, error) {
 	var driver mysql.Drivers
-	if err := r.prodDB.DB.Where("id = ?", id).First(&driver).Error; err != nil {
+	if err := r.prodDB.Conn().WithContext(ctx).Where("id = ?", id).First(&driver).Error; err != nil {
 		return nil, err
 	}
 	return &driver, nil
 }
 
 // GetByBumon 部門コードでドライバー情報を取得
-func (r *DriversRepositoryImpl) GetByBumon(bumon string) ([]*mysql.Drivers, error) {
+func (r *DriversRepositoryImpl) GetByBumon(ctx context.Context, bumon string) ([]*mysql.Drivers, error) {
 	var drivers []*mysql.Drivers
-	if err := r.prodDB.DB.Where("bumon = ?", bumon).Order("id ASC").Find(&drivers).Error; err != nil {
+	if err := r.prodDB.Conn().WithContext(ctx).Where("bumon = ?", bumon).Order("id ASC").Find(&drivers).Error; err != nil {
 		return nil, err
 	}
 	return drivers, nil
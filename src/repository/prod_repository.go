@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"time"
 
 	"github.com/yhonda-ohishi/db_service/src/config"
@@ -21,22 +22,27 @@ func NewProdRepository(prodDB *config.ProdDatabase) *ProdRepository {
 
 // DTakoCarsRepository インターフェース
 type DTakoCarsRepository interface {
-	GetAll(limit, offset int) ([]*mysql.DTakoCars, int64, error)
-	GetByID(id int) (*mysql.DTakoCars, error)
-	GetByCarCode(carCode string) (*mysql.DTakoCars, error)
+	GetAll(ctx context.Context, limit, offset int) ([]*mysql.DTakoCars, int64, error)
+	GetPage(ctx context.Context, page PageRequest) (*Page[mysql.DTakoCars], error)
+	GetByID(ctx context.Context, id int) (*mysql.DTakoCars, error)
+	GetByCarCode(ctx context.Context, carCode string) (*mysql.DTakoCars, error)
+	GetByCarCC(ctx context.Context, carCC string) ([]*mysql.DTakoCars, error)
 }
 
 // DTakoEventsRepository インターフェース
 type DTakoEventsRepository interface {
-	GetAll(limit, offset int, orderBy string) ([]*mysql.DTakoEvents, int64, error)
-	GetByID(id int64) (*mysql.DTakoEvents, error)
-	GetByOperationNo(operationNo string, eventTypes []string, startTime, endTime *time.Time) ([]*mysql.DTakoEvents, error)
+	GetAll(ctx context.Context, limit, offset int, sort []SortField) ([]*mysql.DTakoEvents, int64, error)
+	GetPage(ctx context.Context, page PageRequest) (*Page[mysql.DTakoEvents], error)
+	GetByID(ctx context.Context, id int64) (*mysql.DTakoEvents, error)
+	GetByOperationNo(ctx context.Context, operationNo string, eventTypes []string, startTime, endTime *time.Time) ([]*mysql.DTakoEvents, error)
+	StreamByDateRange(ctx context.Context, startTime, endTime *time.Time, batchSize int, fn func([]*mysql.DTakoEvents) error) error
 }
 
 // TimeCardRepository インターフェース
 type TimeCardRepository interface {
-	GetAll(limit, offset int, orderBy string) ([]*mysql.TimeCard, int64, error)
-	GetByCompositeKey(datetime time.Time, id int) (*mysql.TimeCard, error)
+	GetAll(ctx context.Context, limit, offset int, sort []SortField) ([]*mysql.TimeCard, int64, error)
+	GetPage(ctx context.Context, page PageRequest) (*Page[mysql.TimeCard], error)
+	GetByCompositeKey(ctx context.Context, datetime time.Time, id int) (*mysql.TimeCard, error)
 }
 
 // DTakoCarsRepositoryImpl 実装
@@ -52,41 +58,63 @@ func NewDTakoCarsRepository(prodDB *config.ProdDatabase) DTakoCarsRepository {
 }
 
 // GetAll 全車輌情報を取得
-func (r *DTakoCarsRepositoryImpl) GetAll(limit, offset int) ([]*mysql.DTakoCars, int64, error) {
+func (r *DTakoCarsRepositoryImpl) GetAll(ctx context.Context, limit, offset int) ([]*mysql.DTakoCars, int64, error) {
 	var cars []*mysql.DTakoCars
 	var totalCount int64
 
 	// 総数取得
-	if err := r.prodDB.DB.Model(&mysql.DTakoCars{}).Count(&totalCount).Error; err != nil {
+	if err := r.prodDB.Conn().WithContext(ctx).Model(&mysql.DTakoCars{}).Count(&totalCount).Error; err != nil {
 		return nil, 0, err
 	}
 
 	// データ取得
-	if err := r.prodDB.DB.Limit(limit).Offset(offset).Find(&cars).Error; err != nil {
+	if err := r.prodDB.Conn().WithContext(ctx).Limit(limit).Offset(offset).Find(&cars).Error; err != nil {
 		return nil, 0, err
 	}
 
 	return cars, totalCount, nil
 }
 
+// dtakoCarsKeyset キーセットページネーションのキー（id ASC）
+var dtakoCarsKeyset = keyset[mysql.DTakoCars]{
+	desc: false,
+	columns: []keysetColumn[mysql.DTakoCars]{
+		{column: "id", value: func(m *mysql.DTakoCars) interface{} { return m.ID }},
+	},
+}
+
+// GetPage 全車輌情報を取得（キーセットページネーション）
+func (r *DTakoCarsRepositoryImpl) GetPage(ctx context.Context, page PageRequest) (*Page[mysql.DTakoCars], error) {
+	return listByKeyset(r.prodDB.Conn().WithContext(ctx), dtakoCarsKeyset, page)
+}
+
 // GetByID IDで車輌情報を取得
-func (r *DTakoCarsRepositoryImpl) GetByID(id int) (*mysql.DTakoCars, error) {
+func (r *DTakoCarsRepositoryImpl) GetByID(ctx context.Context, id int) (*mysql.DTakoCars, error) {
 	var car mysql.DTakoCars
-	if err := r.prodDB.DB.Where("id = ?", id).First(&car).Error; err != nil {
+	if err := r.prodDB.Conn().WithContext(ctx).Where("id = ?", id).First(&car).Error; err != nil {
 		return nil, err
 	}
 	return &car, nil
 }
 
 // GetByCarCode 車輌CDで車輌情報を取得
-func (r *DTakoCarsRepositoryImpl) GetByCarCode(carCode string) (*mysql.DTakoCars, error) {
+func (r *DTakoCarsRepositoryImpl) GetByCarCode(ctx context.Context, carCode string) (*mysql.DTakoCars, error) {
 	var car mysql.DTakoCars
-	if err := r.prodDB.DB.Where("車輌CD = ?", carCode).First(&car).Error; err != nil {
+	if err := r.prodDB.Conn().WithContext(ctx).Where("車輌CD = ?", carCode).First(&car).Error; err != nil {
 		return nil, err
 	}
 	return &car, nil
 }
 
+// GetByCarCC 車輌CCで車輌情報を取得（id順）
+func (r *DTakoCarsRepositoryImpl) GetByCarCC(ctx context.Context, carCC string) ([]*mysql.DTakoCars, error) {
+	var cars []*mysql.DTakoCars
+	if err := r.prodDB.Conn().WithContext(ctx).Where("車輌CC = ?", carCC).Order("id ASC").Find(&cars).Error; err != nil {
+		return nil, err
+	}
+	return cars, nil
+}
+
 // DTakoEventsRepositoryImpl 実装
 type DTakoEventsRepositoryImpl struct {
 	*ProdRepository
@@ -100,41 +128,56 @@ func NewDTakoEventsRepository(prodDB *config.ProdDatabase) DTakoEventsRepository
 }
 
 // GetAll 全イベント情報を取得
-func (r *DTakoEventsRepositoryImpl) GetAll(limit, offset int, orderBy string) ([]*mysql.DTakoEvents, int64, error) {
+func (r *DTakoEventsRepositoryImpl) GetAll(ctx context.Context, limit, offset int, sort []SortField) ([]*mysql.DTakoEvents, int64, error) {
+	// ソート条件（ホワイトリストの列のみ）
+	orderBy, err := dtakoEventsSortColumns.orderBy(sort, "開始日時 DESC")
+	if err != nil {
+		return nil, 0, err
+	}
+
 	var events []*mysql.DTakoEvents
 	var totalCount int64
 
 	// 総数取得
-	if err := r.prodDB.DB.Model(&mysql.DTakoEvents{}).Count(&totalCount).Error; err != nil {
+	if err := r.prodDB.Conn().WithContext(ctx).Model(&mysql.DTakoEvents{}).Count(&totalCount).Error; err != nil {
 		return nil, 0, err
 	}
 
-	// デフォルトのorder byを設定
-	if orderBy == "" {
-		orderBy = "開始日時 DESC"
-	}
-
 	// データ取得
-	if err := r.prodDB.DB.Limit(limit).Offset(offset).Order(orderBy).Find(&events).Error; err != nil {
+	if err := r.prodDB.Conn().WithContext(ctx).Limit(limit).Offset(offset).Order(orderBy).Find(&events).Error; err != nil {
 		return nil, 0, err
 	}
 
 	return events, totalCount, nil
 }
 
+// dtakoEventsKeyset キーセットページネーションのキー（開始日時 DESC, id DESC）
+var dtakoEventsKeyset = keyset[mysql.DTakoEvents]{
+	desc: true,
+	columns: []keysetColumn[mysql.DTakoEvents]{
+		{column: "開始日時", value: func(m *mysql.DTakoEvents) interface{} { return m.StartDatetime }},
+		{column: "id", value: func(m *mysql.DTakoEvents) interface{} { return m.ID }},
+	},
+}
+
+// GetPage 全イベント情報を取得（キーセットページネーション）
+func (r *DTakoEventsRepositoryImpl) GetPage(ctx context.Context, page PageRequest) (*Page[mysql.DTakoEvents], error) {
+	return listByKeyset(r.prodDB.Conn().WithContext(ctx), dtakoEventsKeyset, page)
+}
+
 // GetByID IDでイベント情報を取得
-func (r *DTakoEventsRepositoryImpl) GetByID(id int64) (*mysql.DTakoEvents, error) {
+func (r *DTakoEventsRepositoryImpl) GetByID(ctx context.Context, id int64) (*mysql.DTakoEvents, error) {
 	var event mysql.DTakoEvents
-	if err := r.prodDB.DB.Where("id = ?", id).First(&event).Error; err != nil {
+	if err := r.prodDB.Conn().WithContext(ctx).Where("id = ?", id).First(&event).Error; err != nil {
 		return nil, err
 	}
 	return &event, nil
 }
 
 // GetByOperationNo 運行NOでイベント情報を取得（フィルタ付き）
-func (r *DTakoEventsRepositoryImpl) GetByOperationNo(operationNo string, eventTypes []string, startTime, endTime *time.Time) ([]*mysql.DTakoEvents, error) {
+func (r *DTakoEventsRepositoryImpl) GetByOperationNo(ctx context.Context, operationNo string, eventTypes []string, startTime, endTime *time.Time) ([]*mysql.DTakoEvents, error) {
 	var events []*mysql.DTakoEvents
-	query := r.prodDB.DB.Where("運行NO = ?", operationNo)
+	query := r.prodDB.Conn().WithContext(ctx).Where("運行NO = ?", operationNo)
 
 	// イベントタイプでフィルタ
 	if len(eventTypes) > 0 {
@@ -157,6 +200,18 @@ func (r *DTakoEventsRepositoryImpl) GetByOperationNo(operationNo string, eventTy
 	return events, nil
 }
 
+// StreamByDateRange 開始日時の範囲でイベント情報を古い順に一定件数ずつ取得
+func (r *DTakoEventsRepositoryImpl) StreamByDateRange(ctx context.Context, startTime, endTime *time.Time, batchSize int, fn func([]*mysql.DTakoEvents) error) error {
+	query := r.prodDB.Conn().WithContext(ctx).Model(&mysql.DTakoEvents{})
+	if startTime != nil {
+		query = query.Where("開始日時 >= ?", startTime)
+	}
+	if endTime != nil {
+		query = query.Where("開始日時 <= ?", endTime)
+	}
+	return streamByKeyset(ctx, query, dtakoEventsKeyset.ascending(), batchSize, fn)
+}
+
 // TimeCardRepositoryImpl 実装
 type TimeCardRepositoryImpl struct {
 	*ProdRepository
@@ -170,22 +225,23 @@ func NewTimeCardRepository(prodDB *config.ProdDatabase) TimeCardRepository {
 }
 
 // GetAll 全タイムカードデータを取得
-func (r *TimeCardRepositoryImpl) GetAll(limit, offset int, orderBy string) ([]*mysql.TimeCard, int64, error) {
+func (r *TimeCardRepositoryImpl) GetAll(ctx context.Context, limit, offset int, sort []SortField) ([]*mysql.TimeCard, int64, error) {
+	// ソート条件（ホワイトリストの列のみ）
+	orderBy, err := timeCardSortColumns.orderBy(sort, "datetime DESC")
+	if err != nil {
+		return nil, 0, err
+	}
+
 	var timeCards []*mysql.TimeCard
 	var totalCount int64
 
 	// 総件数を取得
-	if err := r.prodDB.DB.Model(&mysql.TimeCard{}).Count(&totalCount).Error; err != nil {
+	if err := r.prodDB.Conn().WithContext(ctx).Model(&mysql.TimeCard{}).Count(&totalCount).Error; err != nil {
 		return nil, 0, err
 	}
 
 	// データを取得
-	query := r.prodDB.DB.Limit(limit).Offset(offset)
-	if orderBy != "" {
-		query = query.Order(orderBy)
-	} else {
-		query = query.Order("datetime DESC")
-	}
+	query := r.prodDB.Conn().WithContext(ctx).Limit(limit).Offset(offset).Order(orderBy)
 
 	if err := query.Find(&timeCards).Error; err != nil {
 		return nil, 0, err
@@ -194,10 +250,24 @@ func (r *TimeCardRepositoryImpl) GetAll(limit, offset int, orderBy string) ([]*m
 	return timeCards, totalCount, nil
 }
 
+// timeCardKeyset キーセットページネーションのキー（datetime DESC, id DESC）
+var timeCardKeyset = keyset[mysql.TimeCard]{
+	desc: true,
+	columns: []keysetColumn[mysql.TimeCard]{
+		{column: "datetime", value: func(m *mysql.TimeCard) interface{} { return m.Datetime }},
+		{column: "id", value: func(m *mysql.TimeCard) interface{} { return m.ID }},
+	},
+}
+
+// GetPage 全タイムカードデータを取得（キーセットページネーション）
+func (r *TimeCardRepositoryImpl) GetPage(ctx context.Context, page PageRequest) (*Page[mysql.TimeCard], error) {
+	return listByKeyset(r.prodDB.Conn().WithContext(ctx), timeCardKeyset, page)
+}
+
 // GetByCompositeKey 複合主キー（datetime + id）でタイムカードデータを取得
-func (r *TimeCardRepositoryImpl) GetByCompositeKey(datetime time.Time, id int) (*mysql.TimeCard, error) {
+func (r *TimeCardRepositoryImpl) GetByCompositeKey(ctx context.Context, datetime time.Time, id int) (*mysql.TimeCard, error) {
 	var timeCard mysql.TimeCard
-	if err := r.prodDB.DB.Where("datetime = ? AND id = ?", datetime, id).First(&timeCard).Error; err != nil {
+	if err := r.prodDB.Conn().WithContext(ctx).Where("datetime = ? AND id = ?", datetime, id).First(&timeCard).Error; err != nil {
 		return nil, err
 	}
 	return &timeCard, nil
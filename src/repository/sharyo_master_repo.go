@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/yhonda-ohishi/db_service/src/config"
+	"github.com/yhonda-ohishi/db_service/src/models/ichibanboshi"
+)
+
+// SharyoMasterRepository 車輌マスタリポジトリインターフェース
+type SharyoMasterRepository interface {
+	GetAll(ctx context.Context, limit, offset int, sort []SortField) ([]*ichibanboshi.SharyoMaster, int64, error)
+	GetPage(ctx context.Context, page PageRequest) (*Page[ichibanboshi.SharyoMaster], error)
+	GetBySharyoC(ctx context.Context, sharyoC, sharyoH string) (*ichibanboshi.SharyoMaster, error)
+	GetAllBySharyoC(ctx context.Context, sharyoC string) ([]*ichibanboshi.SharyoMaster, error)
+}
+
+// SharyoMasterRepositoryImpl 車輌マスタリポジトリ実装
+type SharyoMasterRepositoryImpl struct {
+	*IchibanboshiRepository
+}
+
+// NewSharyoMasterRepository 車輌マスタリポジトリのコンストラクタ
+func NewSharyoMasterRepository(sqlServerDB *config.SQLServerDatabase) SharyoMasterRepository {
+	return &SharyoMasterRepositoryImpl{
+		IchibanboshiRepository: NewIchibanboshiRepository(sqlServerDB),
+	}
+}
+
+// GetAll 全車輌マスタを取得
+func (r *SharyoMasterRepositoryImpl) GetAll(ctx context.Context, limit, offset int, sort []SortField) ([]*ichibanboshi.SharyoMaster, int64, error) {
+	// ソート条件（ホワイトリストの列のみ）
+	orderBy, err := sharyoMasterSortColumns.orderBy(sort, "車輌C ASC, 車輌H ASC")
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var sharyo []*ichibanboshi.SharyoMaster
+	var totalCount int64
+
+	// 総数取得
+	if err := r.sqlServerDB.Conn().WithContext(ctx).Model(&ichibanboshi.SharyoMaster{}).Count(&totalCount).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// データ取得
+	if err := r.sqlServerDB.Conn().WithContext(ctx).Limit(limit).Offset(offset).Order(orderBy).Find(&sharyo).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return sharyo, totalCount, nil
+}
+
+// sharyoMasterKeyset キーセットページネーションのキー（車輌C, 車輌H ASC）
+var sharyoMasterKeyset = keyset[ichibanboshi.SharyoMaster]{
+	desc: false,
+	columns: []keysetColumn[ichibanboshi.SharyoMaster]{
+		{column: "車輌C", value: func(m *ichibanboshi.SharyoMaster) interface{} { return m.SharyoC }},
+		{column: "車輌H", value: func(m *ichibanboshi.SharyoMaster) interface{} { return m.SharyoH }},
+	},
+}
+
+// GetPage 全車輌マスタを取得（キーセットページネーション）
+func (r *SharyoMasterRepositoryImpl) GetPage(ctx context.Context, page PageRequest) (*Page[ichibanboshi.SharyoMaster], error) {
+	return listByKeyset(r.sqlServerDB.Conn().WithContext(ctx), sharyoMasterKeyset, page)
+}
+
+// GetBySharyoC 車輌C、車輌Hで車輌マスタを取得（複合主キー）
+func (r *SharyoMasterRepositoryImpl) GetBySharyoC(ctx context.Context, sharyoC, sharyoH string) (*ichibanboshi.SharyoMaster, error) {
+	var sharyo ichibanboshi.SharyoMaster
+	if err := r.sqlServerDB.Conn().WithContext(ctx).Where("車輌C = ? AND 車輌H = ?", sharyoC, sharyoH).First(&sharyo).Error; err != nil {
+		return nil, err
+	}
+	return &sharyo, nil
+}
+
+// GetAllBySharyoC 車輌Cの車輌マスタを車輌H順に取得
+func (r *SharyoMasterRepositoryImpl) GetAllBySharyoC(ctx context.Context, sharyoC string) ([]*ichibanboshi.SharyoMaster, error) {
+	var sharyo []*ichibanboshi.SharyoMaster
+	if err := r.sqlServerDB.Conn().WithContext(ctx).Where("車輌C = ?", sharyoC).Order("車輌H ASC").Find(&sharyo).Error; err != nil {
+		return nil, err
+	}
+	return sharyo, nil
+}
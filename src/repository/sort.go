@@ -0,0 +1,252 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/yhonda-ohishi/db_service/src/models/ichibanboshi"
+	"github.com/yhonda-ohishi/db_service/src/models/mysql"
+)
+
+// ErrInvalidSortField ソートできないフィールド・不正なソート指定
+var ErrInvalidSortField = errors.New("invalid sort field")
+
+// SortField 一覧取得のソート条件
+type SortField struct {
+	// Field protoのフィールド名（例: start_datetime）
+	Field string
+	Desc  bool
+}
+
+// ParseOrderBy 従来のorder_by文字列（例: "start_datetime DESC, id ASC"）をソート条件に変換
+// フィールド名はsortと同じくprotoのフィールド名で指定する（ソート可能かどうかはリポジトリで判定する）
+func ParseOrderBy(orderBy string) ([]SortField, error) {
+	var fields []SortField
+	for _, part := range strings.Split(orderBy, ",") {
+		tokens := strings.Fields(part)
+		if len(tokens) == 0 || len(tokens) > 2 {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidSortField, strings.TrimSpace(part))
+		}
+		field := SortField{Field: tokens[0]}
+		if len(tokens) == 2 {
+			switch strings.ToUpper(tokens[1]) {
+			case "ASC":
+			case "DESC":
+				field.Desc = true
+			default:
+				return nil, fmt.Errorf("%w: %q", ErrInvalidSortField, strings.TrimSpace(part))
+			}
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// sortColumns ソート可能なフィールドのホワイトリスト（protoのフィールド名 → 列名）
+type sortColumns map[string]string
+
+// column protoのフィールド名に対応する列名（列名での指定は受け付けない）
+func (c sortColumns) column(field string) (string, bool) {
+	column, ok := c[field]
+	return column, ok
+}
+
+// orderBy ソート条件からORDER BY句を作成（未指定の場合はdefaultOrder）
+// ホワイトリストにないフィールドはErrInvalidSortField
+func (c sortColumns) orderBy(sort []SortField, defaultOrder string) (string, error) {
+	if len(sort) == 0 {
+		return defaultOrder, nil
+	}
+	orders := make([]string, len(sort))
+	for i, s := range sort {
+		column, ok := c.column(s.Field)
+		if !ok {
+			return "", fmt.Errorf("%w: %s", ErrInvalidSortField, s.Field)
+		}
+		if s.Desc {
+			orders[i] = column + " DESC"
+		} else {
+			orders[i] = column + " ASC"
+		}
+	}
+	return strings.Join(orders, ", "), nil
+}
+
+// ソート可能なフィールド（主キー・日付・コード等）
+var (
+	dtakoEventsSortColumns = sortColumns{
+		"id":                 "id",
+		"operation_no":       "運行NO",
+		"read_date":          "読取日",
+		"car_code":           "車輌CD",
+		"driver_code1":       "乗務員CD1",
+		"target_driver_code": "対象乗務員CD",
+		"start_datetime":     "開始日時",
+		"end_datetime":       "終了日時",
+		"event_code":         "イベントCD",
+	}
+
+	dtakoRowsSortColumns = sortColumns{
+		"id":                  "id",
+		"operation_no":        "運行NO",
+		"read_date":           "読取日",
+		"operation_date":      "運行日",
+		"car_code":            "車輌CD",
+		"driver_code1":        "乗務員CD1",
+		"target_driver_code":  "対象乗務員CD",
+		"start_work_datetime": "出社日時",
+		"end_work_datetime":   "退社日時",
+		"departure_datetime":  "出庫日時",
+		"return_datetime":     "帰庫日時",
+		"total_distance":      "総走行距離",
+	}
+
+	carsSortColumns = sortColumns{
+		"id":                "id",
+		"id4":               "id4",
+		"name":              "name",
+		"reg_date":          "reg_date",
+		"next_inspect_date": "next_inspect_date",
+		"scrap_date":        "scrap_date",
+		"bumon_code_id":     "bumon_code_id",
+		"driver_id":         "driver_id",
+	}
+
+	driversSortColumns = sortColumns{
+		"id":          "id",
+		"name":        "name",
+		"shain_r":     "社員R",
+		"bumon":       "bumon",
+		"join_date":   "join_date",
+		"retire_date": "retire_date",
+	}
+
+	timeCardSortColumns = sortColumns{
+		"datetime": "datetime",
+		"id":       "id",
+		"state":    "state",
+		"created":  "created",
+		"modified": "modified",
+	}
+
+	timeCardLogSortColumns = sortColumns{
+		"datetime": "datetime",
+		"id":       "id",
+		"card_id":  "card_id",
+		"state":    "state",
+		"created":  "created",
+		"modified": "modified",
+	}
+
+	untenNippoMeisaiSortColumns = sortColumns{
+		"nippo_k":         "日報K",
+		"haisha_k":        "配車K",
+		"sharyo_c":        "車輌C",
+		"unko_nengappi":   "運行年月日",
+		"untenshu_c":      "運転手C",
+		"juchu_nengappi":  "受注年月日",
+		"kanri_nengappi":  "管理年月日",
+		"tokuisaki_c":     "得意先C",
+		"denpyo_no":       "伝票NO",
+		"uriage_nengappi": "売上年月日",
+	}
+
+	shainMasterSortColumns = sortColumns{
+		"shain_c":           "社員C",
+		"shain_n":           "社員N",
+		"shain_f":           "社員F",
+		"shain_k":           "社員K",
+		"nyusha_nengappi":   "入社年月日",
+		"taishoku_nengappi": "退職年月日",
+		"bumon_c":           "部門C",
+	}
+
+	chiikiMasterSortColumns = sortColumns{
+		"chiiki_c": "地域C",
+		"chiiki_n": "地域N",
+		"chiiki_f": "地域F",
+	}
+
+	chikuMasterSortColumns = sortColumns{
+		"chiku_c":  "地区C",
+		"chiku_n":  "地区N",
+		"chiku_f":  "地区F",
+		"chiiki_c": "地域C",
+	}
+
+	tokuisakiMasterSortColumns = sortColumns{
+		"tokuisaki_c": "得意先C",
+		"tokuisaki_h": "得意先H",
+		"tokuisaki_n": "得意先N",
+		"tokuisaki_f": "得意先F",
+	}
+
+	hinmeiMasterSortColumns = sortColumns{
+		"hinmei_c": "品名C",
+		"hinmei_h": "品名H",
+		"hinmei_n": "品名N",
+		"hinmei_f": "品名F",
+	}
+
+	bumonMasterSortColumns = sortColumns{
+		"bumon_c": "部門C",
+		"bumon_n": "部門N",
+		"bumon_f": "部門F",
+	}
+
+	sharyoMasterSortColumns = sortColumns{
+		"sharyo_c": "車輌C",
+		"sharyo_h": "車輌H",
+		"sharyo_n": "車輌N",
+		"bumon_c":  "部門C",
+	}
+)
+
+// ResolveSortFields モデルのソート条件をホワイトリストで検証し、Fieldを列名に置き換えて返す
+// SQLを使用しない実装（repository/memory等）で、リポジトリと同じフィールドを受け付けるために使用する
+func ResolveSortFields(model interface{}, sort []SortField) ([]SortField, error) {
+	var columns sortColumns
+	switch model.(type) {
+	case *mysql.DTakoEvents:
+		columns = dtakoEventsSortColumns
+	case *mysql.DTakoRows:
+		columns = dtakoRowsSortColumns
+	case *mysql.Cars:
+		columns = carsSortColumns
+	case *mysql.Drivers:
+		columns = driversSortColumns
+	case *mysql.TimeCard:
+		columns = timeCardSortColumns
+	case *mysql.TimeCardLog:
+		columns = timeCardLogSortColumns
+	case *ichibanboshi.UntenNippoMeisai:
+		columns = untenNippoMeisaiSortColumns
+	case *ichibanboshi.ShainMaster:
+		columns = shainMasterSortColumns
+	case *ichibanboshi.ChiikiMaster:
+		columns = chiikiMasterSortColumns
+	case *ichibanboshi.ChikuMaster:
+		columns = chikuMasterSortColumns
+	case *ichibanboshi.TokuisakiMaster:
+		columns = tokuisakiMasterSortColumns
+	case *ichibanboshi.HinmeiMaster:
+		columns = hinmeiMasterSortColumns
+	case *ichibanboshi.BumonMaster:
+		columns = bumonMasterSortColumns
+	case *ichibanboshi.SharyoMaster:
+		columns = sharyoMasterSortColumns
+	default:
+		return nil, fmt.Errorf("%w: %T has no sortable fields", ErrInvalidSortField, model)
+	}
+
+	resolved := make([]SortField, len(sort))
+	for i, s := range sort {
+		column, ok := columns.column(s.Field)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidSortField, s.Field)
+		}
+		resolved[i] = SortField{Field: column, Desc: s.Desc}
+	}
+	return resolved, nil
+}
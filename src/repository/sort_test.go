@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/yhonda-ohishi/db_service/src/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestSortColumnsOrderBy(t *testing.T) {
+	tests := []struct {
+		name    string
+		orderBy string
+		want    string
+	}{
+		{name: "protoのフィールド名", orderBy: "start_datetime DESC, id", want: "開始日時 DESC, id ASC"},
+		{name: "小文字の方向", orderBy: "start_datetime desc", want: "開始日時 DESC"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sort, err := ParseOrderBy(tt.orderBy)
+			if err != nil {
+				t.Fatalf("ParseOrderBy failed: %v", err)
+			}
+			got, err := dtakoEventsSortColumns.orderBy(sort, "開始日時 DESC")
+			if err != nil {
+				t.Fatalf("orderBy failed: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	// 未指定の場合はデフォルトの並び順
+	if got, _ := dtakoEventsSortColumns.orderBy(nil, "開始日時 DESC"); got != "開始日時 DESC" {
+		t.Errorf("unexpected default order: %q", got)
+	}
+
+	// ホワイトリストにないフィールド・列名・不正な指定は拒否する
+	for _, invalid := range []string{"イベント名 ASC", "開始日時 DESC", "id; DROP TABLE dtako_events", "id ASC NULLS", "id,"} {
+		sort, err := ParseOrderBy(invalid)
+		if err == nil {
+			_, err = dtakoEventsSortColumns.orderBy(sort, "開始日時 DESC")
+		}
+		if !errors.Is(err, ErrInvalidSortField) {
+			t.Errorf("expected ErrInvalidSortField for %q, got %v", invalid, err)
+		}
+	}
+}
+
+// TestSortColumnsMatchSortFieldEnums protoの一覧ごとのdb_*SortFieldとホワイトリストが一致するか
+func TestSortColumnsMatchSortFieldEnums(t *testing.T) {
+	tests := []struct {
+		enum    protoreflect.EnumDescriptor
+		columns sortColumns
+	}{
+		{proto.Db_DTakoEventsSortField(0).Descriptor(), dtakoEventsSortColumns},
+		{proto.Db_DTakoRowsSortField(0).Descriptor(), dtakoRowsSortColumns},
+		{proto.Db_CarsSortField(0).Descriptor(), carsSortColumns},
+		{proto.Db_DriversSortField(0).Descriptor(), driversSortColumns},
+		{proto.Db_TimeCardSortField(0).Descriptor(), timeCardSortColumns},
+		{proto.Db_TimeCardLogSortField(0).Descriptor(), timeCardLogSortColumns},
+		{proto.Db_UntenNippoMeisaiSortField(0).Descriptor(), untenNippoMeisaiSortColumns},
+		{proto.Db_ShainMasterSortField(0).Descriptor(), shainMasterSortColumns},
+		{proto.Db_ChiikiMasterSortField(0).Descriptor(), chiikiMasterSortColumns},
+		{proto.Db_ChikuMasterSortField(0).Descriptor(), chikuMasterSortColumns},
+		{proto.Db_TokuisakiMasterSortField(0).Descriptor(), tokuisakiMasterSortColumns},
+		{proto.Db_HinmeiMasterSortField(0).Descriptor(), hinmeiMasterSortColumns},
+		{proto.Db_BumonMasterSortField(0).Descriptor(), bumonMasterSortColumns},
+		{proto.Db_SharyoMasterSortField(0).Descriptor(), sharyoMasterSortColumns},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.enum.Name()), func(t *testing.T) {
+			values := tt.enum.Values()
+			// UNSPECIFIEDを除いた値の数
+			if values.Len()-1 != len(tt.columns) {
+				t.Errorf("%d values, want %d", values.Len()-1, len(tt.columns))
+			}
+			for i := 1; i < values.Len(); i++ {
+				name := string(values.Get(i).Name())
+				_, field, ok := strings.Cut(name, "_SORT_FIELD_")
+				if !ok {
+					t.Errorf("%s: missing _SORT_FIELD_", name)
+					continue
+				}
+				if _, ok := tt.columns.column(strings.ToLower(field)); !ok {
+					t.Errorf("%s: %s is not sortable", name, strings.ToLower(field))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/yhonda-ohishi/db_service/src/config"
+	"github.com/yhonda-ohishi/db_service/src/models/ichibanboshi"
+)
+
+// TokuisakiMasterRepository 得意先マスタリポジトリインターフェース
+type TokuisakiMasterRepository interface {
+	GetAll(ctx context.Context, limit, offset int, sort []SortField) ([]*ichibanboshi.TokuisakiMaster, int64, error)
+	GetPage(ctx context.Context, page PageRequest) (*Page[ichibanboshi.TokuisakiMaster], error)
+	GetByTokuisakiC(ctx context.Context, tokuisakiC, tokuisakiH string) (*ichibanboshi.TokuisakiMaster, error)
+	Search(ctx context.Context, query string, limit int) ([]*ichibanboshi.TokuisakiMaster, error)
+	GetTekiyobiList(ctx context.Context, tokuisakiC, tokuisakiH string) ([]*ichibanboshi.TokuisakiTekiyobiMaster, error)
+	GetTekiyobiAt(ctx context.Context, tokuisakiC, tokuisakiH string, at time.Time) (*ichibanboshi.TokuisakiTekiyobiMaster, error)
+}
+
+// TokuisakiMasterRepositoryImpl 得意先マスタリポジトリ実装
+type TokuisakiMasterRepositoryImpl struct {
+	*IchibanboshiRepository
+}
+
+// NewTokuisakiMasterRepository 得意先マスタリポジトリのコンストラクタ
+func NewTokuisakiMasterRepository(sqlServerDB *config.SQLServerDatabase) TokuisakiMasterRepository {
+	return &TokuisakiMasterRepositoryImpl{
+		IchibanboshiRepository: NewIchibanboshiRepository(sqlServerDB),
+	}
+}
+
+// GetAll 全得意先マスタを取得
+func (r *TokuisakiMasterRepositoryImpl) GetAll(ctx context.Context, limit, offset int, sort []SortField) ([]*ichibanboshi.TokuisakiMaster, int64, error) {
+	// ソート条件（ホワイトリストの列のみ）
+	orderBy, err := tokuisakiMasterSortColumns.orderBy(sort, "得意先C ASC, 得意先H ASC")
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var tokuisaki []*ichibanboshi.TokuisakiMaster
+	var totalCount int64
+
+	// 総数取得
+	if err := r.sqlServerDB.Conn().WithContext(ctx).Model(&ichibanboshi.TokuisakiMaster{}).Count(&totalCount).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// データ取得
+	if err := r.sqlServerDB.Conn().WithContext(ctx).Limit(limit).Offset(offset).Order(orderBy).Find(&tokuisaki).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return tokuisaki, totalCount, nil
+}
+
+// tokuisakiMasterKeyset キーセットページネーションのキー（得意先C, 得意先H ASC）
+var tokuisakiMasterKeyset = keyset[ichibanboshi.TokuisakiMaster]{
+	desc: false,
+	columns: []keysetColumn[ichibanboshi.TokuisakiMaster]{
+		{column: "得意先C", value: func(m *ichibanboshi.TokuisakiMaster) interface{} { return m.TokuisakiC }},
+		{column: "得意先H", value: func(m *ichibanboshi.TokuisakiMaster) interface{} { return m.TokuisakiH }},
+	},
+}
+
+// GetPage 全得意先マスタを取得（キーセットページネーション）
+func (r *TokuisakiMasterRepositoryImpl) GetPage(ctx context.Context, page PageRequest) (*Page[ichibanboshi.TokuisakiMaster], error) {
+	return listByKeyset(r.sqlServerDB.Conn().WithContext(ctx), tokuisakiMasterKeyset, page)
+}
+
+// GetByTokuisakiC 得意先C、得意先Hで得意先マスタを取得（複合主キー）
+func (r *TokuisakiMasterRepositoryImpl) GetByTokuisakiC(ctx context.Context, tokuisakiC, tokuisakiH string) (*ichibanboshi.TokuisakiMaster, error) {
+	var tokuisaki ichibanboshi.TokuisakiMaster
+	if err := r.sqlServerDB.Conn().WithContext(ctx).Where("得意先C = ? AND 得意先H = ?", tokuisakiC, tokuisakiH).First(&tokuisaki).Error; err != nil {
+		return nil, err
+	}
+	return &tokuisaki, nil
+}
+
+// Search 得意先N・得意先R・得意先F（カナ）の部分一致で得意先マスタを検索（得意先C, 得意先H順）
+func (r *TokuisakiMasterRepositoryImpl) Search(ctx context.Context, query string, limit int) ([]*ichibanboshi.TokuisakiMaster, error) {
+	pattern := containsPattern(query)
+	var tokuisaki []*ichibanboshi.TokuisakiMaster
+	if err := r.sqlServerDB.Conn().WithContext(ctx).
+		Where("得意先N LIKE ? ESCAPE '\\' OR 得意先R LIKE ? ESCAPE '\\' OR 得意先F LIKE ? ESCAPE '\\'", pattern, pattern, pattern).
+		Order(tokuisakiMasterKeyset.orderBy()).Limit(limit).Find(&tokuisaki).Error; err != nil {
+		return nil, err
+	}
+	return tokuisaki, nil
+}
+
+// GetTekiyobiList 得意先の適用日マスタを適用日の古い順に取得
+func (r *TokuisakiMasterRepositoryImpl) GetTekiyobiList(ctx context.Context, tokuisakiC, tokuisakiH string) ([]*ichibanboshi.TokuisakiTekiyobiMaster, error) {
+	var tekiyobi []*ichibanboshi.TokuisakiTekiyobiMaster
+	if err := r.sqlServerDB.Conn().WithContext(ctx).Where("得意先C = ? AND 得意先H = ?", tokuisakiC, tokuisakiH).
+		Order("適用日 ASC").Find(&tekiyobi).Error; err != nil {
+		return nil, err
+	}
+	return tekiyobi, nil
+}
+
+// GetTekiyobiAt 指定日時に有効な得意先の適用日マスタを取得（適用日がat以前で最も新しいもの）
+func (r *TokuisakiMasterRepositoryImpl) GetTekiyobiAt(ctx context.Context, tokuisakiC, tokuisakiH string, at time.Time) (*ichibanboshi.TokuisakiTekiyobiMaster, error) {
+	var tekiyobi ichibanboshi.TokuisakiTekiyobiMaster
+	if err := r.sqlServerDB.Conn().WithContext(ctx).Where("得意先C = ? AND 得意先H = ? AND 適用日 <= ?", tokuisakiC, tokuisakiH, at).
+		Order("適用日 DESC").First(&tekiyobi).Error; err != nil {
+		return nil, err
+	}
+	return &tekiyobi, nil
+}
+
+// likeEscaper LIKEのワイルドカード（%, _, SQL Serverの[）をエスケープ
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`, `[`, `\[`)
+
+// containsPattern 部分一致のLIKEパターン（ESCAPE '\' と組み合わせて使用する）
+func containsPattern(s string) string {
+	return "%" + likeEscaper.Replace(s) + "%"
+}
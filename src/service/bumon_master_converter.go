@@ -0,0 +1,16 @@
+package service
+
+import (
+	"github.com/yhonda-ohishi/db_service/src/models/ichibanboshi"
+	pb "github.com/yhonda-ohishi/db_service/src/proto"
+)
+
+// convertBumonMasterToProto GORMモデルをProtoメッセージに変換
+func convertBumonMasterToProto(m *ichibanboshi.BumonMaster) *pb.Db_BumonMaster {
+	return &pb.Db_BumonMaster{
+		BumonC: m.BumonC,
+		BumonN: m.BumonN,
+		BumonR: m.BumonR,
+		BumonF: m.BumonF,
+	}
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+
+	"github.com/yhonda-ohishi/db_service/src/grpcerr"
+	pb "github.com/yhonda-ohishi/db_service/src/proto"
+	"github.com/yhonda-ohishi/db_service/src/repository"
+)
+
+// BumonMasterService 部門マスタサービス
+type BumonMasterService struct {
+	pb.UnimplementedDb_BumonMasterServiceServer
+	repo repository.BumonMasterRepository
+}
+
+// NewBumonMasterService コンストラクタ
+func NewBumonMasterService(repo repository.BumonMasterRepository) *BumonMasterService {
+	return &BumonMasterService{
+		repo: repo,
+	}
+}
+
+// Get 部門Cで部門マスタを取得
+func (s *BumonMasterService) Get(ctx context.Context, req *pb.Db_GetBumonMasterRequest) (*pb.Db_BumonMasterResponse, error) {
+	bumon, err := s.repo.GetByBumonC(ctx, req.BumonC)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "部門マスタの取得に失敗しました")
+	}
+
+	return &pb.Db_BumonMasterResponse{
+		BumonMaster: convertBumonMasterToProto(bumon),
+	}, nil
+}
+
+// List 部門マスタのリストを取得
+func (s *BumonMasterService) List(ctx context.Context, req *pb.Db_ListBumonMasterRequest) (*pb.Db_ListBumonMasterResponse, error) {
+	// ソート条件（sort / 非推奨のorder_by）
+	sort, err := sortFields(req.Sort, req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	// キーセットページネーション（page_token指定時）
+	if req.PageToken != nil {
+		page, err := keysetPageRequest(req.Limit, req.Offset, sort, *req.PageToken, req.IncludeTotalCount)
+		if err != nil {
+			return nil, err
+		}
+		result, err := s.repo.GetPage(ctx, page)
+		if err != nil {
+			return nil, grpcerr.FromError(err, "部門マスタの取得に失敗しました")
+		}
+		return &pb.Db_ListBumonMasterResponse{
+			Items:         convertItems(result.Items, convertBumonMasterToProto),
+			TotalCount:    totalCountPtr(result.TotalCount),
+			NextPageToken: result.NextPageToken,
+		}, nil
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 10
+	}
+	offset := int(req.Offset)
+
+	bumonList, totalCount, err := s.repo.GetAll(ctx, limit, offset, sort)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "部門マスタの取得に失敗しました")
+	}
+
+	return &pb.Db_ListBumonMasterResponse{
+		Items:      convertItems(bumonList, convertBumonMasterToProto),
+		TotalCount: int32Ptr(int32(totalCount)),
+	}, nil
+}
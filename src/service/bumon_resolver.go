@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"log"
+
+	"github.com/yhonda-ohishi/db_service/src/repository"
+)
+
+// BumonResolver 部門コードから部門名（一番星の部門マスタの部門N）を解決
+// 本番DB（cars.bumon_code_id, drivers.bumon）と一番星（受注部門, 稼動部門）の部門コードを同じ部門マスタで解決する
+type BumonResolver struct {
+	repo repository.BumonMasterRepository
+	// available SQL Serverが使用可能か（nilの場合は常に使用可能とする）
+	available func() bool
+}
+
+// BumonResolverOption BumonResolverのオプション
+type BumonResolverOption func(*BumonResolver)
+
+// WithBumonAvailability SQL Serverが使用可能かを確認する関数を指定するオプション
+// 部門名は補足情報のため、使用できない間は部門マスタを参照せず部門名なしとする
+func WithBumonAvailability(available func() bool) BumonResolverOption {
+	return func(r *BumonResolver) {
+		r.available = available
+	}
+}
+
+// NewBumonResolver コンストラクタ
+func NewBumonResolver(repo repository.BumonMasterRepository, opts ...BumonResolverOption) *BumonResolver {
+	r := &BumonResolver{
+		repo: repo,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// bumonNames 正規化した部門コードごとの部門名
+type bumonNames map[string]string
+
+// lookup 部門コードの部門名（部門マスタにない場合はnil）
+func (n bumonNames) lookup(code string) *string {
+	name, ok := n[repository.NormalizeBumonC(code)]
+	if !ok {
+		return nil
+	}
+	return &name
+}
+
+// Names 部門コードの部門名を取得
+// リゾルバがnil（SQL Server未設定）の場合、SQL Serverが使用できない場合、部門マスタの取得に失敗した場合は部門名なしとする
+func (r *BumonResolver) Names(ctx context.Context, codes ...string) bumonNames {
+	if r == nil || (r.available != nil && !r.available()) {
+		return nil
+	}
+
+	seen := make(map[string]bool, len(codes))
+	unique := make([]string, 0, len(codes))
+	for _, c := range codes {
+		c = repository.NormalizeBumonC(c)
+		if c == "" || seen[c] {
+			continue
+		}
+		seen[c] = true
+		unique = append(unique, c)
+	}
+	if len(unique) == 0 {
+		return nil
+	}
+
+	bumon, err := r.repo.GetByBumonCs(ctx, unique)
+	if err != nil {
+		log.Printf("Warning: failed to resolve bumon names: %v", err)
+		return nil
+	}
+	names := make(bumonNames, len(bumon))
+	for _, b := range bumon {
+		if b.BumonN != nil {
+			names[repository.NormalizeBumonC(b.BumonC)] = *b.BumonN
+		}
+	}
+	return names
+}
@@ -3,31 +3,33 @@ package service
 import (
 	"context"
 
+	"github.com/yhonda-ohishi/db_service/src/grpcerr"
 	"github.com/yhonda-ohishi/db_service/src/models/mysql"
 	"github.com/yhonda-ohishi/db_service/src/proto"
 	"github.com/yhonda-ohishi/db_service/src/repository"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 // CarsService gRPCサービス実装（本番DB、読み取り専用）
 type CarsService struct {
 	proto.UnimplementedDb_CarsServiceServer
-	repo repository.CarsRepository
+	repo   repository.CarsRepository
+	bumons *BumonResolver
 }
 
 // NewCarsService サービスのコンストラクタ
-func NewCarsService(repo repository.CarsRepository) *CarsService {
+// bumonsがnilの場合は部門名を設定しない
+func NewCarsService(repo repository.CarsRepository, bumons *BumonResolver) *CarsService {
 	return &CarsService{
-		repo: repo,
+		repo:   repo,
+		bumons: bumons,
 	}
 }
 
 // Get 車両情報取得
 func (s *CarsService) Get(ctx context.Context, req *proto.Db_GetCarsRequest) (*proto.Db_CarsResponse, error) {
-	car, err := s.repo.GetByID(req.Id)
+	car, err := s.repo.GetByID(ctx, req.Id)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "car not found: %v", err)
+		return nil, grpcerr.FromError(err, "failed to get car")
 	}
 
 	return &proto.Db_CarsResponse{
@@ -37,6 +39,29 @@ func (s *CarsService) Get(ctx context.Context, req *proto.Db_GetCarsRequest) (*p
 
 // List 車両情報一覧取得
 func (s *CarsService) List(ctx context.Context, req *proto.Db_ListCarsRequest) (*proto.Db_ListCarsResponse, error) {
+	// ソート条件（sort / 非推奨のorder_by）
+	sort, err := sortFields(req.Sort, req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	// キーセットページネーション（page_token指定時）
+	if req.PageToken != nil {
+		page, err := keysetPageRequest(req.Limit, req.Offset, sort, *req.PageToken, req.IncludeTotalCount)
+		if err != nil {
+			return nil, err
+		}
+		result, err := s.repo.GetPage(ctx, page)
+		if err != nil {
+			return nil, grpcerr.FromError(err, "failed to list cars")
+		}
+		return &proto.Db_ListCarsResponse{
+			Items:         convertItems(result.Items, carsModelToProto),
+			TotalCount:    totalCountPtr(result.TotalCount),
+			NextPageToken: result.NextPageToken,
+		}, nil
+	}
+
 	limit := int(req.Limit)
 	offset := int(req.Offset)
 
@@ -44,15 +69,9 @@ func (s *CarsService) List(ctx context.Context, req *proto.Db_ListCarsRequest) (
 		limit = 100
 	}
 
-	// order_byパラメータを取得（nilの場合は空文字列）
-	orderBy := ""
-	if req.OrderBy != nil {
-		orderBy = *req.OrderBy
-	}
-
-	cars, totalCount, err := s.repo.GetAll(limit, offset, orderBy)
+	cars, totalCount, err := s.repo.GetAll(ctx, limit, offset, sort)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list cars: %v", err)
+		return nil, grpcerr.FromError(err, "failed to list cars")
 	}
 
 	items := make([]*proto.Db_Cars, len(cars))
@@ -62,25 +81,30 @@ func (s *CarsService) List(ctx context.Context, req *proto.Db_ListCarsRequest) (
 
 	return &proto.Db_ListCarsResponse{
 		Items:      items,
-		TotalCount: int32(totalCount),
+		TotalCount: int32Ptr(int32(totalCount)),
 	}, nil
 }
 
-// GetByBumonCodeID 部門コードで車両情報取得
+// GetByBumonCodeID 部門コードで車両情報取得（部門名を設定）
 func (s *CarsService) GetByBumonCodeID(ctx context.Context, req *proto.Db_GetCarsByBumonCodeIDRequest) (*proto.Db_ListCarsResponse, error) {
-	cars, err := s.repo.GetByBumonCodeID(req.BumonCodeId)
+	bumon := repository.NormalizeBumonC(req.BumonCodeId)
+	cars, err := s.repo.GetByBumonCodeID(ctx, bumon)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get cars by bumon_code_id: %v", err)
+		return nil, grpcerr.FromError(err, "failed to get cars by bumon_code_id")
 	}
 
+	names := s.bumons.Names(ctx, bumon)
 	items := make([]*proto.Db_Cars, len(cars))
 	for i, car := range cars {
 		items[i] = carsModelToProto(car)
+		if car.BumonCodeID != nil {
+			items[i].BumonName = names.lookup(*car.BumonCodeID)
+		}
 	}
 
 	return &proto.Db_ListCarsResponse{
 		Items:      items,
-		TotalCount: int32(len(cars)),
+		TotalCount: int32Ptr(int32(len(cars))),
 	}, nil
 }
 
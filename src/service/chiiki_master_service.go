@@ -3,10 +3,9 @@ package service
 import (
 	"context"
 
+	"github.com/yhonda-ohishi/db_service/src/grpcerr"
 	pb "github.com/yhonda-ohishi/db_service/src/proto"
 	"github.com/yhonda-ohishi/db_service/src/repository"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 // ChiikiMasterService 地域マスタサービス
@@ -24,9 +23,9 @@ func NewChiikiMasterService(repo repository.ChiikiMasterRepository) *ChiikiMaste
 
 // Get 単一の地域マスタを取得
 func (s *ChiikiMasterService) Get(ctx context.Context, req *pb.Db_GetChiikiMasterRequest) (*pb.Db_ChiikiMasterResponse, error) {
-	chiiki, err := s.repo.GetByChiikiC(req.ChiikiC)
+	chiiki, err := s.repo.GetByChiikiC(ctx, req.ChiikiC)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "地域マスタが見つかりません: %v", err)
+		return nil, grpcerr.FromError(err, "地域マスタの取得に失敗しました")
 	}
 
 	return &pb.Db_ChiikiMasterResponse{
@@ -36,20 +35,38 @@ func (s *ChiikiMasterService) Get(ctx context.Context, req *pb.Db_GetChiikiMaste
 
 // List 地域マスタのリストを取得
 func (s *ChiikiMasterService) List(ctx context.Context, req *pb.Db_ListChiikiMasterRequest) (*pb.Db_ListChiikiMasterResponse, error) {
+	// ソート条件（sort / 非推奨のorder_by）
+	sort, err := sortFields(req.Sort, req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	// キーセットページネーション（page_token指定時）
+	if req.PageToken != nil {
+		page, err := keysetPageRequest(req.Limit, req.Offset, sort, *req.PageToken, req.IncludeTotalCount)
+		if err != nil {
+			return nil, err
+		}
+		result, err := s.repo.GetPage(ctx, page)
+		if err != nil {
+			return nil, grpcerr.FromError(err, "地域マスタの取得に失敗しました")
+		}
+		return &pb.Db_ListChiikiMasterResponse{
+			Items:         convertItems(result.Items, convertChiikiMasterToProto),
+			TotalCount:    totalCountPtr(result.TotalCount),
+			NextPageToken: result.NextPageToken,
+		}, nil
+	}
+
 	limit := int(req.Limit)
 	if limit <= 0 {
 		limit = 10
 	}
 	offset := int(req.Offset)
 
-	orderBy := ""
-	if req.OrderBy != nil {
-		orderBy = *req.OrderBy
-	}
-
-	chiikiList, totalCount, err := s.repo.GetAll(limit, offset, orderBy)
+	chiikiList, totalCount, err := s.repo.GetAll(ctx, limit, offset, sort)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "地域マスタの取得に失敗しました: %v", err)
+		return nil, grpcerr.FromError(err, "地域マスタの取得に失敗しました")
 	}
 
 	pbChiikiList := make([]*pb.Db_ChiikiMaster, len(chiikiList))
@@ -59,6 +76,6 @@ func (s *ChiikiMasterService) List(ctx context.Context, req *pb.Db_ListChiikiMas
 
 	return &pb.Db_ListChiikiMasterResponse{
 		Items:      pbChiikiList,
-		TotalCount: int32(totalCount),
+		TotalCount: int32Ptr(int32(totalCount)),
 	}, nil
 }
@@ -3,10 +3,9 @@ package service
 import (
 	"context"
 
+	"github.com/yhonda-ohishi/db_service/src/grpcerr"
 	pb "github.com/yhonda-ohishi/db_service/src/proto"
 	"github.com/yhonda-ohishi/db_service/src/repository"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 // ChikuMasterService 地区マスタサービス
@@ -24,9 +23,9 @@ func NewChikuMasterService(repo repository.ChikuMasterRepository) *ChikuMasterSe
 
 // Get 単一の地区マスタを取得
 func (s *ChikuMasterService) Get(ctx context.Context, req *pb.Db_GetChikuMasterRequest) (*pb.Db_ChikuMasterResponse, error) {
-	chiku, err := s.repo.GetByChikuC(req.ChikuC)
+	chiku, err := s.repo.GetByChikuC(ctx, req.ChikuC)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "地区マスタが見つかりません: %v", err)
+		return nil, grpcerr.FromError(err, "地区マスタの取得に失敗しました")
 	}
 
 	return &pb.Db_ChikuMasterResponse{
@@ -36,20 +35,38 @@ func (s *ChikuMasterService) Get(ctx context.Context, req *pb.Db_GetChikuMasterR
 
 // List 地区マスタのリストを取得
 func (s *ChikuMasterService) List(ctx context.Context, req *pb.Db_ListChikuMasterRequest) (*pb.Db_ListChikuMasterResponse, error) {
+	// ソート条件（sort / 非推奨のorder_by）
+	sort, err := sortFields(req.Sort, req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	// キーセットページネーション（page_token指定時）
+	if req.PageToken != nil {
+		page, err := keysetPageRequest(req.Limit, req.Offset, sort, *req.PageToken, req.IncludeTotalCount)
+		if err != nil {
+			return nil, err
+		}
+		result, err := s.repo.GetPage(ctx, page)
+		if err != nil {
+			return nil, grpcerr.FromError(err, "地区マスタの取得に失敗しました")
+		}
+		return &pb.Db_ListChikuMasterResponse{
+			Items:         convertItems(result.Items, convertChikuMasterToProto),
+			TotalCount:    totalCountPtr(result.TotalCount),
+			NextPageToken: result.NextPageToken,
+		}, nil
+	}
+
 	limit := int(req.Limit)
 	if limit <= 0 {
 		limit = 10
 	}
 	offset := int(req.Offset)
 
-	orderBy := ""
-	if req.OrderBy != nil {
-		orderBy = *req.OrderBy
-	}
-
-	chikuList, totalCount, err := s.repo.GetAll(limit, offset, orderBy)
+	chikuList, totalCount, err := s.repo.GetAll(ctx, limit, offset, sort)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "地区マスタの取得に失敗しました: %v", err)
+		return nil, grpcerr.FromError(err, "地区マスタの取得に失敗しました")
 	}
 
 	pbChikuList := make([]*pb.Db_ChikuMaster, len(chikuList))
@@ -59,15 +76,15 @@ func (s *ChikuMasterService) List(ctx context.Context, req *pb.Db_ListChikuMaste
 
 	return &pb.Db_ListChikuMasterResponse{
 		Items:      pbChikuList,
-		TotalCount: int32(totalCount),
+		TotalCount: int32Ptr(int32(totalCount)),
 	}, nil
 }
 
 // GetByChiikiC 地域Cで地区マスタを取得
 func (s *ChikuMasterService) GetByChiikiC(ctx context.Context, req *pb.Db_GetChikuMasterByChiikiCRequest) (*pb.Db_ListChikuMasterResponse, error) {
-	chikuList, err := s.repo.GetByChiikiC(req.ChiikiC)
+	chikuList, err := s.repo.GetByChiikiC(ctx, req.ChiikiC)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "地域Cでの地区マスタの取得に失敗しました: %v", err)
+		return nil, grpcerr.FromError(err, "地域Cでの地区マスタの取得に失敗しました")
 	}
 
 	pbChikuList := make([]*pb.Db_ChikuMaster, len(chikuList))
@@ -77,6 +94,6 @@ func (s *ChikuMasterService) GetByChiikiC(ctx context.Context, req *pb.Db_GetChi
 
 	return &pb.Db_ListChikuMasterResponse{
 		Items:      pbChikuList,
-		TotalCount: int32(len(chikuList)),
+		TotalCount: int32Ptr(int32(len(chikuList))),
 	}, nil
 }
@@ -3,31 +3,33 @@ package service
 import (
 	"context"
 
+	"github.com/yhonda-ohishi/db_service/src/grpcerr"
 	"github.com/yhonda-ohishi/db_service/src/models/mysql"
 	"github.com/yhonda-ohishi/db_service/src/proto"
 	"github.com/yhonda-ohishi/db_service/src/repository"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 // DriversService gRPCサービス実装（本番DB、読み取り専用）
 type DriversService struct {
 	proto.UnimplementedDb_DriversServiceServer
-	repo repository.DriversRepository
+	repo   repository.DriversRepository
+	bumons *BumonResolver
 }
 
 // NewDriversService サービスのコンストラクタ
-func NewDriversService(repo repository.DriversRepository) *DriversService {
+// bumonsがnilの場合は部門名を設定しない
+func NewDriversService(repo repository.DriversRepository, bumons *BumonResolver) *DriversService {
 	return &DriversService{
-		repo: repo,
+		repo:   repo,
+		bumons: bumons,
 	}
 }
 
 // Get ドライバー情報取得
 func (s *DriversService) Get(ctx context.Context, req *proto.Db_GetDriversRequest) (*proto.Db_DriversResponse, error) {
-	driver, err := s.repo.GetByID(int(req.Id))
+	driver, err := s.repo.GetByID(ctx, int(req.Id))
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "driver not found: %v", err)
+		return nil, grpcerr.FromError(err, "failed to get driver")
 	}
 
 	return &proto.Db_DriversResponse{
@@ -37,6 +39,29 @@ func (s *DriversService) Get(ctx context.Context, req *proto.Db_GetDriversReques
 
 // List ドライバー情報一覧取得
 func (s *DriversService) List(ctx context.Context, req *proto.Db_ListDriversRequest) (*proto.Db_ListDriversResponse, error) {
+	// ソート条件（sort / 非推奨のorder_by）
+	sort, err := sortFields(req.Sort, req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	// キーセットページネーション（page_token指定時）
+	if req.PageToken != nil {
+		page, err := keysetPageRequest(req.Limit, req.Offset, sort, *req.PageToken, req.IncludeTotalCount)
+		if err != nil {
+			return nil, err
+		}
+		result, err := s.repo.GetPage(ctx, page)
+		if err != nil {
+			return nil, grpcerr.FromError(err, "failed to list drivers")
+		}
+		return &proto.Db_ListDriversResponse{
+			Items:         convertItems(result.Items, driversModelToProto),
+			TotalCount:    totalCountPtr(result.TotalCount),
+			NextPageToken: result.NextPageToken,
+		}, nil
+	}
+
 	limit := int(req.Limit)
 	offset := int(req.Offset)
 
@@ -44,15 +69,9 @@ func (s *DriversService) List(ctx context.Context, req *proto.Db_ListDriversRequ
 		limit = 100
 	}
 
-	// order_byパラメータを取得（nilの場合は空文字列）
-	orderBy := ""
-	if req.OrderBy != nil {
-		orderBy = *req.OrderBy
-	}
-
-	drivers, totalCount, err := s.repo.GetAll(limit, offset, orderBy)
+	drivers, totalCount, err := s.repo.GetAll(ctx, limit, offset, sort)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list drivers: %v", err)
+		return nil, grpcerr.FromError(err, "failed to list drivers")
 	}
 
 	items := make([]*proto.Db_Drivers, len(drivers))
@@ -62,25 +81,28 @@ func (s *DriversService) List(ctx context.Context, req *proto.Db_ListDriversRequ
 
 	return &proto.Db_ListDriversResponse{
 		Items:      items,
-		TotalCount: int32(totalCount),
+		TotalCount: int32Ptr(int32(totalCount)),
 	}, nil
 }
 
-// GetByBumon 部門コードでドライバー情報取得
+// GetByBumon 部門コードでドライバー情報取得（部門名を設定）
 func (s *DriversService) GetByBumon(ctx context.Context, req *proto.Db_GetDriversByBumonRequest) (*proto.Db_ListDriversResponse, error) {
-	drivers, err := s.repo.GetByBumon(req.Bumon)
+	bumon := repository.NormalizeBumonC(req.Bumon)
+	drivers, err := s.repo.GetByBumon(ctx, bumon)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get drivers by bumon: %v", err)
+		return nil, grpcerr.FromError(err, "failed to get drivers by bumon")
 	}
 
+	names := s.bumons.Names(ctx, bumon)
 	items := make([]*proto.Db_Drivers, len(drivers))
 	for i, driver := range drivers {
 		items[i] = driversModelToProto(driver)
+		items[i].BumonName = names.lookup(driver.Bumon)
 	}
 
 	return &proto.Db_ListDriversResponse{
 		Items:      items,
-		TotalCount: int32(len(drivers)),
+		TotalCount: int32Ptr(int32(len(drivers))),
 	}, nil
 }
 
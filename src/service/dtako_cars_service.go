@@ -3,11 +3,10 @@ package service
 import (
 	"context"
 
+	"github.com/yhonda-ohishi/db_service/src/grpcerr"
 	"github.com/yhonda-ohishi/db_service/src/models/mysql"
 	"github.com/yhonda-ohishi/db_service/src/proto"
 	"github.com/yhonda-ohishi/db_service/src/repository"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 // DTakoCarsService gRPCサービス実装（本番DB、読み取り専用）
@@ -25,9 +24,9 @@ func NewDTakoCarsService(repo repository.DTakoCarsRepository) *DTakoCarsService
 
 // Get 車輌情報取得
 func (s *DTakoCarsService) Get(ctx context.Context, req *proto.Db_GetDTakoCarsRequest) (*proto.Db_DTakoCarsResponse, error) {
-	car, err := s.repo.GetByID(int(req.Id))
+	car, err := s.repo.GetByID(ctx, int(req.Id))
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "car not found: %v", err)
+		return nil, grpcerr.FromError(err, "failed to get car")
 	}
 
 	return &proto.Db_DTakoCarsResponse{
@@ -37,6 +36,23 @@ func (s *DTakoCarsService) Get(ctx context.Context, req *proto.Db_GetDTakoCarsRe
 
 // List 車輌情報一覧取得
 func (s *DTakoCarsService) List(ctx context.Context, req *proto.Db_ListDTakoCarsRequest) (*proto.Db_ListDTakoCarsResponse, error) {
+	// キーセットページネーション（page_token指定時）
+	if req.PageToken != nil {
+		page, err := keysetPageRequest(req.Limit, req.Offset, nil, *req.PageToken, req.IncludeTotalCount)
+		if err != nil {
+			return nil, err
+		}
+		result, err := s.repo.GetPage(ctx, page)
+		if err != nil {
+			return nil, grpcerr.FromError(err, "failed to list cars")
+		}
+		return &proto.Db_ListDTakoCarsResponse{
+			Items:         convertItems(result.Items, dtakoCarsModelToProto),
+			TotalCount:    totalCountPtr(result.TotalCount),
+			NextPageToken: result.NextPageToken,
+		}, nil
+	}
+
 	limit := int(req.Limit)
 	offset := int(req.Offset)
 
@@ -44,9 +60,9 @@ func (s *DTakoCarsService) List(ctx context.Context, req *proto.Db_ListDTakoCars
 		limit = 100
 	}
 
-	cars, totalCount, err := s.repo.GetAll(limit, offset)
+	cars, totalCount, err := s.repo.GetAll(ctx, limit, offset)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list cars: %v", err)
+		return nil, grpcerr.FromError(err, "failed to list cars")
 	}
 
 	items := make([]*proto.Db_DTakoCars, len(cars))
@@ -56,15 +72,15 @@ func (s *DTakoCarsService) List(ctx context.Context, req *proto.Db_ListDTakoCars
 
 	return &proto.Db_ListDTakoCarsResponse{
 		Items:      items,
-		TotalCount: int32(totalCount),
+		TotalCount: int32Ptr(int32(totalCount)),
 	}, nil
 }
 
 // GetByCarCode 車輌CDで車輌情報取得
 func (s *DTakoCarsService) GetByCarCode(ctx context.Context, req *proto.Db_GetDTakoCarsByCarCodeRequest) (*proto.Db_DTakoCarsResponse, error) {
-	car, err := s.repo.GetByCarCode(req.CarCode)
+	car, err := s.repo.GetByCarCode(ctx, req.CarCode)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "car not found: %v", err)
+		return nil, grpcerr.FromError(err, "failed to get car")
 	}
 
 	return &proto.Db_DTakoCarsResponse{
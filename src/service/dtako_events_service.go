@@ -4,11 +4,10 @@ import (
 	"context"
 	"time"
 
+	"github.com/yhonda-ohishi/db_service/src/grpcerr"
 	"github.com/yhonda-ohishi/db_service/src/models/mysql"
 	"github.com/yhonda-ohishi/db_service/src/proto"
 	"github.com/yhonda-ohishi/db_service/src/repository"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 // DTakoEventsService gRPCサービス実装（本番DB、読み取り専用）
@@ -26,9 +25,9 @@ func NewDTakoEventsService(repo repository.DTakoEventsRepository) *DTakoEventsSe
 
 // Get イベント情報取得
 func (s *DTakoEventsService) Get(ctx context.Context, req *proto.Db_GetDTakoEventsRequest) (*proto.Db_DTakoEventsResponse, error) {
-	event, err := s.repo.GetByID(req.Id)
+	event, err := s.repo.GetByID(ctx, req.Id)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "event not found: %v", err)
+		return nil, grpcerr.FromError(err, "failed to get event")
 	}
 
 	return &proto.Db_DTakoEventsResponse{
@@ -38,6 +37,29 @@ func (s *DTakoEventsService) Get(ctx context.Context, req *proto.Db_GetDTakoEven
 
 // List イベント情報一覧取得
 func (s *DTakoEventsService) List(ctx context.Context, req *proto.Db_ListDTakoEventsRequest) (*proto.Db_ListDTakoEventsResponse, error) {
+	// ソート条件（sort / 非推奨のorder_by）
+	sort, err := sortFields(req.Sort, req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	// キーセットページネーション（page_token指定時）
+	if req.PageToken != nil {
+		page, err := keysetPageRequest(req.Limit, req.Offset, sort, *req.PageToken, req.IncludeTotalCount)
+		if err != nil {
+			return nil, err
+		}
+		result, err := s.repo.GetPage(ctx, page)
+		if err != nil {
+			return nil, grpcerr.FromError(err, "failed to list events")
+		}
+		return &proto.Db_ListDTakoEventsResponse{
+			Items:         convertItems(result.Items, dtakoEventsModelToProto),
+			TotalCount:    totalCountPtr(result.TotalCount),
+			NextPageToken: result.NextPageToken,
+		}, nil
+	}
+
 	limit := int(req.Limit)
 	offset := int(req.Offset)
 
@@ -45,15 +67,9 @@ func (s *DTakoEventsService) List(ctx context.Context, req *proto.Db_ListDTakoEv
 		limit = 100
 	}
 
-	// order_byパラメータを取得（nilの場合は空文字列）
-	orderBy := ""
-	if req.OrderBy != nil {
-		orderBy = *req.OrderBy
-	}
-
-	events, totalCount, err := s.repo.GetAll(limit, offset, orderBy)
+	events, totalCount, err := s.repo.GetAll(ctx, limit, offset, sort)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list events: %v", err)
+		return nil, grpcerr.FromError(err, "failed to list events")
 	}
 
 	items := make([]*proto.Db_DTakoEvents, len(events))
@@ -63,10 +79,34 @@ func (s *DTakoEventsService) List(ctx context.Context, req *proto.Db_ListDTakoEv
 
 	return &proto.Db_ListDTakoEventsResponse{
 		Items:      items,
-		TotalCount: int32(totalCount),
+		TotalCount: int32Ptr(int32(totalCount)),
 	}, nil
 }
 
+// Stream 期間内のイベント情報をストリーミングで取得（開始日時の古い順）
+func (s *DTakoEventsService) Stream(req *proto.Db_StreamDTakoEventsRequest, stream proto.Db_DTakoEventsService_StreamServer) error {
+	// RFC3339形式の時刻文字列をパース
+	var startTime, endTime *time.Time
+	if req.StartTime != "" {
+		t, err := time.Parse(time.RFC3339, req.StartTime)
+		if err != nil {
+			return grpcerr.InvalidArgument("start_time", "invalid start_time format: %v", err)
+		}
+		startTime = &t
+	}
+	if req.EndTime != "" {
+		t, err := time.Parse(time.RFC3339, req.EndTime)
+		if err != nil {
+			return grpcerr.InvalidArgument("end_time", "invalid end_time format: %v", err)
+		}
+		endTime = &t
+	}
+
+	err := s.repo.StreamByDateRange(stream.Context(), startTime, endTime, int(req.BatchSize),
+		sendItems(stream, dtakoEventsModelToProto))
+	return grpcerr.FromError(err, "failed to stream events")
+}
+
 // GetByOperationNo 運行NOでイベント情報取得
 func (s *DTakoEventsService) GetByOperationNo(ctx context.Context, req *proto.Db_GetDTakoEventsByOperationNoRequest) (*proto.Db_ListDTakoEventsResponse, error) {
 	// RFC3339形式の時刻文字列をパース
@@ -74,21 +114,21 @@ func (s *DTakoEventsService) GetByOperationNo(ctx context.Context, req *proto.Db
 	if req.StartTime != "" {
 		t, err := time.Parse(time.RFC3339, req.StartTime)
 		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid start_time format: %v", err)
+			return nil, grpcerr.InvalidArgument("start_time", "invalid start_time format: %v", err)
 		}
 		startTime = &t
 	}
 	if req.EndTime != "" {
 		t, err := time.Parse(time.RFC3339, req.EndTime)
 		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid end_time format: %v", err)
+			return nil, grpcerr.InvalidArgument("end_time", "invalid end_time format: %v", err)
 		}
 		endTime = &t
 	}
 
-	events, err := s.repo.GetByOperationNo(req.OperationNo, req.EventTypes, startTime, endTime)
+	events, err := s.repo.GetByOperationNo(ctx, req.OperationNo, req.EventTypes, startTime, endTime)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get events by operation_no: %v", err)
+		return nil, grpcerr.FromError(err, "failed to get events by operation_no")
 	}
 
 	items := make([]*proto.Db_DTakoEvents, len(events))
@@ -98,7 +138,7 @@ func (s *DTakoEventsService) GetByOperationNo(ctx context.Context, req *proto.Db
 
 	return &proto.Db_ListDTakoEventsResponse{
 		Items:      items,
-		TotalCount: int32(len(events)),
+		TotalCount: int32Ptr(int32(len(events))),
 	}, nil
 }
 
@@ -3,11 +3,10 @@ package service
 import (
 	"context"
 
+	"github.com/yhonda-ohishi/db_service/src/grpcerr"
 	"github.com/yhonda-ohishi/db_service/src/models/mysql"
 	"github.com/yhonda-ohishi/db_service/src/proto"
 	"github.com/yhonda-ohishi/db_service/src/repository"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 // DTakoFerryRowsProdService gRPCサービス実装（本番DB、読み取り専用）
@@ -25,9 +24,9 @@ func NewDTakoFerryRowsProdService(repo repository.DTakoFerryRowsProdRepository)
 
 // Get フェリー運行データ取得
 func (s *DTakoFerryRowsProdService) Get(ctx context.Context, req *proto.Db_GetDTakoFerryRowsProdRequest) (*proto.Db_DTakoFerryRowsProdResponse, error) {
-	row, err := s.repo.GetByID(req.Id)
+	row, err := s.repo.GetByID(ctx, req.Id)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "ferry row not found: %v", err)
+		return nil, grpcerr.FromError(err, "failed to get ferry row")
 	}
 
 	return &proto.Db_DTakoFerryRowsProdResponse{
@@ -37,6 +36,23 @@ func (s *DTakoFerryRowsProdService) Get(ctx context.Context, req *proto.Db_GetDT
 
 // List フェリー運行データ一覧取得
 func (s *DTakoFerryRowsProdService) List(ctx context.Context, req *proto.Db_ListDTakoFerryRowsProdRequest) (*proto.Db_ListDTakoFerryRowsProdResponse, error) {
+	// キーセットページネーション（page_token指定時）
+	if req.PageToken != nil {
+		page, err := keysetPageRequest(req.Limit, req.Offset, nil, *req.PageToken, req.IncludeTotalCount)
+		if err != nil {
+			return nil, err
+		}
+		result, err := s.repo.GetPage(ctx, page)
+		if err != nil {
+			return nil, grpcerr.FromError(err, "failed to list ferry rows")
+		}
+		return &proto.Db_ListDTakoFerryRowsProdResponse{
+			Items:         convertItems(result.Items, dtakoFerryRowsProdModelToProto),
+			TotalCount:    totalCountPtr(result.TotalCount),
+			NextPageToken: result.NextPageToken,
+		}, nil
+	}
+
 	limit := int(req.Limit)
 	offset := int(req.Offset)
 
@@ -44,9 +60,9 @@ func (s *DTakoFerryRowsProdService) List(ctx context.Context, req *proto.Db_List
 		limit = 100
 	}
 
-	rows, totalCount, err := s.repo.GetAll(limit, offset)
+	rows, totalCount, err := s.repo.GetAll(ctx, limit, offset)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list ferry rows: %v", err)
+		return nil, grpcerr.FromError(err, "failed to list ferry rows")
 	}
 
 	items := make([]*proto.Db_DTakoFerryRowsProd, len(rows))
@@ -56,15 +72,15 @@ func (s *DTakoFerryRowsProdService) List(ctx context.Context, req *proto.Db_List
 
 	return &proto.Db_ListDTakoFerryRowsProdResponse{
 		Items:      items,
-		TotalCount: int32(totalCount),
+		TotalCount: int32Ptr(int32(totalCount)),
 	}, nil
 }
 
 // GetByUnkoNo 運行NOでフェリー運行データ取得
 func (s *DTakoFerryRowsProdService) GetByUnkoNo(ctx context.Context, req *proto.Db_GetDTakoFerryRowsProdByUnkoNoRequest) (*proto.Db_ListDTakoFerryRowsProdResponse, error) {
-	rows, err := s.repo.GetByUnkoNo(req.UnkoNo)
+	rows, err := s.repo.GetByUnkoNo(ctx, req.UnkoNo)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get ferry rows by unko_no: %v", err)
+		return nil, grpcerr.FromError(err, "failed to get ferry rows by unko_no")
 	}
 
 	items := make([]*proto.Db_DTakoFerryRowsProd, len(rows))
@@ -74,7 +90,7 @@ func (s *DTakoFerryRowsProdService) GetByUnkoNo(ctx context.Context, req *proto.
 
 	return &proto.Db_ListDTakoFerryRowsProdResponse{
 		Items:      items,
-		TotalCount: int32(len(rows)),
+		TotalCount: int32Ptr(int32(len(rows))),
 	}, nil
 }
 
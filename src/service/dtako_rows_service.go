@@ -2,12 +2,12 @@ package service
 
 import (
 	"context"
+	"time"
 
+	"github.com/yhonda-ohishi/db_service/src/grpcerr"
 	"github.com/yhonda-ohishi/db_service/src/models/mysql"
 	"github.com/yhonda-ohishi/db_service/src/proto"
 	"github.com/yhonda-ohishi/db_service/src/repository"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 // DTakoRowsService gRPCサービス実装（本番DB、読み取り専用）
@@ -25,9 +25,9 @@ func NewDTakoRowsService(repo repository.DTakoRowsRepository) *DTakoRowsService
 
 // Get 運行データ取得
 func (s *DTakoRowsService) Get(ctx context.Context, req *proto.Db_GetDTakoRowsRequest) (*proto.Db_DTakoRowsResponse, error) {
-	row, err := s.repo.GetByID(req.Id)
+	row, err := s.repo.GetByID(ctx, req.Id)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "row not found: %v", err)
+		return nil, grpcerr.FromError(err, "failed to get row")
 	}
 
 	return &proto.Db_DTakoRowsResponse{
@@ -37,6 +37,29 @@ func (s *DTakoRowsService) Get(ctx context.Context, req *proto.Db_GetDTakoRowsRe
 
 // List 運行データ一覧取得
 func (s *DTakoRowsService) List(ctx context.Context, req *proto.Db_ListDTakoRowsRequest) (*proto.Db_ListDTakoRowsResponse, error) {
+	// ソート条件（sort / 非推奨のorder_by）
+	sort, err := sortFields(req.Sort, req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	// キーセットページネーション（page_token指定時）
+	if req.PageToken != nil {
+		page, err := keysetPageRequest(req.Limit, req.Offset, sort, *req.PageToken, req.IncludeTotalCount)
+		if err != nil {
+			return nil, err
+		}
+		result, err := s.repo.GetPage(ctx, page)
+		if err != nil {
+			return nil, grpcerr.FromError(err, "failed to list rows")
+		}
+		return &proto.Db_ListDTakoRowsResponse{
+			Items:         convertItems(result.Items, dtakoRowsModelToProto),
+			TotalCount:    totalCountPtr(result.TotalCount),
+			NextPageToken: result.NextPageToken,
+		}, nil
+	}
+
 	limit := int(req.Limit)
 	offset := int(req.Offset)
 
@@ -44,15 +67,9 @@ func (s *DTakoRowsService) List(ctx context.Context, req *proto.Db_ListDTakoRows
 		limit = 100
 	}
 
-	// order_byパラメータを取得（nilの場合は空文字列）
-	orderBy := ""
-	if req.OrderBy != nil {
-		orderBy = *req.OrderBy
-	}
-
-	rows, totalCount, err := s.repo.GetAll(limit, offset, orderBy)
+	rows, totalCount, err := s.repo.GetAll(ctx, limit, offset, sort)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list rows: %v", err)
+		return nil, grpcerr.FromError(err, "failed to list rows")
 	}
 
 	items := make([]*proto.Db_DTakoRows, len(rows))
@@ -62,15 +79,39 @@ func (s *DTakoRowsService) List(ctx context.Context, req *proto.Db_ListDTakoRows
 
 	return &proto.Db_ListDTakoRowsResponse{
 		Items:      items,
-		TotalCount: int32(totalCount),
+		TotalCount: int32Ptr(int32(totalCount)),
 	}, nil
 }
 
+// Stream 期間内の運行データをストリーミングで取得（読取日の古い順）
+func (s *DTakoRowsService) Stream(req *proto.Db_StreamDTakoRowsRequest, stream proto.Db_DTakoRowsService_StreamServer) error {
+	// YYYY-MM-DD形式の日付文字列をパース
+	var startDate, endDate *time.Time
+	if req.StartDate != "" {
+		t, err := time.Parse("2006-01-02", req.StartDate)
+		if err != nil {
+			return grpcerr.InvalidArgument("start_date", "invalid start_date format: %v", err)
+		}
+		startDate = &t
+	}
+	if req.EndDate != "" {
+		t, err := time.Parse("2006-01-02", req.EndDate)
+		if err != nil {
+			return grpcerr.InvalidArgument("end_date", "invalid end_date format: %v", err)
+		}
+		endDate = &t
+	}
+
+	err := s.repo.StreamByDateRange(stream.Context(), startDate, endDate, int(req.BatchSize),
+		sendItems(stream, dtakoRowsModelToProto))
+	return grpcerr.FromError(err, "failed to stream rows")
+}
+
 // GetByOperationNo 運行NOで運行データ取得
 func (s *DTakoRowsService) GetByOperationNo(ctx context.Context, req *proto.Db_GetDTakoRowsByOperationNoRequest) (*proto.Db_ListDTakoRowsResponse, error) {
-	rows, err := s.repo.GetByOperationNo(req.OperationNo)
+	rows, err := s.repo.GetByOperationNo(ctx, req.OperationNo)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get rows by operation_no: %v", err)
+		return nil, grpcerr.FromError(err, "failed to get rows by operation_no")
 	}
 
 	items := make([]*proto.Db_DTakoRows, len(rows))
@@ -80,7 +121,7 @@ func (s *DTakoRowsService) GetByOperationNo(ctx context.Context, req *proto.Db_G
 
 	return &proto.Db_ListDTakoRowsResponse{
 		Items:      items,
-		TotalCount: int32(len(rows)),
+		TotalCount: int32Ptr(int32(len(rows))),
 	}, nil
 }
 
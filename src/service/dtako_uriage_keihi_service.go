@@ -4,11 +4,10 @@ import (
 	"context"
 	"time"
 
+	"github.com/yhonda-ohishi/db_service/src/grpcerr"
 	"github.com/yhonda-ohishi/db_service/src/models/mysql"
 	"github.com/yhonda-ohishi/db_service/src/proto"
 	"github.com/yhonda-ohishi/db_service/src/repository"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 // DTakoUriageKeihiService gRPCサービス実装
@@ -27,18 +26,18 @@ func NewDTakoUriageKeihiService(repo repository.DTakoUriageKeihiRepository) *DTa
 // Create 経費精算データ作成
 func (s *DTakoUriageKeihiService) Create(ctx context.Context, req *proto.Db_CreateDTakoUriageKeihiRequest) (*proto.Db_DTakoUriageKeihiResponse, error) {
 	if req.DtakoUriageKeihi == nil {
-		return nil, status.Error(codes.InvalidArgument, "dtako_uriage_keihi is required")
+		return nil, grpcerr.InvalidArgument("dtako_uriage_keihi", "dtako_uriage_keihi is required")
 	}
 
 	// ProtoからModelへ変換
 	model := protoToModel(req.DtakoUriageKeihi)
+	if err := model.Validate(); err != nil {
+		return nil, grpcerr.FromError(err, "invalid dtako_uriage_keihi")
+	}
 
 	// リポジトリで作成
-	if err := s.repo.Create(model); err != nil {
-		if err == mysql.ErrDuplicateKey {
-			return nil, status.Error(codes.AlreadyExists, "record already exists")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to create record: %v", err)
+	if err := s.repo.Create(ctx, model); err != nil {
+		return nil, grpcerr.FromError(err, "failed to create record")
 	}
 
 	// ModelからProtoへ変換して返却
@@ -50,23 +49,23 @@ func (s *DTakoUriageKeihiService) Create(ctx context.Context, req *proto.Db_Crea
 // Get 経費精算データ取得
 func (s *DTakoUriageKeihiService) Get(ctx context.Context, req *proto.Db_GetDTakoUriageKeihiRequest) (*proto.Db_DTakoUriageKeihiResponse, error) {
 	// バリデーション
-	if req.SrchId == "" || req.Datetime == "" {
-		return nil, status.Error(codes.InvalidArgument, "srch_id, datetime, and keihi_c are required")
+	if req.SrchId == "" {
+		return nil, grpcerr.InvalidArgument("srch_id", "srch_id is required")
+	}
+	if req.Datetime == "" {
+		return nil, grpcerr.InvalidArgument("datetime", "datetime is required")
 	}
 
 	// 日時パース
 	datetime, err := time.Parse(time.RFC3339, req.Datetime)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid datetime format: %v", err)
+		return nil, grpcerr.InvalidArgument("datetime", "invalid datetime format: %v", err)
 	}
 
 	// リポジトリから取得
-	model, err := s.repo.GetByCompositeKey(req.SrchId, datetime, req.KeihiC)
+	model, err := s.repo.GetByCompositeKey(ctx, req.SrchId, datetime, req.KeihiC)
 	if err != nil {
-		if err == mysql.ErrRecordNotFound {
-			return nil, status.Error(codes.NotFound, "record not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get record: %v", err)
+		return nil, grpcerr.FromError(err, "failed to get record")
 	}
 
 	return &proto.Db_DTakoUriageKeihiResponse{
@@ -77,18 +76,18 @@ func (s *DTakoUriageKeihiService) Get(ctx context.Context, req *proto.Db_GetDTak
 // Update 経費精算データ更新
 func (s *DTakoUriageKeihiService) Update(ctx context.Context, req *proto.Db_UpdateDTakoUriageKeihiRequest) (*proto.Db_DTakoUriageKeihiResponse, error) {
 	if req.DtakoUriageKeihi == nil {
-		return nil, status.Error(codes.InvalidArgument, "dtako_uriage_keihi is required")
+		return nil, grpcerr.InvalidArgument("dtako_uriage_keihi", "dtako_uriage_keihi is required")
 	}
 
 	// ProtoからModelへ変換
 	model := protoToModel(req.DtakoUriageKeihi)
+	if err := model.Validate(); err != nil {
+		return nil, grpcerr.FromError(err, "invalid dtako_uriage_keihi")
+	}
 
 	// リポジトリで更新
-	if err := s.repo.Update(model); err != nil {
-		if err == mysql.ErrRecordNotFound {
-			return nil, status.Error(codes.NotFound, "record not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to update record: %v", err)
+	if err := s.repo.Update(ctx, model); err != nil {
+		return nil, grpcerr.FromError(err, "failed to update record")
 	}
 
 	return &proto.Db_DTakoUriageKeihiResponse{
@@ -99,22 +98,22 @@ func (s *DTakoUriageKeihiService) Update(ctx context.Context, req *proto.Db_Upda
 // Delete 経費精算データ削除
 func (s *DTakoUriageKeihiService) Delete(ctx context.Context, req *proto.Db_DeleteDTakoUriageKeihiRequest) (*proto.Db_Empty, error) {
 	// バリデーション
-	if req.SrchId == "" || req.Datetime == "" {
-		return nil, status.Error(codes.InvalidArgument, "srch_id, datetime, and keihi_c are required")
+	if req.SrchId == "" {
+		return nil, grpcerr.InvalidArgument("srch_id", "srch_id is required")
+	}
+	if req.Datetime == "" {
+		return nil, grpcerr.InvalidArgument("datetime", "datetime is required")
 	}
 
 	// 日時パース
 	datetime, err := time.Parse(time.RFC3339, req.Datetime)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid datetime format: %v", err)
+		return nil, grpcerr.InvalidArgument("datetime", "invalid datetime format: %v", err)
 	}
 
 	// リポジトリから削除
-	if err := s.repo.DeleteByCompositeKey(req.SrchId, datetime, req.KeihiC); err != nil {
-		if err == mysql.ErrRecordNotFound {
-			return nil, status.Error(codes.NotFound, "record not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to delete record: %v", err)
+	if err := s.repo.DeleteByCompositeKey(ctx, req.SrchId, datetime, req.KeihiC); err != nil {
+		return nil, grpcerr.FromError(err, "failed to delete record")
 	}
 
 	return &proto.Db_Empty{}, nil
@@ -144,10 +143,27 @@ func (s *DTakoUriageKeihiService) List(ctx context.Context, req *proto.Db_ListDT
 		}
 	}
 
+	// キーセットページネーション（page_token指定時）
+	if req.PageToken != nil {
+		page, err := keysetPageRequest(req.Limit, req.Offset, nil, *req.PageToken, req.IncludeTotalCount)
+		if err != nil {
+			return nil, err
+		}
+		result, err := s.repo.ListPage(ctx, params, page)
+		if err != nil {
+			return nil, grpcerr.FromError(err, "failed to list records")
+		}
+		return &proto.Db_ListDTakoUriageKeihiResponse{
+			Items:         convertItems(result.Items, modelToProto),
+			TotalCount:    totalCountPtr(result.TotalCount),
+			NextPageToken: result.NextPageToken,
+		}, nil
+	}
+
 	// リポジトリから取得
-	models, totalCount, err := s.repo.List(params)
+	models, totalCount, err := s.repo.List(ctx, params)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list records: %v", err)
+		return nil, grpcerr.FromError(err, "failed to list records")
 	}
 
 	// ModelからProtoへ変換
@@ -158,7 +174,7 @@ func (s *DTakoUriageKeihiService) List(ctx context.Context, req *proto.Db_ListDT
 
 	return &proto.Db_ListDTakoUriageKeihiResponse{
 		Items:      items,
-		TotalCount: int32(totalCount),
+		TotalCount: int32Ptr(int32(totalCount)),
 	}, nil
 }
 
@@ -0,0 +1,339 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/yhonda-ohishi/db_service/src/employeeid"
+	"github.com/yhonda-ohishi/db_service/src/grpcerr"
+	"github.com/yhonda-ohishi/db_service/src/models/mysql"
+	"github.com/yhonda-ohishi/db_service/src/proto"
+	"github.com/yhonda-ohishi/db_service/src/repository"
+)
+
+// EmployeeIdentityService 社員の識別子の対応表サービス実装
+type EmployeeIdentityService struct {
+	proto.UnimplementedDb_EmployeeIdentityServiceServer
+	repo     repository.EmployeeIdentityRepository
+	resolver *employeeid.Resolver
+}
+
+// NewEmployeeIdentityService サービスのコンストラクタ
+func NewEmployeeIdentityService(repo repository.EmployeeIdentityRepository, resolver *employeeid.Resolver) *EmployeeIdentityService {
+	return &EmployeeIdentityService{
+		repo:     repo,
+		resolver: resolver,
+	}
+}
+
+// Create 社員作成
+func (s *EmployeeIdentityService) Create(ctx context.Context, req *proto.Db_CreateEmployeeIdentityRequest) (*proto.Db_EmployeeIdentityResponse, error) {
+	if req.Employee == nil {
+		return nil, grpcerr.InvalidArgument("employee", "employee is required")
+	}
+
+	model := employeeIdentityProtoToModel(req.Employee)
+	model.ID = 0
+	model.BeforeCreate()
+	if err := model.Validate(); err != nil {
+		return nil, grpcerr.FromError(err, "invalid employee")
+	}
+
+	if err := s.repo.Create(ctx, model); err != nil {
+		return nil, grpcerr.FromError(err, "failed to create employee")
+	}
+
+	return &proto.Db_EmployeeIdentityResponse{
+		Employee: employeeIdentityModelToProto(model),
+	}, nil
+}
+
+// Get 社員取得
+func (s *EmployeeIdentityService) Get(ctx context.Context, req *proto.Db_GetEmployeeIdentityRequest) (*proto.Db_EmployeeIdentityResponse, error) {
+	if req.Id == 0 {
+		return nil, grpcerr.InvalidArgument("id", "id is required")
+	}
+
+	model, err := s.repo.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to get employee")
+	}
+	cards, err := s.repo.GetCards(ctx, model.ID)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to get employee cards")
+	}
+
+	return &proto.Db_EmployeeIdentityResponse{
+		Employee: employeeIdentityModelToProto(model),
+		Cards:    convertItems(cards, employeeCardModelToProto),
+	}, nil
+}
+
+// Update 社員更新
+func (s *EmployeeIdentityService) Update(ctx context.Context, req *proto.Db_UpdateEmployeeIdentityRequest) (*proto.Db_EmployeeIdentityResponse, error) {
+	if req.Employee == nil {
+		return nil, grpcerr.InvalidArgument("employee", "employee is required")
+	}
+	if req.Employee.Id == 0 {
+		return nil, grpcerr.InvalidArgument("id", "id is required")
+	}
+
+	model := employeeIdentityProtoToModel(req.Employee)
+	model.BeforeUpdate()
+	if model.CreatedAt.IsZero() {
+		// 作成日時はリポジトリで登録済みの値に置き換える
+		model.CreatedAt = model.UpdatedAt
+	}
+	if err := model.Validate(); err != nil {
+		return nil, grpcerr.FromError(err, "invalid employee")
+	}
+
+	if err := s.repo.Update(ctx, model); err != nil {
+		return nil, grpcerr.FromError(err, "failed to update employee")
+	}
+
+	return &proto.Db_EmployeeIdentityResponse{
+		Employee: employeeIdentityModelToProto(model),
+	}, nil
+}
+
+// Delete 社員と登録されているカードを削除
+func (s *EmployeeIdentityService) Delete(ctx context.Context, req *proto.Db_DeleteEmployeeIdentityRequest) (*proto.Db_Empty, error) {
+	if req.Id == 0 {
+		return nil, grpcerr.InvalidArgument("id", "id is required")
+	}
+
+	if err := s.repo.DeleteByID(ctx, req.Id); err != nil {
+		return nil, grpcerr.FromError(err, "failed to delete employee")
+	}
+
+	return &proto.Db_Empty{}, nil
+}
+
+// List 社員一覧取得（id順）
+func (s *EmployeeIdentityService) List(ctx context.Context, req *proto.Db_ListEmployeeIdentityRequest) (*proto.Db_ListEmployeeIdentityResponse, error) {
+	// キーセットページネーション（page_token指定時）
+	if req.PageToken != nil {
+		page, err := keysetPageRequest(req.Limit, req.Offset, nil, *req.PageToken, req.IncludeTotalCount)
+		if err != nil {
+			return nil, err
+		}
+		result, err := s.repo.GetPage(ctx, page)
+		if err != nil {
+			return nil, grpcerr.FromError(err, "failed to list employees")
+		}
+		return &proto.Db_ListEmployeeIdentityResponse{
+			Items:         convertItems(result.Items, employeeIdentityModelToProto),
+			TotalCount:    totalCountPtr(result.TotalCount),
+			NextPageToken: result.NextPageToken,
+		}, nil
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 100
+	}
+	offset := int(req.Offset)
+
+	employees, totalCount, err := s.repo.GetAll(ctx, limit, offset)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to list employees")
+	}
+
+	return &proto.Db_ListEmployeeIdentityResponse{
+		Items:      convertItems(employees, employeeIdentityModelToProto),
+		TotalCount: int32Ptr(int32(totalCount)),
+	}, nil
+}
+
+// Resolve 社員コードのいずれか1つから社員を取得
+func (s *EmployeeIdentityService) Resolve(ctx context.Context, req *proto.Db_ResolveEmployeeIdentityRequest) (*proto.Db_ResolveEmployeeIdentityResponse, error) {
+	key, err := employeeKeyFromProto(req)
+	if err != nil {
+		return nil, err
+	}
+
+	person, err := s.resolver.Resolve(ctx, key)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to resolve employee")
+	}
+
+	resp := &proto.Db_ResolveEmployeeIdentityResponse{
+		Employee: employeeIdentityModelToProto(person.Employee),
+		Cards:    convertItems(person.Cards, employeeCardModelToProto),
+	}
+	if person.Driver != nil {
+		resp.Driver = driversModelToProto(person.Driver)
+	}
+	if person.Shain != nil {
+		resp.Shain = convertShainMasterToProto(person.Shain)
+	}
+	return resp, nil
+}
+
+// RegisterCard 社員にFeliCaカードを登録
+func (s *EmployeeIdentityService) RegisterCard(ctx context.Context, req *proto.Db_RegisterEmployeeCardRequest) (*proto.Db_EmployeeCardResponse, error) {
+	if req.EmployeeId == 0 {
+		return nil, grpcerr.InvalidArgument("employee_id", "employee_id is required")
+	}
+	if req.CardId == "" {
+		return nil, grpcerr.InvalidArgument("card_id", "card_id is required")
+	}
+
+	card := &mysql.EmployeeCard{
+		CardID:     req.CardId,
+		EmployeeID: req.EmployeeId,
+		CreatedBy:  req.CreatedBy,
+	}
+	card.SetDefaults()
+
+	created, err := s.repo.RegisterCard(ctx, card)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to register card")
+	}
+
+	return &proto.Db_EmployeeCardResponse{
+		Card:    employeeCardModelToProto(card),
+		Created: created,
+	}, nil
+}
+
+// UnregisterCard カードの登録を削除
+func (s *EmployeeIdentityService) UnregisterCard(ctx context.Context, req *proto.Db_UnregisterEmployeeCardRequest) (*proto.Db_Empty, error) {
+	if req.CardId == "" {
+		return nil, grpcerr.InvalidArgument("card_id", "card_id is required")
+	}
+
+	if err := s.repo.UnregisterCard(ctx, req.CardId); err != nil {
+		return nil, grpcerr.FromError(err, "failed to unregister card")
+	}
+
+	return &proto.Db_Empty{}, nil
+}
+
+// ListUnlinked 対応表に登録されていない社員コードを報告
+func (s *EmployeeIdentityService) ListUnlinked(ctx context.Context, req *proto.Db_ListUnlinkedEmployeeCodesRequest) (*proto.Db_ListUnlinkedEmployeeCodesResponse, error) {
+	report, err := s.resolver.Unlinked(ctx)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to report unlinked employee codes")
+	}
+
+	sources := make(map[proto.Db_EmployeeCodeSource]bool, len(req.Sources))
+	for _, source := range req.Sources {
+		sources[source] = true
+	}
+
+	resp := &proto.Db_ListUnlinkedEmployeeCodesResponse{}
+	for _, source := range report.Sources {
+		resp.Sources = append(resp.Sources, employeeCodeSourceToProto(source))
+	}
+	for _, code := range report.Codes {
+		p := &proto.Db_UnlinkedEmployeeCode{
+			Source: employeeCodeSourceToProto(code.Source),
+			Code:   code.Code,
+			Name:   code.Name,
+		}
+		if len(sources) > 0 && !sources[p.Source] {
+			continue
+		}
+		resp.Items = append(resp.Items, p)
+	}
+	for _, skipped := range report.Skipped {
+		resp.SkippedSources = append(resp.SkippedSources, &proto.Db_SkippedEmployeeCodeSource{
+			Source: employeeCodeSourceToProto(skipped.Source),
+			Reason: skipped.Reason,
+		})
+	}
+	return resp, nil
+}
+
+// employeeKeyFromProto 検索する社員コードの変換
+func employeeKeyFromProto(req *proto.Db_ResolveEmployeeIdentityRequest) (employeeid.Key, error) {
+	var key employeeid.Key
+	var field string
+	switch k := req.Key.(type) {
+	case *proto.Db_ResolveEmployeeIdentityRequest_DriverId:
+		key, field = employeeid.Key{Source: employeeid.SourceDrivers, Value: strconv.Itoa(int(k.DriverId))}, "driver_id"
+	case *proto.Db_ResolveEmployeeIdentityRequest_ShainC:
+		key, field = employeeid.Key{Source: employeeid.SourceShainMaster, Value: k.ShainC}, "shain_c"
+	case *proto.Db_ResolveEmployeeIdentityRequest_DtakoDriverCode:
+		key, field = employeeid.Key{Source: employeeid.SourceDTakoRows, Value: strconv.Itoa(int(k.DtakoDriverCode))}, "dtako_driver_code"
+	case *proto.Db_ResolveEmployeeIdentityRequest_UntenshuC:
+		key, field = employeeid.Key{Source: employeeid.SourceUntenNippoMeisai, Value: k.UntenshuC}, "untenshu_c"
+	case *proto.Db_ResolveEmployeeIdentityRequest_TimeCardUserId:
+		key, field = employeeid.Key{Source: employeeid.SourceTimeCardUser, Value: strconv.Itoa(int(k.TimeCardUserId))}, "time_card_user_id"
+	case *proto.Db_ResolveEmployeeIdentityRequest_CardId:
+		key, field = employeeid.Key{Source: employeeid.SourceTimeCardCard, Value: k.CardId}, "card_id"
+	default:
+		return key, grpcerr.InvalidArgument("key", "one of driver_id, shain_c, dtako_driver_code, untenshu_c, time_card_user_id, card_id is required")
+	}
+	if key.Value == "" {
+		return key, grpcerr.InvalidArgument(field, "%s is required", field)
+	}
+	return key, nil
+}
+
+// employeeCodeSourceToProto 登録元の変換
+func employeeCodeSourceToProto(source employeeid.Source) proto.Db_EmployeeCodeSource {
+	switch source {
+	case employeeid.SourceDrivers:
+		return proto.Db_EmployeeCodeSource_EMPLOYEE_CODE_SOURCE_DRIVERS
+	case employeeid.SourceShainMaster:
+		return proto.Db_EmployeeCodeSource_EMPLOYEE_CODE_SOURCE_SHAIN_MASTER
+	case employeeid.SourceDTakoRows:
+		return proto.Db_EmployeeCodeSource_EMPLOYEE_CODE_SOURCE_DTAKO_ROWS
+	case employeeid.SourceUntenNippoMeisai:
+		return proto.Db_EmployeeCodeSource_EMPLOYEE_CODE_SOURCE_UNTEN_NIPPO_MEISAI
+	case employeeid.SourceTimeCardUser:
+		return proto.Db_EmployeeCodeSource_EMPLOYEE_CODE_SOURCE_TIME_CARD_USER
+	case employeeid.SourceTimeCardCard:
+		return proto.Db_EmployeeCodeSource_EMPLOYEE_CODE_SOURCE_TIME_CARD_CARD
+	}
+	return proto.Db_EmployeeCodeSource_EMPLOYEE_CODE_SOURCE_UNSPECIFIED
+}
+
+// employeeIdentityProtoToModel プロトコルバッファーからモデルに変換
+func employeeIdentityProtoToModel(p *proto.Db_EmployeeIdentity) *mysql.EmployeeIdentity {
+	m := &mysql.EmployeeIdentity{
+		ID:     p.Id,
+		Name:   p.Name,
+		ShainC: p.ShainC,
+		Notes:  p.Notes,
+	}
+	m.DriverID = intPtrFromInt32(p.DriverId)
+	m.DTakoDriverCode = intPtrFromInt32(p.DtakoDriverCode)
+	m.TimeCardUserID = intPtrFromInt32(p.TimeCardUserId)
+
+	if p.CreatedAt != "" {
+		if t, err := time.Parse(time.RFC3339, p.CreatedAt); err == nil {
+			m.CreatedAt = t
+		}
+	}
+	return m
+}
+
+// employeeIdentityModelToProto モデルからプロトコルバッファーに変換
+func employeeIdentityModelToProto(m *mysql.EmployeeIdentity) *proto.Db_EmployeeIdentity {
+	return &proto.Db_EmployeeIdentity{
+		Id:              m.ID,
+		Name:            m.Name,
+		DriverId:        int32PtrFromInt(m.DriverID),
+		ShainC:          m.ShainC,
+		DtakoDriverCode: int32PtrFromInt(m.DTakoDriverCode),
+		TimeCardUserId:  int32PtrFromInt(m.TimeCardUserID),
+		CreatedAt:       m.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:       m.UpdatedAt.Format(time.RFC3339),
+		Notes:           m.Notes,
+	}
+}
+
+// employeeCardModelToProto カードのモデルからプロトコルバッファーに変換
+func employeeCardModelToProto(m *mysql.EmployeeCard) *proto.Db_EmployeeCard {
+	return &proto.Db_EmployeeCard{
+		CardId:     m.CardID,
+		EmployeeId: m.EmployeeID,
+		CreatedAt:  m.CreatedAt.Format(time.RFC3339),
+		CreatedBy:  m.CreatedBy,
+	}
+}
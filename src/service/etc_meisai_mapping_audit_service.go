@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+
+	"github.com/yhonda-ohishi/db_service/src/grpcerr"
+	"github.com/yhonda-ohishi/db_service/src/mappingaudit"
+	"github.com/yhonda-ohishi/db_service/src/proto"
+)
+
+// ETCMeisaiMappingAuditService ETC明細マッピングの整合性チェックサービス実装
+type ETCMeisaiMappingAuditService struct {
+	proto.UnimplementedDb_ETCMeisaiMappingAuditServiceServer
+	auditor *mappingaudit.Auditor
+}
+
+// NewETCMeisaiMappingAuditService サービスのコンストラクタ
+func NewETCMeisaiMappingAuditService(auditor *mappingaudit.Auditor) *ETCMeisaiMappingAuditService {
+	return &ETCMeisaiMappingAuditService{
+		auditor: auditor,
+	}
+}
+
+// Audit マッピングをetc_meisai・本番DBのdtako_rowsと突き合わせて問題を報告
+func (s *ETCMeisaiMappingAuditService) Audit(ctx context.Context, req *proto.Db_AuditETCMeisaiMappingRequest) (*proto.Db_AuditETCMeisaiMappingResponse, error) {
+	report, err := s.auditor.Audit(ctx)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to audit mappings")
+	}
+
+	kinds := make(map[proto.Db_MappingIssueKind]bool, len(req.Kinds))
+	for _, kind := range req.Kinds {
+		kinds[kind] = true
+	}
+
+	resp := &proto.Db_AuditETCMeisaiMappingResponse{
+		MappingCount:        int32(report.MappingCount),
+		OrphanHashCount:     int32(report.Count(mappingaudit.OrphanHash)),
+		OrphanDtakoRowCount: int32(report.Count(mappingaudit.OrphanDTakoRow)),
+		DuplicateHashCount:  int32(report.Count(mappingaudit.DuplicateHash)),
+		StaleHashCount:      int32(report.Count(mappingaudit.StaleHash)),
+	}
+	for _, issue := range report.Issues {
+		p := mappingIssueToProto(issue)
+		if len(kinds) > 0 && !kinds[p.Kind] {
+			continue
+		}
+		resp.Issues = append(resp.Issues, p)
+	}
+	return resp, nil
+}
+
+// mappingIssueKindToProto 問題の種類の変換
+func mappingIssueKindToProto(kind mappingaudit.Kind) proto.Db_MappingIssueKind {
+	switch kind {
+	case mappingaudit.OrphanHash:
+		return proto.Db_MappingIssueKind_MAPPING_ISSUE_KIND_ORPHAN_HASH
+	case mappingaudit.OrphanDTakoRow:
+		return proto.Db_MappingIssueKind_MAPPING_ISSUE_KIND_ORPHAN_DTAKO_ROW
+	case mappingaudit.DuplicateHash:
+		return proto.Db_MappingIssueKind_MAPPING_ISSUE_KIND_DUPLICATE_HASH
+	case mappingaudit.StaleHash:
+		return proto.Db_MappingIssueKind_MAPPING_ISSUE_KIND_STALE_HASH
+	}
+	return proto.Db_MappingIssueKind_MAPPING_ISSUE_KIND_UNSPECIFIED
+}
+
+// mappingIssueToProto 検出した問題の変換
+func mappingIssueToProto(issue *mappingaudit.Issue) *proto.Db_MappingIssue {
+	return &proto.Db_MappingIssue{
+		Kind:          mappingIssueKindToProto(issue.Kind),
+		MappingIds:    issue.MappingIDs,
+		EtcMeisaiHash: issue.ETCMeisaiHash,
+		DtakoRowIds:   issue.DTakoRowIDs,
+		EtcMeisaiId:   issue.ETCMeisaiID,
+		ExpectedHash:  issue.ExpectedHash,
+	}
+}
@@ -2,13 +2,13 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/yhonda-ohishi/db_service/src/grpcerr"
 	"github.com/yhonda-ohishi/db_service/src/models/mysql"
 	"github.com/yhonda-ohishi/db_service/src/proto"
 	"github.com/yhonda-ohishi/db_service/src/repository"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 // ETCMeisaiMappingService ETC明細マッピングサービス実装
@@ -27,7 +27,7 @@ func NewETCMeisaiMappingService(repo repository.ETCMeisaiMappingRepository) *ETC
 // Create マッピング作成
 func (s *ETCMeisaiMappingService) Create(ctx context.Context, req *proto.Db_CreateETCMeisaiMappingRequest) (*proto.Db_ETCMeisaiMappingResponse, error) {
 	if req.EtcMeisaiMapping == nil {
-		return nil, status.Error(codes.InvalidArgument, "etc_meisai_mapping is required")
+		return nil, grpcerr.InvalidArgument("etc_meisai_mapping", "etc_meisai_mapping is required")
 	}
 
 	// プロトコルバッファーからモデルに変換
@@ -35,28 +35,38 @@ func (s *ETCMeisaiMappingService) Create(ctx context.Context, req *proto.Db_Crea
 
 	// タイムスタンプ設定
 	model.BeforeCreate()
+	if err := model.Validate(); err != nil {
+		return nil, grpcerr.FromError(err, "invalid etc_meisai_mapping")
+	}
 
-	// リポジトリで作成
-	if err := s.repo.Create(model); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create mapping: %v", err)
+	// リポジトリで作成（upsert指定時は登録済みのマッピングを返す）
+	created := true
+	if req.Upsert {
+		var err error
+		if created, err = s.repo.Upsert(ctx, model); err != nil {
+			return nil, grpcerr.FromError(err, "failed to upsert mapping")
+		}
+	} else if err := s.repo.Create(ctx, model); err != nil {
+		return nil, grpcerr.FromError(err, "failed to create mapping")
 	}
 
 	// レスポンス作成
 	return &proto.Db_ETCMeisaiMappingResponse{
 		EtcMeisaiMapping: etcMeisaiMappingModelToProto(model),
+		Created:          created,
 	}, nil
 }
 
 // Get マッピング取得
 func (s *ETCMeisaiMappingService) Get(ctx context.Context, req *proto.Db_GetETCMeisaiMappingRequest) (*proto.Db_ETCMeisaiMappingResponse, error) {
 	if req.Id == 0 {
-		return nil, status.Error(codes.InvalidArgument, "id is required")
+		return nil, grpcerr.InvalidArgument("id", "id is required")
 	}
 
 	// リポジトリから取得
-	model, err := s.repo.GetByID(req.Id)
+	model, err := s.repo.GetByID(ctx, req.Id)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "mapping not found: %v", err)
+		return nil, grpcerr.FromError(err, "failed to get mapping")
 	}
 
 	// レスポンス作成
@@ -68,10 +78,10 @@ func (s *ETCMeisaiMappingService) Get(ctx context.Context, req *proto.Db_GetETCM
 // Update マッピング更新
 func (s *ETCMeisaiMappingService) Update(ctx context.Context, req *proto.Db_UpdateETCMeisaiMappingRequest) (*proto.Db_ETCMeisaiMappingResponse, error) {
 	if req.EtcMeisaiMapping == nil {
-		return nil, status.Error(codes.InvalidArgument, "etc_meisai_mapping is required")
+		return nil, grpcerr.InvalidArgument("etc_meisai_mapping", "etc_meisai_mapping is required")
 	}
 	if req.EtcMeisaiMapping.Id == 0 {
-		return nil, status.Error(codes.InvalidArgument, "id is required")
+		return nil, grpcerr.InvalidArgument("id", "id is required")
 	}
 
 	// プロトコルバッファーからモデルに変換
@@ -79,10 +89,13 @@ func (s *ETCMeisaiMappingService) Update(ctx context.Context, req *proto.Db_Upda
 
 	// 更新前フック
 	model.BeforeUpdate()
+	if err := model.Validate(); err != nil {
+		return nil, grpcerr.FromError(err, "invalid etc_meisai_mapping")
+	}
 
 	// リポジトリで更新
-	if err := s.repo.Update(model); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update mapping: %v", err)
+	if err := s.repo.Update(ctx, model); err != nil {
+		return nil, grpcerr.FromError(err, "failed to update mapping")
 	}
 
 	// レスポンス作成
@@ -94,12 +107,12 @@ func (s *ETCMeisaiMappingService) Update(ctx context.Context, req *proto.Db_Upda
 // Delete マッピング削除
 func (s *ETCMeisaiMappingService) Delete(ctx context.Context, req *proto.Db_DeleteETCMeisaiMappingRequest) (*proto.Db_Empty, error) {
 	if req.Id == 0 {
-		return nil, status.Error(codes.InvalidArgument, "id is required")
+		return nil, grpcerr.InvalidArgument("id", "id is required")
 	}
 
 	// リポジトリで削除
-	if err := s.repo.DeleteByID(req.Id); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete mapping: %v", err)
+	if err := s.repo.DeleteByID(ctx, req.Id); err != nil {
+		return nil, grpcerr.FromError(err, "failed to delete mapping")
 	}
 
 	return &proto.Db_Empty{}, nil
@@ -107,11 +120,11 @@ func (s *ETCMeisaiMappingService) Delete(ctx context.Context, req *proto.Db_Dele
 
 // List マッピング一覧取得
 func (s *ETCMeisaiMappingService) List(ctx context.Context, req *proto.Db_ListETCMeisaiMappingRequest) (*proto.Db_ListETCMeisaiMappingResponse, error) {
-	if req.Limit <= 0 {
-		return nil, status.Error(codes.InvalidArgument, "limit must be positive")
+	if req.Limit <= 0 && req.PageToken == nil {
+		return nil, grpcerr.InvalidArgument("limit", "limit must be positive")
 	}
 	if req.Offset < 0 {
-		return nil, status.Error(codes.InvalidArgument, "offset must be non-negative")
+		return nil, grpcerr.InvalidArgument("offset", "offset must be non-negative")
 	}
 
 	// パラメータ作成
@@ -128,10 +141,27 @@ func (s *ETCMeisaiMappingService) List(ctx context.Context, req *proto.Db_ListET
 		params.DTakoRowID = req.DtakoRowId
 	}
 
+	// キーセットページネーション（page_token指定時）
+	if req.PageToken != nil {
+		page, err := keysetPageRequest(req.Limit, req.Offset, nil, *req.PageToken, req.IncludeTotalCount)
+		if err != nil {
+			return nil, err
+		}
+		result, err := s.repo.ListPage(ctx, params, page)
+		if err != nil {
+			return nil, grpcerr.FromError(err, "failed to list mappings")
+		}
+		return &proto.Db_ListETCMeisaiMappingResponse{
+			Items:         convertItems(result.Items, etcMeisaiMappingModelToProto),
+			TotalCount:    totalCountPtr(result.TotalCount),
+			NextPageToken: result.NextPageToken,
+		}, nil
+	}
+
 	// リポジトリから取得
-	models, totalCount, err := s.repo.List(params)
+	models, totalCount, err := s.repo.List(ctx, params)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list mappings: %v", err)
+		return nil, grpcerr.FromError(err, "failed to list mappings")
 	}
 
 	// プロトコルバッファーに変換
@@ -142,20 +172,20 @@ func (s *ETCMeisaiMappingService) List(ctx context.Context, req *proto.Db_ListET
 
 	return &proto.Db_ListETCMeisaiMappingResponse{
 		Items:      items,
-		TotalCount: int32(totalCount),
+		TotalCount: int32Ptr(int32(totalCount)),
 	}, nil
 }
 
 // GetDTakoRowIDByHash ハッシュからDTakoRowIDを取得
 func (s *ETCMeisaiMappingService) GetDTakoRowIDByHash(ctx context.Context, req *proto.Db_GetDTakoRowIDByHashRequest) (*proto.Db_GetDTakoRowIDByHashResponse, error) {
 	if req.EtcMeisaiHash == "" {
-		return nil, status.Error(codes.InvalidArgument, "etc_meisai_hash is required")
+		return nil, grpcerr.InvalidArgument("etc_meisai_hash", "etc_meisai_hash is required")
 	}
 
 	// リポジトリから取得
-	dtakoRowIDs, err := s.repo.GetDTakoRowIDsByHash(req.EtcMeisaiHash)
+	dtakoRowIDs, err := s.repo.GetDTakoRowIDsByHash(ctx, req.EtcMeisaiHash)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get dtako_row_ids: %v", err)
+		return nil, grpcerr.FromError(err, "failed to get dtako_row_ids")
 	}
 
 	return &proto.Db_GetDTakoRowIDByHashResponse{
@@ -163,6 +193,34 @@ func (s *ETCMeisaiMappingService) GetDTakoRowIDByHash(ctx context.Context, req *
 	}, nil
 }
 
+// BulkReplace dtako_row_idのマッピングを1トランザクションで置き換え
+func (s *ETCMeisaiMappingService) BulkReplace(ctx context.Context, req *proto.Db_BulkReplaceETCMeisaiMappingRequest) (*proto.Db_BulkReplaceETCMeisaiMappingResponse, error) {
+	if req.DtakoRowId == "" {
+		return nil, grpcerr.InvalidArgument("dtako_row_id", "dtako_row_id is required")
+	}
+
+	models := make([]*mysql.ETCMeisaiMapping, len(req.Items))
+	for i, item := range req.Items {
+		if item == nil {
+			return nil, grpcerr.InvalidArgument(fmt.Sprintf("items[%d]", i), "items[%d] is required", i)
+		}
+		model := etcMeisaiMappingProtoToModel(item)
+		model.ID = 0
+		model.BeforeCreate()
+		models[i] = model
+	}
+
+	deleted, err := s.repo.BulkReplace(ctx, req.DtakoRowId, models)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to replace mappings")
+	}
+
+	return &proto.Db_BulkReplaceETCMeisaiMappingResponse{
+		Items:        convertItems(models, etcMeisaiMappingModelToProto),
+		DeletedCount: int32(deleted),
+	}, nil
+}
+
 // etcMeisaiMappingProtoToModel プロトコルバッファーからモデルに変換
 func etcMeisaiMappingProtoToModel(p *proto.Db_ETCMeisaiMapping) *mysql.ETCMeisaiMapping {
 	m := &mysql.ETCMeisaiMapping{
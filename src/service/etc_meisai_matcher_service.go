@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/yhonda-ohishi/db_service/src/etcmatch"
+	"github.com/yhonda-ohishi/db_service/src/grpcerr"
+	"github.com/yhonda-ohishi/db_service/src/proto"
+)
+
+// ETCMeisaiMatcherService ETC明細と運行データの自動照合サービス実装
+type ETCMeisaiMatcherService struct {
+	proto.UnimplementedDb_ETCMeisaiMatcherServiceServer
+	matcher *etcmatch.Matcher
+}
+
+// NewETCMeisaiMatcherService サービスのコンストラクタ
+func NewETCMeisaiMatcherService(matcher *etcmatch.Matcher) *ETCMeisaiMatcherService {
+	return &ETCMeisaiMatcherService{
+		matcher: matcher,
+	}
+}
+
+// AutoMatch 期間内のETC明細を運行データと照合し、候補が1件に決まった明細のマッピングを作成
+func (s *ETCMeisaiMatcherService) AutoMatch(ctx context.Context, req *proto.Db_AutoMatchETCMeisaiRequest) (*proto.Db_AutoMatchETCMeisaiResponse, error) {
+	start, err := time.Parse(time.RFC3339, req.StartDate)
+	if err != nil {
+		return nil, grpcerr.InvalidArgument("start_date", "invalid start_date format: %v", err)
+	}
+	end, err := time.Parse(time.RFC3339, req.EndDate)
+	if err != nil {
+		return nil, grpcerr.InvalidArgument("end_date", "invalid end_date format: %v", err)
+	}
+	if end.Before(start) {
+		return nil, grpcerr.InvalidArgument("end_date", "end_date must not be before start_date")
+	}
+	if req.MinConfidence < 0 || req.MinConfidence > 1 {
+		return nil, grpcerr.InvalidArgument("min_confidence", "min_confidence must be between 0 and 1: %v", req.MinConfidence)
+	}
+
+	results, err := s.matcher.Match(ctx, etcmatch.Options{
+		Start:         start,
+		End:           end,
+		MinConfidence: req.MinConfidence,
+		DryRun:        req.DryRun,
+	})
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to match etc_meisai")
+	}
+
+	resp := &proto.Db_AutoMatchETCMeisaiResponse{DryRun: req.DryRun}
+	for _, result := range results {
+		switch result.Status {
+		case etcmatch.Matched:
+			resp.MatchedCount++
+		case etcmatch.Ambiguous:
+			resp.AmbiguousCount++
+		case etcmatch.Unmatched:
+			resp.UnmatchedCount++
+		case etcmatch.AlreadyMapped:
+			resp.AlreadyMappedCount++
+			if !req.IncludeAlreadyMapped {
+				continue
+			}
+		}
+		resp.Results = append(resp.Results, autoMatchResultToProto(result))
+	}
+	return resp, nil
+}
+
+// autoMatchStatusToProto 照合結果の種類の変換
+func autoMatchStatusToProto(s etcmatch.Status) proto.Db_AutoMatchStatus {
+	switch s {
+	case etcmatch.Matched:
+		return proto.Db_AutoMatchStatus_AUTO_MATCH_STATUS_MATCHED
+	case etcmatch.Ambiguous:
+		return proto.Db_AutoMatchStatus_AUTO_MATCH_STATUS_AMBIGUOUS
+	case etcmatch.Unmatched:
+		return proto.Db_AutoMatchStatus_AUTO_MATCH_STATUS_UNMATCHED
+	case etcmatch.AlreadyMapped:
+		return proto.Db_AutoMatchStatus_AUTO_MATCH_STATUS_ALREADY_MAPPED
+	}
+	return proto.Db_AutoMatchStatus_AUTO_MATCH_STATUS_UNSPECIFIED
+}
+
+// autoMatchResultToProto 照合結果の変換
+func autoMatchResultToProto(result *etcmatch.Result) *proto.Db_AutoMatchResult {
+	p := &proto.Db_AutoMatchResult{
+		EtcMeisaiId:   result.ETCMeisai.ID,
+		EtcMeisaiHash: result.ETCMeisai.Hash,
+		EtcNum:        result.ETCMeisai.EtcNum,
+		DateTo:        result.ETCMeisai.DateTo.Format(time.RFC3339),
+		Status:        autoMatchStatusToProto(result.Status),
+		Reason:        result.Reason,
+		MappingId:     result.MappingID,
+	}
+	for _, c := range result.Candidates {
+		p.Candidates = append(p.Candidates, &proto.Db_AutoMatchCandidate{
+			DtakoRowId: c.DTakoRowID,
+			CarId:      c.CarID,
+			Confidence: c.Confidence,
+		})
+	}
+	return p
+}
@@ -2,13 +2,16 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"sort"
 	"time"
 
+	"github.com/yhonda-ohishi/db_service/src/etccsv"
+	"github.com/yhonda-ohishi/db_service/src/grpcerr"
 	"github.com/yhonda-ohishi/db_service/src/models/mysql"
 	"github.com/yhonda-ohishi/db_service/src/proto"
 	"github.com/yhonda-ohishi/db_service/src/repository"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 // ETCMeisaiService gRPCサービス実装
@@ -27,7 +30,7 @@ func NewETCMeisaiService(repo repository.ETCMeisaiRepository) *ETCMeisaiService
 // Create ETC明細データ作成
 func (s *ETCMeisaiService) Create(ctx context.Context, req *proto.Db_CreateETCMeisaiRequest) (*proto.Db_ETCMeisaiResponse, error) {
 	if req.EtcMeisai == nil {
-		return nil, status.Error(codes.InvalidArgument, "etc_meisai is required")
+		return nil, grpcerr.InvalidArgument("etc_meisai", "etc_meisai is required")
 	}
 
 	// ProtoからModelへ変換
@@ -69,8 +72,8 @@ func (s *ETCMeisaiService) Create(ctx context.Context, req *proto.Db_CreateETCMe
 	}
 
 	// リポジトリで作成
-	if err := s.repo.Create(model); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create record: %v", err)
+	if err := s.repo.Create(ctx, model); err != nil {
+		return nil, grpcerr.FromError(err, "failed to create record")
 	}
 
 	// ModelからProtoへ変換して返却
@@ -82,15 +85,12 @@ func (s *ETCMeisaiService) Create(ctx context.Context, req *proto.Db_CreateETCMe
 // Get ETC明細データ取得
 func (s *ETCMeisaiService) Get(ctx context.Context, req *proto.Db_GetETCMeisaiRequest) (*proto.Db_ETCMeisaiResponse, error) {
 	if req.Id <= 0 {
-		return nil, status.Error(codes.InvalidArgument, "invalid id")
+		return nil, grpcerr.InvalidArgument("id", "invalid id")
 	}
 
-	model, err := s.repo.GetByID(req.Id)
+	model, err := s.repo.GetByID(ctx, req.Id)
 	if err != nil {
-		if err == mysql.ErrRecordNotFound {
-			return nil, status.Error(codes.NotFound, "record not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get record: %v", err)
+		return nil, grpcerr.FromError(err, "failed to get record")
 	}
 
 	return &proto.Db_ETCMeisaiResponse{
@@ -101,18 +101,15 @@ func (s *ETCMeisaiService) Get(ctx context.Context, req *proto.Db_GetETCMeisaiRe
 // Update ETC明細データ更新
 func (s *ETCMeisaiService) Update(ctx context.Context, req *proto.Db_UpdateETCMeisaiRequest) (*proto.Db_ETCMeisaiResponse, error) {
 	if req.EtcMeisai == nil {
-		return nil, status.Error(codes.InvalidArgument, "etc_meisai is required")
+		return nil, grpcerr.InvalidArgument("etc_meisai", "etc_meisai is required")
 	}
 
 	// ProtoからModelへ変換
 	model := etcProtoToModel(req.EtcMeisai)
 
 	// リポジトリで更新
-	if err := s.repo.Update(model); err != nil {
-		if err == mysql.ErrRecordNotFound {
-			return nil, status.Error(codes.NotFound, "record not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to update record: %v", err)
+	if err := s.repo.Update(ctx, model); err != nil {
+		return nil, grpcerr.FromError(err, "failed to update record")
 	}
 
 	return &proto.Db_ETCMeisaiResponse{
@@ -123,14 +120,11 @@ func (s *ETCMeisaiService) Update(ctx context.Context, req *proto.Db_UpdateETCMe
 // Delete ETC明細データ削除
 func (s *ETCMeisaiService) Delete(ctx context.Context, req *proto.Db_DeleteETCMeisaiRequest) (*proto.Db_Empty, error) {
 	if req.Id <= 0 {
-		return nil, status.Error(codes.InvalidArgument, "invalid id")
+		return nil, grpcerr.InvalidArgument("id", "invalid id")
 	}
 
-	if err := s.repo.DeleteByID(req.Id); err != nil {
-		if err == mysql.ErrRecordNotFound {
-			return nil, status.Error(codes.NotFound, "record not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to delete record: %v", err)
+	if err := s.repo.DeleteByID(ctx, req.Id); err != nil {
+		return nil, grpcerr.FromError(err, "failed to delete record")
 	}
 
 	return &proto.Db_Empty{}, nil
@@ -161,9 +155,26 @@ func (s *ETCMeisaiService) List(ctx context.Context, req *proto.Db_ListETCMeisai
 		params.EndDate = &t
 	}
 
-	models, totalCount, err := s.repo.List(params)
+	// キーセットページネーション（page_token指定時）
+	if req.PageToken != nil {
+		page, err := keysetPageRequest(req.Limit, req.Offset, nil, *req.PageToken, req.IncludeTotalCount)
+		if err != nil {
+			return nil, err
+		}
+		result, err := s.repo.ListPage(ctx, params, page)
+		if err != nil {
+			return nil, grpcerr.FromError(err, "failed to list records")
+		}
+		return &proto.Db_ListETCMeisaiResponse{
+			Items:         convertItems(result.Items, etcModelToProto),
+			TotalCount:    totalCountPtr(result.TotalCount),
+			NextPageToken: result.NextPageToken,
+		}, nil
+	}
+
+	models, totalCount, err := s.repo.List(ctx, params)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list records: %v", err)
+		return nil, grpcerr.FromError(err, "failed to list records")
 	}
 
 	items := make([]*proto.Db_ETCMeisai, len(models))
@@ -173,10 +184,247 @@ func (s *ETCMeisaiService) List(ctx context.Context, req *proto.Db_ListETCMeisai
 
 	return &proto.Db_ListETCMeisaiResponse{
 		Items:      items,
-		TotalCount: int32(totalCount),
+		TotalCount: int32Ptr(int32(totalCount)),
 	}, nil
 }
 
+// Stream 条件に一致するETC明細をストリーミングで取得（date_toの古い順）
+func (s *ETCMeisaiService) Stream(req *proto.Db_StreamETCMeisaiRequest, stream proto.Db_ETCMeisaiService_StreamServer) error {
+	params := &repository.ETCMeisaiListParams{
+		Hash: req.Hash,
+	}
+	if req.StartDate != nil && *req.StartDate != "" {
+		t, err := time.Parse(time.RFC3339, *req.StartDate)
+		if err != nil {
+			return grpcerr.InvalidArgument("start_date", "invalid start_date format: %v", err)
+		}
+		params.StartDate = &t
+	}
+	if req.EndDate != nil && *req.EndDate != "" {
+		t, err := time.Parse(time.RFC3339, *req.EndDate)
+		if err != nil {
+			return grpcerr.InvalidArgument("end_date", "invalid end_date format: %v", err)
+		}
+		params.EndDate = &t
+	}
+
+	err := s.repo.Stream(stream.Context(), params, int(req.BatchSize),
+		sendItems(stream, etcModelToProto))
+	return grpcerr.FromError(err, "failed to stream records")
+}
+
+// maxBatchCreateSize BatchCreate・BatchCreateStreamで1回に作成できる明細の最大件数
+const maxBatchCreateSize = 10000
+
+// BatchCreate ETC明細データ一括作成
+func (s *ETCMeisaiService) BatchCreate(ctx context.Context, req *proto.Db_BatchCreateETCMeisaiRequest) (*proto.Db_BatchCreateETCMeisaiResponse, error) {
+	if len(req.Items) == 0 {
+		return nil, grpcerr.InvalidArgument("items", "items is required")
+	}
+	if len(req.Items) > maxBatchCreateSize {
+		return nil, grpcerr.InvalidArgument("items", "too many items: %d (max %d)", len(req.Items), maxBatchCreateSize)
+	}
+
+	return s.batchCreate(ctx, req.Items)
+}
+
+// BatchCreateStream ETC明細データ一括作成（クライアントストリーミング）
+// 全メッセージを受信してから1トランザクションで作成する
+func (s *ETCMeisaiService) BatchCreateStream(stream proto.Db_ETCMeisaiService_BatchCreateStreamServer) error {
+	var items []*proto.Db_ETCMeisai
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		items = append(items, req.Items...)
+		if len(items) > maxBatchCreateSize {
+			return grpcerr.InvalidArgument("items", "too many items (max %d)", maxBatchCreateSize)
+		}
+	}
+	if len(items) == 0 {
+		return grpcerr.InvalidArgument("items", "items is required")
+	}
+
+	resp, err := s.batchCreate(stream.Context(), items)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(resp)
+}
+
+// batchCreate 明細を変換してリポジトリで一括作成し、各明細の結果をまとめる
+func (s *ETCMeisaiService) batchCreate(ctx context.Context, items []*proto.Db_ETCMeisai) (*proto.Db_BatchCreateETCMeisaiResponse, error) {
+	resp := &proto.Db_BatchCreateETCMeisaiResponse{
+		Results: make([]*proto.Db_BatchItemResult, len(items)),
+	}
+
+	// 変換できない明細はリポジトリに渡さずINVALIDとする
+	var models []*mysql.ETCMeisai
+	var indexes []int
+	for i, item := range items {
+		model, err := parseETCMeisaiProto(item)
+		if err != nil {
+			resp.Results[i] = batchResultToProto(i, repository.BatchResult{
+				Status: repository.BatchInvalid,
+				Hash:   item.Hash,
+				Err:    err,
+			})
+			continue
+		}
+		models = append(models, model)
+		indexes = append(indexes, i)
+	}
+
+	results, err := s.repo.BatchCreate(ctx, models)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to batch create records")
+	}
+	for j, result := range results {
+		resp.Results[indexes[j]] = batchResultToProto(indexes[j], result)
+	}
+
+	resp.InsertedCount, resp.DuplicateCount, resp.InvalidCount = countBatchResults(resp.Results)
+	return resp, nil
+}
+
+// Import ETC利用照会サービスの明細CSVを取り込み
+// 読み込めなかった行はINVALIDとし、読み込めた明細はBatchCreateと同じく1トランザクションで作成する
+func (s *ETCMeisaiService) Import(ctx context.Context, req *proto.Db_ImportETCMeisaiRequest) (*proto.Db_ImportETCMeisaiResponse, error) {
+	if len(req.Csv) == 0 {
+		return nil, grpcerr.InvalidArgument("csv", "csv is required")
+	}
+
+	parsed, err := etccsv.Parse(req.Csv)
+	if err != nil {
+		return nil, grpcerr.InvalidArgument("csv", "invalid csv: %v", err)
+	}
+	if len(parsed.Records) > maxBatchCreateSize {
+		return nil, grpcerr.InvalidArgument("csv", "too many records: %d (max %d)", len(parsed.Records), maxBatchCreateSize)
+	}
+
+	models := make([]*mysql.ETCMeisai, len(parsed.Records))
+	for i, record := range parsed.Records {
+		models[i] = record.ETCMeisai
+	}
+	var results []repository.BatchResult
+	if req.DryRun {
+		results, err = s.previewBatchCreate(ctx, models)
+	} else {
+		results, err = s.repo.BatchCreate(ctx, models)
+	}
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to import records")
+	}
+
+	resp := &proto.Db_ImportETCMeisaiResponse{DryRun: req.DryRun}
+	for i, result := range results {
+		resp.Results = append(resp.Results, batchResultToProto(parsed.Records[i].Line, result))
+	}
+	for _, lineErr := range parsed.Errors {
+		resp.Results = append(resp.Results, batchResultToProto(lineErr.Line, repository.BatchResult{
+			Status: repository.BatchInvalid,
+			Err:    lineErr.Err,
+		}))
+	}
+	sort.SliceStable(resp.Results, func(i, j int) bool { return resp.Results[i].Index < resp.Results[j].Index })
+
+	resp.InsertedCount, resp.DuplicateCount, resp.InvalidCount = countBatchResults(resp.Results)
+	return resp, nil
+}
+
+// previewBatchCreate BatchCreateと同じ判定を行い、作成はしない（作成予定の明細はBatchInserted、IDは0）
+func (s *ETCMeisaiService) previewBatchCreate(ctx context.Context, data []*mysql.ETCMeisai) ([]repository.BatchResult, error) {
+	results := repository.PrepareETCMeisaiBatch(data)
+
+	var hashes []string
+	for _, result := range results {
+		if result.Status == 0 {
+			hashes = append(hashes, result.Hash)
+		}
+	}
+	idByHash, err := s.repo.FindIDsByHash(ctx, hashes)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range results {
+		id, exists := idByHash[results[i].Hash]
+		switch {
+		case results[i].Status == repository.BatchDuplicate:
+			results[i].ID = id
+		case results[i].Status != 0:
+			continue
+		case exists:
+			results[i].Status = repository.BatchDuplicate
+			results[i].ID = id
+			results[i].Err = repository.ErrHashExists
+		default:
+			results[i].Status = repository.BatchInserted
+		}
+	}
+	return results, nil
+}
+
+// countBatchResults 結果ごとの件数（作成・重複・不正）
+func countBatchResults(results []*proto.Db_BatchItemResult) (inserted, duplicate, invalid int32) {
+	for _, result := range results {
+		switch result.Status {
+		case proto.Db_BatchItemStatus_BATCH_ITEM_STATUS_INSERTED:
+			inserted++
+		case proto.Db_BatchItemStatus_BATCH_ITEM_STATUS_DUPLICATE:
+			duplicate++
+		case proto.Db_BatchItemStatus_BATCH_ITEM_STATUS_INVALID:
+			invalid++
+		}
+	}
+	return inserted, duplicate, invalid
+}
+
+// parseETCMeisaiProto 一括作成用のProtoからModelへの変換
+// 日時の形式が不正な場合はエラーとし、idは無視する（自動採番）
+func parseETCMeisaiProto(p *proto.Db_ETCMeisai) (*mysql.ETCMeisai, error) {
+	if _, err := time.Parse(time.RFC3339, p.DateTo); err != nil {
+		return nil, fmt.Errorf("invalid date_to format: %w", err)
+	}
+	if _, err := time.Parse("2006-01-02", p.DateToDate); err != nil {
+		return nil, fmt.Errorf("invalid date_to_date format: %w", err)
+	}
+	if p.DateFr != nil && *p.DateFr != "" {
+		if _, err := time.Parse(time.RFC3339, *p.DateFr); err != nil {
+			return nil, fmt.Errorf("invalid date_fr format: %w", err)
+		}
+	}
+
+	m := etcProtoToModel(p)
+	m.ID = 0
+	return m, nil
+}
+
+// batchStatusToProto 一括作成の結果の変換
+var batchStatusToProto = map[repository.BatchStatus]proto.Db_BatchItemStatus{
+	repository.BatchInserted:  proto.Db_BatchItemStatus_BATCH_ITEM_STATUS_INSERTED,
+	repository.BatchDuplicate: proto.Db_BatchItemStatus_BATCH_ITEM_STATUS_DUPLICATE,
+	repository.BatchInvalid:   proto.Db_BatchItemStatus_BATCH_ITEM_STATUS_INVALID,
+}
+
+// batchResultToProto 一括作成の各明細の結果をProtoへ変換
+func batchResultToProto(index int, r repository.BatchResult) *proto.Db_BatchItemResult {
+	p := &proto.Db_BatchItemResult{
+		Index:  int32(index),
+		Status: batchStatusToProto[r.Status],
+		Id:     r.ID,
+		Hash:   r.Hash,
+	}
+	if r.Err != nil {
+		p.Reason = r.Err.Error()
+	}
+	return p
+}
+
 // derefString *stringをstringに変換（nilの場合は空文字列）
 func derefString(s *string) string {
 	if s == nil {
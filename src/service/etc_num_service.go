@@ -2,12 +2,13 @@ package service
 
 import (
 	"context"
+	"sort"
+	"time"
 
+	"github.com/yhonda-ohishi/db_service/src/grpcerr"
 	"github.com/yhonda-ohishi/db_service/src/models/mysql"
 	"github.com/yhonda-ohishi/db_service/src/proto"
 	"github.com/yhonda-ohishi/db_service/src/repository"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 // ETCNumService gRPCサービス実装（本番DB、読み取り専用）
@@ -25,6 +26,23 @@ func NewETCNumService(repo repository.ETCNumRepository) *ETCNumService {
 
 // List ETCカード番号一覧取得
 func (s *ETCNumService) List(ctx context.Context, req *proto.Db_ListETCNumRequest) (*proto.Db_ListETCNumResponse, error) {
+	// キーセットページネーション（page_token指定時）
+	if req.PageToken != nil {
+		page, err := keysetPageRequest(req.Limit, req.Offset, nil, *req.PageToken, req.IncludeTotalCount)
+		if err != nil {
+			return nil, err
+		}
+		result, err := s.repo.GetPage(ctx, page)
+		if err != nil {
+			return nil, grpcerr.FromError(err, "failed to list etc_num")
+		}
+		return &proto.Db_ListETCNumResponse{
+			Items:         convertItems(result.Items, etcNumModelToProto),
+			TotalCount:    totalCountPtr(result.TotalCount),
+			NextPageToken: result.NextPageToken,
+		}, nil
+	}
+
 	limit := int(req.Limit)
 	offset := int(req.Offset)
 
@@ -32,9 +50,9 @@ func (s *ETCNumService) List(ctx context.Context, req *proto.Db_ListETCNumReques
 		limit = 100
 	}
 
-	etcNums, totalCount, err := s.repo.GetAll(limit, offset)
+	etcNums, totalCount, err := s.repo.GetAll(ctx, limit, offset)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list etc_num: %v", err)
+		return nil, grpcerr.FromError(err, "failed to list etc_num")
 	}
 
 	items := make([]*proto.Db_ETCNum, len(etcNums))
@@ -44,15 +62,15 @@ func (s *ETCNumService) List(ctx context.Context, req *proto.Db_ListETCNumReques
 
 	return &proto.Db_ListETCNumResponse{
 		Items:      items,
-		TotalCount: int32(totalCount),
+		TotalCount: int32Ptr(int32(totalCount)),
 	}, nil
 }
 
 // GetByETCCardNum ETCカード番号で取得
 func (s *ETCNumService) GetByETCCardNum(ctx context.Context, req *proto.Db_GetETCNumByETCCardNumRequest) (*proto.Db_ListETCNumResponse, error) {
-	etcNums, err := s.repo.GetByETCCardNum(req.EtcCardNum)
+	etcNums, err := s.repo.GetByETCCardNum(ctx, req.EtcCardNum)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get etc_num by etc_card_num: %v", err)
+		return nil, grpcerr.FromError(err, "failed to get etc_num by etc_card_num")
 	}
 
 	items := make([]*proto.Db_ETCNum, len(etcNums))
@@ -62,15 +80,15 @@ func (s *ETCNumService) GetByETCCardNum(ctx context.Context, req *proto.Db_GetET
 
 	return &proto.Db_ListETCNumResponse{
 		Items:      items,
-		TotalCount: int32(len(etcNums)),
+		TotalCount: int32Ptr(int32(len(etcNums))),
 	}, nil
 }
 
 // GetByCarID 車輌IDで取得
 func (s *ETCNumService) GetByCarID(ctx context.Context, req *proto.Db_GetETCNumByCarIDRequest) (*proto.Db_ListETCNumResponse, error) {
-	etcNums, err := s.repo.GetByCarID(req.CarId)
+	etcNums, err := s.repo.GetByCarID(ctx, req.CarId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get etc_num by car_id: %v", err)
+		return nil, grpcerr.FromError(err, "failed to get etc_num by car_id")
 	}
 
 	items := make([]*proto.Db_ETCNum, len(etcNums))
@@ -80,10 +98,128 @@ func (s *ETCNumService) GetByCarID(ctx context.Context, req *proto.Db_GetETCNumB
 
 	return &proto.Db_ListETCNumResponse{
 		Items:      items,
-		TotalCount: int32(len(etcNums)),
+		TotalCount: int32Ptr(int32(len(etcNums))),
 	}, nil
 }
 
+// GetByETCCardNumAt 指定日時にETCカードが登録されていた車輌を取得
+func (s *ETCNumService) GetByETCCardNumAt(ctx context.Context, req *proto.Db_GetETCNumByETCCardNumAtRequest) (*proto.Db_ListETCNumResponse, error) {
+	if req.EtcCardNum == "" {
+		return nil, grpcerr.InvalidArgument("etc_card_num", "etc_card_num is required")
+	}
+	at, err := parseAsOf(req.At)
+	if err != nil {
+		return nil, err
+	}
+
+	etcNums, err := s.repo.GetByETCCardNumAt(ctx, req.EtcCardNum, at)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to get etc_num by etc_card_num")
+	}
+
+	return &proto.Db_ListETCNumResponse{
+		Items:      convertItems(etcNums, etcNumModelToProto),
+		TotalCount: int32Ptr(int32(len(etcNums))),
+	}, nil
+}
+
+// GetByCarIDAt 指定日時に車輌に登録されていたETCカードを取得
+func (s *ETCNumService) GetByCarIDAt(ctx context.Context, req *proto.Db_GetETCNumByCarIDAtRequest) (*proto.Db_ListETCNumResponse, error) {
+	if req.CarId == "" {
+		return nil, grpcerr.InvalidArgument("car_id", "car_id is required")
+	}
+	at, err := parseAsOf(req.At)
+	if err != nil {
+		return nil, err
+	}
+
+	etcNums, err := s.repo.GetByCarIDAt(ctx, req.CarId, at)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to get etc_num by car_id")
+	}
+
+	return &proto.Db_ListETCNumResponse{
+		Items:      convertItems(etcNums, etcNumModelToProto),
+		TotalCount: int32Ptr(int32(len(etcNums))),
+	}, nil
+}
+
+// ListOverlaps 同じETCカードが有効期間の重なる複数の車輌に登録されているデータを検出
+func (s *ETCNumService) ListOverlaps(ctx context.Context, req *proto.Db_ListETCNumOverlapsRequest) (*proto.Db_ListETCNumOverlapsResponse, error) {
+	var etcNums []*mysql.ETCNum
+	var err error
+	if req.EtcCardNum != nil && *req.EtcCardNum != "" {
+		etcNums, err = s.repo.GetByETCCardNum(ctx, *req.EtcCardNum)
+	} else {
+		etcNums, err = s.repo.GetSharedCards(ctx)
+	}
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to get etc_num")
+	}
+
+	// ETCカードごとに全ての組み合わせを比較
+	cards := make(map[string][]*mysql.ETCNum)
+	var cardNums []string
+	for _, etcNum := range etcNums {
+		if _, ok := cards[etcNum.ETCCardNum]; !ok {
+			cardNums = append(cardNums, etcNum.ETCCardNum)
+		}
+		cards[etcNum.ETCCardNum] = append(cards[etcNum.ETCCardNum], etcNum)
+	}
+	sort.Strings(cardNums)
+
+	resp := &proto.Db_ListETCNumOverlapsResponse{}
+	for _, cardNum := range cardNums {
+		cars := cards[cardNum]
+		sort.Slice(cars, func(i, j int) bool { return cars[i].CarID < cars[j].CarID })
+		for i, first := range cars {
+			for _, second := range cars[i+1:] {
+				if first.Overlaps(second) {
+					resp.Overlaps = append(resp.Overlaps, etcNumOverlapToProto(first, second))
+				}
+			}
+		}
+	}
+	return resp, nil
+}
+
+// parseAsOf 基準日時を解釈（空の場合は現在日時）
+func parseAsOf(value string) (time.Time, error) {
+	if value == "" {
+		return time.Now(), nil
+	}
+	at, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, grpcerr.InvalidArgument("at", "invalid at format: %v", err)
+	}
+	return at, nil
+}
+
+// etcNumOverlapToProto 有効期間の重なる登録の変換（重なる期間は遅い方の開始日時から早い方の終了日時まで）
+func etcNumOverlapToProto(first, second *mysql.ETCNum) *proto.Db_ETCNumOverlap {
+	overlap := &proto.Db_ETCNumOverlap{
+		EtcCardNum: first.ETCCardNum,
+		First:      etcNumModelToProto(first),
+		Second:     etcNumModelToProto(second),
+	}
+
+	start := first.StartDateTime
+	if start == nil || (second.StartDateTime != nil && second.StartDateTime.After(*start)) {
+		start = second.StartDateTime
+	}
+	end := first.DueDateTime
+	if end == nil || (second.DueDateTime != nil && second.DueDateTime.Before(*end)) {
+		end = second.DueDateTime
+	}
+	if start != nil {
+		overlap.OverlapStart = stringPtr(start.Format(time.RFC3339))
+	}
+	if end != nil {
+		overlap.OverlapEnd = stringPtr(end.Format(time.RFC3339))
+	}
+	return overlap
+}
+
 // etcNumModelToProto ModelからProtoへの変換
 func etcNumModelToProto(model *mysql.ETCNum) *proto.Db_ETCNum {
 	protoETCNum := &proto.Db_ETCNum{
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"github.com/yhonda-ohishi/db_service/src/models/ichibanboshi"
+	pb "github.com/yhonda-ohishi/db_service/src/proto"
+)
+
+// convertHinmeiMasterToProto GORMモデルをProtoメッセージに変換
+func convertHinmeiMasterToProto(m *ichibanboshi.HinmeiMaster) *pb.Db_HinmeiMaster {
+	return &pb.Db_HinmeiMaster{
+		HinmeiC:   m.HinmeiC,
+		HinmeiH:   m.HinmeiH,
+		HinmeiN:   m.HinmeiN,
+		HinmeiR:   m.HinmeiR,
+		HinmeiF:   m.HinmeiF,
+		Tani:      m.Tani,
+		TaniJuryo: m.TaniJuryo,
+	}
+}
+
+// convertTokuisakiHinmeiMasterToProto GORMモデルをProtoメッセージに変換
+func convertTokuisakiHinmeiMasterToProto(m *ichibanboshi.TokuisakiHinmeiMaster) *pb.Db_TokuisakiHinmeiMaster {
+	return &pb.Db_TokuisakiHinmeiMaster{
+		TokuisakiC: m.TokuisakiC,
+		TokuisakiH: m.TokuisakiH,
+		HinmeiC:    m.HinmeiC,
+		HinmeiH:    m.HinmeiH,
+		HinmeiN:    m.HinmeiN,
+		HinmeiR:    m.HinmeiR,
+		Tani:       m.Tani,
+		TaniJuryo:  m.TaniJuryo,
+	}
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+
+	"github.com/yhonda-ohishi/db_service/src/grpcerr"
+	pb "github.com/yhonda-ohishi/db_service/src/proto"
+	"github.com/yhonda-ohishi/db_service/src/repository"
+)
+
+// HinmeiMasterService 品名マスタサービス
+type HinmeiMasterService struct {
+	pb.UnimplementedDb_HinmeiMasterServiceServer
+	repo repository.HinmeiMasterRepository
+}
+
+// NewHinmeiMasterService コンストラクタ
+func NewHinmeiMasterService(repo repository.HinmeiMasterRepository) *HinmeiMasterService {
+	return &HinmeiMasterService{
+		repo: repo,
+	}
+}
+
+// Get 品名C、品名Hで品名マスタを取得
+func (s *HinmeiMasterService) Get(ctx context.Context, req *pb.Db_GetHinmeiMasterRequest) (*pb.Db_HinmeiMasterResponse, error) {
+	hinmei, err := s.repo.GetByHinmeiC(ctx, req.HinmeiC, req.HinmeiH)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "品名マスタの取得に失敗しました")
+	}
+
+	return &pb.Db_HinmeiMasterResponse{
+		HinmeiMaster: convertHinmeiMasterToProto(hinmei),
+	}, nil
+}
+
+// List 品名マスタのリストを取得
+func (s *HinmeiMasterService) List(ctx context.Context, req *pb.Db_ListHinmeiMasterRequest) (*pb.Db_ListHinmeiMasterResponse, error) {
+	// ソート条件（sort / 非推奨のorder_by）
+	sort, err := sortFields(req.Sort, req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	// キーセットページネーション（page_token指定時）
+	if req.PageToken != nil {
+		page, err := keysetPageRequest(req.Limit, req.Offset, sort, *req.PageToken, req.IncludeTotalCount)
+		if err != nil {
+			return nil, err
+		}
+		result, err := s.repo.GetPage(ctx, page)
+		if err != nil {
+			return nil, grpcerr.FromError(err, "品名マスタの取得に失敗しました")
+		}
+		return &pb.Db_ListHinmeiMasterResponse{
+			Items:         convertItems(result.Items, convertHinmeiMasterToProto),
+			TotalCount:    totalCountPtr(result.TotalCount),
+			NextPageToken: result.NextPageToken,
+		}, nil
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 10
+	}
+	offset := int(req.Offset)
+
+	hinmeiList, totalCount, err := s.repo.GetAll(ctx, limit, offset, sort)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "品名マスタの取得に失敗しました")
+	}
+
+	return &pb.Db_ListHinmeiMasterResponse{
+		Items:      convertItems(hinmeiList, convertHinmeiMasterToProto),
+		TotalCount: int32Ptr(int32(totalCount)),
+	}, nil
+}
+
+// Search 品名N・品名R・品名F（カナ）の部分一致で品名マスタを検索
+func (s *HinmeiMasterService) Search(ctx context.Context, req *pb.Db_SearchHinmeiMasterRequest) (*pb.Db_ListHinmeiMasterResponse, error) {
+	if req.Query == "" {
+		return nil, grpcerr.InvalidArgument("query", "query is required")
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = repository.DefaultPageSize
+	}
+	if limit > repository.MaxPageSize {
+		limit = repository.MaxPageSize
+	}
+
+	hinmeiList, err := s.repo.Search(ctx, req.Query, limit)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "品名マスタの検索に失敗しました")
+	}
+
+	return &pb.Db_ListHinmeiMasterResponse{
+		Items:      convertItems(hinmeiList, convertHinmeiMasterToProto),
+		TotalCount: int32Ptr(int32(len(hinmeiList))),
+	}, nil
+}
+
+// ListTokuisakiHinmei 得意先の得意先別品名マスタを取得
+func (s *HinmeiMasterService) ListTokuisakiHinmei(ctx context.Context, req *pb.Db_ListTokuisakiHinmeiRequest) (*pb.Db_ListTokuisakiHinmeiResponse, error) {
+	hinmeiList, err := s.repo.GetTokuisakiHinmeiList(ctx, req.TokuisakiC, req.TokuisakiH)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "得意先別品名マスタの取得に失敗しました")
+	}
+
+	return &pb.Db_ListTokuisakiHinmeiResponse{
+		Items: convertItems(hinmeiList, convertTokuisakiHinmeiMasterToProto),
+	}, nil
+}
+
+// GetTokuisakiHinmei 得意先C、得意先H、品名C、品名Hで得意先別品名マスタを取得
+func (s *HinmeiMasterService) GetTokuisakiHinmei(ctx context.Context, req *pb.Db_GetTokuisakiHinmeiRequest) (*pb.Db_TokuisakiHinmeiResponse, error) {
+	hinmei, err := s.repo.GetTokuisakiHinmei(ctx, req.TokuisakiC, req.TokuisakiH, req.HinmeiC, req.HinmeiH)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "得意先別品名マスタの取得に失敗しました")
+	}
+
+	return &pb.Db_TokuisakiHinmeiResponse{
+		TokuisakiHinmei: convertTokuisakiHinmeiMasterToProto(hinmei),
+	}, nil
+}
+
+// Resolve 得意先別品名マスタを反映した品名・単位・単位重量を取得
+func (s *HinmeiMasterService) Resolve(ctx context.Context, req *pb.Db_ResolveHinmeiRequest) (*pb.Db_ResolveHinmeiResponse, error) {
+	resolved, err := s.repo.Resolve(ctx, req.TokuisakiC, req.TokuisakiH, req.HinmeiC, req.HinmeiH)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "品名の解決に失敗しました")
+	}
+
+	return &pb.Db_ResolveHinmeiResponse{
+		TokuisakiC:        req.TokuisakiC,
+		TokuisakiH:        req.TokuisakiH,
+		HinmeiC:           req.HinmeiC,
+		HinmeiH:           req.HinmeiH,
+		HinmeiN:           resolved.HinmeiN,
+		Tani:              resolved.Tani,
+		TaniJuryo:         resolved.TaniJuryo,
+		TokuisakiSpecific: resolved.TokuisakiSpecific,
+	}, nil
+}
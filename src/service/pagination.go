@@ -0,0 +1,42 @@
+package service
+
+import (
+	"github.com/yhonda-ohishi/db_service/src/grpcerr"
+	"github.com/yhonda-ohishi/db_service/src/repository"
+)
+
+// keysetPageRequest page_token指定時のリクエストを検証してPageRequestに変換
+// キーセットページネーションでは並び順が固定のため、offset/sort/order_byとは併用できない
+func keysetPageRequest(limit, offset int32, sort []repository.SortField, pageToken string, includeTotalCount bool) (repository.PageRequest, error) {
+	if offset != 0 {
+		return repository.PageRequest{}, grpcerr.InvalidArgument("offset", "offset cannot be used with page_token")
+	}
+	if len(sort) > 0 {
+		return repository.PageRequest{}, grpcerr.InvalidArgument("sort", "sort and order_by cannot be used with page_token")
+	}
+	if limit < 0 {
+		return repository.PageRequest{}, grpcerr.InvalidArgument("limit", "limit must be non-negative")
+	}
+	return repository.PageRequest{
+		PageSize:          int(limit),
+		PageToken:         pageToken,
+		IncludeTotalCount: includeTotalCount,
+	}, nil
+}
+
+// totalCountPtr 総件数を *int32 に変換（未取得の場合はnil）
+func totalCountPtr(totalCount *int64) *int32 {
+	if totalCount == nil {
+		return nil
+	}
+	return int32Ptr(int32(*totalCount))
+}
+
+// convertItems モデルのスライスをProtoのスライスに変換
+func convertItems[M any, P any](models []*M, convert func(*M) *P) []*P {
+	items := make([]*P, len(models))
+	for i, model := range models {
+		items[i] = convert(model)
+	}
+	return items
+}
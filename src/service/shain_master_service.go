@@ -3,10 +3,9 @@ package service
 import (
 	"context"
 
+	"github.com/yhonda-ohishi/db_service/src/grpcerr"
 	pb "github.com/yhonda-ohishi/db_service/src/proto"
 	"github.com/yhonda-ohishi/db_service/src/repository"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 // ShainMasterService 社員マスタサービス
@@ -24,9 +23,9 @@ func NewShainMasterService(repo repository.ShainMasterRepository) *ShainMasterSe
 
 // Get 単一の社員マスタを取得
 func (s *ShainMasterService) Get(ctx context.Context, req *pb.Db_GetShainMasterRequest) (*pb.Db_ShainMasterResponse, error) {
-	shain, err := s.repo.GetByShainC(req.ShainC)
+	shain, err := s.repo.GetByShainC(ctx, req.ShainC)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "社員マスタが見つかりません: %v", err)
+		return nil, grpcerr.FromError(err, "社員マスタの取得に失敗しました")
 	}
 
 	return &pb.Db_ShainMasterResponse{
@@ -36,20 +35,38 @@ func (s *ShainMasterService) Get(ctx context.Context, req *pb.Db_GetShainMasterR
 
 // List 社員マスタのリストを取得
 func (s *ShainMasterService) List(ctx context.Context, req *pb.Db_ListShainMasterRequest) (*pb.Db_ListShainMasterResponse, error) {
+	// ソート条件（sort / 非推奨のorder_by）
+	sort, err := sortFields(req.Sort, req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	// キーセットページネーション（page_token指定時）
+	if req.PageToken != nil {
+		page, err := keysetPageRequest(req.Limit, req.Offset, sort, *req.PageToken, req.IncludeTotalCount)
+		if err != nil {
+			return nil, err
+		}
+		result, err := s.repo.GetPage(ctx, page)
+		if err != nil {
+			return nil, grpcerr.FromError(err, "社員マスタの取得に失敗しました")
+		}
+		return &pb.Db_ListShainMasterResponse{
+			Items:         convertItems(result.Items, convertShainMasterToProto),
+			TotalCount:    totalCountPtr(result.TotalCount),
+			NextPageToken: result.NextPageToken,
+		}, nil
+	}
+
 	limit := int(req.Limit)
 	if limit <= 0 {
 		limit = 10
 	}
 	offset := int(req.Offset)
 
-	orderBy := ""
-	if req.OrderBy != nil {
-		orderBy = *req.OrderBy
-	}
-
-	shainList, totalCount, err := s.repo.GetAll(limit, offset, orderBy)
+	shainList, totalCount, err := s.repo.GetAll(ctx, limit, offset, sort)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "社員マスタの取得に失敗しました: %v", err)
+		return nil, grpcerr.FromError(err, "社員マスタの取得に失敗しました")
 	}
 
 	pbShainList := make([]*pb.Db_ShainMaster, len(shainList))
@@ -59,15 +76,15 @@ func (s *ShainMasterService) List(ctx context.Context, req *pb.Db_ListShainMaste
 
 	return &pb.Db_ListShainMasterResponse{
 		Items:      pbShainList,
-		TotalCount: int32(totalCount),
+		TotalCount: int32Ptr(int32(totalCount)),
 	}, nil
 }
 
 // GetByBumonC 部門Cで社員マスタを取得
 func (s *ShainMasterService) GetByBumonC(ctx context.Context, req *pb.Db_GetShainMasterByBumonCRequest) (*pb.Db_ListShainMasterResponse, error) {
-	shainList, err := s.repo.GetByBumonC(req.BumonC)
+	shainList, err := s.repo.GetByBumonC(ctx, req.BumonC)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "部門Cでの社員マスタの取得に失敗しました: %v", err)
+		return nil, grpcerr.FromError(err, "部門Cでの社員マスタの取得に失敗しました")
 	}
 
 	pbShainList := make([]*pb.Db_ShainMaster, len(shainList))
@@ -77,6 +94,6 @@ func (s *ShainMasterService) GetByBumonC(ctx context.Context, req *pb.Db_GetShai
 
 	return &pb.Db_ListShainMasterResponse{
 		Items:      pbShainList,
-		TotalCount: int32(len(shainList)),
+		TotalCount: int32Ptr(int32(len(shainList))),
 	}, nil
 }
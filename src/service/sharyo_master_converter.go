@@ -0,0 +1,19 @@
+package service
+
+import (
+	"github.com/yhonda-ohishi/db_service/src/models/ichibanboshi"
+	pb "github.com/yhonda-ohishi/db_service/src/proto"
+)
+
+// convertSharyoMasterToProto GORMモデルをProtoメッセージに変換
+func convertSharyoMasterToProto(m *ichibanboshi.SharyoMaster) *pb.Db_SharyoMaster {
+	return &pb.Db_SharyoMaster{
+		SharyoC:   m.SharyoC,
+		SharyoH:   m.SharyoH,
+		SharyoN:   m.SharyoN,
+		SharyoR:   m.SharyoR,
+		ShashuC:   m.ShashuC,
+		BumonC:    m.BumonC,
+		UntenshuC: m.UntenshuC,
+	}
+}
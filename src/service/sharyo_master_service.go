@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+
+	"github.com/yhonda-ohishi/db_service/src/grpcerr"
+	pb "github.com/yhonda-ohishi/db_service/src/proto"
+	"github.com/yhonda-ohishi/db_service/src/repository"
+)
+
+// SharyoMasterService 車輌マスタサービス
+type SharyoMasterService struct {
+	pb.UnimplementedDb_SharyoMasterServiceServer
+	repo repository.SharyoMasterRepository
+}
+
+// NewSharyoMasterService コンストラクタ
+func NewSharyoMasterService(repo repository.SharyoMasterRepository) *SharyoMasterService {
+	return &SharyoMasterService{
+		repo: repo,
+	}
+}
+
+// Get 車輌C、車輌Hで車輌マスタを取得
+func (s *SharyoMasterService) Get(ctx context.Context, req *pb.Db_GetSharyoMasterRequest) (*pb.Db_SharyoMasterResponse, error) {
+	sharyo, err := s.repo.GetBySharyoC(ctx, req.SharyoC, req.SharyoH)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "車輌マスタの取得に失敗しました")
+	}
+
+	return &pb.Db_SharyoMasterResponse{
+		SharyoMaster: convertSharyoMasterToProto(sharyo),
+	}, nil
+}
+
+// ListBySharyoC 車輌Cの車輌マスタを全車輌H取得
+func (s *SharyoMasterService) ListBySharyoC(ctx context.Context, req *pb.Db_ListSharyoMasterBySharyoCRequest) (*pb.Db_ListSharyoMasterResponse, error) {
+	sharyoList, err := s.repo.GetAllBySharyoC(ctx, req.SharyoC)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "車輌マスタの取得に失敗しました")
+	}
+
+	return &pb.Db_ListSharyoMasterResponse{
+		Items:      convertItems(sharyoList, convertSharyoMasterToProto),
+		TotalCount: int32Ptr(int32(len(sharyoList))),
+	}, nil
+}
+
+// List 車輌マスタのリストを取得
+func (s *SharyoMasterService) List(ctx context.Context, req *pb.Db_ListSharyoMasterRequest) (*pb.Db_ListSharyoMasterResponse, error) {
+	// ソート条件（sort / 非推奨のorder_by）
+	sort, err := sortFields(req.Sort, req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	// キーセットページネーション（page_token指定時）
+	if req.PageToken != nil {
+		page, err := keysetPageRequest(req.Limit, req.Offset, sort, *req.PageToken, req.IncludeTotalCount)
+		if err != nil {
+			return nil, err
+		}
+		result, err := s.repo.GetPage(ctx, page)
+		if err != nil {
+			return nil, grpcerr.FromError(err, "車輌マスタの取得に失敗しました")
+		}
+		return &pb.Db_ListSharyoMasterResponse{
+			Items:         convertItems(result.Items, convertSharyoMasterToProto),
+			TotalCount:    totalCountPtr(result.TotalCount),
+			NextPageToken: result.NextPageToken,
+		}, nil
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 10
+	}
+	offset := int(req.Offset)
+
+	sharyoList, totalCount, err := s.repo.GetAll(ctx, limit, offset, sort)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "車輌マスタの取得に失敗しました")
+	}
+
+	return &pb.Db_ListSharyoMasterResponse{
+		Items:      convertItems(sharyoList, convertSharyoMasterToProto),
+		TotalCount: int32Ptr(int32(totalCount)),
+	}, nil
+}
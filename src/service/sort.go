@@ -0,0 +1,65 @@
+package service
+
+import (
+	"strings"
+
+	"github.com/yhonda-ohishi/db_service/src/grpcerr"
+	"github.com/yhonda-ohishi/db_service/src/proto"
+	"github.com/yhonda-ohishi/db_service/src/repository"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// sortSpec 一覧ごとのソート条件（db_*SortSpec）
+type sortSpec interface {
+	ProtoReflect() protoreflect.Message
+	GetDirection() proto.Db_SortDirection
+}
+
+// sortFieldMarker db_*SortFieldの値名でprotoのフィールド名の前に付く部分（例: DTAKO_EVENTS_SORT_FIELD_START_DATETIME）
+const sortFieldMarker = "_SORT_FIELD_"
+
+// sortFields sortと非推奨のorder_byをリポジトリのソート条件に変換
+// sortのフィールドはdb_*SortFieldの値名からprotoのフィールド名に変換し、列名へはリポジトリのホワイトリストで変換する
+func sortFields[S sortSpec](sort []S, orderBy *string) ([]repository.SortField, error) {
+	hasOrderBy := orderBy != nil && *orderBy != ""
+	if len(sort) > 0 && hasOrderBy {
+		return nil, grpcerr.InvalidArgument("order_by", "sort and order_by cannot be used together")
+	}
+
+	if hasOrderBy {
+		fields, err := repository.ParseOrderBy(*orderBy)
+		if err != nil {
+			return nil, grpcerr.InvalidArgument("order_by", "%v", err)
+		}
+		return fields, nil
+	}
+
+	fields := make([]repository.SortField, 0, len(sort))
+	for _, s := range sort {
+		field, err := sortFieldName(s)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, repository.SortField{
+			Field: field,
+			Desc:  s.GetDirection() == proto.Db_SortDirection_SORT_DIRECTION_DESC,
+		})
+	}
+	return fields, nil
+}
+
+// sortFieldName ソート条件のfieldに対応するprotoのフィールド名
+func sortFieldName(s sortSpec) (string, error) {
+	m := s.ProtoReflect()
+	fd := m.Descriptor().Fields().ByName("field")
+	number := m.Get(fd).Enum()
+	if number == 0 {
+		return "", grpcerr.InvalidArgument("sort.field", "sort field is required")
+	}
+	value := fd.Enum().Values().ByNumber(number)
+	if value == nil {
+		return "", grpcerr.InvalidArgument("sort.field", "unknown sort field: %d", number)
+	}
+	_, field, _ := strings.Cut(string(value.Name()), sortFieldMarker)
+	return strings.ToLower(field), nil
+}
@@ -0,0 +1,16 @@
+package service
+
+import "google.golang.org/grpc"
+
+// sendItems リポジトリから取得したレコードをProtoに変換してストリームに送信する関数を作成
+// Sendはクライアントの受信が追いつくまでブロックするため、取得もそれに合わせて待機する（フロー制御）
+func sendItems[M any, P any](stream grpc.ServerStreamingServer[P], convert func(*M) *P) func([]*M) error {
+	return func(models []*M) error {
+		for _, model := range models {
+			if err := stream.Send(convert(model)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
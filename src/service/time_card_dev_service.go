@@ -4,11 +4,10 @@ import (
 	"context"
 	"time"
 
+	"github.com/yhonda-ohishi/db_service/src/grpcerr"
 	"github.com/yhonda-ohishi/db_service/src/models/mysql"
 	"github.com/yhonda-ohishi/db_service/src/proto"
 	"github.com/yhonda-ohishi/db_service/src/repository"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 // TimeCardDevService gRPCサービス実装（ローカルDB、読み書き可能）
@@ -27,18 +26,18 @@ func NewTimeCardDevService(repo repository.TimeCardDevRepository) *TimeCardDevSe
 // Create タイムカードデータ作成
 func (s *TimeCardDevService) Create(ctx context.Context, req *proto.Db_CreateTimeCardRequest) (*proto.Db_TimeCardResponse, error) {
 	if req.TimeCard == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "time_card is required")
+		return nil, grpcerr.InvalidArgument("time_card", "time_card is required")
 	}
 
 	// ProtoからModelへの変換
 	timeCard, err := protoToTimeCardModel(req.TimeCard)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid time_card: %v", err)
+		return nil, err
 	}
 
 	// 作成
-	if err := s.repo.Create(timeCard); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create time_card: %v", err)
+	if err := s.repo.Create(ctx, timeCard); err != nil {
+		return nil, grpcerr.FromError(err, "failed to create time_card")
 	}
 
 	return &proto.Db_TimeCardResponse{
@@ -51,12 +50,12 @@ func (s *TimeCardDevService) Get(ctx context.Context, req *proto.Db_GetTimeCardR
 	// RFC3339形式の文字列をパース
 	datetime, err := time.Parse(time.RFC3339, req.Datetime)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid datetime format: %v", err)
+		return nil, grpcerr.InvalidArgument("datetime", "invalid datetime format: %v", err)
 	}
 
-	timeCard, err := s.repo.GetByCompositeKey(datetime, int(req.Id))
+	timeCard, err := s.repo.GetByCompositeKey(ctx, datetime, int(req.Id))
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "time_card not found: %v", err)
+		return nil, grpcerr.FromError(err, "failed to get time_card")
 	}
 
 	return &proto.Db_TimeCardResponse{
@@ -67,18 +66,18 @@ func (s *TimeCardDevService) Get(ctx context.Context, req *proto.Db_GetTimeCardR
 // Update タイムカードデータ更新
 func (s *TimeCardDevService) Update(ctx context.Context, req *proto.Db_UpdateTimeCardRequest) (*proto.Db_TimeCardResponse, error) {
 	if req.TimeCard == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "time_card is required")
+		return nil, grpcerr.InvalidArgument("time_card", "time_card is required")
 	}
 
 	// ProtoからModelへの変換
 	timeCard, err := protoToTimeCardModel(req.TimeCard)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid time_card: %v", err)
+		return nil, err
 	}
 
 	// 更新
-	if err := s.repo.Update(timeCard); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update time_card: %v", err)
+	if err := s.repo.Update(ctx, timeCard); err != nil {
+		return nil, grpcerr.FromError(err, "failed to update time_card")
 	}
 
 	return &proto.Db_TimeCardResponse{
@@ -91,11 +90,11 @@ func (s *TimeCardDevService) Delete(ctx context.Context, req *proto.Db_DeleteTim
 	// RFC3339形式の文字列をパース
 	datetime, err := time.Parse(time.RFC3339, req.Datetime)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid datetime format: %v", err)
+		return nil, grpcerr.InvalidArgument("datetime", "invalid datetime format: %v", err)
 	}
 
-	if err := s.repo.Delete(datetime, int(req.Id)); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete time_card: %v", err)
+	if err := s.repo.Delete(ctx, datetime, int(req.Id)); err != nil {
+		return nil, grpcerr.FromError(err, "failed to delete time_card")
 	}
 
 	return &proto.Db_Empty{}, nil
@@ -103,19 +102,37 @@ func (s *TimeCardDevService) Delete(ctx context.Context, req *proto.Db_DeleteTim
 
 // List タイムカードデータ一覧取得
 func (s *TimeCardDevService) List(ctx context.Context, req *proto.Db_ListTimeCardRequest) (*proto.Db_ListTimeCardResponse, error) {
+	// ソート条件（sort / 非推奨のorder_by）
+	sort, err := sortFields(req.Sort, req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	// キーセットページネーション（page_token指定時）
+	if req.PageToken != nil {
+		page, err := keysetPageRequest(req.Limit, req.Offset, sort, *req.PageToken, req.IncludeTotalCount)
+		if err != nil {
+			return nil, err
+		}
+		result, err := s.repo.GetPage(ctx, page)
+		if err != nil {
+			return nil, grpcerr.FromError(err, "failed to list time_cards")
+		}
+		return &proto.Db_ListTimeCardResponse{
+			Items:         convertItems(result.Items, timeCardModelToProto),
+			TotalCount:    totalCountPtr(result.TotalCount),
+			NextPageToken: result.NextPageToken,
+		}, nil
+	}
+
 	limit := int(req.Limit)
 	if limit <= 0 {
 		limit = 100
 	}
 	offset := int(req.Offset)
-	orderBy := ""
-	if req.OrderBy != nil {
-		orderBy = *req.OrderBy
-	}
-
-	timeCards, totalCount, err := s.repo.GetAll(limit, offset, orderBy)
+	timeCards, totalCount, err := s.repo.GetAll(ctx, limit, offset, sort)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list time_cards: %v", err)
+		return nil, grpcerr.FromError(err, "failed to list time_cards")
 	}
 
 	items := make([]*proto.Db_TimeCard, len(timeCards))
@@ -125,7 +142,7 @@ func (s *TimeCardDevService) List(ctx context.Context, req *proto.Db_ListTimeCar
 
 	return &proto.Db_ListTimeCardResponse{
 		Items:      items,
-		TotalCount: int32(totalCount),
+		TotalCount: int32Ptr(int32(totalCount)),
 	}, nil
 }
 
@@ -133,15 +150,15 @@ func (s *TimeCardDevService) List(ctx context.Context, req *proto.Db_ListTimeCar
 func protoToTimeCardModel(pb *proto.Db_TimeCard) (*mysql.TimeCard, error) {
 	datetime, err := time.Parse(time.RFC3339, pb.Datetime)
 	if err != nil {
-		return nil, err
+		return nil, grpcerr.InvalidArgument("time_card.datetime", "invalid time_card: invalid datetime format: %v", err)
 	}
 	created, err := time.Parse(time.RFC3339, pb.Created)
 	if err != nil {
-		return nil, err
+		return nil, grpcerr.InvalidArgument("time_card.created", "invalid time_card: invalid created format: %v", err)
 	}
 	modified, err := time.Parse(time.RFC3339, pb.Modified)
 	if err != nil {
-		return nil, err
+		return nil, grpcerr.InvalidArgument("time_card.modified", "invalid time_card: invalid modified format: %v", err)
 	}
 
 	return &mysql.TimeCard{
@@ -4,11 +4,10 @@ import (
 	"context"
 	"time"
 
+	"github.com/yhonda-ohishi/db_service/src/grpcerr"
 	"github.com/yhonda-ohishi/db_service/src/models/mysql"
 	proto "github.com/yhonda-ohishi/db_service/src/proto"
 	"github.com/yhonda-ohishi/db_service/src/repository"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 // TimeCardLogService タイムカードログサービス（ローカルDB、読み書き可能）
@@ -26,13 +25,17 @@ func NewTimeCardLogService(repo repository.TimeCardLogRepository) *TimeCardLogSe
 
 // Create タイムカードログ作成
 func (s *TimeCardLogService) Create(ctx context.Context, req *proto.Db_CreateTimeCardLogRequest) (*proto.Db_TimeCardLogResponse, error) {
+	if req.Log == nil {
+		return nil, grpcerr.InvalidArgument("log", "log is required")
+	}
+
 	log, err := protoToTimeCardLogModel(req.Log)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid log: %v", err)
+		return nil, err
 	}
 
-	if err := s.repo.Create(log); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create log: %v", err)
+	if err := s.repo.Create(ctx, log); err != nil {
+		return nil, grpcerr.FromError(err, "failed to create log")
 	}
 
 	return &proto.Db_TimeCardLogResponse{
@@ -42,9 +45,9 @@ func (s *TimeCardLogService) Create(ctx context.Context, req *proto.Db_CreateTim
 
 // Get タイムカードログ取得（複合主キー）
 func (s *TimeCardLogService) Get(ctx context.Context, req *proto.Db_GetTimeCardLogRequest) (*proto.Db_TimeCardLogResponse, error) {
-	log, err := s.repo.GetByCompositeKey(req.Datetime, int(req.Id))
+	log, err := s.repo.GetByCompositeKey(ctx, req.Datetime, int(req.Id))
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "log not found: %v", err)
+		return nil, grpcerr.FromError(err, "failed to get log")
 	}
 
 	return &proto.Db_TimeCardLogResponse{
@@ -54,13 +57,17 @@ func (s *TimeCardLogService) Get(ctx context.Context, req *proto.Db_GetTimeCardL
 
 // Update タイムカードログ更新
 func (s *TimeCardLogService) Update(ctx context.Context, req *proto.Db_UpdateTimeCardLogRequest) (*proto.Db_TimeCardLogResponse, error) {
+	if req.Log == nil {
+		return nil, grpcerr.InvalidArgument("log", "log is required")
+	}
+
 	log, err := protoToTimeCardLogModel(req.Log)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid log: %v", err)
+		return nil, err
 	}
 
-	if err := s.repo.Update(log); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update log: %v", err)
+	if err := s.repo.Update(ctx, log); err != nil {
+		return nil, grpcerr.FromError(err, "failed to update log")
 	}
 
 	return &proto.Db_TimeCardLogResponse{
@@ -70,8 +77,8 @@ func (s *TimeCardLogService) Update(ctx context.Context, req *proto.Db_UpdateTim
 
 // Delete タイムカードログ削除
 func (s *TimeCardLogService) Delete(ctx context.Context, req *proto.Db_DeleteTimeCardLogRequest) (*proto.Db_Empty, error) {
-	if err := s.repo.Delete(req.Datetime, int(req.Id)); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete log: %v", err)
+	if err := s.repo.Delete(ctx, req.Datetime, int(req.Id)); err != nil {
+		return nil, grpcerr.FromError(err, "failed to delete log")
 	}
 
 	return &proto.Db_Empty{}, nil
@@ -79,16 +86,34 @@ func (s *TimeCardLogService) Delete(ctx context.Context, req *proto.Db_DeleteTim
 
 // List タイムカードログ一覧取得
 func (s *TimeCardLogService) List(ctx context.Context, req *proto.Db_ListTimeCardLogRequest) (*proto.Db_ListTimeCardLogResponse, error) {
-	limit := int(req.Limit)
-	offset := int(req.Offset)
-	orderBy := ""
-	if req.OrderBy != nil {
-		orderBy = *req.OrderBy
+	// ソート条件（sort / 非推奨のorder_by）
+	sort, err := sortFields(req.Sort, req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	// キーセットページネーション（page_token指定時）
+	if req.PageToken != nil {
+		page, err := keysetPageRequest(req.Limit, req.Offset, sort, *req.PageToken, req.IncludeTotalCount)
+		if err != nil {
+			return nil, err
+		}
+		result, err := s.repo.GetPage(ctx, page)
+		if err != nil {
+			return nil, grpcerr.FromError(err, "failed to list logs")
+		}
+		return &proto.Db_ListTimeCardLogResponse{
+			Items:         convertItems(result.Items, timeCardLogModelToProto),
+			TotalCount:    totalCountPtr(result.TotalCount),
+			NextPageToken: result.NextPageToken,
+		}, nil
 	}
 
-	logs, totalCount, err := s.repo.GetAll(limit, offset, orderBy)
+	limit := int(req.Limit)
+	offset := int(req.Offset)
+	logs, totalCount, err := s.repo.GetAll(ctx, limit, offset, sort)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list logs: %v", err)
+		return nil, grpcerr.FromError(err, "failed to list logs")
 	}
 
 	items := make([]*proto.Db_TimeCardLog, len(logs))
@@ -98,7 +123,7 @@ func (s *TimeCardLogService) List(ctx context.Context, req *proto.Db_ListTimeCar
 
 	return &proto.Db_ListTimeCardLogResponse{
 		Items:      items,
-		TotalCount: int32(totalCount),
+		TotalCount: int32Ptr(int32(totalCount)),
 	}, nil
 }
 
@@ -107,9 +132,9 @@ func (s *TimeCardLogService) GetByCardID(ctx context.Context, req *proto.Db_GetB
 	limit := int(req.Limit)
 	offset := int(req.Offset)
 
-	logs, totalCount, err := s.repo.GetByCardID(req.CardId, limit, offset)
+	logs, totalCount, err := s.repo.GetByCardID(ctx, req.CardId, limit, offset)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get logs by card_id: %v", err)
+		return nil, grpcerr.FromError(err, "failed to get logs by card_id")
 	}
 
 	items := make([]*proto.Db_TimeCardLog, len(logs))
@@ -119,7 +144,7 @@ func (s *TimeCardLogService) GetByCardID(ctx context.Context, req *proto.Db_GetB
 
 	return &proto.Db_ListTimeCardLogResponse{
 		Items:      items,
-		TotalCount: int32(totalCount),
+		TotalCount: int32Ptr(int32(totalCount)),
 	}, nil
 }
 
@@ -127,12 +152,12 @@ func (s *TimeCardLogService) GetByCardID(ctx context.Context, req *proto.Db_GetB
 func protoToTimeCardLogModel(pb *proto.Db_TimeCardLog) (*mysql.TimeCardLog, error) {
 	created, err := time.Parse(time.RFC3339, pb.Created)
 	if err != nil {
-		return nil, err
+		return nil, grpcerr.InvalidArgument("log.created", "invalid log: invalid created format: %v", err)
 	}
 
 	modified, err := time.Parse(time.RFC3339, pb.Modified)
 	if err != nil {
-		return nil, err
+		return nil, grpcerr.InvalidArgument("log.modified", "invalid log: invalid modified format: %v", err)
 	}
 
 	return &mysql.TimeCardLog{
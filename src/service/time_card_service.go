@@ -4,11 +4,10 @@ import (
 	"context"
 	"time"
 
+	"github.com/yhonda-ohishi/db_service/src/grpcerr"
 	"github.com/yhonda-ohishi/db_service/src/models/mysql"
 	"github.com/yhonda-ohishi/db_service/src/proto"
 	"github.com/yhonda-ohishi/db_service/src/repository"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 // TimeCardService gRPCサービス実装（本番DB、読み取り専用）
@@ -29,12 +28,12 @@ func (s *TimeCardService) Get(ctx context.Context, req *proto.Db_GetTimeCardRequ
 	// RFC3339形式の文字列をパース
 	datetime, err := time.Parse(time.RFC3339, req.Datetime)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid datetime format: %v", err)
+		return nil, grpcerr.InvalidArgument("datetime", "invalid datetime format: %v", err)
 	}
 
-	timeCard, err := s.repo.GetByCompositeKey(datetime, int(req.Id))
+	timeCard, err := s.repo.GetByCompositeKey(ctx, datetime, int(req.Id))
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "time_card not found: %v", err)
+		return nil, grpcerr.FromError(err, "failed to get time_card")
 	}
 
 	return &proto.Db_TimeCardResponse{
@@ -44,19 +43,37 @@ func (s *TimeCardService) Get(ctx context.Context, req *proto.Db_GetTimeCardRequ
 
 // List タイムカードデータ一覧取得
 func (s *TimeCardService) List(ctx context.Context, req *proto.Db_ListTimeCardRequest) (*proto.Db_ListTimeCardResponse, error) {
+	// ソート条件（sort / 非推奨のorder_by）
+	sort, err := sortFields(req.Sort, req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	// キーセットページネーション（page_token指定時）
+	if req.PageToken != nil {
+		page, err := keysetPageRequest(req.Limit, req.Offset, sort, *req.PageToken, req.IncludeTotalCount)
+		if err != nil {
+			return nil, err
+		}
+		result, err := s.repo.GetPage(ctx, page)
+		if err != nil {
+			return nil, grpcerr.FromError(err, "failed to list time_cards")
+		}
+		return &proto.Db_ListTimeCardResponse{
+			Items:         convertItems(result.Items, timeCardModelToProto),
+			TotalCount:    totalCountPtr(result.TotalCount),
+			NextPageToken: result.NextPageToken,
+		}, nil
+	}
+
 	limit := int(req.Limit)
 	if limit <= 0 {
 		limit = 100
 	}
 	offset := int(req.Offset)
-	orderBy := ""
-	if req.OrderBy != nil {
-		orderBy = *req.OrderBy
-	}
-
-	timeCards, totalCount, err := s.repo.GetAll(limit, offset, orderBy)
+	timeCards, totalCount, err := s.repo.GetAll(ctx, limit, offset, sort)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list time_cards: %v", err)
+		return nil, grpcerr.FromError(err, "failed to list time_cards")
 	}
 
 	items := make([]*proto.Db_TimeCard, len(timeCards))
@@ -66,7 +83,7 @@ func (s *TimeCardService) List(ctx context.Context, req *proto.Db_ListTimeCardRe
 
 	return &proto.Db_ListTimeCardResponse{
 		Items:      items,
-		TotalCount: int32(totalCount),
+		TotalCount: int32Ptr(int32(totalCount)),
 	}, nil
 }
 
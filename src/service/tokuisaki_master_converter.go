@@ -0,0 +1,34 @@
+package service
+
+import (
+	"github.com/yhonda-ohishi/db_service/src/models/ichibanboshi"
+	pb "github.com/yhonda-ohishi/db_service/src/proto"
+)
+
+// convertTokuisakiMasterToProto GORMモデルをProtoメッセージに変換
+func convertTokuisakiMasterToProto(m *ichibanboshi.TokuisakiMaster) *pb.Db_TokuisakiMaster {
+	return &pb.Db_TokuisakiMaster{
+		TokuisakiC: m.TokuisakiC,
+		TokuisakiH: m.TokuisakiH,
+		TokuisakiN: m.TokuisakiN,
+		TokuisakiR: m.TokuisakiR,
+		TokuisakiF: m.TokuisakiF,
+		YubinBango: m.YubinBango,
+		Jusho1:     m.Jusho1,
+		Jusho2:     m.Jusho2,
+		DenwaBango: m.DenwaBango,
+		FaxBango:   m.FAXBango,
+		Tantosha:   m.Tantosha,
+	}
+}
+
+// convertTokuisakiTekiyobiMasterToProto GORMモデルをProtoメッセージに変換
+func convertTokuisakiTekiyobiMasterToProto(m *ichibanboshi.TokuisakiTekiyobiMaster) *pb.Db_TokuisakiTekiyobiMaster {
+	return &pb.Db_TokuisakiTekiyobiMaster{
+		TokuisakiC: m.TokuisakiC,
+		TokuisakiH: m.TokuisakiH,
+		Tekiyobi:   m.Tekiyobi.Format("2006-01-02"),
+		TokuisakiN: m.TokuisakiN,
+		TokuisakiR: m.TokuisakiR,
+	}
+}
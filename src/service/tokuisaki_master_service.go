@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/yhonda-ohishi/db_service/src/grpcerr"
+	pb "github.com/yhonda-ohishi/db_service/src/proto"
+	"github.com/yhonda-ohishi/db_service/src/repository"
+)
+
+// TokuisakiMasterService 得意先マスタサービス
+type TokuisakiMasterService struct {
+	pb.UnimplementedDb_TokuisakiMasterServiceServer
+	repo repository.TokuisakiMasterRepository
+}
+
+// NewTokuisakiMasterService コンストラクタ
+func NewTokuisakiMasterService(repo repository.TokuisakiMasterRepository) *TokuisakiMasterService {
+	return &TokuisakiMasterService{
+		repo: repo,
+	}
+}
+
+// Get 得意先C、得意先Hで得意先マスタを取得
+func (s *TokuisakiMasterService) Get(ctx context.Context, req *pb.Db_GetTokuisakiMasterRequest) (*pb.Db_TokuisakiMasterResponse, error) {
+	tokuisaki, err := s.repo.GetByTokuisakiC(ctx, req.TokuisakiC, req.TokuisakiH)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "得意先マスタの取得に失敗しました")
+	}
+
+	return &pb.Db_TokuisakiMasterResponse{
+		TokuisakiMaster: convertTokuisakiMasterToProto(tokuisaki),
+	}, nil
+}
+
+// List 得意先マスタのリストを取得
+func (s *TokuisakiMasterService) List(ctx context.Context, req *pb.Db_ListTokuisakiMasterRequest) (*pb.Db_ListTokuisakiMasterResponse, error) {
+	// ソート条件（sort / 非推奨のorder_by）
+	sort, err := sortFields(req.Sort, req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	// キーセットページネーション（page_token指定時）
+	if req.PageToken != nil {
+		page, err := keysetPageRequest(req.Limit, req.Offset, sort, *req.PageToken, req.IncludeTotalCount)
+		if err != nil {
+			return nil, err
+		}
+		result, err := s.repo.GetPage(ctx, page)
+		if err != nil {
+			return nil, grpcerr.FromError(err, "得意先マスタの取得に失敗しました")
+		}
+		return &pb.Db_ListTokuisakiMasterResponse{
+			Items:         convertItems(result.Items, convertTokuisakiMasterToProto),
+			TotalCount:    totalCountPtr(result.TotalCount),
+			NextPageToken: result.NextPageToken,
+		}, nil
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 10
+	}
+	offset := int(req.Offset)
+
+	tokuisakiList, totalCount, err := s.repo.GetAll(ctx, limit, offset, sort)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "得意先マスタの取得に失敗しました")
+	}
+
+	return &pb.Db_ListTokuisakiMasterResponse{
+		Items:      convertItems(tokuisakiList, convertTokuisakiMasterToProto),
+		TotalCount: int32Ptr(int32(totalCount)),
+	}, nil
+}
+
+// Search 得意先N・得意先R・得意先F（カナ）の部分一致で得意先マスタを検索
+func (s *TokuisakiMasterService) Search(ctx context.Context, req *pb.Db_SearchTokuisakiMasterRequest) (*pb.Db_ListTokuisakiMasterResponse, error) {
+	if req.Query == "" {
+		return nil, grpcerr.InvalidArgument("query", "query is required")
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = repository.DefaultPageSize
+	}
+	if limit > repository.MaxPageSize {
+		limit = repository.MaxPageSize
+	}
+
+	tokuisakiList, err := s.repo.Search(ctx, req.Query, limit)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "得意先マスタの検索に失敗しました")
+	}
+
+	return &pb.Db_ListTokuisakiMasterResponse{
+		Items:      convertItems(tokuisakiList, convertTokuisakiMasterToProto),
+		TotalCount: int32Ptr(int32(len(tokuisakiList))),
+	}, nil
+}
+
+// ListTekiyobi 得意先適用日マスタを適用日の古い順に取得
+func (s *TokuisakiMasterService) ListTekiyobi(ctx context.Context, req *pb.Db_ListTokuisakiTekiyobiRequest) (*pb.Db_ListTokuisakiTekiyobiResponse, error) {
+	tekiyobiList, err := s.repo.GetTekiyobiList(ctx, req.TokuisakiC, req.TokuisakiH)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "得意先適用日マスタの取得に失敗しました")
+	}
+
+	return &pb.Db_ListTokuisakiTekiyobiResponse{
+		Items: convertItems(tekiyobiList, convertTokuisakiTekiyobiMasterToProto),
+	}, nil
+}
+
+// GetTekiyobiAt 指定日に有効な得意先適用日マスタを取得（dateが空の場合は現在）
+func (s *TokuisakiMasterService) GetTekiyobiAt(ctx context.Context, req *pb.Db_GetTokuisakiTekiyobiAtRequest) (*pb.Db_TokuisakiTekiyobiResponse, error) {
+	at := time.Now()
+	if req.Date != "" {
+		t, err := time.ParseInLocation("2006-01-02", req.Date, time.Local)
+		if err != nil {
+			return nil, grpcerr.InvalidArgument("date", "invalid date format (YYYY-MM-DD): %v", err)
+		}
+		at = t
+	}
+
+	tekiyobi, err := s.repo.GetTekiyobiAt(ctx, req.TokuisakiC, req.TokuisakiH, at)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "得意先適用日マスタの取得に失敗しました")
+	}
+
+	return &pb.Db_TokuisakiTekiyobiResponse{
+		TokuisakiTekiyobi: convertTokuisakiTekiyobiMasterToProto(tekiyobi),
+	}, nil
+}
@@ -15,3 +15,25 @@ func timeToStringPtr(t *time.Time) *string {
 func timeToString(t time.Time) string {
 	return t.Format("2006-01-02")
 }
+
+// int32Ptr int32 を *int32 に変換
+func int32Ptr(v int32) *int32 {
+	return &v
+}
+
+// int32PtrFromInt *int を *int32 に変換
+func int32PtrFromInt(v *int) *int32 {
+	if v == nil {
+		return nil
+	}
+	return int32Ptr(int32(*v))
+}
+
+// intPtrFromInt32 *int32 を *int に変換
+func intPtrFromInt32(v *int32) *int {
+	if v == nil {
+		return nil
+	}
+	i := int(*v)
+	return &i
+}
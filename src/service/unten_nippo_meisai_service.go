@@ -3,30 +3,32 @@ package service
 import (
 	"context"
 
+	"github.com/yhonda-ohishi/db_service/src/grpcerr"
 	pb "github.com/yhonda-ohishi/db_service/src/proto"
 	"github.com/yhonda-ohishi/db_service/src/repository"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 // UntenNippoMeisaiService 運転日報明細サービス
 type UntenNippoMeisaiService struct {
 	pb.UnimplementedDb_UntenNippoMeisaiServiceServer
-	repo repository.UntenNippoMeisaiRepository
+	repo   repository.UntenNippoMeisaiRepository
+	bumons *BumonResolver
 }
 
 // NewUntenNippoMeisaiService コンストラクタ
-func NewUntenNippoMeisaiService(repo repository.UntenNippoMeisaiRepository) *UntenNippoMeisaiService {
+// bumonsがnilの場合は部門名を設定しない
+func NewUntenNippoMeisaiService(repo repository.UntenNippoMeisaiRepository, bumons *BumonResolver) *UntenNippoMeisaiService {
 	return &UntenNippoMeisaiService{
-		repo: repo,
+		repo:   repo,
+		bumons: bumons,
 	}
 }
 
 // Get 単一の運転日報明細を取得（複合主キー: 日報K, 配車K, 車輌C）
 func (s *UntenNippoMeisaiService) Get(ctx context.Context, req *pb.Db_GetUntenNippoMeisaiRequest) (*pb.Db_UntenNippoMeisaiResponse, error) {
-	meisai, err := s.repo.GetByNippoK(req.NippoK, req.HaishaK, req.SharyoC)
+	meisai, err := s.repo.GetByNippoK(ctx, req.NippoK, req.HaishaK, req.SharyoC)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "運転日報明細が見つかりません: %v", err)
+		return nil, grpcerr.FromError(err, "運転日報明細の取得に失敗しました")
 	}
 
 	return &pb.Db_UntenNippoMeisaiResponse{
@@ -36,20 +38,38 @@ func (s *UntenNippoMeisaiService) Get(ctx context.Context, req *pb.Db_GetUntenNi
 
 // List 運転日報明細のリストを取得
 func (s *UntenNippoMeisaiService) List(ctx context.Context, req *pb.Db_ListUntenNippoMeisaiRequest) (*pb.Db_ListUntenNippoMeisaiResponse, error) {
+	// ソート条件（sort / 非推奨のorder_by）
+	sort, err := sortFields(req.Sort, req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	// キーセットページネーション（page_token指定時）
+	if req.PageToken != nil {
+		page, err := keysetPageRequest(req.Limit, req.Offset, sort, *req.PageToken, req.IncludeTotalCount)
+		if err != nil {
+			return nil, err
+		}
+		result, err := s.repo.GetPage(ctx, page)
+		if err != nil {
+			return nil, grpcerr.FromError(err, "運転日報明細の取得に失敗しました")
+		}
+		return &pb.Db_ListUntenNippoMeisaiResponse{
+			Items:         convertItems(result.Items, convertUntenNippoMeisaiToProto),
+			TotalCount:    totalCountPtr(result.TotalCount),
+			NextPageToken: result.NextPageToken,
+		}, nil
+	}
+
 	limit := int(req.Limit)
 	if limit <= 0 {
 		limit = 10
 	}
 	offset := int(req.Offset)
 
-	orderBy := ""
-	if req.OrderBy != nil {
-		orderBy = *req.OrderBy
-	}
-
-	meisaiList, totalCount, err := s.repo.GetAll(limit, offset, orderBy)
+	meisaiList, totalCount, err := s.repo.GetAll(ctx, limit, offset, sort)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "運転日報明細の取得に失敗しました: %v", err)
+		return nil, grpcerr.FromError(err, "運転日報明細の取得に失敗しました")
 	}
 
 	pbMeisaiList := make([]*pb.Db_UntenNippoMeisai, len(meisaiList))
@@ -59,10 +79,17 @@ func (s *UntenNippoMeisaiService) List(ctx context.Context, req *pb.Db_ListUnten
 
 	return &pb.Db_ListUntenNippoMeisaiResponse{
 		Items:      pbMeisaiList,
-		TotalCount: int32(totalCount),
+		TotalCount: int32Ptr(int32(totalCount)),
 	}, nil
 }
 
+// Stream 日付範囲の運転日報明細をストリーミングで取得（管理年月日の古い順）
+func (s *UntenNippoMeisaiService) Stream(req *pb.Db_StreamUntenNippoMeisaiRequest, stream pb.Db_UntenNippoMeisaiService_StreamServer) error {
+	err := s.repo.StreamByDateRange(stream.Context(), req.StartDate, req.EndDate, int(req.BatchSize),
+		sendItems(stream, convertUntenNippoMeisaiToProto))
+	return grpcerr.FromError(err, "運転日報明細のストリーミング取得に失敗しました")
+}
+
 // GetBySharyoC 車輌Cで運転日報明細を取得
 func (s *UntenNippoMeisaiService) GetBySharyoC(ctx context.Context, req *pb.Db_GetUntenNippoMeisaiBySharyoCRequest) (*pb.Db_ListUntenNippoMeisaiResponse, error) {
 	limit := int(req.Limit)
@@ -70,9 +97,9 @@ func (s *UntenNippoMeisaiService) GetBySharyoC(ctx context.Context, req *pb.Db_G
 		limit = 10
 	}
 
-	meisaiList, err := s.repo.GetBySharyoC(req.SharyoC, limit)
+	meisaiList, err := s.repo.GetBySharyoC(ctx, req.SharyoC, limit)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "車輌Cでの運転日報明細の取得に失敗しました: %v", err)
+		return nil, grpcerr.FromError(err, "車輌Cでの運転日報明細の取得に失敗しました")
 	}
 
 	pbMeisaiList := make([]*pb.Db_UntenNippoMeisai, len(meisaiList))
@@ -82,7 +109,7 @@ func (s *UntenNippoMeisaiService) GetBySharyoC(ctx context.Context, req *pb.Db_G
 
 	return &pb.Db_ListUntenNippoMeisaiResponse{
 		Items:      pbMeisaiList,
-		TotalCount: int32(len(meisaiList)),
+		TotalCount: int32Ptr(int32(len(meisaiList))),
 	}, nil
 }
 
@@ -94,18 +121,66 @@ func (s *UntenNippoMeisaiService) GetByDateRange(ctx context.Context, req *pb.Db
 	}
 	offset := int(req.Offset)
 
-	meisaiList, totalCount, err := s.repo.GetByDateRange(req.StartDate, req.EndDate, limit, offset)
+	meisaiList, totalCount, err := s.repo.GetByDateRange(ctx, req.StartDate, req.EndDate, limit, offset)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "日付範囲での運転日報明細の取得に失敗しました: %v", err)
+		return nil, grpcerr.FromError(err, "日付範囲での運転日報明細の取得に失敗しました")
+	}
+
+	pbMeisaiList := make([]*pb.Db_UntenNippoMeisai, len(meisaiList))
+	for i, meisai := range meisaiList {
+		pbMeisaiList[i] = convertUntenNippoMeisaiToProto(meisai)
+	}
+
+	return &pb.Db_ListUntenNippoMeisaiResponse{
+		Items:      pbMeisaiList,
+		TotalCount: int32Ptr(int32(totalCount)),
+	}, nil
+}
+
+// untenNippoMeisaiBumonFields protoの部門の列とリポジトリの列の対応
+var untenNippoMeisaiBumonFields = map[pb.Db_UntenNippoMeisaiBumonField]repository.UntenNippoMeisaiBumonField{
+	pb.Db_UntenNippoMeisaiBumonField_UNTEN_NIPPO_MEISAI_BUMON_FIELD_UNSPECIFIED: repository.BumonFieldAny,
+	pb.Db_UntenNippoMeisaiBumonField_UNTEN_NIPPO_MEISAI_BUMON_FIELD_JUCHU:       repository.BumonFieldJuchu,
+	pb.Db_UntenNippoMeisaiBumonField_UNTEN_NIPPO_MEISAI_BUMON_FIELD_KADO:        repository.BumonFieldKado,
+}
+
+// GetByBumon 受注部門・稼動部門で運転日報明細を取得（部門名を設定）
+func (s *UntenNippoMeisaiService) GetByBumon(ctx context.Context, req *pb.Db_GetUntenNippoMeisaiByBumonRequest) (*pb.Db_ListUntenNippoMeisaiResponse, error) {
+	bumon := repository.NormalizeBumonC(req.Bumon)
+	if bumon == "" {
+		return nil, grpcerr.InvalidArgument("bumon", "bumon is required")
+	}
+	field, ok := untenNippoMeisaiBumonFields[req.Field]
+	if !ok {
+		return nil, grpcerr.InvalidArgument("field", "unknown field: %v", req.Field)
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 10
+	}
+	offset := int(req.Offset)
+
+	meisaiList, totalCount, err := s.repo.GetByBumon(ctx, bumon, field, req.StartDate, req.EndDate, limit, offset)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "部門での運転日報明細の取得に失敗しました")
+	}
+
+	codes := make([]string, 0, len(meisaiList)*2)
+	for _, meisai := range meisaiList {
+		codes = append(codes, meisai.JuchuBumon, meisai.KadoBumon)
 	}
+	names := s.bumons.Names(ctx, codes...)
 
 	pbMeisaiList := make([]*pb.Db_UntenNippoMeisai, len(meisaiList))
 	for i, meisai := range meisaiList {
 		pbMeisaiList[i] = convertUntenNippoMeisaiToProto(meisai)
+		pbMeisaiList[i].JuchuBumonName = names.lookup(meisai.JuchuBumon)
+		pbMeisaiList[i].KadoBumonName = names.lookup(meisai.KadoBumon)
 	}
 
 	return &pb.Db_ListUntenNippoMeisaiResponse{
 		Items:      pbMeisaiList,
-		TotalCount: int32(totalCount),
+		TotalCount: int32Ptr(int32(totalCount)),
 	}, nil
 }
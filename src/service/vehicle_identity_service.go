@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/yhonda-ohishi/db_service/src/grpcerr"
+	"github.com/yhonda-ohishi/db_service/src/proto"
+	"github.com/yhonda-ohishi/db_service/src/vehicleid"
+)
+
+// VehicleIdentityService 車輌の識別子の突き合わせサービス実装
+type VehicleIdentityService struct {
+	proto.UnimplementedDb_VehicleIdentityServiceServer
+	resolver *vehicleid.Resolver
+}
+
+// NewVehicleIdentityService サービスのコンストラクタ
+func NewVehicleIdentityService(resolver *vehicleid.Resolver) *VehicleIdentityService {
+	return &VehicleIdentityService{
+		resolver: resolver,
+	}
+}
+
+// Get 識別子のいずれか1つから車輌の全識別子と登録されているETCカードを取得
+func (s *VehicleIdentityService) Get(ctx context.Context, req *proto.Db_GetVehicleIdentityRequest) (*proto.Db_VehicleIdentityResponse, error) {
+	key, err := vehicleKeyFromProto(req)
+	if err != nil {
+		return nil, err
+	}
+	at, err := parseAsOf(req.At)
+	if err != nil {
+		return nil, err
+	}
+
+	identity, err := s.resolver.Resolve(ctx, key)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to resolve vehicle identity")
+	}
+
+	return &proto.Db_VehicleIdentityResponse{
+		Identity:      vehicleIdentityToProto(identity),
+		ActiveEtcNums: convertItems(identity.ActiveETCNums(at), etcNumModelToProto),
+	}, nil
+}
+
+// ListGaps 一部のシステムに登録されていない車輌を報告
+func (s *VehicleIdentityService) ListGaps(ctx context.Context, req *proto.Db_ListVehicleIdentityGapsRequest) (*proto.Db_ListVehicleIdentityGapsResponse, error) {
+	report, err := s.resolver.Report(ctx)
+	if err != nil {
+		return nil, grpcerr.FromError(err, "failed to report vehicle identity gaps")
+	}
+
+	filter := make(map[proto.Db_VehicleSystem]bool, len(req.Missing))
+	for _, system := range req.Missing {
+		filter[system] = true
+	}
+
+	resp := &proto.Db_ListVehicleIdentityGapsResponse{
+		VehicleCount: int32(report.VehicleCount),
+		Systems:      vehicleSystemsToProto(report.Systems),
+	}
+	for _, identity := range report.Gaps {
+		p := vehicleIdentityToProto(identity)
+		if len(filter) > 0 && !containsVehicleSystem(p.Missing, filter) {
+			continue
+		}
+		resp.Items = append(resp.Items, p)
+	}
+	return resp, nil
+}
+
+// vehicleKeyFromProto 検索する識別子の変換
+func vehicleKeyFromProto(req *proto.Db_GetVehicleIdentityRequest) (vehicleid.Key, error) {
+	var key vehicleid.Key
+	var field string
+	switch k := req.Key.(type) {
+	case *proto.Db_GetVehicleIdentityRequest_CarId:
+		key, field = vehicleid.Key{Kind: vehicleid.KeyCarID, Value: k.CarId}, "car_id"
+	case *proto.Db_GetVehicleIdentityRequest_Id4:
+		key, field = vehicleid.Key{Kind: vehicleid.KeyID4, Value: strconv.Itoa(int(k.Id4))}, "id4"
+	case *proto.Db_GetVehicleIdentityRequest_CarCode:
+		key, field = vehicleid.Key{Kind: vehicleid.KeyCarCode, Value: k.CarCode}, "car_code"
+	case *proto.Db_GetVehicleIdentityRequest_CarCc:
+		key, field = vehicleid.Key{Kind: vehicleid.KeyCarCC, Value: k.CarCc}, "car_cc"
+	case *proto.Db_GetVehicleIdentityRequest_EtcCarId:
+		key, field = vehicleid.Key{Kind: vehicleid.KeyETCCarID, Value: k.EtcCarId}, "etc_car_id"
+	case *proto.Db_GetVehicleIdentityRequest_SharyoC:
+		key, field = vehicleid.Key{Kind: vehicleid.KeySharyoC, Value: k.SharyoC, SharyoH: req.SharyoH}, "sharyo_c"
+	default:
+		return key, grpcerr.InvalidArgument("key", "one of car_id, id4, car_code, car_cc, etc_car_id, sharyo_c is required")
+	}
+	if key.Value == "" {
+		return key, grpcerr.InvalidArgument(field, "%s is required", field)
+	}
+	return key, nil
+}
+
+// vehicleSystemToProto システムの変換
+func vehicleSystemToProto(system vehicleid.System) proto.Db_VehicleSystem {
+	switch system {
+	case vehicleid.SystemCars:
+		return proto.Db_VehicleSystem_VEHICLE_SYSTEM_CARS
+	case vehicleid.SystemDTakoCars:
+		return proto.Db_VehicleSystem_VEHICLE_SYSTEM_DTAKO_CARS
+	case vehicleid.SystemETCNum:
+		return proto.Db_VehicleSystem_VEHICLE_SYSTEM_ETC_NUM
+	case vehicleid.SystemSharyoMaster:
+		return proto.Db_VehicleSystem_VEHICLE_SYSTEM_SHARYO_MASTER
+	}
+	return proto.Db_VehicleSystem_VEHICLE_SYSTEM_UNSPECIFIED
+}
+
+// vehicleSystemsToProto システム一覧の変換
+func vehicleSystemsToProto(systems []vehicleid.System) []proto.Db_VehicleSystem {
+	result := make([]proto.Db_VehicleSystem, len(systems))
+	for i, system := range systems {
+		result[i] = vehicleSystemToProto(system)
+	}
+	return result
+}
+
+// containsVehicleSystem いずれかのシステムがfilterに含まれるか
+func containsVehicleSystem(systems []proto.Db_VehicleSystem, filter map[proto.Db_VehicleSystem]bool) bool {
+	for _, system := range systems {
+		if filter[system] {
+			return true
+		}
+	}
+	return false
+}
+
+// vehicleIdentityToProto 車輌の識別子の変換
+func vehicleIdentityToProto(identity *vehicleid.Identity) *proto.Db_VehicleIdentity {
+	p := &proto.Db_VehicleIdentity{
+		CarCc:         identity.CarCC,
+		DtakoCars:     convertItems(identity.DTakoCars, dtakoCarsModelToProto),
+		EtcNums:       convertItems(identity.ETCNums, etcNumModelToProto),
+		SharyoMasters: convertItems(identity.SharyoMasters, convertSharyoMasterToProto),
+		Missing:       vehicleSystemsToProto(identity.Missing),
+	}
+	if identity.ID4 != nil {
+		p.Id4 = int32Ptr(int32(*identity.ID4))
+	}
+	if identity.Car != nil {
+		p.Car = carsModelToProto(identity.Car)
+	}
+	return p
+}
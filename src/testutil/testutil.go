@@ -0,0 +1,220 @@
+// Package testutil 実DBサーバーなしでリポジトリ・gRPCサービスをテストするためのヘルパー
+//
+// ローカルDB・本番DB（MySQL）・SQL Server (ichibanboshi) をそれぞれインプロセスのSQLiteで代替し、
+// モデル定義（日本語の列名を含む）からスキーマを作成する。
+package testutil
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync/atomic"
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"github.com/yhonda-ohishi/db_service/src/config"
+	"github.com/yhonda-ohishi/db_service/src/models/ichibanboshi"
+	"github.com/yhonda-ohishi/db_service/src/models/mysql"
+	"github.com/yhonda-ohishi/db_service/src/registry"
+	"github.com/yhonda-ohishi/db_service/src/repository"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// LocalModels ローカルDB（db_service）のテーブル
+var LocalModels = []interface{}{
+	&mysql.DTakoUriageKeihi{},
+	&mysql.ETCMeisai{},
+	&mysql.DTakoFerryRows{},
+	&mysql.ETCMeisaiMapping{},
+	&mysql.TimeCard{},
+	&mysql.TimeCardLog{},
+	&mysql.EmployeeIdentity{},
+	&mysql.EmployeeCard{},
+}
+
+// ProdModels 本番DB（MySQL）のテーブル
+var ProdModels = []interface{}{
+	&mysql.DTakoCars{},
+	&mysql.DTakoEvents{},
+	&mysql.DTakoRows{},
+	&mysql.ETCNum{},
+	&mysql.DTakoFerryRows{},
+	&mysql.Cars{},
+	&mysql.Drivers{},
+	&mysql.TimeCard{},
+}
+
+// SQLServerModels SQL Server (ichibanboshi) のテーブル
+var SQLServerModels = []interface{}{
+	&ichibanboshi.UntenNippoMeisai{},
+	&ichibanboshi.ShainMaster{},
+	&ichibanboshi.ChiikiMaster{},
+	&ichibanboshi.ChikuMaster{},
+	&ichibanboshi.TokuisakiMaster{},
+	&ichibanboshi.TokuisakiTekiyobiMaster{},
+	&ichibanboshi.HinmeiMaster{},
+	&ichibanboshi.TokuisakiHinmeiMaster{},
+	&ichibanboshi.BumonMaster{},
+	&ichibanboshi.SharyoMaster{},
+}
+
+// dbSeq インメモリDB名の連番（テストごとに別のDBにする）
+var dbSeq atomic.Int64
+
+// NewSQLiteDB 指定したモデルのテーブルを作成したインメモリSQLiteを返す
+// DBはテストごとに独立しており、テスト終了時に閉じる
+func NewSQLiteDB(t testing.TB, models ...interface{}) *gorm.DB {
+	t.Helper()
+
+	dsn := fmt.Sprintf("file:testutil_%d?mode=memory&cache=shared", dbSeq.Add(1))
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{
+		Logger:         logger.Default.LogMode(logger.Silent),
+		TranslateError: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to open sqlite: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("failed to get sql.DB: %v", err)
+	}
+	// 共有キャッシュのインメモリDBはロック競合を避けるため接続を1つに制限する
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+
+	if err := db.AutoMigrate(models...); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	return db
+}
+
+// DB テスト用のDB一式
+type DB struct {
+	Local     *gorm.DB
+	Prod      *config.ProdDatabase
+	SQLServer *config.SQLServerDatabase
+}
+
+// NewDB ローカルDB・本番DB・SQL Serverを代替するSQLiteを作成
+func NewDB(t testing.TB) *DB {
+	t.Helper()
+	return &DB{
+		Local:     NewSQLiteDB(t, LocalModels...),
+		Prod:      &config.ProdDatabase{DB: NewSQLiteDB(t, ProdModels...)},
+		SQLServer: &config.SQLServerDatabase{DB: NewSQLiteDB(t, SQLServerModels...)},
+	}
+}
+
+// Repositories テスト用DBに接続したリポジトリ一式
+type Repositories struct {
+	// ローカルDB
+	DTakoUriageKeihi repository.DTakoUriageKeihiRepository
+	ETCMeisai        repository.ETCMeisaiRepository
+	DTakoFerryRows   repository.DTakoFerryRowsRepository
+	ETCMeisaiMapping repository.ETCMeisaiMappingRepository
+	TimeCardDev      repository.TimeCardDevRepository
+	TimeCardLog      repository.TimeCardLogRepository
+	EmployeeIdentity repository.EmployeeIdentityRepository
+
+	// 本番DB
+	DTakoCars          repository.DTakoCarsRepository
+	DTakoEvents        repository.DTakoEventsRepository
+	DTakoRows          repository.DTakoRowsRepository
+	ETCNum             repository.ETCNumRepository
+	DTakoFerryRowsProd repository.DTakoFerryRowsProdRepository
+	Cars               repository.CarsRepository
+	Drivers            repository.DriversRepository
+	TimeCard           repository.TimeCardRepository
+
+	// SQL Server (ichibanboshi)
+	UntenNippoMeisai repository.UntenNippoMeisaiRepository
+	ShainMaster      repository.ShainMasterRepository
+	ChiikiMaster     repository.ChiikiMasterRepository
+	ChikuMaster      repository.ChikuMasterRepository
+	TokuisakiMaster  repository.TokuisakiMasterRepository
+	HinmeiMaster     repository.HinmeiMasterRepository
+	BumonMaster      repository.BumonMasterRepository
+	SharyoMaster     repository.SharyoMasterRepository
+}
+
+// NewRepositories テスト用DBを作成し、全リポジトリを初期化
+func NewRepositories(t testing.TB) (*DB, *Repositories) {
+	t.Helper()
+	db := NewDB(t)
+	return db, &Repositories{
+		DTakoUriageKeihi: repository.NewDTakoUriageKeihiRepository(db.Local),
+		ETCMeisai:        repository.NewETCMeisaiRepository(db.Local),
+		DTakoFerryRows:   repository.NewDTakoFerryRowsRepository(db.Local),
+		ETCMeisaiMapping: repository.NewETCMeisaiMappingRepository(db.Local),
+		TimeCardDev:      repository.NewTimeCardDevRepository(db.Local),
+		TimeCardLog:      repository.NewTimeCardLogRepository(db.Local),
+		EmployeeIdentity: repository.NewEmployeeIdentityRepository(db.Local),
+
+		DTakoCars:          repository.NewDTakoCarsRepository(db.Prod),
+		DTakoEvents:        repository.NewDTakoEventsRepository(db.Prod),
+		DTakoRows:          repository.NewDTakoRowsRepository(db.Prod),
+		ETCNum:             repository.NewETCNumRepository(db.Prod),
+		DTakoFerryRowsProd: repository.NewDTakoFerryRowsProdRepository(db.Prod),
+		Cars:               repository.NewCarsRepository(db.Prod),
+		Drivers:            repository.NewDriversRepository(db.Prod),
+		TimeCard:           repository.NewTimeCardRepository(db.Prod),
+
+		UntenNippoMeisai: repository.NewUntenNippoMeisaiRepository(db.SQLServer),
+		ShainMaster:      repository.NewShainMasterRepository(db.SQLServer),
+		ChiikiMaster:     repository.NewChiikiMasterRepository(db.SQLServer),
+		ChikuMaster:      repository.NewChikuMasterRepository(db.SQLServer),
+		TokuisakiMaster:  repository.NewTokuisakiMasterRepository(db.SQLServer),
+		HinmeiMaster:     repository.NewHinmeiMasterRepository(db.SQLServer),
+		BumonMaster:      repository.NewBumonMasterRepository(db.SQLServer),
+		SharyoMaster:     repository.NewSharyoMasterRepository(db.SQLServer),
+	}
+}
+
+// NewRegistry テスト用DBを注入したServiceRegistryを作成
+func NewRegistry(t testing.TB, db *DB, opts ...registry.RegistryOption) *registry.ServiceRegistry {
+	t.Helper()
+	opts = append([]registry.RegistryOption{
+		registry.WithLocalDB(db.Local),
+		registry.WithProdDB(db.Prod),
+		registry.WithSQLServerDB(db.SQLServer),
+	}, opts...)
+	reg, err := registry.NewServiceRegistryE(opts...)
+	if err != nil {
+		t.Fatalf("NewServiceRegistryE failed: %v", err)
+	}
+	t.Cleanup(func() { _ = reg.Close() })
+	return reg
+}
+
+// Dial ServiceRegistryの全サービスをbufconn上のgRPCサーバーで起動し、接続したクライアントを返す
+func Dial(t testing.TB, reg *registry.ServiceRegistry) *grpc.ClientConn {
+	t.Helper()
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	reg.RegisterAll(server)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+// NewClientConn テスト用DBを作成し、全サービスを登録したgRPCサーバーに接続する
+func NewClientConn(t testing.TB, opts ...registry.RegistryOption) (*DB, *grpc.ClientConn) {
+	t.Helper()
+	db := NewDB(t)
+	return db, Dial(t, NewRegistry(t, db, opts...))
+}
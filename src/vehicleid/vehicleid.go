@@ -0,0 +1,411 @@
+// Package vehicleid 車輌の識別子の突き合わせ
+//
+// 1台の車輌は本番DB・デジタコ・ETC・一番星でそれぞれ別の識別子で登録されており、対応表はないため次の規則で突き合わせる。
+//   - cars.id・dtako_cars.車輌CC・etc_num.car_id は同じ車輌コード（etcmatchと同じ）
+//   - cars.id4 は一番星の車輌ﾏｽﾀ・運転日報明細の車輌C（4桁のゼロ埋め）
+//   - dtako_cars.車輌CD は同じ行の車輌CCで突き合わせる
+package vehicleid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yhonda-ohishi/db_service/src/models/ichibanboshi"
+	"github.com/yhonda-ohishi/db_service/src/models/mysql"
+	"github.com/yhonda-ohishi/db_service/src/repository"
+	"gorm.io/gorm"
+)
+
+// System 車輌を登録しているシステム
+type System int
+
+const (
+	// SystemCars 本番DBのcars（id, id4）
+	SystemCars System = iota + 1
+	// SystemDTakoCars デジタコのdtako_cars（車輌CD, 車輌CC）
+	SystemDTakoCars
+	// SystemETCNum ETCカードのetc_num（car_id）
+	SystemETCNum
+	// SystemSharyoMaster 一番星の車輌ﾏｽﾀ（車輌C, 車輌H）
+	SystemSharyoMaster
+)
+
+// String システムの名前
+func (s System) String() string {
+	switch s {
+	case SystemCars:
+		return "cars"
+	case SystemDTakoCars:
+		return "dtako_cars"
+	case SystemETCNum:
+		return "etc_num"
+	case SystemSharyoMaster:
+		return "sharyo_master"
+	}
+	return fmt.Sprintf("System(%d)", int(s))
+}
+
+// KeyKind 検索する識別子の種類
+type KeyKind int
+
+const (
+	// KeyCarID cars.id
+	KeyCarID KeyKind = iota + 1
+	// KeyID4 cars.id4
+	KeyID4
+	// KeyCarCode dtako_cars.車輌CD
+	KeyCarCode
+	// KeyCarCC dtako_cars.車輌CC
+	KeyCarCC
+	// KeyETCCarID etc_num.car_id
+	KeyETCCarID
+	// KeySharyoC 車輌ﾏｽﾀ・運転日報明細の車輌C
+	KeySharyoC
+)
+
+// Key 検索する識別子
+type Key struct {
+	Kind  KeyKind
+	Value string
+	// SharyoH KeySharyoCの場合の車輌H（空の場合はすべて）
+	SharyoH string
+}
+
+// ErrInvalidKey 識別子の種類・値が不正
+var ErrInvalidKey = errors.New("invalid vehicle key")
+
+// Identity 1台の車輌の識別子
+type Identity struct {
+	// CarCC 車輌コード（cars.id・dtako_cars.車輌CC・etc_num.car_id、不明な場合は空）
+	CarCC string
+	// ID4 cars.id4（車輌ﾏｽﾀの車輌C、不明な場合はnil）
+	ID4           *int
+	Car           *mysql.Cars
+	DTakoCars     []*mysql.DTakoCars
+	ETCNums       []*mysql.ETCNum
+	SharyoMasters []*ichibanboshi.SharyoMaster
+	// Missing 登録されていないシステム（車輌ﾏｽﾀを参照しない場合は車輌ﾏｽﾀを含まない）
+	Missing []System
+}
+
+// ActiveETCNums 指定日時に登録されているETCカード
+func (i *Identity) ActiveETCNums(at time.Time) []*mysql.ETCNum {
+	var active []*mysql.ETCNum
+	for _, e := range i.ETCNums {
+		if e.ValidAt(at) {
+			active = append(active, e)
+		}
+	}
+	return active
+}
+
+// Resolver 車輌の識別子の突き合わせ
+type Resolver struct {
+	cars      repository.CarsRepository
+	dtakoCars repository.DTakoCarsRepository
+	etcNums   repository.ETCNumRepository
+	sharyo    repository.SharyoMasterRepository
+	// sharyoAvailable 車輌ﾏｽﾀ（SQL Server）が使用可能か（nilの場合は常に使用可能とする）
+	sharyoAvailable func() bool
+}
+
+// Option Resolverのオプション
+type Option func(*Resolver)
+
+// WithSharyoAvailability 車輌ﾏｽﾀ（SQL Server）が使用可能かを確認する関数を指定するオプション
+// 使用できない間の呼び出しでは車輌ﾏｽﾀを参照しない（sharyoがnilの場合と同じ）
+func WithSharyoAvailability(available func() bool) Option {
+	return func(r *Resolver) {
+		r.sharyoAvailable = available
+	}
+}
+
+// New Resolverのコンストラクタ（sharyoがnilの場合は車輌ﾏｽﾀを参照しない）
+func New(cars repository.CarsRepository, dtakoCars repository.DTakoCarsRepository,
+	etcNums repository.ETCNumRepository, sharyo repository.SharyoMasterRepository, opts ...Option) *Resolver {
+	r := &Resolver{
+		cars:      cars,
+		dtakoCars: dtakoCars,
+		etcNums:   etcNums,
+		sharyo:    sharyo,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// sharyoRepo 車輌ﾏｽﾀのリポジトリ（未設定、またはSQL Serverが使用できない場合はnil）
+func (r *Resolver) sharyoRepo() repository.SharyoMasterRepository {
+	if r.sharyo == nil || (r.sharyoAvailable != nil && !r.sharyoAvailable()) {
+		return nil
+	}
+	return r.sharyo
+}
+
+// Systems 突き合わせるシステム（SQL Serverが使用できない間は車輌ﾏｽﾀを含まない）
+func (r *Resolver) Systems() []System {
+	return systems(r.sharyoRepo())
+}
+
+// systems 突き合わせるシステム
+func systems(sharyo repository.SharyoMasterRepository) []System {
+	systems := []System{SystemCars, SystemDTakoCars, SystemETCNum}
+	if sharyo != nil {
+		systems = append(systems, SystemSharyoMaster)
+	}
+	return systems
+}
+
+// Resolve 識別子のいずれか1つから車輌の識別子をすべて取得（どのシステムにもない場合はgorm.ErrRecordNotFound）
+func (r *Resolver) Resolve(ctx context.Context, key Key) (*Identity, error) {
+	if strings.TrimSpace(key.Value) == "" {
+		return nil, fmt.Errorf("%w: empty value", ErrInvalidKey)
+	}
+
+	id := &Identity{}
+	sharyo := r.sharyoRepo()
+	sharyoLoaded := false
+	switch key.Kind {
+	case KeyCarID, KeyCarCC, KeyETCCarID:
+		id.CarCC = key.Value
+	case KeyID4:
+		n, err := strconv.Atoi(key.Value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: id4 %q", ErrInvalidKey, key.Value)
+		}
+		if err := r.resolveID4(ctx, id, n); err != nil {
+			return nil, err
+		}
+	case KeyCarCode:
+		car, err := r.dtakoCars.GetByCarCode(ctx, key.Value)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("failed to get dtako_cars: %w", err)
+		}
+		if car != nil {
+			id.CarCC = car.CarCC
+		}
+	case KeySharyoC:
+		if sharyo != nil {
+			masters, err := sharyo.GetAllBySharyoC(ctx, key.Value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get 車輌ﾏｽﾀ: %w", err)
+			}
+			for _, m := range masters {
+				if key.SharyoH == "" || m.SharyoH == key.SharyoH {
+					id.SharyoMasters = append(id.SharyoMasters, m)
+				}
+			}
+			sharyoLoaded = true
+		}
+		if n, ok := parseSharyoC(key.Value); ok && n != 0 {
+			if err := r.resolveID4(ctx, id, n); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, fmt.Errorf("%w: kind %d", ErrInvalidKey, key.Kind)
+	}
+
+	if id.CarCC != "" {
+		if err := r.resolveCarCC(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+	if id.ID4 != nil && *id.ID4 != 0 && sharyo != nil && !sharyoLoaded {
+		masters, err := sharyo.GetAllBySharyoC(ctx, formatSharyoC(*id.ID4))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get 車輌ﾏｽﾀ: %w", err)
+		}
+		id.SharyoMasters = masters
+	}
+
+	if id.Car == nil && len(id.DTakoCars) == 0 && len(id.ETCNums) == 0 && len(id.SharyoMasters) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	id.Missing = missing(id, sharyo)
+	return id, nil
+}
+
+// resolveID4 id4の車輌（複数ある場合はid順で最初のもの）
+func (r *Resolver) resolveID4(ctx context.Context, id *Identity, id4 int) error {
+	id.ID4 = &id4
+	cars, err := r.cars.GetByID4(ctx, id4)
+	if err != nil {
+		return fmt.Errorf("failed to get cars: %w", err)
+	}
+	if len(cars) > 0 {
+		id.Car = cars[0]
+		id.CarCC = cars[0].ID
+	}
+	return nil
+}
+
+// resolveCarCC 車輌コードのcars・dtako_cars・etc_num
+func (r *Resolver) resolveCarCC(ctx context.Context, id *Identity) error {
+	if id.Car == nil {
+		car, err := r.cars.GetByID(ctx, id.CarCC)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("failed to get cars: %w", err)
+		}
+		id.Car = car
+	}
+	if id.Car != nil && id.ID4 == nil {
+		id4 := id.Car.ID4
+		id.ID4 = &id4
+	}
+
+	dtakoCars, err := r.dtakoCars.GetByCarCC(ctx, id.CarCC)
+	if err != nil {
+		return fmt.Errorf("failed to get dtako_cars: %w", err)
+	}
+	id.DTakoCars = dtakoCars
+
+	etcNums, err := r.etcNums.GetByCarID(ctx, id.CarCC)
+	if err != nil {
+		return fmt.Errorf("failed to get etc_num: %w", err)
+	}
+	id.ETCNums = etcNums
+	return nil
+}
+
+// missing 車輌が登録されていないシステム（sharyoがnilの場合は車輌ﾏｽﾀを除く）
+func missing(id *Identity, sharyo repository.SharyoMasterRepository) []System {
+	var missing []System
+	if id.Car == nil {
+		missing = append(missing, SystemCars)
+	}
+	if len(id.DTakoCars) == 0 {
+		missing = append(missing, SystemDTakoCars)
+	}
+	if len(id.ETCNums) == 0 {
+		missing = append(missing, SystemETCNum)
+	}
+	if sharyo != nil && len(id.SharyoMasters) == 0 {
+		missing = append(missing, SystemSharyoMaster)
+	}
+	return missing
+}
+
+// Report システム間の車輌の登録状況
+type Report struct {
+	// Systems 突き合わせたシステム
+	Systems []System
+	// VehicleCount 突き合わせた車輌の台数
+	VehicleCount int
+	// Gaps 一部のシステムに登録されていない車輌（車輌コード・車輌C順）
+	Gaps []*Identity
+}
+
+// Report 全システムの車輌を突き合わせ、一部のシステムに登録されていない車輌を報告する
+func (r *Resolver) Report(ctx context.Context) (*Report, error) {
+	cars, err := repository.AllPages(ctx, r.cars.GetPage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cars: %w", err)
+	}
+	dtakoCars, err := repository.AllPages(ctx, r.dtakoCars.GetPage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list dtako_cars: %w", err)
+	}
+	etcNums, err := repository.AllPages(ctx, r.etcNums.GetPage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list etc_num: %w", err)
+	}
+	sharyo := r.sharyoRepo()
+	var masters []*ichibanboshi.SharyoMaster
+	if sharyo != nil {
+		if masters, err = repository.AllPages(ctx, sharyo.GetPage); err != nil {
+			return nil, fmt.Errorf("failed to list 車輌ﾏｽﾀ: %w", err)
+		}
+	}
+
+	// 車輌コード・id4・（carsと対応しない）車輌Cごとの車輌
+	byCarCC := make(map[string]*Identity)
+	byID4 := make(map[int]*Identity)
+	bySharyoC := make(map[string]*Identity)
+	var identities []*Identity
+	carCC := func(cc string) *Identity {
+		if id, ok := byCarCC[cc]; ok {
+			return id
+		}
+		id := &Identity{CarCC: cc}
+		byCarCC[cc] = id
+		identities = append(identities, id)
+		return id
+	}
+
+	for _, car := range cars {
+		id := carCC(car.ID)
+		id.Car = car
+		id4 := car.ID4
+		id.ID4 = &id4
+		// id4が未設定（0）の車輌は車輌Cと対応付けない
+		if _, ok := byID4[id4]; !ok && id4 != 0 {
+			byID4[id4] = id
+		}
+	}
+	for _, d := range dtakoCars {
+		id := carCC(d.CarCC)
+		id.DTakoCars = append(id.DTakoCars, d)
+	}
+	for _, e := range etcNums {
+		id := carCC(e.CarID)
+		id.ETCNums = append(id.ETCNums, e)
+	}
+	for _, m := range masters {
+		n, ok := parseSharyoC(m.SharyoC)
+		if id, found := byID4[n]; ok && found {
+			id.SharyoMasters = append(id.SharyoMasters, m)
+			continue
+		}
+		id, found := bySharyoC[m.SharyoC]
+		if !found {
+			id = &Identity{}
+			if ok {
+				id.ID4 = &n
+			}
+			bySharyoC[m.SharyoC] = id
+			identities = append(identities, id)
+		}
+		id.SharyoMasters = append(id.SharyoMasters, m)
+	}
+
+	report := &Report{Systems: systems(sharyo), VehicleCount: len(identities)}
+	for _, id := range identities {
+		if id.Missing = missing(id, sharyo); len(id.Missing) > 0 {
+			report.Gaps = append(report.Gaps, id)
+		}
+	}
+	sort.SliceStable(report.Gaps, func(i, j int) bool {
+		a, b := report.Gaps[i], report.Gaps[j]
+		if a.CarCC != b.CarCC {
+			return a.CarCC < b.CarCC
+		}
+		return firstSharyoC(a) < firstSharyoC(b)
+	})
+	return report, nil
+}
+
+// formatSharyoC id4に対応する車輌C
+func formatSharyoC(id4 int) string {
+	return fmt.Sprintf("%04d", id4)
+}
+
+// parseSharyoC 車輌Cに対応するid4（数字でない場合はfalse）
+func parseSharyoC(sharyoC string) (int, bool) {
+	n, err := strconv.Atoi(strings.TrimSpace(sharyoC))
+	return n, err == nil
+}
+
+// firstSharyoC 車輌の最初の車輌C（並び替え用）
+func firstSharyoC(id *Identity) string {
+	if len(id.SharyoMasters) == 0 {
+		return ""
+	}
+	return id.SharyoMasters[0].SharyoC
+}
@@ -0,0 +1,254 @@
+package contract
+
+import (
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/yhonda-ohishi/db_service/src/models/ichibanboshi"
+	models "github.com/yhonda-ohishi/db_service/src/models/mysql"
+	"github.com/yhonda-ohishi/db_service/src/proto"
+	"github.com/yhonda-ohishi/db_service/src/testutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDTakoEventsService_List(t *testing.T) {
+	db, conn := testutil.NewClientConn(t)
+
+	client := proto.NewDb_DTakoEventsServiceClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// 本番DBのテストデータ
+	base := time.Date(2025, 1, 10, 8, 0, 0, 0, time.UTC)
+	for i, name := range []string{"運行開始", "休憩", "運行終了"} {
+		event := &models.DTakoEvents{
+			OperationNo:   "OP_LIST001",
+			CarCode:       3 - i,
+			StartDatetime: base.Add(time.Duration(i) * time.Hour),
+			EndDatetime:   base.Add(time.Duration(i) * time.Hour),
+			EventName:     name,
+		}
+		if err := db.Prod.DB.Create(event).Error; err != nil {
+			t.Fatalf("Failed to seed dtako_events: %v", err)
+		}
+	}
+
+	t.Run("List with sort", func(t *testing.T) {
+		response, err := client.List(ctx, &proto.Db_ListDTakoEventsRequest{
+			Limit: 10,
+			Sort:  []*proto.Db_DTakoEventsSortSpec{{Field: proto.Db_DTakoEventsSortField_DTAKO_EVENTS_SORT_FIELD_CAR_CODE, Direction: proto.Db_SortDirection_SORT_DIRECTION_ASC}},
+		})
+		if err != nil {
+			t.Fatalf("List() error = %v", err)
+		}
+		if len(response.Items) != 3 || response.Items[0].EventName != "運行終了" {
+			t.Errorf("unexpected items: %v", response.Items)
+		}
+		if response.GetTotalCount() != 3 {
+			t.Errorf("TotalCount = %d, want 3", response.GetTotalCount())
+		}
+	})
+
+	t.Run("List with invalid sort field", func(t *testing.T) {
+		for _, field := range []proto.Db_DTakoEventsSortField{
+			proto.Db_DTakoEventsSortField_DTAKO_EVENTS_SORT_FIELD_UNSPECIFIED,
+			proto.Db_DTakoEventsSortField(99),
+		} {
+			_, err := client.List(ctx, &proto.Db_ListDTakoEventsRequest{
+				Limit: 10,
+				Sort:  []*proto.Db_DTakoEventsSortSpec{{Field: field}},
+			})
+			if status.Code(err) != codes.InvalidArgument {
+				t.Errorf("field %v: Expected InvalidArgument, got %v", field, err)
+			}
+		}
+	})
+
+	t.Run("List with page_token", func(t *testing.T) {
+		pageToken := ""
+		var names []string
+		for {
+			response, err := client.List(ctx, &proto.Db_ListDTakoEventsRequest{Limit: 2, PageToken: &pageToken})
+			if err != nil {
+				t.Fatalf("List() error = %v", err)
+			}
+			for _, item := range response.Items {
+				names = append(names, item.EventName)
+			}
+			if response.NextPageToken == "" {
+				break
+			}
+			pageToken = response.NextPageToken
+		}
+		if len(names) != 3 || names[0] != "運行終了" || names[2] != "運行開始" {
+			t.Errorf("unexpected order: %v", names)
+		}
+	})
+}
+
+func TestUntenNippoMeisaiService_Stream(t *testing.T) {
+	db, conn := testutil.NewClientConn(t)
+
+	client := proto.NewDb_UntenNippoMeisaiServiceClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// SQL Server (ichibanboshi) のテストデータ
+	for i, sharyoC := range []string{"0001", "0002", "0003", "0004"} {
+		row := &ichibanboshi.UntenNippoMeisai{
+			NippoK:        "1",
+			HaishaK:       "1",
+			SharyoC:       sharyoC,
+			KanriNengappi: time.Date(2025, 1, 10+i, 0, 0, 0, 0, time.UTC),
+		}
+		if err := db.SQLServer.DB.Create(row).Error; err != nil {
+			t.Fatalf("Failed to seed 運転日報明細: %v", err)
+		}
+	}
+
+	stream, err := client.Stream(ctx, &proto.Db_StreamUntenNippoMeisaiRequest{
+		StartDate: "2025-01-11",
+		EndDate:   "2025-01-13",
+		BatchSize: 1,
+	})
+	if err != nil {
+		t.Fatalf("Stream() error = %v", err)
+	}
+	var sharyoCs []string
+	for {
+		item, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Recv() error = %v", err)
+		}
+		sharyoCs = append(sharyoCs, item.SharyoC)
+	}
+	if len(sharyoCs) != 2 || sharyoCs[0] != "0002" || sharyoCs[1] != "0003" {
+		t.Errorf("unexpected streamed rows: %v", sharyoCs)
+	}
+}
+
+func TestETCNumService_AsOf(t *testing.T) {
+	db, conn := testutil.NewClientConn(t)
+
+	client := proto.NewDb_ETCNumServiceClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	at := func(month int) *time.Time {
+		t := time.Date(2025, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+		return &t
+	}
+	for _, etcNum := range []*models.ETCNum{
+		// CARD_A: 000001 → 000002 に付け替え（境界が同じなので重ならない）
+		{ETCCardNum: "CARD_A", CarID: "000001", StartDateTime: at(1), DueDateTime: at(4)},
+		{ETCCardNum: "CARD_A", CarID: "000002", StartDateTime: at(4)},
+		// CARD_B: 000003 の期限内に 000004 にも登録（5月〜6月が重なる）
+		{ETCCardNum: "CARD_B", CarID: "000003", StartDateTime: at(1), DueDateTime: at(6)},
+		{ETCCardNum: "CARD_B", CarID: "000004", StartDateTime: at(5)},
+		{ETCCardNum: "CARD_C", CarID: "000002", StartDateTime: at(1)},
+	} {
+		if err := db.Prod.DB.Create(etcNum).Error; err != nil {
+			t.Fatalf("Failed to seed etc_num: %v", err)
+		}
+	}
+
+	carIDs := func(resp *proto.Db_ListETCNumResponse) []string {
+		ids := make([]string, len(resp.Items))
+		for i, item := range resp.Items {
+			ids[i] = item.CarId
+		}
+		return ids
+	}
+	for _, tc := range []struct {
+		card string
+		at   *time.Time
+		want []string
+	}{
+		{"CARD_A", at(2), []string{"000001"}},
+		{"CARD_A", at(4), []string{"000002"}}, // 付け替えの日時は付け替え後のみ
+		{"CARD_A", at(8), []string{"000002"}},
+		{"CARD_B", at(5), []string{"000003", "000004"}},
+		{"CARD_B", at(6), []string{"000004"}}, // 終了日時は無効
+		{"CARD_B", at(7), []string{"000004"}},
+		{"CARD_A", nil, []string{"000002"}}, // 現在日時
+	} {
+		req := &proto.Db_GetETCNumByETCCardNumAtRequest{EtcCardNum: tc.card}
+		if tc.at != nil {
+			req.At = tc.at.Format(time.RFC3339)
+		}
+		resp, err := client.GetByETCCardNumAt(ctx, req)
+		if err != nil {
+			t.Fatalf("GetByETCCardNumAt failed: %v", err)
+		}
+		if got := carIDs(resp); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%s at %s: got %v, want %v", tc.card, req.At, got, tc.want)
+		}
+	}
+
+	resp, err := client.GetByCarIDAt(ctx, &proto.Db_GetETCNumByCarIDAtRequest{CarId: "000002", At: at(2).Format(time.RFC3339)})
+	if err != nil {
+		t.Fatalf("GetByCarIDAt failed: %v", err)
+	}
+	if len(resp.Items) != 1 || resp.Items[0].EtcCardNum != "CARD_C" {
+		t.Errorf("unexpected cards of 000002: %v", resp.Items)
+	}
+	if _, err := client.GetByCarIDAt(ctx, &proto.Db_GetETCNumByCarIDAtRequest{CarId: "000002", At: "2025-02-01"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument, got %v", err)
+	}
+
+	overlaps, err := client.ListOverlaps(ctx, &proto.Db_ListETCNumOverlapsRequest{})
+	if err != nil {
+		t.Fatalf("ListOverlaps failed: %v", err)
+	}
+	if len(overlaps.Overlaps) != 1 {
+		t.Fatalf("expected 1 overlap, got %v", overlaps.Overlaps)
+	}
+	o := overlaps.Overlaps[0]
+	if o.EtcCardNum != "CARD_B" || o.First.CarId != "000003" || o.Second.CarId != "000004" ||
+		o.GetOverlapStart() != at(5).Format(time.RFC3339) || o.GetOverlapEnd() != at(6).Format(time.RFC3339) {
+		t.Errorf("unexpected overlap: %+v", o)
+	}
+
+	cardA := "CARD_A"
+	overlaps, err = client.ListOverlaps(ctx, &proto.Db_ListETCNumOverlapsRequest{EtcCardNum: &cardA})
+	if err != nil {
+		t.Fatalf("ListOverlaps failed: %v", err)
+	}
+	if len(overlaps.Overlaps) != 0 {
+		t.Errorf("handover should not be an overlap: %v", overlaps.Overlaps)
+	}
+}
+
+func TestShainMasterService_Get(t *testing.T) {
+	db, conn := testutil.NewClientConn(t)
+
+	client := proto.NewDb_ShainMasterServiceClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	name := "山田太郎"
+	if err := db.SQLServer.DB.Create(&ichibanboshi.ShainMaster{ShainC: "0001", ShainN: &name}).Error; err != nil {
+		t.Fatalf("Failed to seed 社員ﾏｽﾀ: %v", err)
+	}
+
+	response, err := client.Get(ctx, &proto.Db_GetShainMasterRequest{ShainC: "0001"})
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if response.ShainMaster.GetShainN() != name {
+		t.Errorf("ShainN = %q, want %q", response.ShainMaster.GetShainN(), name)
+	}
+
+	_, err = client.Get(ctx, &proto.Db_GetShainMasterRequest{ShainC: "9999"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound, got %v", err)
+	}
+}
@@ -0,0 +1,133 @@
+package contract
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/yhonda-ohishi/db_service/src/models/ichibanboshi"
+	models "github.com/yhonda-ohishi/db_service/src/models/mysql"
+	"github.com/yhonda-ohishi/db_service/src/proto"
+	"github.com/yhonda-ohishi/db_service/src/testutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBumonMasterService(t *testing.T) {
+	db, conn := testutil.NewClientConn(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	str := func(s string) *string { return &s }
+
+	// SQL Server (ichibanboshi) の部門マスタ・運転日報明細
+	for _, m := range []*ichibanboshi.BumonMaster{
+		{BumonC: "010", BumonN: str("本社")},
+		{BumonC: "020", BumonN: str("横浜営業所")},
+	} {
+		if err := db.SQLServer.DB.Create(m).Error; err != nil {
+			t.Fatalf("Failed to seed 部門ﾏｽﾀ: %v", err)
+		}
+	}
+	for i, row := range []*ichibanboshi.UntenNippoMeisai{
+		{SharyoC: "1", JuchuBumon: "010", KadoBumon: "020"},
+		{SharyoC: "2", JuchuBumon: "020", KadoBumon: "020"},
+		{SharyoC: "3", JuchuBumon: "030", KadoBumon: "010"},
+	} {
+		row.NippoK = "1"
+		row.HaishaK = "1"
+		row.KanriNengappi = time.Date(2025, 1, 10+i, 0, 0, 0, 0, time.UTC)
+		if err := db.SQLServer.DB.Create(row).Error; err != nil {
+			t.Fatalf("Failed to seed 運転日報明細: %v", err)
+		}
+	}
+
+	// 本番DBの車輌・乗務員
+	if err := db.Prod.DB.Create(&models.Cars{ID: "000001", BumonCodeID: str("010")}).Error; err != nil {
+		t.Fatalf("Failed to seed cars: %v", err)
+	}
+	if err := db.Prod.DB.Create(&models.Drivers{ID: 1, Bumon: "010"}).Error; err != nil {
+		t.Fatalf("Failed to seed drivers: %v", err)
+	}
+
+	t.Run("Get and List", func(t *testing.T) {
+		client := proto.NewDb_BumonMasterServiceClient(conn)
+		response, err := client.Get(ctx, &proto.Db_GetBumonMasterRequest{BumonC: "020"})
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		if response.BumonMaster.GetBumonN() != "横浜営業所" {
+			t.Errorf("unexpected bumon: %v", response.BumonMaster)
+		}
+
+		_, err = client.Get(ctx, &proto.Db_GetBumonMasterRequest{BumonC: "999"})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("Expected NotFound, got %v", err)
+		}
+
+		list, err := client.List(ctx, &proto.Db_ListBumonMasterRequest{Limit: 10})
+		if err != nil {
+			t.Fatalf("List() error = %v", err)
+		}
+		if list.GetTotalCount() != 2 || list.Items[0].BumonC != "010" {
+			t.Errorf("unexpected list: %v", list.Items)
+		}
+	})
+
+	t.Run("Cars and Drivers", func(t *testing.T) {
+		cars, err := proto.NewDb_CarsServiceClient(conn).GetByBumonCodeID(ctx, &proto.Db_GetCarsByBumonCodeIDRequest{BumonCodeId: "010 "})
+		if err != nil {
+			t.Fatalf("GetByBumonCodeID() error = %v", err)
+		}
+		if len(cars.Items) != 1 || cars.Items[0].GetBumonName() != "本社" {
+			t.Errorf("unexpected cars: %v", cars.Items)
+		}
+
+		drivers, err := proto.NewDb_DriversServiceClient(conn).GetByBumon(ctx, &proto.Db_GetDriversByBumonRequest{Bumon: "010"})
+		if err != nil {
+			t.Fatalf("GetByBumon() error = %v", err)
+		}
+		if len(drivers.Items) != 1 || drivers.Items[0].GetBumonName() != "本社" {
+			t.Errorf("unexpected drivers: %v", drivers.Items)
+		}
+	})
+
+	t.Run("UntenNippoMeisai", func(t *testing.T) {
+		client := proto.NewDb_UntenNippoMeisaiServiceClient(conn)
+
+		// 受注部門・稼動部門のいずれか（管理年月日の降順）
+		response, err := client.GetByBumon(ctx, &proto.Db_GetUntenNippoMeisaiByBumonRequest{Bumon: "010"})
+		if err != nil {
+			t.Fatalf("GetByBumon() error = %v", err)
+		}
+		if response.GetTotalCount() != 2 || response.Items[0].SharyoC != "3" || response.Items[1].SharyoC != "1" {
+			t.Fatalf("unexpected items: %v", response.Items)
+		}
+		// 部門マスタにない部門は部門名なし
+		if response.Items[0].JuchuBumonName != nil || response.Items[0].GetKadoBumonName() != "本社" {
+			t.Errorf("unexpected bumon names: %v", response.Items[0])
+		}
+		if response.Items[1].GetJuchuBumonName() != "本社" || response.Items[1].GetKadoBumonName() != "横浜営業所" {
+			t.Errorf("unexpected bumon names: %v", response.Items[1])
+		}
+
+		response, err = client.GetByBumon(ctx, &proto.Db_GetUntenNippoMeisaiByBumonRequest{
+			Bumon:     "020",
+			Field:     proto.Db_UntenNippoMeisaiBumonField_UNTEN_NIPPO_MEISAI_BUMON_FIELD_JUCHU,
+			StartDate: "2025-01-01",
+			EndDate:   "2025-01-31",
+		})
+		if err != nil {
+			t.Fatalf("GetByBumon() error = %v", err)
+		}
+		if response.GetTotalCount() != 1 || response.Items[0].SharyoC != "2" {
+			t.Errorf("unexpected items: %v", response.Items)
+		}
+
+		_, err = client.GetByBumon(ctx, &proto.Db_GetUntenNippoMeisaiByBumonRequest{Bumon: " "})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument, got %v", err)
+		}
+	})
+}
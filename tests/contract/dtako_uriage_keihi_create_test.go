@@ -6,32 +6,27 @@ import (
 	"time"
 
 	"github.com/yhonda-ohishi/db_service/src/proto"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"github.com/yhonda-ohishi/db_service/src/testutil"
 )
 
 func TestDTakoUriageKeihiService_Create(t *testing.T) {
 	// gRPCサーバーへの接続
-	conn, err := grpc.Dial("localhost:50051", grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		t.Fatalf("Failed to connect to gRPC server: %v", err)
-	}
-	defer conn.Close()
+	_, conn := testutil.NewClientConn(t)
 
-	client := proto.NewDTakoUriageKeihiServiceClient(conn)
+	client := proto.NewDb_DTakoUriageKeihiServiceClient(conn)
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
 	// テストデータの作成
 	testCases := []struct {
 		name    string
-		request *proto.CreateDTakoUriageKeihiRequest
+		request *proto.Db_CreateDTakoUriageKeihiRequest
 		wantErr bool
 	}{
 		{
 			name: "Valid creation",
-			request: &proto.CreateDTakoUriageKeihiRequest{
-				DtakoUriageKeihi: &proto.DTakoUriageKeihi{
+			request: &proto.Db_CreateDTakoUriageKeihiRequest{
+				DtakoUriageKeihi: &proto.Db_DTakoUriageKeihi{
 					SrchId:      "TEST001",
 					Datetime:    "2025-09-19T10:00:00Z",
 					KeihiC:      1,
@@ -45,8 +40,8 @@ func TestDTakoUriageKeihiService_Create(t *testing.T) {
 		},
 		{
 			name: "Duplicate primary key",
-			request: &proto.CreateDTakoUriageKeihiRequest{
-				DtakoUriageKeihi: &proto.DTakoUriageKeihi{
+			request: &proto.Db_CreateDTakoUriageKeihiRequest{
+				DtakoUriageKeihi: &proto.Db_DTakoUriageKeihi{
 					SrchId:      "TEST001",
 					Datetime:    "2025-09-19T10:00:00Z",
 					KeihiC:      1,
@@ -59,8 +54,8 @@ func TestDTakoUriageKeihiService_Create(t *testing.T) {
 		},
 		{
 			name: "Missing required fields",
-			request: &proto.CreateDTakoUriageKeihiRequest{
-				DtakoUriageKeihi: &proto.DTakoUriageKeihi{
+			request: &proto.Db_CreateDTakoUriageKeihiRequest{
+				DtakoUriageKeihi: &proto.Db_DTakoUriageKeihi{
 					SrchId:   "TEST002",
 					Datetime: "2025-09-19T11:00:00Z",
 					// KeihiC missing
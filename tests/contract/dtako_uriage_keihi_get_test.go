@@ -6,27 +6,22 @@ import (
 	"time"
 
 	"github.com/yhonda-ohishi/db_service/src/proto"
-	"google.golang.org/grpc"
+	"github.com/yhonda-ohishi/db_service/src/testutil"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
 )
 
 func TestDTakoUriageKeihiService_Get(t *testing.T) {
 	// gRPCサーバーへの接続
-	conn, err := grpc.Dial("localhost:50051", grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		t.Fatalf("Failed to connect to gRPC server: %v", err)
-	}
-	defer conn.Close()
+	_, conn := testutil.NewClientConn(t)
 
-	client := proto.NewDTakoUriageKeihiServiceClient(conn)
+	client := proto.NewDb_DTakoUriageKeihiServiceClient(conn)
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
 	// テストデータの作成（事前準備）
-	setupData := &proto.CreateDTakoUriageKeihiRequest{
-		DtakoUriageKeihi: &proto.DTakoUriageKeihi{
+	setupData := &proto.Db_CreateDTakoUriageKeihiRequest{
+		DtakoUriageKeihi: &proto.Db_DTakoUriageKeihi{
 			SrchId:      "GET_TEST001",
 			Datetime:    "2025-09-19T12:00:00Z",
 			KeihiC:      1,
@@ -41,13 +36,13 @@ func TestDTakoUriageKeihiService_Get(t *testing.T) {
 	// テストケース
 	testCases := []struct {
 		name         string
-		request      *proto.GetDTakoUriageKeihiRequest
+		request      *proto.Db_GetDTakoUriageKeihiRequest
 		wantErr      bool
 		expectedCode codes.Code
 	}{
 		{
 			name: "Valid get",
-			request: &proto.GetDTakoUriageKeihiRequest{
+			request: &proto.Db_GetDTakoUriageKeihiRequest{
 				SrchId:   "GET_TEST001",
 				Datetime: "2025-09-19T12:00:00Z",
 				KeihiC:   1,
@@ -56,7 +51,7 @@ func TestDTakoUriageKeihiService_Get(t *testing.T) {
 		},
 		{
 			name: "Not found",
-			request: &proto.GetDTakoUriageKeihiRequest{
+			request: &proto.Db_GetDTakoUriageKeihiRequest{
 				SrchId:   "NONEXISTENT",
 				Datetime: "2025-09-19T12:00:00Z",
 				KeihiC:   999,
@@ -66,7 +61,7 @@ func TestDTakoUriageKeihiService_Get(t *testing.T) {
 		},
 		{
 			name: "Invalid primary key",
-			request: &proto.GetDTakoUriageKeihiRequest{
+			request: &proto.Db_GetDTakoUriageKeihiRequest{
 				SrchId:   "",
 				Datetime: "",
 				KeihiC:   0,
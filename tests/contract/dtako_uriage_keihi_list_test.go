@@ -6,19 +6,14 @@ import (
 	"time"
 
 	"github.com/yhonda-ohishi/db_service/src/proto"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"github.com/yhonda-ohishi/db_service/src/testutil"
 )
 
 func TestDTakoUriageKeihiService_List(t *testing.T) {
 	// gRPCサーバーへの接続
-	conn, err := grpc.Dial("localhost:50051", grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		t.Fatalf("Failed to connect to gRPC server: %v", err)
-	}
-	defer conn.Close()
+	_, conn := testutil.NewClientConn(t)
 
-	client := proto.NewDTakoUriageKeihiServiceClient(conn)
+	client := proto.NewDb_DTakoUriageKeihiServiceClient(conn)
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
@@ -38,8 +33,8 @@ func TestDTakoUriageKeihiService_List(t *testing.T) {
 	}
 
 	for _, td := range testData {
-		req := &proto.CreateDTakoUriageKeihiRequest{
-			DtakoUriageKeihi: &proto.DTakoUriageKeihi{
+		req := &proto.Db_CreateDTakoUriageKeihiRequest{
+			DtakoUriageKeihi: &proto.Db_DTakoUriageKeihi{
 				SrchId:      td.srchId,
 				Datetime:    td.datetime,
 				KeihiC:      td.keihiC,
@@ -54,13 +49,13 @@ func TestDTakoUriageKeihiService_List(t *testing.T) {
 	// テストケース
 	testCases := []struct {
 		name         string
-		request      *proto.ListDTakoUriageKeihiRequest
+		request      *proto.Db_ListDTakoUriageKeihiRequest
 		wantMinCount int32
 		wantMaxCount int32
 	}{
 		{
 			name: "List all with limit",
-			request: &proto.ListDTakoUriageKeihiRequest{
+			request: &proto.Db_ListDTakoUriageKeihiRequest{
 				Limit:  10,
 				Offset: 0,
 			},
@@ -69,7 +64,7 @@ func TestDTakoUriageKeihiService_List(t *testing.T) {
 		},
 		{
 			name: "List by dtako_row_id",
-			request: &proto.ListDTakoUriageKeihiRequest{
+			request: &proto.Db_ListDTakoUriageKeihiRequest{
 				DtakoRowId: &[]string{"DTAKO_LIST001"}[0],
 				Limit:      10,
 				Offset:     0,
@@ -79,7 +74,7 @@ func TestDTakoUriageKeihiService_List(t *testing.T) {
 		},
 		{
 			name: "List with date range",
-			request: &proto.ListDTakoUriageKeihiRequest{
+			request: &proto.Db_ListDTakoUriageKeihiRequest{
 				StartDate: &[]string{"2025-09-19T00:00:00Z"}[0],
 				EndDate:   &[]string{"2025-09-19T23:59:59Z"}[0],
 				Limit:     10,
@@ -90,7 +85,7 @@ func TestDTakoUriageKeihiService_List(t *testing.T) {
 		},
 		{
 			name: "List with pagination",
-			request: &proto.ListDTakoUriageKeihiRequest{
+			request: &proto.Db_ListDTakoUriageKeihiRequest{
 				Limit:  2,
 				Offset: 2,
 			},
@@ -99,7 +94,7 @@ func TestDTakoUriageKeihiService_List(t *testing.T) {
 		},
 		{
 			name: "List with high offset",
-			request: &proto.ListDTakoUriageKeihiRequest{
+			request: &proto.Db_ListDTakoUriageKeihiRequest{
 				Limit:  10,
 				Offset: 100,
 			},
@@ -128,9 +123,9 @@ func TestDTakoUriageKeihiService_List(t *testing.T) {
 			}
 
 			// 総件数の確認
-			if response.TotalCount < itemCount {
+			if response.GetTotalCount() < itemCount {
 				t.Errorf("TotalCount (%d) is less than returned items (%d)",
-					response.TotalCount, itemCount)
+					response.GetTotalCount(), itemCount)
 			}
 		})
 	}
@@ -6,27 +6,22 @@ import (
 	"time"
 
 	"github.com/yhonda-ohishi/db_service/src/proto"
-	"google.golang.org/grpc"
+	"github.com/yhonda-ohishi/db_service/src/testutil"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
 )
 
 func TestDTakoUriageKeihiService_Update(t *testing.T) {
 	// gRPCサーバーへの接続
-	conn, err := grpc.Dial("localhost:50051", grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		t.Fatalf("Failed to connect to gRPC server: %v", err)
-	}
-	defer conn.Close()
+	_, conn := testutil.NewClientConn(t)
 
-	client := proto.NewDTakoUriageKeihiServiceClient(conn)
+	client := proto.NewDb_DTakoUriageKeihiServiceClient(conn)
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
 	// テストデータの作成（事前準備）
-	setupData := &proto.CreateDTakoUriageKeihiRequest{
-		DtakoUriageKeihi: &proto.DTakoUriageKeihi{
+	setupData := &proto.Db_CreateDTakoUriageKeihiRequest{
+		DtakoUriageKeihi: &proto.Db_DTakoUriageKeihi{
 			SrchId:      "UPDATE_TEST001",
 			Datetime:    "2025-09-19T13:00:00Z",
 			KeihiC:      2,
@@ -41,14 +36,14 @@ func TestDTakoUriageKeihiService_Update(t *testing.T) {
 	// テストケース
 	testCases := []struct {
 		name         string
-		request      *proto.UpdateDTakoUriageKeihiRequest
+		request      *proto.Db_UpdateDTakoUriageKeihiRequest
 		wantErr      bool
 		expectedCode codes.Code
 	}{
 		{
 			name: "Valid update",
-			request: &proto.UpdateDTakoUriageKeihiRequest{
-				DtakoUriageKeihi: &proto.DTakoUriageKeihi{
+			request: &proto.Db_UpdateDTakoUriageKeihiRequest{
+				DtakoUriageKeihi: &proto.Db_DTakoUriageKeihi{
 					SrchId:      "UPDATE_TEST001",
 					Datetime:    "2025-09-19T13:00:00Z",
 					KeihiC:      2,
@@ -63,8 +58,8 @@ func TestDTakoUriageKeihiService_Update(t *testing.T) {
 		},
 		{
 			name: "Update non-existent record",
-			request: &proto.UpdateDTakoUriageKeihiRequest{
-				DtakoUriageKeihi: &proto.DTakoUriageKeihi{
+			request: &proto.Db_UpdateDTakoUriageKeihiRequest{
+				DtakoUriageKeihi: &proto.Db_DTakoUriageKeihi{
 					SrchId:      "NONEXISTENT",
 					Datetime:    "2025-09-19T14:00:00Z",
 					KeihiC:      999,
@@ -78,8 +73,8 @@ func TestDTakoUriageKeihiService_Update(t *testing.T) {
 		},
 		{
 			name: "Invalid update data",
-			request: &proto.UpdateDTakoUriageKeihiRequest{
-				DtakoUriageKeihi: &proto.DTakoUriageKeihi{
+			request: &proto.Db_UpdateDTakoUriageKeihiRequest{
+				DtakoUriageKeihi: &proto.Db_DTakoUriageKeihi{
 					SrchId:   "UPDATE_TEST001",
 					Datetime: "2025-09-19T13:00:00Z",
 					KeihiC:   2,
@@ -106,7 +101,7 @@ func TestDTakoUriageKeihiService_Update(t *testing.T) {
 			}
 			if !tc.wantErr {
 				// 更新後のデータを確認
-				getReq := &proto.GetDTakoUriageKeihiRequest{
+				getReq := &proto.Db_GetDTakoUriageKeihiRequest{
 					SrchId:   tc.request.DtakoUriageKeihi.SrchId,
 					Datetime: tc.request.DtakoUriageKeihi.Datetime,
 					KeihiC:   tc.request.DtakoUriageKeihi.KeihiC,
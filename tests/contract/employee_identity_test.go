@@ -0,0 +1,194 @@
+package contract
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/yhonda-ohishi/db_service/src/models/ichibanboshi"
+	models "github.com/yhonda-ohishi/db_service/src/models/mysql"
+	"github.com/yhonda-ohishi/db_service/src/proto"
+	"github.com/yhonda-ohishi/db_service/src/testutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEmployeeIdentityService(t *testing.T) {
+	db, conn := testutil.NewClientConn(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	str := func(s string) *string { return &s }
+	i32 := func(i int32) *int32 { return &i }
+	now := time.Now()
+
+	// 本番DBの乗務員・デジタコ運行データ（201はdriversのみ、301はdtako_rowsのみ）
+	driverCode := 101
+	for _, m := range []interface{}{
+		&models.Drivers{ID: 11, Name: str("山田太郎")},
+		&models.Drivers{ID: 201, Name: str("佐藤花子")},
+		&models.DTakoRows{ID: "row1", DriverCode1: &driverCode, TargetDriverCode: 301},
+	} {
+		if err := db.Prod.DB.Create(m).Error; err != nil {
+			t.Fatalf("Failed to seed %T: %v", m, err)
+		}
+	}
+	// SQL Server (ichibanboshi) の社員ﾏｽﾀ・運転日報明細（0900は運転日報明細のみ）
+	for _, m := range []interface{}{
+		&ichibanboshi.ShainMaster{ShainC: "0011", ShainN: str("山田太郎")},
+		&ichibanboshi.UntenNippoMeisai{NippoK: "1", HaishaK: "1", SharyoC: "0101", UntenshuC: "0900"},
+	} {
+		if err := db.SQLServer.DB.Create(m).Error; err != nil {
+			t.Fatalf("Failed to seed %T: %v", m, err)
+		}
+	}
+	// タイムカードの打刻（ID 7はcard-Bも使用）
+	for _, m := range []*models.TimeCardLog{
+		{Datetime: "2025-06-01T08:00:00+09:00", ID: 5, CardID: "card-A", MachineIP: "reader1", State: "in", Created: now, Modified: now},
+		{Datetime: "2025-06-01T08:05:00+09:00", ID: 7, CardID: "card-B", MachineIP: "reader1", State: "in", Created: now, Modified: now},
+	} {
+		if err := db.Local.Create(m).Error; err != nil {
+			t.Fatalf("Failed to seed timecard_logs: %v", err)
+		}
+	}
+
+	client := proto.NewDb_EmployeeIdentityServiceClient(conn)
+
+	created, err := client.Create(ctx, &proto.Db_CreateEmployeeIdentityRequest{
+		Employee: &proto.Db_EmployeeIdentity{
+			Name:            "山田太郎",
+			DriverId:        i32(11),
+			ShainC:          str(" 0011 "),
+			DtakoDriverCode: i32(101),
+			TimeCardUserId:  i32(5),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	employee := created.Employee
+	if employee.Id == 0 || employee.GetShainC() != "0011" {
+		t.Fatalf("unexpected employee: %v", employee)
+	}
+
+	t.Run("Create duplicate code", func(t *testing.T) {
+		_, err := client.Create(ctx, &proto.Db_CreateEmployeeIdentityRequest{
+			Employee: &proto.Db_EmployeeIdentity{Name: "別人", DriverId: i32(11)},
+		})
+		if status.Code(err) != codes.AlreadyExists {
+			t.Errorf("Create() code = %v, want AlreadyExists", status.Code(err))
+		}
+	})
+
+	t.Run("RegisterCard", func(t *testing.T) {
+		response, err := client.RegisterCard(ctx, &proto.Db_RegisterEmployeeCardRequest{EmployeeId: employee.Id, CardId: "card-A"})
+		if err != nil {
+			t.Fatalf("RegisterCard() error = %v", err)
+		}
+		if !response.Created || response.Card.CreatedBy != "system" {
+			t.Errorf("unexpected card: %v", response)
+		}
+
+		// 同じ社員への再登録は作成しない
+		response, err = client.RegisterCard(ctx, &proto.Db_RegisterEmployeeCardRequest{EmployeeId: employee.Id, CardId: "card-A"})
+		if err != nil {
+			t.Fatalf("RegisterCard() error = %v", err)
+		}
+		if response.Created {
+			t.Errorf("RegisterCard() created = true, want false")
+		}
+
+		other, err := client.Create(ctx, &proto.Db_CreateEmployeeIdentityRequest{Employee: &proto.Db_EmployeeIdentity{Name: "鈴木一郎"}})
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+		_, err = client.RegisterCard(ctx, &proto.Db_RegisterEmployeeCardRequest{EmployeeId: other.Employee.Id, CardId: "card-A"})
+		if status.Code(err) != codes.AlreadyExists {
+			t.Errorf("RegisterCard() code = %v, want AlreadyExists", status.Code(err))
+		}
+		if _, err := client.Delete(ctx, &proto.Db_DeleteEmployeeIdentityRequest{Id: other.Employee.Id}); err != nil {
+			t.Fatalf("Delete() error = %v", err)
+		}
+	})
+
+	t.Run("Resolve", func(t *testing.T) {
+		for name, req := range map[string]*proto.Db_ResolveEmployeeIdentityRequest{
+			"driver_id":         {Key: &proto.Db_ResolveEmployeeIdentityRequest_DriverId{DriverId: 11}},
+			"shain_c":           {Key: &proto.Db_ResolveEmployeeIdentityRequest_ShainC{ShainC: "0011"}},
+			"dtako_driver_code": {Key: &proto.Db_ResolveEmployeeIdentityRequest_DtakoDriverCode{DtakoDriverCode: 101}},
+			"untenshu_c":        {Key: &proto.Db_ResolveEmployeeIdentityRequest_UntenshuC{UntenshuC: "0011"}},
+			"time_card_user_id": {Key: &proto.Db_ResolveEmployeeIdentityRequest_TimeCardUserId{TimeCardUserId: 5}},
+			"card_id":           {Key: &proto.Db_ResolveEmployeeIdentityRequest_CardId{CardId: "card-A"}},
+		} {
+			response, err := client.Resolve(ctx, req)
+			if err != nil {
+				t.Fatalf("%s: Resolve() error = %v", name, err)
+			}
+			if response.Employee.Id != employee.Id || len(response.Cards) != 1 {
+				t.Errorf("%s: unexpected employee: %v", name, response)
+			}
+			if response.Driver == nil || response.Driver.Id != 11 || response.Shain == nil || response.Shain.ShainC != "0011" {
+				t.Errorf("%s: unexpected driver/shain: %v, %v", name, response.Driver, response.Shain)
+			}
+		}
+
+		_, err := client.Resolve(ctx, &proto.Db_ResolveEmployeeIdentityRequest{Key: &proto.Db_ResolveEmployeeIdentityRequest_DriverId{DriverId: 201}})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("Resolve() code = %v, want NotFound", status.Code(err))
+		}
+		_, err = client.Resolve(ctx, &proto.Db_ResolveEmployeeIdentityRequest{})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Resolve() code = %v, want InvalidArgument", status.Code(err))
+		}
+	})
+
+	t.Run("ListUnlinked", func(t *testing.T) {
+		response, err := client.ListUnlinked(ctx, &proto.Db_ListUnlinkedEmployeeCodesRequest{})
+		if err != nil {
+			t.Fatalf("ListUnlinked() error = %v", err)
+		}
+		if len(response.Sources) != 6 {
+			t.Errorf("unexpected sources: %v", response.Sources)
+		}
+		got := make(map[proto.Db_EmployeeCodeSource][]string)
+		for _, item := range response.Items {
+			got[item.Source] = append(got[item.Source], item.Code)
+		}
+		want := map[proto.Db_EmployeeCodeSource]string{
+			proto.Db_EmployeeCodeSource_EMPLOYEE_CODE_SOURCE_DRIVERS:            "201",
+			proto.Db_EmployeeCodeSource_EMPLOYEE_CODE_SOURCE_DTAKO_ROWS:         "301",
+			proto.Db_EmployeeCodeSource_EMPLOYEE_CODE_SOURCE_UNTEN_NIPPO_MEISAI: "0900",
+			proto.Db_EmployeeCodeSource_EMPLOYEE_CODE_SOURCE_TIME_CARD_USER:     "7",
+			proto.Db_EmployeeCodeSource_EMPLOYEE_CODE_SOURCE_TIME_CARD_CARD:     "card-B",
+		}
+		if len(got) != len(want) {
+			t.Errorf("unexpected unlinked codes: %v", got)
+		}
+		for source, code := range want {
+			if codes := got[source]; len(codes) != 1 || codes[0] != code {
+				t.Errorf("%v: unlinked codes = %v, want [%s]", source, codes, code)
+			}
+		}
+
+		response, err = client.ListUnlinked(ctx, &proto.Db_ListUnlinkedEmployeeCodesRequest{
+			Sources: []proto.Db_EmployeeCodeSource{proto.Db_EmployeeCodeSource_EMPLOYEE_CODE_SOURCE_DRIVERS},
+		})
+		if err != nil {
+			t.Fatalf("ListUnlinked() error = %v", err)
+		}
+		if len(response.Items) != 1 || response.Items[0].GetName() != "佐藤花子" {
+			t.Errorf("unexpected filtered items: %v", response.Items)
+		}
+	})
+
+	t.Run("UnregisterCard", func(t *testing.T) {
+		if _, err := client.UnregisterCard(ctx, &proto.Db_UnregisterEmployeeCardRequest{CardId: "card-A"}); err != nil {
+			t.Fatalf("UnregisterCard() error = %v", err)
+		}
+		_, err := client.Resolve(ctx, &proto.Db_ResolveEmployeeIdentityRequest{Key: &proto.Db_ResolveEmployeeIdentityRequest_CardId{CardId: "card-A"}})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("Resolve() code = %v, want NotFound", status.Code(err))
+		}
+	})
+}
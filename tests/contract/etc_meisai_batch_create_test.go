@@ -0,0 +1,184 @@
+package contract
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/yhonda-ohishi/db_service/src/etccsv"
+	"github.com/yhonda-ohishi/db_service/src/proto"
+	"github.com/yhonda-ohishi/db_service/src/testutil"
+	"golang.org/x/text/encoding/japanese"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// batchETCMeisai 一括作成用のETC明細（hashはサーバー側で生成）
+func batchETCMeisai(day int, price int32) *proto.Db_ETCMeisai {
+	return &proto.Db_ETCMeisai{
+		DateTo:     time.Date(2025, 9, day, 10, 0, 0, 0, time.UTC).Format(time.RFC3339),
+		DateToDate: time.Date(2025, 9, day, 0, 0, 0, 0, time.UTC).Format("2006-01-02"),
+		IcFr:       stringPtr("東京IC"),
+		IcTo:       "横浜IC",
+		Price:      price,
+		Shashu:     1,
+		EtcNum:     "1234567890123456",
+	}
+}
+
+// resultStatuses 各明細の結果のステータス
+func resultStatuses(resp *proto.Db_BatchCreateETCMeisaiResponse) []proto.Db_BatchItemStatus {
+	statuses := make([]proto.Db_BatchItemStatus, len(resp.Results))
+	for i, result := range resp.Results {
+		statuses[i] = result.Status
+	}
+	return statuses
+}
+
+func TestETCMeisaiService_BatchCreate(t *testing.T) {
+	_, conn := testutil.NewClientConn(t)
+
+	client := proto.NewDb_ETCMeisaiServiceClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	invalid := batchETCMeisai(3, 1000)
+	invalid.IcTo = ""
+	badDate := batchETCMeisai(4, 1000)
+	badDate.DateTo = "2025/09/04"
+	forged := batchETCMeisai(6, 1000)
+	forged.Hash = "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+	resp, err := client.BatchCreate(ctx, &proto.Db_BatchCreateETCMeisaiRequest{
+		Items: []*proto.Db_ETCMeisai{
+			batchETCMeisai(1, 1000),
+			batchETCMeisai(2, 2000),
+			batchETCMeisai(1, 1000), // リクエスト内で重複
+			invalid,
+			badDate,
+			forged, // 明細の内容と一致しないhash
+		},
+	})
+	if err != nil {
+		t.Fatalf("BatchCreate failed: %v", err)
+	}
+
+	inserted := proto.Db_BatchItemStatus_BATCH_ITEM_STATUS_INSERTED
+	duplicate := proto.Db_BatchItemStatus_BATCH_ITEM_STATUS_DUPLICATE
+	invalidStatus := proto.Db_BatchItemStatus_BATCH_ITEM_STATUS_INVALID
+	want := []proto.Db_BatchItemStatus{inserted, inserted, duplicate, invalidStatus, invalidStatus, invalidStatus}
+	got := resultStatuses(resp)
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("result %d: got %v, want %v (reason %q)", i, got[i], want[i], resp.Results[i].Reason)
+		}
+	}
+	if resp.InsertedCount != 2 || resp.DuplicateCount != 1 || resp.InvalidCount != 3 {
+		t.Errorf("unexpected counts: %+v", resp)
+	}
+	if resp.Results[2].Id != resp.Results[0].Id || resp.Results[0].Hash == "" {
+		t.Errorf("duplicate should refer to the first item: %+v", resp.Results[2])
+	}
+	if resp.Results[3].Reason == "" || resp.Results[4].Reason == "" {
+		t.Error("invalid items should have a reason")
+	}
+	if !strings.Contains(resp.Results[5].Reason, "hash") {
+		t.Errorf("forged hash should be rejected: %+v", resp.Results[5])
+	}
+
+	// 登録済みのhashはetc_meisai.hashの一意制約で拒否される
+	existing := batchETCMeisai(1, 1000)
+	existing.Hash = resp.Results[0].Hash
+	if _, err := client.Create(ctx, &proto.Db_CreateETCMeisaiRequest{EtcMeisai: existing}); status.Code(err) != codes.AlreadyExists {
+		t.Errorf("expected AlreadyExists for a registered hash, got %v", err)
+	}
+
+	// 同じ明細を再度取り込んでも作成されない（クライアントストリーミング）
+	stream, err := client.BatchCreateStream(ctx)
+	if err != nil {
+		t.Fatalf("BatchCreateStream failed: %v", err)
+	}
+	for _, item := range []*proto.Db_ETCMeisai{batchETCMeisai(1, 1000), batchETCMeisai(2, 2000), batchETCMeisai(5, 500)} {
+		if err := stream.Send(&proto.Db_BatchCreateETCMeisaiRequest{Items: []*proto.Db_ETCMeisai{item}}); err != nil {
+			t.Fatalf("Send failed: %v", err)
+		}
+	}
+	resp, err = stream.CloseAndRecv()
+	if err != nil {
+		t.Fatalf("CloseAndRecv failed: %v", err)
+	}
+	if resp.InsertedCount != 1 || resp.DuplicateCount != 2 {
+		t.Errorf("unexpected counts on re-import: %+v", resp)
+	}
+	if resp.Results[2].Index != 2 || resp.Results[2].Status != inserted {
+		t.Errorf("unexpected result for new item: %+v", resp.Results[2])
+	}
+
+	list, err := client.List(ctx, &proto.Db_ListETCMeisaiRequest{Limit: 100})
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(list.Items) != 3 {
+		t.Errorf("expected 3 records, got %d", len(list.Items))
+	}
+
+	// 空のリクエストは拒否
+	if _, err := client.BatchCreate(ctx, &proto.Db_BatchCreateETCMeisaiRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument, got %v", err)
+	}
+}
+
+func TestETCMeisaiService_Import(t *testing.T) {
+	_, conn := testutil.NewClientConn(t)
+
+	client := proto.NewDb_ETCMeisaiServiceClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	csv, err := japanese.ShiftJIS.NewEncoder().Bytes([]byte(strings.Join([]string{
+		strings.Join(etccsv.Header, ","),
+		"25/09/19,09:30,25/09/19,10:05,東京,横浜青葉,1500,300,1200,1,1234,1234567890123456,",
+		"25/09/20,08:00,25/09/20,08:40,横浜青葉,東京,1500,300,1200,1,1234,1234567890123456,",
+		"25/09/20,08:00,不明,08:40,横浜青葉,東京,1500,300,1200,1,1234,1234567890123456,",
+	}, "\r\n")))
+	if err != nil {
+		t.Fatalf("failed to encode csv: %v", err)
+	}
+
+	// dry-runでは作成しない
+	resp, err := client.Import(ctx, &proto.Db_ImportETCMeisaiRequest{Csv: csv, DryRun: true})
+	if err != nil {
+		t.Fatalf("Import (dry-run) failed: %v", err)
+	}
+	if !resp.DryRun || resp.InsertedCount != 2 || resp.InvalidCount != 1 {
+		t.Errorf("unexpected dry-run result: %+v", resp)
+	}
+	if resp.Results[2].Index != 4 || resp.Results[2].Status != proto.Db_BatchItemStatus_BATCH_ITEM_STATUS_INVALID {
+		t.Errorf("unexpected result for invalid line: %+v", resp.Results[2])
+	}
+
+	resp, err = client.Import(ctx, &proto.Db_ImportETCMeisaiRequest{Csv: csv})
+	if err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+	if resp.InsertedCount != 2 || resp.Results[0].Index != 2 || resp.Results[0].Id == 0 {
+		t.Errorf("unexpected import result: %+v", resp)
+	}
+
+	// 再度取り込むと重複
+	resp, err = client.Import(ctx, &proto.Db_ImportETCMeisaiRequest{Csv: csv, DryRun: true})
+	if err != nil {
+		t.Fatalf("Import (dry-run) failed: %v", err)
+	}
+	if resp.InsertedCount != 0 || resp.DuplicateCount != 2 || resp.Results[0].Id == 0 {
+		t.Errorf("unexpected re-import result: %+v", resp)
+	}
+
+	if _, err := client.Import(ctx, &proto.Db_ImportETCMeisaiRequest{Csv: []byte("a,b\n1,2\n")}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument, got %v", err)
+	}
+}
@@ -6,35 +6,30 @@ import (
 	"time"
 
 	"github.com/yhonda-ohishi/db_service/src/proto"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"github.com/yhonda-ohishi/db_service/src/testutil"
 )
 
 func TestETCMeisaiService_Create(t *testing.T) {
 	// gRPCサーバーへの接続
-	conn, err := grpc.Dial("localhost:50051", grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		t.Fatalf("Failed to connect to gRPC server: %v", err)
-	}
-	defer conn.Close()
+	_, conn := testutil.NewClientConn(t)
 
-	client := proto.NewETCMeisaiServiceClient(conn)
+	client := proto.NewDb_ETCMeisaiServiceClient(conn)
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
 	// テストケース
 	testCases := []struct {
 		name    string
-		request *proto.CreateETCMeisaiRequest
+		request *proto.Db_CreateETCMeisaiRequest
 		wantErr bool
 	}{
 		{
 			name: "Valid creation",
-			request: &proto.CreateETCMeisaiRequest{
-				EtcMeisai: &proto.ETCMeisai{
+			request: &proto.Db_CreateETCMeisaiRequest{
+				EtcMeisai: &proto.Db_ETCMeisai{
 					DateTo:     "2025-09-19T10:00:00Z",
 					DateToDate: "2025-09-19",
-					IcFr:       "東京IC",
+					IcFr:       stringPtr("東京IC"),
 					IcTo:       "横浜IC",
 					Price:      1500,
 					Shashu:     1,
@@ -46,8 +41,8 @@ func TestETCMeisaiService_Create(t *testing.T) {
 		},
 		{
 			name: "Missing required fields",
-			request: &proto.CreateETCMeisaiRequest{
-				EtcMeisai: &proto.ETCMeisai{
+			request: &proto.Db_CreateETCMeisaiRequest{
+				EtcMeisai: &proto.Db_ETCMeisai{
 					DateTo: "2025-09-19T10:00:00Z",
 					// IcFr, IcTo missing
 					Price: 1500,
@@ -56,20 +51,20 @@ func TestETCMeisaiService_Create(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name: "Invalid price",
-			request: &proto.CreateETCMeisaiRequest{
-				EtcMeisai: &proto.ETCMeisai{
+			name: "Negative price",
+			request: &proto.Db_CreateETCMeisaiRequest{
+				EtcMeisai: &proto.Db_ETCMeisai{
 					DateTo:     "2025-09-19T10:00:00Z",
 					DateToDate: "2025-09-19",
-					IcFr:       "東京IC",
+					IcFr:       stringPtr("東京IC"),
 					IcTo:       "横浜IC",
-					Price:      -100, // 無効な料金
+					Price:      -100, // 返金・調整による負の料金は許可
 					Shashu:     1,
 					EtcNum:     "1234567890123456",
-					Hash:       "test_hash_invalid",
+					Hash:       "test_hash_negative",
 				},
 			},
-			wantErr: true,
+			wantErr: false,
 		},
 	}
 
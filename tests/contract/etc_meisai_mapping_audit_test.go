@@ -0,0 +1,96 @@
+package contract
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	models "github.com/yhonda-ohishi/db_service/src/models/mysql"
+	"github.com/yhonda-ohishi/db_service/src/proto"
+	"github.com/yhonda-ohishi/db_service/src/testutil"
+)
+
+func TestETCMeisaiMappingAuditService_Audit(t *testing.T) {
+	db, conn := testutil.NewClientConn(t)
+
+	client := proto.NewDb_ETCMeisaiMappingAuditServiceClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := db.Prod.DB.Create(&models.DTakoRows{ID: "ROW1"}).Error; err != nil {
+		t.Fatalf("failed to seed dtako_rows: %v", err)
+	}
+
+	meisai := func(price int32) *models.ETCMeisai {
+		m := &models.ETCMeisai{
+			DateTo:     time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC),
+			DateToDate: time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC),
+			IcTo:       "横浜IC",
+			Price:      price,
+			Shashu:     1,
+			EtcNum:     "1234567890123456",
+		}
+		m.SetHash()
+		if err := db.Local.Create(m).Error; err != nil {
+			t.Fatalf("failed to seed etc_meisai: %v", err)
+		}
+		return m
+	}
+	valid := meisai(1000)
+	stale := meisai(2000)
+	// hashを更新せずに明細を変更
+	if err := db.Local.Model(stale).Update("price", 2500).Error; err != nil {
+		t.Fatalf("failed to update etc_meisai: %v", err)
+	}
+
+	for _, mapping := range []*models.ETCMeisaiMapping{
+		{ID: 1, ETCMeisaiHash: valid.Hash, DTakoRowID: "ROW1"},
+		{ID: 2, ETCMeisaiHash: valid.Hash, DTakoRowID: "ROW_MISSING"},
+		{ID: 3, ETCMeisaiHash: "missing_hash", DTakoRowID: "ROW1"},
+		{ID: 4, ETCMeisaiHash: stale.Hash, DTakoRowID: "ROW1"},
+	} {
+		mapping.CreatedBy = "test"
+		mapping.SetDefaults()
+		if err := db.Local.Create(mapping).Error; err != nil {
+			t.Fatalf("failed to seed etc_meisai_mapping: %v", err)
+		}
+	}
+
+	resp, err := client.Audit(ctx, &proto.Db_AuditETCMeisaiMappingRequest{})
+	if err != nil {
+		t.Fatalf("Audit failed: %v", err)
+	}
+	if resp.MappingCount != 4 || resp.OrphanHashCount != 1 || resp.OrphanDtakoRowCount != 1 ||
+		resp.DuplicateHashCount != 1 || resp.StaleHashCount != 1 || len(resp.Issues) != 4 {
+		t.Fatalf("unexpected report: %+v", resp)
+	}
+
+	want := []*proto.Db_MappingIssue{
+		{Kind: proto.Db_MappingIssueKind_MAPPING_ISSUE_KIND_ORPHAN_HASH, MappingIds: []int64{3}, EtcMeisaiHash: "missing_hash", DtakoRowIds: []string{"ROW1"}},
+		{Kind: proto.Db_MappingIssueKind_MAPPING_ISSUE_KIND_ORPHAN_DTAKO_ROW, MappingIds: []int64{2}, DtakoRowIds: []string{"ROW_MISSING"}},
+		{Kind: proto.Db_MappingIssueKind_MAPPING_ISSUE_KIND_DUPLICATE_HASH, MappingIds: []int64{1, 2}, EtcMeisaiHash: valid.Hash, DtakoRowIds: []string{"ROW1", "ROW_MISSING"}},
+	}
+	for i, w := range want {
+		got := resp.Issues[i]
+		if got.Kind != w.Kind || got.EtcMeisaiHash != w.EtcMeisaiHash ||
+			!reflect.DeepEqual(got.MappingIds, w.MappingIds) || !reflect.DeepEqual(got.DtakoRowIds, w.DtakoRowIds) {
+			t.Errorf("issue %d: got %+v, want %+v", i, got, w)
+		}
+	}
+	if got := resp.Issues[3]; got.Kind != proto.Db_MappingIssueKind_MAPPING_ISSUE_KIND_STALE_HASH ||
+		got.EtcMeisaiId != stale.ID || got.EtcMeisaiHash != stale.Hash || got.ExpectedHash == "" || got.ExpectedHash == stale.Hash {
+		t.Errorf("unexpected stale hash issue: %+v", got)
+	}
+
+	// 種類を指定した場合は件数はそのまま、問題はその種類のみ
+	resp, err = client.Audit(ctx, &proto.Db_AuditETCMeisaiMappingRequest{
+		Kinds: []proto.Db_MappingIssueKind{proto.Db_MappingIssueKind_MAPPING_ISSUE_KIND_STALE_HASH},
+	})
+	if err != nil {
+		t.Fatalf("Audit failed: %v", err)
+	}
+	if len(resp.Issues) != 1 || resp.OrphanHashCount != 1 {
+		t.Errorf("unexpected filtered report: %+v", resp)
+	}
+}
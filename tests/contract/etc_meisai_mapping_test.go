@@ -6,30 +6,27 @@ import (
 	"time"
 
 	"github.com/yhonda-ohishi/db_service/src/proto"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"github.com/yhonda-ohishi/db_service/src/testutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestETCMeisaiMappingService_Create(t *testing.T) {
-	conn, err := grpc.Dial("localhost:50051", grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		t.Fatalf("Failed to connect to gRPC server: %v", err)
-	}
-	defer conn.Close()
+	_, conn := testutil.NewClientConn(t)
 
-	client := proto.NewETCMeisaiMappingServiceClient(conn)
+	client := proto.NewDb_ETCMeisaiMappingServiceClient(conn)
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
 	testCases := []struct {
 		name    string
-		request *proto.CreateETCMeisaiMappingRequest
+		request *proto.Db_CreateETCMeisaiMappingRequest
 		wantErr bool
 	}{
 		{
 			name: "Valid mapping creation",
-			request: &proto.CreateETCMeisaiMappingRequest{
-				EtcMeisaiMapping: &proto.ETCMeisaiMapping{
+			request: &proto.Db_CreateETCMeisaiMappingRequest{
+				EtcMeisaiMapping: &proto.Db_ETCMeisaiMapping{
 					EtcMeisaiHash: "a1b2c3d4e5f6789012345678901234567890abcdef1234567890abcdef123456",
 					DtakoRowId:    "ROW123456789012345678901",
 					CreatedBy:     "test_user",
@@ -40,8 +37,8 @@ func TestETCMeisaiMappingService_Create(t *testing.T) {
 		},
 		{
 			name: "Missing hash",
-			request: &proto.CreateETCMeisaiMappingRequest{
-				EtcMeisaiMapping: &proto.ETCMeisaiMapping{
+			request: &proto.Db_CreateETCMeisaiMappingRequest{
+				EtcMeisaiMapping: &proto.Db_ETCMeisaiMapping{
 					DtakoRowId: "ROW123456789012345678901",
 					CreatedBy:  "test_user",
 				},
@@ -50,8 +47,8 @@ func TestETCMeisaiMappingService_Create(t *testing.T) {
 		},
 		{
 			name: "Missing dtako_row_id",
-			request: &proto.CreateETCMeisaiMappingRequest{
-				EtcMeisaiMapping: &proto.ETCMeisaiMapping{
+			request: &proto.Db_CreateETCMeisaiMappingRequest{
+				EtcMeisaiMapping: &proto.Db_ETCMeisaiMapping{
 					EtcMeisaiHash: "a1b2c3d4e5f6789012345678901234567890abcdef1234567890abcdef123456",
 					CreatedBy:     "test_user",
 				},
@@ -60,8 +57,8 @@ func TestETCMeisaiMappingService_Create(t *testing.T) {
 		},
 		{
 			name: "Missing created_by",
-			request: &proto.CreateETCMeisaiMappingRequest{
-				EtcMeisaiMapping: &proto.ETCMeisaiMapping{
+			request: &proto.Db_CreateETCMeisaiMappingRequest{
+				EtcMeisaiMapping: &proto.Db_ETCMeisaiMapping{
 					EtcMeisaiHash: "a1b2c3d4e5f6789012345678901234567890abcdef1234567890abcdef123456",
 					DtakoRowId:    "ROW123456789012345678901",
 				},
@@ -89,38 +86,34 @@ func TestETCMeisaiMappingService_Create(t *testing.T) {
 }
 
 func TestETCMeisaiMappingService_GetDTakoRowIDByHash(t *testing.T) {
-	conn, err := grpc.Dial("localhost:50051", grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		t.Fatalf("Failed to connect to gRPC server: %v", err)
-	}
-	defer conn.Close()
+	_, conn := testutil.NewClientConn(t)
 
-	client := proto.NewETCMeisaiMappingServiceClient(conn)
+	client := proto.NewDb_ETCMeisaiMappingServiceClient(conn)
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
 	testCases := []struct {
 		name    string
-		request *proto.GetDTakoRowIDByHashRequest
+		request *proto.Db_GetDTakoRowIDByHashRequest
 		wantErr bool
 	}{
 		{
 			name: "Valid hash lookup",
-			request: &proto.GetDTakoRowIDByHashRequest{
+			request: &proto.Db_GetDTakoRowIDByHashRequest{
 				EtcMeisaiHash: "a1b2c3d4e5f6789012345678901234567890abcdef1234567890abcdef123456",
 			},
 			wantErr: false,
 		},
 		{
 			name: "Empty hash",
-			request: &proto.GetDTakoRowIDByHashRequest{
+			request: &proto.Db_GetDTakoRowIDByHashRequest{
 				EtcMeisaiHash: "",
 			},
 			wantErr: true,
 		},
 		{
 			name: "Non-existent hash",
-			request: &proto.GetDTakoRowIDByHashRequest{
+			request: &proto.Db_GetDTakoRowIDByHashRequest{
 				EtcMeisaiHash: "nonexistent_hash_123456789012345678901234567890123456789012345",
 			},
 			wantErr: false, // 空のリストが返される
@@ -142,24 +135,20 @@ func TestETCMeisaiMappingService_GetDTakoRowIDByHash(t *testing.T) {
 }
 
 func TestETCMeisaiMappingService_List(t *testing.T) {
-	conn, err := grpc.Dial("localhost:50051", grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		t.Fatalf("Failed to connect to gRPC server: %v", err)
-	}
-	defer conn.Close()
+	_, conn := testutil.NewClientConn(t)
 
-	client := proto.NewETCMeisaiMappingServiceClient(conn)
+	client := proto.NewDb_ETCMeisaiMappingServiceClient(conn)
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
 	testCases := []struct {
 		name    string
-		request *proto.ListETCMeisaiMappingRequest
+		request *proto.Db_ListETCMeisaiMappingRequest
 		wantErr bool
 	}{
 		{
 			name: "Valid list request",
-			request: &proto.ListETCMeisaiMappingRequest{
+			request: &proto.Db_ListETCMeisaiMappingRequest{
 				Limit:  10,
 				Offset: 0,
 			},
@@ -167,7 +156,7 @@ func TestETCMeisaiMappingService_List(t *testing.T) {
 		},
 		{
 			name: "Filter by hash",
-			request: &proto.ListETCMeisaiMappingRequest{
+			request: &proto.Db_ListETCMeisaiMappingRequest{
 				EtcMeisaiHash: &[]string{"a1b2c3d4e5f6789012345678901234567890abcdef1234567890abcdef123456"}[0],
 				Limit:         10,
 				Offset:        0,
@@ -176,7 +165,7 @@ func TestETCMeisaiMappingService_List(t *testing.T) {
 		},
 		{
 			name: "Filter by dtako_row_id",
-			request: &proto.ListETCMeisaiMappingRequest{
+			request: &proto.Db_ListETCMeisaiMappingRequest{
 				DtakoRowId: &[]string{"ROW123456789012345678901"}[0],
 				Limit:      10,
 				Offset:     0,
@@ -185,7 +174,7 @@ func TestETCMeisaiMappingService_List(t *testing.T) {
 		},
 		{
 			name: "Invalid limit",
-			request: &proto.ListETCMeisaiMappingRequest{
+			request: &proto.Db_ListETCMeisaiMappingRequest{
 				Limit:  -1,
 				Offset: 0,
 			},
@@ -203,10 +192,139 @@ func TestETCMeisaiMappingService_List(t *testing.T) {
 			if !tc.wantErr {
 				if response == nil {
 					t.Error("Expected non-nil response for successful list")
-				} else if response.TotalCount < 0 {
+				} else if response.GetTotalCount() < 0 {
 					t.Error("Expected non-negative total count")
 				}
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestETCMeisaiMappingService_Uniqueness(t *testing.T) {
+	_, conn := testutil.NewClientConn(t)
+
+	client := proto.NewDb_ETCMeisaiMappingServiceClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	mapping := &proto.Db_ETCMeisaiMapping{
+		EtcMeisaiHash: "hash_unique",
+		DtakoRowId:    "ROW_UNIQUE",
+		CreatedBy:     "test_user",
+	}
+	created, err := client.Create(ctx, &proto.Db_CreateETCMeisaiMappingRequest{EtcMeisaiMapping: mapping})
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if !created.Created {
+		t.Errorf("expected created=true: %+v", created)
+	}
+
+	// 同じペアは作成できない
+	_, err = client.Create(ctx, &proto.Db_CreateETCMeisaiMappingRequest{EtcMeisaiMapping: mapping})
+	if status.Code(err) != codes.AlreadyExists {
+		t.Errorf("expected AlreadyExists, got %v", err)
+	}
+
+	// upsertは登録済みのマッピングを返し、notesを更新する
+	notes := "retried"
+	upserted, err := client.Create(ctx, &proto.Db_CreateETCMeisaiMappingRequest{
+		EtcMeisaiMapping: &proto.Db_ETCMeisaiMapping{EtcMeisaiHash: "hash_unique", DtakoRowId: "ROW_UNIQUE", CreatedBy: "retry", Notes: &notes},
+		Upsert:           true,
+	})
+	if err != nil {
+		t.Fatalf("Create (upsert) failed: %v", err)
+	}
+	if m := upserted.EtcMeisaiMapping; upserted.Created || m.Id != created.EtcMeisaiMapping.Id || m.GetNotes() != notes || m.CreatedBy != "test_user" {
+		t.Errorf("unexpected upsert result: %+v", upserted)
+	}
+
+	// 別のマッピングを同じペアに更新できない
+	other, err := client.Create(ctx, &proto.Db_CreateETCMeisaiMappingRequest{
+		EtcMeisaiMapping: &proto.Db_ETCMeisaiMapping{EtcMeisaiHash: "hash_other", DtakoRowId: "ROW_UNIQUE", CreatedBy: "test_user"},
+	})
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	other.EtcMeisaiMapping.EtcMeisaiHash = "hash_unique"
+	_, err = client.Update(ctx, &proto.Db_UpdateETCMeisaiMappingRequest{EtcMeisaiMapping: other.EtcMeisaiMapping})
+	if status.Code(err) != codes.AlreadyExists {
+		t.Errorf("expected AlreadyExists on update, got %v", err)
+	}
+
+	if _, err := client.Delete(ctx, &proto.Db_DeleteETCMeisaiMappingRequest{Id: 999999}); status.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound on delete, got %v", err)
+	}
+}
+
+func TestETCMeisaiMappingService_BulkReplace(t *testing.T) {
+	_, conn := testutil.NewClientConn(t)
+
+	client := proto.NewDb_ETCMeisaiMappingServiceClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	for _, m := range []*proto.Db_ETCMeisaiMapping{
+		{EtcMeisaiHash: "hash_1", DtakoRowId: "ROW_A", CreatedBy: "test_user"},
+		{EtcMeisaiHash: "hash_2", DtakoRowId: "ROW_A", CreatedBy: "test_user"},
+		{EtcMeisaiHash: "hash_1", DtakoRowId: "ROW_B", CreatedBy: "test_user"},
+	} {
+		if _, err := client.Create(ctx, &proto.Db_CreateETCMeisaiMappingRequest{EtcMeisaiMapping: m}); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+
+	resp, err := client.BulkReplace(ctx, &proto.Db_BulkReplaceETCMeisaiMappingRequest{
+		DtakoRowId: "ROW_A",
+		Items: []*proto.Db_ETCMeisaiMapping{
+			{EtcMeisaiHash: "hash_2", CreatedBy: "test_user"},
+			{EtcMeisaiHash: "hash_3", DtakoRowId: "ROW_A", CreatedBy: "test_user"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("BulkReplace failed: %v", err)
+	}
+	if resp.DeletedCount != 2 || len(resp.Items) != 2 || resp.Items[0].Id == 0 || resp.Items[0].DtakoRowId != "ROW_A" {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+
+	rowID := "ROW_A"
+	list, err := client.List(ctx, &proto.Db_ListETCMeisaiMappingRequest{DtakoRowId: &rowID, Limit: 100})
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	hashes := map[string]bool{}
+	for _, item := range list.Items {
+		hashes[item.EtcMeisaiHash] = true
+	}
+	if len(list.Items) != 2 || !hashes["hash_2"] || !hashes["hash_3"] {
+		t.Errorf("unexpected mappings after replace: %+v", list.Items)
+	}
+
+	// 失敗した場合は置き換えない
+	for _, items := range [][]*proto.Db_ETCMeisaiMapping{
+		{{EtcMeisaiHash: "hash_4", DtakoRowId: "ROW_B", CreatedBy: "test_user"}},
+		{{EtcMeisaiHash: "hash_4", CreatedBy: "test_user"}, {EtcMeisaiHash: "hash_4", CreatedBy: "test_user"}},
+		{{CreatedBy: "test_user"}},
+	} {
+		if _, err := client.BulkReplace(ctx, &proto.Db_BulkReplaceETCMeisaiMappingRequest{DtakoRowId: "ROW_A", Items: items}); err == nil {
+			t.Errorf("expected error for %+v", items)
+		}
+	}
+	list, err = client.List(ctx, &proto.Db_ListETCMeisaiMappingRequest{DtakoRowId: &rowID, Limit: 100})
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(list.Items) != 2 {
+		t.Errorf("mappings should be unchanged after failed replace: %+v", list.Items)
+	}
+
+	// 空の場合は全て削除
+	resp, err = client.BulkReplace(ctx, &proto.Db_BulkReplaceETCMeisaiMappingRequest{DtakoRowId: "ROW_A"})
+	if err != nil {
+		t.Fatalf("BulkReplace failed: %v", err)
+	}
+	if resp.DeletedCount != 2 || len(resp.Items) != 0 {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+}
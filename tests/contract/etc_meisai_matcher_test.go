@@ -0,0 +1,256 @@
+package contract
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/yhonda-ohishi/db_service/src/etcmatch"
+	models "github.com/yhonda-ohishi/db_service/src/models/mysql"
+	"github.com/yhonda-ohishi/db_service/src/proto"
+	"github.com/yhonda-ohishi/db_service/src/testutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// autoMatchResult hashのETC明細の照合結果（ない場合はnil）
+func autoMatchResult(resp *proto.Db_AutoMatchETCMeisaiResponse, hash string) *proto.Db_AutoMatchResult {
+	for _, result := range resp.Results {
+		if result.EtcMeisaiHash == hash {
+			return result
+		}
+	}
+	return nil
+}
+
+func TestETCMeisaiMatcherService_AutoMatch(t *testing.T) {
+	db, conn := testutil.NewClientConn(t)
+
+	client := proto.NewDb_ETCMeisaiMatcherServiceClient(conn)
+	mappingClient := proto.NewDb_ETCMeisaiMappingServiceClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	at := func(day, hour int) time.Time {
+		return time.Date(2025, 9, day, hour, 0, 0, 0, time.UTC)
+	}
+	ptr := func(t time.Time) *time.Time { return &t }
+
+	// 本番DB: ETCカードと車輌、運行データ
+	for _, etcNum := range []*models.ETCNum{
+		{ETCCardNum: "CARD_A", CarID: "000001", StartDateTime: ptr(at(1, 0)), DueDateTime: ptr(at(30, 0))},
+		{ETCCardNum: "CARD_A", CarID: "000002", DueDateTime: ptr(time.Date(2025, 8, 31, 0, 0, 0, 0, time.UTC))}, // 期限切れ
+		{ETCCardNum: "CARD_B", CarID: "000003"},
+		{ETCCardNum: "CARD_B", CarID: "000004"},
+	} {
+		if err := db.Prod.DB.Create(etcNum).Error; err != nil {
+			t.Fatalf("failed to seed etc_num: %v", err)
+		}
+	}
+	for _, row := range []*models.DTakoRows{
+		{ID: "ROW1", CarCC: "000001", DepartureDateTime: at(10, 8), ReturnDateTime: at(10, 18)},
+		{ID: "ROW2", CarCC: "000002", DepartureDateTime: at(10, 8), ReturnDateTime: at(10, 18)},
+		{ID: "ROW3", CarCC: "000003", DepartureDateTime: at(11, 8), ReturnDateTime: at(11, 18)},
+		{ID: "ROW4", CarCC: "000004", DepartureDateTime: at(11, 8), ReturnDateTime: at(11, 18)},
+	} {
+		row.ReadDate = row.ReturnDateTime
+		if err := db.Prod.DB.Create(row).Error; err != nil {
+			t.Fatalf("failed to seed dtako_rows: %v", err)
+		}
+	}
+
+	// ローカルDB: ETC明細
+	meisai := func(card string, dateFr *time.Time, dateTo time.Time) *models.ETCMeisai {
+		m := &models.ETCMeisai{
+			DateFr:     dateFr,
+			DateTo:     dateTo,
+			DateToDate: dateTo.Truncate(24 * time.Hour),
+			IcFr:       "東京IC",
+			IcTo:       "横浜IC",
+			Price:      1000,
+			Shashu:     1,
+			EtcNum:     card,
+		}
+		m.SetHash()
+		if err := db.Local.Create(m).Error; err != nil {
+			t.Fatalf("failed to seed etc_meisai: %v", err)
+		}
+		return m
+	}
+	both := meisai("CARD_A", ptr(at(10, 9)), at(10, 10))
+	exitOnly := meisai("CARD_A", nil, at(10, 12))
+	partial := meisai("CARD_A", ptr(at(10, 7)), at(10, 9))
+	shared := meisai("CARD_B", ptr(at(11, 9)), at(11, 10))
+	unknownCard := meisai("CARD_X", ptr(at(11, 9)), at(11, 10))
+	noRow := meisai("CARD_A", ptr(at(12, 9)), at(12, 10))
+
+	req := &proto.Db_AutoMatchETCMeisaiRequest{
+		StartDate: at(1, 0).Format(time.RFC3339),
+		EndDate:   at(30, 0).Format(time.RFC3339),
+		DryRun:    true,
+	}
+	resp, err := client.AutoMatch(ctx, req)
+	if err != nil {
+		t.Fatalf("AutoMatch (dry-run) failed: %v", err)
+	}
+	if resp.MatchedCount != 2 || resp.AmbiguousCount != 2 || resp.UnmatchedCount != 2 || resp.AlreadyMappedCount != 0 {
+		t.Fatalf("unexpected counts: %+v", resp)
+	}
+
+	want := map[string]struct {
+		status proto.Db_AutoMatchStatus
+		row    string
+		conf   float64
+	}{
+		both.Hash:        {proto.Db_AutoMatchStatus_AUTO_MATCH_STATUS_MATCHED, "ROW1", 1.0},
+		exitOnly.Hash:    {proto.Db_AutoMatchStatus_AUTO_MATCH_STATUS_MATCHED, "ROW1", 0.9},
+		partial.Hash:     {proto.Db_AutoMatchStatus_AUTO_MATCH_STATUS_AMBIGUOUS, "ROW1", 0.5},
+		shared.Hash:      {proto.Db_AutoMatchStatus_AUTO_MATCH_STATUS_AMBIGUOUS, "ROW3", 1.0},
+		unknownCard.Hash: {proto.Db_AutoMatchStatus_AUTO_MATCH_STATUS_UNMATCHED, "", 0},
+		noRow.Hash:       {proto.Db_AutoMatchStatus_AUTO_MATCH_STATUS_UNMATCHED, "", 0},
+	}
+	for _, result := range resp.Results {
+		w := want[result.EtcMeisaiHash]
+		if result.Status != w.status || result.MappingId != 0 {
+			t.Errorf("%s: unexpected result: %+v", result.DateTo, result)
+			continue
+		}
+		if w.row == "" {
+			if len(result.Candidates) != 0 || result.Reason == "" {
+				t.Errorf("%s: unmatched result should have a reason and no candidates: %+v", result.DateTo, result)
+			}
+			continue
+		}
+		if len(result.Candidates) == 0 || result.Candidates[0].DtakoRowId != w.row || result.Candidates[0].Confidence != w.conf {
+			t.Errorf("%s: unexpected candidates: %+v", result.DateTo, result.Candidates)
+		}
+	}
+	if got := len(autoMatchResult(resp, shared.Hash).Candidates); got != 2 {
+		t.Errorf("shared card should have 2 candidates, got %d", got)
+	}
+
+	// 候補が1件に決まった明細のみマッピングを作成
+	req.DryRun = false
+	resp, err = client.AutoMatch(ctx, req)
+	if err != nil {
+		t.Fatalf("AutoMatch failed: %v", err)
+	}
+	mappingID := autoMatchResult(resp, both.Hash).GetMappingId()
+	if resp.MatchedCount != 2 || mappingID == 0 {
+		t.Fatalf("unexpected result: %+v", resp)
+	}
+	mapping, err := mappingClient.Get(ctx, &proto.Db_GetETCMeisaiMappingRequest{Id: mappingID})
+	if err != nil {
+		t.Fatalf("Get mapping failed: %v", err)
+	}
+	if m := mapping.EtcMeisaiMapping; m.EtcMeisaiHash != both.Hash || m.DtakoRowId != "ROW1" || m.CreatedBy != etcmatch.CreatedBy || m.Notes == nil {
+		t.Errorf("unexpected mapping: %+v", m)
+	}
+	list, err := mappingClient.List(ctx, &proto.Db_ListETCMeisaiMappingRequest{Limit: 100})
+	if err != nil {
+		t.Fatalf("List mappings failed: %v", err)
+	}
+	if len(list.Items) != 2 {
+		t.Errorf("expected 2 mappings, got %d", len(list.Items))
+	}
+
+	// 再実行しても重複して作成しない
+	resp, err = client.AutoMatch(ctx, req)
+	if err != nil {
+		t.Fatalf("AutoMatch failed: %v", err)
+	}
+	if resp.MatchedCount != 0 || resp.AlreadyMappedCount != 2 || len(resp.Results) != 4 {
+		t.Errorf("unexpected result on re-run: %+v", resp)
+	}
+
+	req.IncludeAlreadyMapped = true
+	resp, err = client.AutoMatch(ctx, req)
+	if err != nil {
+		t.Fatalf("AutoMatch failed: %v", err)
+	}
+	if len(resp.Results) != 6 || autoMatchResult(resp, both.Hash).GetStatus() != proto.Db_AutoMatchStatus_AUTO_MATCH_STATUS_ALREADY_MAPPED {
+		t.Errorf("unexpected result with include_already_mapped: %+v", resp)
+	}
+
+	// 不正な期間は拒否
+	if _, err := client.AutoMatch(ctx, &proto.Db_AutoMatchETCMeisaiRequest{StartDate: "2025-09-01"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument, got %v", err)
+	}
+	if _, err := client.AutoMatch(ctx, &proto.Db_AutoMatchETCMeisaiRequest{StartDate: req.EndDate, EndDate: req.StartDate}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument, got %v", err)
+	}
+}
+
+func TestETCMeisaiMatcherService_AutoMatch_MultiDayTrip(t *testing.T) {
+	db, conn := testutil.NewClientConn(t)
+
+	client := proto.NewDb_ETCMeisaiMatcherServiceClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	at := func(day, hour int) time.Time {
+		return time.Date(2025, 9, day, hour, 0, 0, 0, time.UTC)
+	}
+
+	if err := db.Prod.DB.Create(&models.ETCNum{ETCCardNum: "CARD_M", CarID: "000010"}).Error; err != nil {
+		t.Fatalf("failed to seed etc_num: %v", err)
+	}
+	// 照合期間（10日）より前に出庫し、期間後に帰庫する複数日の運行と、その前の運行
+	for _, row := range []*models.DTakoRows{
+		{ID: "ROW_EARLY", CarCC: "000010", DepartureDateTime: at(7, 8), ReturnDateTime: at(8, 20)},
+		{ID: "ROW_LONG", CarCC: "000010", DepartureDateTime: at(9, 6), ReturnDateTime: at(12, 18)},
+	} {
+		row.ReadDate = row.ReturnDateTime
+		if err := db.Prod.DB.Create(row).Error; err != nil {
+			t.Fatalf("failed to seed dtako_rows: %v", err)
+		}
+	}
+
+	meisai := func(dateFr, dateTo time.Time) *models.ETCMeisai {
+		m := &models.ETCMeisai{
+			DateFr:     &dateFr,
+			DateTo:     dateTo,
+			DateToDate: dateTo.Truncate(24 * time.Hour),
+			IcFr:       "東京IC",
+			IcTo:       "横浜IC",
+			Price:      1000,
+			Shashu:     1,
+			EtcNum:     "CARD_M",
+		}
+		m.SetHash()
+		if err := db.Local.Create(m).Error; err != nil {
+			t.Fatalf("failed to seed etc_meisai: %v", err)
+		}
+		return m
+	}
+	during := meisai(at(10, 9), at(10, 10))
+	// 入口の利用日時が照合期間の開始より1日以上前の明細（先に照合した明細より前の運行も候補）
+	earlyEntry := meisai(at(8, 10), at(10, 12))
+
+	resp, err := client.AutoMatch(ctx, &proto.Db_AutoMatchETCMeisaiRequest{
+		StartDate: at(10, 0).Format(time.RFC3339),
+		EndDate:   at(11, 0).Format(time.RFC3339),
+		DryRun:    true,
+	})
+	if err != nil {
+		t.Fatalf("AutoMatch failed: %v", err)
+	}
+
+	result := autoMatchResult(resp, during.Hash)
+	if result.GetStatus() != proto.Db_AutoMatchStatus_AUTO_MATCH_STATUS_MATCHED ||
+		len(result.Candidates) != 1 || result.Candidates[0].DtakoRowId != "ROW_LONG" || result.Candidates[0].Confidence != 1.0 {
+		t.Errorf("multi-day trip should be matched: %+v", result)
+	}
+
+	result = autoMatchResult(resp, earlyEntry.Hash)
+	if result.GetStatus() != proto.Db_AutoMatchStatus_AUTO_MATCH_STATUS_AMBIGUOUS || len(result.Candidates) != 2 {
+		t.Fatalf("entry in the earlier trip and exit in the multi-day trip should be ambiguous: %+v", result)
+	}
+	rows := map[string]bool{}
+	for _, c := range result.Candidates {
+		rows[c.DtakoRowId] = true
+	}
+	if !rows["ROW_EARLY"] || !rows["ROW_LONG"] {
+		t.Errorf("unexpected candidates: %+v", result.Candidates)
+	}
+}
@@ -0,0 +1,106 @@
+package contract
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/yhonda-ohishi/db_service/src/models/ichibanboshi"
+	"github.com/yhonda-ohishi/db_service/src/proto"
+	"github.com/yhonda-ohishi/db_service/src/testutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestHinmeiMasterService(t *testing.T) {
+	db, conn := testutil.NewClientConn(t)
+
+	client := proto.NewDb_HinmeiMasterServiceClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	str := func(s string) *string { return &s }
+	num := func(f float64) *float64 { return &f }
+	for _, m := range []*ichibanboshi.HinmeiMaster{
+		{HinmeiC: "0001", HinmeiH: "01", HinmeiN: str("鋼材"), HinmeiF: str("ｺｳｻﾞｲ"), Tani: str("t"), TaniJuryo: num(1000)},
+		{HinmeiC: "0002", HinmeiH: "01", HinmeiN: str("セメント"), HinmeiF: str("ｾﾒﾝﾄ"), Tani: str("袋"), TaniJuryo: num(25)},
+	} {
+		if err := db.SQLServer.DB.Create(m).Error; err != nil {
+			t.Fatalf("Failed to seed 品名ﾏｽﾀ: %v", err)
+		}
+	}
+	for _, m := range []*ichibanboshi.TokuisakiHinmeiMaster{
+		{TokuisakiC: "000100", TokuisakiH: "001", HinmeiC: "0002", HinmeiH: "01", HinmeiN: str("普通セメント"), Tani: str(" ")},
+		{TokuisakiC: "000100", TokuisakiH: "001", HinmeiC: "0009", HinmeiH: "01", HinmeiN: str("特注品"), Tani: str("式")},
+	} {
+		if err := db.SQLServer.DB.Create(m).Error; err != nil {
+			t.Fatalf("Failed to seed 得意先別品名ﾏｽﾀ: %v", err)
+		}
+	}
+
+	t.Run("Get and Search", func(t *testing.T) {
+		response, err := client.Get(ctx, &proto.Db_GetHinmeiMasterRequest{HinmeiC: "0001", HinmeiH: "01"})
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		if response.HinmeiMaster.GetHinmeiN() != "鋼材" || response.HinmeiMaster.GetTaniJuryo() != 1000 {
+			t.Errorf("unexpected hinmei: %v", response.HinmeiMaster)
+		}
+
+		list, err := client.Search(ctx, &proto.Db_SearchHinmeiMasterRequest{Query: "ｾﾒﾝﾄ"})
+		if err != nil {
+			t.Fatalf("Search() error = %v", err)
+		}
+		if len(list.Items) != 1 || list.Items[0].HinmeiC != "0002" {
+			t.Errorf("unexpected search result: %v", list.Items)
+		}
+	})
+
+	t.Run("TokuisakiHinmei", func(t *testing.T) {
+		list, err := client.ListTokuisakiHinmei(ctx, &proto.Db_ListTokuisakiHinmeiRequest{TokuisakiC: "000100", TokuisakiH: "001"})
+		if err != nil {
+			t.Fatalf("ListTokuisakiHinmei() error = %v", err)
+		}
+		if len(list.Items) != 2 || list.Items[0].HinmeiC != "0002" {
+			t.Errorf("unexpected list: %v", list.Items)
+		}
+
+		_, err = client.GetTokuisakiHinmei(ctx, &proto.Db_GetTokuisakiHinmeiRequest{TokuisakiC: "000100", TokuisakiH: "001", HinmeiC: "0001", HinmeiH: "01"})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("Expected NotFound, got %v", err)
+		}
+	})
+
+	t.Run("Resolve", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			req       *proto.Db_ResolveHinmeiRequest
+			hinmeiN   string
+			tani      string
+			taniJuryo float64
+			specific  bool
+		}{
+			// 得意先別品名マスタの品名を優先し、空白の単位は品名マスタの値を使用
+			{"override", &proto.Db_ResolveHinmeiRequest{TokuisakiC: "000100", TokuisakiH: "001", HinmeiC: "0002", HinmeiH: "01"}, "普通セメント", "袋", 25, true},
+			{"master only", &proto.Db_ResolveHinmeiRequest{TokuisakiC: "000200", TokuisakiH: "001", HinmeiC: "0002", HinmeiH: "01"}, "セメント", "袋", 25, false},
+			{"tokuisaki only", &proto.Db_ResolveHinmeiRequest{TokuisakiC: "000100", TokuisakiH: "001", HinmeiC: "0009", HinmeiH: "01"}, "特注品", "式", 0, true},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				response, err := client.Resolve(ctx, tt.req)
+				if err != nil {
+					t.Fatalf("Resolve() error = %v", err)
+				}
+				if response.GetHinmeiN() != tt.hinmeiN || response.GetTani() != tt.tani ||
+					response.GetTaniJuryo() != tt.taniJuryo || response.TokuisakiSpecific != tt.specific {
+					t.Errorf("unexpected resolved hinmei: %v", response)
+				}
+			})
+		}
+
+		_, err := client.Resolve(ctx, &proto.Db_ResolveHinmeiRequest{TokuisakiC: "000100", TokuisakiH: "001", HinmeiC: "9999", HinmeiH: "01"})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("Expected NotFound, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,123 @@
+package contract
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/yhonda-ohishi/db_service/src/models/ichibanboshi"
+	"github.com/yhonda-ohishi/db_service/src/proto"
+	"github.com/yhonda-ohishi/db_service/src/testutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTokuisakiMasterService(t *testing.T) {
+	db, conn := testutil.NewClientConn(t)
+
+	client := proto.NewDb_TokuisakiMasterServiceClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	str := func(s string) *string { return &s }
+	for _, m := range []*ichibanboshi.TokuisakiMaster{
+		{TokuisakiC: "000100", TokuisakiH: "001", TokuisakiN: str("大石運輸株式会社"), TokuisakiF: str("ｵｵｲｼｳﾝﾕ")},
+		{TokuisakiC: "000100", TokuisakiH: "002", TokuisakiN: str("大石運輸 横浜営業所"), TokuisakiF: str("ｵｵｲｼｳﾝﾕﾖｺﾊﾏ")},
+		{TokuisakiC: "000200", TokuisakiH: "001", TokuisakiN: str("100%物流"), TokuisakiF: str("ﾋｬｸﾊﾟｰｾﾝﾄﾌﾞﾂﾘｭｳ")},
+	} {
+		if err := db.SQLServer.DB.Create(m).Error; err != nil {
+			t.Fatalf("Failed to seed 得意先ﾏｽﾀ: %v", err)
+		}
+	}
+	for _, m := range []*ichibanboshi.TokuisakiTekiyobiMaster{
+		{TokuisakiC: "000100", TokuisakiH: "001", Tekiyobi: time.Date(2024, 4, 1, 0, 0, 0, 0, time.Local), TokuisakiN: str("大石運送")},
+		{TokuisakiC: "000100", TokuisakiH: "001", Tekiyobi: time.Date(2025, 4, 1, 0, 0, 0, 0, time.Local), TokuisakiN: str("大石運輸株式会社")},
+	} {
+		if err := db.SQLServer.DB.Create(m).Error; err != nil {
+			t.Fatalf("Failed to seed 得意先適用日ﾏｽﾀ: %v", err)
+		}
+	}
+
+	t.Run("Get", func(t *testing.T) {
+		response, err := client.Get(ctx, &proto.Db_GetTokuisakiMasterRequest{TokuisakiC: "000100", TokuisakiH: "002"})
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		if response.TokuisakiMaster.GetTokuisakiN() != "大石運輸 横浜営業所" {
+			t.Errorf("unexpected TokuisakiN: %q", response.TokuisakiMaster.GetTokuisakiN())
+		}
+
+		_, err = client.Get(ctx, &proto.Db_GetTokuisakiMasterRequest{TokuisakiC: "000100", TokuisakiH: "999"})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("Expected NotFound, got %v", err)
+		}
+	})
+
+	t.Run("List", func(t *testing.T) {
+		response, err := client.List(ctx, &proto.Db_ListTokuisakiMasterRequest{
+			Limit: 10,
+			Sort: []*proto.Db_TokuisakiMasterSortSpec{
+				{Field: proto.Db_TokuisakiMasterSortField_TOKUISAKI_MASTER_SORT_FIELD_TOKUISAKI_C, Direction: proto.Db_SortDirection_SORT_DIRECTION_DESC},
+				{Field: proto.Db_TokuisakiMasterSortField_TOKUISAKI_MASTER_SORT_FIELD_TOKUISAKI_H},
+			},
+		})
+		if err != nil {
+			t.Fatalf("List() error = %v", err)
+		}
+		if response.GetTotalCount() != 3 || len(response.Items) != 3 ||
+			response.Items[0].TokuisakiC != "000200" || response.Items[1].TokuisakiH != "001" {
+			t.Errorf("unexpected list: %v", response.Items)
+		}
+	})
+
+	t.Run("Search", func(t *testing.T) {
+		response, err := client.Search(ctx, &proto.Db_SearchTokuisakiMasterRequest{Query: "ｵｵｲｼ"})
+		if err != nil {
+			t.Fatalf("Search() error = %v", err)
+		}
+		if len(response.Items) != 2 || response.Items[0].TokuisakiH != "001" || response.Items[1].TokuisakiH != "002" {
+			t.Errorf("unexpected search result: %v", response.Items)
+		}
+
+		// ワイルドカードは文字として扱う
+		response, err = client.Search(ctx, &proto.Db_SearchTokuisakiMasterRequest{Query: "0%"})
+		if err != nil {
+			t.Fatalf("Search() error = %v", err)
+		}
+		if len(response.Items) != 1 || response.Items[0].TokuisakiC != "000200" {
+			t.Errorf("unexpected search result: %v", response.Items)
+		}
+
+		_, err = client.Search(ctx, &proto.Db_SearchTokuisakiMasterRequest{})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument, got %v", err)
+		}
+	})
+
+	t.Run("Tekiyobi", func(t *testing.T) {
+		list, err := client.ListTekiyobi(ctx, &proto.Db_ListTokuisakiTekiyobiRequest{TokuisakiC: "000100", TokuisakiH: "001"})
+		if err != nil {
+			t.Fatalf("ListTekiyobi() error = %v", err)
+		}
+		if len(list.Items) != 2 || list.Items[0].Tekiyobi != "2024-04-01" {
+			t.Errorf("unexpected tekiyobi list: %v", list.Items)
+		}
+
+		at, err := client.GetTekiyobiAt(ctx, &proto.Db_GetTokuisakiTekiyobiAtRequest{TokuisakiC: "000100", TokuisakiH: "001", Date: "2025-03-31"})
+		if err != nil {
+			t.Fatalf("GetTekiyobiAt() error = %v", err)
+		}
+		if at.TokuisakiTekiyobi.GetTokuisakiN() != "大石運送" {
+			t.Errorf("unexpected tekiyobi: %v", at.TokuisakiTekiyobi)
+		}
+
+		_, err = client.GetTekiyobiAt(ctx, &proto.Db_GetTokuisakiTekiyobiAtRequest{TokuisakiC: "000100", TokuisakiH: "001", Date: "2024-03-31"})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("Expected NotFound, got %v", err)
+		}
+		_, err = client.GetTekiyobiAt(ctx, &proto.Db_GetTokuisakiTekiyobiAtRequest{TokuisakiC: "000100", TokuisakiH: "001", Date: "2025/04/01"})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,159 @@
+package contract
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/yhonda-ohishi/db_service/src/models/ichibanboshi"
+	models "github.com/yhonda-ohishi/db_service/src/models/mysql"
+	"github.com/yhonda-ohishi/db_service/src/proto"
+	"github.com/yhonda-ohishi/db_service/src/testutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestVehicleIdentityService(t *testing.T) {
+	db, conn := testutil.NewClientConn(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	str := func(s string) *string { return &s }
+	date := func(year int, month time.Month, day int) *time.Time {
+		d := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		return &d
+	}
+
+	// 本番DBの車輌（000101は全システムに登録、000102はcarsのみ、000103はid4未設定）
+	for _, m := range []interface{}{
+		&models.Cars{ID: "000101", ID4: 101},
+		&models.Cars{ID: "000102", ID4: 102},
+		&models.Cars{ID: "000103"},
+		&models.DTakoCars{CarCode: "103", CarCC: "000103"},
+		&models.ETCNum{ETCCardNum: "3333", CarID: "000103"},
+		&models.DTakoCars{CarCode: "101", CarCC: "000101"},
+		&models.ETCNum{ETCCardNum: "1111", CarID: "000101", StartDateTime: date(2024, 1, 1), DueDateTime: date(2025, 1, 1)},
+		&models.ETCNum{ETCCardNum: "2222", CarID: "000101", StartDateTime: date(2025, 1, 1)},
+	} {
+		if err := db.Prod.DB.Create(m).Error; err != nil {
+			t.Fatalf("Failed to seed %T: %v", m, err)
+		}
+	}
+	// SQL Server (ichibanboshi) の車輌マスタ（0900・0000は車輌マスタのみ）
+	for _, m := range []*ichibanboshi.SharyoMaster{
+		{SharyoC: "0101", SharyoH: "01", SharyoN: str("1号車")},
+		{SharyoC: "0900", SharyoH: "01", SharyoN: str("予備車")},
+		{SharyoC: "0000", SharyoH: "01", SharyoN: str("未使用")},
+	} {
+		if err := db.SQLServer.DB.Create(m).Error; err != nil {
+			t.Fatalf("Failed to seed 車輌ﾏｽﾀ: %v", err)
+		}
+	}
+
+	client := proto.NewDb_VehicleIdentityServiceClient(conn)
+
+	t.Run("Get", func(t *testing.T) {
+		for name, req := range map[string]*proto.Db_GetVehicleIdentityRequest{
+			"car_code": {Key: &proto.Db_GetVehicleIdentityRequest_CarCode{CarCode: "101"}},
+			"id4":      {Key: &proto.Db_GetVehicleIdentityRequest_Id4{Id4: 101}},
+			"sharyo_c": {Key: &proto.Db_GetVehicleIdentityRequest_SharyoC{SharyoC: "0101"}},
+			"etc_car":  {Key: &proto.Db_GetVehicleIdentityRequest_EtcCarId{EtcCarId: "000101"}},
+		} {
+			req.At = "2025-06-01T00:00:00Z"
+			response, err := client.Get(ctx, req)
+			if err != nil {
+				t.Fatalf("%s: Get() error = %v", name, err)
+			}
+			identity := response.Identity
+			if identity.CarCc != "000101" || identity.GetId4() != 101 || identity.Car == nil {
+				t.Errorf("%s: unexpected identity: %v", name, identity)
+			}
+			if len(identity.DtakoCars) != 1 || len(identity.EtcNums) != 2 || len(identity.SharyoMasters) != 1 || len(identity.Missing) != 0 {
+				t.Errorf("%s: unexpected aliases: %v", name, identity)
+			}
+			if len(response.ActiveEtcNums) != 1 || response.ActiveEtcNums[0].EtcCardNum != "2222" {
+				t.Errorf("%s: unexpected active etc_num: %v", name, response.ActiveEtcNums)
+			}
+		}
+
+		response, err := client.Get(ctx, &proto.Db_GetVehicleIdentityRequest{Key: &proto.Db_GetVehicleIdentityRequest_CarId{CarId: "000102"}})
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		missing := response.Identity.Missing
+		if len(missing) != 3 || missing[0] != proto.Db_VehicleSystem_VEHICLE_SYSTEM_DTAKO_CARS || missing[2] != proto.Db_VehicleSystem_VEHICLE_SYSTEM_SHARYO_MASTER {
+			t.Errorf("unexpected missing: %v", missing)
+		}
+
+		// id4が未設定の車輌は車輌C 0000 と対応付けない
+		response, err = client.Get(ctx, &proto.Db_GetVehicleIdentityRequest{Key: &proto.Db_GetVehicleIdentityRequest_CarId{CarId: "000103"}})
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		missing = response.Identity.Missing
+		if len(response.Identity.SharyoMasters) != 0 || len(missing) != 1 || missing[0] != proto.Db_VehicleSystem_VEHICLE_SYSTEM_SHARYO_MASTER {
+			t.Errorf("car without id4 should not be linked to 車輌ﾏｽﾀ: %v", response.Identity)
+		}
+		response, err = client.Get(ctx, &proto.Db_GetVehicleIdentityRequest{Key: &proto.Db_GetVehicleIdentityRequest_SharyoC{SharyoC: "0000"}})
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		if response.Identity.Car != nil || len(response.Identity.SharyoMasters) != 1 {
+			t.Errorf("車輌C 0000 should not be linked to a car without id4: %v", response.Identity)
+		}
+
+		_, err = client.Get(ctx, &proto.Db_GetVehicleIdentityRequest{Key: &proto.Db_GetVehicleIdentityRequest_CarCc{CarCc: "999999"}})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("Expected NotFound, got %v", err)
+		}
+		_, err = client.Get(ctx, &proto.Db_GetVehicleIdentityRequest{})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument, got %v", err)
+		}
+	})
+
+	t.Run("ListGaps", func(t *testing.T) {
+		response, err := client.ListGaps(ctx, &proto.Db_ListVehicleIdentityGapsRequest{})
+		if err != nil {
+			t.Fatalf("ListGaps() error = %v", err)
+		}
+		if response.VehicleCount != 5 || len(response.Systems) != 4 {
+			t.Errorf("unexpected report: count=%d systems=%v", response.VehicleCount, response.Systems)
+		}
+		// id4が未設定の000103は車輌C 0000 と対応付けない
+		if len(response.Items) != 4 || response.Items[0].SharyoMasters[0].SharyoC != "0000" || response.Items[1].SharyoMasters[0].SharyoC != "0900" ||
+			response.Items[2].CarCc != "000102" || response.Items[3].CarCc != "000103" || len(response.Items[3].SharyoMasters) != 0 {
+			t.Fatalf("unexpected gaps: %v", response.Items)
+		}
+
+		response, err = client.ListGaps(ctx, &proto.Db_ListVehicleIdentityGapsRequest{
+			Missing: []proto.Db_VehicleSystem{proto.Db_VehicleSystem_VEHICLE_SYSTEM_CARS},
+		})
+		if err != nil {
+			t.Fatalf("ListGaps() error = %v", err)
+		}
+		if len(response.Items) != 2 || response.Items[1].GetId4() != 900 {
+			t.Errorf("unexpected gaps: %v", response.Items)
+		}
+	})
+
+	t.Run("SharyoMaster", func(t *testing.T) {
+		sharyo := proto.NewDb_SharyoMasterServiceClient(conn)
+		response, err := sharyo.Get(ctx, &proto.Db_GetSharyoMasterRequest{SharyoC: "0101", SharyoH: "01"})
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		if response.SharyoMaster.GetSharyoN() != "1号車" {
+			t.Errorf("unexpected sharyo: %v", response.SharyoMaster)
+		}
+
+		list, err := sharyo.ListBySharyoC(ctx, &proto.Db_ListSharyoMasterBySharyoCRequest{SharyoC: "0900"})
+		if err != nil {
+			t.Fatalf("ListBySharyoC() error = %v", err)
+		}
+		if len(list.Items) != 1 {
+			t.Errorf("unexpected list: %v", list.Items)
+		}
+	})
+}
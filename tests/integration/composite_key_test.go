@@ -1,28 +1,19 @@
-//go:build integration
-// +build integration
-
 package integration
 
 import (
+	"context"
 	"testing"
 	"time"
 
-	"github.com/yhonda-ohishi/db_service/src/config"
-	"github.com/yhonda-ohishi/db_service/src/models"
+	models "github.com/yhonda-ohishi/db_service/src/models/mysql"
 	"github.com/yhonda-ohishi/db_service/src/repository"
+	"github.com/yhonda-ohishi/db_service/src/testutil"
 )
 
 func TestCompositeKeyOperations(t *testing.T) {
+	ctx := context.Background()
 	// データベース接続のセットアップ
-	cfg, err := config.LoadConfig()
-	if err != nil {
-		t.Fatalf("Failed to load config: %v", err)
-	}
-
-	db, err := config.InitDatabase(cfg)
-	if err != nil {
-		t.Fatalf("Failed to init database: %v", err)
-	}
+	db := testutil.NewSQLiteDB(t, testutil.LocalModels...)
 
 	repo := repository.NewDTakoUriageKeihiRepository(db)
 
@@ -32,14 +23,14 @@ func TestCompositeKeyOperations(t *testing.T) {
 		Datetime:    time.Now(),
 		KeihiC:      1,
 		Price:       1000.0,
-		Km:          50.5,
+		Km:          float64Ptr(50.5),
 		DtakoRowID:  "DTAKO_COMP001",
 		DtakoRowIDR: "DTAKO_COMP001R",
 	}
 
 	// 複合主キーでの作成
 	t.Run("Create with composite key", func(t *testing.T) {
-		err := repo.Create(testData)
+		err := repo.Create(ctx, testData)
 		if err != nil {
 			t.Errorf("Failed to create record: %v", err)
 		}
@@ -47,7 +38,7 @@ func TestCompositeKeyOperations(t *testing.T) {
 
 	// 複合主キーでの取得
 	t.Run("Get by composite key", func(t *testing.T) {
-		result, err := repo.GetByCompositeKey(testData.SrchID, testData.Datetime, testData.KeihiC)
+		result, err := repo.GetByCompositeKey(ctx, testData.SrchID, testData.Datetime, testData.KeihiC)
 		if err != nil {
 			t.Errorf("Failed to get record: %v", err)
 		}
@@ -69,15 +60,15 @@ func TestCompositeKeyOperations(t *testing.T) {
 			DtakoRowID:  "DTAKO_COMP002",
 			DtakoRowIDR: "DTAKO_COMP002R",
 		}
-		err := repo.Create(duplicate)
-		if err == nil {
-			t.Error("Expected duplicate key error, but got nil")
+		err := repo.Create(ctx, duplicate)
+		if err != models.ErrDuplicateKey {
+			t.Errorf("Expected duplicate key error, got %v", err)
 		}
 	})
 
 	// 部分キーでの検索
 	t.Run("List by partial key", func(t *testing.T) {
-		results, err := repo.ListBySrchID(testData.SrchID)
+		results, err := repo.ListBySrchID(ctx, testData.SrchID)
 		if err != nil {
 			t.Errorf("Failed to list by srch_id: %v", err)
 		}
@@ -88,15 +79,19 @@ func TestCompositeKeyOperations(t *testing.T) {
 
 	// 複合主キーでの削除
 	t.Run("Delete by composite key", func(t *testing.T) {
-		err := repo.DeleteByCompositeKey(testData.SrchID, testData.Datetime, testData.KeihiC)
+		err := repo.DeleteByCompositeKey(ctx, testData.SrchID, testData.Datetime, testData.KeihiC)
 		if err != nil {
 			t.Errorf("Failed to delete record: %v", err)
 		}
 
 		// 削除確認
-		result, err := repo.GetByCompositeKey(testData.SrchID, testData.Datetime, testData.KeihiC)
+		result, err := repo.GetByCompositeKey(ctx, testData.SrchID, testData.Datetime, testData.KeihiC)
 		if err == nil && result != nil {
 			t.Error("Record should have been deleted")
 		}
 	})
 }
+
+func float64Ptr(f float64) *float64 {
+	return &f
+}
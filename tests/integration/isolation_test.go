@@ -0,0 +1,68 @@
+//go:build integration
+// +build integration
+
+package integration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/yhonda-ohishi/db_service/src/config"
+	models "github.com/yhonda-ohishi/db_service/src/models/mysql"
+	"github.com/yhonda-ohishi/db_service/src/repository"
+)
+
+// トランザクションの分離レベルテスト
+func TestTransactionIsolation(t *testing.T) {
+	// 分離レベルはDBサーバーに依存するため、実際のMySQLに接続して確認する
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		t.Fatalf("Failed to load config: %v", err)
+	}
+	db, err := config.InitDatabase(cfg)
+	if err != nil {
+		t.Fatalf("Failed to init database: %v", err)
+	}
+
+	t.Run("Read committed isolation", func(t *testing.T) {
+		// トランザクション1を開始
+		tx1 := db.Begin()
+		repo1 := repository.NewDTakoUriageKeihiRepository(tx1)
+
+		// トランザクション2を開始
+		tx2 := db.Begin()
+		repo2 := repository.NewDTakoUriageKeihiRepository(tx2)
+
+		// トランザクション1でデータ作成
+		testData := &models.DTakoUriageKeihi{
+			SrchID:      "ISO_TEST001",
+			Datetime:    time.Now(),
+			KeihiC:      1,
+			Price:       5000.0,
+			DtakoRowID:  "DTAKO_ISO001",
+			DtakoRowIDR: "DTAKO_ISO001R",
+		}
+
+		err := repo1.Create(testData)
+		if err != nil {
+			t.Errorf("Failed to create in tx1: %v", err)
+		}
+
+		// トランザクション2から見えないことを確認（未コミット）
+		result, _ := repo2.GetByCompositeKey(testData.SrchID, testData.Datetime, testData.KeihiC)
+		if result != nil {
+			t.Error("Uncommitted data should not be visible in tx2")
+		}
+
+		// トランザクション1をコミット
+		tx1.Commit()
+
+		// コミット後はトランザクション2からも見える
+		result, _ = repo2.GetByCompositeKey(testData.SrchID, testData.Datetime, testData.KeihiC)
+		if result == nil {
+			t.Error("Committed data should be visible in tx2")
+		}
+
+		tx2.Rollback()
+	})
+}
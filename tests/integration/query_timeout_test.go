@@ -0,0 +1,44 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/yhonda-ohishi/db_service/src/config"
+	"github.com/yhonda-ohishi/db_service/src/repository"
+	"github.com/yhonda-ohishi/db_service/src/testutil"
+)
+
+func TestQueryTimeout(t *testing.T) {
+	db := testutil.NewSQLiteDB(t, testutil.LocalModels...)
+	// 実行前に期限切れになるデフォルトのタイムアウト
+	if err := config.UseQueryTimeout(db, time.Nanosecond); err != nil {
+		t.Fatalf("UseQueryTimeout failed: %v", err)
+	}
+	repo := repository.NewETCMeisaiRepository(db)
+
+	t.Run("Default timeout without deadline", func(t *testing.T) {
+		_, _, err := repo.List(context.Background(), &repository.ETCMeisaiListParams{Limit: 10})
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("expected context.DeadlineExceeded, got %v", err)
+		}
+	})
+
+	t.Run("Request deadline takes precedence", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if _, _, err := repo.List(ctx, &repository.ETCMeisaiListParams{Limit: 10}); err != nil {
+			t.Errorf("List failed: %v", err)
+		}
+	})
+
+	t.Run("Canceled request", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if _, err := repo.GetByID(ctx, 1); !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context.Canceled, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,125 @@
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/yhonda-ohishi/db_service/src/models/ichibanboshi"
+	models "github.com/yhonda-ohishi/db_service/src/models/mysql"
+	"github.com/yhonda-ohishi/db_service/src/repository"
+	"github.com/yhonda-ohishi/db_service/src/testutil"
+)
+
+func TestDTakoEventsRepository_Keyset(t *testing.T) {
+	ctx := context.Background()
+	db, repos := testutil.NewRepositories(t)
+
+	base := time.Date(2025, 1, 10, 8, 0, 0, 0, time.UTC)
+	for i := 0; i < 5; i++ {
+		event := &models.DTakoEvents{
+			OperationNo:   "OP001",
+			StartDatetime: base.Add(time.Duration(i) * time.Hour),
+			EndDatetime:   base.Add(time.Duration(i)*time.Hour + 30*time.Minute),
+			EventName:     "休憩",
+		}
+		if err := db.Prod.DB.Create(event).Error; err != nil {
+			t.Fatalf("failed to seed dtako_events: %v", err)
+		}
+	}
+
+	// 開始日時の降順で2件ずつ取得
+	var got []int64
+	page := repository.PageRequest{PageSize: 2, IncludeTotalCount: true}
+	for {
+		result, err := repos.DTakoEvents.GetPage(ctx, page)
+		if err != nil {
+			t.Fatalf("GetPage failed: %v", err)
+		}
+		if result.TotalCount == nil || *result.TotalCount != 5 {
+			t.Fatalf("unexpected total count: %v", result.TotalCount)
+		}
+		for _, event := range result.Items {
+			got = append(got, event.ID)
+		}
+		if result.NextPageToken == "" {
+			break
+		}
+		page.PageToken = result.NextPageToken
+	}
+	want := []int64{5, 4, 3, 2, 1}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+
+	// 期間指定のストリーミングは古い順
+	start, end := base.Add(time.Hour), base.Add(3*time.Hour)
+	var streamed []int64
+	err := repos.DTakoEvents.StreamByDateRange(context.Background(), &start, &end, 2, func(events []*models.DTakoEvents) error {
+		for _, event := range events {
+			streamed = append(streamed, event.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("StreamByDateRange failed: %v", err)
+	}
+	if len(streamed) != 3 || streamed[0] != 2 || streamed[2] != 4 {
+		t.Errorf("unexpected streamed ids: %v", streamed)
+	}
+}
+
+func TestUntenNippoMeisaiRepository(t *testing.T) {
+	ctx := context.Background()
+	db, repos := testutil.NewRepositories(t)
+
+	rows := []*ichibanboshi.UntenNippoMeisai{
+		{NippoK: "1", HaishaK: "1", SharyoC: "0001", KanriNengappi: time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)},
+		{NippoK: "1", HaishaK: "2", SharyoC: "0001", KanriNengappi: time.Date(2025, 1, 11, 0, 0, 0, 0, time.UTC)},
+		{NippoK: "2", HaishaK: "1", SharyoC: "0002", KanriNengappi: time.Date(2025, 1, 12, 0, 0, 0, 0, time.UTC)},
+	}
+	if err := db.SQLServer.DB.Create(rows).Error; err != nil {
+		t.Fatalf("failed to seed 運転日報明細: %v", err)
+	}
+
+	// 複合主キーでの取得
+	row, err := repos.UntenNippoMeisai.GetByNippoK(ctx, "1", "2", "0001")
+	if err != nil {
+		t.Fatalf("GetByNippoK failed: %v", err)
+	}
+	if !row.KanriNengappi.Equal(rows[1].KanriNengappi) {
+		t.Errorf("unexpected 管理年月日: %v", row.KanriNengappi)
+	}
+
+	// ホワイトリストのフィールドでソート
+	items, total, err := repos.UntenNippoMeisai.GetAll(ctx, 10, 0, []repository.SortField{{Field: "kanri_nengappi"}})
+	if err != nil {
+		t.Fatalf("GetAll failed: %v", err)
+	}
+	if total != 3 || len(items) != 3 || items[0].NippoK != "1" || items[2].NippoK != "2" {
+		t.Errorf("unexpected items: total=%d, items=%v", total, items)
+	}
+
+	// 複合キーのキーセットページネーション
+	page := repository.PageRequest{PageSize: 1}
+	var count int
+	for {
+		result, err := repos.UntenNippoMeisai.GetPage(ctx, page)
+		if err != nil {
+			t.Fatalf("GetPage failed: %v", err)
+		}
+		count += len(result.Items)
+		if result.NextPageToken == "" {
+			break
+		}
+		page.PageToken = result.NextPageToken
+	}
+	if count != 3 {
+		t.Errorf("expected 3 rows over all pages, got %d", count)
+	}
+}
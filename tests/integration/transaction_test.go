@@ -1,29 +1,19 @@
-//go:build integration
-// +build integration
-
 package integration
 
 import (
+	"context"
 	"testing"
 	"time"
 
-	"github.com/yhonda-ohishi/db_service/src/config"
-	"github.com/yhonda-ohishi/db_service/src/models"
+	models "github.com/yhonda-ohishi/db_service/src/models/mysql"
 	"github.com/yhonda-ohishi/db_service/src/repository"
-	"gorm.io/gorm"
+	"github.com/yhonda-ohishi/db_service/src/testutil"
 )
 
 func TestTransactionRollback(t *testing.T) {
+	ctx := context.Background()
 	// データベース接続のセットアップ
-	cfg, err := config.LoadConfig()
-	if err != nil {
-		t.Fatalf("Failed to load config: %v", err)
-	}
-
-	db, err := config.InitDatabase(cfg)
-	if err != nil {
-		t.Fatalf("Failed to init database: %v", err)
-	}
+	db := testutil.NewSQLiteDB(t, testutil.LocalModels...)
 
 	repo := repository.NewDTakoUriageKeihiRepository(db)
 
@@ -43,7 +33,7 @@ func TestTransactionRollback(t *testing.T) {
 			DtakoRowIDR: "DTAKO_TRANS001R",
 		}
 
-		err := txRepo.Create(validData)
+		err := txRepo.Create(ctx, validData)
 		if err != nil {
 			t.Errorf("Failed to create valid data: %v", err)
 		}
@@ -58,7 +48,7 @@ func TestTransactionRollback(t *testing.T) {
 			DtakoRowIDR: "DTAKO_TRANS002R",
 		}
 
-		err = txRepo.Create(invalidData)
+		err = txRepo.Create(ctx, invalidData)
 		if err == nil {
 			tx.Commit()
 			t.Error("Expected error for duplicate key, but got nil")
@@ -68,7 +58,7 @@ func TestTransactionRollback(t *testing.T) {
 		}
 
 		// ロールバック後、データが存在しないことを確認
-		result, _ := repo.GetByCompositeKey(validData.SrchID, validData.Datetime, validData.KeihiC)
+		result, _ := repo.GetByCompositeKey(ctx, validData.SrchID, validData.Datetime, validData.KeihiC)
 		if result != nil {
 			t.Error("Data should not exist after rollback")
 		}
@@ -89,7 +79,7 @@ func TestTransactionRollback(t *testing.T) {
 			DtakoRowIDR: "DTAKO_MULTI001R",
 		}
 
-		err := keihiRepo.Create(keihiData)
+		err := keihiRepo.Create(ctx, keihiData)
 		if err != nil {
 			tx.Rollback()
 			t.Errorf("Failed to create keihi data: %v", err)
@@ -100,79 +90,47 @@ func TestTransactionRollback(t *testing.T) {
 		etcRepo := repository.NewETCMeisaiRepository(tx)
 		etcData := &models.ETCMeisai{
 			DateTo:     time.Now(),
-			DateToDate: time.Now().Format("2006-01-02"),
+			DateToDate: time.Now().Truncate(24 * time.Hour),
 			IcFr:       "東京IC",
 			IcTo:       "横浜IC",
 			Price:      1500,
 			Shashu:     1,
 			EtcNum:     "1234567890123456",
-			DtakoRowID: &keihiData.DtakoRowID,
+			Hash:       "MULTI_TRANS_HASH001",
 		}
 
-		err = etcRepo.Create(etcData)
+		err = etcRepo.Create(ctx, etcData)
 		if err != nil {
 			tx.Rollback()
 			t.Errorf("Failed to create etc data: %v", err)
 			return
 		}
 
+		// ETC明細と運行データの関連付け
+		mappingRepo := repository.NewETCMeisaiMappingRepository(tx)
+		now := time.Now()
+		err = mappingRepo.Create(ctx, &models.ETCMeisaiMapping{
+			ETCMeisaiHash: etcData.Hash,
+			DTakoRowID:    keihiData.DtakoRowID,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+			CreatedBy:     "integration_test",
+		})
+		if err != nil {
+			tx.Rollback()
+			t.Errorf("Failed to create mapping: %v", err)
+			return
+		}
+
 		// コミット
 		if err := tx.Commit().Error; err != nil {
 			t.Errorf("Failed to commit transaction: %v", err)
 		}
 
 		// データの存在確認
-		result, _ := repo.GetByCompositeKey(keihiData.SrchID, keihiData.Datetime, keihiData.KeihiC)
+		result, _ := repo.GetByCompositeKey(ctx, keihiData.SrchID, keihiData.Datetime, keihiData.KeihiC)
 		if result == nil {
 			t.Error("Data should exist after successful commit")
 		}
 	})
 }
-
-// トランザクションの分離レベルテスト
-func TestTransactionIsolation(t *testing.T) {
-	cfg, _ := config.LoadConfig()
-	db, _ := config.InitDatabase(cfg)
-
-	t.Run("Read committed isolation", func(t *testing.T) {
-		// トランザクション1を開始
-		tx1 := db.Begin(&gorm.Session{PrepareStmt: false})
-		repo1 := repository.NewDTakoUriageKeihiRepository(tx1)
-
-		// トランザクション2を開始
-		tx2 := db.Begin(&gorm.Session{PrepareStmt: false})
-		repo2 := repository.NewDTakoUriageKeihiRepository(tx2)
-
-		// トランザクション1でデータ作成
-		testData := &models.DTakoUriageKeihi{
-			SrchID:      "ISO_TEST001",
-			Datetime:    time.Now(),
-			KeihiC:      1,
-			Price:       5000.0,
-			DtakoRowID:  "DTAKO_ISO001",
-			DtakoRowIDR: "DTAKO_ISO001R",
-		}
-
-		err := repo1.Create(testData)
-		if err != nil {
-			t.Errorf("Failed to create in tx1: %v", err)
-		}
-
-		// トランザクション2から見えないことを確認（未コミット）
-		result, _ := repo2.GetByCompositeKey(testData.SrchID, testData.Datetime, testData.KeihiC)
-		if result != nil {
-			t.Error("Uncommitted data should not be visible in tx2")
-		}
-
-		// トランザクション1をコミット
-		tx1.Commit()
-
-		// コミット後はトランザクション2からも見える
-		result, _ = repo2.GetByCompositeKey(testData.SrchID, testData.Datetime, testData.KeihiC)
-		if result == nil {
-			t.Error("Committed data should be visible in tx2")
-		}
-
-		tx2.Rollback()
-	})
-}
@@ -4,7 +4,7 @@ import (
 	"testing"
 	"time"
 
-	"github.com/yhonda-ohishi/db_service/src/models"
+	models "github.com/yhonda-ohishi/db_service/src/models/mysql"
 )
 
 func TestETCMeisai_GenerateHash(t *testing.T) {
@@ -147,18 +147,18 @@ func TestETCMeisai_ValidateWithHash(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name: "Invalid price with hash",
+			name: "Negative price with hash",
 			meisai: &models.ETCMeisai{
 				DateTo:     testTime,
 				DateToDate: testDate,
 				IcFr:       "東京IC",
 				IcTo:       "横浜IC",
-				Price:      -100,
+				Price:      -100, // 返金・調整による負の料金は許可
 				Shashu:     1,
 				EtcNum:     "1234567890123456",
 				Hash:       "valid_hash",
 			},
-			wantErr: true,
+			wantErr: false,
 		},
 	}
 
@@ -4,7 +4,7 @@ import (
 	"testing"
 	"time"
 
-	"github.com/yhonda-ohishi/db_service/src/models"
+	models "github.com/yhonda-ohishi/db_service/src/models/mysql"
 )
 
 func TestETCMeisaiMapping_Validate(t *testing.T) {
@@ -0,0 +1,108 @@
+package unit
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/yhonda-ohishi/db_service/src/models/ichibanboshi"
+	"gorm.io/gorm/schema"
+)
+
+// dumpColumn sql_server_tables/ の列定義（INFORMATION_SCHEMA.COLUMNS）の1列
+type dumpColumn struct {
+	dataType  string
+	maxLength int // 文字列以外は0
+	nullable  bool
+}
+
+// readColumnDump sqlcmdで出力した列定義を読み込む（ファイルがない場合はnil）
+//
+//	COLUMN_NAME DATA_TYPE CHARACTER_MAXIMUM_LENGTH IS_NULLABLE
+//	----------- --------- ------------------------ -----------
+//	地域C char 6 NO
+//	...
+//	(4 行処理されました)
+func readColumnDump(t *testing.T, name string) map[string]dumpColumn {
+	t.Helper()
+	f, err := os.Open(filepath.Join("..", "..", "sql_server_tables", name))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		t.Fatalf("failed to open %s: %v", name, err)
+	}
+	defer f.Close()
+
+	columns := make(map[string]dumpColumn)
+	scanner := bufio.NewScanner(f)
+	for line := 0; scanner.Scan(); line++ {
+		fields := strings.Fields(scanner.Text())
+		// ヘッダー・区切り線・件数の行は読み飛ばす
+		if line < 2 || len(fields) != 4 {
+			continue
+		}
+		maxLength, _ := strconv.Atoi(fields[2])
+		columns[fields[0]] = dumpColumn{dataType: fields[1], maxLength: maxLength, nullable: fields[3] == "YES"}
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatalf("failed to read %s: %v", name, err)
+	}
+	return columns
+}
+
+// TestIchibanboshiModels_MatchColumnDumps 一番星のモデルの列が sql_server_tables/ の列定義と一致するか
+//
+// 列定義がないテーブルはスキップする（取得方法はREADMEの「一番星のテーブル定義」を参照）。
+func TestIchibanboshiModels_MatchColumnDumps(t *testing.T) {
+	testCases := []struct {
+		dump  string
+		model interface{}
+	}{
+		{"chiiki_master.txt", &ichibanboshi.ChiikiMaster{}},
+		{"chiku_master.txt", &ichibanboshi.ChikuMaster{}},
+		{"shain_master.txt", &ichibanboshi.ShainMaster{}},
+		{"unten_meisai.txt", &ichibanboshi.UntenNippoMeisai{}},
+		{"tokuisaki_master.txt", &ichibanboshi.TokuisakiMaster{}},
+		{"tokuisaki_tekiyobi_master.txt", &ichibanboshi.TokuisakiTekiyobiMaster{}},
+		{"hinmei_master.txt", &ichibanboshi.HinmeiMaster{}},
+		{"tokuisaki_hinmei_master.txt", &ichibanboshi.TokuisakiHinmeiMaster{}},
+		{"bumon_master.txt", &ichibanboshi.BumonMaster{}},
+		{"sharyo_master.txt", &ichibanboshi.SharyoMaster{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.dump, func(t *testing.T) {
+			s, err := schema.Parse(tc.model, &sync.Map{}, schema.NamingStrategy{})
+			if err != nil {
+				t.Fatalf("failed to parse model: %v", err)
+			}
+			columns := readColumnDump(t, tc.dump)
+			if columns == nil {
+				t.Skipf("sql_server_tables/%s がないため %s の列定義を確認できません", tc.dump, s.Table)
+			}
+
+			for _, field := range s.Fields {
+				if field.DBName == "" {
+					continue
+				}
+				column, ok := columns[field.DBName]
+				if !ok {
+					t.Errorf("%s.%s: column does not exist", s.Table, field.DBName)
+					continue
+				}
+				if column.maxLength > 0 && field.Size > 0 && field.Size != column.maxLength {
+					t.Errorf("%s.%s: size %d, want %d", s.Table, field.DBName, field.Size, column.maxLength)
+				}
+				if nullable := field.FieldType.Kind() == reflect.Ptr; nullable != column.nullable && !field.PrimaryKey {
+					t.Errorf("%s.%s: nullable %v, want %v", s.Table, field.DBName, nullable, column.nullable)
+				}
+			}
+		})
+	}
+}